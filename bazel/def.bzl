@@ -23,7 +23,8 @@ def _crd_proto_manifest(ctx):
         args.add_all(i, format_each = "--proto_path=%s")
 
     out = ctx.actions.declare_file("%s.crd.yaml" % ctx.label.name)
-    args.add("--crd_out=%s:." % out.path)
+    args.add("--crd_out=.")
+    args.add("--crd_opt=out=%s" % out.path)
     ctx.actions.run(
         executable = ctx.toolchains[_PROTO_TOOLCHAIN].proto.proto_compiler,
         tools = [ctx.executable._compiler],
@@ -55,7 +56,7 @@ K8SClient = provider()
 
 def _go_client(ctx):
     go = go_context(ctx)
-    opts = ctx.attr.importpath
+    opts = "importpath=%s" % ctx.attr.importpath
     if ctx.attr.fqdn:
         opts += ",fqdn-set"
     out = _execute_protoc(
@@ -103,7 +104,7 @@ go_client = rule(
 
 def _go_testing_client(ctx):
     go = go_context(ctx)
-    opts = "%s,%s" % (ctx.attr.importpath, ctx.attr.client[GoLibrary].importpath)
+    opts = "importpath=%s,client-importpath=%s" % (ctx.attr.importpath, ctx.attr.client[GoLibrary].importpath)
     if ctx.attr.client[K8SClient].fqdn:
         opts += ",fqdn-set"
     out = _execute_protoc(
@@ -147,7 +148,48 @@ go_testing_client = rule(
     ],
 )
 
-def _execute_protoc(ctx, compiler, compiler_name, suffix, srcs, opts = "", env = None):
+def _go_openapi(ctx):
+    go = go_context(ctx)
+    out = _execute_protoc(
+        ctx,
+        ctx.executable._compiler,
+        ctx.attr._compiler_name,
+        "generated.openapi.go",
+        ctx.attr.srcs,
+    )
+    library = go.new_library(go, srcs = [out])
+    source = go.library_to_source(go, ctx.attr, library, False)
+
+    return [
+        library,
+        source,
+        DefaultInfo(
+            files = depset([out]),
+        ),
+    ]
+
+go_openapi = rule(
+    implementation = _go_openapi,
+    attrs = {
+        "srcs": attr.label_list(providers = [ProtoInfo]),
+        "importpath": attr.string(mandatory = True),
+        "_compiler": attr.label(
+            executable = True,
+            cfg = "host",
+            default = "//cmd/protoc-gen-openapi",
+        ),
+        "_compiler_name": attr.string(default = "openapi"),
+        "_go_context_data": attr.label(
+            default = "@rules_go//:go_context_data",
+        ),
+    },
+    toolchains = [
+        "@rules_go//go:toolchain",
+        _PROTO_TOOLCHAIN,
+    ],
+)
+
+def _execute_protoc(ctx, compiler, compiler_name, suffix, srcs, opts = ""):
     args = ctx.actions.args()
     args.add("--plugin", ("protoc-gen-%s=%s" % (compiler_name, compiler.path)))
 
@@ -163,7 +205,8 @@ def _execute_protoc(ctx, compiler, compiler_name, suffix, srcs, opts = "", env =
             proto_files.append(s)
 
     out = ctx.actions.declare_file("%s.%s" % (ctx.label.name, suffix))
-    args.add("--%s_out=%s:." % (compiler_name, out.path))
+    args.add("--%s_out=." % compiler_name)
+    args.add("--%s_opt=out=%s" % (compiler_name, out.path))
     if opts:
         args.add("--%s_opt=%s" % (compiler_name, opts))
 
@@ -176,8 +219,6 @@ def _execute_protoc(ctx, compiler, compiler_name, suffix, srcs, opts = "", env =
         ),
         outputs = [out],
         arguments = [args],
-        use_default_shell_env = True,
-        env = env,
     )
 
     return out
@@ -185,9 +226,9 @@ def _execute_protoc(ctx, compiler, compiler_name, suffix, srcs, opts = "", env =
 def _kubeproto_go_api(ctx):
     go = go_context(ctx)
 
-    env = None
+    opts = ""
     if ctx.attr.all:
-        env = {"KUBEPROTO_OPTS": "all"}
+        opts = "all"
 
     objectOut = _execute_protoc(
         ctx,
@@ -195,7 +236,7 @@ def _kubeproto_go_api(ctx):
         ctx.attr._object_compiler_name,
         "generated.object.go",
         ctx.attr.srcs,
-        env = env,
+        opts,
     )
     library = go.new_library(go, srcs = [objectOut])
     source = go.library_to_source(go, ctx.attr, library, False)
@@ -213,7 +254,7 @@ kubeproto_go_api = rule(
     attrs = {
         "srcs": attr.label_list(providers = [ProtoInfo]),
         "importpath": attr.string(mandatory = True),
-        "all": attr.bool(),
+        "all": attr.bool(default = False, doc = "Generate all messages instead of the messages which are referenced by Kinds"),
         "_object_compiler": attr.label(
             executable = True,
             cfg = "host",
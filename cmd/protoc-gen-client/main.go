@@ -2,12 +2,12 @@ package main
 
 import (
 	"bytes"
+	"errors"
 	"fmt"
 	"io"
 	"os"
 	"path"
 	"path/filepath"
-	"strings"
 
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/reflect/protodesc"
@@ -15,6 +15,7 @@ import (
 	"google.golang.org/protobuf/types/pluginpb"
 
 	"go.f110.dev/kubeproto/internal/k8s"
+	"go.f110.dev/kubeproto/internal/parameter"
 )
 
 func genClient() error {
@@ -32,23 +33,12 @@ func genClient() error {
 		return err
 	}
 
-	var outFile, importPath string
-	var FQNDNSetName bool
-	opt := input.GetParameter()
-	if strings.Contains(opt, ",") {
-		s := strings.Split(opt, ",")
-		outFile = s[0]
-		importPath = s[1]
-		if len(s) > 2 {
-			for _, v := range s[2:] {
-				switch v {
-				case "fqdn-set":
-					FQNDNSetName = true
-				}
-			}
-		}
-	} else {
-		outFile = opt
+	params, err := parameter.Parse(input.GetParameter(), parameter.Out, parameter.ImportPath, parameter.FQDNSet, parameter.Header)
+	if err != nil {
+		return err
+	}
+	if params.Out == "" || params.ImportPath == "" {
+		return errors.New("out and importpath are mandatory")
 	}
 
 	var res pluginpb.CodeGeneratorResponse
@@ -56,12 +46,13 @@ func genClient() error {
 	res.SupportedFeatures = &supportedFeatures
 	out := new(bytes.Buffer)
 	g := k8s.NewClientGenerator(input.FileToGenerate, files)
-	packageName := path.Base(filepath.Dir(outFile))
-	if err := g.Generate(out, packageName, importPath, FQNDNSetName); err != nil {
+	out.WriteString(params.HeaderComment("// "))
+	packageName := path.Base(filepath.Dir(params.Out))
+	if err := g.Generate(out, packageName, params.ImportPath, params.FQDNSet); err != nil {
 		return err
 	}
 	res.File = append(res.File, &pluginpb.CodeGeneratorResponse_File{
-		Name:    proto.String(outFile),
+		Name:    proto.String(params.Out),
 		Content: proto.String(out.String()),
 	})
 
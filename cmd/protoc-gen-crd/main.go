@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"strings"
 
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/reflect/protodesc"
@@ -12,6 +13,7 @@ import (
 	"google.golang.org/protobuf/types/pluginpb"
 
 	"go.f110.dev/kubeproto/internal/k8s"
+	"go.f110.dev/kubeproto/internal/parameter"
 )
 
 func genCRD() error {
@@ -29,11 +31,19 @@ func genCRD() error {
 		return err
 	}
 
-	outFile := input.GetParameter()
+	params, err := parameter.Parse(input.GetParameter(), parameter.Out, parameter.Header)
+	if err != nil {
+		return err
+	}
+	outFile := params.Out
+	if outFile == "" {
+		outFile = strings.TrimSuffix(input.FileToGenerate[0], ".proto") + ".crd.yaml"
+	}
 	var res pluginpb.CodeGeneratorResponse
 	supportedFeatures := uint64(pluginpb.CodeGeneratorResponse_FEATURE_PROTO3_OPTIONAL)
 	res.SupportedFeatures = &supportedFeatures
 	out := new(bytes.Buffer)
+	out.WriteString(params.HeaderComment("# "))
 	g, err := k8s.NewCRDGenerator(input.FileToGenerate, files)
 	if err != nil {
 		return err
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"strings"
 
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/reflect/protodesc"
@@ -12,6 +13,7 @@ import (
 	"google.golang.org/protobuf/types/pluginpb"
 
 	"go.f110.dev/kubeproto/internal/k8s"
+	"go.f110.dev/kubeproto/internal/parameter"
 )
 
 func genObject() error {
@@ -29,7 +31,14 @@ func genObject() error {
 		return err
 	}
 
-	outFile := input.GetParameter()
+	params, err := parameter.Parse(input.GetParameter(), parameter.Out, parameter.All, parameter.SkipDeepCopy, parameter.Header)
+	if err != nil {
+		return err
+	}
+	outFile := params.Out
+	if outFile == "" {
+		outFile = strings.TrimSuffix(input.FileToGenerate[0], ".proto") + ".generated.object.go"
+	}
 	var res pluginpb.CodeGeneratorResponse
 	supportedFeatures := uint64(pluginpb.CodeGeneratorResponse_FEATURE_PROTO3_OPTIONAL)
 	res.SupportedFeatures = &supportedFeatures
@@ -38,7 +47,8 @@ func genObject() error {
 		return err
 	}
 	out := new(bytes.Buffer)
-	if err := g.Generate(out); err != nil {
+	out.WriteString(params.HeaderComment("// "))
+	if err := g.Generate(out, params.All, params.SkipDeepCopy); err != nil {
 		return err
 	}
 	res.File = append(res.File, &pluginpb.CodeGeneratorResponse_File{
@@ -0,0 +1,75 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/pluginpb"
+
+	"go.f110.dev/kubeproto/internal/k8s"
+	"go.f110.dev/kubeproto/internal/parameter"
+)
+
+func genOpenAPI() error {
+	buf, err := io.ReadAll(os.Stdin)
+	if err != nil {
+		return err
+	}
+	var input pluginpb.CodeGeneratorRequest
+	err = proto.Unmarshal(buf, &input)
+	if err != nil {
+		return err
+	}
+	files, err := protodesc.NewFiles(&descriptorpb.FileDescriptorSet{File: input.ProtoFile})
+	if err != nil {
+		return err
+	}
+
+	params, err := parameter.Parse(input.GetParameter(), parameter.Out, parameter.Header)
+	if err != nil {
+		return err
+	}
+	outFile := params.Out
+	if outFile == "" {
+		outFile = strings.TrimSuffix(input.FileToGenerate[0], ".proto") + ".generated.openapi.go"
+	}
+	var res pluginpb.CodeGeneratorResponse
+	supportedFeatures := uint64(pluginpb.CodeGeneratorResponse_FEATURE_PROTO3_OPTIONAL)
+	res.SupportedFeatures = &supportedFeatures
+	out := new(bytes.Buffer)
+	out.WriteString(params.HeaderComment("// "))
+	g, err := k8s.NewOpenAPIGenerator(input.FileToGenerate, files)
+	if err != nil {
+		return err
+	}
+	if err := g.Generate(out); err != nil {
+		return err
+	}
+	res.File = append(res.File, &pluginpb.CodeGeneratorResponse_File{
+		Name:    proto.String(outFile),
+		Content: proto.String(out.String()),
+	})
+
+	output, err := proto.Marshal(&res)
+	if err != nil {
+		return err
+	}
+	if _, err := os.Stdout.Write(output); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func main() {
+	if err := genOpenAPI(); err != nil {
+		fmt.Fprintf(os.Stderr, "%+v\n", err)
+		os.Exit(1)
+	}
+}
@@ -8,7 +8,6 @@ import (
 	"os"
 	"path"
 	"path/filepath"
-	"strings"
 
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/reflect/protodesc"
@@ -16,6 +15,7 @@ import (
 	"google.golang.org/protobuf/types/pluginpb"
 
 	"go.f110.dev/kubeproto/internal/k8s"
+	"go.f110.dev/kubeproto/internal/parameter"
 )
 
 func genFakeClient() error {
@@ -33,24 +33,12 @@ func genFakeClient() error {
 		return err
 	}
 
-	var outFile, importPath, clientPath string
-	var FQDNSetName bool
-	opt := input.GetParameter()
-	if strings.Contains(opt, ",") {
-		s := strings.Split(opt, ",")
-		outFile = s[0]
-		importPath = s[1]
-		clientPath = s[2]
-		if len(s) > 3 {
-			for _, v := range s[2:] {
-				switch v {
-				case "fqdn-set":
-					FQDNSetName = true
-				}
-			}
-		}
-	} else {
-		return errors.New("import path and client path is mandatory")
+	params, err := parameter.Parse(input.GetParameter(), parameter.Out, parameter.ImportPath, parameter.ClientImportPath, parameter.FQDNSet, parameter.Header)
+	if err != nil {
+		return err
+	}
+	if params.Out == "" || params.ImportPath == "" || params.ClientImportPath == "" {
+		return errors.New("out, importpath and client-importpath are mandatory")
 	}
 
 	var res pluginpb.CodeGeneratorResponse
@@ -58,12 +46,13 @@ func genFakeClient() error {
 	res.SupportedFeatures = &supportedFeatures
 	out := new(bytes.Buffer)
 	g := k8s.NewFakeClientGenerator(input.FileToGenerate, files)
-	packageName := path.Base(filepath.Dir(outFile))
-	if err := g.Generate(out, packageName, importPath, clientPath, FQDNSetName); err != nil {
+	out.WriteString(params.HeaderComment("// "))
+	packageName := path.Base(filepath.Dir(params.Out))
+	if err := g.Generate(out, packageName, params.ImportPath, params.ClientImportPath, params.FQDNSet); err != nil {
 		return err
 	}
 	res.File = append(res.File, &pluginpb.CodeGeneratorResponse_File{
-		Name:    proto.String(outFile),
+		Name:    proto.String(params.Out),
 		Content: proto.String(out.String()),
 	})
 
@@ -1,7 +1,11 @@
 package admissionregistrationv1
 
 import (
+	"github.com/mailru/easyjson/jlexer"
+	"github.com/mailru/easyjson/jwriter"
 	"go.f110.dev/kubeproto/go/apis/metav1"
+	"go.f110.dev/kubeproto/go/patch"
+	"k8s.io/apimachinery/pkg/fields"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 )
@@ -138,6 +142,323 @@ func (in *MutatingAdmissionPolicy) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// MutatingAdmissionPolicyToSelectableFields returns the fields of MutatingAdmissionPolicy which can be used by the field selector.
+func MutatingAdmissionPolicyToSelectableFields(obj *MutatingAdmissionPolicy) fields.Set {
+	set := fields.Set{
+		"metadata.name":      obj.ObjectMeta.Name,
+		"metadata.namespace": obj.ObjectMeta.Namespace,
+	}
+	return set
+}
+
+// MutatingAdmissionPolicyPatch builds JSON patch or JSON merge patch of MutatingAdmissionPolicy.
+// JSON patch fails if the parent of the field doesn't exist (e.g. SetLabel to the object which has no label). MergePatch creates the parent.
+type MutatingAdmissionPolicyPatch struct {
+	*patch.Builder
+}
+
+func NewMutatingAdmissionPolicyPatch() *MutatingAdmissionPolicyPatch {
+	return &MutatingAdmissionPolicyPatch{Builder: patch.NewBuilder()}
+}
+
+func (p *MutatingAdmissionPolicyPatch) SetLabel(key, value string) *MutatingAdmissionPolicyPatch {
+	p.Set([]string{"metadata", "labels", key}, value)
+	return p
+}
+
+func (p *MutatingAdmissionPolicyPatch) RemoveLabel(key string) *MutatingAdmissionPolicyPatch {
+	p.Remove([]string{"metadata", "labels", key})
+	return p
+}
+
+func (p *MutatingAdmissionPolicyPatch) SetAnnotation(key, value string) *MutatingAdmissionPolicyPatch {
+	p.Set([]string{"metadata", "annotations", key}, value)
+	return p
+}
+
+func (p *MutatingAdmissionPolicyPatch) RemoveAnnotation(key string) *MutatingAdmissionPolicyPatch {
+	p.Remove([]string{"metadata", "annotations", key})
+	return p
+}
+
+func (p *MutatingAdmissionPolicyPatch) SetSpec(v *MutatingAdmissionPolicySpec) *MutatingAdmissionPolicyPatch {
+	p.Set([]string{"spec"}, v)
+	return p
+}
+
+func (p *MutatingAdmissionPolicyPatch) RemoveSpec() *MutatingAdmissionPolicyPatch {
+	p.Remove([]string{"spec"})
+	return p
+}
+
+func (p *MutatingAdmissionPolicyPatch) SetSpecParamKind(v *ParamKind) *MutatingAdmissionPolicyPatch {
+	p.Set([]string{"spec", "paramKind"}, v)
+	return p
+}
+
+func (p *MutatingAdmissionPolicyPatch) RemoveSpecParamKind() *MutatingAdmissionPolicyPatch {
+	p.Remove([]string{"spec", "paramKind"})
+	return p
+}
+
+func (p *MutatingAdmissionPolicyPatch) SetSpecParamKindAPIVersion(v string) *MutatingAdmissionPolicyPatch {
+	p.Set([]string{"spec", "paramKind", "apiVersion"}, v)
+	return p
+}
+
+func (p *MutatingAdmissionPolicyPatch) RemoveSpecParamKindAPIVersion() *MutatingAdmissionPolicyPatch {
+	p.Remove([]string{"spec", "paramKind", "apiVersion"})
+	return p
+}
+
+func (p *MutatingAdmissionPolicyPatch) SetSpecParamKindKind(v string) *MutatingAdmissionPolicyPatch {
+	p.Set([]string{"spec", "paramKind", "kind"}, v)
+	return p
+}
+
+func (p *MutatingAdmissionPolicyPatch) RemoveSpecParamKindKind() *MutatingAdmissionPolicyPatch {
+	p.Remove([]string{"spec", "paramKind", "kind"})
+	return p
+}
+
+func (p *MutatingAdmissionPolicyPatch) SetSpecMatchConstraints(v *MatchResources) *MutatingAdmissionPolicyPatch {
+	p.Set([]string{"spec", "matchConstraints"}, v)
+	return p
+}
+
+func (p *MutatingAdmissionPolicyPatch) RemoveSpecMatchConstraints() *MutatingAdmissionPolicyPatch {
+	p.Remove([]string{"spec", "matchConstraints"})
+	return p
+}
+
+func (p *MutatingAdmissionPolicyPatch) SetSpecMatchConstraintsNamespaceSelector(v *metav1.LabelSelector) *MutatingAdmissionPolicyPatch {
+	p.Set([]string{"spec", "matchConstraints", "namespaceSelector"}, v)
+	return p
+}
+
+func (p *MutatingAdmissionPolicyPatch) RemoveSpecMatchConstraintsNamespaceSelector() *MutatingAdmissionPolicyPatch {
+	p.Remove([]string{"spec", "matchConstraints", "namespaceSelector"})
+	return p
+}
+
+func (p *MutatingAdmissionPolicyPatch) SetSpecMatchConstraintsObjectSelector(v *metav1.LabelSelector) *MutatingAdmissionPolicyPatch {
+	p.Set([]string{"spec", "matchConstraints", "objectSelector"}, v)
+	return p
+}
+
+func (p *MutatingAdmissionPolicyPatch) RemoveSpecMatchConstraintsObjectSelector() *MutatingAdmissionPolicyPatch {
+	p.Remove([]string{"spec", "matchConstraints", "objectSelector"})
+	return p
+}
+
+func (p *MutatingAdmissionPolicyPatch) SetSpecMatchConstraintsResourceRules(v []NamedRuleWithOperations) *MutatingAdmissionPolicyPatch {
+	p.Set([]string{"spec", "matchConstraints", "resourceRules"}, v)
+	return p
+}
+
+func (p *MutatingAdmissionPolicyPatch) RemoveSpecMatchConstraintsResourceRules() *MutatingAdmissionPolicyPatch {
+	p.Remove([]string{"spec", "matchConstraints", "resourceRules"})
+	return p
+}
+
+func (p *MutatingAdmissionPolicyPatch) AddSpecMatchConstraintsResourceRules(v ...NamedRuleWithOperations) *MutatingAdmissionPolicyPatch {
+	for _, e := range v {
+		p.Append([]string{"spec", "matchConstraints", "resourceRules"}, e)
+	}
+	return p
+}
+
+func (p *MutatingAdmissionPolicyPatch) SetSpecMatchConstraintsExcludeResourceRules(v []NamedRuleWithOperations) *MutatingAdmissionPolicyPatch {
+	p.Set([]string{"spec", "matchConstraints", "excludeResourceRules"}, v)
+	return p
+}
+
+func (p *MutatingAdmissionPolicyPatch) RemoveSpecMatchConstraintsExcludeResourceRules() *MutatingAdmissionPolicyPatch {
+	p.Remove([]string{"spec", "matchConstraints", "excludeResourceRules"})
+	return p
+}
+
+func (p *MutatingAdmissionPolicyPatch) AddSpecMatchConstraintsExcludeResourceRules(v ...NamedRuleWithOperations) *MutatingAdmissionPolicyPatch {
+	for _, e := range v {
+		p.Append([]string{"spec", "matchConstraints", "excludeResourceRules"}, e)
+	}
+	return p
+}
+
+func (p *MutatingAdmissionPolicyPatch) SetSpecMatchConstraintsMatchPolicy(v MatchPolicyType) *MutatingAdmissionPolicyPatch {
+	p.Set([]string{"spec", "matchConstraints", "matchPolicy"}, v)
+	return p
+}
+
+func (p *MutatingAdmissionPolicyPatch) RemoveSpecMatchConstraintsMatchPolicy() *MutatingAdmissionPolicyPatch {
+	p.Remove([]string{"spec", "matchConstraints", "matchPolicy"})
+	return p
+}
+
+func (p *MutatingAdmissionPolicyPatch) SetSpecVariables(v []Variable) *MutatingAdmissionPolicyPatch {
+	p.Set([]string{"spec", "variables"}, v)
+	return p
+}
+
+func (p *MutatingAdmissionPolicyPatch) RemoveSpecVariables() *MutatingAdmissionPolicyPatch {
+	p.Remove([]string{"spec", "variables"})
+	return p
+}
+
+func (p *MutatingAdmissionPolicyPatch) AddSpecVariables(v ...Variable) *MutatingAdmissionPolicyPatch {
+	for _, e := range v {
+		p.Append([]string{"spec", "variables"}, e)
+	}
+	return p
+}
+
+func (p *MutatingAdmissionPolicyPatch) SetSpecMutations(v []Mutation) *MutatingAdmissionPolicyPatch {
+	p.Set([]string{"spec", "mutations"}, v)
+	return p
+}
+
+func (p *MutatingAdmissionPolicyPatch) RemoveSpecMutations() *MutatingAdmissionPolicyPatch {
+	p.Remove([]string{"spec", "mutations"})
+	return p
+}
+
+func (p *MutatingAdmissionPolicyPatch) AddSpecMutations(v ...Mutation) *MutatingAdmissionPolicyPatch {
+	for _, e := range v {
+		p.Append([]string{"spec", "mutations"}, e)
+	}
+	return p
+}
+
+func (p *MutatingAdmissionPolicyPatch) SetSpecFailurePolicy(v FailurePolicyType) *MutatingAdmissionPolicyPatch {
+	p.Set([]string{"spec", "failurePolicy"}, v)
+	return p
+}
+
+func (p *MutatingAdmissionPolicyPatch) RemoveSpecFailurePolicy() *MutatingAdmissionPolicyPatch {
+	p.Remove([]string{"spec", "failurePolicy"})
+	return p
+}
+
+func (p *MutatingAdmissionPolicyPatch) SetSpecMatchConditions(v []MatchCondition) *MutatingAdmissionPolicyPatch {
+	p.Set([]string{"spec", "matchConditions"}, v)
+	return p
+}
+
+func (p *MutatingAdmissionPolicyPatch) RemoveSpecMatchConditions() *MutatingAdmissionPolicyPatch {
+	p.Remove([]string{"spec", "matchConditions"})
+	return p
+}
+
+func (p *MutatingAdmissionPolicyPatch) AddSpecMatchConditions(v ...MatchCondition) *MutatingAdmissionPolicyPatch {
+	for _, e := range v {
+		p.Append([]string{"spec", "matchConditions"}, e)
+	}
+	return p
+}
+
+func (p *MutatingAdmissionPolicyPatch) SetSpecReinvocationPolicy(v ReinvocationPolicyType) *MutatingAdmissionPolicyPatch {
+	p.Set([]string{"spec", "reinvocationPolicy"}, v)
+	return p
+}
+
+func (p *MutatingAdmissionPolicyPatch) RemoveSpecReinvocationPolicy() *MutatingAdmissionPolicyPatch {
+	p.Remove([]string{"spec", "reinvocationPolicy"})
+	return p
+}
+
+func (in *MutatingAdmissionPolicy) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *MutatingAdmissionPolicy) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *MutatingAdmissionPolicy) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	first := true
+	if in.TypeMeta.Kind != "" {
+		w.RawString("\"kind\":")
+		w.String(in.TypeMeta.Kind)
+		first = false
+	}
+	if in.TypeMeta.APIVersion != "" {
+		if !first {
+			w.RawByte(',')
+		}
+		w.RawString("\"apiVersion\":")
+		w.String(in.TypeMeta.APIVersion)
+		first = false
+	}
+	if !first {
+		w.RawByte(',')
+	}
+	w.RawString("\"metadata\":")
+	in.ObjectMeta.MarshalEasyJSON(w)
+	if in.Spec != nil {
+		w.RawString(",\"spec\":")
+		in.Spec.MarshalEasyJSON(w)
+	}
+	w.RawByte('}')
+}
+
+func (in *MutatingAdmissionPolicy) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "kind":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.TypeMeta.Kind = l.String()
+			}
+		case "apiVersion":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.TypeMeta.APIVersion = l.String()
+			}
+		case "metadata":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.ObjectMeta.UnmarshalEasyJSON(l)
+			}
+		case "spec":
+			if l.IsNull() {
+				l.Skip()
+				in.Spec = nil
+			} else {
+				if in.Spec == nil {
+					in.Spec = new(MutatingAdmissionPolicySpec)
+				}
+				in.Spec.UnmarshalEasyJSON(l)
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
+
 type MutatingAdmissionPolicyBinding struct {
 	metav1.TypeMeta   `json:",inline"`
 	metav1.ObjectMeta `json:"metadata"`
@@ -172,6 +493,282 @@ func (in *MutatingAdmissionPolicyBinding) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// MutatingAdmissionPolicyBindingToSelectableFields returns the fields of MutatingAdmissionPolicyBinding which can be used by the field selector.
+func MutatingAdmissionPolicyBindingToSelectableFields(obj *MutatingAdmissionPolicyBinding) fields.Set {
+	set := fields.Set{
+		"metadata.name":      obj.ObjectMeta.Name,
+		"metadata.namespace": obj.ObjectMeta.Namespace,
+	}
+	return set
+}
+
+// MutatingAdmissionPolicyBindingPatch builds JSON patch or JSON merge patch of MutatingAdmissionPolicyBinding.
+// JSON patch fails if the parent of the field doesn't exist (e.g. SetLabel to the object which has no label). MergePatch creates the parent.
+type MutatingAdmissionPolicyBindingPatch struct {
+	*patch.Builder
+}
+
+func NewMutatingAdmissionPolicyBindingPatch() *MutatingAdmissionPolicyBindingPatch {
+	return &MutatingAdmissionPolicyBindingPatch{Builder: patch.NewBuilder()}
+}
+
+func (p *MutatingAdmissionPolicyBindingPatch) SetLabel(key, value string) *MutatingAdmissionPolicyBindingPatch {
+	p.Set([]string{"metadata", "labels", key}, value)
+	return p
+}
+
+func (p *MutatingAdmissionPolicyBindingPatch) RemoveLabel(key string) *MutatingAdmissionPolicyBindingPatch {
+	p.Remove([]string{"metadata", "labels", key})
+	return p
+}
+
+func (p *MutatingAdmissionPolicyBindingPatch) SetAnnotation(key, value string) *MutatingAdmissionPolicyBindingPatch {
+	p.Set([]string{"metadata", "annotations", key}, value)
+	return p
+}
+
+func (p *MutatingAdmissionPolicyBindingPatch) RemoveAnnotation(key string) *MutatingAdmissionPolicyBindingPatch {
+	p.Remove([]string{"metadata", "annotations", key})
+	return p
+}
+
+func (p *MutatingAdmissionPolicyBindingPatch) SetSpec(v *MutatingAdmissionPolicyBindingSpec) *MutatingAdmissionPolicyBindingPatch {
+	p.Set([]string{"spec"}, v)
+	return p
+}
+
+func (p *MutatingAdmissionPolicyBindingPatch) RemoveSpec() *MutatingAdmissionPolicyBindingPatch {
+	p.Remove([]string{"spec"})
+	return p
+}
+
+func (p *MutatingAdmissionPolicyBindingPatch) SetSpecPolicyName(v string) *MutatingAdmissionPolicyBindingPatch {
+	p.Set([]string{"spec", "policyName"}, v)
+	return p
+}
+
+func (p *MutatingAdmissionPolicyBindingPatch) RemoveSpecPolicyName() *MutatingAdmissionPolicyBindingPatch {
+	p.Remove([]string{"spec", "policyName"})
+	return p
+}
+
+func (p *MutatingAdmissionPolicyBindingPatch) SetSpecParamRef(v *ParamRef) *MutatingAdmissionPolicyBindingPatch {
+	p.Set([]string{"spec", "paramRef"}, v)
+	return p
+}
+
+func (p *MutatingAdmissionPolicyBindingPatch) RemoveSpecParamRef() *MutatingAdmissionPolicyBindingPatch {
+	p.Remove([]string{"spec", "paramRef"})
+	return p
+}
+
+func (p *MutatingAdmissionPolicyBindingPatch) SetSpecParamRefName(v string) *MutatingAdmissionPolicyBindingPatch {
+	p.Set([]string{"spec", "paramRef", "name"}, v)
+	return p
+}
+
+func (p *MutatingAdmissionPolicyBindingPatch) RemoveSpecParamRefName() *MutatingAdmissionPolicyBindingPatch {
+	p.Remove([]string{"spec", "paramRef", "name"})
+	return p
+}
+
+func (p *MutatingAdmissionPolicyBindingPatch) SetSpecParamRefNamespace(v string) *MutatingAdmissionPolicyBindingPatch {
+	p.Set([]string{"spec", "paramRef", "namespace"}, v)
+	return p
+}
+
+func (p *MutatingAdmissionPolicyBindingPatch) RemoveSpecParamRefNamespace() *MutatingAdmissionPolicyBindingPatch {
+	p.Remove([]string{"spec", "paramRef", "namespace"})
+	return p
+}
+
+func (p *MutatingAdmissionPolicyBindingPatch) SetSpecParamRefSelector(v *metav1.LabelSelector) *MutatingAdmissionPolicyBindingPatch {
+	p.Set([]string{"spec", "paramRef", "selector"}, v)
+	return p
+}
+
+func (p *MutatingAdmissionPolicyBindingPatch) RemoveSpecParamRefSelector() *MutatingAdmissionPolicyBindingPatch {
+	p.Remove([]string{"spec", "paramRef", "selector"})
+	return p
+}
+
+func (p *MutatingAdmissionPolicyBindingPatch) SetSpecParamRefParameterNotFoundAction(v ParameterNotFoundActionType) *MutatingAdmissionPolicyBindingPatch {
+	p.Set([]string{"spec", "paramRef", "parameterNotFoundAction"}, v)
+	return p
+}
+
+func (p *MutatingAdmissionPolicyBindingPatch) RemoveSpecParamRefParameterNotFoundAction() *MutatingAdmissionPolicyBindingPatch {
+	p.Remove([]string{"spec", "paramRef", "parameterNotFoundAction"})
+	return p
+}
+
+func (p *MutatingAdmissionPolicyBindingPatch) SetSpecMatchResources(v *MatchResources) *MutatingAdmissionPolicyBindingPatch {
+	p.Set([]string{"spec", "matchResources"}, v)
+	return p
+}
+
+func (p *MutatingAdmissionPolicyBindingPatch) RemoveSpecMatchResources() *MutatingAdmissionPolicyBindingPatch {
+	p.Remove([]string{"spec", "matchResources"})
+	return p
+}
+
+func (p *MutatingAdmissionPolicyBindingPatch) SetSpecMatchResourcesNamespaceSelector(v *metav1.LabelSelector) *MutatingAdmissionPolicyBindingPatch {
+	p.Set([]string{"spec", "matchResources", "namespaceSelector"}, v)
+	return p
+}
+
+func (p *MutatingAdmissionPolicyBindingPatch) RemoveSpecMatchResourcesNamespaceSelector() *MutatingAdmissionPolicyBindingPatch {
+	p.Remove([]string{"spec", "matchResources", "namespaceSelector"})
+	return p
+}
+
+func (p *MutatingAdmissionPolicyBindingPatch) SetSpecMatchResourcesObjectSelector(v *metav1.LabelSelector) *MutatingAdmissionPolicyBindingPatch {
+	p.Set([]string{"spec", "matchResources", "objectSelector"}, v)
+	return p
+}
+
+func (p *MutatingAdmissionPolicyBindingPatch) RemoveSpecMatchResourcesObjectSelector() *MutatingAdmissionPolicyBindingPatch {
+	p.Remove([]string{"spec", "matchResources", "objectSelector"})
+	return p
+}
+
+func (p *MutatingAdmissionPolicyBindingPatch) SetSpecMatchResourcesResourceRules(v []NamedRuleWithOperations) *MutatingAdmissionPolicyBindingPatch {
+	p.Set([]string{"spec", "matchResources", "resourceRules"}, v)
+	return p
+}
+
+func (p *MutatingAdmissionPolicyBindingPatch) RemoveSpecMatchResourcesResourceRules() *MutatingAdmissionPolicyBindingPatch {
+	p.Remove([]string{"spec", "matchResources", "resourceRules"})
+	return p
+}
+
+func (p *MutatingAdmissionPolicyBindingPatch) AddSpecMatchResourcesResourceRules(v ...NamedRuleWithOperations) *MutatingAdmissionPolicyBindingPatch {
+	for _, e := range v {
+		p.Append([]string{"spec", "matchResources", "resourceRules"}, e)
+	}
+	return p
+}
+
+func (p *MutatingAdmissionPolicyBindingPatch) SetSpecMatchResourcesExcludeResourceRules(v []NamedRuleWithOperations) *MutatingAdmissionPolicyBindingPatch {
+	p.Set([]string{"spec", "matchResources", "excludeResourceRules"}, v)
+	return p
+}
+
+func (p *MutatingAdmissionPolicyBindingPatch) RemoveSpecMatchResourcesExcludeResourceRules() *MutatingAdmissionPolicyBindingPatch {
+	p.Remove([]string{"spec", "matchResources", "excludeResourceRules"})
+	return p
+}
+
+func (p *MutatingAdmissionPolicyBindingPatch) AddSpecMatchResourcesExcludeResourceRules(v ...NamedRuleWithOperations) *MutatingAdmissionPolicyBindingPatch {
+	for _, e := range v {
+		p.Append([]string{"spec", "matchResources", "excludeResourceRules"}, e)
+	}
+	return p
+}
+
+func (p *MutatingAdmissionPolicyBindingPatch) SetSpecMatchResourcesMatchPolicy(v MatchPolicyType) *MutatingAdmissionPolicyBindingPatch {
+	p.Set([]string{"spec", "matchResources", "matchPolicy"}, v)
+	return p
+}
+
+func (p *MutatingAdmissionPolicyBindingPatch) RemoveSpecMatchResourcesMatchPolicy() *MutatingAdmissionPolicyBindingPatch {
+	p.Remove([]string{"spec", "matchResources", "matchPolicy"})
+	return p
+}
+
+func (in *MutatingAdmissionPolicyBinding) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *MutatingAdmissionPolicyBinding) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *MutatingAdmissionPolicyBinding) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	first := true
+	if in.TypeMeta.Kind != "" {
+		w.RawString("\"kind\":")
+		w.String(in.TypeMeta.Kind)
+		first = false
+	}
+	if in.TypeMeta.APIVersion != "" {
+		if !first {
+			w.RawByte(',')
+		}
+		w.RawString("\"apiVersion\":")
+		w.String(in.TypeMeta.APIVersion)
+		first = false
+	}
+	if !first {
+		w.RawByte(',')
+	}
+	w.RawString("\"metadata\":")
+	in.ObjectMeta.MarshalEasyJSON(w)
+	if in.Spec != nil {
+		w.RawString(",\"spec\":")
+		in.Spec.MarshalEasyJSON(w)
+	}
+	w.RawByte('}')
+}
+
+func (in *MutatingAdmissionPolicyBinding) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "kind":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.TypeMeta.Kind = l.String()
+			}
+		case "apiVersion":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.TypeMeta.APIVersion = l.String()
+			}
+		case "metadata":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.ObjectMeta.UnmarshalEasyJSON(l)
+			}
+		case "spec":
+			if l.IsNull() {
+				l.Skip()
+				in.Spec = nil
+			} else {
+				if in.Spec == nil {
+					in.Spec = new(MutatingAdmissionPolicyBindingSpec)
+				}
+				in.Spec.UnmarshalEasyJSON(l)
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
+
 type MutatingAdmissionPolicyBindingList struct {
 	metav1.TypeMeta `json:",inline"`
 	metav1.ListMeta `json:"metadata"`
@@ -207,6 +804,117 @@ func (in *MutatingAdmissionPolicyBindingList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+func (in *MutatingAdmissionPolicyBindingList) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *MutatingAdmissionPolicyBindingList) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *MutatingAdmissionPolicyBindingList) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	first := true
+	if in.TypeMeta.Kind != "" {
+		w.RawString("\"kind\":")
+		w.String(in.TypeMeta.Kind)
+		first = false
+	}
+	if in.TypeMeta.APIVersion != "" {
+		if !first {
+			w.RawByte(',')
+		}
+		w.RawString("\"apiVersion\":")
+		w.String(in.TypeMeta.APIVersion)
+		first = false
+	}
+	if !first {
+		w.RawByte(',')
+	}
+	w.RawString("\"metadata\":")
+	in.ListMeta.MarshalEasyJSON(w)
+	w.RawString(",\"items\":")
+	if in.Items == nil {
+		w.RawString("null")
+	} else {
+		w.RawByte('[')
+		for i0 := range in.Items {
+			if i0 > 0 {
+				w.RawByte(',')
+			}
+			in.Items[i0].MarshalEasyJSON(w)
+		}
+		w.RawByte(']')
+	}
+	w.RawByte('}')
+}
+
+func (in *MutatingAdmissionPolicyBindingList) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "kind":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.TypeMeta.Kind = l.String()
+			}
+		case "apiVersion":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.TypeMeta.APIVersion = l.String()
+			}
+		case "metadata":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.ListMeta.UnmarshalEasyJSON(l)
+			}
+		case "items":
+			if l.IsNull() {
+				l.Skip()
+				in.Items = nil
+			} else {
+				in.Items = make([]MutatingAdmissionPolicyBinding, 0)
+				l.Delim('[')
+				for !l.IsDelim(']') {
+					var v0 MutatingAdmissionPolicyBinding
+					if l.IsNull() {
+						l.Skip()
+					} else {
+						v0.UnmarshalEasyJSON(l)
+					}
+					in.Items = append(in.Items, v0)
+					l.WantComma()
+				}
+				l.Delim(']')
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
+
 type MutatingAdmissionPolicyList struct {
 	metav1.TypeMeta `json:",inline"`
 	metav1.ListMeta `json:"metadata"`
@@ -242,6 +950,117 @@ func (in *MutatingAdmissionPolicyList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+func (in *MutatingAdmissionPolicyList) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *MutatingAdmissionPolicyList) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *MutatingAdmissionPolicyList) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	first := true
+	if in.TypeMeta.Kind != "" {
+		w.RawString("\"kind\":")
+		w.String(in.TypeMeta.Kind)
+		first = false
+	}
+	if in.TypeMeta.APIVersion != "" {
+		if !first {
+			w.RawByte(',')
+		}
+		w.RawString("\"apiVersion\":")
+		w.String(in.TypeMeta.APIVersion)
+		first = false
+	}
+	if !first {
+		w.RawByte(',')
+	}
+	w.RawString("\"metadata\":")
+	in.ListMeta.MarshalEasyJSON(w)
+	w.RawString(",\"items\":")
+	if in.Items == nil {
+		w.RawString("null")
+	} else {
+		w.RawByte('[')
+		for i0 := range in.Items {
+			if i0 > 0 {
+				w.RawByte(',')
+			}
+			in.Items[i0].MarshalEasyJSON(w)
+		}
+		w.RawByte(']')
+	}
+	w.RawByte('}')
+}
+
+func (in *MutatingAdmissionPolicyList) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "kind":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.TypeMeta.Kind = l.String()
+			}
+		case "apiVersion":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.TypeMeta.APIVersion = l.String()
+			}
+		case "metadata":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.ListMeta.UnmarshalEasyJSON(l)
+			}
+		case "items":
+			if l.IsNull() {
+				l.Skip()
+				in.Items = nil
+			} else {
+				in.Items = make([]MutatingAdmissionPolicy, 0)
+				l.Delim('[')
+				for !l.IsDelim(']') {
+					var v0 MutatingAdmissionPolicy
+					if l.IsNull() {
+						l.Skip()
+					} else {
+						v0.UnmarshalEasyJSON(l)
+					}
+					in.Items = append(in.Items, v0)
+					l.WantComma()
+				}
+				l.Delim(']')
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
+
 type MutatingWebhookConfiguration struct {
 	metav1.TypeMeta   `json:",inline"`
 	metav1.ObjectMeta `json:"metadata"`
@@ -262,99 +1081,807 @@ func (in *MutatingWebhookConfiguration) DeepCopyInto(out *MutatingWebhookConfigu
 	}
 }
 
-func (in *MutatingWebhookConfiguration) DeepCopy() *MutatingWebhookConfiguration {
-	if in == nil {
-		return nil
-	}
-	out := new(MutatingWebhookConfiguration)
-	in.DeepCopyInto(out)
-	return out
+func (in *MutatingWebhookConfiguration) DeepCopy() *MutatingWebhookConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(MutatingWebhookConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *MutatingWebhookConfiguration) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// MutatingWebhookConfigurationToSelectableFields returns the fields of MutatingWebhookConfiguration which can be used by the field selector.
+func MutatingWebhookConfigurationToSelectableFields(obj *MutatingWebhookConfiguration) fields.Set {
+	set := fields.Set{
+		"metadata.name":      obj.ObjectMeta.Name,
+		"metadata.namespace": obj.ObjectMeta.Namespace,
+	}
+	return set
+}
+
+// MutatingWebhookConfigurationPatch builds JSON patch or JSON merge patch of MutatingWebhookConfiguration.
+// JSON patch fails if the parent of the field doesn't exist (e.g. SetLabel to the object which has no label). MergePatch creates the parent.
+type MutatingWebhookConfigurationPatch struct {
+	*patch.Builder
+}
+
+func NewMutatingWebhookConfigurationPatch() *MutatingWebhookConfigurationPatch {
+	return &MutatingWebhookConfigurationPatch{Builder: patch.NewBuilder()}
+}
+
+func (p *MutatingWebhookConfigurationPatch) SetLabel(key, value string) *MutatingWebhookConfigurationPatch {
+	p.Set([]string{"metadata", "labels", key}, value)
+	return p
+}
+
+func (p *MutatingWebhookConfigurationPatch) RemoveLabel(key string) *MutatingWebhookConfigurationPatch {
+	p.Remove([]string{"metadata", "labels", key})
+	return p
+}
+
+func (p *MutatingWebhookConfigurationPatch) SetAnnotation(key, value string) *MutatingWebhookConfigurationPatch {
+	p.Set([]string{"metadata", "annotations", key}, value)
+	return p
+}
+
+func (p *MutatingWebhookConfigurationPatch) RemoveAnnotation(key string) *MutatingWebhookConfigurationPatch {
+	p.Remove([]string{"metadata", "annotations", key})
+	return p
+}
+
+func (p *MutatingWebhookConfigurationPatch) SetWebhooks(v []MutatingWebhook) *MutatingWebhookConfigurationPatch {
+	p.Set([]string{"webhooks"}, v)
+	return p
+}
+
+func (p *MutatingWebhookConfigurationPatch) RemoveWebhooks() *MutatingWebhookConfigurationPatch {
+	p.Remove([]string{"webhooks"})
+	return p
+}
+
+func (p *MutatingWebhookConfigurationPatch) AddWebhooks(v ...MutatingWebhook) *MutatingWebhookConfigurationPatch {
+	for _, e := range v {
+		p.Append([]string{"webhooks"}, e)
+	}
+	return p
+}
+
+func (in *MutatingWebhookConfiguration) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *MutatingWebhookConfiguration) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *MutatingWebhookConfiguration) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	first := true
+	if in.TypeMeta.Kind != "" {
+		w.RawString("\"kind\":")
+		w.String(in.TypeMeta.Kind)
+		first = false
+	}
+	if in.TypeMeta.APIVersion != "" {
+		if !first {
+			w.RawByte(',')
+		}
+		w.RawString("\"apiVersion\":")
+		w.String(in.TypeMeta.APIVersion)
+		first = false
+	}
+	if !first {
+		w.RawByte(',')
+	}
+	w.RawString("\"metadata\":")
+	in.ObjectMeta.MarshalEasyJSON(w)
+	w.RawString(",\"webhooks\":")
+	if in.Webhooks == nil {
+		w.RawString("null")
+	} else {
+		w.RawByte('[')
+		for i0 := range in.Webhooks {
+			if i0 > 0 {
+				w.RawByte(',')
+			}
+			in.Webhooks[i0].MarshalEasyJSON(w)
+		}
+		w.RawByte(']')
+	}
+	w.RawByte('}')
+}
+
+func (in *MutatingWebhookConfiguration) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "kind":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.TypeMeta.Kind = l.String()
+			}
+		case "apiVersion":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.TypeMeta.APIVersion = l.String()
+			}
+		case "metadata":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.ObjectMeta.UnmarshalEasyJSON(l)
+			}
+		case "webhooks":
+			if l.IsNull() {
+				l.Skip()
+				in.Webhooks = nil
+			} else {
+				in.Webhooks = make([]MutatingWebhook, 0)
+				l.Delim('[')
+				for !l.IsDelim(']') {
+					var v0 MutatingWebhook
+					if l.IsNull() {
+						l.Skip()
+					} else {
+						v0.UnmarshalEasyJSON(l)
+					}
+					in.Webhooks = append(in.Webhooks, v0)
+					l.WantComma()
+				}
+				l.Delim(']')
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
+
+type MutatingWebhookConfigurationList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata"`
+	Items           []MutatingWebhookConfiguration `json:"items"`
+}
+
+func (in *MutatingWebhookConfigurationList) DeepCopyInto(out *MutatingWebhookConfigurationList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		l := make([]MutatingWebhookConfiguration, len(in.Items))
+		for i := range in.Items {
+			in.Items[i].DeepCopyInto(&l[i])
+		}
+		out.Items = l
+	}
+}
+
+func (in *MutatingWebhookConfigurationList) DeepCopy() *MutatingWebhookConfigurationList {
+	if in == nil {
+		return nil
+	}
+	out := new(MutatingWebhookConfigurationList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *MutatingWebhookConfigurationList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+func (in *MutatingWebhookConfigurationList) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *MutatingWebhookConfigurationList) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *MutatingWebhookConfigurationList) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	first := true
+	if in.TypeMeta.Kind != "" {
+		w.RawString("\"kind\":")
+		w.String(in.TypeMeta.Kind)
+		first = false
+	}
+	if in.TypeMeta.APIVersion != "" {
+		if !first {
+			w.RawByte(',')
+		}
+		w.RawString("\"apiVersion\":")
+		w.String(in.TypeMeta.APIVersion)
+		first = false
+	}
+	if !first {
+		w.RawByte(',')
+	}
+	w.RawString("\"metadata\":")
+	in.ListMeta.MarshalEasyJSON(w)
+	w.RawString(",\"items\":")
+	if in.Items == nil {
+		w.RawString("null")
+	} else {
+		w.RawByte('[')
+		for i0 := range in.Items {
+			if i0 > 0 {
+				w.RawByte(',')
+			}
+			in.Items[i0].MarshalEasyJSON(w)
+		}
+		w.RawByte(']')
+	}
+	w.RawByte('}')
+}
+
+func (in *MutatingWebhookConfigurationList) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "kind":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.TypeMeta.Kind = l.String()
+			}
+		case "apiVersion":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.TypeMeta.APIVersion = l.String()
+			}
+		case "metadata":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.ListMeta.UnmarshalEasyJSON(l)
+			}
+		case "items":
+			if l.IsNull() {
+				l.Skip()
+				in.Items = nil
+			} else {
+				in.Items = make([]MutatingWebhookConfiguration, 0)
+				l.Delim('[')
+				for !l.IsDelim(']') {
+					var v0 MutatingWebhookConfiguration
+					if l.IsNull() {
+						l.Skip()
+					} else {
+						v0.UnmarshalEasyJSON(l)
+					}
+					in.Items = append(in.Items, v0)
+					l.WantComma()
+				}
+				l.Delim(']')
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
+
+type ValidatingAdmissionPolicy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata"`
+	// spec defines the desired behavior of the ValidatingAdmissionPolicy.
+	Spec *ValidatingAdmissionPolicySpec `json:"spec,omitempty"`
+	// status represents the current status of the ValidatingAdmissionPolicy, including warnings that are useful to determine if the policy
+	// behaves in the expected way.
+	// Populated by the system.
+	// Read-only.
+	Status *ValidatingAdmissionPolicyStatus `json:"status,omitempty"`
+}
+
+func (in *ValidatingAdmissionPolicy) DeepCopyInto(out *ValidatingAdmissionPolicy) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	if in.Spec != nil {
+		in, out := &in.Spec, &out.Spec
+		*out = new(ValidatingAdmissionPolicySpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Status != nil {
+		in, out := &in.Status, &out.Status
+		*out = new(ValidatingAdmissionPolicyStatus)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+func (in *ValidatingAdmissionPolicy) DeepCopy() *ValidatingAdmissionPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(ValidatingAdmissionPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *ValidatingAdmissionPolicy) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+func (in *ValidatingAdmissionPolicy) GetConditions() []metav1.Condition {
+	if in.Status == nil {
+		return nil
+	}
+	return in.Status.Conditions
+}
+
+func (in *ValidatingAdmissionPolicy) SetConditions(conditions []metav1.Condition) {
+	if in.Status == nil {
+		in.Status = &ValidatingAdmissionPolicyStatus{}
+	}
+	in.Status.Conditions = conditions
+}
+
+func (in *ValidatingAdmissionPolicy) FindCondition(conditionType string) *metav1.Condition {
+	return metav1.FindCondition(in.GetConditions(), conditionType)
+}
+
+func (in *ValidatingAdmissionPolicy) SetCondition(condition metav1.Condition) {
+	conditions := in.GetConditions()
+	metav1.SetCondition(&conditions, condition)
+	in.SetConditions(conditions)
+}
+
+func (in *ValidatingAdmissionPolicy) IsConditionTrue(conditionType string) bool {
+	return metav1.IsConditionTrue(in.GetConditions(), conditionType)
+}
+
+// ValidatingAdmissionPolicyToSelectableFields returns the fields of ValidatingAdmissionPolicy which can be used by the field selector.
+func ValidatingAdmissionPolicyToSelectableFields(obj *ValidatingAdmissionPolicy) fields.Set {
+	set := fields.Set{
+		"metadata.name":      obj.ObjectMeta.Name,
+		"metadata.namespace": obj.ObjectMeta.Namespace,
+	}
+	return set
+}
+
+// ValidatingAdmissionPolicyPatch builds JSON patch or JSON merge patch of ValidatingAdmissionPolicy.
+// JSON patch fails if the parent of the field doesn't exist (e.g. SetLabel to the object which has no label). MergePatch creates the parent.
+type ValidatingAdmissionPolicyPatch struct {
+	*patch.Builder
+}
+
+func NewValidatingAdmissionPolicyPatch() *ValidatingAdmissionPolicyPatch {
+	return &ValidatingAdmissionPolicyPatch{Builder: patch.NewBuilder()}
+}
+
+func (p *ValidatingAdmissionPolicyPatch) SetLabel(key, value string) *ValidatingAdmissionPolicyPatch {
+	p.Set([]string{"metadata", "labels", key}, value)
+	return p
+}
+
+func (p *ValidatingAdmissionPolicyPatch) RemoveLabel(key string) *ValidatingAdmissionPolicyPatch {
+	p.Remove([]string{"metadata", "labels", key})
+	return p
+}
+
+func (p *ValidatingAdmissionPolicyPatch) SetAnnotation(key, value string) *ValidatingAdmissionPolicyPatch {
+	p.Set([]string{"metadata", "annotations", key}, value)
+	return p
+}
+
+func (p *ValidatingAdmissionPolicyPatch) RemoveAnnotation(key string) *ValidatingAdmissionPolicyPatch {
+	p.Remove([]string{"metadata", "annotations", key})
+	return p
+}
+
+func (p *ValidatingAdmissionPolicyPatch) SetSpec(v *ValidatingAdmissionPolicySpec) *ValidatingAdmissionPolicyPatch {
+	p.Set([]string{"spec"}, v)
+	return p
+}
+
+func (p *ValidatingAdmissionPolicyPatch) RemoveSpec() *ValidatingAdmissionPolicyPatch {
+	p.Remove([]string{"spec"})
+	return p
+}
+
+func (p *ValidatingAdmissionPolicyPatch) SetSpecParamKind(v *ParamKind) *ValidatingAdmissionPolicyPatch {
+	p.Set([]string{"spec", "paramKind"}, v)
+	return p
+}
+
+func (p *ValidatingAdmissionPolicyPatch) RemoveSpecParamKind() *ValidatingAdmissionPolicyPatch {
+	p.Remove([]string{"spec", "paramKind"})
+	return p
+}
+
+func (p *ValidatingAdmissionPolicyPatch) SetSpecParamKindAPIVersion(v string) *ValidatingAdmissionPolicyPatch {
+	p.Set([]string{"spec", "paramKind", "apiVersion"}, v)
+	return p
+}
+
+func (p *ValidatingAdmissionPolicyPatch) RemoveSpecParamKindAPIVersion() *ValidatingAdmissionPolicyPatch {
+	p.Remove([]string{"spec", "paramKind", "apiVersion"})
+	return p
+}
+
+func (p *ValidatingAdmissionPolicyPatch) SetSpecParamKindKind(v string) *ValidatingAdmissionPolicyPatch {
+	p.Set([]string{"spec", "paramKind", "kind"}, v)
+	return p
+}
+
+func (p *ValidatingAdmissionPolicyPatch) RemoveSpecParamKindKind() *ValidatingAdmissionPolicyPatch {
+	p.Remove([]string{"spec", "paramKind", "kind"})
+	return p
+}
+
+func (p *ValidatingAdmissionPolicyPatch) SetSpecMatchConstraints(v *MatchResources) *ValidatingAdmissionPolicyPatch {
+	p.Set([]string{"spec", "matchConstraints"}, v)
+	return p
+}
+
+func (p *ValidatingAdmissionPolicyPatch) RemoveSpecMatchConstraints() *ValidatingAdmissionPolicyPatch {
+	p.Remove([]string{"spec", "matchConstraints"})
+	return p
+}
+
+func (p *ValidatingAdmissionPolicyPatch) SetSpecMatchConstraintsNamespaceSelector(v *metav1.LabelSelector) *ValidatingAdmissionPolicyPatch {
+	p.Set([]string{"spec", "matchConstraints", "namespaceSelector"}, v)
+	return p
+}
+
+func (p *ValidatingAdmissionPolicyPatch) RemoveSpecMatchConstraintsNamespaceSelector() *ValidatingAdmissionPolicyPatch {
+	p.Remove([]string{"spec", "matchConstraints", "namespaceSelector"})
+	return p
+}
+
+func (p *ValidatingAdmissionPolicyPatch) SetSpecMatchConstraintsObjectSelector(v *metav1.LabelSelector) *ValidatingAdmissionPolicyPatch {
+	p.Set([]string{"spec", "matchConstraints", "objectSelector"}, v)
+	return p
+}
+
+func (p *ValidatingAdmissionPolicyPatch) RemoveSpecMatchConstraintsObjectSelector() *ValidatingAdmissionPolicyPatch {
+	p.Remove([]string{"spec", "matchConstraints", "objectSelector"})
+	return p
+}
+
+func (p *ValidatingAdmissionPolicyPatch) SetSpecMatchConstraintsResourceRules(v []NamedRuleWithOperations) *ValidatingAdmissionPolicyPatch {
+	p.Set([]string{"spec", "matchConstraints", "resourceRules"}, v)
+	return p
+}
+
+func (p *ValidatingAdmissionPolicyPatch) RemoveSpecMatchConstraintsResourceRules() *ValidatingAdmissionPolicyPatch {
+	p.Remove([]string{"spec", "matchConstraints", "resourceRules"})
+	return p
+}
+
+func (p *ValidatingAdmissionPolicyPatch) AddSpecMatchConstraintsResourceRules(v ...NamedRuleWithOperations) *ValidatingAdmissionPolicyPatch {
+	for _, e := range v {
+		p.Append([]string{"spec", "matchConstraints", "resourceRules"}, e)
+	}
+	return p
+}
+
+func (p *ValidatingAdmissionPolicyPatch) SetSpecMatchConstraintsExcludeResourceRules(v []NamedRuleWithOperations) *ValidatingAdmissionPolicyPatch {
+	p.Set([]string{"spec", "matchConstraints", "excludeResourceRules"}, v)
+	return p
+}
+
+func (p *ValidatingAdmissionPolicyPatch) RemoveSpecMatchConstraintsExcludeResourceRules() *ValidatingAdmissionPolicyPatch {
+	p.Remove([]string{"spec", "matchConstraints", "excludeResourceRules"})
+	return p
+}
+
+func (p *ValidatingAdmissionPolicyPatch) AddSpecMatchConstraintsExcludeResourceRules(v ...NamedRuleWithOperations) *ValidatingAdmissionPolicyPatch {
+	for _, e := range v {
+		p.Append([]string{"spec", "matchConstraints", "excludeResourceRules"}, e)
+	}
+	return p
+}
+
+func (p *ValidatingAdmissionPolicyPatch) SetSpecMatchConstraintsMatchPolicy(v MatchPolicyType) *ValidatingAdmissionPolicyPatch {
+	p.Set([]string{"spec", "matchConstraints", "matchPolicy"}, v)
+	return p
+}
+
+func (p *ValidatingAdmissionPolicyPatch) RemoveSpecMatchConstraintsMatchPolicy() *ValidatingAdmissionPolicyPatch {
+	p.Remove([]string{"spec", "matchConstraints", "matchPolicy"})
+	return p
+}
+
+func (p *ValidatingAdmissionPolicyPatch) SetSpecValidations(v []Validation) *ValidatingAdmissionPolicyPatch {
+	p.Set([]string{"spec", "validations"}, v)
+	return p
+}
+
+func (p *ValidatingAdmissionPolicyPatch) RemoveSpecValidations() *ValidatingAdmissionPolicyPatch {
+	p.Remove([]string{"spec", "validations"})
+	return p
+}
+
+func (p *ValidatingAdmissionPolicyPatch) AddSpecValidations(v ...Validation) *ValidatingAdmissionPolicyPatch {
+	for _, e := range v {
+		p.Append([]string{"spec", "validations"}, e)
+	}
+	return p
+}
+
+func (p *ValidatingAdmissionPolicyPatch) SetSpecFailurePolicy(v FailurePolicyType) *ValidatingAdmissionPolicyPatch {
+	p.Set([]string{"spec", "failurePolicy"}, v)
+	return p
+}
+
+func (p *ValidatingAdmissionPolicyPatch) RemoveSpecFailurePolicy() *ValidatingAdmissionPolicyPatch {
+	p.Remove([]string{"spec", "failurePolicy"})
+	return p
+}
+
+func (p *ValidatingAdmissionPolicyPatch) SetSpecAuditAnnotations(v []AuditAnnotation) *ValidatingAdmissionPolicyPatch {
+	p.Set([]string{"spec", "auditAnnotations"}, v)
+	return p
+}
+
+func (p *ValidatingAdmissionPolicyPatch) RemoveSpecAuditAnnotations() *ValidatingAdmissionPolicyPatch {
+	p.Remove([]string{"spec", "auditAnnotations"})
+	return p
+}
+
+func (p *ValidatingAdmissionPolicyPatch) AddSpecAuditAnnotations(v ...AuditAnnotation) *ValidatingAdmissionPolicyPatch {
+	for _, e := range v {
+		p.Append([]string{"spec", "auditAnnotations"}, e)
+	}
+	return p
+}
+
+func (p *ValidatingAdmissionPolicyPatch) SetSpecMatchConditions(v []MatchCondition) *ValidatingAdmissionPolicyPatch {
+	p.Set([]string{"spec", "matchConditions"}, v)
+	return p
+}
+
+func (p *ValidatingAdmissionPolicyPatch) RemoveSpecMatchConditions() *ValidatingAdmissionPolicyPatch {
+	p.Remove([]string{"spec", "matchConditions"})
+	return p
+}
+
+func (p *ValidatingAdmissionPolicyPatch) AddSpecMatchConditions(v ...MatchCondition) *ValidatingAdmissionPolicyPatch {
+	for _, e := range v {
+		p.Append([]string{"spec", "matchConditions"}, e)
+	}
+	return p
+}
+
+func (p *ValidatingAdmissionPolicyPatch) SetSpecVariables(v []Variable) *ValidatingAdmissionPolicyPatch {
+	p.Set([]string{"spec", "variables"}, v)
+	return p
+}
+
+func (p *ValidatingAdmissionPolicyPatch) RemoveSpecVariables() *ValidatingAdmissionPolicyPatch {
+	p.Remove([]string{"spec", "variables"})
+	return p
+}
+
+func (p *ValidatingAdmissionPolicyPatch) AddSpecVariables(v ...Variable) *ValidatingAdmissionPolicyPatch {
+	for _, e := range v {
+		p.Append([]string{"spec", "variables"}, e)
+	}
+	return p
+}
+
+func (p *ValidatingAdmissionPolicyPatch) SetStatus(v *ValidatingAdmissionPolicyStatus) *ValidatingAdmissionPolicyPatch {
+	p.Set([]string{"status"}, v)
+	return p
+}
+
+func (p *ValidatingAdmissionPolicyPatch) RemoveStatus() *ValidatingAdmissionPolicyPatch {
+	p.Remove([]string{"status"})
+	return p
+}
+
+func (p *ValidatingAdmissionPolicyPatch) SetStatusObservedGeneration(v int64) *ValidatingAdmissionPolicyPatch {
+	p.Set([]string{"status", "observedGeneration"}, v)
+	return p
+}
+
+func (p *ValidatingAdmissionPolicyPatch) RemoveStatusObservedGeneration() *ValidatingAdmissionPolicyPatch {
+	p.Remove([]string{"status", "observedGeneration"})
+	return p
+}
+
+func (p *ValidatingAdmissionPolicyPatch) SetStatusTypeChecking(v *TypeChecking) *ValidatingAdmissionPolicyPatch {
+	p.Set([]string{"status", "typeChecking"}, v)
+	return p
+}
+
+func (p *ValidatingAdmissionPolicyPatch) RemoveStatusTypeChecking() *ValidatingAdmissionPolicyPatch {
+	p.Remove([]string{"status", "typeChecking"})
+	return p
 }
 
-func (in *MutatingWebhookConfiguration) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
-	}
-	return nil
+func (p *ValidatingAdmissionPolicyPatch) SetStatusTypeCheckingExpressionWarnings(v []ExpressionWarning) *ValidatingAdmissionPolicyPatch {
+	p.Set([]string{"status", "typeChecking", "expressionWarnings"}, v)
+	return p
 }
 
-type MutatingWebhookConfigurationList struct {
-	metav1.TypeMeta `json:",inline"`
-	metav1.ListMeta `json:"metadata"`
-	Items           []MutatingWebhookConfiguration `json:"items"`
+func (p *ValidatingAdmissionPolicyPatch) RemoveStatusTypeCheckingExpressionWarnings() *ValidatingAdmissionPolicyPatch {
+	p.Remove([]string{"status", "typeChecking", "expressionWarnings"})
+	return p
 }
 
-func (in *MutatingWebhookConfigurationList) DeepCopyInto(out *MutatingWebhookConfigurationList) {
-	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ListMeta.DeepCopyInto(&out.ListMeta)
-	if in.Items != nil {
-		l := make([]MutatingWebhookConfiguration, len(in.Items))
-		for i := range in.Items {
-			in.Items[i].DeepCopyInto(&l[i])
-		}
-		out.Items = l
+func (p *ValidatingAdmissionPolicyPatch) AddStatusTypeCheckingExpressionWarnings(v ...ExpressionWarning) *ValidatingAdmissionPolicyPatch {
+	for _, e := range v {
+		p.Append([]string{"status", "typeChecking", "expressionWarnings"}, e)
 	}
+	return p
 }
 
-func (in *MutatingWebhookConfigurationList) DeepCopy() *MutatingWebhookConfigurationList {
-	if in == nil {
-		return nil
-	}
-	out := new(MutatingWebhookConfigurationList)
-	in.DeepCopyInto(out)
-	return out
+func (p *ValidatingAdmissionPolicyPatch) SetStatusConditions(v []metav1.Condition) *ValidatingAdmissionPolicyPatch {
+	p.Set([]string{"status", "conditions"}, v)
+	return p
 }
 
-func (in *MutatingWebhookConfigurationList) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
+func (p *ValidatingAdmissionPolicyPatch) RemoveStatusConditions() *ValidatingAdmissionPolicyPatch {
+	p.Remove([]string{"status", "conditions"})
+	return p
+}
+
+func (p *ValidatingAdmissionPolicyPatch) AddStatusConditions(v ...metav1.Condition) *ValidatingAdmissionPolicyPatch {
+	for _, e := range v {
+		p.Append([]string{"status", "conditions"}, e)
 	}
-	return nil
+	return p
 }
 
-type ValidatingAdmissionPolicy struct {
-	metav1.TypeMeta   `json:",inline"`
-	metav1.ObjectMeta `json:"metadata"`
-	// spec defines the desired behavior of the ValidatingAdmissionPolicy.
-	Spec *ValidatingAdmissionPolicySpec `json:"spec,omitempty"`
-	// status represents the current status of the ValidatingAdmissionPolicy, including warnings that are useful to determine if the policy
-	// behaves in the expected way.
-	// Populated by the system.
-	// Read-only.
-	Status *ValidatingAdmissionPolicyStatus `json:"status,omitempty"`
+func (in *ValidatingAdmissionPolicy) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
 }
 
-func (in *ValidatingAdmissionPolicy) DeepCopyInto(out *ValidatingAdmissionPolicy) {
-	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+func (in *ValidatingAdmissionPolicy) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *ValidatingAdmissionPolicy) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	first := true
+	if in.TypeMeta.Kind != "" {
+		w.RawString("\"kind\":")
+		w.String(in.TypeMeta.Kind)
+		first = false
+	}
+	if in.TypeMeta.APIVersion != "" {
+		if !first {
+			w.RawByte(',')
+		}
+		w.RawString("\"apiVersion\":")
+		w.String(in.TypeMeta.APIVersion)
+		first = false
+	}
+	if !first {
+		w.RawByte(',')
+	}
+	w.RawString("\"metadata\":")
+	in.ObjectMeta.MarshalEasyJSON(w)
 	if in.Spec != nil {
-		in, out := &in.Spec, &out.Spec
-		*out = new(ValidatingAdmissionPolicySpec)
-		(*in).DeepCopyInto(*out)
+		w.RawString(",\"spec\":")
+		in.Spec.MarshalEasyJSON(w)
 	}
 	if in.Status != nil {
-		in, out := &in.Status, &out.Status
-		*out = new(ValidatingAdmissionPolicyStatus)
-		(*in).DeepCopyInto(*out)
+		w.RawString(",\"status\":")
+		in.Status.MarshalEasyJSON(w)
 	}
+	w.RawByte('}')
 }
 
-func (in *ValidatingAdmissionPolicy) DeepCopy() *ValidatingAdmissionPolicy {
-	if in == nil {
-		return nil
+func (in *ValidatingAdmissionPolicy) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
 	}
-	out := new(ValidatingAdmissionPolicy)
-	in.DeepCopyInto(out)
-	return out
-}
-
-func (in *ValidatingAdmissionPolicy) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "kind":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.TypeMeta.Kind = l.String()
+			}
+		case "apiVersion":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.TypeMeta.APIVersion = l.String()
+			}
+		case "metadata":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.ObjectMeta.UnmarshalEasyJSON(l)
+			}
+		case "spec":
+			if l.IsNull() {
+				l.Skip()
+				in.Spec = nil
+			} else {
+				if in.Spec == nil {
+					in.Spec = new(ValidatingAdmissionPolicySpec)
+				}
+				in.Spec.UnmarshalEasyJSON(l)
+			}
+		case "status":
+			if l.IsNull() {
+				l.Skip()
+				in.Status = nil
+			} else {
+				if in.Status == nil {
+					in.Status = new(ValidatingAdmissionPolicyStatus)
+				}
+				in.Status.UnmarshalEasyJSON(l)
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
 	}
-	return nil
 }
 
 type ValidatingAdmissionPolicyBinding struct {
@@ -391,6 +1918,299 @@ func (in *ValidatingAdmissionPolicyBinding) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// ValidatingAdmissionPolicyBindingToSelectableFields returns the fields of ValidatingAdmissionPolicyBinding which can be used by the field selector.
+func ValidatingAdmissionPolicyBindingToSelectableFields(obj *ValidatingAdmissionPolicyBinding) fields.Set {
+	set := fields.Set{
+		"metadata.name":      obj.ObjectMeta.Name,
+		"metadata.namespace": obj.ObjectMeta.Namespace,
+	}
+	return set
+}
+
+// ValidatingAdmissionPolicyBindingPatch builds JSON patch or JSON merge patch of ValidatingAdmissionPolicyBinding.
+// JSON patch fails if the parent of the field doesn't exist (e.g. SetLabel to the object which has no label). MergePatch creates the parent.
+type ValidatingAdmissionPolicyBindingPatch struct {
+	*patch.Builder
+}
+
+func NewValidatingAdmissionPolicyBindingPatch() *ValidatingAdmissionPolicyBindingPatch {
+	return &ValidatingAdmissionPolicyBindingPatch{Builder: patch.NewBuilder()}
+}
+
+func (p *ValidatingAdmissionPolicyBindingPatch) SetLabel(key, value string) *ValidatingAdmissionPolicyBindingPatch {
+	p.Set([]string{"metadata", "labels", key}, value)
+	return p
+}
+
+func (p *ValidatingAdmissionPolicyBindingPatch) RemoveLabel(key string) *ValidatingAdmissionPolicyBindingPatch {
+	p.Remove([]string{"metadata", "labels", key})
+	return p
+}
+
+func (p *ValidatingAdmissionPolicyBindingPatch) SetAnnotation(key, value string) *ValidatingAdmissionPolicyBindingPatch {
+	p.Set([]string{"metadata", "annotations", key}, value)
+	return p
+}
+
+func (p *ValidatingAdmissionPolicyBindingPatch) RemoveAnnotation(key string) *ValidatingAdmissionPolicyBindingPatch {
+	p.Remove([]string{"metadata", "annotations", key})
+	return p
+}
+
+func (p *ValidatingAdmissionPolicyBindingPatch) SetSpec(v *ValidatingAdmissionPolicyBindingSpec) *ValidatingAdmissionPolicyBindingPatch {
+	p.Set([]string{"spec"}, v)
+	return p
+}
+
+func (p *ValidatingAdmissionPolicyBindingPatch) RemoveSpec() *ValidatingAdmissionPolicyBindingPatch {
+	p.Remove([]string{"spec"})
+	return p
+}
+
+func (p *ValidatingAdmissionPolicyBindingPatch) SetSpecPolicyName(v string) *ValidatingAdmissionPolicyBindingPatch {
+	p.Set([]string{"spec", "policyName"}, v)
+	return p
+}
+
+func (p *ValidatingAdmissionPolicyBindingPatch) RemoveSpecPolicyName() *ValidatingAdmissionPolicyBindingPatch {
+	p.Remove([]string{"spec", "policyName"})
+	return p
+}
+
+func (p *ValidatingAdmissionPolicyBindingPatch) SetSpecParamRef(v *ParamRef) *ValidatingAdmissionPolicyBindingPatch {
+	p.Set([]string{"spec", "paramRef"}, v)
+	return p
+}
+
+func (p *ValidatingAdmissionPolicyBindingPatch) RemoveSpecParamRef() *ValidatingAdmissionPolicyBindingPatch {
+	p.Remove([]string{"spec", "paramRef"})
+	return p
+}
+
+func (p *ValidatingAdmissionPolicyBindingPatch) SetSpecParamRefName(v string) *ValidatingAdmissionPolicyBindingPatch {
+	p.Set([]string{"spec", "paramRef", "name"}, v)
+	return p
+}
+
+func (p *ValidatingAdmissionPolicyBindingPatch) RemoveSpecParamRefName() *ValidatingAdmissionPolicyBindingPatch {
+	p.Remove([]string{"spec", "paramRef", "name"})
+	return p
+}
+
+func (p *ValidatingAdmissionPolicyBindingPatch) SetSpecParamRefNamespace(v string) *ValidatingAdmissionPolicyBindingPatch {
+	p.Set([]string{"spec", "paramRef", "namespace"}, v)
+	return p
+}
+
+func (p *ValidatingAdmissionPolicyBindingPatch) RemoveSpecParamRefNamespace() *ValidatingAdmissionPolicyBindingPatch {
+	p.Remove([]string{"spec", "paramRef", "namespace"})
+	return p
+}
+
+func (p *ValidatingAdmissionPolicyBindingPatch) SetSpecParamRefSelector(v *metav1.LabelSelector) *ValidatingAdmissionPolicyBindingPatch {
+	p.Set([]string{"spec", "paramRef", "selector"}, v)
+	return p
+}
+
+func (p *ValidatingAdmissionPolicyBindingPatch) RemoveSpecParamRefSelector() *ValidatingAdmissionPolicyBindingPatch {
+	p.Remove([]string{"spec", "paramRef", "selector"})
+	return p
+}
+
+func (p *ValidatingAdmissionPolicyBindingPatch) SetSpecParamRefParameterNotFoundAction(v ParameterNotFoundActionType) *ValidatingAdmissionPolicyBindingPatch {
+	p.Set([]string{"spec", "paramRef", "parameterNotFoundAction"}, v)
+	return p
+}
+
+func (p *ValidatingAdmissionPolicyBindingPatch) RemoveSpecParamRefParameterNotFoundAction() *ValidatingAdmissionPolicyBindingPatch {
+	p.Remove([]string{"spec", "paramRef", "parameterNotFoundAction"})
+	return p
+}
+
+func (p *ValidatingAdmissionPolicyBindingPatch) SetSpecMatchResources(v *MatchResources) *ValidatingAdmissionPolicyBindingPatch {
+	p.Set([]string{"spec", "matchResources"}, v)
+	return p
+}
+
+func (p *ValidatingAdmissionPolicyBindingPatch) RemoveSpecMatchResources() *ValidatingAdmissionPolicyBindingPatch {
+	p.Remove([]string{"spec", "matchResources"})
+	return p
+}
+
+func (p *ValidatingAdmissionPolicyBindingPatch) SetSpecMatchResourcesNamespaceSelector(v *metav1.LabelSelector) *ValidatingAdmissionPolicyBindingPatch {
+	p.Set([]string{"spec", "matchResources", "namespaceSelector"}, v)
+	return p
+}
+
+func (p *ValidatingAdmissionPolicyBindingPatch) RemoveSpecMatchResourcesNamespaceSelector() *ValidatingAdmissionPolicyBindingPatch {
+	p.Remove([]string{"spec", "matchResources", "namespaceSelector"})
+	return p
+}
+
+func (p *ValidatingAdmissionPolicyBindingPatch) SetSpecMatchResourcesObjectSelector(v *metav1.LabelSelector) *ValidatingAdmissionPolicyBindingPatch {
+	p.Set([]string{"spec", "matchResources", "objectSelector"}, v)
+	return p
+}
+
+func (p *ValidatingAdmissionPolicyBindingPatch) RemoveSpecMatchResourcesObjectSelector() *ValidatingAdmissionPolicyBindingPatch {
+	p.Remove([]string{"spec", "matchResources", "objectSelector"})
+	return p
+}
+
+func (p *ValidatingAdmissionPolicyBindingPatch) SetSpecMatchResourcesResourceRules(v []NamedRuleWithOperations) *ValidatingAdmissionPolicyBindingPatch {
+	p.Set([]string{"spec", "matchResources", "resourceRules"}, v)
+	return p
+}
+
+func (p *ValidatingAdmissionPolicyBindingPatch) RemoveSpecMatchResourcesResourceRules() *ValidatingAdmissionPolicyBindingPatch {
+	p.Remove([]string{"spec", "matchResources", "resourceRules"})
+	return p
+}
+
+func (p *ValidatingAdmissionPolicyBindingPatch) AddSpecMatchResourcesResourceRules(v ...NamedRuleWithOperations) *ValidatingAdmissionPolicyBindingPatch {
+	for _, e := range v {
+		p.Append([]string{"spec", "matchResources", "resourceRules"}, e)
+	}
+	return p
+}
+
+func (p *ValidatingAdmissionPolicyBindingPatch) SetSpecMatchResourcesExcludeResourceRules(v []NamedRuleWithOperations) *ValidatingAdmissionPolicyBindingPatch {
+	p.Set([]string{"spec", "matchResources", "excludeResourceRules"}, v)
+	return p
+}
+
+func (p *ValidatingAdmissionPolicyBindingPatch) RemoveSpecMatchResourcesExcludeResourceRules() *ValidatingAdmissionPolicyBindingPatch {
+	p.Remove([]string{"spec", "matchResources", "excludeResourceRules"})
+	return p
+}
+
+func (p *ValidatingAdmissionPolicyBindingPatch) AddSpecMatchResourcesExcludeResourceRules(v ...NamedRuleWithOperations) *ValidatingAdmissionPolicyBindingPatch {
+	for _, e := range v {
+		p.Append([]string{"spec", "matchResources", "excludeResourceRules"}, e)
+	}
+	return p
+}
+
+func (p *ValidatingAdmissionPolicyBindingPatch) SetSpecMatchResourcesMatchPolicy(v MatchPolicyType) *ValidatingAdmissionPolicyBindingPatch {
+	p.Set([]string{"spec", "matchResources", "matchPolicy"}, v)
+	return p
+}
+
+func (p *ValidatingAdmissionPolicyBindingPatch) RemoveSpecMatchResourcesMatchPolicy() *ValidatingAdmissionPolicyBindingPatch {
+	p.Remove([]string{"spec", "matchResources", "matchPolicy"})
+	return p
+}
+
+func (p *ValidatingAdmissionPolicyBindingPatch) SetSpecValidationActions(v []ValidationAction) *ValidatingAdmissionPolicyBindingPatch {
+	p.Set([]string{"spec", "validationActions"}, v)
+	return p
+}
+
+func (p *ValidatingAdmissionPolicyBindingPatch) RemoveSpecValidationActions() *ValidatingAdmissionPolicyBindingPatch {
+	p.Remove([]string{"spec", "validationActions"})
+	return p
+}
+
+func (p *ValidatingAdmissionPolicyBindingPatch) AddSpecValidationActions(v ...ValidationAction) *ValidatingAdmissionPolicyBindingPatch {
+	for _, e := range v {
+		p.Append([]string{"spec", "validationActions"}, e)
+	}
+	return p
+}
+
+func (in *ValidatingAdmissionPolicyBinding) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *ValidatingAdmissionPolicyBinding) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *ValidatingAdmissionPolicyBinding) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	first := true
+	if in.TypeMeta.Kind != "" {
+		w.RawString("\"kind\":")
+		w.String(in.TypeMeta.Kind)
+		first = false
+	}
+	if in.TypeMeta.APIVersion != "" {
+		if !first {
+			w.RawByte(',')
+		}
+		w.RawString("\"apiVersion\":")
+		w.String(in.TypeMeta.APIVersion)
+		first = false
+	}
+	if !first {
+		w.RawByte(',')
+	}
+	w.RawString("\"metadata\":")
+	in.ObjectMeta.MarshalEasyJSON(w)
+	if in.Spec != nil {
+		w.RawString(",\"spec\":")
+		in.Spec.MarshalEasyJSON(w)
+	}
+	w.RawByte('}')
+}
+
+func (in *ValidatingAdmissionPolicyBinding) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "kind":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.TypeMeta.Kind = l.String()
+			}
+		case "apiVersion":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.TypeMeta.APIVersion = l.String()
+			}
+		case "metadata":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.ObjectMeta.UnmarshalEasyJSON(l)
+			}
+		case "spec":
+			if l.IsNull() {
+				l.Skip()
+				in.Spec = nil
+			} else {
+				if in.Spec == nil {
+					in.Spec = new(ValidatingAdmissionPolicyBindingSpec)
+				}
+				in.Spec.UnmarshalEasyJSON(l)
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
+
 type ValidatingAdmissionPolicyBindingList struct {
 	metav1.TypeMeta `json:",inline"`
 	metav1.ListMeta `json:"metadata"`
@@ -426,6 +2246,117 @@ func (in *ValidatingAdmissionPolicyBindingList) DeepCopyObject() runtime.Object
 	return nil
 }
 
+func (in *ValidatingAdmissionPolicyBindingList) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *ValidatingAdmissionPolicyBindingList) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *ValidatingAdmissionPolicyBindingList) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	first := true
+	if in.TypeMeta.Kind != "" {
+		w.RawString("\"kind\":")
+		w.String(in.TypeMeta.Kind)
+		first = false
+	}
+	if in.TypeMeta.APIVersion != "" {
+		if !first {
+			w.RawByte(',')
+		}
+		w.RawString("\"apiVersion\":")
+		w.String(in.TypeMeta.APIVersion)
+		first = false
+	}
+	if !first {
+		w.RawByte(',')
+	}
+	w.RawString("\"metadata\":")
+	in.ListMeta.MarshalEasyJSON(w)
+	w.RawString(",\"items\":")
+	if in.Items == nil {
+		w.RawString("null")
+	} else {
+		w.RawByte('[')
+		for i0 := range in.Items {
+			if i0 > 0 {
+				w.RawByte(',')
+			}
+			in.Items[i0].MarshalEasyJSON(w)
+		}
+		w.RawByte(']')
+	}
+	w.RawByte('}')
+}
+
+func (in *ValidatingAdmissionPolicyBindingList) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "kind":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.TypeMeta.Kind = l.String()
+			}
+		case "apiVersion":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.TypeMeta.APIVersion = l.String()
+			}
+		case "metadata":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.ListMeta.UnmarshalEasyJSON(l)
+			}
+		case "items":
+			if l.IsNull() {
+				l.Skip()
+				in.Items = nil
+			} else {
+				in.Items = make([]ValidatingAdmissionPolicyBinding, 0)
+				l.Delim('[')
+				for !l.IsDelim(']') {
+					var v0 ValidatingAdmissionPolicyBinding
+					if l.IsNull() {
+						l.Skip()
+					} else {
+						v0.UnmarshalEasyJSON(l)
+					}
+					in.Items = append(in.Items, v0)
+					l.WantComma()
+				}
+				l.Delim(']')
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
+
 type ValidatingAdmissionPolicyList struct {
 	metav1.TypeMeta `json:",inline"`
 	metav1.ListMeta `json:"metadata"`
@@ -461,6 +2392,117 @@ func (in *ValidatingAdmissionPolicyList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+func (in *ValidatingAdmissionPolicyList) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *ValidatingAdmissionPolicyList) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *ValidatingAdmissionPolicyList) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	first := true
+	if in.TypeMeta.Kind != "" {
+		w.RawString("\"kind\":")
+		w.String(in.TypeMeta.Kind)
+		first = false
+	}
+	if in.TypeMeta.APIVersion != "" {
+		if !first {
+			w.RawByte(',')
+		}
+		w.RawString("\"apiVersion\":")
+		w.String(in.TypeMeta.APIVersion)
+		first = false
+	}
+	if !first {
+		w.RawByte(',')
+	}
+	w.RawString("\"metadata\":")
+	in.ListMeta.MarshalEasyJSON(w)
+	w.RawString(",\"items\":")
+	if in.Items == nil {
+		w.RawString("null")
+	} else {
+		w.RawByte('[')
+		for i0 := range in.Items {
+			if i0 > 0 {
+				w.RawByte(',')
+			}
+			in.Items[i0].MarshalEasyJSON(w)
+		}
+		w.RawByte(']')
+	}
+	w.RawByte('}')
+}
+
+func (in *ValidatingAdmissionPolicyList) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "kind":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.TypeMeta.Kind = l.String()
+			}
+		case "apiVersion":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.TypeMeta.APIVersion = l.String()
+			}
+		case "metadata":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.ListMeta.UnmarshalEasyJSON(l)
+			}
+		case "items":
+			if l.IsNull() {
+				l.Skip()
+				in.Items = nil
+			} else {
+				in.Items = make([]ValidatingAdmissionPolicy, 0)
+				l.Delim('[')
+				for !l.IsDelim(']') {
+					var v0 ValidatingAdmissionPolicy
+					if l.IsNull() {
+						l.Skip()
+					} else {
+						v0.UnmarshalEasyJSON(l)
+					}
+					in.Items = append(in.Items, v0)
+					l.WantComma()
+				}
+				l.Delim(']')
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
+
 type ValidatingWebhookConfiguration struct {
 	metav1.TypeMeta   `json:",inline"`
 	metav1.ObjectMeta `json:"metadata"`
@@ -490,11 +2532,178 @@ func (in *ValidatingWebhookConfiguration) DeepCopy() *ValidatingWebhookConfigura
 	return out
 }
 
-func (in *ValidatingWebhookConfiguration) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
+func (in *ValidatingWebhookConfiguration) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// ValidatingWebhookConfigurationToSelectableFields returns the fields of ValidatingWebhookConfiguration which can be used by the field selector.
+func ValidatingWebhookConfigurationToSelectableFields(obj *ValidatingWebhookConfiguration) fields.Set {
+	set := fields.Set{
+		"metadata.name":      obj.ObjectMeta.Name,
+		"metadata.namespace": obj.ObjectMeta.Namespace,
+	}
+	return set
+}
+
+// ValidatingWebhookConfigurationPatch builds JSON patch or JSON merge patch of ValidatingWebhookConfiguration.
+// JSON patch fails if the parent of the field doesn't exist (e.g. SetLabel to the object which has no label). MergePatch creates the parent.
+type ValidatingWebhookConfigurationPatch struct {
+	*patch.Builder
+}
+
+func NewValidatingWebhookConfigurationPatch() *ValidatingWebhookConfigurationPatch {
+	return &ValidatingWebhookConfigurationPatch{Builder: patch.NewBuilder()}
+}
+
+func (p *ValidatingWebhookConfigurationPatch) SetLabel(key, value string) *ValidatingWebhookConfigurationPatch {
+	p.Set([]string{"metadata", "labels", key}, value)
+	return p
+}
+
+func (p *ValidatingWebhookConfigurationPatch) RemoveLabel(key string) *ValidatingWebhookConfigurationPatch {
+	p.Remove([]string{"metadata", "labels", key})
+	return p
+}
+
+func (p *ValidatingWebhookConfigurationPatch) SetAnnotation(key, value string) *ValidatingWebhookConfigurationPatch {
+	p.Set([]string{"metadata", "annotations", key}, value)
+	return p
+}
+
+func (p *ValidatingWebhookConfigurationPatch) RemoveAnnotation(key string) *ValidatingWebhookConfigurationPatch {
+	p.Remove([]string{"metadata", "annotations", key})
+	return p
+}
+
+func (p *ValidatingWebhookConfigurationPatch) SetWebhooks(v []ValidatingWebhook) *ValidatingWebhookConfigurationPatch {
+	p.Set([]string{"webhooks"}, v)
+	return p
+}
+
+func (p *ValidatingWebhookConfigurationPatch) RemoveWebhooks() *ValidatingWebhookConfigurationPatch {
+	p.Remove([]string{"webhooks"})
+	return p
+}
+
+func (p *ValidatingWebhookConfigurationPatch) AddWebhooks(v ...ValidatingWebhook) *ValidatingWebhookConfigurationPatch {
+	for _, e := range v {
+		p.Append([]string{"webhooks"}, e)
+	}
+	return p
+}
+
+func (in *ValidatingWebhookConfiguration) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *ValidatingWebhookConfiguration) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *ValidatingWebhookConfiguration) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	first := true
+	if in.TypeMeta.Kind != "" {
+		w.RawString("\"kind\":")
+		w.String(in.TypeMeta.Kind)
+		first = false
+	}
+	if in.TypeMeta.APIVersion != "" {
+		if !first {
+			w.RawByte(',')
+		}
+		w.RawString("\"apiVersion\":")
+		w.String(in.TypeMeta.APIVersion)
+		first = false
+	}
+	if !first {
+		w.RawByte(',')
+	}
+	w.RawString("\"metadata\":")
+	in.ObjectMeta.MarshalEasyJSON(w)
+	w.RawString(",\"webhooks\":")
+	if in.Webhooks == nil {
+		w.RawString("null")
+	} else {
+		w.RawByte('[')
+		for i0 := range in.Webhooks {
+			if i0 > 0 {
+				w.RawByte(',')
+			}
+			in.Webhooks[i0].MarshalEasyJSON(w)
+		}
+		w.RawByte(']')
+	}
+	w.RawByte('}')
+}
+
+func (in *ValidatingWebhookConfiguration) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "kind":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.TypeMeta.Kind = l.String()
+			}
+		case "apiVersion":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.TypeMeta.APIVersion = l.String()
+			}
+		case "metadata":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.ObjectMeta.UnmarshalEasyJSON(l)
+			}
+		case "webhooks":
+			if l.IsNull() {
+				l.Skip()
+				in.Webhooks = nil
+			} else {
+				in.Webhooks = make([]ValidatingWebhook, 0)
+				l.Delim('[')
+				for !l.IsDelim(']') {
+					var v0 ValidatingWebhook
+					if l.IsNull() {
+						l.Skip()
+					} else {
+						v0.UnmarshalEasyJSON(l)
+					}
+					in.Webhooks = append(in.Webhooks, v0)
+					l.WantComma()
+				}
+				l.Delim(']')
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
 	}
-	return nil
 }
 
 type ValidatingWebhookConfigurationList struct {
@@ -532,6 +2741,117 @@ func (in *ValidatingWebhookConfigurationList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+func (in *ValidatingWebhookConfigurationList) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *ValidatingWebhookConfigurationList) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *ValidatingWebhookConfigurationList) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	first := true
+	if in.TypeMeta.Kind != "" {
+		w.RawString("\"kind\":")
+		w.String(in.TypeMeta.Kind)
+		first = false
+	}
+	if in.TypeMeta.APIVersion != "" {
+		if !first {
+			w.RawByte(',')
+		}
+		w.RawString("\"apiVersion\":")
+		w.String(in.TypeMeta.APIVersion)
+		first = false
+	}
+	if !first {
+		w.RawByte(',')
+	}
+	w.RawString("\"metadata\":")
+	in.ListMeta.MarshalEasyJSON(w)
+	w.RawString(",\"items\":")
+	if in.Items == nil {
+		w.RawString("null")
+	} else {
+		w.RawByte('[')
+		for i0 := range in.Items {
+			if i0 > 0 {
+				w.RawByte(',')
+			}
+			in.Items[i0].MarshalEasyJSON(w)
+		}
+		w.RawByte(']')
+	}
+	w.RawByte('}')
+}
+
+func (in *ValidatingWebhookConfigurationList) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "kind":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.TypeMeta.Kind = l.String()
+			}
+		case "apiVersion":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.TypeMeta.APIVersion = l.String()
+			}
+		case "metadata":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.ListMeta.UnmarshalEasyJSON(l)
+			}
+		case "items":
+			if l.IsNull() {
+				l.Skip()
+				in.Items = nil
+			} else {
+				in.Items = make([]ValidatingWebhookConfiguration, 0)
+				l.Delim('[')
+				for !l.IsDelim(']') {
+					var v0 ValidatingWebhookConfiguration
+					if l.IsNull() {
+						l.Skip()
+					} else {
+						v0.UnmarshalEasyJSON(l)
+					}
+					in.Items = append(in.Items, v0)
+					l.WantComma()
+				}
+				l.Delim(']')
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
+
 type MutatingAdmissionPolicySpec struct {
 	// paramKind specifies the kind of resources used to parameterize this policy.
 	// If absent, there are no parameters for this policy and the param CEL variable will not be provided to validation expressions.
@@ -636,6 +2956,201 @@ func (in *MutatingAdmissionPolicySpec) DeepCopy() *MutatingAdmissionPolicySpec {
 	return out
 }
 
+func (in *MutatingAdmissionPolicySpec) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *MutatingAdmissionPolicySpec) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *MutatingAdmissionPolicySpec) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	first := true
+	if in.ParamKind != nil {
+		w.RawString("\"paramKind\":")
+		in.ParamKind.MarshalEasyJSON(w)
+		first = false
+	}
+	if in.MatchConstraints != nil {
+		if !first {
+			w.RawByte(',')
+		}
+		w.RawString("\"matchConstraints\":")
+		in.MatchConstraints.MarshalEasyJSON(w)
+		first = false
+	}
+	if !first {
+		w.RawByte(',')
+	}
+	w.RawString("\"variables\":")
+	if in.Variables == nil {
+		w.RawString("null")
+	} else {
+		w.RawByte('[')
+		for i0 := range in.Variables {
+			if i0 > 0 {
+				w.RawByte(',')
+			}
+			in.Variables[i0].MarshalEasyJSON(w)
+		}
+		w.RawByte(']')
+	}
+	w.RawString(",\"mutations\":")
+	if in.Mutations == nil {
+		w.RawString("null")
+	} else {
+		w.RawByte('[')
+		for i0 := range in.Mutations {
+			if i0 > 0 {
+				w.RawByte(',')
+			}
+			in.Mutations[i0].MarshalEasyJSON(w)
+		}
+		w.RawByte(']')
+	}
+	if in.FailurePolicy != "" {
+		w.RawString(",\"failurePolicy\":")
+		w.String(string(in.FailurePolicy))
+	}
+	w.RawString(",\"matchConditions\":")
+	if in.MatchConditions == nil {
+		w.RawString("null")
+	} else {
+		w.RawByte('[')
+		for i0 := range in.MatchConditions {
+			if i0 > 0 {
+				w.RawByte(',')
+			}
+			in.MatchConditions[i0].MarshalEasyJSON(w)
+		}
+		w.RawByte(']')
+	}
+	if in.ReinvocationPolicy != "" {
+		w.RawString(",\"reinvocationPolicy\":")
+		w.String(string(in.ReinvocationPolicy))
+	}
+	w.RawByte('}')
+}
+
+func (in *MutatingAdmissionPolicySpec) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "paramKind":
+			if l.IsNull() {
+				l.Skip()
+				in.ParamKind = nil
+			} else {
+				if in.ParamKind == nil {
+					in.ParamKind = new(ParamKind)
+				}
+				in.ParamKind.UnmarshalEasyJSON(l)
+			}
+		case "matchConstraints":
+			if l.IsNull() {
+				l.Skip()
+				in.MatchConstraints = nil
+			} else {
+				if in.MatchConstraints == nil {
+					in.MatchConstraints = new(MatchResources)
+				}
+				in.MatchConstraints.UnmarshalEasyJSON(l)
+			}
+		case "variables":
+			if l.IsNull() {
+				l.Skip()
+				in.Variables = nil
+			} else {
+				in.Variables = make([]Variable, 0)
+				l.Delim('[')
+				for !l.IsDelim(']') {
+					var v0 Variable
+					if l.IsNull() {
+						l.Skip()
+					} else {
+						v0.UnmarshalEasyJSON(l)
+					}
+					in.Variables = append(in.Variables, v0)
+					l.WantComma()
+				}
+				l.Delim(']')
+			}
+		case "mutations":
+			if l.IsNull() {
+				l.Skip()
+				in.Mutations = nil
+			} else {
+				in.Mutations = make([]Mutation, 0)
+				l.Delim('[')
+				for !l.IsDelim(']') {
+					var v0 Mutation
+					if l.IsNull() {
+						l.Skip()
+					} else {
+						v0.UnmarshalEasyJSON(l)
+					}
+					in.Mutations = append(in.Mutations, v0)
+					l.WantComma()
+				}
+				l.Delim(']')
+			}
+		case "failurePolicy":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.FailurePolicy = FailurePolicyType(l.String())
+			}
+		case "matchConditions":
+			if l.IsNull() {
+				l.Skip()
+				in.MatchConditions = nil
+			} else {
+				in.MatchConditions = make([]MatchCondition, 0)
+				l.Delim('[')
+				for !l.IsDelim(']') {
+					var v0 MatchCondition
+					if l.IsNull() {
+						l.Skip()
+					} else {
+						v0.UnmarshalEasyJSON(l)
+					}
+					in.MatchConditions = append(in.MatchConditions, v0)
+					l.WantComma()
+				}
+				l.Delim(']')
+			}
+		case "reinvocationPolicy":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.ReinvocationPolicy = ReinvocationPolicyType(l.String())
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
+
 type MutatingAdmissionPolicyBindingSpec struct {
 	// policyName references a MutatingAdmissionPolicy name which the MutatingAdmissionPolicyBinding binds to.
 	// If the referenced resource does not exist, this binding is considered invalid and will be ignored
@@ -681,6 +3196,96 @@ func (in *MutatingAdmissionPolicyBindingSpec) DeepCopy() *MutatingAdmissionPolic
 	return out
 }
 
+func (in *MutatingAdmissionPolicyBindingSpec) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *MutatingAdmissionPolicyBindingSpec) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *MutatingAdmissionPolicyBindingSpec) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	first := true
+	if in.PolicyName != "" {
+		w.RawString("\"policyName\":")
+		w.String(in.PolicyName)
+		first = false
+	}
+	if in.ParamRef != nil {
+		if !first {
+			w.RawByte(',')
+		}
+		w.RawString("\"paramRef\":")
+		in.ParamRef.MarshalEasyJSON(w)
+		first = false
+	}
+	if in.MatchResources != nil {
+		if !first {
+			w.RawByte(',')
+		}
+		w.RawString("\"matchResources\":")
+		in.MatchResources.MarshalEasyJSON(w)
+		first = false
+	}
+	w.RawByte('}')
+}
+
+func (in *MutatingAdmissionPolicyBindingSpec) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "policyName":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.PolicyName = l.String()
+			}
+		case "paramRef":
+			if l.IsNull() {
+				l.Skip()
+				in.ParamRef = nil
+			} else {
+				if in.ParamRef == nil {
+					in.ParamRef = new(ParamRef)
+				}
+				in.ParamRef.UnmarshalEasyJSON(l)
+			}
+		case "matchResources":
+			if l.IsNull() {
+				l.Skip()
+				in.MatchResources = nil
+			} else {
+				if in.MatchResources == nil {
+					in.MatchResources = new(MatchResources)
+				}
+				in.MatchResources.UnmarshalEasyJSON(l)
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
+
 type MutatingWebhook struct {
 	// name is the name of the admission webhook.
 	// Name should be fully qualified, e.g., imagepolicy.kubernetes.io, where
@@ -855,6 +3460,239 @@ func (in *MutatingWebhook) DeepCopy() *MutatingWebhook {
 	return out
 }
 
+func (in *MutatingWebhook) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *MutatingWebhook) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *MutatingWebhook) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	w.RawString("\"name\":")
+	w.String(in.Name)
+	w.RawString(",\"clientConfig\":")
+	in.ClientConfig.MarshalEasyJSON(w)
+	w.RawString(",\"rules\":")
+	if in.Rules == nil {
+		w.RawString("null")
+	} else {
+		w.RawByte('[')
+		for i0 := range in.Rules {
+			if i0 > 0 {
+				w.RawByte(',')
+			}
+			in.Rules[i0].MarshalEasyJSON(w)
+		}
+		w.RawByte(']')
+	}
+	if in.FailurePolicy != "" {
+		w.RawString(",\"failurePolicy\":")
+		w.String(string(in.FailurePolicy))
+	}
+	if in.MatchPolicy != "" {
+		w.RawString(",\"matchPolicy\":")
+		w.String(string(in.MatchPolicy))
+	}
+	if in.NamespaceSelector != nil {
+		w.RawString(",\"namespaceSelector\":")
+		in.NamespaceSelector.MarshalEasyJSON(w)
+	}
+	if in.ObjectSelector != nil {
+		w.RawString(",\"objectSelector\":")
+		in.ObjectSelector.MarshalEasyJSON(w)
+	}
+	if in.SideEffects != "" {
+		w.RawString(",\"sideEffects\":")
+		w.String(string(in.SideEffects))
+	}
+	if in.TimeoutSeconds != 0 {
+		w.RawString(",\"timeoutSeconds\":")
+		w.Int(in.TimeoutSeconds)
+	}
+	w.RawString(",\"admissionReviewVersions\":")
+	if in.AdmissionReviewVersions == nil {
+		w.RawString("null")
+	} else {
+		w.RawByte('[')
+		for i0 := range in.AdmissionReviewVersions {
+			if i0 > 0 {
+				w.RawByte(',')
+			}
+			w.String(in.AdmissionReviewVersions[i0])
+		}
+		w.RawByte(']')
+	}
+	if in.ReinvocationPolicy != "" {
+		w.RawString(",\"reinvocationPolicy\":")
+		w.String(string(in.ReinvocationPolicy))
+	}
+	w.RawString(",\"matchConditions\":")
+	if in.MatchConditions == nil {
+		w.RawString("null")
+	} else {
+		w.RawByte('[')
+		for i0 := range in.MatchConditions {
+			if i0 > 0 {
+				w.RawByte(',')
+			}
+			in.MatchConditions[i0].MarshalEasyJSON(w)
+		}
+		w.RawByte(']')
+	}
+
+	w.RawByte('}')
+}
+
+func (in *MutatingWebhook) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "name":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.Name = l.String()
+			}
+		case "clientConfig":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.ClientConfig.UnmarshalEasyJSON(l)
+			}
+		case "rules":
+			if l.IsNull() {
+				l.Skip()
+				in.Rules = nil
+			} else {
+				in.Rules = make([]RuleWithOperations, 0)
+				l.Delim('[')
+				for !l.IsDelim(']') {
+					var v0 RuleWithOperations
+					if l.IsNull() {
+						l.Skip()
+					} else {
+						v0.UnmarshalEasyJSON(l)
+					}
+					in.Rules = append(in.Rules, v0)
+					l.WantComma()
+				}
+				l.Delim(']')
+			}
+		case "failurePolicy":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.FailurePolicy = FailurePolicyType(l.String())
+			}
+		case "matchPolicy":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.MatchPolicy = MatchPolicyType(l.String())
+			}
+		case "namespaceSelector":
+			if l.IsNull() {
+				l.Skip()
+				in.NamespaceSelector = nil
+			} else {
+				if in.NamespaceSelector == nil {
+					in.NamespaceSelector = new(metav1.LabelSelector)
+				}
+				in.NamespaceSelector.UnmarshalEasyJSON(l)
+			}
+		case "objectSelector":
+			if l.IsNull() {
+				l.Skip()
+				in.ObjectSelector = nil
+			} else {
+				if in.ObjectSelector == nil {
+					in.ObjectSelector = new(metav1.LabelSelector)
+				}
+				in.ObjectSelector.UnmarshalEasyJSON(l)
+			}
+		case "sideEffects":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.SideEffects = SideEffectClass(l.String())
+			}
+		case "timeoutSeconds":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.TimeoutSeconds = l.Int()
+			}
+		case "admissionReviewVersions":
+			if l.IsNull() {
+				l.Skip()
+				in.AdmissionReviewVersions = nil
+			} else {
+				in.AdmissionReviewVersions = make([]string, 0)
+				l.Delim('[')
+				for !l.IsDelim(']') {
+					var v0 string
+					if l.IsNull() {
+						l.Skip()
+					} else {
+						v0 = l.String()
+					}
+					in.AdmissionReviewVersions = append(in.AdmissionReviewVersions, v0)
+					l.WantComma()
+				}
+				l.Delim(']')
+			}
+		case "reinvocationPolicy":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.ReinvocationPolicy = ReinvocationPolicyType(l.String())
+			}
+		case "matchConditions":
+			if l.IsNull() {
+				l.Skip()
+				in.MatchConditions = nil
+			} else {
+				in.MatchConditions = make([]MatchCondition, 0)
+				l.Delim('[')
+				for !l.IsDelim(']') {
+					var v0 MatchCondition
+					if l.IsNull() {
+						l.Skip()
+					} else {
+						v0.UnmarshalEasyJSON(l)
+					}
+					in.MatchConditions = append(in.MatchConditions, v0)
+					l.WantComma()
+				}
+				l.Delim(']')
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
+
 type ValidatingAdmissionPolicySpec struct {
 	// paramKind specifies the kind of resources used to parameterize this policy.
 	// If absent, there are no parameters for this policy and the param CEL variable will not be provided to validation expressions.
@@ -959,6 +3797,223 @@ func (in *ValidatingAdmissionPolicySpec) DeepCopy() *ValidatingAdmissionPolicySp
 	return out
 }
 
+func (in *ValidatingAdmissionPolicySpec) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *ValidatingAdmissionPolicySpec) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *ValidatingAdmissionPolicySpec) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	first := true
+	if in.ParamKind != nil {
+		w.RawString("\"paramKind\":")
+		in.ParamKind.MarshalEasyJSON(w)
+		first = false
+	}
+	if in.MatchConstraints != nil {
+		if !first {
+			w.RawByte(',')
+		}
+		w.RawString("\"matchConstraints\":")
+		in.MatchConstraints.MarshalEasyJSON(w)
+		first = false
+	}
+	if !first {
+		w.RawByte(',')
+	}
+	w.RawString("\"validations\":")
+	if in.Validations == nil {
+		w.RawString("null")
+	} else {
+		w.RawByte('[')
+		for i0 := range in.Validations {
+			if i0 > 0 {
+				w.RawByte(',')
+			}
+			in.Validations[i0].MarshalEasyJSON(w)
+		}
+		w.RawByte(']')
+	}
+	if in.FailurePolicy != "" {
+		w.RawString(",\"failurePolicy\":")
+		w.String(string(in.FailurePolicy))
+	}
+	w.RawString(",\"auditAnnotations\":")
+	if in.AuditAnnotations == nil {
+		w.RawString("null")
+	} else {
+		w.RawByte('[')
+		for i0 := range in.AuditAnnotations {
+			if i0 > 0 {
+				w.RawByte(',')
+			}
+			in.AuditAnnotations[i0].MarshalEasyJSON(w)
+		}
+		w.RawByte(']')
+	}
+	w.RawString(",\"matchConditions\":")
+	if in.MatchConditions == nil {
+		w.RawString("null")
+	} else {
+		w.RawByte('[')
+		for i0 := range in.MatchConditions {
+			if i0 > 0 {
+				w.RawByte(',')
+			}
+			in.MatchConditions[i0].MarshalEasyJSON(w)
+		}
+		w.RawByte(']')
+	}
+	w.RawString(",\"variables\":")
+	if in.Variables == nil {
+		w.RawString("null")
+	} else {
+		w.RawByte('[')
+		for i0 := range in.Variables {
+			if i0 > 0 {
+				w.RawByte(',')
+			}
+			in.Variables[i0].MarshalEasyJSON(w)
+		}
+		w.RawByte(']')
+	}
+	w.RawByte('}')
+}
+
+func (in *ValidatingAdmissionPolicySpec) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "paramKind":
+			if l.IsNull() {
+				l.Skip()
+				in.ParamKind = nil
+			} else {
+				if in.ParamKind == nil {
+					in.ParamKind = new(ParamKind)
+				}
+				in.ParamKind.UnmarshalEasyJSON(l)
+			}
+		case "matchConstraints":
+			if l.IsNull() {
+				l.Skip()
+				in.MatchConstraints = nil
+			} else {
+				if in.MatchConstraints == nil {
+					in.MatchConstraints = new(MatchResources)
+				}
+				in.MatchConstraints.UnmarshalEasyJSON(l)
+			}
+		case "validations":
+			if l.IsNull() {
+				l.Skip()
+				in.Validations = nil
+			} else {
+				in.Validations = make([]Validation, 0)
+				l.Delim('[')
+				for !l.IsDelim(']') {
+					var v0 Validation
+					if l.IsNull() {
+						l.Skip()
+					} else {
+						v0.UnmarshalEasyJSON(l)
+					}
+					in.Validations = append(in.Validations, v0)
+					l.WantComma()
+				}
+				l.Delim(']')
+			}
+		case "failurePolicy":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.FailurePolicy = FailurePolicyType(l.String())
+			}
+		case "auditAnnotations":
+			if l.IsNull() {
+				l.Skip()
+				in.AuditAnnotations = nil
+			} else {
+				in.AuditAnnotations = make([]AuditAnnotation, 0)
+				l.Delim('[')
+				for !l.IsDelim(']') {
+					var v0 AuditAnnotation
+					if l.IsNull() {
+						l.Skip()
+					} else {
+						v0.UnmarshalEasyJSON(l)
+					}
+					in.AuditAnnotations = append(in.AuditAnnotations, v0)
+					l.WantComma()
+				}
+				l.Delim(']')
+			}
+		case "matchConditions":
+			if l.IsNull() {
+				l.Skip()
+				in.MatchConditions = nil
+			} else {
+				in.MatchConditions = make([]MatchCondition, 0)
+				l.Delim('[')
+				for !l.IsDelim(']') {
+					var v0 MatchCondition
+					if l.IsNull() {
+						l.Skip()
+					} else {
+						v0.UnmarshalEasyJSON(l)
+					}
+					in.MatchConditions = append(in.MatchConditions, v0)
+					l.WantComma()
+				}
+				l.Delim(']')
+			}
+		case "variables":
+			if l.IsNull() {
+				l.Skip()
+				in.Variables = nil
+			} else {
+				in.Variables = make([]Variable, 0)
+				l.Delim('[')
+				for !l.IsDelim(']') {
+					var v0 Variable
+					if l.IsNull() {
+						l.Skip()
+					} else {
+						v0.UnmarshalEasyJSON(l)
+					}
+					in.Variables = append(in.Variables, v0)
+					l.WantComma()
+				}
+				l.Delim(']')
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
+
 type ValidatingAdmissionPolicyStatus struct {
 	// observedGeneration is the generation observed by the controller.
 	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
@@ -994,6 +4049,113 @@ func (in *ValidatingAdmissionPolicyStatus) DeepCopy() *ValidatingAdmissionPolicy
 	return out
 }
 
+func (in *ValidatingAdmissionPolicyStatus) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *ValidatingAdmissionPolicyStatus) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *ValidatingAdmissionPolicyStatus) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	first := true
+	if in.ObservedGeneration != 0 {
+		w.RawString("\"observedGeneration\":")
+		w.Int64(in.ObservedGeneration)
+		first = false
+	}
+	if in.TypeChecking != nil {
+		if !first {
+			w.RawByte(',')
+		}
+		w.RawString("\"typeChecking\":")
+		in.TypeChecking.MarshalEasyJSON(w)
+		first = false
+	}
+	if !first {
+		w.RawByte(',')
+	}
+	w.RawString("\"conditions\":")
+	if in.Conditions == nil {
+		w.RawString("null")
+	} else {
+		w.RawByte('[')
+		for i0 := range in.Conditions {
+			if i0 > 0 {
+				w.RawByte(',')
+			}
+			in.Conditions[i0].MarshalEasyJSON(w)
+		}
+		w.RawByte(']')
+	}
+	w.RawByte('}')
+}
+
+func (in *ValidatingAdmissionPolicyStatus) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "observedGeneration":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.ObservedGeneration = l.Int64()
+			}
+		case "typeChecking":
+			if l.IsNull() {
+				l.Skip()
+				in.TypeChecking = nil
+			} else {
+				if in.TypeChecking == nil {
+					in.TypeChecking = new(TypeChecking)
+				}
+				in.TypeChecking.UnmarshalEasyJSON(l)
+			}
+		case "conditions":
+			if l.IsNull() {
+				l.Skip()
+				in.Conditions = nil
+			} else {
+				in.Conditions = make([]metav1.Condition, 0)
+				l.Delim('[')
+				for !l.IsDelim(']') {
+					var v0 metav1.Condition
+					if l.IsNull() {
+						l.Skip()
+					} else {
+						v0.UnmarshalEasyJSON(l)
+					}
+					in.Conditions = append(in.Conditions, v0)
+					l.WantComma()
+				}
+				l.Delim(']')
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
+
 type ValidatingAdmissionPolicyBindingSpec struct {
 	// policyName references a ValidatingAdmissionPolicy name which the ValidatingAdmissionPolicyBinding binds to.
 	// If the referenced resource does not exist, this binding is considered invalid and will be ignored
@@ -1071,6 +4233,131 @@ func (in *ValidatingAdmissionPolicyBindingSpec) DeepCopy() *ValidatingAdmissionP
 	return out
 }
 
+func (in *ValidatingAdmissionPolicyBindingSpec) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *ValidatingAdmissionPolicyBindingSpec) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *ValidatingAdmissionPolicyBindingSpec) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	first := true
+	if in.PolicyName != "" {
+		w.RawString("\"policyName\":")
+		w.String(in.PolicyName)
+		first = false
+	}
+	if in.ParamRef != nil {
+		if !first {
+			w.RawByte(',')
+		}
+		w.RawString("\"paramRef\":")
+		in.ParamRef.MarshalEasyJSON(w)
+		first = false
+	}
+	if in.MatchResources != nil {
+		if !first {
+			w.RawByte(',')
+		}
+		w.RawString("\"matchResources\":")
+		in.MatchResources.MarshalEasyJSON(w)
+		first = false
+	}
+	if !first {
+		w.RawByte(',')
+	}
+	w.RawString("\"validationActions\":")
+	if in.ValidationActions == nil {
+		w.RawString("null")
+	} else {
+		w.RawByte('[')
+		for i0 := range in.ValidationActions {
+			if i0 > 0 {
+				w.RawByte(',')
+			}
+			w.String(string(in.ValidationActions[i0]))
+		}
+		w.RawByte(']')
+	}
+	w.RawByte('}')
+}
+
+func (in *ValidatingAdmissionPolicyBindingSpec) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "policyName":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.PolicyName = l.String()
+			}
+		case "paramRef":
+			if l.IsNull() {
+				l.Skip()
+				in.ParamRef = nil
+			} else {
+				if in.ParamRef == nil {
+					in.ParamRef = new(ParamRef)
+				}
+				in.ParamRef.UnmarshalEasyJSON(l)
+			}
+		case "matchResources":
+			if l.IsNull() {
+				l.Skip()
+				in.MatchResources = nil
+			} else {
+				if in.MatchResources == nil {
+					in.MatchResources = new(MatchResources)
+				}
+				in.MatchResources.UnmarshalEasyJSON(l)
+			}
+		case "validationActions":
+			if l.IsNull() {
+				l.Skip()
+				in.ValidationActions = nil
+			} else {
+				in.ValidationActions = make([]ValidationAction, 0)
+				l.Delim('[')
+				for !l.IsDelim(']') {
+					var v0 ValidationAction
+					if l.IsNull() {
+						l.Skip()
+					} else {
+						v0 = ValidationAction(l.String())
+					}
+					in.ValidationActions = append(in.ValidationActions, v0)
+					l.WantComma()
+				}
+				l.Delim(']')
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
+
 type ValidatingWebhook struct {
 	// name is the name of the admission webhook.
 	// Name should be fully qualified, e.g., imagepolicy.kubernetes.io, where
@@ -1232,6 +4519,229 @@ func (in *ValidatingWebhook) DeepCopy() *ValidatingWebhook {
 	return out
 }
 
+func (in *ValidatingWebhook) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *ValidatingWebhook) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *ValidatingWebhook) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	w.RawString("\"name\":")
+	w.String(in.Name)
+	w.RawString(",\"clientConfig\":")
+	in.ClientConfig.MarshalEasyJSON(w)
+	w.RawString(",\"rules\":")
+	if in.Rules == nil {
+		w.RawString("null")
+	} else {
+		w.RawByte('[')
+		for i0 := range in.Rules {
+			if i0 > 0 {
+				w.RawByte(',')
+			}
+			in.Rules[i0].MarshalEasyJSON(w)
+		}
+		w.RawByte(']')
+	}
+	if in.FailurePolicy != "" {
+		w.RawString(",\"failurePolicy\":")
+		w.String(string(in.FailurePolicy))
+	}
+	if in.MatchPolicy != "" {
+		w.RawString(",\"matchPolicy\":")
+		w.String(string(in.MatchPolicy))
+	}
+	if in.NamespaceSelector != nil {
+		w.RawString(",\"namespaceSelector\":")
+		in.NamespaceSelector.MarshalEasyJSON(w)
+	}
+	if in.ObjectSelector != nil {
+		w.RawString(",\"objectSelector\":")
+		in.ObjectSelector.MarshalEasyJSON(w)
+	}
+	if in.SideEffects != "" {
+		w.RawString(",\"sideEffects\":")
+		w.String(string(in.SideEffects))
+	}
+	if in.TimeoutSeconds != 0 {
+		w.RawString(",\"timeoutSeconds\":")
+		w.Int(in.TimeoutSeconds)
+	}
+	w.RawString(",\"admissionReviewVersions\":")
+	if in.AdmissionReviewVersions == nil {
+		w.RawString("null")
+	} else {
+		w.RawByte('[')
+		for i0 := range in.AdmissionReviewVersions {
+			if i0 > 0 {
+				w.RawByte(',')
+			}
+			w.String(in.AdmissionReviewVersions[i0])
+		}
+		w.RawByte(']')
+	}
+	w.RawString(",\"matchConditions\":")
+	if in.MatchConditions == nil {
+		w.RawString("null")
+	} else {
+		w.RawByte('[')
+		for i0 := range in.MatchConditions {
+			if i0 > 0 {
+				w.RawByte(',')
+			}
+			in.MatchConditions[i0].MarshalEasyJSON(w)
+		}
+		w.RawByte(']')
+	}
+
+	w.RawByte('}')
+}
+
+func (in *ValidatingWebhook) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "name":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.Name = l.String()
+			}
+		case "clientConfig":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.ClientConfig.UnmarshalEasyJSON(l)
+			}
+		case "rules":
+			if l.IsNull() {
+				l.Skip()
+				in.Rules = nil
+			} else {
+				in.Rules = make([]RuleWithOperations, 0)
+				l.Delim('[')
+				for !l.IsDelim(']') {
+					var v0 RuleWithOperations
+					if l.IsNull() {
+						l.Skip()
+					} else {
+						v0.UnmarshalEasyJSON(l)
+					}
+					in.Rules = append(in.Rules, v0)
+					l.WantComma()
+				}
+				l.Delim(']')
+			}
+		case "failurePolicy":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.FailurePolicy = FailurePolicyType(l.String())
+			}
+		case "matchPolicy":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.MatchPolicy = MatchPolicyType(l.String())
+			}
+		case "namespaceSelector":
+			if l.IsNull() {
+				l.Skip()
+				in.NamespaceSelector = nil
+			} else {
+				if in.NamespaceSelector == nil {
+					in.NamespaceSelector = new(metav1.LabelSelector)
+				}
+				in.NamespaceSelector.UnmarshalEasyJSON(l)
+			}
+		case "objectSelector":
+			if l.IsNull() {
+				l.Skip()
+				in.ObjectSelector = nil
+			} else {
+				if in.ObjectSelector == nil {
+					in.ObjectSelector = new(metav1.LabelSelector)
+				}
+				in.ObjectSelector.UnmarshalEasyJSON(l)
+			}
+		case "sideEffects":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.SideEffects = SideEffectClass(l.String())
+			}
+		case "timeoutSeconds":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.TimeoutSeconds = l.Int()
+			}
+		case "admissionReviewVersions":
+			if l.IsNull() {
+				l.Skip()
+				in.AdmissionReviewVersions = nil
+			} else {
+				in.AdmissionReviewVersions = make([]string, 0)
+				l.Delim('[')
+				for !l.IsDelim(']') {
+					var v0 string
+					if l.IsNull() {
+						l.Skip()
+					} else {
+						v0 = l.String()
+					}
+					in.AdmissionReviewVersions = append(in.AdmissionReviewVersions, v0)
+					l.WantComma()
+				}
+				l.Delim(']')
+			}
+		case "matchConditions":
+			if l.IsNull() {
+				l.Skip()
+				in.MatchConditions = nil
+			} else {
+				in.MatchConditions = make([]MatchCondition, 0)
+				l.Delim('[')
+				for !l.IsDelim(']') {
+					var v0 MatchCondition
+					if l.IsNull() {
+						l.Skip()
+					} else {
+						v0.UnmarshalEasyJSON(l)
+					}
+					in.MatchConditions = append(in.MatchConditions, v0)
+					l.WantComma()
+				}
+				l.Delim(']')
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
+
 type ParamKind struct {
 	// apiVersion is the API group version the resources belong to.
 	// In format of "group/version".
@@ -1255,6 +4765,74 @@ func (in *ParamKind) DeepCopy() *ParamKind {
 	return out
 }
 
+func (in *ParamKind) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *ParamKind) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *ParamKind) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	first := true
+	if in.APIVersion != "" {
+		w.RawString("\"apiVersion\":")
+		w.String(in.APIVersion)
+		first = false
+	}
+	if in.Kind != "" {
+		if !first {
+			w.RawByte(',')
+		}
+		w.RawString("\"kind\":")
+		w.String(in.Kind)
+		first = false
+	}
+	w.RawByte('}')
+}
+
+func (in *ParamKind) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "apiVersion":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.APIVersion = l.String()
+			}
+		case "kind":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.Kind = l.String()
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
+
 type MatchResources struct {
 	// namespaceSelector decides whether to run the admission control policy on an object based
 	// on whether the namespace for that object matches the selector. If the
@@ -1365,6 +4943,159 @@ func (in *MatchResources) DeepCopy() *MatchResources {
 	return out
 }
 
+func (in *MatchResources) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *MatchResources) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *MatchResources) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	first := true
+	if in.NamespaceSelector != nil {
+		w.RawString("\"namespaceSelector\":")
+		in.NamespaceSelector.MarshalEasyJSON(w)
+		first = false
+	}
+	if in.ObjectSelector != nil {
+		if !first {
+			w.RawByte(',')
+		}
+		w.RawString("\"objectSelector\":")
+		in.ObjectSelector.MarshalEasyJSON(w)
+		first = false
+	}
+	if !first {
+		w.RawByte(',')
+	}
+	w.RawString("\"resourceRules\":")
+	if in.ResourceRules == nil {
+		w.RawString("null")
+	} else {
+		w.RawByte('[')
+		for i0 := range in.ResourceRules {
+			if i0 > 0 {
+				w.RawByte(',')
+			}
+			in.ResourceRules[i0].MarshalEasyJSON(w)
+		}
+		w.RawByte(']')
+	}
+	w.RawString(",\"excludeResourceRules\":")
+	if in.ExcludeResourceRules == nil {
+		w.RawString("null")
+	} else {
+		w.RawByte('[')
+		for i0 := range in.ExcludeResourceRules {
+			if i0 > 0 {
+				w.RawByte(',')
+			}
+			in.ExcludeResourceRules[i0].MarshalEasyJSON(w)
+		}
+		w.RawByte(']')
+	}
+	if in.MatchPolicy != "" {
+		w.RawString(",\"matchPolicy\":")
+		w.String(string(in.MatchPolicy))
+	}
+	w.RawByte('}')
+}
+
+func (in *MatchResources) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "namespaceSelector":
+			if l.IsNull() {
+				l.Skip()
+				in.NamespaceSelector = nil
+			} else {
+				if in.NamespaceSelector == nil {
+					in.NamespaceSelector = new(metav1.LabelSelector)
+				}
+				in.NamespaceSelector.UnmarshalEasyJSON(l)
+			}
+		case "objectSelector":
+			if l.IsNull() {
+				l.Skip()
+				in.ObjectSelector = nil
+			} else {
+				if in.ObjectSelector == nil {
+					in.ObjectSelector = new(metav1.LabelSelector)
+				}
+				in.ObjectSelector.UnmarshalEasyJSON(l)
+			}
+		case "resourceRules":
+			if l.IsNull() {
+				l.Skip()
+				in.ResourceRules = nil
+			} else {
+				in.ResourceRules = make([]NamedRuleWithOperations, 0)
+				l.Delim('[')
+				for !l.IsDelim(']') {
+					var v0 NamedRuleWithOperations
+					if l.IsNull() {
+						l.Skip()
+					} else {
+						v0.UnmarshalEasyJSON(l)
+					}
+					in.ResourceRules = append(in.ResourceRules, v0)
+					l.WantComma()
+				}
+				l.Delim(']')
+			}
+		case "excludeResourceRules":
+			if l.IsNull() {
+				l.Skip()
+				in.ExcludeResourceRules = nil
+			} else {
+				in.ExcludeResourceRules = make([]NamedRuleWithOperations, 0)
+				l.Delim('[')
+				for !l.IsDelim(']') {
+					var v0 NamedRuleWithOperations
+					if l.IsNull() {
+						l.Skip()
+					} else {
+						v0.UnmarshalEasyJSON(l)
+					}
+					in.ExcludeResourceRules = append(in.ExcludeResourceRules, v0)
+					l.WantComma()
+				}
+				l.Delim(']')
+			}
+		case "matchPolicy":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.MatchPolicy = MatchPolicyType(l.String())
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
+
 type Variable struct {
 	// name is the name of the variable. The name must be a valid CEL identifier and unique among all variables.
 	// The variable can be accessed in other expressions through `variables`
@@ -1383,9 +5114,68 @@ func (in *Variable) DeepCopy() *Variable {
 	if in == nil {
 		return nil
 	}
-	out := new(Variable)
-	in.DeepCopyInto(out)
-	return out
+	out := new(Variable)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *Variable) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *Variable) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *Variable) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	w.RawString("\"name\":")
+	w.String(in.Name)
+	w.RawString(",\"expression\":")
+	w.String(in.Expression)
+
+	w.RawByte('}')
+}
+
+func (in *Variable) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "name":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.Name = l.String()
+			}
+		case "expression":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.Expression = l.String()
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
 }
 
 type Mutation struct {
@@ -1426,6 +5216,85 @@ func (in *Mutation) DeepCopy() *Mutation {
 	return out
 }
 
+func (in *Mutation) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *Mutation) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *Mutation) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	w.RawString("\"patchType\":")
+	w.String(string(in.PatchType))
+	if in.ApplyConfiguration != nil {
+		w.RawString(",\"applyConfiguration\":")
+		in.ApplyConfiguration.MarshalEasyJSON(w)
+	}
+	if in.JSONPatch != nil {
+		w.RawString(",\"jsonPatch\":")
+		in.JSONPatch.MarshalEasyJSON(w)
+	}
+
+	w.RawByte('}')
+}
+
+func (in *Mutation) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "patchType":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.PatchType = PatchType(l.String())
+			}
+		case "applyConfiguration":
+			if l.IsNull() {
+				l.Skip()
+				in.ApplyConfiguration = nil
+			} else {
+				if in.ApplyConfiguration == nil {
+					in.ApplyConfiguration = new(ApplyConfiguration)
+				}
+				in.ApplyConfiguration.UnmarshalEasyJSON(l)
+			}
+		case "jsonPatch":
+			if l.IsNull() {
+				l.Skip()
+				in.JSONPatch = nil
+			} else {
+				if in.JSONPatch == nil {
+					in.JSONPatch = new(JSONPatch)
+				}
+				in.JSONPatch.UnmarshalEasyJSON(l)
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
+
 type MatchCondition struct {
 	// name is an identifier for this match condition, used for strategic merging of MatchConditions,
 	// as well as providing an identifier for logging purposes. A good name should be descriptive of
@@ -1463,6 +5332,65 @@ func (in *MatchCondition) DeepCopy() *MatchCondition {
 	return out
 }
 
+func (in *MatchCondition) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *MatchCondition) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *MatchCondition) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	w.RawString("\"name\":")
+	w.String(in.Name)
+	w.RawString(",\"expression\":")
+	w.String(in.Expression)
+
+	w.RawByte('}')
+}
+
+func (in *MatchCondition) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "name":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.Name = l.String()
+			}
+		case "expression":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.Expression = l.String()
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
+
 type ParamRef struct {
 	// name is the name of the resource being referenced.
 	// One of `name` or `selector` must be set, but `name` and `selector` are
@@ -1519,6 +5447,106 @@ func (in *ParamRef) DeepCopy() *ParamRef {
 	return out
 }
 
+func (in *ParamRef) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *ParamRef) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *ParamRef) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	first := true
+	if in.Name != "" {
+		w.RawString("\"name\":")
+		w.String(in.Name)
+		first = false
+	}
+	if in.Namespace != "" {
+		if !first {
+			w.RawByte(',')
+		}
+		w.RawString("\"namespace\":")
+		w.String(in.Namespace)
+		first = false
+	}
+	if in.Selector != nil {
+		if !first {
+			w.RawByte(',')
+		}
+		w.RawString("\"selector\":")
+		in.Selector.MarshalEasyJSON(w)
+		first = false
+	}
+	if in.ParameterNotFoundAction != "" {
+		if !first {
+			w.RawByte(',')
+		}
+		w.RawString("\"parameterNotFoundAction\":")
+		w.String(string(in.ParameterNotFoundAction))
+		first = false
+	}
+	w.RawByte('}')
+}
+
+func (in *ParamRef) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "name":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.Name = l.String()
+			}
+		case "namespace":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.Namespace = l.String()
+			}
+		case "selector":
+			if l.IsNull() {
+				l.Skip()
+				in.Selector = nil
+			} else {
+				if in.Selector == nil {
+					in.Selector = new(metav1.LabelSelector)
+				}
+				in.Selector.UnmarshalEasyJSON(l)
+			}
+		case "parameterNotFoundAction":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.ParameterNotFoundAction = ParameterNotFoundActionType(l.String())
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
+
 type WebhookClientConfig struct {
 	// url gives the location of the webhook, in standard URL form
 	// (`scheme://host:port/path`). Exactly one of `url` or `service`
@@ -1568,6 +5596,93 @@ func (in *WebhookClientConfig) DeepCopy() *WebhookClientConfig {
 	return out
 }
 
+func (in *WebhookClientConfig) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *WebhookClientConfig) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *WebhookClientConfig) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	first := true
+	if in.URL != "" {
+		w.RawString("\"url\":")
+		w.String(in.URL)
+		first = false
+	}
+	if in.Service != nil {
+		if !first {
+			w.RawByte(',')
+		}
+		w.RawString("\"service\":")
+		in.Service.MarshalEasyJSON(w)
+		first = false
+	}
+	if len(in.CABundle) != 0 {
+		if !first {
+			w.RawByte(',')
+		}
+		w.RawString("\"caBundle\":")
+		w.Base64Bytes(in.CABundle)
+		first = false
+	}
+	w.RawByte('}')
+}
+
+func (in *WebhookClientConfig) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "url":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.URL = l.String()
+			}
+		case "service":
+			if l.IsNull() {
+				l.Skip()
+				in.Service = nil
+			} else {
+				if in.Service == nil {
+					in.Service = new(ServiceReference)
+				}
+				in.Service.UnmarshalEasyJSON(l)
+			}
+		case "caBundle":
+			if l.IsNull() {
+				l.Skip()
+				in.CABundle = nil
+			} else {
+				in.CABundle = l.Bytes()
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
+
 type RuleWithOperations struct {
 	// operations is the operations the admission hook cares about - CREATE, UPDATE, DELETE, CONNECT or *
 	// for all of those operations and any future admission operations that are added.
@@ -1598,6 +5713,187 @@ func (in *RuleWithOperations) DeepCopy() *RuleWithOperations {
 	return out
 }
 
+func (in *RuleWithOperations) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *RuleWithOperations) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *RuleWithOperations) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	w.RawString("\"operations\":")
+	if in.Operations == nil {
+		w.RawString("null")
+	} else {
+		w.RawByte('[')
+		for i0 := range in.Operations {
+			if i0 > 0 {
+				w.RawByte(',')
+			}
+			w.String(string(in.Operations[i0]))
+		}
+		w.RawByte(']')
+	}
+	w.RawString(",\"apiGroups\":")
+	if in.Rule.APIGroups == nil {
+		w.RawString("null")
+	} else {
+		w.RawByte('[')
+		for i0 := range in.Rule.APIGroups {
+			if i0 > 0 {
+				w.RawByte(',')
+			}
+			w.String(in.Rule.APIGroups[i0])
+		}
+		w.RawByte(']')
+	}
+	w.RawString(",\"apiVersions\":")
+	if in.Rule.APIVersions == nil {
+		w.RawString("null")
+	} else {
+		w.RawByte('[')
+		for i0 := range in.Rule.APIVersions {
+			if i0 > 0 {
+				w.RawByte(',')
+			}
+			w.String(in.Rule.APIVersions[i0])
+		}
+		w.RawByte(']')
+	}
+	w.RawString(",\"resources\":")
+	if in.Rule.Resources == nil {
+		w.RawString("null")
+	} else {
+		w.RawByte('[')
+		for i0 := range in.Rule.Resources {
+			if i0 > 0 {
+				w.RawByte(',')
+			}
+			w.String(in.Rule.Resources[i0])
+		}
+		w.RawByte(']')
+	}
+	if in.Rule.Scope != "" {
+		w.RawString(",\"scope\":")
+		w.String(string(in.Rule.Scope))
+	}
+
+	w.RawByte('}')
+}
+
+func (in *RuleWithOperations) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "operations":
+			if l.IsNull() {
+				l.Skip()
+				in.Operations = nil
+			} else {
+				in.Operations = make([]OperationType, 0)
+				l.Delim('[')
+				for !l.IsDelim(']') {
+					var v0 OperationType
+					if l.IsNull() {
+						l.Skip()
+					} else {
+						v0 = OperationType(l.String())
+					}
+					in.Operations = append(in.Operations, v0)
+					l.WantComma()
+				}
+				l.Delim(']')
+			}
+		case "apiGroups":
+			if l.IsNull() {
+				l.Skip()
+				in.Rule.APIGroups = nil
+			} else {
+				in.Rule.APIGroups = make([]string, 0)
+				l.Delim('[')
+				for !l.IsDelim(']') {
+					var v0 string
+					if l.IsNull() {
+						l.Skip()
+					} else {
+						v0 = l.String()
+					}
+					in.Rule.APIGroups = append(in.Rule.APIGroups, v0)
+					l.WantComma()
+				}
+				l.Delim(']')
+			}
+		case "apiVersions":
+			if l.IsNull() {
+				l.Skip()
+				in.Rule.APIVersions = nil
+			} else {
+				in.Rule.APIVersions = make([]string, 0)
+				l.Delim('[')
+				for !l.IsDelim(']') {
+					var v0 string
+					if l.IsNull() {
+						l.Skip()
+					} else {
+						v0 = l.String()
+					}
+					in.Rule.APIVersions = append(in.Rule.APIVersions, v0)
+					l.WantComma()
+				}
+				l.Delim(']')
+			}
+		case "resources":
+			if l.IsNull() {
+				l.Skip()
+				in.Rule.Resources = nil
+			} else {
+				in.Rule.Resources = make([]string, 0)
+				l.Delim('[')
+				for !l.IsDelim(']') {
+					var v0 string
+					if l.IsNull() {
+						l.Skip()
+					} else {
+						v0 = l.String()
+					}
+					in.Rule.Resources = append(in.Rule.Resources, v0)
+					l.WantComma()
+				}
+				l.Delim(']')
+			}
+		case "scope":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.Rule.Scope = ScopeType(l.String())
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
+
 type Validation struct {
 	// expression represents the expression which will be evaluated by CEL.
 	// ref: https://github.com/google/cel-spec
@@ -1678,6 +5974,87 @@ func (in *Validation) DeepCopy() *Validation {
 	return out
 }
 
+func (in *Validation) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *Validation) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *Validation) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	w.RawString("\"expression\":")
+	w.String(in.Expression)
+	if in.Message != "" {
+		w.RawString(",\"message\":")
+		w.String(in.Message)
+	}
+	if in.Reason != "" {
+		w.RawString(",\"reason\":")
+		w.String(string(in.Reason))
+	}
+	if in.MessageExpression != "" {
+		w.RawString(",\"messageExpression\":")
+		w.String(in.MessageExpression)
+	}
+
+	w.RawByte('}')
+}
+
+func (in *Validation) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "expression":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.Expression = l.String()
+			}
+		case "message":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.Message = l.String()
+			}
+		case "reason":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.Reason = metav1.StatusReason(l.String())
+			}
+		case "messageExpression":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.MessageExpression = l.String()
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
+
 type AuditAnnotation struct {
 	// key specifies the audit annotation key. The audit annotation keys of
 	// a ValidatingAdmissionPolicy must be unique. The key must be a qualified
@@ -1721,6 +6098,65 @@ func (in *AuditAnnotation) DeepCopy() *AuditAnnotation {
 	return out
 }
 
+func (in *AuditAnnotation) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *AuditAnnotation) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *AuditAnnotation) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	w.RawString("\"key\":")
+	w.String(in.Key)
+	w.RawString(",\"valueExpression\":")
+	w.String(in.ValueExpression)
+
+	w.RawByte('}')
+}
+
+func (in *AuditAnnotation) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "key":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.Key = l.String()
+			}
+		case "valueExpression":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.ValueExpression = l.String()
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
+
 type TypeChecking struct {
 	// expressionWarnings contains the type checking warnings for each expression.
 	ExpressionWarnings []ExpressionWarning `json:"expressionWarnings"`
@@ -1746,6 +6182,81 @@ func (in *TypeChecking) DeepCopy() *TypeChecking {
 	return out
 }
 
+func (in *TypeChecking) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *TypeChecking) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *TypeChecking) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	w.RawString("\"expressionWarnings\":")
+	if in.ExpressionWarnings == nil {
+		w.RawString("null")
+	} else {
+		w.RawByte('[')
+		for i0 := range in.ExpressionWarnings {
+			if i0 > 0 {
+				w.RawByte(',')
+			}
+			in.ExpressionWarnings[i0].MarshalEasyJSON(w)
+		}
+		w.RawByte(']')
+	}
+
+	w.RawByte('}')
+}
+
+func (in *TypeChecking) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "expressionWarnings":
+			if l.IsNull() {
+				l.Skip()
+				in.ExpressionWarnings = nil
+			} else {
+				in.ExpressionWarnings = make([]ExpressionWarning, 0)
+				l.Delim('[')
+				for !l.IsDelim(']') {
+					var v0 ExpressionWarning
+					if l.IsNull() {
+						l.Skip()
+					} else {
+						v0.UnmarshalEasyJSON(l)
+					}
+					in.ExpressionWarnings = append(in.ExpressionWarnings, v0)
+					l.WantComma()
+				}
+				l.Delim(']')
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
+
 type NamedRuleWithOperations struct {
 	// resourceNames is an optional white list of names that the rule applies to.  An empty set means that everything is allowed.
 	ResourceNames []string `json:"resourceNames"`
@@ -1772,6 +6283,219 @@ func (in *NamedRuleWithOperations) DeepCopy() *NamedRuleWithOperations {
 	return out
 }
 
+func (in *NamedRuleWithOperations) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *NamedRuleWithOperations) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *NamedRuleWithOperations) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	w.RawString("\"resourceNames\":")
+	if in.ResourceNames == nil {
+		w.RawString("null")
+	} else {
+		w.RawByte('[')
+		for i0 := range in.ResourceNames {
+			if i0 > 0 {
+				w.RawByte(',')
+			}
+			w.String(in.ResourceNames[i0])
+		}
+		w.RawByte(']')
+	}
+	w.RawString(",\"operations\":")
+	if in.RuleWithOperations.Operations == nil {
+		w.RawString("null")
+	} else {
+		w.RawByte('[')
+		for i0 := range in.RuleWithOperations.Operations {
+			if i0 > 0 {
+				w.RawByte(',')
+			}
+			w.String(string(in.RuleWithOperations.Operations[i0]))
+		}
+		w.RawByte(']')
+	}
+	w.RawString(",\"apiGroups\":")
+	if in.RuleWithOperations.Rule.APIGroups == nil {
+		w.RawString("null")
+	} else {
+		w.RawByte('[')
+		for i0 := range in.RuleWithOperations.Rule.APIGroups {
+			if i0 > 0 {
+				w.RawByte(',')
+			}
+			w.String(in.RuleWithOperations.Rule.APIGroups[i0])
+		}
+		w.RawByte(']')
+	}
+	w.RawString(",\"apiVersions\":")
+	if in.RuleWithOperations.Rule.APIVersions == nil {
+		w.RawString("null")
+	} else {
+		w.RawByte('[')
+		for i0 := range in.RuleWithOperations.Rule.APIVersions {
+			if i0 > 0 {
+				w.RawByte(',')
+			}
+			w.String(in.RuleWithOperations.Rule.APIVersions[i0])
+		}
+		w.RawByte(']')
+	}
+	w.RawString(",\"resources\":")
+	if in.RuleWithOperations.Rule.Resources == nil {
+		w.RawString("null")
+	} else {
+		w.RawByte('[')
+		for i0 := range in.RuleWithOperations.Rule.Resources {
+			if i0 > 0 {
+				w.RawByte(',')
+			}
+			w.String(in.RuleWithOperations.Rule.Resources[i0])
+		}
+		w.RawByte(']')
+	}
+	if in.RuleWithOperations.Rule.Scope != "" {
+		w.RawString(",\"scope\":")
+		w.String(string(in.RuleWithOperations.Rule.Scope))
+	}
+
+	w.RawByte('}')
+}
+
+func (in *NamedRuleWithOperations) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "resourceNames":
+			if l.IsNull() {
+				l.Skip()
+				in.ResourceNames = nil
+			} else {
+				in.ResourceNames = make([]string, 0)
+				l.Delim('[')
+				for !l.IsDelim(']') {
+					var v0 string
+					if l.IsNull() {
+						l.Skip()
+					} else {
+						v0 = l.String()
+					}
+					in.ResourceNames = append(in.ResourceNames, v0)
+					l.WantComma()
+				}
+				l.Delim(']')
+			}
+		case "operations":
+			if l.IsNull() {
+				l.Skip()
+				in.RuleWithOperations.Operations = nil
+			} else {
+				in.RuleWithOperations.Operations = make([]OperationType, 0)
+				l.Delim('[')
+				for !l.IsDelim(']') {
+					var v0 OperationType
+					if l.IsNull() {
+						l.Skip()
+					} else {
+						v0 = OperationType(l.String())
+					}
+					in.RuleWithOperations.Operations = append(in.RuleWithOperations.Operations, v0)
+					l.WantComma()
+				}
+				l.Delim(']')
+			}
+		case "apiGroups":
+			if l.IsNull() {
+				l.Skip()
+				in.RuleWithOperations.Rule.APIGroups = nil
+			} else {
+				in.RuleWithOperations.Rule.APIGroups = make([]string, 0)
+				l.Delim('[')
+				for !l.IsDelim(']') {
+					var v0 string
+					if l.IsNull() {
+						l.Skip()
+					} else {
+						v0 = l.String()
+					}
+					in.RuleWithOperations.Rule.APIGroups = append(in.RuleWithOperations.Rule.APIGroups, v0)
+					l.WantComma()
+				}
+				l.Delim(']')
+			}
+		case "apiVersions":
+			if l.IsNull() {
+				l.Skip()
+				in.RuleWithOperations.Rule.APIVersions = nil
+			} else {
+				in.RuleWithOperations.Rule.APIVersions = make([]string, 0)
+				l.Delim('[')
+				for !l.IsDelim(']') {
+					var v0 string
+					if l.IsNull() {
+						l.Skip()
+					} else {
+						v0 = l.String()
+					}
+					in.RuleWithOperations.Rule.APIVersions = append(in.RuleWithOperations.Rule.APIVersions, v0)
+					l.WantComma()
+				}
+				l.Delim(']')
+			}
+		case "resources":
+			if l.IsNull() {
+				l.Skip()
+				in.RuleWithOperations.Rule.Resources = nil
+			} else {
+				in.RuleWithOperations.Rule.Resources = make([]string, 0)
+				l.Delim('[')
+				for !l.IsDelim(']') {
+					var v0 string
+					if l.IsNull() {
+						l.Skip()
+					} else {
+						v0 = l.String()
+					}
+					in.RuleWithOperations.Rule.Resources = append(in.RuleWithOperations.Rule.Resources, v0)
+					l.WantComma()
+				}
+				l.Delim(']')
+			}
+		case "scope":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.RuleWithOperations.Rule.Scope = ScopeType(l.String())
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
+
 type ApplyConfiguration struct {
 	// expression will be evaluated by CEL to create an apply configuration.
 	// ref: https://github.com/google/cel-spec
@@ -1820,6 +6544,59 @@ func (in *ApplyConfiguration) DeepCopy() *ApplyConfiguration {
 	return out
 }
 
+func (in *ApplyConfiguration) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *ApplyConfiguration) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *ApplyConfiguration) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	if in.Expression != "" {
+		w.RawString("\"expression\":")
+		w.String(in.Expression)
+	}
+
+	w.RawByte('}')
+}
+
+func (in *ApplyConfiguration) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "expression":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.Expression = l.String()
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
+
 type JSONPatch struct {
 	// expression will be evaluated by CEL to create a [JSON patch](https://jsonpatch.com/).
 	// ref: https://github.com/google/cel-spec
@@ -1887,6 +6664,59 @@ func (in *JSONPatch) DeepCopy() *JSONPatch {
 	return out
 }
 
+func (in *JSONPatch) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *JSONPatch) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *JSONPatch) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	if in.Expression != "" {
+		w.RawString("\"expression\":")
+		w.String(in.Expression)
+	}
+
+	w.RawByte('}')
+}
+
+func (in *JSONPatch) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "expression":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.Expression = l.String()
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
+
 type ServiceReference struct {
 	// namespace is the namespace of the service.
 	// Required
@@ -1916,6 +6746,85 @@ func (in *ServiceReference) DeepCopy() *ServiceReference {
 	return out
 }
 
+func (in *ServiceReference) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *ServiceReference) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *ServiceReference) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	w.RawString("\"namespace\":")
+	w.String(in.Namespace)
+	w.RawString(",\"name\":")
+	w.String(in.Name)
+	if in.Path != "" {
+		w.RawString(",\"path\":")
+		w.String(in.Path)
+	}
+	if in.Port != 0 {
+		w.RawString(",\"port\":")
+		w.Int(in.Port)
+	}
+
+	w.RawByte('}')
+}
+
+func (in *ServiceReference) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "namespace":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.Namespace = l.String()
+			}
+		case "name":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.Name = l.String()
+			}
+		case "path":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.Path = l.String()
+			}
+		case "port":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.Port = l.Int()
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
+
 type Rule struct {
 	// apiGroups is the API groups the resources belong to. '*' is all groups.
 	// If '*' is present, the length of the slice must be one.
@@ -1977,6 +6886,155 @@ func (in *Rule) DeepCopy() *Rule {
 	return out
 }
 
+func (in *Rule) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *Rule) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *Rule) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	w.RawString("\"apiGroups\":")
+	if in.APIGroups == nil {
+		w.RawString("null")
+	} else {
+		w.RawByte('[')
+		for i0 := range in.APIGroups {
+			if i0 > 0 {
+				w.RawByte(',')
+			}
+			w.String(in.APIGroups[i0])
+		}
+		w.RawByte(']')
+	}
+	w.RawString(",\"apiVersions\":")
+	if in.APIVersions == nil {
+		w.RawString("null")
+	} else {
+		w.RawByte('[')
+		for i0 := range in.APIVersions {
+			if i0 > 0 {
+				w.RawByte(',')
+			}
+			w.String(in.APIVersions[i0])
+		}
+		w.RawByte(']')
+	}
+	w.RawString(",\"resources\":")
+	if in.Resources == nil {
+		w.RawString("null")
+	} else {
+		w.RawByte('[')
+		for i0 := range in.Resources {
+			if i0 > 0 {
+				w.RawByte(',')
+			}
+			w.String(in.Resources[i0])
+		}
+		w.RawByte(']')
+	}
+	if in.Scope != "" {
+		w.RawString(",\"scope\":")
+		w.String(string(in.Scope))
+	}
+
+	w.RawByte('}')
+}
+
+func (in *Rule) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "apiGroups":
+			if l.IsNull() {
+				l.Skip()
+				in.APIGroups = nil
+			} else {
+				in.APIGroups = make([]string, 0)
+				l.Delim('[')
+				for !l.IsDelim(']') {
+					var v0 string
+					if l.IsNull() {
+						l.Skip()
+					} else {
+						v0 = l.String()
+					}
+					in.APIGroups = append(in.APIGroups, v0)
+					l.WantComma()
+				}
+				l.Delim(']')
+			}
+		case "apiVersions":
+			if l.IsNull() {
+				l.Skip()
+				in.APIVersions = nil
+			} else {
+				in.APIVersions = make([]string, 0)
+				l.Delim('[')
+				for !l.IsDelim(']') {
+					var v0 string
+					if l.IsNull() {
+						l.Skip()
+					} else {
+						v0 = l.String()
+					}
+					in.APIVersions = append(in.APIVersions, v0)
+					l.WantComma()
+				}
+				l.Delim(']')
+			}
+		case "resources":
+			if l.IsNull() {
+				l.Skip()
+				in.Resources = nil
+			} else {
+				in.Resources = make([]string, 0)
+				l.Delim('[')
+				for !l.IsDelim(']') {
+					var v0 string
+					if l.IsNull() {
+						l.Skip()
+					} else {
+						v0 = l.String()
+					}
+					in.Resources = append(in.Resources, v0)
+					l.WantComma()
+				}
+				l.Delim(']')
+			}
+		case "scope":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.Scope = ScopeType(l.String())
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
+
 type ExpressionWarning struct {
 	// fieldRef is the path to the field that refers to the expression.
 	// For example, the reference to the expression of the first item of
@@ -2000,3 +7058,62 @@ func (in *ExpressionWarning) DeepCopy() *ExpressionWarning {
 	in.DeepCopyInto(out)
 	return out
 }
+
+func (in *ExpressionWarning) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *ExpressionWarning) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *ExpressionWarning) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	w.RawString("\"fieldRef\":")
+	w.String(in.FieldRef)
+	w.RawString(",\"warning\":")
+	w.String(in.Warning)
+
+	w.RawByte('}')
+}
+
+func (in *ExpressionWarning) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "fieldRef":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.FieldRef = l.String()
+			}
+		case "warning":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.Warning = l.String()
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
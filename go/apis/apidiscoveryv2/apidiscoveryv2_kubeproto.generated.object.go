@@ -0,0 +1,980 @@
+package apidiscoveryv2
+
+import (
+	"github.com/mailru/easyjson/jlexer"
+	"github.com/mailru/easyjson/jwriter"
+	"go.f110.dev/kubeproto/go/apis/metav1"
+	"go.f110.dev/kubeproto/go/patch"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+const GroupName = "apidiscovery.k8s.io"
+
+var (
+	GroupVersion       = metav1.GroupVersion{Group: GroupName, Version: "v2"}
+	SchemeBuilder      = runtime.NewSchemeBuilder(addKnownTypes)
+	AddToScheme        = SchemeBuilder.AddToScheme
+	SchemaGroupVersion = schema.GroupVersion{Group: GroupName, Version: "v2"}
+)
+
+func addKnownTypes(scheme *runtime.Scheme) error {
+	scheme.AddKnownTypes(SchemaGroupVersion,
+		&APIGroupDiscovery{},
+		&APIGroupDiscoveryList{},
+	)
+	metav1.AddToGroupVersion(scheme, SchemaGroupVersion)
+	return nil
+}
+
+type DiscoveryFreshness string
+
+const (
+	DiscoveryFreshnessCurrent DiscoveryFreshness = "Current"
+	DiscoveryFreshnessStale   DiscoveryFreshness = "Stale"
+)
+
+type ResourceScope string
+
+const (
+	ResourceScopeCluster    ResourceScope = "Cluster"
+	ResourceScopeNamespaced ResourceScope = "Namespaced"
+)
+
+type APIGroupDiscovery struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata"`
+	// versions are the versions supported in this group. They are sorted in descending order of preference,
+	// with the preferred version being the first entry.
+	Versions []APIVersionDiscovery `json:"versions"`
+}
+
+func (in *APIGroupDiscovery) DeepCopyInto(out *APIGroupDiscovery) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	if in.Versions != nil {
+		l := make([]APIVersionDiscovery, len(in.Versions))
+		for i := range in.Versions {
+			in.Versions[i].DeepCopyInto(&l[i])
+		}
+		out.Versions = l
+	}
+}
+
+func (in *APIGroupDiscovery) DeepCopy() *APIGroupDiscovery {
+	if in == nil {
+		return nil
+	}
+	out := new(APIGroupDiscovery)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *APIGroupDiscovery) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// APIGroupDiscoveryToSelectableFields returns the fields of APIGroupDiscovery which can be used by the field selector.
+func APIGroupDiscoveryToSelectableFields(obj *APIGroupDiscovery) fields.Set {
+	set := fields.Set{
+		"metadata.name":      obj.ObjectMeta.Name,
+		"metadata.namespace": obj.ObjectMeta.Namespace,
+	}
+	return set
+}
+
+// APIGroupDiscoveryPatch builds JSON patch or JSON merge patch of APIGroupDiscovery.
+// JSON patch fails if the parent of the field doesn't exist (e.g. SetLabel to the object which has no label). MergePatch creates the parent.
+type APIGroupDiscoveryPatch struct {
+	*patch.Builder
+}
+
+func NewAPIGroupDiscoveryPatch() *APIGroupDiscoveryPatch {
+	return &APIGroupDiscoveryPatch{Builder: patch.NewBuilder()}
+}
+
+func (p *APIGroupDiscoveryPatch) SetLabel(key, value string) *APIGroupDiscoveryPatch {
+	p.Set([]string{"metadata", "labels", key}, value)
+	return p
+}
+
+func (p *APIGroupDiscoveryPatch) RemoveLabel(key string) *APIGroupDiscoveryPatch {
+	p.Remove([]string{"metadata", "labels", key})
+	return p
+}
+
+func (p *APIGroupDiscoveryPatch) SetAnnotation(key, value string) *APIGroupDiscoveryPatch {
+	p.Set([]string{"metadata", "annotations", key}, value)
+	return p
+}
+
+func (p *APIGroupDiscoveryPatch) RemoveAnnotation(key string) *APIGroupDiscoveryPatch {
+	p.Remove([]string{"metadata", "annotations", key})
+	return p
+}
+
+func (p *APIGroupDiscoveryPatch) SetVersions(v []APIVersionDiscovery) *APIGroupDiscoveryPatch {
+	p.Set([]string{"versions"}, v)
+	return p
+}
+
+func (p *APIGroupDiscoveryPatch) RemoveVersions() *APIGroupDiscoveryPatch {
+	p.Remove([]string{"versions"})
+	return p
+}
+
+func (p *APIGroupDiscoveryPatch) AddVersions(v ...APIVersionDiscovery) *APIGroupDiscoveryPatch {
+	for _, e := range v {
+		p.Append([]string{"versions"}, e)
+	}
+	return p
+}
+
+func (in *APIGroupDiscovery) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *APIGroupDiscovery) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *APIGroupDiscovery) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	first := true
+	if in.TypeMeta.Kind != "" {
+		w.RawString("\"kind\":")
+		w.String(in.TypeMeta.Kind)
+		first = false
+	}
+	if in.TypeMeta.APIVersion != "" {
+		if !first {
+			w.RawByte(',')
+		}
+		w.RawString("\"apiVersion\":")
+		w.String(in.TypeMeta.APIVersion)
+		first = false
+	}
+	if !first {
+		w.RawByte(',')
+	}
+	w.RawString("\"metadata\":")
+	in.ObjectMeta.MarshalEasyJSON(w)
+	w.RawString(",\"versions\":")
+	if in.Versions == nil {
+		w.RawString("null")
+	} else {
+		w.RawByte('[')
+		for i0 := range in.Versions {
+			if i0 > 0 {
+				w.RawByte(',')
+			}
+			in.Versions[i0].MarshalEasyJSON(w)
+		}
+		w.RawByte(']')
+	}
+	w.RawByte('}')
+}
+
+func (in *APIGroupDiscovery) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "kind":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.TypeMeta.Kind = l.String()
+			}
+		case "apiVersion":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.TypeMeta.APIVersion = l.String()
+			}
+		case "metadata":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.ObjectMeta.UnmarshalEasyJSON(l)
+			}
+		case "versions":
+			if l.IsNull() {
+				l.Skip()
+				in.Versions = nil
+			} else {
+				in.Versions = make([]APIVersionDiscovery, 0)
+				l.Delim('[')
+				for !l.IsDelim(']') {
+					var v0 APIVersionDiscovery
+					if l.IsNull() {
+						l.Skip()
+					} else {
+						v0.UnmarshalEasyJSON(l)
+					}
+					in.Versions = append(in.Versions, v0)
+					l.WantComma()
+				}
+				l.Delim(']')
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
+
+type APIGroupDiscoveryList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata"`
+	Items           []APIGroupDiscovery `json:"items"`
+}
+
+func (in *APIGroupDiscoveryList) DeepCopyInto(out *APIGroupDiscoveryList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		l := make([]APIGroupDiscovery, len(in.Items))
+		for i := range in.Items {
+			in.Items[i].DeepCopyInto(&l[i])
+		}
+		out.Items = l
+	}
+}
+
+func (in *APIGroupDiscoveryList) DeepCopy() *APIGroupDiscoveryList {
+	if in == nil {
+		return nil
+	}
+	out := new(APIGroupDiscoveryList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *APIGroupDiscoveryList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+func (in *APIGroupDiscoveryList) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *APIGroupDiscoveryList) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *APIGroupDiscoveryList) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	first := true
+	if in.TypeMeta.Kind != "" {
+		w.RawString("\"kind\":")
+		w.String(in.TypeMeta.Kind)
+		first = false
+	}
+	if in.TypeMeta.APIVersion != "" {
+		if !first {
+			w.RawByte(',')
+		}
+		w.RawString("\"apiVersion\":")
+		w.String(in.TypeMeta.APIVersion)
+		first = false
+	}
+	if !first {
+		w.RawByte(',')
+	}
+	w.RawString("\"metadata\":")
+	in.ListMeta.MarshalEasyJSON(w)
+	w.RawString(",\"items\":")
+	if in.Items == nil {
+		w.RawString("null")
+	} else {
+		w.RawByte('[')
+		for i0 := range in.Items {
+			if i0 > 0 {
+				w.RawByte(',')
+			}
+			in.Items[i0].MarshalEasyJSON(w)
+		}
+		w.RawByte(']')
+	}
+	w.RawByte('}')
+}
+
+func (in *APIGroupDiscoveryList) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "kind":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.TypeMeta.Kind = l.String()
+			}
+		case "apiVersion":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.TypeMeta.APIVersion = l.String()
+			}
+		case "metadata":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.ListMeta.UnmarshalEasyJSON(l)
+			}
+		case "items":
+			if l.IsNull() {
+				l.Skip()
+				in.Items = nil
+			} else {
+				in.Items = make([]APIGroupDiscovery, 0)
+				l.Delim('[')
+				for !l.IsDelim(']') {
+					var v0 APIGroupDiscovery
+					if l.IsNull() {
+						l.Skip()
+					} else {
+						v0.UnmarshalEasyJSON(l)
+					}
+					in.Items = append(in.Items, v0)
+					l.WantComma()
+				}
+				l.Delim(']')
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
+
+type APIVersionDiscovery struct {
+	// version is the name of the version within a group version.
+	Version string `json:"version"`
+	// resources is a list of APIResourceDiscovery objects for the corresponding group version.
+	Resources []APIResourceDiscovery `json:"resources"`
+	// freshness marks whether a group version's discovery document is up to date.
+	// "Current" indicates the discovery document was recently
+	// refreshed. "Stale" indicates the discovery document could not
+	// be retrieved and the returned discovery document may be
+	// significantly out of date. Clients that require the latest
+	// version of the discovery information be retrieved before
+	// performing an operation should not use the aggregated document
+	Freshness DiscoveryFreshness `json:"freshness,omitempty"`
+}
+
+func (in *APIVersionDiscovery) DeepCopyInto(out *APIVersionDiscovery) {
+	*out = *in
+	if in.Resources != nil {
+		l := make([]APIResourceDiscovery, len(in.Resources))
+		for i := range in.Resources {
+			in.Resources[i].DeepCopyInto(&l[i])
+		}
+		out.Resources = l
+	}
+}
+
+func (in *APIVersionDiscovery) DeepCopy() *APIVersionDiscovery {
+	if in == nil {
+		return nil
+	}
+	out := new(APIVersionDiscovery)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *APIVersionDiscovery) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *APIVersionDiscovery) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *APIVersionDiscovery) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	w.RawString("\"version\":")
+	w.String(in.Version)
+	w.RawString(",\"resources\":")
+	if in.Resources == nil {
+		w.RawString("null")
+	} else {
+		w.RawByte('[')
+		for i0 := range in.Resources {
+			if i0 > 0 {
+				w.RawByte(',')
+			}
+			in.Resources[i0].MarshalEasyJSON(w)
+		}
+		w.RawByte(']')
+	}
+	if in.Freshness != "" {
+		w.RawString(",\"freshness\":")
+		w.String(string(in.Freshness))
+	}
+
+	w.RawByte('}')
+}
+
+func (in *APIVersionDiscovery) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "version":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.Version = l.String()
+			}
+		case "resources":
+			if l.IsNull() {
+				l.Skip()
+				in.Resources = nil
+			} else {
+				in.Resources = make([]APIResourceDiscovery, 0)
+				l.Delim('[')
+				for !l.IsDelim(']') {
+					var v0 APIResourceDiscovery
+					if l.IsNull() {
+						l.Skip()
+					} else {
+						v0.UnmarshalEasyJSON(l)
+					}
+					in.Resources = append(in.Resources, v0)
+					l.WantComma()
+				}
+				l.Delim(']')
+			}
+		case "freshness":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.Freshness = DiscoveryFreshness(l.String())
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
+
+type APIResourceDiscovery struct {
+	// resource is the plural name of the resource.  This is used in the URL path and is the unique identifier
+	// for this resource across all versions in the API group.
+	// Resources with non-empty groups are located at /apis/<APIGroupDiscovery.objectMeta.name>/<APIVersionDiscovery.version>/<APIResourceDiscovery.Resource>
+	// Resources with empty groups are located at /api/v1/<APIResourceDiscovery.Resource>
+	Resource string `json:"resource"`
+	// responseKind describes the group, version, and kind of the serialization schema for the object type this endpoint typically returns.
+	// APIs may return other objects types at their discretion, such as error conditions, requests for alternate representations, or other operation specific behavior.
+	// This value will be null or empty if an APIService reports subresources but supports no operations on the parent resource
+	ResponseKind *metav1.GroupVersionKind `json:"responseKind,omitempty"`
+	// scope indicates the scope of a resource, either Cluster or Namespaced
+	Scope ResourceScope `json:"scope"`
+	// singularResource is the singular name of the resource.  This allows clients to handle plural and singular opaquely.
+	// For many clients the singular form of the resource will be more understandable to users reading messages and should be used when integrating the name of the resource into a sentence.
+	// The command line tool kubectl, for example, allows use of the singular resource name in place of plurals.
+	// The singular form of a resource should always be an optional element - when in doubt use the canonical resource name.
+	SingularResource string `json:"singularResource"`
+	// verbs is a list of supported API operation types (this includes
+	// but is not limited to get, list, watch, create, update, patch,
+	// delete, deletecollection, and proxy).
+	Verbs []string `json:"verbs"`
+	// shortNames is a list of suggested short names of the resource.
+	ShortNames []string `json:"shortNames"`
+	// categories is a list of the grouped resources this resource belongs to (e.g. 'all').
+	// Clients may use this to simplify acting on multiple resource types at once.
+	Categories []string `json:"categories"`
+	// subresources is a list of subresources provided by this resource. Subresources are located at /apis/<APIGroupDiscovery.objectMeta.name>/<APIVersionDiscovery.version>/<APIResourceDiscovery.Resource>/name-of-instance/<APIResourceDiscovery.subresources[i].subresource>
+	Subresources []APISubresourceDiscovery `json:"subresources"`
+}
+
+func (in *APIResourceDiscovery) DeepCopyInto(out *APIResourceDiscovery) {
+	*out = *in
+	if in.ResponseKind != nil {
+		in, out := &in.ResponseKind, &out.ResponseKind
+		*out = new(metav1.GroupVersionKind)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Verbs != nil {
+		t := make([]string, len(in.Verbs))
+		copy(t, in.Verbs)
+		out.Verbs = t
+	}
+	if in.ShortNames != nil {
+		t := make([]string, len(in.ShortNames))
+		copy(t, in.ShortNames)
+		out.ShortNames = t
+	}
+	if in.Categories != nil {
+		t := make([]string, len(in.Categories))
+		copy(t, in.Categories)
+		out.Categories = t
+	}
+	if in.Subresources != nil {
+		l := make([]APISubresourceDiscovery, len(in.Subresources))
+		for i := range in.Subresources {
+			in.Subresources[i].DeepCopyInto(&l[i])
+		}
+		out.Subresources = l
+	}
+}
+
+func (in *APIResourceDiscovery) DeepCopy() *APIResourceDiscovery {
+	if in == nil {
+		return nil
+	}
+	out := new(APIResourceDiscovery)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *APIResourceDiscovery) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *APIResourceDiscovery) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *APIResourceDiscovery) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	w.RawString("\"resource\":")
+	w.String(in.Resource)
+	if in.ResponseKind != nil {
+		w.RawString(",\"responseKind\":")
+		in.ResponseKind.MarshalEasyJSON(w)
+	}
+	w.RawString(",\"scope\":")
+	w.String(string(in.Scope))
+	w.RawString(",\"singularResource\":")
+	w.String(in.SingularResource)
+	w.RawString(",\"verbs\":")
+	if in.Verbs == nil {
+		w.RawString("null")
+	} else {
+		w.RawByte('[')
+		for i0 := range in.Verbs {
+			if i0 > 0 {
+				w.RawByte(',')
+			}
+			w.String(in.Verbs[i0])
+		}
+		w.RawByte(']')
+	}
+	w.RawString(",\"shortNames\":")
+	if in.ShortNames == nil {
+		w.RawString("null")
+	} else {
+		w.RawByte('[')
+		for i0 := range in.ShortNames {
+			if i0 > 0 {
+				w.RawByte(',')
+			}
+			w.String(in.ShortNames[i0])
+		}
+		w.RawByte(']')
+	}
+	w.RawString(",\"categories\":")
+	if in.Categories == nil {
+		w.RawString("null")
+	} else {
+		w.RawByte('[')
+		for i0 := range in.Categories {
+			if i0 > 0 {
+				w.RawByte(',')
+			}
+			w.String(in.Categories[i0])
+		}
+		w.RawByte(']')
+	}
+	w.RawString(",\"subresources\":")
+	if in.Subresources == nil {
+		w.RawString("null")
+	} else {
+		w.RawByte('[')
+		for i0 := range in.Subresources {
+			if i0 > 0 {
+				w.RawByte(',')
+			}
+			in.Subresources[i0].MarshalEasyJSON(w)
+		}
+		w.RawByte(']')
+	}
+
+	w.RawByte('}')
+}
+
+func (in *APIResourceDiscovery) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "resource":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.Resource = l.String()
+			}
+		case "responseKind":
+			if l.IsNull() {
+				l.Skip()
+				in.ResponseKind = nil
+			} else {
+				if in.ResponseKind == nil {
+					in.ResponseKind = new(metav1.GroupVersionKind)
+				}
+				in.ResponseKind.UnmarshalEasyJSON(l)
+			}
+		case "scope":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.Scope = ResourceScope(l.String())
+			}
+		case "singularResource":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.SingularResource = l.String()
+			}
+		case "verbs":
+			if l.IsNull() {
+				l.Skip()
+				in.Verbs = nil
+			} else {
+				in.Verbs = make([]string, 0)
+				l.Delim('[')
+				for !l.IsDelim(']') {
+					var v0 string
+					if l.IsNull() {
+						l.Skip()
+					} else {
+						v0 = l.String()
+					}
+					in.Verbs = append(in.Verbs, v0)
+					l.WantComma()
+				}
+				l.Delim(']')
+			}
+		case "shortNames":
+			if l.IsNull() {
+				l.Skip()
+				in.ShortNames = nil
+			} else {
+				in.ShortNames = make([]string, 0)
+				l.Delim('[')
+				for !l.IsDelim(']') {
+					var v0 string
+					if l.IsNull() {
+						l.Skip()
+					} else {
+						v0 = l.String()
+					}
+					in.ShortNames = append(in.ShortNames, v0)
+					l.WantComma()
+				}
+				l.Delim(']')
+			}
+		case "categories":
+			if l.IsNull() {
+				l.Skip()
+				in.Categories = nil
+			} else {
+				in.Categories = make([]string, 0)
+				l.Delim('[')
+				for !l.IsDelim(']') {
+					var v0 string
+					if l.IsNull() {
+						l.Skip()
+					} else {
+						v0 = l.String()
+					}
+					in.Categories = append(in.Categories, v0)
+					l.WantComma()
+				}
+				l.Delim(']')
+			}
+		case "subresources":
+			if l.IsNull() {
+				l.Skip()
+				in.Subresources = nil
+			} else {
+				in.Subresources = make([]APISubresourceDiscovery, 0)
+				l.Delim('[')
+				for !l.IsDelim(']') {
+					var v0 APISubresourceDiscovery
+					if l.IsNull() {
+						l.Skip()
+					} else {
+						v0.UnmarshalEasyJSON(l)
+					}
+					in.Subresources = append(in.Subresources, v0)
+					l.WantComma()
+				}
+				l.Delim(']')
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
+
+type APISubresourceDiscovery struct {
+	// subresource is the name of the subresource.  This is used in the URL path and is the unique identifier
+	// for this resource across all versions.
+	Subresource string `json:"subresource"`
+	// responseKind describes the group, version, and kind of the serialization schema for the object type this endpoint typically returns.
+	// Some subresources do not return normal resources, these will have null or empty return types.
+	ResponseKind *metav1.GroupVersionKind `json:"responseKind,omitempty"`
+	// acceptedTypes describes the kinds that this endpoint accepts.
+	// Subresources may accept the standard content types or define
+	// custom negotiation schemes. The list may not be exhaustive for
+	// all operations.
+	AcceptedTypes []metav1.GroupVersionKind `json:"acceptedTypes"`
+	// verbs is a list of supported API operation types (this includes
+	// but is not limited to get, list, watch, create, update, patch,
+	// delete, deletecollection, and proxy). Subresources may define
+	// custom verbs outside the standard Kubernetes verb set. Clients
+	// should expect the behavior of standard verbs to align with
+	// Kubernetes interaction conventions.
+	Verbs []string `json:"verbs"`
+}
+
+func (in *APISubresourceDiscovery) DeepCopyInto(out *APISubresourceDiscovery) {
+	*out = *in
+	if in.ResponseKind != nil {
+		in, out := &in.ResponseKind, &out.ResponseKind
+		*out = new(metav1.GroupVersionKind)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AcceptedTypes != nil {
+		l := make([]metav1.GroupVersionKind, len(in.AcceptedTypes))
+		for i := range in.AcceptedTypes {
+			in.AcceptedTypes[i].DeepCopyInto(&l[i])
+		}
+		out.AcceptedTypes = l
+	}
+	if in.Verbs != nil {
+		t := make([]string, len(in.Verbs))
+		copy(t, in.Verbs)
+		out.Verbs = t
+	}
+}
+
+func (in *APISubresourceDiscovery) DeepCopy() *APISubresourceDiscovery {
+	if in == nil {
+		return nil
+	}
+	out := new(APISubresourceDiscovery)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *APISubresourceDiscovery) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *APISubresourceDiscovery) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *APISubresourceDiscovery) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	w.RawString("\"subresource\":")
+	w.String(in.Subresource)
+	if in.ResponseKind != nil {
+		w.RawString(",\"responseKind\":")
+		in.ResponseKind.MarshalEasyJSON(w)
+	}
+	w.RawString(",\"acceptedTypes\":")
+	if in.AcceptedTypes == nil {
+		w.RawString("null")
+	} else {
+		w.RawByte('[')
+		for i0 := range in.AcceptedTypes {
+			if i0 > 0 {
+				w.RawByte(',')
+			}
+			in.AcceptedTypes[i0].MarshalEasyJSON(w)
+		}
+		w.RawByte(']')
+	}
+	w.RawString(",\"verbs\":")
+	if in.Verbs == nil {
+		w.RawString("null")
+	} else {
+		w.RawByte('[')
+		for i0 := range in.Verbs {
+			if i0 > 0 {
+				w.RawByte(',')
+			}
+			w.String(in.Verbs[i0])
+		}
+		w.RawByte(']')
+	}
+
+	w.RawByte('}')
+}
+
+func (in *APISubresourceDiscovery) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "subresource":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.Subresource = l.String()
+			}
+		case "responseKind":
+			if l.IsNull() {
+				l.Skip()
+				in.ResponseKind = nil
+			} else {
+				if in.ResponseKind == nil {
+					in.ResponseKind = new(metav1.GroupVersionKind)
+				}
+				in.ResponseKind.UnmarshalEasyJSON(l)
+			}
+		case "acceptedTypes":
+			if l.IsNull() {
+				l.Skip()
+				in.AcceptedTypes = nil
+			} else {
+				in.AcceptedTypes = make([]metav1.GroupVersionKind, 0)
+				l.Delim('[')
+				for !l.IsDelim(']') {
+					var v0 metav1.GroupVersionKind
+					if l.IsNull() {
+						l.Skip()
+					} else {
+						v0.UnmarshalEasyJSON(l)
+					}
+					in.AcceptedTypes = append(in.AcceptedTypes, v0)
+					l.WantComma()
+				}
+				l.Delim(']')
+			}
+		case "verbs":
+			if l.IsNull() {
+				l.Skip()
+				in.Verbs = nil
+			} else {
+				in.Verbs = make([]string, 0)
+				l.Delim('[')
+				for !l.IsDelim(']') {
+					var v0 string
+					if l.IsNull() {
+						l.Skip()
+					} else {
+						v0 = l.String()
+					}
+					in.Verbs = append(in.Verbs, v0)
+					l.WantComma()
+				}
+				l.Delim(']')
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
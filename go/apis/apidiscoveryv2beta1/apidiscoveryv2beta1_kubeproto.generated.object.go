@@ -1,7 +1,11 @@
 package apidiscoveryv2beta1
 
 import (
+	"github.com/mailru/easyjson/jlexer"
+	"github.com/mailru/easyjson/jwriter"
 	"go.f110.dev/kubeproto/go/apis/metav1"
+	"go.f110.dev/kubeproto/go/patch"
+	"k8s.io/apimachinery/pkg/fields"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 )
@@ -75,6 +79,173 @@ func (in *APIGroupDiscovery) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// APIGroupDiscoveryToSelectableFields returns the fields of APIGroupDiscovery which can be used by the field selector.
+func APIGroupDiscoveryToSelectableFields(obj *APIGroupDiscovery) fields.Set {
+	set := fields.Set{
+		"metadata.name":      obj.ObjectMeta.Name,
+		"metadata.namespace": obj.ObjectMeta.Namespace,
+	}
+	return set
+}
+
+// APIGroupDiscoveryPatch builds JSON patch or JSON merge patch of APIGroupDiscovery.
+// JSON patch fails if the parent of the field doesn't exist (e.g. SetLabel to the object which has no label). MergePatch creates the parent.
+type APIGroupDiscoveryPatch struct {
+	*patch.Builder
+}
+
+func NewAPIGroupDiscoveryPatch() *APIGroupDiscoveryPatch {
+	return &APIGroupDiscoveryPatch{Builder: patch.NewBuilder()}
+}
+
+func (p *APIGroupDiscoveryPatch) SetLabel(key, value string) *APIGroupDiscoveryPatch {
+	p.Set([]string{"metadata", "labels", key}, value)
+	return p
+}
+
+func (p *APIGroupDiscoveryPatch) RemoveLabel(key string) *APIGroupDiscoveryPatch {
+	p.Remove([]string{"metadata", "labels", key})
+	return p
+}
+
+func (p *APIGroupDiscoveryPatch) SetAnnotation(key, value string) *APIGroupDiscoveryPatch {
+	p.Set([]string{"metadata", "annotations", key}, value)
+	return p
+}
+
+func (p *APIGroupDiscoveryPatch) RemoveAnnotation(key string) *APIGroupDiscoveryPatch {
+	p.Remove([]string{"metadata", "annotations", key})
+	return p
+}
+
+func (p *APIGroupDiscoveryPatch) SetVersions(v []APIVersionDiscovery) *APIGroupDiscoveryPatch {
+	p.Set([]string{"versions"}, v)
+	return p
+}
+
+func (p *APIGroupDiscoveryPatch) RemoveVersions() *APIGroupDiscoveryPatch {
+	p.Remove([]string{"versions"})
+	return p
+}
+
+func (p *APIGroupDiscoveryPatch) AddVersions(v ...APIVersionDiscovery) *APIGroupDiscoveryPatch {
+	for _, e := range v {
+		p.Append([]string{"versions"}, e)
+	}
+	return p
+}
+
+func (in *APIGroupDiscovery) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *APIGroupDiscovery) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *APIGroupDiscovery) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	first := true
+	if in.TypeMeta.Kind != "" {
+		w.RawString("\"kind\":")
+		w.String(in.TypeMeta.Kind)
+		first = false
+	}
+	if in.TypeMeta.APIVersion != "" {
+		if !first {
+			w.RawByte(',')
+		}
+		w.RawString("\"apiVersion\":")
+		w.String(in.TypeMeta.APIVersion)
+		first = false
+	}
+	if !first {
+		w.RawByte(',')
+	}
+	w.RawString("\"metadata\":")
+	in.ObjectMeta.MarshalEasyJSON(w)
+	w.RawString(",\"versions\":")
+	if in.Versions == nil {
+		w.RawString("null")
+	} else {
+		w.RawByte('[')
+		for i0 := range in.Versions {
+			if i0 > 0 {
+				w.RawByte(',')
+			}
+			in.Versions[i0].MarshalEasyJSON(w)
+		}
+		w.RawByte(']')
+	}
+	w.RawByte('}')
+}
+
+func (in *APIGroupDiscovery) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "kind":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.TypeMeta.Kind = l.String()
+			}
+		case "apiVersion":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.TypeMeta.APIVersion = l.String()
+			}
+		case "metadata":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.ObjectMeta.UnmarshalEasyJSON(l)
+			}
+		case "versions":
+			if l.IsNull() {
+				l.Skip()
+				in.Versions = nil
+			} else {
+				in.Versions = make([]APIVersionDiscovery, 0)
+				l.Delim('[')
+				for !l.IsDelim(']') {
+					var v0 APIVersionDiscovery
+					if l.IsNull() {
+						l.Skip()
+					} else {
+						v0.UnmarshalEasyJSON(l)
+					}
+					in.Versions = append(in.Versions, v0)
+					l.WantComma()
+				}
+				l.Delim(']')
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
+
 type APIGroupDiscoveryList struct {
 	metav1.TypeMeta `json:",inline"`
 	metav1.ListMeta `json:"metadata"`
@@ -110,6 +281,117 @@ func (in *APIGroupDiscoveryList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+func (in *APIGroupDiscoveryList) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *APIGroupDiscoveryList) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *APIGroupDiscoveryList) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	first := true
+	if in.TypeMeta.Kind != "" {
+		w.RawString("\"kind\":")
+		w.String(in.TypeMeta.Kind)
+		first = false
+	}
+	if in.TypeMeta.APIVersion != "" {
+		if !first {
+			w.RawByte(',')
+		}
+		w.RawString("\"apiVersion\":")
+		w.String(in.TypeMeta.APIVersion)
+		first = false
+	}
+	if !first {
+		w.RawByte(',')
+	}
+	w.RawString("\"metadata\":")
+	in.ListMeta.MarshalEasyJSON(w)
+	w.RawString(",\"items\":")
+	if in.Items == nil {
+		w.RawString("null")
+	} else {
+		w.RawByte('[')
+		for i0 := range in.Items {
+			if i0 > 0 {
+				w.RawByte(',')
+			}
+			in.Items[i0].MarshalEasyJSON(w)
+		}
+		w.RawByte(']')
+	}
+	w.RawByte('}')
+}
+
+func (in *APIGroupDiscoveryList) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "kind":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.TypeMeta.Kind = l.String()
+			}
+		case "apiVersion":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.TypeMeta.APIVersion = l.String()
+			}
+		case "metadata":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.ListMeta.UnmarshalEasyJSON(l)
+			}
+		case "items":
+			if l.IsNull() {
+				l.Skip()
+				in.Items = nil
+			} else {
+				in.Items = make([]APIGroupDiscovery, 0)
+				l.Delim('[')
+				for !l.IsDelim(']') {
+					var v0 APIGroupDiscovery
+					if l.IsNull() {
+						l.Skip()
+					} else {
+						v0.UnmarshalEasyJSON(l)
+					}
+					in.Items = append(in.Items, v0)
+					l.WantComma()
+				}
+				l.Delim(']')
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
+
 type APIVersionDiscovery struct {
 	// version is the name of the version within a group version.
 	Version string `json:"version"`
@@ -145,6 +427,99 @@ func (in *APIVersionDiscovery) DeepCopy() *APIVersionDiscovery {
 	return out
 }
 
+func (in *APIVersionDiscovery) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *APIVersionDiscovery) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *APIVersionDiscovery) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	w.RawString("\"version\":")
+	w.String(in.Version)
+	w.RawString(",\"resources\":")
+	if in.Resources == nil {
+		w.RawString("null")
+	} else {
+		w.RawByte('[')
+		for i0 := range in.Resources {
+			if i0 > 0 {
+				w.RawByte(',')
+			}
+			in.Resources[i0].MarshalEasyJSON(w)
+		}
+		w.RawByte(']')
+	}
+	if in.Freshness != "" {
+		w.RawString(",\"freshness\":")
+		w.String(string(in.Freshness))
+	}
+
+	w.RawByte('}')
+}
+
+func (in *APIVersionDiscovery) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "version":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.Version = l.String()
+			}
+		case "resources":
+			if l.IsNull() {
+				l.Skip()
+				in.Resources = nil
+			} else {
+				in.Resources = make([]APIResourceDiscovery, 0)
+				l.Delim('[')
+				for !l.IsDelim(']') {
+					var v0 APIResourceDiscovery
+					if l.IsNull() {
+						l.Skip()
+					} else {
+						v0.UnmarshalEasyJSON(l)
+					}
+					in.Resources = append(in.Resources, v0)
+					l.WantComma()
+				}
+				l.Delim(']')
+			}
+		case "freshness":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.Freshness = DiscoveryFreshness(l.String())
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
+
 type APIResourceDiscovery struct {
 	// resource is the plural name of the resource.  This is used in the URL path and is the unique identifier
 	// for this resource across all versions in the API group.
@@ -215,6 +590,215 @@ func (in *APIResourceDiscovery) DeepCopy() *APIResourceDiscovery {
 	return out
 }
 
+func (in *APIResourceDiscovery) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *APIResourceDiscovery) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *APIResourceDiscovery) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	w.RawString("\"resource\":")
+	w.String(in.Resource)
+	if in.ResponseKind != nil {
+		w.RawString(",\"responseKind\":")
+		in.ResponseKind.MarshalEasyJSON(w)
+	}
+	w.RawString(",\"scope\":")
+	w.String(string(in.Scope))
+	w.RawString(",\"singularResource\":")
+	w.String(in.SingularResource)
+	w.RawString(",\"verbs\":")
+	if in.Verbs == nil {
+		w.RawString("null")
+	} else {
+		w.RawByte('[')
+		for i0 := range in.Verbs {
+			if i0 > 0 {
+				w.RawByte(',')
+			}
+			w.String(in.Verbs[i0])
+		}
+		w.RawByte(']')
+	}
+	w.RawString(",\"shortNames\":")
+	if in.ShortNames == nil {
+		w.RawString("null")
+	} else {
+		w.RawByte('[')
+		for i0 := range in.ShortNames {
+			if i0 > 0 {
+				w.RawByte(',')
+			}
+			w.String(in.ShortNames[i0])
+		}
+		w.RawByte(']')
+	}
+	w.RawString(",\"categories\":")
+	if in.Categories == nil {
+		w.RawString("null")
+	} else {
+		w.RawByte('[')
+		for i0 := range in.Categories {
+			if i0 > 0 {
+				w.RawByte(',')
+			}
+			w.String(in.Categories[i0])
+		}
+		w.RawByte(']')
+	}
+	w.RawString(",\"subresources\":")
+	if in.Subresources == nil {
+		w.RawString("null")
+	} else {
+		w.RawByte('[')
+		for i0 := range in.Subresources {
+			if i0 > 0 {
+				w.RawByte(',')
+			}
+			in.Subresources[i0].MarshalEasyJSON(w)
+		}
+		w.RawByte(']')
+	}
+
+	w.RawByte('}')
+}
+
+func (in *APIResourceDiscovery) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "resource":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.Resource = l.String()
+			}
+		case "responseKind":
+			if l.IsNull() {
+				l.Skip()
+				in.ResponseKind = nil
+			} else {
+				if in.ResponseKind == nil {
+					in.ResponseKind = new(metav1.GroupVersionKind)
+				}
+				in.ResponseKind.UnmarshalEasyJSON(l)
+			}
+		case "scope":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.Scope = ResourceScope(l.String())
+			}
+		case "singularResource":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.SingularResource = l.String()
+			}
+		case "verbs":
+			if l.IsNull() {
+				l.Skip()
+				in.Verbs = nil
+			} else {
+				in.Verbs = make([]string, 0)
+				l.Delim('[')
+				for !l.IsDelim(']') {
+					var v0 string
+					if l.IsNull() {
+						l.Skip()
+					} else {
+						v0 = l.String()
+					}
+					in.Verbs = append(in.Verbs, v0)
+					l.WantComma()
+				}
+				l.Delim(']')
+			}
+		case "shortNames":
+			if l.IsNull() {
+				l.Skip()
+				in.ShortNames = nil
+			} else {
+				in.ShortNames = make([]string, 0)
+				l.Delim('[')
+				for !l.IsDelim(']') {
+					var v0 string
+					if l.IsNull() {
+						l.Skip()
+					} else {
+						v0 = l.String()
+					}
+					in.ShortNames = append(in.ShortNames, v0)
+					l.WantComma()
+				}
+				l.Delim(']')
+			}
+		case "categories":
+			if l.IsNull() {
+				l.Skip()
+				in.Categories = nil
+			} else {
+				in.Categories = make([]string, 0)
+				l.Delim('[')
+				for !l.IsDelim(']') {
+					var v0 string
+					if l.IsNull() {
+						l.Skip()
+					} else {
+						v0 = l.String()
+					}
+					in.Categories = append(in.Categories, v0)
+					l.WantComma()
+				}
+				l.Delim(']')
+			}
+		case "subresources":
+			if l.IsNull() {
+				l.Skip()
+				in.Subresources = nil
+			} else {
+				in.Subresources = make([]APISubresourceDiscovery, 0)
+				l.Delim('[')
+				for !l.IsDelim(']') {
+					var v0 APISubresourceDiscovery
+					if l.IsNull() {
+						l.Skip()
+					} else {
+						v0.UnmarshalEasyJSON(l)
+					}
+					in.Subresources = append(in.Subresources, v0)
+					l.WantComma()
+				}
+				l.Delim(']')
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
+
 type APISubresourceDiscovery struct {
 	// subresource is the name of the subresource.  This is used in the URL path and is the unique identifier
 	// for this resource across all versions.
@@ -265,3 +849,132 @@ func (in *APISubresourceDiscovery) DeepCopy() *APISubresourceDiscovery {
 	in.DeepCopyInto(out)
 	return out
 }
+
+func (in *APISubresourceDiscovery) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *APISubresourceDiscovery) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *APISubresourceDiscovery) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	w.RawString("\"subresource\":")
+	w.String(in.Subresource)
+	if in.ResponseKind != nil {
+		w.RawString(",\"responseKind\":")
+		in.ResponseKind.MarshalEasyJSON(w)
+	}
+	w.RawString(",\"acceptedTypes\":")
+	if in.AcceptedTypes == nil {
+		w.RawString("null")
+	} else {
+		w.RawByte('[')
+		for i0 := range in.AcceptedTypes {
+			if i0 > 0 {
+				w.RawByte(',')
+			}
+			in.AcceptedTypes[i0].MarshalEasyJSON(w)
+		}
+		w.RawByte(']')
+	}
+	w.RawString(",\"verbs\":")
+	if in.Verbs == nil {
+		w.RawString("null")
+	} else {
+		w.RawByte('[')
+		for i0 := range in.Verbs {
+			if i0 > 0 {
+				w.RawByte(',')
+			}
+			w.String(in.Verbs[i0])
+		}
+		w.RawByte(']')
+	}
+
+	w.RawByte('}')
+}
+
+func (in *APISubresourceDiscovery) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "subresource":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.Subresource = l.String()
+			}
+		case "responseKind":
+			if l.IsNull() {
+				l.Skip()
+				in.ResponseKind = nil
+			} else {
+				if in.ResponseKind == nil {
+					in.ResponseKind = new(metav1.GroupVersionKind)
+				}
+				in.ResponseKind.UnmarshalEasyJSON(l)
+			}
+		case "acceptedTypes":
+			if l.IsNull() {
+				l.Skip()
+				in.AcceptedTypes = nil
+			} else {
+				in.AcceptedTypes = make([]metav1.GroupVersionKind, 0)
+				l.Delim('[')
+				for !l.IsDelim(']') {
+					var v0 metav1.GroupVersionKind
+					if l.IsNull() {
+						l.Skip()
+					} else {
+						v0.UnmarshalEasyJSON(l)
+					}
+					in.AcceptedTypes = append(in.AcceptedTypes, v0)
+					l.WantComma()
+				}
+				l.Delim(']')
+			}
+		case "verbs":
+			if l.IsNull() {
+				l.Skip()
+				in.Verbs = nil
+			} else {
+				in.Verbs = make([]string, 0)
+				l.Delim('[')
+				for !l.IsDelim(']') {
+					var v0 string
+					if l.IsNull() {
+						l.Skip()
+					} else {
+						v0 = l.String()
+					}
+					in.Verbs = append(in.Verbs, v0)
+					l.WantComma()
+				}
+				l.Delim(']')
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
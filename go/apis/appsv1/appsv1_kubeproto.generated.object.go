@@ -1,8 +1,13 @@
 package appsv1
 
 import (
+	"encoding/json"
+	"github.com/mailru/easyjson/jlexer"
+	"github.com/mailru/easyjson/jwriter"
 	"go.f110.dev/kubeproto/go/apis/corev1"
 	"go.f110.dev/kubeproto/go/apis/metav1"
+	"go.f110.dev/kubeproto/go/patch"
+	"k8s.io/apimachinery/pkg/fields"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	utilintstr "k8s.io/apimachinery/pkg/util/intstr"
@@ -119,6 +124,158 @@ func (in *ControllerRevision) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// ControllerRevisionToSelectableFields returns the fields of ControllerRevision which can be used by the field selector.
+func ControllerRevisionToSelectableFields(obj *ControllerRevision) fields.Set {
+	set := fields.Set{
+		"metadata.name":      obj.ObjectMeta.Name,
+		"metadata.namespace": obj.ObjectMeta.Namespace,
+	}
+	return set
+}
+
+// ControllerRevisionPatch builds JSON patch or JSON merge patch of ControllerRevision.
+// JSON patch fails if the parent of the field doesn't exist (e.g. SetLabel to the object which has no label). MergePatch creates the parent.
+type ControllerRevisionPatch struct {
+	*patch.Builder
+}
+
+func NewControllerRevisionPatch() *ControllerRevisionPatch {
+	return &ControllerRevisionPatch{Builder: patch.NewBuilder()}
+}
+
+func (p *ControllerRevisionPatch) SetLabel(key, value string) *ControllerRevisionPatch {
+	p.Set([]string{"metadata", "labels", key}, value)
+	return p
+}
+
+func (p *ControllerRevisionPatch) RemoveLabel(key string) *ControllerRevisionPatch {
+	p.Remove([]string{"metadata", "labels", key})
+	return p
+}
+
+func (p *ControllerRevisionPatch) SetAnnotation(key, value string) *ControllerRevisionPatch {
+	p.Set([]string{"metadata", "annotations", key}, value)
+	return p
+}
+
+func (p *ControllerRevisionPatch) RemoveAnnotation(key string) *ControllerRevisionPatch {
+	p.Remove([]string{"metadata", "annotations", key})
+	return p
+}
+
+func (p *ControllerRevisionPatch) SetData(v *runtime.RawExtension) *ControllerRevisionPatch {
+	p.Set([]string{"data"}, v)
+	return p
+}
+
+func (p *ControllerRevisionPatch) RemoveData() *ControllerRevisionPatch {
+	p.Remove([]string{"data"})
+	return p
+}
+
+func (p *ControllerRevisionPatch) SetRevision(v int64) *ControllerRevisionPatch {
+	p.Set([]string{"revision"}, v)
+	return p
+}
+
+func (p *ControllerRevisionPatch) RemoveRevision() *ControllerRevisionPatch {
+	p.Remove([]string{"revision"})
+	return p
+}
+
+func (in *ControllerRevision) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *ControllerRevision) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *ControllerRevision) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	first := true
+	if in.TypeMeta.Kind != "" {
+		w.RawString("\"kind\":")
+		w.String(in.TypeMeta.Kind)
+		first = false
+	}
+	if in.TypeMeta.APIVersion != "" {
+		if !first {
+			w.RawByte(',')
+		}
+		w.RawString("\"apiVersion\":")
+		w.String(in.TypeMeta.APIVersion)
+		first = false
+	}
+	if !first {
+		w.RawByte(',')
+	}
+	w.RawString("\"metadata\":")
+	in.ObjectMeta.MarshalEasyJSON(w)
+	if in.Data != nil {
+		w.RawString(",\"data\":")
+		w.Raw(json.Marshal(in.Data))
+	}
+	w.RawString(",\"revision\":")
+	w.Int64(in.Revision)
+	w.RawByte('}')
+}
+
+func (in *ControllerRevision) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "kind":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.TypeMeta.Kind = l.String()
+			}
+		case "apiVersion":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.TypeMeta.APIVersion = l.String()
+			}
+		case "metadata":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.ObjectMeta.UnmarshalEasyJSON(l)
+			}
+		case "data":
+			l.AddError(json.Unmarshal(l.Raw(), &in.Data))
+		case "revision":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.Revision = l.Int64()
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
+
 type ControllerRevisionList struct {
 	metav1.TypeMeta `json:",inline"`
 	metav1.ListMeta `json:"metadata"`
@@ -154,6 +311,117 @@ func (in *ControllerRevisionList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+func (in *ControllerRevisionList) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *ControllerRevisionList) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *ControllerRevisionList) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	first := true
+	if in.TypeMeta.Kind != "" {
+		w.RawString("\"kind\":")
+		w.String(in.TypeMeta.Kind)
+		first = false
+	}
+	if in.TypeMeta.APIVersion != "" {
+		if !first {
+			w.RawByte(',')
+		}
+		w.RawString("\"apiVersion\":")
+		w.String(in.TypeMeta.APIVersion)
+		first = false
+	}
+	if !first {
+		w.RawByte(',')
+	}
+	w.RawString("\"metadata\":")
+	in.ListMeta.MarshalEasyJSON(w)
+	w.RawString(",\"items\":")
+	if in.Items == nil {
+		w.RawString("null")
+	} else {
+		w.RawByte('[')
+		for i0 := range in.Items {
+			if i0 > 0 {
+				w.RawByte(',')
+			}
+			in.Items[i0].MarshalEasyJSON(w)
+		}
+		w.RawByte(']')
+	}
+	w.RawByte('}')
+}
+
+func (in *ControllerRevisionList) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "kind":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.TypeMeta.Kind = l.String()
+			}
+		case "apiVersion":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.TypeMeta.APIVersion = l.String()
+			}
+		case "metadata":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.ListMeta.UnmarshalEasyJSON(l)
+			}
+		case "items":
+			if l.IsNull() {
+				l.Skip()
+				in.Items = nil
+			} else {
+				in.Items = make([]ControllerRevision, 0)
+				l.Delim('[')
+				for !l.IsDelim(']') {
+					var v0 ControllerRevision
+					if l.IsNull() {
+						l.Skip()
+					} else {
+						v0.UnmarshalEasyJSON(l)
+					}
+					in.Items = append(in.Items, v0)
+					l.WantComma()
+				}
+				l.Delim(']')
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
+
 type DaemonSet struct {
 	metav1.TypeMeta   `json:",inline"`
 	metav1.ObjectMeta `json:"metadata"`
@@ -200,6 +468,369 @@ func (in *DaemonSet) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DaemonSetToSelectableFields returns the fields of DaemonSet which can be used by the field selector.
+func DaemonSetToSelectableFields(obj *DaemonSet) fields.Set {
+	set := fields.Set{
+		"metadata.name":      obj.ObjectMeta.Name,
+		"metadata.namespace": obj.ObjectMeta.Namespace,
+	}
+	return set
+}
+
+// DaemonSetPatch builds JSON patch or JSON merge patch of DaemonSet.
+// JSON patch fails if the parent of the field doesn't exist (e.g. SetLabel to the object which has no label). MergePatch creates the parent.
+type DaemonSetPatch struct {
+	*patch.Builder
+}
+
+func NewDaemonSetPatch() *DaemonSetPatch {
+	return &DaemonSetPatch{Builder: patch.NewBuilder()}
+}
+
+func (p *DaemonSetPatch) SetLabel(key, value string) *DaemonSetPatch {
+	p.Set([]string{"metadata", "labels", key}, value)
+	return p
+}
+
+func (p *DaemonSetPatch) RemoveLabel(key string) *DaemonSetPatch {
+	p.Remove([]string{"metadata", "labels", key})
+	return p
+}
+
+func (p *DaemonSetPatch) SetAnnotation(key, value string) *DaemonSetPatch {
+	p.Set([]string{"metadata", "annotations", key}, value)
+	return p
+}
+
+func (p *DaemonSetPatch) RemoveAnnotation(key string) *DaemonSetPatch {
+	p.Remove([]string{"metadata", "annotations", key})
+	return p
+}
+
+func (p *DaemonSetPatch) SetSpec(v *DaemonSetSpec) *DaemonSetPatch {
+	p.Set([]string{"spec"}, v)
+	return p
+}
+
+func (p *DaemonSetPatch) RemoveSpec() *DaemonSetPatch {
+	p.Remove([]string{"spec"})
+	return p
+}
+
+func (p *DaemonSetPatch) SetSpecSelector(v *metav1.LabelSelector) *DaemonSetPatch {
+	p.Set([]string{"spec", "selector"}, v)
+	return p
+}
+
+func (p *DaemonSetPatch) RemoveSpecSelector() *DaemonSetPatch {
+	p.Remove([]string{"spec", "selector"})
+	return p
+}
+
+func (p *DaemonSetPatch) SetSpecTemplate(v corev1.PodTemplateSpec) *DaemonSetPatch {
+	p.Set([]string{"spec", "template"}, v)
+	return p
+}
+
+func (p *DaemonSetPatch) RemoveSpecTemplate() *DaemonSetPatch {
+	p.Remove([]string{"spec", "template"})
+	return p
+}
+
+func (p *DaemonSetPatch) SetSpecUpdateStrategy(v *DaemonSetUpdateStrategy) *DaemonSetPatch {
+	p.Set([]string{"spec", "updateStrategy"}, v)
+	return p
+}
+
+func (p *DaemonSetPatch) RemoveSpecUpdateStrategy() *DaemonSetPatch {
+	p.Remove([]string{"spec", "updateStrategy"})
+	return p
+}
+
+func (p *DaemonSetPatch) SetSpecUpdateStrategyType(v DaemonSetUpdateStrategyType) *DaemonSetPatch {
+	p.Set([]string{"spec", "updateStrategy", "type"}, v)
+	return p
+}
+
+func (p *DaemonSetPatch) RemoveSpecUpdateStrategyType() *DaemonSetPatch {
+	p.Remove([]string{"spec", "updateStrategy", "type"})
+	return p
+}
+
+func (p *DaemonSetPatch) SetSpecUpdateStrategyRollingUpdate(v *RollingUpdateDaemonSet) *DaemonSetPatch {
+	p.Set([]string{"spec", "updateStrategy", "rollingUpdate"}, v)
+	return p
+}
+
+func (p *DaemonSetPatch) RemoveSpecUpdateStrategyRollingUpdate() *DaemonSetPatch {
+	p.Remove([]string{"spec", "updateStrategy", "rollingUpdate"})
+	return p
+}
+
+func (p *DaemonSetPatch) SetSpecUpdateStrategyRollingUpdateMaxUnavailable(v *utilintstr.IntOrString) *DaemonSetPatch {
+	p.Set([]string{"spec", "updateStrategy", "rollingUpdate", "maxUnavailable"}, v)
+	return p
+}
+
+func (p *DaemonSetPatch) RemoveSpecUpdateStrategyRollingUpdateMaxUnavailable() *DaemonSetPatch {
+	p.Remove([]string{"spec", "updateStrategy", "rollingUpdate", "maxUnavailable"})
+	return p
+}
+
+func (p *DaemonSetPatch) SetSpecUpdateStrategyRollingUpdateMaxSurge(v *utilintstr.IntOrString) *DaemonSetPatch {
+	p.Set([]string{"spec", "updateStrategy", "rollingUpdate", "maxSurge"}, v)
+	return p
+}
+
+func (p *DaemonSetPatch) RemoveSpecUpdateStrategyRollingUpdateMaxSurge() *DaemonSetPatch {
+	p.Remove([]string{"spec", "updateStrategy", "rollingUpdate", "maxSurge"})
+	return p
+}
+
+func (p *DaemonSetPatch) SetSpecMinReadySeconds(v int) *DaemonSetPatch {
+	p.Set([]string{"spec", "minReadySeconds"}, v)
+	return p
+}
+
+func (p *DaemonSetPatch) RemoveSpecMinReadySeconds() *DaemonSetPatch {
+	p.Remove([]string{"spec", "minReadySeconds"})
+	return p
+}
+
+func (p *DaemonSetPatch) SetSpecRevisionHistoryLimit(v int) *DaemonSetPatch {
+	p.Set([]string{"spec", "revisionHistoryLimit"}, v)
+	return p
+}
+
+func (p *DaemonSetPatch) RemoveSpecRevisionHistoryLimit() *DaemonSetPatch {
+	p.Remove([]string{"spec", "revisionHistoryLimit"})
+	return p
+}
+
+func (p *DaemonSetPatch) SetStatus(v *DaemonSetStatus) *DaemonSetPatch {
+	p.Set([]string{"status"}, v)
+	return p
+}
+
+func (p *DaemonSetPatch) RemoveStatus() *DaemonSetPatch {
+	p.Remove([]string{"status"})
+	return p
+}
+
+func (p *DaemonSetPatch) SetStatusCurrentNumberScheduled(v int) *DaemonSetPatch {
+	p.Set([]string{"status", "currentNumberScheduled"}, v)
+	return p
+}
+
+func (p *DaemonSetPatch) RemoveStatusCurrentNumberScheduled() *DaemonSetPatch {
+	p.Remove([]string{"status", "currentNumberScheduled"})
+	return p
+}
+
+func (p *DaemonSetPatch) SetStatusNumberMisscheduled(v int) *DaemonSetPatch {
+	p.Set([]string{"status", "numberMisscheduled"}, v)
+	return p
+}
+
+func (p *DaemonSetPatch) RemoveStatusNumberMisscheduled() *DaemonSetPatch {
+	p.Remove([]string{"status", "numberMisscheduled"})
+	return p
+}
+
+func (p *DaemonSetPatch) SetStatusDesiredNumberScheduled(v int) *DaemonSetPatch {
+	p.Set([]string{"status", "desiredNumberScheduled"}, v)
+	return p
+}
+
+func (p *DaemonSetPatch) RemoveStatusDesiredNumberScheduled() *DaemonSetPatch {
+	p.Remove([]string{"status", "desiredNumberScheduled"})
+	return p
+}
+
+func (p *DaemonSetPatch) SetStatusNumberReady(v int) *DaemonSetPatch {
+	p.Set([]string{"status", "numberReady"}, v)
+	return p
+}
+
+func (p *DaemonSetPatch) RemoveStatusNumberReady() *DaemonSetPatch {
+	p.Remove([]string{"status", "numberReady"})
+	return p
+}
+
+func (p *DaemonSetPatch) SetStatusObservedGeneration(v int64) *DaemonSetPatch {
+	p.Set([]string{"status", "observedGeneration"}, v)
+	return p
+}
+
+func (p *DaemonSetPatch) RemoveStatusObservedGeneration() *DaemonSetPatch {
+	p.Remove([]string{"status", "observedGeneration"})
+	return p
+}
+
+func (p *DaemonSetPatch) SetStatusUpdatedNumberScheduled(v int) *DaemonSetPatch {
+	p.Set([]string{"status", "updatedNumberScheduled"}, v)
+	return p
+}
+
+func (p *DaemonSetPatch) RemoveStatusUpdatedNumberScheduled() *DaemonSetPatch {
+	p.Remove([]string{"status", "updatedNumberScheduled"})
+	return p
+}
+
+func (p *DaemonSetPatch) SetStatusNumberAvailable(v int) *DaemonSetPatch {
+	p.Set([]string{"status", "numberAvailable"}, v)
+	return p
+}
+
+func (p *DaemonSetPatch) RemoveStatusNumberAvailable() *DaemonSetPatch {
+	p.Remove([]string{"status", "numberAvailable"})
+	return p
+}
+
+func (p *DaemonSetPatch) SetStatusNumberUnavailable(v int) *DaemonSetPatch {
+	p.Set([]string{"status", "numberUnavailable"}, v)
+	return p
+}
+
+func (p *DaemonSetPatch) RemoveStatusNumberUnavailable() *DaemonSetPatch {
+	p.Remove([]string{"status", "numberUnavailable"})
+	return p
+}
+
+func (p *DaemonSetPatch) SetStatusCollisionCount(v int) *DaemonSetPatch {
+	p.Set([]string{"status", "collisionCount"}, v)
+	return p
+}
+
+func (p *DaemonSetPatch) RemoveStatusCollisionCount() *DaemonSetPatch {
+	p.Remove([]string{"status", "collisionCount"})
+	return p
+}
+
+func (p *DaemonSetPatch) SetStatusConditions(v []DaemonSetCondition) *DaemonSetPatch {
+	p.Set([]string{"status", "conditions"}, v)
+	return p
+}
+
+func (p *DaemonSetPatch) RemoveStatusConditions() *DaemonSetPatch {
+	p.Remove([]string{"status", "conditions"})
+	return p
+}
+
+func (p *DaemonSetPatch) AddStatusConditions(v ...DaemonSetCondition) *DaemonSetPatch {
+	for _, e := range v {
+		p.Append([]string{"status", "conditions"}, e)
+	}
+	return p
+}
+
+func (in *DaemonSet) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *DaemonSet) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *DaemonSet) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	first := true
+	if in.TypeMeta.Kind != "" {
+		w.RawString("\"kind\":")
+		w.String(in.TypeMeta.Kind)
+		first = false
+	}
+	if in.TypeMeta.APIVersion != "" {
+		if !first {
+			w.RawByte(',')
+		}
+		w.RawString("\"apiVersion\":")
+		w.String(in.TypeMeta.APIVersion)
+		first = false
+	}
+	if !first {
+		w.RawByte(',')
+	}
+	w.RawString("\"metadata\":")
+	in.ObjectMeta.MarshalEasyJSON(w)
+	if in.Spec != nil {
+		w.RawString(",\"spec\":")
+		in.Spec.MarshalEasyJSON(w)
+	}
+	if in.Status != nil {
+		w.RawString(",\"status\":")
+		in.Status.MarshalEasyJSON(w)
+	}
+	w.RawByte('}')
+}
+
+func (in *DaemonSet) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "kind":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.TypeMeta.Kind = l.String()
+			}
+		case "apiVersion":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.TypeMeta.APIVersion = l.String()
+			}
+		case "metadata":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.ObjectMeta.UnmarshalEasyJSON(l)
+			}
+		case "spec":
+			if l.IsNull() {
+				l.Skip()
+				in.Spec = nil
+			} else {
+				if in.Spec == nil {
+					in.Spec = new(DaemonSetSpec)
+				}
+				in.Spec.UnmarshalEasyJSON(l)
+			}
+		case "status":
+			if l.IsNull() {
+				l.Skip()
+				in.Status = nil
+			} else {
+				if in.Status == nil {
+					in.Status = new(DaemonSetStatus)
+				}
+				in.Status.UnmarshalEasyJSON(l)
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
+
 type DaemonSetList struct {
 	metav1.TypeMeta `json:",inline"`
 	metav1.ListMeta `json:"metadata"`
@@ -235,6 +866,117 @@ func (in *DaemonSetList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+func (in *DaemonSetList) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *DaemonSetList) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *DaemonSetList) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	first := true
+	if in.TypeMeta.Kind != "" {
+		w.RawString("\"kind\":")
+		w.String(in.TypeMeta.Kind)
+		first = false
+	}
+	if in.TypeMeta.APIVersion != "" {
+		if !first {
+			w.RawByte(',')
+		}
+		w.RawString("\"apiVersion\":")
+		w.String(in.TypeMeta.APIVersion)
+		first = false
+	}
+	if !first {
+		w.RawByte(',')
+	}
+	w.RawString("\"metadata\":")
+	in.ListMeta.MarshalEasyJSON(w)
+	w.RawString(",\"items\":")
+	if in.Items == nil {
+		w.RawString("null")
+	} else {
+		w.RawByte('[')
+		for i0 := range in.Items {
+			if i0 > 0 {
+				w.RawByte(',')
+			}
+			in.Items[i0].MarshalEasyJSON(w)
+		}
+		w.RawByte(']')
+	}
+	w.RawByte('}')
+}
+
+func (in *DaemonSetList) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "kind":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.TypeMeta.Kind = l.String()
+			}
+		case "apiVersion":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.TypeMeta.APIVersion = l.String()
+			}
+		case "metadata":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.ListMeta.UnmarshalEasyJSON(l)
+			}
+		case "items":
+			if l.IsNull() {
+				l.Skip()
+				in.Items = nil
+			} else {
+				in.Items = make([]DaemonSet, 0)
+				l.Delim('[')
+				for !l.IsDelim(']') {
+					var v0 DaemonSet
+					if l.IsNull() {
+						l.Skip()
+					} else {
+						v0.UnmarshalEasyJSON(l)
+					}
+					in.Items = append(in.Items, v0)
+					l.WantComma()
+				}
+				l.Delim(']')
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
+
 type Deployment struct {
 	metav1.TypeMeta   `json:",inline"`
 	metav1.ObjectMeta `json:"metadata"`
@@ -276,68 +1018,562 @@ func (in *Deployment) DeepCopyObject() runtime.Object {
 	return nil
 }
 
-type DeploymentList struct {
-	metav1.TypeMeta `json:",inline"`
-	metav1.ListMeta `json:"metadata"`
-	Items           []Deployment `json:"items"`
+// DeploymentToSelectableFields returns the fields of Deployment which can be used by the field selector.
+func DeploymentToSelectableFields(obj *Deployment) fields.Set {
+	set := fields.Set{
+		"metadata.name":      obj.ObjectMeta.Name,
+		"metadata.namespace": obj.ObjectMeta.Namespace,
+	}
+	return set
 }
 
-func (in *DeploymentList) DeepCopyInto(out *DeploymentList) {
-	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ListMeta.DeepCopyInto(&out.ListMeta)
-	if in.Items != nil {
-		l := make([]Deployment, len(in.Items))
-		for i := range in.Items {
-			in.Items[i].DeepCopyInto(&l[i])
-		}
-		out.Items = l
-	}
+// DeploymentPatch builds JSON patch or JSON merge patch of Deployment.
+// JSON patch fails if the parent of the field doesn't exist (e.g. SetLabel to the object which has no label). MergePatch creates the parent.
+type DeploymentPatch struct {
+	*patch.Builder
 }
 
-func (in *DeploymentList) DeepCopy() *DeploymentList {
-	if in == nil {
-		return nil
-	}
-	out := new(DeploymentList)
-	in.DeepCopyInto(out)
-	return out
+func NewDeploymentPatch() *DeploymentPatch {
+	return &DeploymentPatch{Builder: patch.NewBuilder()}
 }
 
-func (in *DeploymentList) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
-	}
-	return nil
+func (p *DeploymentPatch) SetLabel(key, value string) *DeploymentPatch {
+	p.Set([]string{"metadata", "labels", key}, value)
+	return p
 }
 
-type ReplicaSet struct {
-	metav1.TypeMeta   `json:",inline"`
-	metav1.ObjectMeta `json:"metadata"`
-	// Spec defines the specification of the desired behavior of the ReplicaSet.
-	// More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#spec-and-status
-	Spec *ReplicaSetSpec `json:"spec,omitempty"`
-	// Status is the most recently observed status of the ReplicaSet.
-	// This data may be out of date by some window of time.
-	// Populated by the system.
-	// Read-only.
-	// More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#spec-and-status
-	Status *ReplicaSetStatus `json:"status,omitempty"`
+func (p *DeploymentPatch) RemoveLabel(key string) *DeploymentPatch {
+	p.Remove([]string{"metadata", "labels", key})
+	return p
 }
 
-func (in *ReplicaSet) DeepCopyInto(out *ReplicaSet) {
-	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	if in.Spec != nil {
-		in, out := &in.Spec, &out.Spec
-		*out = new(ReplicaSetSpec)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.Status != nil {
-		in, out := &in.Status, &out.Status
-		*out = new(ReplicaSetStatus)
-		(*in).DeepCopyInto(*out)
+func (p *DeploymentPatch) SetAnnotation(key, value string) *DeploymentPatch {
+	p.Set([]string{"metadata", "annotations", key}, value)
+	return p
+}
+
+func (p *DeploymentPatch) RemoveAnnotation(key string) *DeploymentPatch {
+	p.Remove([]string{"metadata", "annotations", key})
+	return p
+}
+
+func (p *DeploymentPatch) SetSpec(v *DeploymentSpec) *DeploymentPatch {
+	p.Set([]string{"spec"}, v)
+	return p
+}
+
+func (p *DeploymentPatch) RemoveSpec() *DeploymentPatch {
+	p.Remove([]string{"spec"})
+	return p
+}
+
+func (p *DeploymentPatch) SetSpecReplicas(v int) *DeploymentPatch {
+	p.Set([]string{"spec", "replicas"}, v)
+	return p
+}
+
+func (p *DeploymentPatch) RemoveSpecReplicas() *DeploymentPatch {
+	p.Remove([]string{"spec", "replicas"})
+	return p
+}
+
+func (p *DeploymentPatch) SetSpecSelector(v *metav1.LabelSelector) *DeploymentPatch {
+	p.Set([]string{"spec", "selector"}, v)
+	return p
+}
+
+func (p *DeploymentPatch) RemoveSpecSelector() *DeploymentPatch {
+	p.Remove([]string{"spec", "selector"})
+	return p
+}
+
+func (p *DeploymentPatch) SetSpecTemplate(v corev1.PodTemplateSpec) *DeploymentPatch {
+	p.Set([]string{"spec", "template"}, v)
+	return p
+}
+
+func (p *DeploymentPatch) RemoveSpecTemplate() *DeploymentPatch {
+	p.Remove([]string{"spec", "template"})
+	return p
+}
+
+func (p *DeploymentPatch) SetSpecStrategy(v *DeploymentStrategy) *DeploymentPatch {
+	p.Set([]string{"spec", "strategy"}, v)
+	return p
+}
+
+func (p *DeploymentPatch) RemoveSpecStrategy() *DeploymentPatch {
+	p.Remove([]string{"spec", "strategy"})
+	return p
+}
+
+func (p *DeploymentPatch) SetSpecStrategyType(v DeploymentStrategyType) *DeploymentPatch {
+	p.Set([]string{"spec", "strategy", "type"}, v)
+	return p
+}
+
+func (p *DeploymentPatch) RemoveSpecStrategyType() *DeploymentPatch {
+	p.Remove([]string{"spec", "strategy", "type"})
+	return p
+}
+
+func (p *DeploymentPatch) SetSpecStrategyRollingUpdate(v *RollingUpdateDeployment) *DeploymentPatch {
+	p.Set([]string{"spec", "strategy", "rollingUpdate"}, v)
+	return p
+}
+
+func (p *DeploymentPatch) RemoveSpecStrategyRollingUpdate() *DeploymentPatch {
+	p.Remove([]string{"spec", "strategy", "rollingUpdate"})
+	return p
+}
+
+func (p *DeploymentPatch) SetSpecStrategyRollingUpdateMaxUnavailable(v *utilintstr.IntOrString) *DeploymentPatch {
+	p.Set([]string{"spec", "strategy", "rollingUpdate", "maxUnavailable"}, v)
+	return p
+}
+
+func (p *DeploymentPatch) RemoveSpecStrategyRollingUpdateMaxUnavailable() *DeploymentPatch {
+	p.Remove([]string{"spec", "strategy", "rollingUpdate", "maxUnavailable"})
+	return p
+}
+
+func (p *DeploymentPatch) SetSpecStrategyRollingUpdateMaxSurge(v *utilintstr.IntOrString) *DeploymentPatch {
+	p.Set([]string{"spec", "strategy", "rollingUpdate", "maxSurge"}, v)
+	return p
+}
+
+func (p *DeploymentPatch) RemoveSpecStrategyRollingUpdateMaxSurge() *DeploymentPatch {
+	p.Remove([]string{"spec", "strategy", "rollingUpdate", "maxSurge"})
+	return p
+}
+
+func (p *DeploymentPatch) SetSpecMinReadySeconds(v int) *DeploymentPatch {
+	p.Set([]string{"spec", "minReadySeconds"}, v)
+	return p
+}
+
+func (p *DeploymentPatch) RemoveSpecMinReadySeconds() *DeploymentPatch {
+	p.Remove([]string{"spec", "minReadySeconds"})
+	return p
+}
+
+func (p *DeploymentPatch) SetSpecRevisionHistoryLimit(v int) *DeploymentPatch {
+	p.Set([]string{"spec", "revisionHistoryLimit"}, v)
+	return p
+}
+
+func (p *DeploymentPatch) RemoveSpecRevisionHistoryLimit() *DeploymentPatch {
+	p.Remove([]string{"spec", "revisionHistoryLimit"})
+	return p
+}
+
+func (p *DeploymentPatch) SetSpecPaused(v bool) *DeploymentPatch {
+	p.Set([]string{"spec", "paused"}, v)
+	return p
+}
+
+func (p *DeploymentPatch) RemoveSpecPaused() *DeploymentPatch {
+	p.Remove([]string{"spec", "paused"})
+	return p
+}
+
+func (p *DeploymentPatch) SetSpecProgressDeadlineSeconds(v int) *DeploymentPatch {
+	p.Set([]string{"spec", "progressDeadlineSeconds"}, v)
+	return p
+}
+
+func (p *DeploymentPatch) RemoveSpecProgressDeadlineSeconds() *DeploymentPatch {
+	p.Remove([]string{"spec", "progressDeadlineSeconds"})
+	return p
+}
+
+func (p *DeploymentPatch) SetStatus(v *DeploymentStatus) *DeploymentPatch {
+	p.Set([]string{"status"}, v)
+	return p
+}
+
+func (p *DeploymentPatch) RemoveStatus() *DeploymentPatch {
+	p.Remove([]string{"status"})
+	return p
+}
+
+func (p *DeploymentPatch) SetStatusObservedGeneration(v int64) *DeploymentPatch {
+	p.Set([]string{"status", "observedGeneration"}, v)
+	return p
+}
+
+func (p *DeploymentPatch) RemoveStatusObservedGeneration() *DeploymentPatch {
+	p.Remove([]string{"status", "observedGeneration"})
+	return p
+}
+
+func (p *DeploymentPatch) SetStatusReplicas(v int) *DeploymentPatch {
+	p.Set([]string{"status", "replicas"}, v)
+	return p
+}
+
+func (p *DeploymentPatch) RemoveStatusReplicas() *DeploymentPatch {
+	p.Remove([]string{"status", "replicas"})
+	return p
+}
+
+func (p *DeploymentPatch) SetStatusUpdatedReplicas(v int) *DeploymentPatch {
+	p.Set([]string{"status", "updatedReplicas"}, v)
+	return p
+}
+
+func (p *DeploymentPatch) RemoveStatusUpdatedReplicas() *DeploymentPatch {
+	p.Remove([]string{"status", "updatedReplicas"})
+	return p
+}
+
+func (p *DeploymentPatch) SetStatusReadyReplicas(v int) *DeploymentPatch {
+	p.Set([]string{"status", "readyReplicas"}, v)
+	return p
+}
+
+func (p *DeploymentPatch) RemoveStatusReadyReplicas() *DeploymentPatch {
+	p.Remove([]string{"status", "readyReplicas"})
+	return p
+}
+
+func (p *DeploymentPatch) SetStatusAvailableReplicas(v int) *DeploymentPatch {
+	p.Set([]string{"status", "availableReplicas"}, v)
+	return p
+}
+
+func (p *DeploymentPatch) RemoveStatusAvailableReplicas() *DeploymentPatch {
+	p.Remove([]string{"status", "availableReplicas"})
+	return p
+}
+
+func (p *DeploymentPatch) SetStatusUnavailableReplicas(v int) *DeploymentPatch {
+	p.Set([]string{"status", "unavailableReplicas"}, v)
+	return p
+}
+
+func (p *DeploymentPatch) RemoveStatusUnavailableReplicas() *DeploymentPatch {
+	p.Remove([]string{"status", "unavailableReplicas"})
+	return p
+}
+
+func (p *DeploymentPatch) SetStatusTerminatingReplicas(v int) *DeploymentPatch {
+	p.Set([]string{"status", "terminatingReplicas"}, v)
+	return p
+}
+
+func (p *DeploymentPatch) RemoveStatusTerminatingReplicas() *DeploymentPatch {
+	p.Remove([]string{"status", "terminatingReplicas"})
+	return p
+}
+
+func (p *DeploymentPatch) SetStatusConditions(v []DeploymentCondition) *DeploymentPatch {
+	p.Set([]string{"status", "conditions"}, v)
+	return p
+}
+
+func (p *DeploymentPatch) RemoveStatusConditions() *DeploymentPatch {
+	p.Remove([]string{"status", "conditions"})
+	return p
+}
+
+func (p *DeploymentPatch) AddStatusConditions(v ...DeploymentCondition) *DeploymentPatch {
+	for _, e := range v {
+		p.Append([]string{"status", "conditions"}, e)
+	}
+	return p
+}
+
+func (p *DeploymentPatch) SetStatusCollisionCount(v int) *DeploymentPatch {
+	p.Set([]string{"status", "collisionCount"}, v)
+	return p
+}
+
+func (p *DeploymentPatch) RemoveStatusCollisionCount() *DeploymentPatch {
+	p.Remove([]string{"status", "collisionCount"})
+	return p
+}
+
+func (in *Deployment) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *Deployment) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *Deployment) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	first := true
+	if in.TypeMeta.Kind != "" {
+		w.RawString("\"kind\":")
+		w.String(in.TypeMeta.Kind)
+		first = false
+	}
+	if in.TypeMeta.APIVersion != "" {
+		if !first {
+			w.RawByte(',')
+		}
+		w.RawString("\"apiVersion\":")
+		w.String(in.TypeMeta.APIVersion)
+		first = false
+	}
+	if !first {
+		w.RawByte(',')
+	}
+	w.RawString("\"metadata\":")
+	in.ObjectMeta.MarshalEasyJSON(w)
+	if in.Spec != nil {
+		w.RawString(",\"spec\":")
+		in.Spec.MarshalEasyJSON(w)
+	}
+	if in.Status != nil {
+		w.RawString(",\"status\":")
+		in.Status.MarshalEasyJSON(w)
+	}
+	w.RawByte('}')
+}
+
+func (in *Deployment) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "kind":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.TypeMeta.Kind = l.String()
+			}
+		case "apiVersion":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.TypeMeta.APIVersion = l.String()
+			}
+		case "metadata":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.ObjectMeta.UnmarshalEasyJSON(l)
+			}
+		case "spec":
+			if l.IsNull() {
+				l.Skip()
+				in.Spec = nil
+			} else {
+				if in.Spec == nil {
+					in.Spec = new(DeploymentSpec)
+				}
+				in.Spec.UnmarshalEasyJSON(l)
+			}
+		case "status":
+			if l.IsNull() {
+				l.Skip()
+				in.Status = nil
+			} else {
+				if in.Status == nil {
+					in.Status = new(DeploymentStatus)
+				}
+				in.Status.UnmarshalEasyJSON(l)
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
+
+type DeploymentList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata"`
+	Items           []Deployment `json:"items"`
+}
+
+func (in *DeploymentList) DeepCopyInto(out *DeploymentList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		l := make([]Deployment, len(in.Items))
+		for i := range in.Items {
+			in.Items[i].DeepCopyInto(&l[i])
+		}
+		out.Items = l
+	}
+}
+
+func (in *DeploymentList) DeepCopy() *DeploymentList {
+	if in == nil {
+		return nil
+	}
+	out := new(DeploymentList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DeploymentList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+func (in *DeploymentList) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *DeploymentList) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *DeploymentList) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	first := true
+	if in.TypeMeta.Kind != "" {
+		w.RawString("\"kind\":")
+		w.String(in.TypeMeta.Kind)
+		first = false
+	}
+	if in.TypeMeta.APIVersion != "" {
+		if !first {
+			w.RawByte(',')
+		}
+		w.RawString("\"apiVersion\":")
+		w.String(in.TypeMeta.APIVersion)
+		first = false
+	}
+	if !first {
+		w.RawByte(',')
+	}
+	w.RawString("\"metadata\":")
+	in.ListMeta.MarshalEasyJSON(w)
+	w.RawString(",\"items\":")
+	if in.Items == nil {
+		w.RawString("null")
+	} else {
+		w.RawByte('[')
+		for i0 := range in.Items {
+			if i0 > 0 {
+				w.RawByte(',')
+			}
+			in.Items[i0].MarshalEasyJSON(w)
+		}
+		w.RawByte(']')
+	}
+	w.RawByte('}')
+}
+
+func (in *DeploymentList) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "kind":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.TypeMeta.Kind = l.String()
+			}
+		case "apiVersion":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.TypeMeta.APIVersion = l.String()
+			}
+		case "metadata":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.ListMeta.UnmarshalEasyJSON(l)
+			}
+		case "items":
+			if l.IsNull() {
+				l.Skip()
+				in.Items = nil
+			} else {
+				in.Items = make([]Deployment, 0)
+				l.Delim('[')
+				for !l.IsDelim(']') {
+					var v0 Deployment
+					if l.IsNull() {
+						l.Skip()
+					} else {
+						v0.UnmarshalEasyJSON(l)
+					}
+					in.Items = append(in.Items, v0)
+					l.WantComma()
+				}
+				l.Delim(']')
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
+
+type ReplicaSet struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata"`
+	// Spec defines the specification of the desired behavior of the ReplicaSet.
+	// More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#spec-and-status
+	Spec *ReplicaSetSpec `json:"spec,omitempty"`
+	// Status is the most recently observed status of the ReplicaSet.
+	// This data may be out of date by some window of time.
+	// Populated by the system.
+	// Read-only.
+	// More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#spec-and-status
+	Status *ReplicaSetStatus `json:"status,omitempty"`
+}
+
+func (in *ReplicaSet) DeepCopyInto(out *ReplicaSet) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	if in.Spec != nil {
+		in, out := &in.Spec, &out.Spec
+		*out = new(ReplicaSetSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Status != nil {
+		in, out := &in.Status, &out.Status
+		*out = new(ReplicaSetStatus)
+		(*in).DeepCopyInto(*out)
 	}
 }
 
@@ -357,81 +1593,935 @@ func (in *ReplicaSet) DeepCopyObject() runtime.Object {
 	return nil
 }
 
-type ReplicaSetList struct {
-	metav1.TypeMeta `json:",inline"`
-	metav1.ListMeta `json:"metadata"`
-	Items           []ReplicaSet `json:"items"`
+// ReplicaSetToSelectableFields returns the fields of ReplicaSet which can be used by the field selector.
+func ReplicaSetToSelectableFields(obj *ReplicaSet) fields.Set {
+	set := fields.Set{
+		"metadata.name":      obj.ObjectMeta.Name,
+		"metadata.namespace": obj.ObjectMeta.Namespace,
+	}
+	return set
+}
+
+// ReplicaSetPatch builds JSON patch or JSON merge patch of ReplicaSet.
+// JSON patch fails if the parent of the field doesn't exist (e.g. SetLabel to the object which has no label). MergePatch creates the parent.
+type ReplicaSetPatch struct {
+	*patch.Builder
+}
+
+func NewReplicaSetPatch() *ReplicaSetPatch {
+	return &ReplicaSetPatch{Builder: patch.NewBuilder()}
+}
+
+func (p *ReplicaSetPatch) SetLabel(key, value string) *ReplicaSetPatch {
+	p.Set([]string{"metadata", "labels", key}, value)
+	return p
+}
+
+func (p *ReplicaSetPatch) RemoveLabel(key string) *ReplicaSetPatch {
+	p.Remove([]string{"metadata", "labels", key})
+	return p
+}
+
+func (p *ReplicaSetPatch) SetAnnotation(key, value string) *ReplicaSetPatch {
+	p.Set([]string{"metadata", "annotations", key}, value)
+	return p
+}
+
+func (p *ReplicaSetPatch) RemoveAnnotation(key string) *ReplicaSetPatch {
+	p.Remove([]string{"metadata", "annotations", key})
+	return p
+}
+
+func (p *ReplicaSetPatch) SetSpec(v *ReplicaSetSpec) *ReplicaSetPatch {
+	p.Set([]string{"spec"}, v)
+	return p
+}
+
+func (p *ReplicaSetPatch) RemoveSpec() *ReplicaSetPatch {
+	p.Remove([]string{"spec"})
+	return p
+}
+
+func (p *ReplicaSetPatch) SetSpecReplicas(v int) *ReplicaSetPatch {
+	p.Set([]string{"spec", "replicas"}, v)
+	return p
+}
+
+func (p *ReplicaSetPatch) RemoveSpecReplicas() *ReplicaSetPatch {
+	p.Remove([]string{"spec", "replicas"})
+	return p
+}
+
+func (p *ReplicaSetPatch) SetSpecMinReadySeconds(v int) *ReplicaSetPatch {
+	p.Set([]string{"spec", "minReadySeconds"}, v)
+	return p
+}
+
+func (p *ReplicaSetPatch) RemoveSpecMinReadySeconds() *ReplicaSetPatch {
+	p.Remove([]string{"spec", "minReadySeconds"})
+	return p
+}
+
+func (p *ReplicaSetPatch) SetSpecSelector(v *metav1.LabelSelector) *ReplicaSetPatch {
+	p.Set([]string{"spec", "selector"}, v)
+	return p
+}
+
+func (p *ReplicaSetPatch) RemoveSpecSelector() *ReplicaSetPatch {
+	p.Remove([]string{"spec", "selector"})
+	return p
+}
+
+func (p *ReplicaSetPatch) SetSpecTemplate(v *corev1.PodTemplateSpec) *ReplicaSetPatch {
+	p.Set([]string{"spec", "template"}, v)
+	return p
+}
+
+func (p *ReplicaSetPatch) RemoveSpecTemplate() *ReplicaSetPatch {
+	p.Remove([]string{"spec", "template"})
+	return p
+}
+
+func (p *ReplicaSetPatch) SetStatus(v *ReplicaSetStatus) *ReplicaSetPatch {
+	p.Set([]string{"status"}, v)
+	return p
+}
+
+func (p *ReplicaSetPatch) RemoveStatus() *ReplicaSetPatch {
+	p.Remove([]string{"status"})
+	return p
+}
+
+func (p *ReplicaSetPatch) SetStatusReplicas(v int) *ReplicaSetPatch {
+	p.Set([]string{"status", "replicas"}, v)
+	return p
+}
+
+func (p *ReplicaSetPatch) RemoveStatusReplicas() *ReplicaSetPatch {
+	p.Remove([]string{"status", "replicas"})
+	return p
+}
+
+func (p *ReplicaSetPatch) SetStatusFullyLabeledReplicas(v int) *ReplicaSetPatch {
+	p.Set([]string{"status", "fullyLabeledReplicas"}, v)
+	return p
+}
+
+func (p *ReplicaSetPatch) RemoveStatusFullyLabeledReplicas() *ReplicaSetPatch {
+	p.Remove([]string{"status", "fullyLabeledReplicas"})
+	return p
+}
+
+func (p *ReplicaSetPatch) SetStatusReadyReplicas(v int) *ReplicaSetPatch {
+	p.Set([]string{"status", "readyReplicas"}, v)
+	return p
+}
+
+func (p *ReplicaSetPatch) RemoveStatusReadyReplicas() *ReplicaSetPatch {
+	p.Remove([]string{"status", "readyReplicas"})
+	return p
+}
+
+func (p *ReplicaSetPatch) SetStatusAvailableReplicas(v int) *ReplicaSetPatch {
+	p.Set([]string{"status", "availableReplicas"}, v)
+	return p
+}
+
+func (p *ReplicaSetPatch) RemoveStatusAvailableReplicas() *ReplicaSetPatch {
+	p.Remove([]string{"status", "availableReplicas"})
+	return p
+}
+
+func (p *ReplicaSetPatch) SetStatusTerminatingReplicas(v int) *ReplicaSetPatch {
+	p.Set([]string{"status", "terminatingReplicas"}, v)
+	return p
+}
+
+func (p *ReplicaSetPatch) RemoveStatusTerminatingReplicas() *ReplicaSetPatch {
+	p.Remove([]string{"status", "terminatingReplicas"})
+	return p
+}
+
+func (p *ReplicaSetPatch) SetStatusObservedGeneration(v int64) *ReplicaSetPatch {
+	p.Set([]string{"status", "observedGeneration"}, v)
+	return p
+}
+
+func (p *ReplicaSetPatch) RemoveStatusObservedGeneration() *ReplicaSetPatch {
+	p.Remove([]string{"status", "observedGeneration"})
+	return p
+}
+
+func (p *ReplicaSetPatch) SetStatusConditions(v []ReplicaSetCondition) *ReplicaSetPatch {
+	p.Set([]string{"status", "conditions"}, v)
+	return p
+}
+
+func (p *ReplicaSetPatch) RemoveStatusConditions() *ReplicaSetPatch {
+	p.Remove([]string{"status", "conditions"})
+	return p
+}
+
+func (p *ReplicaSetPatch) AddStatusConditions(v ...ReplicaSetCondition) *ReplicaSetPatch {
+	for _, e := range v {
+		p.Append([]string{"status", "conditions"}, e)
+	}
+	return p
+}
+
+func (in *ReplicaSet) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *ReplicaSet) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *ReplicaSet) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	first := true
+	if in.TypeMeta.Kind != "" {
+		w.RawString("\"kind\":")
+		w.String(in.TypeMeta.Kind)
+		first = false
+	}
+	if in.TypeMeta.APIVersion != "" {
+		if !first {
+			w.RawByte(',')
+		}
+		w.RawString("\"apiVersion\":")
+		w.String(in.TypeMeta.APIVersion)
+		first = false
+	}
+	if !first {
+		w.RawByte(',')
+	}
+	w.RawString("\"metadata\":")
+	in.ObjectMeta.MarshalEasyJSON(w)
+	if in.Spec != nil {
+		w.RawString(",\"spec\":")
+		in.Spec.MarshalEasyJSON(w)
+	}
+	if in.Status != nil {
+		w.RawString(",\"status\":")
+		in.Status.MarshalEasyJSON(w)
+	}
+	w.RawByte('}')
+}
+
+func (in *ReplicaSet) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "kind":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.TypeMeta.Kind = l.String()
+			}
+		case "apiVersion":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.TypeMeta.APIVersion = l.String()
+			}
+		case "metadata":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.ObjectMeta.UnmarshalEasyJSON(l)
+			}
+		case "spec":
+			if l.IsNull() {
+				l.Skip()
+				in.Spec = nil
+			} else {
+				if in.Spec == nil {
+					in.Spec = new(ReplicaSetSpec)
+				}
+				in.Spec.UnmarshalEasyJSON(l)
+			}
+		case "status":
+			if l.IsNull() {
+				l.Skip()
+				in.Status = nil
+			} else {
+				if in.Status == nil {
+					in.Status = new(ReplicaSetStatus)
+				}
+				in.Status.UnmarshalEasyJSON(l)
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
+
+type ReplicaSetList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata"`
+	Items           []ReplicaSet `json:"items"`
+}
+
+func (in *ReplicaSetList) DeepCopyInto(out *ReplicaSetList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		l := make([]ReplicaSet, len(in.Items))
+		for i := range in.Items {
+			in.Items[i].DeepCopyInto(&l[i])
+		}
+		out.Items = l
+	}
+}
+
+func (in *ReplicaSetList) DeepCopy() *ReplicaSetList {
+	if in == nil {
+		return nil
+	}
+	out := new(ReplicaSetList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *ReplicaSetList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+func (in *ReplicaSetList) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *ReplicaSetList) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *ReplicaSetList) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	first := true
+	if in.TypeMeta.Kind != "" {
+		w.RawString("\"kind\":")
+		w.String(in.TypeMeta.Kind)
+		first = false
+	}
+	if in.TypeMeta.APIVersion != "" {
+		if !first {
+			w.RawByte(',')
+		}
+		w.RawString("\"apiVersion\":")
+		w.String(in.TypeMeta.APIVersion)
+		first = false
+	}
+	if !first {
+		w.RawByte(',')
+	}
+	w.RawString("\"metadata\":")
+	in.ListMeta.MarshalEasyJSON(w)
+	w.RawString(",\"items\":")
+	if in.Items == nil {
+		w.RawString("null")
+	} else {
+		w.RawByte('[')
+		for i0 := range in.Items {
+			if i0 > 0 {
+				w.RawByte(',')
+			}
+			in.Items[i0].MarshalEasyJSON(w)
+		}
+		w.RawByte(']')
+	}
+	w.RawByte('}')
+}
+
+func (in *ReplicaSetList) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "kind":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.TypeMeta.Kind = l.String()
+			}
+		case "apiVersion":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.TypeMeta.APIVersion = l.String()
+			}
+		case "metadata":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.ListMeta.UnmarshalEasyJSON(l)
+			}
+		case "items":
+			if l.IsNull() {
+				l.Skip()
+				in.Items = nil
+			} else {
+				in.Items = make([]ReplicaSet, 0)
+				l.Delim('[')
+				for !l.IsDelim(']') {
+					var v0 ReplicaSet
+					if l.IsNull() {
+						l.Skip()
+					} else {
+						v0.UnmarshalEasyJSON(l)
+					}
+					in.Items = append(in.Items, v0)
+					l.WantComma()
+				}
+				l.Delim(']')
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
+
+type StatefulSet struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata"`
+	// Spec defines the desired identities of pods in this set.
+	Spec *StatefulSetSpec `json:"spec,omitempty"`
+	// Status is the current status of Pods in this StatefulSet. This data
+	// may be out of date by some window of time.
+	Status *StatefulSetStatus `json:"status,omitempty"`
+}
+
+func (in *StatefulSet) DeepCopyInto(out *StatefulSet) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	if in.Spec != nil {
+		in, out := &in.Spec, &out.Spec
+		*out = new(StatefulSetSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Status != nil {
+		in, out := &in.Status, &out.Status
+		*out = new(StatefulSetStatus)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+func (in *StatefulSet) DeepCopy() *StatefulSet {
+	if in == nil {
+		return nil
+	}
+	out := new(StatefulSet)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *StatefulSet) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// StatefulSetToSelectableFields returns the fields of StatefulSet which can be used by the field selector.
+func StatefulSetToSelectableFields(obj *StatefulSet) fields.Set {
+	set := fields.Set{
+		"metadata.name":      obj.ObjectMeta.Name,
+		"metadata.namespace": obj.ObjectMeta.Namespace,
+	}
+	return set
+}
+
+// StatefulSetPatch builds JSON patch or JSON merge patch of StatefulSet.
+// JSON patch fails if the parent of the field doesn't exist (e.g. SetLabel to the object which has no label). MergePatch creates the parent.
+type StatefulSetPatch struct {
+	*patch.Builder
+}
+
+func NewStatefulSetPatch() *StatefulSetPatch {
+	return &StatefulSetPatch{Builder: patch.NewBuilder()}
+}
+
+func (p *StatefulSetPatch) SetLabel(key, value string) *StatefulSetPatch {
+	p.Set([]string{"metadata", "labels", key}, value)
+	return p
+}
+
+func (p *StatefulSetPatch) RemoveLabel(key string) *StatefulSetPatch {
+	p.Remove([]string{"metadata", "labels", key})
+	return p
+}
+
+func (p *StatefulSetPatch) SetAnnotation(key, value string) *StatefulSetPatch {
+	p.Set([]string{"metadata", "annotations", key}, value)
+	return p
+}
+
+func (p *StatefulSetPatch) RemoveAnnotation(key string) *StatefulSetPatch {
+	p.Remove([]string{"metadata", "annotations", key})
+	return p
+}
+
+func (p *StatefulSetPatch) SetSpec(v *StatefulSetSpec) *StatefulSetPatch {
+	p.Set([]string{"spec"}, v)
+	return p
+}
+
+func (p *StatefulSetPatch) RemoveSpec() *StatefulSetPatch {
+	p.Remove([]string{"spec"})
+	return p
+}
+
+func (p *StatefulSetPatch) SetSpecReplicas(v int) *StatefulSetPatch {
+	p.Set([]string{"spec", "replicas"}, v)
+	return p
+}
+
+func (p *StatefulSetPatch) RemoveSpecReplicas() *StatefulSetPatch {
+	p.Remove([]string{"spec", "replicas"})
+	return p
+}
+
+func (p *StatefulSetPatch) SetSpecSelector(v *metav1.LabelSelector) *StatefulSetPatch {
+	p.Set([]string{"spec", "selector"}, v)
+	return p
+}
+
+func (p *StatefulSetPatch) RemoveSpecSelector() *StatefulSetPatch {
+	p.Remove([]string{"spec", "selector"})
+	return p
+}
+
+func (p *StatefulSetPatch) SetSpecTemplate(v corev1.PodTemplateSpec) *StatefulSetPatch {
+	p.Set([]string{"spec", "template"}, v)
+	return p
+}
+
+func (p *StatefulSetPatch) RemoveSpecTemplate() *StatefulSetPatch {
+	p.Remove([]string{"spec", "template"})
+	return p
+}
+
+func (p *StatefulSetPatch) SetSpecVolumeClaimTemplates(v []corev1.PersistentVolumeClaim) *StatefulSetPatch {
+	p.Set([]string{"spec", "volumeClaimTemplates"}, v)
+	return p
+}
+
+func (p *StatefulSetPatch) RemoveSpecVolumeClaimTemplates() *StatefulSetPatch {
+	p.Remove([]string{"spec", "volumeClaimTemplates"})
+	return p
+}
+
+func (p *StatefulSetPatch) AddSpecVolumeClaimTemplates(v ...corev1.PersistentVolumeClaim) *StatefulSetPatch {
+	for _, e := range v {
+		p.Append([]string{"spec", "volumeClaimTemplates"}, e)
+	}
+	return p
+}
+
+func (p *StatefulSetPatch) SetSpecServiceName(v string) *StatefulSetPatch {
+	p.Set([]string{"spec", "serviceName"}, v)
+	return p
+}
+
+func (p *StatefulSetPatch) RemoveSpecServiceName() *StatefulSetPatch {
+	p.Remove([]string{"spec", "serviceName"})
+	return p
+}
+
+func (p *StatefulSetPatch) SetSpecPodManagementPolicy(v PodManagementPolicyType) *StatefulSetPatch {
+	p.Set([]string{"spec", "podManagementPolicy"}, v)
+	return p
+}
+
+func (p *StatefulSetPatch) RemoveSpecPodManagementPolicy() *StatefulSetPatch {
+	p.Remove([]string{"spec", "podManagementPolicy"})
+	return p
+}
+
+func (p *StatefulSetPatch) SetSpecUpdateStrategy(v *StatefulSetUpdateStrategy) *StatefulSetPatch {
+	p.Set([]string{"spec", "updateStrategy"}, v)
+	return p
+}
+
+func (p *StatefulSetPatch) RemoveSpecUpdateStrategy() *StatefulSetPatch {
+	p.Remove([]string{"spec", "updateStrategy"})
+	return p
+}
+
+func (p *StatefulSetPatch) SetSpecUpdateStrategyType(v StatefulSetUpdateStrategyType) *StatefulSetPatch {
+	p.Set([]string{"spec", "updateStrategy", "type"}, v)
+	return p
+}
+
+func (p *StatefulSetPatch) RemoveSpecUpdateStrategyType() *StatefulSetPatch {
+	p.Remove([]string{"spec", "updateStrategy", "type"})
+	return p
+}
+
+func (p *StatefulSetPatch) SetSpecUpdateStrategyRollingUpdate(v *RollingUpdateStatefulSetStrategy) *StatefulSetPatch {
+	p.Set([]string{"spec", "updateStrategy", "rollingUpdate"}, v)
+	return p
+}
+
+func (p *StatefulSetPatch) RemoveSpecUpdateStrategyRollingUpdate() *StatefulSetPatch {
+	p.Remove([]string{"spec", "updateStrategy", "rollingUpdate"})
+	return p
+}
+
+func (p *StatefulSetPatch) SetSpecUpdateStrategyRollingUpdatePartition(v int) *StatefulSetPatch {
+	p.Set([]string{"spec", "updateStrategy", "rollingUpdate", "partition"}, v)
+	return p
+}
+
+func (p *StatefulSetPatch) RemoveSpecUpdateStrategyRollingUpdatePartition() *StatefulSetPatch {
+	p.Remove([]string{"spec", "updateStrategy", "rollingUpdate", "partition"})
+	return p
+}
+
+func (p *StatefulSetPatch) SetSpecUpdateStrategyRollingUpdateMaxUnavailable(v *utilintstr.IntOrString) *StatefulSetPatch {
+	p.Set([]string{"spec", "updateStrategy", "rollingUpdate", "maxUnavailable"}, v)
+	return p
+}
+
+func (p *StatefulSetPatch) RemoveSpecUpdateStrategyRollingUpdateMaxUnavailable() *StatefulSetPatch {
+	p.Remove([]string{"spec", "updateStrategy", "rollingUpdate", "maxUnavailable"})
+	return p
+}
+
+func (p *StatefulSetPatch) SetSpecRevisionHistoryLimit(v int) *StatefulSetPatch {
+	p.Set([]string{"spec", "revisionHistoryLimit"}, v)
+	return p
+}
+
+func (p *StatefulSetPatch) RemoveSpecRevisionHistoryLimit() *StatefulSetPatch {
+	p.Remove([]string{"spec", "revisionHistoryLimit"})
+	return p
+}
+
+func (p *StatefulSetPatch) SetSpecMinReadySeconds(v int) *StatefulSetPatch {
+	p.Set([]string{"spec", "minReadySeconds"}, v)
+	return p
+}
+
+func (p *StatefulSetPatch) RemoveSpecMinReadySeconds() *StatefulSetPatch {
+	p.Remove([]string{"spec", "minReadySeconds"})
+	return p
+}
+
+func (p *StatefulSetPatch) SetSpecPersistentVolumeClaimRetentionPolicy(v *StatefulSetPersistentVolumeClaimRetentionPolicy) *StatefulSetPatch {
+	p.Set([]string{"spec", "persistentVolumeClaimRetentionPolicy"}, v)
+	return p
+}
+
+func (p *StatefulSetPatch) RemoveSpecPersistentVolumeClaimRetentionPolicy() *StatefulSetPatch {
+	p.Remove([]string{"spec", "persistentVolumeClaimRetentionPolicy"})
+	return p
+}
+
+func (p *StatefulSetPatch) SetSpecPersistentVolumeClaimRetentionPolicyWhenDeleted(v PersistentVolumeClaimRetentionPolicyType) *StatefulSetPatch {
+	p.Set([]string{"spec", "persistentVolumeClaimRetentionPolicy", "whenDeleted"}, v)
+	return p
+}
+
+func (p *StatefulSetPatch) RemoveSpecPersistentVolumeClaimRetentionPolicyWhenDeleted() *StatefulSetPatch {
+	p.Remove([]string{"spec", "persistentVolumeClaimRetentionPolicy", "whenDeleted"})
+	return p
+}
+
+func (p *StatefulSetPatch) SetSpecPersistentVolumeClaimRetentionPolicyWhenScaled(v PersistentVolumeClaimRetentionPolicyType) *StatefulSetPatch {
+	p.Set([]string{"spec", "persistentVolumeClaimRetentionPolicy", "whenScaled"}, v)
+	return p
+}
+
+func (p *StatefulSetPatch) RemoveSpecPersistentVolumeClaimRetentionPolicyWhenScaled() *StatefulSetPatch {
+	p.Remove([]string{"spec", "persistentVolumeClaimRetentionPolicy", "whenScaled"})
+	return p
+}
+
+func (p *StatefulSetPatch) SetSpecOrdinals(v *StatefulSetOrdinals) *StatefulSetPatch {
+	p.Set([]string{"spec", "ordinals"}, v)
+	return p
+}
+
+func (p *StatefulSetPatch) RemoveSpecOrdinals() *StatefulSetPatch {
+	p.Remove([]string{"spec", "ordinals"})
+	return p
+}
+
+func (p *StatefulSetPatch) SetSpecOrdinalsStart(v int) *StatefulSetPatch {
+	p.Set([]string{"spec", "ordinals", "start"}, v)
+	return p
+}
+
+func (p *StatefulSetPatch) RemoveSpecOrdinalsStart() *StatefulSetPatch {
+	p.Remove([]string{"spec", "ordinals", "start"})
+	return p
+}
+
+func (p *StatefulSetPatch) SetStatus(v *StatefulSetStatus) *StatefulSetPatch {
+	p.Set([]string{"status"}, v)
+	return p
+}
+
+func (p *StatefulSetPatch) RemoveStatus() *StatefulSetPatch {
+	p.Remove([]string{"status"})
+	return p
+}
+
+func (p *StatefulSetPatch) SetStatusObservedGeneration(v int64) *StatefulSetPatch {
+	p.Set([]string{"status", "observedGeneration"}, v)
+	return p
+}
+
+func (p *StatefulSetPatch) RemoveStatusObservedGeneration() *StatefulSetPatch {
+	p.Remove([]string{"status", "observedGeneration"})
+	return p
+}
+
+func (p *StatefulSetPatch) SetStatusReplicas(v int) *StatefulSetPatch {
+	p.Set([]string{"status", "replicas"}, v)
+	return p
+}
+
+func (p *StatefulSetPatch) RemoveStatusReplicas() *StatefulSetPatch {
+	p.Remove([]string{"status", "replicas"})
+	return p
+}
+
+func (p *StatefulSetPatch) SetStatusReadyReplicas(v int) *StatefulSetPatch {
+	p.Set([]string{"status", "readyReplicas"}, v)
+	return p
+}
+
+func (p *StatefulSetPatch) RemoveStatusReadyReplicas() *StatefulSetPatch {
+	p.Remove([]string{"status", "readyReplicas"})
+	return p
+}
+
+func (p *StatefulSetPatch) SetStatusCurrentReplicas(v int) *StatefulSetPatch {
+	p.Set([]string{"status", "currentReplicas"}, v)
+	return p
+}
+
+func (p *StatefulSetPatch) RemoveStatusCurrentReplicas() *StatefulSetPatch {
+	p.Remove([]string{"status", "currentReplicas"})
+	return p
+}
+
+func (p *StatefulSetPatch) SetStatusUpdatedReplicas(v int) *StatefulSetPatch {
+	p.Set([]string{"status", "updatedReplicas"}, v)
+	return p
+}
+
+func (p *StatefulSetPatch) RemoveStatusUpdatedReplicas() *StatefulSetPatch {
+	p.Remove([]string{"status", "updatedReplicas"})
+	return p
+}
+
+func (p *StatefulSetPatch) SetStatusCurrentRevision(v string) *StatefulSetPatch {
+	p.Set([]string{"status", "currentRevision"}, v)
+	return p
+}
+
+func (p *StatefulSetPatch) RemoveStatusCurrentRevision() *StatefulSetPatch {
+	p.Remove([]string{"status", "currentRevision"})
+	return p
+}
+
+func (p *StatefulSetPatch) SetStatusUpdateRevision(v string) *StatefulSetPatch {
+	p.Set([]string{"status", "updateRevision"}, v)
+	return p
+}
+
+func (p *StatefulSetPatch) RemoveStatusUpdateRevision() *StatefulSetPatch {
+	p.Remove([]string{"status", "updateRevision"})
+	return p
+}
+
+func (p *StatefulSetPatch) SetStatusCollisionCount(v int) *StatefulSetPatch {
+	p.Set([]string{"status", "collisionCount"}, v)
+	return p
+}
+
+func (p *StatefulSetPatch) RemoveStatusCollisionCount() *StatefulSetPatch {
+	p.Remove([]string{"status", "collisionCount"})
+	return p
+}
+
+func (p *StatefulSetPatch) SetStatusConditions(v []StatefulSetCondition) *StatefulSetPatch {
+	p.Set([]string{"status", "conditions"}, v)
+	return p
 }
 
-func (in *ReplicaSetList) DeepCopyInto(out *ReplicaSetList) {
-	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ListMeta.DeepCopyInto(&out.ListMeta)
-	if in.Items != nil {
-		l := make([]ReplicaSet, len(in.Items))
-		for i := range in.Items {
-			in.Items[i].DeepCopyInto(&l[i])
-		}
-		out.Items = l
-	}
+func (p *StatefulSetPatch) RemoveStatusConditions() *StatefulSetPatch {
+	p.Remove([]string{"status", "conditions"})
+	return p
 }
 
-func (in *ReplicaSetList) DeepCopy() *ReplicaSetList {
-	if in == nil {
-		return nil
+func (p *StatefulSetPatch) AddStatusConditions(v ...StatefulSetCondition) *StatefulSetPatch {
+	for _, e := range v {
+		p.Append([]string{"status", "conditions"}, e)
 	}
-	out := new(ReplicaSetList)
-	in.DeepCopyInto(out)
-	return out
+	return p
 }
 
-func (in *ReplicaSetList) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
-	}
-	return nil
+func (p *StatefulSetPatch) SetStatusAvailableReplicas(v int) *StatefulSetPatch {
+	p.Set([]string{"status", "availableReplicas"}, v)
+	return p
 }
 
-type StatefulSet struct {
-	metav1.TypeMeta   `json:",inline"`
-	metav1.ObjectMeta `json:"metadata"`
-	// Spec defines the desired identities of pods in this set.
-	Spec *StatefulSetSpec `json:"spec,omitempty"`
-	// Status is the current status of Pods in this StatefulSet. This data
-	// may be out of date by some window of time.
-	Status *StatefulSetStatus `json:"status,omitempty"`
+func (p *StatefulSetPatch) RemoveStatusAvailableReplicas() *StatefulSetPatch {
+	p.Remove([]string{"status", "availableReplicas"})
+	return p
 }
 
-func (in *StatefulSet) DeepCopyInto(out *StatefulSet) {
-	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+func (in *StatefulSet) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *StatefulSet) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *StatefulSet) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	first := true
+	if in.TypeMeta.Kind != "" {
+		w.RawString("\"kind\":")
+		w.String(in.TypeMeta.Kind)
+		first = false
+	}
+	if in.TypeMeta.APIVersion != "" {
+		if !first {
+			w.RawByte(',')
+		}
+		w.RawString("\"apiVersion\":")
+		w.String(in.TypeMeta.APIVersion)
+		first = false
+	}
+	if !first {
+		w.RawByte(',')
+	}
+	w.RawString("\"metadata\":")
+	in.ObjectMeta.MarshalEasyJSON(w)
 	if in.Spec != nil {
-		in, out := &in.Spec, &out.Spec
-		*out = new(StatefulSetSpec)
-		(*in).DeepCopyInto(*out)
+		w.RawString(",\"spec\":")
+		in.Spec.MarshalEasyJSON(w)
 	}
 	if in.Status != nil {
-		in, out := &in.Status, &out.Status
-		*out = new(StatefulSetStatus)
-		(*in).DeepCopyInto(*out)
+		w.RawString(",\"status\":")
+		in.Status.MarshalEasyJSON(w)
 	}
+	w.RawByte('}')
 }
 
-func (in *StatefulSet) DeepCopy() *StatefulSet {
-	if in == nil {
-		return nil
+func (in *StatefulSet) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
 	}
-	out := new(StatefulSet)
-	in.DeepCopyInto(out)
-	return out
-}
-
-func (in *StatefulSet) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "kind":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.TypeMeta.Kind = l.String()
+			}
+		case "apiVersion":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.TypeMeta.APIVersion = l.String()
+			}
+		case "metadata":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.ObjectMeta.UnmarshalEasyJSON(l)
+			}
+		case "spec":
+			if l.IsNull() {
+				l.Skip()
+				in.Spec = nil
+			} else {
+				if in.Spec == nil {
+					in.Spec = new(StatefulSetSpec)
+				}
+				in.Spec.UnmarshalEasyJSON(l)
+			}
+		case "status":
+			if l.IsNull() {
+				l.Skip()
+				in.Status = nil
+			} else {
+				if in.Status == nil {
+					in.Status = new(StatefulSetStatus)
+				}
+				in.Status.UnmarshalEasyJSON(l)
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
 	}
-	return nil
 }
 
 type StatefulSetList struct {
@@ -469,6 +2559,117 @@ func (in *StatefulSetList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+func (in *StatefulSetList) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *StatefulSetList) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *StatefulSetList) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	first := true
+	if in.TypeMeta.Kind != "" {
+		w.RawString("\"kind\":")
+		w.String(in.TypeMeta.Kind)
+		first = false
+	}
+	if in.TypeMeta.APIVersion != "" {
+		if !first {
+			w.RawByte(',')
+		}
+		w.RawString("\"apiVersion\":")
+		w.String(in.TypeMeta.APIVersion)
+		first = false
+	}
+	if !first {
+		w.RawByte(',')
+	}
+	w.RawString("\"metadata\":")
+	in.ListMeta.MarshalEasyJSON(w)
+	w.RawString(",\"items\":")
+	if in.Items == nil {
+		w.RawString("null")
+	} else {
+		w.RawByte('[')
+		for i0 := range in.Items {
+			if i0 > 0 {
+				w.RawByte(',')
+			}
+			in.Items[i0].MarshalEasyJSON(w)
+		}
+		w.RawByte(']')
+	}
+	w.RawByte('}')
+}
+
+func (in *StatefulSetList) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "kind":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.TypeMeta.Kind = l.String()
+			}
+		case "apiVersion":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.TypeMeta.APIVersion = l.String()
+			}
+		case "metadata":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.ListMeta.UnmarshalEasyJSON(l)
+			}
+		case "items":
+			if l.IsNull() {
+				l.Skip()
+				in.Items = nil
+			} else {
+				in.Items = make([]StatefulSet, 0)
+				l.Delim('[')
+				for !l.IsDelim(']') {
+					var v0 StatefulSet
+					if l.IsNull() {
+						l.Skip()
+					} else {
+						v0.UnmarshalEasyJSON(l)
+					}
+					in.Items = append(in.Items, v0)
+					l.WantComma()
+				}
+				l.Delim(']')
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
+
 type DaemonSetSpec struct {
 	// A label query over pods that are managed by the daemon set.
 	// Must match in order to be controlled.
@@ -519,6 +2720,109 @@ func (in *DaemonSetSpec) DeepCopy() *DaemonSetSpec {
 	return out
 }
 
+func (in *DaemonSetSpec) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *DaemonSetSpec) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *DaemonSetSpec) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	first := true
+	if in.Selector != nil {
+		w.RawString("\"selector\":")
+		in.Selector.MarshalEasyJSON(w)
+		first = false
+	}
+	if !first {
+		w.RawByte(',')
+	}
+	w.RawString("\"template\":")
+	in.Template.MarshalEasyJSON(w)
+	if in.UpdateStrategy != nil {
+		w.RawString(",\"updateStrategy\":")
+		in.UpdateStrategy.MarshalEasyJSON(w)
+	}
+	if in.MinReadySeconds != 0 {
+		w.RawString(",\"minReadySeconds\":")
+		w.Int(in.MinReadySeconds)
+	}
+	if in.RevisionHistoryLimit != 0 {
+		w.RawString(",\"revisionHistoryLimit\":")
+		w.Int(in.RevisionHistoryLimit)
+	}
+	w.RawByte('}')
+}
+
+func (in *DaemonSetSpec) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "selector":
+			if l.IsNull() {
+				l.Skip()
+				in.Selector = nil
+			} else {
+				if in.Selector == nil {
+					in.Selector = new(metav1.LabelSelector)
+				}
+				in.Selector.UnmarshalEasyJSON(l)
+			}
+		case "template":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.Template.UnmarshalEasyJSON(l)
+			}
+		case "updateStrategy":
+			if l.IsNull() {
+				l.Skip()
+				in.UpdateStrategy = nil
+			} else {
+				if in.UpdateStrategy == nil {
+					in.UpdateStrategy = new(DaemonSetUpdateStrategy)
+				}
+				in.UpdateStrategy.UnmarshalEasyJSON(l)
+			}
+		case "minReadySeconds":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.MinReadySeconds = l.Int()
+			}
+		case "revisionHistoryLimit":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.RevisionHistoryLimit = l.Int()
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
+
 type DaemonSetStatus struct {
 	// The number of nodes that are running at least 1
 	// daemon pod and are supposed to run the daemon pod.
@@ -575,6 +2879,163 @@ func (in *DaemonSetStatus) DeepCopy() *DaemonSetStatus {
 	return out
 }
 
+func (in *DaemonSetStatus) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *DaemonSetStatus) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *DaemonSetStatus) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	w.RawString("\"currentNumberScheduled\":")
+	w.Int(in.CurrentNumberScheduled)
+	w.RawString(",\"numberMisscheduled\":")
+	w.Int(in.NumberMisscheduled)
+	w.RawString(",\"desiredNumberScheduled\":")
+	w.Int(in.DesiredNumberScheduled)
+	w.RawString(",\"numberReady\":")
+	w.Int(in.NumberReady)
+	if in.ObservedGeneration != 0 {
+		w.RawString(",\"observedGeneration\":")
+		w.Int64(in.ObservedGeneration)
+	}
+	if in.UpdatedNumberScheduled != 0 {
+		w.RawString(",\"updatedNumberScheduled\":")
+		w.Int(in.UpdatedNumberScheduled)
+	}
+	if in.NumberAvailable != 0 {
+		w.RawString(",\"numberAvailable\":")
+		w.Int(in.NumberAvailable)
+	}
+	if in.NumberUnavailable != 0 {
+		w.RawString(",\"numberUnavailable\":")
+		w.Int(in.NumberUnavailable)
+	}
+	if in.CollisionCount != 0 {
+		w.RawString(",\"collisionCount\":")
+		w.Int(in.CollisionCount)
+	}
+	w.RawString(",\"conditions\":")
+	if in.Conditions == nil {
+		w.RawString("null")
+	} else {
+		w.RawByte('[')
+		for i0 := range in.Conditions {
+			if i0 > 0 {
+				w.RawByte(',')
+			}
+			in.Conditions[i0].MarshalEasyJSON(w)
+		}
+		w.RawByte(']')
+	}
+
+	w.RawByte('}')
+}
+
+func (in *DaemonSetStatus) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "currentNumberScheduled":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.CurrentNumberScheduled = l.Int()
+			}
+		case "numberMisscheduled":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.NumberMisscheduled = l.Int()
+			}
+		case "desiredNumberScheduled":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.DesiredNumberScheduled = l.Int()
+			}
+		case "numberReady":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.NumberReady = l.Int()
+			}
+		case "observedGeneration":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.ObservedGeneration = l.Int64()
+			}
+		case "updatedNumberScheduled":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.UpdatedNumberScheduled = l.Int()
+			}
+		case "numberAvailable":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.NumberAvailable = l.Int()
+			}
+		case "numberUnavailable":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.NumberUnavailable = l.Int()
+			}
+		case "collisionCount":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.CollisionCount = l.Int()
+			}
+		case "conditions":
+			if l.IsNull() {
+				l.Skip()
+				in.Conditions = nil
+			} else {
+				in.Conditions = make([]DaemonSetCondition, 0)
+				l.Delim('[')
+				for !l.IsDelim(']') {
+					var v0 DaemonSetCondition
+					if l.IsNull() {
+						l.Skip()
+					} else {
+						v0.UnmarshalEasyJSON(l)
+					}
+					in.Conditions = append(in.Conditions, v0)
+					l.WantComma()
+				}
+				l.Delim(']')
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
+
 type DeploymentSpec struct {
 	// Number of desired pods. This is a pointer to distinguish between explicit
 	// zero and not specified. Defaults to 1.
@@ -630,6 +3091,143 @@ func (in *DeploymentSpec) DeepCopy() *DeploymentSpec {
 	return out
 }
 
+func (in *DeploymentSpec) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *DeploymentSpec) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *DeploymentSpec) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	first := true
+	if in.Replicas != 0 {
+		w.RawString("\"replicas\":")
+		w.Int(in.Replicas)
+		first = false
+	}
+	if in.Selector != nil {
+		if !first {
+			w.RawByte(',')
+		}
+		w.RawString("\"selector\":")
+		in.Selector.MarshalEasyJSON(w)
+		first = false
+	}
+	if !first {
+		w.RawByte(',')
+	}
+	w.RawString("\"template\":")
+	in.Template.MarshalEasyJSON(w)
+	if in.Strategy != nil {
+		w.RawString(",\"strategy\":")
+		in.Strategy.MarshalEasyJSON(w)
+	}
+	if in.MinReadySeconds != 0 {
+		w.RawString(",\"minReadySeconds\":")
+		w.Int(in.MinReadySeconds)
+	}
+	if in.RevisionHistoryLimit != 0 {
+		w.RawString(",\"revisionHistoryLimit\":")
+		w.Int(in.RevisionHistoryLimit)
+	}
+	if in.Paused {
+		w.RawString(",\"paused\":")
+		w.Bool(in.Paused)
+	}
+	if in.ProgressDeadlineSeconds != 0 {
+		w.RawString(",\"progressDeadlineSeconds\":")
+		w.Int(in.ProgressDeadlineSeconds)
+	}
+	w.RawByte('}')
+}
+
+func (in *DeploymentSpec) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "replicas":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.Replicas = l.Int()
+			}
+		case "selector":
+			if l.IsNull() {
+				l.Skip()
+				in.Selector = nil
+			} else {
+				if in.Selector == nil {
+					in.Selector = new(metav1.LabelSelector)
+				}
+				in.Selector.UnmarshalEasyJSON(l)
+			}
+		case "template":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.Template.UnmarshalEasyJSON(l)
+			}
+		case "strategy":
+			if l.IsNull() {
+				l.Skip()
+				in.Strategy = nil
+			} else {
+				if in.Strategy == nil {
+					in.Strategy = new(DeploymentStrategy)
+				}
+				in.Strategy.UnmarshalEasyJSON(l)
+			}
+		case "minReadySeconds":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.MinReadySeconds = l.Int()
+			}
+		case "revisionHistoryLimit":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.RevisionHistoryLimit = l.Int()
+			}
+		case "paused":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.Paused = l.Bool()
+			}
+		case "progressDeadlineSeconds":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.ProgressDeadlineSeconds = l.Int()
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
+
 type DeploymentStatus struct {
 	// The generation observed by the deployment controller.
 	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
@@ -677,6 +3275,189 @@ func (in *DeploymentStatus) DeepCopy() *DeploymentStatus {
 	return out
 }
 
+func (in *DeploymentStatus) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *DeploymentStatus) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *DeploymentStatus) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	first := true
+	if in.ObservedGeneration != 0 {
+		w.RawString("\"observedGeneration\":")
+		w.Int64(in.ObservedGeneration)
+		first = false
+	}
+	if in.Replicas != 0 {
+		if !first {
+			w.RawByte(',')
+		}
+		w.RawString("\"replicas\":")
+		w.Int(in.Replicas)
+		first = false
+	}
+	if in.UpdatedReplicas != 0 {
+		if !first {
+			w.RawByte(',')
+		}
+		w.RawString("\"updatedReplicas\":")
+		w.Int(in.UpdatedReplicas)
+		first = false
+	}
+	if in.ReadyReplicas != 0 {
+		if !first {
+			w.RawByte(',')
+		}
+		w.RawString("\"readyReplicas\":")
+		w.Int(in.ReadyReplicas)
+		first = false
+	}
+	if in.AvailableReplicas != 0 {
+		if !first {
+			w.RawByte(',')
+		}
+		w.RawString("\"availableReplicas\":")
+		w.Int(in.AvailableReplicas)
+		first = false
+	}
+	if in.UnavailableReplicas != 0 {
+		if !first {
+			w.RawByte(',')
+		}
+		w.RawString("\"unavailableReplicas\":")
+		w.Int(in.UnavailableReplicas)
+		first = false
+	}
+	if in.TerminatingReplicas != 0 {
+		if !first {
+			w.RawByte(',')
+		}
+		w.RawString("\"terminatingReplicas\":")
+		w.Int(in.TerminatingReplicas)
+		first = false
+	}
+	if !first {
+		w.RawByte(',')
+	}
+	w.RawString("\"conditions\":")
+	if in.Conditions == nil {
+		w.RawString("null")
+	} else {
+		w.RawByte('[')
+		for i0 := range in.Conditions {
+			if i0 > 0 {
+				w.RawByte(',')
+			}
+			in.Conditions[i0].MarshalEasyJSON(w)
+		}
+		w.RawByte(']')
+	}
+	if in.CollisionCount != 0 {
+		w.RawString(",\"collisionCount\":")
+		w.Int(in.CollisionCount)
+	}
+	w.RawByte('}')
+}
+
+func (in *DeploymentStatus) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "observedGeneration":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.ObservedGeneration = l.Int64()
+			}
+		case "replicas":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.Replicas = l.Int()
+			}
+		case "updatedReplicas":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.UpdatedReplicas = l.Int()
+			}
+		case "readyReplicas":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.ReadyReplicas = l.Int()
+			}
+		case "availableReplicas":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.AvailableReplicas = l.Int()
+			}
+		case "unavailableReplicas":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.UnavailableReplicas = l.Int()
+			}
+		case "terminatingReplicas":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.TerminatingReplicas = l.Int()
+			}
+		case "conditions":
+			if l.IsNull() {
+				l.Skip()
+				in.Conditions = nil
+			} else {
+				in.Conditions = make([]DeploymentCondition, 0)
+				l.Delim('[')
+				for !l.IsDelim(']') {
+					var v0 DeploymentCondition
+					if l.IsNull() {
+						l.Skip()
+					} else {
+						v0.UnmarshalEasyJSON(l)
+					}
+					in.Conditions = append(in.Conditions, v0)
+					l.WantComma()
+				}
+				l.Delim(']')
+			}
+		case "collisionCount":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.CollisionCount = l.Int()
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
+
 type ReplicaSetSpec struct {
 	// Replicas is the number of desired pods.
 	// This is a pointer to distinguish between explicit zero and unspecified.
@@ -721,6 +3502,110 @@ func (in *ReplicaSetSpec) DeepCopy() *ReplicaSetSpec {
 	return out
 }
 
+func (in *ReplicaSetSpec) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *ReplicaSetSpec) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *ReplicaSetSpec) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	first := true
+	if in.Replicas != 0 {
+		w.RawString("\"replicas\":")
+		w.Int(in.Replicas)
+		first = false
+	}
+	if in.MinReadySeconds != 0 {
+		if !first {
+			w.RawByte(',')
+		}
+		w.RawString("\"minReadySeconds\":")
+		w.Int(in.MinReadySeconds)
+		first = false
+	}
+	if in.Selector != nil {
+		if !first {
+			w.RawByte(',')
+		}
+		w.RawString("\"selector\":")
+		in.Selector.MarshalEasyJSON(w)
+		first = false
+	}
+	if in.Template != nil {
+		if !first {
+			w.RawByte(',')
+		}
+		w.RawString("\"template\":")
+		in.Template.MarshalEasyJSON(w)
+		first = false
+	}
+	w.RawByte('}')
+}
+
+func (in *ReplicaSetSpec) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "replicas":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.Replicas = l.Int()
+			}
+		case "minReadySeconds":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.MinReadySeconds = l.Int()
+			}
+		case "selector":
+			if l.IsNull() {
+				l.Skip()
+				in.Selector = nil
+			} else {
+				if in.Selector == nil {
+					in.Selector = new(metav1.LabelSelector)
+				}
+				in.Selector.UnmarshalEasyJSON(l)
+			}
+		case "template":
+			if l.IsNull() {
+				l.Skip()
+				in.Template = nil
+			} else {
+				if in.Template == nil {
+					in.Template = new(corev1.PodTemplateSpec)
+				}
+				in.Template.UnmarshalEasyJSON(l)
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
+
 type ReplicaSetStatus struct {
 	// Replicas is the most recently observed number of non-terminating pods.
 	// More info: https://kubernetes.io/docs/concepts/workloads/controllers/replicaset
@@ -761,6 +3646,139 @@ func (in *ReplicaSetStatus) DeepCopy() *ReplicaSetStatus {
 	return out
 }
 
+func (in *ReplicaSetStatus) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *ReplicaSetStatus) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *ReplicaSetStatus) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	w.RawString("\"replicas\":")
+	w.Int(in.Replicas)
+	if in.FullyLabeledReplicas != 0 {
+		w.RawString(",\"fullyLabeledReplicas\":")
+		w.Int(in.FullyLabeledReplicas)
+	}
+	if in.ReadyReplicas != 0 {
+		w.RawString(",\"readyReplicas\":")
+		w.Int(in.ReadyReplicas)
+	}
+	if in.AvailableReplicas != 0 {
+		w.RawString(",\"availableReplicas\":")
+		w.Int(in.AvailableReplicas)
+	}
+	if in.TerminatingReplicas != 0 {
+		w.RawString(",\"terminatingReplicas\":")
+		w.Int(in.TerminatingReplicas)
+	}
+	if in.ObservedGeneration != 0 {
+		w.RawString(",\"observedGeneration\":")
+		w.Int64(in.ObservedGeneration)
+	}
+	w.RawString(",\"conditions\":")
+	if in.Conditions == nil {
+		w.RawString("null")
+	} else {
+		w.RawByte('[')
+		for i0 := range in.Conditions {
+			if i0 > 0 {
+				w.RawByte(',')
+			}
+			in.Conditions[i0].MarshalEasyJSON(w)
+		}
+		w.RawByte(']')
+	}
+
+	w.RawByte('}')
+}
+
+func (in *ReplicaSetStatus) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "replicas":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.Replicas = l.Int()
+			}
+		case "fullyLabeledReplicas":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.FullyLabeledReplicas = l.Int()
+			}
+		case "readyReplicas":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.ReadyReplicas = l.Int()
+			}
+		case "availableReplicas":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.AvailableReplicas = l.Int()
+			}
+		case "terminatingReplicas":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.TerminatingReplicas = l.Int()
+			}
+		case "observedGeneration":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.ObservedGeneration = l.Int64()
+			}
+		case "conditions":
+			if l.IsNull() {
+				l.Skip()
+				in.Conditions = nil
+			} else {
+				in.Conditions = make([]ReplicaSetCondition, 0)
+				l.Delim('[')
+				for !l.IsDelim(']') {
+					var v0 ReplicaSetCondition
+					if l.IsNull() {
+						l.Skip()
+					} else {
+						v0.UnmarshalEasyJSON(l)
+					}
+					in.Conditions = append(in.Conditions, v0)
+					l.WantComma()
+				}
+				l.Delim(']')
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
+
 type StatefulSetSpec struct {
 	// replicas is the desired number of replicas of the given Template.
 	// These are replicas in the sense that they are instantiations of the
@@ -840,32 +3858,227 @@ func (in *StatefulSetSpec) DeepCopyInto(out *StatefulSetSpec) {
 		for i := range in.VolumeClaimTemplates {
 			in.VolumeClaimTemplates[i].DeepCopyInto(&l[i])
 		}
-		out.VolumeClaimTemplates = l
+		out.VolumeClaimTemplates = l
+	}
+	if in.UpdateStrategy != nil {
+		in, out := &in.UpdateStrategy, &out.UpdateStrategy
+		*out = new(StatefulSetUpdateStrategy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PersistentVolumeClaimRetentionPolicy != nil {
+		in, out := &in.PersistentVolumeClaimRetentionPolicy, &out.PersistentVolumeClaimRetentionPolicy
+		*out = new(StatefulSetPersistentVolumeClaimRetentionPolicy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Ordinals != nil {
+		in, out := &in.Ordinals, &out.Ordinals
+		*out = new(StatefulSetOrdinals)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+func (in *StatefulSetSpec) DeepCopy() *StatefulSetSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(StatefulSetSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *StatefulSetSpec) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *StatefulSetSpec) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *StatefulSetSpec) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	first := true
+	if in.Replicas != 0 {
+		w.RawString("\"replicas\":")
+		w.Int(in.Replicas)
+		first = false
+	}
+	if in.Selector != nil {
+		if !first {
+			w.RawByte(',')
+		}
+		w.RawString("\"selector\":")
+		in.Selector.MarshalEasyJSON(w)
+		first = false
+	}
+	if !first {
+		w.RawByte(',')
+	}
+	w.RawString("\"template\":")
+	in.Template.MarshalEasyJSON(w)
+	w.RawString(",\"volumeClaimTemplates\":")
+	if in.VolumeClaimTemplates == nil {
+		w.RawString("null")
+	} else {
+		w.RawByte('[')
+		for i0 := range in.VolumeClaimTemplates {
+			if i0 > 0 {
+				w.RawByte(',')
+			}
+			in.VolumeClaimTemplates[i0].MarshalEasyJSON(w)
+		}
+		w.RawByte(']')
+	}
+	w.RawString(",\"serviceName\":")
+	w.String(in.ServiceName)
+	if in.PodManagementPolicy != "" {
+		w.RawString(",\"podManagementPolicy\":")
+		w.String(string(in.PodManagementPolicy))
 	}
 	if in.UpdateStrategy != nil {
-		in, out := &in.UpdateStrategy, &out.UpdateStrategy
-		*out = new(StatefulSetUpdateStrategy)
-		(*in).DeepCopyInto(*out)
+		w.RawString(",\"updateStrategy\":")
+		in.UpdateStrategy.MarshalEasyJSON(w)
+	}
+	if in.RevisionHistoryLimit != 0 {
+		w.RawString(",\"revisionHistoryLimit\":")
+		w.Int(in.RevisionHistoryLimit)
+	}
+	if in.MinReadySeconds != 0 {
+		w.RawString(",\"minReadySeconds\":")
+		w.Int(in.MinReadySeconds)
 	}
 	if in.PersistentVolumeClaimRetentionPolicy != nil {
-		in, out := &in.PersistentVolumeClaimRetentionPolicy, &out.PersistentVolumeClaimRetentionPolicy
-		*out = new(StatefulSetPersistentVolumeClaimRetentionPolicy)
-		(*in).DeepCopyInto(*out)
+		w.RawString(",\"persistentVolumeClaimRetentionPolicy\":")
+		in.PersistentVolumeClaimRetentionPolicy.MarshalEasyJSON(w)
 	}
 	if in.Ordinals != nil {
-		in, out := &in.Ordinals, &out.Ordinals
-		*out = new(StatefulSetOrdinals)
-		(*in).DeepCopyInto(*out)
+		w.RawString(",\"ordinals\":")
+		in.Ordinals.MarshalEasyJSON(w)
 	}
+	w.RawByte('}')
 }
 
-func (in *StatefulSetSpec) DeepCopy() *StatefulSetSpec {
-	if in == nil {
-		return nil
+func (in *StatefulSetSpec) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "replicas":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.Replicas = l.Int()
+			}
+		case "selector":
+			if l.IsNull() {
+				l.Skip()
+				in.Selector = nil
+			} else {
+				if in.Selector == nil {
+					in.Selector = new(metav1.LabelSelector)
+				}
+				in.Selector.UnmarshalEasyJSON(l)
+			}
+		case "template":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.Template.UnmarshalEasyJSON(l)
+			}
+		case "volumeClaimTemplates":
+			if l.IsNull() {
+				l.Skip()
+				in.VolumeClaimTemplates = nil
+			} else {
+				in.VolumeClaimTemplates = make([]corev1.PersistentVolumeClaim, 0)
+				l.Delim('[')
+				for !l.IsDelim(']') {
+					var v0 corev1.PersistentVolumeClaim
+					if l.IsNull() {
+						l.Skip()
+					} else {
+						v0.UnmarshalEasyJSON(l)
+					}
+					in.VolumeClaimTemplates = append(in.VolumeClaimTemplates, v0)
+					l.WantComma()
+				}
+				l.Delim(']')
+			}
+		case "serviceName":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.ServiceName = l.String()
+			}
+		case "podManagementPolicy":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.PodManagementPolicy = PodManagementPolicyType(l.String())
+			}
+		case "updateStrategy":
+			if l.IsNull() {
+				l.Skip()
+				in.UpdateStrategy = nil
+			} else {
+				if in.UpdateStrategy == nil {
+					in.UpdateStrategy = new(StatefulSetUpdateStrategy)
+				}
+				in.UpdateStrategy.UnmarshalEasyJSON(l)
+			}
+		case "revisionHistoryLimit":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.RevisionHistoryLimit = l.Int()
+			}
+		case "minReadySeconds":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.MinReadySeconds = l.Int()
+			}
+		case "persistentVolumeClaimRetentionPolicy":
+			if l.IsNull() {
+				l.Skip()
+				in.PersistentVolumeClaimRetentionPolicy = nil
+			} else {
+				if in.PersistentVolumeClaimRetentionPolicy == nil {
+					in.PersistentVolumeClaimRetentionPolicy = new(StatefulSetPersistentVolumeClaimRetentionPolicy)
+				}
+				in.PersistentVolumeClaimRetentionPolicy.UnmarshalEasyJSON(l)
+			}
+		case "ordinals":
+			if l.IsNull() {
+				l.Skip()
+				in.Ordinals = nil
+			} else {
+				if in.Ordinals == nil {
+					in.Ordinals = new(StatefulSetOrdinals)
+				}
+				in.Ordinals.UnmarshalEasyJSON(l)
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
 	}
-	out := new(StatefulSetSpec)
-	in.DeepCopyInto(out)
-	return out
 }
 
 type StatefulSetStatus struct {
@@ -918,6 +4131,171 @@ func (in *StatefulSetStatus) DeepCopy() *StatefulSetStatus {
 	return out
 }
 
+func (in *StatefulSetStatus) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *StatefulSetStatus) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *StatefulSetStatus) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	first := true
+	if in.ObservedGeneration != 0 {
+		w.RawString("\"observedGeneration\":")
+		w.Int64(in.ObservedGeneration)
+		first = false
+	}
+	if !first {
+		w.RawByte(',')
+	}
+	w.RawString("\"replicas\":")
+	w.Int(in.Replicas)
+	if in.ReadyReplicas != 0 {
+		w.RawString(",\"readyReplicas\":")
+		w.Int(in.ReadyReplicas)
+	}
+	if in.CurrentReplicas != 0 {
+		w.RawString(",\"currentReplicas\":")
+		w.Int(in.CurrentReplicas)
+	}
+	if in.UpdatedReplicas != 0 {
+		w.RawString(",\"updatedReplicas\":")
+		w.Int(in.UpdatedReplicas)
+	}
+	if in.CurrentRevision != "" {
+		w.RawString(",\"currentRevision\":")
+		w.String(in.CurrentRevision)
+	}
+	if in.UpdateRevision != "" {
+		w.RawString(",\"updateRevision\":")
+		w.String(in.UpdateRevision)
+	}
+	if in.CollisionCount != 0 {
+		w.RawString(",\"collisionCount\":")
+		w.Int(in.CollisionCount)
+	}
+	w.RawString(",\"conditions\":")
+	if in.Conditions == nil {
+		w.RawString("null")
+	} else {
+		w.RawByte('[')
+		for i0 := range in.Conditions {
+			if i0 > 0 {
+				w.RawByte(',')
+			}
+			in.Conditions[i0].MarshalEasyJSON(w)
+		}
+		w.RawByte(']')
+	}
+	w.RawString(",\"availableReplicas\":")
+	w.Int(in.AvailableReplicas)
+	w.RawByte('}')
+}
+
+func (in *StatefulSetStatus) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "observedGeneration":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.ObservedGeneration = l.Int64()
+			}
+		case "replicas":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.Replicas = l.Int()
+			}
+		case "readyReplicas":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.ReadyReplicas = l.Int()
+			}
+		case "currentReplicas":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.CurrentReplicas = l.Int()
+			}
+		case "updatedReplicas":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.UpdatedReplicas = l.Int()
+			}
+		case "currentRevision":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.CurrentRevision = l.String()
+			}
+		case "updateRevision":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.UpdateRevision = l.String()
+			}
+		case "collisionCount":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.CollisionCount = l.Int()
+			}
+		case "conditions":
+			if l.IsNull() {
+				l.Skip()
+				in.Conditions = nil
+			} else {
+				in.Conditions = make([]StatefulSetCondition, 0)
+				l.Delim('[')
+				for !l.IsDelim(']') {
+					var v0 StatefulSetCondition
+					if l.IsNull() {
+						l.Skip()
+					} else {
+						v0.UnmarshalEasyJSON(l)
+					}
+					in.Conditions = append(in.Conditions, v0)
+					l.WantComma()
+				}
+				l.Delim(']')
+			}
+		case "availableReplicas":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.AvailableReplicas = l.Int()
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
+
 type DaemonSetUpdateStrategy struct {
 	// Type of daemon set update. Can be "RollingUpdate" or "OnDelete". Default is RollingUpdate.
 	Type DaemonSetUpdateStrategyType `json:"type,omitempty"`
@@ -946,6 +4324,78 @@ func (in *DaemonSetUpdateStrategy) DeepCopy() *DaemonSetUpdateStrategy {
 	return out
 }
 
+func (in *DaemonSetUpdateStrategy) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *DaemonSetUpdateStrategy) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *DaemonSetUpdateStrategy) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	first := true
+	if in.Type != "" {
+		w.RawString("\"type\":")
+		w.String(string(in.Type))
+		first = false
+	}
+	if in.RollingUpdate != nil {
+		if !first {
+			w.RawByte(',')
+		}
+		w.RawString("\"rollingUpdate\":")
+		in.RollingUpdate.MarshalEasyJSON(w)
+		first = false
+	}
+	w.RawByte('}')
+}
+
+func (in *DaemonSetUpdateStrategy) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "type":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.Type = DaemonSetUpdateStrategyType(l.String())
+			}
+		case "rollingUpdate":
+			if l.IsNull() {
+				l.Skip()
+				in.RollingUpdate = nil
+			} else {
+				if in.RollingUpdate == nil {
+					in.RollingUpdate = new(RollingUpdateDaemonSet)
+				}
+				in.RollingUpdate.UnmarshalEasyJSON(l)
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
+
 type DaemonSetCondition struct {
 	// Type of DaemonSet condition.
 	Type string `json:"type"`
@@ -977,6 +4427,91 @@ func (in *DaemonSetCondition) DeepCopy() *DaemonSetCondition {
 	return out
 }
 
+func (in *DaemonSetCondition) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *DaemonSetCondition) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *DaemonSetCondition) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	w.RawString("\"type\":")
+	w.String(in.Type)
+	w.RawString(",\"status\":")
+	w.String(string(in.Status))
+	if in.LastTransitionTime != nil {
+		w.RawString(",\"lastTransitionTime\":")
+		w.Raw(json.Marshal(in.LastTransitionTime))
+	}
+	if in.Reason != "" {
+		w.RawString(",\"reason\":")
+		w.String(in.Reason)
+	}
+	if in.Message != "" {
+		w.RawString(",\"message\":")
+		w.String(in.Message)
+	}
+
+	w.RawByte('}')
+}
+
+func (in *DaemonSetCondition) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "type":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.Type = l.String()
+			}
+		case "status":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.Status = corev1.ConditionStatus(l.String())
+			}
+		case "lastTransitionTime":
+			l.AddError(json.Unmarshal(l.Raw(), &in.LastTransitionTime))
+		case "reason":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.Reason = l.String()
+			}
+		case "message":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.Message = l.String()
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
+
 type DeploymentStrategy struct {
 	// Type of deployment. Can be "Recreate" or "RollingUpdate". Default is RollingUpdate.
 	Type DeploymentStrategyType `json:"type,omitempty"`
@@ -1005,6 +4540,78 @@ func (in *DeploymentStrategy) DeepCopy() *DeploymentStrategy {
 	return out
 }
 
+func (in *DeploymentStrategy) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *DeploymentStrategy) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *DeploymentStrategy) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	first := true
+	if in.Type != "" {
+		w.RawString("\"type\":")
+		w.String(string(in.Type))
+		first = false
+	}
+	if in.RollingUpdate != nil {
+		if !first {
+			w.RawByte(',')
+		}
+		w.RawString("\"rollingUpdate\":")
+		in.RollingUpdate.MarshalEasyJSON(w)
+		first = false
+	}
+	w.RawByte('}')
+}
+
+func (in *DeploymentStrategy) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "type":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.Type = DeploymentStrategyType(l.String())
+			}
+		case "rollingUpdate":
+			if l.IsNull() {
+				l.Skip()
+				in.RollingUpdate = nil
+			} else {
+				if in.RollingUpdate == nil {
+					in.RollingUpdate = new(RollingUpdateDeployment)
+				}
+				in.RollingUpdate.UnmarshalEasyJSON(l)
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
+
 type DeploymentCondition struct {
 	// Type of deployment condition.
 	Type DeploymentConditionType `json:"type"`
@@ -1043,6 +4650,97 @@ func (in *DeploymentCondition) DeepCopy() *DeploymentCondition {
 	return out
 }
 
+func (in *DeploymentCondition) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *DeploymentCondition) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *DeploymentCondition) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	w.RawString("\"type\":")
+	w.String(string(in.Type))
+	w.RawString(",\"status\":")
+	w.String(string(in.Status))
+	if in.LastUpdateTime != nil {
+		w.RawString(",\"lastUpdateTime\":")
+		w.Raw(json.Marshal(in.LastUpdateTime))
+	}
+	if in.LastTransitionTime != nil {
+		w.RawString(",\"lastTransitionTime\":")
+		w.Raw(json.Marshal(in.LastTransitionTime))
+	}
+	if in.Reason != "" {
+		w.RawString(",\"reason\":")
+		w.String(in.Reason)
+	}
+	if in.Message != "" {
+		w.RawString(",\"message\":")
+		w.String(in.Message)
+	}
+
+	w.RawByte('}')
+}
+
+func (in *DeploymentCondition) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "type":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.Type = DeploymentConditionType(l.String())
+			}
+		case "status":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.Status = corev1.ConditionStatus(l.String())
+			}
+		case "lastUpdateTime":
+			l.AddError(json.Unmarshal(l.Raw(), &in.LastUpdateTime))
+		case "lastTransitionTime":
+			l.AddError(json.Unmarshal(l.Raw(), &in.LastTransitionTime))
+		case "reason":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.Reason = l.String()
+			}
+		case "message":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.Message = l.String()
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
+
 type ReplicaSetCondition struct {
 	// Type of replica set condition.
 	Type ReplicaSetConditionType `json:"type"`
@@ -1074,6 +4772,91 @@ func (in *ReplicaSetCondition) DeepCopy() *ReplicaSetCondition {
 	return out
 }
 
+func (in *ReplicaSetCondition) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *ReplicaSetCondition) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *ReplicaSetCondition) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	w.RawString("\"type\":")
+	w.String(string(in.Type))
+	w.RawString(",\"status\":")
+	w.String(string(in.Status))
+	if in.LastTransitionTime != nil {
+		w.RawString(",\"lastTransitionTime\":")
+		w.Raw(json.Marshal(in.LastTransitionTime))
+	}
+	if in.Reason != "" {
+		w.RawString(",\"reason\":")
+		w.String(in.Reason)
+	}
+	if in.Message != "" {
+		w.RawString(",\"message\":")
+		w.String(in.Message)
+	}
+
+	w.RawByte('}')
+}
+
+func (in *ReplicaSetCondition) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "type":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.Type = ReplicaSetConditionType(l.String())
+			}
+		case "status":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.Status = corev1.ConditionStatus(l.String())
+			}
+		case "lastTransitionTime":
+			l.AddError(json.Unmarshal(l.Raw(), &in.LastTransitionTime))
+		case "reason":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.Reason = l.String()
+			}
+		case "message":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.Message = l.String()
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
+
 type StatefulSetUpdateStrategy struct {
 	// Type indicates the type of the StatefulSetUpdateStrategy.
 	// Default is RollingUpdate.
@@ -1100,6 +4883,78 @@ func (in *StatefulSetUpdateStrategy) DeepCopy() *StatefulSetUpdateStrategy {
 	return out
 }
 
+func (in *StatefulSetUpdateStrategy) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *StatefulSetUpdateStrategy) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *StatefulSetUpdateStrategy) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	first := true
+	if in.Type != "" {
+		w.RawString("\"type\":")
+		w.String(string(in.Type))
+		first = false
+	}
+	if in.RollingUpdate != nil {
+		if !first {
+			w.RawByte(',')
+		}
+		w.RawString("\"rollingUpdate\":")
+		in.RollingUpdate.MarshalEasyJSON(w)
+		first = false
+	}
+	w.RawByte('}')
+}
+
+func (in *StatefulSetUpdateStrategy) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "type":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.Type = StatefulSetUpdateStrategyType(l.String())
+			}
+		case "rollingUpdate":
+			if l.IsNull() {
+				l.Skip()
+				in.RollingUpdate = nil
+			} else {
+				if in.RollingUpdate == nil {
+					in.RollingUpdate = new(RollingUpdateStatefulSetStrategy)
+				}
+				in.RollingUpdate.UnmarshalEasyJSON(l)
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
+
 type StatefulSetPersistentVolumeClaimRetentionPolicy struct {
 	// WhenDeleted specifies what happens to PVCs created from StatefulSet
 	// VolumeClaimTemplates when the StatefulSet is deleted. The default policy
@@ -1127,6 +4982,74 @@ func (in *StatefulSetPersistentVolumeClaimRetentionPolicy) DeepCopy() *StatefulS
 	return out
 }
 
+func (in *StatefulSetPersistentVolumeClaimRetentionPolicy) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *StatefulSetPersistentVolumeClaimRetentionPolicy) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *StatefulSetPersistentVolumeClaimRetentionPolicy) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	first := true
+	if in.WhenDeleted != "" {
+		w.RawString("\"whenDeleted\":")
+		w.String(string(in.WhenDeleted))
+		first = false
+	}
+	if in.WhenScaled != "" {
+		if !first {
+			w.RawByte(',')
+		}
+		w.RawString("\"whenScaled\":")
+		w.String(string(in.WhenScaled))
+		first = false
+	}
+	w.RawByte('}')
+}
+
+func (in *StatefulSetPersistentVolumeClaimRetentionPolicy) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "whenDeleted":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.WhenDeleted = PersistentVolumeClaimRetentionPolicyType(l.String())
+			}
+		case "whenScaled":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.WhenScaled = PersistentVolumeClaimRetentionPolicyType(l.String())
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
+
 type StatefulSetOrdinals struct {
 	// start is the number representing the first replica's index. It may be used
 	// to number replicas from an alternate index (eg: 1-indexed) over the default
@@ -1152,6 +5075,57 @@ func (in *StatefulSetOrdinals) DeepCopy() *StatefulSetOrdinals {
 	return out
 }
 
+func (in *StatefulSetOrdinals) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *StatefulSetOrdinals) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *StatefulSetOrdinals) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	w.RawString("\"start\":")
+	w.Int(in.Start)
+
+	w.RawByte('}')
+}
+
+func (in *StatefulSetOrdinals) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "start":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.Start = l.Int()
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
+
 type StatefulSetCondition struct {
 	// Type of statefulset condition.
 	Type string `json:"type"`
@@ -1183,6 +5157,91 @@ func (in *StatefulSetCondition) DeepCopy() *StatefulSetCondition {
 	return out
 }
 
+func (in *StatefulSetCondition) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *StatefulSetCondition) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *StatefulSetCondition) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	w.RawString("\"type\":")
+	w.String(in.Type)
+	w.RawString(",\"status\":")
+	w.String(string(in.Status))
+	if in.LastTransitionTime != nil {
+		w.RawString(",\"lastTransitionTime\":")
+		w.Raw(json.Marshal(in.LastTransitionTime))
+	}
+	if in.Reason != "" {
+		w.RawString(",\"reason\":")
+		w.String(in.Reason)
+	}
+	if in.Message != "" {
+		w.RawString(",\"message\":")
+		w.String(in.Message)
+	}
+
+	w.RawByte('}')
+}
+
+func (in *StatefulSetCondition) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "type":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.Type = l.String()
+			}
+		case "status":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.Status = corev1.ConditionStatus(l.String())
+			}
+		case "lastTransitionTime":
+			l.AddError(json.Unmarshal(l.Raw(), &in.LastTransitionTime))
+		case "reason":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.Reason = l.String()
+			}
+		case "message":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.Message = l.String()
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
+
 type RollingUpdateDaemonSet struct {
 	// The maximum number of DaemonSet pods that can be unavailable during the
 	// update. Value can be an absolute number (ex: 5) or a percentage of total
@@ -1243,6 +5302,66 @@ func (in *RollingUpdateDaemonSet) DeepCopy() *RollingUpdateDaemonSet {
 	return out
 }
 
+func (in *RollingUpdateDaemonSet) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *RollingUpdateDaemonSet) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *RollingUpdateDaemonSet) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	first := true
+	if in.MaxUnavailable != nil {
+		w.RawString("\"maxUnavailable\":")
+		w.Raw(json.Marshal(in.MaxUnavailable))
+		first = false
+	}
+	if in.MaxSurge != nil {
+		if !first {
+			w.RawByte(',')
+		}
+		w.RawString("\"maxSurge\":")
+		w.Raw(json.Marshal(in.MaxSurge))
+		first = false
+	}
+	w.RawByte('}')
+}
+
+func (in *RollingUpdateDaemonSet) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "maxUnavailable":
+			l.AddError(json.Unmarshal(l.Raw(), &in.MaxUnavailable))
+		case "maxSurge":
+			l.AddError(json.Unmarshal(l.Raw(), &in.MaxSurge))
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
+
 type RollingUpdateDeployment struct {
 	// The maximum number of pods that can be unavailable during the update.
 	// Value can be an absolute number (ex: 5) or a percentage of desired pods (ex: 10%).
@@ -1292,6 +5411,66 @@ func (in *RollingUpdateDeployment) DeepCopy() *RollingUpdateDeployment {
 	return out
 }
 
+func (in *RollingUpdateDeployment) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *RollingUpdateDeployment) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *RollingUpdateDeployment) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	first := true
+	if in.MaxUnavailable != nil {
+		w.RawString("\"maxUnavailable\":")
+		w.Raw(json.Marshal(in.MaxUnavailable))
+		first = false
+	}
+	if in.MaxSurge != nil {
+		if !first {
+			w.RawByte(',')
+		}
+		w.RawString("\"maxSurge\":")
+		w.Raw(json.Marshal(in.MaxSurge))
+		first = false
+	}
+	w.RawByte('}')
+}
+
+func (in *RollingUpdateDeployment) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "maxUnavailable":
+			l.AddError(json.Unmarshal(l.Raw(), &in.MaxUnavailable))
+		case "maxSurge":
+			l.AddError(json.Unmarshal(l.Raw(), &in.MaxSurge))
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
+
 type RollingUpdateStatefulSetStrategy struct {
 	// Partition indicates the ordinal at which the StatefulSet should be partitioned
 	// for updates. During a rolling update, all pods from ordinal Replicas-1 to
@@ -1325,3 +5504,67 @@ func (in *RollingUpdateStatefulSetStrategy) DeepCopy() *RollingUpdateStatefulSet
 	in.DeepCopyInto(out)
 	return out
 }
+
+func (in *RollingUpdateStatefulSetStrategy) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *RollingUpdateStatefulSetStrategy) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *RollingUpdateStatefulSetStrategy) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	first := true
+	if in.Partition != 0 {
+		w.RawString("\"partition\":")
+		w.Int(in.Partition)
+		first = false
+	}
+	if in.MaxUnavailable != nil {
+		if !first {
+			w.RawByte(',')
+		}
+		w.RawString("\"maxUnavailable\":")
+		w.Raw(json.Marshal(in.MaxUnavailable))
+		first = false
+	}
+	w.RawByte('}')
+}
+
+func (in *RollingUpdateStatefulSetStrategy) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "partition":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.Partition = l.Int()
+			}
+		case "maxUnavailable":
+			l.AddError(json.Unmarshal(l.Raw(), &in.MaxUnavailable))
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
@@ -1,9 +1,16 @@
 package authenticationv1
 
 import (
+	"encoding/json"
+	"github.com/mailru/easyjson/jlexer"
+	"github.com/mailru/easyjson/jwriter"
 	"go.f110.dev/kubeproto/go/apis/metav1"
+	"go.f110.dev/kubeproto/go/patch"
+	"k8s.io/apimachinery/pkg/fields"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"maps"
+	"slices"
 )
 
 const GroupName = "authentication.k8s.io"
@@ -62,6 +69,205 @@ func (in *SelfSubjectReview) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// SelfSubjectReviewToSelectableFields returns the fields of SelfSubjectReview which can be used by the field selector.
+func SelfSubjectReviewToSelectableFields(obj *SelfSubjectReview) fields.Set {
+	set := fields.Set{
+		"metadata.name":      obj.ObjectMeta.Name,
+		"metadata.namespace": obj.ObjectMeta.Namespace,
+	}
+	return set
+}
+
+// SelfSubjectReviewPatch builds JSON patch or JSON merge patch of SelfSubjectReview.
+// JSON patch fails if the parent of the field doesn't exist (e.g. SetLabel to the object which has no label). MergePatch creates the parent.
+type SelfSubjectReviewPatch struct {
+	*patch.Builder
+}
+
+func NewSelfSubjectReviewPatch() *SelfSubjectReviewPatch {
+	return &SelfSubjectReviewPatch{Builder: patch.NewBuilder()}
+}
+
+func (p *SelfSubjectReviewPatch) SetLabel(key, value string) *SelfSubjectReviewPatch {
+	p.Set([]string{"metadata", "labels", key}, value)
+	return p
+}
+
+func (p *SelfSubjectReviewPatch) RemoveLabel(key string) *SelfSubjectReviewPatch {
+	p.Remove([]string{"metadata", "labels", key})
+	return p
+}
+
+func (p *SelfSubjectReviewPatch) SetAnnotation(key, value string) *SelfSubjectReviewPatch {
+	p.Set([]string{"metadata", "annotations", key}, value)
+	return p
+}
+
+func (p *SelfSubjectReviewPatch) RemoveAnnotation(key string) *SelfSubjectReviewPatch {
+	p.Remove([]string{"metadata", "annotations", key})
+	return p
+}
+
+func (p *SelfSubjectReviewPatch) SetStatus(v *SelfSubjectReviewStatus) *SelfSubjectReviewPatch {
+	p.Set([]string{"status"}, v)
+	return p
+}
+
+func (p *SelfSubjectReviewPatch) RemoveStatus() *SelfSubjectReviewPatch {
+	p.Remove([]string{"status"})
+	return p
+}
+
+func (p *SelfSubjectReviewPatch) SetStatusUserInfo(v *UserInfo) *SelfSubjectReviewPatch {
+	p.Set([]string{"status", "userInfo"}, v)
+	return p
+}
+
+func (p *SelfSubjectReviewPatch) RemoveStatusUserInfo() *SelfSubjectReviewPatch {
+	p.Remove([]string{"status", "userInfo"})
+	return p
+}
+
+func (p *SelfSubjectReviewPatch) SetStatusUserInfoUsername(v string) *SelfSubjectReviewPatch {
+	p.Set([]string{"status", "userInfo", "username"}, v)
+	return p
+}
+
+func (p *SelfSubjectReviewPatch) RemoveStatusUserInfoUsername() *SelfSubjectReviewPatch {
+	p.Remove([]string{"status", "userInfo", "username"})
+	return p
+}
+
+func (p *SelfSubjectReviewPatch) SetStatusUserInfoUID(v string) *SelfSubjectReviewPatch {
+	p.Set([]string{"status", "userInfo", "uid"}, v)
+	return p
+}
+
+func (p *SelfSubjectReviewPatch) RemoveStatusUserInfoUID() *SelfSubjectReviewPatch {
+	p.Remove([]string{"status", "userInfo", "uid"})
+	return p
+}
+
+func (p *SelfSubjectReviewPatch) SetStatusUserInfoGroups(v []string) *SelfSubjectReviewPatch {
+	p.Set([]string{"status", "userInfo", "groups"}, v)
+	return p
+}
+
+func (p *SelfSubjectReviewPatch) RemoveStatusUserInfoGroups() *SelfSubjectReviewPatch {
+	p.Remove([]string{"status", "userInfo", "groups"})
+	return p
+}
+
+func (p *SelfSubjectReviewPatch) AddStatusUserInfoGroups(v ...string) *SelfSubjectReviewPatch {
+	for _, e := range v {
+		p.Append([]string{"status", "userInfo", "groups"}, e)
+	}
+	return p
+}
+
+func (p *SelfSubjectReviewPatch) SetStatusUserInfoExtra(v map[string]ExtraValue) *SelfSubjectReviewPatch {
+	p.Set([]string{"status", "userInfo", "extra"}, v)
+	return p
+}
+
+func (p *SelfSubjectReviewPatch) RemoveStatusUserInfoExtra() *SelfSubjectReviewPatch {
+	p.Remove([]string{"status", "userInfo", "extra"})
+	return p
+}
+
+func (in *SelfSubjectReview) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *SelfSubjectReview) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *SelfSubjectReview) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	first := true
+	if in.TypeMeta.Kind != "" {
+		w.RawString("\"kind\":")
+		w.String(in.TypeMeta.Kind)
+		first = false
+	}
+	if in.TypeMeta.APIVersion != "" {
+		if !first {
+			w.RawByte(',')
+		}
+		w.RawString("\"apiVersion\":")
+		w.String(in.TypeMeta.APIVersion)
+		first = false
+	}
+	if !first {
+		w.RawByte(',')
+	}
+	w.RawString("\"metadata\":")
+	in.ObjectMeta.MarshalEasyJSON(w)
+	if in.Status != nil {
+		w.RawString(",\"status\":")
+		in.Status.MarshalEasyJSON(w)
+	}
+	w.RawByte('}')
+}
+
+func (in *SelfSubjectReview) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "kind":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.TypeMeta.Kind = l.String()
+			}
+		case "apiVersion":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.TypeMeta.APIVersion = l.String()
+			}
+		case "metadata":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.ObjectMeta.UnmarshalEasyJSON(l)
+			}
+		case "status":
+			if l.IsNull() {
+				l.Skip()
+				in.Status = nil
+			} else {
+				if in.Status == nil {
+					in.Status = new(SelfSubjectReviewStatus)
+				}
+				in.Status.UnmarshalEasyJSON(l)
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
+
 type SelfSubjectReviewList struct {
 	metav1.TypeMeta `json:",inline"`
 	metav1.ListMeta `json:"metadata"`
@@ -97,6 +303,117 @@ func (in *SelfSubjectReviewList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+func (in *SelfSubjectReviewList) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *SelfSubjectReviewList) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *SelfSubjectReviewList) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	first := true
+	if in.TypeMeta.Kind != "" {
+		w.RawString("\"kind\":")
+		w.String(in.TypeMeta.Kind)
+		first = false
+	}
+	if in.TypeMeta.APIVersion != "" {
+		if !first {
+			w.RawByte(',')
+		}
+		w.RawString("\"apiVersion\":")
+		w.String(in.TypeMeta.APIVersion)
+		first = false
+	}
+	if !first {
+		w.RawByte(',')
+	}
+	w.RawString("\"metadata\":")
+	in.ListMeta.MarshalEasyJSON(w)
+	w.RawString(",\"items\":")
+	if in.Items == nil {
+		w.RawString("null")
+	} else {
+		w.RawByte('[')
+		for i0 := range in.Items {
+			if i0 > 0 {
+				w.RawByte(',')
+			}
+			in.Items[i0].MarshalEasyJSON(w)
+		}
+		w.RawByte(']')
+	}
+	w.RawByte('}')
+}
+
+func (in *SelfSubjectReviewList) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "kind":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.TypeMeta.Kind = l.String()
+			}
+		case "apiVersion":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.TypeMeta.APIVersion = l.String()
+			}
+		case "metadata":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.ListMeta.UnmarshalEasyJSON(l)
+			}
+		case "items":
+			if l.IsNull() {
+				l.Skip()
+				in.Items = nil
+			} else {
+				in.Items = make([]SelfSubjectReview, 0)
+				l.Delim('[')
+				for !l.IsDelim(']') {
+					var v0 SelfSubjectReview
+					if l.IsNull() {
+						l.Skip()
+					} else {
+						v0.UnmarshalEasyJSON(l)
+					}
+					in.Items = append(in.Items, v0)
+					l.WantComma()
+				}
+				l.Delim(']')
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
+
 type TokenRequest struct {
 	metav1.TypeMeta   `json:",inline"`
 	metav1.ObjectMeta `json:"metadata"`
@@ -134,6 +451,263 @@ func (in *TokenRequest) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// TokenRequestToSelectableFields returns the fields of TokenRequest which can be used by the field selector.
+func TokenRequestToSelectableFields(obj *TokenRequest) fields.Set {
+	set := fields.Set{
+		"metadata.name":      obj.ObjectMeta.Name,
+		"metadata.namespace": obj.ObjectMeta.Namespace,
+	}
+	return set
+}
+
+// TokenRequestPatch builds JSON patch or JSON merge patch of TokenRequest.
+// JSON patch fails if the parent of the field doesn't exist (e.g. SetLabel to the object which has no label). MergePatch creates the parent.
+type TokenRequestPatch struct {
+	*patch.Builder
+}
+
+func NewTokenRequestPatch() *TokenRequestPatch {
+	return &TokenRequestPatch{Builder: patch.NewBuilder()}
+}
+
+func (p *TokenRequestPatch) SetLabel(key, value string) *TokenRequestPatch {
+	p.Set([]string{"metadata", "labels", key}, value)
+	return p
+}
+
+func (p *TokenRequestPatch) RemoveLabel(key string) *TokenRequestPatch {
+	p.Remove([]string{"metadata", "labels", key})
+	return p
+}
+
+func (p *TokenRequestPatch) SetAnnotation(key, value string) *TokenRequestPatch {
+	p.Set([]string{"metadata", "annotations", key}, value)
+	return p
+}
+
+func (p *TokenRequestPatch) RemoveAnnotation(key string) *TokenRequestPatch {
+	p.Remove([]string{"metadata", "annotations", key})
+	return p
+}
+
+func (p *TokenRequestPatch) SetSpec(v TokenRequestSpec) *TokenRequestPatch {
+	p.Set([]string{"spec"}, v)
+	return p
+}
+
+func (p *TokenRequestPatch) RemoveSpec() *TokenRequestPatch {
+	p.Remove([]string{"spec"})
+	return p
+}
+
+func (p *TokenRequestPatch) SetSpecAudiences(v []string) *TokenRequestPatch {
+	p.Set([]string{"spec", "audiences"}, v)
+	return p
+}
+
+func (p *TokenRequestPatch) RemoveSpecAudiences() *TokenRequestPatch {
+	p.Remove([]string{"spec", "audiences"})
+	return p
+}
+
+func (p *TokenRequestPatch) AddSpecAudiences(v ...string) *TokenRequestPatch {
+	for _, e := range v {
+		p.Append([]string{"spec", "audiences"}, e)
+	}
+	return p
+}
+
+func (p *TokenRequestPatch) SetSpecExpirationSeconds(v int64) *TokenRequestPatch {
+	p.Set([]string{"spec", "expirationSeconds"}, v)
+	return p
+}
+
+func (p *TokenRequestPatch) RemoveSpecExpirationSeconds() *TokenRequestPatch {
+	p.Remove([]string{"spec", "expirationSeconds"})
+	return p
+}
+
+func (p *TokenRequestPatch) SetSpecBoundObjectRef(v *BoundObjectReference) *TokenRequestPatch {
+	p.Set([]string{"spec", "boundObjectRef"}, v)
+	return p
+}
+
+func (p *TokenRequestPatch) RemoveSpecBoundObjectRef() *TokenRequestPatch {
+	p.Remove([]string{"spec", "boundObjectRef"})
+	return p
+}
+
+func (p *TokenRequestPatch) SetSpecBoundObjectRefKind(v string) *TokenRequestPatch {
+	p.Set([]string{"spec", "boundObjectRef", "kind"}, v)
+	return p
+}
+
+func (p *TokenRequestPatch) RemoveSpecBoundObjectRefKind() *TokenRequestPatch {
+	p.Remove([]string{"spec", "boundObjectRef", "kind"})
+	return p
+}
+
+func (p *TokenRequestPatch) SetSpecBoundObjectRefAPIVersion(v string) *TokenRequestPatch {
+	p.Set([]string{"spec", "boundObjectRef", "apiVersion"}, v)
+	return p
+}
+
+func (p *TokenRequestPatch) RemoveSpecBoundObjectRefAPIVersion() *TokenRequestPatch {
+	p.Remove([]string{"spec", "boundObjectRef", "apiVersion"})
+	return p
+}
+
+func (p *TokenRequestPatch) SetSpecBoundObjectRefName(v string) *TokenRequestPatch {
+	p.Set([]string{"spec", "boundObjectRef", "name"}, v)
+	return p
+}
+
+func (p *TokenRequestPatch) RemoveSpecBoundObjectRefName() *TokenRequestPatch {
+	p.Remove([]string{"spec", "boundObjectRef", "name"})
+	return p
+}
+
+func (p *TokenRequestPatch) SetSpecBoundObjectRefUID(v string) *TokenRequestPatch {
+	p.Set([]string{"spec", "boundObjectRef", "uid"}, v)
+	return p
+}
+
+func (p *TokenRequestPatch) RemoveSpecBoundObjectRefUID() *TokenRequestPatch {
+	p.Remove([]string{"spec", "boundObjectRef", "uid"})
+	return p
+}
+
+func (p *TokenRequestPatch) SetStatus(v *TokenRequestStatus) *TokenRequestPatch {
+	p.Set([]string{"status"}, v)
+	return p
+}
+
+func (p *TokenRequestPatch) RemoveStatus() *TokenRequestPatch {
+	p.Remove([]string{"status"})
+	return p
+}
+
+func (p *TokenRequestPatch) SetStatusToken(v string) *TokenRequestPatch {
+	p.Set([]string{"status", "token"}, v)
+	return p
+}
+
+func (p *TokenRequestPatch) RemoveStatusToken() *TokenRequestPatch {
+	p.Remove([]string{"status", "token"})
+	return p
+}
+
+func (p *TokenRequestPatch) SetStatusExpirationTimestamp(v metav1.Time) *TokenRequestPatch {
+	p.Set([]string{"status", "expirationTimestamp"}, v)
+	return p
+}
+
+func (p *TokenRequestPatch) RemoveStatusExpirationTimestamp() *TokenRequestPatch {
+	p.Remove([]string{"status", "expirationTimestamp"})
+	return p
+}
+
+func (in *TokenRequest) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *TokenRequest) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *TokenRequest) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	first := true
+	if in.TypeMeta.Kind != "" {
+		w.RawString("\"kind\":")
+		w.String(in.TypeMeta.Kind)
+		first = false
+	}
+	if in.TypeMeta.APIVersion != "" {
+		if !first {
+			w.RawByte(',')
+		}
+		w.RawString("\"apiVersion\":")
+		w.String(in.TypeMeta.APIVersion)
+		first = false
+	}
+	if !first {
+		w.RawByte(',')
+	}
+	w.RawString("\"metadata\":")
+	in.ObjectMeta.MarshalEasyJSON(w)
+	w.RawString(",\"spec\":")
+	in.Spec.MarshalEasyJSON(w)
+	if in.Status != nil {
+		w.RawString(",\"status\":")
+		in.Status.MarshalEasyJSON(w)
+	}
+	w.RawByte('}')
+}
+
+func (in *TokenRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "kind":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.TypeMeta.Kind = l.String()
+			}
+		case "apiVersion":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.TypeMeta.APIVersion = l.String()
+			}
+		case "metadata":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.ObjectMeta.UnmarshalEasyJSON(l)
+			}
+		case "spec":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.Spec.UnmarshalEasyJSON(l)
+			}
+		case "status":
+			if l.IsNull() {
+				l.Skip()
+				in.Status = nil
+			} else {
+				if in.Status == nil {
+					in.Status = new(TokenRequestStatus)
+				}
+				in.Status.UnmarshalEasyJSON(l)
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
+
 type TokenRequestList struct {
 	metav1.TypeMeta `json:",inline"`
 	metav1.ListMeta `json:"metadata"`
@@ -169,6 +743,117 @@ func (in *TokenRequestList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+func (in *TokenRequestList) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *TokenRequestList) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *TokenRequestList) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	first := true
+	if in.TypeMeta.Kind != "" {
+		w.RawString("\"kind\":")
+		w.String(in.TypeMeta.Kind)
+		first = false
+	}
+	if in.TypeMeta.APIVersion != "" {
+		if !first {
+			w.RawByte(',')
+		}
+		w.RawString("\"apiVersion\":")
+		w.String(in.TypeMeta.APIVersion)
+		first = false
+	}
+	if !first {
+		w.RawByte(',')
+	}
+	w.RawString("\"metadata\":")
+	in.ListMeta.MarshalEasyJSON(w)
+	w.RawString(",\"items\":")
+	if in.Items == nil {
+		w.RawString("null")
+	} else {
+		w.RawByte('[')
+		for i0 := range in.Items {
+			if i0 > 0 {
+				w.RawByte(',')
+			}
+			in.Items[i0].MarshalEasyJSON(w)
+		}
+		w.RawByte(']')
+	}
+	w.RawByte('}')
+}
+
+func (in *TokenRequestList) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "kind":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.TypeMeta.Kind = l.String()
+			}
+		case "apiVersion":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.TypeMeta.APIVersion = l.String()
+			}
+		case "metadata":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.ListMeta.UnmarshalEasyJSON(l)
+			}
+		case "items":
+			if l.IsNull() {
+				l.Skip()
+				in.Items = nil
+			} else {
+				in.Items = make([]TokenRequest, 0)
+				l.Delim('[')
+				for !l.IsDelim(']') {
+					var v0 TokenRequest
+					if l.IsNull() {
+						l.Skip()
+					} else {
+						v0.UnmarshalEasyJSON(l)
+					}
+					in.Items = append(in.Items, v0)
+					l.WantComma()
+				}
+				l.Delim(']')
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
+
 type TokenReview struct {
 	metav1.TypeMeta   `json:",inline"`
 	metav1.ObjectMeta `json:"metadata"`
@@ -206,6 +891,287 @@ func (in *TokenReview) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// TokenReviewToSelectableFields returns the fields of TokenReview which can be used by the field selector.
+func TokenReviewToSelectableFields(obj *TokenReview) fields.Set {
+	set := fields.Set{
+		"metadata.name":      obj.ObjectMeta.Name,
+		"metadata.namespace": obj.ObjectMeta.Namespace,
+	}
+	return set
+}
+
+// TokenReviewPatch builds JSON patch or JSON merge patch of TokenReview.
+// JSON patch fails if the parent of the field doesn't exist (e.g. SetLabel to the object which has no label). MergePatch creates the parent.
+type TokenReviewPatch struct {
+	*patch.Builder
+}
+
+func NewTokenReviewPatch() *TokenReviewPatch {
+	return &TokenReviewPatch{Builder: patch.NewBuilder()}
+}
+
+func (p *TokenReviewPatch) SetLabel(key, value string) *TokenReviewPatch {
+	p.Set([]string{"metadata", "labels", key}, value)
+	return p
+}
+
+func (p *TokenReviewPatch) RemoveLabel(key string) *TokenReviewPatch {
+	p.Remove([]string{"metadata", "labels", key})
+	return p
+}
+
+func (p *TokenReviewPatch) SetAnnotation(key, value string) *TokenReviewPatch {
+	p.Set([]string{"metadata", "annotations", key}, value)
+	return p
+}
+
+func (p *TokenReviewPatch) RemoveAnnotation(key string) *TokenReviewPatch {
+	p.Remove([]string{"metadata", "annotations", key})
+	return p
+}
+
+func (p *TokenReviewPatch) SetSpec(v TokenReviewSpec) *TokenReviewPatch {
+	p.Set([]string{"spec"}, v)
+	return p
+}
+
+func (p *TokenReviewPatch) RemoveSpec() *TokenReviewPatch {
+	p.Remove([]string{"spec"})
+	return p
+}
+
+func (p *TokenReviewPatch) SetSpecToken(v string) *TokenReviewPatch {
+	p.Set([]string{"spec", "token"}, v)
+	return p
+}
+
+func (p *TokenReviewPatch) RemoveSpecToken() *TokenReviewPatch {
+	p.Remove([]string{"spec", "token"})
+	return p
+}
+
+func (p *TokenReviewPatch) SetSpecAudiences(v []string) *TokenReviewPatch {
+	p.Set([]string{"spec", "audiences"}, v)
+	return p
+}
+
+func (p *TokenReviewPatch) RemoveSpecAudiences() *TokenReviewPatch {
+	p.Remove([]string{"spec", "audiences"})
+	return p
+}
+
+func (p *TokenReviewPatch) AddSpecAudiences(v ...string) *TokenReviewPatch {
+	for _, e := range v {
+		p.Append([]string{"spec", "audiences"}, e)
+	}
+	return p
+}
+
+func (p *TokenReviewPatch) SetStatus(v *TokenReviewStatus) *TokenReviewPatch {
+	p.Set([]string{"status"}, v)
+	return p
+}
+
+func (p *TokenReviewPatch) RemoveStatus() *TokenReviewPatch {
+	p.Remove([]string{"status"})
+	return p
+}
+
+func (p *TokenReviewPatch) SetStatusAuthenticated(v bool) *TokenReviewPatch {
+	p.Set([]string{"status", "authenticated"}, v)
+	return p
+}
+
+func (p *TokenReviewPatch) RemoveStatusAuthenticated() *TokenReviewPatch {
+	p.Remove([]string{"status", "authenticated"})
+	return p
+}
+
+func (p *TokenReviewPatch) SetStatusUser(v *UserInfo) *TokenReviewPatch {
+	p.Set([]string{"status", "user"}, v)
+	return p
+}
+
+func (p *TokenReviewPatch) RemoveStatusUser() *TokenReviewPatch {
+	p.Remove([]string{"status", "user"})
+	return p
+}
+
+func (p *TokenReviewPatch) SetStatusUserUsername(v string) *TokenReviewPatch {
+	p.Set([]string{"status", "user", "username"}, v)
+	return p
+}
+
+func (p *TokenReviewPatch) RemoveStatusUserUsername() *TokenReviewPatch {
+	p.Remove([]string{"status", "user", "username"})
+	return p
+}
+
+func (p *TokenReviewPatch) SetStatusUserUID(v string) *TokenReviewPatch {
+	p.Set([]string{"status", "user", "uid"}, v)
+	return p
+}
+
+func (p *TokenReviewPatch) RemoveStatusUserUID() *TokenReviewPatch {
+	p.Remove([]string{"status", "user", "uid"})
+	return p
+}
+
+func (p *TokenReviewPatch) SetStatusUserGroups(v []string) *TokenReviewPatch {
+	p.Set([]string{"status", "user", "groups"}, v)
+	return p
+}
+
+func (p *TokenReviewPatch) RemoveStatusUserGroups() *TokenReviewPatch {
+	p.Remove([]string{"status", "user", "groups"})
+	return p
+}
+
+func (p *TokenReviewPatch) AddStatusUserGroups(v ...string) *TokenReviewPatch {
+	for _, e := range v {
+		p.Append([]string{"status", "user", "groups"}, e)
+	}
+	return p
+}
+
+func (p *TokenReviewPatch) SetStatusUserExtra(v map[string]ExtraValue) *TokenReviewPatch {
+	p.Set([]string{"status", "user", "extra"}, v)
+	return p
+}
+
+func (p *TokenReviewPatch) RemoveStatusUserExtra() *TokenReviewPatch {
+	p.Remove([]string{"status", "user", "extra"})
+	return p
+}
+
+func (p *TokenReviewPatch) SetStatusAudiences(v []string) *TokenReviewPatch {
+	p.Set([]string{"status", "audiences"}, v)
+	return p
+}
+
+func (p *TokenReviewPatch) RemoveStatusAudiences() *TokenReviewPatch {
+	p.Remove([]string{"status", "audiences"})
+	return p
+}
+
+func (p *TokenReviewPatch) AddStatusAudiences(v ...string) *TokenReviewPatch {
+	for _, e := range v {
+		p.Append([]string{"status", "audiences"}, e)
+	}
+	return p
+}
+
+func (p *TokenReviewPatch) SetStatusError(v string) *TokenReviewPatch {
+	p.Set([]string{"status", "error"}, v)
+	return p
+}
+
+func (p *TokenReviewPatch) RemoveStatusError() *TokenReviewPatch {
+	p.Remove([]string{"status", "error"})
+	return p
+}
+
+func (in *TokenReview) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *TokenReview) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *TokenReview) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	first := true
+	if in.TypeMeta.Kind != "" {
+		w.RawString("\"kind\":")
+		w.String(in.TypeMeta.Kind)
+		first = false
+	}
+	if in.TypeMeta.APIVersion != "" {
+		if !first {
+			w.RawByte(',')
+		}
+		w.RawString("\"apiVersion\":")
+		w.String(in.TypeMeta.APIVersion)
+		first = false
+	}
+	if !first {
+		w.RawByte(',')
+	}
+	w.RawString("\"metadata\":")
+	in.ObjectMeta.MarshalEasyJSON(w)
+	w.RawString(",\"spec\":")
+	in.Spec.MarshalEasyJSON(w)
+	if in.Status != nil {
+		w.RawString(",\"status\":")
+		in.Status.MarshalEasyJSON(w)
+	}
+	w.RawByte('}')
+}
+
+func (in *TokenReview) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "kind":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.TypeMeta.Kind = l.String()
+			}
+		case "apiVersion":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.TypeMeta.APIVersion = l.String()
+			}
+		case "metadata":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.ObjectMeta.UnmarshalEasyJSON(l)
+			}
+		case "spec":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.Spec.UnmarshalEasyJSON(l)
+			}
+		case "status":
+			if l.IsNull() {
+				l.Skip()
+				in.Status = nil
+			} else {
+				if in.Status == nil {
+					in.Status = new(TokenReviewStatus)
+				}
+				in.Status.UnmarshalEasyJSON(l)
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
+
 type TokenReviewList struct {
 	metav1.TypeMeta `json:",inline"`
 	metav1.ListMeta `json:"metadata"`
@@ -241,6 +1207,117 @@ func (in *TokenReviewList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+func (in *TokenReviewList) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *TokenReviewList) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *TokenReviewList) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	first := true
+	if in.TypeMeta.Kind != "" {
+		w.RawString("\"kind\":")
+		w.String(in.TypeMeta.Kind)
+		first = false
+	}
+	if in.TypeMeta.APIVersion != "" {
+		if !first {
+			w.RawByte(',')
+		}
+		w.RawString("\"apiVersion\":")
+		w.String(in.TypeMeta.APIVersion)
+		first = false
+	}
+	if !first {
+		w.RawByte(',')
+	}
+	w.RawString("\"metadata\":")
+	in.ListMeta.MarshalEasyJSON(w)
+	w.RawString(",\"items\":")
+	if in.Items == nil {
+		w.RawString("null")
+	} else {
+		w.RawByte('[')
+		for i0 := range in.Items {
+			if i0 > 0 {
+				w.RawByte(',')
+			}
+			in.Items[i0].MarshalEasyJSON(w)
+		}
+		w.RawByte(']')
+	}
+	w.RawByte('}')
+}
+
+func (in *TokenReviewList) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "kind":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.TypeMeta.Kind = l.String()
+			}
+		case "apiVersion":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.TypeMeta.APIVersion = l.String()
+			}
+		case "metadata":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.ListMeta.UnmarshalEasyJSON(l)
+			}
+		case "items":
+			if l.IsNull() {
+				l.Skip()
+				in.Items = nil
+			} else {
+				in.Items = make([]TokenReview, 0)
+				l.Delim('[')
+				for !l.IsDelim(']') {
+					var v0 TokenReview
+					if l.IsNull() {
+						l.Skip()
+					} else {
+						v0.UnmarshalEasyJSON(l)
+					}
+					in.Items = append(in.Items, v0)
+					l.WantComma()
+				}
+				l.Delim(']')
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
+
 type SelfSubjectReviewStatus struct {
 	// userInfo is a set of attributes belonging to the user making this request.
 	UserInfo *UserInfo `json:"userInfo,omitempty"`
@@ -264,6 +1341,63 @@ func (in *SelfSubjectReviewStatus) DeepCopy() *SelfSubjectReviewStatus {
 	return out
 }
 
+func (in *SelfSubjectReviewStatus) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *SelfSubjectReviewStatus) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *SelfSubjectReviewStatus) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	if in.UserInfo != nil {
+		w.RawString("\"userInfo\":")
+		in.UserInfo.MarshalEasyJSON(w)
+	}
+
+	w.RawByte('}')
+}
+
+func (in *SelfSubjectReviewStatus) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "userInfo":
+			if l.IsNull() {
+				l.Skip()
+				in.UserInfo = nil
+			} else {
+				if in.UserInfo == nil {
+					in.UserInfo = new(UserInfo)
+				}
+				in.UserInfo.UnmarshalEasyJSON(l)
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
+
 type TokenRequestSpec struct {
 	// audiences are the intendend audiences of the token. A recipient of a
 	// token must identify themself with an identifier in the list of
@@ -307,6 +1441,105 @@ func (in *TokenRequestSpec) DeepCopy() *TokenRequestSpec {
 	return out
 }
 
+func (in *TokenRequestSpec) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *TokenRequestSpec) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *TokenRequestSpec) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	w.RawString("\"audiences\":")
+	if in.Audiences == nil {
+		w.RawString("null")
+	} else {
+		w.RawByte('[')
+		for i0 := range in.Audiences {
+			if i0 > 0 {
+				w.RawByte(',')
+			}
+			w.String(in.Audiences[i0])
+		}
+		w.RawByte(']')
+	}
+	if in.ExpirationSeconds != 0 {
+		w.RawString(",\"expirationSeconds\":")
+		w.Int64(in.ExpirationSeconds)
+	}
+	if in.BoundObjectRef != nil {
+		w.RawString(",\"boundObjectRef\":")
+		in.BoundObjectRef.MarshalEasyJSON(w)
+	}
+
+	w.RawByte('}')
+}
+
+func (in *TokenRequestSpec) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "audiences":
+			if l.IsNull() {
+				l.Skip()
+				in.Audiences = nil
+			} else {
+				in.Audiences = make([]string, 0)
+				l.Delim('[')
+				for !l.IsDelim(']') {
+					var v0 string
+					if l.IsNull() {
+						l.Skip()
+					} else {
+						v0 = l.String()
+					}
+					in.Audiences = append(in.Audiences, v0)
+					l.WantComma()
+				}
+				l.Delim(']')
+			}
+		case "expirationSeconds":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.ExpirationSeconds = l.Int64()
+			}
+		case "boundObjectRef":
+			if l.IsNull() {
+				l.Skip()
+				in.BoundObjectRef = nil
+			} else {
+				if in.BoundObjectRef == nil {
+					in.BoundObjectRef = new(BoundObjectReference)
+				}
+				in.BoundObjectRef.UnmarshalEasyJSON(l)
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
+
 type TokenRequestStatus struct {
 	// token is the opaque bearer token.
 	Token string `json:"token"`
@@ -328,6 +1561,61 @@ func (in *TokenRequestStatus) DeepCopy() *TokenRequestStatus {
 	return out
 }
 
+func (in *TokenRequestStatus) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *TokenRequestStatus) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *TokenRequestStatus) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	w.RawString("\"token\":")
+	w.String(in.Token)
+	w.RawString(",\"expirationTimestamp\":")
+	w.Raw(json.Marshal(&in.ExpirationTimestamp))
+
+	w.RawByte('}')
+}
+
+func (in *TokenRequestStatus) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "token":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.Token = l.String()
+			}
+		case "expirationTimestamp":
+			l.AddError(json.Unmarshal(l.Raw(), &in.ExpirationTimestamp))
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
+
 type TokenReviewSpec struct {
 	// token is the opaque bearer token.
 	Token string `json:"token,omitempty"`
@@ -357,6 +1645,95 @@ func (in *TokenReviewSpec) DeepCopy() *TokenReviewSpec {
 	return out
 }
 
+func (in *TokenReviewSpec) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *TokenReviewSpec) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *TokenReviewSpec) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	first := true
+	if in.Token != "" {
+		w.RawString("\"token\":")
+		w.String(in.Token)
+		first = false
+	}
+	if !first {
+		w.RawByte(',')
+	}
+	w.RawString("\"audiences\":")
+	if in.Audiences == nil {
+		w.RawString("null")
+	} else {
+		w.RawByte('[')
+		for i0 := range in.Audiences {
+			if i0 > 0 {
+				w.RawByte(',')
+			}
+			w.String(in.Audiences[i0])
+		}
+		w.RawByte(']')
+	}
+	w.RawByte('}')
+}
+
+func (in *TokenReviewSpec) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "token":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.Token = l.String()
+			}
+		case "audiences":
+			if l.IsNull() {
+				l.Skip()
+				in.Audiences = nil
+			} else {
+				in.Audiences = make([]string, 0)
+				l.Delim('[')
+				for !l.IsDelim(']') {
+					var v0 string
+					if l.IsNull() {
+						l.Skip()
+					} else {
+						v0 = l.String()
+					}
+					in.Audiences = append(in.Audiences, v0)
+					l.WantComma()
+				}
+				l.Delim(']')
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
+
 type TokenReviewStatus struct {
 	// authenticated indicates that the token was associated with a known user.
 	Authenticated bool `json:"authenticated,omitempty"`
@@ -399,6 +1776,123 @@ func (in *TokenReviewStatus) DeepCopy() *TokenReviewStatus {
 	return out
 }
 
+func (in *TokenReviewStatus) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *TokenReviewStatus) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *TokenReviewStatus) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	first := true
+	if in.Authenticated {
+		w.RawString("\"authenticated\":")
+		w.Bool(in.Authenticated)
+		first = false
+	}
+	if in.User != nil {
+		if !first {
+			w.RawByte(',')
+		}
+		w.RawString("\"user\":")
+		in.User.MarshalEasyJSON(w)
+		first = false
+	}
+	if !first {
+		w.RawByte(',')
+	}
+	w.RawString("\"audiences\":")
+	if in.Audiences == nil {
+		w.RawString("null")
+	} else {
+		w.RawByte('[')
+		for i0 := range in.Audiences {
+			if i0 > 0 {
+				w.RawByte(',')
+			}
+			w.String(in.Audiences[i0])
+		}
+		w.RawByte(']')
+	}
+	if in.Error != "" {
+		w.RawString(",\"error\":")
+		w.String(in.Error)
+	}
+	w.RawByte('}')
+}
+
+func (in *TokenReviewStatus) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "authenticated":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.Authenticated = l.Bool()
+			}
+		case "user":
+			if l.IsNull() {
+				l.Skip()
+				in.User = nil
+			} else {
+				if in.User == nil {
+					in.User = new(UserInfo)
+				}
+				in.User.UnmarshalEasyJSON(l)
+			}
+		case "audiences":
+			if l.IsNull() {
+				l.Skip()
+				in.Audiences = nil
+			} else {
+				in.Audiences = make([]string, 0)
+				l.Delim('[')
+				for !l.IsDelim(']') {
+					var v0 string
+					if l.IsNull() {
+						l.Skip()
+					} else {
+						v0 = l.String()
+					}
+					in.Audiences = append(in.Audiences, v0)
+					l.WantComma()
+				}
+				l.Delim(']')
+			}
+		case "error":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.Error = l.String()
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
+
 type UserInfo struct {
 	// username is the name that uniquely identifies this user among all active users.
 	Username string `json:"username,omitempty"`
@@ -437,6 +1931,174 @@ func (in *UserInfo) DeepCopy() *UserInfo {
 	return out
 }
 
+func (in *UserInfo) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *UserInfo) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *UserInfo) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	first := true
+	if in.Username != "" {
+		w.RawString("\"username\":")
+		w.String(in.Username)
+		first = false
+	}
+	if in.UID != "" {
+		if !first {
+			w.RawByte(',')
+		}
+		w.RawString("\"uid\":")
+		w.String(in.UID)
+		first = false
+	}
+	if !first {
+		w.RawByte(',')
+	}
+	w.RawString("\"groups\":")
+	if in.Groups == nil {
+		w.RawString("null")
+	} else {
+		w.RawByte('[')
+		for i0 := range in.Groups {
+			if i0 > 0 {
+				w.RawByte(',')
+			}
+			w.String(in.Groups[i0])
+		}
+		w.RawByte(']')
+	}
+	if len(in.Extra) != 0 {
+		w.RawString(",\"extra\":")
+		if in.Extra == nil {
+			w.RawString("null")
+		} else {
+			w.RawByte('{')
+			for i0, k0 := range slices.Sorted(maps.Keys(in.Extra)) {
+				if i0 > 0 {
+					w.RawByte(',')
+				}
+				w.String(k0)
+				w.RawByte(':')
+				v0 := in.Extra[k0]
+				if v0 == nil {
+					w.RawString("null")
+				} else {
+					w.RawByte('[')
+					for i1 := range v0 {
+						if i1 > 0 {
+							w.RawByte(',')
+						}
+						w.String(v0[i1])
+					}
+					w.RawByte(']')
+				}
+			}
+			w.RawByte('}')
+		}
+	}
+	w.RawByte('}')
+}
+
+func (in *UserInfo) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "username":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.Username = l.String()
+			}
+		case "uid":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.UID = l.String()
+			}
+		case "groups":
+			if l.IsNull() {
+				l.Skip()
+				in.Groups = nil
+			} else {
+				in.Groups = make([]string, 0)
+				l.Delim('[')
+				for !l.IsDelim(']') {
+					var v0 string
+					if l.IsNull() {
+						l.Skip()
+					} else {
+						v0 = l.String()
+					}
+					in.Groups = append(in.Groups, v0)
+					l.WantComma()
+				}
+				l.Delim(']')
+			}
+		case "extra":
+			if l.IsNull() {
+				l.Skip()
+				in.Extra = nil
+			} else {
+				if in.Extra == nil {
+					in.Extra = make(map[string]ExtraValue)
+				}
+				l.Delim('{')
+				for !l.IsDelim('}') {
+					k0 := l.String()
+					l.WantColon()
+					var v0 ExtraValue
+					if l.IsNull() {
+						l.Skip()
+						v0 = nil
+					} else {
+						v0 = make(ExtraValue, 0)
+						l.Delim('[')
+						for !l.IsDelim(']') {
+							var v1 string
+							if l.IsNull() {
+								l.Skip()
+							} else {
+								v1 = l.String()
+							}
+							v0 = append(v0, v1)
+							l.WantComma()
+						}
+						l.Delim(']')
+					}
+					in.Extra[k0] = v0
+					l.WantComma()
+				}
+				l.Delim('}')
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
+
 type BoundObjectReference struct {
 	// kind of the referent. Valid kinds are 'Pod' and 'Secret'.
 	Kind string `json:"kind,omitempty"`
@@ -461,4 +2123,100 @@ func (in *BoundObjectReference) DeepCopy() *BoundObjectReference {
 	return out
 }
 
+func (in *BoundObjectReference) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *BoundObjectReference) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *BoundObjectReference) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	first := true
+	if in.Kind != "" {
+		w.RawString("\"kind\":")
+		w.String(in.Kind)
+		first = false
+	}
+	if in.APIVersion != "" {
+		if !first {
+			w.RawByte(',')
+		}
+		w.RawString("\"apiVersion\":")
+		w.String(in.APIVersion)
+		first = false
+	}
+	if in.Name != "" {
+		if !first {
+			w.RawByte(',')
+		}
+		w.RawString("\"name\":")
+		w.String(in.Name)
+		first = false
+	}
+	if in.UID != "" {
+		if !first {
+			w.RawByte(',')
+		}
+		w.RawString("\"uid\":")
+		w.String(in.UID)
+		first = false
+	}
+	w.RawByte('}')
+}
+
+func (in *BoundObjectReference) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "kind":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.Kind = l.String()
+			}
+		case "apiVersion":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.APIVersion = l.String()
+			}
+		case "name":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.Name = l.String()
+			}
+		case "uid":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.UID = l.String()
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
+
 type ExtraValue []string
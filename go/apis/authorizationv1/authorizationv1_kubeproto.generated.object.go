@@ -1,9 +1,15 @@
 package authorizationv1
 
 import (
+	"github.com/mailru/easyjson/jlexer"
+	"github.com/mailru/easyjson/jwriter"
 	"go.f110.dev/kubeproto/go/apis/metav1"
+	"go.f110.dev/kubeproto/go/patch"
+	"k8s.io/apimachinery/pkg/fields"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"maps"
+	"slices"
 )
 
 const GroupName = "authorization.k8s.io"
@@ -68,6 +74,437 @@ func (in *LocalSubjectAccessReview) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// LocalSubjectAccessReviewToSelectableFields returns the fields of LocalSubjectAccessReview which can be used by the field selector.
+func LocalSubjectAccessReviewToSelectableFields(obj *LocalSubjectAccessReview) fields.Set {
+	set := fields.Set{
+		"metadata.name":      obj.ObjectMeta.Name,
+		"metadata.namespace": obj.ObjectMeta.Namespace,
+	}
+	return set
+}
+
+// LocalSubjectAccessReviewPatch builds JSON patch or JSON merge patch of LocalSubjectAccessReview.
+// JSON patch fails if the parent of the field doesn't exist (e.g. SetLabel to the object which has no label). MergePatch creates the parent.
+type LocalSubjectAccessReviewPatch struct {
+	*patch.Builder
+}
+
+func NewLocalSubjectAccessReviewPatch() *LocalSubjectAccessReviewPatch {
+	return &LocalSubjectAccessReviewPatch{Builder: patch.NewBuilder()}
+}
+
+func (p *LocalSubjectAccessReviewPatch) SetLabel(key, value string) *LocalSubjectAccessReviewPatch {
+	p.Set([]string{"metadata", "labels", key}, value)
+	return p
+}
+
+func (p *LocalSubjectAccessReviewPatch) RemoveLabel(key string) *LocalSubjectAccessReviewPatch {
+	p.Remove([]string{"metadata", "labels", key})
+	return p
+}
+
+func (p *LocalSubjectAccessReviewPatch) SetAnnotation(key, value string) *LocalSubjectAccessReviewPatch {
+	p.Set([]string{"metadata", "annotations", key}, value)
+	return p
+}
+
+func (p *LocalSubjectAccessReviewPatch) RemoveAnnotation(key string) *LocalSubjectAccessReviewPatch {
+	p.Remove([]string{"metadata", "annotations", key})
+	return p
+}
+
+func (p *LocalSubjectAccessReviewPatch) SetSpec(v SubjectAccessReviewSpec) *LocalSubjectAccessReviewPatch {
+	p.Set([]string{"spec"}, v)
+	return p
+}
+
+func (p *LocalSubjectAccessReviewPatch) RemoveSpec() *LocalSubjectAccessReviewPatch {
+	p.Remove([]string{"spec"})
+	return p
+}
+
+func (p *LocalSubjectAccessReviewPatch) SetSpecResourceAttributes(v *ResourceAttributes) *LocalSubjectAccessReviewPatch {
+	p.Set([]string{"spec", "resourceAttributes"}, v)
+	return p
+}
+
+func (p *LocalSubjectAccessReviewPatch) RemoveSpecResourceAttributes() *LocalSubjectAccessReviewPatch {
+	p.Remove([]string{"spec", "resourceAttributes"})
+	return p
+}
+
+func (p *LocalSubjectAccessReviewPatch) SetSpecResourceAttributesNamespace(v string) *LocalSubjectAccessReviewPatch {
+	p.Set([]string{"spec", "resourceAttributes", "namespace"}, v)
+	return p
+}
+
+func (p *LocalSubjectAccessReviewPatch) RemoveSpecResourceAttributesNamespace() *LocalSubjectAccessReviewPatch {
+	p.Remove([]string{"spec", "resourceAttributes", "namespace"})
+	return p
+}
+
+func (p *LocalSubjectAccessReviewPatch) SetSpecResourceAttributesVerb(v string) *LocalSubjectAccessReviewPatch {
+	p.Set([]string{"spec", "resourceAttributes", "verb"}, v)
+	return p
+}
+
+func (p *LocalSubjectAccessReviewPatch) RemoveSpecResourceAttributesVerb() *LocalSubjectAccessReviewPatch {
+	p.Remove([]string{"spec", "resourceAttributes", "verb"})
+	return p
+}
+
+func (p *LocalSubjectAccessReviewPatch) SetSpecResourceAttributesGroup(v string) *LocalSubjectAccessReviewPatch {
+	p.Set([]string{"spec", "resourceAttributes", "group"}, v)
+	return p
+}
+
+func (p *LocalSubjectAccessReviewPatch) RemoveSpecResourceAttributesGroup() *LocalSubjectAccessReviewPatch {
+	p.Remove([]string{"spec", "resourceAttributes", "group"})
+	return p
+}
+
+func (p *LocalSubjectAccessReviewPatch) SetSpecResourceAttributesVersion(v string) *LocalSubjectAccessReviewPatch {
+	p.Set([]string{"spec", "resourceAttributes", "version"}, v)
+	return p
+}
+
+func (p *LocalSubjectAccessReviewPatch) RemoveSpecResourceAttributesVersion() *LocalSubjectAccessReviewPatch {
+	p.Remove([]string{"spec", "resourceAttributes", "version"})
+	return p
+}
+
+func (p *LocalSubjectAccessReviewPatch) SetSpecResourceAttributesResource(v string) *LocalSubjectAccessReviewPatch {
+	p.Set([]string{"spec", "resourceAttributes", "resource"}, v)
+	return p
+}
+
+func (p *LocalSubjectAccessReviewPatch) RemoveSpecResourceAttributesResource() *LocalSubjectAccessReviewPatch {
+	p.Remove([]string{"spec", "resourceAttributes", "resource"})
+	return p
+}
+
+func (p *LocalSubjectAccessReviewPatch) SetSpecResourceAttributesSubresource(v string) *LocalSubjectAccessReviewPatch {
+	p.Set([]string{"spec", "resourceAttributes", "subresource"}, v)
+	return p
+}
+
+func (p *LocalSubjectAccessReviewPatch) RemoveSpecResourceAttributesSubresource() *LocalSubjectAccessReviewPatch {
+	p.Remove([]string{"spec", "resourceAttributes", "subresource"})
+	return p
+}
+
+func (p *LocalSubjectAccessReviewPatch) SetSpecResourceAttributesName(v string) *LocalSubjectAccessReviewPatch {
+	p.Set([]string{"spec", "resourceAttributes", "name"}, v)
+	return p
+}
+
+func (p *LocalSubjectAccessReviewPatch) RemoveSpecResourceAttributesName() *LocalSubjectAccessReviewPatch {
+	p.Remove([]string{"spec", "resourceAttributes", "name"})
+	return p
+}
+
+func (p *LocalSubjectAccessReviewPatch) SetSpecResourceAttributesFieldSelector(v *FieldSelectorAttributes) *LocalSubjectAccessReviewPatch {
+	p.Set([]string{"spec", "resourceAttributes", "fieldSelector"}, v)
+	return p
+}
+
+func (p *LocalSubjectAccessReviewPatch) RemoveSpecResourceAttributesFieldSelector() *LocalSubjectAccessReviewPatch {
+	p.Remove([]string{"spec", "resourceAttributes", "fieldSelector"})
+	return p
+}
+
+func (p *LocalSubjectAccessReviewPatch) SetSpecResourceAttributesFieldSelectorRawSelector(v string) *LocalSubjectAccessReviewPatch {
+	p.Set([]string{"spec", "resourceAttributes", "fieldSelector", "rawSelector"}, v)
+	return p
+}
+
+func (p *LocalSubjectAccessReviewPatch) RemoveSpecResourceAttributesFieldSelectorRawSelector() *LocalSubjectAccessReviewPatch {
+	p.Remove([]string{"spec", "resourceAttributes", "fieldSelector", "rawSelector"})
+	return p
+}
+
+func (p *LocalSubjectAccessReviewPatch) SetSpecResourceAttributesFieldSelectorRequirements(v []metav1.FieldSelectorRequirement) *LocalSubjectAccessReviewPatch {
+	p.Set([]string{"spec", "resourceAttributes", "fieldSelector", "requirements"}, v)
+	return p
+}
+
+func (p *LocalSubjectAccessReviewPatch) RemoveSpecResourceAttributesFieldSelectorRequirements() *LocalSubjectAccessReviewPatch {
+	p.Remove([]string{"spec", "resourceAttributes", "fieldSelector", "requirements"})
+	return p
+}
+
+func (p *LocalSubjectAccessReviewPatch) AddSpecResourceAttributesFieldSelectorRequirements(v ...metav1.FieldSelectorRequirement) *LocalSubjectAccessReviewPatch {
+	for _, e := range v {
+		p.Append([]string{"spec", "resourceAttributes", "fieldSelector", "requirements"}, e)
+	}
+	return p
+}
+
+func (p *LocalSubjectAccessReviewPatch) SetSpecResourceAttributesLabelSelector(v *LabelSelectorAttributes) *LocalSubjectAccessReviewPatch {
+	p.Set([]string{"spec", "resourceAttributes", "labelSelector"}, v)
+	return p
+}
+
+func (p *LocalSubjectAccessReviewPatch) RemoveSpecResourceAttributesLabelSelector() *LocalSubjectAccessReviewPatch {
+	p.Remove([]string{"spec", "resourceAttributes", "labelSelector"})
+	return p
+}
+
+func (p *LocalSubjectAccessReviewPatch) SetSpecResourceAttributesLabelSelectorRawSelector(v string) *LocalSubjectAccessReviewPatch {
+	p.Set([]string{"spec", "resourceAttributes", "labelSelector", "rawSelector"}, v)
+	return p
+}
+
+func (p *LocalSubjectAccessReviewPatch) RemoveSpecResourceAttributesLabelSelectorRawSelector() *LocalSubjectAccessReviewPatch {
+	p.Remove([]string{"spec", "resourceAttributes", "labelSelector", "rawSelector"})
+	return p
+}
+
+func (p *LocalSubjectAccessReviewPatch) SetSpecResourceAttributesLabelSelectorRequirements(v []metav1.LabelSelectorRequirement) *LocalSubjectAccessReviewPatch {
+	p.Set([]string{"spec", "resourceAttributes", "labelSelector", "requirements"}, v)
+	return p
+}
+
+func (p *LocalSubjectAccessReviewPatch) RemoveSpecResourceAttributesLabelSelectorRequirements() *LocalSubjectAccessReviewPatch {
+	p.Remove([]string{"spec", "resourceAttributes", "labelSelector", "requirements"})
+	return p
+}
+
+func (p *LocalSubjectAccessReviewPatch) AddSpecResourceAttributesLabelSelectorRequirements(v ...metav1.LabelSelectorRequirement) *LocalSubjectAccessReviewPatch {
+	for _, e := range v {
+		p.Append([]string{"spec", "resourceAttributes", "labelSelector", "requirements"}, e)
+	}
+	return p
+}
+
+func (p *LocalSubjectAccessReviewPatch) SetSpecNonResourceAttributes(v *NonResourceAttributes) *LocalSubjectAccessReviewPatch {
+	p.Set([]string{"spec", "nonResourceAttributes"}, v)
+	return p
+}
+
+func (p *LocalSubjectAccessReviewPatch) RemoveSpecNonResourceAttributes() *LocalSubjectAccessReviewPatch {
+	p.Remove([]string{"spec", "nonResourceAttributes"})
+	return p
+}
+
+func (p *LocalSubjectAccessReviewPatch) SetSpecNonResourceAttributesPath(v string) *LocalSubjectAccessReviewPatch {
+	p.Set([]string{"spec", "nonResourceAttributes", "path"}, v)
+	return p
+}
+
+func (p *LocalSubjectAccessReviewPatch) RemoveSpecNonResourceAttributesPath() *LocalSubjectAccessReviewPatch {
+	p.Remove([]string{"spec", "nonResourceAttributes", "path"})
+	return p
+}
+
+func (p *LocalSubjectAccessReviewPatch) SetSpecNonResourceAttributesVerb(v string) *LocalSubjectAccessReviewPatch {
+	p.Set([]string{"spec", "nonResourceAttributes", "verb"}, v)
+	return p
+}
+
+func (p *LocalSubjectAccessReviewPatch) RemoveSpecNonResourceAttributesVerb() *LocalSubjectAccessReviewPatch {
+	p.Remove([]string{"spec", "nonResourceAttributes", "verb"})
+	return p
+}
+
+func (p *LocalSubjectAccessReviewPatch) SetSpecUser(v string) *LocalSubjectAccessReviewPatch {
+	p.Set([]string{"spec", "user"}, v)
+	return p
+}
+
+func (p *LocalSubjectAccessReviewPatch) RemoveSpecUser() *LocalSubjectAccessReviewPatch {
+	p.Remove([]string{"spec", "user"})
+	return p
+}
+
+func (p *LocalSubjectAccessReviewPatch) SetSpecGroups(v []string) *LocalSubjectAccessReviewPatch {
+	p.Set([]string{"spec", "groups"}, v)
+	return p
+}
+
+func (p *LocalSubjectAccessReviewPatch) RemoveSpecGroups() *LocalSubjectAccessReviewPatch {
+	p.Remove([]string{"spec", "groups"})
+	return p
+}
+
+func (p *LocalSubjectAccessReviewPatch) AddSpecGroups(v ...string) *LocalSubjectAccessReviewPatch {
+	for _, e := range v {
+		p.Append([]string{"spec", "groups"}, e)
+	}
+	return p
+}
+
+func (p *LocalSubjectAccessReviewPatch) SetSpecExtra(v map[string]ExtraValue) *LocalSubjectAccessReviewPatch {
+	p.Set([]string{"spec", "extra"}, v)
+	return p
+}
+
+func (p *LocalSubjectAccessReviewPatch) RemoveSpecExtra() *LocalSubjectAccessReviewPatch {
+	p.Remove([]string{"spec", "extra"})
+	return p
+}
+
+func (p *LocalSubjectAccessReviewPatch) SetSpecUID(v string) *LocalSubjectAccessReviewPatch {
+	p.Set([]string{"spec", "uid"}, v)
+	return p
+}
+
+func (p *LocalSubjectAccessReviewPatch) RemoveSpecUID() *LocalSubjectAccessReviewPatch {
+	p.Remove([]string{"spec", "uid"})
+	return p
+}
+
+func (p *LocalSubjectAccessReviewPatch) SetStatus(v *SubjectAccessReviewStatus) *LocalSubjectAccessReviewPatch {
+	p.Set([]string{"status"}, v)
+	return p
+}
+
+func (p *LocalSubjectAccessReviewPatch) RemoveStatus() *LocalSubjectAccessReviewPatch {
+	p.Remove([]string{"status"})
+	return p
+}
+
+func (p *LocalSubjectAccessReviewPatch) SetStatusAllowed(v bool) *LocalSubjectAccessReviewPatch {
+	p.Set([]string{"status", "allowed"}, v)
+	return p
+}
+
+func (p *LocalSubjectAccessReviewPatch) RemoveStatusAllowed() *LocalSubjectAccessReviewPatch {
+	p.Remove([]string{"status", "allowed"})
+	return p
+}
+
+func (p *LocalSubjectAccessReviewPatch) SetStatusDenied(v bool) *LocalSubjectAccessReviewPatch {
+	p.Set([]string{"status", "denied"}, v)
+	return p
+}
+
+func (p *LocalSubjectAccessReviewPatch) RemoveStatusDenied() *LocalSubjectAccessReviewPatch {
+	p.Remove([]string{"status", "denied"})
+	return p
+}
+
+func (p *LocalSubjectAccessReviewPatch) SetStatusReason(v string) *LocalSubjectAccessReviewPatch {
+	p.Set([]string{"status", "reason"}, v)
+	return p
+}
+
+func (p *LocalSubjectAccessReviewPatch) RemoveStatusReason() *LocalSubjectAccessReviewPatch {
+	p.Remove([]string{"status", "reason"})
+	return p
+}
+
+func (p *LocalSubjectAccessReviewPatch) SetStatusEvaluationError(v string) *LocalSubjectAccessReviewPatch {
+	p.Set([]string{"status", "evaluationError"}, v)
+	return p
+}
+
+func (p *LocalSubjectAccessReviewPatch) RemoveStatusEvaluationError() *LocalSubjectAccessReviewPatch {
+	p.Remove([]string{"status", "evaluationError"})
+	return p
+}
+
+func (in *LocalSubjectAccessReview) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *LocalSubjectAccessReview) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *LocalSubjectAccessReview) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	first := true
+	if in.TypeMeta.Kind != "" {
+		w.RawString("\"kind\":")
+		w.String(in.TypeMeta.Kind)
+		first = false
+	}
+	if in.TypeMeta.APIVersion != "" {
+		if !first {
+			w.RawByte(',')
+		}
+		w.RawString("\"apiVersion\":")
+		w.String(in.TypeMeta.APIVersion)
+		first = false
+	}
+	if !first {
+		w.RawByte(',')
+	}
+	w.RawString("\"metadata\":")
+	in.ObjectMeta.MarshalEasyJSON(w)
+	w.RawString(",\"spec\":")
+	in.Spec.MarshalEasyJSON(w)
+	if in.Status != nil {
+		w.RawString(",\"status\":")
+		in.Status.MarshalEasyJSON(w)
+	}
+	w.RawByte('}')
+}
+
+func (in *LocalSubjectAccessReview) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "kind":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.TypeMeta.Kind = l.String()
+			}
+		case "apiVersion":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.TypeMeta.APIVersion = l.String()
+			}
+		case "metadata":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.ObjectMeta.UnmarshalEasyJSON(l)
+			}
+		case "spec":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.Spec.UnmarshalEasyJSON(l)
+			}
+		case "status":
+			if l.IsNull() {
+				l.Skip()
+				in.Status = nil
+			} else {
+				if in.Status == nil {
+					in.Status = new(SubjectAccessReviewStatus)
+				}
+				in.Status.UnmarshalEasyJSON(l)
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
+
 type LocalSubjectAccessReviewList struct {
 	metav1.TypeMeta `json:",inline"`
 	metav1.ListMeta `json:"metadata"`
@@ -103,6 +540,117 @@ func (in *LocalSubjectAccessReviewList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+func (in *LocalSubjectAccessReviewList) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *LocalSubjectAccessReviewList) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *LocalSubjectAccessReviewList) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	first := true
+	if in.TypeMeta.Kind != "" {
+		w.RawString("\"kind\":")
+		w.String(in.TypeMeta.Kind)
+		first = false
+	}
+	if in.TypeMeta.APIVersion != "" {
+		if !first {
+			w.RawByte(',')
+		}
+		w.RawString("\"apiVersion\":")
+		w.String(in.TypeMeta.APIVersion)
+		first = false
+	}
+	if !first {
+		w.RawByte(',')
+	}
+	w.RawString("\"metadata\":")
+	in.ListMeta.MarshalEasyJSON(w)
+	w.RawString(",\"items\":")
+	if in.Items == nil {
+		w.RawString("null")
+	} else {
+		w.RawByte('[')
+		for i0 := range in.Items {
+			if i0 > 0 {
+				w.RawByte(',')
+			}
+			in.Items[i0].MarshalEasyJSON(w)
+		}
+		w.RawByte(']')
+	}
+	w.RawByte('}')
+}
+
+func (in *LocalSubjectAccessReviewList) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "kind":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.TypeMeta.Kind = l.String()
+			}
+		case "apiVersion":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.TypeMeta.APIVersion = l.String()
+			}
+		case "metadata":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.ListMeta.UnmarshalEasyJSON(l)
+			}
+		case "items":
+			if l.IsNull() {
+				l.Skip()
+				in.Items = nil
+			} else {
+				in.Items = make([]LocalSubjectAccessReview, 0)
+				l.Delim('[')
+				for !l.IsDelim(']') {
+					var v0 LocalSubjectAccessReview
+					if l.IsNull() {
+						l.Skip()
+					} else {
+						v0.UnmarshalEasyJSON(l)
+					}
+					in.Items = append(in.Items, v0)
+					l.WantComma()
+				}
+				l.Delim(']')
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
+
 type SelfSubjectAccessReview struct {
 	metav1.TypeMeta   `json:",inline"`
 	metav1.ObjectMeta `json:"metadata"`
@@ -140,6 +688,390 @@ func (in *SelfSubjectAccessReview) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// SelfSubjectAccessReviewToSelectableFields returns the fields of SelfSubjectAccessReview which can be used by the field selector.
+func SelfSubjectAccessReviewToSelectableFields(obj *SelfSubjectAccessReview) fields.Set {
+	set := fields.Set{
+		"metadata.name":      obj.ObjectMeta.Name,
+		"metadata.namespace": obj.ObjectMeta.Namespace,
+	}
+	return set
+}
+
+// SelfSubjectAccessReviewPatch builds JSON patch or JSON merge patch of SelfSubjectAccessReview.
+// JSON patch fails if the parent of the field doesn't exist (e.g. SetLabel to the object which has no label). MergePatch creates the parent.
+type SelfSubjectAccessReviewPatch struct {
+	*patch.Builder
+}
+
+func NewSelfSubjectAccessReviewPatch() *SelfSubjectAccessReviewPatch {
+	return &SelfSubjectAccessReviewPatch{Builder: patch.NewBuilder()}
+}
+
+func (p *SelfSubjectAccessReviewPatch) SetLabel(key, value string) *SelfSubjectAccessReviewPatch {
+	p.Set([]string{"metadata", "labels", key}, value)
+	return p
+}
+
+func (p *SelfSubjectAccessReviewPatch) RemoveLabel(key string) *SelfSubjectAccessReviewPatch {
+	p.Remove([]string{"metadata", "labels", key})
+	return p
+}
+
+func (p *SelfSubjectAccessReviewPatch) SetAnnotation(key, value string) *SelfSubjectAccessReviewPatch {
+	p.Set([]string{"metadata", "annotations", key}, value)
+	return p
+}
+
+func (p *SelfSubjectAccessReviewPatch) RemoveAnnotation(key string) *SelfSubjectAccessReviewPatch {
+	p.Remove([]string{"metadata", "annotations", key})
+	return p
+}
+
+func (p *SelfSubjectAccessReviewPatch) SetSpec(v SelfSubjectAccessReviewSpec) *SelfSubjectAccessReviewPatch {
+	p.Set([]string{"spec"}, v)
+	return p
+}
+
+func (p *SelfSubjectAccessReviewPatch) RemoveSpec() *SelfSubjectAccessReviewPatch {
+	p.Remove([]string{"spec"})
+	return p
+}
+
+func (p *SelfSubjectAccessReviewPatch) SetSpecResourceAttributes(v *ResourceAttributes) *SelfSubjectAccessReviewPatch {
+	p.Set([]string{"spec", "resourceAttributes"}, v)
+	return p
+}
+
+func (p *SelfSubjectAccessReviewPatch) RemoveSpecResourceAttributes() *SelfSubjectAccessReviewPatch {
+	p.Remove([]string{"spec", "resourceAttributes"})
+	return p
+}
+
+func (p *SelfSubjectAccessReviewPatch) SetSpecResourceAttributesNamespace(v string) *SelfSubjectAccessReviewPatch {
+	p.Set([]string{"spec", "resourceAttributes", "namespace"}, v)
+	return p
+}
+
+func (p *SelfSubjectAccessReviewPatch) RemoveSpecResourceAttributesNamespace() *SelfSubjectAccessReviewPatch {
+	p.Remove([]string{"spec", "resourceAttributes", "namespace"})
+	return p
+}
+
+func (p *SelfSubjectAccessReviewPatch) SetSpecResourceAttributesVerb(v string) *SelfSubjectAccessReviewPatch {
+	p.Set([]string{"spec", "resourceAttributes", "verb"}, v)
+	return p
+}
+
+func (p *SelfSubjectAccessReviewPatch) RemoveSpecResourceAttributesVerb() *SelfSubjectAccessReviewPatch {
+	p.Remove([]string{"spec", "resourceAttributes", "verb"})
+	return p
+}
+
+func (p *SelfSubjectAccessReviewPatch) SetSpecResourceAttributesGroup(v string) *SelfSubjectAccessReviewPatch {
+	p.Set([]string{"spec", "resourceAttributes", "group"}, v)
+	return p
+}
+
+func (p *SelfSubjectAccessReviewPatch) RemoveSpecResourceAttributesGroup() *SelfSubjectAccessReviewPatch {
+	p.Remove([]string{"spec", "resourceAttributes", "group"})
+	return p
+}
+
+func (p *SelfSubjectAccessReviewPatch) SetSpecResourceAttributesVersion(v string) *SelfSubjectAccessReviewPatch {
+	p.Set([]string{"spec", "resourceAttributes", "version"}, v)
+	return p
+}
+
+func (p *SelfSubjectAccessReviewPatch) RemoveSpecResourceAttributesVersion() *SelfSubjectAccessReviewPatch {
+	p.Remove([]string{"spec", "resourceAttributes", "version"})
+	return p
+}
+
+func (p *SelfSubjectAccessReviewPatch) SetSpecResourceAttributesResource(v string) *SelfSubjectAccessReviewPatch {
+	p.Set([]string{"spec", "resourceAttributes", "resource"}, v)
+	return p
+}
+
+func (p *SelfSubjectAccessReviewPatch) RemoveSpecResourceAttributesResource() *SelfSubjectAccessReviewPatch {
+	p.Remove([]string{"spec", "resourceAttributes", "resource"})
+	return p
+}
+
+func (p *SelfSubjectAccessReviewPatch) SetSpecResourceAttributesSubresource(v string) *SelfSubjectAccessReviewPatch {
+	p.Set([]string{"spec", "resourceAttributes", "subresource"}, v)
+	return p
+}
+
+func (p *SelfSubjectAccessReviewPatch) RemoveSpecResourceAttributesSubresource() *SelfSubjectAccessReviewPatch {
+	p.Remove([]string{"spec", "resourceAttributes", "subresource"})
+	return p
+}
+
+func (p *SelfSubjectAccessReviewPatch) SetSpecResourceAttributesName(v string) *SelfSubjectAccessReviewPatch {
+	p.Set([]string{"spec", "resourceAttributes", "name"}, v)
+	return p
+}
+
+func (p *SelfSubjectAccessReviewPatch) RemoveSpecResourceAttributesName() *SelfSubjectAccessReviewPatch {
+	p.Remove([]string{"spec", "resourceAttributes", "name"})
+	return p
+}
+
+func (p *SelfSubjectAccessReviewPatch) SetSpecResourceAttributesFieldSelector(v *FieldSelectorAttributes) *SelfSubjectAccessReviewPatch {
+	p.Set([]string{"spec", "resourceAttributes", "fieldSelector"}, v)
+	return p
+}
+
+func (p *SelfSubjectAccessReviewPatch) RemoveSpecResourceAttributesFieldSelector() *SelfSubjectAccessReviewPatch {
+	p.Remove([]string{"spec", "resourceAttributes", "fieldSelector"})
+	return p
+}
+
+func (p *SelfSubjectAccessReviewPatch) SetSpecResourceAttributesFieldSelectorRawSelector(v string) *SelfSubjectAccessReviewPatch {
+	p.Set([]string{"spec", "resourceAttributes", "fieldSelector", "rawSelector"}, v)
+	return p
+}
+
+func (p *SelfSubjectAccessReviewPatch) RemoveSpecResourceAttributesFieldSelectorRawSelector() *SelfSubjectAccessReviewPatch {
+	p.Remove([]string{"spec", "resourceAttributes", "fieldSelector", "rawSelector"})
+	return p
+}
+
+func (p *SelfSubjectAccessReviewPatch) SetSpecResourceAttributesFieldSelectorRequirements(v []metav1.FieldSelectorRequirement) *SelfSubjectAccessReviewPatch {
+	p.Set([]string{"spec", "resourceAttributes", "fieldSelector", "requirements"}, v)
+	return p
+}
+
+func (p *SelfSubjectAccessReviewPatch) RemoveSpecResourceAttributesFieldSelectorRequirements() *SelfSubjectAccessReviewPatch {
+	p.Remove([]string{"spec", "resourceAttributes", "fieldSelector", "requirements"})
+	return p
+}
+
+func (p *SelfSubjectAccessReviewPatch) AddSpecResourceAttributesFieldSelectorRequirements(v ...metav1.FieldSelectorRequirement) *SelfSubjectAccessReviewPatch {
+	for _, e := range v {
+		p.Append([]string{"spec", "resourceAttributes", "fieldSelector", "requirements"}, e)
+	}
+	return p
+}
+
+func (p *SelfSubjectAccessReviewPatch) SetSpecResourceAttributesLabelSelector(v *LabelSelectorAttributes) *SelfSubjectAccessReviewPatch {
+	p.Set([]string{"spec", "resourceAttributes", "labelSelector"}, v)
+	return p
+}
+
+func (p *SelfSubjectAccessReviewPatch) RemoveSpecResourceAttributesLabelSelector() *SelfSubjectAccessReviewPatch {
+	p.Remove([]string{"spec", "resourceAttributes", "labelSelector"})
+	return p
+}
+
+func (p *SelfSubjectAccessReviewPatch) SetSpecResourceAttributesLabelSelectorRawSelector(v string) *SelfSubjectAccessReviewPatch {
+	p.Set([]string{"spec", "resourceAttributes", "labelSelector", "rawSelector"}, v)
+	return p
+}
+
+func (p *SelfSubjectAccessReviewPatch) RemoveSpecResourceAttributesLabelSelectorRawSelector() *SelfSubjectAccessReviewPatch {
+	p.Remove([]string{"spec", "resourceAttributes", "labelSelector", "rawSelector"})
+	return p
+}
+
+func (p *SelfSubjectAccessReviewPatch) SetSpecResourceAttributesLabelSelectorRequirements(v []metav1.LabelSelectorRequirement) *SelfSubjectAccessReviewPatch {
+	p.Set([]string{"spec", "resourceAttributes", "labelSelector", "requirements"}, v)
+	return p
+}
+
+func (p *SelfSubjectAccessReviewPatch) RemoveSpecResourceAttributesLabelSelectorRequirements() *SelfSubjectAccessReviewPatch {
+	p.Remove([]string{"spec", "resourceAttributes", "labelSelector", "requirements"})
+	return p
+}
+
+func (p *SelfSubjectAccessReviewPatch) AddSpecResourceAttributesLabelSelectorRequirements(v ...metav1.LabelSelectorRequirement) *SelfSubjectAccessReviewPatch {
+	for _, e := range v {
+		p.Append([]string{"spec", "resourceAttributes", "labelSelector", "requirements"}, e)
+	}
+	return p
+}
+
+func (p *SelfSubjectAccessReviewPatch) SetSpecNonResourceAttributes(v *NonResourceAttributes) *SelfSubjectAccessReviewPatch {
+	p.Set([]string{"spec", "nonResourceAttributes"}, v)
+	return p
+}
+
+func (p *SelfSubjectAccessReviewPatch) RemoveSpecNonResourceAttributes() *SelfSubjectAccessReviewPatch {
+	p.Remove([]string{"spec", "nonResourceAttributes"})
+	return p
+}
+
+func (p *SelfSubjectAccessReviewPatch) SetSpecNonResourceAttributesPath(v string) *SelfSubjectAccessReviewPatch {
+	p.Set([]string{"spec", "nonResourceAttributes", "path"}, v)
+	return p
+}
+
+func (p *SelfSubjectAccessReviewPatch) RemoveSpecNonResourceAttributesPath() *SelfSubjectAccessReviewPatch {
+	p.Remove([]string{"spec", "nonResourceAttributes", "path"})
+	return p
+}
+
+func (p *SelfSubjectAccessReviewPatch) SetSpecNonResourceAttributesVerb(v string) *SelfSubjectAccessReviewPatch {
+	p.Set([]string{"spec", "nonResourceAttributes", "verb"}, v)
+	return p
+}
+
+func (p *SelfSubjectAccessReviewPatch) RemoveSpecNonResourceAttributesVerb() *SelfSubjectAccessReviewPatch {
+	p.Remove([]string{"spec", "nonResourceAttributes", "verb"})
+	return p
+}
+
+func (p *SelfSubjectAccessReviewPatch) SetStatus(v *SubjectAccessReviewStatus) *SelfSubjectAccessReviewPatch {
+	p.Set([]string{"status"}, v)
+	return p
+}
+
+func (p *SelfSubjectAccessReviewPatch) RemoveStatus() *SelfSubjectAccessReviewPatch {
+	p.Remove([]string{"status"})
+	return p
+}
+
+func (p *SelfSubjectAccessReviewPatch) SetStatusAllowed(v bool) *SelfSubjectAccessReviewPatch {
+	p.Set([]string{"status", "allowed"}, v)
+	return p
+}
+
+func (p *SelfSubjectAccessReviewPatch) RemoveStatusAllowed() *SelfSubjectAccessReviewPatch {
+	p.Remove([]string{"status", "allowed"})
+	return p
+}
+
+func (p *SelfSubjectAccessReviewPatch) SetStatusDenied(v bool) *SelfSubjectAccessReviewPatch {
+	p.Set([]string{"status", "denied"}, v)
+	return p
+}
+
+func (p *SelfSubjectAccessReviewPatch) RemoveStatusDenied() *SelfSubjectAccessReviewPatch {
+	p.Remove([]string{"status", "denied"})
+	return p
+}
+
+func (p *SelfSubjectAccessReviewPatch) SetStatusReason(v string) *SelfSubjectAccessReviewPatch {
+	p.Set([]string{"status", "reason"}, v)
+	return p
+}
+
+func (p *SelfSubjectAccessReviewPatch) RemoveStatusReason() *SelfSubjectAccessReviewPatch {
+	p.Remove([]string{"status", "reason"})
+	return p
+}
+
+func (p *SelfSubjectAccessReviewPatch) SetStatusEvaluationError(v string) *SelfSubjectAccessReviewPatch {
+	p.Set([]string{"status", "evaluationError"}, v)
+	return p
+}
+
+func (p *SelfSubjectAccessReviewPatch) RemoveStatusEvaluationError() *SelfSubjectAccessReviewPatch {
+	p.Remove([]string{"status", "evaluationError"})
+	return p
+}
+
+func (in *SelfSubjectAccessReview) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *SelfSubjectAccessReview) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *SelfSubjectAccessReview) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	first := true
+	if in.TypeMeta.Kind != "" {
+		w.RawString("\"kind\":")
+		w.String(in.TypeMeta.Kind)
+		first = false
+	}
+	if in.TypeMeta.APIVersion != "" {
+		if !first {
+			w.RawByte(',')
+		}
+		w.RawString("\"apiVersion\":")
+		w.String(in.TypeMeta.APIVersion)
+		first = false
+	}
+	if !first {
+		w.RawByte(',')
+	}
+	w.RawString("\"metadata\":")
+	in.ObjectMeta.MarshalEasyJSON(w)
+	w.RawString(",\"spec\":")
+	in.Spec.MarshalEasyJSON(w)
+	if in.Status != nil {
+		w.RawString(",\"status\":")
+		in.Status.MarshalEasyJSON(w)
+	}
+	w.RawByte('}')
+}
+
+func (in *SelfSubjectAccessReview) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "kind":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.TypeMeta.Kind = l.String()
+			}
+		case "apiVersion":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.TypeMeta.APIVersion = l.String()
+			}
+		case "metadata":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.ObjectMeta.UnmarshalEasyJSON(l)
+			}
+		case "spec":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.Spec.UnmarshalEasyJSON(l)
+			}
+		case "status":
+			if l.IsNull() {
+				l.Skip()
+				in.Status = nil
+			} else {
+				if in.Status == nil {
+					in.Status = new(SubjectAccessReviewStatus)
+				}
+				in.Status.UnmarshalEasyJSON(l)
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
+
 type SelfSubjectAccessReviewList struct {
 	metav1.TypeMeta `json:",inline"`
 	metav1.ListMeta `json:"metadata"`
@@ -155,24 +1087,135 @@ func (in *SelfSubjectAccessReviewList) DeepCopyInto(out *SelfSubjectAccessReview
 		for i := range in.Items {
 			in.Items[i].DeepCopyInto(&l[i])
 		}
-		out.Items = l
+		out.Items = l
+	}
+}
+
+func (in *SelfSubjectAccessReviewList) DeepCopy() *SelfSubjectAccessReviewList {
+	if in == nil {
+		return nil
+	}
+	out := new(SelfSubjectAccessReviewList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *SelfSubjectAccessReviewList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+func (in *SelfSubjectAccessReviewList) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *SelfSubjectAccessReviewList) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *SelfSubjectAccessReviewList) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	first := true
+	if in.TypeMeta.Kind != "" {
+		w.RawString("\"kind\":")
+		w.String(in.TypeMeta.Kind)
+		first = false
+	}
+	if in.TypeMeta.APIVersion != "" {
+		if !first {
+			w.RawByte(',')
+		}
+		w.RawString("\"apiVersion\":")
+		w.String(in.TypeMeta.APIVersion)
+		first = false
+	}
+	if !first {
+		w.RawByte(',')
+	}
+	w.RawString("\"metadata\":")
+	in.ListMeta.MarshalEasyJSON(w)
+	w.RawString(",\"items\":")
+	if in.Items == nil {
+		w.RawString("null")
+	} else {
+		w.RawByte('[')
+		for i0 := range in.Items {
+			if i0 > 0 {
+				w.RawByte(',')
+			}
+			in.Items[i0].MarshalEasyJSON(w)
+		}
+		w.RawByte(']')
 	}
+	w.RawByte('}')
 }
 
-func (in *SelfSubjectAccessReviewList) DeepCopy() *SelfSubjectAccessReviewList {
-	if in == nil {
-		return nil
+func (in *SelfSubjectAccessReviewList) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
 	}
-	out := new(SelfSubjectAccessReviewList)
-	in.DeepCopyInto(out)
-	return out
-}
-
-func (in *SelfSubjectAccessReviewList) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "kind":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.TypeMeta.Kind = l.String()
+			}
+		case "apiVersion":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.TypeMeta.APIVersion = l.String()
+			}
+		case "metadata":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.ListMeta.UnmarshalEasyJSON(l)
+			}
+		case "items":
+			if l.IsNull() {
+				l.Skip()
+				in.Items = nil
+			} else {
+				in.Items = make([]SelfSubjectAccessReview, 0)
+				l.Delim('[')
+				for !l.IsDelim(']') {
+					var v0 SelfSubjectAccessReview
+					if l.IsNull() {
+						l.Skip()
+					} else {
+						v0.UnmarshalEasyJSON(l)
+					}
+					in.Items = append(in.Items, v0)
+					l.WantComma()
+				}
+				l.Delim(']')
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
 	}
-	return nil
 }
 
 type SelfSubjectRulesReview struct {
@@ -212,6 +1255,230 @@ func (in *SelfSubjectRulesReview) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// SelfSubjectRulesReviewToSelectableFields returns the fields of SelfSubjectRulesReview which can be used by the field selector.
+func SelfSubjectRulesReviewToSelectableFields(obj *SelfSubjectRulesReview) fields.Set {
+	set := fields.Set{
+		"metadata.name":      obj.ObjectMeta.Name,
+		"metadata.namespace": obj.ObjectMeta.Namespace,
+	}
+	return set
+}
+
+// SelfSubjectRulesReviewPatch builds JSON patch or JSON merge patch of SelfSubjectRulesReview.
+// JSON patch fails if the parent of the field doesn't exist (e.g. SetLabel to the object which has no label). MergePatch creates the parent.
+type SelfSubjectRulesReviewPatch struct {
+	*patch.Builder
+}
+
+func NewSelfSubjectRulesReviewPatch() *SelfSubjectRulesReviewPatch {
+	return &SelfSubjectRulesReviewPatch{Builder: patch.NewBuilder()}
+}
+
+func (p *SelfSubjectRulesReviewPatch) SetLabel(key, value string) *SelfSubjectRulesReviewPatch {
+	p.Set([]string{"metadata", "labels", key}, value)
+	return p
+}
+
+func (p *SelfSubjectRulesReviewPatch) RemoveLabel(key string) *SelfSubjectRulesReviewPatch {
+	p.Remove([]string{"metadata", "labels", key})
+	return p
+}
+
+func (p *SelfSubjectRulesReviewPatch) SetAnnotation(key, value string) *SelfSubjectRulesReviewPatch {
+	p.Set([]string{"metadata", "annotations", key}, value)
+	return p
+}
+
+func (p *SelfSubjectRulesReviewPatch) RemoveAnnotation(key string) *SelfSubjectRulesReviewPatch {
+	p.Remove([]string{"metadata", "annotations", key})
+	return p
+}
+
+func (p *SelfSubjectRulesReviewPatch) SetSpec(v SelfSubjectRulesReviewSpec) *SelfSubjectRulesReviewPatch {
+	p.Set([]string{"spec"}, v)
+	return p
+}
+
+func (p *SelfSubjectRulesReviewPatch) RemoveSpec() *SelfSubjectRulesReviewPatch {
+	p.Remove([]string{"spec"})
+	return p
+}
+
+func (p *SelfSubjectRulesReviewPatch) SetSpecNamespace(v string) *SelfSubjectRulesReviewPatch {
+	p.Set([]string{"spec", "namespace"}, v)
+	return p
+}
+
+func (p *SelfSubjectRulesReviewPatch) RemoveSpecNamespace() *SelfSubjectRulesReviewPatch {
+	p.Remove([]string{"spec", "namespace"})
+	return p
+}
+
+func (p *SelfSubjectRulesReviewPatch) SetStatus(v *SubjectRulesReviewStatus) *SelfSubjectRulesReviewPatch {
+	p.Set([]string{"status"}, v)
+	return p
+}
+
+func (p *SelfSubjectRulesReviewPatch) RemoveStatus() *SelfSubjectRulesReviewPatch {
+	p.Remove([]string{"status"})
+	return p
+}
+
+func (p *SelfSubjectRulesReviewPatch) SetStatusResourceRules(v []ResourceRule) *SelfSubjectRulesReviewPatch {
+	p.Set([]string{"status", "resourceRules"}, v)
+	return p
+}
+
+func (p *SelfSubjectRulesReviewPatch) RemoveStatusResourceRules() *SelfSubjectRulesReviewPatch {
+	p.Remove([]string{"status", "resourceRules"})
+	return p
+}
+
+func (p *SelfSubjectRulesReviewPatch) AddStatusResourceRules(v ...ResourceRule) *SelfSubjectRulesReviewPatch {
+	for _, e := range v {
+		p.Append([]string{"status", "resourceRules"}, e)
+	}
+	return p
+}
+
+func (p *SelfSubjectRulesReviewPatch) SetStatusNonResourceRules(v []NonResourceRule) *SelfSubjectRulesReviewPatch {
+	p.Set([]string{"status", "nonResourceRules"}, v)
+	return p
+}
+
+func (p *SelfSubjectRulesReviewPatch) RemoveStatusNonResourceRules() *SelfSubjectRulesReviewPatch {
+	p.Remove([]string{"status", "nonResourceRules"})
+	return p
+}
+
+func (p *SelfSubjectRulesReviewPatch) AddStatusNonResourceRules(v ...NonResourceRule) *SelfSubjectRulesReviewPatch {
+	for _, e := range v {
+		p.Append([]string{"status", "nonResourceRules"}, e)
+	}
+	return p
+}
+
+func (p *SelfSubjectRulesReviewPatch) SetStatusIncomplete(v bool) *SelfSubjectRulesReviewPatch {
+	p.Set([]string{"status", "incomplete"}, v)
+	return p
+}
+
+func (p *SelfSubjectRulesReviewPatch) RemoveStatusIncomplete() *SelfSubjectRulesReviewPatch {
+	p.Remove([]string{"status", "incomplete"})
+	return p
+}
+
+func (p *SelfSubjectRulesReviewPatch) SetStatusEvaluationError(v string) *SelfSubjectRulesReviewPatch {
+	p.Set([]string{"status", "evaluationError"}, v)
+	return p
+}
+
+func (p *SelfSubjectRulesReviewPatch) RemoveStatusEvaluationError() *SelfSubjectRulesReviewPatch {
+	p.Remove([]string{"status", "evaluationError"})
+	return p
+}
+
+func (in *SelfSubjectRulesReview) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *SelfSubjectRulesReview) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *SelfSubjectRulesReview) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	first := true
+	if in.TypeMeta.Kind != "" {
+		w.RawString("\"kind\":")
+		w.String(in.TypeMeta.Kind)
+		first = false
+	}
+	if in.TypeMeta.APIVersion != "" {
+		if !first {
+			w.RawByte(',')
+		}
+		w.RawString("\"apiVersion\":")
+		w.String(in.TypeMeta.APIVersion)
+		first = false
+	}
+	if !first {
+		w.RawByte(',')
+	}
+	w.RawString("\"metadata\":")
+	in.ObjectMeta.MarshalEasyJSON(w)
+	w.RawString(",\"spec\":")
+	in.Spec.MarshalEasyJSON(w)
+	if in.Status != nil {
+		w.RawString(",\"status\":")
+		in.Status.MarshalEasyJSON(w)
+	}
+	w.RawByte('}')
+}
+
+func (in *SelfSubjectRulesReview) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "kind":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.TypeMeta.Kind = l.String()
+			}
+		case "apiVersion":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.TypeMeta.APIVersion = l.String()
+			}
+		case "metadata":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.ObjectMeta.UnmarshalEasyJSON(l)
+			}
+		case "spec":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.Spec.UnmarshalEasyJSON(l)
+			}
+		case "status":
+			if l.IsNull() {
+				l.Skip()
+				in.Status = nil
+			} else {
+				if in.Status == nil {
+					in.Status = new(SubjectRulesReviewStatus)
+				}
+				in.Status.UnmarshalEasyJSON(l)
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
+
 type SelfSubjectRulesReviewList struct {
 	metav1.TypeMeta `json:",inline"`
 	metav1.ListMeta `json:"metadata"`
@@ -247,6 +1514,117 @@ func (in *SelfSubjectRulesReviewList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+func (in *SelfSubjectRulesReviewList) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *SelfSubjectRulesReviewList) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *SelfSubjectRulesReviewList) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	first := true
+	if in.TypeMeta.Kind != "" {
+		w.RawString("\"kind\":")
+		w.String(in.TypeMeta.Kind)
+		first = false
+	}
+	if in.TypeMeta.APIVersion != "" {
+		if !first {
+			w.RawByte(',')
+		}
+		w.RawString("\"apiVersion\":")
+		w.String(in.TypeMeta.APIVersion)
+		first = false
+	}
+	if !first {
+		w.RawByte(',')
+	}
+	w.RawString("\"metadata\":")
+	in.ListMeta.MarshalEasyJSON(w)
+	w.RawString(",\"items\":")
+	if in.Items == nil {
+		w.RawString("null")
+	} else {
+		w.RawByte('[')
+		for i0 := range in.Items {
+			if i0 > 0 {
+				w.RawByte(',')
+			}
+			in.Items[i0].MarshalEasyJSON(w)
+		}
+		w.RawByte(']')
+	}
+	w.RawByte('}')
+}
+
+func (in *SelfSubjectRulesReviewList) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "kind":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.TypeMeta.Kind = l.String()
+			}
+		case "apiVersion":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.TypeMeta.APIVersion = l.String()
+			}
+		case "metadata":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.ListMeta.UnmarshalEasyJSON(l)
+			}
+		case "items":
+			if l.IsNull() {
+				l.Skip()
+				in.Items = nil
+			} else {
+				in.Items = make([]SelfSubjectRulesReview, 0)
+				l.Delim('[')
+				for !l.IsDelim(']') {
+					var v0 SelfSubjectRulesReview
+					if l.IsNull() {
+						l.Skip()
+					} else {
+						v0.UnmarshalEasyJSON(l)
+					}
+					in.Items = append(in.Items, v0)
+					l.WantComma()
+				}
+				l.Delim(']')
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
+
 type SubjectAccessReview struct {
 	metav1.TypeMeta   `json:",inline"`
 	metav1.ObjectMeta `json:"metadata"`
@@ -268,20 +1646,451 @@ func (in *SubjectAccessReview) DeepCopyInto(out *SubjectAccessReview) {
 	}
 }
 
-func (in *SubjectAccessReview) DeepCopy() *SubjectAccessReview {
-	if in == nil {
-		return nil
+func (in *SubjectAccessReview) DeepCopy() *SubjectAccessReview {
+	if in == nil {
+		return nil
+	}
+	out := new(SubjectAccessReview)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *SubjectAccessReview) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// SubjectAccessReviewToSelectableFields returns the fields of SubjectAccessReview which can be used by the field selector.
+func SubjectAccessReviewToSelectableFields(obj *SubjectAccessReview) fields.Set {
+	set := fields.Set{
+		"metadata.name":      obj.ObjectMeta.Name,
+		"metadata.namespace": obj.ObjectMeta.Namespace,
+	}
+	return set
+}
+
+// SubjectAccessReviewPatch builds JSON patch or JSON merge patch of SubjectAccessReview.
+// JSON patch fails if the parent of the field doesn't exist (e.g. SetLabel to the object which has no label). MergePatch creates the parent.
+type SubjectAccessReviewPatch struct {
+	*patch.Builder
+}
+
+func NewSubjectAccessReviewPatch() *SubjectAccessReviewPatch {
+	return &SubjectAccessReviewPatch{Builder: patch.NewBuilder()}
+}
+
+func (p *SubjectAccessReviewPatch) SetLabel(key, value string) *SubjectAccessReviewPatch {
+	p.Set([]string{"metadata", "labels", key}, value)
+	return p
+}
+
+func (p *SubjectAccessReviewPatch) RemoveLabel(key string) *SubjectAccessReviewPatch {
+	p.Remove([]string{"metadata", "labels", key})
+	return p
+}
+
+func (p *SubjectAccessReviewPatch) SetAnnotation(key, value string) *SubjectAccessReviewPatch {
+	p.Set([]string{"metadata", "annotations", key}, value)
+	return p
+}
+
+func (p *SubjectAccessReviewPatch) RemoveAnnotation(key string) *SubjectAccessReviewPatch {
+	p.Remove([]string{"metadata", "annotations", key})
+	return p
+}
+
+func (p *SubjectAccessReviewPatch) SetSpec(v SubjectAccessReviewSpec) *SubjectAccessReviewPatch {
+	p.Set([]string{"spec"}, v)
+	return p
+}
+
+func (p *SubjectAccessReviewPatch) RemoveSpec() *SubjectAccessReviewPatch {
+	p.Remove([]string{"spec"})
+	return p
+}
+
+func (p *SubjectAccessReviewPatch) SetSpecResourceAttributes(v *ResourceAttributes) *SubjectAccessReviewPatch {
+	p.Set([]string{"spec", "resourceAttributes"}, v)
+	return p
+}
+
+func (p *SubjectAccessReviewPatch) RemoveSpecResourceAttributes() *SubjectAccessReviewPatch {
+	p.Remove([]string{"spec", "resourceAttributes"})
+	return p
+}
+
+func (p *SubjectAccessReviewPatch) SetSpecResourceAttributesNamespace(v string) *SubjectAccessReviewPatch {
+	p.Set([]string{"spec", "resourceAttributes", "namespace"}, v)
+	return p
+}
+
+func (p *SubjectAccessReviewPatch) RemoveSpecResourceAttributesNamespace() *SubjectAccessReviewPatch {
+	p.Remove([]string{"spec", "resourceAttributes", "namespace"})
+	return p
+}
+
+func (p *SubjectAccessReviewPatch) SetSpecResourceAttributesVerb(v string) *SubjectAccessReviewPatch {
+	p.Set([]string{"spec", "resourceAttributes", "verb"}, v)
+	return p
+}
+
+func (p *SubjectAccessReviewPatch) RemoveSpecResourceAttributesVerb() *SubjectAccessReviewPatch {
+	p.Remove([]string{"spec", "resourceAttributes", "verb"})
+	return p
+}
+
+func (p *SubjectAccessReviewPatch) SetSpecResourceAttributesGroup(v string) *SubjectAccessReviewPatch {
+	p.Set([]string{"spec", "resourceAttributes", "group"}, v)
+	return p
+}
+
+func (p *SubjectAccessReviewPatch) RemoveSpecResourceAttributesGroup() *SubjectAccessReviewPatch {
+	p.Remove([]string{"spec", "resourceAttributes", "group"})
+	return p
+}
+
+func (p *SubjectAccessReviewPatch) SetSpecResourceAttributesVersion(v string) *SubjectAccessReviewPatch {
+	p.Set([]string{"spec", "resourceAttributes", "version"}, v)
+	return p
+}
+
+func (p *SubjectAccessReviewPatch) RemoveSpecResourceAttributesVersion() *SubjectAccessReviewPatch {
+	p.Remove([]string{"spec", "resourceAttributes", "version"})
+	return p
+}
+
+func (p *SubjectAccessReviewPatch) SetSpecResourceAttributesResource(v string) *SubjectAccessReviewPatch {
+	p.Set([]string{"spec", "resourceAttributes", "resource"}, v)
+	return p
+}
+
+func (p *SubjectAccessReviewPatch) RemoveSpecResourceAttributesResource() *SubjectAccessReviewPatch {
+	p.Remove([]string{"spec", "resourceAttributes", "resource"})
+	return p
+}
+
+func (p *SubjectAccessReviewPatch) SetSpecResourceAttributesSubresource(v string) *SubjectAccessReviewPatch {
+	p.Set([]string{"spec", "resourceAttributes", "subresource"}, v)
+	return p
+}
+
+func (p *SubjectAccessReviewPatch) RemoveSpecResourceAttributesSubresource() *SubjectAccessReviewPatch {
+	p.Remove([]string{"spec", "resourceAttributes", "subresource"})
+	return p
+}
+
+func (p *SubjectAccessReviewPatch) SetSpecResourceAttributesName(v string) *SubjectAccessReviewPatch {
+	p.Set([]string{"spec", "resourceAttributes", "name"}, v)
+	return p
+}
+
+func (p *SubjectAccessReviewPatch) RemoveSpecResourceAttributesName() *SubjectAccessReviewPatch {
+	p.Remove([]string{"spec", "resourceAttributes", "name"})
+	return p
+}
+
+func (p *SubjectAccessReviewPatch) SetSpecResourceAttributesFieldSelector(v *FieldSelectorAttributes) *SubjectAccessReviewPatch {
+	p.Set([]string{"spec", "resourceAttributes", "fieldSelector"}, v)
+	return p
+}
+
+func (p *SubjectAccessReviewPatch) RemoveSpecResourceAttributesFieldSelector() *SubjectAccessReviewPatch {
+	p.Remove([]string{"spec", "resourceAttributes", "fieldSelector"})
+	return p
+}
+
+func (p *SubjectAccessReviewPatch) SetSpecResourceAttributesFieldSelectorRawSelector(v string) *SubjectAccessReviewPatch {
+	p.Set([]string{"spec", "resourceAttributes", "fieldSelector", "rawSelector"}, v)
+	return p
+}
+
+func (p *SubjectAccessReviewPatch) RemoveSpecResourceAttributesFieldSelectorRawSelector() *SubjectAccessReviewPatch {
+	p.Remove([]string{"spec", "resourceAttributes", "fieldSelector", "rawSelector"})
+	return p
+}
+
+func (p *SubjectAccessReviewPatch) SetSpecResourceAttributesFieldSelectorRequirements(v []metav1.FieldSelectorRequirement) *SubjectAccessReviewPatch {
+	p.Set([]string{"spec", "resourceAttributes", "fieldSelector", "requirements"}, v)
+	return p
+}
+
+func (p *SubjectAccessReviewPatch) RemoveSpecResourceAttributesFieldSelectorRequirements() *SubjectAccessReviewPatch {
+	p.Remove([]string{"spec", "resourceAttributes", "fieldSelector", "requirements"})
+	return p
+}
+
+func (p *SubjectAccessReviewPatch) AddSpecResourceAttributesFieldSelectorRequirements(v ...metav1.FieldSelectorRequirement) *SubjectAccessReviewPatch {
+	for _, e := range v {
+		p.Append([]string{"spec", "resourceAttributes", "fieldSelector", "requirements"}, e)
+	}
+	return p
+}
+
+func (p *SubjectAccessReviewPatch) SetSpecResourceAttributesLabelSelector(v *LabelSelectorAttributes) *SubjectAccessReviewPatch {
+	p.Set([]string{"spec", "resourceAttributes", "labelSelector"}, v)
+	return p
+}
+
+func (p *SubjectAccessReviewPatch) RemoveSpecResourceAttributesLabelSelector() *SubjectAccessReviewPatch {
+	p.Remove([]string{"spec", "resourceAttributes", "labelSelector"})
+	return p
+}
+
+func (p *SubjectAccessReviewPatch) SetSpecResourceAttributesLabelSelectorRawSelector(v string) *SubjectAccessReviewPatch {
+	p.Set([]string{"spec", "resourceAttributes", "labelSelector", "rawSelector"}, v)
+	return p
+}
+
+func (p *SubjectAccessReviewPatch) RemoveSpecResourceAttributesLabelSelectorRawSelector() *SubjectAccessReviewPatch {
+	p.Remove([]string{"spec", "resourceAttributes", "labelSelector", "rawSelector"})
+	return p
+}
+
+func (p *SubjectAccessReviewPatch) SetSpecResourceAttributesLabelSelectorRequirements(v []metav1.LabelSelectorRequirement) *SubjectAccessReviewPatch {
+	p.Set([]string{"spec", "resourceAttributes", "labelSelector", "requirements"}, v)
+	return p
+}
+
+func (p *SubjectAccessReviewPatch) RemoveSpecResourceAttributesLabelSelectorRequirements() *SubjectAccessReviewPatch {
+	p.Remove([]string{"spec", "resourceAttributes", "labelSelector", "requirements"})
+	return p
+}
+
+func (p *SubjectAccessReviewPatch) AddSpecResourceAttributesLabelSelectorRequirements(v ...metav1.LabelSelectorRequirement) *SubjectAccessReviewPatch {
+	for _, e := range v {
+		p.Append([]string{"spec", "resourceAttributes", "labelSelector", "requirements"}, e)
+	}
+	return p
+}
+
+func (p *SubjectAccessReviewPatch) SetSpecNonResourceAttributes(v *NonResourceAttributes) *SubjectAccessReviewPatch {
+	p.Set([]string{"spec", "nonResourceAttributes"}, v)
+	return p
+}
+
+func (p *SubjectAccessReviewPatch) RemoveSpecNonResourceAttributes() *SubjectAccessReviewPatch {
+	p.Remove([]string{"spec", "nonResourceAttributes"})
+	return p
+}
+
+func (p *SubjectAccessReviewPatch) SetSpecNonResourceAttributesPath(v string) *SubjectAccessReviewPatch {
+	p.Set([]string{"spec", "nonResourceAttributes", "path"}, v)
+	return p
+}
+
+func (p *SubjectAccessReviewPatch) RemoveSpecNonResourceAttributesPath() *SubjectAccessReviewPatch {
+	p.Remove([]string{"spec", "nonResourceAttributes", "path"})
+	return p
+}
+
+func (p *SubjectAccessReviewPatch) SetSpecNonResourceAttributesVerb(v string) *SubjectAccessReviewPatch {
+	p.Set([]string{"spec", "nonResourceAttributes", "verb"}, v)
+	return p
+}
+
+func (p *SubjectAccessReviewPatch) RemoveSpecNonResourceAttributesVerb() *SubjectAccessReviewPatch {
+	p.Remove([]string{"spec", "nonResourceAttributes", "verb"})
+	return p
+}
+
+func (p *SubjectAccessReviewPatch) SetSpecUser(v string) *SubjectAccessReviewPatch {
+	p.Set([]string{"spec", "user"}, v)
+	return p
+}
+
+func (p *SubjectAccessReviewPatch) RemoveSpecUser() *SubjectAccessReviewPatch {
+	p.Remove([]string{"spec", "user"})
+	return p
+}
+
+func (p *SubjectAccessReviewPatch) SetSpecGroups(v []string) *SubjectAccessReviewPatch {
+	p.Set([]string{"spec", "groups"}, v)
+	return p
+}
+
+func (p *SubjectAccessReviewPatch) RemoveSpecGroups() *SubjectAccessReviewPatch {
+	p.Remove([]string{"spec", "groups"})
+	return p
+}
+
+func (p *SubjectAccessReviewPatch) AddSpecGroups(v ...string) *SubjectAccessReviewPatch {
+	for _, e := range v {
+		p.Append([]string{"spec", "groups"}, e)
+	}
+	return p
+}
+
+func (p *SubjectAccessReviewPatch) SetSpecExtra(v map[string]ExtraValue) *SubjectAccessReviewPatch {
+	p.Set([]string{"spec", "extra"}, v)
+	return p
+}
+
+func (p *SubjectAccessReviewPatch) RemoveSpecExtra() *SubjectAccessReviewPatch {
+	p.Remove([]string{"spec", "extra"})
+	return p
+}
+
+func (p *SubjectAccessReviewPatch) SetSpecUID(v string) *SubjectAccessReviewPatch {
+	p.Set([]string{"spec", "uid"}, v)
+	return p
+}
+
+func (p *SubjectAccessReviewPatch) RemoveSpecUID() *SubjectAccessReviewPatch {
+	p.Remove([]string{"spec", "uid"})
+	return p
+}
+
+func (p *SubjectAccessReviewPatch) SetStatus(v *SubjectAccessReviewStatus) *SubjectAccessReviewPatch {
+	p.Set([]string{"status"}, v)
+	return p
+}
+
+func (p *SubjectAccessReviewPatch) RemoveStatus() *SubjectAccessReviewPatch {
+	p.Remove([]string{"status"})
+	return p
+}
+
+func (p *SubjectAccessReviewPatch) SetStatusAllowed(v bool) *SubjectAccessReviewPatch {
+	p.Set([]string{"status", "allowed"}, v)
+	return p
+}
+
+func (p *SubjectAccessReviewPatch) RemoveStatusAllowed() *SubjectAccessReviewPatch {
+	p.Remove([]string{"status", "allowed"})
+	return p
+}
+
+func (p *SubjectAccessReviewPatch) SetStatusDenied(v bool) *SubjectAccessReviewPatch {
+	p.Set([]string{"status", "denied"}, v)
+	return p
+}
+
+func (p *SubjectAccessReviewPatch) RemoveStatusDenied() *SubjectAccessReviewPatch {
+	p.Remove([]string{"status", "denied"})
+	return p
+}
+
+func (p *SubjectAccessReviewPatch) SetStatusReason(v string) *SubjectAccessReviewPatch {
+	p.Set([]string{"status", "reason"}, v)
+	return p
+}
+
+func (p *SubjectAccessReviewPatch) RemoveStatusReason() *SubjectAccessReviewPatch {
+	p.Remove([]string{"status", "reason"})
+	return p
+}
+
+func (p *SubjectAccessReviewPatch) SetStatusEvaluationError(v string) *SubjectAccessReviewPatch {
+	p.Set([]string{"status", "evaluationError"}, v)
+	return p
+}
+
+func (p *SubjectAccessReviewPatch) RemoveStatusEvaluationError() *SubjectAccessReviewPatch {
+	p.Remove([]string{"status", "evaluationError"})
+	return p
+}
+
+func (in *SubjectAccessReview) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *SubjectAccessReview) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *SubjectAccessReview) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	first := true
+	if in.TypeMeta.Kind != "" {
+		w.RawString("\"kind\":")
+		w.String(in.TypeMeta.Kind)
+		first = false
 	}
-	out := new(SubjectAccessReview)
-	in.DeepCopyInto(out)
-	return out
+	if in.TypeMeta.APIVersion != "" {
+		if !first {
+			w.RawByte(',')
+		}
+		w.RawString("\"apiVersion\":")
+		w.String(in.TypeMeta.APIVersion)
+		first = false
+	}
+	if !first {
+		w.RawByte(',')
+	}
+	w.RawString("\"metadata\":")
+	in.ObjectMeta.MarshalEasyJSON(w)
+	w.RawString(",\"spec\":")
+	in.Spec.MarshalEasyJSON(w)
+	if in.Status != nil {
+		w.RawString(",\"status\":")
+		in.Status.MarshalEasyJSON(w)
+	}
+	w.RawByte('}')
 }
 
-func (in *SubjectAccessReview) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
+func (in *SubjectAccessReview) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "kind":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.TypeMeta.Kind = l.String()
+			}
+		case "apiVersion":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.TypeMeta.APIVersion = l.String()
+			}
+		case "metadata":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.ObjectMeta.UnmarshalEasyJSON(l)
+			}
+		case "spec":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.Spec.UnmarshalEasyJSON(l)
+			}
+		case "status":
+			if l.IsNull() {
+				l.Skip()
+				in.Status = nil
+			} else {
+				if in.Status == nil {
+					in.Status = new(SubjectAccessReviewStatus)
+				}
+				in.Status.UnmarshalEasyJSON(l)
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
 	}
-	return nil
 }
 
 type SubjectAccessReviewList struct {
@@ -319,6 +2128,117 @@ func (in *SubjectAccessReviewList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+func (in *SubjectAccessReviewList) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *SubjectAccessReviewList) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *SubjectAccessReviewList) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	first := true
+	if in.TypeMeta.Kind != "" {
+		w.RawString("\"kind\":")
+		w.String(in.TypeMeta.Kind)
+		first = false
+	}
+	if in.TypeMeta.APIVersion != "" {
+		if !first {
+			w.RawByte(',')
+		}
+		w.RawString("\"apiVersion\":")
+		w.String(in.TypeMeta.APIVersion)
+		first = false
+	}
+	if !first {
+		w.RawByte(',')
+	}
+	w.RawString("\"metadata\":")
+	in.ListMeta.MarshalEasyJSON(w)
+	w.RawString(",\"items\":")
+	if in.Items == nil {
+		w.RawString("null")
+	} else {
+		w.RawByte('[')
+		for i0 := range in.Items {
+			if i0 > 0 {
+				w.RawByte(',')
+			}
+			in.Items[i0].MarshalEasyJSON(w)
+		}
+		w.RawByte(']')
+	}
+	w.RawByte('}')
+}
+
+func (in *SubjectAccessReviewList) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "kind":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.TypeMeta.Kind = l.String()
+			}
+		case "apiVersion":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.TypeMeta.APIVersion = l.String()
+			}
+		case "metadata":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.ListMeta.UnmarshalEasyJSON(l)
+			}
+		case "items":
+			if l.IsNull() {
+				l.Skip()
+				in.Items = nil
+			} else {
+				in.Items = make([]SubjectAccessReview, 0)
+				l.Delim('[')
+				for !l.IsDelim(']') {
+					var v0 SubjectAccessReview
+					if l.IsNull() {
+						l.Skip()
+					} else {
+						v0.UnmarshalEasyJSON(l)
+					}
+					in.Items = append(in.Items, v0)
+					l.WantComma()
+				}
+				l.Delim(']')
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
+
 type SubjectAccessReviewSpec struct {
 	// resourceAttributes describes information for a resource access request
 	ResourceAttributes *ResourceAttributes `json:"resourceAttributes,omitempty"`
@@ -371,6 +2291,206 @@ func (in *SubjectAccessReviewSpec) DeepCopy() *SubjectAccessReviewSpec {
 	return out
 }
 
+func (in *SubjectAccessReviewSpec) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *SubjectAccessReviewSpec) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *SubjectAccessReviewSpec) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	first := true
+	if in.ResourceAttributes != nil {
+		w.RawString("\"resourceAttributes\":")
+		in.ResourceAttributes.MarshalEasyJSON(w)
+		first = false
+	}
+	if in.NonResourceAttributes != nil {
+		if !first {
+			w.RawByte(',')
+		}
+		w.RawString("\"nonResourceAttributes\":")
+		in.NonResourceAttributes.MarshalEasyJSON(w)
+		first = false
+	}
+	if in.User != "" {
+		if !first {
+			w.RawByte(',')
+		}
+		w.RawString("\"user\":")
+		w.String(in.User)
+		first = false
+	}
+	if !first {
+		w.RawByte(',')
+	}
+	w.RawString("\"groups\":")
+	if in.Groups == nil {
+		w.RawString("null")
+	} else {
+		w.RawByte('[')
+		for i0 := range in.Groups {
+			if i0 > 0 {
+				w.RawByte(',')
+			}
+			w.String(in.Groups[i0])
+		}
+		w.RawByte(']')
+	}
+	if len(in.Extra) != 0 {
+		w.RawString(",\"extra\":")
+		if in.Extra == nil {
+			w.RawString("null")
+		} else {
+			w.RawByte('{')
+			for i0, k0 := range slices.Sorted(maps.Keys(in.Extra)) {
+				if i0 > 0 {
+					w.RawByte(',')
+				}
+				w.String(k0)
+				w.RawByte(':')
+				v0 := in.Extra[k0]
+				if v0 == nil {
+					w.RawString("null")
+				} else {
+					w.RawByte('[')
+					for i1 := range v0 {
+						if i1 > 0 {
+							w.RawByte(',')
+						}
+						w.String(v0[i1])
+					}
+					w.RawByte(']')
+				}
+			}
+			w.RawByte('}')
+		}
+	}
+	if in.UID != "" {
+		w.RawString(",\"uid\":")
+		w.String(in.UID)
+	}
+	w.RawByte('}')
+}
+
+func (in *SubjectAccessReviewSpec) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "resourceAttributes":
+			if l.IsNull() {
+				l.Skip()
+				in.ResourceAttributes = nil
+			} else {
+				if in.ResourceAttributes == nil {
+					in.ResourceAttributes = new(ResourceAttributes)
+				}
+				in.ResourceAttributes.UnmarshalEasyJSON(l)
+			}
+		case "nonResourceAttributes":
+			if l.IsNull() {
+				l.Skip()
+				in.NonResourceAttributes = nil
+			} else {
+				if in.NonResourceAttributes == nil {
+					in.NonResourceAttributes = new(NonResourceAttributes)
+				}
+				in.NonResourceAttributes.UnmarshalEasyJSON(l)
+			}
+		case "user":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.User = l.String()
+			}
+		case "groups":
+			if l.IsNull() {
+				l.Skip()
+				in.Groups = nil
+			} else {
+				in.Groups = make([]string, 0)
+				l.Delim('[')
+				for !l.IsDelim(']') {
+					var v0 string
+					if l.IsNull() {
+						l.Skip()
+					} else {
+						v0 = l.String()
+					}
+					in.Groups = append(in.Groups, v0)
+					l.WantComma()
+				}
+				l.Delim(']')
+			}
+		case "extra":
+			if l.IsNull() {
+				l.Skip()
+				in.Extra = nil
+			} else {
+				if in.Extra == nil {
+					in.Extra = make(map[string]ExtraValue)
+				}
+				l.Delim('{')
+				for !l.IsDelim('}') {
+					k0 := l.String()
+					l.WantColon()
+					var v0 ExtraValue
+					if l.IsNull() {
+						l.Skip()
+						v0 = nil
+					} else {
+						v0 = make(ExtraValue, 0)
+						l.Delim('[')
+						for !l.IsDelim(']') {
+							var v1 string
+							if l.IsNull() {
+								l.Skip()
+							} else {
+								v1 = l.String()
+							}
+							v0 = append(v0, v1)
+							l.WantComma()
+						}
+						l.Delim(']')
+					}
+					in.Extra[k0] = v0
+					l.WantComma()
+				}
+				l.Delim('}')
+			}
+		case "uid":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.UID = l.String()
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
+
 type SubjectAccessReviewStatus struct {
 	// allowed is required. True if the action would be allowed, false otherwise.
 	Allowed bool `json:"allowed"`
@@ -400,6 +2520,87 @@ func (in *SubjectAccessReviewStatus) DeepCopy() *SubjectAccessReviewStatus {
 	return out
 }
 
+func (in *SubjectAccessReviewStatus) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *SubjectAccessReviewStatus) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *SubjectAccessReviewStatus) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	w.RawString("\"allowed\":")
+	w.Bool(in.Allowed)
+	if in.Denied {
+		w.RawString(",\"denied\":")
+		w.Bool(in.Denied)
+	}
+	if in.Reason != "" {
+		w.RawString(",\"reason\":")
+		w.String(in.Reason)
+	}
+	if in.EvaluationError != "" {
+		w.RawString(",\"evaluationError\":")
+		w.String(in.EvaluationError)
+	}
+
+	w.RawByte('}')
+}
+
+func (in *SubjectAccessReviewStatus) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "allowed":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.Allowed = l.Bool()
+			}
+		case "denied":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.Denied = l.Bool()
+			}
+		case "reason":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.Reason = l.String()
+			}
+		case "evaluationError":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.EvaluationError = l.String()
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
+
 type SelfSubjectAccessReviewSpec struct {
 	// resourceAttributes describes information for a resource access request
 	ResourceAttributes *ResourceAttributes `json:"resourceAttributes,omitempty"`
@@ -430,6 +2631,82 @@ func (in *SelfSubjectAccessReviewSpec) DeepCopy() *SelfSubjectAccessReviewSpec {
 	return out
 }
 
+func (in *SelfSubjectAccessReviewSpec) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *SelfSubjectAccessReviewSpec) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *SelfSubjectAccessReviewSpec) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	first := true
+	if in.ResourceAttributes != nil {
+		w.RawString("\"resourceAttributes\":")
+		in.ResourceAttributes.MarshalEasyJSON(w)
+		first = false
+	}
+	if in.NonResourceAttributes != nil {
+		if !first {
+			w.RawByte(',')
+		}
+		w.RawString("\"nonResourceAttributes\":")
+		in.NonResourceAttributes.MarshalEasyJSON(w)
+		first = false
+	}
+	w.RawByte('}')
+}
+
+func (in *SelfSubjectAccessReviewSpec) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "resourceAttributes":
+			if l.IsNull() {
+				l.Skip()
+				in.ResourceAttributes = nil
+			} else {
+				if in.ResourceAttributes == nil {
+					in.ResourceAttributes = new(ResourceAttributes)
+				}
+				in.ResourceAttributes.UnmarshalEasyJSON(l)
+			}
+		case "nonResourceAttributes":
+			if l.IsNull() {
+				l.Skip()
+				in.NonResourceAttributes = nil
+			} else {
+				if in.NonResourceAttributes == nil {
+					in.NonResourceAttributes = new(NonResourceAttributes)
+				}
+				in.NonResourceAttributes.UnmarshalEasyJSON(l)
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
+
 type SelfSubjectRulesReviewSpec struct {
 	// namespace to evaluate rules for. Required.
 	Namespace string `json:"namespace,omitempty"`
@@ -448,6 +2725,59 @@ func (in *SelfSubjectRulesReviewSpec) DeepCopy() *SelfSubjectRulesReviewSpec {
 	return out
 }
 
+func (in *SelfSubjectRulesReviewSpec) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *SelfSubjectRulesReviewSpec) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *SelfSubjectRulesReviewSpec) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	if in.Namespace != "" {
+		w.RawString("\"namespace\":")
+		w.String(in.Namespace)
+	}
+
+	w.RawByte('}')
+}
+
+func (in *SelfSubjectRulesReviewSpec) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "namespace":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.Namespace = l.String()
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
+
 type SubjectRulesReviewStatus struct {
 	// resourceRules is the list of actions the subject is allowed to perform on resources.
 	// The list ordering isn't significant, may contain duplicates, and possibly be incomplete.
@@ -491,6 +2821,131 @@ func (in *SubjectRulesReviewStatus) DeepCopy() *SubjectRulesReviewStatus {
 	return out
 }
 
+func (in *SubjectRulesReviewStatus) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *SubjectRulesReviewStatus) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *SubjectRulesReviewStatus) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	w.RawString("\"resourceRules\":")
+	if in.ResourceRules == nil {
+		w.RawString("null")
+	} else {
+		w.RawByte('[')
+		for i0 := range in.ResourceRules {
+			if i0 > 0 {
+				w.RawByte(',')
+			}
+			in.ResourceRules[i0].MarshalEasyJSON(w)
+		}
+		w.RawByte(']')
+	}
+	w.RawString(",\"nonResourceRules\":")
+	if in.NonResourceRules == nil {
+		w.RawString("null")
+	} else {
+		w.RawByte('[')
+		for i0 := range in.NonResourceRules {
+			if i0 > 0 {
+				w.RawByte(',')
+			}
+			in.NonResourceRules[i0].MarshalEasyJSON(w)
+		}
+		w.RawByte(']')
+	}
+	w.RawString(",\"incomplete\":")
+	w.Bool(in.Incomplete)
+	if in.EvaluationError != "" {
+		w.RawString(",\"evaluationError\":")
+		w.String(in.EvaluationError)
+	}
+
+	w.RawByte('}')
+}
+
+func (in *SubjectRulesReviewStatus) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "resourceRules":
+			if l.IsNull() {
+				l.Skip()
+				in.ResourceRules = nil
+			} else {
+				in.ResourceRules = make([]ResourceRule, 0)
+				l.Delim('[')
+				for !l.IsDelim(']') {
+					var v0 ResourceRule
+					if l.IsNull() {
+						l.Skip()
+					} else {
+						v0.UnmarshalEasyJSON(l)
+					}
+					in.ResourceRules = append(in.ResourceRules, v0)
+					l.WantComma()
+				}
+				l.Delim(']')
+			}
+		case "nonResourceRules":
+			if l.IsNull() {
+				l.Skip()
+				in.NonResourceRules = nil
+			} else {
+				in.NonResourceRules = make([]NonResourceRule, 0)
+				l.Delim('[')
+				for !l.IsDelim(']') {
+					var v0 NonResourceRule
+					if l.IsNull() {
+						l.Skip()
+					} else {
+						v0.UnmarshalEasyJSON(l)
+					}
+					in.NonResourceRules = append(in.NonResourceRules, v0)
+					l.WantComma()
+				}
+				l.Delim(']')
+			}
+		case "incomplete":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.Incomplete = l.Bool()
+			}
+		case "evaluationError":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.EvaluationError = l.String()
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
+
 type ResourceAttributes struct {
 	// namespace is the namespace of the action being requested.  Currently, there is no distinction between no namespace and all namespaces
 	// "" (empty) is defaulted for LocalSubjectAccessReviews
@@ -538,6 +2993,180 @@ func (in *ResourceAttributes) DeepCopy() *ResourceAttributes {
 	return out
 }
 
+func (in *ResourceAttributes) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *ResourceAttributes) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *ResourceAttributes) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	first := true
+	if in.Namespace != "" {
+		w.RawString("\"namespace\":")
+		w.String(in.Namespace)
+		first = false
+	}
+	if in.Verb != "" {
+		if !first {
+			w.RawByte(',')
+		}
+		w.RawString("\"verb\":")
+		w.String(in.Verb)
+		first = false
+	}
+	if in.Group != "" {
+		if !first {
+			w.RawByte(',')
+		}
+		w.RawString("\"group\":")
+		w.String(in.Group)
+		first = false
+	}
+	if in.Version != "" {
+		if !first {
+			w.RawByte(',')
+		}
+		w.RawString("\"version\":")
+		w.String(in.Version)
+		first = false
+	}
+	if in.Resource != "" {
+		if !first {
+			w.RawByte(',')
+		}
+		w.RawString("\"resource\":")
+		w.String(in.Resource)
+		first = false
+	}
+	if in.Subresource != "" {
+		if !first {
+			w.RawByte(',')
+		}
+		w.RawString("\"subresource\":")
+		w.String(in.Subresource)
+		first = false
+	}
+	if in.Name != "" {
+		if !first {
+			w.RawByte(',')
+		}
+		w.RawString("\"name\":")
+		w.String(in.Name)
+		first = false
+	}
+	if in.FieldSelector != nil {
+		if !first {
+			w.RawByte(',')
+		}
+		w.RawString("\"fieldSelector\":")
+		in.FieldSelector.MarshalEasyJSON(w)
+		first = false
+	}
+	if in.LabelSelector != nil {
+		if !first {
+			w.RawByte(',')
+		}
+		w.RawString("\"labelSelector\":")
+		in.LabelSelector.MarshalEasyJSON(w)
+		first = false
+	}
+	w.RawByte('}')
+}
+
+func (in *ResourceAttributes) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "namespace":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.Namespace = l.String()
+			}
+		case "verb":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.Verb = l.String()
+			}
+		case "group":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.Group = l.String()
+			}
+		case "version":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.Version = l.String()
+			}
+		case "resource":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.Resource = l.String()
+			}
+		case "subresource":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.Subresource = l.String()
+			}
+		case "name":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.Name = l.String()
+			}
+		case "fieldSelector":
+			if l.IsNull() {
+				l.Skip()
+				in.FieldSelector = nil
+			} else {
+				if in.FieldSelector == nil {
+					in.FieldSelector = new(FieldSelectorAttributes)
+				}
+				in.FieldSelector.UnmarshalEasyJSON(l)
+			}
+		case "labelSelector":
+			if l.IsNull() {
+				l.Skip()
+				in.LabelSelector = nil
+			} else {
+				if in.LabelSelector == nil {
+					in.LabelSelector = new(LabelSelectorAttributes)
+				}
+				in.LabelSelector.UnmarshalEasyJSON(l)
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
+
 type NonResourceAttributes struct {
 	// path is the URL path of the request
 	Path string `json:"path,omitempty"`
@@ -558,6 +3187,74 @@ func (in *NonResourceAttributes) DeepCopy() *NonResourceAttributes {
 	return out
 }
 
+func (in *NonResourceAttributes) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *NonResourceAttributes) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *NonResourceAttributes) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	first := true
+	if in.Path != "" {
+		w.RawString("\"path\":")
+		w.String(in.Path)
+		first = false
+	}
+	if in.Verb != "" {
+		if !first {
+			w.RawByte(',')
+		}
+		w.RawString("\"verb\":")
+		w.String(in.Verb)
+		first = false
+	}
+	w.RawByte('}')
+}
+
+func (in *NonResourceAttributes) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "path":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.Path = l.String()
+			}
+		case "verb":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.Verb = l.String()
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
+
 type ExtraValue []string
 
 type ResourceRule struct {
@@ -606,6 +3303,177 @@ func (in *ResourceRule) DeepCopy() *ResourceRule {
 	return out
 }
 
+func (in *ResourceRule) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *ResourceRule) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *ResourceRule) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	w.RawString("\"verbs\":")
+	if in.Verbs == nil {
+		w.RawString("null")
+	} else {
+		w.RawByte('[')
+		for i0 := range in.Verbs {
+			if i0 > 0 {
+				w.RawByte(',')
+			}
+			w.String(in.Verbs[i0])
+		}
+		w.RawByte(']')
+	}
+	w.RawString(",\"apiGroups\":")
+	if in.APIGroups == nil {
+		w.RawString("null")
+	} else {
+		w.RawByte('[')
+		for i0 := range in.APIGroups {
+			if i0 > 0 {
+				w.RawByte(',')
+			}
+			w.String(in.APIGroups[i0])
+		}
+		w.RawByte(']')
+	}
+	w.RawString(",\"resources\":")
+	if in.Resources == nil {
+		w.RawString("null")
+	} else {
+		w.RawByte('[')
+		for i0 := range in.Resources {
+			if i0 > 0 {
+				w.RawByte(',')
+			}
+			w.String(in.Resources[i0])
+		}
+		w.RawByte(']')
+	}
+	w.RawString(",\"resourceNames\":")
+	if in.ResourceNames == nil {
+		w.RawString("null")
+	} else {
+		w.RawByte('[')
+		for i0 := range in.ResourceNames {
+			if i0 > 0 {
+				w.RawByte(',')
+			}
+			w.String(in.ResourceNames[i0])
+		}
+		w.RawByte(']')
+	}
+
+	w.RawByte('}')
+}
+
+func (in *ResourceRule) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "verbs":
+			if l.IsNull() {
+				l.Skip()
+				in.Verbs = nil
+			} else {
+				in.Verbs = make([]string, 0)
+				l.Delim('[')
+				for !l.IsDelim(']') {
+					var v0 string
+					if l.IsNull() {
+						l.Skip()
+					} else {
+						v0 = l.String()
+					}
+					in.Verbs = append(in.Verbs, v0)
+					l.WantComma()
+				}
+				l.Delim(']')
+			}
+		case "apiGroups":
+			if l.IsNull() {
+				l.Skip()
+				in.APIGroups = nil
+			} else {
+				in.APIGroups = make([]string, 0)
+				l.Delim('[')
+				for !l.IsDelim(']') {
+					var v0 string
+					if l.IsNull() {
+						l.Skip()
+					} else {
+						v0 = l.String()
+					}
+					in.APIGroups = append(in.APIGroups, v0)
+					l.WantComma()
+				}
+				l.Delim(']')
+			}
+		case "resources":
+			if l.IsNull() {
+				l.Skip()
+				in.Resources = nil
+			} else {
+				in.Resources = make([]string, 0)
+				l.Delim('[')
+				for !l.IsDelim(']') {
+					var v0 string
+					if l.IsNull() {
+						l.Skip()
+					} else {
+						v0 = l.String()
+					}
+					in.Resources = append(in.Resources, v0)
+					l.WantComma()
+				}
+				l.Delim(']')
+			}
+		case "resourceNames":
+			if l.IsNull() {
+				l.Skip()
+				in.ResourceNames = nil
+			} else {
+				in.ResourceNames = make([]string, 0)
+				l.Delim('[')
+				for !l.IsDelim(']') {
+					var v0 string
+					if l.IsNull() {
+						l.Skip()
+					} else {
+						v0 = l.String()
+					}
+					in.ResourceNames = append(in.ResourceNames, v0)
+					l.WantComma()
+				}
+				l.Delim(']')
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
+
 type NonResourceRule struct {
 	// verbs is a list of kubernetes non-resource API verbs, like: get, post, put, delete, patch, head, options.  "*" means all.
 	Verbs []string `json:"verbs"`
@@ -637,6 +3505,113 @@ func (in *NonResourceRule) DeepCopy() *NonResourceRule {
 	return out
 }
 
+func (in *NonResourceRule) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *NonResourceRule) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *NonResourceRule) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	w.RawString("\"verbs\":")
+	if in.Verbs == nil {
+		w.RawString("null")
+	} else {
+		w.RawByte('[')
+		for i0 := range in.Verbs {
+			if i0 > 0 {
+				w.RawByte(',')
+			}
+			w.String(in.Verbs[i0])
+		}
+		w.RawByte(']')
+	}
+	w.RawString(",\"nonResourceURLs\":")
+	if in.NonResourceURLs == nil {
+		w.RawString("null")
+	} else {
+		w.RawByte('[')
+		for i0 := range in.NonResourceURLs {
+			if i0 > 0 {
+				w.RawByte(',')
+			}
+			w.String(in.NonResourceURLs[i0])
+		}
+		w.RawByte(']')
+	}
+
+	w.RawByte('}')
+}
+
+func (in *NonResourceRule) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "verbs":
+			if l.IsNull() {
+				l.Skip()
+				in.Verbs = nil
+			} else {
+				in.Verbs = make([]string, 0)
+				l.Delim('[')
+				for !l.IsDelim(']') {
+					var v0 string
+					if l.IsNull() {
+						l.Skip()
+					} else {
+						v0 = l.String()
+					}
+					in.Verbs = append(in.Verbs, v0)
+					l.WantComma()
+				}
+				l.Delim(']')
+			}
+		case "nonResourceURLs":
+			if l.IsNull() {
+				l.Skip()
+				in.NonResourceURLs = nil
+			} else {
+				in.NonResourceURLs = make([]string, 0)
+				l.Delim('[')
+				for !l.IsDelim(']') {
+					var v0 string
+					if l.IsNull() {
+						l.Skip()
+					} else {
+						v0 = l.String()
+					}
+					in.NonResourceURLs = append(in.NonResourceURLs, v0)
+					l.WantComma()
+				}
+				l.Delim(']')
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
+
 type FieldSelectorAttributes struct {
 	// rawSelector is the serialization of a field selector that would be included in a query parameter.
 	// Webhook implementations are encouraged to ignore rawSelector.
@@ -670,6 +3645,95 @@ func (in *FieldSelectorAttributes) DeepCopy() *FieldSelectorAttributes {
 	return out
 }
 
+func (in *FieldSelectorAttributes) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *FieldSelectorAttributes) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *FieldSelectorAttributes) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	first := true
+	if in.RawSelector != "" {
+		w.RawString("\"rawSelector\":")
+		w.String(in.RawSelector)
+		first = false
+	}
+	if !first {
+		w.RawByte(',')
+	}
+	w.RawString("\"requirements\":")
+	if in.Requirements == nil {
+		w.RawString("null")
+	} else {
+		w.RawByte('[')
+		for i0 := range in.Requirements {
+			if i0 > 0 {
+				w.RawByte(',')
+			}
+			in.Requirements[i0].MarshalEasyJSON(w)
+		}
+		w.RawByte(']')
+	}
+	w.RawByte('}')
+}
+
+func (in *FieldSelectorAttributes) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "rawSelector":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.RawSelector = l.String()
+			}
+		case "requirements":
+			if l.IsNull() {
+				l.Skip()
+				in.Requirements = nil
+			} else {
+				in.Requirements = make([]metav1.FieldSelectorRequirement, 0)
+				l.Delim('[')
+				for !l.IsDelim(']') {
+					var v0 metav1.FieldSelectorRequirement
+					if l.IsNull() {
+						l.Skip()
+					} else {
+						v0.UnmarshalEasyJSON(l)
+					}
+					in.Requirements = append(in.Requirements, v0)
+					l.WantComma()
+				}
+				l.Delim(']')
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
+
 type LabelSelectorAttributes struct {
 	// rawSelector is the serialization of a field selector that would be included in a query parameter.
 	// Webhook implementations are encouraged to ignore rawSelector.
@@ -702,3 +3766,92 @@ func (in *LabelSelectorAttributes) DeepCopy() *LabelSelectorAttributes {
 	in.DeepCopyInto(out)
 	return out
 }
+
+func (in *LabelSelectorAttributes) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *LabelSelectorAttributes) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *LabelSelectorAttributes) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	first := true
+	if in.RawSelector != "" {
+		w.RawString("\"rawSelector\":")
+		w.String(in.RawSelector)
+		first = false
+	}
+	if !first {
+		w.RawByte(',')
+	}
+	w.RawString("\"requirements\":")
+	if in.Requirements == nil {
+		w.RawString("null")
+	} else {
+		w.RawByte('[')
+		for i0 := range in.Requirements {
+			if i0 > 0 {
+				w.RawByte(',')
+			}
+			in.Requirements[i0].MarshalEasyJSON(w)
+		}
+		w.RawByte(']')
+	}
+	w.RawByte('}')
+}
+
+func (in *LabelSelectorAttributes) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "rawSelector":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.RawSelector = l.String()
+			}
+		case "requirements":
+			if l.IsNull() {
+				l.Skip()
+				in.Requirements = nil
+			} else {
+				in.Requirements = make([]metav1.LabelSelectorRequirement, 0)
+				l.Delim('[')
+				for !l.IsDelim(']') {
+					var v0 metav1.LabelSelectorRequirement
+					if l.IsNull() {
+						l.Skip()
+					} else {
+						v0.UnmarshalEasyJSON(l)
+					}
+					in.Requirements = append(in.Requirements, v0)
+					l.WantComma()
+				}
+				l.Delim(']')
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
@@ -1,7 +1,12 @@
 package autoscalingv1
 
 import (
+	"encoding/json"
+	"github.com/mailru/easyjson/jlexer"
+	"github.com/mailru/easyjson/jwriter"
 	"go.f110.dev/kubeproto/go/apis/metav1"
+	"go.f110.dev/kubeproto/go/patch"
+	"k8s.io/apimachinery/pkg/fields"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 )
@@ -86,6 +91,292 @@ func (in *HorizontalPodAutoscaler) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// HorizontalPodAutoscalerToSelectableFields returns the fields of HorizontalPodAutoscaler which can be used by the field selector.
+func HorizontalPodAutoscalerToSelectableFields(obj *HorizontalPodAutoscaler) fields.Set {
+	set := fields.Set{
+		"metadata.name":      obj.ObjectMeta.Name,
+		"metadata.namespace": obj.ObjectMeta.Namespace,
+	}
+	return set
+}
+
+// HorizontalPodAutoscalerPatch builds JSON patch or JSON merge patch of HorizontalPodAutoscaler.
+// JSON patch fails if the parent of the field doesn't exist (e.g. SetLabel to the object which has no label). MergePatch creates the parent.
+type HorizontalPodAutoscalerPatch struct {
+	*patch.Builder
+}
+
+func NewHorizontalPodAutoscalerPatch() *HorizontalPodAutoscalerPatch {
+	return &HorizontalPodAutoscalerPatch{Builder: patch.NewBuilder()}
+}
+
+func (p *HorizontalPodAutoscalerPatch) SetLabel(key, value string) *HorizontalPodAutoscalerPatch {
+	p.Set([]string{"metadata", "labels", key}, value)
+	return p
+}
+
+func (p *HorizontalPodAutoscalerPatch) RemoveLabel(key string) *HorizontalPodAutoscalerPatch {
+	p.Remove([]string{"metadata", "labels", key})
+	return p
+}
+
+func (p *HorizontalPodAutoscalerPatch) SetAnnotation(key, value string) *HorizontalPodAutoscalerPatch {
+	p.Set([]string{"metadata", "annotations", key}, value)
+	return p
+}
+
+func (p *HorizontalPodAutoscalerPatch) RemoveAnnotation(key string) *HorizontalPodAutoscalerPatch {
+	p.Remove([]string{"metadata", "annotations", key})
+	return p
+}
+
+func (p *HorizontalPodAutoscalerPatch) SetSpec(v *HorizontalPodAutoscalerSpec) *HorizontalPodAutoscalerPatch {
+	p.Set([]string{"spec"}, v)
+	return p
+}
+
+func (p *HorizontalPodAutoscalerPatch) RemoveSpec() *HorizontalPodAutoscalerPatch {
+	p.Remove([]string{"spec"})
+	return p
+}
+
+func (p *HorizontalPodAutoscalerPatch) SetSpecScaleTargetRef(v CrossVersionObjectReference) *HorizontalPodAutoscalerPatch {
+	p.Set([]string{"spec", "scaleTargetRef"}, v)
+	return p
+}
+
+func (p *HorizontalPodAutoscalerPatch) RemoveSpecScaleTargetRef() *HorizontalPodAutoscalerPatch {
+	p.Remove([]string{"spec", "scaleTargetRef"})
+	return p
+}
+
+func (p *HorizontalPodAutoscalerPatch) SetSpecScaleTargetRefKind(v string) *HorizontalPodAutoscalerPatch {
+	p.Set([]string{"spec", "scaleTargetRef", "kind"}, v)
+	return p
+}
+
+func (p *HorizontalPodAutoscalerPatch) RemoveSpecScaleTargetRefKind() *HorizontalPodAutoscalerPatch {
+	p.Remove([]string{"spec", "scaleTargetRef", "kind"})
+	return p
+}
+
+func (p *HorizontalPodAutoscalerPatch) SetSpecScaleTargetRefName(v string) *HorizontalPodAutoscalerPatch {
+	p.Set([]string{"spec", "scaleTargetRef", "name"}, v)
+	return p
+}
+
+func (p *HorizontalPodAutoscalerPatch) RemoveSpecScaleTargetRefName() *HorizontalPodAutoscalerPatch {
+	p.Remove([]string{"spec", "scaleTargetRef", "name"})
+	return p
+}
+
+func (p *HorizontalPodAutoscalerPatch) SetSpecScaleTargetRefAPIVersion(v string) *HorizontalPodAutoscalerPatch {
+	p.Set([]string{"spec", "scaleTargetRef", "apiVersion"}, v)
+	return p
+}
+
+func (p *HorizontalPodAutoscalerPatch) RemoveSpecScaleTargetRefAPIVersion() *HorizontalPodAutoscalerPatch {
+	p.Remove([]string{"spec", "scaleTargetRef", "apiVersion"})
+	return p
+}
+
+func (p *HorizontalPodAutoscalerPatch) SetSpecMinReplicas(v int) *HorizontalPodAutoscalerPatch {
+	p.Set([]string{"spec", "minReplicas"}, v)
+	return p
+}
+
+func (p *HorizontalPodAutoscalerPatch) RemoveSpecMinReplicas() *HorizontalPodAutoscalerPatch {
+	p.Remove([]string{"spec", "minReplicas"})
+	return p
+}
+
+func (p *HorizontalPodAutoscalerPatch) SetSpecMaxReplicas(v int) *HorizontalPodAutoscalerPatch {
+	p.Set([]string{"spec", "maxReplicas"}, v)
+	return p
+}
+
+func (p *HorizontalPodAutoscalerPatch) RemoveSpecMaxReplicas() *HorizontalPodAutoscalerPatch {
+	p.Remove([]string{"spec", "maxReplicas"})
+	return p
+}
+
+func (p *HorizontalPodAutoscalerPatch) SetSpecTargetCPUUtilizationPercentage(v int) *HorizontalPodAutoscalerPatch {
+	p.Set([]string{"spec", "targetCPUUtilizationPercentage"}, v)
+	return p
+}
+
+func (p *HorizontalPodAutoscalerPatch) RemoveSpecTargetCPUUtilizationPercentage() *HorizontalPodAutoscalerPatch {
+	p.Remove([]string{"spec", "targetCPUUtilizationPercentage"})
+	return p
+}
+
+func (p *HorizontalPodAutoscalerPatch) SetStatus(v *HorizontalPodAutoscalerStatus) *HorizontalPodAutoscalerPatch {
+	p.Set([]string{"status"}, v)
+	return p
+}
+
+func (p *HorizontalPodAutoscalerPatch) RemoveStatus() *HorizontalPodAutoscalerPatch {
+	p.Remove([]string{"status"})
+	return p
+}
+
+func (p *HorizontalPodAutoscalerPatch) SetStatusObservedGeneration(v int64) *HorizontalPodAutoscalerPatch {
+	p.Set([]string{"status", "observedGeneration"}, v)
+	return p
+}
+
+func (p *HorizontalPodAutoscalerPatch) RemoveStatusObservedGeneration() *HorizontalPodAutoscalerPatch {
+	p.Remove([]string{"status", "observedGeneration"})
+	return p
+}
+
+func (p *HorizontalPodAutoscalerPatch) SetStatusLastScaleTime(v *metav1.Time) *HorizontalPodAutoscalerPatch {
+	p.Set([]string{"status", "lastScaleTime"}, v)
+	return p
+}
+
+func (p *HorizontalPodAutoscalerPatch) RemoveStatusLastScaleTime() *HorizontalPodAutoscalerPatch {
+	p.Remove([]string{"status", "lastScaleTime"})
+	return p
+}
+
+func (p *HorizontalPodAutoscalerPatch) SetStatusCurrentReplicas(v int) *HorizontalPodAutoscalerPatch {
+	p.Set([]string{"status", "currentReplicas"}, v)
+	return p
+}
+
+func (p *HorizontalPodAutoscalerPatch) RemoveStatusCurrentReplicas() *HorizontalPodAutoscalerPatch {
+	p.Remove([]string{"status", "currentReplicas"})
+	return p
+}
+
+func (p *HorizontalPodAutoscalerPatch) SetStatusDesiredReplicas(v int) *HorizontalPodAutoscalerPatch {
+	p.Set([]string{"status", "desiredReplicas"}, v)
+	return p
+}
+
+func (p *HorizontalPodAutoscalerPatch) RemoveStatusDesiredReplicas() *HorizontalPodAutoscalerPatch {
+	p.Remove([]string{"status", "desiredReplicas"})
+	return p
+}
+
+func (p *HorizontalPodAutoscalerPatch) SetStatusCurrentCPUUtilizationPercentage(v int) *HorizontalPodAutoscalerPatch {
+	p.Set([]string{"status", "currentCPUUtilizationPercentage"}, v)
+	return p
+}
+
+func (p *HorizontalPodAutoscalerPatch) RemoveStatusCurrentCPUUtilizationPercentage() *HorizontalPodAutoscalerPatch {
+	p.Remove([]string{"status", "currentCPUUtilizationPercentage"})
+	return p
+}
+
+func (in *HorizontalPodAutoscaler) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *HorizontalPodAutoscaler) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *HorizontalPodAutoscaler) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	first := true
+	if in.TypeMeta.Kind != "" {
+		w.RawString("\"kind\":")
+		w.String(in.TypeMeta.Kind)
+		first = false
+	}
+	if in.TypeMeta.APIVersion != "" {
+		if !first {
+			w.RawByte(',')
+		}
+		w.RawString("\"apiVersion\":")
+		w.String(in.TypeMeta.APIVersion)
+		first = false
+	}
+	if !first {
+		w.RawByte(',')
+	}
+	w.RawString("\"metadata\":")
+	in.ObjectMeta.MarshalEasyJSON(w)
+	if in.Spec != nil {
+		w.RawString(",\"spec\":")
+		in.Spec.MarshalEasyJSON(w)
+	}
+	if in.Status != nil {
+		w.RawString(",\"status\":")
+		in.Status.MarshalEasyJSON(w)
+	}
+	w.RawByte('}')
+}
+
+func (in *HorizontalPodAutoscaler) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "kind":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.TypeMeta.Kind = l.String()
+			}
+		case "apiVersion":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.TypeMeta.APIVersion = l.String()
+			}
+		case "metadata":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.ObjectMeta.UnmarshalEasyJSON(l)
+			}
+		case "spec":
+			if l.IsNull() {
+				l.Skip()
+				in.Spec = nil
+			} else {
+				if in.Spec == nil {
+					in.Spec = new(HorizontalPodAutoscalerSpec)
+				}
+				in.Spec.UnmarshalEasyJSON(l)
+			}
+		case "status":
+			if l.IsNull() {
+				l.Skip()
+				in.Status = nil
+			} else {
+				if in.Status == nil {
+					in.Status = new(HorizontalPodAutoscalerStatus)
+				}
+				in.Status.UnmarshalEasyJSON(l)
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
+
 type HorizontalPodAutoscalerList struct {
 	metav1.TypeMeta `json:",inline"`
 	metav1.ListMeta `json:"metadata"`
@@ -121,6 +412,117 @@ func (in *HorizontalPodAutoscalerList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+func (in *HorizontalPodAutoscalerList) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *HorizontalPodAutoscalerList) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *HorizontalPodAutoscalerList) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	first := true
+	if in.TypeMeta.Kind != "" {
+		w.RawString("\"kind\":")
+		w.String(in.TypeMeta.Kind)
+		first = false
+	}
+	if in.TypeMeta.APIVersion != "" {
+		if !first {
+			w.RawByte(',')
+		}
+		w.RawString("\"apiVersion\":")
+		w.String(in.TypeMeta.APIVersion)
+		first = false
+	}
+	if !first {
+		w.RawByte(',')
+	}
+	w.RawString("\"metadata\":")
+	in.ListMeta.MarshalEasyJSON(w)
+	w.RawString(",\"items\":")
+	if in.Items == nil {
+		w.RawString("null")
+	} else {
+		w.RawByte('[')
+		for i0 := range in.Items {
+			if i0 > 0 {
+				w.RawByte(',')
+			}
+			in.Items[i0].MarshalEasyJSON(w)
+		}
+		w.RawByte(']')
+	}
+	w.RawByte('}')
+}
+
+func (in *HorizontalPodAutoscalerList) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "kind":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.TypeMeta.Kind = l.String()
+			}
+		case "apiVersion":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.TypeMeta.APIVersion = l.String()
+			}
+		case "metadata":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.ListMeta.UnmarshalEasyJSON(l)
+			}
+		case "items":
+			if l.IsNull() {
+				l.Skip()
+				in.Items = nil
+			} else {
+				in.Items = make([]HorizontalPodAutoscaler, 0)
+				l.Delim('[')
+				for !l.IsDelim(']') {
+					var v0 HorizontalPodAutoscaler
+					if l.IsNull() {
+						l.Skip()
+					} else {
+						v0.UnmarshalEasyJSON(l)
+					}
+					in.Items = append(in.Items, v0)
+					l.WantComma()
+				}
+				l.Delim(']')
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
+
 type Scale struct {
 	metav1.TypeMeta   `json:",inline"`
 	metav1.ObjectMeta `json:"metadata"`
@@ -162,6 +564,202 @@ func (in *Scale) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// ScaleToSelectableFields returns the fields of Scale which can be used by the field selector.
+func ScaleToSelectableFields(obj *Scale) fields.Set {
+	set := fields.Set{
+		"metadata.name":      obj.ObjectMeta.Name,
+		"metadata.namespace": obj.ObjectMeta.Namespace,
+	}
+	return set
+}
+
+// ScalePatch builds JSON patch or JSON merge patch of Scale.
+// JSON patch fails if the parent of the field doesn't exist (e.g. SetLabel to the object which has no label). MergePatch creates the parent.
+type ScalePatch struct {
+	*patch.Builder
+}
+
+func NewScalePatch() *ScalePatch {
+	return &ScalePatch{Builder: patch.NewBuilder()}
+}
+
+func (p *ScalePatch) SetLabel(key, value string) *ScalePatch {
+	p.Set([]string{"metadata", "labels", key}, value)
+	return p
+}
+
+func (p *ScalePatch) RemoveLabel(key string) *ScalePatch {
+	p.Remove([]string{"metadata", "labels", key})
+	return p
+}
+
+func (p *ScalePatch) SetAnnotation(key, value string) *ScalePatch {
+	p.Set([]string{"metadata", "annotations", key}, value)
+	return p
+}
+
+func (p *ScalePatch) RemoveAnnotation(key string) *ScalePatch {
+	p.Remove([]string{"metadata", "annotations", key})
+	return p
+}
+
+func (p *ScalePatch) SetSpec(v *ScaleSpec) *ScalePatch {
+	p.Set([]string{"spec"}, v)
+	return p
+}
+
+func (p *ScalePatch) RemoveSpec() *ScalePatch {
+	p.Remove([]string{"spec"})
+	return p
+}
+
+func (p *ScalePatch) SetSpecReplicas(v int) *ScalePatch {
+	p.Set([]string{"spec", "replicas"}, v)
+	return p
+}
+
+func (p *ScalePatch) RemoveSpecReplicas() *ScalePatch {
+	p.Remove([]string{"spec", "replicas"})
+	return p
+}
+
+func (p *ScalePatch) SetStatus(v *ScaleStatus) *ScalePatch {
+	p.Set([]string{"status"}, v)
+	return p
+}
+
+func (p *ScalePatch) RemoveStatus() *ScalePatch {
+	p.Remove([]string{"status"})
+	return p
+}
+
+func (p *ScalePatch) SetStatusReplicas(v int) *ScalePatch {
+	p.Set([]string{"status", "replicas"}, v)
+	return p
+}
+
+func (p *ScalePatch) RemoveStatusReplicas() *ScalePatch {
+	p.Remove([]string{"status", "replicas"})
+	return p
+}
+
+func (p *ScalePatch) SetStatusSelector(v string) *ScalePatch {
+	p.Set([]string{"status", "selector"}, v)
+	return p
+}
+
+func (p *ScalePatch) RemoveStatusSelector() *ScalePatch {
+	p.Remove([]string{"status", "selector"})
+	return p
+}
+
+func (in *Scale) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *Scale) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *Scale) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	first := true
+	if in.TypeMeta.Kind != "" {
+		w.RawString("\"kind\":")
+		w.String(in.TypeMeta.Kind)
+		first = false
+	}
+	if in.TypeMeta.APIVersion != "" {
+		if !first {
+			w.RawByte(',')
+		}
+		w.RawString("\"apiVersion\":")
+		w.String(in.TypeMeta.APIVersion)
+		first = false
+	}
+	if !first {
+		w.RawByte(',')
+	}
+	w.RawString("\"metadata\":")
+	in.ObjectMeta.MarshalEasyJSON(w)
+	if in.Spec != nil {
+		w.RawString(",\"spec\":")
+		in.Spec.MarshalEasyJSON(w)
+	}
+	if in.Status != nil {
+		w.RawString(",\"status\":")
+		in.Status.MarshalEasyJSON(w)
+	}
+	w.RawByte('}')
+}
+
+func (in *Scale) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "kind":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.TypeMeta.Kind = l.String()
+			}
+		case "apiVersion":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.TypeMeta.APIVersion = l.String()
+			}
+		case "metadata":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.ObjectMeta.UnmarshalEasyJSON(l)
+			}
+		case "spec":
+			if l.IsNull() {
+				l.Skip()
+				in.Spec = nil
+			} else {
+				if in.Spec == nil {
+					in.Spec = new(ScaleSpec)
+				}
+				in.Spec.UnmarshalEasyJSON(l)
+			}
+		case "status":
+			if l.IsNull() {
+				l.Skip()
+				in.Status = nil
+			} else {
+				if in.Status == nil {
+					in.Status = new(ScaleStatus)
+				}
+				in.Status.UnmarshalEasyJSON(l)
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
+
 type ScaleList struct {
 	metav1.TypeMeta `json:",inline"`
 	metav1.ListMeta `json:"metadata"`
@@ -197,6 +795,117 @@ func (in *ScaleList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+func (in *ScaleList) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *ScaleList) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *ScaleList) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	first := true
+	if in.TypeMeta.Kind != "" {
+		w.RawString("\"kind\":")
+		w.String(in.TypeMeta.Kind)
+		first = false
+	}
+	if in.TypeMeta.APIVersion != "" {
+		if !first {
+			w.RawByte(',')
+		}
+		w.RawString("\"apiVersion\":")
+		w.String(in.TypeMeta.APIVersion)
+		first = false
+	}
+	if !first {
+		w.RawByte(',')
+	}
+	w.RawString("\"metadata\":")
+	in.ListMeta.MarshalEasyJSON(w)
+	w.RawString(",\"items\":")
+	if in.Items == nil {
+		w.RawString("null")
+	} else {
+		w.RawByte('[')
+		for i0 := range in.Items {
+			if i0 > 0 {
+				w.RawByte(',')
+			}
+			in.Items[i0].MarshalEasyJSON(w)
+		}
+		w.RawByte(']')
+	}
+	w.RawByte('}')
+}
+
+func (in *ScaleList) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "kind":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.TypeMeta.Kind = l.String()
+			}
+		case "apiVersion":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.TypeMeta.APIVersion = l.String()
+			}
+		case "metadata":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.ListMeta.UnmarshalEasyJSON(l)
+			}
+		case "items":
+			if l.IsNull() {
+				l.Skip()
+				in.Items = nil
+			} else {
+				in.Items = make([]Scale, 0)
+				l.Delim('[')
+				for !l.IsDelim(']') {
+					var v0 Scale
+					if l.IsNull() {
+						l.Skip()
+					} else {
+						v0.UnmarshalEasyJSON(l)
+					}
+					in.Items = append(in.Items, v0)
+					l.WantComma()
+				}
+				l.Delim(']')
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
+
 type HorizontalPodAutoscalerSpec struct {
 	// reference to scaled resource; horizontal pod autoscaler will learn the current resource consumption
 	// and will set the desired number of pods by using its Scale subresource.
@@ -228,6 +937,85 @@ func (in *HorizontalPodAutoscalerSpec) DeepCopy() *HorizontalPodAutoscalerSpec {
 	return out
 }
 
+func (in *HorizontalPodAutoscalerSpec) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *HorizontalPodAutoscalerSpec) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *HorizontalPodAutoscalerSpec) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	w.RawString("\"scaleTargetRef\":")
+	in.ScaleTargetRef.MarshalEasyJSON(w)
+	if in.MinReplicas != 0 {
+		w.RawString(",\"minReplicas\":")
+		w.Int(in.MinReplicas)
+	}
+	w.RawString(",\"maxReplicas\":")
+	w.Int(in.MaxReplicas)
+	if in.TargetCPUUtilizationPercentage != 0 {
+		w.RawString(",\"targetCPUUtilizationPercentage\":")
+		w.Int(in.TargetCPUUtilizationPercentage)
+	}
+
+	w.RawByte('}')
+}
+
+func (in *HorizontalPodAutoscalerSpec) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "scaleTargetRef":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.ScaleTargetRef.UnmarshalEasyJSON(l)
+			}
+		case "minReplicas":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.MinReplicas = l.Int()
+			}
+		case "maxReplicas":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.MaxReplicas = l.Int()
+			}
+		case "targetCPUUtilizationPercentage":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.TargetCPUUtilizationPercentage = l.Int()
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
+
 type HorizontalPodAutoscalerStatus struct {
 	// observedGeneration is the most recent generation observed by this autoscaler.
 	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
@@ -261,6 +1049,99 @@ func (in *HorizontalPodAutoscalerStatus) DeepCopy() *HorizontalPodAutoscalerStat
 	return out
 }
 
+func (in *HorizontalPodAutoscalerStatus) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *HorizontalPodAutoscalerStatus) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *HorizontalPodAutoscalerStatus) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	first := true
+	if in.ObservedGeneration != 0 {
+		w.RawString("\"observedGeneration\":")
+		w.Int64(in.ObservedGeneration)
+		first = false
+	}
+	if in.LastScaleTime != nil {
+		if !first {
+			w.RawByte(',')
+		}
+		w.RawString("\"lastScaleTime\":")
+		w.Raw(json.Marshal(in.LastScaleTime))
+		first = false
+	}
+	if !first {
+		w.RawByte(',')
+	}
+	w.RawString("\"currentReplicas\":")
+	w.Int(in.CurrentReplicas)
+	w.RawString(",\"desiredReplicas\":")
+	w.Int(in.DesiredReplicas)
+	if in.CurrentCPUUtilizationPercentage != 0 {
+		w.RawString(",\"currentCPUUtilizationPercentage\":")
+		w.Int(in.CurrentCPUUtilizationPercentage)
+	}
+	w.RawByte('}')
+}
+
+func (in *HorizontalPodAutoscalerStatus) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "observedGeneration":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.ObservedGeneration = l.Int64()
+			}
+		case "lastScaleTime":
+			l.AddError(json.Unmarshal(l.Raw(), &in.LastScaleTime))
+		case "currentReplicas":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.CurrentReplicas = l.Int()
+			}
+		case "desiredReplicas":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.DesiredReplicas = l.Int()
+			}
+		case "currentCPUUtilizationPercentage":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.CurrentCPUUtilizationPercentage = l.Int()
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
+
 type ScaleSpec struct {
 	// replicas is the desired number of instances for the scaled object.
 	Replicas int `json:"replicas,omitempty"`
@@ -279,6 +1160,59 @@ func (in *ScaleSpec) DeepCopy() *ScaleSpec {
 	return out
 }
 
+func (in *ScaleSpec) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *ScaleSpec) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *ScaleSpec) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	if in.Replicas != 0 {
+		w.RawString("\"replicas\":")
+		w.Int(in.Replicas)
+	}
+
+	w.RawByte('}')
+}
+
+func (in *ScaleSpec) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "replicas":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.Replicas = l.Int()
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
+
 type ScaleStatus struct {
 	// replicas is the actual number of observed instances of the scaled object.
 	Replicas int `json:"replicas"`
@@ -302,6 +1236,67 @@ func (in *ScaleStatus) DeepCopy() *ScaleStatus {
 	return out
 }
 
+func (in *ScaleStatus) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *ScaleStatus) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *ScaleStatus) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	w.RawString("\"replicas\":")
+	w.Int(in.Replicas)
+	if in.Selector != "" {
+		w.RawString(",\"selector\":")
+		w.String(in.Selector)
+	}
+
+	w.RawByte('}')
+}
+
+func (in *ScaleStatus) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "replicas":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.Replicas = l.Int()
+			}
+		case "selector":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.Selector = l.String()
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
+
 type CrossVersionObjectReference struct {
 	// kind is the kind of the referent; More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds
 	Kind string `json:"kind"`
@@ -323,3 +1318,72 @@ func (in *CrossVersionObjectReference) DeepCopy() *CrossVersionObjectReference {
 	in.DeepCopyInto(out)
 	return out
 }
+
+func (in *CrossVersionObjectReference) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *CrossVersionObjectReference) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *CrossVersionObjectReference) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	w.RawString("\"kind\":")
+	w.String(in.Kind)
+	w.RawString(",\"name\":")
+	w.String(in.Name)
+	if in.APIVersion != "" {
+		w.RawString(",\"apiVersion\":")
+		w.String(in.APIVersion)
+	}
+
+	w.RawByte('}')
+}
+
+func (in *CrossVersionObjectReference) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "kind":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.Kind = l.String()
+			}
+		case "name":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.Name = l.String()
+			}
+		case "apiVersion":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.APIVersion = l.String()
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
@@ -1,9 +1,14 @@
 package autoscalingv2
 
 import (
+	"encoding/json"
+	"github.com/mailru/easyjson/jlexer"
+	"github.com/mailru/easyjson/jwriter"
 	"go.f110.dev/kubeproto/go/apis/corev1"
 	"go.f110.dev/kubeproto/go/apis/metav1"
+	"go.f110.dev/kubeproto/go/patch"
 	apiresource "k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/fields"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 )
@@ -110,6 +115,447 @@ func (in *HorizontalPodAutoscaler) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// HorizontalPodAutoscalerToSelectableFields returns the fields of HorizontalPodAutoscaler which can be used by the field selector.
+func HorizontalPodAutoscalerToSelectableFields(obj *HorizontalPodAutoscaler) fields.Set {
+	set := fields.Set{
+		"metadata.name":      obj.ObjectMeta.Name,
+		"metadata.namespace": obj.ObjectMeta.Namespace,
+	}
+	return set
+}
+
+// HorizontalPodAutoscalerPatch builds JSON patch or JSON merge patch of HorizontalPodAutoscaler.
+// JSON patch fails if the parent of the field doesn't exist (e.g. SetLabel to the object which has no label). MergePatch creates the parent.
+type HorizontalPodAutoscalerPatch struct {
+	*patch.Builder
+}
+
+func NewHorizontalPodAutoscalerPatch() *HorizontalPodAutoscalerPatch {
+	return &HorizontalPodAutoscalerPatch{Builder: patch.NewBuilder()}
+}
+
+func (p *HorizontalPodAutoscalerPatch) SetLabel(key, value string) *HorizontalPodAutoscalerPatch {
+	p.Set([]string{"metadata", "labels", key}, value)
+	return p
+}
+
+func (p *HorizontalPodAutoscalerPatch) RemoveLabel(key string) *HorizontalPodAutoscalerPatch {
+	p.Remove([]string{"metadata", "labels", key})
+	return p
+}
+
+func (p *HorizontalPodAutoscalerPatch) SetAnnotation(key, value string) *HorizontalPodAutoscalerPatch {
+	p.Set([]string{"metadata", "annotations", key}, value)
+	return p
+}
+
+func (p *HorizontalPodAutoscalerPatch) RemoveAnnotation(key string) *HorizontalPodAutoscalerPatch {
+	p.Remove([]string{"metadata", "annotations", key})
+	return p
+}
+
+func (p *HorizontalPodAutoscalerPatch) SetSpec(v *HorizontalPodAutoscalerSpec) *HorizontalPodAutoscalerPatch {
+	p.Set([]string{"spec"}, v)
+	return p
+}
+
+func (p *HorizontalPodAutoscalerPatch) RemoveSpec() *HorizontalPodAutoscalerPatch {
+	p.Remove([]string{"spec"})
+	return p
+}
+
+func (p *HorizontalPodAutoscalerPatch) SetSpecScaleTargetRef(v CrossVersionObjectReference) *HorizontalPodAutoscalerPatch {
+	p.Set([]string{"spec", "scaleTargetRef"}, v)
+	return p
+}
+
+func (p *HorizontalPodAutoscalerPatch) RemoveSpecScaleTargetRef() *HorizontalPodAutoscalerPatch {
+	p.Remove([]string{"spec", "scaleTargetRef"})
+	return p
+}
+
+func (p *HorizontalPodAutoscalerPatch) SetSpecScaleTargetRefKind(v string) *HorizontalPodAutoscalerPatch {
+	p.Set([]string{"spec", "scaleTargetRef", "kind"}, v)
+	return p
+}
+
+func (p *HorizontalPodAutoscalerPatch) RemoveSpecScaleTargetRefKind() *HorizontalPodAutoscalerPatch {
+	p.Remove([]string{"spec", "scaleTargetRef", "kind"})
+	return p
+}
+
+func (p *HorizontalPodAutoscalerPatch) SetSpecScaleTargetRefName(v string) *HorizontalPodAutoscalerPatch {
+	p.Set([]string{"spec", "scaleTargetRef", "name"}, v)
+	return p
+}
+
+func (p *HorizontalPodAutoscalerPatch) RemoveSpecScaleTargetRefName() *HorizontalPodAutoscalerPatch {
+	p.Remove([]string{"spec", "scaleTargetRef", "name"})
+	return p
+}
+
+func (p *HorizontalPodAutoscalerPatch) SetSpecScaleTargetRefAPIVersion(v string) *HorizontalPodAutoscalerPatch {
+	p.Set([]string{"spec", "scaleTargetRef", "apiVersion"}, v)
+	return p
+}
+
+func (p *HorizontalPodAutoscalerPatch) RemoveSpecScaleTargetRefAPIVersion() *HorizontalPodAutoscalerPatch {
+	p.Remove([]string{"spec", "scaleTargetRef", "apiVersion"})
+	return p
+}
+
+func (p *HorizontalPodAutoscalerPatch) SetSpecMinReplicas(v int) *HorizontalPodAutoscalerPatch {
+	p.Set([]string{"spec", "minReplicas"}, v)
+	return p
+}
+
+func (p *HorizontalPodAutoscalerPatch) RemoveSpecMinReplicas() *HorizontalPodAutoscalerPatch {
+	p.Remove([]string{"spec", "minReplicas"})
+	return p
+}
+
+func (p *HorizontalPodAutoscalerPatch) SetSpecMaxReplicas(v int) *HorizontalPodAutoscalerPatch {
+	p.Set([]string{"spec", "maxReplicas"}, v)
+	return p
+}
+
+func (p *HorizontalPodAutoscalerPatch) RemoveSpecMaxReplicas() *HorizontalPodAutoscalerPatch {
+	p.Remove([]string{"spec", "maxReplicas"})
+	return p
+}
+
+func (p *HorizontalPodAutoscalerPatch) SetSpecMetrics(v []MetricSpec) *HorizontalPodAutoscalerPatch {
+	p.Set([]string{"spec", "metrics"}, v)
+	return p
+}
+
+func (p *HorizontalPodAutoscalerPatch) RemoveSpecMetrics() *HorizontalPodAutoscalerPatch {
+	p.Remove([]string{"spec", "metrics"})
+	return p
+}
+
+func (p *HorizontalPodAutoscalerPatch) AddSpecMetrics(v ...MetricSpec) *HorizontalPodAutoscalerPatch {
+	for _, e := range v {
+		p.Append([]string{"spec", "metrics"}, e)
+	}
+	return p
+}
+
+func (p *HorizontalPodAutoscalerPatch) SetSpecBehavior(v *HorizontalPodAutoscalerBehavior) *HorizontalPodAutoscalerPatch {
+	p.Set([]string{"spec", "behavior"}, v)
+	return p
+}
+
+func (p *HorizontalPodAutoscalerPatch) RemoveSpecBehavior() *HorizontalPodAutoscalerPatch {
+	p.Remove([]string{"spec", "behavior"})
+	return p
+}
+
+func (p *HorizontalPodAutoscalerPatch) SetSpecBehaviorScaleUp(v *HPAScalingRules) *HorizontalPodAutoscalerPatch {
+	p.Set([]string{"spec", "behavior", "scaleUp"}, v)
+	return p
+}
+
+func (p *HorizontalPodAutoscalerPatch) RemoveSpecBehaviorScaleUp() *HorizontalPodAutoscalerPatch {
+	p.Remove([]string{"spec", "behavior", "scaleUp"})
+	return p
+}
+
+func (p *HorizontalPodAutoscalerPatch) SetSpecBehaviorScaleUpStabilizationWindowSeconds(v int) *HorizontalPodAutoscalerPatch {
+	p.Set([]string{"spec", "behavior", "scaleUp", "stabilizationWindowSeconds"}, v)
+	return p
+}
+
+func (p *HorizontalPodAutoscalerPatch) RemoveSpecBehaviorScaleUpStabilizationWindowSeconds() *HorizontalPodAutoscalerPatch {
+	p.Remove([]string{"spec", "behavior", "scaleUp", "stabilizationWindowSeconds"})
+	return p
+}
+
+func (p *HorizontalPodAutoscalerPatch) SetSpecBehaviorScaleUpSelectPolicy(v ScalingPolicySelect) *HorizontalPodAutoscalerPatch {
+	p.Set([]string{"spec", "behavior", "scaleUp", "selectPolicy"}, v)
+	return p
+}
+
+func (p *HorizontalPodAutoscalerPatch) RemoveSpecBehaviorScaleUpSelectPolicy() *HorizontalPodAutoscalerPatch {
+	p.Remove([]string{"spec", "behavior", "scaleUp", "selectPolicy"})
+	return p
+}
+
+func (p *HorizontalPodAutoscalerPatch) SetSpecBehaviorScaleUpPolicies(v []HPAScalingPolicy) *HorizontalPodAutoscalerPatch {
+	p.Set([]string{"spec", "behavior", "scaleUp", "policies"}, v)
+	return p
+}
+
+func (p *HorizontalPodAutoscalerPatch) RemoveSpecBehaviorScaleUpPolicies() *HorizontalPodAutoscalerPatch {
+	p.Remove([]string{"spec", "behavior", "scaleUp", "policies"})
+	return p
+}
+
+func (p *HorizontalPodAutoscalerPatch) AddSpecBehaviorScaleUpPolicies(v ...HPAScalingPolicy) *HorizontalPodAutoscalerPatch {
+	for _, e := range v {
+		p.Append([]string{"spec", "behavior", "scaleUp", "policies"}, e)
+	}
+	return p
+}
+
+func (p *HorizontalPodAutoscalerPatch) SetSpecBehaviorScaleUpTolerance(v *apiresource.Quantity) *HorizontalPodAutoscalerPatch {
+	p.Set([]string{"spec", "behavior", "scaleUp", "tolerance"}, v)
+	return p
+}
+
+func (p *HorizontalPodAutoscalerPatch) RemoveSpecBehaviorScaleUpTolerance() *HorizontalPodAutoscalerPatch {
+	p.Remove([]string{"spec", "behavior", "scaleUp", "tolerance"})
+	return p
+}
+
+func (p *HorizontalPodAutoscalerPatch) SetSpecBehaviorScaleDown(v *HPAScalingRules) *HorizontalPodAutoscalerPatch {
+	p.Set([]string{"spec", "behavior", "scaleDown"}, v)
+	return p
+}
+
+func (p *HorizontalPodAutoscalerPatch) RemoveSpecBehaviorScaleDown() *HorizontalPodAutoscalerPatch {
+	p.Remove([]string{"spec", "behavior", "scaleDown"})
+	return p
+}
+
+func (p *HorizontalPodAutoscalerPatch) SetSpecBehaviorScaleDownStabilizationWindowSeconds(v int) *HorizontalPodAutoscalerPatch {
+	p.Set([]string{"spec", "behavior", "scaleDown", "stabilizationWindowSeconds"}, v)
+	return p
+}
+
+func (p *HorizontalPodAutoscalerPatch) RemoveSpecBehaviorScaleDownStabilizationWindowSeconds() *HorizontalPodAutoscalerPatch {
+	p.Remove([]string{"spec", "behavior", "scaleDown", "stabilizationWindowSeconds"})
+	return p
+}
+
+func (p *HorizontalPodAutoscalerPatch) SetSpecBehaviorScaleDownSelectPolicy(v ScalingPolicySelect) *HorizontalPodAutoscalerPatch {
+	p.Set([]string{"spec", "behavior", "scaleDown", "selectPolicy"}, v)
+	return p
+}
+
+func (p *HorizontalPodAutoscalerPatch) RemoveSpecBehaviorScaleDownSelectPolicy() *HorizontalPodAutoscalerPatch {
+	p.Remove([]string{"spec", "behavior", "scaleDown", "selectPolicy"})
+	return p
+}
+
+func (p *HorizontalPodAutoscalerPatch) SetSpecBehaviorScaleDownPolicies(v []HPAScalingPolicy) *HorizontalPodAutoscalerPatch {
+	p.Set([]string{"spec", "behavior", "scaleDown", "policies"}, v)
+	return p
+}
+
+func (p *HorizontalPodAutoscalerPatch) RemoveSpecBehaviorScaleDownPolicies() *HorizontalPodAutoscalerPatch {
+	p.Remove([]string{"spec", "behavior", "scaleDown", "policies"})
+	return p
+}
+
+func (p *HorizontalPodAutoscalerPatch) AddSpecBehaviorScaleDownPolicies(v ...HPAScalingPolicy) *HorizontalPodAutoscalerPatch {
+	for _, e := range v {
+		p.Append([]string{"spec", "behavior", "scaleDown", "policies"}, e)
+	}
+	return p
+}
+
+func (p *HorizontalPodAutoscalerPatch) SetSpecBehaviorScaleDownTolerance(v *apiresource.Quantity) *HorizontalPodAutoscalerPatch {
+	p.Set([]string{"spec", "behavior", "scaleDown", "tolerance"}, v)
+	return p
+}
+
+func (p *HorizontalPodAutoscalerPatch) RemoveSpecBehaviorScaleDownTolerance() *HorizontalPodAutoscalerPatch {
+	p.Remove([]string{"spec", "behavior", "scaleDown", "tolerance"})
+	return p
+}
+
+func (p *HorizontalPodAutoscalerPatch) SetStatus(v *HorizontalPodAutoscalerStatus) *HorizontalPodAutoscalerPatch {
+	p.Set([]string{"status"}, v)
+	return p
+}
+
+func (p *HorizontalPodAutoscalerPatch) RemoveStatus() *HorizontalPodAutoscalerPatch {
+	p.Remove([]string{"status"})
+	return p
+}
+
+func (p *HorizontalPodAutoscalerPatch) SetStatusObservedGeneration(v int64) *HorizontalPodAutoscalerPatch {
+	p.Set([]string{"status", "observedGeneration"}, v)
+	return p
+}
+
+func (p *HorizontalPodAutoscalerPatch) RemoveStatusObservedGeneration() *HorizontalPodAutoscalerPatch {
+	p.Remove([]string{"status", "observedGeneration"})
+	return p
+}
+
+func (p *HorizontalPodAutoscalerPatch) SetStatusLastScaleTime(v *metav1.Time) *HorizontalPodAutoscalerPatch {
+	p.Set([]string{"status", "lastScaleTime"}, v)
+	return p
+}
+
+func (p *HorizontalPodAutoscalerPatch) RemoveStatusLastScaleTime() *HorizontalPodAutoscalerPatch {
+	p.Remove([]string{"status", "lastScaleTime"})
+	return p
+}
+
+func (p *HorizontalPodAutoscalerPatch) SetStatusCurrentReplicas(v int) *HorizontalPodAutoscalerPatch {
+	p.Set([]string{"status", "currentReplicas"}, v)
+	return p
+}
+
+func (p *HorizontalPodAutoscalerPatch) RemoveStatusCurrentReplicas() *HorizontalPodAutoscalerPatch {
+	p.Remove([]string{"status", "currentReplicas"})
+	return p
+}
+
+func (p *HorizontalPodAutoscalerPatch) SetStatusDesiredReplicas(v int) *HorizontalPodAutoscalerPatch {
+	p.Set([]string{"status", "desiredReplicas"}, v)
+	return p
+}
+
+func (p *HorizontalPodAutoscalerPatch) RemoveStatusDesiredReplicas() *HorizontalPodAutoscalerPatch {
+	p.Remove([]string{"status", "desiredReplicas"})
+	return p
+}
+
+func (p *HorizontalPodAutoscalerPatch) SetStatusCurrentMetrics(v []MetricStatus) *HorizontalPodAutoscalerPatch {
+	p.Set([]string{"status", "currentMetrics"}, v)
+	return p
+}
+
+func (p *HorizontalPodAutoscalerPatch) RemoveStatusCurrentMetrics() *HorizontalPodAutoscalerPatch {
+	p.Remove([]string{"status", "currentMetrics"})
+	return p
+}
+
+func (p *HorizontalPodAutoscalerPatch) AddStatusCurrentMetrics(v ...MetricStatus) *HorizontalPodAutoscalerPatch {
+	for _, e := range v {
+		p.Append([]string{"status", "currentMetrics"}, e)
+	}
+	return p
+}
+
+func (p *HorizontalPodAutoscalerPatch) SetStatusConditions(v []HorizontalPodAutoscalerCondition) *HorizontalPodAutoscalerPatch {
+	p.Set([]string{"status", "conditions"}, v)
+	return p
+}
+
+func (p *HorizontalPodAutoscalerPatch) RemoveStatusConditions() *HorizontalPodAutoscalerPatch {
+	p.Remove([]string{"status", "conditions"})
+	return p
+}
+
+func (p *HorizontalPodAutoscalerPatch) AddStatusConditions(v ...HorizontalPodAutoscalerCondition) *HorizontalPodAutoscalerPatch {
+	for _, e := range v {
+		p.Append([]string{"status", "conditions"}, e)
+	}
+	return p
+}
+
+func (in *HorizontalPodAutoscaler) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *HorizontalPodAutoscaler) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *HorizontalPodAutoscaler) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	first := true
+	if in.TypeMeta.Kind != "" {
+		w.RawString("\"kind\":")
+		w.String(in.TypeMeta.Kind)
+		first = false
+	}
+	if in.TypeMeta.APIVersion != "" {
+		if !first {
+			w.RawByte(',')
+		}
+		w.RawString("\"apiVersion\":")
+		w.String(in.TypeMeta.APIVersion)
+		first = false
+	}
+	if !first {
+		w.RawByte(',')
+	}
+	w.RawString("\"metadata\":")
+	in.ObjectMeta.MarshalEasyJSON(w)
+	if in.Spec != nil {
+		w.RawString(",\"spec\":")
+		in.Spec.MarshalEasyJSON(w)
+	}
+	if in.Status != nil {
+		w.RawString(",\"status\":")
+		in.Status.MarshalEasyJSON(w)
+	}
+	w.RawByte('}')
+}
+
+func (in *HorizontalPodAutoscaler) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "kind":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.TypeMeta.Kind = l.String()
+			}
+		case "apiVersion":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.TypeMeta.APIVersion = l.String()
+			}
+		case "metadata":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.ObjectMeta.UnmarshalEasyJSON(l)
+			}
+		case "spec":
+			if l.IsNull() {
+				l.Skip()
+				in.Spec = nil
+			} else {
+				if in.Spec == nil {
+					in.Spec = new(HorizontalPodAutoscalerSpec)
+				}
+				in.Spec.UnmarshalEasyJSON(l)
+			}
+		case "status":
+			if l.IsNull() {
+				l.Skip()
+				in.Status = nil
+			} else {
+				if in.Status == nil {
+					in.Status = new(HorizontalPodAutoscalerStatus)
+				}
+				in.Status.UnmarshalEasyJSON(l)
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
+
 type HorizontalPodAutoscalerList struct {
 	metav1.TypeMeta `json:",inline"`
 	metav1.ListMeta `json:"metadata"`
@@ -145,6 +591,117 @@ func (in *HorizontalPodAutoscalerList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+func (in *HorizontalPodAutoscalerList) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *HorizontalPodAutoscalerList) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *HorizontalPodAutoscalerList) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	first := true
+	if in.TypeMeta.Kind != "" {
+		w.RawString("\"kind\":")
+		w.String(in.TypeMeta.Kind)
+		first = false
+	}
+	if in.TypeMeta.APIVersion != "" {
+		if !first {
+			w.RawByte(',')
+		}
+		w.RawString("\"apiVersion\":")
+		w.String(in.TypeMeta.APIVersion)
+		first = false
+	}
+	if !first {
+		w.RawByte(',')
+	}
+	w.RawString("\"metadata\":")
+	in.ListMeta.MarshalEasyJSON(w)
+	w.RawString(",\"items\":")
+	if in.Items == nil {
+		w.RawString("null")
+	} else {
+		w.RawByte('[')
+		for i0 := range in.Items {
+			if i0 > 0 {
+				w.RawByte(',')
+			}
+			in.Items[i0].MarshalEasyJSON(w)
+		}
+		w.RawByte(']')
+	}
+	w.RawByte('}')
+}
+
+func (in *HorizontalPodAutoscalerList) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "kind":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.TypeMeta.Kind = l.String()
+			}
+		case "apiVersion":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.TypeMeta.APIVersion = l.String()
+			}
+		case "metadata":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.ListMeta.UnmarshalEasyJSON(l)
+			}
+		case "items":
+			if l.IsNull() {
+				l.Skip()
+				in.Items = nil
+			} else {
+				in.Items = make([]HorizontalPodAutoscaler, 0)
+				l.Delim('[')
+				for !l.IsDelim(']') {
+					var v0 HorizontalPodAutoscaler
+					if l.IsNull() {
+						l.Skip()
+					} else {
+						v0.UnmarshalEasyJSON(l)
+					}
+					in.Items = append(in.Items, v0)
+					l.WantComma()
+				}
+				l.Delim(']')
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
+
 type HorizontalPodAutoscalerSpec struct {
 	// scaleTargetRef points to the target resource to scale, and is used to the pods for which metrics
 	// should be collected, as well as to actually change the replica count.
@@ -199,6 +756,121 @@ func (in *HorizontalPodAutoscalerSpec) DeepCopy() *HorizontalPodAutoscalerSpec {
 	return out
 }
 
+func (in *HorizontalPodAutoscalerSpec) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *HorizontalPodAutoscalerSpec) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *HorizontalPodAutoscalerSpec) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	w.RawString("\"scaleTargetRef\":")
+	in.ScaleTargetRef.MarshalEasyJSON(w)
+	if in.MinReplicas != 0 {
+		w.RawString(",\"minReplicas\":")
+		w.Int(in.MinReplicas)
+	}
+	w.RawString(",\"maxReplicas\":")
+	w.Int(in.MaxReplicas)
+	w.RawString(",\"metrics\":")
+	if in.Metrics == nil {
+		w.RawString("null")
+	} else {
+		w.RawByte('[')
+		for i0 := range in.Metrics {
+			if i0 > 0 {
+				w.RawByte(',')
+			}
+			in.Metrics[i0].MarshalEasyJSON(w)
+		}
+		w.RawByte(']')
+	}
+	if in.Behavior != nil {
+		w.RawString(",\"behavior\":")
+		in.Behavior.MarshalEasyJSON(w)
+	}
+
+	w.RawByte('}')
+}
+
+func (in *HorizontalPodAutoscalerSpec) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "scaleTargetRef":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.ScaleTargetRef.UnmarshalEasyJSON(l)
+			}
+		case "minReplicas":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.MinReplicas = l.Int()
+			}
+		case "maxReplicas":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.MaxReplicas = l.Int()
+			}
+		case "metrics":
+			if l.IsNull() {
+				l.Skip()
+				in.Metrics = nil
+			} else {
+				in.Metrics = make([]MetricSpec, 0)
+				l.Delim('[')
+				for !l.IsDelim(']') {
+					var v0 MetricSpec
+					if l.IsNull() {
+						l.Skip()
+					} else {
+						v0.UnmarshalEasyJSON(l)
+					}
+					in.Metrics = append(in.Metrics, v0)
+					l.WantComma()
+				}
+				l.Delim(']')
+			}
+		case "behavior":
+			if l.IsNull() {
+				l.Skip()
+				in.Behavior = nil
+			} else {
+				if in.Behavior == nil {
+					in.Behavior = new(HorizontalPodAutoscalerBehavior)
+				}
+				in.Behavior.UnmarshalEasyJSON(l)
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
+
 type HorizontalPodAutoscalerStatus struct {
 	// observedGeneration is the most recent generation observed by this autoscaler.
 	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
@@ -250,6 +922,159 @@ func (in *HorizontalPodAutoscalerStatus) DeepCopy() *HorizontalPodAutoscalerStat
 	return out
 }
 
+func (in *HorizontalPodAutoscalerStatus) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *HorizontalPodAutoscalerStatus) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *HorizontalPodAutoscalerStatus) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	first := true
+	if in.ObservedGeneration != 0 {
+		w.RawString("\"observedGeneration\":")
+		w.Int64(in.ObservedGeneration)
+		first = false
+	}
+	if in.LastScaleTime != nil {
+		if !first {
+			w.RawByte(',')
+		}
+		w.RawString("\"lastScaleTime\":")
+		w.Raw(json.Marshal(in.LastScaleTime))
+		first = false
+	}
+	if in.CurrentReplicas != 0 {
+		if !first {
+			w.RawByte(',')
+		}
+		w.RawString("\"currentReplicas\":")
+		w.Int(in.CurrentReplicas)
+		first = false
+	}
+	if !first {
+		w.RawByte(',')
+	}
+	w.RawString("\"desiredReplicas\":")
+	w.Int(in.DesiredReplicas)
+	w.RawString(",\"currentMetrics\":")
+	if in.CurrentMetrics == nil {
+		w.RawString("null")
+	} else {
+		w.RawByte('[')
+		for i0 := range in.CurrentMetrics {
+			if i0 > 0 {
+				w.RawByte(',')
+			}
+			in.CurrentMetrics[i0].MarshalEasyJSON(w)
+		}
+		w.RawByte(']')
+	}
+	w.RawString(",\"conditions\":")
+	if in.Conditions == nil {
+		w.RawString("null")
+	} else {
+		w.RawByte('[')
+		for i0 := range in.Conditions {
+			if i0 > 0 {
+				w.RawByte(',')
+			}
+			in.Conditions[i0].MarshalEasyJSON(w)
+		}
+		w.RawByte(']')
+	}
+	w.RawByte('}')
+}
+
+func (in *HorizontalPodAutoscalerStatus) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "observedGeneration":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.ObservedGeneration = l.Int64()
+			}
+		case "lastScaleTime":
+			l.AddError(json.Unmarshal(l.Raw(), &in.LastScaleTime))
+		case "currentReplicas":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.CurrentReplicas = l.Int()
+			}
+		case "desiredReplicas":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.DesiredReplicas = l.Int()
+			}
+		case "currentMetrics":
+			if l.IsNull() {
+				l.Skip()
+				in.CurrentMetrics = nil
+			} else {
+				in.CurrentMetrics = make([]MetricStatus, 0)
+				l.Delim('[')
+				for !l.IsDelim(']') {
+					var v0 MetricStatus
+					if l.IsNull() {
+						l.Skip()
+					} else {
+						v0.UnmarshalEasyJSON(l)
+					}
+					in.CurrentMetrics = append(in.CurrentMetrics, v0)
+					l.WantComma()
+				}
+				l.Delim(']')
+			}
+		case "conditions":
+			if l.IsNull() {
+				l.Skip()
+				in.Conditions = nil
+			} else {
+				in.Conditions = make([]HorizontalPodAutoscalerCondition, 0)
+				l.Delim('[')
+				for !l.IsDelim(']') {
+					var v0 HorizontalPodAutoscalerCondition
+					if l.IsNull() {
+						l.Skip()
+					} else {
+						v0.UnmarshalEasyJSON(l)
+					}
+					in.Conditions = append(in.Conditions, v0)
+					l.WantComma()
+				}
+				l.Delim(']')
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
+
 type CrossVersionObjectReference struct {
 	// kind is the kind of the referent; More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds
 	Kind string `json:"kind"`
@@ -272,6 +1097,75 @@ func (in *CrossVersionObjectReference) DeepCopy() *CrossVersionObjectReference {
 	return out
 }
 
+func (in *CrossVersionObjectReference) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *CrossVersionObjectReference) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *CrossVersionObjectReference) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	w.RawString("\"kind\":")
+	w.String(in.Kind)
+	w.RawString(",\"name\":")
+	w.String(in.Name)
+	if in.APIVersion != "" {
+		w.RawString(",\"apiVersion\":")
+		w.String(in.APIVersion)
+	}
+
+	w.RawByte('}')
+}
+
+func (in *CrossVersionObjectReference) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "kind":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.Kind = l.String()
+			}
+		case "name":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.Name = l.String()
+			}
+		case "apiVersion":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.APIVersion = l.String()
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
+
 type MetricSpec struct {
 	// type is the type of metric source.  It should be one of "ContainerResource", "External",
 	// "Object", "Pods" or "Resource", each mapping to a matching field in the object.
@@ -341,6 +1235,127 @@ func (in *MetricSpec) DeepCopy() *MetricSpec {
 	return out
 }
 
+func (in *MetricSpec) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *MetricSpec) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *MetricSpec) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	w.RawString("\"type\":")
+	w.String(string(in.Type))
+	if in.Object != nil {
+		w.RawString(",\"object\":")
+		in.Object.MarshalEasyJSON(w)
+	}
+	if in.Pods != nil {
+		w.RawString(",\"pods\":")
+		in.Pods.MarshalEasyJSON(w)
+	}
+	if in.Resource != nil {
+		w.RawString(",\"resource\":")
+		in.Resource.MarshalEasyJSON(w)
+	}
+	if in.ContainerResource != nil {
+		w.RawString(",\"containerResource\":")
+		in.ContainerResource.MarshalEasyJSON(w)
+	}
+	if in.External != nil {
+		w.RawString(",\"external\":")
+		in.External.MarshalEasyJSON(w)
+	}
+
+	w.RawByte('}')
+}
+
+func (in *MetricSpec) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "type":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.Type = MetricSourceType(l.String())
+			}
+		case "object":
+			if l.IsNull() {
+				l.Skip()
+				in.Object = nil
+			} else {
+				if in.Object == nil {
+					in.Object = new(ObjectMetricSource)
+				}
+				in.Object.UnmarshalEasyJSON(l)
+			}
+		case "pods":
+			if l.IsNull() {
+				l.Skip()
+				in.Pods = nil
+			} else {
+				if in.Pods == nil {
+					in.Pods = new(PodsMetricSource)
+				}
+				in.Pods.UnmarshalEasyJSON(l)
+			}
+		case "resource":
+			if l.IsNull() {
+				l.Skip()
+				in.Resource = nil
+			} else {
+				if in.Resource == nil {
+					in.Resource = new(ResourceMetricSource)
+				}
+				in.Resource.UnmarshalEasyJSON(l)
+			}
+		case "containerResource":
+			if l.IsNull() {
+				l.Skip()
+				in.ContainerResource = nil
+			} else {
+				if in.ContainerResource == nil {
+					in.ContainerResource = new(ContainerResourceMetricSource)
+				}
+				in.ContainerResource.UnmarshalEasyJSON(l)
+			}
+		case "external":
+			if l.IsNull() {
+				l.Skip()
+				in.External = nil
+			} else {
+				if in.External == nil {
+					in.External = new(ExternalMetricSource)
+				}
+				in.External.UnmarshalEasyJSON(l)
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
+
 type HorizontalPodAutoscalerBehavior struct {
 	// scaleUp is scaling policy for scaling Up.
 	// If not set, the default value is the higher of:
@@ -378,6 +1393,82 @@ func (in *HorizontalPodAutoscalerBehavior) DeepCopy() *HorizontalPodAutoscalerBe
 	return out
 }
 
+func (in *HorizontalPodAutoscalerBehavior) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *HorizontalPodAutoscalerBehavior) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *HorizontalPodAutoscalerBehavior) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	first := true
+	if in.ScaleUp != nil {
+		w.RawString("\"scaleUp\":")
+		in.ScaleUp.MarshalEasyJSON(w)
+		first = false
+	}
+	if in.ScaleDown != nil {
+		if !first {
+			w.RawByte(',')
+		}
+		w.RawString("\"scaleDown\":")
+		in.ScaleDown.MarshalEasyJSON(w)
+		first = false
+	}
+	w.RawByte('}')
+}
+
+func (in *HorizontalPodAutoscalerBehavior) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "scaleUp":
+			if l.IsNull() {
+				l.Skip()
+				in.ScaleUp = nil
+			} else {
+				if in.ScaleUp == nil {
+					in.ScaleUp = new(HPAScalingRules)
+				}
+				in.ScaleUp.UnmarshalEasyJSON(l)
+			}
+		case "scaleDown":
+			if l.IsNull() {
+				l.Skip()
+				in.ScaleDown = nil
+			} else {
+				if in.ScaleDown == nil {
+					in.ScaleDown = new(HPAScalingRules)
+				}
+				in.ScaleDown.UnmarshalEasyJSON(l)
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
+
 type MetricStatus struct {
 	// type is the type of metric source.  It will be one of "ContainerResource", "External",
 	// "Object", "Pods" or "Resource", each corresponds to a matching field in the object.
@@ -436,15 +1527,136 @@ func (in *MetricStatus) DeepCopyInto(out *MetricStatus) {
 		*out = new(ExternalMetricStatus)
 		(*in).DeepCopyInto(*out)
 	}
-}
-
-func (in *MetricStatus) DeepCopy() *MetricStatus {
-	if in == nil {
-		return nil
+}
+
+func (in *MetricStatus) DeepCopy() *MetricStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(MetricStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *MetricStatus) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *MetricStatus) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *MetricStatus) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	w.RawString("\"type\":")
+	w.String(string(in.Type))
+	if in.Object != nil {
+		w.RawString(",\"object\":")
+		in.Object.MarshalEasyJSON(w)
+	}
+	if in.Pods != nil {
+		w.RawString(",\"pods\":")
+		in.Pods.MarshalEasyJSON(w)
+	}
+	if in.Resource != nil {
+		w.RawString(",\"resource\":")
+		in.Resource.MarshalEasyJSON(w)
+	}
+	if in.ContainerResource != nil {
+		w.RawString(",\"containerResource\":")
+		in.ContainerResource.MarshalEasyJSON(w)
+	}
+	if in.External != nil {
+		w.RawString(",\"external\":")
+		in.External.MarshalEasyJSON(w)
+	}
+
+	w.RawByte('}')
+}
+
+func (in *MetricStatus) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "type":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.Type = MetricSourceType(l.String())
+			}
+		case "object":
+			if l.IsNull() {
+				l.Skip()
+				in.Object = nil
+			} else {
+				if in.Object == nil {
+					in.Object = new(ObjectMetricStatus)
+				}
+				in.Object.UnmarshalEasyJSON(l)
+			}
+		case "pods":
+			if l.IsNull() {
+				l.Skip()
+				in.Pods = nil
+			} else {
+				if in.Pods == nil {
+					in.Pods = new(PodsMetricStatus)
+				}
+				in.Pods.UnmarshalEasyJSON(l)
+			}
+		case "resource":
+			if l.IsNull() {
+				l.Skip()
+				in.Resource = nil
+			} else {
+				if in.Resource == nil {
+					in.Resource = new(ResourceMetricStatus)
+				}
+				in.Resource.UnmarshalEasyJSON(l)
+			}
+		case "containerResource":
+			if l.IsNull() {
+				l.Skip()
+				in.ContainerResource = nil
+			} else {
+				if in.ContainerResource == nil {
+					in.ContainerResource = new(ContainerResourceMetricStatus)
+				}
+				in.ContainerResource.UnmarshalEasyJSON(l)
+			}
+		case "external":
+			if l.IsNull() {
+				l.Skip()
+				in.External = nil
+			} else {
+				if in.External == nil {
+					in.External = new(ExternalMetricStatus)
+				}
+				in.External.UnmarshalEasyJSON(l)
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
 	}
-	out := new(MetricStatus)
-	in.DeepCopyInto(out)
-	return out
 }
 
 type HorizontalPodAutoscalerCondition struct {
@@ -480,6 +1692,91 @@ func (in *HorizontalPodAutoscalerCondition) DeepCopy() *HorizontalPodAutoscalerC
 	return out
 }
 
+func (in *HorizontalPodAutoscalerCondition) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *HorizontalPodAutoscalerCondition) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *HorizontalPodAutoscalerCondition) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	w.RawString("\"type\":")
+	w.String(string(in.Type))
+	w.RawString(",\"status\":")
+	w.String(string(in.Status))
+	if in.LastTransitionTime != nil {
+		w.RawString(",\"lastTransitionTime\":")
+		w.Raw(json.Marshal(in.LastTransitionTime))
+	}
+	if in.Reason != "" {
+		w.RawString(",\"reason\":")
+		w.String(in.Reason)
+	}
+	if in.Message != "" {
+		w.RawString(",\"message\":")
+		w.String(in.Message)
+	}
+
+	w.RawByte('}')
+}
+
+func (in *HorizontalPodAutoscalerCondition) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "type":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.Type = HorizontalPodAutoscalerConditionType(l.String())
+			}
+		case "status":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.Status = corev1.ConditionStatus(l.String())
+			}
+		case "lastTransitionTime":
+			l.AddError(json.Unmarshal(l.Raw(), &in.LastTransitionTime))
+		case "reason":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.Reason = l.String()
+			}
+		case "message":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.Message = l.String()
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
+
 type ObjectMetricSource struct {
 	// describedObject specifies the descriptions of a object,such as kind,name apiVersion
 	DescribedObject CrossVersionObjectReference `json:"describedObject"`
@@ -505,6 +1802,73 @@ func (in *ObjectMetricSource) DeepCopy() *ObjectMetricSource {
 	return out
 }
 
+func (in *ObjectMetricSource) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *ObjectMetricSource) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *ObjectMetricSource) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	w.RawString("\"describedObject\":")
+	in.DescribedObject.MarshalEasyJSON(w)
+	w.RawString(",\"target\":")
+	in.Target.MarshalEasyJSON(w)
+	w.RawString(",\"metric\":")
+	in.Metric.MarshalEasyJSON(w)
+
+	w.RawByte('}')
+}
+
+func (in *ObjectMetricSource) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "describedObject":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.DescribedObject.UnmarshalEasyJSON(l)
+			}
+		case "target":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.Target.UnmarshalEasyJSON(l)
+			}
+		case "metric":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.Metric.UnmarshalEasyJSON(l)
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
+
 type PodsMetricSource struct {
 	// metric identifies the target metric by name and selector
 	Metric MetricIdentifier `json:"metric"`
@@ -527,6 +1891,65 @@ func (in *PodsMetricSource) DeepCopy() *PodsMetricSource {
 	return out
 }
 
+func (in *PodsMetricSource) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *PodsMetricSource) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *PodsMetricSource) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	w.RawString("\"metric\":")
+	in.Metric.MarshalEasyJSON(w)
+	w.RawString(",\"target\":")
+	in.Target.MarshalEasyJSON(w)
+
+	w.RawByte('}')
+}
+
+func (in *PodsMetricSource) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "metric":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.Metric.UnmarshalEasyJSON(l)
+			}
+		case "target":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.Target.UnmarshalEasyJSON(l)
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
+
 type ResourceMetricSource struct {
 	// name is the name of the resource in question.
 	Name corev1.ResourceName `json:"name"`
@@ -548,6 +1971,65 @@ func (in *ResourceMetricSource) DeepCopy() *ResourceMetricSource {
 	return out
 }
 
+func (in *ResourceMetricSource) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *ResourceMetricSource) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *ResourceMetricSource) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	w.RawString("\"name\":")
+	w.String(string(in.Name))
+	w.RawString(",\"target\":")
+	in.Target.MarshalEasyJSON(w)
+
+	w.RawByte('}')
+}
+
+func (in *ResourceMetricSource) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "name":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.Name = corev1.ResourceName(l.String())
+			}
+		case "target":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.Target.UnmarshalEasyJSON(l)
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
+
 type ContainerResourceMetricSource struct {
 	// name is the name of the resource in question.
 	Name corev1.ResourceName `json:"name"`
@@ -571,6 +2053,73 @@ func (in *ContainerResourceMetricSource) DeepCopy() *ContainerResourceMetricSour
 	return out
 }
 
+func (in *ContainerResourceMetricSource) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *ContainerResourceMetricSource) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *ContainerResourceMetricSource) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	w.RawString("\"name\":")
+	w.String(string(in.Name))
+	w.RawString(",\"target\":")
+	in.Target.MarshalEasyJSON(w)
+	w.RawString(",\"container\":")
+	w.String(in.Container)
+
+	w.RawByte('}')
+}
+
+func (in *ContainerResourceMetricSource) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "name":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.Name = corev1.ResourceName(l.String())
+			}
+		case "target":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.Target.UnmarshalEasyJSON(l)
+			}
+		case "container":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.Container = l.String()
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
+
 type ExternalMetricSource struct {
 	// metric identifies the target metric by name and selector
 	Metric MetricIdentifier `json:"metric"`
@@ -593,6 +2142,65 @@ func (in *ExternalMetricSource) DeepCopy() *ExternalMetricSource {
 	return out
 }
 
+func (in *ExternalMetricSource) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *ExternalMetricSource) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *ExternalMetricSource) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	w.RawString("\"metric\":")
+	in.Metric.MarshalEasyJSON(w)
+	w.RawString(",\"target\":")
+	in.Target.MarshalEasyJSON(w)
+
+	w.RawByte('}')
+}
+
+func (in *ExternalMetricSource) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "metric":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.Metric.UnmarshalEasyJSON(l)
+			}
+		case "target":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.Target.UnmarshalEasyJSON(l)
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
+
 type HPAScalingRules struct {
 	// stabilizationWindowSeconds is the number of seconds for which past recommendations should be
 	// considered while scaling up or scaling down.
@@ -646,6 +2254,115 @@ func (in *HPAScalingRules) DeepCopy() *HPAScalingRules {
 	return out
 }
 
+func (in *HPAScalingRules) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *HPAScalingRules) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *HPAScalingRules) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	first := true
+	if in.StabilizationWindowSeconds != 0 {
+		w.RawString("\"stabilizationWindowSeconds\":")
+		w.Int(in.StabilizationWindowSeconds)
+		first = false
+	}
+	if in.SelectPolicy != "" {
+		if !first {
+			w.RawByte(',')
+		}
+		w.RawString("\"selectPolicy\":")
+		w.String(string(in.SelectPolicy))
+		first = false
+	}
+	if !first {
+		w.RawByte(',')
+	}
+	w.RawString("\"policies\":")
+	if in.Policies == nil {
+		w.RawString("null")
+	} else {
+		w.RawByte('[')
+		for i0 := range in.Policies {
+			if i0 > 0 {
+				w.RawByte(',')
+			}
+			in.Policies[i0].MarshalEasyJSON(w)
+		}
+		w.RawByte(']')
+	}
+	if in.Tolerance != nil {
+		w.RawString(",\"tolerance\":")
+		w.Raw(json.Marshal(in.Tolerance))
+	}
+	w.RawByte('}')
+}
+
+func (in *HPAScalingRules) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "stabilizationWindowSeconds":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.StabilizationWindowSeconds = l.Int()
+			}
+		case "selectPolicy":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.SelectPolicy = ScalingPolicySelect(l.String())
+			}
+		case "policies":
+			if l.IsNull() {
+				l.Skip()
+				in.Policies = nil
+			} else {
+				in.Policies = make([]HPAScalingPolicy, 0)
+				l.Delim('[')
+				for !l.IsDelim(']') {
+					var v0 HPAScalingPolicy
+					if l.IsNull() {
+						l.Skip()
+					} else {
+						v0.UnmarshalEasyJSON(l)
+					}
+					in.Policies = append(in.Policies, v0)
+					l.WantComma()
+				}
+				l.Delim(']')
+			}
+		case "tolerance":
+			l.AddError(json.Unmarshal(l.Raw(), &in.Tolerance))
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
+
 type ObjectMetricStatus struct {
 	// metric identifies the target metric by name and selector
 	Metric MetricIdentifier `json:"metric"`
@@ -671,6 +2388,73 @@ func (in *ObjectMetricStatus) DeepCopy() *ObjectMetricStatus {
 	return out
 }
 
+func (in *ObjectMetricStatus) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *ObjectMetricStatus) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *ObjectMetricStatus) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	w.RawString("\"metric\":")
+	in.Metric.MarshalEasyJSON(w)
+	w.RawString(",\"current\":")
+	in.Current.MarshalEasyJSON(w)
+	w.RawString(",\"describedObject\":")
+	in.DescribedObject.MarshalEasyJSON(w)
+
+	w.RawByte('}')
+}
+
+func (in *ObjectMetricStatus) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "metric":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.Metric.UnmarshalEasyJSON(l)
+			}
+		case "current":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.Current.UnmarshalEasyJSON(l)
+			}
+		case "describedObject":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.DescribedObject.UnmarshalEasyJSON(l)
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
+
 type PodsMetricStatus struct {
 	// metric identifies the target metric by name and selector
 	Metric MetricIdentifier `json:"metric"`
@@ -693,6 +2477,65 @@ func (in *PodsMetricStatus) DeepCopy() *PodsMetricStatus {
 	return out
 }
 
+func (in *PodsMetricStatus) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *PodsMetricStatus) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *PodsMetricStatus) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	w.RawString("\"metric\":")
+	in.Metric.MarshalEasyJSON(w)
+	w.RawString(",\"current\":")
+	in.Current.MarshalEasyJSON(w)
+
+	w.RawByte('}')
+}
+
+func (in *PodsMetricStatus) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "metric":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.Metric.UnmarshalEasyJSON(l)
+			}
+		case "current":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.Current.UnmarshalEasyJSON(l)
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
+
 type ResourceMetricStatus struct {
 	// name is the name of the resource in question.
 	Name corev1.ResourceName `json:"name"`
@@ -714,6 +2557,65 @@ func (in *ResourceMetricStatus) DeepCopy() *ResourceMetricStatus {
 	return out
 }
 
+func (in *ResourceMetricStatus) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *ResourceMetricStatus) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *ResourceMetricStatus) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	w.RawString("\"name\":")
+	w.String(string(in.Name))
+	w.RawString(",\"current\":")
+	in.Current.MarshalEasyJSON(w)
+
+	w.RawByte('}')
+}
+
+func (in *ResourceMetricStatus) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "name":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.Name = corev1.ResourceName(l.String())
+			}
+		case "current":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.Current.UnmarshalEasyJSON(l)
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
+
 type ContainerResourceMetricStatus struct {
 	// name is the name of the resource in question.
 	Name corev1.ResourceName `json:"name"`
@@ -737,6 +2639,73 @@ func (in *ContainerResourceMetricStatus) DeepCopy() *ContainerResourceMetricStat
 	return out
 }
 
+func (in *ContainerResourceMetricStatus) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *ContainerResourceMetricStatus) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *ContainerResourceMetricStatus) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	w.RawString("\"name\":")
+	w.String(string(in.Name))
+	w.RawString(",\"current\":")
+	in.Current.MarshalEasyJSON(w)
+	w.RawString(",\"container\":")
+	w.String(in.Container)
+
+	w.RawByte('}')
+}
+
+func (in *ContainerResourceMetricStatus) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "name":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.Name = corev1.ResourceName(l.String())
+			}
+		case "current":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.Current.UnmarshalEasyJSON(l)
+			}
+		case "container":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.Container = l.String()
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
+
 type ExternalMetricStatus struct {
 	// metric identifies the target metric by name and selector
 	Metric MetricIdentifier `json:"metric"`
@@ -759,6 +2728,65 @@ func (in *ExternalMetricStatus) DeepCopy() *ExternalMetricStatus {
 	return out
 }
 
+func (in *ExternalMetricStatus) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *ExternalMetricStatus) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *ExternalMetricStatus) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	w.RawString("\"metric\":")
+	in.Metric.MarshalEasyJSON(w)
+	w.RawString(",\"current\":")
+	in.Current.MarshalEasyJSON(w)
+
+	w.RawByte('}')
+}
+
+func (in *ExternalMetricStatus) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "metric":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.Metric.UnmarshalEasyJSON(l)
+			}
+		case "current":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.Current.UnmarshalEasyJSON(l)
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
+
 type MetricTarget struct {
 	// type represents whether the metric type is Utilization, Value, or AverageValue
 	Type MetricTargetType `json:"type"`
@@ -797,6 +2825,79 @@ func (in *MetricTarget) DeepCopy() *MetricTarget {
 	return out
 }
 
+func (in *MetricTarget) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *MetricTarget) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *MetricTarget) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	w.RawString("\"type\":")
+	w.String(string(in.Type))
+	if in.Value != nil {
+		w.RawString(",\"value\":")
+		w.Raw(json.Marshal(in.Value))
+	}
+	if in.AverageValue != nil {
+		w.RawString(",\"averageValue\":")
+		w.Raw(json.Marshal(in.AverageValue))
+	}
+	if in.AverageUtilization != 0 {
+		w.RawString(",\"averageUtilization\":")
+		w.Int(in.AverageUtilization)
+	}
+
+	w.RawByte('}')
+}
+
+func (in *MetricTarget) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "type":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.Type = MetricTargetType(l.String())
+			}
+		case "value":
+			l.AddError(json.Unmarshal(l.Raw(), &in.Value))
+		case "averageValue":
+			l.AddError(json.Unmarshal(l.Raw(), &in.AverageValue))
+		case "averageUtilization":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.AverageUtilization = l.Int()
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
+
 type MetricIdentifier struct {
 	// name is the name of the given metric
 	Name string `json:"name"`
@@ -824,6 +2925,71 @@ func (in *MetricIdentifier) DeepCopy() *MetricIdentifier {
 	return out
 }
 
+func (in *MetricIdentifier) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *MetricIdentifier) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *MetricIdentifier) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	w.RawString("\"name\":")
+	w.String(in.Name)
+	if in.Selector != nil {
+		w.RawString(",\"selector\":")
+		in.Selector.MarshalEasyJSON(w)
+	}
+
+	w.RawByte('}')
+}
+
+func (in *MetricIdentifier) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "name":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.Name = l.String()
+			}
+		case "selector":
+			if l.IsNull() {
+				l.Skip()
+				in.Selector = nil
+			} else {
+				if in.Selector == nil {
+					in.Selector = new(metav1.LabelSelector)
+				}
+				in.Selector.UnmarshalEasyJSON(l)
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
+
 type HPAScalingPolicy struct {
 	// type is used to specify the scaling policy.
 	Type HPAScalingPolicyType `json:"type"`
@@ -848,6 +3014,73 @@ func (in *HPAScalingPolicy) DeepCopy() *HPAScalingPolicy {
 	return out
 }
 
+func (in *HPAScalingPolicy) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *HPAScalingPolicy) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *HPAScalingPolicy) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	w.RawString("\"type\":")
+	w.String(string(in.Type))
+	w.RawString(",\"value\":")
+	w.Int(in.Value)
+	w.RawString(",\"periodSeconds\":")
+	w.Int(in.PeriodSeconds)
+
+	w.RawByte('}')
+}
+
+func (in *HPAScalingPolicy) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "type":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.Type = HPAScalingPolicyType(l.String())
+			}
+		case "value":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.Value = l.Int()
+			}
+		case "periodSeconds":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.PeriodSeconds = l.Int()
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
+
 type MetricValueStatus struct {
 	// value is the current value of the metric (as a quantity).
 	Value *apiresource.Quantity `json:"value,omitempty"`
@@ -882,3 +3115,77 @@ func (in *MetricValueStatus) DeepCopy() *MetricValueStatus {
 	in.DeepCopyInto(out)
 	return out
 }
+
+func (in *MetricValueStatus) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *MetricValueStatus) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *MetricValueStatus) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	first := true
+	if in.Value != nil {
+		w.RawString("\"value\":")
+		w.Raw(json.Marshal(in.Value))
+		first = false
+	}
+	if in.AverageValue != nil {
+		if !first {
+			w.RawByte(',')
+		}
+		w.RawString("\"averageValue\":")
+		w.Raw(json.Marshal(in.AverageValue))
+		first = false
+	}
+	if in.AverageUtilization != 0 {
+		if !first {
+			w.RawByte(',')
+		}
+		w.RawString("\"averageUtilization\":")
+		w.Int(in.AverageUtilization)
+		first = false
+	}
+	w.RawByte('}')
+}
+
+func (in *MetricValueStatus) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "value":
+			l.AddError(json.Unmarshal(l.Raw(), &in.Value))
+		case "averageValue":
+			l.AddError(json.Unmarshal(l.Raw(), &in.AverageValue))
+		case "averageUtilization":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.AverageUtilization = l.Int()
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
@@ -1,8 +1,13 @@
 package batchv1
 
 import (
+	"encoding/json"
+	"github.com/mailru/easyjson/jlexer"
+	"github.com/mailru/easyjson/jwriter"
 	"go.f110.dev/kubeproto/go/apis/corev1"
 	"go.f110.dev/kubeproto/go/apis/metav1"
+	"go.f110.dev/kubeproto/go/patch"
+	"k8s.io/apimachinery/pkg/fields"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 )
@@ -118,6 +123,503 @@ func (in *CronJob) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// CronJobToSelectableFields returns the fields of CronJob which can be used by the field selector.
+func CronJobToSelectableFields(obj *CronJob) fields.Set {
+	set := fields.Set{
+		"metadata.name":      obj.ObjectMeta.Name,
+		"metadata.namespace": obj.ObjectMeta.Namespace,
+	}
+	return set
+}
+
+// CronJobPatch builds JSON patch or JSON merge patch of CronJob.
+// JSON patch fails if the parent of the field doesn't exist (e.g. SetLabel to the object which has no label). MergePatch creates the parent.
+type CronJobPatch struct {
+	*patch.Builder
+}
+
+func NewCronJobPatch() *CronJobPatch {
+	return &CronJobPatch{Builder: patch.NewBuilder()}
+}
+
+func (p *CronJobPatch) SetLabel(key, value string) *CronJobPatch {
+	p.Set([]string{"metadata", "labels", key}, value)
+	return p
+}
+
+func (p *CronJobPatch) RemoveLabel(key string) *CronJobPatch {
+	p.Remove([]string{"metadata", "labels", key})
+	return p
+}
+
+func (p *CronJobPatch) SetAnnotation(key, value string) *CronJobPatch {
+	p.Set([]string{"metadata", "annotations", key}, value)
+	return p
+}
+
+func (p *CronJobPatch) RemoveAnnotation(key string) *CronJobPatch {
+	p.Remove([]string{"metadata", "annotations", key})
+	return p
+}
+
+func (p *CronJobPatch) SetSpec(v *CronJobSpec) *CronJobPatch {
+	p.Set([]string{"spec"}, v)
+	return p
+}
+
+func (p *CronJobPatch) RemoveSpec() *CronJobPatch {
+	p.Remove([]string{"spec"})
+	return p
+}
+
+func (p *CronJobPatch) SetSpecSchedule(v string) *CronJobPatch {
+	p.Set([]string{"spec", "schedule"}, v)
+	return p
+}
+
+func (p *CronJobPatch) RemoveSpecSchedule() *CronJobPatch {
+	p.Remove([]string{"spec", "schedule"})
+	return p
+}
+
+func (p *CronJobPatch) SetSpecTimeZone(v string) *CronJobPatch {
+	p.Set([]string{"spec", "timeZone"}, v)
+	return p
+}
+
+func (p *CronJobPatch) RemoveSpecTimeZone() *CronJobPatch {
+	p.Remove([]string{"spec", "timeZone"})
+	return p
+}
+
+func (p *CronJobPatch) SetSpecStartingDeadlineSeconds(v int64) *CronJobPatch {
+	p.Set([]string{"spec", "startingDeadlineSeconds"}, v)
+	return p
+}
+
+func (p *CronJobPatch) RemoveSpecStartingDeadlineSeconds() *CronJobPatch {
+	p.Remove([]string{"spec", "startingDeadlineSeconds"})
+	return p
+}
+
+func (p *CronJobPatch) SetSpecConcurrencyPolicy(v ConcurrencyPolicy) *CronJobPatch {
+	p.Set([]string{"spec", "concurrencyPolicy"}, v)
+	return p
+}
+
+func (p *CronJobPatch) RemoveSpecConcurrencyPolicy() *CronJobPatch {
+	p.Remove([]string{"spec", "concurrencyPolicy"})
+	return p
+}
+
+func (p *CronJobPatch) SetSpecSuspend(v bool) *CronJobPatch {
+	p.Set([]string{"spec", "suspend"}, v)
+	return p
+}
+
+func (p *CronJobPatch) RemoveSpecSuspend() *CronJobPatch {
+	p.Remove([]string{"spec", "suspend"})
+	return p
+}
+
+func (p *CronJobPatch) SetSpecJobTemplate(v JobTemplateSpec) *CronJobPatch {
+	p.Set([]string{"spec", "jobTemplate"}, v)
+	return p
+}
+
+func (p *CronJobPatch) RemoveSpecJobTemplate() *CronJobPatch {
+	p.Remove([]string{"spec", "jobTemplate"})
+	return p
+}
+
+func (p *CronJobPatch) SetSpecJobTemplateObjectMeta(v *metav1.ObjectMeta) *CronJobPatch {
+	p.Set([]string{"spec", "jobTemplate", "metadata"}, v)
+	return p
+}
+
+func (p *CronJobPatch) RemoveSpecJobTemplateObjectMeta() *CronJobPatch {
+	p.Remove([]string{"spec", "jobTemplate", "metadata"})
+	return p
+}
+
+func (p *CronJobPatch) SetSpecJobTemplateSpec(v *JobSpec) *CronJobPatch {
+	p.Set([]string{"spec", "jobTemplate", "spec"}, v)
+	return p
+}
+
+func (p *CronJobPatch) RemoveSpecJobTemplateSpec() *CronJobPatch {
+	p.Remove([]string{"spec", "jobTemplate", "spec"})
+	return p
+}
+
+func (p *CronJobPatch) SetSpecJobTemplateSpecParallelism(v int) *CronJobPatch {
+	p.Set([]string{"spec", "jobTemplate", "spec", "parallelism"}, v)
+	return p
+}
+
+func (p *CronJobPatch) RemoveSpecJobTemplateSpecParallelism() *CronJobPatch {
+	p.Remove([]string{"spec", "jobTemplate", "spec", "parallelism"})
+	return p
+}
+
+func (p *CronJobPatch) SetSpecJobTemplateSpecCompletions(v int) *CronJobPatch {
+	p.Set([]string{"spec", "jobTemplate", "spec", "completions"}, v)
+	return p
+}
+
+func (p *CronJobPatch) RemoveSpecJobTemplateSpecCompletions() *CronJobPatch {
+	p.Remove([]string{"spec", "jobTemplate", "spec", "completions"})
+	return p
+}
+
+func (p *CronJobPatch) SetSpecJobTemplateSpecActiveDeadlineSeconds(v int64) *CronJobPatch {
+	p.Set([]string{"spec", "jobTemplate", "spec", "activeDeadlineSeconds"}, v)
+	return p
+}
+
+func (p *CronJobPatch) RemoveSpecJobTemplateSpecActiveDeadlineSeconds() *CronJobPatch {
+	p.Remove([]string{"spec", "jobTemplate", "spec", "activeDeadlineSeconds"})
+	return p
+}
+
+func (p *CronJobPatch) SetSpecJobTemplateSpecPodFailurePolicy(v *PodFailurePolicy) *CronJobPatch {
+	p.Set([]string{"spec", "jobTemplate", "spec", "podFailurePolicy"}, v)
+	return p
+}
+
+func (p *CronJobPatch) RemoveSpecJobTemplateSpecPodFailurePolicy() *CronJobPatch {
+	p.Remove([]string{"spec", "jobTemplate", "spec", "podFailurePolicy"})
+	return p
+}
+
+func (p *CronJobPatch) SetSpecJobTemplateSpecPodFailurePolicyRules(v []PodFailurePolicyRule) *CronJobPatch {
+	p.Set([]string{"spec", "jobTemplate", "spec", "podFailurePolicy", "rules"}, v)
+	return p
+}
+
+func (p *CronJobPatch) RemoveSpecJobTemplateSpecPodFailurePolicyRules() *CronJobPatch {
+	p.Remove([]string{"spec", "jobTemplate", "spec", "podFailurePolicy", "rules"})
+	return p
+}
+
+func (p *CronJobPatch) AddSpecJobTemplateSpecPodFailurePolicyRules(v ...PodFailurePolicyRule) *CronJobPatch {
+	for _, e := range v {
+		p.Append([]string{"spec", "jobTemplate", "spec", "podFailurePolicy", "rules"}, e)
+	}
+	return p
+}
+
+func (p *CronJobPatch) SetSpecJobTemplateSpecSuccessPolicy(v *SuccessPolicy) *CronJobPatch {
+	p.Set([]string{"spec", "jobTemplate", "spec", "successPolicy"}, v)
+	return p
+}
+
+func (p *CronJobPatch) RemoveSpecJobTemplateSpecSuccessPolicy() *CronJobPatch {
+	p.Remove([]string{"spec", "jobTemplate", "spec", "successPolicy"})
+	return p
+}
+
+func (p *CronJobPatch) SetSpecJobTemplateSpecSuccessPolicyRules(v []SuccessPolicyRule) *CronJobPatch {
+	p.Set([]string{"spec", "jobTemplate", "spec", "successPolicy", "rules"}, v)
+	return p
+}
+
+func (p *CronJobPatch) RemoveSpecJobTemplateSpecSuccessPolicyRules() *CronJobPatch {
+	p.Remove([]string{"spec", "jobTemplate", "spec", "successPolicy", "rules"})
+	return p
+}
+
+func (p *CronJobPatch) AddSpecJobTemplateSpecSuccessPolicyRules(v ...SuccessPolicyRule) *CronJobPatch {
+	for _, e := range v {
+		p.Append([]string{"spec", "jobTemplate", "spec", "successPolicy", "rules"}, e)
+	}
+	return p
+}
+
+func (p *CronJobPatch) SetSpecJobTemplateSpecBackoffLimit(v int) *CronJobPatch {
+	p.Set([]string{"spec", "jobTemplate", "spec", "backoffLimit"}, v)
+	return p
+}
+
+func (p *CronJobPatch) RemoveSpecJobTemplateSpecBackoffLimit() *CronJobPatch {
+	p.Remove([]string{"spec", "jobTemplate", "spec", "backoffLimit"})
+	return p
+}
+
+func (p *CronJobPatch) SetSpecJobTemplateSpecBackoffLimitPerIndex(v int) *CronJobPatch {
+	p.Set([]string{"spec", "jobTemplate", "spec", "backoffLimitPerIndex"}, v)
+	return p
+}
+
+func (p *CronJobPatch) RemoveSpecJobTemplateSpecBackoffLimitPerIndex() *CronJobPatch {
+	p.Remove([]string{"spec", "jobTemplate", "spec", "backoffLimitPerIndex"})
+	return p
+}
+
+func (p *CronJobPatch) SetSpecJobTemplateSpecMaxFailedIndexes(v int) *CronJobPatch {
+	p.Set([]string{"spec", "jobTemplate", "spec", "maxFailedIndexes"}, v)
+	return p
+}
+
+func (p *CronJobPatch) RemoveSpecJobTemplateSpecMaxFailedIndexes() *CronJobPatch {
+	p.Remove([]string{"spec", "jobTemplate", "spec", "maxFailedIndexes"})
+	return p
+}
+
+func (p *CronJobPatch) SetSpecJobTemplateSpecSelector(v *metav1.LabelSelector) *CronJobPatch {
+	p.Set([]string{"spec", "jobTemplate", "spec", "selector"}, v)
+	return p
+}
+
+func (p *CronJobPatch) RemoveSpecJobTemplateSpecSelector() *CronJobPatch {
+	p.Remove([]string{"spec", "jobTemplate", "spec", "selector"})
+	return p
+}
+
+func (p *CronJobPatch) SetSpecJobTemplateSpecManualSelector(v bool) *CronJobPatch {
+	p.Set([]string{"spec", "jobTemplate", "spec", "manualSelector"}, v)
+	return p
+}
+
+func (p *CronJobPatch) RemoveSpecJobTemplateSpecManualSelector() *CronJobPatch {
+	p.Remove([]string{"spec", "jobTemplate", "spec", "manualSelector"})
+	return p
+}
+
+func (p *CronJobPatch) SetSpecJobTemplateSpecTemplate(v corev1.PodTemplateSpec) *CronJobPatch {
+	p.Set([]string{"spec", "jobTemplate", "spec", "template"}, v)
+	return p
+}
+
+func (p *CronJobPatch) RemoveSpecJobTemplateSpecTemplate() *CronJobPatch {
+	p.Remove([]string{"spec", "jobTemplate", "spec", "template"})
+	return p
+}
+
+func (p *CronJobPatch) SetSpecJobTemplateSpecTTLSecondsAfterFinished(v int) *CronJobPatch {
+	p.Set([]string{"spec", "jobTemplate", "spec", "ttlSecondsAfterFinished"}, v)
+	return p
+}
+
+func (p *CronJobPatch) RemoveSpecJobTemplateSpecTTLSecondsAfterFinished() *CronJobPatch {
+	p.Remove([]string{"spec", "jobTemplate", "spec", "ttlSecondsAfterFinished"})
+	return p
+}
+
+func (p *CronJobPatch) SetSpecJobTemplateSpecCompletionMode(v CompletionMode) *CronJobPatch {
+	p.Set([]string{"spec", "jobTemplate", "spec", "completionMode"}, v)
+	return p
+}
+
+func (p *CronJobPatch) RemoveSpecJobTemplateSpecCompletionMode() *CronJobPatch {
+	p.Remove([]string{"spec", "jobTemplate", "spec", "completionMode"})
+	return p
+}
+
+func (p *CronJobPatch) SetSpecJobTemplateSpecSuspend(v bool) *CronJobPatch {
+	p.Set([]string{"spec", "jobTemplate", "spec", "suspend"}, v)
+	return p
+}
+
+func (p *CronJobPatch) RemoveSpecJobTemplateSpecSuspend() *CronJobPatch {
+	p.Remove([]string{"spec", "jobTemplate", "spec", "suspend"})
+	return p
+}
+
+func (p *CronJobPatch) SetSpecJobTemplateSpecPodReplacementPolicy(v PodReplacementPolicy) *CronJobPatch {
+	p.Set([]string{"spec", "jobTemplate", "spec", "podReplacementPolicy"}, v)
+	return p
+}
+
+func (p *CronJobPatch) RemoveSpecJobTemplateSpecPodReplacementPolicy() *CronJobPatch {
+	p.Remove([]string{"spec", "jobTemplate", "spec", "podReplacementPolicy"})
+	return p
+}
+
+func (p *CronJobPatch) SetSpecJobTemplateSpecManagedBy(v string) *CronJobPatch {
+	p.Set([]string{"spec", "jobTemplate", "spec", "managedBy"}, v)
+	return p
+}
+
+func (p *CronJobPatch) RemoveSpecJobTemplateSpecManagedBy() *CronJobPatch {
+	p.Remove([]string{"spec", "jobTemplate", "spec", "managedBy"})
+	return p
+}
+
+func (p *CronJobPatch) SetSpecSuccessfulJobsHistoryLimit(v int) *CronJobPatch {
+	p.Set([]string{"spec", "successfulJobsHistoryLimit"}, v)
+	return p
+}
+
+func (p *CronJobPatch) RemoveSpecSuccessfulJobsHistoryLimit() *CronJobPatch {
+	p.Remove([]string{"spec", "successfulJobsHistoryLimit"})
+	return p
+}
+
+func (p *CronJobPatch) SetSpecFailedJobsHistoryLimit(v int) *CronJobPatch {
+	p.Set([]string{"spec", "failedJobsHistoryLimit"}, v)
+	return p
+}
+
+func (p *CronJobPatch) RemoveSpecFailedJobsHistoryLimit() *CronJobPatch {
+	p.Remove([]string{"spec", "failedJobsHistoryLimit"})
+	return p
+}
+
+func (p *CronJobPatch) SetStatus(v *CronJobStatus) *CronJobPatch {
+	p.Set([]string{"status"}, v)
+	return p
+}
+
+func (p *CronJobPatch) RemoveStatus() *CronJobPatch {
+	p.Remove([]string{"status"})
+	return p
+}
+
+func (p *CronJobPatch) SetStatusActive(v []corev1.ObjectReference) *CronJobPatch {
+	p.Set([]string{"status", "active"}, v)
+	return p
+}
+
+func (p *CronJobPatch) RemoveStatusActive() *CronJobPatch {
+	p.Remove([]string{"status", "active"})
+	return p
+}
+
+func (p *CronJobPatch) AddStatusActive(v ...corev1.ObjectReference) *CronJobPatch {
+	for _, e := range v {
+		p.Append([]string{"status", "active"}, e)
+	}
+	return p
+}
+
+func (p *CronJobPatch) SetStatusLastScheduleTime(v *metav1.Time) *CronJobPatch {
+	p.Set([]string{"status", "lastScheduleTime"}, v)
+	return p
+}
+
+func (p *CronJobPatch) RemoveStatusLastScheduleTime() *CronJobPatch {
+	p.Remove([]string{"status", "lastScheduleTime"})
+	return p
+}
+
+func (p *CronJobPatch) SetStatusLastSuccessfulTime(v *metav1.Time) *CronJobPatch {
+	p.Set([]string{"status", "lastSuccessfulTime"}, v)
+	return p
+}
+
+func (p *CronJobPatch) RemoveStatusLastSuccessfulTime() *CronJobPatch {
+	p.Remove([]string{"status", "lastSuccessfulTime"})
+	return p
+}
+
+func (in *CronJob) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *CronJob) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *CronJob) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	first := true
+	if in.TypeMeta.Kind != "" {
+		w.RawString("\"kind\":")
+		w.String(in.TypeMeta.Kind)
+		first = false
+	}
+	if in.TypeMeta.APIVersion != "" {
+		if !first {
+			w.RawByte(',')
+		}
+		w.RawString("\"apiVersion\":")
+		w.String(in.TypeMeta.APIVersion)
+		first = false
+	}
+	if !first {
+		w.RawByte(',')
+	}
+	w.RawString("\"metadata\":")
+	in.ObjectMeta.MarshalEasyJSON(w)
+	if in.Spec != nil {
+		w.RawString(",\"spec\":")
+		in.Spec.MarshalEasyJSON(w)
+	}
+	if in.Status != nil {
+		w.RawString(",\"status\":")
+		in.Status.MarshalEasyJSON(w)
+	}
+	w.RawByte('}')
+}
+
+func (in *CronJob) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "kind":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.TypeMeta.Kind = l.String()
+			}
+		case "apiVersion":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.TypeMeta.APIVersion = l.String()
+			}
+		case "metadata":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.ObjectMeta.UnmarshalEasyJSON(l)
+			}
+		case "spec":
+			if l.IsNull() {
+				l.Skip()
+				in.Spec = nil
+			} else {
+				if in.Spec == nil {
+					in.Spec = new(CronJobSpec)
+				}
+				in.Spec.UnmarshalEasyJSON(l)
+			}
+		case "status":
+			if l.IsNull() {
+				l.Skip()
+				in.Status = nil
+			} else {
+				if in.Status == nil {
+					in.Status = new(CronJobStatus)
+				}
+				in.Status.UnmarshalEasyJSON(l)
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
+
 type CronJobList struct {
 	metav1.TypeMeta `json:",inline"`
 	metav1.ListMeta `json:"metadata"`
@@ -153,6 +655,117 @@ func (in *CronJobList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+func (in *CronJobList) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *CronJobList) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *CronJobList) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	first := true
+	if in.TypeMeta.Kind != "" {
+		w.RawString("\"kind\":")
+		w.String(in.TypeMeta.Kind)
+		first = false
+	}
+	if in.TypeMeta.APIVersion != "" {
+		if !first {
+			w.RawByte(',')
+		}
+		w.RawString("\"apiVersion\":")
+		w.String(in.TypeMeta.APIVersion)
+		first = false
+	}
+	if !first {
+		w.RawByte(',')
+	}
+	w.RawString("\"metadata\":")
+	in.ListMeta.MarshalEasyJSON(w)
+	w.RawString(",\"items\":")
+	if in.Items == nil {
+		w.RawString("null")
+	} else {
+		w.RawByte('[')
+		for i0 := range in.Items {
+			if i0 > 0 {
+				w.RawByte(',')
+			}
+			in.Items[i0].MarshalEasyJSON(w)
+		}
+		w.RawByte(']')
+	}
+	w.RawByte('}')
+}
+
+func (in *CronJobList) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "kind":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.TypeMeta.Kind = l.String()
+			}
+		case "apiVersion":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.TypeMeta.APIVersion = l.String()
+			}
+		case "metadata":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.ListMeta.UnmarshalEasyJSON(l)
+			}
+		case "items":
+			if l.IsNull() {
+				l.Skip()
+				in.Items = nil
+			} else {
+				in.Items = make([]CronJob, 0)
+				l.Delim('[')
+				for !l.IsDelim(']') {
+					var v0 CronJob
+					if l.IsNull() {
+						l.Skip()
+					} else {
+						v0.UnmarshalEasyJSON(l)
+					}
+					in.Items = append(in.Items, v0)
+					l.WantComma()
+				}
+				l.Delim(']')
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
+
 type Job struct {
 	metav1.TypeMeta   `json:",inline"`
 	metav1.ObjectMeta `json:"metadata"`
@@ -196,6 +809,517 @@ func (in *Job) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// JobToSelectableFields returns the fields of Job which can be used by the field selector.
+func JobToSelectableFields(obj *Job) fields.Set {
+	set := fields.Set{
+		"metadata.name":      obj.ObjectMeta.Name,
+		"metadata.namespace": obj.ObjectMeta.Namespace,
+	}
+	return set
+}
+
+// JobPatch builds JSON patch or JSON merge patch of Job.
+// JSON patch fails if the parent of the field doesn't exist (e.g. SetLabel to the object which has no label). MergePatch creates the parent.
+type JobPatch struct {
+	*patch.Builder
+}
+
+func NewJobPatch() *JobPatch {
+	return &JobPatch{Builder: patch.NewBuilder()}
+}
+
+func (p *JobPatch) SetLabel(key, value string) *JobPatch {
+	p.Set([]string{"metadata", "labels", key}, value)
+	return p
+}
+
+func (p *JobPatch) RemoveLabel(key string) *JobPatch {
+	p.Remove([]string{"metadata", "labels", key})
+	return p
+}
+
+func (p *JobPatch) SetAnnotation(key, value string) *JobPatch {
+	p.Set([]string{"metadata", "annotations", key}, value)
+	return p
+}
+
+func (p *JobPatch) RemoveAnnotation(key string) *JobPatch {
+	p.Remove([]string{"metadata", "annotations", key})
+	return p
+}
+
+func (p *JobPatch) SetSpec(v *JobSpec) *JobPatch {
+	p.Set([]string{"spec"}, v)
+	return p
+}
+
+func (p *JobPatch) RemoveSpec() *JobPatch {
+	p.Remove([]string{"spec"})
+	return p
+}
+
+func (p *JobPatch) SetSpecParallelism(v int) *JobPatch {
+	p.Set([]string{"spec", "parallelism"}, v)
+	return p
+}
+
+func (p *JobPatch) RemoveSpecParallelism() *JobPatch {
+	p.Remove([]string{"spec", "parallelism"})
+	return p
+}
+
+func (p *JobPatch) SetSpecCompletions(v int) *JobPatch {
+	p.Set([]string{"spec", "completions"}, v)
+	return p
+}
+
+func (p *JobPatch) RemoveSpecCompletions() *JobPatch {
+	p.Remove([]string{"spec", "completions"})
+	return p
+}
+
+func (p *JobPatch) SetSpecActiveDeadlineSeconds(v int64) *JobPatch {
+	p.Set([]string{"spec", "activeDeadlineSeconds"}, v)
+	return p
+}
+
+func (p *JobPatch) RemoveSpecActiveDeadlineSeconds() *JobPatch {
+	p.Remove([]string{"spec", "activeDeadlineSeconds"})
+	return p
+}
+
+func (p *JobPatch) SetSpecPodFailurePolicy(v *PodFailurePolicy) *JobPatch {
+	p.Set([]string{"spec", "podFailurePolicy"}, v)
+	return p
+}
+
+func (p *JobPatch) RemoveSpecPodFailurePolicy() *JobPatch {
+	p.Remove([]string{"spec", "podFailurePolicy"})
+	return p
+}
+
+func (p *JobPatch) SetSpecPodFailurePolicyRules(v []PodFailurePolicyRule) *JobPatch {
+	p.Set([]string{"spec", "podFailurePolicy", "rules"}, v)
+	return p
+}
+
+func (p *JobPatch) RemoveSpecPodFailurePolicyRules() *JobPatch {
+	p.Remove([]string{"spec", "podFailurePolicy", "rules"})
+	return p
+}
+
+func (p *JobPatch) AddSpecPodFailurePolicyRules(v ...PodFailurePolicyRule) *JobPatch {
+	for _, e := range v {
+		p.Append([]string{"spec", "podFailurePolicy", "rules"}, e)
+	}
+	return p
+}
+
+func (p *JobPatch) SetSpecSuccessPolicy(v *SuccessPolicy) *JobPatch {
+	p.Set([]string{"spec", "successPolicy"}, v)
+	return p
+}
+
+func (p *JobPatch) RemoveSpecSuccessPolicy() *JobPatch {
+	p.Remove([]string{"spec", "successPolicy"})
+	return p
+}
+
+func (p *JobPatch) SetSpecSuccessPolicyRules(v []SuccessPolicyRule) *JobPatch {
+	p.Set([]string{"spec", "successPolicy", "rules"}, v)
+	return p
+}
+
+func (p *JobPatch) RemoveSpecSuccessPolicyRules() *JobPatch {
+	p.Remove([]string{"spec", "successPolicy", "rules"})
+	return p
+}
+
+func (p *JobPatch) AddSpecSuccessPolicyRules(v ...SuccessPolicyRule) *JobPatch {
+	for _, e := range v {
+		p.Append([]string{"spec", "successPolicy", "rules"}, e)
+	}
+	return p
+}
+
+func (p *JobPatch) SetSpecBackoffLimit(v int) *JobPatch {
+	p.Set([]string{"spec", "backoffLimit"}, v)
+	return p
+}
+
+func (p *JobPatch) RemoveSpecBackoffLimit() *JobPatch {
+	p.Remove([]string{"spec", "backoffLimit"})
+	return p
+}
+
+func (p *JobPatch) SetSpecBackoffLimitPerIndex(v int) *JobPatch {
+	p.Set([]string{"spec", "backoffLimitPerIndex"}, v)
+	return p
+}
+
+func (p *JobPatch) RemoveSpecBackoffLimitPerIndex() *JobPatch {
+	p.Remove([]string{"spec", "backoffLimitPerIndex"})
+	return p
+}
+
+func (p *JobPatch) SetSpecMaxFailedIndexes(v int) *JobPatch {
+	p.Set([]string{"spec", "maxFailedIndexes"}, v)
+	return p
+}
+
+func (p *JobPatch) RemoveSpecMaxFailedIndexes() *JobPatch {
+	p.Remove([]string{"spec", "maxFailedIndexes"})
+	return p
+}
+
+func (p *JobPatch) SetSpecSelector(v *metav1.LabelSelector) *JobPatch {
+	p.Set([]string{"spec", "selector"}, v)
+	return p
+}
+
+func (p *JobPatch) RemoveSpecSelector() *JobPatch {
+	p.Remove([]string{"spec", "selector"})
+	return p
+}
+
+func (p *JobPatch) SetSpecManualSelector(v bool) *JobPatch {
+	p.Set([]string{"spec", "manualSelector"}, v)
+	return p
+}
+
+func (p *JobPatch) RemoveSpecManualSelector() *JobPatch {
+	p.Remove([]string{"spec", "manualSelector"})
+	return p
+}
+
+func (p *JobPatch) SetSpecTemplate(v corev1.PodTemplateSpec) *JobPatch {
+	p.Set([]string{"spec", "template"}, v)
+	return p
+}
+
+func (p *JobPatch) RemoveSpecTemplate() *JobPatch {
+	p.Remove([]string{"spec", "template"})
+	return p
+}
+
+func (p *JobPatch) SetSpecTTLSecondsAfterFinished(v int) *JobPatch {
+	p.Set([]string{"spec", "ttlSecondsAfterFinished"}, v)
+	return p
+}
+
+func (p *JobPatch) RemoveSpecTTLSecondsAfterFinished() *JobPatch {
+	p.Remove([]string{"spec", "ttlSecondsAfterFinished"})
+	return p
+}
+
+func (p *JobPatch) SetSpecCompletionMode(v CompletionMode) *JobPatch {
+	p.Set([]string{"spec", "completionMode"}, v)
+	return p
+}
+
+func (p *JobPatch) RemoveSpecCompletionMode() *JobPatch {
+	p.Remove([]string{"spec", "completionMode"})
+	return p
+}
+
+func (p *JobPatch) SetSpecSuspend(v bool) *JobPatch {
+	p.Set([]string{"spec", "suspend"}, v)
+	return p
+}
+
+func (p *JobPatch) RemoveSpecSuspend() *JobPatch {
+	p.Remove([]string{"spec", "suspend"})
+	return p
+}
+
+func (p *JobPatch) SetSpecPodReplacementPolicy(v PodReplacementPolicy) *JobPatch {
+	p.Set([]string{"spec", "podReplacementPolicy"}, v)
+	return p
+}
+
+func (p *JobPatch) RemoveSpecPodReplacementPolicy() *JobPatch {
+	p.Remove([]string{"spec", "podReplacementPolicy"})
+	return p
+}
+
+func (p *JobPatch) SetSpecManagedBy(v string) *JobPatch {
+	p.Set([]string{"spec", "managedBy"}, v)
+	return p
+}
+
+func (p *JobPatch) RemoveSpecManagedBy() *JobPatch {
+	p.Remove([]string{"spec", "managedBy"})
+	return p
+}
+
+func (p *JobPatch) SetStatus(v *JobStatus) *JobPatch {
+	p.Set([]string{"status"}, v)
+	return p
+}
+
+func (p *JobPatch) RemoveStatus() *JobPatch {
+	p.Remove([]string{"status"})
+	return p
+}
+
+func (p *JobPatch) SetStatusConditions(v []JobCondition) *JobPatch {
+	p.Set([]string{"status", "conditions"}, v)
+	return p
+}
+
+func (p *JobPatch) RemoveStatusConditions() *JobPatch {
+	p.Remove([]string{"status", "conditions"})
+	return p
+}
+
+func (p *JobPatch) AddStatusConditions(v ...JobCondition) *JobPatch {
+	for _, e := range v {
+		p.Append([]string{"status", "conditions"}, e)
+	}
+	return p
+}
+
+func (p *JobPatch) SetStatusStartTime(v *metav1.Time) *JobPatch {
+	p.Set([]string{"status", "startTime"}, v)
+	return p
+}
+
+func (p *JobPatch) RemoveStatusStartTime() *JobPatch {
+	p.Remove([]string{"status", "startTime"})
+	return p
+}
+
+func (p *JobPatch) SetStatusCompletionTime(v *metav1.Time) *JobPatch {
+	p.Set([]string{"status", "completionTime"}, v)
+	return p
+}
+
+func (p *JobPatch) RemoveStatusCompletionTime() *JobPatch {
+	p.Remove([]string{"status", "completionTime"})
+	return p
+}
+
+func (p *JobPatch) SetStatusActive(v int) *JobPatch {
+	p.Set([]string{"status", "active"}, v)
+	return p
+}
+
+func (p *JobPatch) RemoveStatusActive() *JobPatch {
+	p.Remove([]string{"status", "active"})
+	return p
+}
+
+func (p *JobPatch) SetStatusSucceeded(v int) *JobPatch {
+	p.Set([]string{"status", "succeeded"}, v)
+	return p
+}
+
+func (p *JobPatch) RemoveStatusSucceeded() *JobPatch {
+	p.Remove([]string{"status", "succeeded"})
+	return p
+}
+
+func (p *JobPatch) SetStatusFailed(v int) *JobPatch {
+	p.Set([]string{"status", "failed"}, v)
+	return p
+}
+
+func (p *JobPatch) RemoveStatusFailed() *JobPatch {
+	p.Remove([]string{"status", "failed"})
+	return p
+}
+
+func (p *JobPatch) SetStatusTerminating(v int) *JobPatch {
+	p.Set([]string{"status", "terminating"}, v)
+	return p
+}
+
+func (p *JobPatch) RemoveStatusTerminating() *JobPatch {
+	p.Remove([]string{"status", "terminating"})
+	return p
+}
+
+func (p *JobPatch) SetStatusCompletedIndexes(v string) *JobPatch {
+	p.Set([]string{"status", "completedIndexes"}, v)
+	return p
+}
+
+func (p *JobPatch) RemoveStatusCompletedIndexes() *JobPatch {
+	p.Remove([]string{"status", "completedIndexes"})
+	return p
+}
+
+func (p *JobPatch) SetStatusFailedIndexes(v string) *JobPatch {
+	p.Set([]string{"status", "failedIndexes"}, v)
+	return p
+}
+
+func (p *JobPatch) RemoveStatusFailedIndexes() *JobPatch {
+	p.Remove([]string{"status", "failedIndexes"})
+	return p
+}
+
+func (p *JobPatch) SetStatusUncountedTerminatedPods(v *UncountedTerminatedPods) *JobPatch {
+	p.Set([]string{"status", "uncountedTerminatedPods"}, v)
+	return p
+}
+
+func (p *JobPatch) RemoveStatusUncountedTerminatedPods() *JobPatch {
+	p.Remove([]string{"status", "uncountedTerminatedPods"})
+	return p
+}
+
+func (p *JobPatch) SetStatusUncountedTerminatedPodsSucceeded(v []string) *JobPatch {
+	p.Set([]string{"status", "uncountedTerminatedPods", "succeeded"}, v)
+	return p
+}
+
+func (p *JobPatch) RemoveStatusUncountedTerminatedPodsSucceeded() *JobPatch {
+	p.Remove([]string{"status", "uncountedTerminatedPods", "succeeded"})
+	return p
+}
+
+func (p *JobPatch) AddStatusUncountedTerminatedPodsSucceeded(v ...string) *JobPatch {
+	for _, e := range v {
+		p.Append([]string{"status", "uncountedTerminatedPods", "succeeded"}, e)
+	}
+	return p
+}
+
+func (p *JobPatch) SetStatusUncountedTerminatedPodsFailed(v []string) *JobPatch {
+	p.Set([]string{"status", "uncountedTerminatedPods", "failed"}, v)
+	return p
+}
+
+func (p *JobPatch) RemoveStatusUncountedTerminatedPodsFailed() *JobPatch {
+	p.Remove([]string{"status", "uncountedTerminatedPods", "failed"})
+	return p
+}
+
+func (p *JobPatch) AddStatusUncountedTerminatedPodsFailed(v ...string) *JobPatch {
+	for _, e := range v {
+		p.Append([]string{"status", "uncountedTerminatedPods", "failed"}, e)
+	}
+	return p
+}
+
+func (p *JobPatch) SetStatusReady(v int) *JobPatch {
+	p.Set([]string{"status", "ready"}, v)
+	return p
+}
+
+func (p *JobPatch) RemoveStatusReady() *JobPatch {
+	p.Remove([]string{"status", "ready"})
+	return p
+}
+
+func (in *Job) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *Job) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *Job) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	first := true
+	if in.TypeMeta.Kind != "" {
+		w.RawString("\"kind\":")
+		w.String(in.TypeMeta.Kind)
+		first = false
+	}
+	if in.TypeMeta.APIVersion != "" {
+		if !first {
+			w.RawByte(',')
+		}
+		w.RawString("\"apiVersion\":")
+		w.String(in.TypeMeta.APIVersion)
+		first = false
+	}
+	if !first {
+		w.RawByte(',')
+	}
+	w.RawString("\"metadata\":")
+	in.ObjectMeta.MarshalEasyJSON(w)
+	if in.Spec != nil {
+		w.RawString(",\"spec\":")
+		in.Spec.MarshalEasyJSON(w)
+	}
+	if in.Status != nil {
+		w.RawString(",\"status\":")
+		in.Status.MarshalEasyJSON(w)
+	}
+	w.RawByte('}')
+}
+
+func (in *Job) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "kind":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.TypeMeta.Kind = l.String()
+			}
+		case "apiVersion":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.TypeMeta.APIVersion = l.String()
+			}
+		case "metadata":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.ObjectMeta.UnmarshalEasyJSON(l)
+			}
+		case "spec":
+			if l.IsNull() {
+				l.Skip()
+				in.Spec = nil
+			} else {
+				if in.Spec == nil {
+					in.Spec = new(JobSpec)
+				}
+				in.Spec.UnmarshalEasyJSON(l)
+			}
+		case "status":
+			if l.IsNull() {
+				l.Skip()
+				in.Status = nil
+			} else {
+				if in.Status == nil {
+					in.Status = new(JobStatus)
+				}
+				in.Status.UnmarshalEasyJSON(l)
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
+
 type JobList struct {
 	metav1.TypeMeta `json:",inline"`
 	metav1.ListMeta `json:"metadata"`
@@ -231,6 +1355,117 @@ func (in *JobList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+func (in *JobList) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *JobList) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *JobList) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	first := true
+	if in.TypeMeta.Kind != "" {
+		w.RawString("\"kind\":")
+		w.String(in.TypeMeta.Kind)
+		first = false
+	}
+	if in.TypeMeta.APIVersion != "" {
+		if !first {
+			w.RawByte(',')
+		}
+		w.RawString("\"apiVersion\":")
+		w.String(in.TypeMeta.APIVersion)
+		first = false
+	}
+	if !first {
+		w.RawByte(',')
+	}
+	w.RawString("\"metadata\":")
+	in.ListMeta.MarshalEasyJSON(w)
+	w.RawString(",\"items\":")
+	if in.Items == nil {
+		w.RawString("null")
+	} else {
+		w.RawByte('[')
+		for i0 := range in.Items {
+			if i0 > 0 {
+				w.RawByte(',')
+			}
+			in.Items[i0].MarshalEasyJSON(w)
+		}
+		w.RawByte(']')
+	}
+	w.RawByte('}')
+}
+
+func (in *JobList) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "kind":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.TypeMeta.Kind = l.String()
+			}
+		case "apiVersion":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.TypeMeta.APIVersion = l.String()
+			}
+		case "metadata":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.ListMeta.UnmarshalEasyJSON(l)
+			}
+		case "items":
+			if l.IsNull() {
+				l.Skip()
+				in.Items = nil
+			} else {
+				in.Items = make([]Job, 0)
+				l.Delim('[')
+				for !l.IsDelim(']') {
+					var v0 Job
+					if l.IsNull() {
+						l.Skip()
+					} else {
+						v0.UnmarshalEasyJSON(l)
+					}
+					in.Items = append(in.Items, v0)
+					l.WantComma()
+				}
+				l.Delim(']')
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
+
 type CronJobSpec struct {
 	// The schedule in Cron format, see https://en.wikipedia.org/wiki/Cron.
 	Schedule string `json:"schedule"`
@@ -266,18 +1501,137 @@ type CronJobSpec struct {
 	FailedJobsHistoryLimit int `json:"failedJobsHistoryLimit,omitempty"`
 }
 
-func (in *CronJobSpec) DeepCopyInto(out *CronJobSpec) {
-	*out = *in
-	in.JobTemplate.DeepCopyInto(&out.JobTemplate)
+func (in *CronJobSpec) DeepCopyInto(out *CronJobSpec) {
+	*out = *in
+	in.JobTemplate.DeepCopyInto(&out.JobTemplate)
+}
+
+func (in *CronJobSpec) DeepCopy() *CronJobSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(CronJobSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *CronJobSpec) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *CronJobSpec) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *CronJobSpec) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	w.RawString("\"schedule\":")
+	w.String(in.Schedule)
+	if in.TimeZone != "" {
+		w.RawString(",\"timeZone\":")
+		w.String(in.TimeZone)
+	}
+	if in.StartingDeadlineSeconds != 0 {
+		w.RawString(",\"startingDeadlineSeconds\":")
+		w.Int64(in.StartingDeadlineSeconds)
+	}
+	if in.ConcurrencyPolicy != "" {
+		w.RawString(",\"concurrencyPolicy\":")
+		w.String(string(in.ConcurrencyPolicy))
+	}
+	if in.Suspend {
+		w.RawString(",\"suspend\":")
+		w.Bool(in.Suspend)
+	}
+	w.RawString(",\"jobTemplate\":")
+	in.JobTemplate.MarshalEasyJSON(w)
+	if in.SuccessfulJobsHistoryLimit != 0 {
+		w.RawString(",\"successfulJobsHistoryLimit\":")
+		w.Int(in.SuccessfulJobsHistoryLimit)
+	}
+	if in.FailedJobsHistoryLimit != 0 {
+		w.RawString(",\"failedJobsHistoryLimit\":")
+		w.Int(in.FailedJobsHistoryLimit)
+	}
+
+	w.RawByte('}')
 }
 
-func (in *CronJobSpec) DeepCopy() *CronJobSpec {
-	if in == nil {
-		return nil
+func (in *CronJobSpec) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "schedule":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.Schedule = l.String()
+			}
+		case "timeZone":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.TimeZone = l.String()
+			}
+		case "startingDeadlineSeconds":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.StartingDeadlineSeconds = l.Int64()
+			}
+		case "concurrencyPolicy":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.ConcurrencyPolicy = ConcurrencyPolicy(l.String())
+			}
+		case "suspend":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.Suspend = l.Bool()
+			}
+		case "jobTemplate":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.JobTemplate.UnmarshalEasyJSON(l)
+			}
+		case "successfulJobsHistoryLimit":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.SuccessfulJobsHistoryLimit = l.Int()
+			}
+		case "failedJobsHistoryLimit":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.FailedJobsHistoryLimit = l.Int()
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
 	}
-	out := new(CronJobSpec)
-	in.DeepCopyInto(out)
-	return out
 }
 
 type CronJobStatus struct {
@@ -319,6 +1673,93 @@ func (in *CronJobStatus) DeepCopy() *CronJobStatus {
 	return out
 }
 
+func (in *CronJobStatus) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *CronJobStatus) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *CronJobStatus) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	w.RawString("\"active\":")
+	if in.Active == nil {
+		w.RawString("null")
+	} else {
+		w.RawByte('[')
+		for i0 := range in.Active {
+			if i0 > 0 {
+				w.RawByte(',')
+			}
+			in.Active[i0].MarshalEasyJSON(w)
+		}
+		w.RawByte(']')
+	}
+	if in.LastScheduleTime != nil {
+		w.RawString(",\"lastScheduleTime\":")
+		w.Raw(json.Marshal(in.LastScheduleTime))
+	}
+	if in.LastSuccessfulTime != nil {
+		w.RawString(",\"lastSuccessfulTime\":")
+		w.Raw(json.Marshal(in.LastSuccessfulTime))
+	}
+
+	w.RawByte('}')
+}
+
+func (in *CronJobStatus) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "active":
+			if l.IsNull() {
+				l.Skip()
+				in.Active = nil
+			} else {
+				in.Active = make([]corev1.ObjectReference, 0)
+				l.Delim('[')
+				for !l.IsDelim(']') {
+					var v0 corev1.ObjectReference
+					if l.IsNull() {
+						l.Skip()
+					} else {
+						v0.UnmarshalEasyJSON(l)
+					}
+					in.Active = append(in.Active, v0)
+					l.WantComma()
+				}
+				l.Delim(']')
+			}
+		case "lastScheduleTime":
+			l.AddError(json.Unmarshal(l.Raw(), &in.LastScheduleTime))
+		case "lastSuccessfulTime":
+			l.AddError(json.Unmarshal(l.Raw(), &in.LastSuccessfulTime))
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
+
 type JobSpec struct {
 	// Specifies the maximum desired number of pods the job should
 	// run at any given time. The actual number of pods running in steady state will
@@ -478,6 +1919,259 @@ func (in *JobSpec) DeepCopy() *JobSpec {
 	return out
 }
 
+func (in *JobSpec) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *JobSpec) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *JobSpec) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	first := true
+	if in.Parallelism != 0 {
+		w.RawString("\"parallelism\":")
+		w.Int(in.Parallelism)
+		first = false
+	}
+	if in.Completions != 0 {
+		if !first {
+			w.RawByte(',')
+		}
+		w.RawString("\"completions\":")
+		w.Int(in.Completions)
+		first = false
+	}
+	if in.ActiveDeadlineSeconds != 0 {
+		if !first {
+			w.RawByte(',')
+		}
+		w.RawString("\"activeDeadlineSeconds\":")
+		w.Int64(in.ActiveDeadlineSeconds)
+		first = false
+	}
+	if in.PodFailurePolicy != nil {
+		if !first {
+			w.RawByte(',')
+		}
+		w.RawString("\"podFailurePolicy\":")
+		in.PodFailurePolicy.MarshalEasyJSON(w)
+		first = false
+	}
+	if in.SuccessPolicy != nil {
+		if !first {
+			w.RawByte(',')
+		}
+		w.RawString("\"successPolicy\":")
+		in.SuccessPolicy.MarshalEasyJSON(w)
+		first = false
+	}
+	if in.BackoffLimit != 0 {
+		if !first {
+			w.RawByte(',')
+		}
+		w.RawString("\"backoffLimit\":")
+		w.Int(in.BackoffLimit)
+		first = false
+	}
+	if in.BackoffLimitPerIndex != 0 {
+		if !first {
+			w.RawByte(',')
+		}
+		w.RawString("\"backoffLimitPerIndex\":")
+		w.Int(in.BackoffLimitPerIndex)
+		first = false
+	}
+	if in.MaxFailedIndexes != 0 {
+		if !first {
+			w.RawByte(',')
+		}
+		w.RawString("\"maxFailedIndexes\":")
+		w.Int(in.MaxFailedIndexes)
+		first = false
+	}
+	if in.Selector != nil {
+		if !first {
+			w.RawByte(',')
+		}
+		w.RawString("\"selector\":")
+		in.Selector.MarshalEasyJSON(w)
+		first = false
+	}
+	if in.ManualSelector {
+		if !first {
+			w.RawByte(',')
+		}
+		w.RawString("\"manualSelector\":")
+		w.Bool(in.ManualSelector)
+		first = false
+	}
+	if !first {
+		w.RawByte(',')
+	}
+	w.RawString("\"template\":")
+	in.Template.MarshalEasyJSON(w)
+	if in.TTLSecondsAfterFinished != 0 {
+		w.RawString(",\"ttlSecondsAfterFinished\":")
+		w.Int(in.TTLSecondsAfterFinished)
+	}
+	if in.CompletionMode != "" {
+		w.RawString(",\"completionMode\":")
+		w.String(string(in.CompletionMode))
+	}
+	if in.Suspend {
+		w.RawString(",\"suspend\":")
+		w.Bool(in.Suspend)
+	}
+	if in.PodReplacementPolicy != "" {
+		w.RawString(",\"podReplacementPolicy\":")
+		w.String(string(in.PodReplacementPolicy))
+	}
+	if in.ManagedBy != "" {
+		w.RawString(",\"managedBy\":")
+		w.String(in.ManagedBy)
+	}
+	w.RawByte('}')
+}
+
+func (in *JobSpec) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "parallelism":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.Parallelism = l.Int()
+			}
+		case "completions":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.Completions = l.Int()
+			}
+		case "activeDeadlineSeconds":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.ActiveDeadlineSeconds = l.Int64()
+			}
+		case "podFailurePolicy":
+			if l.IsNull() {
+				l.Skip()
+				in.PodFailurePolicy = nil
+			} else {
+				if in.PodFailurePolicy == nil {
+					in.PodFailurePolicy = new(PodFailurePolicy)
+				}
+				in.PodFailurePolicy.UnmarshalEasyJSON(l)
+			}
+		case "successPolicy":
+			if l.IsNull() {
+				l.Skip()
+				in.SuccessPolicy = nil
+			} else {
+				if in.SuccessPolicy == nil {
+					in.SuccessPolicy = new(SuccessPolicy)
+				}
+				in.SuccessPolicy.UnmarshalEasyJSON(l)
+			}
+		case "backoffLimit":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.BackoffLimit = l.Int()
+			}
+		case "backoffLimitPerIndex":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.BackoffLimitPerIndex = l.Int()
+			}
+		case "maxFailedIndexes":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.MaxFailedIndexes = l.Int()
+			}
+		case "selector":
+			if l.IsNull() {
+				l.Skip()
+				in.Selector = nil
+			} else {
+				if in.Selector == nil {
+					in.Selector = new(metav1.LabelSelector)
+				}
+				in.Selector.UnmarshalEasyJSON(l)
+			}
+		case "manualSelector":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.ManualSelector = l.Bool()
+			}
+		case "template":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.Template.UnmarshalEasyJSON(l)
+			}
+		case "ttlSecondsAfterFinished":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.TTLSecondsAfterFinished = l.Int()
+			}
+		case "completionMode":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.CompletionMode = CompletionMode(l.String())
+			}
+		case "suspend":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.Suspend = l.Bool()
+			}
+		case "podReplacementPolicy":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.PodReplacementPolicy = PodReplacementPolicy(l.String())
+			}
+		case "managedBy":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.ManagedBy = l.String()
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
+
 type JobStatus struct {
 	// The latest available observations of an object's current state. When a Job
 	// fails, one of the conditions will have type "Failed" and status true. When
@@ -592,6 +2286,177 @@ func (in *JobStatus) DeepCopy() *JobStatus {
 	return out
 }
 
+func (in *JobStatus) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *JobStatus) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *JobStatus) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	w.RawString("\"conditions\":")
+	if in.Conditions == nil {
+		w.RawString("null")
+	} else {
+		w.RawByte('[')
+		for i0 := range in.Conditions {
+			if i0 > 0 {
+				w.RawByte(',')
+			}
+			in.Conditions[i0].MarshalEasyJSON(w)
+		}
+		w.RawByte(']')
+	}
+	if in.StartTime != nil {
+		w.RawString(",\"startTime\":")
+		w.Raw(json.Marshal(in.StartTime))
+	}
+	if in.CompletionTime != nil {
+		w.RawString(",\"completionTime\":")
+		w.Raw(json.Marshal(in.CompletionTime))
+	}
+	if in.Active != 0 {
+		w.RawString(",\"active\":")
+		w.Int(in.Active)
+	}
+	if in.Succeeded != 0 {
+		w.RawString(",\"succeeded\":")
+		w.Int(in.Succeeded)
+	}
+	if in.Failed != 0 {
+		w.RawString(",\"failed\":")
+		w.Int(in.Failed)
+	}
+	if in.Terminating != 0 {
+		w.RawString(",\"terminating\":")
+		w.Int(in.Terminating)
+	}
+	if in.CompletedIndexes != "" {
+		w.RawString(",\"completedIndexes\":")
+		w.String(in.CompletedIndexes)
+	}
+	if in.FailedIndexes != "" {
+		w.RawString(",\"failedIndexes\":")
+		w.String(in.FailedIndexes)
+	}
+	if in.UncountedTerminatedPods != nil {
+		w.RawString(",\"uncountedTerminatedPods\":")
+		in.UncountedTerminatedPods.MarshalEasyJSON(w)
+	}
+	if in.Ready != 0 {
+		w.RawString(",\"ready\":")
+		w.Int(in.Ready)
+	}
+
+	w.RawByte('}')
+}
+
+func (in *JobStatus) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "conditions":
+			if l.IsNull() {
+				l.Skip()
+				in.Conditions = nil
+			} else {
+				in.Conditions = make([]JobCondition, 0)
+				l.Delim('[')
+				for !l.IsDelim(']') {
+					var v0 JobCondition
+					if l.IsNull() {
+						l.Skip()
+					} else {
+						v0.UnmarshalEasyJSON(l)
+					}
+					in.Conditions = append(in.Conditions, v0)
+					l.WantComma()
+				}
+				l.Delim(']')
+			}
+		case "startTime":
+			l.AddError(json.Unmarshal(l.Raw(), &in.StartTime))
+		case "completionTime":
+			l.AddError(json.Unmarshal(l.Raw(), &in.CompletionTime))
+		case "active":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.Active = l.Int()
+			}
+		case "succeeded":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.Succeeded = l.Int()
+			}
+		case "failed":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.Failed = l.Int()
+			}
+		case "terminating":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.Terminating = l.Int()
+			}
+		case "completedIndexes":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.CompletedIndexes = l.String()
+			}
+		case "failedIndexes":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.FailedIndexes = l.String()
+			}
+		case "uncountedTerminatedPods":
+			if l.IsNull() {
+				l.Skip()
+				in.UncountedTerminatedPods = nil
+			} else {
+				if in.UncountedTerminatedPods == nil {
+					in.UncountedTerminatedPods = new(UncountedTerminatedPods)
+				}
+				in.UncountedTerminatedPods.UnmarshalEasyJSON(l)
+			}
+		case "ready":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.Ready = l.Int()
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
+
 type JobTemplateSpec struct {
 	// Standard object's metadata of the jobs created from this template.
 	// More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#metadata
@@ -624,6 +2489,82 @@ func (in *JobTemplateSpec) DeepCopy() *JobTemplateSpec {
 	return out
 }
 
+func (in *JobTemplateSpec) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *JobTemplateSpec) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *JobTemplateSpec) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	first := true
+	if in.ObjectMeta != nil {
+		w.RawString("\"metadata\":")
+		in.ObjectMeta.MarshalEasyJSON(w)
+		first = false
+	}
+	if in.Spec != nil {
+		if !first {
+			w.RawByte(',')
+		}
+		w.RawString("\"spec\":")
+		in.Spec.MarshalEasyJSON(w)
+		first = false
+	}
+	w.RawByte('}')
+}
+
+func (in *JobTemplateSpec) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "metadata":
+			if l.IsNull() {
+				l.Skip()
+				in.ObjectMeta = nil
+			} else {
+				if in.ObjectMeta == nil {
+					in.ObjectMeta = new(metav1.ObjectMeta)
+				}
+				in.ObjectMeta.UnmarshalEasyJSON(l)
+			}
+		case "spec":
+			if l.IsNull() {
+				l.Skip()
+				in.Spec = nil
+			} else {
+				if in.Spec == nil {
+					in.Spec = new(JobSpec)
+				}
+				in.Spec.UnmarshalEasyJSON(l)
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
+
 type PodFailurePolicy struct {
 	// A list of pod failure policy rules. The rules are evaluated in order.
 	// Once a rule matches a Pod failure, the remaining of the rules are ignored.
@@ -653,6 +2594,81 @@ func (in *PodFailurePolicy) DeepCopy() *PodFailurePolicy {
 	return out
 }
 
+func (in *PodFailurePolicy) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *PodFailurePolicy) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *PodFailurePolicy) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	w.RawString("\"rules\":")
+	if in.Rules == nil {
+		w.RawString("null")
+	} else {
+		w.RawByte('[')
+		for i0 := range in.Rules {
+			if i0 > 0 {
+				w.RawByte(',')
+			}
+			in.Rules[i0].MarshalEasyJSON(w)
+		}
+		w.RawByte(']')
+	}
+
+	w.RawByte('}')
+}
+
+func (in *PodFailurePolicy) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "rules":
+			if l.IsNull() {
+				l.Skip()
+				in.Rules = nil
+			} else {
+				in.Rules = make([]PodFailurePolicyRule, 0)
+				l.Delim('[')
+				for !l.IsDelim(']') {
+					var v0 PodFailurePolicyRule
+					if l.IsNull() {
+						l.Skip()
+					} else {
+						v0.UnmarshalEasyJSON(l)
+					}
+					in.Rules = append(in.Rules, v0)
+					l.WantComma()
+				}
+				l.Delim(']')
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
+
 type SuccessPolicy struct {
 	// rules represents the list of alternative rules for the declaring the Jobs
 	// as successful before `.status.succeeded >= .spec.completions`. Once any of the rules are met,
@@ -683,6 +2699,81 @@ func (in *SuccessPolicy) DeepCopy() *SuccessPolicy {
 	return out
 }
 
+func (in *SuccessPolicy) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *SuccessPolicy) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *SuccessPolicy) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	w.RawString("\"rules\":")
+	if in.Rules == nil {
+		w.RawString("null")
+	} else {
+		w.RawByte('[')
+		for i0 := range in.Rules {
+			if i0 > 0 {
+				w.RawByte(',')
+			}
+			in.Rules[i0].MarshalEasyJSON(w)
+		}
+		w.RawByte(']')
+	}
+
+	w.RawByte('}')
+}
+
+func (in *SuccessPolicy) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "rules":
+			if l.IsNull() {
+				l.Skip()
+				in.Rules = nil
+			} else {
+				in.Rules = make([]SuccessPolicyRule, 0)
+				l.Delim('[')
+				for !l.IsDelim(']') {
+					var v0 SuccessPolicyRule
+					if l.IsNull() {
+						l.Skip()
+					} else {
+						v0.UnmarshalEasyJSON(l)
+					}
+					in.Rules = append(in.Rules, v0)
+					l.WantComma()
+				}
+				l.Delim(']')
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
+
 type JobCondition struct {
 	// Type of job condition, Complete or Failed.
 	Type JobConditionType `json:"type"`
@@ -721,6 +2812,97 @@ func (in *JobCondition) DeepCopy() *JobCondition {
 	return out
 }
 
+func (in *JobCondition) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *JobCondition) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *JobCondition) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	w.RawString("\"type\":")
+	w.String(string(in.Type))
+	w.RawString(",\"status\":")
+	w.String(string(in.Status))
+	if in.LastProbeTime != nil {
+		w.RawString(",\"lastProbeTime\":")
+		w.Raw(json.Marshal(in.LastProbeTime))
+	}
+	if in.LastTransitionTime != nil {
+		w.RawString(",\"lastTransitionTime\":")
+		w.Raw(json.Marshal(in.LastTransitionTime))
+	}
+	if in.Reason != "" {
+		w.RawString(",\"reason\":")
+		w.String(in.Reason)
+	}
+	if in.Message != "" {
+		w.RawString(",\"message\":")
+		w.String(in.Message)
+	}
+
+	w.RawByte('}')
+}
+
+func (in *JobCondition) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "type":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.Type = JobConditionType(l.String())
+			}
+		case "status":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.Status = corev1.ConditionStatus(l.String())
+			}
+		case "lastProbeTime":
+			l.AddError(json.Unmarshal(l.Raw(), &in.LastProbeTime))
+		case "lastTransitionTime":
+			l.AddError(json.Unmarshal(l.Raw(), &in.LastTransitionTime))
+		case "reason":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.Reason = l.String()
+			}
+		case "message":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.Message = l.String()
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
+
 type UncountedTerminatedPods struct {
 	// succeeded holds UIDs of succeeded Pods.
 	Succeeded []string `json:"succeeded"`
@@ -751,6 +2933,113 @@ func (in *UncountedTerminatedPods) DeepCopy() *UncountedTerminatedPods {
 	return out
 }
 
+func (in *UncountedTerminatedPods) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *UncountedTerminatedPods) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *UncountedTerminatedPods) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	w.RawString("\"succeeded\":")
+	if in.Succeeded == nil {
+		w.RawString("null")
+	} else {
+		w.RawByte('[')
+		for i0 := range in.Succeeded {
+			if i0 > 0 {
+				w.RawByte(',')
+			}
+			w.String(in.Succeeded[i0])
+		}
+		w.RawByte(']')
+	}
+	w.RawString(",\"failed\":")
+	if in.Failed == nil {
+		w.RawString("null")
+	} else {
+		w.RawByte('[')
+		for i0 := range in.Failed {
+			if i0 > 0 {
+				w.RawByte(',')
+			}
+			w.String(in.Failed[i0])
+		}
+		w.RawByte(']')
+	}
+
+	w.RawByte('}')
+}
+
+func (in *UncountedTerminatedPods) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "succeeded":
+			if l.IsNull() {
+				l.Skip()
+				in.Succeeded = nil
+			} else {
+				in.Succeeded = make([]string, 0)
+				l.Delim('[')
+				for !l.IsDelim(']') {
+					var v0 string
+					if l.IsNull() {
+						l.Skip()
+					} else {
+						v0 = l.String()
+					}
+					in.Succeeded = append(in.Succeeded, v0)
+					l.WantComma()
+				}
+				l.Delim(']')
+			}
+		case "failed":
+			if l.IsNull() {
+				l.Skip()
+				in.Failed = nil
+			} else {
+				in.Failed = make([]string, 0)
+				l.Delim('[')
+				for !l.IsDelim(']') {
+					var v0 string
+					if l.IsNull() {
+						l.Skip()
+					} else {
+						v0 = l.String()
+					}
+					in.Failed = append(in.Failed, v0)
+					l.WantComma()
+				}
+				l.Delim(']')
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
+
 type PodFailurePolicyRule struct {
 	// Specifies the action taken on a pod failure when the requirements are satisfied.
 	// Possible values are:
@@ -798,6 +3087,103 @@ func (in *PodFailurePolicyRule) DeepCopy() *PodFailurePolicyRule {
 	return out
 }
 
+func (in *PodFailurePolicyRule) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *PodFailurePolicyRule) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *PodFailurePolicyRule) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	w.RawString("\"action\":")
+	w.String(string(in.Action))
+	if in.OnExitCodes != nil {
+		w.RawString(",\"onExitCodes\":")
+		in.OnExitCodes.MarshalEasyJSON(w)
+	}
+	w.RawString(",\"onPodConditions\":")
+	if in.OnPodConditions == nil {
+		w.RawString("null")
+	} else {
+		w.RawByte('[')
+		for i0 := range in.OnPodConditions {
+			if i0 > 0 {
+				w.RawByte(',')
+			}
+			in.OnPodConditions[i0].MarshalEasyJSON(w)
+		}
+		w.RawByte(']')
+	}
+
+	w.RawByte('}')
+}
+
+func (in *PodFailurePolicyRule) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "action":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.Action = PodFailurePolicyAction(l.String())
+			}
+		case "onExitCodes":
+			if l.IsNull() {
+				l.Skip()
+				in.OnExitCodes = nil
+			} else {
+				if in.OnExitCodes == nil {
+					in.OnExitCodes = new(PodFailurePolicyOnExitCodesRequirement)
+				}
+				in.OnExitCodes.UnmarshalEasyJSON(l)
+			}
+		case "onPodConditions":
+			if l.IsNull() {
+				l.Skip()
+				in.OnPodConditions = nil
+			} else {
+				in.OnPodConditions = make([]PodFailurePolicyOnPodConditionsPattern, 0)
+				l.Delim('[')
+				for !l.IsDelim(']') {
+					var v0 PodFailurePolicyOnPodConditionsPattern
+					if l.IsNull() {
+						l.Skip()
+					} else {
+						v0.UnmarshalEasyJSON(l)
+					}
+					in.OnPodConditions = append(in.OnPodConditions, v0)
+					l.WantComma()
+				}
+				l.Delim(']')
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
+
 type SuccessPolicyRule struct {
 	// succeededIndexes specifies the set of indexes
 	// which need to be contained in the actual set of the succeeded indexes for the Job.
@@ -837,6 +3223,74 @@ func (in *SuccessPolicyRule) DeepCopy() *SuccessPolicyRule {
 	return out
 }
 
+func (in *SuccessPolicyRule) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *SuccessPolicyRule) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *SuccessPolicyRule) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	first := true
+	if in.SucceededIndexes != "" {
+		w.RawString("\"succeededIndexes\":")
+		w.String(in.SucceededIndexes)
+		first = false
+	}
+	if in.SucceededCount != 0 {
+		if !first {
+			w.RawByte(',')
+		}
+		w.RawString("\"succeededCount\":")
+		w.Int(in.SucceededCount)
+		first = false
+	}
+	w.RawByte('}')
+}
+
+func (in *SuccessPolicyRule) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "succeededIndexes":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.SucceededIndexes = l.String()
+			}
+		case "succeededCount":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.SucceededCount = l.Int()
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
+
 type PodFailurePolicyOnExitCodesRequirement struct {
 	// Restricts the check for exit codes to the container with the
 	// specified name. When null, the rule applies to all containers.
@@ -881,6 +3335,103 @@ func (in *PodFailurePolicyOnExitCodesRequirement) DeepCopy() *PodFailurePolicyOn
 	return out
 }
 
+func (in *PodFailurePolicyOnExitCodesRequirement) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *PodFailurePolicyOnExitCodesRequirement) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *PodFailurePolicyOnExitCodesRequirement) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	first := true
+	if in.ContainerName != "" {
+		w.RawString("\"containerName\":")
+		w.String(in.ContainerName)
+		first = false
+	}
+	if !first {
+		w.RawByte(',')
+	}
+	w.RawString("\"operator\":")
+	w.String(string(in.Operator))
+	w.RawString(",\"values\":")
+	if in.Values == nil {
+		w.RawString("null")
+	} else {
+		w.RawByte('[')
+		for i0 := range in.Values {
+			if i0 > 0 {
+				w.RawByte(',')
+			}
+			w.Int(in.Values[i0])
+		}
+		w.RawByte(']')
+	}
+	w.RawByte('}')
+}
+
+func (in *PodFailurePolicyOnExitCodesRequirement) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "containerName":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.ContainerName = l.String()
+			}
+		case "operator":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.Operator = PodFailurePolicyOnExitCodesOperator(l.String())
+			}
+		case "values":
+			if l.IsNull() {
+				l.Skip()
+				in.Values = nil
+			} else {
+				in.Values = make([]int, 0)
+				l.Delim('[')
+				for !l.IsDelim(']') {
+					var v0 int
+					if l.IsNull() {
+						l.Skip()
+					} else {
+						v0 = l.Int()
+					}
+					in.Values = append(in.Values, v0)
+					l.WantComma()
+				}
+				l.Delim(']')
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
+
 type PodFailurePolicyOnPodConditionsPattern struct {
 	// Specifies the required Pod condition type. To match a pod condition
 	// it is required that specified type equals the pod condition type.
@@ -903,3 +3454,62 @@ func (in *PodFailurePolicyOnPodConditionsPattern) DeepCopy() *PodFailurePolicyOn
 	in.DeepCopyInto(out)
 	return out
 }
+
+func (in *PodFailurePolicyOnPodConditionsPattern) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *PodFailurePolicyOnPodConditionsPattern) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *PodFailurePolicyOnPodConditionsPattern) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	w.RawString("\"type\":")
+	w.String(string(in.Type))
+	w.RawString(",\"status\":")
+	w.String(string(in.Status))
+
+	w.RawByte('}')
+}
+
+func (in *PodFailurePolicyOnPodConditionsPattern) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "type":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.Type = corev1.PodConditionType(l.String())
+			}
+		case "status":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.Status = corev1.ConditionStatus(l.String())
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
@@ -1,10 +1,17 @@
 package certificatesv1
 
 import (
+	"encoding/json"
+	"github.com/mailru/easyjson/jlexer"
+	"github.com/mailru/easyjson/jwriter"
 	"go.f110.dev/kubeproto/go/apis/corev1"
 	"go.f110.dev/kubeproto/go/apis/metav1"
+	"go.f110.dev/kubeproto/go/patch"
+	"k8s.io/apimachinery/pkg/fields"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"maps"
+	"slices"
 )
 
 const GroupName = "certificates.k8s.io"
@@ -101,6 +108,287 @@ func (in *CertificateSigningRequest) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// CertificateSigningRequestToSelectableFields returns the fields of CertificateSigningRequest which can be used by the field selector.
+func CertificateSigningRequestToSelectableFields(obj *CertificateSigningRequest) fields.Set {
+	set := fields.Set{
+		"metadata.name":      obj.ObjectMeta.Name,
+		"metadata.namespace": obj.ObjectMeta.Namespace,
+	}
+	return set
+}
+
+// CertificateSigningRequestPatch builds JSON patch or JSON merge patch of CertificateSigningRequest.
+// JSON patch fails if the parent of the field doesn't exist (e.g. SetLabel to the object which has no label). MergePatch creates the parent.
+type CertificateSigningRequestPatch struct {
+	*patch.Builder
+}
+
+func NewCertificateSigningRequestPatch() *CertificateSigningRequestPatch {
+	return &CertificateSigningRequestPatch{Builder: patch.NewBuilder()}
+}
+
+func (p *CertificateSigningRequestPatch) SetLabel(key, value string) *CertificateSigningRequestPatch {
+	p.Set([]string{"metadata", "labels", key}, value)
+	return p
+}
+
+func (p *CertificateSigningRequestPatch) RemoveLabel(key string) *CertificateSigningRequestPatch {
+	p.Remove([]string{"metadata", "labels", key})
+	return p
+}
+
+func (p *CertificateSigningRequestPatch) SetAnnotation(key, value string) *CertificateSigningRequestPatch {
+	p.Set([]string{"metadata", "annotations", key}, value)
+	return p
+}
+
+func (p *CertificateSigningRequestPatch) RemoveAnnotation(key string) *CertificateSigningRequestPatch {
+	p.Remove([]string{"metadata", "annotations", key})
+	return p
+}
+
+func (p *CertificateSigningRequestPatch) SetSpec(v CertificateSigningRequestSpec) *CertificateSigningRequestPatch {
+	p.Set([]string{"spec"}, v)
+	return p
+}
+
+func (p *CertificateSigningRequestPatch) RemoveSpec() *CertificateSigningRequestPatch {
+	p.Remove([]string{"spec"})
+	return p
+}
+
+func (p *CertificateSigningRequestPatch) SetSpecRequest(v []byte) *CertificateSigningRequestPatch {
+	p.Set([]string{"spec", "request"}, v)
+	return p
+}
+
+func (p *CertificateSigningRequestPatch) RemoveSpecRequest() *CertificateSigningRequestPatch {
+	p.Remove([]string{"spec", "request"})
+	return p
+}
+
+func (p *CertificateSigningRequestPatch) SetSpecSignerName(v string) *CertificateSigningRequestPatch {
+	p.Set([]string{"spec", "signerName"}, v)
+	return p
+}
+
+func (p *CertificateSigningRequestPatch) RemoveSpecSignerName() *CertificateSigningRequestPatch {
+	p.Remove([]string{"spec", "signerName"})
+	return p
+}
+
+func (p *CertificateSigningRequestPatch) SetSpecExpirationSeconds(v int) *CertificateSigningRequestPatch {
+	p.Set([]string{"spec", "expirationSeconds"}, v)
+	return p
+}
+
+func (p *CertificateSigningRequestPatch) RemoveSpecExpirationSeconds() *CertificateSigningRequestPatch {
+	p.Remove([]string{"spec", "expirationSeconds"})
+	return p
+}
+
+func (p *CertificateSigningRequestPatch) SetSpecUsages(v []KeyUsage) *CertificateSigningRequestPatch {
+	p.Set([]string{"spec", "usages"}, v)
+	return p
+}
+
+func (p *CertificateSigningRequestPatch) RemoveSpecUsages() *CertificateSigningRequestPatch {
+	p.Remove([]string{"spec", "usages"})
+	return p
+}
+
+func (p *CertificateSigningRequestPatch) AddSpecUsages(v ...KeyUsage) *CertificateSigningRequestPatch {
+	for _, e := range v {
+		p.Append([]string{"spec", "usages"}, e)
+	}
+	return p
+}
+
+func (p *CertificateSigningRequestPatch) SetSpecUsername(v string) *CertificateSigningRequestPatch {
+	p.Set([]string{"spec", "username"}, v)
+	return p
+}
+
+func (p *CertificateSigningRequestPatch) RemoveSpecUsername() *CertificateSigningRequestPatch {
+	p.Remove([]string{"spec", "username"})
+	return p
+}
+
+func (p *CertificateSigningRequestPatch) SetSpecUID(v string) *CertificateSigningRequestPatch {
+	p.Set([]string{"spec", "uid"}, v)
+	return p
+}
+
+func (p *CertificateSigningRequestPatch) RemoveSpecUID() *CertificateSigningRequestPatch {
+	p.Remove([]string{"spec", "uid"})
+	return p
+}
+
+func (p *CertificateSigningRequestPatch) SetSpecGroups(v []string) *CertificateSigningRequestPatch {
+	p.Set([]string{"spec", "groups"}, v)
+	return p
+}
+
+func (p *CertificateSigningRequestPatch) RemoveSpecGroups() *CertificateSigningRequestPatch {
+	p.Remove([]string{"spec", "groups"})
+	return p
+}
+
+func (p *CertificateSigningRequestPatch) AddSpecGroups(v ...string) *CertificateSigningRequestPatch {
+	for _, e := range v {
+		p.Append([]string{"spec", "groups"}, e)
+	}
+	return p
+}
+
+func (p *CertificateSigningRequestPatch) SetSpecExtra(v map[string]ExtraValue) *CertificateSigningRequestPatch {
+	p.Set([]string{"spec", "extra"}, v)
+	return p
+}
+
+func (p *CertificateSigningRequestPatch) RemoveSpecExtra() *CertificateSigningRequestPatch {
+	p.Remove([]string{"spec", "extra"})
+	return p
+}
+
+func (p *CertificateSigningRequestPatch) SetStatus(v *CertificateSigningRequestStatus) *CertificateSigningRequestPatch {
+	p.Set([]string{"status"}, v)
+	return p
+}
+
+func (p *CertificateSigningRequestPatch) RemoveStatus() *CertificateSigningRequestPatch {
+	p.Remove([]string{"status"})
+	return p
+}
+
+func (p *CertificateSigningRequestPatch) SetStatusConditions(v []CertificateSigningRequestCondition) *CertificateSigningRequestPatch {
+	p.Set([]string{"status", "conditions"}, v)
+	return p
+}
+
+func (p *CertificateSigningRequestPatch) RemoveStatusConditions() *CertificateSigningRequestPatch {
+	p.Remove([]string{"status", "conditions"})
+	return p
+}
+
+func (p *CertificateSigningRequestPatch) AddStatusConditions(v ...CertificateSigningRequestCondition) *CertificateSigningRequestPatch {
+	for _, e := range v {
+		p.Append([]string{"status", "conditions"}, e)
+	}
+	return p
+}
+
+func (p *CertificateSigningRequestPatch) SetStatusCertificate(v []byte) *CertificateSigningRequestPatch {
+	p.Set([]string{"status", "certificate"}, v)
+	return p
+}
+
+func (p *CertificateSigningRequestPatch) RemoveStatusCertificate() *CertificateSigningRequestPatch {
+	p.Remove([]string{"status", "certificate"})
+	return p
+}
+
+func (in *CertificateSigningRequest) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *CertificateSigningRequest) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *CertificateSigningRequest) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	first := true
+	if in.TypeMeta.Kind != "" {
+		w.RawString("\"kind\":")
+		w.String(in.TypeMeta.Kind)
+		first = false
+	}
+	if in.TypeMeta.APIVersion != "" {
+		if !first {
+			w.RawByte(',')
+		}
+		w.RawString("\"apiVersion\":")
+		w.String(in.TypeMeta.APIVersion)
+		first = false
+	}
+	if !first {
+		w.RawByte(',')
+	}
+	w.RawString("\"metadata\":")
+	in.ObjectMeta.MarshalEasyJSON(w)
+	w.RawString(",\"spec\":")
+	in.Spec.MarshalEasyJSON(w)
+	if in.Status != nil {
+		w.RawString(",\"status\":")
+		in.Status.MarshalEasyJSON(w)
+	}
+	w.RawByte('}')
+}
+
+func (in *CertificateSigningRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "kind":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.TypeMeta.Kind = l.String()
+			}
+		case "apiVersion":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.TypeMeta.APIVersion = l.String()
+			}
+		case "metadata":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.ObjectMeta.UnmarshalEasyJSON(l)
+			}
+		case "spec":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.Spec.UnmarshalEasyJSON(l)
+			}
+		case "status":
+			if l.IsNull() {
+				l.Skip()
+				in.Status = nil
+			} else {
+				if in.Status == nil {
+					in.Status = new(CertificateSigningRequestStatus)
+				}
+				in.Status.UnmarshalEasyJSON(l)
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
+
 type CertificateSigningRequestList struct {
 	metav1.TypeMeta `json:",inline"`
 	metav1.ListMeta `json:"metadata"`
@@ -136,6 +424,117 @@ func (in *CertificateSigningRequestList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+func (in *CertificateSigningRequestList) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *CertificateSigningRequestList) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *CertificateSigningRequestList) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	first := true
+	if in.TypeMeta.Kind != "" {
+		w.RawString("\"kind\":")
+		w.String(in.TypeMeta.Kind)
+		first = false
+	}
+	if in.TypeMeta.APIVersion != "" {
+		if !first {
+			w.RawByte(',')
+		}
+		w.RawString("\"apiVersion\":")
+		w.String(in.TypeMeta.APIVersion)
+		first = false
+	}
+	if !first {
+		w.RawByte(',')
+	}
+	w.RawString("\"metadata\":")
+	in.ListMeta.MarshalEasyJSON(w)
+	w.RawString(",\"items\":")
+	if in.Items == nil {
+		w.RawString("null")
+	} else {
+		w.RawByte('[')
+		for i0 := range in.Items {
+			if i0 > 0 {
+				w.RawByte(',')
+			}
+			in.Items[i0].MarshalEasyJSON(w)
+		}
+		w.RawByte(']')
+	}
+	w.RawByte('}')
+}
+
+func (in *CertificateSigningRequestList) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "kind":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.TypeMeta.Kind = l.String()
+			}
+		case "apiVersion":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.TypeMeta.APIVersion = l.String()
+			}
+		case "metadata":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.ListMeta.UnmarshalEasyJSON(l)
+			}
+		case "items":
+			if l.IsNull() {
+				l.Skip()
+				in.Items = nil
+			} else {
+				in.Items = make([]CertificateSigningRequest, 0)
+				l.Delim('[')
+				for !l.IsDelim(']') {
+					var v0 CertificateSigningRequest
+					if l.IsNull() {
+						l.Skip()
+					} else {
+						v0.UnmarshalEasyJSON(l)
+					}
+					in.Items = append(in.Items, v0)
+					l.WantComma()
+				}
+				l.Delim(']')
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
+
 type CertificateSigningRequestSpec struct {
 	// request contains an x509 certificate signing request encoded in a "CERTIFICATE REQUEST" PEM block.
 	// When serialized as JSON or YAML, the data is additionally base64-encoded.
@@ -229,6 +628,231 @@ func (in *CertificateSigningRequestSpec) DeepCopy() *CertificateSigningRequestSp
 	return out
 }
 
+func (in *CertificateSigningRequestSpec) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *CertificateSigningRequestSpec) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *CertificateSigningRequestSpec) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	first := true
+	if len(in.Request) != 0 {
+		w.RawString("\"request\":")
+		w.Base64Bytes(in.Request)
+		first = false
+	}
+	if !first {
+		w.RawByte(',')
+	}
+	w.RawString("\"signerName\":")
+	w.String(in.SignerName)
+	if in.ExpirationSeconds != 0 {
+		w.RawString(",\"expirationSeconds\":")
+		w.Int(in.ExpirationSeconds)
+	}
+	w.RawString(",\"usages\":")
+	if in.Usages == nil {
+		w.RawString("null")
+	} else {
+		w.RawByte('[')
+		for i0 := range in.Usages {
+			if i0 > 0 {
+				w.RawByte(',')
+			}
+			w.String(string(in.Usages[i0]))
+		}
+		w.RawByte(']')
+	}
+	if in.Username != "" {
+		w.RawString(",\"username\":")
+		w.String(in.Username)
+	}
+	if in.UID != "" {
+		w.RawString(",\"uid\":")
+		w.String(in.UID)
+	}
+	w.RawString(",\"groups\":")
+	if in.Groups == nil {
+		w.RawString("null")
+	} else {
+		w.RawByte('[')
+		for i0 := range in.Groups {
+			if i0 > 0 {
+				w.RawByte(',')
+			}
+			w.String(in.Groups[i0])
+		}
+		w.RawByte(']')
+	}
+	if len(in.Extra) != 0 {
+		w.RawString(",\"extra\":")
+		if in.Extra == nil {
+			w.RawString("null")
+		} else {
+			w.RawByte('{')
+			for i0, k0 := range slices.Sorted(maps.Keys(in.Extra)) {
+				if i0 > 0 {
+					w.RawByte(',')
+				}
+				w.String(k0)
+				w.RawByte(':')
+				v0 := in.Extra[k0]
+				if v0 == nil {
+					w.RawString("null")
+				} else {
+					w.RawByte('[')
+					for i1 := range v0 {
+						if i1 > 0 {
+							w.RawByte(',')
+						}
+						w.String(v0[i1])
+					}
+					w.RawByte(']')
+				}
+			}
+			w.RawByte('}')
+		}
+	}
+	w.RawByte('}')
+}
+
+func (in *CertificateSigningRequestSpec) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "request":
+			if l.IsNull() {
+				l.Skip()
+				in.Request = nil
+			} else {
+				in.Request = l.Bytes()
+			}
+		case "signerName":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.SignerName = l.String()
+			}
+		case "expirationSeconds":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.ExpirationSeconds = l.Int()
+			}
+		case "usages":
+			if l.IsNull() {
+				l.Skip()
+				in.Usages = nil
+			} else {
+				in.Usages = make([]KeyUsage, 0)
+				l.Delim('[')
+				for !l.IsDelim(']') {
+					var v0 KeyUsage
+					if l.IsNull() {
+						l.Skip()
+					} else {
+						v0 = KeyUsage(l.String())
+					}
+					in.Usages = append(in.Usages, v0)
+					l.WantComma()
+				}
+				l.Delim(']')
+			}
+		case "username":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.Username = l.String()
+			}
+		case "uid":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.UID = l.String()
+			}
+		case "groups":
+			if l.IsNull() {
+				l.Skip()
+				in.Groups = nil
+			} else {
+				in.Groups = make([]string, 0)
+				l.Delim('[')
+				for !l.IsDelim(']') {
+					var v0 string
+					if l.IsNull() {
+						l.Skip()
+					} else {
+						v0 = l.String()
+					}
+					in.Groups = append(in.Groups, v0)
+					l.WantComma()
+				}
+				l.Delim(']')
+			}
+		case "extra":
+			if l.IsNull() {
+				l.Skip()
+				in.Extra = nil
+			} else {
+				if in.Extra == nil {
+					in.Extra = make(map[string]ExtraValue)
+				}
+				l.Delim('{')
+				for !l.IsDelim('}') {
+					k0 := l.String()
+					l.WantColon()
+					var v0 ExtraValue
+					if l.IsNull() {
+						l.Skip()
+						v0 = nil
+					} else {
+						v0 = make(ExtraValue, 0)
+						l.Delim('[')
+						for !l.IsDelim(']') {
+							var v1 string
+							if l.IsNull() {
+								l.Skip()
+							} else {
+								v1 = l.String()
+							}
+							v0 = append(v0, v1)
+							l.WantComma()
+						}
+						l.Delim(']')
+					}
+					in.Extra[k0] = v0
+					l.WantComma()
+				}
+				l.Delim('}')
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
+
 type CertificateSigningRequestStatus struct {
 	// conditions applied to the request. Known conditions are "Approved", "Denied", and "Failed".
 	Conditions []CertificateSigningRequestCondition `json:"conditions"`
@@ -275,6 +899,92 @@ func (in *CertificateSigningRequestStatus) DeepCopy() *CertificateSigningRequest
 	return out
 }
 
+func (in *CertificateSigningRequestStatus) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *CertificateSigningRequestStatus) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *CertificateSigningRequestStatus) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	w.RawString("\"conditions\":")
+	if in.Conditions == nil {
+		w.RawString("null")
+	} else {
+		w.RawByte('[')
+		for i0 := range in.Conditions {
+			if i0 > 0 {
+				w.RawByte(',')
+			}
+			in.Conditions[i0].MarshalEasyJSON(w)
+		}
+		w.RawByte(']')
+	}
+	if len(in.Certificate) != 0 {
+		w.RawString(",\"certificate\":")
+		w.Base64Bytes(in.Certificate)
+	}
+
+	w.RawByte('}')
+}
+
+func (in *CertificateSigningRequestStatus) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "conditions":
+			if l.IsNull() {
+				l.Skip()
+				in.Conditions = nil
+			} else {
+				in.Conditions = make([]CertificateSigningRequestCondition, 0)
+				l.Delim('[')
+				for !l.IsDelim(']') {
+					var v0 CertificateSigningRequestCondition
+					if l.IsNull() {
+						l.Skip()
+					} else {
+						v0.UnmarshalEasyJSON(l)
+					}
+					in.Conditions = append(in.Conditions, v0)
+					l.WantComma()
+				}
+				l.Delim(']')
+			}
+		case "certificate":
+			if l.IsNull() {
+				l.Skip()
+				in.Certificate = nil
+			} else {
+				in.Certificate = l.Bytes()
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
+
 type ExtraValue []string
 
 type CertificateSigningRequestCondition struct {
@@ -326,3 +1036,94 @@ func (in *CertificateSigningRequestCondition) DeepCopy() *CertificateSigningRequ
 	in.DeepCopyInto(out)
 	return out
 }
+
+func (in *CertificateSigningRequestCondition) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *CertificateSigningRequestCondition) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *CertificateSigningRequestCondition) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	w.RawString("\"type\":")
+	w.String(string(in.Type))
+	w.RawString(",\"status\":")
+	w.String(string(in.Status))
+	if in.Reason != "" {
+		w.RawString(",\"reason\":")
+		w.String(in.Reason)
+	}
+	if in.Message != "" {
+		w.RawString(",\"message\":")
+		w.String(in.Message)
+	}
+	if in.LastUpdateTime != nil {
+		w.RawString(",\"lastUpdateTime\":")
+		w.Raw(json.Marshal(in.LastUpdateTime))
+	}
+	if in.LastTransitionTime != nil {
+		w.RawString(",\"lastTransitionTime\":")
+		w.Raw(json.Marshal(in.LastTransitionTime))
+	}
+
+	w.RawByte('}')
+}
+
+func (in *CertificateSigningRequestCondition) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "type":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.Type = RequestConditionType(l.String())
+			}
+		case "status":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.Status = corev1.ConditionStatus(l.String())
+			}
+		case "reason":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.Reason = l.String()
+			}
+		case "message":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.Message = l.String()
+			}
+		case "lastUpdateTime":
+			l.AddError(json.Unmarshal(l.Raw(), &in.LastUpdateTime))
+		case "lastTransitionTime":
+			l.AddError(json.Unmarshal(l.Raw(), &in.LastTransitionTime))
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
@@ -1,7 +1,11 @@
 package coordinationv1
 
 import (
+	"github.com/mailru/easyjson/jlexer"
+	"github.com/mailru/easyjson/jwriter"
 	"go.f110.dev/kubeproto/go/apis/metav1"
+	"go.f110.dev/kubeproto/go/patch"
+	"k8s.io/apimachinery/pkg/fields"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 )
@@ -65,6 +69,218 @@ func (in *Lease) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// LeaseToSelectableFields returns the fields of Lease which can be used by the field selector.
+func LeaseToSelectableFields(obj *Lease) fields.Set {
+	set := fields.Set{
+		"metadata.name":      obj.ObjectMeta.Name,
+		"metadata.namespace": obj.ObjectMeta.Namespace,
+	}
+	return set
+}
+
+// LeasePatch builds JSON patch or JSON merge patch of Lease.
+// JSON patch fails if the parent of the field doesn't exist (e.g. SetLabel to the object which has no label). MergePatch creates the parent.
+type LeasePatch struct {
+	*patch.Builder
+}
+
+func NewLeasePatch() *LeasePatch {
+	return &LeasePatch{Builder: patch.NewBuilder()}
+}
+
+func (p *LeasePatch) SetLabel(key, value string) *LeasePatch {
+	p.Set([]string{"metadata", "labels", key}, value)
+	return p
+}
+
+func (p *LeasePatch) RemoveLabel(key string) *LeasePatch {
+	p.Remove([]string{"metadata", "labels", key})
+	return p
+}
+
+func (p *LeasePatch) SetAnnotation(key, value string) *LeasePatch {
+	p.Set([]string{"metadata", "annotations", key}, value)
+	return p
+}
+
+func (p *LeasePatch) RemoveAnnotation(key string) *LeasePatch {
+	p.Remove([]string{"metadata", "annotations", key})
+	return p
+}
+
+func (p *LeasePatch) SetSpec(v *LeaseSpec) *LeasePatch {
+	p.Set([]string{"spec"}, v)
+	return p
+}
+
+func (p *LeasePatch) RemoveSpec() *LeasePatch {
+	p.Remove([]string{"spec"})
+	return p
+}
+
+func (p *LeasePatch) SetSpecHolderIdentity(v string) *LeasePatch {
+	p.Set([]string{"spec", "holderIdentity"}, v)
+	return p
+}
+
+func (p *LeasePatch) RemoveSpecHolderIdentity() *LeasePatch {
+	p.Remove([]string{"spec", "holderIdentity"})
+	return p
+}
+
+func (p *LeasePatch) SetSpecLeaseDurationSeconds(v int) *LeasePatch {
+	p.Set([]string{"spec", "leaseDurationSeconds"}, v)
+	return p
+}
+
+func (p *LeasePatch) RemoveSpecLeaseDurationSeconds() *LeasePatch {
+	p.Remove([]string{"spec", "leaseDurationSeconds"})
+	return p
+}
+
+func (p *LeasePatch) SetSpecAcquireTime(v *metav1.MicroTime) *LeasePatch {
+	p.Set([]string{"spec", "acquireTime"}, v)
+	return p
+}
+
+func (p *LeasePatch) RemoveSpecAcquireTime() *LeasePatch {
+	p.Remove([]string{"spec", "acquireTime"})
+	return p
+}
+
+func (p *LeasePatch) SetSpecRenewTime(v *metav1.MicroTime) *LeasePatch {
+	p.Set([]string{"spec", "renewTime"}, v)
+	return p
+}
+
+func (p *LeasePatch) RemoveSpecRenewTime() *LeasePatch {
+	p.Remove([]string{"spec", "renewTime"})
+	return p
+}
+
+func (p *LeasePatch) SetSpecLeaseTransitions(v int) *LeasePatch {
+	p.Set([]string{"spec", "leaseTransitions"}, v)
+	return p
+}
+
+func (p *LeasePatch) RemoveSpecLeaseTransitions() *LeasePatch {
+	p.Remove([]string{"spec", "leaseTransitions"})
+	return p
+}
+
+func (p *LeasePatch) SetSpecStrategy(v CoordinatedLeaseStrategy) *LeasePatch {
+	p.Set([]string{"spec", "strategy"}, v)
+	return p
+}
+
+func (p *LeasePatch) RemoveSpecStrategy() *LeasePatch {
+	p.Remove([]string{"spec", "strategy"})
+	return p
+}
+
+func (p *LeasePatch) SetSpecPreferredHolder(v string) *LeasePatch {
+	p.Set([]string{"spec", "preferredHolder"}, v)
+	return p
+}
+
+func (p *LeasePatch) RemoveSpecPreferredHolder() *LeasePatch {
+	p.Remove([]string{"spec", "preferredHolder"})
+	return p
+}
+
+func (in *Lease) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *Lease) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *Lease) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	first := true
+	if in.TypeMeta.Kind != "" {
+		w.RawString("\"kind\":")
+		w.String(in.TypeMeta.Kind)
+		first = false
+	}
+	if in.TypeMeta.APIVersion != "" {
+		if !first {
+			w.RawByte(',')
+		}
+		w.RawString("\"apiVersion\":")
+		w.String(in.TypeMeta.APIVersion)
+		first = false
+	}
+	if !first {
+		w.RawByte(',')
+	}
+	w.RawString("\"metadata\":")
+	in.ObjectMeta.MarshalEasyJSON(w)
+	if in.Spec != nil {
+		w.RawString(",\"spec\":")
+		in.Spec.MarshalEasyJSON(w)
+	}
+	w.RawByte('}')
+}
+
+func (in *Lease) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "kind":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.TypeMeta.Kind = l.String()
+			}
+		case "apiVersion":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.TypeMeta.APIVersion = l.String()
+			}
+		case "metadata":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.ObjectMeta.UnmarshalEasyJSON(l)
+			}
+		case "spec":
+			if l.IsNull() {
+				l.Skip()
+				in.Spec = nil
+			} else {
+				if in.Spec == nil {
+					in.Spec = new(LeaseSpec)
+				}
+				in.Spec.UnmarshalEasyJSON(l)
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
+
 type LeaseList struct {
 	metav1.TypeMeta `json:",inline"`
 	metav1.ListMeta `json:"metadata"`
@@ -100,6 +316,117 @@ func (in *LeaseList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+func (in *LeaseList) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *LeaseList) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *LeaseList) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	first := true
+	if in.TypeMeta.Kind != "" {
+		w.RawString("\"kind\":")
+		w.String(in.TypeMeta.Kind)
+		first = false
+	}
+	if in.TypeMeta.APIVersion != "" {
+		if !first {
+			w.RawByte(',')
+		}
+		w.RawString("\"apiVersion\":")
+		w.String(in.TypeMeta.APIVersion)
+		first = false
+	}
+	if !first {
+		w.RawByte(',')
+	}
+	w.RawString("\"metadata\":")
+	in.ListMeta.MarshalEasyJSON(w)
+	w.RawString(",\"items\":")
+	if in.Items == nil {
+		w.RawString("null")
+	} else {
+		w.RawByte('[')
+		for i0 := range in.Items {
+			if i0 > 0 {
+				w.RawByte(',')
+			}
+			in.Items[i0].MarshalEasyJSON(w)
+		}
+		w.RawByte(']')
+	}
+	w.RawByte('}')
+}
+
+func (in *LeaseList) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "kind":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.TypeMeta.Kind = l.String()
+			}
+		case "apiVersion":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.TypeMeta.APIVersion = l.String()
+			}
+		case "metadata":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.ListMeta.UnmarshalEasyJSON(l)
+			}
+		case "items":
+			if l.IsNull() {
+				l.Skip()
+				in.Items = nil
+			} else {
+				in.Items = make([]Lease, 0)
+				l.Delim('[')
+				for !l.IsDelim(']') {
+					var v0 Lease
+					if l.IsNull() {
+						l.Skip()
+					} else {
+						v0.UnmarshalEasyJSON(l)
+					}
+					in.Items = append(in.Items, v0)
+					l.WantComma()
+				}
+				l.Delim(']')
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
+
 type LeaseSpec struct {
 	// holderIdentity contains the identity of the holder of a current lease.
 	// If Coordinated Leader Election is used, the holder identity must be
@@ -149,3 +476,149 @@ func (in *LeaseSpec) DeepCopy() *LeaseSpec {
 	in.DeepCopyInto(out)
 	return out
 }
+
+func (in *LeaseSpec) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *LeaseSpec) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *LeaseSpec) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	first := true
+	if in.HolderIdentity != "" {
+		w.RawString("\"holderIdentity\":")
+		w.String(in.HolderIdentity)
+		first = false
+	}
+	if in.LeaseDurationSeconds != 0 {
+		if !first {
+			w.RawByte(',')
+		}
+		w.RawString("\"leaseDurationSeconds\":")
+		w.Int(in.LeaseDurationSeconds)
+		first = false
+	}
+	if in.AcquireTime != nil {
+		if !first {
+			w.RawByte(',')
+		}
+		w.RawString("\"acquireTime\":")
+		in.AcquireTime.MarshalEasyJSON(w)
+		first = false
+	}
+	if in.RenewTime != nil {
+		if !first {
+			w.RawByte(',')
+		}
+		w.RawString("\"renewTime\":")
+		in.RenewTime.MarshalEasyJSON(w)
+		first = false
+	}
+	if in.LeaseTransitions != 0 {
+		if !first {
+			w.RawByte(',')
+		}
+		w.RawString("\"leaseTransitions\":")
+		w.Int(in.LeaseTransitions)
+		first = false
+	}
+	if in.Strategy != "" {
+		if !first {
+			w.RawByte(',')
+		}
+		w.RawString("\"strategy\":")
+		w.String(string(in.Strategy))
+		first = false
+	}
+	if in.PreferredHolder != "" {
+		if !first {
+			w.RawByte(',')
+		}
+		w.RawString("\"preferredHolder\":")
+		w.String(in.PreferredHolder)
+		first = false
+	}
+	w.RawByte('}')
+}
+
+func (in *LeaseSpec) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "holderIdentity":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.HolderIdentity = l.String()
+			}
+		case "leaseDurationSeconds":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.LeaseDurationSeconds = l.Int()
+			}
+		case "acquireTime":
+			if l.IsNull() {
+				l.Skip()
+				in.AcquireTime = nil
+			} else {
+				if in.AcquireTime == nil {
+					in.AcquireTime = new(metav1.MicroTime)
+				}
+				in.AcquireTime.UnmarshalEasyJSON(l)
+			}
+		case "renewTime":
+			if l.IsNull() {
+				l.Skip()
+				in.RenewTime = nil
+			} else {
+				if in.RenewTime == nil {
+					in.RenewTime = new(metav1.MicroTime)
+				}
+				in.RenewTime.UnmarshalEasyJSON(l)
+			}
+		case "leaseTransitions":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.LeaseTransitions = l.Int()
+			}
+		case "strategy":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.Strategy = CoordinatedLeaseStrategy(l.String())
+			}
+		case "preferredHolder":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.PreferredHolder = l.String()
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
@@ -0,0 +1,37 @@
+package corev1
+
+import (
+	"encoding/json"
+	"testing"
+
+	"go.f110.dev/kubeproto/go/apis/metav1"
+	"go.f110.dev/kubeproto/go/internal/assertion"
+)
+
+func TestPodJSON(t *testing.T) {
+	pod := &Pod{
+		TypeMeta:   metav1.TypeMeta{Kind: "Pod", APIVersion: "v1"},
+		ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: metav1.NamespaceDefault, Labels: map[string]string{"b": "2", "a": "1"}},
+		Spec: &PodSpec{
+			Containers:    []Container{{Name: "app", Image: "nginx:latest", Args: []string{"<arg>"}}},
+			RestartPolicy: RestartPolicyAlways,
+		},
+	}
+	buf, err := json.Marshal(pod)
+	assertion.MustNoError(t, err)
+	assertion.Equal(t, `{"kind":"Pod","apiVersion":"v1","metadata":{"name":"test","namespace":"default","labels":{"a":"1","b":"2"},"ownerReferences":null,"finalizers":null,"managedFields":null},"spec":{"volumes":null,"initContainers":null,"containers":[{"name":"app","image":"nginx:latest","command":null,"args":["\u003carg\u003e"],"ports":null,"envFrom":null,"env":null,"resizePolicy":null,"restartPolicyRules":null,"volumeMounts":null,"volumeDevices":null}],"ephemeralContainers":null,"restartPolicy":"Always","imagePullSecrets":null,"tolerations":null,"hostAliases":null,"readinessGates":null,"topologySpreadConstraints":null,"schedulingGates":null,"resourceClaims":null}}`, string(buf))
+
+	decoded := &Pod{}
+	err = json.Unmarshal(buf, decoded)
+	assertion.MustNoError(t, err)
+	assertion.Equal(t, "Pod", decoded.Kind)
+	assertion.Equal(t, "test", decoded.Name)
+	assertion.Equal(t, "2", decoded.Labels["b"])
+	assertion.Len(t, decoded.Spec.Containers, 1)
+	assertion.Equal(t, "<arg>", decoded.Spec.Containers[0].Args[0])
+	assertion.Equal(t, RestartPolicyAlways, decoded.Spec.RestartPolicy)
+	assertion.Equal(t, true, decoded.Status == nil)
+
+	err = json.Unmarshal([]byte(`{"metadata":{"name":1}}`), decoded)
+	assertion.Equal(t, true, err != nil)
+}
@@ -0,0 +1,31 @@
+package corev1
+
+import (
+	"testing"
+
+	"go.f110.dev/kubeproto/go/internal/assertion"
+)
+
+func TestPodPatch(t *testing.T) {
+	p := NewPodPatch().
+		SetLabel("app", "nginx").
+		SetSpecNodeName("node-1").
+		SetSpecSecurityContextRunAsNonRoot(true).
+		RemoveSpecActiveDeadlineSeconds()
+	buf, err := p.JSONPatch()
+	assertion.MustNoError(t, err)
+	assertion.Equal(t,
+		`[{"op":"add","path":"/metadata/labels/app","value":"nginx"},{"op":"add","path":"/spec/nodeName","value":"node-1"},{"op":"add","path":"/spec/securityContext/runAsNonRoot","value":true},{"op":"remove","path":"/spec/activeDeadlineSeconds"}]`,
+		string(buf),
+	)
+
+	buf, err = p.MergePatch()
+	assertion.MustNoError(t, err)
+	assertion.Equal(t,
+		`{"metadata":{"labels":{"app":"nginx"}},"spec":{"activeDeadlineSeconds":null,"nodeName":"node-1","securityContext":{"runAsNonRoot":true}}}`,
+		string(buf),
+	)
+
+	_, err = NewPodPatch().AddSpecContainers(Container{Name: "sidecar"}).MergePatch()
+	assertion.Equal(t, true, err != nil)
+}
@@ -1,10 +1,16 @@
 package discoveryv1
 
 import (
+	"github.com/mailru/easyjson/jlexer"
+	"github.com/mailru/easyjson/jwriter"
 	"go.f110.dev/kubeproto/go/apis/corev1"
 	"go.f110.dev/kubeproto/go/apis/metav1"
+	"go.f110.dev/kubeproto/go/patch"
+	"k8s.io/apimachinery/pkg/fields"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"maps"
+	"slices"
 )
 
 const GroupName = "discovery.k8s.io"
@@ -95,6 +101,240 @@ func (in *EndpointSlice) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// EndpointSliceToSelectableFields returns the fields of EndpointSlice which can be used by the field selector.
+func EndpointSliceToSelectableFields(obj *EndpointSlice) fields.Set {
+	set := fields.Set{
+		"metadata.name":      obj.ObjectMeta.Name,
+		"metadata.namespace": obj.ObjectMeta.Namespace,
+	}
+	return set
+}
+
+// EndpointSlicePatch builds JSON patch or JSON merge patch of EndpointSlice.
+// JSON patch fails if the parent of the field doesn't exist (e.g. SetLabel to the object which has no label). MergePatch creates the parent.
+type EndpointSlicePatch struct {
+	*patch.Builder
+}
+
+func NewEndpointSlicePatch() *EndpointSlicePatch {
+	return &EndpointSlicePatch{Builder: patch.NewBuilder()}
+}
+
+func (p *EndpointSlicePatch) SetLabel(key, value string) *EndpointSlicePatch {
+	p.Set([]string{"metadata", "labels", key}, value)
+	return p
+}
+
+func (p *EndpointSlicePatch) RemoveLabel(key string) *EndpointSlicePatch {
+	p.Remove([]string{"metadata", "labels", key})
+	return p
+}
+
+func (p *EndpointSlicePatch) SetAnnotation(key, value string) *EndpointSlicePatch {
+	p.Set([]string{"metadata", "annotations", key}, value)
+	return p
+}
+
+func (p *EndpointSlicePatch) RemoveAnnotation(key string) *EndpointSlicePatch {
+	p.Remove([]string{"metadata", "annotations", key})
+	return p
+}
+
+func (p *EndpointSlicePatch) SetAddressType(v AddressType) *EndpointSlicePatch {
+	p.Set([]string{"addressType"}, v)
+	return p
+}
+
+func (p *EndpointSlicePatch) RemoveAddressType() *EndpointSlicePatch {
+	p.Remove([]string{"addressType"})
+	return p
+}
+
+func (p *EndpointSlicePatch) SetEndpoints(v []Endpoint) *EndpointSlicePatch {
+	p.Set([]string{"endpoints"}, v)
+	return p
+}
+
+func (p *EndpointSlicePatch) RemoveEndpoints() *EndpointSlicePatch {
+	p.Remove([]string{"endpoints"})
+	return p
+}
+
+func (p *EndpointSlicePatch) AddEndpoints(v ...Endpoint) *EndpointSlicePatch {
+	for _, e := range v {
+		p.Append([]string{"endpoints"}, e)
+	}
+	return p
+}
+
+func (p *EndpointSlicePatch) SetPorts(v []EndpointPort) *EndpointSlicePatch {
+	p.Set([]string{"ports"}, v)
+	return p
+}
+
+func (p *EndpointSlicePatch) RemovePorts() *EndpointSlicePatch {
+	p.Remove([]string{"ports"})
+	return p
+}
+
+func (p *EndpointSlicePatch) AddPorts(v ...EndpointPort) *EndpointSlicePatch {
+	for _, e := range v {
+		p.Append([]string{"ports"}, e)
+	}
+	return p
+}
+
+func (in *EndpointSlice) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *EndpointSlice) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *EndpointSlice) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	first := true
+	if in.TypeMeta.Kind != "" {
+		w.RawString("\"kind\":")
+		w.String(in.TypeMeta.Kind)
+		first = false
+	}
+	if in.TypeMeta.APIVersion != "" {
+		if !first {
+			w.RawByte(',')
+		}
+		w.RawString("\"apiVersion\":")
+		w.String(in.TypeMeta.APIVersion)
+		first = false
+	}
+	if !first {
+		w.RawByte(',')
+	}
+	w.RawString("\"metadata\":")
+	in.ObjectMeta.MarshalEasyJSON(w)
+	w.RawString(",\"addressType\":")
+	w.String(string(in.AddressType))
+	w.RawString(",\"endpoints\":")
+	if in.Endpoints == nil {
+		w.RawString("null")
+	} else {
+		w.RawByte('[')
+		for i0 := range in.Endpoints {
+			if i0 > 0 {
+				w.RawByte(',')
+			}
+			in.Endpoints[i0].MarshalEasyJSON(w)
+		}
+		w.RawByte(']')
+	}
+	w.RawString(",\"ports\":")
+	if in.Ports == nil {
+		w.RawString("null")
+	} else {
+		w.RawByte('[')
+		for i0 := range in.Ports {
+			if i0 > 0 {
+				w.RawByte(',')
+			}
+			in.Ports[i0].MarshalEasyJSON(w)
+		}
+		w.RawByte(']')
+	}
+	w.RawByte('}')
+}
+
+func (in *EndpointSlice) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "kind":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.TypeMeta.Kind = l.String()
+			}
+		case "apiVersion":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.TypeMeta.APIVersion = l.String()
+			}
+		case "metadata":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.ObjectMeta.UnmarshalEasyJSON(l)
+			}
+		case "addressType":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.AddressType = AddressType(l.String())
+			}
+		case "endpoints":
+			if l.IsNull() {
+				l.Skip()
+				in.Endpoints = nil
+			} else {
+				in.Endpoints = make([]Endpoint, 0)
+				l.Delim('[')
+				for !l.IsDelim(']') {
+					var v0 Endpoint
+					if l.IsNull() {
+						l.Skip()
+					} else {
+						v0.UnmarshalEasyJSON(l)
+					}
+					in.Endpoints = append(in.Endpoints, v0)
+					l.WantComma()
+				}
+				l.Delim(']')
+			}
+		case "ports":
+			if l.IsNull() {
+				l.Skip()
+				in.Ports = nil
+			} else {
+				in.Ports = make([]EndpointPort, 0)
+				l.Delim('[')
+				for !l.IsDelim(']') {
+					var v0 EndpointPort
+					if l.IsNull() {
+						l.Skip()
+					} else {
+						v0.UnmarshalEasyJSON(l)
+					}
+					in.Ports = append(in.Ports, v0)
+					l.WantComma()
+				}
+				l.Delim(']')
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
+
 type EndpointSliceList struct {
 	metav1.TypeMeta `json:",inline"`
 	metav1.ListMeta `json:"metadata"`
@@ -130,6 +370,117 @@ func (in *EndpointSliceList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+func (in *EndpointSliceList) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *EndpointSliceList) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *EndpointSliceList) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	first := true
+	if in.TypeMeta.Kind != "" {
+		w.RawString("\"kind\":")
+		w.String(in.TypeMeta.Kind)
+		first = false
+	}
+	if in.TypeMeta.APIVersion != "" {
+		if !first {
+			w.RawByte(',')
+		}
+		w.RawString("\"apiVersion\":")
+		w.String(in.TypeMeta.APIVersion)
+		first = false
+	}
+	if !first {
+		w.RawByte(',')
+	}
+	w.RawString("\"metadata\":")
+	in.ListMeta.MarshalEasyJSON(w)
+	w.RawString(",\"items\":")
+	if in.Items == nil {
+		w.RawString("null")
+	} else {
+		w.RawByte('[')
+		for i0 := range in.Items {
+			if i0 > 0 {
+				w.RawByte(',')
+			}
+			in.Items[i0].MarshalEasyJSON(w)
+		}
+		w.RawByte(']')
+	}
+	w.RawByte('}')
+}
+
+func (in *EndpointSliceList) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "kind":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.TypeMeta.Kind = l.String()
+			}
+		case "apiVersion":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.TypeMeta.APIVersion = l.String()
+			}
+		case "metadata":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.ListMeta.UnmarshalEasyJSON(l)
+			}
+		case "items":
+			if l.IsNull() {
+				l.Skip()
+				in.Items = nil
+			} else {
+				in.Items = make([]EndpointSlice, 0)
+				l.Delim('[')
+				for !l.IsDelim(']') {
+					var v0 EndpointSlice
+					if l.IsNull() {
+						l.Skip()
+					} else {
+						v0.UnmarshalEasyJSON(l)
+					}
+					in.Items = append(in.Items, v0)
+					l.WantComma()
+				}
+				l.Delim(']')
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
+
 type Endpoint struct {
 	// addresses of this endpoint. For EndpointSlices of addressType "IPv4" or "IPv6",
 	// the values are IP addresses in canonical form. The syntax and semantics of
@@ -206,6 +557,194 @@ func (in *Endpoint) DeepCopy() *Endpoint {
 	return out
 }
 
+func (in *Endpoint) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *Endpoint) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *Endpoint) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	w.RawString("\"addresses\":")
+	if in.Addresses == nil {
+		w.RawString("null")
+	} else {
+		w.RawByte('[')
+		for i0 := range in.Addresses {
+			if i0 > 0 {
+				w.RawByte(',')
+			}
+			w.String(in.Addresses[i0])
+		}
+		w.RawByte(']')
+	}
+	if in.Conditions != nil {
+		w.RawString(",\"conditions\":")
+		in.Conditions.MarshalEasyJSON(w)
+	}
+	if in.Hostname != "" {
+		w.RawString(",\"hostname\":")
+		w.String(in.Hostname)
+	}
+	if in.TargetRef != nil {
+		w.RawString(",\"targetRef\":")
+		in.TargetRef.MarshalEasyJSON(w)
+	}
+	if len(in.DeprecatedTopology) != 0 {
+		w.RawString(",\"deprecatedTopology\":")
+		if in.DeprecatedTopology == nil {
+			w.RawString("null")
+		} else {
+			w.RawByte('{')
+			for i0, k0 := range slices.Sorted(maps.Keys(in.DeprecatedTopology)) {
+				if i0 > 0 {
+					w.RawByte(',')
+				}
+				w.String(k0)
+				w.RawByte(':')
+				v0 := in.DeprecatedTopology[k0]
+				w.String(v0)
+			}
+			w.RawByte('}')
+		}
+	}
+	if in.NodeName != "" {
+		w.RawString(",\"nodeName\":")
+		w.String(in.NodeName)
+	}
+	if in.Zone != "" {
+		w.RawString(",\"zone\":")
+		w.String(in.Zone)
+	}
+	if in.Hints != nil {
+		w.RawString(",\"hints\":")
+		in.Hints.MarshalEasyJSON(w)
+	}
+
+	w.RawByte('}')
+}
+
+func (in *Endpoint) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "addresses":
+			if l.IsNull() {
+				l.Skip()
+				in.Addresses = nil
+			} else {
+				in.Addresses = make([]string, 0)
+				l.Delim('[')
+				for !l.IsDelim(']') {
+					var v0 string
+					if l.IsNull() {
+						l.Skip()
+					} else {
+						v0 = l.String()
+					}
+					in.Addresses = append(in.Addresses, v0)
+					l.WantComma()
+				}
+				l.Delim(']')
+			}
+		case "conditions":
+			if l.IsNull() {
+				l.Skip()
+				in.Conditions = nil
+			} else {
+				if in.Conditions == nil {
+					in.Conditions = new(EndpointConditions)
+				}
+				in.Conditions.UnmarshalEasyJSON(l)
+			}
+		case "hostname":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.Hostname = l.String()
+			}
+		case "targetRef":
+			if l.IsNull() {
+				l.Skip()
+				in.TargetRef = nil
+			} else {
+				if in.TargetRef == nil {
+					in.TargetRef = new(corev1.ObjectReference)
+				}
+				in.TargetRef.UnmarshalEasyJSON(l)
+			}
+		case "deprecatedTopology":
+			if l.IsNull() {
+				l.Skip()
+				in.DeprecatedTopology = nil
+			} else {
+				if in.DeprecatedTopology == nil {
+					in.DeprecatedTopology = make(map[string]string)
+				}
+				l.Delim('{')
+				for !l.IsDelim('}') {
+					k0 := l.String()
+					l.WantColon()
+					var v0 string
+					if l.IsNull() {
+						l.Skip()
+					} else {
+						v0 = l.String()
+					}
+					in.DeprecatedTopology[k0] = v0
+					l.WantComma()
+				}
+				l.Delim('}')
+			}
+		case "nodeName":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.NodeName = l.String()
+			}
+		case "zone":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.Zone = l.String()
+			}
+		case "hints":
+			if l.IsNull() {
+				l.Skip()
+				in.Hints = nil
+			} else {
+				if in.Hints == nil {
+					in.Hints = new(EndpointHints)
+				}
+				in.Hints.UnmarshalEasyJSON(l)
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
+
 type EndpointPort struct {
 	// name represents the name of this port. All ports in an EndpointSlice must have a unique name.
 	// If the EndpointSlice is derived from a Kubernetes service, this corresponds to the Service.ports[].name.
@@ -252,6 +791,102 @@ func (in *EndpointPort) DeepCopy() *EndpointPort {
 	return out
 }
 
+func (in *EndpointPort) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *EndpointPort) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *EndpointPort) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	first := true
+	if in.Name != "" {
+		w.RawString("\"name\":")
+		w.String(in.Name)
+		first = false
+	}
+	if in.Protocol != "" {
+		if !first {
+			w.RawByte(',')
+		}
+		w.RawString("\"protocol\":")
+		w.String(string(in.Protocol))
+		first = false
+	}
+	if in.Port != 0 {
+		if !first {
+			w.RawByte(',')
+		}
+		w.RawString("\"port\":")
+		w.Int(in.Port)
+		first = false
+	}
+	if in.AppProtocol != "" {
+		if !first {
+			w.RawByte(',')
+		}
+		w.RawString("\"appProtocol\":")
+		w.String(in.AppProtocol)
+		first = false
+	}
+	w.RawByte('}')
+}
+
+func (in *EndpointPort) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "name":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.Name = l.String()
+			}
+		case "protocol":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.Protocol = corev1.Protocol(l.String())
+			}
+		case "port":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.Port = l.Int()
+			}
+		case "appProtocol":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.AppProtocol = l.String()
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
+
 type EndpointConditions struct {
 	// ready indicates that this endpoint is ready to receive traffic,
 	// according to whatever system is managing the endpoint. A nil value
@@ -284,6 +919,88 @@ func (in *EndpointConditions) DeepCopy() *EndpointConditions {
 	return out
 }
 
+func (in *EndpointConditions) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *EndpointConditions) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *EndpointConditions) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	first := true
+	if in.Ready {
+		w.RawString("\"ready\":")
+		w.Bool(in.Ready)
+		first = false
+	}
+	if in.Serving {
+		if !first {
+			w.RawByte(',')
+		}
+		w.RawString("\"serving\":")
+		w.Bool(in.Serving)
+		first = false
+	}
+	if in.Terminating {
+		if !first {
+			w.RawByte(',')
+		}
+		w.RawString("\"terminating\":")
+		w.Bool(in.Terminating)
+		first = false
+	}
+	w.RawByte('}')
+}
+
+func (in *EndpointConditions) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "ready":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.Ready = l.Bool()
+			}
+		case "serving":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.Serving = l.Bool()
+			}
+		case "terminating":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.Terminating = l.Bool()
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
+
 type EndpointHints struct {
 	// forZones indicates the zone(s) this endpoint should be consumed by when
 	// using topology aware routing. May contain a maximum of 8 entries.
@@ -320,6 +1037,113 @@ func (in *EndpointHints) DeepCopy() *EndpointHints {
 	return out
 }
 
+func (in *EndpointHints) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *EndpointHints) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *EndpointHints) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	w.RawString("\"forZones\":")
+	if in.ForZones == nil {
+		w.RawString("null")
+	} else {
+		w.RawByte('[')
+		for i0 := range in.ForZones {
+			if i0 > 0 {
+				w.RawByte(',')
+			}
+			in.ForZones[i0].MarshalEasyJSON(w)
+		}
+		w.RawByte(']')
+	}
+	w.RawString(",\"forNodes\":")
+	if in.ForNodes == nil {
+		w.RawString("null")
+	} else {
+		w.RawByte('[')
+		for i0 := range in.ForNodes {
+			if i0 > 0 {
+				w.RawByte(',')
+			}
+			in.ForNodes[i0].MarshalEasyJSON(w)
+		}
+		w.RawByte(']')
+	}
+
+	w.RawByte('}')
+}
+
+func (in *EndpointHints) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "forZones":
+			if l.IsNull() {
+				l.Skip()
+				in.ForZones = nil
+			} else {
+				in.ForZones = make([]ForZone, 0)
+				l.Delim('[')
+				for !l.IsDelim(']') {
+					var v0 ForZone
+					if l.IsNull() {
+						l.Skip()
+					} else {
+						v0.UnmarshalEasyJSON(l)
+					}
+					in.ForZones = append(in.ForZones, v0)
+					l.WantComma()
+				}
+				l.Delim(']')
+			}
+		case "forNodes":
+			if l.IsNull() {
+				l.Skip()
+				in.ForNodes = nil
+			} else {
+				in.ForNodes = make([]ForNode, 0)
+				l.Delim('[')
+				for !l.IsDelim(']') {
+					var v0 ForNode
+					if l.IsNull() {
+						l.Skip()
+					} else {
+						v0.UnmarshalEasyJSON(l)
+					}
+					in.ForNodes = append(in.ForNodes, v0)
+					l.WantComma()
+				}
+				l.Delim(']')
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
+
 type ForZone struct {
 	// name represents the name of the zone.
 	Name string `json:"name"`
@@ -338,6 +1162,57 @@ func (in *ForZone) DeepCopy() *ForZone {
 	return out
 }
 
+func (in *ForZone) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *ForZone) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *ForZone) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	w.RawString("\"name\":")
+	w.String(in.Name)
+
+	w.RawByte('}')
+}
+
+func (in *ForZone) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "name":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.Name = l.String()
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
+
 type ForNode struct {
 	// name represents the name of the node.
 	Name string `json:"name"`
@@ -355,3 +1230,54 @@ func (in *ForNode) DeepCopy() *ForNode {
 	in.DeepCopyInto(out)
 	return out
 }
+
+func (in *ForNode) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *ForNode) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *ForNode) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	w.RawString("\"name\":")
+	w.String(in.Name)
+
+	w.RawByte('}')
+}
+
+func (in *ForNode) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "name":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.Name = l.String()
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
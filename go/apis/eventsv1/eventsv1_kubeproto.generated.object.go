@@ -1,8 +1,13 @@
 package eventsv1
 
 import (
+	"encoding/json"
+	"github.com/mailru/easyjson/jlexer"
+	"github.com/mailru/easyjson/jwriter"
 	"go.f110.dev/kubeproto/go/apis/corev1"
 	"go.f110.dev/kubeproto/go/apis/metav1"
+	"go.f110.dev/kubeproto/go/patch"
+	"k8s.io/apimachinery/pkg/fields"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 )
@@ -122,6 +127,430 @@ func (in *Event) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// EventToSelectableFields returns the fields of Event which can be used by the field selector.
+func EventToSelectableFields(obj *Event) fields.Set {
+	set := fields.Set{
+		"metadata.name":      obj.ObjectMeta.Name,
+		"metadata.namespace": obj.ObjectMeta.Namespace,
+	}
+	return set
+}
+
+// EventPatch builds JSON patch or JSON merge patch of Event.
+// JSON patch fails if the parent of the field doesn't exist (e.g. SetLabel to the object which has no label). MergePatch creates the parent.
+type EventPatch struct {
+	*patch.Builder
+}
+
+func NewEventPatch() *EventPatch {
+	return &EventPatch{Builder: patch.NewBuilder()}
+}
+
+func (p *EventPatch) SetLabel(key, value string) *EventPatch {
+	p.Set([]string{"metadata", "labels", key}, value)
+	return p
+}
+
+func (p *EventPatch) RemoveLabel(key string) *EventPatch {
+	p.Remove([]string{"metadata", "labels", key})
+	return p
+}
+
+func (p *EventPatch) SetAnnotation(key, value string) *EventPatch {
+	p.Set([]string{"metadata", "annotations", key}, value)
+	return p
+}
+
+func (p *EventPatch) RemoveAnnotation(key string) *EventPatch {
+	p.Remove([]string{"metadata", "annotations", key})
+	return p
+}
+
+func (p *EventPatch) SetEventTime(v metav1.MicroTime) *EventPatch {
+	p.Set([]string{"eventTime"}, v)
+	return p
+}
+
+func (p *EventPatch) RemoveEventTime() *EventPatch {
+	p.Remove([]string{"eventTime"})
+	return p
+}
+
+func (p *EventPatch) SetSeries(v *EventSeries) *EventPatch {
+	p.Set([]string{"series"}, v)
+	return p
+}
+
+func (p *EventPatch) RemoveSeries() *EventPatch {
+	p.Remove([]string{"series"})
+	return p
+}
+
+func (p *EventPatch) SetSeriesCount(v int) *EventPatch {
+	p.Set([]string{"series", "count"}, v)
+	return p
+}
+
+func (p *EventPatch) RemoveSeriesCount() *EventPatch {
+	p.Remove([]string{"series", "count"})
+	return p
+}
+
+func (p *EventPatch) SetSeriesLastObservedTime(v metav1.MicroTime) *EventPatch {
+	p.Set([]string{"series", "lastObservedTime"}, v)
+	return p
+}
+
+func (p *EventPatch) RemoveSeriesLastObservedTime() *EventPatch {
+	p.Remove([]string{"series", "lastObservedTime"})
+	return p
+}
+
+func (p *EventPatch) SetReportingController(v string) *EventPatch {
+	p.Set([]string{"reportingController"}, v)
+	return p
+}
+
+func (p *EventPatch) RemoveReportingController() *EventPatch {
+	p.Remove([]string{"reportingController"})
+	return p
+}
+
+func (p *EventPatch) SetReportingInstance(v string) *EventPatch {
+	p.Set([]string{"reportingInstance"}, v)
+	return p
+}
+
+func (p *EventPatch) RemoveReportingInstance() *EventPatch {
+	p.Remove([]string{"reportingInstance"})
+	return p
+}
+
+func (p *EventPatch) SetAction(v string) *EventPatch {
+	p.Set([]string{"action"}, v)
+	return p
+}
+
+func (p *EventPatch) RemoveAction() *EventPatch {
+	p.Remove([]string{"action"})
+	return p
+}
+
+func (p *EventPatch) SetReason(v string) *EventPatch {
+	p.Set([]string{"reason"}, v)
+	return p
+}
+
+func (p *EventPatch) RemoveReason() *EventPatch {
+	p.Remove([]string{"reason"})
+	return p
+}
+
+func (p *EventPatch) SetRegarding(v *corev1.ObjectReference) *EventPatch {
+	p.Set([]string{"regarding"}, v)
+	return p
+}
+
+func (p *EventPatch) RemoveRegarding() *EventPatch {
+	p.Remove([]string{"regarding"})
+	return p
+}
+
+func (p *EventPatch) SetRelated(v *corev1.ObjectReference) *EventPatch {
+	p.Set([]string{"related"}, v)
+	return p
+}
+
+func (p *EventPatch) RemoveRelated() *EventPatch {
+	p.Remove([]string{"related"})
+	return p
+}
+
+func (p *EventPatch) SetNote(v string) *EventPatch {
+	p.Set([]string{"note"}, v)
+	return p
+}
+
+func (p *EventPatch) RemoveNote() *EventPatch {
+	p.Remove([]string{"note"})
+	return p
+}
+
+func (p *EventPatch) SetType(v string) *EventPatch {
+	p.Set([]string{"type"}, v)
+	return p
+}
+
+func (p *EventPatch) RemoveType() *EventPatch {
+	p.Remove([]string{"type"})
+	return p
+}
+
+func (p *EventPatch) SetDeprecatedSource(v *corev1.EventSource) *EventPatch {
+	p.Set([]string{"deprecatedSource"}, v)
+	return p
+}
+
+func (p *EventPatch) RemoveDeprecatedSource() *EventPatch {
+	p.Remove([]string{"deprecatedSource"})
+	return p
+}
+
+func (p *EventPatch) SetDeprecatedFirstTimestamp(v *metav1.Time) *EventPatch {
+	p.Set([]string{"deprecatedFirstTimestamp"}, v)
+	return p
+}
+
+func (p *EventPatch) RemoveDeprecatedFirstTimestamp() *EventPatch {
+	p.Remove([]string{"deprecatedFirstTimestamp"})
+	return p
+}
+
+func (p *EventPatch) SetDeprecatedLastTimestamp(v *metav1.Time) *EventPatch {
+	p.Set([]string{"deprecatedLastTimestamp"}, v)
+	return p
+}
+
+func (p *EventPatch) RemoveDeprecatedLastTimestamp() *EventPatch {
+	p.Remove([]string{"deprecatedLastTimestamp"})
+	return p
+}
+
+func (p *EventPatch) SetDeprecatedCount(v int) *EventPatch {
+	p.Set([]string{"deprecatedCount"}, v)
+	return p
+}
+
+func (p *EventPatch) RemoveDeprecatedCount() *EventPatch {
+	p.Remove([]string{"deprecatedCount"})
+	return p
+}
+
+func (in *Event) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *Event) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *Event) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	first := true
+	if in.TypeMeta.Kind != "" {
+		w.RawString("\"kind\":")
+		w.String(in.TypeMeta.Kind)
+		first = false
+	}
+	if in.TypeMeta.APIVersion != "" {
+		if !first {
+			w.RawByte(',')
+		}
+		w.RawString("\"apiVersion\":")
+		w.String(in.TypeMeta.APIVersion)
+		first = false
+	}
+	if !first {
+		w.RawByte(',')
+	}
+	w.RawString("\"metadata\":")
+	in.ObjectMeta.MarshalEasyJSON(w)
+	w.RawString(",\"eventTime\":")
+	in.EventTime.MarshalEasyJSON(w)
+	if in.Series != nil {
+		w.RawString(",\"series\":")
+		in.Series.MarshalEasyJSON(w)
+	}
+	if in.ReportingController != "" {
+		w.RawString(",\"reportingController\":")
+		w.String(in.ReportingController)
+	}
+	if in.ReportingInstance != "" {
+		w.RawString(",\"reportingInstance\":")
+		w.String(in.ReportingInstance)
+	}
+	if in.Action != "" {
+		w.RawString(",\"action\":")
+		w.String(in.Action)
+	}
+	if in.Reason != "" {
+		w.RawString(",\"reason\":")
+		w.String(in.Reason)
+	}
+	if in.Regarding != nil {
+		w.RawString(",\"regarding\":")
+		in.Regarding.MarshalEasyJSON(w)
+	}
+	if in.Related != nil {
+		w.RawString(",\"related\":")
+		in.Related.MarshalEasyJSON(w)
+	}
+	if in.Note != "" {
+		w.RawString(",\"note\":")
+		w.String(in.Note)
+	}
+	if in.Type != "" {
+		w.RawString(",\"type\":")
+		w.String(in.Type)
+	}
+	if in.DeprecatedSource != nil {
+		w.RawString(",\"deprecatedSource\":")
+		in.DeprecatedSource.MarshalEasyJSON(w)
+	}
+	if in.DeprecatedFirstTimestamp != nil {
+		w.RawString(",\"deprecatedFirstTimestamp\":")
+		w.Raw(json.Marshal(in.DeprecatedFirstTimestamp))
+	}
+	if in.DeprecatedLastTimestamp != nil {
+		w.RawString(",\"deprecatedLastTimestamp\":")
+		w.Raw(json.Marshal(in.DeprecatedLastTimestamp))
+	}
+	if in.DeprecatedCount != 0 {
+		w.RawString(",\"deprecatedCount\":")
+		w.Int(in.DeprecatedCount)
+	}
+	w.RawByte('}')
+}
+
+func (in *Event) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "kind":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.TypeMeta.Kind = l.String()
+			}
+		case "apiVersion":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.TypeMeta.APIVersion = l.String()
+			}
+		case "metadata":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.ObjectMeta.UnmarshalEasyJSON(l)
+			}
+		case "eventTime":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.EventTime.UnmarshalEasyJSON(l)
+			}
+		case "series":
+			if l.IsNull() {
+				l.Skip()
+				in.Series = nil
+			} else {
+				if in.Series == nil {
+					in.Series = new(EventSeries)
+				}
+				in.Series.UnmarshalEasyJSON(l)
+			}
+		case "reportingController":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.ReportingController = l.String()
+			}
+		case "reportingInstance":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.ReportingInstance = l.String()
+			}
+		case "action":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.Action = l.String()
+			}
+		case "reason":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.Reason = l.String()
+			}
+		case "regarding":
+			if l.IsNull() {
+				l.Skip()
+				in.Regarding = nil
+			} else {
+				if in.Regarding == nil {
+					in.Regarding = new(corev1.ObjectReference)
+				}
+				in.Regarding.UnmarshalEasyJSON(l)
+			}
+		case "related":
+			if l.IsNull() {
+				l.Skip()
+				in.Related = nil
+			} else {
+				if in.Related == nil {
+					in.Related = new(corev1.ObjectReference)
+				}
+				in.Related.UnmarshalEasyJSON(l)
+			}
+		case "note":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.Note = l.String()
+			}
+		case "type":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.Type = l.String()
+			}
+		case "deprecatedSource":
+			if l.IsNull() {
+				l.Skip()
+				in.DeprecatedSource = nil
+			} else {
+				if in.DeprecatedSource == nil {
+					in.DeprecatedSource = new(corev1.EventSource)
+				}
+				in.DeprecatedSource.UnmarshalEasyJSON(l)
+			}
+		case "deprecatedFirstTimestamp":
+			l.AddError(json.Unmarshal(l.Raw(), &in.DeprecatedFirstTimestamp))
+		case "deprecatedLastTimestamp":
+			l.AddError(json.Unmarshal(l.Raw(), &in.DeprecatedLastTimestamp))
+		case "deprecatedCount":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.DeprecatedCount = l.Int()
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
+
 type EventList struct {
 	metav1.TypeMeta `json:",inline"`
 	metav1.ListMeta `json:"metadata"`
@@ -157,6 +586,117 @@ func (in *EventList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+func (in *EventList) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *EventList) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *EventList) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	first := true
+	if in.TypeMeta.Kind != "" {
+		w.RawString("\"kind\":")
+		w.String(in.TypeMeta.Kind)
+		first = false
+	}
+	if in.TypeMeta.APIVersion != "" {
+		if !first {
+			w.RawByte(',')
+		}
+		w.RawString("\"apiVersion\":")
+		w.String(in.TypeMeta.APIVersion)
+		first = false
+	}
+	if !first {
+		w.RawByte(',')
+	}
+	w.RawString("\"metadata\":")
+	in.ListMeta.MarshalEasyJSON(w)
+	w.RawString(",\"items\":")
+	if in.Items == nil {
+		w.RawString("null")
+	} else {
+		w.RawByte('[')
+		for i0 := range in.Items {
+			if i0 > 0 {
+				w.RawByte(',')
+			}
+			in.Items[i0].MarshalEasyJSON(w)
+		}
+		w.RawByte(']')
+	}
+	w.RawByte('}')
+}
+
+func (in *EventList) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "kind":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.TypeMeta.Kind = l.String()
+			}
+		case "apiVersion":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.TypeMeta.APIVersion = l.String()
+			}
+		case "metadata":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.ListMeta.UnmarshalEasyJSON(l)
+			}
+		case "items":
+			if l.IsNull() {
+				l.Skip()
+				in.Items = nil
+			} else {
+				in.Items = make([]Event, 0)
+				l.Delim('[')
+				for !l.IsDelim(']') {
+					var v0 Event
+					if l.IsNull() {
+						l.Skip()
+					} else {
+						v0.UnmarshalEasyJSON(l)
+					}
+					in.Items = append(in.Items, v0)
+					l.WantComma()
+				}
+				l.Delim(']')
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
+
 type EventSeries struct {
 	// count is the number of occurrences in this series up to the last heartbeat time.
 	Count int `json:"count"`
@@ -177,3 +717,62 @@ func (in *EventSeries) DeepCopy() *EventSeries {
 	in.DeepCopyInto(out)
 	return out
 }
+
+func (in *EventSeries) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *EventSeries) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *EventSeries) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	w.RawString("\"count\":")
+	w.Int(in.Count)
+	w.RawString(",\"lastObservedTime\":")
+	in.LastObservedTime.MarshalEasyJSON(w)
+
+	w.RawByte('}')
+}
+
+func (in *EventSeries) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "count":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.Count = l.Int()
+			}
+		case "lastObservedTime":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.LastObservedTime.UnmarshalEasyJSON(l)
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
@@ -0,0 +1,58 @@
+package metav1
+
+// ConditionsAccessor is implemented by the objects which have the list of Condition.
+type ConditionsAccessor interface {
+	GetConditions() []Condition
+	SetConditions(conditions []Condition)
+}
+
+// FindCondition returns the condition which has conditionType.
+// If conditions doesn't have it, FindCondition returns nil.
+func FindCondition(conditions []Condition, conditionType string) *Condition {
+	for i := range conditions {
+		if conditions[i].Type == conditionType {
+			return &conditions[i]
+		}
+	}
+
+	return nil
+}
+
+// SetCondition adds newCondition or updates the condition which has the same type.
+// LastTransitionTime is changed only when the status is changed.
+// If LastTransitionTime of newCondition is zero, the current time is used.
+func SetCondition(conditions *[]Condition, newCondition Condition) {
+	if conditions == nil {
+		return
+	}
+
+	existing := FindCondition(*conditions, newCondition.Type)
+	if existing == nil {
+		if newCondition.LastTransitionTime.IsZero() {
+			newCondition.LastTransitionTime = Now()
+		}
+		*conditions = append(*conditions, newCondition)
+		return
+	}
+
+	if existing.Status != newCondition.Status {
+		existing.Status = newCondition.Status
+		if !newCondition.LastTransitionTime.IsZero() {
+			existing.LastTransitionTime = newCondition.LastTransitionTime
+		} else {
+			existing.LastTransitionTime = Now()
+		}
+	}
+	existing.Reason = newCondition.Reason
+	existing.Message = newCondition.Message
+	existing.ObservedGeneration = newCondition.ObservedGeneration
+}
+
+// IsConditionTrue returns true if the status of the condition which has conditionType is True.
+func IsConditionTrue(conditions []Condition, conditionType string) bool {
+	if c := FindCondition(conditions, conditionType); c != nil {
+		return c.Status == ConditionStatusTrue
+	}
+
+	return false
+}
@@ -0,0 +1,31 @@
+package metav1
+
+import (
+	"testing"
+	"time"
+
+	"go.f110.dev/kubeproto/go/internal/assertion"
+)
+
+func TestSetCondition(t *testing.T) {
+	var conditions []Condition
+	lastTransitionTime := NewTime(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))
+	SetCondition(&conditions, Condition{Type: "Ready", Status: ConditionStatusFalse, Reason: "Initializing", LastTransitionTime: lastTransitionTime})
+	assertion.Len(t, conditions, 1)
+	assertion.Equal(t, false, IsConditionTrue(conditions, "Ready"))
+
+	// The status is not changed. LastTransitionTime should be kept.
+	SetCondition(&conditions, Condition{Type: "Ready", Status: ConditionStatusFalse, Reason: "Waiting"})
+	assertion.Len(t, conditions, 1)
+	assertion.Equal(t, "Waiting", conditions[0].Reason)
+	assertion.Equal(t, true, conditions[0].LastTransitionTime.Equal(&lastTransitionTime))
+
+	SetCondition(&conditions, Condition{Type: "Ready", Status: ConditionStatusTrue, Reason: "Running"})
+	assertion.Equal(t, true, IsConditionTrue(conditions, "Ready"))
+	assertion.Equal(t, true, conditions[0].LastTransitionTime.After(lastTransitionTime.Time))
+
+	SetCondition(&conditions, Condition{Type: "Degraded", Status: ConditionStatusFalse})
+	assertion.Len(t, conditions, 2)
+	assertion.Equal(t, false, FindCondition(conditions, "Degraded").LastTransitionTime.IsZero())
+	assertion.Equal(t, true, FindCondition(conditions, "Unknown") == nil)
+}
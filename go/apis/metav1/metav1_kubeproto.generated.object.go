@@ -1,7 +1,12 @@
 package metav1
 
 import (
+	"encoding/json"
+	"github.com/mailru/easyjson/jlexer"
+	"github.com/mailru/easyjson/jwriter"
 	"k8s.io/apimachinery/pkg/runtime"
+	"maps"
+	"slices"
 	"time"
 )
 
@@ -169,6 +174,163 @@ func (in *APIGroup) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+func (in *APIGroup) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *APIGroup) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *APIGroup) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	first := true
+	if in.TypeMeta.Kind != "" {
+		w.RawString("\"kind\":")
+		w.String(in.TypeMeta.Kind)
+		first = false
+	}
+	if in.TypeMeta.APIVersion != "" {
+		if !first {
+			w.RawByte(',')
+		}
+		w.RawString("\"apiVersion\":")
+		w.String(in.TypeMeta.APIVersion)
+		first = false
+	}
+	if !first {
+		w.RawByte(',')
+	}
+	w.RawString("\"name\":")
+	w.String(in.Name)
+	w.RawString(",\"versions\":")
+	if in.Versions == nil {
+		w.RawString("null")
+	} else {
+		w.RawByte('[')
+		for i0 := range in.Versions {
+			if i0 > 0 {
+				w.RawByte(',')
+			}
+			in.Versions[i0].MarshalEasyJSON(w)
+		}
+		w.RawByte(']')
+	}
+	if in.PreferredVersion != nil {
+		w.RawString(",\"preferredVersion\":")
+		in.PreferredVersion.MarshalEasyJSON(w)
+	}
+	w.RawString(",\"serverAddressByClientCIDRs\":")
+	if in.ServerAddressByClientCIDRs == nil {
+		w.RawString("null")
+	} else {
+		w.RawByte('[')
+		for i0 := range in.ServerAddressByClientCIDRs {
+			if i0 > 0 {
+				w.RawByte(',')
+			}
+			in.ServerAddressByClientCIDRs[i0].MarshalEasyJSON(w)
+		}
+		w.RawByte(']')
+	}
+	w.RawByte('}')
+}
+
+func (in *APIGroup) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "kind":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.TypeMeta.Kind = l.String()
+			}
+		case "apiVersion":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.TypeMeta.APIVersion = l.String()
+			}
+		case "name":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.Name = l.String()
+			}
+		case "versions":
+			if l.IsNull() {
+				l.Skip()
+				in.Versions = nil
+			} else {
+				in.Versions = make([]GroupVersionForDiscovery, 0)
+				l.Delim('[')
+				for !l.IsDelim(']') {
+					var v0 GroupVersionForDiscovery
+					if l.IsNull() {
+						l.Skip()
+					} else {
+						v0.UnmarshalEasyJSON(l)
+					}
+					in.Versions = append(in.Versions, v0)
+					l.WantComma()
+				}
+				l.Delim(']')
+			}
+		case "preferredVersion":
+			if l.IsNull() {
+				l.Skip()
+				in.PreferredVersion = nil
+			} else {
+				if in.PreferredVersion == nil {
+					in.PreferredVersion = new(GroupVersionForDiscovery)
+				}
+				in.PreferredVersion.UnmarshalEasyJSON(l)
+			}
+		case "serverAddressByClientCIDRs":
+			if l.IsNull() {
+				l.Skip()
+				in.ServerAddressByClientCIDRs = nil
+			} else {
+				in.ServerAddressByClientCIDRs = make([]ServerAddressByClientCIDR, 0)
+				l.Delim('[')
+				for !l.IsDelim(']') {
+					var v0 ServerAddressByClientCIDR
+					if l.IsNull() {
+						l.Skip()
+					} else {
+						v0.UnmarshalEasyJSON(l)
+					}
+					in.ServerAddressByClientCIDRs = append(in.ServerAddressByClientCIDRs, v0)
+					l.WantComma()
+				}
+				l.Delim(']')
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
+
 type APIGroupList struct {
 	TypeMeta `json:",inline"`
 	// groups is a list of APIGroup.
@@ -203,6 +365,109 @@ func (in *APIGroupList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+func (in *APIGroupList) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *APIGroupList) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *APIGroupList) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	first := true
+	if in.TypeMeta.Kind != "" {
+		w.RawString("\"kind\":")
+		w.String(in.TypeMeta.Kind)
+		first = false
+	}
+	if in.TypeMeta.APIVersion != "" {
+		if !first {
+			w.RawByte(',')
+		}
+		w.RawString("\"apiVersion\":")
+		w.String(in.TypeMeta.APIVersion)
+		first = false
+	}
+	if !first {
+		w.RawByte(',')
+	}
+	w.RawString("\"groups\":")
+	if in.Groups == nil {
+		w.RawString("null")
+	} else {
+		w.RawByte('[')
+		for i0 := range in.Groups {
+			if i0 > 0 {
+				w.RawByte(',')
+			}
+			in.Groups[i0].MarshalEasyJSON(w)
+		}
+		w.RawByte(']')
+	}
+	w.RawByte('}')
+}
+
+func (in *APIGroupList) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "kind":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.TypeMeta.Kind = l.String()
+			}
+		case "apiVersion":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.TypeMeta.APIVersion = l.String()
+			}
+		case "groups":
+			if l.IsNull() {
+				l.Skip()
+				in.Groups = nil
+			} else {
+				in.Groups = make([]APIGroup, 0)
+				l.Delim('[')
+				for !l.IsDelim(']') {
+					var v0 APIGroup
+					if l.IsNull() {
+						l.Skip()
+					} else {
+						v0.UnmarshalEasyJSON(l)
+					}
+					in.Groups = append(in.Groups, v0)
+					l.WantComma()
+				}
+				l.Delim(']')
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
+
 type APIResource struct {
 	// name is the plural name of the resource.
 	Name string `json:"name"`
@@ -261,6 +526,207 @@ func (in *APIResource) DeepCopy() *APIResource {
 	return out
 }
 
+func (in *APIResource) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *APIResource) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *APIResource) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	w.RawString("\"name\":")
+	w.String(in.Name)
+	w.RawString(",\"singularName\":")
+	w.String(in.SingularName)
+	w.RawString(",\"namespaced\":")
+	w.Bool(in.Namespaced)
+	if in.Group != "" {
+		w.RawString(",\"group\":")
+		w.String(in.Group)
+	}
+	if in.Version != "" {
+		w.RawString(",\"version\":")
+		w.String(in.Version)
+	}
+	w.RawString(",\"kind\":")
+	w.String(in.Kind)
+	w.RawString(",\"verbs\":")
+	if in.Verbs == nil {
+		w.RawString("null")
+	} else {
+		w.RawByte('[')
+		for i0 := range in.Verbs {
+			if i0 > 0 {
+				w.RawByte(',')
+			}
+			w.String(in.Verbs[i0])
+		}
+		w.RawByte(']')
+	}
+	w.RawString(",\"shortNames\":")
+	if in.ShortNames == nil {
+		w.RawString("null")
+	} else {
+		w.RawByte('[')
+		for i0 := range in.ShortNames {
+			if i0 > 0 {
+				w.RawByte(',')
+			}
+			w.String(in.ShortNames[i0])
+		}
+		w.RawByte(']')
+	}
+	w.RawString(",\"categories\":")
+	if in.Categories == nil {
+		w.RawString("null")
+	} else {
+		w.RawByte('[')
+		for i0 := range in.Categories {
+			if i0 > 0 {
+				w.RawByte(',')
+			}
+			w.String(in.Categories[i0])
+		}
+		w.RawByte(']')
+	}
+	if in.StorageVersionHash != "" {
+		w.RawString(",\"storageVersionHash\":")
+		w.String(in.StorageVersionHash)
+	}
+
+	w.RawByte('}')
+}
+
+func (in *APIResource) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "name":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.Name = l.String()
+			}
+		case "singularName":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.SingularName = l.String()
+			}
+		case "namespaced":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.Namespaced = l.Bool()
+			}
+		case "group":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.Group = l.String()
+			}
+		case "version":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.Version = l.String()
+			}
+		case "kind":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.Kind = l.String()
+			}
+		case "verbs":
+			if l.IsNull() {
+				l.Skip()
+				in.Verbs = nil
+			} else {
+				in.Verbs = make(Verbs, 0)
+				l.Delim('[')
+				for !l.IsDelim(']') {
+					var v0 string
+					if l.IsNull() {
+						l.Skip()
+					} else {
+						v0 = l.String()
+					}
+					in.Verbs = append(in.Verbs, v0)
+					l.WantComma()
+				}
+				l.Delim(']')
+			}
+		case "shortNames":
+			if l.IsNull() {
+				l.Skip()
+				in.ShortNames = nil
+			} else {
+				in.ShortNames = make([]string, 0)
+				l.Delim('[')
+				for !l.IsDelim(']') {
+					var v0 string
+					if l.IsNull() {
+						l.Skip()
+					} else {
+						v0 = l.String()
+					}
+					in.ShortNames = append(in.ShortNames, v0)
+					l.WantComma()
+				}
+				l.Delim(']')
+			}
+		case "categories":
+			if l.IsNull() {
+				l.Skip()
+				in.Categories = nil
+			} else {
+				in.Categories = make([]string, 0)
+				l.Delim('[')
+				for !l.IsDelim(']') {
+					var v0 string
+					if l.IsNull() {
+						l.Skip()
+					} else {
+						v0 = l.String()
+					}
+					in.Categories = append(in.Categories, v0)
+					l.WantComma()
+				}
+				l.Delim(']')
+			}
+		case "storageVersionHash":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.StorageVersionHash = l.String()
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
+
 type APIResourceList struct {
 	TypeMeta `json:",inline"`
 	// groupVersion is the group and version this APIResourceList is for.
@@ -297,6 +763,117 @@ func (in *APIResourceList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+func (in *APIResourceList) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *APIResourceList) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *APIResourceList) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	first := true
+	if in.TypeMeta.Kind != "" {
+		w.RawString("\"kind\":")
+		w.String(in.TypeMeta.Kind)
+		first = false
+	}
+	if in.TypeMeta.APIVersion != "" {
+		if !first {
+			w.RawByte(',')
+		}
+		w.RawString("\"apiVersion\":")
+		w.String(in.TypeMeta.APIVersion)
+		first = false
+	}
+	if !first {
+		w.RawByte(',')
+	}
+	w.RawString("\"groupVersion\":")
+	w.String(in.GroupVersion)
+	w.RawString(",\"resources\":")
+	if in.APIResources == nil {
+		w.RawString("null")
+	} else {
+		w.RawByte('[')
+		for i0 := range in.APIResources {
+			if i0 > 0 {
+				w.RawByte(',')
+			}
+			in.APIResources[i0].MarshalEasyJSON(w)
+		}
+		w.RawByte(']')
+	}
+	w.RawByte('}')
+}
+
+func (in *APIResourceList) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "kind":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.TypeMeta.Kind = l.String()
+			}
+		case "apiVersion":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.TypeMeta.APIVersion = l.String()
+			}
+		case "groupVersion":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.GroupVersion = l.String()
+			}
+		case "resources":
+			if l.IsNull() {
+				l.Skip()
+				in.APIResources = nil
+			} else {
+				in.APIResources = make([]APIResource, 0)
+				l.Delim('[')
+				for !l.IsDelim(']') {
+					var v0 APIResource
+					if l.IsNull() {
+						l.Skip()
+					} else {
+						v0.UnmarshalEasyJSON(l)
+					}
+					in.APIResources = append(in.APIResources, v0)
+					l.WantComma()
+				}
+				l.Delim(']')
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
+
 type APIVersions struct {
 	TypeMeta `json:",inline"`
 	// versions are the api versions that are available.
@@ -344,6 +921,141 @@ func (in *APIVersions) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+func (in *APIVersions) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *APIVersions) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *APIVersions) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	first := true
+	if in.TypeMeta.Kind != "" {
+		w.RawString("\"kind\":")
+		w.String(in.TypeMeta.Kind)
+		first = false
+	}
+	if in.TypeMeta.APIVersion != "" {
+		if !first {
+			w.RawByte(',')
+		}
+		w.RawString("\"apiVersion\":")
+		w.String(in.TypeMeta.APIVersion)
+		first = false
+	}
+	if !first {
+		w.RawByte(',')
+	}
+	w.RawString("\"versions\":")
+	if in.Versions == nil {
+		w.RawString("null")
+	} else {
+		w.RawByte('[')
+		for i0 := range in.Versions {
+			if i0 > 0 {
+				w.RawByte(',')
+			}
+			w.String(in.Versions[i0])
+		}
+		w.RawByte(']')
+	}
+	w.RawString(",\"serverAddressByClientCIDRs\":")
+	if in.ServerAddressByClientCIDRs == nil {
+		w.RawString("null")
+	} else {
+		w.RawByte('[')
+		for i0 := range in.ServerAddressByClientCIDRs {
+			if i0 > 0 {
+				w.RawByte(',')
+			}
+			in.ServerAddressByClientCIDRs[i0].MarshalEasyJSON(w)
+		}
+		w.RawByte(']')
+	}
+	w.RawByte('}')
+}
+
+func (in *APIVersions) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "kind":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.TypeMeta.Kind = l.String()
+			}
+		case "apiVersion":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.TypeMeta.APIVersion = l.String()
+			}
+		case "versions":
+			if l.IsNull() {
+				l.Skip()
+				in.Versions = nil
+			} else {
+				in.Versions = make([]string, 0)
+				l.Delim('[')
+				for !l.IsDelim(']') {
+					var v0 string
+					if l.IsNull() {
+						l.Skip()
+					} else {
+						v0 = l.String()
+					}
+					in.Versions = append(in.Versions, v0)
+					l.WantComma()
+				}
+				l.Delim(']')
+			}
+		case "serverAddressByClientCIDRs":
+			if l.IsNull() {
+				l.Skip()
+				in.ServerAddressByClientCIDRs = nil
+			} else {
+				in.ServerAddressByClientCIDRs = make([]ServerAddressByClientCIDR, 0)
+				l.Delim('[')
+				for !l.IsDelim(']') {
+					var v0 ServerAddressByClientCIDR
+					if l.IsNull() {
+						l.Skip()
+					} else {
+						v0.UnmarshalEasyJSON(l)
+					}
+					in.ServerAddressByClientCIDRs = append(in.ServerAddressByClientCIDRs, v0)
+					l.WantComma()
+				}
+				l.Delim(']')
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
+
 type ApplyOptions struct {
 	TypeMeta `json:",inline"`
 	// When present, indicates that modifications should not be
@@ -389,6 +1101,125 @@ func (in *ApplyOptions) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+func (in *ApplyOptions) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *ApplyOptions) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *ApplyOptions) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	first := true
+	if in.TypeMeta.Kind != "" {
+		w.RawString("\"kind\":")
+		w.String(in.TypeMeta.Kind)
+		first = false
+	}
+	if in.TypeMeta.APIVersion != "" {
+		if !first {
+			w.RawByte(',')
+		}
+		w.RawString("\"apiVersion\":")
+		w.String(in.TypeMeta.APIVersion)
+		first = false
+	}
+	if !first {
+		w.RawByte(',')
+	}
+	w.RawString("\"dryRun\":")
+	if in.DryRun == nil {
+		w.RawString("null")
+	} else {
+		w.RawByte('[')
+		for i0 := range in.DryRun {
+			if i0 > 0 {
+				w.RawByte(',')
+			}
+			w.String(in.DryRun[i0])
+		}
+		w.RawByte(']')
+	}
+	w.RawString(",\"force\":")
+	w.Bool(in.Force)
+	w.RawString(",\"fieldManager\":")
+	w.String(in.FieldManager)
+	w.RawByte('}')
+}
+
+func (in *ApplyOptions) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "kind":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.TypeMeta.Kind = l.String()
+			}
+		case "apiVersion":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.TypeMeta.APIVersion = l.String()
+			}
+		case "dryRun":
+			if l.IsNull() {
+				l.Skip()
+				in.DryRun = nil
+			} else {
+				in.DryRun = make([]string, 0)
+				l.Delim('[')
+				for !l.IsDelim(']') {
+					var v0 string
+					if l.IsNull() {
+						l.Skip()
+					} else {
+						v0 = l.String()
+					}
+					in.DryRun = append(in.DryRun, v0)
+					l.WantComma()
+				}
+				l.Delim(']')
+			}
+		case "force":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.Force = l.Bool()
+			}
+		case "fieldManager":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.FieldManager = l.String()
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
+
 type Condition struct {
 	// type of condition in CamelCase or in foo.example.com/CamelCase.
 	// ---
@@ -430,6 +1261,95 @@ func (in *Condition) DeepCopy() *Condition {
 	return out
 }
 
+func (in *Condition) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *Condition) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *Condition) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	w.RawString("\"type\":")
+	w.String(in.Type)
+	w.RawString(",\"status\":")
+	w.String(string(in.Status))
+	if in.ObservedGeneration != 0 {
+		w.RawString(",\"observedGeneration\":")
+		w.Int64(in.ObservedGeneration)
+	}
+	w.RawString(",\"lastTransitionTime\":")
+	w.Raw(json.Marshal(&in.LastTransitionTime))
+	w.RawString(",\"reason\":")
+	w.String(in.Reason)
+	w.RawString(",\"message\":")
+	w.String(in.Message)
+
+	w.RawByte('}')
+}
+
+func (in *Condition) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "type":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.Type = l.String()
+			}
+		case "status":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.Status = ConditionStatus(l.String())
+			}
+		case "observedGeneration":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.ObservedGeneration = l.Int64()
+			}
+		case "lastTransitionTime":
+			l.AddError(json.Unmarshal(l.Raw(), &in.LastTransitionTime))
+		case "reason":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.Reason = l.String()
+			}
+		case "message":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.Message = l.String()
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
+
 type CreateOptions struct {
 	TypeMeta `json:",inline"`
 	// When present, indicates that modifications should not be
@@ -488,6 +1408,129 @@ func (in *CreateOptions) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+func (in *CreateOptions) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *CreateOptions) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *CreateOptions) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	first := true
+	if in.TypeMeta.Kind != "" {
+		w.RawString("\"kind\":")
+		w.String(in.TypeMeta.Kind)
+		first = false
+	}
+	if in.TypeMeta.APIVersion != "" {
+		if !first {
+			w.RawByte(',')
+		}
+		w.RawString("\"apiVersion\":")
+		w.String(in.TypeMeta.APIVersion)
+		first = false
+	}
+	if !first {
+		w.RawByte(',')
+	}
+	w.RawString("\"dryRun\":")
+	if in.DryRun == nil {
+		w.RawString("null")
+	} else {
+		w.RawByte('[')
+		for i0 := range in.DryRun {
+			if i0 > 0 {
+				w.RawByte(',')
+			}
+			w.String(in.DryRun[i0])
+		}
+		w.RawByte(']')
+	}
+	if in.FieldManager != "" {
+		w.RawString(",\"fieldManager\":")
+		w.String(in.FieldManager)
+	}
+	if in.FieldValidation != "" {
+		w.RawString(",\"fieldValidation\":")
+		w.String(in.FieldValidation)
+	}
+	w.RawByte('}')
+}
+
+func (in *CreateOptions) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "kind":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.TypeMeta.Kind = l.String()
+			}
+		case "apiVersion":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.TypeMeta.APIVersion = l.String()
+			}
+		case "dryRun":
+			if l.IsNull() {
+				l.Skip()
+				in.DryRun = nil
+			} else {
+				in.DryRun = make([]string, 0)
+				l.Delim('[')
+				for !l.IsDelim(']') {
+					var v0 string
+					if l.IsNull() {
+						l.Skip()
+					} else {
+						v0 = l.String()
+					}
+					in.DryRun = append(in.DryRun, v0)
+					l.WantComma()
+				}
+				l.Delim(']')
+			}
+		case "fieldManager":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.FieldManager = l.String()
+			}
+		case "fieldValidation":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.FieldValidation = l.String()
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
+
 type DeleteOptions struct {
 	TypeMeta `json:",inline"`
 	// The duration in seconds before the object should be deleted. Value must be non-negative integer.
@@ -564,6 +1607,179 @@ func (in *DeleteOptions) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+func (in *DeleteOptions) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *DeleteOptions) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *DeleteOptions) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	first := true
+	if in.TypeMeta.Kind != "" {
+		w.RawString("\"kind\":")
+		w.String(in.TypeMeta.Kind)
+		first = false
+	}
+	if in.TypeMeta.APIVersion != "" {
+		if !first {
+			w.RawByte(',')
+		}
+		w.RawString("\"apiVersion\":")
+		w.String(in.TypeMeta.APIVersion)
+		first = false
+	}
+	if in.GracePeriodSeconds != 0 {
+		if !first {
+			w.RawByte(',')
+		}
+		w.RawString("\"gracePeriodSeconds\":")
+		w.Int64(in.GracePeriodSeconds)
+		first = false
+	}
+	if in.Preconditions != nil {
+		if !first {
+			w.RawByte(',')
+		}
+		w.RawString("\"preconditions\":")
+		in.Preconditions.MarshalEasyJSON(w)
+		first = false
+	}
+	if in.OrphanDependents {
+		if !first {
+			w.RawByte(',')
+		}
+		w.RawString("\"orphanDependents\":")
+		w.Bool(in.OrphanDependents)
+		first = false
+	}
+	if in.PropagationPolicy != "" {
+		if !first {
+			w.RawByte(',')
+		}
+		w.RawString("\"propagationPolicy\":")
+		w.String(string(in.PropagationPolicy))
+		first = false
+	}
+	if !first {
+		w.RawByte(',')
+	}
+	w.RawString("\"dryRun\":")
+	if in.DryRun == nil {
+		w.RawString("null")
+	} else {
+		w.RawByte('[')
+		for i0 := range in.DryRun {
+			if i0 > 0 {
+				w.RawByte(',')
+			}
+			w.String(in.DryRun[i0])
+		}
+		w.RawByte(']')
+	}
+	if in.IgnoreStoreReadErrorWithClusterBreakingPotential {
+		w.RawString(",\"ignoreStoreReadErrorWithClusterBreakingPotential\":")
+		w.Bool(in.IgnoreStoreReadErrorWithClusterBreakingPotential)
+	}
+	w.RawByte('}')
+}
+
+func (in *DeleteOptions) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "kind":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.TypeMeta.Kind = l.String()
+			}
+		case "apiVersion":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.TypeMeta.APIVersion = l.String()
+			}
+		case "gracePeriodSeconds":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.GracePeriodSeconds = l.Int64()
+			}
+		case "preconditions":
+			if l.IsNull() {
+				l.Skip()
+				in.Preconditions = nil
+			} else {
+				if in.Preconditions == nil {
+					in.Preconditions = new(Preconditions)
+				}
+				in.Preconditions.UnmarshalEasyJSON(l)
+			}
+		case "orphanDependents":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.OrphanDependents = l.Bool()
+			}
+		case "propagationPolicy":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.PropagationPolicy = DeletionPropagation(l.String())
+			}
+		case "dryRun":
+			if l.IsNull() {
+				l.Skip()
+				in.DryRun = nil
+			} else {
+				in.DryRun = make([]string, 0)
+				l.Delim('[')
+				for !l.IsDelim(']') {
+					var v0 string
+					if l.IsNull() {
+						l.Skip()
+					} else {
+						v0 = l.String()
+					}
+					in.DryRun = append(in.DryRun, v0)
+					l.WantComma()
+				}
+				l.Delim(']')
+			}
+		case "ignoreStoreReadErrorWithClusterBreakingPotential":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.IgnoreStoreReadErrorWithClusterBreakingPotential = l.Bool()
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
+
 type Duration struct {
 	Duration int64 `json:"duration"`
 }
@@ -581,6 +1797,57 @@ func (in *Duration) DeepCopy() *Duration {
 	return out
 }
 
+func (in *Duration) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *Duration) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *Duration) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	w.RawString("\"duration\":")
+	w.Int64(in.Duration)
+
+	w.RawByte('}')
+}
+
+func (in *Duration) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "duration":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.Duration = l.Int64()
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
+
 type FieldSelectorRequirement struct {
 	// key is the field selector key that the requirement applies to.
 	Key string `json:"key"`
@@ -612,6 +1879,97 @@ func (in *FieldSelectorRequirement) DeepCopy() *FieldSelectorRequirement {
 	return out
 }
 
+func (in *FieldSelectorRequirement) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *FieldSelectorRequirement) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *FieldSelectorRequirement) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	w.RawString("\"key\":")
+	w.String(in.Key)
+	w.RawString(",\"operator\":")
+	w.String(string(in.Operator))
+	w.RawString(",\"values\":")
+	if in.Values == nil {
+		w.RawString("null")
+	} else {
+		w.RawByte('[')
+		for i0 := range in.Values {
+			if i0 > 0 {
+				w.RawByte(',')
+			}
+			w.String(in.Values[i0])
+		}
+		w.RawByte(']')
+	}
+
+	w.RawByte('}')
+}
+
+func (in *FieldSelectorRequirement) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "key":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.Key = l.String()
+			}
+		case "operator":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.Operator = FieldSelectorOperator(l.String())
+			}
+		case "values":
+			if l.IsNull() {
+				l.Skip()
+				in.Values = nil
+			} else {
+				in.Values = make([]string, 0)
+				l.Delim('[')
+				for !l.IsDelim(']') {
+					var v0 string
+					if l.IsNull() {
+						l.Skip()
+					} else {
+						v0 = l.String()
+					}
+					in.Values = append(in.Values, v0)
+					l.WantComma()
+				}
+				l.Delim(']')
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
+
 type FieldsV1 struct {
 	// Raw is the underlying serialization of this object.
 	// Deprecated: Direct access to this field is deprecated. Use GetRawBytes, GetRawString, SetRawBytes, SetRawString, GetRawReader, NewFieldsV1 instead.
@@ -631,6 +1989,60 @@ func (in *FieldsV1) DeepCopy() *FieldsV1 {
 	return out
 }
 
+func (in *FieldsV1) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *FieldsV1) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *FieldsV1) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	if len(in.Raw) != 0 {
+		w.RawString("\"-\":")
+		w.Base64Bytes(in.Raw)
+	}
+
+	w.RawByte('}')
+}
+
+func (in *FieldsV1) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "-":
+			if l.IsNull() {
+				l.Skip()
+				in.Raw = nil
+			} else {
+				in.Raw = l.Bytes()
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
+
 type GetOptions struct {
 	TypeMeta `json:",inline"`
 	// resourceVersion sets a constraint on what resource versions a request may be served from.
@@ -661,6 +2073,88 @@ func (in *GetOptions) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+func (in *GetOptions) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *GetOptions) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *GetOptions) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	first := true
+	if in.TypeMeta.Kind != "" {
+		w.RawString("\"kind\":")
+		w.String(in.TypeMeta.Kind)
+		first = false
+	}
+	if in.TypeMeta.APIVersion != "" {
+		if !first {
+			w.RawByte(',')
+		}
+		w.RawString("\"apiVersion\":")
+		w.String(in.TypeMeta.APIVersion)
+		first = false
+	}
+	if in.ResourceVersion != "" {
+		if !first {
+			w.RawByte(',')
+		}
+		w.RawString("\"resourceVersion\":")
+		w.String(in.ResourceVersion)
+		first = false
+	}
+	w.RawByte('}')
+}
+
+func (in *GetOptions) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "kind":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.TypeMeta.Kind = l.String()
+			}
+		case "apiVersion":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.TypeMeta.APIVersion = l.String()
+			}
+		case "resourceVersion":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.ResourceVersion = l.String()
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
+
 type GroupKind struct {
 	Group string `json:"group"`
 	Kind  string `json:"kind"`
@@ -679,24 +2173,142 @@ func (in *GroupKind) DeepCopy() *GroupKind {
 	return out
 }
 
-type GroupResource struct {
-	Group    string `json:"group"`
-	Resource string `json:"resource"`
+func (in *GroupKind) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
 }
 
-func (in *GroupResource) DeepCopyInto(out *GroupResource) {
-	*out = *in
+func (in *GroupKind) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
 }
 
-func (in *GroupResource) DeepCopy() *GroupResource {
-	if in == nil {
-		return nil
-	}
-	out := new(GroupResource)
+func (in *GroupKind) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	w.RawString("\"group\":")
+	w.String(in.Group)
+	w.RawString(",\"kind\":")
+	w.String(in.Kind)
+
+	w.RawByte('}')
+}
+
+func (in *GroupKind) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "group":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.Group = l.String()
+			}
+		case "kind":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.Kind = l.String()
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
+
+type GroupResource struct {
+	Group    string `json:"group"`
+	Resource string `json:"resource"`
+}
+
+func (in *GroupResource) DeepCopyInto(out *GroupResource) {
+	*out = *in
+}
+
+func (in *GroupResource) DeepCopy() *GroupResource {
+	if in == nil {
+		return nil
+	}
+	out := new(GroupResource)
 	in.DeepCopyInto(out)
 	return out
 }
 
+func (in *GroupResource) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *GroupResource) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *GroupResource) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	w.RawString("\"group\":")
+	w.String(in.Group)
+	w.RawString(",\"resource\":")
+	w.String(in.Resource)
+
+	w.RawByte('}')
+}
+
+func (in *GroupResource) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "group":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.Group = l.String()
+			}
+		case "resource":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.Resource = l.String()
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
+
 type GroupVersion struct {
 	Group   string `json:"group"`
 	Version string `json:"version"`
@@ -715,6 +2327,65 @@ func (in *GroupVersion) DeepCopy() *GroupVersion {
 	return out
 }
 
+func (in *GroupVersion) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *GroupVersion) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *GroupVersion) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	w.RawString("\"group\":")
+	w.String(in.Group)
+	w.RawString(",\"version\":")
+	w.String(in.Version)
+
+	w.RawByte('}')
+}
+
+func (in *GroupVersion) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "group":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.Group = l.String()
+			}
+		case "version":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.Version = l.String()
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
+
 type GroupVersionForDiscovery struct {
 	// groupVersion specifies the API group and version in the form "group/version"
 	GroupVersion string `json:"groupVersion"`
@@ -736,6 +2407,65 @@ func (in *GroupVersionForDiscovery) DeepCopy() *GroupVersionForDiscovery {
 	return out
 }
 
+func (in *GroupVersionForDiscovery) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *GroupVersionForDiscovery) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *GroupVersionForDiscovery) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	w.RawString("\"groupVersion\":")
+	w.String(in.GroupVersion)
+	w.RawString(",\"version\":")
+	w.String(in.Version)
+
+	w.RawByte('}')
+}
+
+func (in *GroupVersionForDiscovery) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "groupVersion":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.GroupVersion = l.String()
+			}
+		case "version":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.Version = l.String()
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
+
 type GroupVersionKind struct {
 	Group   string `json:"group"`
 	Version string `json:"version"`
@@ -755,6 +2485,73 @@ func (in *GroupVersionKind) DeepCopy() *GroupVersionKind {
 	return out
 }
 
+func (in *GroupVersionKind) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *GroupVersionKind) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *GroupVersionKind) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	w.RawString("\"group\":")
+	w.String(in.Group)
+	w.RawString(",\"version\":")
+	w.String(in.Version)
+	w.RawString(",\"kind\":")
+	w.String(in.Kind)
+
+	w.RawByte('}')
+}
+
+func (in *GroupVersionKind) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "group":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.Group = l.String()
+			}
+		case "version":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.Version = l.String()
+			}
+		case "kind":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.Kind = l.String()
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
+
 type GroupVersionResource struct {
 	Group    string `json:"group"`
 	Version  string `json:"version"`
@@ -774,6 +2571,73 @@ func (in *GroupVersionResource) DeepCopy() *GroupVersionResource {
 	return out
 }
 
+func (in *GroupVersionResource) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *GroupVersionResource) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *GroupVersionResource) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	w.RawString("\"group\":")
+	w.String(in.Group)
+	w.RawString(",\"version\":")
+	w.String(in.Version)
+	w.RawString(",\"resource\":")
+	w.String(in.Resource)
+
+	w.RawByte('}')
+}
+
+func (in *GroupVersionResource) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "group":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.Group = l.String()
+			}
+		case "version":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.Version = l.String()
+			}
+		case "resource":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.Resource = l.String()
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
+
 type LabelSelector struct {
 	// matchLabels is a map of {key,value} pairs. A single {key,value} in the matchLabels
 	// map is equivalent to an element of matchExpressions, whose key field is "key", the
@@ -810,6 +2674,126 @@ func (in *LabelSelector) DeepCopy() *LabelSelector {
 	return out
 }
 
+func (in *LabelSelector) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *LabelSelector) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *LabelSelector) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	first := true
+	if len(in.MatchLabels) != 0 {
+		w.RawString("\"matchLabels\":")
+		if in.MatchLabels == nil {
+			w.RawString("null")
+		} else {
+			w.RawByte('{')
+			for i0, k0 := range slices.Sorted(maps.Keys(in.MatchLabels)) {
+				if i0 > 0 {
+					w.RawByte(',')
+				}
+				w.String(k0)
+				w.RawByte(':')
+				v0 := in.MatchLabels[k0]
+				w.String(v0)
+			}
+			w.RawByte('}')
+		}
+		first = false
+	}
+	if !first {
+		w.RawByte(',')
+	}
+	w.RawString("\"matchExpressions\":")
+	if in.MatchExpressions == nil {
+		w.RawString("null")
+	} else {
+		w.RawByte('[')
+		for i0 := range in.MatchExpressions {
+			if i0 > 0 {
+				w.RawByte(',')
+			}
+			in.MatchExpressions[i0].MarshalEasyJSON(w)
+		}
+		w.RawByte(']')
+	}
+	w.RawByte('}')
+}
+
+func (in *LabelSelector) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "matchLabels":
+			if l.IsNull() {
+				l.Skip()
+				in.MatchLabels = nil
+			} else {
+				if in.MatchLabels == nil {
+					in.MatchLabels = make(map[string]string)
+				}
+				l.Delim('{')
+				for !l.IsDelim('}') {
+					k0 := l.String()
+					l.WantColon()
+					var v0 string
+					if l.IsNull() {
+						l.Skip()
+					} else {
+						v0 = l.String()
+					}
+					in.MatchLabels[k0] = v0
+					l.WantComma()
+				}
+				l.Delim('}')
+			}
+		case "matchExpressions":
+			if l.IsNull() {
+				l.Skip()
+				in.MatchExpressions = nil
+			} else {
+				in.MatchExpressions = make([]LabelSelectorRequirement, 0)
+				l.Delim('[')
+				for !l.IsDelim(']') {
+					var v0 LabelSelectorRequirement
+					if l.IsNull() {
+						l.Skip()
+					} else {
+						v0.UnmarshalEasyJSON(l)
+					}
+					in.MatchExpressions = append(in.MatchExpressions, v0)
+					l.WantComma()
+				}
+				l.Delim(']')
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
+
 type LabelSelectorRequirement struct {
 	// key is the label key that the selector applies to.
 	Key string `json:"key"`
@@ -841,6 +2825,97 @@ func (in *LabelSelectorRequirement) DeepCopy() *LabelSelectorRequirement {
 	return out
 }
 
+func (in *LabelSelectorRequirement) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *LabelSelectorRequirement) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *LabelSelectorRequirement) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	w.RawString("\"key\":")
+	w.String(in.Key)
+	w.RawString(",\"operator\":")
+	w.String(string(in.Operator))
+	w.RawString(",\"values\":")
+	if in.Values == nil {
+		w.RawString("null")
+	} else {
+		w.RawByte('[')
+		for i0 := range in.Values {
+			if i0 > 0 {
+				w.RawByte(',')
+			}
+			w.String(in.Values[i0])
+		}
+		w.RawByte(']')
+	}
+
+	w.RawByte('}')
+}
+
+func (in *LabelSelectorRequirement) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "key":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.Key = l.String()
+			}
+		case "operator":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.Operator = LabelSelectorOperator(l.String())
+			}
+		case "values":
+			if l.IsNull() {
+				l.Skip()
+				in.Values = nil
+			} else {
+				in.Values = make([]string, 0)
+				l.Delim('[')
+				for !l.IsDelim(']') {
+					var v0 string
+					if l.IsNull() {
+						l.Skip()
+					} else {
+						v0 = l.String()
+					}
+					in.Values = append(in.Values, v0)
+					l.WantComma()
+				}
+				l.Delim(']')
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
+
 type List struct {
 	TypeMeta `json:",inline"`
 	// Standard list metadata.
@@ -883,6 +2958,123 @@ func (in *List) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+func (in *List) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *List) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *List) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	first := true
+	if in.TypeMeta.Kind != "" {
+		w.RawString("\"kind\":")
+		w.String(in.TypeMeta.Kind)
+		first = false
+	}
+	if in.TypeMeta.APIVersion != "" {
+		if !first {
+			w.RawByte(',')
+		}
+		w.RawString("\"apiVersion\":")
+		w.String(in.TypeMeta.APIVersion)
+		first = false
+	}
+	if in.ListMeta != nil {
+		if !first {
+			w.RawByte(',')
+		}
+		w.RawString("\"metadata\":")
+		in.ListMeta.MarshalEasyJSON(w)
+		first = false
+	}
+	if !first {
+		w.RawByte(',')
+	}
+	w.RawString("\"items\":")
+	if in.Items == nil {
+		w.RawString("null")
+	} else {
+		w.RawByte('[')
+		for i0 := range in.Items {
+			if i0 > 0 {
+				w.RawByte(',')
+			}
+			w.Raw(json.Marshal(&in.Items[i0]))
+		}
+		w.RawByte(']')
+	}
+	w.RawByte('}')
+}
+
+func (in *List) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "kind":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.TypeMeta.Kind = l.String()
+			}
+		case "apiVersion":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.TypeMeta.APIVersion = l.String()
+			}
+		case "metadata":
+			if l.IsNull() {
+				l.Skip()
+				in.ListMeta = nil
+			} else {
+				if in.ListMeta == nil {
+					in.ListMeta = new(ListMeta)
+				}
+				in.ListMeta.UnmarshalEasyJSON(l)
+			}
+		case "items":
+			if l.IsNull() {
+				l.Skip()
+				in.Items = nil
+			} else {
+				in.Items = make([]runtime.RawExtension, 0)
+				l.Delim('[')
+				for !l.IsDelim(']') {
+					var v0 runtime.RawExtension
+					l.AddError(json.Unmarshal(l.Raw(), &v0))
+					in.Items = append(in.Items, v0)
+					l.WantComma()
+				}
+				l.Delim(']')
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
+
 type ListMeta struct {
 	// Deprecated: selfLink is a legacy read-only field that is no longer populated by the system.
 	SelfLink string `json:"selfLink,omitempty"`
@@ -938,6 +3130,108 @@ func (in *ListMeta) DeepCopy() *ListMeta {
 	return out
 }
 
+func (in *ListMeta) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	first := true
+	if in.SelfLink != "" {
+		w.RawString("\"selfLink\":")
+		w.String(in.SelfLink)
+		first = false
+	}
+	if in.ResourceVersion != "" {
+		if !first {
+			w.RawByte(',')
+		}
+		w.RawString("\"resourceVersion\":")
+		w.String(in.ResourceVersion)
+		first = false
+	}
+	if in.Continue != "" {
+		if !first {
+			w.RawByte(',')
+		}
+		w.RawString("\"continue\":")
+		w.String(in.Continue)
+		first = false
+	}
+	if in.RemainingItemCount != 0 {
+		if !first {
+			w.RawByte(',')
+		}
+		w.RawString("\"remainingItemCount\":")
+		w.Int64(in.RemainingItemCount)
+		first = false
+	}
+	if in.ShardInfo != nil {
+		if !first {
+			w.RawByte(',')
+		}
+		w.RawString("\"shardInfo\":")
+		in.ShardInfo.MarshalEasyJSON(w)
+		first = false
+	}
+	w.RawByte('}')
+}
+
+func (in *ListMeta) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "selfLink":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.SelfLink = l.String()
+			}
+		case "resourceVersion":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.ResourceVersion = l.String()
+			}
+		case "continue":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.Continue = l.String()
+			}
+		case "remainingItemCount":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.RemainingItemCount = l.Int64()
+			}
+		case "shardInfo":
+			if l.IsNull() {
+				l.Skip()
+				in.ShardInfo = nil
+			} else {
+				if in.ShardInfo == nil {
+					in.ShardInfo = new(ShardInfo)
+				}
+				in.ShardInfo.UnmarshalEasyJSON(l)
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
+
 type ListOptions struct {
 	TypeMeta `json:",inline"`
 	// A selector to restrict the list of returned objects by their labels.
@@ -1070,6 +3364,228 @@ func (in *ListOptions) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+func (in *ListOptions) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *ListOptions) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *ListOptions) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	first := true
+	if in.TypeMeta.Kind != "" {
+		w.RawString("\"kind\":")
+		w.String(in.TypeMeta.Kind)
+		first = false
+	}
+	if in.TypeMeta.APIVersion != "" {
+		if !first {
+			w.RawByte(',')
+		}
+		w.RawString("\"apiVersion\":")
+		w.String(in.TypeMeta.APIVersion)
+		first = false
+	}
+	if in.LabelSelector != "" {
+		if !first {
+			w.RawByte(',')
+		}
+		w.RawString("\"labelSelector\":")
+		w.String(in.LabelSelector)
+		first = false
+	}
+	if in.FieldSelector != "" {
+		if !first {
+			w.RawByte(',')
+		}
+		w.RawString("\"fieldSelector\":")
+		w.String(in.FieldSelector)
+		first = false
+	}
+	if in.Watch {
+		if !first {
+			w.RawByte(',')
+		}
+		w.RawString("\"watch\":")
+		w.Bool(in.Watch)
+		first = false
+	}
+	if in.AllowWatchBookmarks {
+		if !first {
+			w.RawByte(',')
+		}
+		w.RawString("\"allowWatchBookmarks\":")
+		w.Bool(in.AllowWatchBookmarks)
+		first = false
+	}
+	if in.ResourceVersion != "" {
+		if !first {
+			w.RawByte(',')
+		}
+		w.RawString("\"resourceVersion\":")
+		w.String(in.ResourceVersion)
+		first = false
+	}
+	if in.ResourceVersionMatch != "" {
+		if !first {
+			w.RawByte(',')
+		}
+		w.RawString("\"resourceVersionMatch\":")
+		w.String(string(in.ResourceVersionMatch))
+		first = false
+	}
+	if in.TimeoutSeconds != 0 {
+		if !first {
+			w.RawByte(',')
+		}
+		w.RawString("\"timeoutSeconds\":")
+		w.Int64(in.TimeoutSeconds)
+		first = false
+	}
+	if in.Limit != 0 {
+		if !first {
+			w.RawByte(',')
+		}
+		w.RawString("\"limit\":")
+		w.Int64(in.Limit)
+		first = false
+	}
+	if in.Continue != "" {
+		if !first {
+			w.RawByte(',')
+		}
+		w.RawString("\"continue\":")
+		w.String(in.Continue)
+		first = false
+	}
+	if in.SendInitialEvents {
+		if !first {
+			w.RawByte(',')
+		}
+		w.RawString("\"sendInitialEvents\":")
+		w.Bool(in.SendInitialEvents)
+		first = false
+	}
+	if in.ShardSelector != "" {
+		if !first {
+			w.RawByte(',')
+		}
+		w.RawString("\"shardSelector\":")
+		w.String(in.ShardSelector)
+		first = false
+	}
+	w.RawByte('}')
+}
+
+func (in *ListOptions) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "kind":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.TypeMeta.Kind = l.String()
+			}
+		case "apiVersion":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.TypeMeta.APIVersion = l.String()
+			}
+		case "labelSelector":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.LabelSelector = l.String()
+			}
+		case "fieldSelector":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.FieldSelector = l.String()
+			}
+		case "watch":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.Watch = l.Bool()
+			}
+		case "allowWatchBookmarks":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.AllowWatchBookmarks = l.Bool()
+			}
+		case "resourceVersion":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.ResourceVersion = l.String()
+			}
+		case "resourceVersionMatch":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.ResourceVersionMatch = ResourceVersionMatch(l.String())
+			}
+		case "timeoutSeconds":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.TimeoutSeconds = l.Int64()
+			}
+		case "limit":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.Limit = l.Int64()
+			}
+		case "continue":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.Continue = l.String()
+			}
+		case "sendInitialEvents":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.SendInitialEvents = l.Bool()
+			}
+		case "shardSelector":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.ShardSelector = l.String()
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
+
 type ManagedFieldsEntry struct {
 	// Manager is an identifier of the workflow managing these fields.
 	Manager string `json:"manager,omitempty"`
@@ -1125,6 +3641,144 @@ func (in *ManagedFieldsEntry) DeepCopy() *ManagedFieldsEntry {
 	return out
 }
 
+func (in *ManagedFieldsEntry) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *ManagedFieldsEntry) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *ManagedFieldsEntry) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	first := true
+	if in.Manager != "" {
+		w.RawString("\"manager\":")
+		w.String(in.Manager)
+		first = false
+	}
+	if in.Operation != "" {
+		if !first {
+			w.RawByte(',')
+		}
+		w.RawString("\"operation\":")
+		w.String(string(in.Operation))
+		first = false
+	}
+	if in.APIVersion != "" {
+		if !first {
+			w.RawByte(',')
+		}
+		w.RawString("\"apiVersion\":")
+		w.String(in.APIVersion)
+		first = false
+	}
+	if in.Time != nil {
+		if !first {
+			w.RawByte(',')
+		}
+		w.RawString("\"time\":")
+		w.Raw(json.Marshal(in.Time))
+		first = false
+	}
+	if in.FieldsType != "" {
+		if !first {
+			w.RawByte(',')
+		}
+		w.RawString("\"fieldsType\":")
+		w.String(in.FieldsType)
+		first = false
+	}
+	if in.FieldsV1 != nil {
+		if !first {
+			w.RawByte(',')
+		}
+		w.RawString("\"fieldsV1\":")
+		in.FieldsV1.MarshalEasyJSON(w)
+		first = false
+	}
+	if in.Subresource != "" {
+		if !first {
+			w.RawByte(',')
+		}
+		w.RawString("\"subresource\":")
+		w.String(in.Subresource)
+		first = false
+	}
+	w.RawByte('}')
+}
+
+func (in *ManagedFieldsEntry) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "manager":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.Manager = l.String()
+			}
+		case "operation":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.Operation = ManagedFieldsOperationType(l.String())
+			}
+		case "apiVersion":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.APIVersion = l.String()
+			}
+		case "time":
+			l.AddError(json.Unmarshal(l.Raw(), &in.Time))
+		case "fieldsType":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.FieldsType = l.String()
+			}
+		case "fieldsV1":
+			if l.IsNull() {
+				l.Skip()
+				in.FieldsV1 = nil
+			} else {
+				if in.FieldsV1 == nil {
+					in.FieldsV1 = new(FieldsV1)
+				}
+				in.FieldsV1.UnmarshalEasyJSON(l)
+			}
+		case "subresource":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.Subresource = l.String()
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
+
 type MicroTime struct {
 	// Represents seconds of UTC time since Unix epoch
 	// 1970-01-01T00:00:00Z. Must be from 0001-01-01T00:00:00Z to
@@ -1150,6 +3804,65 @@ func (in *MicroTime) DeepCopy() *MicroTime {
 	return out
 }
 
+func (in *MicroTime) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *MicroTime) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *MicroTime) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	w.RawString("\"seconds\":")
+	w.Int64(in.Seconds)
+	w.RawString(",\"nanos\":")
+	w.Int(in.Nanos)
+
+	w.RawByte('}')
+}
+
+func (in *MicroTime) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "seconds":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.Seconds = l.Int64()
+			}
+		case "nanos":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.Nanos = l.Int()
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
+
 type ObjectMeta struct {
 	// Name must be unique within a namespace. Is required when creating resources, although
 	// some resources may allow a client to request the generation of an appropriate name
@@ -1325,21 +4038,370 @@ func (in *ObjectMeta) DeepCopy() *ObjectMeta {
 	return out
 }
 
-type OwnerReference struct {
-	// API version of the referent.
-	APIVersion string `json:"apiVersion"`
-	// Kind of the referent.
-	// More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds
-	Kind string `json:"kind"`
-	// Name of the referent.
-	// More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names#names
-	Name string `json:"name"`
-	// UID of the referent.
-	// More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names#uids
-	UID string `json:"uid"`
-	// If true, this reference points to the managing controller.
-	Controller bool `json:"controller,omitempty"`
-	// If true, AND if the owner has the "foregroundDeletion" finalizer, then
+func (in *ObjectMeta) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	first := true
+	if in.Name != "" {
+		w.RawString("\"name\":")
+		w.String(in.Name)
+		first = false
+	}
+	if in.GenerateName != "" {
+		if !first {
+			w.RawByte(',')
+		}
+		w.RawString("\"generateName\":")
+		w.String(in.GenerateName)
+		first = false
+	}
+	if in.Namespace != "" {
+		if !first {
+			w.RawByte(',')
+		}
+		w.RawString("\"namespace\":")
+		w.String(in.Namespace)
+		first = false
+	}
+	if in.SelfLink != "" {
+		if !first {
+			w.RawByte(',')
+		}
+		w.RawString("\"selfLink\":")
+		w.String(in.SelfLink)
+		first = false
+	}
+	if in.UID != "" {
+		if !first {
+			w.RawByte(',')
+		}
+		w.RawString("\"uid\":")
+		w.String(in.UID)
+		first = false
+	}
+	if in.ResourceVersion != "" {
+		if !first {
+			w.RawByte(',')
+		}
+		w.RawString("\"resourceVersion\":")
+		w.String(in.ResourceVersion)
+		first = false
+	}
+	if in.Generation != 0 {
+		if !first {
+			w.RawByte(',')
+		}
+		w.RawString("\"generation\":")
+		w.Int64(in.Generation)
+		first = false
+	}
+	if in.CreationTimestamp != nil {
+		if !first {
+			w.RawByte(',')
+		}
+		w.RawString("\"creationTimestamp\":")
+		w.Raw(json.Marshal(in.CreationTimestamp))
+		first = false
+	}
+	if in.DeletionTimestamp != nil {
+		if !first {
+			w.RawByte(',')
+		}
+		w.RawString("\"deletionTimestamp\":")
+		w.Raw(json.Marshal(in.DeletionTimestamp))
+		first = false
+	}
+	if in.DeletionGracePeriodSeconds != 0 {
+		if !first {
+			w.RawByte(',')
+		}
+		w.RawString("\"deletionGracePeriodSeconds\":")
+		w.Int64(in.DeletionGracePeriodSeconds)
+		first = false
+	}
+	if len(in.Labels) != 0 {
+		if !first {
+			w.RawByte(',')
+		}
+		w.RawString("\"labels\":")
+		if in.Labels == nil {
+			w.RawString("null")
+		} else {
+			w.RawByte('{')
+			for i0, k0 := range slices.Sorted(maps.Keys(in.Labels)) {
+				if i0 > 0 {
+					w.RawByte(',')
+				}
+				w.String(k0)
+				w.RawByte(':')
+				v0 := in.Labels[k0]
+				w.String(v0)
+			}
+			w.RawByte('}')
+		}
+		first = false
+	}
+	if len(in.Annotations) != 0 {
+		if !first {
+			w.RawByte(',')
+		}
+		w.RawString("\"annotations\":")
+		if in.Annotations == nil {
+			w.RawString("null")
+		} else {
+			w.RawByte('{')
+			for i0, k0 := range slices.Sorted(maps.Keys(in.Annotations)) {
+				if i0 > 0 {
+					w.RawByte(',')
+				}
+				w.String(k0)
+				w.RawByte(':')
+				v0 := in.Annotations[k0]
+				w.String(v0)
+			}
+			w.RawByte('}')
+		}
+		first = false
+	}
+	if !first {
+		w.RawByte(',')
+	}
+	w.RawString("\"ownerReferences\":")
+	if in.OwnerReferences == nil {
+		w.RawString("null")
+	} else {
+		w.RawByte('[')
+		for i0 := range in.OwnerReferences {
+			if i0 > 0 {
+				w.RawByte(',')
+			}
+			in.OwnerReferences[i0].MarshalEasyJSON(w)
+		}
+		w.RawByte(']')
+	}
+	w.RawString(",\"finalizers\":")
+	if in.Finalizers == nil {
+		w.RawString("null")
+	} else {
+		w.RawByte('[')
+		for i0 := range in.Finalizers {
+			if i0 > 0 {
+				w.RawByte(',')
+			}
+			w.String(in.Finalizers[i0])
+		}
+		w.RawByte(']')
+	}
+	w.RawString(",\"managedFields\":")
+	if in.ManagedFields == nil {
+		w.RawString("null")
+	} else {
+		w.RawByte('[')
+		for i0 := range in.ManagedFields {
+			if i0 > 0 {
+				w.RawByte(',')
+			}
+			in.ManagedFields[i0].MarshalEasyJSON(w)
+		}
+		w.RawByte(']')
+	}
+	w.RawByte('}')
+}
+
+func (in *ObjectMeta) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "name":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.Name = l.String()
+			}
+		case "generateName":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.GenerateName = l.String()
+			}
+		case "namespace":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.Namespace = l.String()
+			}
+		case "selfLink":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.SelfLink = l.String()
+			}
+		case "uid":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.UID = l.String()
+			}
+		case "resourceVersion":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.ResourceVersion = l.String()
+			}
+		case "generation":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.Generation = l.Int64()
+			}
+		case "creationTimestamp":
+			l.AddError(json.Unmarshal(l.Raw(), &in.CreationTimestamp))
+		case "deletionTimestamp":
+			l.AddError(json.Unmarshal(l.Raw(), &in.DeletionTimestamp))
+		case "deletionGracePeriodSeconds":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.DeletionGracePeriodSeconds = l.Int64()
+			}
+		case "labels":
+			if l.IsNull() {
+				l.Skip()
+				in.Labels = nil
+			} else {
+				if in.Labels == nil {
+					in.Labels = make(map[string]string)
+				}
+				l.Delim('{')
+				for !l.IsDelim('}') {
+					k0 := l.String()
+					l.WantColon()
+					var v0 string
+					if l.IsNull() {
+						l.Skip()
+					} else {
+						v0 = l.String()
+					}
+					in.Labels[k0] = v0
+					l.WantComma()
+				}
+				l.Delim('}')
+			}
+		case "annotations":
+			if l.IsNull() {
+				l.Skip()
+				in.Annotations = nil
+			} else {
+				if in.Annotations == nil {
+					in.Annotations = make(map[string]string)
+				}
+				l.Delim('{')
+				for !l.IsDelim('}') {
+					k0 := l.String()
+					l.WantColon()
+					var v0 string
+					if l.IsNull() {
+						l.Skip()
+					} else {
+						v0 = l.String()
+					}
+					in.Annotations[k0] = v0
+					l.WantComma()
+				}
+				l.Delim('}')
+			}
+		case "ownerReferences":
+			if l.IsNull() {
+				l.Skip()
+				in.OwnerReferences = nil
+			} else {
+				in.OwnerReferences = make([]OwnerReference, 0)
+				l.Delim('[')
+				for !l.IsDelim(']') {
+					var v0 OwnerReference
+					if l.IsNull() {
+						l.Skip()
+					} else {
+						v0.UnmarshalEasyJSON(l)
+					}
+					in.OwnerReferences = append(in.OwnerReferences, v0)
+					l.WantComma()
+				}
+				l.Delim(']')
+			}
+		case "finalizers":
+			if l.IsNull() {
+				l.Skip()
+				in.Finalizers = nil
+			} else {
+				in.Finalizers = make([]string, 0)
+				l.Delim('[')
+				for !l.IsDelim(']') {
+					var v0 string
+					if l.IsNull() {
+						l.Skip()
+					} else {
+						v0 = l.String()
+					}
+					in.Finalizers = append(in.Finalizers, v0)
+					l.WantComma()
+				}
+				l.Delim(']')
+			}
+		case "managedFields":
+			if l.IsNull() {
+				l.Skip()
+				in.ManagedFields = nil
+			} else {
+				in.ManagedFields = make([]ManagedFieldsEntry, 0)
+				l.Delim('[')
+				for !l.IsDelim(']') {
+					var v0 ManagedFieldsEntry
+					if l.IsNull() {
+						l.Skip()
+					} else {
+						v0.UnmarshalEasyJSON(l)
+					}
+					in.ManagedFields = append(in.ManagedFields, v0)
+					l.WantComma()
+				}
+				l.Delim(']')
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
+
+type OwnerReference struct {
+	// API version of the referent.
+	APIVersion string `json:"apiVersion"`
+	// Kind of the referent.
+	// More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds
+	Kind string `json:"kind"`
+	// Name of the referent.
+	// More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names#names
+	Name string `json:"name"`
+	// UID of the referent.
+	// More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names#uids
+	UID string `json:"uid"`
+	// If true, this reference points to the managing controller.
+	Controller bool `json:"controller,omitempty"`
+	// If true, AND if the owner has the "foregroundDeletion" finalizer, then
 	// the owner cannot be deleted from the key-value store until this
 	// reference is removed.
 	// See https://kubernetes.io/docs/concepts/architecture/garbage-collection/#foreground-deletion
@@ -1363,6 +4425,101 @@ func (in *OwnerReference) DeepCopy() *OwnerReference {
 	return out
 }
 
+func (in *OwnerReference) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *OwnerReference) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *OwnerReference) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	w.RawString("\"apiVersion\":")
+	w.String(in.APIVersion)
+	w.RawString(",\"kind\":")
+	w.String(in.Kind)
+	w.RawString(",\"name\":")
+	w.String(in.Name)
+	w.RawString(",\"uid\":")
+	w.String(in.UID)
+	if in.Controller {
+		w.RawString(",\"controller\":")
+		w.Bool(in.Controller)
+	}
+	if in.BlockOwnerDeletion {
+		w.RawString(",\"blockOwnerDeletion\":")
+		w.Bool(in.BlockOwnerDeletion)
+	}
+
+	w.RawByte('}')
+}
+
+func (in *OwnerReference) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "apiVersion":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.APIVersion = l.String()
+			}
+		case "kind":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.Kind = l.String()
+			}
+		case "name":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.Name = l.String()
+			}
+		case "uid":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.UID = l.String()
+			}
+		case "controller":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.Controller = l.Bool()
+			}
+		case "blockOwnerDeletion":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.BlockOwnerDeletion = l.Bool()
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
+
 type PartialObjectMetadata struct {
 	TypeMeta   `json:",inline"`
 	ObjectMeta `json:"metadata"`
@@ -1390,6 +4547,85 @@ func (in *PartialObjectMetadata) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+func (in *PartialObjectMetadata) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *PartialObjectMetadata) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *PartialObjectMetadata) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	first := true
+	if in.TypeMeta.Kind != "" {
+		w.RawString("\"kind\":")
+		w.String(in.TypeMeta.Kind)
+		first = false
+	}
+	if in.TypeMeta.APIVersion != "" {
+		if !first {
+			w.RawByte(',')
+		}
+		w.RawString("\"apiVersion\":")
+		w.String(in.TypeMeta.APIVersion)
+		first = false
+	}
+	if !first {
+		w.RawByte(',')
+	}
+	w.RawString("\"metadata\":")
+	in.ObjectMeta.MarshalEasyJSON(w)
+	w.RawByte('}')
+}
+
+func (in *PartialObjectMetadata) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "kind":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.TypeMeta.Kind = l.String()
+			}
+		case "apiVersion":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.TypeMeta.APIVersion = l.String()
+			}
+		case "metadata":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.ObjectMeta.UnmarshalEasyJSON(l)
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
+
 type PartialObjectMetadataList struct {
 	TypeMeta `json:",inline"`
 	ListMeta `json:"metadata"`
@@ -1425,6 +4661,117 @@ func (in *PartialObjectMetadataList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+func (in *PartialObjectMetadataList) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *PartialObjectMetadataList) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *PartialObjectMetadataList) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	first := true
+	if in.TypeMeta.Kind != "" {
+		w.RawString("\"kind\":")
+		w.String(in.TypeMeta.Kind)
+		first = false
+	}
+	if in.TypeMeta.APIVersion != "" {
+		if !first {
+			w.RawByte(',')
+		}
+		w.RawString("\"apiVersion\":")
+		w.String(in.TypeMeta.APIVersion)
+		first = false
+	}
+	if !first {
+		w.RawByte(',')
+	}
+	w.RawString("\"metadata\":")
+	in.ListMeta.MarshalEasyJSON(w)
+	w.RawString(",\"items\":")
+	if in.Items == nil {
+		w.RawString("null")
+	} else {
+		w.RawByte('[')
+		for i0 := range in.Items {
+			if i0 > 0 {
+				w.RawByte(',')
+			}
+			in.Items[i0].MarshalEasyJSON(w)
+		}
+		w.RawByte(']')
+	}
+	w.RawByte('}')
+}
+
+func (in *PartialObjectMetadataList) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "kind":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.TypeMeta.Kind = l.String()
+			}
+		case "apiVersion":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.TypeMeta.APIVersion = l.String()
+			}
+		case "metadata":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.ListMeta.UnmarshalEasyJSON(l)
+			}
+		case "items":
+			if l.IsNull() {
+				l.Skip()
+				in.Items = nil
+			} else {
+				in.Items = make([]PartialObjectMetadata, 0)
+				l.Delim('[')
+				for !l.IsDelim(']') {
+					var v0 PartialObjectMetadata
+					if l.IsNull() {
+						l.Skip()
+					} else {
+						v0.UnmarshalEasyJSON(l)
+					}
+					in.Items = append(in.Items, v0)
+					l.WantComma()
+				}
+				l.Delim(']')
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
+
 type Patch struct {
 }
 
@@ -1441,6 +4788,49 @@ func (in *Patch) DeepCopy() *Patch {
 	return out
 }
 
+func (in *Patch) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *Patch) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *Patch) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+
+	w.RawByte('}')
+}
+
+func (in *Patch) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
+
 type PatchOptions struct {
 	TypeMeta `json:",inline"`
 	// When present, indicates that modifications should not be
@@ -1506,6 +4896,139 @@ func (in *PatchOptions) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+func (in *PatchOptions) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *PatchOptions) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *PatchOptions) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	first := true
+	if in.TypeMeta.Kind != "" {
+		w.RawString("\"kind\":")
+		w.String(in.TypeMeta.Kind)
+		first = false
+	}
+	if in.TypeMeta.APIVersion != "" {
+		if !first {
+			w.RawByte(',')
+		}
+		w.RawString("\"apiVersion\":")
+		w.String(in.TypeMeta.APIVersion)
+		first = false
+	}
+	if !first {
+		w.RawByte(',')
+	}
+	w.RawString("\"dryRun\":")
+	if in.DryRun == nil {
+		w.RawString("null")
+	} else {
+		w.RawByte('[')
+		for i0 := range in.DryRun {
+			if i0 > 0 {
+				w.RawByte(',')
+			}
+			w.String(in.DryRun[i0])
+		}
+		w.RawByte(']')
+	}
+	if in.Force {
+		w.RawString(",\"force\":")
+		w.Bool(in.Force)
+	}
+	if in.FieldManager != "" {
+		w.RawString(",\"fieldManager\":")
+		w.String(in.FieldManager)
+	}
+	if in.FieldValidation != "" {
+		w.RawString(",\"fieldValidation\":")
+		w.String(in.FieldValidation)
+	}
+	w.RawByte('}')
+}
+
+func (in *PatchOptions) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "kind":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.TypeMeta.Kind = l.String()
+			}
+		case "apiVersion":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.TypeMeta.APIVersion = l.String()
+			}
+		case "dryRun":
+			if l.IsNull() {
+				l.Skip()
+				in.DryRun = nil
+			} else {
+				in.DryRun = make([]string, 0)
+				l.Delim('[')
+				for !l.IsDelim(']') {
+					var v0 string
+					if l.IsNull() {
+						l.Skip()
+					} else {
+						v0 = l.String()
+					}
+					in.DryRun = append(in.DryRun, v0)
+					l.WantComma()
+				}
+				l.Delim(']')
+			}
+		case "force":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.Force = l.Bool()
+			}
+		case "fieldManager":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.FieldManager = l.String()
+			}
+		case "fieldValidation":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.FieldValidation = l.String()
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
+
 type Preconditions struct {
 	// Specifies the target UID.
 	UID string `json:"uid,omitempty"`
@@ -1526,6 +5049,74 @@ func (in *Preconditions) DeepCopy() *Preconditions {
 	return out
 }
 
+func (in *Preconditions) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *Preconditions) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *Preconditions) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	first := true
+	if in.UID != "" {
+		w.RawString("\"uid\":")
+		w.String(in.UID)
+		first = false
+	}
+	if in.ResourceVersion != "" {
+		if !first {
+			w.RawByte(',')
+		}
+		w.RawString("\"resourceVersion\":")
+		w.String(in.ResourceVersion)
+		first = false
+	}
+	w.RawByte('}')
+}
+
+func (in *Preconditions) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "uid":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.UID = l.String()
+			}
+		case "resourceVersion":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.ResourceVersion = l.String()
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
+
 type RootPaths struct {
 	// paths are the paths available at root.
 	Paths []string `json:"paths"`
@@ -1549,6 +5140,81 @@ func (in *RootPaths) DeepCopy() *RootPaths {
 	return out
 }
 
+func (in *RootPaths) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *RootPaths) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *RootPaths) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	w.RawString("\"paths\":")
+	if in.Paths == nil {
+		w.RawString("null")
+	} else {
+		w.RawByte('[')
+		for i0 := range in.Paths {
+			if i0 > 0 {
+				w.RawByte(',')
+			}
+			w.String(in.Paths[i0])
+		}
+		w.RawByte(']')
+	}
+
+	w.RawByte('}')
+}
+
+func (in *RootPaths) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "paths":
+			if l.IsNull() {
+				l.Skip()
+				in.Paths = nil
+			} else {
+				in.Paths = make([]string, 0)
+				l.Delim('[')
+				for !l.IsDelim(']') {
+					var v0 string
+					if l.IsNull() {
+						l.Skip()
+					} else {
+						v0 = l.String()
+					}
+					in.Paths = append(in.Paths, v0)
+					l.WantComma()
+				}
+				l.Delim(']')
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
+
 type ServerAddressByClientCIDR struct {
 	// The CIDR with which clients can match their IP to figure out the server address that they should use.
 	ClientCIDR string `json:"clientCIDR"`
@@ -1570,6 +5236,65 @@ func (in *ServerAddressByClientCIDR) DeepCopy() *ServerAddressByClientCIDR {
 	return out
 }
 
+func (in *ServerAddressByClientCIDR) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *ServerAddressByClientCIDR) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *ServerAddressByClientCIDR) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	w.RawString("\"clientCIDR\":")
+	w.String(in.ClientCIDR)
+	w.RawString(",\"serverAddress\":")
+	w.String(in.ServerAddress)
+
+	w.RawByte('}')
+}
+
+func (in *ServerAddressByClientCIDR) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "clientCIDR":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.ClientCIDR = l.String()
+			}
+		case "serverAddress":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.ServerAddress = l.String()
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
+
 type ShardInfo struct {
 	// selector is the shard selector string from the request, echoed back so clients
 	// can verify which shard they received and merge responses from multiple shards.
@@ -1589,6 +5314,57 @@ func (in *ShardInfo) DeepCopy() *ShardInfo {
 	return out
 }
 
+func (in *ShardInfo) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *ShardInfo) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *ShardInfo) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	w.RawString("\"selector\":")
+	w.String(in.Selector)
+
+	w.RawByte('}')
+}
+
+func (in *ShardInfo) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "selector":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.Selector = l.String()
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
+
 type Status struct {
 	TypeMeta `json:",inline"`
 	// Standard list metadata.
@@ -1645,6 +5421,166 @@ func (in *Status) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+func (in *Status) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *Status) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *Status) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	first := true
+	if in.TypeMeta.Kind != "" {
+		w.RawString("\"kind\":")
+		w.String(in.TypeMeta.Kind)
+		first = false
+	}
+	if in.TypeMeta.APIVersion != "" {
+		if !first {
+			w.RawByte(',')
+		}
+		w.RawString("\"apiVersion\":")
+		w.String(in.TypeMeta.APIVersion)
+		first = false
+	}
+	if in.ListMeta != nil {
+		if !first {
+			w.RawByte(',')
+		}
+		w.RawString("\"metadata\":")
+		in.ListMeta.MarshalEasyJSON(w)
+		first = false
+	}
+	if in.Status != "" {
+		if !first {
+			w.RawByte(',')
+		}
+		w.RawString("\"status\":")
+		w.String(in.Status)
+		first = false
+	}
+	if in.Message != "" {
+		if !first {
+			w.RawByte(',')
+		}
+		w.RawString("\"message\":")
+		w.String(in.Message)
+		first = false
+	}
+	if in.Reason != "" {
+		if !first {
+			w.RawByte(',')
+		}
+		w.RawString("\"reason\":")
+		w.String(string(in.Reason))
+		first = false
+	}
+	if in.Details != nil {
+		if !first {
+			w.RawByte(',')
+		}
+		w.RawString("\"details\":")
+		in.Details.MarshalEasyJSON(w)
+		first = false
+	}
+	if in.Code != 0 {
+		if !first {
+			w.RawByte(',')
+		}
+		w.RawString("\"code\":")
+		w.Int(in.Code)
+		first = false
+	}
+	w.RawByte('}')
+}
+
+func (in *Status) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "kind":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.TypeMeta.Kind = l.String()
+			}
+		case "apiVersion":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.TypeMeta.APIVersion = l.String()
+			}
+		case "metadata":
+			if l.IsNull() {
+				l.Skip()
+				in.ListMeta = nil
+			} else {
+				if in.ListMeta == nil {
+					in.ListMeta = new(ListMeta)
+				}
+				in.ListMeta.UnmarshalEasyJSON(l)
+			}
+		case "status":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.Status = l.String()
+			}
+		case "message":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.Message = l.String()
+			}
+		case "reason":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.Reason = StatusReason(l.String())
+			}
+		case "details":
+			if l.IsNull() {
+				l.Skip()
+				in.Details = nil
+			} else {
+				if in.Details == nil {
+					in.Details = new(StatusDetails)
+				}
+				in.Details.UnmarshalEasyJSON(l)
+			}
+		case "code":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.Code = l.Int()
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
+
 type StatusCause struct {
 	// A machine-readable description of the cause of the error. If this value is
 	// empty there is no information available.
@@ -1676,6 +5612,88 @@ func (in *StatusCause) DeepCopy() *StatusCause {
 	return out
 }
 
+func (in *StatusCause) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *StatusCause) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *StatusCause) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	first := true
+	if in.Type != "" {
+		w.RawString("\"reason\":")
+		w.String(string(in.Type))
+		first = false
+	}
+	if in.Message != "" {
+		if !first {
+			w.RawByte(',')
+		}
+		w.RawString("\"message\":")
+		w.String(in.Message)
+		first = false
+	}
+	if in.Field != "" {
+		if !first {
+			w.RawByte(',')
+		}
+		w.RawString("\"field\":")
+		w.String(in.Field)
+		first = false
+	}
+	w.RawByte('}')
+}
+
+func (in *StatusCause) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "reason":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.Type = CauseType(l.String())
+			}
+		case "message":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.Message = l.String()
+			}
+		case "field":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.Field = l.String()
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
+
 type StatusDetails struct {
 	// The name attribute of the resource associated with the status StatusReason
 	// (when there is a single name which can be described).
@@ -1719,6 +5737,147 @@ func (in *StatusDetails) DeepCopy() *StatusDetails {
 	return out
 }
 
+func (in *StatusDetails) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *StatusDetails) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *StatusDetails) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	first := true
+	if in.Name != "" {
+		w.RawString("\"name\":")
+		w.String(in.Name)
+		first = false
+	}
+	if in.Group != "" {
+		if !first {
+			w.RawByte(',')
+		}
+		w.RawString("\"group\":")
+		w.String(in.Group)
+		first = false
+	}
+	if in.Kind != "" {
+		if !first {
+			w.RawByte(',')
+		}
+		w.RawString("\"kind\":")
+		w.String(in.Kind)
+		first = false
+	}
+	if in.UID != "" {
+		if !first {
+			w.RawByte(',')
+		}
+		w.RawString("\"uid\":")
+		w.String(in.UID)
+		first = false
+	}
+	if !first {
+		w.RawByte(',')
+	}
+	w.RawString("\"causes\":")
+	if in.Causes == nil {
+		w.RawString("null")
+	} else {
+		w.RawByte('[')
+		for i0 := range in.Causes {
+			if i0 > 0 {
+				w.RawByte(',')
+			}
+			in.Causes[i0].MarshalEasyJSON(w)
+		}
+		w.RawByte(']')
+	}
+	if in.RetryAfterSeconds != 0 {
+		w.RawString(",\"retryAfterSeconds\":")
+		w.Int(in.RetryAfterSeconds)
+	}
+	w.RawByte('}')
+}
+
+func (in *StatusDetails) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "name":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.Name = l.String()
+			}
+		case "group":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.Group = l.String()
+			}
+		case "kind":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.Kind = l.String()
+			}
+		case "uid":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.UID = l.String()
+			}
+		case "causes":
+			if l.IsNull() {
+				l.Skip()
+				in.Causes = nil
+			} else {
+				in.Causes = make([]StatusCause, 0)
+				l.Delim('[')
+				for !l.IsDelim(']') {
+					var v0 StatusCause
+					if l.IsNull() {
+						l.Skip()
+					} else {
+						v0.UnmarshalEasyJSON(l)
+					}
+					in.Causes = append(in.Causes, v0)
+					l.WantComma()
+				}
+				l.Delim(']')
+			}
+		case "retryAfterSeconds":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.RetryAfterSeconds = l.Int()
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
+
 type TableOptions struct {
 	TypeMeta `json:",inline"`
 	// includeObject decides whether to include each object along with its columnar information.
@@ -1749,6 +5908,88 @@ func (in *TableOptions) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+func (in *TableOptions) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *TableOptions) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *TableOptions) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	first := true
+	if in.TypeMeta.Kind != "" {
+		w.RawString("\"kind\":")
+		w.String(in.TypeMeta.Kind)
+		first = false
+	}
+	if in.TypeMeta.APIVersion != "" {
+		if !first {
+			w.RawByte(',')
+		}
+		w.RawString("\"apiVersion\":")
+		w.String(in.TypeMeta.APIVersion)
+		first = false
+	}
+	if in.IncludeObject != "" {
+		if !first {
+			w.RawByte(',')
+		}
+		w.RawString("\"includeObject\":")
+		w.String(string(in.IncludeObject))
+		first = false
+	}
+	w.RawByte('}')
+}
+
+func (in *TableOptions) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "kind":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.TypeMeta.Kind = l.String()
+			}
+		case "apiVersion":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.TypeMeta.APIVersion = l.String()
+			}
+		case "includeObject":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.IncludeObject = IncludeObjectPolicy(l.String())
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
+
 type Time struct {
 	time.Time
 }
@@ -1791,6 +6032,65 @@ func (in *Timestamp) DeepCopy() *Timestamp {
 	return out
 }
 
+func (in *Timestamp) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *Timestamp) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *Timestamp) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	w.RawString("\"seconds\":")
+	w.Int64(in.Seconds)
+	w.RawString(",\"nanos\":")
+	w.Int(in.Nanos)
+
+	w.RawByte('}')
+}
+
+func (in *Timestamp) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "seconds":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.Seconds = l.Int64()
+			}
+		case "nanos":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.Nanos = l.Int()
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
+
 type TypeMeta struct {
 	// Kind is a string value representing the REST resource this object represents.
 	// Servers may infer this from the endpoint the client submits requests to.
@@ -1818,6 +6118,62 @@ func (in *TypeMeta) DeepCopy() *TypeMeta {
 	return out
 }
 
+func (in *TypeMeta) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	first := true
+	if in.Kind != "" {
+		w.RawString("\"kind\":")
+		w.String(in.Kind)
+		first = false
+	}
+	if in.APIVersion != "" {
+		if !first {
+			w.RawByte(',')
+		}
+		w.RawString("\"apiVersion\":")
+		w.String(in.APIVersion)
+		first = false
+	}
+	w.RawByte('}')
+}
+
+func (in *TypeMeta) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "kind":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.Kind = l.String()
+			}
+		case "apiVersion":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.APIVersion = l.String()
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
+
 type UpdateOptions struct {
 	TypeMeta `json:",inline"`
 	// When present, indicates that modifications should not be
@@ -1876,6 +6232,129 @@ func (in *UpdateOptions) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+func (in *UpdateOptions) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *UpdateOptions) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *UpdateOptions) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	first := true
+	if in.TypeMeta.Kind != "" {
+		w.RawString("\"kind\":")
+		w.String(in.TypeMeta.Kind)
+		first = false
+	}
+	if in.TypeMeta.APIVersion != "" {
+		if !first {
+			w.RawByte(',')
+		}
+		w.RawString("\"apiVersion\":")
+		w.String(in.TypeMeta.APIVersion)
+		first = false
+	}
+	if !first {
+		w.RawByte(',')
+	}
+	w.RawString("\"dryRun\":")
+	if in.DryRun == nil {
+		w.RawString("null")
+	} else {
+		w.RawByte('[')
+		for i0 := range in.DryRun {
+			if i0 > 0 {
+				w.RawByte(',')
+			}
+			w.String(in.DryRun[i0])
+		}
+		w.RawByte(']')
+	}
+	if in.FieldManager != "" {
+		w.RawString(",\"fieldManager\":")
+		w.String(in.FieldManager)
+	}
+	if in.FieldValidation != "" {
+		w.RawString(",\"fieldValidation\":")
+		w.String(in.FieldValidation)
+	}
+	w.RawByte('}')
+}
+
+func (in *UpdateOptions) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "kind":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.TypeMeta.Kind = l.String()
+			}
+		case "apiVersion":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.TypeMeta.APIVersion = l.String()
+			}
+		case "dryRun":
+			if l.IsNull() {
+				l.Skip()
+				in.DryRun = nil
+			} else {
+				in.DryRun = make([]string, 0)
+				l.Delim('[')
+				for !l.IsDelim(']') {
+					var v0 string
+					if l.IsNull() {
+						l.Skip()
+					} else {
+						v0 = l.String()
+					}
+					in.DryRun = append(in.DryRun, v0)
+					l.WantComma()
+				}
+				l.Delim(']')
+			}
+		case "fieldManager":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.FieldManager = l.String()
+			}
+		case "fieldValidation":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.FieldValidation = l.String()
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
+
 type Verbs []string
 
 type WatchEvent struct {
@@ -1901,3 +6380,58 @@ func (in *WatchEvent) DeepCopy() *WatchEvent {
 	in.DeepCopyInto(out)
 	return out
 }
+
+func (in *WatchEvent) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *WatchEvent) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *WatchEvent) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	w.RawString("\"type\":")
+	w.String(in.Type)
+	w.RawString(",\"object\":")
+	w.Raw(json.Marshal(&in.Object))
+
+	w.RawByte('}')
+}
+
+func (in *WatchEvent) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "type":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.Type = l.String()
+			}
+		case "object":
+			l.AddError(json.Unmarshal(l.Raw(), &in.Object))
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
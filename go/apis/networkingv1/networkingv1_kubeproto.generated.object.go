@@ -1,8 +1,13 @@
 package networkingv1
 
 import (
+	"encoding/json"
+	"github.com/mailru/easyjson/jlexer"
+	"github.com/mailru/easyjson/jwriter"
 	"go.f110.dev/kubeproto/go/apis/corev1"
 	"go.f110.dev/kubeproto/go/apis/metav1"
+	"go.f110.dev/kubeproto/go/patch"
+	"k8s.io/apimachinery/pkg/fields"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	utilintstr "k8s.io/apimachinery/pkg/util/intstr"
@@ -84,6 +89,198 @@ func (in *IPAddress) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// IPAddressToSelectableFields returns the fields of IPAddress which can be used by the field selector.
+func IPAddressToSelectableFields(obj *IPAddress) fields.Set {
+	set := fields.Set{
+		"metadata.name":      obj.ObjectMeta.Name,
+		"metadata.namespace": obj.ObjectMeta.Namespace,
+	}
+	return set
+}
+
+// IPAddressPatch builds JSON patch or JSON merge patch of IPAddress.
+// JSON patch fails if the parent of the field doesn't exist (e.g. SetLabel to the object which has no label). MergePatch creates the parent.
+type IPAddressPatch struct {
+	*patch.Builder
+}
+
+func NewIPAddressPatch() *IPAddressPatch {
+	return &IPAddressPatch{Builder: patch.NewBuilder()}
+}
+
+func (p *IPAddressPatch) SetLabel(key, value string) *IPAddressPatch {
+	p.Set([]string{"metadata", "labels", key}, value)
+	return p
+}
+
+func (p *IPAddressPatch) RemoveLabel(key string) *IPAddressPatch {
+	p.Remove([]string{"metadata", "labels", key})
+	return p
+}
+
+func (p *IPAddressPatch) SetAnnotation(key, value string) *IPAddressPatch {
+	p.Set([]string{"metadata", "annotations", key}, value)
+	return p
+}
+
+func (p *IPAddressPatch) RemoveAnnotation(key string) *IPAddressPatch {
+	p.Remove([]string{"metadata", "annotations", key})
+	return p
+}
+
+func (p *IPAddressPatch) SetSpec(v *IPAddressSpec) *IPAddressPatch {
+	p.Set([]string{"spec"}, v)
+	return p
+}
+
+func (p *IPAddressPatch) RemoveSpec() *IPAddressPatch {
+	p.Remove([]string{"spec"})
+	return p
+}
+
+func (p *IPAddressPatch) SetSpecParentRef(v *ParentReference) *IPAddressPatch {
+	p.Set([]string{"spec", "parentRef"}, v)
+	return p
+}
+
+func (p *IPAddressPatch) RemoveSpecParentRef() *IPAddressPatch {
+	p.Remove([]string{"spec", "parentRef"})
+	return p
+}
+
+func (p *IPAddressPatch) SetSpecParentRefGroup(v string) *IPAddressPatch {
+	p.Set([]string{"spec", "parentRef", "group"}, v)
+	return p
+}
+
+func (p *IPAddressPatch) RemoveSpecParentRefGroup() *IPAddressPatch {
+	p.Remove([]string{"spec", "parentRef", "group"})
+	return p
+}
+
+func (p *IPAddressPatch) SetSpecParentRefResource(v string) *IPAddressPatch {
+	p.Set([]string{"spec", "parentRef", "resource"}, v)
+	return p
+}
+
+func (p *IPAddressPatch) RemoveSpecParentRefResource() *IPAddressPatch {
+	p.Remove([]string{"spec", "parentRef", "resource"})
+	return p
+}
+
+func (p *IPAddressPatch) SetSpecParentRefNamespace(v string) *IPAddressPatch {
+	p.Set([]string{"spec", "parentRef", "namespace"}, v)
+	return p
+}
+
+func (p *IPAddressPatch) RemoveSpecParentRefNamespace() *IPAddressPatch {
+	p.Remove([]string{"spec", "parentRef", "namespace"})
+	return p
+}
+
+func (p *IPAddressPatch) SetSpecParentRefName(v string) *IPAddressPatch {
+	p.Set([]string{"spec", "parentRef", "name"}, v)
+	return p
+}
+
+func (p *IPAddressPatch) RemoveSpecParentRefName() *IPAddressPatch {
+	p.Remove([]string{"spec", "parentRef", "name"})
+	return p
+}
+
+func (in *IPAddress) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *IPAddress) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *IPAddress) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	first := true
+	if in.TypeMeta.Kind != "" {
+		w.RawString("\"kind\":")
+		w.String(in.TypeMeta.Kind)
+		first = false
+	}
+	if in.TypeMeta.APIVersion != "" {
+		if !first {
+			w.RawByte(',')
+		}
+		w.RawString("\"apiVersion\":")
+		w.String(in.TypeMeta.APIVersion)
+		first = false
+	}
+	if !first {
+		w.RawByte(',')
+	}
+	w.RawString("\"metadata\":")
+	in.ObjectMeta.MarshalEasyJSON(w)
+	if in.Spec != nil {
+		w.RawString(",\"spec\":")
+		in.Spec.MarshalEasyJSON(w)
+	}
+	w.RawByte('}')
+}
+
+func (in *IPAddress) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "kind":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.TypeMeta.Kind = l.String()
+			}
+		case "apiVersion":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.TypeMeta.APIVersion = l.String()
+			}
+		case "metadata":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.ObjectMeta.UnmarshalEasyJSON(l)
+			}
+		case "spec":
+			if l.IsNull() {
+				l.Skip()
+				in.Spec = nil
+			} else {
+				if in.Spec == nil {
+					in.Spec = new(IPAddressSpec)
+				}
+				in.Spec.UnmarshalEasyJSON(l)
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
+
 type IPAddressList struct {
 	metav1.TypeMeta `json:",inline"`
 	metav1.ListMeta `json:"metadata"`
@@ -119,6 +316,117 @@ func (in *IPAddressList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+func (in *IPAddressList) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *IPAddressList) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *IPAddressList) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	first := true
+	if in.TypeMeta.Kind != "" {
+		w.RawString("\"kind\":")
+		w.String(in.TypeMeta.Kind)
+		first = false
+	}
+	if in.TypeMeta.APIVersion != "" {
+		if !first {
+			w.RawByte(',')
+		}
+		w.RawString("\"apiVersion\":")
+		w.String(in.TypeMeta.APIVersion)
+		first = false
+	}
+	if !first {
+		w.RawByte(',')
+	}
+	w.RawString("\"metadata\":")
+	in.ListMeta.MarshalEasyJSON(w)
+	w.RawString(",\"items\":")
+	if in.Items == nil {
+		w.RawString("null")
+	} else {
+		w.RawByte('[')
+		for i0 := range in.Items {
+			if i0 > 0 {
+				w.RawByte(',')
+			}
+			in.Items[i0].MarshalEasyJSON(w)
+		}
+		w.RawByte(']')
+	}
+	w.RawByte('}')
+}
+
+func (in *IPAddressList) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "kind":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.TypeMeta.Kind = l.String()
+			}
+		case "apiVersion":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.TypeMeta.APIVersion = l.String()
+			}
+		case "metadata":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.ListMeta.UnmarshalEasyJSON(l)
+			}
+		case "items":
+			if l.IsNull() {
+				l.Skip()
+				in.Items = nil
+			} else {
+				in.Items = make([]IPAddress, 0)
+				l.Delim('[')
+				for !l.IsDelim(']') {
+					var v0 IPAddress
+					if l.IsNull() {
+						l.Skip()
+					} else {
+						v0.UnmarshalEasyJSON(l)
+					}
+					in.Items = append(in.Items, v0)
+					l.WantComma()
+				}
+				l.Delim(']')
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
+
 type Ingress struct {
 	metav1.TypeMeta   `json:",inline"`
 	metav1.ObjectMeta `json:"metadata"`
@@ -162,6 +470,313 @@ func (in *Ingress) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// IngressToSelectableFields returns the fields of Ingress which can be used by the field selector.
+func IngressToSelectableFields(obj *Ingress) fields.Set {
+	set := fields.Set{
+		"metadata.name":      obj.ObjectMeta.Name,
+		"metadata.namespace": obj.ObjectMeta.Namespace,
+	}
+	return set
+}
+
+// IngressPatch builds JSON patch or JSON merge patch of Ingress.
+// JSON patch fails if the parent of the field doesn't exist (e.g. SetLabel to the object which has no label). MergePatch creates the parent.
+type IngressPatch struct {
+	*patch.Builder
+}
+
+func NewIngressPatch() *IngressPatch {
+	return &IngressPatch{Builder: patch.NewBuilder()}
+}
+
+func (p *IngressPatch) SetLabel(key, value string) *IngressPatch {
+	p.Set([]string{"metadata", "labels", key}, value)
+	return p
+}
+
+func (p *IngressPatch) RemoveLabel(key string) *IngressPatch {
+	p.Remove([]string{"metadata", "labels", key})
+	return p
+}
+
+func (p *IngressPatch) SetAnnotation(key, value string) *IngressPatch {
+	p.Set([]string{"metadata", "annotations", key}, value)
+	return p
+}
+
+func (p *IngressPatch) RemoveAnnotation(key string) *IngressPatch {
+	p.Remove([]string{"metadata", "annotations", key})
+	return p
+}
+
+func (p *IngressPatch) SetSpec(v *IngressSpec) *IngressPatch {
+	p.Set([]string{"spec"}, v)
+	return p
+}
+
+func (p *IngressPatch) RemoveSpec() *IngressPatch {
+	p.Remove([]string{"spec"})
+	return p
+}
+
+func (p *IngressPatch) SetSpecIngressClassName(v string) *IngressPatch {
+	p.Set([]string{"spec", "ingressClassName"}, v)
+	return p
+}
+
+func (p *IngressPatch) RemoveSpecIngressClassName() *IngressPatch {
+	p.Remove([]string{"spec", "ingressClassName"})
+	return p
+}
+
+func (p *IngressPatch) SetSpecDefaultBackend(v *IngressBackend) *IngressPatch {
+	p.Set([]string{"spec", "defaultBackend"}, v)
+	return p
+}
+
+func (p *IngressPatch) RemoveSpecDefaultBackend() *IngressPatch {
+	p.Remove([]string{"spec", "defaultBackend"})
+	return p
+}
+
+func (p *IngressPatch) SetSpecDefaultBackendService(v *IngressServiceBackend) *IngressPatch {
+	p.Set([]string{"spec", "defaultBackend", "service"}, v)
+	return p
+}
+
+func (p *IngressPatch) RemoveSpecDefaultBackendService() *IngressPatch {
+	p.Remove([]string{"spec", "defaultBackend", "service"})
+	return p
+}
+
+func (p *IngressPatch) SetSpecDefaultBackendServiceName(v string) *IngressPatch {
+	p.Set([]string{"spec", "defaultBackend", "service", "name"}, v)
+	return p
+}
+
+func (p *IngressPatch) RemoveSpecDefaultBackendServiceName() *IngressPatch {
+	p.Remove([]string{"spec", "defaultBackend", "service", "name"})
+	return p
+}
+
+func (p *IngressPatch) SetSpecDefaultBackendServicePort(v *ServiceBackendPort) *IngressPatch {
+	p.Set([]string{"spec", "defaultBackend", "service", "port"}, v)
+	return p
+}
+
+func (p *IngressPatch) RemoveSpecDefaultBackendServicePort() *IngressPatch {
+	p.Remove([]string{"spec", "defaultBackend", "service", "port"})
+	return p
+}
+
+func (p *IngressPatch) SetSpecDefaultBackendServicePortName(v string) *IngressPatch {
+	p.Set([]string{"spec", "defaultBackend", "service", "port", "name"}, v)
+	return p
+}
+
+func (p *IngressPatch) RemoveSpecDefaultBackendServicePortName() *IngressPatch {
+	p.Remove([]string{"spec", "defaultBackend", "service", "port", "name"})
+	return p
+}
+
+func (p *IngressPatch) SetSpecDefaultBackendServicePortNumber(v int) *IngressPatch {
+	p.Set([]string{"spec", "defaultBackend", "service", "port", "number"}, v)
+	return p
+}
+
+func (p *IngressPatch) RemoveSpecDefaultBackendServicePortNumber() *IngressPatch {
+	p.Remove([]string{"spec", "defaultBackend", "service", "port", "number"})
+	return p
+}
+
+func (p *IngressPatch) SetSpecDefaultBackendResource(v *corev1.TypedLocalObjectReference) *IngressPatch {
+	p.Set([]string{"spec", "defaultBackend", "resource"}, v)
+	return p
+}
+
+func (p *IngressPatch) RemoveSpecDefaultBackendResource() *IngressPatch {
+	p.Remove([]string{"spec", "defaultBackend", "resource"})
+	return p
+}
+
+func (p *IngressPatch) SetSpecTLS(v []IngressTLS) *IngressPatch {
+	p.Set([]string{"spec", "tls"}, v)
+	return p
+}
+
+func (p *IngressPatch) RemoveSpecTLS() *IngressPatch {
+	p.Remove([]string{"spec", "tls"})
+	return p
+}
+
+func (p *IngressPatch) AddSpecTLS(v ...IngressTLS) *IngressPatch {
+	for _, e := range v {
+		p.Append([]string{"spec", "tls"}, e)
+	}
+	return p
+}
+
+func (p *IngressPatch) SetSpecRules(v []IngressRule) *IngressPatch {
+	p.Set([]string{"spec", "rules"}, v)
+	return p
+}
+
+func (p *IngressPatch) RemoveSpecRules() *IngressPatch {
+	p.Remove([]string{"spec", "rules"})
+	return p
+}
+
+func (p *IngressPatch) AddSpecRules(v ...IngressRule) *IngressPatch {
+	for _, e := range v {
+		p.Append([]string{"spec", "rules"}, e)
+	}
+	return p
+}
+
+func (p *IngressPatch) SetStatus(v *IngressStatus) *IngressPatch {
+	p.Set([]string{"status"}, v)
+	return p
+}
+
+func (p *IngressPatch) RemoveStatus() *IngressPatch {
+	p.Remove([]string{"status"})
+	return p
+}
+
+func (p *IngressPatch) SetStatusLoadBalancer(v *IngressLoadBalancerStatus) *IngressPatch {
+	p.Set([]string{"status", "loadBalancer"}, v)
+	return p
+}
+
+func (p *IngressPatch) RemoveStatusLoadBalancer() *IngressPatch {
+	p.Remove([]string{"status", "loadBalancer"})
+	return p
+}
+
+func (p *IngressPatch) SetStatusLoadBalancerIngress(v []IngressLoadBalancerIngress) *IngressPatch {
+	p.Set([]string{"status", "loadBalancer", "ingress"}, v)
+	return p
+}
+
+func (p *IngressPatch) RemoveStatusLoadBalancerIngress() *IngressPatch {
+	p.Remove([]string{"status", "loadBalancer", "ingress"})
+	return p
+}
+
+func (p *IngressPatch) AddStatusLoadBalancerIngress(v ...IngressLoadBalancerIngress) *IngressPatch {
+	for _, e := range v {
+		p.Append([]string{"status", "loadBalancer", "ingress"}, e)
+	}
+	return p
+}
+
+func (in *Ingress) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *Ingress) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *Ingress) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	first := true
+	if in.TypeMeta.Kind != "" {
+		w.RawString("\"kind\":")
+		w.String(in.TypeMeta.Kind)
+		first = false
+	}
+	if in.TypeMeta.APIVersion != "" {
+		if !first {
+			w.RawByte(',')
+		}
+		w.RawString("\"apiVersion\":")
+		w.String(in.TypeMeta.APIVersion)
+		first = false
+	}
+	if !first {
+		w.RawByte(',')
+	}
+	w.RawString("\"metadata\":")
+	in.ObjectMeta.MarshalEasyJSON(w)
+	if in.Spec != nil {
+		w.RawString(",\"spec\":")
+		in.Spec.MarshalEasyJSON(w)
+	}
+	if in.Status != nil {
+		w.RawString(",\"status\":")
+		in.Status.MarshalEasyJSON(w)
+	}
+	w.RawByte('}')
+}
+
+func (in *Ingress) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "kind":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.TypeMeta.Kind = l.String()
+			}
+		case "apiVersion":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.TypeMeta.APIVersion = l.String()
+			}
+		case "metadata":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.ObjectMeta.UnmarshalEasyJSON(l)
+			}
+		case "spec":
+			if l.IsNull() {
+				l.Skip()
+				in.Spec = nil
+			} else {
+				if in.Spec == nil {
+					in.Spec = new(IngressSpec)
+				}
+				in.Spec.UnmarshalEasyJSON(l)
+			}
+		case "status":
+			if l.IsNull() {
+				l.Skip()
+				in.Status = nil
+			} else {
+				if in.Status == nil {
+					in.Status = new(IngressStatus)
+				}
+				in.Status.UnmarshalEasyJSON(l)
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
+
 type IngressClass struct {
 	metav1.TypeMeta   `json:",inline"`
 	metav1.ObjectMeta `json:"metadata"`
@@ -197,6 +812,218 @@ func (in *IngressClass) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// IngressClassToSelectableFields returns the fields of IngressClass which can be used by the field selector.
+func IngressClassToSelectableFields(obj *IngressClass) fields.Set {
+	set := fields.Set{
+		"metadata.name":      obj.ObjectMeta.Name,
+		"metadata.namespace": obj.ObjectMeta.Namespace,
+	}
+	return set
+}
+
+// IngressClassPatch builds JSON patch or JSON merge patch of IngressClass.
+// JSON patch fails if the parent of the field doesn't exist (e.g. SetLabel to the object which has no label). MergePatch creates the parent.
+type IngressClassPatch struct {
+	*patch.Builder
+}
+
+func NewIngressClassPatch() *IngressClassPatch {
+	return &IngressClassPatch{Builder: patch.NewBuilder()}
+}
+
+func (p *IngressClassPatch) SetLabel(key, value string) *IngressClassPatch {
+	p.Set([]string{"metadata", "labels", key}, value)
+	return p
+}
+
+func (p *IngressClassPatch) RemoveLabel(key string) *IngressClassPatch {
+	p.Remove([]string{"metadata", "labels", key})
+	return p
+}
+
+func (p *IngressClassPatch) SetAnnotation(key, value string) *IngressClassPatch {
+	p.Set([]string{"metadata", "annotations", key}, value)
+	return p
+}
+
+func (p *IngressClassPatch) RemoveAnnotation(key string) *IngressClassPatch {
+	p.Remove([]string{"metadata", "annotations", key})
+	return p
+}
+
+func (p *IngressClassPatch) SetSpec(v *IngressClassSpec) *IngressClassPatch {
+	p.Set([]string{"spec"}, v)
+	return p
+}
+
+func (p *IngressClassPatch) RemoveSpec() *IngressClassPatch {
+	p.Remove([]string{"spec"})
+	return p
+}
+
+func (p *IngressClassPatch) SetSpecController(v string) *IngressClassPatch {
+	p.Set([]string{"spec", "controller"}, v)
+	return p
+}
+
+func (p *IngressClassPatch) RemoveSpecController() *IngressClassPatch {
+	p.Remove([]string{"spec", "controller"})
+	return p
+}
+
+func (p *IngressClassPatch) SetSpecParameters(v *IngressClassParametersReference) *IngressClassPatch {
+	p.Set([]string{"spec", "parameters"}, v)
+	return p
+}
+
+func (p *IngressClassPatch) RemoveSpecParameters() *IngressClassPatch {
+	p.Remove([]string{"spec", "parameters"})
+	return p
+}
+
+func (p *IngressClassPatch) SetSpecParametersAPIGroup(v string) *IngressClassPatch {
+	p.Set([]string{"spec", "parameters", "apiGroup"}, v)
+	return p
+}
+
+func (p *IngressClassPatch) RemoveSpecParametersAPIGroup() *IngressClassPatch {
+	p.Remove([]string{"spec", "parameters", "apiGroup"})
+	return p
+}
+
+func (p *IngressClassPatch) SetSpecParametersKind(v string) *IngressClassPatch {
+	p.Set([]string{"spec", "parameters", "kind"}, v)
+	return p
+}
+
+func (p *IngressClassPatch) RemoveSpecParametersKind() *IngressClassPatch {
+	p.Remove([]string{"spec", "parameters", "kind"})
+	return p
+}
+
+func (p *IngressClassPatch) SetSpecParametersName(v string) *IngressClassPatch {
+	p.Set([]string{"spec", "parameters", "name"}, v)
+	return p
+}
+
+func (p *IngressClassPatch) RemoveSpecParametersName() *IngressClassPatch {
+	p.Remove([]string{"spec", "parameters", "name"})
+	return p
+}
+
+func (p *IngressClassPatch) SetSpecParametersScope(v string) *IngressClassPatch {
+	p.Set([]string{"spec", "parameters", "scope"}, v)
+	return p
+}
+
+func (p *IngressClassPatch) RemoveSpecParametersScope() *IngressClassPatch {
+	p.Remove([]string{"spec", "parameters", "scope"})
+	return p
+}
+
+func (p *IngressClassPatch) SetSpecParametersNamespace(v string) *IngressClassPatch {
+	p.Set([]string{"spec", "parameters", "namespace"}, v)
+	return p
+}
+
+func (p *IngressClassPatch) RemoveSpecParametersNamespace() *IngressClassPatch {
+	p.Remove([]string{"spec", "parameters", "namespace"})
+	return p
+}
+
+func (in *IngressClass) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *IngressClass) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *IngressClass) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	first := true
+	if in.TypeMeta.Kind != "" {
+		w.RawString("\"kind\":")
+		w.String(in.TypeMeta.Kind)
+		first = false
+	}
+	if in.TypeMeta.APIVersion != "" {
+		if !first {
+			w.RawByte(',')
+		}
+		w.RawString("\"apiVersion\":")
+		w.String(in.TypeMeta.APIVersion)
+		first = false
+	}
+	if !first {
+		w.RawByte(',')
+	}
+	w.RawString("\"metadata\":")
+	in.ObjectMeta.MarshalEasyJSON(w)
+	if in.Spec != nil {
+		w.RawString(",\"spec\":")
+		in.Spec.MarshalEasyJSON(w)
+	}
+	w.RawByte('}')
+}
+
+func (in *IngressClass) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "kind":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.TypeMeta.Kind = l.String()
+			}
+		case "apiVersion":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.TypeMeta.APIVersion = l.String()
+			}
+		case "metadata":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.ObjectMeta.UnmarshalEasyJSON(l)
+			}
+		case "spec":
+			if l.IsNull() {
+				l.Skip()
+				in.Spec = nil
+			} else {
+				if in.Spec == nil {
+					in.Spec = new(IngressClassSpec)
+				}
+				in.Spec.UnmarshalEasyJSON(l)
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
+
 type IngressClassList struct {
 	metav1.TypeMeta `json:",inline"`
 	metav1.ListMeta `json:"metadata"`
@@ -232,6 +1059,117 @@ func (in *IngressClassList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+func (in *IngressClassList) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *IngressClassList) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *IngressClassList) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	first := true
+	if in.TypeMeta.Kind != "" {
+		w.RawString("\"kind\":")
+		w.String(in.TypeMeta.Kind)
+		first = false
+	}
+	if in.TypeMeta.APIVersion != "" {
+		if !first {
+			w.RawByte(',')
+		}
+		w.RawString("\"apiVersion\":")
+		w.String(in.TypeMeta.APIVersion)
+		first = false
+	}
+	if !first {
+		w.RawByte(',')
+	}
+	w.RawString("\"metadata\":")
+	in.ListMeta.MarshalEasyJSON(w)
+	w.RawString(",\"items\":")
+	if in.Items == nil {
+		w.RawString("null")
+	} else {
+		w.RawByte('[')
+		for i0 := range in.Items {
+			if i0 > 0 {
+				w.RawByte(',')
+			}
+			in.Items[i0].MarshalEasyJSON(w)
+		}
+		w.RawByte(']')
+	}
+	w.RawByte('}')
+}
+
+func (in *IngressClassList) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "kind":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.TypeMeta.Kind = l.String()
+			}
+		case "apiVersion":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.TypeMeta.APIVersion = l.String()
+			}
+		case "metadata":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.ListMeta.UnmarshalEasyJSON(l)
+			}
+		case "items":
+			if l.IsNull() {
+				l.Skip()
+				in.Items = nil
+			} else {
+				in.Items = make([]IngressClass, 0)
+				l.Delim('[')
+				for !l.IsDelim(']') {
+					var v0 IngressClass
+					if l.IsNull() {
+						l.Skip()
+					} else {
+						v0.UnmarshalEasyJSON(l)
+					}
+					in.Items = append(in.Items, v0)
+					l.WantComma()
+				}
+				l.Delim(']')
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
+
 type IngressList struct {
 	metav1.TypeMeta `json:",inline"`
 	metav1.ListMeta `json:"metadata"`
@@ -267,6 +1205,117 @@ func (in *IngressList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+func (in *IngressList) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *IngressList) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *IngressList) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	first := true
+	if in.TypeMeta.Kind != "" {
+		w.RawString("\"kind\":")
+		w.String(in.TypeMeta.Kind)
+		first = false
+	}
+	if in.TypeMeta.APIVersion != "" {
+		if !first {
+			w.RawByte(',')
+		}
+		w.RawString("\"apiVersion\":")
+		w.String(in.TypeMeta.APIVersion)
+		first = false
+	}
+	if !first {
+		w.RawByte(',')
+	}
+	w.RawString("\"metadata\":")
+	in.ListMeta.MarshalEasyJSON(w)
+	w.RawString(",\"items\":")
+	if in.Items == nil {
+		w.RawString("null")
+	} else {
+		w.RawByte('[')
+		for i0 := range in.Items {
+			if i0 > 0 {
+				w.RawByte(',')
+			}
+			in.Items[i0].MarshalEasyJSON(w)
+		}
+		w.RawByte(']')
+	}
+	w.RawByte('}')
+}
+
+func (in *IngressList) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "kind":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.TypeMeta.Kind = l.String()
+			}
+		case "apiVersion":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.TypeMeta.APIVersion = l.String()
+			}
+		case "metadata":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.ListMeta.UnmarshalEasyJSON(l)
+			}
+		case "items":
+			if l.IsNull() {
+				l.Skip()
+				in.Items = nil
+			} else {
+				in.Items = make([]Ingress, 0)
+				l.Delim('[')
+				for !l.IsDelim(']') {
+					var v0 Ingress
+					if l.IsNull() {
+						l.Skip()
+					} else {
+						v0.UnmarshalEasyJSON(l)
+					}
+					in.Items = append(in.Items, v0)
+					l.WantComma()
+				}
+				l.Delim(']')
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
+
 type NetworkPolicy struct {
 	metav1.TypeMeta   `json:",inline"`
 	metav1.ObjectMeta `json:"metadata"`
@@ -301,6 +1350,209 @@ func (in *NetworkPolicy) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// NetworkPolicyToSelectableFields returns the fields of NetworkPolicy which can be used by the field selector.
+func NetworkPolicyToSelectableFields(obj *NetworkPolicy) fields.Set {
+	set := fields.Set{
+		"metadata.name":      obj.ObjectMeta.Name,
+		"metadata.namespace": obj.ObjectMeta.Namespace,
+	}
+	return set
+}
+
+// NetworkPolicyPatch builds JSON patch or JSON merge patch of NetworkPolicy.
+// JSON patch fails if the parent of the field doesn't exist (e.g. SetLabel to the object which has no label). MergePatch creates the parent.
+type NetworkPolicyPatch struct {
+	*patch.Builder
+}
+
+func NewNetworkPolicyPatch() *NetworkPolicyPatch {
+	return &NetworkPolicyPatch{Builder: patch.NewBuilder()}
+}
+
+func (p *NetworkPolicyPatch) SetLabel(key, value string) *NetworkPolicyPatch {
+	p.Set([]string{"metadata", "labels", key}, value)
+	return p
+}
+
+func (p *NetworkPolicyPatch) RemoveLabel(key string) *NetworkPolicyPatch {
+	p.Remove([]string{"metadata", "labels", key})
+	return p
+}
+
+func (p *NetworkPolicyPatch) SetAnnotation(key, value string) *NetworkPolicyPatch {
+	p.Set([]string{"metadata", "annotations", key}, value)
+	return p
+}
+
+func (p *NetworkPolicyPatch) RemoveAnnotation(key string) *NetworkPolicyPatch {
+	p.Remove([]string{"metadata", "annotations", key})
+	return p
+}
+
+func (p *NetworkPolicyPatch) SetSpec(v *NetworkPolicySpec) *NetworkPolicyPatch {
+	p.Set([]string{"spec"}, v)
+	return p
+}
+
+func (p *NetworkPolicyPatch) RemoveSpec() *NetworkPolicyPatch {
+	p.Remove([]string{"spec"})
+	return p
+}
+
+func (p *NetworkPolicyPatch) SetSpecPodSelector(v metav1.LabelSelector) *NetworkPolicyPatch {
+	p.Set([]string{"spec", "podSelector"}, v)
+	return p
+}
+
+func (p *NetworkPolicyPatch) RemoveSpecPodSelector() *NetworkPolicyPatch {
+	p.Remove([]string{"spec", "podSelector"})
+	return p
+}
+
+func (p *NetworkPolicyPatch) SetSpecIngress(v []NetworkPolicyIngressRule) *NetworkPolicyPatch {
+	p.Set([]string{"spec", "ingress"}, v)
+	return p
+}
+
+func (p *NetworkPolicyPatch) RemoveSpecIngress() *NetworkPolicyPatch {
+	p.Remove([]string{"spec", "ingress"})
+	return p
+}
+
+func (p *NetworkPolicyPatch) AddSpecIngress(v ...NetworkPolicyIngressRule) *NetworkPolicyPatch {
+	for _, e := range v {
+		p.Append([]string{"spec", "ingress"}, e)
+	}
+	return p
+}
+
+func (p *NetworkPolicyPatch) SetSpecEgress(v []NetworkPolicyEgressRule) *NetworkPolicyPatch {
+	p.Set([]string{"spec", "egress"}, v)
+	return p
+}
+
+func (p *NetworkPolicyPatch) RemoveSpecEgress() *NetworkPolicyPatch {
+	p.Remove([]string{"spec", "egress"})
+	return p
+}
+
+func (p *NetworkPolicyPatch) AddSpecEgress(v ...NetworkPolicyEgressRule) *NetworkPolicyPatch {
+	for _, e := range v {
+		p.Append([]string{"spec", "egress"}, e)
+	}
+	return p
+}
+
+func (p *NetworkPolicyPatch) SetSpecPolicyTypes(v []PolicyType) *NetworkPolicyPatch {
+	p.Set([]string{"spec", "policyTypes"}, v)
+	return p
+}
+
+func (p *NetworkPolicyPatch) RemoveSpecPolicyTypes() *NetworkPolicyPatch {
+	p.Remove([]string{"spec", "policyTypes"})
+	return p
+}
+
+func (p *NetworkPolicyPatch) AddSpecPolicyTypes(v ...PolicyType) *NetworkPolicyPatch {
+	for _, e := range v {
+		p.Append([]string{"spec", "policyTypes"}, e)
+	}
+	return p
+}
+
+func (in *NetworkPolicy) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *NetworkPolicy) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *NetworkPolicy) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	first := true
+	if in.TypeMeta.Kind != "" {
+		w.RawString("\"kind\":")
+		w.String(in.TypeMeta.Kind)
+		first = false
+	}
+	if in.TypeMeta.APIVersion != "" {
+		if !first {
+			w.RawByte(',')
+		}
+		w.RawString("\"apiVersion\":")
+		w.String(in.TypeMeta.APIVersion)
+		first = false
+	}
+	if !first {
+		w.RawByte(',')
+	}
+	w.RawString("\"metadata\":")
+	in.ObjectMeta.MarshalEasyJSON(w)
+	if in.Spec != nil {
+		w.RawString(",\"spec\":")
+		in.Spec.MarshalEasyJSON(w)
+	}
+	w.RawByte('}')
+}
+
+func (in *NetworkPolicy) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "kind":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.TypeMeta.Kind = l.String()
+			}
+		case "apiVersion":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.TypeMeta.APIVersion = l.String()
+			}
+		case "metadata":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.ObjectMeta.UnmarshalEasyJSON(l)
+			}
+		case "spec":
+			if l.IsNull() {
+				l.Skip()
+				in.Spec = nil
+			} else {
+				if in.Spec == nil {
+					in.Spec = new(NetworkPolicySpec)
+				}
+				in.Spec.UnmarshalEasyJSON(l)
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
+
 type NetworkPolicyList struct {
 	metav1.TypeMeta `json:",inline"`
 	metav1.ListMeta `json:"metadata"`
@@ -320,63 +1572,402 @@ func (in *NetworkPolicyList) DeepCopyInto(out *NetworkPolicyList) {
 	}
 }
 
-func (in *NetworkPolicyList) DeepCopy() *NetworkPolicyList {
-	if in == nil {
-		return nil
+func (in *NetworkPolicyList) DeepCopy() *NetworkPolicyList {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkPolicyList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *NetworkPolicyList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+func (in *NetworkPolicyList) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *NetworkPolicyList) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *NetworkPolicyList) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	first := true
+	if in.TypeMeta.Kind != "" {
+		w.RawString("\"kind\":")
+		w.String(in.TypeMeta.Kind)
+		first = false
+	}
+	if in.TypeMeta.APIVersion != "" {
+		if !first {
+			w.RawByte(',')
+		}
+		w.RawString("\"apiVersion\":")
+		w.String(in.TypeMeta.APIVersion)
+		first = false
+	}
+	if !first {
+		w.RawByte(',')
+	}
+	w.RawString("\"metadata\":")
+	in.ListMeta.MarshalEasyJSON(w)
+	w.RawString(",\"items\":")
+	if in.Items == nil {
+		w.RawString("null")
+	} else {
+		w.RawByte('[')
+		for i0 := range in.Items {
+			if i0 > 0 {
+				w.RawByte(',')
+			}
+			in.Items[i0].MarshalEasyJSON(w)
+		}
+		w.RawByte(']')
+	}
+	w.RawByte('}')
+}
+
+func (in *NetworkPolicyList) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "kind":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.TypeMeta.Kind = l.String()
+			}
+		case "apiVersion":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.TypeMeta.APIVersion = l.String()
+			}
+		case "metadata":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.ListMeta.UnmarshalEasyJSON(l)
+			}
+		case "items":
+			if l.IsNull() {
+				l.Skip()
+				in.Items = nil
+			} else {
+				in.Items = make([]NetworkPolicy, 0)
+				l.Delim('[')
+				for !l.IsDelim(']') {
+					var v0 NetworkPolicy
+					if l.IsNull() {
+						l.Skip()
+					} else {
+						v0.UnmarshalEasyJSON(l)
+					}
+					in.Items = append(in.Items, v0)
+					l.WantComma()
+				}
+				l.Delim(']')
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
+
+type ServiceCIDR struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata"`
+	// spec is the desired state of the ServiceCIDR.
+	// More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#spec-and-status
+	Spec *ServiceCIDRSpec `json:"spec,omitempty"`
+	// status represents the current state of the ServiceCIDR.
+	// More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#spec-and-status
+	Status *ServiceCIDRStatus `json:"status,omitempty"`
+}
+
+func (in *ServiceCIDR) DeepCopyInto(out *ServiceCIDR) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	if in.Spec != nil {
+		in, out := &in.Spec, &out.Spec
+		*out = new(ServiceCIDRSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Status != nil {
+		in, out := &in.Status, &out.Status
+		*out = new(ServiceCIDRStatus)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+func (in *ServiceCIDR) DeepCopy() *ServiceCIDR {
+	if in == nil {
+		return nil
+	}
+	out := new(ServiceCIDR)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *ServiceCIDR) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+func (in *ServiceCIDR) GetConditions() []metav1.Condition {
+	if in.Status == nil {
+		return nil
+	}
+	return in.Status.Conditions
+}
+
+func (in *ServiceCIDR) SetConditions(conditions []metav1.Condition) {
+	if in.Status == nil {
+		in.Status = &ServiceCIDRStatus{}
+	}
+	in.Status.Conditions = conditions
+}
+
+func (in *ServiceCIDR) FindCondition(conditionType string) *metav1.Condition {
+	return metav1.FindCondition(in.GetConditions(), conditionType)
+}
+
+func (in *ServiceCIDR) SetCondition(condition metav1.Condition) {
+	conditions := in.GetConditions()
+	metav1.SetCondition(&conditions, condition)
+	in.SetConditions(conditions)
+}
+
+func (in *ServiceCIDR) IsConditionTrue(conditionType string) bool {
+	return metav1.IsConditionTrue(in.GetConditions(), conditionType)
+}
+
+// ServiceCIDRToSelectableFields returns the fields of ServiceCIDR which can be used by the field selector.
+func ServiceCIDRToSelectableFields(obj *ServiceCIDR) fields.Set {
+	set := fields.Set{
+		"metadata.name":      obj.ObjectMeta.Name,
+		"metadata.namespace": obj.ObjectMeta.Namespace,
+	}
+	return set
+}
+
+// ServiceCIDRPatch builds JSON patch or JSON merge patch of ServiceCIDR.
+// JSON patch fails if the parent of the field doesn't exist (e.g. SetLabel to the object which has no label). MergePatch creates the parent.
+type ServiceCIDRPatch struct {
+	*patch.Builder
+}
+
+func NewServiceCIDRPatch() *ServiceCIDRPatch {
+	return &ServiceCIDRPatch{Builder: patch.NewBuilder()}
+}
+
+func (p *ServiceCIDRPatch) SetLabel(key, value string) *ServiceCIDRPatch {
+	p.Set([]string{"metadata", "labels", key}, value)
+	return p
+}
+
+func (p *ServiceCIDRPatch) RemoveLabel(key string) *ServiceCIDRPatch {
+	p.Remove([]string{"metadata", "labels", key})
+	return p
+}
+
+func (p *ServiceCIDRPatch) SetAnnotation(key, value string) *ServiceCIDRPatch {
+	p.Set([]string{"metadata", "annotations", key}, value)
+	return p
+}
+
+func (p *ServiceCIDRPatch) RemoveAnnotation(key string) *ServiceCIDRPatch {
+	p.Remove([]string{"metadata", "annotations", key})
+	return p
+}
+
+func (p *ServiceCIDRPatch) SetSpec(v *ServiceCIDRSpec) *ServiceCIDRPatch {
+	p.Set([]string{"spec"}, v)
+	return p
+}
+
+func (p *ServiceCIDRPatch) RemoveSpec() *ServiceCIDRPatch {
+	p.Remove([]string{"spec"})
+	return p
+}
+
+func (p *ServiceCIDRPatch) SetSpecCIDRs(v []string) *ServiceCIDRPatch {
+	p.Set([]string{"spec", "cidrs"}, v)
+	return p
+}
+
+func (p *ServiceCIDRPatch) RemoveSpecCIDRs() *ServiceCIDRPatch {
+	p.Remove([]string{"spec", "cidrs"})
+	return p
+}
+
+func (p *ServiceCIDRPatch) AddSpecCIDRs(v ...string) *ServiceCIDRPatch {
+	for _, e := range v {
+		p.Append([]string{"spec", "cidrs"}, e)
 	}
-	out := new(NetworkPolicyList)
-	in.DeepCopyInto(out)
-	return out
+	return p
 }
 
-func (in *NetworkPolicyList) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
+func (p *ServiceCIDRPatch) SetStatus(v *ServiceCIDRStatus) *ServiceCIDRPatch {
+	p.Set([]string{"status"}, v)
+	return p
+}
+
+func (p *ServiceCIDRPatch) RemoveStatus() *ServiceCIDRPatch {
+	p.Remove([]string{"status"})
+	return p
+}
+
+func (p *ServiceCIDRPatch) SetStatusConditions(v []metav1.Condition) *ServiceCIDRPatch {
+	p.Set([]string{"status", "conditions"}, v)
+	return p
+}
+
+func (p *ServiceCIDRPatch) RemoveStatusConditions() *ServiceCIDRPatch {
+	p.Remove([]string{"status", "conditions"})
+	return p
+}
+
+func (p *ServiceCIDRPatch) AddStatusConditions(v ...metav1.Condition) *ServiceCIDRPatch {
+	for _, e := range v {
+		p.Append([]string{"status", "conditions"}, e)
 	}
-	return nil
+	return p
 }
 
-type ServiceCIDR struct {
-	metav1.TypeMeta   `json:",inline"`
-	metav1.ObjectMeta `json:"metadata"`
-	// spec is the desired state of the ServiceCIDR.
-	// More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#spec-and-status
-	Spec *ServiceCIDRSpec `json:"spec,omitempty"`
-	// status represents the current state of the ServiceCIDR.
-	// More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#spec-and-status
-	Status *ServiceCIDRStatus `json:"status,omitempty"`
+func (in *ServiceCIDR) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
 }
 
-func (in *ServiceCIDR) DeepCopyInto(out *ServiceCIDR) {
-	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+func (in *ServiceCIDR) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *ServiceCIDR) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	first := true
+	if in.TypeMeta.Kind != "" {
+		w.RawString("\"kind\":")
+		w.String(in.TypeMeta.Kind)
+		first = false
+	}
+	if in.TypeMeta.APIVersion != "" {
+		if !first {
+			w.RawByte(',')
+		}
+		w.RawString("\"apiVersion\":")
+		w.String(in.TypeMeta.APIVersion)
+		first = false
+	}
+	if !first {
+		w.RawByte(',')
+	}
+	w.RawString("\"metadata\":")
+	in.ObjectMeta.MarshalEasyJSON(w)
 	if in.Spec != nil {
-		in, out := &in.Spec, &out.Spec
-		*out = new(ServiceCIDRSpec)
-		(*in).DeepCopyInto(*out)
+		w.RawString(",\"spec\":")
+		in.Spec.MarshalEasyJSON(w)
 	}
 	if in.Status != nil {
-		in, out := &in.Status, &out.Status
-		*out = new(ServiceCIDRStatus)
-		(*in).DeepCopyInto(*out)
+		w.RawString(",\"status\":")
+		in.Status.MarshalEasyJSON(w)
 	}
+	w.RawByte('}')
 }
 
-func (in *ServiceCIDR) DeepCopy() *ServiceCIDR {
-	if in == nil {
-		return nil
+func (in *ServiceCIDR) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
 	}
-	out := new(ServiceCIDR)
-	in.DeepCopyInto(out)
-	return out
-}
-
-func (in *ServiceCIDR) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "kind":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.TypeMeta.Kind = l.String()
+			}
+		case "apiVersion":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.TypeMeta.APIVersion = l.String()
+			}
+		case "metadata":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.ObjectMeta.UnmarshalEasyJSON(l)
+			}
+		case "spec":
+			if l.IsNull() {
+				l.Skip()
+				in.Spec = nil
+			} else {
+				if in.Spec == nil {
+					in.Spec = new(ServiceCIDRSpec)
+				}
+				in.Spec.UnmarshalEasyJSON(l)
+			}
+		case "status":
+			if l.IsNull() {
+				l.Skip()
+				in.Status = nil
+			} else {
+				if in.Status == nil {
+					in.Status = new(ServiceCIDRStatus)
+				}
+				in.Status.UnmarshalEasyJSON(l)
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
 	}
-	return nil
 }
 
 type ServiceCIDRList struct {
@@ -414,6 +2005,117 @@ func (in *ServiceCIDRList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+func (in *ServiceCIDRList) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *ServiceCIDRList) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *ServiceCIDRList) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	first := true
+	if in.TypeMeta.Kind != "" {
+		w.RawString("\"kind\":")
+		w.String(in.TypeMeta.Kind)
+		first = false
+	}
+	if in.TypeMeta.APIVersion != "" {
+		if !first {
+			w.RawByte(',')
+		}
+		w.RawString("\"apiVersion\":")
+		w.String(in.TypeMeta.APIVersion)
+		first = false
+	}
+	if !first {
+		w.RawByte(',')
+	}
+	w.RawString("\"metadata\":")
+	in.ListMeta.MarshalEasyJSON(w)
+	w.RawString(",\"items\":")
+	if in.Items == nil {
+		w.RawString("null")
+	} else {
+		w.RawByte('[')
+		for i0 := range in.Items {
+			if i0 > 0 {
+				w.RawByte(',')
+			}
+			in.Items[i0].MarshalEasyJSON(w)
+		}
+		w.RawByte(']')
+	}
+	w.RawByte('}')
+}
+
+func (in *ServiceCIDRList) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "kind":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.TypeMeta.Kind = l.String()
+			}
+		case "apiVersion":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.TypeMeta.APIVersion = l.String()
+			}
+		case "metadata":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.ListMeta.UnmarshalEasyJSON(l)
+			}
+		case "items":
+			if l.IsNull() {
+				l.Skip()
+				in.Items = nil
+			} else {
+				in.Items = make([]ServiceCIDR, 0)
+				l.Delim('[')
+				for !l.IsDelim(']') {
+					var v0 ServiceCIDR
+					if l.IsNull() {
+						l.Skip()
+					} else {
+						v0.UnmarshalEasyJSON(l)
+					}
+					in.Items = append(in.Items, v0)
+					l.WantComma()
+				}
+				l.Delim(']')
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
+
 type IPAddressSpec struct {
 	// ParentRef references the resource that an IPAddress is attached to.
 	// An IPAddress must reference a parent object.
@@ -438,6 +2140,63 @@ func (in *IPAddressSpec) DeepCopy() *IPAddressSpec {
 	return out
 }
 
+func (in *IPAddressSpec) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *IPAddressSpec) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *IPAddressSpec) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	if in.ParentRef != nil {
+		w.RawString("\"parentRef\":")
+		in.ParentRef.MarshalEasyJSON(w)
+	}
+
+	w.RawByte('}')
+}
+
+func (in *IPAddressSpec) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "parentRef":
+			if l.IsNull() {
+				l.Skip()
+				in.ParentRef = nil
+			} else {
+				if in.ParentRef == nil {
+					in.ParentRef = new(ParentReference)
+				}
+				in.ParentRef.UnmarshalEasyJSON(l)
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
+
 type IngressSpec struct {
 	// ingressClassName is the name of an IngressClass cluster resource. Ingress
 	// controller implementations use this field to know whether they should be
@@ -498,6 +2257,145 @@ func (in *IngressSpec) DeepCopy() *IngressSpec {
 	return out
 }
 
+func (in *IngressSpec) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *IngressSpec) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *IngressSpec) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	first := true
+	if in.IngressClassName != "" {
+		w.RawString("\"ingressClassName\":")
+		w.String(in.IngressClassName)
+		first = false
+	}
+	if in.DefaultBackend != nil {
+		if !first {
+			w.RawByte(',')
+		}
+		w.RawString("\"defaultBackend\":")
+		in.DefaultBackend.MarshalEasyJSON(w)
+		first = false
+	}
+	if !first {
+		w.RawByte(',')
+	}
+	w.RawString("\"tls\":")
+	if in.TLS == nil {
+		w.RawString("null")
+	} else {
+		w.RawByte('[')
+		for i0 := range in.TLS {
+			if i0 > 0 {
+				w.RawByte(',')
+			}
+			in.TLS[i0].MarshalEasyJSON(w)
+		}
+		w.RawByte(']')
+	}
+	w.RawString(",\"rules\":")
+	if in.Rules == nil {
+		w.RawString("null")
+	} else {
+		w.RawByte('[')
+		for i0 := range in.Rules {
+			if i0 > 0 {
+				w.RawByte(',')
+			}
+			in.Rules[i0].MarshalEasyJSON(w)
+		}
+		w.RawByte(']')
+	}
+	w.RawByte('}')
+}
+
+func (in *IngressSpec) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "ingressClassName":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.IngressClassName = l.String()
+			}
+		case "defaultBackend":
+			if l.IsNull() {
+				l.Skip()
+				in.DefaultBackend = nil
+			} else {
+				if in.DefaultBackend == nil {
+					in.DefaultBackend = new(IngressBackend)
+				}
+				in.DefaultBackend.UnmarshalEasyJSON(l)
+			}
+		case "tls":
+			if l.IsNull() {
+				l.Skip()
+				in.TLS = nil
+			} else {
+				in.TLS = make([]IngressTLS, 0)
+				l.Delim('[')
+				for !l.IsDelim(']') {
+					var v0 IngressTLS
+					if l.IsNull() {
+						l.Skip()
+					} else {
+						v0.UnmarshalEasyJSON(l)
+					}
+					in.TLS = append(in.TLS, v0)
+					l.WantComma()
+				}
+				l.Delim(']')
+			}
+		case "rules":
+			if l.IsNull() {
+				l.Skip()
+				in.Rules = nil
+			} else {
+				in.Rules = make([]IngressRule, 0)
+				l.Delim('[')
+				for !l.IsDelim(']') {
+					var v0 IngressRule
+					if l.IsNull() {
+						l.Skip()
+					} else {
+						v0.UnmarshalEasyJSON(l)
+					}
+					in.Rules = append(in.Rules, v0)
+					l.WantComma()
+				}
+				l.Delim(']')
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
+
 type IngressStatus struct {
 	// loadBalancer contains the current status of the load-balancer.
 	LoadBalancer *IngressLoadBalancerStatus `json:"loadBalancer,omitempty"`
@@ -521,6 +2419,63 @@ func (in *IngressStatus) DeepCopy() *IngressStatus {
 	return out
 }
 
+func (in *IngressStatus) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *IngressStatus) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *IngressStatus) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	if in.LoadBalancer != nil {
+		w.RawString("\"loadBalancer\":")
+		in.LoadBalancer.MarshalEasyJSON(w)
+	}
+
+	w.RawByte('}')
+}
+
+func (in *IngressStatus) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "loadBalancer":
+			if l.IsNull() {
+				l.Skip()
+				in.LoadBalancer = nil
+			} else {
+				if in.LoadBalancer == nil {
+					in.LoadBalancer = new(IngressLoadBalancerStatus)
+				}
+				in.LoadBalancer.UnmarshalEasyJSON(l)
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
+
 type IngressClassSpec struct {
 	// controller refers to the name of the controller that should handle this
 	// class. This allows for different "flavors" that are controlled by the
@@ -553,6 +2508,78 @@ func (in *IngressClassSpec) DeepCopy() *IngressClassSpec {
 	return out
 }
 
+func (in *IngressClassSpec) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *IngressClassSpec) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *IngressClassSpec) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	first := true
+	if in.Controller != "" {
+		w.RawString("\"controller\":")
+		w.String(in.Controller)
+		first = false
+	}
+	if in.Parameters != nil {
+		if !first {
+			w.RawByte(',')
+		}
+		w.RawString("\"parameters\":")
+		in.Parameters.MarshalEasyJSON(w)
+		first = false
+	}
+	w.RawByte('}')
+}
+
+func (in *IngressClassSpec) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "controller":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.Controller = l.String()
+			}
+		case "parameters":
+			if l.IsNull() {
+				l.Skip()
+				in.Parameters = nil
+			} else {
+				if in.Parameters == nil {
+					in.Parameters = new(IngressClassParametersReference)
+				}
+				in.Parameters.UnmarshalEasyJSON(l)
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
+
 type NetworkPolicySpec struct {
 	// podSelector selects the pods to which this NetworkPolicy object applies.
 	// The array of rules is applied to any pods selected by this field. An empty
@@ -623,6 +2650,153 @@ func (in *NetworkPolicySpec) DeepCopy() *NetworkPolicySpec {
 	return out
 }
 
+func (in *NetworkPolicySpec) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *NetworkPolicySpec) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *NetworkPolicySpec) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	w.RawString("\"podSelector\":")
+	in.PodSelector.MarshalEasyJSON(w)
+	w.RawString(",\"ingress\":")
+	if in.Ingress == nil {
+		w.RawString("null")
+	} else {
+		w.RawByte('[')
+		for i0 := range in.Ingress {
+			if i0 > 0 {
+				w.RawByte(',')
+			}
+			in.Ingress[i0].MarshalEasyJSON(w)
+		}
+		w.RawByte(']')
+	}
+	w.RawString(",\"egress\":")
+	if in.Egress == nil {
+		w.RawString("null")
+	} else {
+		w.RawByte('[')
+		for i0 := range in.Egress {
+			if i0 > 0 {
+				w.RawByte(',')
+			}
+			in.Egress[i0].MarshalEasyJSON(w)
+		}
+		w.RawByte(']')
+	}
+	w.RawString(",\"policyTypes\":")
+	if in.PolicyTypes == nil {
+		w.RawString("null")
+	} else {
+		w.RawByte('[')
+		for i0 := range in.PolicyTypes {
+			if i0 > 0 {
+				w.RawByte(',')
+			}
+			w.String(string(in.PolicyTypes[i0]))
+		}
+		w.RawByte(']')
+	}
+
+	w.RawByte('}')
+}
+
+func (in *NetworkPolicySpec) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "podSelector":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.PodSelector.UnmarshalEasyJSON(l)
+			}
+		case "ingress":
+			if l.IsNull() {
+				l.Skip()
+				in.Ingress = nil
+			} else {
+				in.Ingress = make([]NetworkPolicyIngressRule, 0)
+				l.Delim('[')
+				for !l.IsDelim(']') {
+					var v0 NetworkPolicyIngressRule
+					if l.IsNull() {
+						l.Skip()
+					} else {
+						v0.UnmarshalEasyJSON(l)
+					}
+					in.Ingress = append(in.Ingress, v0)
+					l.WantComma()
+				}
+				l.Delim(']')
+			}
+		case "egress":
+			if l.IsNull() {
+				l.Skip()
+				in.Egress = nil
+			} else {
+				in.Egress = make([]NetworkPolicyEgressRule, 0)
+				l.Delim('[')
+				for !l.IsDelim(']') {
+					var v0 NetworkPolicyEgressRule
+					if l.IsNull() {
+						l.Skip()
+					} else {
+						v0.UnmarshalEasyJSON(l)
+					}
+					in.Egress = append(in.Egress, v0)
+					l.WantComma()
+				}
+				l.Delim(']')
+			}
+		case "policyTypes":
+			if l.IsNull() {
+				l.Skip()
+				in.PolicyTypes = nil
+			} else {
+				in.PolicyTypes = make([]PolicyType, 0)
+				l.Delim('[')
+				for !l.IsDelim(']') {
+					var v0 PolicyType
+					if l.IsNull() {
+						l.Skip()
+					} else {
+						v0 = PolicyType(l.String())
+					}
+					in.PolicyTypes = append(in.PolicyTypes, v0)
+					l.WantComma()
+				}
+				l.Delim(']')
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
+
 type ServiceCIDRSpec struct {
 	// CIDRs defines the IP blocks in CIDR notation (e.g. "192.168.0.0/24" or "2001:db8::/64")
 	// from which to assign service cluster IPs. Max of two CIDRs is allowed, one of each IP family.
@@ -648,6 +2822,81 @@ func (in *ServiceCIDRSpec) DeepCopy() *ServiceCIDRSpec {
 	return out
 }
 
+func (in *ServiceCIDRSpec) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *ServiceCIDRSpec) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *ServiceCIDRSpec) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	w.RawString("\"cidrs\":")
+	if in.CIDRs == nil {
+		w.RawString("null")
+	} else {
+		w.RawByte('[')
+		for i0 := range in.CIDRs {
+			if i0 > 0 {
+				w.RawByte(',')
+			}
+			w.String(in.CIDRs[i0])
+		}
+		w.RawByte(']')
+	}
+
+	w.RawByte('}')
+}
+
+func (in *ServiceCIDRSpec) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "cidrs":
+			if l.IsNull() {
+				l.Skip()
+				in.CIDRs = nil
+			} else {
+				in.CIDRs = make([]string, 0)
+				l.Delim('[')
+				for !l.IsDelim(']') {
+					var v0 string
+					if l.IsNull() {
+						l.Skip()
+					} else {
+						v0 = l.String()
+					}
+					in.CIDRs = append(in.CIDRs, v0)
+					l.WantComma()
+				}
+				l.Delim(']')
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
+
 type ServiceCIDRStatus struct {
 	// conditions holds an array of metav1.Condition that describe the state of the ServiceCIDR.
 	// Current service state
@@ -674,6 +2923,81 @@ func (in *ServiceCIDRStatus) DeepCopy() *ServiceCIDRStatus {
 	return out
 }
 
+func (in *ServiceCIDRStatus) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *ServiceCIDRStatus) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *ServiceCIDRStatus) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	w.RawString("\"conditions\":")
+	if in.Conditions == nil {
+		w.RawString("null")
+	} else {
+		w.RawByte('[')
+		for i0 := range in.Conditions {
+			if i0 > 0 {
+				w.RawByte(',')
+			}
+			in.Conditions[i0].MarshalEasyJSON(w)
+		}
+		w.RawByte(']')
+	}
+
+	w.RawByte('}')
+}
+
+func (in *ServiceCIDRStatus) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "conditions":
+			if l.IsNull() {
+				l.Skip()
+				in.Conditions = nil
+			} else {
+				in.Conditions = make([]metav1.Condition, 0)
+				l.Delim('[')
+				for !l.IsDelim(']') {
+					var v0 metav1.Condition
+					if l.IsNull() {
+						l.Skip()
+					} else {
+						v0.UnmarshalEasyJSON(l)
+					}
+					in.Conditions = append(in.Conditions, v0)
+					l.WantComma()
+				}
+				l.Delim(']')
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
+
 type ParentReference struct {
 	// Group is the group of the object being referenced.
 	Group string `json:"group,omitempty"`
@@ -698,6 +3022,102 @@ func (in *ParentReference) DeepCopy() *ParentReference {
 	return out
 }
 
+func (in *ParentReference) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *ParentReference) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *ParentReference) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	first := true
+	if in.Group != "" {
+		w.RawString("\"group\":")
+		w.String(in.Group)
+		first = false
+	}
+	if in.Resource != "" {
+		if !first {
+			w.RawByte(',')
+		}
+		w.RawString("\"resource\":")
+		w.String(in.Resource)
+		first = false
+	}
+	if in.Namespace != "" {
+		if !first {
+			w.RawByte(',')
+		}
+		w.RawString("\"namespace\":")
+		w.String(in.Namespace)
+		first = false
+	}
+	if in.Name != "" {
+		if !first {
+			w.RawByte(',')
+		}
+		w.RawString("\"name\":")
+		w.String(in.Name)
+		first = false
+	}
+	w.RawByte('}')
+}
+
+func (in *ParentReference) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "group":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.Group = l.String()
+			}
+		case "resource":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.Resource = l.String()
+			}
+		case "namespace":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.Namespace = l.String()
+			}
+		case "name":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.Name = l.String()
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
+
 type IngressBackend struct {
 	// service references a service as a backend.
 	// This is a mutually exclusive setting with "Resource".
@@ -732,6 +3152,82 @@ func (in *IngressBackend) DeepCopy() *IngressBackend {
 	return out
 }
 
+func (in *IngressBackend) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *IngressBackend) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *IngressBackend) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	first := true
+	if in.Service != nil {
+		w.RawString("\"service\":")
+		in.Service.MarshalEasyJSON(w)
+		first = false
+	}
+	if in.Resource != nil {
+		if !first {
+			w.RawByte(',')
+		}
+		w.RawString("\"resource\":")
+		in.Resource.MarshalEasyJSON(w)
+		first = false
+	}
+	w.RawByte('}')
+}
+
+func (in *IngressBackend) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "service":
+			if l.IsNull() {
+				l.Skip()
+				in.Service = nil
+			} else {
+				if in.Service == nil {
+					in.Service = new(IngressServiceBackend)
+				}
+				in.Service.UnmarshalEasyJSON(l)
+			}
+		case "resource":
+			if l.IsNull() {
+				l.Skip()
+				in.Resource = nil
+			} else {
+				if in.Resource == nil {
+					in.Resource = new(corev1.TypedLocalObjectReference)
+				}
+				in.Resource.UnmarshalEasyJSON(l)
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
+
 type IngressTLS struct {
 	// hosts is a list of hosts included in the TLS certificate. The values in
 	// this list must match the name/s used in the tlsSecret. Defaults to the
@@ -764,6 +3260,91 @@ func (in *IngressTLS) DeepCopy() *IngressTLS {
 	return out
 }
 
+func (in *IngressTLS) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *IngressTLS) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *IngressTLS) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	w.RawString("\"hosts\":")
+	if in.Hosts == nil {
+		w.RawString("null")
+	} else {
+		w.RawByte('[')
+		for i0 := range in.Hosts {
+			if i0 > 0 {
+				w.RawByte(',')
+			}
+			w.String(in.Hosts[i0])
+		}
+		w.RawByte(']')
+	}
+	if in.SecretName != "" {
+		w.RawString(",\"secretName\":")
+		w.String(in.SecretName)
+	}
+
+	w.RawByte('}')
+}
+
+func (in *IngressTLS) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "hosts":
+			if l.IsNull() {
+				l.Skip()
+				in.Hosts = nil
+			} else {
+				in.Hosts = make([]string, 0)
+				l.Delim('[')
+				for !l.IsDelim(']') {
+					var v0 string
+					if l.IsNull() {
+						l.Skip()
+					} else {
+						v0 = l.String()
+					}
+					in.Hosts = append(in.Hosts, v0)
+					l.WantComma()
+				}
+				l.Delim(']')
+			}
+		case "secretName":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.SecretName = l.String()
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
+
 type IngressRule struct {
 	// host is the fully qualified domain name of a network host, as defined by RFC 3986.
 	// Note the following deviations from the "host" part of the
@@ -809,6 +3390,78 @@ func (in *IngressRule) DeepCopy() *IngressRule {
 	return out
 }
 
+func (in *IngressRule) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *IngressRule) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *IngressRule) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	first := true
+	if in.Host != "" {
+		w.RawString("\"host\":")
+		w.String(in.Host)
+		first = false
+	}
+	if in.IngressRuleValue.HTTP != nil {
+		if !first {
+			w.RawByte(',')
+		}
+		w.RawString("\"http\":")
+		in.IngressRuleValue.HTTP.MarshalEasyJSON(w)
+		first = false
+	}
+	w.RawByte('}')
+}
+
+func (in *IngressRule) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "host":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.Host = l.String()
+			}
+		case "http":
+			if l.IsNull() {
+				l.Skip()
+				in.IngressRuleValue.HTTP = nil
+			} else {
+				if in.IngressRuleValue.HTTP == nil {
+					in.IngressRuleValue.HTTP = new(HTTPIngressRuleValue)
+				}
+				in.IngressRuleValue.HTTP.UnmarshalEasyJSON(l)
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
+
 type IngressLoadBalancerStatus struct {
 	// ingress is a list containing ingress points for the load-balancer.
 	Ingress []IngressLoadBalancerIngress `json:"ingress"`
@@ -834,6 +3487,81 @@ func (in *IngressLoadBalancerStatus) DeepCopy() *IngressLoadBalancerStatus {
 	return out
 }
 
+func (in *IngressLoadBalancerStatus) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *IngressLoadBalancerStatus) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *IngressLoadBalancerStatus) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	w.RawString("\"ingress\":")
+	if in.Ingress == nil {
+		w.RawString("null")
+	} else {
+		w.RawByte('[')
+		for i0 := range in.Ingress {
+			if i0 > 0 {
+				w.RawByte(',')
+			}
+			in.Ingress[i0].MarshalEasyJSON(w)
+		}
+		w.RawByte(']')
+	}
+
+	w.RawByte('}')
+}
+
+func (in *IngressLoadBalancerStatus) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "ingress":
+			if l.IsNull() {
+				l.Skip()
+				in.Ingress = nil
+			} else {
+				in.Ingress = make([]IngressLoadBalancerIngress, 0)
+				l.Delim('[')
+				for !l.IsDelim(']') {
+					var v0 IngressLoadBalancerIngress
+					if l.IsNull() {
+						l.Skip()
+					} else {
+						v0.UnmarshalEasyJSON(l)
+					}
+					in.Ingress = append(in.Ingress, v0)
+					l.WantComma()
+				}
+				l.Delim(']')
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
+
 type IngressClassParametersReference struct {
 	// apiGroup is the group for the resource being referenced. If APIGroup is
 	// not specified, the specified Kind must be in the core API group. For any
@@ -865,6 +3593,99 @@ func (in *IngressClassParametersReference) DeepCopy() *IngressClassParametersRef
 	return out
 }
 
+func (in *IngressClassParametersReference) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *IngressClassParametersReference) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *IngressClassParametersReference) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	first := true
+	if in.APIGroup != "" {
+		w.RawString("\"apiGroup\":")
+		w.String(in.APIGroup)
+		first = false
+	}
+	if !first {
+		w.RawByte(',')
+	}
+	w.RawString("\"kind\":")
+	w.String(in.Kind)
+	w.RawString(",\"name\":")
+	w.String(in.Name)
+	if in.Scope != "" {
+		w.RawString(",\"scope\":")
+		w.String(in.Scope)
+	}
+	if in.Namespace != "" {
+		w.RawString(",\"namespace\":")
+		w.String(in.Namespace)
+	}
+	w.RawByte('}')
+}
+
+func (in *IngressClassParametersReference) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "apiGroup":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.APIGroup = l.String()
+			}
+		case "kind":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.Kind = l.String()
+			}
+		case "name":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.Name = l.String()
+			}
+		case "scope":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.Scope = l.String()
+			}
+		case "namespace":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.Namespace = l.String()
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
+
 type NetworkPolicyIngressRule struct {
 	// ports is a list of ports which should be made accessible on the pods selected for
 	// this rule. Each item in this list is combined using a logical OR. If this field is
@@ -907,6 +3728,113 @@ func (in *NetworkPolicyIngressRule) DeepCopy() *NetworkPolicyIngressRule {
 	return out
 }
 
+func (in *NetworkPolicyIngressRule) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *NetworkPolicyIngressRule) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *NetworkPolicyIngressRule) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	w.RawString("\"ports\":")
+	if in.Ports == nil {
+		w.RawString("null")
+	} else {
+		w.RawByte('[')
+		for i0 := range in.Ports {
+			if i0 > 0 {
+				w.RawByte(',')
+			}
+			in.Ports[i0].MarshalEasyJSON(w)
+		}
+		w.RawByte(']')
+	}
+	w.RawString(",\"from\":")
+	if in.From == nil {
+		w.RawString("null")
+	} else {
+		w.RawByte('[')
+		for i0 := range in.From {
+			if i0 > 0 {
+				w.RawByte(',')
+			}
+			in.From[i0].MarshalEasyJSON(w)
+		}
+		w.RawByte(']')
+	}
+
+	w.RawByte('}')
+}
+
+func (in *NetworkPolicyIngressRule) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "ports":
+			if l.IsNull() {
+				l.Skip()
+				in.Ports = nil
+			} else {
+				in.Ports = make([]NetworkPolicyPort, 0)
+				l.Delim('[')
+				for !l.IsDelim(']') {
+					var v0 NetworkPolicyPort
+					if l.IsNull() {
+						l.Skip()
+					} else {
+						v0.UnmarshalEasyJSON(l)
+					}
+					in.Ports = append(in.Ports, v0)
+					l.WantComma()
+				}
+				l.Delim(']')
+			}
+		case "from":
+			if l.IsNull() {
+				l.Skip()
+				in.From = nil
+			} else {
+				in.From = make([]NetworkPolicyPeer, 0)
+				l.Delim('[')
+				for !l.IsDelim(']') {
+					var v0 NetworkPolicyPeer
+					if l.IsNull() {
+						l.Skip()
+					} else {
+						v0.UnmarshalEasyJSON(l)
+					}
+					in.From = append(in.From, v0)
+					l.WantComma()
+				}
+				l.Delim(']')
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
+
 type NetworkPolicyEgressRule struct {
 	// ports is a list of destination ports for outgoing traffic.
 	// Each item in this list is combined using a logical OR. If this field is
@@ -949,6 +3877,113 @@ func (in *NetworkPolicyEgressRule) DeepCopy() *NetworkPolicyEgressRule {
 	return out
 }
 
+func (in *NetworkPolicyEgressRule) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *NetworkPolicyEgressRule) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *NetworkPolicyEgressRule) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	w.RawString("\"ports\":")
+	if in.Ports == nil {
+		w.RawString("null")
+	} else {
+		w.RawByte('[')
+		for i0 := range in.Ports {
+			if i0 > 0 {
+				w.RawByte(',')
+			}
+			in.Ports[i0].MarshalEasyJSON(w)
+		}
+		w.RawByte(']')
+	}
+	w.RawString(",\"to\":")
+	if in.To == nil {
+		w.RawString("null")
+	} else {
+		w.RawByte('[')
+		for i0 := range in.To {
+			if i0 > 0 {
+				w.RawByte(',')
+			}
+			in.To[i0].MarshalEasyJSON(w)
+		}
+		w.RawByte(']')
+	}
+
+	w.RawByte('}')
+}
+
+func (in *NetworkPolicyEgressRule) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "ports":
+			if l.IsNull() {
+				l.Skip()
+				in.Ports = nil
+			} else {
+				in.Ports = make([]NetworkPolicyPort, 0)
+				l.Delim('[')
+				for !l.IsDelim(']') {
+					var v0 NetworkPolicyPort
+					if l.IsNull() {
+						l.Skip()
+					} else {
+						v0.UnmarshalEasyJSON(l)
+					}
+					in.Ports = append(in.Ports, v0)
+					l.WantComma()
+				}
+				l.Delim(']')
+			}
+		case "to":
+			if l.IsNull() {
+				l.Skip()
+				in.To = nil
+			} else {
+				in.To = make([]NetworkPolicyPeer, 0)
+				l.Delim('[')
+				for !l.IsDelim(']') {
+					var v0 NetworkPolicyPeer
+					if l.IsNull() {
+						l.Skip()
+					} else {
+						v0.UnmarshalEasyJSON(l)
+					}
+					in.To = append(in.To, v0)
+					l.WantComma()
+				}
+				l.Delim(']')
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
+
 type IngressServiceBackend struct {
 	// name is the referenced service. The service must exist in
 	// the same namespace as the Ingress object.
@@ -976,6 +4011,71 @@ func (in *IngressServiceBackend) DeepCopy() *IngressServiceBackend {
 	return out
 }
 
+func (in *IngressServiceBackend) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *IngressServiceBackend) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *IngressServiceBackend) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	w.RawString("\"name\":")
+	w.String(in.Name)
+	if in.Port != nil {
+		w.RawString(",\"port\":")
+		in.Port.MarshalEasyJSON(w)
+	}
+
+	w.RawByte('}')
+}
+
+func (in *IngressServiceBackend) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "name":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.Name = l.String()
+			}
+		case "port":
+			if l.IsNull() {
+				l.Skip()
+				in.Port = nil
+			} else {
+				if in.Port == nil {
+					in.Port = new(ServiceBackendPort)
+				}
+				in.Port.UnmarshalEasyJSON(l)
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
+
 type IngressRuleValue struct {
 	HTTP *HTTPIngressRuleValue `json:"http,omitempty"`
 }
@@ -998,6 +4098,63 @@ func (in *IngressRuleValue) DeepCopy() *IngressRuleValue {
 	return out
 }
 
+func (in *IngressRuleValue) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *IngressRuleValue) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *IngressRuleValue) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	if in.HTTP != nil {
+		w.RawString("\"http\":")
+		in.HTTP.MarshalEasyJSON(w)
+	}
+
+	w.RawByte('}')
+}
+
+func (in *IngressRuleValue) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "http":
+			if l.IsNull() {
+				l.Skip()
+				in.HTTP = nil
+			} else {
+				if in.HTTP == nil {
+					in.HTTP = new(HTTPIngressRuleValue)
+				}
+				in.HTTP.UnmarshalEasyJSON(l)
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
+
 type IngressLoadBalancerIngress struct {
 	// ip is set for load-balancer ingress points that are IP based.
 	IP string `json:"ip,omitempty"`
@@ -1027,6 +4184,109 @@ func (in *IngressLoadBalancerIngress) DeepCopy() *IngressLoadBalancerIngress {
 	return out
 }
 
+func (in *IngressLoadBalancerIngress) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *IngressLoadBalancerIngress) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *IngressLoadBalancerIngress) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	first := true
+	if in.IP != "" {
+		w.RawString("\"ip\":")
+		w.String(in.IP)
+		first = false
+	}
+	if in.Hostname != "" {
+		if !first {
+			w.RawByte(',')
+		}
+		w.RawString("\"hostname\":")
+		w.String(in.Hostname)
+		first = false
+	}
+	if !first {
+		w.RawByte(',')
+	}
+	w.RawString("\"ports\":")
+	if in.Ports == nil {
+		w.RawString("null")
+	} else {
+		w.RawByte('[')
+		for i0 := range in.Ports {
+			if i0 > 0 {
+				w.RawByte(',')
+			}
+			in.Ports[i0].MarshalEasyJSON(w)
+		}
+		w.RawByte(']')
+	}
+	w.RawByte('}')
+}
+
+func (in *IngressLoadBalancerIngress) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "ip":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.IP = l.String()
+			}
+		case "hostname":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.Hostname = l.String()
+			}
+		case "ports":
+			if l.IsNull() {
+				l.Skip()
+				in.Ports = nil
+			} else {
+				in.Ports = make([]IngressPortStatus, 0)
+				l.Delim('[')
+				for !l.IsDelim(']') {
+					var v0 IngressPortStatus
+					if l.IsNull() {
+						l.Skip()
+					} else {
+						v0.UnmarshalEasyJSON(l)
+					}
+					in.Ports = append(in.Ports, v0)
+					l.WantComma()
+				}
+				l.Delim(']')
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
+
 type NetworkPolicyPort struct {
 	// protocol represents the protocol (TCP, UDP, or SCTP) which traffic must match.
 	// If not specified, this field defaults to TCP.
@@ -1061,6 +4321,84 @@ func (in *NetworkPolicyPort) DeepCopy() *NetworkPolicyPort {
 	return out
 }
 
+func (in *NetworkPolicyPort) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *NetworkPolicyPort) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *NetworkPolicyPort) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	first := true
+	if in.Protocol != "" {
+		w.RawString("\"protocol\":")
+		w.String(string(in.Protocol))
+		first = false
+	}
+	if in.Port != nil {
+		if !first {
+			w.RawByte(',')
+		}
+		w.RawString("\"port\":")
+		w.Raw(json.Marshal(in.Port))
+		first = false
+	}
+	if in.EndPort != 0 {
+		if !first {
+			w.RawByte(',')
+		}
+		w.RawString("\"endPort\":")
+		w.Int(in.EndPort)
+		first = false
+	}
+	w.RawByte('}')
+}
+
+func (in *NetworkPolicyPort) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "protocol":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.Protocol = corev1.Protocol(l.String())
+			}
+		case "port":
+			l.AddError(json.Unmarshal(l.Raw(), &in.Port))
+		case "endPort":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.EndPort = l.Int()
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
+
 type NetworkPolicyPeer struct {
 	// podSelector is a label selector which selects pods. This field follows standard label
 	// selector semantics; if present but empty, it selects all pods.
@@ -1107,6 +4445,100 @@ func (in *NetworkPolicyPeer) DeepCopy() *NetworkPolicyPeer {
 	return out
 }
 
+func (in *NetworkPolicyPeer) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *NetworkPolicyPeer) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *NetworkPolicyPeer) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	first := true
+	if in.PodSelector != nil {
+		w.RawString("\"podSelector\":")
+		in.PodSelector.MarshalEasyJSON(w)
+		first = false
+	}
+	if in.NamespaceSelector != nil {
+		if !first {
+			w.RawByte(',')
+		}
+		w.RawString("\"namespaceSelector\":")
+		in.NamespaceSelector.MarshalEasyJSON(w)
+		first = false
+	}
+	if in.IPBlock != nil {
+		if !first {
+			w.RawByte(',')
+		}
+		w.RawString("\"ipBlock\":")
+		in.IPBlock.MarshalEasyJSON(w)
+		first = false
+	}
+	w.RawByte('}')
+}
+
+func (in *NetworkPolicyPeer) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "podSelector":
+			if l.IsNull() {
+				l.Skip()
+				in.PodSelector = nil
+			} else {
+				if in.PodSelector == nil {
+					in.PodSelector = new(metav1.LabelSelector)
+				}
+				in.PodSelector.UnmarshalEasyJSON(l)
+			}
+		case "namespaceSelector":
+			if l.IsNull() {
+				l.Skip()
+				in.NamespaceSelector = nil
+			} else {
+				if in.NamespaceSelector == nil {
+					in.NamespaceSelector = new(metav1.LabelSelector)
+				}
+				in.NamespaceSelector.UnmarshalEasyJSON(l)
+			}
+		case "ipBlock":
+			if l.IsNull() {
+				l.Skip()
+				in.IPBlock = nil
+			} else {
+				if in.IPBlock == nil {
+					in.IPBlock = new(IPBlock)
+				}
+				in.IPBlock.UnmarshalEasyJSON(l)
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
+
 type ServiceBackendPort struct {
 	// name is the name of the port on the Service.
 	// This is a mutually exclusive setting with "Number".
@@ -1129,6 +4561,74 @@ func (in *ServiceBackendPort) DeepCopy() *ServiceBackendPort {
 	return out
 }
 
+func (in *ServiceBackendPort) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *ServiceBackendPort) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *ServiceBackendPort) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	first := true
+	if in.Name != "" {
+		w.RawString("\"name\":")
+		w.String(in.Name)
+		first = false
+	}
+	if in.Number != 0 {
+		if !first {
+			w.RawByte(',')
+		}
+		w.RawString("\"number\":")
+		w.Int(in.Number)
+		first = false
+	}
+	w.RawByte('}')
+}
+
+func (in *ServiceBackendPort) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "name":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.Name = l.String()
+			}
+		case "number":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.Number = l.Int()
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
+
 type HTTPIngressRuleValue struct {
 	// paths is a collection of paths that map requests to backends.
 	Paths []HTTPIngressPath `json:"paths"`
@@ -1154,6 +4654,81 @@ func (in *HTTPIngressRuleValue) DeepCopy() *HTTPIngressRuleValue {
 	return out
 }
 
+func (in *HTTPIngressRuleValue) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *HTTPIngressRuleValue) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *HTTPIngressRuleValue) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	w.RawString("\"paths\":")
+	if in.Paths == nil {
+		w.RawString("null")
+	} else {
+		w.RawByte('[')
+		for i0 := range in.Paths {
+			if i0 > 0 {
+				w.RawByte(',')
+			}
+			in.Paths[i0].MarshalEasyJSON(w)
+		}
+		w.RawByte(']')
+	}
+
+	w.RawByte('}')
+}
+
+func (in *HTTPIngressRuleValue) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "paths":
+			if l.IsNull() {
+				l.Skip()
+				in.Paths = nil
+			} else {
+				in.Paths = make([]HTTPIngressPath, 0)
+				l.Delim('[')
+				for !l.IsDelim(']') {
+					var v0 HTTPIngressPath
+					if l.IsNull() {
+						l.Skip()
+					} else {
+						v0.UnmarshalEasyJSON(l)
+					}
+					in.Paths = append(in.Paths, v0)
+					l.WantComma()
+				}
+				l.Delim(']')
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
+
 type IngressPortStatus struct {
 	// port is the port number of the ingress port.
 	Port int `json:"port"`
@@ -1184,6 +4759,75 @@ func (in *IngressPortStatus) DeepCopy() *IngressPortStatus {
 	return out
 }
 
+func (in *IngressPortStatus) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *IngressPortStatus) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *IngressPortStatus) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	w.RawString("\"port\":")
+	w.Int(in.Port)
+	w.RawString(",\"protocol\":")
+	w.String(string(in.Protocol))
+	if in.Error != "" {
+		w.RawString(",\"error\":")
+		w.String(in.Error)
+	}
+
+	w.RawByte('}')
+}
+
+func (in *IngressPortStatus) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "port":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.Port = l.Int()
+			}
+		case "protocol":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.Protocol = corev1.Protocol(l.String())
+			}
+		case "error":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.Error = l.String()
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
+
 type IPBlock struct {
 	// cidr is a string representing the IPBlock
 	// Valid examples are "192.168.1.0/24" or "2001:db8::/64"
@@ -1212,6 +4856,89 @@ func (in *IPBlock) DeepCopy() *IPBlock {
 	return out
 }
 
+func (in *IPBlock) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *IPBlock) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *IPBlock) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	w.RawString("\"cidr\":")
+	w.String(in.CIDR)
+	w.RawString(",\"except\":")
+	if in.Except == nil {
+		w.RawString("null")
+	} else {
+		w.RawByte('[')
+		for i0 := range in.Except {
+			if i0 > 0 {
+				w.RawByte(',')
+			}
+			w.String(in.Except[i0])
+		}
+		w.RawByte(']')
+	}
+
+	w.RawByte('}')
+}
+
+func (in *IPBlock) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "cidr":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.CIDR = l.String()
+			}
+		case "except":
+			if l.IsNull() {
+				l.Skip()
+				in.Except = nil
+			} else {
+				in.Except = make([]string, 0)
+				l.Delim('[')
+				for !l.IsDelim(']') {
+					var v0 string
+					if l.IsNull() {
+						l.Skip()
+					} else {
+						v0 = l.String()
+					}
+					in.Except = append(in.Except, v0)
+					l.WantComma()
+				}
+				l.Delim(']')
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
+
 type HTTPIngressPath struct {
 	// path is matched against the path of an incoming request. Currently it can
 	// contain characters disallowed from the conventional "path" part of a URL
@@ -1251,3 +4978,82 @@ func (in *HTTPIngressPath) DeepCopy() *HTTPIngressPath {
 	in.DeepCopyInto(out)
 	return out
 }
+
+func (in *HTTPIngressPath) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *HTTPIngressPath) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *HTTPIngressPath) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	first := true
+	if in.Path != "" {
+		w.RawString("\"path\":")
+		w.String(in.Path)
+		first = false
+	}
+	if in.PathType != "" {
+		if !first {
+			w.RawByte(',')
+		}
+		w.RawString("\"pathType\":")
+		w.String(string(in.PathType))
+		first = false
+	}
+	if !first {
+		w.RawByte(',')
+	}
+	w.RawString("\"backend\":")
+	in.Backend.MarshalEasyJSON(w)
+	w.RawByte('}')
+}
+
+func (in *HTTPIngressPath) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "path":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.Path = l.String()
+			}
+		case "pathType":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.PathType = PathType(l.String())
+			}
+		case "backend":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.Backend.UnmarshalEasyJSON(l)
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
@@ -1,10 +1,17 @@
 package policyv1
 
 import (
+	"encoding/json"
+	"github.com/mailru/easyjson/jlexer"
+	"github.com/mailru/easyjson/jwriter"
 	"go.f110.dev/kubeproto/go/apis/metav1"
+	"go.f110.dev/kubeproto/go/patch"
+	"k8s.io/apimachinery/pkg/fields"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	utilintstr "k8s.io/apimachinery/pkg/util/intstr"
+	"maps"
+	"slices"
 )
 
 const GroupName = "policy"
@@ -68,6 +75,148 @@ func (in *Eviction) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// EvictionToSelectableFields returns the fields of Eviction which can be used by the field selector.
+func EvictionToSelectableFields(obj *Eviction) fields.Set {
+	set := fields.Set{
+		"metadata.name":      obj.ObjectMeta.Name,
+		"metadata.namespace": obj.ObjectMeta.Namespace,
+	}
+	return set
+}
+
+// EvictionPatch builds JSON patch or JSON merge patch of Eviction.
+// JSON patch fails if the parent of the field doesn't exist (e.g. SetLabel to the object which has no label). MergePatch creates the parent.
+type EvictionPatch struct {
+	*patch.Builder
+}
+
+func NewEvictionPatch() *EvictionPatch {
+	return &EvictionPatch{Builder: patch.NewBuilder()}
+}
+
+func (p *EvictionPatch) SetLabel(key, value string) *EvictionPatch {
+	p.Set([]string{"metadata", "labels", key}, value)
+	return p
+}
+
+func (p *EvictionPatch) RemoveLabel(key string) *EvictionPatch {
+	p.Remove([]string{"metadata", "labels", key})
+	return p
+}
+
+func (p *EvictionPatch) SetAnnotation(key, value string) *EvictionPatch {
+	p.Set([]string{"metadata", "annotations", key}, value)
+	return p
+}
+
+func (p *EvictionPatch) RemoveAnnotation(key string) *EvictionPatch {
+	p.Remove([]string{"metadata", "annotations", key})
+	return p
+}
+
+func (p *EvictionPatch) SetDeleteOptions(v *metav1.DeleteOptions) *EvictionPatch {
+	p.Set([]string{"deleteOptions"}, v)
+	return p
+}
+
+func (p *EvictionPatch) RemoveDeleteOptions() *EvictionPatch {
+	p.Remove([]string{"deleteOptions"})
+	return p
+}
+
+func (in *Eviction) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *Eviction) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *Eviction) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	first := true
+	if in.TypeMeta.Kind != "" {
+		w.RawString("\"kind\":")
+		w.String(in.TypeMeta.Kind)
+		first = false
+	}
+	if in.TypeMeta.APIVersion != "" {
+		if !first {
+			w.RawByte(',')
+		}
+		w.RawString("\"apiVersion\":")
+		w.String(in.TypeMeta.APIVersion)
+		first = false
+	}
+	if !first {
+		w.RawByte(',')
+	}
+	w.RawString("\"metadata\":")
+	in.ObjectMeta.MarshalEasyJSON(w)
+	if in.DeleteOptions != nil {
+		w.RawString(",\"deleteOptions\":")
+		in.DeleteOptions.MarshalEasyJSON(w)
+	}
+	w.RawByte('}')
+}
+
+func (in *Eviction) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "kind":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.TypeMeta.Kind = l.String()
+			}
+		case "apiVersion":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.TypeMeta.APIVersion = l.String()
+			}
+		case "metadata":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.ObjectMeta.UnmarshalEasyJSON(l)
+			}
+		case "deleteOptions":
+			if l.IsNull() {
+				l.Skip()
+				in.DeleteOptions = nil
+			} else {
+				if in.DeleteOptions == nil {
+					in.DeleteOptions = new(metav1.DeleteOptions)
+				}
+				in.DeleteOptions.UnmarshalEasyJSON(l)
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
+
 type EvictionList struct {
 	metav1.TypeMeta `json:",inline"`
 	metav1.ListMeta `json:"metadata"`
@@ -103,6 +252,117 @@ func (in *EvictionList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+func (in *EvictionList) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *EvictionList) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *EvictionList) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	first := true
+	if in.TypeMeta.Kind != "" {
+		w.RawString("\"kind\":")
+		w.String(in.TypeMeta.Kind)
+		first = false
+	}
+	if in.TypeMeta.APIVersion != "" {
+		if !first {
+			w.RawByte(',')
+		}
+		w.RawString("\"apiVersion\":")
+		w.String(in.TypeMeta.APIVersion)
+		first = false
+	}
+	if !first {
+		w.RawByte(',')
+	}
+	w.RawString("\"metadata\":")
+	in.ListMeta.MarshalEasyJSON(w)
+	w.RawString(",\"items\":")
+	if in.Items == nil {
+		w.RawString("null")
+	} else {
+		w.RawByte('[')
+		for i0 := range in.Items {
+			if i0 > 0 {
+				w.RawByte(',')
+			}
+			in.Items[i0].MarshalEasyJSON(w)
+		}
+		w.RawByte(']')
+	}
+	w.RawByte('}')
+}
+
+func (in *EvictionList) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "kind":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.TypeMeta.Kind = l.String()
+			}
+		case "apiVersion":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.TypeMeta.APIVersion = l.String()
+			}
+		case "metadata":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.ListMeta.UnmarshalEasyJSON(l)
+			}
+		case "items":
+			if l.IsNull() {
+				l.Skip()
+				in.Items = nil
+			} else {
+				in.Items = make([]Eviction, 0)
+				l.Delim('[')
+				for !l.IsDelim(']') {
+					var v0 Eviction
+					if l.IsNull() {
+						l.Skip()
+					} else {
+						v0.UnmarshalEasyJSON(l)
+					}
+					in.Items = append(in.Items, v0)
+					l.WantComma()
+				}
+				l.Delim(']')
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
+
 type PodDisruptionBudget struct {
 	metav1.TypeMeta   `json:",inline"`
 	metav1.ObjectMeta `json:"metadata"`
@@ -144,6 +404,317 @@ func (in *PodDisruptionBudget) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+func (in *PodDisruptionBudget) GetConditions() []metav1.Condition {
+	if in.Status == nil {
+		return nil
+	}
+	return in.Status.Conditions
+}
+
+func (in *PodDisruptionBudget) SetConditions(conditions []metav1.Condition) {
+	if in.Status == nil {
+		in.Status = &PodDisruptionBudgetStatus{}
+	}
+	in.Status.Conditions = conditions
+}
+
+func (in *PodDisruptionBudget) FindCondition(conditionType string) *metav1.Condition {
+	return metav1.FindCondition(in.GetConditions(), conditionType)
+}
+
+func (in *PodDisruptionBudget) SetCondition(condition metav1.Condition) {
+	conditions := in.GetConditions()
+	metav1.SetCondition(&conditions, condition)
+	in.SetConditions(conditions)
+}
+
+func (in *PodDisruptionBudget) IsConditionTrue(conditionType string) bool {
+	return metav1.IsConditionTrue(in.GetConditions(), conditionType)
+}
+
+// PodDisruptionBudgetToSelectableFields returns the fields of PodDisruptionBudget which can be used by the field selector.
+func PodDisruptionBudgetToSelectableFields(obj *PodDisruptionBudget) fields.Set {
+	set := fields.Set{
+		"metadata.name":      obj.ObjectMeta.Name,
+		"metadata.namespace": obj.ObjectMeta.Namespace,
+	}
+	return set
+}
+
+// PodDisruptionBudgetPatch builds JSON patch or JSON merge patch of PodDisruptionBudget.
+// JSON patch fails if the parent of the field doesn't exist (e.g. SetLabel to the object which has no label). MergePatch creates the parent.
+type PodDisruptionBudgetPatch struct {
+	*patch.Builder
+}
+
+func NewPodDisruptionBudgetPatch() *PodDisruptionBudgetPatch {
+	return &PodDisruptionBudgetPatch{Builder: patch.NewBuilder()}
+}
+
+func (p *PodDisruptionBudgetPatch) SetLabel(key, value string) *PodDisruptionBudgetPatch {
+	p.Set([]string{"metadata", "labels", key}, value)
+	return p
+}
+
+func (p *PodDisruptionBudgetPatch) RemoveLabel(key string) *PodDisruptionBudgetPatch {
+	p.Remove([]string{"metadata", "labels", key})
+	return p
+}
+
+func (p *PodDisruptionBudgetPatch) SetAnnotation(key, value string) *PodDisruptionBudgetPatch {
+	p.Set([]string{"metadata", "annotations", key}, value)
+	return p
+}
+
+func (p *PodDisruptionBudgetPatch) RemoveAnnotation(key string) *PodDisruptionBudgetPatch {
+	p.Remove([]string{"metadata", "annotations", key})
+	return p
+}
+
+func (p *PodDisruptionBudgetPatch) SetSpec(v *PodDisruptionBudgetSpec) *PodDisruptionBudgetPatch {
+	p.Set([]string{"spec"}, v)
+	return p
+}
+
+func (p *PodDisruptionBudgetPatch) RemoveSpec() *PodDisruptionBudgetPatch {
+	p.Remove([]string{"spec"})
+	return p
+}
+
+func (p *PodDisruptionBudgetPatch) SetSpecMinAvailable(v *utilintstr.IntOrString) *PodDisruptionBudgetPatch {
+	p.Set([]string{"spec", "minAvailable"}, v)
+	return p
+}
+
+func (p *PodDisruptionBudgetPatch) RemoveSpecMinAvailable() *PodDisruptionBudgetPatch {
+	p.Remove([]string{"spec", "minAvailable"})
+	return p
+}
+
+func (p *PodDisruptionBudgetPatch) SetSpecSelector(v *metav1.LabelSelector) *PodDisruptionBudgetPatch {
+	p.Set([]string{"spec", "selector"}, v)
+	return p
+}
+
+func (p *PodDisruptionBudgetPatch) RemoveSpecSelector() *PodDisruptionBudgetPatch {
+	p.Remove([]string{"spec", "selector"})
+	return p
+}
+
+func (p *PodDisruptionBudgetPatch) SetSpecMaxUnavailable(v *utilintstr.IntOrString) *PodDisruptionBudgetPatch {
+	p.Set([]string{"spec", "maxUnavailable"}, v)
+	return p
+}
+
+func (p *PodDisruptionBudgetPatch) RemoveSpecMaxUnavailable() *PodDisruptionBudgetPatch {
+	p.Remove([]string{"spec", "maxUnavailable"})
+	return p
+}
+
+func (p *PodDisruptionBudgetPatch) SetSpecUnhealthyPodEvictionPolicy(v UnhealthyPodEvictionPolicyType) *PodDisruptionBudgetPatch {
+	p.Set([]string{"spec", "unhealthyPodEvictionPolicy"}, v)
+	return p
+}
+
+func (p *PodDisruptionBudgetPatch) RemoveSpecUnhealthyPodEvictionPolicy() *PodDisruptionBudgetPatch {
+	p.Remove([]string{"spec", "unhealthyPodEvictionPolicy"})
+	return p
+}
+
+func (p *PodDisruptionBudgetPatch) SetStatus(v *PodDisruptionBudgetStatus) *PodDisruptionBudgetPatch {
+	p.Set([]string{"status"}, v)
+	return p
+}
+
+func (p *PodDisruptionBudgetPatch) RemoveStatus() *PodDisruptionBudgetPatch {
+	p.Remove([]string{"status"})
+	return p
+}
+
+func (p *PodDisruptionBudgetPatch) SetStatusObservedGeneration(v int64) *PodDisruptionBudgetPatch {
+	p.Set([]string{"status", "observedGeneration"}, v)
+	return p
+}
+
+func (p *PodDisruptionBudgetPatch) RemoveStatusObservedGeneration() *PodDisruptionBudgetPatch {
+	p.Remove([]string{"status", "observedGeneration"})
+	return p
+}
+
+func (p *PodDisruptionBudgetPatch) SetStatusDisruptedPods(v map[string]metav1.Time) *PodDisruptionBudgetPatch {
+	p.Set([]string{"status", "disruptedPods"}, v)
+	return p
+}
+
+func (p *PodDisruptionBudgetPatch) RemoveStatusDisruptedPods() *PodDisruptionBudgetPatch {
+	p.Remove([]string{"status", "disruptedPods"})
+	return p
+}
+
+func (p *PodDisruptionBudgetPatch) SetStatusDisruptionsAllowed(v int) *PodDisruptionBudgetPatch {
+	p.Set([]string{"status", "disruptionsAllowed"}, v)
+	return p
+}
+
+func (p *PodDisruptionBudgetPatch) RemoveStatusDisruptionsAllowed() *PodDisruptionBudgetPatch {
+	p.Remove([]string{"status", "disruptionsAllowed"})
+	return p
+}
+
+func (p *PodDisruptionBudgetPatch) SetStatusCurrentHealthy(v int) *PodDisruptionBudgetPatch {
+	p.Set([]string{"status", "currentHealthy"}, v)
+	return p
+}
+
+func (p *PodDisruptionBudgetPatch) RemoveStatusCurrentHealthy() *PodDisruptionBudgetPatch {
+	p.Remove([]string{"status", "currentHealthy"})
+	return p
+}
+
+func (p *PodDisruptionBudgetPatch) SetStatusDesiredHealthy(v int) *PodDisruptionBudgetPatch {
+	p.Set([]string{"status", "desiredHealthy"}, v)
+	return p
+}
+
+func (p *PodDisruptionBudgetPatch) RemoveStatusDesiredHealthy() *PodDisruptionBudgetPatch {
+	p.Remove([]string{"status", "desiredHealthy"})
+	return p
+}
+
+func (p *PodDisruptionBudgetPatch) SetStatusExpectedPods(v int) *PodDisruptionBudgetPatch {
+	p.Set([]string{"status", "expectedPods"}, v)
+	return p
+}
+
+func (p *PodDisruptionBudgetPatch) RemoveStatusExpectedPods() *PodDisruptionBudgetPatch {
+	p.Remove([]string{"status", "expectedPods"})
+	return p
+}
+
+func (p *PodDisruptionBudgetPatch) SetStatusConditions(v []metav1.Condition) *PodDisruptionBudgetPatch {
+	p.Set([]string{"status", "conditions"}, v)
+	return p
+}
+
+func (p *PodDisruptionBudgetPatch) RemoveStatusConditions() *PodDisruptionBudgetPatch {
+	p.Remove([]string{"status", "conditions"})
+	return p
+}
+
+func (p *PodDisruptionBudgetPatch) AddStatusConditions(v ...metav1.Condition) *PodDisruptionBudgetPatch {
+	for _, e := range v {
+		p.Append([]string{"status", "conditions"}, e)
+	}
+	return p
+}
+
+func (in *PodDisruptionBudget) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *PodDisruptionBudget) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *PodDisruptionBudget) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	first := true
+	if in.TypeMeta.Kind != "" {
+		w.RawString("\"kind\":")
+		w.String(in.TypeMeta.Kind)
+		first = false
+	}
+	if in.TypeMeta.APIVersion != "" {
+		if !first {
+			w.RawByte(',')
+		}
+		w.RawString("\"apiVersion\":")
+		w.String(in.TypeMeta.APIVersion)
+		first = false
+	}
+	if !first {
+		w.RawByte(',')
+	}
+	w.RawString("\"metadata\":")
+	in.ObjectMeta.MarshalEasyJSON(w)
+	if in.Spec != nil {
+		w.RawString(",\"spec\":")
+		in.Spec.MarshalEasyJSON(w)
+	}
+	if in.Status != nil {
+		w.RawString(",\"status\":")
+		in.Status.MarshalEasyJSON(w)
+	}
+	w.RawByte('}')
+}
+
+func (in *PodDisruptionBudget) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "kind":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.TypeMeta.Kind = l.String()
+			}
+		case "apiVersion":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.TypeMeta.APIVersion = l.String()
+			}
+		case "metadata":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.ObjectMeta.UnmarshalEasyJSON(l)
+			}
+		case "spec":
+			if l.IsNull() {
+				l.Skip()
+				in.Spec = nil
+			} else {
+				if in.Spec == nil {
+					in.Spec = new(PodDisruptionBudgetSpec)
+				}
+				in.Spec.UnmarshalEasyJSON(l)
+			}
+		case "status":
+			if l.IsNull() {
+				l.Skip()
+				in.Status = nil
+			} else {
+				if in.Status == nil {
+					in.Status = new(PodDisruptionBudgetStatus)
+				}
+				in.Status.UnmarshalEasyJSON(l)
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
+
 type PodDisruptionBudgetList struct {
 	metav1.TypeMeta `json:",inline"`
 	metav1.ListMeta `json:"metadata"`
@@ -179,6 +750,117 @@ func (in *PodDisruptionBudgetList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+func (in *PodDisruptionBudgetList) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *PodDisruptionBudgetList) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *PodDisruptionBudgetList) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	first := true
+	if in.TypeMeta.Kind != "" {
+		w.RawString("\"kind\":")
+		w.String(in.TypeMeta.Kind)
+		first = false
+	}
+	if in.TypeMeta.APIVersion != "" {
+		if !first {
+			w.RawByte(',')
+		}
+		w.RawString("\"apiVersion\":")
+		w.String(in.TypeMeta.APIVersion)
+		first = false
+	}
+	if !first {
+		w.RawByte(',')
+	}
+	w.RawString("\"metadata\":")
+	in.ListMeta.MarshalEasyJSON(w)
+	w.RawString(",\"items\":")
+	if in.Items == nil {
+		w.RawString("null")
+	} else {
+		w.RawByte('[')
+		for i0 := range in.Items {
+			if i0 > 0 {
+				w.RawByte(',')
+			}
+			in.Items[i0].MarshalEasyJSON(w)
+		}
+		w.RawByte(']')
+	}
+	w.RawByte('}')
+}
+
+func (in *PodDisruptionBudgetList) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "kind":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.TypeMeta.Kind = l.String()
+			}
+		case "apiVersion":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.TypeMeta.APIVersion = l.String()
+			}
+		case "metadata":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.ListMeta.UnmarshalEasyJSON(l)
+			}
+		case "items":
+			if l.IsNull() {
+				l.Skip()
+				in.Items = nil
+			} else {
+				in.Items = make([]PodDisruptionBudget, 0)
+				l.Delim('[')
+				for !l.IsDelim(']') {
+					var v0 PodDisruptionBudget
+					if l.IsNull() {
+						l.Skip()
+					} else {
+						v0.UnmarshalEasyJSON(l)
+					}
+					in.Items = append(in.Items, v0)
+					l.WantComma()
+				}
+				l.Delim(']')
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
+
 type PodDisruptionBudgetSpec struct {
 	// An eviction is allowed if at least "minAvailable" pods selected by
 	// "selector" will still be available after the eviction, i.e. even in the
@@ -244,6 +926,98 @@ func (in *PodDisruptionBudgetSpec) DeepCopy() *PodDisruptionBudgetSpec {
 	return out
 }
 
+func (in *PodDisruptionBudgetSpec) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *PodDisruptionBudgetSpec) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *PodDisruptionBudgetSpec) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	first := true
+	if in.MinAvailable != nil {
+		w.RawString("\"minAvailable\":")
+		w.Raw(json.Marshal(in.MinAvailable))
+		first = false
+	}
+	if in.Selector != nil {
+		if !first {
+			w.RawByte(',')
+		}
+		w.RawString("\"selector\":")
+		in.Selector.MarshalEasyJSON(w)
+		first = false
+	}
+	if in.MaxUnavailable != nil {
+		if !first {
+			w.RawByte(',')
+		}
+		w.RawString("\"maxUnavailable\":")
+		w.Raw(json.Marshal(in.MaxUnavailable))
+		first = false
+	}
+	if in.UnhealthyPodEvictionPolicy != "" {
+		if !first {
+			w.RawByte(',')
+		}
+		w.RawString("\"unhealthyPodEvictionPolicy\":")
+		w.String(string(in.UnhealthyPodEvictionPolicy))
+		first = false
+	}
+	w.RawByte('}')
+}
+
+func (in *PodDisruptionBudgetSpec) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "minAvailable":
+			l.AddError(json.Unmarshal(l.Raw(), &in.MinAvailable))
+		case "selector":
+			if l.IsNull() {
+				l.Skip()
+				in.Selector = nil
+			} else {
+				if in.Selector == nil {
+					in.Selector = new(metav1.LabelSelector)
+				}
+				in.Selector.UnmarshalEasyJSON(l)
+			}
+		case "maxUnavailable":
+			l.AddError(json.Unmarshal(l.Raw(), &in.MaxUnavailable))
+		case "unhealthyPodEvictionPolicy":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.UnhealthyPodEvictionPolicy = UnhealthyPodEvictionPolicyType(l.String())
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
+
 type PodDisruptionBudgetStatus struct {
 	// Most recent generation observed when updating this PDB status. DisruptionsAllowed and other
 	// status information is valid only if observedGeneration equals to PDB's object generation.
@@ -309,3 +1083,165 @@ func (in *PodDisruptionBudgetStatus) DeepCopy() *PodDisruptionBudgetStatus {
 	in.DeepCopyInto(out)
 	return out
 }
+
+func (in *PodDisruptionBudgetStatus) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *PodDisruptionBudgetStatus) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *PodDisruptionBudgetStatus) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	first := true
+	if in.ObservedGeneration != 0 {
+		w.RawString("\"observedGeneration\":")
+		w.Int64(in.ObservedGeneration)
+		first = false
+	}
+	if len(in.DisruptedPods) != 0 {
+		if !first {
+			w.RawByte(',')
+		}
+		w.RawString("\"disruptedPods\":")
+		if in.DisruptedPods == nil {
+			w.RawString("null")
+		} else {
+			w.RawByte('{')
+			for i0, k0 := range slices.Sorted(maps.Keys(in.DisruptedPods)) {
+				if i0 > 0 {
+					w.RawByte(',')
+				}
+				w.String(k0)
+				w.RawByte(':')
+				v0 := in.DisruptedPods[k0]
+				w.Raw(json.Marshal(&v0))
+			}
+			w.RawByte('}')
+		}
+		first = false
+	}
+	if !first {
+		w.RawByte(',')
+	}
+	w.RawString("\"disruptionsAllowed\":")
+	w.Int(in.DisruptionsAllowed)
+	w.RawString(",\"currentHealthy\":")
+	w.Int(in.CurrentHealthy)
+	w.RawString(",\"desiredHealthy\":")
+	w.Int(in.DesiredHealthy)
+	w.RawString(",\"expectedPods\":")
+	w.Int(in.ExpectedPods)
+	w.RawString(",\"conditions\":")
+	if in.Conditions == nil {
+		w.RawString("null")
+	} else {
+		w.RawByte('[')
+		for i0 := range in.Conditions {
+			if i0 > 0 {
+				w.RawByte(',')
+			}
+			in.Conditions[i0].MarshalEasyJSON(w)
+		}
+		w.RawByte(']')
+	}
+	w.RawByte('}')
+}
+
+func (in *PodDisruptionBudgetStatus) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "observedGeneration":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.ObservedGeneration = l.Int64()
+			}
+		case "disruptedPods":
+			if l.IsNull() {
+				l.Skip()
+				in.DisruptedPods = nil
+			} else {
+				if in.DisruptedPods == nil {
+					in.DisruptedPods = make(map[string]metav1.Time)
+				}
+				l.Delim('{')
+				for !l.IsDelim('}') {
+					k0 := l.String()
+					l.WantColon()
+					var v0 metav1.Time
+					l.AddError(json.Unmarshal(l.Raw(), &v0))
+					in.DisruptedPods[k0] = v0
+					l.WantComma()
+				}
+				l.Delim('}')
+			}
+		case "disruptionsAllowed":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.DisruptionsAllowed = l.Int()
+			}
+		case "currentHealthy":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.CurrentHealthy = l.Int()
+			}
+		case "desiredHealthy":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.DesiredHealthy = l.Int()
+			}
+		case "expectedPods":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.ExpectedPods = l.Int()
+			}
+		case "conditions":
+			if l.IsNull() {
+				l.Skip()
+				in.Conditions = nil
+			} else {
+				in.Conditions = make([]metav1.Condition, 0)
+				l.Delim('[')
+				for !l.IsDelim(']') {
+					var v0 metav1.Condition
+					if l.IsNull() {
+						l.Skip()
+					} else {
+						v0.UnmarshalEasyJSON(l)
+					}
+					in.Conditions = append(in.Conditions, v0)
+					l.WantComma()
+				}
+				l.Delim(']')
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
@@ -1,7 +1,11 @@
 package rbacv1
 
 import (
+	"github.com/mailru/easyjson/jlexer"
+	"github.com/mailru/easyjson/jwriter"
 	"go.f110.dev/kubeproto/go/apis/metav1"
+	"go.f110.dev/kubeproto/go/patch"
+	"k8s.io/apimachinery/pkg/fields"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 )
@@ -75,6 +79,214 @@ func (in *ClusterRole) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// ClusterRoleToSelectableFields returns the fields of ClusterRole which can be used by the field selector.
+func ClusterRoleToSelectableFields(obj *ClusterRole) fields.Set {
+	set := fields.Set{
+		"metadata.name":      obj.ObjectMeta.Name,
+		"metadata.namespace": obj.ObjectMeta.Namespace,
+	}
+	return set
+}
+
+// ClusterRolePatch builds JSON patch or JSON merge patch of ClusterRole.
+// JSON patch fails if the parent of the field doesn't exist (e.g. SetLabel to the object which has no label). MergePatch creates the parent.
+type ClusterRolePatch struct {
+	*patch.Builder
+}
+
+func NewClusterRolePatch() *ClusterRolePatch {
+	return &ClusterRolePatch{Builder: patch.NewBuilder()}
+}
+
+func (p *ClusterRolePatch) SetLabel(key, value string) *ClusterRolePatch {
+	p.Set([]string{"metadata", "labels", key}, value)
+	return p
+}
+
+func (p *ClusterRolePatch) RemoveLabel(key string) *ClusterRolePatch {
+	p.Remove([]string{"metadata", "labels", key})
+	return p
+}
+
+func (p *ClusterRolePatch) SetAnnotation(key, value string) *ClusterRolePatch {
+	p.Set([]string{"metadata", "annotations", key}, value)
+	return p
+}
+
+func (p *ClusterRolePatch) RemoveAnnotation(key string) *ClusterRolePatch {
+	p.Remove([]string{"metadata", "annotations", key})
+	return p
+}
+
+func (p *ClusterRolePatch) SetRules(v []PolicyRule) *ClusterRolePatch {
+	p.Set([]string{"rules"}, v)
+	return p
+}
+
+func (p *ClusterRolePatch) RemoveRules() *ClusterRolePatch {
+	p.Remove([]string{"rules"})
+	return p
+}
+
+func (p *ClusterRolePatch) AddRules(v ...PolicyRule) *ClusterRolePatch {
+	for _, e := range v {
+		p.Append([]string{"rules"}, e)
+	}
+	return p
+}
+
+func (p *ClusterRolePatch) SetAggregationRule(v *AggregationRule) *ClusterRolePatch {
+	p.Set([]string{"aggregationRule"}, v)
+	return p
+}
+
+func (p *ClusterRolePatch) RemoveAggregationRule() *ClusterRolePatch {
+	p.Remove([]string{"aggregationRule"})
+	return p
+}
+
+func (p *ClusterRolePatch) SetAggregationRuleClusterRoleSelectors(v []metav1.LabelSelector) *ClusterRolePatch {
+	p.Set([]string{"aggregationRule", "clusterRoleSelectors"}, v)
+	return p
+}
+
+func (p *ClusterRolePatch) RemoveAggregationRuleClusterRoleSelectors() *ClusterRolePatch {
+	p.Remove([]string{"aggregationRule", "clusterRoleSelectors"})
+	return p
+}
+
+func (p *ClusterRolePatch) AddAggregationRuleClusterRoleSelectors(v ...metav1.LabelSelector) *ClusterRolePatch {
+	for _, e := range v {
+		p.Append([]string{"aggregationRule", "clusterRoleSelectors"}, e)
+	}
+	return p
+}
+
+func (in *ClusterRole) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *ClusterRole) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *ClusterRole) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	first := true
+	if in.TypeMeta.Kind != "" {
+		w.RawString("\"kind\":")
+		w.String(in.TypeMeta.Kind)
+		first = false
+	}
+	if in.TypeMeta.APIVersion != "" {
+		if !first {
+			w.RawByte(',')
+		}
+		w.RawString("\"apiVersion\":")
+		w.String(in.TypeMeta.APIVersion)
+		first = false
+	}
+	if !first {
+		w.RawByte(',')
+	}
+	w.RawString("\"metadata\":")
+	in.ObjectMeta.MarshalEasyJSON(w)
+	w.RawString(",\"rules\":")
+	if in.Rules == nil {
+		w.RawString("null")
+	} else {
+		w.RawByte('[')
+		for i0 := range in.Rules {
+			if i0 > 0 {
+				w.RawByte(',')
+			}
+			in.Rules[i0].MarshalEasyJSON(w)
+		}
+		w.RawByte(']')
+	}
+	if in.AggregationRule != nil {
+		w.RawString(",\"aggregationRule\":")
+		in.AggregationRule.MarshalEasyJSON(w)
+	}
+	w.RawByte('}')
+}
+
+func (in *ClusterRole) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "kind":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.TypeMeta.Kind = l.String()
+			}
+		case "apiVersion":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.TypeMeta.APIVersion = l.String()
+			}
+		case "metadata":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.ObjectMeta.UnmarshalEasyJSON(l)
+			}
+		case "rules":
+			if l.IsNull() {
+				l.Skip()
+				in.Rules = nil
+			} else {
+				in.Rules = make([]PolicyRule, 0)
+				l.Delim('[')
+				for !l.IsDelim(']') {
+					var v0 PolicyRule
+					if l.IsNull() {
+						l.Skip()
+					} else {
+						v0.UnmarshalEasyJSON(l)
+					}
+					in.Rules = append(in.Rules, v0)
+					l.WantComma()
+				}
+				l.Delim(']')
+			}
+		case "aggregationRule":
+			if l.IsNull() {
+				l.Skip()
+				in.AggregationRule = nil
+			} else {
+				if in.AggregationRule == nil {
+					in.AggregationRule = new(AggregationRule)
+				}
+				in.AggregationRule.UnmarshalEasyJSON(l)
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
+
 type ClusterRoleBinding struct {
 	metav1.TypeMeta   `json:",inline"`
 	metav1.ObjectMeta `json:"metadata"`
@@ -116,6 +328,221 @@ func (in *ClusterRoleBinding) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// ClusterRoleBindingToSelectableFields returns the fields of ClusterRoleBinding which can be used by the field selector.
+func ClusterRoleBindingToSelectableFields(obj *ClusterRoleBinding) fields.Set {
+	set := fields.Set{
+		"metadata.name":      obj.ObjectMeta.Name,
+		"metadata.namespace": obj.ObjectMeta.Namespace,
+	}
+	return set
+}
+
+// ClusterRoleBindingPatch builds JSON patch or JSON merge patch of ClusterRoleBinding.
+// JSON patch fails if the parent of the field doesn't exist (e.g. SetLabel to the object which has no label). MergePatch creates the parent.
+type ClusterRoleBindingPatch struct {
+	*patch.Builder
+}
+
+func NewClusterRoleBindingPatch() *ClusterRoleBindingPatch {
+	return &ClusterRoleBindingPatch{Builder: patch.NewBuilder()}
+}
+
+func (p *ClusterRoleBindingPatch) SetLabel(key, value string) *ClusterRoleBindingPatch {
+	p.Set([]string{"metadata", "labels", key}, value)
+	return p
+}
+
+func (p *ClusterRoleBindingPatch) RemoveLabel(key string) *ClusterRoleBindingPatch {
+	p.Remove([]string{"metadata", "labels", key})
+	return p
+}
+
+func (p *ClusterRoleBindingPatch) SetAnnotation(key, value string) *ClusterRoleBindingPatch {
+	p.Set([]string{"metadata", "annotations", key}, value)
+	return p
+}
+
+func (p *ClusterRoleBindingPatch) RemoveAnnotation(key string) *ClusterRoleBindingPatch {
+	p.Remove([]string{"metadata", "annotations", key})
+	return p
+}
+
+func (p *ClusterRoleBindingPatch) SetSubjects(v []Subject) *ClusterRoleBindingPatch {
+	p.Set([]string{"subjects"}, v)
+	return p
+}
+
+func (p *ClusterRoleBindingPatch) RemoveSubjects() *ClusterRoleBindingPatch {
+	p.Remove([]string{"subjects"})
+	return p
+}
+
+func (p *ClusterRoleBindingPatch) AddSubjects(v ...Subject) *ClusterRoleBindingPatch {
+	for _, e := range v {
+		p.Append([]string{"subjects"}, e)
+	}
+	return p
+}
+
+func (p *ClusterRoleBindingPatch) SetRoleRef(v RoleRef) *ClusterRoleBindingPatch {
+	p.Set([]string{"roleRef"}, v)
+	return p
+}
+
+func (p *ClusterRoleBindingPatch) RemoveRoleRef() *ClusterRoleBindingPatch {
+	p.Remove([]string{"roleRef"})
+	return p
+}
+
+func (p *ClusterRoleBindingPatch) SetRoleRefAPIGroup(v string) *ClusterRoleBindingPatch {
+	p.Set([]string{"roleRef", "apiGroup"}, v)
+	return p
+}
+
+func (p *ClusterRoleBindingPatch) RemoveRoleRefAPIGroup() *ClusterRoleBindingPatch {
+	p.Remove([]string{"roleRef", "apiGroup"})
+	return p
+}
+
+func (p *ClusterRoleBindingPatch) SetRoleRefKind(v string) *ClusterRoleBindingPatch {
+	p.Set([]string{"roleRef", "kind"}, v)
+	return p
+}
+
+func (p *ClusterRoleBindingPatch) RemoveRoleRefKind() *ClusterRoleBindingPatch {
+	p.Remove([]string{"roleRef", "kind"})
+	return p
+}
+
+func (p *ClusterRoleBindingPatch) SetRoleRefName(v string) *ClusterRoleBindingPatch {
+	p.Set([]string{"roleRef", "name"}, v)
+	return p
+}
+
+func (p *ClusterRoleBindingPatch) RemoveRoleRefName() *ClusterRoleBindingPatch {
+	p.Remove([]string{"roleRef", "name"})
+	return p
+}
+
+func (in *ClusterRoleBinding) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *ClusterRoleBinding) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *ClusterRoleBinding) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	first := true
+	if in.TypeMeta.Kind != "" {
+		w.RawString("\"kind\":")
+		w.String(in.TypeMeta.Kind)
+		first = false
+	}
+	if in.TypeMeta.APIVersion != "" {
+		if !first {
+			w.RawByte(',')
+		}
+		w.RawString("\"apiVersion\":")
+		w.String(in.TypeMeta.APIVersion)
+		first = false
+	}
+	if !first {
+		w.RawByte(',')
+	}
+	w.RawString("\"metadata\":")
+	in.ObjectMeta.MarshalEasyJSON(w)
+	w.RawString(",\"subjects\":")
+	if in.Subjects == nil {
+		w.RawString("null")
+	} else {
+		w.RawByte('[')
+		for i0 := range in.Subjects {
+			if i0 > 0 {
+				w.RawByte(',')
+			}
+			in.Subjects[i0].MarshalEasyJSON(w)
+		}
+		w.RawByte(']')
+	}
+	w.RawString(",\"roleRef\":")
+	in.RoleRef.MarshalEasyJSON(w)
+	w.RawByte('}')
+}
+
+func (in *ClusterRoleBinding) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "kind":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.TypeMeta.Kind = l.String()
+			}
+		case "apiVersion":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.TypeMeta.APIVersion = l.String()
+			}
+		case "metadata":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.ObjectMeta.UnmarshalEasyJSON(l)
+			}
+		case "subjects":
+			if l.IsNull() {
+				l.Skip()
+				in.Subjects = nil
+			} else {
+				in.Subjects = make([]Subject, 0)
+				l.Delim('[')
+				for !l.IsDelim(']') {
+					var v0 Subject
+					if l.IsNull() {
+						l.Skip()
+					} else {
+						v0.UnmarshalEasyJSON(l)
+					}
+					in.Subjects = append(in.Subjects, v0)
+					l.WantComma()
+				}
+				l.Delim(']')
+			}
+		case "roleRef":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.RoleRef.UnmarshalEasyJSON(l)
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
+
 type ClusterRoleBindingList struct {
 	metav1.TypeMeta `json:",inline"`
 	metav1.ListMeta `json:"metadata"`
@@ -151,6 +578,117 @@ func (in *ClusterRoleBindingList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+func (in *ClusterRoleBindingList) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *ClusterRoleBindingList) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *ClusterRoleBindingList) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	first := true
+	if in.TypeMeta.Kind != "" {
+		w.RawString("\"kind\":")
+		w.String(in.TypeMeta.Kind)
+		first = false
+	}
+	if in.TypeMeta.APIVersion != "" {
+		if !first {
+			w.RawByte(',')
+		}
+		w.RawString("\"apiVersion\":")
+		w.String(in.TypeMeta.APIVersion)
+		first = false
+	}
+	if !first {
+		w.RawByte(',')
+	}
+	w.RawString("\"metadata\":")
+	in.ListMeta.MarshalEasyJSON(w)
+	w.RawString(",\"items\":")
+	if in.Items == nil {
+		w.RawString("null")
+	} else {
+		w.RawByte('[')
+		for i0 := range in.Items {
+			if i0 > 0 {
+				w.RawByte(',')
+			}
+			in.Items[i0].MarshalEasyJSON(w)
+		}
+		w.RawByte(']')
+	}
+	w.RawByte('}')
+}
+
+func (in *ClusterRoleBindingList) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "kind":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.TypeMeta.Kind = l.String()
+			}
+		case "apiVersion":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.TypeMeta.APIVersion = l.String()
+			}
+		case "metadata":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.ListMeta.UnmarshalEasyJSON(l)
+			}
+		case "items":
+			if l.IsNull() {
+				l.Skip()
+				in.Items = nil
+			} else {
+				in.Items = make([]ClusterRoleBinding, 0)
+				l.Delim('[')
+				for !l.IsDelim(']') {
+					var v0 ClusterRoleBinding
+					if l.IsNull() {
+						l.Skip()
+					} else {
+						v0.UnmarshalEasyJSON(l)
+					}
+					in.Items = append(in.Items, v0)
+					l.WantComma()
+				}
+				l.Delim(']')
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
+
 type ClusterRoleList struct {
 	metav1.TypeMeta `json:",inline"`
 	metav1.ListMeta `json:"metadata"`
@@ -186,6 +724,117 @@ func (in *ClusterRoleList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+func (in *ClusterRoleList) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *ClusterRoleList) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *ClusterRoleList) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	first := true
+	if in.TypeMeta.Kind != "" {
+		w.RawString("\"kind\":")
+		w.String(in.TypeMeta.Kind)
+		first = false
+	}
+	if in.TypeMeta.APIVersion != "" {
+		if !first {
+			w.RawByte(',')
+		}
+		w.RawString("\"apiVersion\":")
+		w.String(in.TypeMeta.APIVersion)
+		first = false
+	}
+	if !first {
+		w.RawByte(',')
+	}
+	w.RawString("\"metadata\":")
+	in.ListMeta.MarshalEasyJSON(w)
+	w.RawString(",\"items\":")
+	if in.Items == nil {
+		w.RawString("null")
+	} else {
+		w.RawByte('[')
+		for i0 := range in.Items {
+			if i0 > 0 {
+				w.RawByte(',')
+			}
+			in.Items[i0].MarshalEasyJSON(w)
+		}
+		w.RawByte(']')
+	}
+	w.RawByte('}')
+}
+
+func (in *ClusterRoleList) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "kind":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.TypeMeta.Kind = l.String()
+			}
+		case "apiVersion":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.TypeMeta.APIVersion = l.String()
+			}
+		case "metadata":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.ListMeta.UnmarshalEasyJSON(l)
+			}
+		case "items":
+			if l.IsNull() {
+				l.Skip()
+				in.Items = nil
+			} else {
+				in.Items = make([]ClusterRole, 0)
+				l.Delim('[')
+				for !l.IsDelim(']') {
+					var v0 ClusterRole
+					if l.IsNull() {
+						l.Skip()
+					} else {
+						v0.UnmarshalEasyJSON(l)
+					}
+					in.Items = append(in.Items, v0)
+					l.WantComma()
+				}
+				l.Delim(']')
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
+
 type Role struct {
 	metav1.TypeMeta   `json:",inline"`
 	metav1.ObjectMeta `json:"metadata"`
@@ -222,6 +871,173 @@ func (in *Role) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// RoleToSelectableFields returns the fields of Role which can be used by the field selector.
+func RoleToSelectableFields(obj *Role) fields.Set {
+	set := fields.Set{
+		"metadata.name":      obj.ObjectMeta.Name,
+		"metadata.namespace": obj.ObjectMeta.Namespace,
+	}
+	return set
+}
+
+// RolePatch builds JSON patch or JSON merge patch of Role.
+// JSON patch fails if the parent of the field doesn't exist (e.g. SetLabel to the object which has no label). MergePatch creates the parent.
+type RolePatch struct {
+	*patch.Builder
+}
+
+func NewRolePatch() *RolePatch {
+	return &RolePatch{Builder: patch.NewBuilder()}
+}
+
+func (p *RolePatch) SetLabel(key, value string) *RolePatch {
+	p.Set([]string{"metadata", "labels", key}, value)
+	return p
+}
+
+func (p *RolePatch) RemoveLabel(key string) *RolePatch {
+	p.Remove([]string{"metadata", "labels", key})
+	return p
+}
+
+func (p *RolePatch) SetAnnotation(key, value string) *RolePatch {
+	p.Set([]string{"metadata", "annotations", key}, value)
+	return p
+}
+
+func (p *RolePatch) RemoveAnnotation(key string) *RolePatch {
+	p.Remove([]string{"metadata", "annotations", key})
+	return p
+}
+
+func (p *RolePatch) SetRules(v []PolicyRule) *RolePatch {
+	p.Set([]string{"rules"}, v)
+	return p
+}
+
+func (p *RolePatch) RemoveRules() *RolePatch {
+	p.Remove([]string{"rules"})
+	return p
+}
+
+func (p *RolePatch) AddRules(v ...PolicyRule) *RolePatch {
+	for _, e := range v {
+		p.Append([]string{"rules"}, e)
+	}
+	return p
+}
+
+func (in *Role) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *Role) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *Role) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	first := true
+	if in.TypeMeta.Kind != "" {
+		w.RawString("\"kind\":")
+		w.String(in.TypeMeta.Kind)
+		first = false
+	}
+	if in.TypeMeta.APIVersion != "" {
+		if !first {
+			w.RawByte(',')
+		}
+		w.RawString("\"apiVersion\":")
+		w.String(in.TypeMeta.APIVersion)
+		first = false
+	}
+	if !first {
+		w.RawByte(',')
+	}
+	w.RawString("\"metadata\":")
+	in.ObjectMeta.MarshalEasyJSON(w)
+	w.RawString(",\"rules\":")
+	if in.Rules == nil {
+		w.RawString("null")
+	} else {
+		w.RawByte('[')
+		for i0 := range in.Rules {
+			if i0 > 0 {
+				w.RawByte(',')
+			}
+			in.Rules[i0].MarshalEasyJSON(w)
+		}
+		w.RawByte(']')
+	}
+	w.RawByte('}')
+}
+
+func (in *Role) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "kind":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.TypeMeta.Kind = l.String()
+			}
+		case "apiVersion":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.TypeMeta.APIVersion = l.String()
+			}
+		case "metadata":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.ObjectMeta.UnmarshalEasyJSON(l)
+			}
+		case "rules":
+			if l.IsNull() {
+				l.Skip()
+				in.Rules = nil
+			} else {
+				in.Rules = make([]PolicyRule, 0)
+				l.Delim('[')
+				for !l.IsDelim(']') {
+					var v0 PolicyRule
+					if l.IsNull() {
+						l.Skip()
+					} else {
+						v0.UnmarshalEasyJSON(l)
+					}
+					in.Rules = append(in.Rules, v0)
+					l.WantComma()
+				}
+				l.Delim(']')
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
+
 type RoleBinding struct {
 	metav1.TypeMeta   `json:",inline"`
 	metav1.ObjectMeta `json:"metadata"`
@@ -263,6 +1079,221 @@ func (in *RoleBinding) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// RoleBindingToSelectableFields returns the fields of RoleBinding which can be used by the field selector.
+func RoleBindingToSelectableFields(obj *RoleBinding) fields.Set {
+	set := fields.Set{
+		"metadata.name":      obj.ObjectMeta.Name,
+		"metadata.namespace": obj.ObjectMeta.Namespace,
+	}
+	return set
+}
+
+// RoleBindingPatch builds JSON patch or JSON merge patch of RoleBinding.
+// JSON patch fails if the parent of the field doesn't exist (e.g. SetLabel to the object which has no label). MergePatch creates the parent.
+type RoleBindingPatch struct {
+	*patch.Builder
+}
+
+func NewRoleBindingPatch() *RoleBindingPatch {
+	return &RoleBindingPatch{Builder: patch.NewBuilder()}
+}
+
+func (p *RoleBindingPatch) SetLabel(key, value string) *RoleBindingPatch {
+	p.Set([]string{"metadata", "labels", key}, value)
+	return p
+}
+
+func (p *RoleBindingPatch) RemoveLabel(key string) *RoleBindingPatch {
+	p.Remove([]string{"metadata", "labels", key})
+	return p
+}
+
+func (p *RoleBindingPatch) SetAnnotation(key, value string) *RoleBindingPatch {
+	p.Set([]string{"metadata", "annotations", key}, value)
+	return p
+}
+
+func (p *RoleBindingPatch) RemoveAnnotation(key string) *RoleBindingPatch {
+	p.Remove([]string{"metadata", "annotations", key})
+	return p
+}
+
+func (p *RoleBindingPatch) SetSubjects(v []Subject) *RoleBindingPatch {
+	p.Set([]string{"subjects"}, v)
+	return p
+}
+
+func (p *RoleBindingPatch) RemoveSubjects() *RoleBindingPatch {
+	p.Remove([]string{"subjects"})
+	return p
+}
+
+func (p *RoleBindingPatch) AddSubjects(v ...Subject) *RoleBindingPatch {
+	for _, e := range v {
+		p.Append([]string{"subjects"}, e)
+	}
+	return p
+}
+
+func (p *RoleBindingPatch) SetRoleRef(v RoleRef) *RoleBindingPatch {
+	p.Set([]string{"roleRef"}, v)
+	return p
+}
+
+func (p *RoleBindingPatch) RemoveRoleRef() *RoleBindingPatch {
+	p.Remove([]string{"roleRef"})
+	return p
+}
+
+func (p *RoleBindingPatch) SetRoleRefAPIGroup(v string) *RoleBindingPatch {
+	p.Set([]string{"roleRef", "apiGroup"}, v)
+	return p
+}
+
+func (p *RoleBindingPatch) RemoveRoleRefAPIGroup() *RoleBindingPatch {
+	p.Remove([]string{"roleRef", "apiGroup"})
+	return p
+}
+
+func (p *RoleBindingPatch) SetRoleRefKind(v string) *RoleBindingPatch {
+	p.Set([]string{"roleRef", "kind"}, v)
+	return p
+}
+
+func (p *RoleBindingPatch) RemoveRoleRefKind() *RoleBindingPatch {
+	p.Remove([]string{"roleRef", "kind"})
+	return p
+}
+
+func (p *RoleBindingPatch) SetRoleRefName(v string) *RoleBindingPatch {
+	p.Set([]string{"roleRef", "name"}, v)
+	return p
+}
+
+func (p *RoleBindingPatch) RemoveRoleRefName() *RoleBindingPatch {
+	p.Remove([]string{"roleRef", "name"})
+	return p
+}
+
+func (in *RoleBinding) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *RoleBinding) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *RoleBinding) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	first := true
+	if in.TypeMeta.Kind != "" {
+		w.RawString("\"kind\":")
+		w.String(in.TypeMeta.Kind)
+		first = false
+	}
+	if in.TypeMeta.APIVersion != "" {
+		if !first {
+			w.RawByte(',')
+		}
+		w.RawString("\"apiVersion\":")
+		w.String(in.TypeMeta.APIVersion)
+		first = false
+	}
+	if !first {
+		w.RawByte(',')
+	}
+	w.RawString("\"metadata\":")
+	in.ObjectMeta.MarshalEasyJSON(w)
+	w.RawString(",\"subjects\":")
+	if in.Subjects == nil {
+		w.RawString("null")
+	} else {
+		w.RawByte('[')
+		for i0 := range in.Subjects {
+			if i0 > 0 {
+				w.RawByte(',')
+			}
+			in.Subjects[i0].MarshalEasyJSON(w)
+		}
+		w.RawByte(']')
+	}
+	w.RawString(",\"roleRef\":")
+	in.RoleRef.MarshalEasyJSON(w)
+	w.RawByte('}')
+}
+
+func (in *RoleBinding) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "kind":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.TypeMeta.Kind = l.String()
+			}
+		case "apiVersion":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.TypeMeta.APIVersion = l.String()
+			}
+		case "metadata":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.ObjectMeta.UnmarshalEasyJSON(l)
+			}
+		case "subjects":
+			if l.IsNull() {
+				l.Skip()
+				in.Subjects = nil
+			} else {
+				in.Subjects = make([]Subject, 0)
+				l.Delim('[')
+				for !l.IsDelim(']') {
+					var v0 Subject
+					if l.IsNull() {
+						l.Skip()
+					} else {
+						v0.UnmarshalEasyJSON(l)
+					}
+					in.Subjects = append(in.Subjects, v0)
+					l.WantComma()
+				}
+				l.Delim(']')
+			}
+		case "roleRef":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.RoleRef.UnmarshalEasyJSON(l)
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
+
 type RoleBindingList struct {
 	metav1.TypeMeta `json:",inline"`
 	metav1.ListMeta `json:"metadata"`
@@ -298,6 +1329,117 @@ func (in *RoleBindingList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+func (in *RoleBindingList) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *RoleBindingList) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *RoleBindingList) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	first := true
+	if in.TypeMeta.Kind != "" {
+		w.RawString("\"kind\":")
+		w.String(in.TypeMeta.Kind)
+		first = false
+	}
+	if in.TypeMeta.APIVersion != "" {
+		if !first {
+			w.RawByte(',')
+		}
+		w.RawString("\"apiVersion\":")
+		w.String(in.TypeMeta.APIVersion)
+		first = false
+	}
+	if !first {
+		w.RawByte(',')
+	}
+	w.RawString("\"metadata\":")
+	in.ListMeta.MarshalEasyJSON(w)
+	w.RawString(",\"items\":")
+	if in.Items == nil {
+		w.RawString("null")
+	} else {
+		w.RawByte('[')
+		for i0 := range in.Items {
+			if i0 > 0 {
+				w.RawByte(',')
+			}
+			in.Items[i0].MarshalEasyJSON(w)
+		}
+		w.RawByte(']')
+	}
+	w.RawByte('}')
+}
+
+func (in *RoleBindingList) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "kind":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.TypeMeta.Kind = l.String()
+			}
+		case "apiVersion":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.TypeMeta.APIVersion = l.String()
+			}
+		case "metadata":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.ListMeta.UnmarshalEasyJSON(l)
+			}
+		case "items":
+			if l.IsNull() {
+				l.Skip()
+				in.Items = nil
+			} else {
+				in.Items = make([]RoleBinding, 0)
+				l.Delim('[')
+				for !l.IsDelim(']') {
+					var v0 RoleBinding
+					if l.IsNull() {
+						l.Skip()
+					} else {
+						v0.UnmarshalEasyJSON(l)
+					}
+					in.Items = append(in.Items, v0)
+					l.WantComma()
+				}
+				l.Delim(']')
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
+
 type RoleList struct {
 	metav1.TypeMeta `json:",inline"`
 	metav1.ListMeta `json:"metadata"`
@@ -333,6 +1475,117 @@ func (in *RoleList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+func (in *RoleList) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *RoleList) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *RoleList) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	first := true
+	if in.TypeMeta.Kind != "" {
+		w.RawString("\"kind\":")
+		w.String(in.TypeMeta.Kind)
+		first = false
+	}
+	if in.TypeMeta.APIVersion != "" {
+		if !first {
+			w.RawByte(',')
+		}
+		w.RawString("\"apiVersion\":")
+		w.String(in.TypeMeta.APIVersion)
+		first = false
+	}
+	if !first {
+		w.RawByte(',')
+	}
+	w.RawString("\"metadata\":")
+	in.ListMeta.MarshalEasyJSON(w)
+	w.RawString(",\"items\":")
+	if in.Items == nil {
+		w.RawString("null")
+	} else {
+		w.RawByte('[')
+		for i0 := range in.Items {
+			if i0 > 0 {
+				w.RawByte(',')
+			}
+			in.Items[i0].MarshalEasyJSON(w)
+		}
+		w.RawByte(']')
+	}
+	w.RawByte('}')
+}
+
+func (in *RoleList) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "kind":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.TypeMeta.Kind = l.String()
+			}
+		case "apiVersion":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.TypeMeta.APIVersion = l.String()
+			}
+		case "metadata":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.ListMeta.UnmarshalEasyJSON(l)
+			}
+		case "items":
+			if l.IsNull() {
+				l.Skip()
+				in.Items = nil
+			} else {
+				in.Items = make([]Role, 0)
+				l.Delim('[')
+				for !l.IsDelim(']') {
+					var v0 Role
+					if l.IsNull() {
+						l.Skip()
+					} else {
+						v0.UnmarshalEasyJSON(l)
+					}
+					in.Items = append(in.Items, v0)
+					l.WantComma()
+				}
+				l.Delim(']')
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
+
 type PolicyRule struct {
 	// Verbs is a list of Verbs that apply to ALL the ResourceKinds contained in this rule. '*' represents all verbs.
 	Verbs []string `json:"verbs"`
@@ -387,6 +1640,209 @@ func (in *PolicyRule) DeepCopy() *PolicyRule {
 	return out
 }
 
+func (in *PolicyRule) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *PolicyRule) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *PolicyRule) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	w.RawString("\"verbs\":")
+	if in.Verbs == nil {
+		w.RawString("null")
+	} else {
+		w.RawByte('[')
+		for i0 := range in.Verbs {
+			if i0 > 0 {
+				w.RawByte(',')
+			}
+			w.String(in.Verbs[i0])
+		}
+		w.RawByte(']')
+	}
+	w.RawString(",\"apiGroups\":")
+	if in.APIGroups == nil {
+		w.RawString("null")
+	} else {
+		w.RawByte('[')
+		for i0 := range in.APIGroups {
+			if i0 > 0 {
+				w.RawByte(',')
+			}
+			w.String(in.APIGroups[i0])
+		}
+		w.RawByte(']')
+	}
+	w.RawString(",\"resources\":")
+	if in.Resources == nil {
+		w.RawString("null")
+	} else {
+		w.RawByte('[')
+		for i0 := range in.Resources {
+			if i0 > 0 {
+				w.RawByte(',')
+			}
+			w.String(in.Resources[i0])
+		}
+		w.RawByte(']')
+	}
+	w.RawString(",\"resourceNames\":")
+	if in.ResourceNames == nil {
+		w.RawString("null")
+	} else {
+		w.RawByte('[')
+		for i0 := range in.ResourceNames {
+			if i0 > 0 {
+				w.RawByte(',')
+			}
+			w.String(in.ResourceNames[i0])
+		}
+		w.RawByte(']')
+	}
+	w.RawString(",\"nonResourceURLs\":")
+	if in.NonResourceURLs == nil {
+		w.RawString("null")
+	} else {
+		w.RawByte('[')
+		for i0 := range in.NonResourceURLs {
+			if i0 > 0 {
+				w.RawByte(',')
+			}
+			w.String(in.NonResourceURLs[i0])
+		}
+		w.RawByte(']')
+	}
+
+	w.RawByte('}')
+}
+
+func (in *PolicyRule) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "verbs":
+			if l.IsNull() {
+				l.Skip()
+				in.Verbs = nil
+			} else {
+				in.Verbs = make([]string, 0)
+				l.Delim('[')
+				for !l.IsDelim(']') {
+					var v0 string
+					if l.IsNull() {
+						l.Skip()
+					} else {
+						v0 = l.String()
+					}
+					in.Verbs = append(in.Verbs, v0)
+					l.WantComma()
+				}
+				l.Delim(']')
+			}
+		case "apiGroups":
+			if l.IsNull() {
+				l.Skip()
+				in.APIGroups = nil
+			} else {
+				in.APIGroups = make([]string, 0)
+				l.Delim('[')
+				for !l.IsDelim(']') {
+					var v0 string
+					if l.IsNull() {
+						l.Skip()
+					} else {
+						v0 = l.String()
+					}
+					in.APIGroups = append(in.APIGroups, v0)
+					l.WantComma()
+				}
+				l.Delim(']')
+			}
+		case "resources":
+			if l.IsNull() {
+				l.Skip()
+				in.Resources = nil
+			} else {
+				in.Resources = make([]string, 0)
+				l.Delim('[')
+				for !l.IsDelim(']') {
+					var v0 string
+					if l.IsNull() {
+						l.Skip()
+					} else {
+						v0 = l.String()
+					}
+					in.Resources = append(in.Resources, v0)
+					l.WantComma()
+				}
+				l.Delim(']')
+			}
+		case "resourceNames":
+			if l.IsNull() {
+				l.Skip()
+				in.ResourceNames = nil
+			} else {
+				in.ResourceNames = make([]string, 0)
+				l.Delim('[')
+				for !l.IsDelim(']') {
+					var v0 string
+					if l.IsNull() {
+						l.Skip()
+					} else {
+						v0 = l.String()
+					}
+					in.ResourceNames = append(in.ResourceNames, v0)
+					l.WantComma()
+				}
+				l.Delim(']')
+			}
+		case "nonResourceURLs":
+			if l.IsNull() {
+				l.Skip()
+				in.NonResourceURLs = nil
+			} else {
+				in.NonResourceURLs = make([]string, 0)
+				l.Delim('[')
+				for !l.IsDelim(']') {
+					var v0 string
+					if l.IsNull() {
+						l.Skip()
+					} else {
+						v0 = l.String()
+					}
+					in.NonResourceURLs = append(in.NonResourceURLs, v0)
+					l.WantComma()
+				}
+				l.Delim(']')
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
+
 type AggregationRule struct {
 	// ClusterRoleSelectors holds a list of selectors which will be used to find ClusterRoles and create the rules.
 	// If any of the selectors match, then the ClusterRole's permissions will be added
@@ -413,6 +1869,81 @@ func (in *AggregationRule) DeepCopy() *AggregationRule {
 	return out
 }
 
+func (in *AggregationRule) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *AggregationRule) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *AggregationRule) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	w.RawString("\"clusterRoleSelectors\":")
+	if in.ClusterRoleSelectors == nil {
+		w.RawString("null")
+	} else {
+		w.RawByte('[')
+		for i0 := range in.ClusterRoleSelectors {
+			if i0 > 0 {
+				w.RawByte(',')
+			}
+			in.ClusterRoleSelectors[i0].MarshalEasyJSON(w)
+		}
+		w.RawByte(']')
+	}
+
+	w.RawByte('}')
+}
+
+func (in *AggregationRule) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "clusterRoleSelectors":
+			if l.IsNull() {
+				l.Skip()
+				in.ClusterRoleSelectors = nil
+			} else {
+				in.ClusterRoleSelectors = make([]metav1.LabelSelector, 0)
+				l.Delim('[')
+				for !l.IsDelim(']') {
+					var v0 metav1.LabelSelector
+					if l.IsNull() {
+						l.Skip()
+					} else {
+						v0.UnmarshalEasyJSON(l)
+					}
+					in.ClusterRoleSelectors = append(in.ClusterRoleSelectors, v0)
+					l.WantComma()
+				}
+				l.Delim(']')
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
+
 type Subject struct {
 	// Kind of object being referenced. Values defined by this API group are "User", "Group", and "ServiceAccount".
 	// If the Authorizer does not recognized the kind value, the Authorizer should report an error.
@@ -441,6 +1972,85 @@ func (in *Subject) DeepCopy() *Subject {
 	return out
 }
 
+func (in *Subject) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *Subject) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *Subject) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	w.RawString("\"kind\":")
+	w.String(in.Kind)
+	if in.APIGroup != "" {
+		w.RawString(",\"apiGroup\":")
+		w.String(in.APIGroup)
+	}
+	w.RawString(",\"name\":")
+	w.String(in.Name)
+	if in.Namespace != "" {
+		w.RawString(",\"namespace\":")
+		w.String(in.Namespace)
+	}
+
+	w.RawByte('}')
+}
+
+func (in *Subject) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "kind":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.Kind = l.String()
+			}
+		case "apiGroup":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.APIGroup = l.String()
+			}
+		case "name":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.Name = l.String()
+			}
+		case "namespace":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.Namespace = l.String()
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
+
 type RoleRef struct {
 	// APIGroup is the group for the resource being referenced
 	APIGroup string `json:"apiGroup"`
@@ -462,3 +2072,70 @@ func (in *RoleRef) DeepCopy() *RoleRef {
 	in.DeepCopyInto(out)
 	return out
 }
+
+func (in *RoleRef) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *RoleRef) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *RoleRef) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	w.RawString("\"apiGroup\":")
+	w.String(in.APIGroup)
+	w.RawString(",\"kind\":")
+	w.String(in.Kind)
+	w.RawString(",\"name\":")
+	w.String(in.Name)
+
+	w.RawByte('}')
+}
+
+func (in *RoleRef) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "apiGroup":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.APIGroup = l.String()
+			}
+		case "kind":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.Kind = l.String()
+			}
+		case "name":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.Name = l.String()
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
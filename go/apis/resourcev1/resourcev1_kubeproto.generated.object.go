@@ -1,11 +1,18 @@
 package resourcev1
 
 import (
+	"encoding/json"
+	"github.com/mailru/easyjson/jlexer"
+	"github.com/mailru/easyjson/jwriter"
 	"go.f110.dev/kubeproto/go/apis/corev1"
 	"go.f110.dev/kubeproto/go/apis/metav1"
+	"go.f110.dev/kubeproto/go/patch"
 	apiresource "k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/fields"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"maps"
+	"slices"
 )
 
 const GroupName = "resource"
@@ -96,6 +103,186 @@ func (in *DeviceClass) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeviceClassToSelectableFields returns the fields of DeviceClass which can be used by the field selector.
+func DeviceClassToSelectableFields(obj *DeviceClass) fields.Set {
+	set := fields.Set{
+		"metadata.name":      obj.ObjectMeta.Name,
+		"metadata.namespace": obj.ObjectMeta.Namespace,
+	}
+	return set
+}
+
+// DeviceClassPatch builds JSON patch or JSON merge patch of DeviceClass.
+// JSON patch fails if the parent of the field doesn't exist (e.g. SetLabel to the object which has no label). MergePatch creates the parent.
+type DeviceClassPatch struct {
+	*patch.Builder
+}
+
+func NewDeviceClassPatch() *DeviceClassPatch {
+	return &DeviceClassPatch{Builder: patch.NewBuilder()}
+}
+
+func (p *DeviceClassPatch) SetLabel(key, value string) *DeviceClassPatch {
+	p.Set([]string{"metadata", "labels", key}, value)
+	return p
+}
+
+func (p *DeviceClassPatch) RemoveLabel(key string) *DeviceClassPatch {
+	p.Remove([]string{"metadata", "labels", key})
+	return p
+}
+
+func (p *DeviceClassPatch) SetAnnotation(key, value string) *DeviceClassPatch {
+	p.Set([]string{"metadata", "annotations", key}, value)
+	return p
+}
+
+func (p *DeviceClassPatch) RemoveAnnotation(key string) *DeviceClassPatch {
+	p.Remove([]string{"metadata", "annotations", key})
+	return p
+}
+
+func (p *DeviceClassPatch) SetSpec(v DeviceClassSpec) *DeviceClassPatch {
+	p.Set([]string{"spec"}, v)
+	return p
+}
+
+func (p *DeviceClassPatch) RemoveSpec() *DeviceClassPatch {
+	p.Remove([]string{"spec"})
+	return p
+}
+
+func (p *DeviceClassPatch) SetSpecSelectors(v []DeviceSelector) *DeviceClassPatch {
+	p.Set([]string{"spec", "selectors"}, v)
+	return p
+}
+
+func (p *DeviceClassPatch) RemoveSpecSelectors() *DeviceClassPatch {
+	p.Remove([]string{"spec", "selectors"})
+	return p
+}
+
+func (p *DeviceClassPatch) AddSpecSelectors(v ...DeviceSelector) *DeviceClassPatch {
+	for _, e := range v {
+		p.Append([]string{"spec", "selectors"}, e)
+	}
+	return p
+}
+
+func (p *DeviceClassPatch) SetSpecConfig(v []DeviceClassConfiguration) *DeviceClassPatch {
+	p.Set([]string{"spec", "config"}, v)
+	return p
+}
+
+func (p *DeviceClassPatch) RemoveSpecConfig() *DeviceClassPatch {
+	p.Remove([]string{"spec", "config"})
+	return p
+}
+
+func (p *DeviceClassPatch) AddSpecConfig(v ...DeviceClassConfiguration) *DeviceClassPatch {
+	for _, e := range v {
+		p.Append([]string{"spec", "config"}, e)
+	}
+	return p
+}
+
+func (p *DeviceClassPatch) SetSpecExtendedResourceName(v string) *DeviceClassPatch {
+	p.Set([]string{"spec", "extendedResourceName"}, v)
+	return p
+}
+
+func (p *DeviceClassPatch) RemoveSpecExtendedResourceName() *DeviceClassPatch {
+	p.Remove([]string{"spec", "extendedResourceName"})
+	return p
+}
+
+func (in *DeviceClass) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *DeviceClass) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *DeviceClass) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	first := true
+	if in.TypeMeta.Kind != "" {
+		w.RawString("\"kind\":")
+		w.String(in.TypeMeta.Kind)
+		first = false
+	}
+	if in.TypeMeta.APIVersion != "" {
+		if !first {
+			w.RawByte(',')
+		}
+		w.RawString("\"apiVersion\":")
+		w.String(in.TypeMeta.APIVersion)
+		first = false
+	}
+	if !first {
+		w.RawByte(',')
+	}
+	w.RawString("\"metadata\":")
+	in.ObjectMeta.MarshalEasyJSON(w)
+	w.RawString(",\"spec\":")
+	in.Spec.MarshalEasyJSON(w)
+	w.RawByte('}')
+}
+
+func (in *DeviceClass) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "kind":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.TypeMeta.Kind = l.String()
+			}
+		case "apiVersion":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.TypeMeta.APIVersion = l.String()
+			}
+		case "metadata":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.ObjectMeta.UnmarshalEasyJSON(l)
+			}
+		case "spec":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.Spec.UnmarshalEasyJSON(l)
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
+
 type DeviceClassList struct {
 	metav1.TypeMeta `json:",inline"`
 	metav1.ListMeta `json:"metadata"`
@@ -131,6 +318,117 @@ func (in *DeviceClassList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+func (in *DeviceClassList) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *DeviceClassList) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *DeviceClassList) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	first := true
+	if in.TypeMeta.Kind != "" {
+		w.RawString("\"kind\":")
+		w.String(in.TypeMeta.Kind)
+		first = false
+	}
+	if in.TypeMeta.APIVersion != "" {
+		if !first {
+			w.RawByte(',')
+		}
+		w.RawString("\"apiVersion\":")
+		w.String(in.TypeMeta.APIVersion)
+		first = false
+	}
+	if !first {
+		w.RawByte(',')
+	}
+	w.RawString("\"metadata\":")
+	in.ListMeta.MarshalEasyJSON(w)
+	w.RawString(",\"items\":")
+	if in.Items == nil {
+		w.RawString("null")
+	} else {
+		w.RawByte('[')
+		for i0 := range in.Items {
+			if i0 > 0 {
+				w.RawByte(',')
+			}
+			in.Items[i0].MarshalEasyJSON(w)
+		}
+		w.RawByte(']')
+	}
+	w.RawByte('}')
+}
+
+func (in *DeviceClassList) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "kind":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.TypeMeta.Kind = l.String()
+			}
+		case "apiVersion":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.TypeMeta.APIVersion = l.String()
+			}
+		case "metadata":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.ListMeta.UnmarshalEasyJSON(l)
+			}
+		case "items":
+			if l.IsNull() {
+				l.Skip()
+				in.Items = nil
+			} else {
+				in.Items = make([]DeviceClass, 0)
+				l.Delim('[')
+				for !l.IsDelim(']') {
+					var v0 DeviceClass
+					if l.IsNull() {
+						l.Skip()
+					} else {
+						v0.UnmarshalEasyJSON(l)
+					}
+					in.Items = append(in.Items, v0)
+					l.WantComma()
+				}
+				l.Delim(']')
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
+
 type ResourceClaim struct {
 	metav1.TypeMeta   `json:",inline"`
 	metav1.ObjectMeta `json:"metadata"`
@@ -169,6 +467,335 @@ func (in *ResourceClaim) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// ResourceClaimToSelectableFields returns the fields of ResourceClaim which can be used by the field selector.
+func ResourceClaimToSelectableFields(obj *ResourceClaim) fields.Set {
+	set := fields.Set{
+		"metadata.name":      obj.ObjectMeta.Name,
+		"metadata.namespace": obj.ObjectMeta.Namespace,
+	}
+	return set
+}
+
+// ResourceClaimPatch builds JSON patch or JSON merge patch of ResourceClaim.
+// JSON patch fails if the parent of the field doesn't exist (e.g. SetLabel to the object which has no label). MergePatch creates the parent.
+type ResourceClaimPatch struct {
+	*patch.Builder
+}
+
+func NewResourceClaimPatch() *ResourceClaimPatch {
+	return &ResourceClaimPatch{Builder: patch.NewBuilder()}
+}
+
+func (p *ResourceClaimPatch) SetLabel(key, value string) *ResourceClaimPatch {
+	p.Set([]string{"metadata", "labels", key}, value)
+	return p
+}
+
+func (p *ResourceClaimPatch) RemoveLabel(key string) *ResourceClaimPatch {
+	p.Remove([]string{"metadata", "labels", key})
+	return p
+}
+
+func (p *ResourceClaimPatch) SetAnnotation(key, value string) *ResourceClaimPatch {
+	p.Set([]string{"metadata", "annotations", key}, value)
+	return p
+}
+
+func (p *ResourceClaimPatch) RemoveAnnotation(key string) *ResourceClaimPatch {
+	p.Remove([]string{"metadata", "annotations", key})
+	return p
+}
+
+func (p *ResourceClaimPatch) SetSpec(v ResourceClaimSpec) *ResourceClaimPatch {
+	p.Set([]string{"spec"}, v)
+	return p
+}
+
+func (p *ResourceClaimPatch) RemoveSpec() *ResourceClaimPatch {
+	p.Remove([]string{"spec"})
+	return p
+}
+
+func (p *ResourceClaimPatch) SetSpecDevices(v DeviceClaim) *ResourceClaimPatch {
+	p.Set([]string{"spec", "devices"}, v)
+	return p
+}
+
+func (p *ResourceClaimPatch) RemoveSpecDevices() *ResourceClaimPatch {
+	p.Remove([]string{"spec", "devices"})
+	return p
+}
+
+func (p *ResourceClaimPatch) SetSpecDevicesRequests(v []DeviceRequest) *ResourceClaimPatch {
+	p.Set([]string{"spec", "devices", "requests"}, v)
+	return p
+}
+
+func (p *ResourceClaimPatch) RemoveSpecDevicesRequests() *ResourceClaimPatch {
+	p.Remove([]string{"spec", "devices", "requests"})
+	return p
+}
+
+func (p *ResourceClaimPatch) AddSpecDevicesRequests(v ...DeviceRequest) *ResourceClaimPatch {
+	for _, e := range v {
+		p.Append([]string{"spec", "devices", "requests"}, e)
+	}
+	return p
+}
+
+func (p *ResourceClaimPatch) SetSpecDevicesConstraints(v []DeviceConstraint) *ResourceClaimPatch {
+	p.Set([]string{"spec", "devices", "constraints"}, v)
+	return p
+}
+
+func (p *ResourceClaimPatch) RemoveSpecDevicesConstraints() *ResourceClaimPatch {
+	p.Remove([]string{"spec", "devices", "constraints"})
+	return p
+}
+
+func (p *ResourceClaimPatch) AddSpecDevicesConstraints(v ...DeviceConstraint) *ResourceClaimPatch {
+	for _, e := range v {
+		p.Append([]string{"spec", "devices", "constraints"}, e)
+	}
+	return p
+}
+
+func (p *ResourceClaimPatch) SetSpecDevicesConfig(v []DeviceClaimConfiguration) *ResourceClaimPatch {
+	p.Set([]string{"spec", "devices", "config"}, v)
+	return p
+}
+
+func (p *ResourceClaimPatch) RemoveSpecDevicesConfig() *ResourceClaimPatch {
+	p.Remove([]string{"spec", "devices", "config"})
+	return p
+}
+
+func (p *ResourceClaimPatch) AddSpecDevicesConfig(v ...DeviceClaimConfiguration) *ResourceClaimPatch {
+	for _, e := range v {
+		p.Append([]string{"spec", "devices", "config"}, e)
+	}
+	return p
+}
+
+func (p *ResourceClaimPatch) SetStatus(v *ResourceClaimStatus) *ResourceClaimPatch {
+	p.Set([]string{"status"}, v)
+	return p
+}
+
+func (p *ResourceClaimPatch) RemoveStatus() *ResourceClaimPatch {
+	p.Remove([]string{"status"})
+	return p
+}
+
+func (p *ResourceClaimPatch) SetStatusAllocation(v *AllocationResult) *ResourceClaimPatch {
+	p.Set([]string{"status", "allocation"}, v)
+	return p
+}
+
+func (p *ResourceClaimPatch) RemoveStatusAllocation() *ResourceClaimPatch {
+	p.Remove([]string{"status", "allocation"})
+	return p
+}
+
+func (p *ResourceClaimPatch) SetStatusAllocationDevices(v *DeviceAllocationResult) *ResourceClaimPatch {
+	p.Set([]string{"status", "allocation", "devices"}, v)
+	return p
+}
+
+func (p *ResourceClaimPatch) RemoveStatusAllocationDevices() *ResourceClaimPatch {
+	p.Remove([]string{"status", "allocation", "devices"})
+	return p
+}
+
+func (p *ResourceClaimPatch) SetStatusAllocationDevicesResults(v []DeviceRequestAllocationResult) *ResourceClaimPatch {
+	p.Set([]string{"status", "allocation", "devices", "results"}, v)
+	return p
+}
+
+func (p *ResourceClaimPatch) RemoveStatusAllocationDevicesResults() *ResourceClaimPatch {
+	p.Remove([]string{"status", "allocation", "devices", "results"})
+	return p
+}
+
+func (p *ResourceClaimPatch) AddStatusAllocationDevicesResults(v ...DeviceRequestAllocationResult) *ResourceClaimPatch {
+	for _, e := range v {
+		p.Append([]string{"status", "allocation", "devices", "results"}, e)
+	}
+	return p
+}
+
+func (p *ResourceClaimPatch) SetStatusAllocationDevicesConfig(v []DeviceAllocationConfiguration) *ResourceClaimPatch {
+	p.Set([]string{"status", "allocation", "devices", "config"}, v)
+	return p
+}
+
+func (p *ResourceClaimPatch) RemoveStatusAllocationDevicesConfig() *ResourceClaimPatch {
+	p.Remove([]string{"status", "allocation", "devices", "config"})
+	return p
+}
+
+func (p *ResourceClaimPatch) AddStatusAllocationDevicesConfig(v ...DeviceAllocationConfiguration) *ResourceClaimPatch {
+	for _, e := range v {
+		p.Append([]string{"status", "allocation", "devices", "config"}, e)
+	}
+	return p
+}
+
+func (p *ResourceClaimPatch) SetStatusAllocationNodeSelector(v *corev1.NodeSelector) *ResourceClaimPatch {
+	p.Set([]string{"status", "allocation", "nodeSelector"}, v)
+	return p
+}
+
+func (p *ResourceClaimPatch) RemoveStatusAllocationNodeSelector() *ResourceClaimPatch {
+	p.Remove([]string{"status", "allocation", "nodeSelector"})
+	return p
+}
+
+func (p *ResourceClaimPatch) SetStatusAllocationAllocationTimestamp(v *metav1.Time) *ResourceClaimPatch {
+	p.Set([]string{"status", "allocation", "allocationTimestamp"}, v)
+	return p
+}
+
+func (p *ResourceClaimPatch) RemoveStatusAllocationAllocationTimestamp() *ResourceClaimPatch {
+	p.Remove([]string{"status", "allocation", "allocationTimestamp"})
+	return p
+}
+
+func (p *ResourceClaimPatch) SetStatusReservedFor(v []ResourceClaimConsumerReference) *ResourceClaimPatch {
+	p.Set([]string{"status", "reservedFor"}, v)
+	return p
+}
+
+func (p *ResourceClaimPatch) RemoveStatusReservedFor() *ResourceClaimPatch {
+	p.Remove([]string{"status", "reservedFor"})
+	return p
+}
+
+func (p *ResourceClaimPatch) AddStatusReservedFor(v ...ResourceClaimConsumerReference) *ResourceClaimPatch {
+	for _, e := range v {
+		p.Append([]string{"status", "reservedFor"}, e)
+	}
+	return p
+}
+
+func (p *ResourceClaimPatch) SetStatusDevices(v []AllocatedDeviceStatus) *ResourceClaimPatch {
+	p.Set([]string{"status", "devices"}, v)
+	return p
+}
+
+func (p *ResourceClaimPatch) RemoveStatusDevices() *ResourceClaimPatch {
+	p.Remove([]string{"status", "devices"})
+	return p
+}
+
+func (p *ResourceClaimPatch) AddStatusDevices(v ...AllocatedDeviceStatus) *ResourceClaimPatch {
+	for _, e := range v {
+		p.Append([]string{"status", "devices"}, e)
+	}
+	return p
+}
+
+func (in *ResourceClaim) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *ResourceClaim) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *ResourceClaim) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	first := true
+	if in.TypeMeta.Kind != "" {
+		w.RawString("\"kind\":")
+		w.String(in.TypeMeta.Kind)
+		first = false
+	}
+	if in.TypeMeta.APIVersion != "" {
+		if !first {
+			w.RawByte(',')
+		}
+		w.RawString("\"apiVersion\":")
+		w.String(in.TypeMeta.APIVersion)
+		first = false
+	}
+	if !first {
+		w.RawByte(',')
+	}
+	w.RawString("\"metadata\":")
+	in.ObjectMeta.MarshalEasyJSON(w)
+	w.RawString(",\"spec\":")
+	in.Spec.MarshalEasyJSON(w)
+	if in.Status != nil {
+		w.RawString(",\"status\":")
+		in.Status.MarshalEasyJSON(w)
+	}
+	w.RawByte('}')
+}
+
+func (in *ResourceClaim) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "kind":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.TypeMeta.Kind = l.String()
+			}
+		case "apiVersion":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.TypeMeta.APIVersion = l.String()
+			}
+		case "metadata":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.ObjectMeta.UnmarshalEasyJSON(l)
+			}
+		case "spec":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.Spec.UnmarshalEasyJSON(l)
+			}
+		case "status":
+			if l.IsNull() {
+				l.Skip()
+				in.Status = nil
+			} else {
+				if in.Status == nil {
+					in.Status = new(ResourceClaimStatus)
+				}
+				in.Status.UnmarshalEasyJSON(l)
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
+
 type ResourceClaimList struct {
 	metav1.TypeMeta `json:",inline"`
 	metav1.ListMeta `json:"metadata"`
@@ -204,6 +831,117 @@ func (in *ResourceClaimList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+func (in *ResourceClaimList) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *ResourceClaimList) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *ResourceClaimList) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	first := true
+	if in.TypeMeta.Kind != "" {
+		w.RawString("\"kind\":")
+		w.String(in.TypeMeta.Kind)
+		first = false
+	}
+	if in.TypeMeta.APIVersion != "" {
+		if !first {
+			w.RawByte(',')
+		}
+		w.RawString("\"apiVersion\":")
+		w.String(in.TypeMeta.APIVersion)
+		first = false
+	}
+	if !first {
+		w.RawByte(',')
+	}
+	w.RawString("\"metadata\":")
+	in.ListMeta.MarshalEasyJSON(w)
+	w.RawString(",\"items\":")
+	if in.Items == nil {
+		w.RawString("null")
+	} else {
+		w.RawByte('[')
+		for i0 := range in.Items {
+			if i0 > 0 {
+				w.RawByte(',')
+			}
+			in.Items[i0].MarshalEasyJSON(w)
+		}
+		w.RawByte(']')
+	}
+	w.RawByte('}')
+}
+
+func (in *ResourceClaimList) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "kind":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.TypeMeta.Kind = l.String()
+			}
+		case "apiVersion":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.TypeMeta.APIVersion = l.String()
+			}
+		case "metadata":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.ListMeta.UnmarshalEasyJSON(l)
+			}
+		case "items":
+			if l.IsNull() {
+				l.Skip()
+				in.Items = nil
+			} else {
+				in.Items = make([]ResourceClaim, 0)
+				l.Delim('[')
+				for !l.IsDelim(']') {
+					var v0 ResourceClaim
+					if l.IsNull() {
+						l.Skip()
+					} else {
+						v0.UnmarshalEasyJSON(l)
+					}
+					in.Items = append(in.Items, v0)
+					l.WantComma()
+				}
+				l.Delim(']')
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
+
 type ResourceClaimTemplate struct {
 	metav1.TypeMeta   `json:",inline"`
 	metav1.ObjectMeta `json:"metadata"`
@@ -221,86 +959,674 @@ func (in *ResourceClaimTemplate) DeepCopyInto(out *ResourceClaimTemplate) {
 	in.Spec.DeepCopyInto(&out.Spec)
 }
 
-func (in *ResourceClaimTemplate) DeepCopy() *ResourceClaimTemplate {
-	if in == nil {
-		return nil
+func (in *ResourceClaimTemplate) DeepCopy() *ResourceClaimTemplate {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourceClaimTemplate)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *ResourceClaimTemplate) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// ResourceClaimTemplateToSelectableFields returns the fields of ResourceClaimTemplate which can be used by the field selector.
+func ResourceClaimTemplateToSelectableFields(obj *ResourceClaimTemplate) fields.Set {
+	set := fields.Set{
+		"metadata.name":      obj.ObjectMeta.Name,
+		"metadata.namespace": obj.ObjectMeta.Namespace,
+	}
+	return set
+}
+
+// ResourceClaimTemplatePatch builds JSON patch or JSON merge patch of ResourceClaimTemplate.
+// JSON patch fails if the parent of the field doesn't exist (e.g. SetLabel to the object which has no label). MergePatch creates the parent.
+type ResourceClaimTemplatePatch struct {
+	*patch.Builder
+}
+
+func NewResourceClaimTemplatePatch() *ResourceClaimTemplatePatch {
+	return &ResourceClaimTemplatePatch{Builder: patch.NewBuilder()}
+}
+
+func (p *ResourceClaimTemplatePatch) SetLabel(key, value string) *ResourceClaimTemplatePatch {
+	p.Set([]string{"metadata", "labels", key}, value)
+	return p
+}
+
+func (p *ResourceClaimTemplatePatch) RemoveLabel(key string) *ResourceClaimTemplatePatch {
+	p.Remove([]string{"metadata", "labels", key})
+	return p
+}
+
+func (p *ResourceClaimTemplatePatch) SetAnnotation(key, value string) *ResourceClaimTemplatePatch {
+	p.Set([]string{"metadata", "annotations", key}, value)
+	return p
+}
+
+func (p *ResourceClaimTemplatePatch) RemoveAnnotation(key string) *ResourceClaimTemplatePatch {
+	p.Remove([]string{"metadata", "annotations", key})
+	return p
+}
+
+func (p *ResourceClaimTemplatePatch) SetSpec(v ResourceClaimTemplateSpec) *ResourceClaimTemplatePatch {
+	p.Set([]string{"spec"}, v)
+	return p
+}
+
+func (p *ResourceClaimTemplatePatch) RemoveSpec() *ResourceClaimTemplatePatch {
+	p.Remove([]string{"spec"})
+	return p
+}
+
+func (p *ResourceClaimTemplatePatch) SetSpecObjectMeta(v *metav1.ObjectMeta) *ResourceClaimTemplatePatch {
+	p.Set([]string{"spec", "metadata"}, v)
+	return p
+}
+
+func (p *ResourceClaimTemplatePatch) RemoveSpecObjectMeta() *ResourceClaimTemplatePatch {
+	p.Remove([]string{"spec", "metadata"})
+	return p
+}
+
+func (p *ResourceClaimTemplatePatch) SetSpecSpec(v ResourceClaimSpec) *ResourceClaimTemplatePatch {
+	p.Set([]string{"spec", "spec"}, v)
+	return p
+}
+
+func (p *ResourceClaimTemplatePatch) RemoveSpecSpec() *ResourceClaimTemplatePatch {
+	p.Remove([]string{"spec", "spec"})
+	return p
+}
+
+func (p *ResourceClaimTemplatePatch) SetSpecSpecDevices(v DeviceClaim) *ResourceClaimTemplatePatch {
+	p.Set([]string{"spec", "spec", "devices"}, v)
+	return p
+}
+
+func (p *ResourceClaimTemplatePatch) RemoveSpecSpecDevices() *ResourceClaimTemplatePatch {
+	p.Remove([]string{"spec", "spec", "devices"})
+	return p
+}
+
+func (p *ResourceClaimTemplatePatch) SetSpecSpecDevicesRequests(v []DeviceRequest) *ResourceClaimTemplatePatch {
+	p.Set([]string{"spec", "spec", "devices", "requests"}, v)
+	return p
+}
+
+func (p *ResourceClaimTemplatePatch) RemoveSpecSpecDevicesRequests() *ResourceClaimTemplatePatch {
+	p.Remove([]string{"spec", "spec", "devices", "requests"})
+	return p
+}
+
+func (p *ResourceClaimTemplatePatch) AddSpecSpecDevicesRequests(v ...DeviceRequest) *ResourceClaimTemplatePatch {
+	for _, e := range v {
+		p.Append([]string{"spec", "spec", "devices", "requests"}, e)
+	}
+	return p
+}
+
+func (p *ResourceClaimTemplatePatch) SetSpecSpecDevicesConstraints(v []DeviceConstraint) *ResourceClaimTemplatePatch {
+	p.Set([]string{"spec", "spec", "devices", "constraints"}, v)
+	return p
+}
+
+func (p *ResourceClaimTemplatePatch) RemoveSpecSpecDevicesConstraints() *ResourceClaimTemplatePatch {
+	p.Remove([]string{"spec", "spec", "devices", "constraints"})
+	return p
+}
+
+func (p *ResourceClaimTemplatePatch) AddSpecSpecDevicesConstraints(v ...DeviceConstraint) *ResourceClaimTemplatePatch {
+	for _, e := range v {
+		p.Append([]string{"spec", "spec", "devices", "constraints"}, e)
+	}
+	return p
+}
+
+func (p *ResourceClaimTemplatePatch) SetSpecSpecDevicesConfig(v []DeviceClaimConfiguration) *ResourceClaimTemplatePatch {
+	p.Set([]string{"spec", "spec", "devices", "config"}, v)
+	return p
+}
+
+func (p *ResourceClaimTemplatePatch) RemoveSpecSpecDevicesConfig() *ResourceClaimTemplatePatch {
+	p.Remove([]string{"spec", "spec", "devices", "config"})
+	return p
+}
+
+func (p *ResourceClaimTemplatePatch) AddSpecSpecDevicesConfig(v ...DeviceClaimConfiguration) *ResourceClaimTemplatePatch {
+	for _, e := range v {
+		p.Append([]string{"spec", "spec", "devices", "config"}, e)
+	}
+	return p
+}
+
+func (in *ResourceClaimTemplate) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *ResourceClaimTemplate) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *ResourceClaimTemplate) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	first := true
+	if in.TypeMeta.Kind != "" {
+		w.RawString("\"kind\":")
+		w.String(in.TypeMeta.Kind)
+		first = false
+	}
+	if in.TypeMeta.APIVersion != "" {
+		if !first {
+			w.RawByte(',')
+		}
+		w.RawString("\"apiVersion\":")
+		w.String(in.TypeMeta.APIVersion)
+		first = false
+	}
+	if !first {
+		w.RawByte(',')
+	}
+	w.RawString("\"metadata\":")
+	in.ObjectMeta.MarshalEasyJSON(w)
+	w.RawString(",\"spec\":")
+	in.Spec.MarshalEasyJSON(w)
+	w.RawByte('}')
+}
+
+func (in *ResourceClaimTemplate) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "kind":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.TypeMeta.Kind = l.String()
+			}
+		case "apiVersion":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.TypeMeta.APIVersion = l.String()
+			}
+		case "metadata":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.ObjectMeta.UnmarshalEasyJSON(l)
+			}
+		case "spec":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.Spec.UnmarshalEasyJSON(l)
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
+
+type ResourceClaimTemplateList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata"`
+	Items           []ResourceClaimTemplate `json:"items"`
+}
+
+func (in *ResourceClaimTemplateList) DeepCopyInto(out *ResourceClaimTemplateList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		l := make([]ResourceClaimTemplate, len(in.Items))
+		for i := range in.Items {
+			in.Items[i].DeepCopyInto(&l[i])
+		}
+		out.Items = l
+	}
+}
+
+func (in *ResourceClaimTemplateList) DeepCopy() *ResourceClaimTemplateList {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourceClaimTemplateList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *ResourceClaimTemplateList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+func (in *ResourceClaimTemplateList) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *ResourceClaimTemplateList) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *ResourceClaimTemplateList) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	first := true
+	if in.TypeMeta.Kind != "" {
+		w.RawString("\"kind\":")
+		w.String(in.TypeMeta.Kind)
+		first = false
+	}
+	if in.TypeMeta.APIVersion != "" {
+		if !first {
+			w.RawByte(',')
+		}
+		w.RawString("\"apiVersion\":")
+		w.String(in.TypeMeta.APIVersion)
+		first = false
+	}
+	if !first {
+		w.RawByte(',')
+	}
+	w.RawString("\"metadata\":")
+	in.ListMeta.MarshalEasyJSON(w)
+	w.RawString(",\"items\":")
+	if in.Items == nil {
+		w.RawString("null")
+	} else {
+		w.RawByte('[')
+		for i0 := range in.Items {
+			if i0 > 0 {
+				w.RawByte(',')
+			}
+			in.Items[i0].MarshalEasyJSON(w)
+		}
+		w.RawByte(']')
+	}
+	w.RawByte('}')
+}
+
+func (in *ResourceClaimTemplateList) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "kind":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.TypeMeta.Kind = l.String()
+			}
+		case "apiVersion":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.TypeMeta.APIVersion = l.String()
+			}
+		case "metadata":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.ListMeta.UnmarshalEasyJSON(l)
+			}
+		case "items":
+			if l.IsNull() {
+				l.Skip()
+				in.Items = nil
+			} else {
+				in.Items = make([]ResourceClaimTemplate, 0)
+				l.Delim('[')
+				for !l.IsDelim(']') {
+					var v0 ResourceClaimTemplate
+					if l.IsNull() {
+						l.Skip()
+					} else {
+						v0.UnmarshalEasyJSON(l)
+					}
+					in.Items = append(in.Items, v0)
+					l.WantComma()
+				}
+				l.Delim(']')
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
+
+type ResourceSlice struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata"`
+	// Contains the information published by the driver.
+	// Changing the spec automatically increments the metadata.generation number.
+	Spec ResourceSliceSpec `json:"spec"`
+}
+
+func (in *ResourceSlice) DeepCopyInto(out *ResourceSlice) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+}
+
+func (in *ResourceSlice) DeepCopy() *ResourceSlice {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourceSlice)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *ResourceSlice) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// ResourceSliceToSelectableFields returns the fields of ResourceSlice which can be used by the field selector.
+func ResourceSliceToSelectableFields(obj *ResourceSlice) fields.Set {
+	set := fields.Set{
+		"metadata.name":      obj.ObjectMeta.Name,
+		"metadata.namespace": obj.ObjectMeta.Namespace,
+	}
+	return set
+}
+
+// ResourceSlicePatch builds JSON patch or JSON merge patch of ResourceSlice.
+// JSON patch fails if the parent of the field doesn't exist (e.g. SetLabel to the object which has no label). MergePatch creates the parent.
+type ResourceSlicePatch struct {
+	*patch.Builder
+}
+
+func NewResourceSlicePatch() *ResourceSlicePatch {
+	return &ResourceSlicePatch{Builder: patch.NewBuilder()}
+}
+
+func (p *ResourceSlicePatch) SetLabel(key, value string) *ResourceSlicePatch {
+	p.Set([]string{"metadata", "labels", key}, value)
+	return p
+}
+
+func (p *ResourceSlicePatch) RemoveLabel(key string) *ResourceSlicePatch {
+	p.Remove([]string{"metadata", "labels", key})
+	return p
+}
+
+func (p *ResourceSlicePatch) SetAnnotation(key, value string) *ResourceSlicePatch {
+	p.Set([]string{"metadata", "annotations", key}, value)
+	return p
+}
+
+func (p *ResourceSlicePatch) RemoveAnnotation(key string) *ResourceSlicePatch {
+	p.Remove([]string{"metadata", "annotations", key})
+	return p
+}
+
+func (p *ResourceSlicePatch) SetSpec(v ResourceSliceSpec) *ResourceSlicePatch {
+	p.Set([]string{"spec"}, v)
+	return p
+}
+
+func (p *ResourceSlicePatch) RemoveSpec() *ResourceSlicePatch {
+	p.Remove([]string{"spec"})
+	return p
+}
+
+func (p *ResourceSlicePatch) SetSpecDriver(v string) *ResourceSlicePatch {
+	p.Set([]string{"spec", "driver"}, v)
+	return p
+}
+
+func (p *ResourceSlicePatch) RemoveSpecDriver() *ResourceSlicePatch {
+	p.Remove([]string{"spec", "driver"})
+	return p
+}
+
+func (p *ResourceSlicePatch) SetSpecPool(v ResourcePool) *ResourceSlicePatch {
+	p.Set([]string{"spec", "pool"}, v)
+	return p
+}
+
+func (p *ResourceSlicePatch) RemoveSpecPool() *ResourceSlicePatch {
+	p.Remove([]string{"spec", "pool"})
+	return p
+}
+
+func (p *ResourceSlicePatch) SetSpecPoolName(v string) *ResourceSlicePatch {
+	p.Set([]string{"spec", "pool", "name"}, v)
+	return p
+}
+
+func (p *ResourceSlicePatch) RemoveSpecPoolName() *ResourceSlicePatch {
+	p.Remove([]string{"spec", "pool", "name"})
+	return p
+}
+
+func (p *ResourceSlicePatch) SetSpecPoolGeneration(v int64) *ResourceSlicePatch {
+	p.Set([]string{"spec", "pool", "generation"}, v)
+	return p
+}
+
+func (p *ResourceSlicePatch) RemoveSpecPoolGeneration() *ResourceSlicePatch {
+	p.Remove([]string{"spec", "pool", "generation"})
+	return p
+}
+
+func (p *ResourceSlicePatch) SetSpecPoolResourceSliceCount(v int64) *ResourceSlicePatch {
+	p.Set([]string{"spec", "pool", "resourceSliceCount"}, v)
+	return p
+}
+
+func (p *ResourceSlicePatch) RemoveSpecPoolResourceSliceCount() *ResourceSlicePatch {
+	p.Remove([]string{"spec", "pool", "resourceSliceCount"})
+	return p
+}
+
+func (p *ResourceSlicePatch) SetSpecNodeName(v string) *ResourceSlicePatch {
+	p.Set([]string{"spec", "nodeName"}, v)
+	return p
+}
+
+func (p *ResourceSlicePatch) RemoveSpecNodeName() *ResourceSlicePatch {
+	p.Remove([]string{"spec", "nodeName"})
+	return p
+}
+
+func (p *ResourceSlicePatch) SetSpecNodeSelector(v *corev1.NodeSelector) *ResourceSlicePatch {
+	p.Set([]string{"spec", "nodeSelector"}, v)
+	return p
+}
+
+func (p *ResourceSlicePatch) RemoveSpecNodeSelector() *ResourceSlicePatch {
+	p.Remove([]string{"spec", "nodeSelector"})
+	return p
+}
+
+func (p *ResourceSlicePatch) SetSpecAllNodes(v bool) *ResourceSlicePatch {
+	p.Set([]string{"spec", "allNodes"}, v)
+	return p
+}
+
+func (p *ResourceSlicePatch) RemoveSpecAllNodes() *ResourceSlicePatch {
+	p.Remove([]string{"spec", "allNodes"})
+	return p
+}
+
+func (p *ResourceSlicePatch) SetSpecDevices(v []Device) *ResourceSlicePatch {
+	p.Set([]string{"spec", "devices"}, v)
+	return p
+}
+
+func (p *ResourceSlicePatch) RemoveSpecDevices() *ResourceSlicePatch {
+	p.Remove([]string{"spec", "devices"})
+	return p
+}
+
+func (p *ResourceSlicePatch) AddSpecDevices(v ...Device) *ResourceSlicePatch {
+	for _, e := range v {
+		p.Append([]string{"spec", "devices"}, e)
 	}
-	out := new(ResourceClaimTemplate)
-	in.DeepCopyInto(out)
-	return out
+	return p
 }
 
-func (in *ResourceClaimTemplate) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
-	}
-	return nil
+func (p *ResourceSlicePatch) SetSpecPerDeviceNodeSelection(v bool) *ResourceSlicePatch {
+	p.Set([]string{"spec", "perDeviceNodeSelection"}, v)
+	return p
 }
 
-type ResourceClaimTemplateList struct {
-	metav1.TypeMeta `json:",inline"`
-	metav1.ListMeta `json:"metadata"`
-	Items           []ResourceClaimTemplate `json:"items"`
+func (p *ResourceSlicePatch) RemoveSpecPerDeviceNodeSelection() *ResourceSlicePatch {
+	p.Remove([]string{"spec", "perDeviceNodeSelection"})
+	return p
 }
 
-func (in *ResourceClaimTemplateList) DeepCopyInto(out *ResourceClaimTemplateList) {
-	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ListMeta.DeepCopyInto(&out.ListMeta)
-	if in.Items != nil {
-		l := make([]ResourceClaimTemplate, len(in.Items))
-		for i := range in.Items {
-			in.Items[i].DeepCopyInto(&l[i])
-		}
-		out.Items = l
-	}
+func (p *ResourceSlicePatch) SetSpecSharedCounters(v []CounterSet) *ResourceSlicePatch {
+	p.Set([]string{"spec", "sharedCounters"}, v)
+	return p
 }
 
-func (in *ResourceClaimTemplateList) DeepCopy() *ResourceClaimTemplateList {
-	if in == nil {
-		return nil
-	}
-	out := new(ResourceClaimTemplateList)
-	in.DeepCopyInto(out)
-	return out
+func (p *ResourceSlicePatch) RemoveSpecSharedCounters() *ResourceSlicePatch {
+	p.Remove([]string{"spec", "sharedCounters"})
+	return p
 }
 
-func (in *ResourceClaimTemplateList) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
+func (p *ResourceSlicePatch) AddSpecSharedCounters(v ...CounterSet) *ResourceSlicePatch {
+	for _, e := range v {
+		p.Append([]string{"spec", "sharedCounters"}, e)
 	}
-	return nil
+	return p
 }
 
-type ResourceSlice struct {
-	metav1.TypeMeta   `json:",inline"`
-	metav1.ObjectMeta `json:"metadata"`
-	// Contains the information published by the driver.
-	// Changing the spec automatically increments the metadata.generation number.
-	Spec ResourceSliceSpec `json:"spec"`
+func (in *ResourceSlice) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
 }
 
-func (in *ResourceSlice) DeepCopyInto(out *ResourceSlice) {
-	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	in.Spec.DeepCopyInto(&out.Spec)
+func (in *ResourceSlice) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
 }
 
-func (in *ResourceSlice) DeepCopy() *ResourceSlice {
-	if in == nil {
-		return nil
+func (in *ResourceSlice) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	first := true
+	if in.TypeMeta.Kind != "" {
+		w.RawString("\"kind\":")
+		w.String(in.TypeMeta.Kind)
+		first = false
 	}
-	out := new(ResourceSlice)
-	in.DeepCopyInto(out)
-	return out
-}
-
-func (in *ResourceSlice) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
+	if in.TypeMeta.APIVersion != "" {
+		if !first {
+			w.RawByte(',')
+		}
+		w.RawString("\"apiVersion\":")
+		w.String(in.TypeMeta.APIVersion)
+		first = false
+	}
+	if !first {
+		w.RawByte(',')
+	}
+	w.RawString("\"metadata\":")
+	in.ObjectMeta.MarshalEasyJSON(w)
+	w.RawString(",\"spec\":")
+	in.Spec.MarshalEasyJSON(w)
+	w.RawByte('}')
+}
+
+func (in *ResourceSlice) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "kind":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.TypeMeta.Kind = l.String()
+			}
+		case "apiVersion":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.TypeMeta.APIVersion = l.String()
+			}
+		case "metadata":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.ObjectMeta.UnmarshalEasyJSON(l)
+			}
+		case "spec":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.Spec.UnmarshalEasyJSON(l)
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
 	}
-	return nil
 }
 
 type ResourceSliceList struct {
@@ -338,6 +1664,117 @@ func (in *ResourceSliceList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+func (in *ResourceSliceList) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *ResourceSliceList) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *ResourceSliceList) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	first := true
+	if in.TypeMeta.Kind != "" {
+		w.RawString("\"kind\":")
+		w.String(in.TypeMeta.Kind)
+		first = false
+	}
+	if in.TypeMeta.APIVersion != "" {
+		if !first {
+			w.RawByte(',')
+		}
+		w.RawString("\"apiVersion\":")
+		w.String(in.TypeMeta.APIVersion)
+		first = false
+	}
+	if !first {
+		w.RawByte(',')
+	}
+	w.RawString("\"metadata\":")
+	in.ListMeta.MarshalEasyJSON(w)
+	w.RawString(",\"items\":")
+	if in.Items == nil {
+		w.RawString("null")
+	} else {
+		w.RawByte('[')
+		for i0 := range in.Items {
+			if i0 > 0 {
+				w.RawByte(',')
+			}
+			in.Items[i0].MarshalEasyJSON(w)
+		}
+		w.RawByte(']')
+	}
+	w.RawByte('}')
+}
+
+func (in *ResourceSliceList) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "kind":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.TypeMeta.Kind = l.String()
+			}
+		case "apiVersion":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.TypeMeta.APIVersion = l.String()
+			}
+		case "metadata":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.ListMeta.UnmarshalEasyJSON(l)
+			}
+		case "items":
+			if l.IsNull() {
+				l.Skip()
+				in.Items = nil
+			} else {
+				in.Items = make([]ResourceSlice, 0)
+				l.Delim('[')
+				for !l.IsDelim(']') {
+					var v0 ResourceSlice
+					if l.IsNull() {
+						l.Skip()
+					} else {
+						v0.UnmarshalEasyJSON(l)
+					}
+					in.Items = append(in.Items, v0)
+					l.WantComma()
+				}
+				l.Delim(']')
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
+
 type DeviceClassSpec struct {
 	// Each selector must be satisfied by a device which is claimed via this class.
 	Selectors []DeviceSelector `json:"selectors"`
@@ -385,6 +1822,123 @@ func (in *DeviceClassSpec) DeepCopy() *DeviceClassSpec {
 	return out
 }
 
+func (in *DeviceClassSpec) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *DeviceClassSpec) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *DeviceClassSpec) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	w.RawString("\"selectors\":")
+	if in.Selectors == nil {
+		w.RawString("null")
+	} else {
+		w.RawByte('[')
+		for i0 := range in.Selectors {
+			if i0 > 0 {
+				w.RawByte(',')
+			}
+			in.Selectors[i0].MarshalEasyJSON(w)
+		}
+		w.RawByte(']')
+	}
+	w.RawString(",\"config\":")
+	if in.Config == nil {
+		w.RawString("null")
+	} else {
+		w.RawByte('[')
+		for i0 := range in.Config {
+			if i0 > 0 {
+				w.RawByte(',')
+			}
+			in.Config[i0].MarshalEasyJSON(w)
+		}
+		w.RawByte(']')
+	}
+	if in.ExtendedResourceName != "" {
+		w.RawString(",\"extendedResourceName\":")
+		w.String(in.ExtendedResourceName)
+	}
+
+	w.RawByte('}')
+}
+
+func (in *DeviceClassSpec) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "selectors":
+			if l.IsNull() {
+				l.Skip()
+				in.Selectors = nil
+			} else {
+				in.Selectors = make([]DeviceSelector, 0)
+				l.Delim('[')
+				for !l.IsDelim(']') {
+					var v0 DeviceSelector
+					if l.IsNull() {
+						l.Skip()
+					} else {
+						v0.UnmarshalEasyJSON(l)
+					}
+					in.Selectors = append(in.Selectors, v0)
+					l.WantComma()
+				}
+				l.Delim(']')
+			}
+		case "config":
+			if l.IsNull() {
+				l.Skip()
+				in.Config = nil
+			} else {
+				in.Config = make([]DeviceClassConfiguration, 0)
+				l.Delim('[')
+				for !l.IsDelim(']') {
+					var v0 DeviceClassConfiguration
+					if l.IsNull() {
+						l.Skip()
+					} else {
+						v0.UnmarshalEasyJSON(l)
+					}
+					in.Config = append(in.Config, v0)
+					l.WantComma()
+				}
+				l.Delim(']')
+			}
+		case "extendedResourceName":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.ExtendedResourceName = l.String()
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
+
 type ResourceClaimSpec struct {
 	// Devices defines how to request devices.
 	Devices DeviceClaim `json:"devices"`
@@ -404,6 +1958,57 @@ func (in *ResourceClaimSpec) DeepCopy() *ResourceClaimSpec {
 	return out
 }
 
+func (in *ResourceClaimSpec) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *ResourceClaimSpec) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *ResourceClaimSpec) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	w.RawString("\"devices\":")
+	in.Devices.MarshalEasyJSON(w)
+
+	w.RawByte('}')
+}
+
+func (in *ResourceClaimSpec) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "devices":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.Devices.UnmarshalEasyJSON(l)
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
+
 type ResourceClaimStatus struct {
 	// Allocation is set once the claim has been allocated successfully.
 	Allocation *AllocationResult `json:"allocation,omitempty"`
@@ -462,6 +2067,131 @@ func (in *ResourceClaimStatus) DeepCopy() *ResourceClaimStatus {
 	return out
 }
 
+func (in *ResourceClaimStatus) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *ResourceClaimStatus) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *ResourceClaimStatus) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	first := true
+	if in.Allocation != nil {
+		w.RawString("\"allocation\":")
+		in.Allocation.MarshalEasyJSON(w)
+		first = false
+	}
+	if !first {
+		w.RawByte(',')
+	}
+	w.RawString("\"reservedFor\":")
+	if in.ReservedFor == nil {
+		w.RawString("null")
+	} else {
+		w.RawByte('[')
+		for i0 := range in.ReservedFor {
+			if i0 > 0 {
+				w.RawByte(',')
+			}
+			in.ReservedFor[i0].MarshalEasyJSON(w)
+		}
+		w.RawByte(']')
+	}
+	w.RawString(",\"devices\":")
+	if in.Devices == nil {
+		w.RawString("null")
+	} else {
+		w.RawByte('[')
+		for i0 := range in.Devices {
+			if i0 > 0 {
+				w.RawByte(',')
+			}
+			in.Devices[i0].MarshalEasyJSON(w)
+		}
+		w.RawByte(']')
+	}
+	w.RawByte('}')
+}
+
+func (in *ResourceClaimStatus) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "allocation":
+			if l.IsNull() {
+				l.Skip()
+				in.Allocation = nil
+			} else {
+				if in.Allocation == nil {
+					in.Allocation = new(AllocationResult)
+				}
+				in.Allocation.UnmarshalEasyJSON(l)
+			}
+		case "reservedFor":
+			if l.IsNull() {
+				l.Skip()
+				in.ReservedFor = nil
+			} else {
+				in.ReservedFor = make([]ResourceClaimConsumerReference, 0)
+				l.Delim('[')
+				for !l.IsDelim(']') {
+					var v0 ResourceClaimConsumerReference
+					if l.IsNull() {
+						l.Skip()
+					} else {
+						v0.UnmarshalEasyJSON(l)
+					}
+					in.ReservedFor = append(in.ReservedFor, v0)
+					l.WantComma()
+				}
+				l.Delim(']')
+			}
+		case "devices":
+			if l.IsNull() {
+				l.Skip()
+				in.Devices = nil
+			} else {
+				in.Devices = make([]AllocatedDeviceStatus, 0)
+				l.Delim('[')
+				for !l.IsDelim(']') {
+					var v0 AllocatedDeviceStatus
+					if l.IsNull() {
+						l.Skip()
+					} else {
+						v0.UnmarshalEasyJSON(l)
+					}
+					in.Devices = append(in.Devices, v0)
+					l.WantComma()
+				}
+				l.Delim(']')
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
+
 type ResourceClaimTemplateSpec struct {
 	// ObjectMeta may contain labels and annotations that will be copied into the ResourceClaim
 	// when creating it. No other fields are allowed and will be rejected during
@@ -492,6 +2222,75 @@ func (in *ResourceClaimTemplateSpec) DeepCopy() *ResourceClaimTemplateSpec {
 	return out
 }
 
+func (in *ResourceClaimTemplateSpec) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *ResourceClaimTemplateSpec) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *ResourceClaimTemplateSpec) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	first := true
+	if in.ObjectMeta != nil {
+		w.RawString("\"metadata\":")
+		in.ObjectMeta.MarshalEasyJSON(w)
+		first = false
+	}
+	if !first {
+		w.RawByte(',')
+	}
+	w.RawString("\"spec\":")
+	in.Spec.MarshalEasyJSON(w)
+	w.RawByte('}')
+}
+
+func (in *ResourceClaimTemplateSpec) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "metadata":
+			if l.IsNull() {
+				l.Skip()
+				in.ObjectMeta = nil
+			} else {
+				if in.ObjectMeta == nil {
+					in.ObjectMeta = new(metav1.ObjectMeta)
+				}
+				in.ObjectMeta.UnmarshalEasyJSON(l)
+			}
+		case "spec":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.Spec.UnmarshalEasyJSON(l)
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
+
 type ResourceSliceSpec struct {
 	// Driver identifies the DRA driver providing the capacity information.
 	// A field selector can be used to list only ResourceSlice
@@ -571,6 +2370,173 @@ func (in *ResourceSliceSpec) DeepCopy() *ResourceSliceSpec {
 	return out
 }
 
+func (in *ResourceSliceSpec) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *ResourceSliceSpec) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *ResourceSliceSpec) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	w.RawString("\"driver\":")
+	w.String(in.Driver)
+	w.RawString(",\"pool\":")
+	in.Pool.MarshalEasyJSON(w)
+	if in.NodeName != "" {
+		w.RawString(",\"nodeName\":")
+		w.String(in.NodeName)
+	}
+	if in.NodeSelector != nil {
+		w.RawString(",\"nodeSelector\":")
+		in.NodeSelector.MarshalEasyJSON(w)
+	}
+	if in.AllNodes {
+		w.RawString(",\"allNodes\":")
+		w.Bool(in.AllNodes)
+	}
+	w.RawString(",\"devices\":")
+	if in.Devices == nil {
+		w.RawString("null")
+	} else {
+		w.RawByte('[')
+		for i0 := range in.Devices {
+			if i0 > 0 {
+				w.RawByte(',')
+			}
+			in.Devices[i0].MarshalEasyJSON(w)
+		}
+		w.RawByte(']')
+	}
+	if in.PerDeviceNodeSelection {
+		w.RawString(",\"perDeviceNodeSelection\":")
+		w.Bool(in.PerDeviceNodeSelection)
+	}
+	w.RawString(",\"sharedCounters\":")
+	if in.SharedCounters == nil {
+		w.RawString("null")
+	} else {
+		w.RawByte('[')
+		for i0 := range in.SharedCounters {
+			if i0 > 0 {
+				w.RawByte(',')
+			}
+			in.SharedCounters[i0].MarshalEasyJSON(w)
+		}
+		w.RawByte(']')
+	}
+
+	w.RawByte('}')
+}
+
+func (in *ResourceSliceSpec) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "driver":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.Driver = l.String()
+			}
+		case "pool":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.Pool.UnmarshalEasyJSON(l)
+			}
+		case "nodeName":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.NodeName = l.String()
+			}
+		case "nodeSelector":
+			if l.IsNull() {
+				l.Skip()
+				in.NodeSelector = nil
+			} else {
+				if in.NodeSelector == nil {
+					in.NodeSelector = new(corev1.NodeSelector)
+				}
+				in.NodeSelector.UnmarshalEasyJSON(l)
+			}
+		case "allNodes":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.AllNodes = l.Bool()
+			}
+		case "devices":
+			if l.IsNull() {
+				l.Skip()
+				in.Devices = nil
+			} else {
+				in.Devices = make([]Device, 0)
+				l.Delim('[')
+				for !l.IsDelim(']') {
+					var v0 Device
+					if l.IsNull() {
+						l.Skip()
+					} else {
+						v0.UnmarshalEasyJSON(l)
+					}
+					in.Devices = append(in.Devices, v0)
+					l.WantComma()
+				}
+				l.Delim(']')
+			}
+		case "perDeviceNodeSelection":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.PerDeviceNodeSelection = l.Bool()
+			}
+		case "sharedCounters":
+			if l.IsNull() {
+				l.Skip()
+				in.SharedCounters = nil
+			} else {
+				in.SharedCounters = make([]CounterSet, 0)
+				l.Delim('[')
+				for !l.IsDelim(']') {
+					var v0 CounterSet
+					if l.IsNull() {
+						l.Skip()
+					} else {
+						v0.UnmarshalEasyJSON(l)
+					}
+					in.SharedCounters = append(in.SharedCounters, v0)
+					l.WantComma()
+				}
+				l.Delim(']')
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
+
 type DeviceSelector struct {
 	// CEL contains a CEL expression for selecting a device.
 	CEL *CELDeviceSelector `json:"cel,omitempty"`
@@ -594,6 +2560,63 @@ func (in *DeviceSelector) DeepCopy() *DeviceSelector {
 	return out
 }
 
+func (in *DeviceSelector) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *DeviceSelector) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *DeviceSelector) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	if in.CEL != nil {
+		w.RawString("\"cel\":")
+		in.CEL.MarshalEasyJSON(w)
+	}
+
+	w.RawByte('}')
+}
+
+func (in *DeviceSelector) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "cel":
+			if l.IsNull() {
+				l.Skip()
+				in.CEL = nil
+			} else {
+				if in.CEL == nil {
+					in.CEL = new(CELDeviceSelector)
+				}
+				in.CEL.UnmarshalEasyJSON(l)
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
+
 type DeviceClassConfiguration struct {
 	DeviceConfiguration `json:",inline"`
 }
@@ -612,6 +2635,63 @@ func (in *DeviceClassConfiguration) DeepCopy() *DeviceClassConfiguration {
 	return out
 }
 
+func (in *DeviceClassConfiguration) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *DeviceClassConfiguration) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *DeviceClassConfiguration) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	if in.DeviceConfiguration.Opaque != nil {
+		w.RawString("\"opaque\":")
+		in.DeviceConfiguration.Opaque.MarshalEasyJSON(w)
+	}
+
+	w.RawByte('}')
+}
+
+func (in *DeviceClassConfiguration) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "opaque":
+			if l.IsNull() {
+				l.Skip()
+				in.DeviceConfiguration.Opaque = nil
+			} else {
+				if in.DeviceConfiguration.Opaque == nil {
+					in.DeviceConfiguration.Opaque = new(OpaqueDeviceConfiguration)
+				}
+				in.DeviceConfiguration.Opaque.UnmarshalEasyJSON(l)
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
+
 type DeviceClaim struct {
 	// Requests represent individual requests for distinct devices which
 	// must all be satisfied. If empty, nothing needs to be allocated.
@@ -659,6 +2739,145 @@ func (in *DeviceClaim) DeepCopy() *DeviceClaim {
 	return out
 }
 
+func (in *DeviceClaim) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *DeviceClaim) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *DeviceClaim) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	w.RawString("\"requests\":")
+	if in.Requests == nil {
+		w.RawString("null")
+	} else {
+		w.RawByte('[')
+		for i0 := range in.Requests {
+			if i0 > 0 {
+				w.RawByte(',')
+			}
+			in.Requests[i0].MarshalEasyJSON(w)
+		}
+		w.RawByte(']')
+	}
+	w.RawString(",\"constraints\":")
+	if in.Constraints == nil {
+		w.RawString("null")
+	} else {
+		w.RawByte('[')
+		for i0 := range in.Constraints {
+			if i0 > 0 {
+				w.RawByte(',')
+			}
+			in.Constraints[i0].MarshalEasyJSON(w)
+		}
+		w.RawByte(']')
+	}
+	w.RawString(",\"config\":")
+	if in.Config == nil {
+		w.RawString("null")
+	} else {
+		w.RawByte('[')
+		for i0 := range in.Config {
+			if i0 > 0 {
+				w.RawByte(',')
+			}
+			in.Config[i0].MarshalEasyJSON(w)
+		}
+		w.RawByte(']')
+	}
+
+	w.RawByte('}')
+}
+
+func (in *DeviceClaim) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "requests":
+			if l.IsNull() {
+				l.Skip()
+				in.Requests = nil
+			} else {
+				in.Requests = make([]DeviceRequest, 0)
+				l.Delim('[')
+				for !l.IsDelim(']') {
+					var v0 DeviceRequest
+					if l.IsNull() {
+						l.Skip()
+					} else {
+						v0.UnmarshalEasyJSON(l)
+					}
+					in.Requests = append(in.Requests, v0)
+					l.WantComma()
+				}
+				l.Delim(']')
+			}
+		case "constraints":
+			if l.IsNull() {
+				l.Skip()
+				in.Constraints = nil
+			} else {
+				in.Constraints = make([]DeviceConstraint, 0)
+				l.Delim('[')
+				for !l.IsDelim(']') {
+					var v0 DeviceConstraint
+					if l.IsNull() {
+						l.Skip()
+					} else {
+						v0.UnmarshalEasyJSON(l)
+					}
+					in.Constraints = append(in.Constraints, v0)
+					l.WantComma()
+				}
+				l.Delim(']')
+			}
+		case "config":
+			if l.IsNull() {
+				l.Skip()
+				in.Config = nil
+			} else {
+				in.Config = make([]DeviceClaimConfiguration, 0)
+				l.Delim('[')
+				for !l.IsDelim(']') {
+					var v0 DeviceClaimConfiguration
+					if l.IsNull() {
+						l.Skip()
+					} else {
+						v0.UnmarshalEasyJSON(l)
+					}
+					in.Config = append(in.Config, v0)
+					l.WantComma()
+				}
+				l.Delim(']')
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
+
 type AllocationResult struct {
 	// Devices is the result of allocating devices.
 	Devices *DeviceAllocationResult `json:"devices,omitempty"`
@@ -680,24 +2899,110 @@ func (in *AllocationResult) DeepCopyInto(out *AllocationResult) {
 		(*in).DeepCopyInto(*out)
 	}
 	if in.NodeSelector != nil {
-		in, out := &in.NodeSelector, &out.NodeSelector
-		*out = new(corev1.NodeSelector)
-		(*in).DeepCopyInto(*out)
+		in, out := &in.NodeSelector, &out.NodeSelector
+		*out = new(corev1.NodeSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AllocationTimestamp != nil {
+		in, out := &in.AllocationTimestamp, &out.AllocationTimestamp
+		*out = new(metav1.Time)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+func (in *AllocationResult) DeepCopy() *AllocationResult {
+	if in == nil {
+		return nil
+	}
+	out := new(AllocationResult)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *AllocationResult) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *AllocationResult) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *AllocationResult) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	first := true
+	if in.Devices != nil {
+		w.RawString("\"devices\":")
+		in.Devices.MarshalEasyJSON(w)
+		first = false
+	}
+	if in.NodeSelector != nil {
+		if !first {
+			w.RawByte(',')
+		}
+		w.RawString("\"nodeSelector\":")
+		in.NodeSelector.MarshalEasyJSON(w)
+		first = false
 	}
 	if in.AllocationTimestamp != nil {
-		in, out := &in.AllocationTimestamp, &out.AllocationTimestamp
-		*out = new(metav1.Time)
-		(*in).DeepCopyInto(*out)
+		if !first {
+			w.RawByte(',')
+		}
+		w.RawString("\"allocationTimestamp\":")
+		w.Raw(json.Marshal(in.AllocationTimestamp))
+		first = false
 	}
+	w.RawByte('}')
 }
 
-func (in *AllocationResult) DeepCopy() *AllocationResult {
-	if in == nil {
-		return nil
+func (in *AllocationResult) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "devices":
+			if l.IsNull() {
+				l.Skip()
+				in.Devices = nil
+			} else {
+				if in.Devices == nil {
+					in.Devices = new(DeviceAllocationResult)
+				}
+				in.Devices.UnmarshalEasyJSON(l)
+			}
+		case "nodeSelector":
+			if l.IsNull() {
+				l.Skip()
+				in.NodeSelector = nil
+			} else {
+				if in.NodeSelector == nil {
+					in.NodeSelector = new(corev1.NodeSelector)
+				}
+				in.NodeSelector.UnmarshalEasyJSON(l)
+			}
+		case "allocationTimestamp":
+			l.AddError(json.Unmarshal(l.Raw(), &in.AllocationTimestamp))
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
 	}
-	out := new(AllocationResult)
-	in.DeepCopyInto(out)
-	return out
 }
 
 type ResourceClaimConsumerReference struct {
@@ -726,6 +3031,87 @@ func (in *ResourceClaimConsumerReference) DeepCopy() *ResourceClaimConsumerRefer
 	return out
 }
 
+func (in *ResourceClaimConsumerReference) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *ResourceClaimConsumerReference) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *ResourceClaimConsumerReference) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	first := true
+	if in.APIGroup != "" {
+		w.RawString("\"apiGroup\":")
+		w.String(in.APIGroup)
+		first = false
+	}
+	if !first {
+		w.RawByte(',')
+	}
+	w.RawString("\"resource\":")
+	w.String(in.Resource)
+	w.RawString(",\"name\":")
+	w.String(in.Name)
+	w.RawString(",\"uid\":")
+	w.String(in.UID)
+	w.RawByte('}')
+}
+
+func (in *ResourceClaimConsumerReference) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "apiGroup":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.APIGroup = l.String()
+			}
+		case "resource":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.Resource = l.String()
+			}
+		case "name":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.Name = l.String()
+			}
+		case "uid":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.UID = l.String()
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
+
 type AllocatedDeviceStatus struct {
 	// Driver specifies the name of the DRA driver whose kubelet
 	// plugin should be invoked to process the allocation once the claim is
@@ -785,6 +3171,135 @@ func (in *AllocatedDeviceStatus) DeepCopy() *AllocatedDeviceStatus {
 	return out
 }
 
+func (in *AllocatedDeviceStatus) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *AllocatedDeviceStatus) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *AllocatedDeviceStatus) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	w.RawString("\"driver\":")
+	w.String(in.Driver)
+	w.RawString(",\"pool\":")
+	w.String(in.Pool)
+	w.RawString(",\"device\":")
+	w.String(in.Device)
+	if in.ShareID != "" {
+		w.RawString(",\"shareID\":")
+		w.String(in.ShareID)
+	}
+	w.RawString(",\"conditions\":")
+	if in.Conditions == nil {
+		w.RawString("null")
+	} else {
+		w.RawByte('[')
+		for i0 := range in.Conditions {
+			if i0 > 0 {
+				w.RawByte(',')
+			}
+			in.Conditions[i0].MarshalEasyJSON(w)
+		}
+		w.RawByte(']')
+	}
+	if in.Data != nil {
+		w.RawString(",\"data\":")
+		w.Raw(json.Marshal(in.Data))
+	}
+	if in.NetworkData != nil {
+		w.RawString(",\"networkData\":")
+		in.NetworkData.MarshalEasyJSON(w)
+	}
+
+	w.RawByte('}')
+}
+
+func (in *AllocatedDeviceStatus) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "driver":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.Driver = l.String()
+			}
+		case "pool":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.Pool = l.String()
+			}
+		case "device":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.Device = l.String()
+			}
+		case "shareID":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.ShareID = l.String()
+			}
+		case "conditions":
+			if l.IsNull() {
+				l.Skip()
+				in.Conditions = nil
+			} else {
+				in.Conditions = make([]metav1.Condition, 0)
+				l.Delim('[')
+				for !l.IsDelim(']') {
+					var v0 metav1.Condition
+					if l.IsNull() {
+						l.Skip()
+					} else {
+						v0.UnmarshalEasyJSON(l)
+					}
+					in.Conditions = append(in.Conditions, v0)
+					l.WantComma()
+				}
+				l.Delim(']')
+			}
+		case "data":
+			l.AddError(json.Unmarshal(l.Raw(), &in.Data))
+		case "networkData":
+			if l.IsNull() {
+				l.Skip()
+				in.NetworkData = nil
+			} else {
+				if in.NetworkData == nil {
+					in.NetworkData = new(NetworkDeviceData)
+				}
+				in.NetworkData.UnmarshalEasyJSON(l)
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
+
 type ResourcePool struct {
 	// Name is used to identify the pool. For node-local devices, this
 	// is often the node name, but this is not required.
@@ -823,6 +3338,73 @@ func (in *ResourcePool) DeepCopy() *ResourcePool {
 	return out
 }
 
+func (in *ResourcePool) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *ResourcePool) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *ResourcePool) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	w.RawString("\"name\":")
+	w.String(in.Name)
+	w.RawString(",\"generation\":")
+	w.Int64(in.Generation)
+	w.RawString(",\"resourceSliceCount\":")
+	w.Int64(in.ResourceSliceCount)
+
+	w.RawByte('}')
+}
+
+func (in *ResourcePool) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "name":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.Name = l.String()
+			}
+		case "generation":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.Generation = l.Int64()
+			}
+		case "resourceSliceCount":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.ResourceSliceCount = l.Int64()
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
+
 type Device struct {
 	// Name is unique identifier among all devices managed by
 	// the driver in the pool. It must be a DNS label.
@@ -967,6 +3549,362 @@ func (in *Device) DeepCopy() *Device {
 	return out
 }
 
+func (in *Device) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *Device) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *Device) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	w.RawString("\"name\":")
+	w.String(in.Name)
+	if len(in.Attributes) != 0 {
+		w.RawString(",\"attributes\":")
+		if in.Attributes == nil {
+			w.RawString("null")
+		} else {
+			w.RawByte('{')
+			for i0, k0 := range slices.Sorted(maps.Keys(in.Attributes)) {
+				if i0 > 0 {
+					w.RawByte(',')
+				}
+				w.String(k0)
+				w.RawByte(':')
+				v0 := in.Attributes[k0]
+				v0.MarshalEasyJSON(w)
+			}
+			w.RawByte('}')
+		}
+	}
+	if len(in.Capacity) != 0 {
+		w.RawString(",\"capacity\":")
+		if in.Capacity == nil {
+			w.RawString("null")
+		} else {
+			w.RawByte('{')
+			for i0, k0 := range slices.Sorted(maps.Keys(in.Capacity)) {
+				if i0 > 0 {
+					w.RawByte(',')
+				}
+				w.String(k0)
+				w.RawByte(':')
+				v0 := in.Capacity[k0]
+				v0.MarshalEasyJSON(w)
+			}
+			w.RawByte('}')
+		}
+	}
+	w.RawString(",\"consumesCounters\":")
+	if in.ConsumesCounters == nil {
+		w.RawString("null")
+	} else {
+		w.RawByte('[')
+		for i0 := range in.ConsumesCounters {
+			if i0 > 0 {
+				w.RawByte(',')
+			}
+			in.ConsumesCounters[i0].MarshalEasyJSON(w)
+		}
+		w.RawByte(']')
+	}
+	if in.NodeName != "" {
+		w.RawString(",\"nodeName\":")
+		w.String(in.NodeName)
+	}
+	if in.NodeSelector != nil {
+		w.RawString(",\"nodeSelector\":")
+		in.NodeSelector.MarshalEasyJSON(w)
+	}
+	if in.AllNodes {
+		w.RawString(",\"allNodes\":")
+		w.Bool(in.AllNodes)
+	}
+	w.RawString(",\"taints\":")
+	if in.Taints == nil {
+		w.RawString("null")
+	} else {
+		w.RawByte('[')
+		for i0 := range in.Taints {
+			if i0 > 0 {
+				w.RawByte(',')
+			}
+			in.Taints[i0].MarshalEasyJSON(w)
+		}
+		w.RawByte(']')
+	}
+	if in.BindsToNode {
+		w.RawString(",\"bindsToNode\":")
+		w.Bool(in.BindsToNode)
+	}
+	w.RawString(",\"bindingConditions\":")
+	if in.BindingConditions == nil {
+		w.RawString("null")
+	} else {
+		w.RawByte('[')
+		for i0 := range in.BindingConditions {
+			if i0 > 0 {
+				w.RawByte(',')
+			}
+			w.String(in.BindingConditions[i0])
+		}
+		w.RawByte(']')
+	}
+	w.RawString(",\"bindingFailureConditions\":")
+	if in.BindingFailureConditions == nil {
+		w.RawString("null")
+	} else {
+		w.RawByte('[')
+		for i0 := range in.BindingFailureConditions {
+			if i0 > 0 {
+				w.RawByte(',')
+			}
+			w.String(in.BindingFailureConditions[i0])
+		}
+		w.RawByte(']')
+	}
+	if in.AllowMultipleAllocations {
+		w.RawString(",\"allowMultipleAllocations\":")
+		w.Bool(in.AllowMultipleAllocations)
+	}
+	if len(in.NodeAllocatableResourceMappings) != 0 {
+		w.RawString(",\"nodeAllocatableResourceMappings\":")
+		if in.NodeAllocatableResourceMappings == nil {
+			w.RawString("null")
+		} else {
+			w.RawByte('{')
+			for i0, k0 := range slices.Sorted(maps.Keys(in.NodeAllocatableResourceMappings)) {
+				if i0 > 0 {
+					w.RawByte(',')
+				}
+				w.String(k0)
+				w.RawByte(':')
+				v0 := in.NodeAllocatableResourceMappings[k0]
+				v0.MarshalEasyJSON(w)
+			}
+			w.RawByte('}')
+		}
+	}
+
+	w.RawByte('}')
+}
+
+func (in *Device) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "name":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.Name = l.String()
+			}
+		case "attributes":
+			if l.IsNull() {
+				l.Skip()
+				in.Attributes = nil
+			} else {
+				if in.Attributes == nil {
+					in.Attributes = make(map[string]DeviceAttribute)
+				}
+				l.Delim('{')
+				for !l.IsDelim('}') {
+					k0 := l.String()
+					l.WantColon()
+					var v0 DeviceAttribute
+					if l.IsNull() {
+						l.Skip()
+					} else {
+						v0.UnmarshalEasyJSON(l)
+					}
+					in.Attributes[k0] = v0
+					l.WantComma()
+				}
+				l.Delim('}')
+			}
+		case "capacity":
+			if l.IsNull() {
+				l.Skip()
+				in.Capacity = nil
+			} else {
+				if in.Capacity == nil {
+					in.Capacity = make(map[string]DeviceCapacity)
+				}
+				l.Delim('{')
+				for !l.IsDelim('}') {
+					k0 := l.String()
+					l.WantColon()
+					var v0 DeviceCapacity
+					if l.IsNull() {
+						l.Skip()
+					} else {
+						v0.UnmarshalEasyJSON(l)
+					}
+					in.Capacity[k0] = v0
+					l.WantComma()
+				}
+				l.Delim('}')
+			}
+		case "consumesCounters":
+			if l.IsNull() {
+				l.Skip()
+				in.ConsumesCounters = nil
+			} else {
+				in.ConsumesCounters = make([]DeviceCounterConsumption, 0)
+				l.Delim('[')
+				for !l.IsDelim(']') {
+					var v0 DeviceCounterConsumption
+					if l.IsNull() {
+						l.Skip()
+					} else {
+						v0.UnmarshalEasyJSON(l)
+					}
+					in.ConsumesCounters = append(in.ConsumesCounters, v0)
+					l.WantComma()
+				}
+				l.Delim(']')
+			}
+		case "nodeName":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.NodeName = l.String()
+			}
+		case "nodeSelector":
+			if l.IsNull() {
+				l.Skip()
+				in.NodeSelector = nil
+			} else {
+				if in.NodeSelector == nil {
+					in.NodeSelector = new(corev1.NodeSelector)
+				}
+				in.NodeSelector.UnmarshalEasyJSON(l)
+			}
+		case "allNodes":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.AllNodes = l.Bool()
+			}
+		case "taints":
+			if l.IsNull() {
+				l.Skip()
+				in.Taints = nil
+			} else {
+				in.Taints = make([]DeviceTaint, 0)
+				l.Delim('[')
+				for !l.IsDelim(']') {
+					var v0 DeviceTaint
+					if l.IsNull() {
+						l.Skip()
+					} else {
+						v0.UnmarshalEasyJSON(l)
+					}
+					in.Taints = append(in.Taints, v0)
+					l.WantComma()
+				}
+				l.Delim(']')
+			}
+		case "bindsToNode":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.BindsToNode = l.Bool()
+			}
+		case "bindingConditions":
+			if l.IsNull() {
+				l.Skip()
+				in.BindingConditions = nil
+			} else {
+				in.BindingConditions = make([]string, 0)
+				l.Delim('[')
+				for !l.IsDelim(']') {
+					var v0 string
+					if l.IsNull() {
+						l.Skip()
+					} else {
+						v0 = l.String()
+					}
+					in.BindingConditions = append(in.BindingConditions, v0)
+					l.WantComma()
+				}
+				l.Delim(']')
+			}
+		case "bindingFailureConditions":
+			if l.IsNull() {
+				l.Skip()
+				in.BindingFailureConditions = nil
+			} else {
+				in.BindingFailureConditions = make([]string, 0)
+				l.Delim('[')
+				for !l.IsDelim(']') {
+					var v0 string
+					if l.IsNull() {
+						l.Skip()
+					} else {
+						v0 = l.String()
+					}
+					in.BindingFailureConditions = append(in.BindingFailureConditions, v0)
+					l.WantComma()
+				}
+				l.Delim(']')
+			}
+		case "allowMultipleAllocations":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.AllowMultipleAllocations = l.Bool()
+			}
+		case "nodeAllocatableResourceMappings":
+			if l.IsNull() {
+				l.Skip()
+				in.NodeAllocatableResourceMappings = nil
+			} else {
+				if in.NodeAllocatableResourceMappings == nil {
+					in.NodeAllocatableResourceMappings = make(map[string]NodeAllocatableResourceMapping)
+				}
+				l.Delim('{')
+				for !l.IsDelim('}') {
+					k0 := l.String()
+					l.WantColon()
+					var v0 NodeAllocatableResourceMapping
+					if l.IsNull() {
+						l.Skip()
+					} else {
+						v0.UnmarshalEasyJSON(l)
+					}
+					in.NodeAllocatableResourceMappings[k0] = v0
+					l.WantComma()
+				}
+				l.Delim('}')
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
+
 type CounterSet struct {
 	// Name defines the name of the counter set.
 	// It must be a DNS label.
@@ -997,6 +3935,98 @@ func (in *CounterSet) DeepCopy() *CounterSet {
 	return out
 }
 
+func (in *CounterSet) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *CounterSet) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *CounterSet) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	w.RawString("\"name\":")
+	w.String(in.Name)
+	if len(in.Counters) != 0 {
+		w.RawString(",\"counters\":")
+		if in.Counters == nil {
+			w.RawString("null")
+		} else {
+			w.RawByte('{')
+			for i0, k0 := range slices.Sorted(maps.Keys(in.Counters)) {
+				if i0 > 0 {
+					w.RawByte(',')
+				}
+				w.String(k0)
+				w.RawByte(':')
+				v0 := in.Counters[k0]
+				v0.MarshalEasyJSON(w)
+			}
+			w.RawByte('}')
+		}
+	}
+
+	w.RawByte('}')
+}
+
+func (in *CounterSet) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "name":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.Name = l.String()
+			}
+		case "counters":
+			if l.IsNull() {
+				l.Skip()
+				in.Counters = nil
+			} else {
+				if in.Counters == nil {
+					in.Counters = make(map[string]Counter)
+				}
+				l.Delim('{')
+				for !l.IsDelim('}') {
+					k0 := l.String()
+					l.WantColon()
+					var v0 Counter
+					if l.IsNull() {
+						l.Skip()
+					} else {
+						v0.UnmarshalEasyJSON(l)
+					}
+					in.Counters[k0] = v0
+					l.WantComma()
+				}
+				l.Delim('}')
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
+
 type CELDeviceSelector struct {
 	// Expression is a CEL expression which evaluates a single device. It
 	// must evaluate to true when the device under consideration satisfies
@@ -1062,6 +4092,57 @@ func (in *CELDeviceSelector) DeepCopy() *CELDeviceSelector {
 	return out
 }
 
+func (in *CELDeviceSelector) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *CELDeviceSelector) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *CELDeviceSelector) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	w.RawString("\"expression\":")
+	w.String(in.Expression)
+
+	w.RawByte('}')
+}
+
+func (in *CELDeviceSelector) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "expression":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.Expression = l.String()
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
+
 type DeviceConfiguration struct {
 	// Opaque provides driver-specific configuration parameters.
 	Opaque *OpaqueDeviceConfiguration `json:"opaque,omitempty"`
@@ -1085,6 +4166,63 @@ func (in *DeviceConfiguration) DeepCopy() *DeviceConfiguration {
 	return out
 }
 
+func (in *DeviceConfiguration) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *DeviceConfiguration) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *DeviceConfiguration) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	if in.Opaque != nil {
+		w.RawString("\"opaque\":")
+		in.Opaque.MarshalEasyJSON(w)
+	}
+
+	w.RawByte('}')
+}
+
+func (in *DeviceConfiguration) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "opaque":
+			if l.IsNull() {
+				l.Skip()
+				in.Opaque = nil
+			} else {
+				if in.Opaque == nil {
+					in.Opaque = new(OpaqueDeviceConfiguration)
+				}
+				in.Opaque.UnmarshalEasyJSON(l)
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
+
 type DeviceRequest struct {
 	// Name can be used to reference this request in a pod.spec.containers[].resources.claims
 	// entry and in a constraint of the claim.
@@ -1139,6 +4277,103 @@ func (in *DeviceRequest) DeepCopy() *DeviceRequest {
 	return out
 }
 
+func (in *DeviceRequest) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *DeviceRequest) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *DeviceRequest) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	w.RawString("\"name\":")
+	w.String(in.Name)
+	if in.Exactly != nil {
+		w.RawString(",\"exactly\":")
+		in.Exactly.MarshalEasyJSON(w)
+	}
+	w.RawString(",\"firstAvailable\":")
+	if in.FirstAvailable == nil {
+		w.RawString("null")
+	} else {
+		w.RawByte('[')
+		for i0 := range in.FirstAvailable {
+			if i0 > 0 {
+				w.RawByte(',')
+			}
+			in.FirstAvailable[i0].MarshalEasyJSON(w)
+		}
+		w.RawByte(']')
+	}
+
+	w.RawByte('}')
+}
+
+func (in *DeviceRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "name":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.Name = l.String()
+			}
+		case "exactly":
+			if l.IsNull() {
+				l.Skip()
+				in.Exactly = nil
+			} else {
+				if in.Exactly == nil {
+					in.Exactly = new(ExactDeviceRequest)
+				}
+				in.Exactly.UnmarshalEasyJSON(l)
+			}
+		case "firstAvailable":
+			if l.IsNull() {
+				l.Skip()
+				in.FirstAvailable = nil
+			} else {
+				in.FirstAvailable = make([]DeviceSubRequest, 0)
+				l.Delim('[')
+				for !l.IsDelim(']') {
+					var v0 DeviceSubRequest
+					if l.IsNull() {
+						l.Skip()
+					} else {
+						v0.UnmarshalEasyJSON(l)
+					}
+					in.FirstAvailable = append(in.FirstAvailable, v0)
+					l.WantComma()
+				}
+				l.Delim(']')
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
+
 type DeviceConstraint struct {
 	// Requests is a list of the one or more requests in this claim which
 	// must co-satisfy this constraint. If a request is fulfilled by
@@ -1196,6 +4431,97 @@ func (in *DeviceConstraint) DeepCopy() *DeviceConstraint {
 	return out
 }
 
+func (in *DeviceConstraint) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *DeviceConstraint) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *DeviceConstraint) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	w.RawString("\"requests\":")
+	if in.Requests == nil {
+		w.RawString("null")
+	} else {
+		w.RawByte('[')
+		for i0 := range in.Requests {
+			if i0 > 0 {
+				w.RawByte(',')
+			}
+			w.String(in.Requests[i0])
+		}
+		w.RawByte(']')
+	}
+	w.RawString(",\"matchAttribute\":")
+	w.String(in.MatchAttribute)
+	w.RawString(",\"distinctAttribute\":")
+	w.String(in.DistinctAttribute)
+
+	w.RawByte('}')
+}
+
+func (in *DeviceConstraint) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "requests":
+			if l.IsNull() {
+				l.Skip()
+				in.Requests = nil
+			} else {
+				in.Requests = make([]string, 0)
+				l.Delim('[')
+				for !l.IsDelim(']') {
+					var v0 string
+					if l.IsNull() {
+						l.Skip()
+					} else {
+						v0 = l.String()
+					}
+					in.Requests = append(in.Requests, v0)
+					l.WantComma()
+				}
+				l.Delim(']')
+			}
+		case "matchAttribute":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.MatchAttribute = l.String()
+			}
+		case "distinctAttribute":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.DistinctAttribute = l.String()
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
+
 type DeviceClaimConfiguration struct {
 	// Requests lists the names of requests where the configuration applies.
 	// If empty, it applies to all requests.
@@ -1225,6 +4551,95 @@ func (in *DeviceClaimConfiguration) DeepCopy() *DeviceClaimConfiguration {
 	return out
 }
 
+func (in *DeviceClaimConfiguration) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *DeviceClaimConfiguration) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *DeviceClaimConfiguration) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	w.RawString("\"requests\":")
+	if in.Requests == nil {
+		w.RawString("null")
+	} else {
+		w.RawByte('[')
+		for i0 := range in.Requests {
+			if i0 > 0 {
+				w.RawByte(',')
+			}
+			w.String(in.Requests[i0])
+		}
+		w.RawByte(']')
+	}
+	if in.DeviceConfiguration.Opaque != nil {
+		w.RawString(",\"opaque\":")
+		in.DeviceConfiguration.Opaque.MarshalEasyJSON(w)
+	}
+
+	w.RawByte('}')
+}
+
+func (in *DeviceClaimConfiguration) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "requests":
+			if l.IsNull() {
+				l.Skip()
+				in.Requests = nil
+			} else {
+				in.Requests = make([]string, 0)
+				l.Delim('[')
+				for !l.IsDelim(']') {
+					var v0 string
+					if l.IsNull() {
+						l.Skip()
+					} else {
+						v0 = l.String()
+					}
+					in.Requests = append(in.Requests, v0)
+					l.WantComma()
+				}
+				l.Delim(']')
+			}
+		case "opaque":
+			if l.IsNull() {
+				l.Skip()
+				in.DeviceConfiguration.Opaque = nil
+			} else {
+				if in.DeviceConfiguration.Opaque == nil {
+					in.DeviceConfiguration.Opaque = new(OpaqueDeviceConfiguration)
+				}
+				in.DeviceConfiguration.Opaque.UnmarshalEasyJSON(l)
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
+
 type DeviceAllocationResult struct {
 	// Results lists all allocated devices.
 	Results []DeviceRequestAllocationResult `json:"results"`
@@ -1264,6 +4679,113 @@ func (in *DeviceAllocationResult) DeepCopy() *DeviceAllocationResult {
 	return out
 }
 
+func (in *DeviceAllocationResult) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *DeviceAllocationResult) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *DeviceAllocationResult) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	w.RawString("\"results\":")
+	if in.Results == nil {
+		w.RawString("null")
+	} else {
+		w.RawByte('[')
+		for i0 := range in.Results {
+			if i0 > 0 {
+				w.RawByte(',')
+			}
+			in.Results[i0].MarshalEasyJSON(w)
+		}
+		w.RawByte(']')
+	}
+	w.RawString(",\"config\":")
+	if in.Config == nil {
+		w.RawString("null")
+	} else {
+		w.RawByte('[')
+		for i0 := range in.Config {
+			if i0 > 0 {
+				w.RawByte(',')
+			}
+			in.Config[i0].MarshalEasyJSON(w)
+		}
+		w.RawByte(']')
+	}
+
+	w.RawByte('}')
+}
+
+func (in *DeviceAllocationResult) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "results":
+			if l.IsNull() {
+				l.Skip()
+				in.Results = nil
+			} else {
+				in.Results = make([]DeviceRequestAllocationResult, 0)
+				l.Delim('[')
+				for !l.IsDelim(']') {
+					var v0 DeviceRequestAllocationResult
+					if l.IsNull() {
+						l.Skip()
+					} else {
+						v0.UnmarshalEasyJSON(l)
+					}
+					in.Results = append(in.Results, v0)
+					l.WantComma()
+				}
+				l.Delim(']')
+			}
+		case "config":
+			if l.IsNull() {
+				l.Skip()
+				in.Config = nil
+			} else {
+				in.Config = make([]DeviceAllocationConfiguration, 0)
+				l.Delim('[')
+				for !l.IsDelim(']') {
+					var v0 DeviceAllocationConfiguration
+					if l.IsNull() {
+						l.Skip()
+					} else {
+						v0.UnmarshalEasyJSON(l)
+					}
+					in.Config = append(in.Config, v0)
+					l.WantComma()
+				}
+				l.Delim(']')
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
+
 type NetworkDeviceData struct {
 	// InterfaceName specifies the name of the network interface associated with
 	// the allocated device. This might be the name of a physical or virtual
@@ -1299,6 +4821,105 @@ func (in *NetworkDeviceData) DeepCopy() *NetworkDeviceData {
 	return out
 }
 
+func (in *NetworkDeviceData) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *NetworkDeviceData) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *NetworkDeviceData) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	first := true
+	if in.InterfaceName != "" {
+		w.RawString("\"interfaceName\":")
+		w.String(in.InterfaceName)
+		first = false
+	}
+	if !first {
+		w.RawByte(',')
+	}
+	w.RawString("\"ips\":")
+	if in.IPs == nil {
+		w.RawString("null")
+	} else {
+		w.RawByte('[')
+		for i0 := range in.IPs {
+			if i0 > 0 {
+				w.RawByte(',')
+			}
+			w.String(in.IPs[i0])
+		}
+		w.RawByte(']')
+	}
+	if in.HardwareAddress != "" {
+		w.RawString(",\"hardwareAddress\":")
+		w.String(in.HardwareAddress)
+	}
+	w.RawByte('}')
+}
+
+func (in *NetworkDeviceData) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "interfaceName":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.InterfaceName = l.String()
+			}
+		case "ips":
+			if l.IsNull() {
+				l.Skip()
+				in.IPs = nil
+			} else {
+				in.IPs = make([]string, 0)
+				l.Delim('[')
+				for !l.IsDelim(']') {
+					var v0 string
+					if l.IsNull() {
+						l.Skip()
+					} else {
+						v0 = l.String()
+					}
+					in.IPs = append(in.IPs, v0)
+					l.WantComma()
+				}
+				l.Delim(']')
+			}
+		case "hardwareAddress":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.HardwareAddress = l.String()
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
+
 type DeviceAttribute struct {
 	// IntValue is a number.
 	IntValue int64 `json:"int,omitempty"`
@@ -1341,20 +4962,247 @@ func (in *DeviceAttribute) DeepCopyInto(out *DeviceAttribute) {
 		copy(t, in.StringValues)
 		out.StringValues = t
 	}
-	if in.VersionValues != nil {
-		t := make([]string, len(in.VersionValues))
-		copy(t, in.VersionValues)
-		out.VersionValues = t
+	if in.VersionValues != nil {
+		t := make([]string, len(in.VersionValues))
+		copy(t, in.VersionValues)
+		out.VersionValues = t
+	}
+}
+
+func (in *DeviceAttribute) DeepCopy() *DeviceAttribute {
+	if in == nil {
+		return nil
+	}
+	out := new(DeviceAttribute)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DeviceAttribute) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *DeviceAttribute) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *DeviceAttribute) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	first := true
+	if in.IntValue != 0 {
+		w.RawString("\"int\":")
+		w.Int64(in.IntValue)
+		first = false
+	}
+	if in.BoolValue {
+		if !first {
+			w.RawByte(',')
+		}
+		w.RawString("\"bool\":")
+		w.Bool(in.BoolValue)
+		first = false
+	}
+	if in.StringValue != "" {
+		if !first {
+			w.RawByte(',')
+		}
+		w.RawString("\"string\":")
+		w.String(in.StringValue)
+		first = false
+	}
+	if in.VersionValue != "" {
+		if !first {
+			w.RawByte(',')
+		}
+		w.RawString("\"version\":")
+		w.String(in.VersionValue)
+		first = false
+	}
+	if !first {
+		w.RawByte(',')
+	}
+	w.RawString("\"ints\":")
+	if in.IntValues == nil {
+		w.RawString("null")
+	} else {
+		w.RawByte('[')
+		for i0 := range in.IntValues {
+			if i0 > 0 {
+				w.RawByte(',')
+			}
+			w.Int64(in.IntValues[i0])
+		}
+		w.RawByte(']')
+	}
+	w.RawString(",\"bools\":")
+	if in.BoolValues == nil {
+		w.RawString("null")
+	} else {
+		w.RawByte('[')
+		for i0 := range in.BoolValues {
+			if i0 > 0 {
+				w.RawByte(',')
+			}
+			w.Bool(in.BoolValues[i0])
+		}
+		w.RawByte(']')
+	}
+	w.RawString(",\"strings\":")
+	if in.StringValues == nil {
+		w.RawString("null")
+	} else {
+		w.RawByte('[')
+		for i0 := range in.StringValues {
+			if i0 > 0 {
+				w.RawByte(',')
+			}
+			w.String(in.StringValues[i0])
+		}
+		w.RawByte(']')
+	}
+	w.RawString(",\"versions\":")
+	if in.VersionValues == nil {
+		w.RawString("null")
+	} else {
+		w.RawByte('[')
+		for i0 := range in.VersionValues {
+			if i0 > 0 {
+				w.RawByte(',')
+			}
+			w.String(in.VersionValues[i0])
+		}
+		w.RawByte(']')
 	}
+	w.RawByte('}')
 }
 
-func (in *DeviceAttribute) DeepCopy() *DeviceAttribute {
-	if in == nil {
-		return nil
+func (in *DeviceAttribute) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "int":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.IntValue = l.Int64()
+			}
+		case "bool":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.BoolValue = l.Bool()
+			}
+		case "string":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.StringValue = l.String()
+			}
+		case "version":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.VersionValue = l.String()
+			}
+		case "ints":
+			if l.IsNull() {
+				l.Skip()
+				in.IntValues = nil
+			} else {
+				in.IntValues = make([]int64, 0)
+				l.Delim('[')
+				for !l.IsDelim(']') {
+					var v0 int64
+					if l.IsNull() {
+						l.Skip()
+					} else {
+						v0 = l.Int64()
+					}
+					in.IntValues = append(in.IntValues, v0)
+					l.WantComma()
+				}
+				l.Delim(']')
+			}
+		case "bools":
+			if l.IsNull() {
+				l.Skip()
+				in.BoolValues = nil
+			} else {
+				in.BoolValues = make([]bool, 0)
+				l.Delim('[')
+				for !l.IsDelim(']') {
+					var v0 bool
+					if l.IsNull() {
+						l.Skip()
+					} else {
+						v0 = l.Bool()
+					}
+					in.BoolValues = append(in.BoolValues, v0)
+					l.WantComma()
+				}
+				l.Delim(']')
+			}
+		case "strings":
+			if l.IsNull() {
+				l.Skip()
+				in.StringValues = nil
+			} else {
+				in.StringValues = make([]string, 0)
+				l.Delim('[')
+				for !l.IsDelim(']') {
+					var v0 string
+					if l.IsNull() {
+						l.Skip()
+					} else {
+						v0 = l.String()
+					}
+					in.StringValues = append(in.StringValues, v0)
+					l.WantComma()
+				}
+				l.Delim(']')
+			}
+		case "versions":
+			if l.IsNull() {
+				l.Skip()
+				in.VersionValues = nil
+			} else {
+				in.VersionValues = make([]string, 0)
+				l.Delim('[')
+				for !l.IsDelim(']') {
+					var v0 string
+					if l.IsNull() {
+						l.Skip()
+					} else {
+						v0 = l.String()
+					}
+					in.VersionValues = append(in.VersionValues, v0)
+					l.WantComma()
+				}
+				l.Delim(']')
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
 	}
-	out := new(DeviceAttribute)
-	in.DeepCopyInto(out)
-	return out
 }
 
 type DeviceCapacity struct {
@@ -1392,6 +5240,67 @@ func (in *DeviceCapacity) DeepCopy() *DeviceCapacity {
 	return out
 }
 
+func (in *DeviceCapacity) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *DeviceCapacity) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *DeviceCapacity) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	w.RawString("\"value\":")
+	w.Raw(json.Marshal(&in.Value))
+	if in.RequestPolicy != nil {
+		w.RawString(",\"requestPolicy\":")
+		in.RequestPolicy.MarshalEasyJSON(w)
+	}
+
+	w.RawByte('}')
+}
+
+func (in *DeviceCapacity) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "value":
+			l.AddError(json.Unmarshal(l.Raw(), &in.Value))
+		case "requestPolicy":
+			if l.IsNull() {
+				l.Skip()
+				in.RequestPolicy = nil
+			} else {
+				if in.RequestPolicy == nil {
+					in.RequestPolicy = new(CapacityRequestPolicy)
+				}
+				in.RequestPolicy.UnmarshalEasyJSON(l)
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
+
 type DeviceCounterConsumption struct {
 	// CounterSet is the name of the set from which the
 	// counters defined will be consumed.
@@ -1421,6 +5330,98 @@ func (in *DeviceCounterConsumption) DeepCopy() *DeviceCounterConsumption {
 	return out
 }
 
+func (in *DeviceCounterConsumption) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *DeviceCounterConsumption) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *DeviceCounterConsumption) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	w.RawString("\"counterSet\":")
+	w.String(in.CounterSet)
+	if len(in.Counters) != 0 {
+		w.RawString(",\"counters\":")
+		if in.Counters == nil {
+			w.RawString("null")
+		} else {
+			w.RawByte('{')
+			for i0, k0 := range slices.Sorted(maps.Keys(in.Counters)) {
+				if i0 > 0 {
+					w.RawByte(',')
+				}
+				w.String(k0)
+				w.RawByte(':')
+				v0 := in.Counters[k0]
+				v0.MarshalEasyJSON(w)
+			}
+			w.RawByte('}')
+		}
+	}
+
+	w.RawByte('}')
+}
+
+func (in *DeviceCounterConsumption) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "counterSet":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.CounterSet = l.String()
+			}
+		case "counters":
+			if l.IsNull() {
+				l.Skip()
+				in.Counters = nil
+			} else {
+				if in.Counters == nil {
+					in.Counters = make(map[string]Counter)
+				}
+				l.Delim('{')
+				for !l.IsDelim('}') {
+					k0 := l.String()
+					l.WantColon()
+					var v0 Counter
+					if l.IsNull() {
+						l.Skip()
+					} else {
+						v0.UnmarshalEasyJSON(l)
+					}
+					in.Counters[k0] = v0
+					l.WantComma()
+				}
+				l.Delim('}')
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
+
 type DeviceTaint struct {
 	// The taint key to be applied to a device.
 	// Must be a label name.
@@ -1465,6 +5466,81 @@ func (in *DeviceTaint) DeepCopy() *DeviceTaint {
 	return out
 }
 
+func (in *DeviceTaint) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *DeviceTaint) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *DeviceTaint) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	w.RawString("\"key\":")
+	w.String(in.Key)
+	if in.Value != "" {
+		w.RawString(",\"value\":")
+		w.String(in.Value)
+	}
+	w.RawString(",\"effect\":")
+	w.String(string(in.Effect))
+	if in.TimeAdded != nil {
+		w.RawString(",\"timeAdded\":")
+		w.Raw(json.Marshal(in.TimeAdded))
+	}
+
+	w.RawByte('}')
+}
+
+func (in *DeviceTaint) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "key":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.Key = l.String()
+			}
+		case "value":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.Value = l.String()
+			}
+		case "effect":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.Effect = DeviceTaintEffect(l.String())
+			}
+		case "timeAdded":
+			l.AddError(json.Unmarshal(l.Raw(), &in.TimeAdded))
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
+
 type NodeAllocatableResourceMapping struct {
 	// CapacityKey references a capacity name defined as a key in the
 	// `spec.devices[*].capacity` map. When this field is set, the value associated with
@@ -1515,6 +5591,63 @@ func (in *NodeAllocatableResourceMapping) DeepCopy() *NodeAllocatableResourceMap
 	return out
 }
 
+func (in *NodeAllocatableResourceMapping) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *NodeAllocatableResourceMapping) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *NodeAllocatableResourceMapping) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	w.RawString("\"capacityKey\":")
+	w.String(in.CapacityKey)
+	if in.AllocationMultiplier != nil {
+		w.RawString(",\"allocationMultiplier\":")
+		w.Raw(json.Marshal(in.AllocationMultiplier))
+	}
+
+	w.RawByte('}')
+}
+
+func (in *NodeAllocatableResourceMapping) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "capacityKey":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.CapacityKey = l.String()
+			}
+		case "allocationMultiplier":
+			l.AddError(json.Unmarshal(l.Raw(), &in.AllocationMultiplier))
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
+
 type Counter struct {
 	// Value defines how much of a certain device counter is available.
 	Value apiresource.Quantity `json:"value"`
@@ -1534,6 +5667,53 @@ func (in *Counter) DeepCopy() *Counter {
 	return out
 }
 
+func (in *Counter) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *Counter) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *Counter) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	w.RawString("\"value\":")
+	w.Raw(json.Marshal(&in.Value))
+
+	w.RawByte('}')
+}
+
+func (in *Counter) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "value":
+			l.AddError(json.Unmarshal(l.Raw(), &in.Value))
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
+
 type OpaqueDeviceConfiguration struct {
 	// Driver is used to determine which kubelet plugin needs
 	// to be passed these configuration parameters.
@@ -1564,6 +5744,61 @@ func (in *OpaqueDeviceConfiguration) DeepCopy() *OpaqueDeviceConfiguration {
 	return out
 }
 
+func (in *OpaqueDeviceConfiguration) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *OpaqueDeviceConfiguration) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *OpaqueDeviceConfiguration) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	w.RawString("\"driver\":")
+	w.String(in.Driver)
+	w.RawString(",\"parameters\":")
+	w.Raw(json.Marshal(&in.Parameters))
+
+	w.RawByte('}')
+}
+
+func (in *OpaqueDeviceConfiguration) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "driver":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.Driver = l.String()
+			}
+		case "parameters":
+			l.AddError(json.Unmarshal(l.Raw(), &in.Parameters))
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
+
 type ExactDeviceRequest struct {
 	// DeviceClassName references a specific DeviceClass, which can define
 	// additional configuration and selectors to be inherited by this
@@ -1665,6 +5900,165 @@ func (in *ExactDeviceRequest) DeepCopy() *ExactDeviceRequest {
 	return out
 }
 
+func (in *ExactDeviceRequest) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *ExactDeviceRequest) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *ExactDeviceRequest) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	w.RawString("\"deviceClassName\":")
+	w.String(in.DeviceClassName)
+	w.RawString(",\"selectors\":")
+	if in.Selectors == nil {
+		w.RawString("null")
+	} else {
+		w.RawByte('[')
+		for i0 := range in.Selectors {
+			if i0 > 0 {
+				w.RawByte(',')
+			}
+			in.Selectors[i0].MarshalEasyJSON(w)
+		}
+		w.RawByte(']')
+	}
+	if in.AllocationMode != "" {
+		w.RawString(",\"allocationMode\":")
+		w.String(string(in.AllocationMode))
+	}
+	if in.Count != 0 {
+		w.RawString(",\"count\":")
+		w.Int64(in.Count)
+	}
+	if in.AdminAccess {
+		w.RawString(",\"adminAccess\":")
+		w.Bool(in.AdminAccess)
+	}
+	w.RawString(",\"tolerations\":")
+	if in.Tolerations == nil {
+		w.RawString("null")
+	} else {
+		w.RawByte('[')
+		for i0 := range in.Tolerations {
+			if i0 > 0 {
+				w.RawByte(',')
+			}
+			in.Tolerations[i0].MarshalEasyJSON(w)
+		}
+		w.RawByte(']')
+	}
+	if in.Capacity != nil {
+		w.RawString(",\"capacity\":")
+		in.Capacity.MarshalEasyJSON(w)
+	}
+
+	w.RawByte('}')
+}
+
+func (in *ExactDeviceRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "deviceClassName":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.DeviceClassName = l.String()
+			}
+		case "selectors":
+			if l.IsNull() {
+				l.Skip()
+				in.Selectors = nil
+			} else {
+				in.Selectors = make([]DeviceSelector, 0)
+				l.Delim('[')
+				for !l.IsDelim(']') {
+					var v0 DeviceSelector
+					if l.IsNull() {
+						l.Skip()
+					} else {
+						v0.UnmarshalEasyJSON(l)
+					}
+					in.Selectors = append(in.Selectors, v0)
+					l.WantComma()
+				}
+				l.Delim(']')
+			}
+		case "allocationMode":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.AllocationMode = DeviceAllocationMode(l.String())
+			}
+		case "count":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.Count = l.Int64()
+			}
+		case "adminAccess":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.AdminAccess = l.Bool()
+			}
+		case "tolerations":
+			if l.IsNull() {
+				l.Skip()
+				in.Tolerations = nil
+			} else {
+				in.Tolerations = make([]DeviceToleration, 0)
+				l.Delim('[')
+				for !l.IsDelim(']') {
+					var v0 DeviceToleration
+					if l.IsNull() {
+						l.Skip()
+					} else {
+						v0.UnmarshalEasyJSON(l)
+					}
+					in.Tolerations = append(in.Tolerations, v0)
+					l.WantComma()
+				}
+				l.Delim(']')
+			}
+		case "capacity":
+			if l.IsNull() {
+				l.Skip()
+				in.Capacity = nil
+			} else {
+				if in.Capacity == nil {
+					in.Capacity = new(CapacityRequirements)
+				}
+				in.Capacity.UnmarshalEasyJSON(l)
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
+
 type DeviceSubRequest struct {
 	// Name can be used to reference this subrequest in the list of constraints
 	// or the list of configurations for the claim. References must use the
@@ -1762,6 +6156,163 @@ func (in *DeviceSubRequest) DeepCopy() *DeviceSubRequest {
 	return out
 }
 
+func (in *DeviceSubRequest) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *DeviceSubRequest) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *DeviceSubRequest) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	w.RawString("\"name\":")
+	w.String(in.Name)
+	w.RawString(",\"deviceClassName\":")
+	w.String(in.DeviceClassName)
+	w.RawString(",\"selectors\":")
+	if in.Selectors == nil {
+		w.RawString("null")
+	} else {
+		w.RawByte('[')
+		for i0 := range in.Selectors {
+			if i0 > 0 {
+				w.RawByte(',')
+			}
+			in.Selectors[i0].MarshalEasyJSON(w)
+		}
+		w.RawByte(']')
+	}
+	if in.AllocationMode != "" {
+		w.RawString(",\"allocationMode\":")
+		w.String(string(in.AllocationMode))
+	}
+	if in.Count != 0 {
+		w.RawString(",\"count\":")
+		w.Int64(in.Count)
+	}
+	w.RawString(",\"tolerations\":")
+	if in.Tolerations == nil {
+		w.RawString("null")
+	} else {
+		w.RawByte('[')
+		for i0 := range in.Tolerations {
+			if i0 > 0 {
+				w.RawByte(',')
+			}
+			in.Tolerations[i0].MarshalEasyJSON(w)
+		}
+		w.RawByte(']')
+	}
+	if in.Capacity != nil {
+		w.RawString(",\"capacity\":")
+		in.Capacity.MarshalEasyJSON(w)
+	}
+
+	w.RawByte('}')
+}
+
+func (in *DeviceSubRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "name":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.Name = l.String()
+			}
+		case "deviceClassName":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.DeviceClassName = l.String()
+			}
+		case "selectors":
+			if l.IsNull() {
+				l.Skip()
+				in.Selectors = nil
+			} else {
+				in.Selectors = make([]DeviceSelector, 0)
+				l.Delim('[')
+				for !l.IsDelim(']') {
+					var v0 DeviceSelector
+					if l.IsNull() {
+						l.Skip()
+					} else {
+						v0.UnmarshalEasyJSON(l)
+					}
+					in.Selectors = append(in.Selectors, v0)
+					l.WantComma()
+				}
+				l.Delim(']')
+			}
+		case "allocationMode":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.AllocationMode = DeviceAllocationMode(l.String())
+			}
+		case "count":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.Count = l.Int64()
+			}
+		case "tolerations":
+			if l.IsNull() {
+				l.Skip()
+				in.Tolerations = nil
+			} else {
+				in.Tolerations = make([]DeviceToleration, 0)
+				l.Delim('[')
+				for !l.IsDelim(']') {
+					var v0 DeviceToleration
+					if l.IsNull() {
+						l.Skip()
+					} else {
+						v0.UnmarshalEasyJSON(l)
+					}
+					in.Tolerations = append(in.Tolerations, v0)
+					l.WantComma()
+				}
+				l.Delim(']')
+			}
+		case "capacity":
+			if l.IsNull() {
+				l.Skip()
+				in.Capacity = nil
+			} else {
+				if in.Capacity == nil {
+					in.Capacity = new(CapacityRequirements)
+				}
+				in.Capacity.UnmarshalEasyJSON(l)
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
+
 type DeviceRequestAllocationResult struct {
 	// Request is the name of the request in the claim which caused this
 	// device to be allocated. If it references a subrequest in the
@@ -1857,6 +6408,234 @@ func (in *DeviceRequestAllocationResult) DeepCopy() *DeviceRequestAllocationResu
 	return out
 }
 
+func (in *DeviceRequestAllocationResult) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *DeviceRequestAllocationResult) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *DeviceRequestAllocationResult) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	w.RawString("\"request\":")
+	w.String(in.Request)
+	w.RawString(",\"driver\":")
+	w.String(in.Driver)
+	w.RawString(",\"pool\":")
+	w.String(in.Pool)
+	w.RawString(",\"device\":")
+	w.String(in.Device)
+	if in.AdminAccess {
+		w.RawString(",\"adminAccess\":")
+		w.Bool(in.AdminAccess)
+	}
+	w.RawString(",\"tolerations\":")
+	if in.Tolerations == nil {
+		w.RawString("null")
+	} else {
+		w.RawByte('[')
+		for i0 := range in.Tolerations {
+			if i0 > 0 {
+				w.RawByte(',')
+			}
+			in.Tolerations[i0].MarshalEasyJSON(w)
+		}
+		w.RawByte(']')
+	}
+	w.RawString(",\"bindingConditions\":")
+	if in.BindingConditions == nil {
+		w.RawString("null")
+	} else {
+		w.RawByte('[')
+		for i0 := range in.BindingConditions {
+			if i0 > 0 {
+				w.RawByte(',')
+			}
+			w.String(in.BindingConditions[i0])
+		}
+		w.RawByte(']')
+	}
+	w.RawString(",\"bindingFailureConditions\":")
+	if in.BindingFailureConditions == nil {
+		w.RawString("null")
+	} else {
+		w.RawByte('[')
+		for i0 := range in.BindingFailureConditions {
+			if i0 > 0 {
+				w.RawByte(',')
+			}
+			w.String(in.BindingFailureConditions[i0])
+		}
+		w.RawByte(']')
+	}
+	if in.ShareID != "" {
+		w.RawString(",\"shareID\":")
+		w.String(in.ShareID)
+	}
+	if len(in.ConsumedCapacity) != 0 {
+		w.RawString(",\"consumedCapacity\":")
+		if in.ConsumedCapacity == nil {
+			w.RawString("null")
+		} else {
+			w.RawByte('{')
+			for i0, k0 := range slices.Sorted(maps.Keys(in.ConsumedCapacity)) {
+				if i0 > 0 {
+					w.RawByte(',')
+				}
+				w.String(k0)
+				w.RawByte(':')
+				v0 := in.ConsumedCapacity[k0]
+				w.Raw(json.Marshal(&v0))
+			}
+			w.RawByte('}')
+		}
+	}
+
+	w.RawByte('}')
+}
+
+func (in *DeviceRequestAllocationResult) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "request":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.Request = l.String()
+			}
+		case "driver":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.Driver = l.String()
+			}
+		case "pool":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.Pool = l.String()
+			}
+		case "device":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.Device = l.String()
+			}
+		case "adminAccess":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.AdminAccess = l.Bool()
+			}
+		case "tolerations":
+			if l.IsNull() {
+				l.Skip()
+				in.Tolerations = nil
+			} else {
+				in.Tolerations = make([]DeviceToleration, 0)
+				l.Delim('[')
+				for !l.IsDelim(']') {
+					var v0 DeviceToleration
+					if l.IsNull() {
+						l.Skip()
+					} else {
+						v0.UnmarshalEasyJSON(l)
+					}
+					in.Tolerations = append(in.Tolerations, v0)
+					l.WantComma()
+				}
+				l.Delim(']')
+			}
+		case "bindingConditions":
+			if l.IsNull() {
+				l.Skip()
+				in.BindingConditions = nil
+			} else {
+				in.BindingConditions = make([]string, 0)
+				l.Delim('[')
+				for !l.IsDelim(']') {
+					var v0 string
+					if l.IsNull() {
+						l.Skip()
+					} else {
+						v0 = l.String()
+					}
+					in.BindingConditions = append(in.BindingConditions, v0)
+					l.WantComma()
+				}
+				l.Delim(']')
+			}
+		case "bindingFailureConditions":
+			if l.IsNull() {
+				l.Skip()
+				in.BindingFailureConditions = nil
+			} else {
+				in.BindingFailureConditions = make([]string, 0)
+				l.Delim('[')
+				for !l.IsDelim(']') {
+					var v0 string
+					if l.IsNull() {
+						l.Skip()
+					} else {
+						v0 = l.String()
+					}
+					in.BindingFailureConditions = append(in.BindingFailureConditions, v0)
+					l.WantComma()
+				}
+				l.Delim(']')
+			}
+		case "shareID":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.ShareID = l.String()
+			}
+		case "consumedCapacity":
+			if l.IsNull() {
+				l.Skip()
+				in.ConsumedCapacity = nil
+			} else {
+				if in.ConsumedCapacity == nil {
+					in.ConsumedCapacity = make(map[string]apiresource.Quantity)
+				}
+				l.Delim('{')
+				for !l.IsDelim('}') {
+					k0 := l.String()
+					l.WantColon()
+					var v0 apiresource.Quantity
+					l.AddError(json.Unmarshal(l.Raw(), &v0))
+					in.ConsumedCapacity[k0] = v0
+					l.WantComma()
+				}
+				l.Delim('}')
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
+
 type DeviceAllocationConfiguration struct {
 	// Source records whether the configuration comes from a class and thus
 	// is not something that a normal user would have been able to set
@@ -1890,6 +6669,103 @@ func (in *DeviceAllocationConfiguration) DeepCopy() *DeviceAllocationConfigurati
 	return out
 }
 
+func (in *DeviceAllocationConfiguration) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *DeviceAllocationConfiguration) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *DeviceAllocationConfiguration) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	w.RawString("\"source\":")
+	w.String(string(in.Source))
+	w.RawString(",\"requests\":")
+	if in.Requests == nil {
+		w.RawString("null")
+	} else {
+		w.RawByte('[')
+		for i0 := range in.Requests {
+			if i0 > 0 {
+				w.RawByte(',')
+			}
+			w.String(in.Requests[i0])
+		}
+		w.RawByte(']')
+	}
+	if in.DeviceConfiguration.Opaque != nil {
+		w.RawString(",\"opaque\":")
+		in.DeviceConfiguration.Opaque.MarshalEasyJSON(w)
+	}
+
+	w.RawByte('}')
+}
+
+func (in *DeviceAllocationConfiguration) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "source":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.Source = AllocationConfigSource(l.String())
+			}
+		case "requests":
+			if l.IsNull() {
+				l.Skip()
+				in.Requests = nil
+			} else {
+				in.Requests = make([]string, 0)
+				l.Delim('[')
+				for !l.IsDelim(']') {
+					var v0 string
+					if l.IsNull() {
+						l.Skip()
+					} else {
+						v0 = l.String()
+					}
+					in.Requests = append(in.Requests, v0)
+					l.WantComma()
+				}
+				l.Delim(']')
+			}
+		case "opaque":
+			if l.IsNull() {
+				l.Skip()
+				in.DeviceConfiguration.Opaque = nil
+			} else {
+				if in.DeviceConfiguration.Opaque == nil {
+					in.DeviceConfiguration.Opaque = new(OpaqueDeviceConfiguration)
+				}
+				in.DeviceConfiguration.Opaque.UnmarshalEasyJSON(l)
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
+
 type CapacityRequestPolicy struct {
 	// Default specifies how much of this capacity is consumed by a request
 	// that does not contain an entry for it in DeviceRequest's Capacity.
@@ -1944,6 +6820,101 @@ func (in *CapacityRequestPolicy) DeepCopy() *CapacityRequestPolicy {
 	return out
 }
 
+func (in *CapacityRequestPolicy) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *CapacityRequestPolicy) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *CapacityRequestPolicy) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	first := true
+	if in.Default != nil {
+		w.RawString("\"default\":")
+		w.Raw(json.Marshal(in.Default))
+		first = false
+	}
+	if !first {
+		w.RawByte(',')
+	}
+	w.RawString("\"validValues\":")
+	if in.ValidValues == nil {
+		w.RawString("null")
+	} else {
+		w.RawByte('[')
+		for i0 := range in.ValidValues {
+			if i0 > 0 {
+				w.RawByte(',')
+			}
+			w.Raw(json.Marshal(&in.ValidValues[i0]))
+		}
+		w.RawByte(']')
+	}
+	if in.ValidRange != nil {
+		w.RawString(",\"validRange\":")
+		in.ValidRange.MarshalEasyJSON(w)
+	}
+	w.RawByte('}')
+}
+
+func (in *CapacityRequestPolicy) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "default":
+			l.AddError(json.Unmarshal(l.Raw(), &in.Default))
+		case "validValues":
+			if l.IsNull() {
+				l.Skip()
+				in.ValidValues = nil
+			} else {
+				in.ValidValues = make([]apiresource.Quantity, 0)
+				l.Delim('[')
+				for !l.IsDelim(']') {
+					var v0 apiresource.Quantity
+					l.AddError(json.Unmarshal(l.Raw(), &v0))
+					in.ValidValues = append(in.ValidValues, v0)
+					l.WantComma()
+				}
+				l.Delim(']')
+			}
+		case "validRange":
+			if l.IsNull() {
+				l.Skip()
+				in.ValidRange = nil
+			} else {
+				if in.ValidRange == nil {
+					in.ValidRange = new(CapacityRequestPolicyRange)
+				}
+				in.ValidRange.UnmarshalEasyJSON(l)
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
+
 type DeviceToleration struct {
 	// Key is the taint key that the toleration applies to. Empty means match all taint keys.
 	// If the key is empty, operator must be Exists; this combination means to match all values and all keys.
@@ -1983,6 +6954,116 @@ func (in *DeviceToleration) DeepCopy() *DeviceToleration {
 	return out
 }
 
+func (in *DeviceToleration) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *DeviceToleration) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *DeviceToleration) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	first := true
+	if in.Key != "" {
+		w.RawString("\"key\":")
+		w.String(in.Key)
+		first = false
+	}
+	if in.Operator != "" {
+		if !first {
+			w.RawByte(',')
+		}
+		w.RawString("\"operator\":")
+		w.String(string(in.Operator))
+		first = false
+	}
+	if in.Value != "" {
+		if !first {
+			w.RawByte(',')
+		}
+		w.RawString("\"value\":")
+		w.String(in.Value)
+		first = false
+	}
+	if in.Effect != "" {
+		if !first {
+			w.RawByte(',')
+		}
+		w.RawString("\"effect\":")
+		w.String(string(in.Effect))
+		first = false
+	}
+	if in.TolerationSeconds != 0 {
+		if !first {
+			w.RawByte(',')
+		}
+		w.RawString("\"tolerationSeconds\":")
+		w.Int64(in.TolerationSeconds)
+		first = false
+	}
+	w.RawByte('}')
+}
+
+func (in *DeviceToleration) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "key":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.Key = l.String()
+			}
+		case "operator":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.Operator = DeviceTolerationOperator(l.String())
+			}
+		case "value":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.Value = l.String()
+			}
+		case "effect":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.Effect = DeviceTaintEffect(l.String())
+			}
+		case "tolerationSeconds":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.TolerationSeconds = l.Int64()
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
+
 type CapacityRequirements struct {
 	// Requests represent individual device resource requests for distinct resources,
 	// all of which must be provided by the device.
@@ -2025,6 +7106,86 @@ func (in *CapacityRequirements) DeepCopy() *CapacityRequirements {
 	return out
 }
 
+func (in *CapacityRequirements) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *CapacityRequirements) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *CapacityRequirements) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	if len(in.Requests) != 0 {
+		w.RawString("\"requests\":")
+		if in.Requests == nil {
+			w.RawString("null")
+		} else {
+			w.RawByte('{')
+			for i0, k0 := range slices.Sorted(maps.Keys(in.Requests)) {
+				if i0 > 0 {
+					w.RawByte(',')
+				}
+				w.String(k0)
+				w.RawByte(':')
+				v0 := in.Requests[k0]
+				w.Raw(json.Marshal(&v0))
+			}
+			w.RawByte('}')
+		}
+	}
+
+	w.RawByte('}')
+}
+
+func (in *CapacityRequirements) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "requests":
+			if l.IsNull() {
+				l.Skip()
+				in.Requests = nil
+			} else {
+				if in.Requests == nil {
+					in.Requests = make(map[string]apiresource.Quantity)
+				}
+				l.Delim('{')
+				for !l.IsDelim('}') {
+					k0 := l.String()
+					l.WantColon()
+					var v0 apiresource.Quantity
+					l.AddError(json.Unmarshal(l.Raw(), &v0))
+					in.Requests[k0] = v0
+					l.WantComma()
+				}
+				l.Delim('}')
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
+
 type CapacityRequestPolicyRange struct {
 	// Min specifies the minimum capacity allowed for a consumption request.
 	// Min must be greater than or equal to zero,
@@ -2068,3 +7229,73 @@ func (in *CapacityRequestPolicyRange) DeepCopy() *CapacityRequestPolicyRange {
 	in.DeepCopyInto(out)
 	return out
 }
+
+func (in *CapacityRequestPolicyRange) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *CapacityRequestPolicyRange) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *CapacityRequestPolicyRange) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	first := true
+	if in.Min != nil {
+		w.RawString("\"min\":")
+		w.Raw(json.Marshal(in.Min))
+		first = false
+	}
+	if in.Max != nil {
+		if !first {
+			w.RawByte(',')
+		}
+		w.RawString("\"max\":")
+		w.Raw(json.Marshal(in.Max))
+		first = false
+	}
+	if in.Step != nil {
+		if !first {
+			w.RawByte(',')
+		}
+		w.RawString("\"step\":")
+		w.Raw(json.Marshal(in.Step))
+		first = false
+	}
+	w.RawByte('}')
+}
+
+func (in *CapacityRequestPolicyRange) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "min":
+			l.AddError(json.Unmarshal(l.Raw(), &in.Min))
+		case "max":
+			l.AddError(json.Unmarshal(l.Raw(), &in.Max))
+		case "step":
+			l.AddError(json.Unmarshal(l.Raw(), &in.Step))
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
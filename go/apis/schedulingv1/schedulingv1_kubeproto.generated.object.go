@@ -1,8 +1,12 @@
 package schedulingv1
 
 import (
+	"github.com/mailru/easyjson/jlexer"
+	"github.com/mailru/easyjson/jwriter"
 	"go.f110.dev/kubeproto/go/apis/corev1"
 	"go.f110.dev/kubeproto/go/apis/metav1"
+	"go.f110.dev/kubeproto/go/patch"
+	"k8s.io/apimachinery/pkg/fields"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 )
@@ -68,6 +72,202 @@ func (in *PriorityClass) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// PriorityClassToSelectableFields returns the fields of PriorityClass which can be used by the field selector.
+func PriorityClassToSelectableFields(obj *PriorityClass) fields.Set {
+	set := fields.Set{
+		"metadata.name":      obj.ObjectMeta.Name,
+		"metadata.namespace": obj.ObjectMeta.Namespace,
+	}
+	return set
+}
+
+// PriorityClassPatch builds JSON patch or JSON merge patch of PriorityClass.
+// JSON patch fails if the parent of the field doesn't exist (e.g. SetLabel to the object which has no label). MergePatch creates the parent.
+type PriorityClassPatch struct {
+	*patch.Builder
+}
+
+func NewPriorityClassPatch() *PriorityClassPatch {
+	return &PriorityClassPatch{Builder: patch.NewBuilder()}
+}
+
+func (p *PriorityClassPatch) SetLabel(key, value string) *PriorityClassPatch {
+	p.Set([]string{"metadata", "labels", key}, value)
+	return p
+}
+
+func (p *PriorityClassPatch) RemoveLabel(key string) *PriorityClassPatch {
+	p.Remove([]string{"metadata", "labels", key})
+	return p
+}
+
+func (p *PriorityClassPatch) SetAnnotation(key, value string) *PriorityClassPatch {
+	p.Set([]string{"metadata", "annotations", key}, value)
+	return p
+}
+
+func (p *PriorityClassPatch) RemoveAnnotation(key string) *PriorityClassPatch {
+	p.Remove([]string{"metadata", "annotations", key})
+	return p
+}
+
+func (p *PriorityClassPatch) SetValue(v int) *PriorityClassPatch {
+	p.Set([]string{"value"}, v)
+	return p
+}
+
+func (p *PriorityClassPatch) RemoveValue() *PriorityClassPatch {
+	p.Remove([]string{"value"})
+	return p
+}
+
+func (p *PriorityClassPatch) SetGlobalDefault(v bool) *PriorityClassPatch {
+	p.Set([]string{"globalDefault"}, v)
+	return p
+}
+
+func (p *PriorityClassPatch) RemoveGlobalDefault() *PriorityClassPatch {
+	p.Remove([]string{"globalDefault"})
+	return p
+}
+
+func (p *PriorityClassPatch) SetDescription(v string) *PriorityClassPatch {
+	p.Set([]string{"description"}, v)
+	return p
+}
+
+func (p *PriorityClassPatch) RemoveDescription() *PriorityClassPatch {
+	p.Remove([]string{"description"})
+	return p
+}
+
+func (p *PriorityClassPatch) SetPreemptionPolicy(v corev1.PreemptionPolicy) *PriorityClassPatch {
+	p.Set([]string{"preemptionPolicy"}, v)
+	return p
+}
+
+func (p *PriorityClassPatch) RemovePreemptionPolicy() *PriorityClassPatch {
+	p.Remove([]string{"preemptionPolicy"})
+	return p
+}
+
+func (in *PriorityClass) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *PriorityClass) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *PriorityClass) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	first := true
+	if in.TypeMeta.Kind != "" {
+		w.RawString("\"kind\":")
+		w.String(in.TypeMeta.Kind)
+		first = false
+	}
+	if in.TypeMeta.APIVersion != "" {
+		if !first {
+			w.RawByte(',')
+		}
+		w.RawString("\"apiVersion\":")
+		w.String(in.TypeMeta.APIVersion)
+		first = false
+	}
+	if !first {
+		w.RawByte(',')
+	}
+	w.RawString("\"metadata\":")
+	in.ObjectMeta.MarshalEasyJSON(w)
+	w.RawString(",\"value\":")
+	w.Int(in.Value)
+	if in.GlobalDefault {
+		w.RawString(",\"globalDefault\":")
+		w.Bool(in.GlobalDefault)
+	}
+	if in.Description != "" {
+		w.RawString(",\"description\":")
+		w.String(in.Description)
+	}
+	if in.PreemptionPolicy != "" {
+		w.RawString(",\"preemptionPolicy\":")
+		w.String(string(in.PreemptionPolicy))
+	}
+	w.RawByte('}')
+}
+
+func (in *PriorityClass) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "kind":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.TypeMeta.Kind = l.String()
+			}
+		case "apiVersion":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.TypeMeta.APIVersion = l.String()
+			}
+		case "metadata":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.ObjectMeta.UnmarshalEasyJSON(l)
+			}
+		case "value":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.Value = l.Int()
+			}
+		case "globalDefault":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.GlobalDefault = l.Bool()
+			}
+		case "description":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.Description = l.String()
+			}
+		case "preemptionPolicy":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.PreemptionPolicy = corev1.PreemptionPolicy(l.String())
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
+
 type PriorityClassList struct {
 	metav1.TypeMeta `json:",inline"`
 	metav1.ListMeta `json:"metadata"`
@@ -102,3 +302,114 @@ func (in *PriorityClassList) DeepCopyObject() runtime.Object {
 	}
 	return nil
 }
+
+func (in *PriorityClassList) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *PriorityClassList) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *PriorityClassList) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	first := true
+	if in.TypeMeta.Kind != "" {
+		w.RawString("\"kind\":")
+		w.String(in.TypeMeta.Kind)
+		first = false
+	}
+	if in.TypeMeta.APIVersion != "" {
+		if !first {
+			w.RawByte(',')
+		}
+		w.RawString("\"apiVersion\":")
+		w.String(in.TypeMeta.APIVersion)
+		first = false
+	}
+	if !first {
+		w.RawByte(',')
+	}
+	w.RawString("\"metadata\":")
+	in.ListMeta.MarshalEasyJSON(w)
+	w.RawString(",\"items\":")
+	if in.Items == nil {
+		w.RawString("null")
+	} else {
+		w.RawByte('[')
+		for i0 := range in.Items {
+			if i0 > 0 {
+				w.RawByte(',')
+			}
+			in.Items[i0].MarshalEasyJSON(w)
+		}
+		w.RawByte(']')
+	}
+	w.RawByte('}')
+}
+
+func (in *PriorityClassList) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "kind":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.TypeMeta.Kind = l.String()
+			}
+		case "apiVersion":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.TypeMeta.APIVersion = l.String()
+			}
+		case "metadata":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.ListMeta.UnmarshalEasyJSON(l)
+			}
+		case "items":
+			if l.IsNull() {
+				l.Skip()
+				in.Items = nil
+			} else {
+				in.Items = make([]PriorityClass, 0)
+				l.Delim('[')
+				for !l.IsDelim(']') {
+					var v0 PriorityClass
+					if l.IsNull() {
+						l.Skip()
+					} else {
+						v0.UnmarshalEasyJSON(l)
+					}
+					in.Items = append(in.Items, v0)
+					l.WantComma()
+				}
+				l.Delim(']')
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
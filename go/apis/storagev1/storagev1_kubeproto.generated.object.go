@@ -1,11 +1,18 @@
 package storagev1
 
 import (
+	"encoding/json"
+	"github.com/mailru/easyjson/jlexer"
+	"github.com/mailru/easyjson/jwriter"
 	"go.f110.dev/kubeproto/go/apis/corev1"
 	"go.f110.dev/kubeproto/go/apis/metav1"
+	"go.f110.dev/kubeproto/go/patch"
 	apiresource "k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/fields"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"maps"
+	"slices"
 )
 
 const GroupName = "storage.k8s.io"
@@ -88,6 +95,266 @@ func (in *CSIDriver) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// CSIDriverToSelectableFields returns the fields of CSIDriver which can be used by the field selector.
+func CSIDriverToSelectableFields(obj *CSIDriver) fields.Set {
+	set := fields.Set{
+		"metadata.name":      obj.ObjectMeta.Name,
+		"metadata.namespace": obj.ObjectMeta.Namespace,
+	}
+	return set
+}
+
+// CSIDriverPatch builds JSON patch or JSON merge patch of CSIDriver.
+// JSON patch fails if the parent of the field doesn't exist (e.g. SetLabel to the object which has no label). MergePatch creates the parent.
+type CSIDriverPatch struct {
+	*patch.Builder
+}
+
+func NewCSIDriverPatch() *CSIDriverPatch {
+	return &CSIDriverPatch{Builder: patch.NewBuilder()}
+}
+
+func (p *CSIDriverPatch) SetLabel(key, value string) *CSIDriverPatch {
+	p.Set([]string{"metadata", "labels", key}, value)
+	return p
+}
+
+func (p *CSIDriverPatch) RemoveLabel(key string) *CSIDriverPatch {
+	p.Remove([]string{"metadata", "labels", key})
+	return p
+}
+
+func (p *CSIDriverPatch) SetAnnotation(key, value string) *CSIDriverPatch {
+	p.Set([]string{"metadata", "annotations", key}, value)
+	return p
+}
+
+func (p *CSIDriverPatch) RemoveAnnotation(key string) *CSIDriverPatch {
+	p.Remove([]string{"metadata", "annotations", key})
+	return p
+}
+
+func (p *CSIDriverPatch) SetSpec(v CSIDriverSpec) *CSIDriverPatch {
+	p.Set([]string{"spec"}, v)
+	return p
+}
+
+func (p *CSIDriverPatch) RemoveSpec() *CSIDriverPatch {
+	p.Remove([]string{"spec"})
+	return p
+}
+
+func (p *CSIDriverPatch) SetSpecAttachRequired(v bool) *CSIDriverPatch {
+	p.Set([]string{"spec", "attachRequired"}, v)
+	return p
+}
+
+func (p *CSIDriverPatch) RemoveSpecAttachRequired() *CSIDriverPatch {
+	p.Remove([]string{"spec", "attachRequired"})
+	return p
+}
+
+func (p *CSIDriverPatch) SetSpecPodInfoOnMount(v bool) *CSIDriverPatch {
+	p.Set([]string{"spec", "podInfoOnMount"}, v)
+	return p
+}
+
+func (p *CSIDriverPatch) RemoveSpecPodInfoOnMount() *CSIDriverPatch {
+	p.Remove([]string{"spec", "podInfoOnMount"})
+	return p
+}
+
+func (p *CSIDriverPatch) SetSpecVolumeLifecycleModes(v []VolumeLifecycleMode) *CSIDriverPatch {
+	p.Set([]string{"spec", "volumeLifecycleModes"}, v)
+	return p
+}
+
+func (p *CSIDriverPatch) RemoveSpecVolumeLifecycleModes() *CSIDriverPatch {
+	p.Remove([]string{"spec", "volumeLifecycleModes"})
+	return p
+}
+
+func (p *CSIDriverPatch) AddSpecVolumeLifecycleModes(v ...VolumeLifecycleMode) *CSIDriverPatch {
+	for _, e := range v {
+		p.Append([]string{"spec", "volumeLifecycleModes"}, e)
+	}
+	return p
+}
+
+func (p *CSIDriverPatch) SetSpecStorageCapacity(v bool) *CSIDriverPatch {
+	p.Set([]string{"spec", "storageCapacity"}, v)
+	return p
+}
+
+func (p *CSIDriverPatch) RemoveSpecStorageCapacity() *CSIDriverPatch {
+	p.Remove([]string{"spec", "storageCapacity"})
+	return p
+}
+
+func (p *CSIDriverPatch) SetSpecFSGroupPolicy(v FSGroupPolicy) *CSIDriverPatch {
+	p.Set([]string{"spec", "fsGroupPolicy"}, v)
+	return p
+}
+
+func (p *CSIDriverPatch) RemoveSpecFSGroupPolicy() *CSIDriverPatch {
+	p.Remove([]string{"spec", "fsGroupPolicy"})
+	return p
+}
+
+func (p *CSIDriverPatch) SetSpecTokenRequests(v []TokenRequest) *CSIDriverPatch {
+	p.Set([]string{"spec", "tokenRequests"}, v)
+	return p
+}
+
+func (p *CSIDriverPatch) RemoveSpecTokenRequests() *CSIDriverPatch {
+	p.Remove([]string{"spec", "tokenRequests"})
+	return p
+}
+
+func (p *CSIDriverPatch) AddSpecTokenRequests(v ...TokenRequest) *CSIDriverPatch {
+	for _, e := range v {
+		p.Append([]string{"spec", "tokenRequests"}, e)
+	}
+	return p
+}
+
+func (p *CSIDriverPatch) SetSpecRequiresRepublish(v bool) *CSIDriverPatch {
+	p.Set([]string{"spec", "requiresRepublish"}, v)
+	return p
+}
+
+func (p *CSIDriverPatch) RemoveSpecRequiresRepublish() *CSIDriverPatch {
+	p.Remove([]string{"spec", "requiresRepublish"})
+	return p
+}
+
+func (p *CSIDriverPatch) SetSpecSELinuxMount(v bool) *CSIDriverPatch {
+	p.Set([]string{"spec", "seLinuxMount"}, v)
+	return p
+}
+
+func (p *CSIDriverPatch) RemoveSpecSELinuxMount() *CSIDriverPatch {
+	p.Remove([]string{"spec", "seLinuxMount"})
+	return p
+}
+
+func (p *CSIDriverPatch) SetSpecNodeAllocatableUpdatePeriodSeconds(v int64) *CSIDriverPatch {
+	p.Set([]string{"spec", "nodeAllocatableUpdatePeriodSeconds"}, v)
+	return p
+}
+
+func (p *CSIDriverPatch) RemoveSpecNodeAllocatableUpdatePeriodSeconds() *CSIDriverPatch {
+	p.Remove([]string{"spec", "nodeAllocatableUpdatePeriodSeconds"})
+	return p
+}
+
+func (p *CSIDriverPatch) SetSpecServiceAccountTokenInSecrets(v bool) *CSIDriverPatch {
+	p.Set([]string{"spec", "serviceAccountTokenInSecrets"}, v)
+	return p
+}
+
+func (p *CSIDriverPatch) RemoveSpecServiceAccountTokenInSecrets() *CSIDriverPatch {
+	p.Remove([]string{"spec", "serviceAccountTokenInSecrets"})
+	return p
+}
+
+func (p *CSIDriverPatch) SetSpecPreventPodSchedulingIfMissing(v bool) *CSIDriverPatch {
+	p.Set([]string{"spec", "preventPodSchedulingIfMissing"}, v)
+	return p
+}
+
+func (p *CSIDriverPatch) RemoveSpecPreventPodSchedulingIfMissing() *CSIDriverPatch {
+	p.Remove([]string{"spec", "preventPodSchedulingIfMissing"})
+	return p
+}
+
+func (in *CSIDriver) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *CSIDriver) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *CSIDriver) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	first := true
+	if in.TypeMeta.Kind != "" {
+		w.RawString("\"kind\":")
+		w.String(in.TypeMeta.Kind)
+		first = false
+	}
+	if in.TypeMeta.APIVersion != "" {
+		if !first {
+			w.RawByte(',')
+		}
+		w.RawString("\"apiVersion\":")
+		w.String(in.TypeMeta.APIVersion)
+		first = false
+	}
+	if !first {
+		w.RawByte(',')
+	}
+	w.RawString("\"metadata\":")
+	in.ObjectMeta.MarshalEasyJSON(w)
+	w.RawString(",\"spec\":")
+	in.Spec.MarshalEasyJSON(w)
+	w.RawByte('}')
+}
+
+func (in *CSIDriver) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "kind":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.TypeMeta.Kind = l.String()
+			}
+		case "apiVersion":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.TypeMeta.APIVersion = l.String()
+			}
+		case "metadata":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.ObjectMeta.UnmarshalEasyJSON(l)
+			}
+		case "spec":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.Spec.UnmarshalEasyJSON(l)
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
+
 type CSIDriverList struct {
 	metav1.TypeMeta `json:",inline"`
 	metav1.ListMeta `json:"metadata"`
@@ -123,6 +390,117 @@ func (in *CSIDriverList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+func (in *CSIDriverList) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *CSIDriverList) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *CSIDriverList) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	first := true
+	if in.TypeMeta.Kind != "" {
+		w.RawString("\"kind\":")
+		w.String(in.TypeMeta.Kind)
+		first = false
+	}
+	if in.TypeMeta.APIVersion != "" {
+		if !first {
+			w.RawByte(',')
+		}
+		w.RawString("\"apiVersion\":")
+		w.String(in.TypeMeta.APIVersion)
+		first = false
+	}
+	if !first {
+		w.RawByte(',')
+	}
+	w.RawString("\"metadata\":")
+	in.ListMeta.MarshalEasyJSON(w)
+	w.RawString(",\"items\":")
+	if in.Items == nil {
+		w.RawString("null")
+	} else {
+		w.RawByte('[')
+		for i0 := range in.Items {
+			if i0 > 0 {
+				w.RawByte(',')
+			}
+			in.Items[i0].MarshalEasyJSON(w)
+		}
+		w.RawByte(']')
+	}
+	w.RawByte('}')
+}
+
+func (in *CSIDriverList) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "kind":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.TypeMeta.Kind = l.String()
+			}
+		case "apiVersion":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.TypeMeta.APIVersion = l.String()
+			}
+		case "metadata":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.ListMeta.UnmarshalEasyJSON(l)
+			}
+		case "items":
+			if l.IsNull() {
+				l.Skip()
+				in.Items = nil
+			} else {
+				in.Items = make([]CSIDriver, 0)
+				l.Delim('[')
+				for !l.IsDelim(']') {
+					var v0 CSIDriver
+					if l.IsNull() {
+						l.Skip()
+					} else {
+						v0.UnmarshalEasyJSON(l)
+					}
+					in.Items = append(in.Items, v0)
+					l.WantComma()
+				}
+				l.Delim(']')
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
+
 type CSINode struct {
 	metav1.TypeMeta   `json:",inline"`
 	metav1.ObjectMeta `json:"metadata"`
@@ -153,6 +531,159 @@ func (in *CSINode) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// CSINodeToSelectableFields returns the fields of CSINode which can be used by the field selector.
+func CSINodeToSelectableFields(obj *CSINode) fields.Set {
+	set := fields.Set{
+		"metadata.name":      obj.ObjectMeta.Name,
+		"metadata.namespace": obj.ObjectMeta.Namespace,
+	}
+	return set
+}
+
+// CSINodePatch builds JSON patch or JSON merge patch of CSINode.
+// JSON patch fails if the parent of the field doesn't exist (e.g. SetLabel to the object which has no label). MergePatch creates the parent.
+type CSINodePatch struct {
+	*patch.Builder
+}
+
+func NewCSINodePatch() *CSINodePatch {
+	return &CSINodePatch{Builder: patch.NewBuilder()}
+}
+
+func (p *CSINodePatch) SetLabel(key, value string) *CSINodePatch {
+	p.Set([]string{"metadata", "labels", key}, value)
+	return p
+}
+
+func (p *CSINodePatch) RemoveLabel(key string) *CSINodePatch {
+	p.Remove([]string{"metadata", "labels", key})
+	return p
+}
+
+func (p *CSINodePatch) SetAnnotation(key, value string) *CSINodePatch {
+	p.Set([]string{"metadata", "annotations", key}, value)
+	return p
+}
+
+func (p *CSINodePatch) RemoveAnnotation(key string) *CSINodePatch {
+	p.Remove([]string{"metadata", "annotations", key})
+	return p
+}
+
+func (p *CSINodePatch) SetSpec(v CSINodeSpec) *CSINodePatch {
+	p.Set([]string{"spec"}, v)
+	return p
+}
+
+func (p *CSINodePatch) RemoveSpec() *CSINodePatch {
+	p.Remove([]string{"spec"})
+	return p
+}
+
+func (p *CSINodePatch) SetSpecDrivers(v []CSINodeDriver) *CSINodePatch {
+	p.Set([]string{"spec", "drivers"}, v)
+	return p
+}
+
+func (p *CSINodePatch) RemoveSpecDrivers() *CSINodePatch {
+	p.Remove([]string{"spec", "drivers"})
+	return p
+}
+
+func (p *CSINodePatch) AddSpecDrivers(v ...CSINodeDriver) *CSINodePatch {
+	for _, e := range v {
+		p.Append([]string{"spec", "drivers"}, e)
+	}
+	return p
+}
+
+func (in *CSINode) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *CSINode) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *CSINode) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	first := true
+	if in.TypeMeta.Kind != "" {
+		w.RawString("\"kind\":")
+		w.String(in.TypeMeta.Kind)
+		first = false
+	}
+	if in.TypeMeta.APIVersion != "" {
+		if !first {
+			w.RawByte(',')
+		}
+		w.RawString("\"apiVersion\":")
+		w.String(in.TypeMeta.APIVersion)
+		first = false
+	}
+	if !first {
+		w.RawByte(',')
+	}
+	w.RawString("\"metadata\":")
+	in.ObjectMeta.MarshalEasyJSON(w)
+	w.RawString(",\"spec\":")
+	in.Spec.MarshalEasyJSON(w)
+	w.RawByte('}')
+}
+
+func (in *CSINode) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "kind":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.TypeMeta.Kind = l.String()
+			}
+		case "apiVersion":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.TypeMeta.APIVersion = l.String()
+			}
+		case "metadata":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.ObjectMeta.UnmarshalEasyJSON(l)
+			}
+		case "spec":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.Spec.UnmarshalEasyJSON(l)
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
+
 type CSINodeList struct {
 	metav1.TypeMeta `json:",inline"`
 	metav1.ListMeta `json:"metadata"`
@@ -188,6 +719,117 @@ func (in *CSINodeList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+func (in *CSINodeList) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *CSINodeList) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *CSINodeList) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	first := true
+	if in.TypeMeta.Kind != "" {
+		w.RawString("\"kind\":")
+		w.String(in.TypeMeta.Kind)
+		first = false
+	}
+	if in.TypeMeta.APIVersion != "" {
+		if !first {
+			w.RawByte(',')
+		}
+		w.RawString("\"apiVersion\":")
+		w.String(in.TypeMeta.APIVersion)
+		first = false
+	}
+	if !first {
+		w.RawByte(',')
+	}
+	w.RawString("\"metadata\":")
+	in.ListMeta.MarshalEasyJSON(w)
+	w.RawString(",\"items\":")
+	if in.Items == nil {
+		w.RawString("null")
+	} else {
+		w.RawByte('[')
+		for i0 := range in.Items {
+			if i0 > 0 {
+				w.RawByte(',')
+			}
+			in.Items[i0].MarshalEasyJSON(w)
+		}
+		w.RawByte(']')
+	}
+	w.RawByte('}')
+}
+
+func (in *CSINodeList) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "kind":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.TypeMeta.Kind = l.String()
+			}
+		case "apiVersion":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.TypeMeta.APIVersion = l.String()
+			}
+		case "metadata":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.ListMeta.UnmarshalEasyJSON(l)
+			}
+		case "items":
+			if l.IsNull() {
+				l.Skip()
+				in.Items = nil
+			} else {
+				in.Items = make([]CSINode, 0)
+				l.Delim('[')
+				for !l.IsDelim(']') {
+					var v0 CSINode
+					if l.IsNull() {
+						l.Skip()
+					} else {
+						v0.UnmarshalEasyJSON(l)
+					}
+					in.Items = append(in.Items, v0)
+					l.WantComma()
+				}
+				l.Delim(']')
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
+
 type CSIStorageCapacity struct {
 	metav1.TypeMeta   `json:",inline"`
 	metav1.ObjectMeta `json:"metadata"`
@@ -261,6 +903,198 @@ func (in *CSIStorageCapacity) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// CSIStorageCapacityToSelectableFields returns the fields of CSIStorageCapacity which can be used by the field selector.
+func CSIStorageCapacityToSelectableFields(obj *CSIStorageCapacity) fields.Set {
+	set := fields.Set{
+		"metadata.name":      obj.ObjectMeta.Name,
+		"metadata.namespace": obj.ObjectMeta.Namespace,
+	}
+	return set
+}
+
+// CSIStorageCapacityPatch builds JSON patch or JSON merge patch of CSIStorageCapacity.
+// JSON patch fails if the parent of the field doesn't exist (e.g. SetLabel to the object which has no label). MergePatch creates the parent.
+type CSIStorageCapacityPatch struct {
+	*patch.Builder
+}
+
+func NewCSIStorageCapacityPatch() *CSIStorageCapacityPatch {
+	return &CSIStorageCapacityPatch{Builder: patch.NewBuilder()}
+}
+
+func (p *CSIStorageCapacityPatch) SetLabel(key, value string) *CSIStorageCapacityPatch {
+	p.Set([]string{"metadata", "labels", key}, value)
+	return p
+}
+
+func (p *CSIStorageCapacityPatch) RemoveLabel(key string) *CSIStorageCapacityPatch {
+	p.Remove([]string{"metadata", "labels", key})
+	return p
+}
+
+func (p *CSIStorageCapacityPatch) SetAnnotation(key, value string) *CSIStorageCapacityPatch {
+	p.Set([]string{"metadata", "annotations", key}, value)
+	return p
+}
+
+func (p *CSIStorageCapacityPatch) RemoveAnnotation(key string) *CSIStorageCapacityPatch {
+	p.Remove([]string{"metadata", "annotations", key})
+	return p
+}
+
+func (p *CSIStorageCapacityPatch) SetNodeTopology(v *metav1.LabelSelector) *CSIStorageCapacityPatch {
+	p.Set([]string{"nodeTopology"}, v)
+	return p
+}
+
+func (p *CSIStorageCapacityPatch) RemoveNodeTopology() *CSIStorageCapacityPatch {
+	p.Remove([]string{"nodeTopology"})
+	return p
+}
+
+func (p *CSIStorageCapacityPatch) SetStorageClassName(v string) *CSIStorageCapacityPatch {
+	p.Set([]string{"storageClassName"}, v)
+	return p
+}
+
+func (p *CSIStorageCapacityPatch) RemoveStorageClassName() *CSIStorageCapacityPatch {
+	p.Remove([]string{"storageClassName"})
+	return p
+}
+
+func (p *CSIStorageCapacityPatch) SetCapacity(v *apiresource.Quantity) *CSIStorageCapacityPatch {
+	p.Set([]string{"capacity"}, v)
+	return p
+}
+
+func (p *CSIStorageCapacityPatch) RemoveCapacity() *CSIStorageCapacityPatch {
+	p.Remove([]string{"capacity"})
+	return p
+}
+
+func (p *CSIStorageCapacityPatch) SetMaximumVolumeSize(v *apiresource.Quantity) *CSIStorageCapacityPatch {
+	p.Set([]string{"maximumVolumeSize"}, v)
+	return p
+}
+
+func (p *CSIStorageCapacityPatch) RemoveMaximumVolumeSize() *CSIStorageCapacityPatch {
+	p.Remove([]string{"maximumVolumeSize"})
+	return p
+}
+
+func (in *CSIStorageCapacity) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *CSIStorageCapacity) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *CSIStorageCapacity) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	first := true
+	if in.TypeMeta.Kind != "" {
+		w.RawString("\"kind\":")
+		w.String(in.TypeMeta.Kind)
+		first = false
+	}
+	if in.TypeMeta.APIVersion != "" {
+		if !first {
+			w.RawByte(',')
+		}
+		w.RawString("\"apiVersion\":")
+		w.String(in.TypeMeta.APIVersion)
+		first = false
+	}
+	if !first {
+		w.RawByte(',')
+	}
+	w.RawString("\"metadata\":")
+	in.ObjectMeta.MarshalEasyJSON(w)
+	if in.NodeTopology != nil {
+		w.RawString(",\"nodeTopology\":")
+		in.NodeTopology.MarshalEasyJSON(w)
+	}
+	w.RawString(",\"storageClassName\":")
+	w.String(in.StorageClassName)
+	if in.Capacity != nil {
+		w.RawString(",\"capacity\":")
+		w.Raw(json.Marshal(in.Capacity))
+	}
+	if in.MaximumVolumeSize != nil {
+		w.RawString(",\"maximumVolumeSize\":")
+		w.Raw(json.Marshal(in.MaximumVolumeSize))
+	}
+	w.RawByte('}')
+}
+
+func (in *CSIStorageCapacity) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "kind":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.TypeMeta.Kind = l.String()
+			}
+		case "apiVersion":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.TypeMeta.APIVersion = l.String()
+			}
+		case "metadata":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.ObjectMeta.UnmarshalEasyJSON(l)
+			}
+		case "nodeTopology":
+			if l.IsNull() {
+				l.Skip()
+				in.NodeTopology = nil
+			} else {
+				if in.NodeTopology == nil {
+					in.NodeTopology = new(metav1.LabelSelector)
+				}
+				in.NodeTopology.UnmarshalEasyJSON(l)
+			}
+		case "storageClassName":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.StorageClassName = l.String()
+			}
+		case "capacity":
+			l.AddError(json.Unmarshal(l.Raw(), &in.Capacity))
+		case "maximumVolumeSize":
+			l.AddError(json.Unmarshal(l.Raw(), &in.MaximumVolumeSize))
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
+
 type CSIStorageCapacityList struct {
 	metav1.TypeMeta `json:",inline"`
 	metav1.ListMeta `json:"metadata"`
@@ -296,6 +1130,117 @@ func (in *CSIStorageCapacityList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+func (in *CSIStorageCapacityList) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *CSIStorageCapacityList) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *CSIStorageCapacityList) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	first := true
+	if in.TypeMeta.Kind != "" {
+		w.RawString("\"kind\":")
+		w.String(in.TypeMeta.Kind)
+		first = false
+	}
+	if in.TypeMeta.APIVersion != "" {
+		if !first {
+			w.RawByte(',')
+		}
+		w.RawString("\"apiVersion\":")
+		w.String(in.TypeMeta.APIVersion)
+		first = false
+	}
+	if !first {
+		w.RawByte(',')
+	}
+	w.RawString("\"metadata\":")
+	in.ListMeta.MarshalEasyJSON(w)
+	w.RawString(",\"items\":")
+	if in.Items == nil {
+		w.RawString("null")
+	} else {
+		w.RawByte('[')
+		for i0 := range in.Items {
+			if i0 > 0 {
+				w.RawByte(',')
+			}
+			in.Items[i0].MarshalEasyJSON(w)
+		}
+		w.RawByte(']')
+	}
+	w.RawByte('}')
+}
+
+func (in *CSIStorageCapacityList) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "kind":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.TypeMeta.Kind = l.String()
+			}
+		case "apiVersion":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.TypeMeta.APIVersion = l.String()
+			}
+		case "metadata":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.ListMeta.UnmarshalEasyJSON(l)
+			}
+		case "items":
+			if l.IsNull() {
+				l.Skip()
+				in.Items = nil
+			} else {
+				in.Items = make([]CSIStorageCapacity, 0)
+				l.Delim('[')
+				for !l.IsDelim(']') {
+					var v0 CSIStorageCapacity
+					if l.IsNull() {
+						l.Skip()
+					} else {
+						v0.UnmarshalEasyJSON(l)
+					}
+					in.Items = append(in.Items, v0)
+					l.WantComma()
+				}
+				l.Delim(']')
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
+
 type StorageClass struct {
 	metav1.TypeMeta   `json:",inline"`
 	metav1.ObjectMeta `json:"metadata"`
@@ -365,6 +1310,351 @@ func (in *StorageClass) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// StorageClassToSelectableFields returns the fields of StorageClass which can be used by the field selector.
+func StorageClassToSelectableFields(obj *StorageClass) fields.Set {
+	set := fields.Set{
+		"metadata.name":      obj.ObjectMeta.Name,
+		"metadata.namespace": obj.ObjectMeta.Namespace,
+	}
+	return set
+}
+
+// StorageClassPatch builds JSON patch or JSON merge patch of StorageClass.
+// JSON patch fails if the parent of the field doesn't exist (e.g. SetLabel to the object which has no label). MergePatch creates the parent.
+type StorageClassPatch struct {
+	*patch.Builder
+}
+
+func NewStorageClassPatch() *StorageClassPatch {
+	return &StorageClassPatch{Builder: patch.NewBuilder()}
+}
+
+func (p *StorageClassPatch) SetLabel(key, value string) *StorageClassPatch {
+	p.Set([]string{"metadata", "labels", key}, value)
+	return p
+}
+
+func (p *StorageClassPatch) RemoveLabel(key string) *StorageClassPatch {
+	p.Remove([]string{"metadata", "labels", key})
+	return p
+}
+
+func (p *StorageClassPatch) SetAnnotation(key, value string) *StorageClassPatch {
+	p.Set([]string{"metadata", "annotations", key}, value)
+	return p
+}
+
+func (p *StorageClassPatch) RemoveAnnotation(key string) *StorageClassPatch {
+	p.Remove([]string{"metadata", "annotations", key})
+	return p
+}
+
+func (p *StorageClassPatch) SetProvisioner(v string) *StorageClassPatch {
+	p.Set([]string{"provisioner"}, v)
+	return p
+}
+
+func (p *StorageClassPatch) RemoveProvisioner() *StorageClassPatch {
+	p.Remove([]string{"provisioner"})
+	return p
+}
+
+func (p *StorageClassPatch) SetParameters(v map[string]string) *StorageClassPatch {
+	p.Set([]string{"parameters"}, v)
+	return p
+}
+
+func (p *StorageClassPatch) RemoveParameters() *StorageClassPatch {
+	p.Remove([]string{"parameters"})
+	return p
+}
+
+func (p *StorageClassPatch) SetReclaimPolicy(v corev1.PersistentVolumeReclaimPolicy) *StorageClassPatch {
+	p.Set([]string{"reclaimPolicy"}, v)
+	return p
+}
+
+func (p *StorageClassPatch) RemoveReclaimPolicy() *StorageClassPatch {
+	p.Remove([]string{"reclaimPolicy"})
+	return p
+}
+
+func (p *StorageClassPatch) SetMountOptions(v []string) *StorageClassPatch {
+	p.Set([]string{"mountOptions"}, v)
+	return p
+}
+
+func (p *StorageClassPatch) RemoveMountOptions() *StorageClassPatch {
+	p.Remove([]string{"mountOptions"})
+	return p
+}
+
+func (p *StorageClassPatch) AddMountOptions(v ...string) *StorageClassPatch {
+	for _, e := range v {
+		p.Append([]string{"mountOptions"}, e)
+	}
+	return p
+}
+
+func (p *StorageClassPatch) SetAllowVolumeExpansion(v bool) *StorageClassPatch {
+	p.Set([]string{"allowVolumeExpansion"}, v)
+	return p
+}
+
+func (p *StorageClassPatch) RemoveAllowVolumeExpansion() *StorageClassPatch {
+	p.Remove([]string{"allowVolumeExpansion"})
+	return p
+}
+
+func (p *StorageClassPatch) SetVolumeBindingMode(v VolumeBindingMode) *StorageClassPatch {
+	p.Set([]string{"volumeBindingMode"}, v)
+	return p
+}
+
+func (p *StorageClassPatch) RemoveVolumeBindingMode() *StorageClassPatch {
+	p.Remove([]string{"volumeBindingMode"})
+	return p
+}
+
+func (p *StorageClassPatch) SetAllowedTopologies(v []corev1.TopologySelectorTerm) *StorageClassPatch {
+	p.Set([]string{"allowedTopologies"}, v)
+	return p
+}
+
+func (p *StorageClassPatch) RemoveAllowedTopologies() *StorageClassPatch {
+	p.Remove([]string{"allowedTopologies"})
+	return p
+}
+
+func (p *StorageClassPatch) AddAllowedTopologies(v ...corev1.TopologySelectorTerm) *StorageClassPatch {
+	for _, e := range v {
+		p.Append([]string{"allowedTopologies"}, e)
+	}
+	return p
+}
+
+func (in *StorageClass) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *StorageClass) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *StorageClass) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	first := true
+	if in.TypeMeta.Kind != "" {
+		w.RawString("\"kind\":")
+		w.String(in.TypeMeta.Kind)
+		first = false
+	}
+	if in.TypeMeta.APIVersion != "" {
+		if !first {
+			w.RawByte(',')
+		}
+		w.RawString("\"apiVersion\":")
+		w.String(in.TypeMeta.APIVersion)
+		first = false
+	}
+	if !first {
+		w.RawByte(',')
+	}
+	w.RawString("\"metadata\":")
+	in.ObjectMeta.MarshalEasyJSON(w)
+	w.RawString(",\"provisioner\":")
+	w.String(in.Provisioner)
+	if len(in.Parameters) != 0 {
+		w.RawString(",\"parameters\":")
+		if in.Parameters == nil {
+			w.RawString("null")
+		} else {
+			w.RawByte('{')
+			for i0, k0 := range slices.Sorted(maps.Keys(in.Parameters)) {
+				if i0 > 0 {
+					w.RawByte(',')
+				}
+				w.String(k0)
+				w.RawByte(':')
+				v0 := in.Parameters[k0]
+				w.String(v0)
+			}
+			w.RawByte('}')
+		}
+	}
+	if in.ReclaimPolicy != "" {
+		w.RawString(",\"reclaimPolicy\":")
+		w.String(string(in.ReclaimPolicy))
+	}
+	w.RawString(",\"mountOptions\":")
+	if in.MountOptions == nil {
+		w.RawString("null")
+	} else {
+		w.RawByte('[')
+		for i0 := range in.MountOptions {
+			if i0 > 0 {
+				w.RawByte(',')
+			}
+			w.String(in.MountOptions[i0])
+		}
+		w.RawByte(']')
+	}
+	if in.AllowVolumeExpansion {
+		w.RawString(",\"allowVolumeExpansion\":")
+		w.Bool(in.AllowVolumeExpansion)
+	}
+	if in.VolumeBindingMode != "" {
+		w.RawString(",\"volumeBindingMode\":")
+		w.String(string(in.VolumeBindingMode))
+	}
+	w.RawString(",\"allowedTopologies\":")
+	if in.AllowedTopologies == nil {
+		w.RawString("null")
+	} else {
+		w.RawByte('[')
+		for i0 := range in.AllowedTopologies {
+			if i0 > 0 {
+				w.RawByte(',')
+			}
+			in.AllowedTopologies[i0].MarshalEasyJSON(w)
+		}
+		w.RawByte(']')
+	}
+	w.RawByte('}')
+}
+
+func (in *StorageClass) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "kind":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.TypeMeta.Kind = l.String()
+			}
+		case "apiVersion":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.TypeMeta.APIVersion = l.String()
+			}
+		case "metadata":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.ObjectMeta.UnmarshalEasyJSON(l)
+			}
+		case "provisioner":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.Provisioner = l.String()
+			}
+		case "parameters":
+			if l.IsNull() {
+				l.Skip()
+				in.Parameters = nil
+			} else {
+				if in.Parameters == nil {
+					in.Parameters = make(map[string]string)
+				}
+				l.Delim('{')
+				for !l.IsDelim('}') {
+					k0 := l.String()
+					l.WantColon()
+					var v0 string
+					if l.IsNull() {
+						l.Skip()
+					} else {
+						v0 = l.String()
+					}
+					in.Parameters[k0] = v0
+					l.WantComma()
+				}
+				l.Delim('}')
+			}
+		case "reclaimPolicy":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.ReclaimPolicy = corev1.PersistentVolumeReclaimPolicy(l.String())
+			}
+		case "mountOptions":
+			if l.IsNull() {
+				l.Skip()
+				in.MountOptions = nil
+			} else {
+				in.MountOptions = make([]string, 0)
+				l.Delim('[')
+				for !l.IsDelim(']') {
+					var v0 string
+					if l.IsNull() {
+						l.Skip()
+					} else {
+						v0 = l.String()
+					}
+					in.MountOptions = append(in.MountOptions, v0)
+					l.WantComma()
+				}
+				l.Delim(']')
+			}
+		case "allowVolumeExpansion":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.AllowVolumeExpansion = l.Bool()
+			}
+		case "volumeBindingMode":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.VolumeBindingMode = VolumeBindingMode(l.String())
+			}
+		case "allowedTopologies":
+			if l.IsNull() {
+				l.Skip()
+				in.AllowedTopologies = nil
+			} else {
+				in.AllowedTopologies = make([]corev1.TopologySelectorTerm, 0)
+				l.Delim('[')
+				for !l.IsDelim(']') {
+					var v0 corev1.TopologySelectorTerm
+					if l.IsNull() {
+						l.Skip()
+					} else {
+						v0.UnmarshalEasyJSON(l)
+					}
+					in.AllowedTopologies = append(in.AllowedTopologies, v0)
+					l.WantComma()
+				}
+				l.Delim(']')
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
+
 type StorageClassList struct {
 	metav1.TypeMeta `json:",inline"`
 	metav1.ListMeta `json:"metadata"`
@@ -400,6 +1690,117 @@ func (in *StorageClassList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+func (in *StorageClassList) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *StorageClassList) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *StorageClassList) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	first := true
+	if in.TypeMeta.Kind != "" {
+		w.RawString("\"kind\":")
+		w.String(in.TypeMeta.Kind)
+		first = false
+	}
+	if in.TypeMeta.APIVersion != "" {
+		if !first {
+			w.RawByte(',')
+		}
+		w.RawString("\"apiVersion\":")
+		w.String(in.TypeMeta.APIVersion)
+		first = false
+	}
+	if !first {
+		w.RawByte(',')
+	}
+	w.RawString("\"metadata\":")
+	in.ListMeta.MarshalEasyJSON(w)
+	w.RawString(",\"items\":")
+	if in.Items == nil {
+		w.RawString("null")
+	} else {
+		w.RawByte('[')
+		for i0 := range in.Items {
+			if i0 > 0 {
+				w.RawByte(',')
+			}
+			in.Items[i0].MarshalEasyJSON(w)
+		}
+		w.RawByte(']')
+	}
+	w.RawByte('}')
+}
+
+func (in *StorageClassList) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "kind":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.TypeMeta.Kind = l.String()
+			}
+		case "apiVersion":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.TypeMeta.APIVersion = l.String()
+			}
+		case "metadata":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.ListMeta.UnmarshalEasyJSON(l)
+			}
+		case "items":
+			if l.IsNull() {
+				l.Skip()
+				in.Items = nil
+			} else {
+				in.Items = make([]StorageClass, 0)
+				l.Delim('[')
+				for !l.IsDelim(']') {
+					var v0 StorageClass
+					if l.IsNull() {
+						l.Skip()
+					} else {
+						v0.UnmarshalEasyJSON(l)
+					}
+					in.Items = append(in.Items, v0)
+					l.WantComma()
+				}
+				l.Delim(']')
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
+
 type VolumeAttachment struct {
 	metav1.TypeMeta   `json:",inline"`
 	metav1.ObjectMeta `json:"metadata"`
@@ -424,20 +1825,330 @@ func (in *VolumeAttachment) DeepCopyInto(out *VolumeAttachment) {
 	}
 }
 
-func (in *VolumeAttachment) DeepCopy() *VolumeAttachment {
-	if in == nil {
-		return nil
+func (in *VolumeAttachment) DeepCopy() *VolumeAttachment {
+	if in == nil {
+		return nil
+	}
+	out := new(VolumeAttachment)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *VolumeAttachment) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// VolumeAttachmentToSelectableFields returns the fields of VolumeAttachment which can be used by the field selector.
+func VolumeAttachmentToSelectableFields(obj *VolumeAttachment) fields.Set {
+	set := fields.Set{
+		"metadata.name":      obj.ObjectMeta.Name,
+		"metadata.namespace": obj.ObjectMeta.Namespace,
+	}
+	return set
+}
+
+// VolumeAttachmentPatch builds JSON patch or JSON merge patch of VolumeAttachment.
+// JSON patch fails if the parent of the field doesn't exist (e.g. SetLabel to the object which has no label). MergePatch creates the parent.
+type VolumeAttachmentPatch struct {
+	*patch.Builder
+}
+
+func NewVolumeAttachmentPatch() *VolumeAttachmentPatch {
+	return &VolumeAttachmentPatch{Builder: patch.NewBuilder()}
+}
+
+func (p *VolumeAttachmentPatch) SetLabel(key, value string) *VolumeAttachmentPatch {
+	p.Set([]string{"metadata", "labels", key}, value)
+	return p
+}
+
+func (p *VolumeAttachmentPatch) RemoveLabel(key string) *VolumeAttachmentPatch {
+	p.Remove([]string{"metadata", "labels", key})
+	return p
+}
+
+func (p *VolumeAttachmentPatch) SetAnnotation(key, value string) *VolumeAttachmentPatch {
+	p.Set([]string{"metadata", "annotations", key}, value)
+	return p
+}
+
+func (p *VolumeAttachmentPatch) RemoveAnnotation(key string) *VolumeAttachmentPatch {
+	p.Remove([]string{"metadata", "annotations", key})
+	return p
+}
+
+func (p *VolumeAttachmentPatch) SetSpec(v VolumeAttachmentSpec) *VolumeAttachmentPatch {
+	p.Set([]string{"spec"}, v)
+	return p
+}
+
+func (p *VolumeAttachmentPatch) RemoveSpec() *VolumeAttachmentPatch {
+	p.Remove([]string{"spec"})
+	return p
+}
+
+func (p *VolumeAttachmentPatch) SetSpecAttacher(v string) *VolumeAttachmentPatch {
+	p.Set([]string{"spec", "attacher"}, v)
+	return p
+}
+
+func (p *VolumeAttachmentPatch) RemoveSpecAttacher() *VolumeAttachmentPatch {
+	p.Remove([]string{"spec", "attacher"})
+	return p
+}
+
+func (p *VolumeAttachmentPatch) SetSpecSource(v VolumeAttachmentSource) *VolumeAttachmentPatch {
+	p.Set([]string{"spec", "source"}, v)
+	return p
+}
+
+func (p *VolumeAttachmentPatch) RemoveSpecSource() *VolumeAttachmentPatch {
+	p.Remove([]string{"spec", "source"})
+	return p
+}
+
+func (p *VolumeAttachmentPatch) SetSpecSourcePersistentVolumeName(v string) *VolumeAttachmentPatch {
+	p.Set([]string{"spec", "source", "persistentVolumeName"}, v)
+	return p
+}
+
+func (p *VolumeAttachmentPatch) RemoveSpecSourcePersistentVolumeName() *VolumeAttachmentPatch {
+	p.Remove([]string{"spec", "source", "persistentVolumeName"})
+	return p
+}
+
+func (p *VolumeAttachmentPatch) SetSpecSourceInlineVolumeSpec(v *corev1.PersistentVolumeSpec) *VolumeAttachmentPatch {
+	p.Set([]string{"spec", "source", "inlineVolumeSpec"}, v)
+	return p
+}
+
+func (p *VolumeAttachmentPatch) RemoveSpecSourceInlineVolumeSpec() *VolumeAttachmentPatch {
+	p.Remove([]string{"spec", "source", "inlineVolumeSpec"})
+	return p
+}
+
+func (p *VolumeAttachmentPatch) SetSpecNodeName(v string) *VolumeAttachmentPatch {
+	p.Set([]string{"spec", "nodeName"}, v)
+	return p
+}
+
+func (p *VolumeAttachmentPatch) RemoveSpecNodeName() *VolumeAttachmentPatch {
+	p.Remove([]string{"spec", "nodeName"})
+	return p
+}
+
+func (p *VolumeAttachmentPatch) SetStatus(v *VolumeAttachmentStatus) *VolumeAttachmentPatch {
+	p.Set([]string{"status"}, v)
+	return p
+}
+
+func (p *VolumeAttachmentPatch) RemoveStatus() *VolumeAttachmentPatch {
+	p.Remove([]string{"status"})
+	return p
+}
+
+func (p *VolumeAttachmentPatch) SetStatusAttached(v bool) *VolumeAttachmentPatch {
+	p.Set([]string{"status", "attached"}, v)
+	return p
+}
+
+func (p *VolumeAttachmentPatch) RemoveStatusAttached() *VolumeAttachmentPatch {
+	p.Remove([]string{"status", "attached"})
+	return p
+}
+
+func (p *VolumeAttachmentPatch) SetStatusAttachmentMetadata(v map[string]string) *VolumeAttachmentPatch {
+	p.Set([]string{"status", "attachmentMetadata"}, v)
+	return p
+}
+
+func (p *VolumeAttachmentPatch) RemoveStatusAttachmentMetadata() *VolumeAttachmentPatch {
+	p.Remove([]string{"status", "attachmentMetadata"})
+	return p
+}
+
+func (p *VolumeAttachmentPatch) SetStatusAttachError(v *VolumeError) *VolumeAttachmentPatch {
+	p.Set([]string{"status", "attachError"}, v)
+	return p
+}
+
+func (p *VolumeAttachmentPatch) RemoveStatusAttachError() *VolumeAttachmentPatch {
+	p.Remove([]string{"status", "attachError"})
+	return p
+}
+
+func (p *VolumeAttachmentPatch) SetStatusAttachErrorTime(v *metav1.Time) *VolumeAttachmentPatch {
+	p.Set([]string{"status", "attachError", "time"}, v)
+	return p
+}
+
+func (p *VolumeAttachmentPatch) RemoveStatusAttachErrorTime() *VolumeAttachmentPatch {
+	p.Remove([]string{"status", "attachError", "time"})
+	return p
+}
+
+func (p *VolumeAttachmentPatch) SetStatusAttachErrorMessage(v string) *VolumeAttachmentPatch {
+	p.Set([]string{"status", "attachError", "message"}, v)
+	return p
+}
+
+func (p *VolumeAttachmentPatch) RemoveStatusAttachErrorMessage() *VolumeAttachmentPatch {
+	p.Remove([]string{"status", "attachError", "message"})
+	return p
+}
+
+func (p *VolumeAttachmentPatch) SetStatusAttachErrorErrorCode(v int) *VolumeAttachmentPatch {
+	p.Set([]string{"status", "attachError", "errorCode"}, v)
+	return p
+}
+
+func (p *VolumeAttachmentPatch) RemoveStatusAttachErrorErrorCode() *VolumeAttachmentPatch {
+	p.Remove([]string{"status", "attachError", "errorCode"})
+	return p
+}
+
+func (p *VolumeAttachmentPatch) SetStatusDetachError(v *VolumeError) *VolumeAttachmentPatch {
+	p.Set([]string{"status", "detachError"}, v)
+	return p
+}
+
+func (p *VolumeAttachmentPatch) RemoveStatusDetachError() *VolumeAttachmentPatch {
+	p.Remove([]string{"status", "detachError"})
+	return p
+}
+
+func (p *VolumeAttachmentPatch) SetStatusDetachErrorTime(v *metav1.Time) *VolumeAttachmentPatch {
+	p.Set([]string{"status", "detachError", "time"}, v)
+	return p
+}
+
+func (p *VolumeAttachmentPatch) RemoveStatusDetachErrorTime() *VolumeAttachmentPatch {
+	p.Remove([]string{"status", "detachError", "time"})
+	return p
+}
+
+func (p *VolumeAttachmentPatch) SetStatusDetachErrorMessage(v string) *VolumeAttachmentPatch {
+	p.Set([]string{"status", "detachError", "message"}, v)
+	return p
+}
+
+func (p *VolumeAttachmentPatch) RemoveStatusDetachErrorMessage() *VolumeAttachmentPatch {
+	p.Remove([]string{"status", "detachError", "message"})
+	return p
+}
+
+func (p *VolumeAttachmentPatch) SetStatusDetachErrorErrorCode(v int) *VolumeAttachmentPatch {
+	p.Set([]string{"status", "detachError", "errorCode"}, v)
+	return p
+}
+
+func (p *VolumeAttachmentPatch) RemoveStatusDetachErrorErrorCode() *VolumeAttachmentPatch {
+	p.Remove([]string{"status", "detachError", "errorCode"})
+	return p
+}
+
+func (in *VolumeAttachment) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *VolumeAttachment) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *VolumeAttachment) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	first := true
+	if in.TypeMeta.Kind != "" {
+		w.RawString("\"kind\":")
+		w.String(in.TypeMeta.Kind)
+		first = false
 	}
-	out := new(VolumeAttachment)
-	in.DeepCopyInto(out)
-	return out
+	if in.TypeMeta.APIVersion != "" {
+		if !first {
+			w.RawByte(',')
+		}
+		w.RawString("\"apiVersion\":")
+		w.String(in.TypeMeta.APIVersion)
+		first = false
+	}
+	if !first {
+		w.RawByte(',')
+	}
+	w.RawString("\"metadata\":")
+	in.ObjectMeta.MarshalEasyJSON(w)
+	w.RawString(",\"spec\":")
+	in.Spec.MarshalEasyJSON(w)
+	if in.Status != nil {
+		w.RawString(",\"status\":")
+		in.Status.MarshalEasyJSON(w)
+	}
+	w.RawByte('}')
 }
 
-func (in *VolumeAttachment) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
+func (in *VolumeAttachment) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "kind":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.TypeMeta.Kind = l.String()
+			}
+		case "apiVersion":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.TypeMeta.APIVersion = l.String()
+			}
+		case "metadata":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.ObjectMeta.UnmarshalEasyJSON(l)
+			}
+		case "spec":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.Spec.UnmarshalEasyJSON(l)
+			}
+		case "status":
+			if l.IsNull() {
+				l.Skip()
+				in.Status = nil
+			} else {
+				if in.Status == nil {
+					in.Status = new(VolumeAttachmentStatus)
+				}
+				in.Status.UnmarshalEasyJSON(l)
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
 	}
-	return nil
 }
 
 type VolumeAttachmentList struct {
@@ -475,6 +2186,117 @@ func (in *VolumeAttachmentList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+func (in *VolumeAttachmentList) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *VolumeAttachmentList) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *VolumeAttachmentList) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	first := true
+	if in.TypeMeta.Kind != "" {
+		w.RawString("\"kind\":")
+		w.String(in.TypeMeta.Kind)
+		first = false
+	}
+	if in.TypeMeta.APIVersion != "" {
+		if !first {
+			w.RawByte(',')
+		}
+		w.RawString("\"apiVersion\":")
+		w.String(in.TypeMeta.APIVersion)
+		first = false
+	}
+	if !first {
+		w.RawByte(',')
+	}
+	w.RawString("\"metadata\":")
+	in.ListMeta.MarshalEasyJSON(w)
+	w.RawString(",\"items\":")
+	if in.Items == nil {
+		w.RawString("null")
+	} else {
+		w.RawByte('[')
+		for i0 := range in.Items {
+			if i0 > 0 {
+				w.RawByte(',')
+			}
+			in.Items[i0].MarshalEasyJSON(w)
+		}
+		w.RawByte(']')
+	}
+	w.RawByte('}')
+}
+
+func (in *VolumeAttachmentList) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "kind":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.TypeMeta.Kind = l.String()
+			}
+		case "apiVersion":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.TypeMeta.APIVersion = l.String()
+			}
+		case "metadata":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.ListMeta.UnmarshalEasyJSON(l)
+			}
+		case "items":
+			if l.IsNull() {
+				l.Skip()
+				in.Items = nil
+			} else {
+				in.Items = make([]VolumeAttachment, 0)
+				l.Delim('[')
+				for !l.IsDelim(']') {
+					var v0 VolumeAttachment
+					if l.IsNull() {
+						l.Skip()
+					} else {
+						v0.UnmarshalEasyJSON(l)
+					}
+					in.Items = append(in.Items, v0)
+					l.WantComma()
+				}
+				l.Delim(']')
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
+
 type VolumeAttributesClass struct {
 	metav1.TypeMeta   `json:",inline"`
 	metav1.ObjectMeta `json:"metadata"`
@@ -525,6 +2347,193 @@ func (in *VolumeAttributesClass) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// VolumeAttributesClassToSelectableFields returns the fields of VolumeAttributesClass which can be used by the field selector.
+func VolumeAttributesClassToSelectableFields(obj *VolumeAttributesClass) fields.Set {
+	set := fields.Set{
+		"metadata.name":      obj.ObjectMeta.Name,
+		"metadata.namespace": obj.ObjectMeta.Namespace,
+	}
+	return set
+}
+
+// VolumeAttributesClassPatch builds JSON patch or JSON merge patch of VolumeAttributesClass.
+// JSON patch fails if the parent of the field doesn't exist (e.g. SetLabel to the object which has no label). MergePatch creates the parent.
+type VolumeAttributesClassPatch struct {
+	*patch.Builder
+}
+
+func NewVolumeAttributesClassPatch() *VolumeAttributesClassPatch {
+	return &VolumeAttributesClassPatch{Builder: patch.NewBuilder()}
+}
+
+func (p *VolumeAttributesClassPatch) SetLabel(key, value string) *VolumeAttributesClassPatch {
+	p.Set([]string{"metadata", "labels", key}, value)
+	return p
+}
+
+func (p *VolumeAttributesClassPatch) RemoveLabel(key string) *VolumeAttributesClassPatch {
+	p.Remove([]string{"metadata", "labels", key})
+	return p
+}
+
+func (p *VolumeAttributesClassPatch) SetAnnotation(key, value string) *VolumeAttributesClassPatch {
+	p.Set([]string{"metadata", "annotations", key}, value)
+	return p
+}
+
+func (p *VolumeAttributesClassPatch) RemoveAnnotation(key string) *VolumeAttributesClassPatch {
+	p.Remove([]string{"metadata", "annotations", key})
+	return p
+}
+
+func (p *VolumeAttributesClassPatch) SetDriverName(v string) *VolumeAttributesClassPatch {
+	p.Set([]string{"driverName"}, v)
+	return p
+}
+
+func (p *VolumeAttributesClassPatch) RemoveDriverName() *VolumeAttributesClassPatch {
+	p.Remove([]string{"driverName"})
+	return p
+}
+
+func (p *VolumeAttributesClassPatch) SetParameters(v map[string]string) *VolumeAttributesClassPatch {
+	p.Set([]string{"parameters"}, v)
+	return p
+}
+
+func (p *VolumeAttributesClassPatch) RemoveParameters() *VolumeAttributesClassPatch {
+	p.Remove([]string{"parameters"})
+	return p
+}
+
+func (in *VolumeAttributesClass) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *VolumeAttributesClass) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *VolumeAttributesClass) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	first := true
+	if in.TypeMeta.Kind != "" {
+		w.RawString("\"kind\":")
+		w.String(in.TypeMeta.Kind)
+		first = false
+	}
+	if in.TypeMeta.APIVersion != "" {
+		if !first {
+			w.RawByte(',')
+		}
+		w.RawString("\"apiVersion\":")
+		w.String(in.TypeMeta.APIVersion)
+		first = false
+	}
+	if !first {
+		w.RawByte(',')
+	}
+	w.RawString("\"metadata\":")
+	in.ObjectMeta.MarshalEasyJSON(w)
+	w.RawString(",\"driverName\":")
+	w.String(in.DriverName)
+	if len(in.Parameters) != 0 {
+		w.RawString(",\"parameters\":")
+		if in.Parameters == nil {
+			w.RawString("null")
+		} else {
+			w.RawByte('{')
+			for i0, k0 := range slices.Sorted(maps.Keys(in.Parameters)) {
+				if i0 > 0 {
+					w.RawByte(',')
+				}
+				w.String(k0)
+				w.RawByte(':')
+				v0 := in.Parameters[k0]
+				w.String(v0)
+			}
+			w.RawByte('}')
+		}
+	}
+	w.RawByte('}')
+}
+
+func (in *VolumeAttributesClass) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "kind":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.TypeMeta.Kind = l.String()
+			}
+		case "apiVersion":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.TypeMeta.APIVersion = l.String()
+			}
+		case "metadata":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.ObjectMeta.UnmarshalEasyJSON(l)
+			}
+		case "driverName":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.DriverName = l.String()
+			}
+		case "parameters":
+			if l.IsNull() {
+				l.Skip()
+				in.Parameters = nil
+			} else {
+				if in.Parameters == nil {
+					in.Parameters = make(map[string]string)
+				}
+				l.Delim('{')
+				for !l.IsDelim('}') {
+					k0 := l.String()
+					l.WantColon()
+					var v0 string
+					if l.IsNull() {
+						l.Skip()
+					} else {
+						v0 = l.String()
+					}
+					in.Parameters[k0] = v0
+					l.WantComma()
+				}
+				l.Delim('}')
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
+
 type VolumeAttributesClassList struct {
 	metav1.TypeMeta `json:",inline"`
 	metav1.ListMeta `json:"metadata"`
@@ -560,6 +2569,117 @@ func (in *VolumeAttributesClassList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+func (in *VolumeAttributesClassList) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *VolumeAttributesClassList) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *VolumeAttributesClassList) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	first := true
+	if in.TypeMeta.Kind != "" {
+		w.RawString("\"kind\":")
+		w.String(in.TypeMeta.Kind)
+		first = false
+	}
+	if in.TypeMeta.APIVersion != "" {
+		if !first {
+			w.RawByte(',')
+		}
+		w.RawString("\"apiVersion\":")
+		w.String(in.TypeMeta.APIVersion)
+		first = false
+	}
+	if !first {
+		w.RawByte(',')
+	}
+	w.RawString("\"metadata\":")
+	in.ListMeta.MarshalEasyJSON(w)
+	w.RawString(",\"items\":")
+	if in.Items == nil {
+		w.RawString("null")
+	} else {
+		w.RawByte('[')
+		for i0 := range in.Items {
+			if i0 > 0 {
+				w.RawByte(',')
+			}
+			in.Items[i0].MarshalEasyJSON(w)
+		}
+		w.RawByte(']')
+	}
+	w.RawByte('}')
+}
+
+func (in *VolumeAttributesClassList) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "kind":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.TypeMeta.Kind = l.String()
+			}
+		case "apiVersion":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.TypeMeta.APIVersion = l.String()
+			}
+		case "metadata":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.ListMeta.UnmarshalEasyJSON(l)
+			}
+		case "items":
+			if l.IsNull() {
+				l.Skip()
+				in.Items = nil
+			} else {
+				in.Items = make([]VolumeAttributesClass, 0)
+				l.Delim('[')
+				for !l.IsDelim(']') {
+					var v0 VolumeAttributesClass
+					if l.IsNull() {
+						l.Skip()
+					} else {
+						v0.UnmarshalEasyJSON(l)
+					}
+					in.Items = append(in.Items, v0)
+					l.WantComma()
+				}
+				l.Delim(']')
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
+
 type CSIDriverSpec struct {
 	// attachRequired indicates this CSI volume driver requires an attach
 	// operation (because it implements the CSI ControllerPublishVolume()
@@ -730,6 +2850,211 @@ func (in *CSIDriverSpec) DeepCopy() *CSIDriverSpec {
 	return out
 }
 
+func (in *CSIDriverSpec) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *CSIDriverSpec) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *CSIDriverSpec) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	first := true
+	if in.AttachRequired {
+		w.RawString("\"attachRequired\":")
+		w.Bool(in.AttachRequired)
+		first = false
+	}
+	if in.PodInfoOnMount {
+		if !first {
+			w.RawByte(',')
+		}
+		w.RawString("\"podInfoOnMount\":")
+		w.Bool(in.PodInfoOnMount)
+		first = false
+	}
+	if !first {
+		w.RawByte(',')
+	}
+	w.RawString("\"volumeLifecycleModes\":")
+	if in.VolumeLifecycleModes == nil {
+		w.RawString("null")
+	} else {
+		w.RawByte('[')
+		for i0 := range in.VolumeLifecycleModes {
+			if i0 > 0 {
+				w.RawByte(',')
+			}
+			w.String(string(in.VolumeLifecycleModes[i0]))
+		}
+		w.RawByte(']')
+	}
+	if in.StorageCapacity {
+		w.RawString(",\"storageCapacity\":")
+		w.Bool(in.StorageCapacity)
+	}
+	if in.FSGroupPolicy != "" {
+		w.RawString(",\"fsGroupPolicy\":")
+		w.String(string(in.FSGroupPolicy))
+	}
+	w.RawString(",\"tokenRequests\":")
+	if in.TokenRequests == nil {
+		w.RawString("null")
+	} else {
+		w.RawByte('[')
+		for i0 := range in.TokenRequests {
+			if i0 > 0 {
+				w.RawByte(',')
+			}
+			in.TokenRequests[i0].MarshalEasyJSON(w)
+		}
+		w.RawByte(']')
+	}
+	if in.RequiresRepublish {
+		w.RawString(",\"requiresRepublish\":")
+		w.Bool(in.RequiresRepublish)
+	}
+	if in.SELinuxMount {
+		w.RawString(",\"seLinuxMount\":")
+		w.Bool(in.SELinuxMount)
+	}
+	if in.NodeAllocatableUpdatePeriodSeconds != 0 {
+		w.RawString(",\"nodeAllocatableUpdatePeriodSeconds\":")
+		w.Int64(in.NodeAllocatableUpdatePeriodSeconds)
+	}
+	if in.ServiceAccountTokenInSecrets {
+		w.RawString(",\"serviceAccountTokenInSecrets\":")
+		w.Bool(in.ServiceAccountTokenInSecrets)
+	}
+	if in.PreventPodSchedulingIfMissing {
+		w.RawString(",\"preventPodSchedulingIfMissing\":")
+		w.Bool(in.PreventPodSchedulingIfMissing)
+	}
+	w.RawByte('}')
+}
+
+func (in *CSIDriverSpec) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "attachRequired":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.AttachRequired = l.Bool()
+			}
+		case "podInfoOnMount":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.PodInfoOnMount = l.Bool()
+			}
+		case "volumeLifecycleModes":
+			if l.IsNull() {
+				l.Skip()
+				in.VolumeLifecycleModes = nil
+			} else {
+				in.VolumeLifecycleModes = make([]VolumeLifecycleMode, 0)
+				l.Delim('[')
+				for !l.IsDelim(']') {
+					var v0 VolumeLifecycleMode
+					if l.IsNull() {
+						l.Skip()
+					} else {
+						v0 = VolumeLifecycleMode(l.String())
+					}
+					in.VolumeLifecycleModes = append(in.VolumeLifecycleModes, v0)
+					l.WantComma()
+				}
+				l.Delim(']')
+			}
+		case "storageCapacity":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.StorageCapacity = l.Bool()
+			}
+		case "fsGroupPolicy":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.FSGroupPolicy = FSGroupPolicy(l.String())
+			}
+		case "tokenRequests":
+			if l.IsNull() {
+				l.Skip()
+				in.TokenRequests = nil
+			} else {
+				in.TokenRequests = make([]TokenRequest, 0)
+				l.Delim('[')
+				for !l.IsDelim(']') {
+					var v0 TokenRequest
+					if l.IsNull() {
+						l.Skip()
+					} else {
+						v0.UnmarshalEasyJSON(l)
+					}
+					in.TokenRequests = append(in.TokenRequests, v0)
+					l.WantComma()
+				}
+				l.Delim(']')
+			}
+		case "requiresRepublish":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.RequiresRepublish = l.Bool()
+			}
+		case "seLinuxMount":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.SELinuxMount = l.Bool()
+			}
+		case "nodeAllocatableUpdatePeriodSeconds":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.NodeAllocatableUpdatePeriodSeconds = l.Int64()
+			}
+		case "serviceAccountTokenInSecrets":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.ServiceAccountTokenInSecrets = l.Bool()
+			}
+		case "preventPodSchedulingIfMissing":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.PreventPodSchedulingIfMissing = l.Bool()
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
+
 type CSINodeSpec struct {
 	// drivers is a list of information of all CSI Drivers existing on a node.
 	// If all drivers in the list are uninstalled, this can become empty.
@@ -756,6 +3081,81 @@ func (in *CSINodeSpec) DeepCopy() *CSINodeSpec {
 	return out
 }
 
+func (in *CSINodeSpec) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *CSINodeSpec) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *CSINodeSpec) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	w.RawString("\"drivers\":")
+	if in.Drivers == nil {
+		w.RawString("null")
+	} else {
+		w.RawByte('[')
+		for i0 := range in.Drivers {
+			if i0 > 0 {
+				w.RawByte(',')
+			}
+			in.Drivers[i0].MarshalEasyJSON(w)
+		}
+		w.RawByte(']')
+	}
+
+	w.RawByte('}')
+}
+
+func (in *CSINodeSpec) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "drivers":
+			if l.IsNull() {
+				l.Skip()
+				in.Drivers = nil
+			} else {
+				in.Drivers = make([]CSINodeDriver, 0)
+				l.Delim('[')
+				for !l.IsDelim(']') {
+					var v0 CSINodeDriver
+					if l.IsNull() {
+						l.Skip()
+					} else {
+						v0.UnmarshalEasyJSON(l)
+					}
+					in.Drivers = append(in.Drivers, v0)
+					l.WantComma()
+				}
+				l.Delim(']')
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
+
 type VolumeAttachmentSpec struct {
 	// attacher indicates the name of the volume driver that MUST handle this
 	// request. This is the name returned by GetPluginName().
@@ -780,6 +3180,73 @@ func (in *VolumeAttachmentSpec) DeepCopy() *VolumeAttachmentSpec {
 	return out
 }
 
+func (in *VolumeAttachmentSpec) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *VolumeAttachmentSpec) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *VolumeAttachmentSpec) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	w.RawString("\"attacher\":")
+	w.String(in.Attacher)
+	w.RawString(",\"source\":")
+	in.Source.MarshalEasyJSON(w)
+	w.RawString(",\"nodeName\":")
+	w.String(in.NodeName)
+
+	w.RawByte('}')
+}
+
+func (in *VolumeAttachmentSpec) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "attacher":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.Attacher = l.String()
+			}
+		case "source":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.Source.UnmarshalEasyJSON(l)
+			}
+		case "nodeName":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.NodeName = l.String()
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
+
 type VolumeAttachmentStatus struct {
 	// attached indicates the volume is successfully attached.
 	// This field must only be set by the entity completing the attach
@@ -831,6 +3298,126 @@ func (in *VolumeAttachmentStatus) DeepCopy() *VolumeAttachmentStatus {
 	return out
 }
 
+func (in *VolumeAttachmentStatus) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *VolumeAttachmentStatus) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *VolumeAttachmentStatus) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	w.RawString("\"attached\":")
+	w.Bool(in.Attached)
+	if len(in.AttachmentMetadata) != 0 {
+		w.RawString(",\"attachmentMetadata\":")
+		if in.AttachmentMetadata == nil {
+			w.RawString("null")
+		} else {
+			w.RawByte('{')
+			for i0, k0 := range slices.Sorted(maps.Keys(in.AttachmentMetadata)) {
+				if i0 > 0 {
+					w.RawByte(',')
+				}
+				w.String(k0)
+				w.RawByte(':')
+				v0 := in.AttachmentMetadata[k0]
+				w.String(v0)
+			}
+			w.RawByte('}')
+		}
+	}
+	if in.AttachError != nil {
+		w.RawString(",\"attachError\":")
+		in.AttachError.MarshalEasyJSON(w)
+	}
+	if in.DetachError != nil {
+		w.RawString(",\"detachError\":")
+		in.DetachError.MarshalEasyJSON(w)
+	}
+
+	w.RawByte('}')
+}
+
+func (in *VolumeAttachmentStatus) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "attached":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.Attached = l.Bool()
+			}
+		case "attachmentMetadata":
+			if l.IsNull() {
+				l.Skip()
+				in.AttachmentMetadata = nil
+			} else {
+				if in.AttachmentMetadata == nil {
+					in.AttachmentMetadata = make(map[string]string)
+				}
+				l.Delim('{')
+				for !l.IsDelim('}') {
+					k0 := l.String()
+					l.WantColon()
+					var v0 string
+					if l.IsNull() {
+						l.Skip()
+					} else {
+						v0 = l.String()
+					}
+					in.AttachmentMetadata[k0] = v0
+					l.WantComma()
+				}
+				l.Delim('}')
+			}
+		case "attachError":
+			if l.IsNull() {
+				l.Skip()
+				in.AttachError = nil
+			} else {
+				if in.AttachError == nil {
+					in.AttachError = new(VolumeError)
+				}
+				in.AttachError.UnmarshalEasyJSON(l)
+			}
+		case "detachError":
+			if l.IsNull() {
+				l.Skip()
+				in.DetachError = nil
+			} else {
+				if in.DetachError == nil {
+					in.DetachError = new(VolumeError)
+				}
+				in.DetachError.UnmarshalEasyJSON(l)
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
+
 type TokenRequest struct {
 	// audience is the intended audience of the token in "TokenRequestSpec".
 	// It will default to the audiences of kube apiserver.
@@ -853,6 +3440,67 @@ func (in *TokenRequest) DeepCopy() *TokenRequest {
 	return out
 }
 
+func (in *TokenRequest) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *TokenRequest) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *TokenRequest) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	w.RawString("\"audience\":")
+	w.String(in.Audience)
+	if in.ExpirationSeconds != 0 {
+		w.RawString(",\"expirationSeconds\":")
+		w.Int64(in.ExpirationSeconds)
+	}
+
+	w.RawByte('}')
+}
+
+func (in *TokenRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "audience":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.Audience = l.String()
+			}
+		case "expirationSeconds":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.ExpirationSeconds = l.Int64()
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
+
 type CSINodeDriver struct {
 	// name represents the name of the CSI driver that this object refers to.
 	// This MUST be the same name returned by the CSI GetPluginName() call for
@@ -907,6 +3555,111 @@ func (in *CSINodeDriver) DeepCopy() *CSINodeDriver {
 	return out
 }
 
+func (in *CSINodeDriver) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *CSINodeDriver) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *CSINodeDriver) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	w.RawString("\"name\":")
+	w.String(in.Name)
+	w.RawString(",\"nodeID\":")
+	w.String(in.NodeID)
+	w.RawString(",\"topologyKeys\":")
+	if in.TopologyKeys == nil {
+		w.RawString("null")
+	} else {
+		w.RawByte('[')
+		for i0 := range in.TopologyKeys {
+			if i0 > 0 {
+				w.RawByte(',')
+			}
+			w.String(in.TopologyKeys[i0])
+		}
+		w.RawByte(']')
+	}
+	if in.Allocatable != nil {
+		w.RawString(",\"allocatable\":")
+		in.Allocatable.MarshalEasyJSON(w)
+	}
+
+	w.RawByte('}')
+}
+
+func (in *CSINodeDriver) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "name":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.Name = l.String()
+			}
+		case "nodeID":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.NodeID = l.String()
+			}
+		case "topologyKeys":
+			if l.IsNull() {
+				l.Skip()
+				in.TopologyKeys = nil
+			} else {
+				in.TopologyKeys = make([]string, 0)
+				l.Delim('[')
+				for !l.IsDelim(']') {
+					var v0 string
+					if l.IsNull() {
+						l.Skip()
+					} else {
+						v0 = l.String()
+					}
+					in.TopologyKeys = append(in.TopologyKeys, v0)
+					l.WantComma()
+				}
+				l.Delim(']')
+			}
+		case "allocatable":
+			if l.IsNull() {
+				l.Skip()
+				in.Allocatable = nil
+			} else {
+				if in.Allocatable == nil {
+					in.Allocatable = new(VolumeNodeResources)
+				}
+				in.Allocatable.UnmarshalEasyJSON(l)
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
+
 type VolumeAttachmentSource struct {
 	// persistentVolumeName represents the name of the persistent volume to attach.
 	PersistentVolumeName string `json:"persistentVolumeName,omitempty"`
@@ -937,6 +3690,78 @@ func (in *VolumeAttachmentSource) DeepCopy() *VolumeAttachmentSource {
 	return out
 }
 
+func (in *VolumeAttachmentSource) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *VolumeAttachmentSource) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *VolumeAttachmentSource) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	first := true
+	if in.PersistentVolumeName != "" {
+		w.RawString("\"persistentVolumeName\":")
+		w.String(in.PersistentVolumeName)
+		first = false
+	}
+	if in.InlineVolumeSpec != nil {
+		if !first {
+			w.RawByte(',')
+		}
+		w.RawString("\"inlineVolumeSpec\":")
+		in.InlineVolumeSpec.MarshalEasyJSON(w)
+		first = false
+	}
+	w.RawByte('}')
+}
+
+func (in *VolumeAttachmentSource) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "persistentVolumeName":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.PersistentVolumeName = l.String()
+			}
+		case "inlineVolumeSpec":
+			if l.IsNull() {
+				l.Skip()
+				in.InlineVolumeSpec = nil
+			} else {
+				if in.InlineVolumeSpec == nil {
+					in.InlineVolumeSpec = new(corev1.PersistentVolumeSpec)
+				}
+				in.InlineVolumeSpec.UnmarshalEasyJSON(l)
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
+
 type VolumeError struct {
 	// time represents the time the error was encountered.
 	Time *metav1.Time `json:"time,omitempty"`
@@ -967,6 +3792,84 @@ func (in *VolumeError) DeepCopy() *VolumeError {
 	return out
 }
 
+func (in *VolumeError) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *VolumeError) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *VolumeError) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	first := true
+	if in.Time != nil {
+		w.RawString("\"time\":")
+		w.Raw(json.Marshal(in.Time))
+		first = false
+	}
+	if in.Message != "" {
+		if !first {
+			w.RawByte(',')
+		}
+		w.RawString("\"message\":")
+		w.String(in.Message)
+		first = false
+	}
+	if in.ErrorCode != 0 {
+		if !first {
+			w.RawByte(',')
+		}
+		w.RawString("\"errorCode\":")
+		w.Int(in.ErrorCode)
+		first = false
+	}
+	w.RawByte('}')
+}
+
+func (in *VolumeError) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "time":
+			l.AddError(json.Unmarshal(l.Raw(), &in.Time))
+		case "message":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.Message = l.String()
+			}
+		case "errorCode":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.ErrorCode = l.Int()
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
+
 type VolumeNodeResources struct {
 	// count indicates the maximum number of unique volumes managed by the CSI driver that can be used on a node.
 	// A volume that is both attached and mounted on a node is considered to be used once, not twice.
@@ -987,3 +3890,56 @@ func (in *VolumeNodeResources) DeepCopy() *VolumeNodeResources {
 	in.DeepCopyInto(out)
 	return out
 }
+
+func (in *VolumeNodeResources) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *VolumeNodeResources) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *VolumeNodeResources) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	if in.Count != 0 {
+		w.RawString("\"count\":")
+		w.Int(in.Count)
+	}
+
+	w.RawByte('}')
+}
+
+func (in *VolumeNodeResources) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "count":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.Count = l.Int()
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
@@ -0,0 +1,385 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	k8smetav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/runtime/serializer"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/cache"
+
+	"go.f110.dev/kubeproto/go/apis/metav1"
+	"go.f110.dev/kubeproto/go/internal/testapis/testv1"
+	"go.f110.dev/kubeproto/go/typedclient"
+)
+
+var (
+	Scheme         = runtime.NewScheme()
+	ParameterCodec = runtime.NewParameterCodec(Scheme)
+	Codecs         = serializer.NewCodecFactory(Scheme)
+	AddToScheme    = localSchemeBuilder.AddToScheme
+)
+
+var localSchemeBuilder = runtime.SchemeBuilder{
+	testv1.AddToScheme,
+}
+
+func init() {
+	for _, v := range []func(*runtime.Scheme) error{
+		testv1.AddToScheme,
+	} {
+		if err := v(Scheme); err != nil {
+			panic(err)
+		}
+	}
+}
+
+type Backend = typedclient.Backend
+
+type Set struct {
+	TestV1 *TestV1
+}
+
+func NewSet(cfg *rest.Config) (*Set, error) {
+	s := &Set{}
+	{
+		conf := *cfg
+		conf.GroupVersion = &testv1.SchemaGroupVersion
+		conf.APIPath = "/apis"
+		conf.NegotiatedSerializer = Codecs.WithoutConversion()
+		c, err := rest.RESTClientFor(&conf)
+		if err != nil {
+			return nil, err
+		}
+		s.TestV1 = NewTestV1Client(typedclient.NewRESTBackend(c, ParameterCodec), &conf)
+	}
+
+	return s, nil
+}
+
+type TestV1 struct {
+	backend Backend
+	config  *rest.Config
+
+	widgetClient *typedclient.ResourceClient[*testv1.Widget, *testv1.WidgetList]
+}
+
+func NewTestV1Client(b Backend, config *rest.Config) *TestV1 {
+	return &TestV1{
+		backend:      b,
+		config:       config,
+		widgetClient: typedclient.NewResourceClient[*testv1.Widget, *testv1.WidgetList](b, schema.GroupVersionResource{Group: "test.f110.dev", Version: "v1", Resource: "widgets"}),
+	}
+}
+
+// Widgets returns the client of Widget.
+func (c *TestV1) Widgets() *typedclient.ResourceClient[*testv1.Widget, *testv1.WidgetList] {
+	return c.widgetClient
+}
+
+func (c *TestV1) GetWidget(ctx context.Context, namespace string, name string, opts metav1.GetOptions) (*testv1.Widget, error) {
+	return c.widgetClient.Get(ctx, namespace, name, opts)
+}
+
+func (c *TestV1) CreateWidget(ctx context.Context, v *testv1.Widget, opts metav1.CreateOptions) (*testv1.Widget, error) {
+	return c.widgetClient.Create(ctx, v, opts)
+}
+
+func (c *TestV1) UpdateWidget(ctx context.Context, v *testv1.Widget, opts metav1.UpdateOptions) (*testv1.Widget, error) {
+	return c.widgetClient.Update(ctx, v, opts)
+}
+
+func (c *TestV1) UpdateStatusWidget(ctx context.Context, v *testv1.Widget, opts metav1.UpdateOptions) (*testv1.Widget, error) {
+	return c.widgetClient.UpdateStatus(ctx, v, opts)
+}
+
+func (c *TestV1) PatchWidget(ctx context.Context, namespace string, name string, pt types.PatchType, data []byte, opts metav1.PatchOptions) (*testv1.Widget, error) {
+	return c.widgetClient.Patch(ctx, namespace, name, pt, data, opts)
+}
+
+func (c *TestV1) PatchWidgetStatus(ctx context.Context, namespace string, name string, pt types.PatchType, data []byte, opts metav1.PatchOptions) (*testv1.Widget, error) {
+	return c.widgetClient.Patch(ctx, namespace, name, pt, data, opts, "status")
+}
+
+func (c *TestV1) DeleteWidget(ctx context.Context, namespace string, name string, opts metav1.DeleteOptions) error {
+	return c.widgetClient.Delete(ctx, namespace, name, opts)
+}
+
+func (c *TestV1) DeleteCollectionWidget(ctx context.Context, namespace string, opts metav1.DeleteOptions, listOpts metav1.ListOptions) error {
+	return c.widgetClient.DeleteCollection(ctx, namespace, opts, listOpts)
+}
+
+func (c *TestV1) ListWidget(ctx context.Context, namespace string, opts metav1.ListOptions) (*testv1.WidgetList, error) {
+	return c.widgetClient.List(ctx, namespace, opts)
+}
+
+func (c *TestV1) WatchWidget(ctx context.Context, namespace string, opts metav1.ListOptions) (watch.Interface, error) {
+	return c.widgetClient.Watch(ctx, namespace, opts)
+}
+
+// InformerOptions is the options of the informers.
+type InformerOptions struct {
+	// Namespaces is the set of the namespaces which are watched by one informer.
+	// All namespaces are watched if Namespaces is empty. Namespaces is ignored by the cluster scoped resources.
+	Namespaces    []string
+	LabelSelector string
+	FieldSelector string
+	// TweakListOptions modifies the options of the list and the watch.
+	// The informers are shared if the options which are modified by TweakListOptions are the same.
+	TweakListOptions func(*k8smetav1.ListOptions)
+}
+
+func (o InformerOptions) namespaces() []string {
+	var namespaces []string
+	for _, v := range o.Namespaces {
+		if v == metav1.NamespaceAll {
+			return nil
+		}
+		namespaces = append(namespaces, v)
+	}
+	sort.Strings(namespaces)
+	return namespaces
+}
+
+// key returns the key of InformerCache.
+// The key consists of the namespaces and the list options which are modified by the options.
+func (o InformerOptions) key(namespaced bool) string {
+	var namespaces []string
+	if namespaced {
+		namespaces = o.namespaces()
+	}
+	listOptions := &k8smetav1.ListOptions{}
+	o.tweakListOptions(listOptions)
+	return strings.Join(namespaces, ",") + "/" + listOptions.String()
+}
+
+func (o InformerOptions) tweakListOptions(options *k8smetav1.ListOptions) {
+	if o.LabelSelector != "" {
+		options.LabelSelector = o.LabelSelector
+	}
+	if o.FieldSelector != "" {
+		options.FieldSelector = o.FieldSelector
+	}
+	if o.TweakListOptions != nil {
+		o.TweakListOptions(options)
+	}
+}
+
+type informerCacheKey struct {
+	typ    reflect.Type
+	filter string
+}
+
+type InformerCache struct {
+	mu        sync.Mutex
+	informers map[informerCacheKey]cache.SharedIndexInformer
+}
+
+func NewInformerCache() *InformerCache {
+	return &InformerCache{informers: make(map[informerCacheKey]cache.SharedIndexInformer)}
+}
+
+func (c *InformerCache) Write(obj runtime.Object, newFunc func() cache.SharedIndexInformer) cache.SharedIndexInformer {
+	return c.WriteWithFilter(obj, "", newFunc)
+}
+
+// WriteWithFilter returns the informer of obj and filter. newFunc is called if the informer doesn't exist.
+// filter identifies the informers of the same type which watch the different objects.
+func (c *InformerCache) WriteWithFilter(obj runtime.Object, filter string, newFunc func() cache.SharedIndexInformer) cache.SharedIndexInformer {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	key := informerCacheKey{typ: reflect.TypeOf(obj), filter: filter}
+	if v, ok := c.informers[key]; ok {
+		return v
+	}
+	informer := newFunc()
+	c.informers[key] = informer
+
+	return informer
+}
+
+func (c *InformerCache) Informers() []cache.SharedIndexInformer {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	a := make([]cache.SharedIndexInformer, 0, len(c.informers))
+	for _, v := range c.informers {
+		a = append(a, v)
+	}
+
+	return a
+}
+
+type InformerFactory struct {
+	set   *Set
+	cache *InformerCache
+
+	options      InformerOptions
+	resyncPeriod time.Duration
+}
+
+func NewInformerFactory(s *Set, c *InformerCache, namespace string, resyncPeriod time.Duration) *InformerFactory {
+	return NewInformerFactoryWithOptions(s, c, resyncPeriod, InformerOptions{Namespaces: []string{namespace}})
+}
+
+// NewInformerFactoryWithOptions returns InformerFactory of which informers are filtered by opts.
+// The informers are shared with the other factories which have the same cache and the same filter.
+func NewInformerFactoryWithOptions(s *Set, c *InformerCache, resyncPeriod time.Duration, opts InformerOptions) *InformerFactory {
+	return &InformerFactory{set: s, cache: c, options: opts, resyncPeriod: resyncPeriod}
+}
+
+func (f *InformerFactory) Cache() *InformerCache {
+	return f.cache
+}
+
+// AddIndexers adds indexers to the informer of obj. The indexer which has the same name as the existing indexer is ignored.
+// The indexers should be added before the informer starts because the existing objects are indexed again.
+func (f *InformerFactory) AddIndexers(obj runtime.Object, indexers cache.Indexers) error {
+	informer := f.InformerFor(obj)
+	if informer == nil {
+		return fmt.Errorf("unknown object: %T", obj)
+	}
+	existing := informer.GetIndexer().GetIndexers()
+	newIndexers := make(cache.Indexers)
+	for k, v := range indexers {
+		if _, ok := existing[k]; ok {
+			continue
+		}
+		newIndexers[k] = v
+	}
+	if len(newIndexers) == 0 {
+		return nil
+	}
+	return informer.AddIndexers(newIndexers)
+}
+
+func (f *InformerFactory) InformerFor(obj runtime.Object) cache.SharedIndexInformer {
+	switch obj.(type) {
+	case *testv1.Widget:
+		return NewTestV1InformerWithOptions(f.cache, f.set.TestV1, f.resyncPeriod, f.options).WidgetInformer()
+	default:
+		return nil
+	}
+}
+
+func (f *InformerFactory) InformerForResource(gvr schema.GroupVersionResource) cache.SharedIndexInformer {
+	switch gvr {
+	case testv1.SchemaGroupVersion.WithResource("widgets"):
+		return NewTestV1InformerWithOptions(f.cache, f.set.TestV1, f.resyncPeriod, f.options).WidgetInformer()
+	default:
+		return nil
+	}
+}
+
+// Run starts all informers in the cache. ctx is passed to the list and the watch of the informers,
+// and the informers stop when ctx is canceled.
+func (f *InformerFactory) Run(ctx context.Context) {
+	for _, v := range f.cache.Informers() {
+		go v.RunWithContext(ctx)
+	}
+}
+
+type TestV1Informer struct {
+	cache        *InformerCache
+	client       *TestV1
+	options      InformerOptions
+	resyncPeriod time.Duration
+	indexers     cache.Indexers
+}
+
+func NewTestV1Informer(c *InformerCache, client *TestV1, namespace string, resyncPeriod time.Duration) *TestV1Informer {
+	return NewTestV1InformerWithOptions(c, client, resyncPeriod, InformerOptions{Namespaces: []string{namespace}})
+}
+
+func NewTestV1InformerWithOptions(c *InformerCache, client *TestV1, resyncPeriod time.Duration, opts InformerOptions) *TestV1Informer {
+	return &TestV1Informer{
+		cache:        c,
+		client:       client,
+		options:      opts,
+		resyncPeriod: resyncPeriod,
+		indexers:     cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc},
+	}
+}
+
+func (f *TestV1Informer) WidgetInformer() cache.SharedIndexInformer {
+	return f.cache.WriteWithFilter(&testv1.Widget{}, f.options.key(true), func() cache.SharedIndexInformer {
+		return cache.NewSharedIndexInformer(
+			typedclient.NewListWatch(f.client.backend, f.options.namespaces(), f.options.tweakListOptions,
+				func(ctx context.Context, namespace string, options k8smetav1.ListOptions) (runtime.Object, error) {
+					return f.client.ListWidget(ctx, namespace, metav1.ListOptionsFromUpstream(options))
+				},
+				func(ctx context.Context, namespace string, options k8smetav1.ListOptions) (watch.Interface, error) {
+					return f.client.WatchWidget(ctx, namespace, metav1.ListOptionsFromUpstream(options))
+				},
+			),
+			&testv1.Widget{},
+			f.resyncPeriod,
+			f.indexers,
+		)
+	})
+}
+
+func (f *TestV1Informer) WidgetLister() *TestV1WidgetLister {
+	return NewTestV1WidgetLister(f.WidgetInformer().GetIndexer())
+}
+
+// NewRESTMapper returns meta.RESTMapper which knows all kinds of Set.
+// The core group is preferred over the other groups and the newer version is preferred in the same group.
+func NewRESTMapper() *typedclient.StaticRESTMapper {
+	return typedclient.NewStaticRESTMapper(
+		typedclient.ResourceMapping{GroupVersionKind: testv1.SchemaGroupVersion.WithKind("Widget"), Resource: "widgets", SingularResource: "widget", Namespaced: true},
+	)
+}
+
+type TestV1WidgetLister struct {
+	indexer cache.Indexer
+}
+
+func NewTestV1WidgetLister(indexer cache.Indexer) *TestV1WidgetLister {
+	return &TestV1WidgetLister{indexer: indexer}
+}
+
+func (x *TestV1WidgetLister) List(namespace string, selector labels.Selector) ([]*testv1.Widget, error) {
+	var ret []*testv1.Widget
+	err := cache.ListAllByNamespace(x.indexer, namespace, selector, func(m interface{}) {
+		ret = append(ret, m.(*testv1.Widget).DeepCopy())
+	})
+	return ret, err
+}
+
+func (x *TestV1WidgetLister) Get(namespace, name string) (*testv1.Widget, error) {
+	obj, exists, err := x.indexer.GetByKey(namespace + "/" + name)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, k8serrors.NewNotFound(testv1.SchemaGroupVersion.WithResource("widget").GroupResource(), name)
+	}
+	return obj.(*testv1.Widget).DeepCopy(), nil
+}
+
+// ListByIndex returns the objects of which indexedValue of the index is matched.
+func (x *TestV1WidgetLister) ListByIndex(indexName, indexedValue string) ([]*testv1.Widget, error) {
+	objs, err := x.indexer.ByIndex(indexName, indexedValue)
+	if err != nil {
+		return nil, err
+	}
+	ret := make([]*testv1.Widget, 0, len(objs))
+	for _, v := range objs {
+		ret = append(ret, v.(*testv1.Widget).DeepCopy())
+	}
+	return ret, nil
+}
@@ -0,0 +1,455 @@
+package testingclient
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"sync"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	k8smetav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/runtime/serializer"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/rest"
+	k8stesting "k8s.io/client-go/testing"
+
+	"go.f110.dev/kubeproto/go/apis/metav1"
+	"go.f110.dev/kubeproto/go/internal/testapis/client"
+	"go.f110.dev/kubeproto/go/internal/testapis/testv1"
+)
+
+var (
+	codecs = serializer.NewCodecFactory(client.Scheme)
+)
+
+type Set struct {
+	client.Set
+
+	fake k8stesting.Fake
+	// scheme is the scheme of the tracker. The kinds which are unknown to the client can be registered to scheme.
+	scheme  *runtime.Scheme
+	tracker k8stesting.ObjectTracker
+	proxy   *proxyHandlers
+}
+
+func NewSet() *Set {
+	s := &Set{proxy: &proxyHandlers{handlers: make(map[string]http.Handler)}}
+	s.scheme = runtime.NewScheme()
+	if err := client.AddToScheme(s.scheme); err != nil {
+		panic(err)
+	}
+	s.tracker = k8stesting.NewObjectTracker(s.scheme, codecs.UniversalDecoder())
+	s.fake.AddReactor("delete-collection", "*", deleteCollectionReaction(s.tracker))
+	s.fake.AddReactor("*", "*", k8stesting.ObjectReaction(s.tracker))
+	s.fake.AddWatchReactor("*", func(action k8stesting.Action) (handled bool, ret watch.Interface, err error) {
+		w, err := s.tracker.Watch(action.GetResource(), action.GetNamespace())
+		if err != nil {
+			return false, nil, err
+		}
+		return true, w, nil
+	})
+
+	s.TestV1 = client.NewTestV1Client(&fakerBackend{fake: &s.fake, tracker: s.tracker, proxy: s.proxy}, nil)
+	return s
+}
+
+func (s *Set) Tracker() k8stesting.ObjectTracker {
+	return s.tracker
+}
+
+func (s *Set) Actions() []k8stesting.Action {
+	return s.fake.Actions()
+}
+
+func (s *Set) ClearActions() {
+	s.fake.ClearActions()
+}
+
+// PrependReactor adds the reactor which is called before the reactors of the tracker.
+func (s *Set) PrependReactor(verb, resource string, reaction k8stesting.ReactionFunc) {
+	s.fake.PrependReactor(verb, resource, reaction)
+}
+
+// RegisterProxyHandler registers the handler which serves the requests through the proxy sub resource.
+// resourceName is "services" or "pods". The handler serves the requests to all ports of the object.
+// The request is recorded as k8stesting.ProxyGetAction whose verb is the lower case of the method.
+// If the reactor of the action returns the error, the request fails with the error.
+func (s *Set) RegisterProxyHandler(resourceName, namespace, name string, h http.Handler) {
+	s.proxy.mu.Lock()
+	defer s.proxy.mu.Unlock()
+
+	s.proxy.handlers[resourceName+"/"+namespace+"/"+name] = h
+}
+
+var resourceKinds = map[schema.GroupVersionResource]string{
+	{Group: "test.f110.dev", Version: "v1", Resource: "widgets"}: "Widget",
+}
+
+// selectableFields returns the fields of obj which can be used by the field selector.
+func selectableFields(obj runtime.Object) fields.Set {
+	switch v := obj.(type) {
+	case *testv1.Widget:
+		return testv1.WidgetToSelectableFields(v)
+	}
+	objMeta := obj.(metav1.Object).GetObjectMeta()
+	return fields.Set{"metadata.name": objMeta.Name, "metadata.namespace": objMeta.Namespace}
+}
+
+// validateFieldSelector returns the error if selector has the field which is not selectable for gvk.
+// The kind which doesn't have the selectable fields supports only metadata.name and metadata.namespace like the API server.
+func validateFieldSelector(gvk schema.GroupVersionKind, selector fields.Selector) error {
+	for _, r := range selector.Requirements() {
+		if _, _, err := client.Scheme.ConvertFieldLabel(gvk, r.Field, r.Value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+type fakerBackend struct {
+	fake    *k8stesting.Fake
+	tracker k8stesting.ObjectTracker
+	proxy   *proxyHandlers
+}
+
+type proxyHandlers struct {
+	mu       sync.RWMutex
+	handlers map[string]http.Handler
+}
+
+func (p *proxyHandlers) get(resourceName, namespace, name string) http.Handler {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+
+	return p.handlers[resourceName+"/"+namespace+"/"+name]
+}
+
+type roundTripperFunc func(req *http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+func (f *fakerBackend) Get(ctx context.Context, resourceName, namespace, name string, opts metav1.GetOptions, result runtime.Object) (runtime.Object, error) {
+	gvks, _, err := client.Scheme.ObjectKinds(result)
+	if err != nil {
+		return nil, err
+	}
+	gvk := gvks[0]
+	obj, err := f.fake.Invokes(k8stesting.NewGetAction(gvk.GroupVersion().WithResource(resourceName), namespace, name), result)
+	if obj == nil {
+		return nil, err
+	}
+	return obj.DeepCopyObject(), nil
+}
+
+func (f *fakerBackend) List(ctx context.Context, resourceName, namespace string, opts metav1.ListOptions, result runtime.Object) (runtime.Object, error) {
+	gvks, _, err := client.Scheme.ObjectKinds(result)
+	if err != nil {
+		return nil, err
+	}
+	gvk := gvks[0]
+	if strings.HasSuffix(gvk.Kind, "List") {
+		gvk.Kind = strings.TrimSuffix(gvk.Kind, "List")
+	}
+	label, err := labels.Parse(opts.LabelSelector)
+	if err != nil {
+		return nil, err
+	}
+	field, err := fields.ParseSelector(opts.FieldSelector)
+	if err != nil {
+		return nil, err
+	}
+	if err := validateFieldSelector(gvk, field); err != nil {
+		return nil, err
+	}
+	k8sListOpt := k8smetav1.ListOptions{
+		LabelSelector:   opts.LabelSelector,
+		FieldSelector:   opts.FieldSelector,
+		ResourceVersion: opts.ResourceVersion,
+	}
+	obj, err := f.fake.Invokes(k8stesting.NewListAction(gvk.GroupVersion().WithResource(resourceName), gvk, namespace, k8sListOpt), result)
+
+	if obj == nil {
+		return nil, err
+	}
+
+	objs, err := meta.ExtractList(obj)
+	if err != nil {
+		return nil, err
+	}
+	filtered := make([]runtime.Object, 0)
+	for _, item := range objs {
+		m := item.(metav1.Object)
+		objMeta := m.GetObjectMeta()
+		if label.Matches(labels.Set(objMeta.Labels)) && field.Matches(selectableFields(item)) {
+			filtered = append(filtered, item)
+		}
+	}
+	if err := meta.SetList(obj, filtered); err != nil {
+		return nil, err
+	}
+	return obj.DeepCopyObject(), err
+}
+
+func (f *fakerBackend) Create(ctx context.Context, resourceName string, obj runtime.Object, opts metav1.CreateOptions, result runtime.Object) (runtime.Object, error) {
+	gvks, _, err := client.Scheme.ObjectKinds(result)
+	if err != nil {
+		return nil, err
+	}
+	gvk := gvks[0]
+	m := obj.(metav1.Object)
+	objMeta := m.GetObjectMeta()
+	obj, err = f.fake.Invokes(k8stesting.NewCreateAction(gvk.GroupVersion().WithResource(resourceName), objMeta.Namespace, obj), result)
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.DeepCopyObject(), err
+}
+
+func (f *fakerBackend) Update(ctx context.Context, resourceName string, obj runtime.Object, opts metav1.UpdateOptions, result runtime.Object) (runtime.Object, error) {
+	gvks, _, err := client.Scheme.ObjectKinds(result)
+	if err != nil {
+		return nil, err
+	}
+	gvk := gvks[0]
+	m := obj.(metav1.Object)
+	objMeta := m.GetObjectMeta()
+	obj, err = f.fake.Invokes(k8stesting.NewUpdateAction(gvk.GroupVersion().WithResource(resourceName), objMeta.Namespace, obj), result)
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.DeepCopyObject(), err
+}
+func (f *fakerBackend) UpdateStatus(ctx context.Context, resourceName string, obj runtime.Object, opts metav1.UpdateOptions, result runtime.Object) (runtime.Object, error) {
+	gvks, _, err := client.Scheme.ObjectKinds(result)
+	if err != nil {
+		return nil, err
+	}
+	gvk := gvks[0]
+	m := obj.(metav1.Object)
+	objMeta := m.GetObjectMeta()
+	obj, err = f.fake.Invokes(k8stesting.NewUpdateSubresourceAction(gvk.GroupVersion().WithResource(resourceName), "status", objMeta.Namespace, obj), result)
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.DeepCopyObject(), err
+}
+func (f *fakerBackend) Delete(ctx context.Context, gvr schema.GroupVersionResource, namespace, name string, opts metav1.DeleteOptions) error {
+	_, err := f.fake.Invokes(k8stesting.NewDeleteAction(gvr, namespace, name), nil)
+
+	return err
+}
+func (f *fakerBackend) DeleteCollection(ctx context.Context, gvr schema.GroupVersionResource, namespace string, opts metav1.DeleteOptions, listOpts metav1.ListOptions) error {
+	if _, err := labels.Parse(listOpts.LabelSelector); err != nil {
+		return err
+	}
+	if _, err := fields.ParseSelector(listOpts.FieldSelector); err != nil {
+		return err
+	}
+	k8sListOpt := k8smetav1.ListOptions{
+		LabelSelector:   listOpts.LabelSelector,
+		FieldSelector:   listOpts.FieldSelector,
+		ResourceVersion: listOpts.ResourceVersion,
+	}
+	_, err := f.fake.Invokes(k8stesting.NewDeleteCollectionAction(gvr, namespace, k8sListOpt), nil)
+
+	return err
+}
+
+// deleteCollectionReaction returns the reactor which deletes the objects matched to the selectors of the action from tracker.
+func deleteCollectionReaction(tracker k8stesting.ObjectTracker) k8stesting.ReactionFunc {
+	return func(action k8stesting.Action) (bool, runtime.Object, error) {
+		a := action.(k8stesting.DeleteCollectionAction)
+		gvr := a.GetResource()
+		kind, ok := resourceKinds[gvr]
+		if !ok {
+			return true, nil, fmt.Errorf("unknown resource: %s", gvr.String())
+		}
+		restrictions := a.GetListRestrictions()
+		if err := validateFieldSelector(gvr.GroupVersion().WithKind(kind), restrictions.Fields); err != nil {
+			return true, nil, err
+		}
+		list, err := tracker.List(gvr, gvr.GroupVersion().WithKind(kind), a.GetNamespace())
+		if err != nil {
+			return true, nil, err
+		}
+		objs, err := meta.ExtractList(list)
+		if err != nil {
+			return true, nil, err
+		}
+		for _, item := range objs {
+			objMeta := item.(metav1.Object).GetObjectMeta()
+			if !restrictions.Labels.Matches(labels.Set(objMeta.Labels)) || !restrictions.Fields.Matches(selectableFields(item)) {
+				continue
+			}
+			if err := tracker.Delete(gvr, objMeta.Namespace, objMeta.Name); err != nil {
+				return true, nil, err
+			}
+		}
+		return true, nil, nil
+	}
+}
+
+// Watch watches the objects which are matched to the label selector and the field selector of opts.
+func (f *fakerBackend) Watch(ctx context.Context, gvr schema.GroupVersionResource, namespace string, opts metav1.ListOptions) (watch.Interface, error) {
+	label, err := labels.Parse(opts.LabelSelector)
+	if err != nil {
+		return nil, err
+	}
+	field, err := fields.ParseSelector(opts.FieldSelector)
+	if err != nil {
+		return nil, err
+	}
+	if kind, ok := resourceKinds[gvr]; ok {
+		if err := validateFieldSelector(gvr.GroupVersion().WithKind(kind), field); err != nil {
+			return nil, err
+		}
+	}
+	w, err := f.fake.InvokesWatch(k8stesting.NewWatchAction(gvr, namespace, opts))
+	if err != nil || (label.Empty() && field.Empty()) {
+		return w, err
+	}
+	return watch.Filter(w, func(in watch.Event) (watch.Event, bool) {
+		m, ok := in.Object.(metav1.Object)
+		if !ok {
+			return in, true
+		}
+		return in, label.Matches(labels.Set(m.GetObjectMeta().Labels)) && field.Matches(selectableFields(in.Object))
+	}), nil
+}
+
+// IsWatchListSemanticsUnSupported reports that the tracker doesn't send the initial events and the bookmark.
+// The informers fall back to the list and the watch.
+func (f *fakerBackend) IsWatchListSemanticsUnSupported() bool {
+	return true
+}
+func (f *fakerBackend) Patch(ctx context.Context, resourceName, namespace, name string, pt types.PatchType, data []byte, opts metav1.PatchOptions, result runtime.Object, subresources ...string) (runtime.Object, error) {
+	gvks, _, err := client.Scheme.ObjectKinds(result)
+	if err != nil {
+		return nil, err
+	}
+	gvk := gvks[0]
+	k8sPatchOpt := k8smetav1.PatchOptions{
+		DryRun:       opts.DryRun,
+		FieldManager: opts.FieldManager,
+	}
+	if opts.Force {
+		force := true
+		k8sPatchOpt.Force = &force
+	}
+	obj, err := f.fake.Invokes(k8stesting.NewPatchSubresourceActionWithOptions(gvk.GroupVersion().WithResource(resourceName), namespace, name, pt, data, k8sPatchOpt, subresources...), result)
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.DeepCopyObject(), err
+}
+
+func (f *fakerBackend) SubResource(ctx context.Context, verb string, gvr schema.GroupVersionResource, namespace, name, subresource string, obj, opts, result runtime.Object) (runtime.Object, error) {
+	var action k8stesting.Action
+	switch verb {
+	case "GET":
+		action = k8stesting.NewGetSubresourceAction(gvr, namespace, subresource, name)
+	case "POST":
+		action = k8stesting.NewCreateSubresourceAction(gvr, name, subresource, namespace, obj)
+	case "PUT":
+		action = k8stesting.NewUpdateSubresourceAction(gvr, subresource, namespace, obj)
+	default:
+		return nil, fmt.Errorf("%s is not supported", verb)
+	}
+	ret, err := f.fake.Invokes(action, result)
+
+	if ret == nil {
+		return nil, err
+	}
+	if reflect.TypeOf(ret) != reflect.TypeOf(result) {
+		// The tracker returns the request object as it is if the type of the request is not the parent object.
+		// (e.g. Binding of Pod) The tracker doesn't have the side effect of the sub resource like binding the pod
+		// to the node or evicting the pod. The test which depends on the side effect has to add a reactor.
+		if obj != nil && reflect.TypeOf(ret) == reflect.TypeOf(obj) {
+			return result, err
+		}
+		// The tracker returns the parent object instead of the sub resource.
+		return nil, fmt.Errorf("sub resource %s of %s is not supported by the tracker; add a reactor", subresource, gvr.Resource)
+	}
+	return ret.DeepCopyObject(), err
+}
+
+func (f *fakerBackend) GetClusterScoped(ctx context.Context, resourceName, name string, opts metav1.GetOptions, result runtime.Object) (runtime.Object, error) {
+	return f.Get(ctx, resourceName, "", name, opts, result)
+}
+
+func (f *fakerBackend) ListClusterScoped(ctx context.Context, resourceName string, opts metav1.ListOptions, result runtime.Object) (runtime.Object, error) {
+	return f.List(ctx, resourceName, "", opts, result)
+}
+
+func (f *fakerBackend) CreateClusterScoped(ctx context.Context, resourceName string, obj runtime.Object, opts metav1.CreateOptions, result runtime.Object) (runtime.Object, error) {
+	return f.Create(ctx, resourceName, obj, opts, result)
+}
+
+func (f *fakerBackend) UpdateClusterScoped(ctx context.Context, resourceName string, obj runtime.Object, opts metav1.UpdateOptions, result runtime.Object) (runtime.Object, error) {
+	return f.Update(ctx, resourceName, obj, opts, result)
+}
+
+func (f *fakerBackend) UpdateStatusClusterScoped(ctx context.Context, resourceName string, obj runtime.Object, opts metav1.UpdateOptions, result runtime.Object) (runtime.Object, error) {
+	return f.UpdateStatus(ctx, resourceName, obj, opts, result)
+}
+
+func (f *fakerBackend) DeleteClusterScoped(ctx context.Context, gvr schema.GroupVersionResource, name string, opts metav1.DeleteOptions) error {
+	return f.Delete(ctx, gvr, "", name, opts)
+}
+
+func (f *fakerBackend) DeleteCollectionClusterScoped(ctx context.Context, gvr schema.GroupVersionResource, opts metav1.DeleteOptions, listOpts metav1.ListOptions) error {
+	return f.DeleteCollection(ctx, gvr, "", opts, listOpts)
+}
+
+func (f *fakerBackend) WatchClusterScoped(ctx context.Context, gvr schema.GroupVersionResource, opts metav1.ListOptions) (watch.Interface, error) {
+	return f.Watch(ctx, gvr, "", opts)
+}
+
+func (f *fakerBackend) PatchClusterScoped(ctx context.Context, resourceName, name string, pt types.PatchType, data []byte, opts metav1.PatchOptions, result runtime.Object, subresources ...string) (runtime.Object, error) {
+	return f.Patch(ctx, resourceName, "", name, pt, data, opts, result, subresources...)
+}
+
+func (f *fakerBackend) SubResourceClusterScoped(ctx context.Context, verb string, gvr schema.GroupVersionResource, name, subresource string, obj, opts, result runtime.Object) (runtime.Object, error) {
+	return f.SubResource(ctx, verb, gvr, "", name, subresource, obj, opts, result)
+}
+
+func (f *fakerBackend) ProxyRoundTripper(resourceName, namespace, name, port string) http.RoundTripper {
+	return roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		params := make(map[string]string)
+		for k, v := range req.URL.Query() {
+			params[k] = v[0]
+		}
+		action := k8stesting.NewProxyGetAction(schema.GroupVersionResource{Version: "v1", Resource: resourceName}, namespace, req.URL.Scheme, name, port, req.URL.Path, params)
+		// The verb of the action is the method of the request. (e.g. post)
+		action.Verb = strings.ToLower(req.Method)
+		if _, err := f.fake.Invokes(action, nil); err != nil {
+			return nil, err
+		}
+
+		rec := httptest.NewRecorder()
+		h := f.proxy.get(resourceName, namespace, name)
+		if h == nil {
+			http.Error(rec, fmt.Sprintf("no handler for %s %s/%s", resourceName, namespace, name), http.StatusServiceUnavailable)
+		} else {
+			h.ServeHTTP(rec, req)
+		}
+		return rec.Result(), nil
+	})
+}
+
+func (f *fakerBackend) RESTClient() *rest.RESTClient {
+	return nil
+}
@@ -0,0 +1,107 @@
+package testingclient
+
+import (
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	"go.f110.dev/kubeproto/go/apis/metav1"
+	"go.f110.dev/kubeproto/go/internal/assertion"
+	"go.f110.dev/kubeproto/go/internal/testapis/testv1"
+)
+
+func newWidgets(t *testing.T, s *Set) {
+	for _, v := range []*testv1.Widget{
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "fast-1", Namespace: metav1.NamespaceDefault},
+			Spec:       testv1.WidgetSpec{Phase: testv1.WidgetPhaseRUNNING, Storage: &testv1.WidgetStorage{StorageClass: "fast"}},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "fast-2", Namespace: metav1.NamespaceDefault},
+			Spec:       testv1.WidgetSpec{Phase: testv1.WidgetPhasePENDING, Storage: &testv1.WidgetStorage{StorageClass: "fast"}},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "slow", Namespace: metav1.NamespaceDefault},
+			Spec:       testv1.WidgetSpec{Phase: testv1.WidgetPhaseRUNNING, Storage: &testv1.WidgetStorage{StorageClass: "slow"}},
+		},
+		// The widget which doesn't have the optional message.
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "no-storage", Namespace: metav1.NamespaceDefault},
+			Spec:       testv1.WidgetSpec{Phase: testv1.WidgetPhaseRUNNING},
+		},
+	} {
+		err := s.Tracker().Add(v)
+		assertion.MustNoError(t, err)
+	}
+}
+
+func widgetNames(items []testv1.Widget) string {
+	var names []string
+	for _, v := range items {
+		names = append(names, v.Name)
+	}
+	sort.Strings(names)
+	return strings.Join(names, ",")
+}
+
+func TestTestingClient_ListWithNestedFieldSelector(t *testing.T) {
+	cases := []struct {
+		Selector string
+		Names    string
+	}{
+		{Selector: "spec.storage.storageClass=fast", Names: "fast-1,fast-2"},
+		{Selector: "spec.storage.storageClass=fast,spec.phase=RUNNING", Names: "fast-1"},
+		{Selector: "spec.phase=RUNNING", Names: "fast-1,no-storage,slow"},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Selector, func(t *testing.T) {
+			s := NewSet()
+			newWidgets(t, s)
+
+			widgets, err := s.TestV1.ListWidget(t.Context(), metav1.NamespaceDefault, metav1.ListOptions{FieldSelector: tc.Selector})
+			assertion.MustNoError(t, err)
+			assertion.Equal(t, tc.Names, widgetNames(widgets.Items))
+		})
+	}
+}
+
+func TestTestingClient_WatchWithNestedFieldSelector(t *testing.T) {
+	s := NewSet()
+	w, err := s.TestV1.WatchWidget(t.Context(), metav1.NamespaceDefault, metav1.ListOptions{FieldSelector: "spec.storage.storageClass=fast"})
+	assertion.MustNoError(t, err)
+	defer w.Stop()
+
+	newWidgets(t, s)
+	var names []string
+	for len(names) < 2 {
+		select {
+		case e := <-w.ResultChan():
+			names = append(names, e.Object.(*testv1.Widget).Name)
+		case <-time.After(5 * time.Second):
+			t.Fatalf("the events are not received: %v", names)
+		}
+	}
+	assertion.Equal(t, "fast-1,fast-2", strings.Join(names, ","))
+	select {
+	case e := <-w.ResultChan():
+		t.Fatalf("the event of the unmatched object is received: %s", e.Object.(*testv1.Widget).Name)
+	case <-time.After(100 * time.Millisecond):
+	}
+
+	_, err = s.TestV1.WatchWidget(t.Context(), metav1.NamespaceDefault, metav1.ListOptions{FieldSelector: "spec.replicas=1"})
+	assertion.Equal(t, true, err != nil)
+}
+
+func TestTestingClient_DeleteCollectionWithNestedFieldSelector(t *testing.T) {
+	s := NewSet()
+	newWidgets(t, s)
+
+	err := s.TestV1.DeleteCollectionWidget(t.Context(), metav1.NamespaceDefault, metav1.DeleteOptions{}, metav1.ListOptions{FieldSelector: "spec.storage.storageClass=fast"})
+	assertion.MustNoError(t, err)
+
+	widgets, err := s.TestV1.ListWidget(t.Context(), metav1.NamespaceDefault, metav1.ListOptions{})
+	assertion.MustNoError(t, err)
+	assertion.Equal(t, "no-storage,slow", widgetNames(widgets.Items))
+}
@@ -0,0 +1,93 @@
+package testv1
+
+import (
+	"os"
+	"testing"
+
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/apimachinery/pkg/util/yaml"
+
+	"go.f110.dev/kubeproto/go/internal/assertion"
+)
+
+func TestWidget_ValidateUpdate(t *testing.T) {
+	newWidget := func() *Widget {
+		return &Widget{
+			Spec: WidgetSpec{
+				Class:    "standard",
+				Replicas: 1,
+				Zone:     "zone-a",
+				Storage:  &WidgetStorage{Size: "1Gi", StorageClass: "fast"},
+				Source:   WidgetSource{Url: "https://example.com", Branches: []string{"main"}},
+			},
+		}
+	}
+
+	cases := []struct {
+		Name   string
+		Update func(w *Widget)
+		// Path is the path of the error. An empty means no error.
+		Path string
+	}{
+		{Name: "Mutable", Update: func(w *Widget) { w.Spec.Replicas = 3; w.Spec.Storage.StorageClass = "slow" }},
+		{Name: "Scalar", Update: func(w *Widget) { w.Spec.Class = "premium" }, Path: "spec.class"},
+		{Name: "OptionalScalar", Update: func(w *Widget) { w.Spec.Zone = "zone-b" }, Path: "spec.zone"},
+		// The API server also rejects the removal by the rule of the parent.
+		{Name: "UnsetOptionalScalar", Update: func(w *Widget) { w.Spec.Zone = "" }, Path: "spec.zone"},
+		{Name: "NestedScalar", Update: func(w *Widget) { w.Spec.Storage.Size = "2Gi" }, Path: "spec.storage.size"},
+		// The immutable field in the optional message isn't validated if the message doesn't exist.
+		{Name: "UnsetNestedMessage", Update: func(w *Widget) { w.Spec.Storage = nil }},
+		{Name: "Message", Update: func(w *Widget) { w.Spec.Source.Branches = append(w.Spec.Source.Branches, "dev") }, Path: "spec.source"},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			old := newWidget()
+			w := newWidget()
+			tc.Update(w)
+
+			errs := w.ValidateUpdate(old)
+			if tc.Path == "" {
+				assertion.Len(t, errs, 0)
+				return
+			}
+			assertion.Len(t, errs, 1)
+			assertion.Equal(t, tc.Path, errs[0].Field)
+			assertion.Equal(t, "field is immutable", errs[0].Detail)
+		})
+	}
+}
+
+func TestWidget_CRDImmutableFields(t *testing.T) {
+	f, err := os.Open("testv1_crd.crd.yaml")
+	assertion.MustNoError(t, err)
+	defer f.Close()
+	crd := &apiextensionsv1.CustomResourceDefinition{}
+	err = yaml.NewYAMLOrJSONDecoder(f, 4096).Decode(crd)
+	assertion.MustNoError(t, err)
+	assertion.Len(t, crd.Spec.Versions, 1)
+
+	spec := crd.Spec.Versions[0].Schema.OpenAPIV3Schema.Properties["spec"]
+	for name, props := range map[string]apiextensionsv1.JSONSchemaProps{
+		"class":        spec.Properties["class"],
+		"zone":         spec.Properties["zone"],
+		"source":       spec.Properties["source"],
+		"storage.size": spec.Properties["storage"].Properties["size"],
+	} {
+		if len(props.XValidations) != 1 {
+			t.Errorf("%s: expected one validation rule but %d rules", name, len(props.XValidations))
+			continue
+		}
+		assertion.Equal(t, "self == oldSelf", props.XValidations[0].Rule)
+	}
+	// The mutable fields don't have the rule.
+	assertion.Len(t, spec.Properties["replicas"].XValidations, 0)
+	assertion.Len(t, spec.Properties["storage"].XValidations, 0)
+	assertion.Len(t, spec.Properties["storage"].Properties["storageClass"].XValidations, 0)
+
+	// The rule of the field is not evaluated when the optional field is removed.
+	// The parent rejects the removal like ValidateUpdate.
+	assertion.Len(t, spec.XValidations, 1)
+	assertion.Equal(t, "has(self.zone) == has(oldSelf.zone)", spec.XValidations[0].Rule)
+	assertion.Len(t, spec.Properties["storage"].XValidations, 0)
+}
@@ -0,0 +1,57 @@
+package testv1
+
+import (
+	"testing"
+
+	"k8s.io/kube-openapi/pkg/common"
+	"k8s.io/kube-openapi/pkg/validation/spec"
+
+	"go.f110.dev/kubeproto/go/internal/assertion"
+)
+
+func TestGetOpenAPIDefinitions(t *testing.T) {
+	var refs []string
+	defs := GetOpenAPIDefinitions(func(path string) spec.Ref {
+		refs = append(refs, path)
+		return spec.MustCreateRef("#/definitions/" + common.EscapeJsonPointer(path))
+	})
+	assertion.Len(t, defs, 2)
+
+	def, ok := defs["go.f110.dev/kubeproto/go/internal/testapis/testv1.Widget"]
+	assertion.Equal(t, true, ok)
+	assertion.Len(t, def.Dependencies, 1)
+	assertion.Equal(t, "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta", def.Dependencies[0])
+	assertion.Len(t, refs, 3)
+	metadata := def.Schema.Properties["metadata"]
+	assertion.Equal(t, "#/definitions/k8s.io~1apimachinery~1pkg~1apis~1meta~1v1.ObjectMeta", metadata.Ref.String())
+
+	specSchema := def.Schema.Properties["spec"]
+	assertion.Equal(t, "object", specSchema.Type[0])
+	assertion.Len(t, specSchema.Required, 5)
+	assertion.Equal(t, "integer", specSchema.Properties["replicas"].Type[0])
+	assertion.Equal(t, "array", specSchema.Properties["tags"].Type[0])
+	assertion.Equal(t, "string", specSchema.Properties["tags"].Items.Schema.Type[0])
+	assertion.Len(t, specSchema.Properties["phase"].Enum, 2)
+	assertion.Equal(t, "class can't be changed after the creation.", specSchema.Properties["class"].Description)
+
+	// The rule of the immutable field is the extension.
+	validations, ok := specSchema.Properties["class"].Extensions["x-kubernetes-validations"].([]interface{})
+	assertion.Equal(t, true, ok)
+	assertion.Len(t, validations, 1)
+	rule := validations[0].(map[string]interface{})
+	assertion.Equal(t, "self == oldSelf", rule["rule"].(string))
+	assertion.Equal(t, "class is immutable", rule["message"].(string))
+	_, ok = specSchema.Properties["replicas"].Extensions["x-kubernetes-validations"]
+	assertion.Equal(t, false, ok)
+
+	// The items of the list are the reference to the definition of the kind.
+	list, ok := defs["go.f110.dev/kubeproto/go/internal/testapis/testv1.WidgetList"]
+	assertion.Equal(t, true, ok)
+	assertion.Len(t, list.Dependencies, 2)
+	assertion.Equal(t, "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta", list.Dependencies[0])
+	assertion.Equal(t, "go.f110.dev/kubeproto/go/internal/testapis/testv1.Widget", list.Dependencies[1])
+	items := list.Schema.Properties["items"]
+	assertion.Equal(t, "array", items.Type[0])
+	assertion.Equal(t, "#/definitions/go.f110.dev~1kubeproto~1go~1internal~1testapis~1testv1.Widget", items.Items.Schema.Ref.String())
+	assertion.Len(t, items.Items.Schema.Properties, 0)
+}
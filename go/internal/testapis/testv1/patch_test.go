@@ -0,0 +1,17 @@
+package testv1
+
+import (
+	"testing"
+
+	"go.f110.dev/kubeproto/go/internal/assertion"
+)
+
+func TestWidgetPatch_ConflictedSetter(t *testing.T) {
+	// Spec.StorageSize has the same name as Spec.Storage.Size, so the setter of the latter field is qualified.
+	p, err := NewWidgetPatch().SetSpecStorageSize("1Gi").SetSpec_StorageSize("2Gi").JSONPatch()
+	assertion.MustNoError(t, err)
+	assertion.Equal(t,
+		`[{"op":"add","path":"/spec/storage/size","value":"1Gi"},{"op":"add","path":"/spec/storageSize","value":"2Gi"}]`,
+		string(p),
+	)
+}
@@ -0,0 +1,990 @@
+package testv1
+
+import (
+	"fmt"
+	"github.com/mailru/easyjson/jlexer"
+	"github.com/mailru/easyjson/jwriter"
+	"go.f110.dev/kubeproto/go/apis/metav1"
+	"go.f110.dev/kubeproto/go/patch"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+const GroupName = "test.f110.dev"
+
+var (
+	GroupVersion       = metav1.GroupVersion{Group: GroupName, Version: "v1"}
+	SchemeBuilder      = runtime.NewSchemeBuilder(addKnownTypes)
+	AddToScheme        = SchemeBuilder.AddToScheme
+	SchemaGroupVersion = schema.GroupVersion{Group: GroupName, Version: "v1"}
+)
+
+func addKnownTypes(scheme *runtime.Scheme) error {
+	scheme.AddKnownTypes(SchemaGroupVersion,
+		&Widget{},
+		&WidgetList{},
+	)
+	metav1.AddToGroupVersion(scheme, SchemaGroupVersion)
+	if err := scheme.AddFieldLabelConversionFunc(SchemaGroupVersion.WithKind("Widget"), fieldLabelConversionFuncForWidget); err != nil {
+		return err
+	}
+	return nil
+}
+
+type WidgetPhase string
+
+const (
+	WidgetPhasePENDING WidgetPhase = "PENDING"
+	WidgetPhaseRUNNING WidgetPhase = "RUNNING"
+)
+
+type Widget struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata"`
+	Spec              WidgetSpec    `json:"spec"`
+	Status            *WidgetStatus `json:"status,omitempty"`
+}
+
+func (in *Widget) DeepCopyInto(out *Widget) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	if in.Status != nil {
+		in, out := &in.Status, &out.Status
+		*out = new(WidgetStatus)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+func (in *Widget) DeepCopy() *Widget {
+	if in == nil {
+		return nil
+	}
+	out := new(Widget)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *Widget) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// ValidateUpdate returns the errors if the immutable fields are changed from old.
+func (in *Widget) ValidateUpdate(old *Widget) field.ErrorList {
+	var allErrs field.ErrorList
+	if in.Spec.Class != old.Spec.Class {
+		allErrs = append(allErrs, field.Invalid(field.NewPath("spec", "class"), in.Spec.Class, "field is immutable"))
+	}
+	if in.Spec.Zone != old.Spec.Zone {
+		allErrs = append(allErrs, field.Invalid(field.NewPath("spec", "zone"), in.Spec.Zone, "field is immutable"))
+	}
+	if in.Spec.Storage != nil && old.Spec.Storage != nil && in.Spec.Storage.Size != old.Spec.Storage.Size {
+		allErrs = append(allErrs, field.Invalid(field.NewPath("spec", "storage", "size"), in.Spec.Storage.Size, "field is immutable"))
+	}
+	if !equality.Semantic.DeepEqual(in.Spec.Source, old.Spec.Source) {
+		allErrs = append(allErrs, field.Invalid(field.NewPath("spec", "source"), in.Spec.Source, "field is immutable"))
+	}
+	return allErrs
+}
+
+// WidgetToSelectableFields returns the fields of Widget which can be used by the field selector.
+func WidgetToSelectableFields(obj *Widget) fields.Set {
+	set := fields.Set{
+		"metadata.name":      obj.ObjectMeta.Name,
+		"metadata.namespace": obj.ObjectMeta.Namespace,
+	}
+	set["spec.phase"] = string(obj.Spec.Phase)
+	if obj.Spec.Storage != nil {
+		set["spec.storage.storageClass"] = obj.Spec.Storage.StorageClass
+	}
+	return set
+}
+
+func fieldLabelConversionFuncForWidget(label, value string) (string, string, error) {
+	switch label {
+	case "metadata.name", "metadata.namespace", "spec.phase", "spec.storage.storageClass":
+		return label, value, nil
+	default:
+		return "", "", fmt.Errorf("field label not supported: %s", label)
+	}
+}
+
+// WidgetPatch builds JSON patch or JSON merge patch of Widget.
+// JSON patch fails if the parent of the field doesn't exist (e.g. SetLabel to the object which has no label). MergePatch creates the parent.
+type WidgetPatch struct {
+	*patch.Builder
+}
+
+func NewWidgetPatch() *WidgetPatch {
+	return &WidgetPatch{Builder: patch.NewBuilder()}
+}
+
+func (p *WidgetPatch) SetLabel(key, value string) *WidgetPatch {
+	p.Set([]string{"metadata", "labels", key}, value)
+	return p
+}
+
+func (p *WidgetPatch) RemoveLabel(key string) *WidgetPatch {
+	p.Remove([]string{"metadata", "labels", key})
+	return p
+}
+
+func (p *WidgetPatch) SetAnnotation(key, value string) *WidgetPatch {
+	p.Set([]string{"metadata", "annotations", key}, value)
+	return p
+}
+
+func (p *WidgetPatch) RemoveAnnotation(key string) *WidgetPatch {
+	p.Remove([]string{"metadata", "annotations", key})
+	return p
+}
+
+func (p *WidgetPatch) SetSpec(v WidgetSpec) *WidgetPatch {
+	p.Set([]string{"spec"}, v)
+	return p
+}
+
+func (p *WidgetPatch) RemoveSpec() *WidgetPatch {
+	p.Remove([]string{"spec"})
+	return p
+}
+
+func (p *WidgetPatch) SetSpecClass(v string) *WidgetPatch {
+	p.Set([]string{"spec", "class"}, v)
+	return p
+}
+
+func (p *WidgetPatch) RemoveSpecClass() *WidgetPatch {
+	p.Remove([]string{"spec", "class"})
+	return p
+}
+
+func (p *WidgetPatch) SetSpecReplicas(v int) *WidgetPatch {
+	p.Set([]string{"spec", "replicas"}, v)
+	return p
+}
+
+func (p *WidgetPatch) RemoveSpecReplicas() *WidgetPatch {
+	p.Remove([]string{"spec", "replicas"})
+	return p
+}
+
+func (p *WidgetPatch) SetSpecTags(v []string) *WidgetPatch {
+	p.Set([]string{"spec", "tags"}, v)
+	return p
+}
+
+func (p *WidgetPatch) RemoveSpecTags() *WidgetPatch {
+	p.Remove([]string{"spec", "tags"})
+	return p
+}
+
+func (p *WidgetPatch) AddSpecTags(v ...string) *WidgetPatch {
+	for _, e := range v {
+		p.Append([]string{"spec", "tags"}, e)
+	}
+	return p
+}
+
+func (p *WidgetPatch) SetSpecPhase(v WidgetPhase) *WidgetPatch {
+	p.Set([]string{"spec", "phase"}, v)
+	return p
+}
+
+func (p *WidgetPatch) RemoveSpecPhase() *WidgetPatch {
+	p.Remove([]string{"spec", "phase"})
+	return p
+}
+
+func (p *WidgetPatch) SetSpecZone(v string) *WidgetPatch {
+	p.Set([]string{"spec", "zone"}, v)
+	return p
+}
+
+func (p *WidgetPatch) RemoveSpecZone() *WidgetPatch {
+	p.Remove([]string{"spec", "zone"})
+	return p
+}
+
+func (p *WidgetPatch) SetSpecStorage(v *WidgetStorage) *WidgetPatch {
+	p.Set([]string{"spec", "storage"}, v)
+	return p
+}
+
+func (p *WidgetPatch) RemoveSpecStorage() *WidgetPatch {
+	p.Remove([]string{"spec", "storage"})
+	return p
+}
+
+func (p *WidgetPatch) SetSpecStorageSize(v string) *WidgetPatch {
+	p.Set([]string{"spec", "storage", "size"}, v)
+	return p
+}
+
+func (p *WidgetPatch) RemoveSpecStorageSize() *WidgetPatch {
+	p.Remove([]string{"spec", "storage", "size"})
+	return p
+}
+
+func (p *WidgetPatch) SetSpecStorageStorageClass(v string) *WidgetPatch {
+	p.Set([]string{"spec", "storage", "storageClass"}, v)
+	return p
+}
+
+func (p *WidgetPatch) RemoveSpecStorageStorageClass() *WidgetPatch {
+	p.Remove([]string{"spec", "storage", "storageClass"})
+	return p
+}
+
+func (p *WidgetPatch) SetSpecSource(v WidgetSource) *WidgetPatch {
+	p.Set([]string{"spec", "source"}, v)
+	return p
+}
+
+func (p *WidgetPatch) RemoveSpecSource() *WidgetPatch {
+	p.Remove([]string{"spec", "source"})
+	return p
+}
+
+func (p *WidgetPatch) SetSpecSourceUrl(v string) *WidgetPatch {
+	p.Set([]string{"spec", "source", "url"}, v)
+	return p
+}
+
+func (p *WidgetPatch) RemoveSpecSourceUrl() *WidgetPatch {
+	p.Remove([]string{"spec", "source", "url"})
+	return p
+}
+
+func (p *WidgetPatch) SetSpecSourceBranches(v []string) *WidgetPatch {
+	p.Set([]string{"spec", "source", "branches"}, v)
+	return p
+}
+
+func (p *WidgetPatch) RemoveSpecSourceBranches() *WidgetPatch {
+	p.Remove([]string{"spec", "source", "branches"})
+	return p
+}
+
+func (p *WidgetPatch) AddSpecSourceBranches(v ...string) *WidgetPatch {
+	for _, e := range v {
+		p.Append([]string{"spec", "source", "branches"}, e)
+	}
+	return p
+}
+
+func (p *WidgetPatch) SetSpec_StorageSize(v string) *WidgetPatch {
+	p.Set([]string{"spec", "storageSize"}, v)
+	return p
+}
+
+func (p *WidgetPatch) RemoveSpec_StorageSize() *WidgetPatch {
+	p.Remove([]string{"spec", "storageSize"})
+	return p
+}
+
+func (p *WidgetPatch) SetStatus(v *WidgetStatus) *WidgetPatch {
+	p.Set([]string{"status"}, v)
+	return p
+}
+
+func (p *WidgetPatch) RemoveStatus() *WidgetPatch {
+	p.Remove([]string{"status"})
+	return p
+}
+
+func (p *WidgetPatch) SetStatusReady(v bool) *WidgetPatch {
+	p.Set([]string{"status", "ready"}, v)
+	return p
+}
+
+func (p *WidgetPatch) RemoveStatusReady() *WidgetPatch {
+	p.Remove([]string{"status", "ready"})
+	return p
+}
+
+func (in *Widget) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *Widget) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *Widget) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	first := true
+	if in.TypeMeta.Kind != "" {
+		w.RawString("\"kind\":")
+		w.String(in.TypeMeta.Kind)
+		first = false
+	}
+	if in.TypeMeta.APIVersion != "" {
+		if !first {
+			w.RawByte(',')
+		}
+		w.RawString("\"apiVersion\":")
+		w.String(in.TypeMeta.APIVersion)
+		first = false
+	}
+	if !first {
+		w.RawByte(',')
+	}
+	w.RawString("\"metadata\":")
+	in.ObjectMeta.MarshalEasyJSON(w)
+	w.RawString(",\"spec\":")
+	in.Spec.MarshalEasyJSON(w)
+	if in.Status != nil {
+		w.RawString(",\"status\":")
+		in.Status.MarshalEasyJSON(w)
+	}
+	w.RawByte('}')
+}
+
+func (in *Widget) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "kind":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.TypeMeta.Kind = l.String()
+			}
+		case "apiVersion":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.TypeMeta.APIVersion = l.String()
+			}
+		case "metadata":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.ObjectMeta.UnmarshalEasyJSON(l)
+			}
+		case "spec":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.Spec.UnmarshalEasyJSON(l)
+			}
+		case "status":
+			if l.IsNull() {
+				l.Skip()
+				in.Status = nil
+			} else {
+				if in.Status == nil {
+					in.Status = new(WidgetStatus)
+				}
+				in.Status.UnmarshalEasyJSON(l)
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
+
+type WidgetList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata"`
+	Items           []Widget `json:"items"`
+}
+
+func (in *WidgetList) DeepCopyInto(out *WidgetList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		l := make([]Widget, len(in.Items))
+		for i := range in.Items {
+			in.Items[i].DeepCopyInto(&l[i])
+		}
+		out.Items = l
+	}
+}
+
+func (in *WidgetList) DeepCopy() *WidgetList {
+	if in == nil {
+		return nil
+	}
+	out := new(WidgetList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *WidgetList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+func (in *WidgetList) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *WidgetList) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *WidgetList) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	first := true
+	if in.TypeMeta.Kind != "" {
+		w.RawString("\"kind\":")
+		w.String(in.TypeMeta.Kind)
+		first = false
+	}
+	if in.TypeMeta.APIVersion != "" {
+		if !first {
+			w.RawByte(',')
+		}
+		w.RawString("\"apiVersion\":")
+		w.String(in.TypeMeta.APIVersion)
+		first = false
+	}
+	if !first {
+		w.RawByte(',')
+	}
+	w.RawString("\"metadata\":")
+	in.ListMeta.MarshalEasyJSON(w)
+	w.RawString(",\"items\":")
+	if in.Items == nil {
+		w.RawString("null")
+	} else {
+		w.RawByte('[')
+		for i0 := range in.Items {
+			if i0 > 0 {
+				w.RawByte(',')
+			}
+			in.Items[i0].MarshalEasyJSON(w)
+		}
+		w.RawByte(']')
+	}
+	w.RawByte('}')
+}
+
+func (in *WidgetList) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "kind":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.TypeMeta.Kind = l.String()
+			}
+		case "apiVersion":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.TypeMeta.APIVersion = l.String()
+			}
+		case "metadata":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.ListMeta.UnmarshalEasyJSON(l)
+			}
+		case "items":
+			if l.IsNull() {
+				l.Skip()
+				in.Items = nil
+			} else {
+				in.Items = make([]Widget, 0)
+				l.Delim('[')
+				for !l.IsDelim(']') {
+					var v0 Widget
+					if l.IsNull() {
+						l.Skip()
+					} else {
+						v0.UnmarshalEasyJSON(l)
+					}
+					in.Items = append(in.Items, v0)
+					l.WantComma()
+				}
+				l.Delim(']')
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
+
+type WidgetSpec struct {
+	// class can't be changed after the creation.
+	Class    string      `json:"class"`
+	Replicas int         `json:"replicas"`
+	Tags     []string    `json:"tags"`
+	Phase    WidgetPhase `json:"phase"`
+	// zone can't be changed after the creation.
+	Zone    string         `json:"zone,omitempty"`
+	Storage *WidgetStorage `json:"storage,omitempty"`
+	// source can't be changed after the creation.
+	Source WidgetSource `json:"source"`
+	// The setter of storage_size has the same name as the setter of storage.size.
+	StorageSize string `json:"storageSize,omitempty"`
+}
+
+func (in *WidgetSpec) DeepCopyInto(out *WidgetSpec) {
+	*out = *in
+	if in.Tags != nil {
+		t := make([]string, len(in.Tags))
+		copy(t, in.Tags)
+		out.Tags = t
+	}
+	if in.Storage != nil {
+		in, out := &in.Storage, &out.Storage
+		*out = new(WidgetStorage)
+		(*in).DeepCopyInto(*out)
+	}
+	in.Source.DeepCopyInto(&out.Source)
+}
+
+func (in *WidgetSpec) DeepCopy() *WidgetSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(WidgetSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *WidgetSpec) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *WidgetSpec) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *WidgetSpec) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	w.RawString("\"class\":")
+	w.String(in.Class)
+	w.RawString(",\"replicas\":")
+	w.Int(in.Replicas)
+	w.RawString(",\"tags\":")
+	if in.Tags == nil {
+		w.RawString("null")
+	} else {
+		w.RawByte('[')
+		for i0 := range in.Tags {
+			if i0 > 0 {
+				w.RawByte(',')
+			}
+			w.String(in.Tags[i0])
+		}
+		w.RawByte(']')
+	}
+	w.RawString(",\"phase\":")
+	w.String(string(in.Phase))
+	if in.Zone != "" {
+		w.RawString(",\"zone\":")
+		w.String(in.Zone)
+	}
+	if in.Storage != nil {
+		w.RawString(",\"storage\":")
+		in.Storage.MarshalEasyJSON(w)
+	}
+	w.RawString(",\"source\":")
+	in.Source.MarshalEasyJSON(w)
+	if in.StorageSize != "" {
+		w.RawString(",\"storageSize\":")
+		w.String(in.StorageSize)
+	}
+
+	w.RawByte('}')
+}
+
+func (in *WidgetSpec) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "class":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.Class = l.String()
+			}
+		case "replicas":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.Replicas = l.Int()
+			}
+		case "tags":
+			if l.IsNull() {
+				l.Skip()
+				in.Tags = nil
+			} else {
+				in.Tags = make([]string, 0)
+				l.Delim('[')
+				for !l.IsDelim(']') {
+					var v0 string
+					if l.IsNull() {
+						l.Skip()
+					} else {
+						v0 = l.String()
+					}
+					in.Tags = append(in.Tags, v0)
+					l.WantComma()
+				}
+				l.Delim(']')
+			}
+		case "phase":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.Phase = WidgetPhase(l.String())
+			}
+		case "zone":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.Zone = l.String()
+			}
+		case "storage":
+			if l.IsNull() {
+				l.Skip()
+				in.Storage = nil
+			} else {
+				if in.Storage == nil {
+					in.Storage = new(WidgetStorage)
+				}
+				in.Storage.UnmarshalEasyJSON(l)
+			}
+		case "source":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.Source.UnmarshalEasyJSON(l)
+			}
+		case "storageSize":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.StorageSize = l.String()
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
+
+type WidgetStatus struct {
+	Ready bool `json:"ready"`
+}
+
+func (in *WidgetStatus) DeepCopyInto(out *WidgetStatus) {
+	*out = *in
+}
+
+func (in *WidgetStatus) DeepCopy() *WidgetStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(WidgetStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *WidgetStatus) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *WidgetStatus) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *WidgetStatus) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	w.RawString("\"ready\":")
+	w.Bool(in.Ready)
+
+	w.RawByte('}')
+}
+
+func (in *WidgetStatus) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "ready":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.Ready = l.Bool()
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
+
+type WidgetStorage struct {
+	// size can't be changed after the creation.
+	Size         string `json:"size"`
+	StorageClass string `json:"storageClass"`
+}
+
+func (in *WidgetStorage) DeepCopyInto(out *WidgetStorage) {
+	*out = *in
+}
+
+func (in *WidgetStorage) DeepCopy() *WidgetStorage {
+	if in == nil {
+		return nil
+	}
+	out := new(WidgetStorage)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *WidgetStorage) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *WidgetStorage) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *WidgetStorage) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	w.RawString("\"size\":")
+	w.String(in.Size)
+	w.RawString(",\"storageClass\":")
+	w.String(in.StorageClass)
+
+	w.RawByte('}')
+}
+
+func (in *WidgetStorage) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "size":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.Size = l.String()
+			}
+		case "storageClass":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.StorageClass = l.String()
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
+
+type WidgetSource struct {
+	Url      string   `json:"url"`
+	Branches []string `json:"branches"`
+}
+
+func (in *WidgetSource) DeepCopyInto(out *WidgetSource) {
+	*out = *in
+	if in.Branches != nil {
+		t := make([]string, len(in.Branches))
+		copy(t, in.Branches)
+		out.Branches = t
+	}
+}
+
+func (in *WidgetSource) DeepCopy() *WidgetSource {
+	if in == nil {
+		return nil
+	}
+	out := new(WidgetSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *WidgetSource) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	in.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+func (in *WidgetSource) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	in.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (in *WidgetSource) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawByte('{')
+	w.RawString("\"url\":")
+	w.String(in.Url)
+	w.RawString(",\"branches\":")
+	if in.Branches == nil {
+		w.RawString("null")
+	} else {
+		w.RawByte('[')
+		for i0 := range in.Branches {
+			if i0 > 0 {
+				w.RawByte(',')
+			}
+			w.String(in.Branches[i0])
+		}
+		w.RawByte(']')
+	}
+
+	w.RawByte('}')
+}
+
+func (in *WidgetSource) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	isTopLevel := l.IsStart()
+	if l.IsNull() {
+		if isTopLevel {
+			l.Consumed()
+		}
+		l.Skip()
+		return
+	}
+	l.Delim('{')
+	for !l.IsDelim('}') {
+		key := l.UnsafeFieldName(false)
+		l.WantColon()
+		switch key {
+		case "url":
+			if l.IsNull() {
+				l.Skip()
+			} else {
+				in.Url = l.String()
+			}
+		case "branches":
+			if l.IsNull() {
+				l.Skip()
+				in.Branches = nil
+			} else {
+				in.Branches = make([]string, 0)
+				l.Delim('[')
+				for !l.IsDelim(']') {
+					var v0 string
+					if l.IsNull() {
+						l.Skip()
+					} else {
+						v0 = l.String()
+					}
+					in.Branches = append(in.Branches, v0)
+					l.WantComma()
+				}
+				l.Delim(']')
+			}
+		default:
+			l.SkipRecursive()
+		}
+		l.WantComma()
+	}
+	l.Delim('}')
+	if isTopLevel {
+		l.Consumed()
+	}
+}
@@ -0,0 +1,228 @@
+package testv1
+
+import (
+	"k8s.io/kube-openapi/pkg/common"
+	"k8s.io/kube-openapi/pkg/validation/spec"
+)
+
+func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenAPIDefinition {
+	return map[string]common.OpenAPIDefinition{
+		"go.f110.dev/kubeproto/go/internal/testapis/testv1.Widget":     openAPISchemaWidget(ref),
+		"go.f110.dev/kubeproto/go/internal/testapis/testv1.WidgetList": openAPISchemaWidgetList(ref),
+	}
+}
+
+func openAPISchemaWidget(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"apiVersion": spec.Schema{
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values.",
+							Type:        []string{"string"},
+						},
+					},
+					"kind": spec.Schema{
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated.",
+							Type:        []string{"string"},
+						},
+					},
+					"metadata": spec.Schema{
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"spec": spec.Schema{
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"object"},
+							Properties: map[string]spec.Schema{
+								"class": spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Description: "class can't be changed after the creation.",
+										Type:        []string{"string"},
+									},
+									VendorExtensible: spec.VendorExtensible{
+										Extensions: spec.Extensions{
+											"x-kubernetes-validations": []interface{}{
+												map[string]interface{}{"rule": "self == oldSelf", "message": "class is immutable"},
+											},
+										},
+									},
+								},
+								"phase": spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type: []string{"string"},
+										Enum: []interface{}{
+											"PENDING",
+											"RUNNING",
+										},
+									},
+								},
+								"replicas": spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type: []string{"integer"},
+									},
+								},
+								"source": spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type: []string{"object"},
+										Properties: map[string]spec.Schema{
+											"branches": spec.Schema{
+												SchemaProps: spec.SchemaProps{
+													Type: []string{"array"},
+													Items: &spec.SchemaOrArray{Schema: &spec.Schema{
+														SchemaProps: spec.SchemaProps{
+															Type: []string{"string"},
+														},
+													}},
+												},
+											},
+											"url": spec.Schema{
+												SchemaProps: spec.SchemaProps{
+													Type: []string{"string"},
+												},
+											},
+										},
+										Required: []string{"url", "branches"},
+									},
+									VendorExtensible: spec.VendorExtensible{
+										Extensions: spec.Extensions{
+											"x-kubernetes-validations": []interface{}{
+												map[string]interface{}{"rule": "self == oldSelf", "message": "source is immutable"},
+											},
+										},
+									},
+								},
+								"storage": spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type: []string{"object"},
+										Properties: map[string]spec.Schema{
+											"size": spec.Schema{
+												SchemaProps: spec.SchemaProps{
+													Description: "size can't be changed after the creation.",
+													Type:        []string{"string"},
+												},
+												VendorExtensible: spec.VendorExtensible{
+													Extensions: spec.Extensions{
+														"x-kubernetes-validations": []interface{}{
+															map[string]interface{}{"rule": "self == oldSelf", "message": "size is immutable"},
+														},
+													},
+												},
+											},
+											"storageClass": spec.Schema{
+												SchemaProps: spec.SchemaProps{
+													Type: []string{"string"},
+												},
+											},
+										},
+										Required: []string{"size", "storageClass"},
+									},
+								},
+								"storageSize": spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Description: "The setter of storage_size has the same name as the setter of storage.size.",
+										Type:        []string{"string"},
+									},
+								},
+								"tags": spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type: []string{"array"},
+										Items: &spec.SchemaOrArray{Schema: &spec.Schema{
+											SchemaProps: spec.SchemaProps{
+												Type: []string{"string"},
+											},
+										}},
+									},
+								},
+								"zone": spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Description: "zone can't be changed after the creation.",
+										Type:        []string{"string"},
+									},
+									VendorExtensible: spec.VendorExtensible{
+										Extensions: spec.Extensions{
+											"x-kubernetes-validations": []interface{}{
+												map[string]interface{}{"rule": "self == oldSelf", "message": "zone is immutable"},
+											},
+										},
+									},
+								},
+							},
+							Required: []string{"class", "replicas", "tags", "phase", "source"},
+						},
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-validations": []interface{}{
+									map[string]interface{}{"rule": "has(self.zone) == has(oldSelf.zone)", "message": "zone is immutable"},
+								},
+							},
+						},
+					},
+					"status": spec.Schema{
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"object"},
+							Properties: map[string]spec.Schema{
+								"ready": spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type: []string{"boolean"},
+									},
+								},
+							},
+							Required: []string{"ready"},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta",
+		},
+	}
+}
+
+func openAPISchemaWidgetList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"apiVersion": spec.Schema{
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values.",
+							Type:        []string{"string"},
+						},
+					},
+					"items": spec.Schema{
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{Schema: &spec.Schema{
+								SchemaProps: spec.SchemaProps{
+									Ref: ref("go.f110.dev/kubeproto/go/internal/testapis/testv1.Widget"),
+								},
+							}},
+						},
+					},
+					"kind": spec.Schema{
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated.",
+							Type:        []string{"string"},
+						},
+					},
+					"metadata": spec.Schema{
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta",
+			"go.f110.dev/kubeproto/go/internal/testapis/testv1.Widget",
+		},
+	}
+}
@@ -0,0 +1,163 @@
+package k8sclient
+
+import (
+	"archive/tar"
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+)
+
+// CopyToPod copies the local file or directory src into the directory dst of the container.
+// The container must have the tar command.
+func (c *CoreV1) CopyToPod(ctx context.Context, namespace, name, container, src, dst string) error {
+	if _, err := os.Lstat(src); err != nil {
+		return err
+	}
+
+	pr, pw := io.Pipe()
+	go func() {
+		pw.CloseWithError(writeTar(pw, src))
+	}()
+	defer pr.Close()
+
+	stderr := new(bytes.Buffer)
+	err := c.ExecInPod(ctx, namespace, name, container, []string{"tar", "xf", "-", "-C", dst}, pr, nil, stderr, false, nil)
+	if err != nil {
+		if stderr.Len() > 0 {
+			return fmt.Errorf("%w: %s", err, stderr.String())
+		}
+		return err
+	}
+	return nil
+}
+
+// CopyFromPod copies the file or directory src of the container into the local directory dst.
+// The container must have the tar command.
+func (c *CoreV1) CopyFromPod(ctx context.Context, namespace, name, container, src, dst string) error {
+	pr, pw := io.Pipe()
+	stderr := new(bytes.Buffer)
+	go func() {
+		err := c.ExecInPod(ctx, namespace, name, container, []string{"tar", "cf", "-", "-C", path.Dir(src), path.Base(src)}, nil, pw, stderr, false, nil)
+		if err != nil && stderr.Len() > 0 {
+			err = fmt.Errorf("%w: %s", err, stderr.String())
+		}
+		pw.CloseWithError(err)
+	}()
+	defer pr.Close()
+
+	return extractTar(pr, dst)
+}
+
+// writeTar writes src to w as the tar archive. The name of the entries is relative to the parent of src.
+func writeTar(w io.Writer, src string) error {
+	tw := tar.NewWriter(w)
+	base := filepath.Dir(src)
+	err := filepath.WalkDir(src, func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+		var link string
+		if info.Mode()&fs.ModeSymlink != 0 {
+			link, err = os.Readlink(p)
+			if err != nil {
+				return err
+			}
+		}
+		hdr, err := tar.FileInfoHeader(info, link)
+		if err != nil {
+			return err
+		}
+		name, err := filepath.Rel(base, p)
+		if err != nil {
+			return err
+		}
+		hdr.Name = filepath.ToSlash(name)
+		if err := tw.WriteHeader(hdr); err != nil {
+			return err
+		}
+		if !info.Mode().IsRegular() {
+			return nil
+		}
+
+		f, err := os.Open(p)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		_, err = io.Copy(tw, f)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+	return tw.Close()
+}
+
+// extractTar extracts the tar archive into dst.
+// The entry which points to the outside of dst is rejected.
+// The entries are written through os.Root, so the symlinks in the archive can't be followed to the outside of dst either.
+func extractTar(r io.Reader, dst string) error {
+	root, err := os.OpenRoot(dst)
+	if err != nil {
+		return err
+	}
+	defer root.Close()
+
+	tr := tar.NewReader(r)
+	for {
+		hdr, err := tr.Next()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		p := filepath.FromSlash(hdr.Name)
+		if !filepath.IsLocal(p) {
+			return fmt.Errorf("invalid file name in archive: %s", hdr.Name)
+		}
+
+		switch hdr.Typeflag {
+		case tar.TypeDir:
+			if err := root.MkdirAll(p, hdr.FileInfo().Mode().Perm()|0o700); err != nil {
+				return err
+			}
+		case tar.TypeReg:
+			if err := root.MkdirAll(filepath.Dir(p), 0o755); err != nil {
+				return err
+			}
+			f, err := root.OpenFile(p, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, hdr.FileInfo().Mode().Perm())
+			if err != nil {
+				return err
+			}
+			if _, err := io.Copy(f, tr); err != nil {
+				f.Close()
+				return err
+			}
+			if err := f.Close(); err != nil {
+				return err
+			}
+		case tar.TypeSymlink:
+			link := filepath.Join(filepath.Dir(p), filepath.FromSlash(hdr.Linkname))
+			if filepath.IsAbs(hdr.Linkname) || !filepath.IsLocal(link) {
+				return fmt.Errorf("invalid link in archive: %s -> %s", hdr.Name, hdr.Linkname)
+			}
+			if err := root.MkdirAll(filepath.Dir(p), 0o755); err != nil {
+				return err
+			}
+			if err := root.Symlink(hdr.Linkname, p); err != nil {
+				return err
+			}
+		}
+	}
+}
@@ -0,0 +1,76 @@
+package k8sclient
+
+import (
+	"archive/tar"
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"go.f110.dev/kubeproto/go/internal/assertion"
+)
+
+func TestTar(t *testing.T) {
+	src := filepath.Join(t.TempDir(), "data")
+	assertion.MustNoError(t, os.MkdirAll(filepath.Join(src, "sub"), 0o755))
+	assertion.MustNoError(t, os.WriteFile(filepath.Join(src, "a.txt"), []byte("a"), 0o644))
+	assertion.MustNoError(t, os.WriteFile(filepath.Join(src, "sub", "b.txt"), []byte("b"), 0o600))
+	assertion.MustNoError(t, os.Symlink("a.txt", filepath.Join(src, "link")))
+
+	buf := new(bytes.Buffer)
+	assertion.MustNoError(t, writeTar(buf, src))
+	dst := t.TempDir()
+	assertion.MustNoError(t, extractTar(buf, dst))
+
+	b, err := os.ReadFile(filepath.Join(dst, "data", "a.txt"))
+	assertion.MustNoError(t, err)
+	assertion.Equal(t, "a", string(b))
+	b, err = os.ReadFile(filepath.Join(dst, "data", "sub", "b.txt"))
+	assertion.MustNoError(t, err)
+	assertion.Equal(t, "b", string(b))
+	info, err := os.Stat(filepath.Join(dst, "data", "sub", "b.txt"))
+	assertion.MustNoError(t, err)
+	assertion.Equal(t, os.FileMode(0o600), info.Mode().Perm())
+	link, err := os.Readlink(filepath.Join(dst, "data", "link"))
+	assertion.MustNoError(t, err)
+	assertion.Equal(t, "a.txt", link)
+}
+
+func TestExtractTar_Escape(t *testing.T) {
+	cases := []*tar.Header{
+		{Name: "../evil", Typeflag: tar.TypeReg, Mode: 0o644},
+		{Name: "/etc/evil", Typeflag: tar.TypeReg, Mode: 0o644},
+		{Name: "link", Typeflag: tar.TypeSymlink, Linkname: "../../etc/passwd"},
+		{Name: "link", Typeflag: tar.TypeSymlink, Linkname: "/etc/passwd"},
+	}
+	for _, hdr := range cases {
+		buf := new(bytes.Buffer)
+		tw := tar.NewWriter(buf)
+		assertion.MustNoError(t, tw.WriteHeader(hdr))
+		assertion.MustNoError(t, tw.Close())
+
+		err := extractTar(buf, t.TempDir())
+		assertion.Equal(t, true, err != nil)
+	}
+
+	// Each link points to the inside of dst lexically, but the chained links point to the parent of dst.
+	parent := t.TempDir()
+	dst := filepath.Join(parent, "dst")
+	assertion.MustNoError(t, os.Mkdir(dst, 0o755))
+	buf := new(bytes.Buffer)
+	tw := tar.NewWriter(buf)
+	for _, hdr := range []*tar.Header{
+		{Name: "sub/", Typeflag: tar.TypeDir, Mode: 0o755},
+		{Name: "sub/l", Typeflag: tar.TypeSymlink, Linkname: ".."},
+		{Name: "sub/l/l2", Typeflag: tar.TypeSymlink, Linkname: ".."},
+		{Name: "sub/l/l2/evil", Typeflag: tar.TypeReg, Mode: 0o644},
+	} {
+		assertion.MustNoError(t, tw.WriteHeader(hdr))
+	}
+	assertion.MustNoError(t, tw.Close())
+
+	err := extractTar(buf, dst)
+	assertion.Equal(t, true, err != nil)
+	_, err = os.Lstat(filepath.Join(parent, "evil"))
+	assertion.Equal(t, true, os.IsNotExist(err))
+}
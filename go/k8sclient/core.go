@@ -2,69 +2,130 @@ package k8sclient
 
 import (
 	"context"
-	"errors"
-	"fmt"
+	"io"
 	"net/http"
-	"time"
 
-	"k8s.io/client-go/tools/portforward"
-	"k8s.io/client-go/transport/spdy"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/remotecommand"
+	"k8s.io/streaming/pkg/httpstream"
 
 	"go.f110.dev/kubeproto/go/apis/corev1"
+	"go.f110.dev/kubeproto/go/apis/metav1"
+	"go.f110.dev/kubeproto/go/apis/policyv1"
 )
 
 func init() {
-	// PodLogOptions is not in corev1.AddToScheme's known types, but
+	// These options are not in corev1.AddToScheme's known types, but
 	// VersionedParams requires the scheme to know the type.
-	Scheme.AddKnownTypes(corev1.SchemaGroupVersion, &corev1.PodLogOptions{})
+	Scheme.AddKnownTypes(corev1.SchemaGroupVersion, &corev1.PodLogOptions{}, &corev1.PodExecOptions{}, &corev1.PodAttachOptions{})
 }
 
-func (c *CoreV1) PortForward(ctx context.Context, pod *corev1.Pod, port int) (*portforward.PortForwarder, uint16, error) {
-	req := c.backend.RESTClient().Post().Resource("pods").Namespace(pod.Namespace).Name(pod.Name).SubResource("portforward")
-	transport, upgrader, err := spdy.RoundTripperFor(c.config)
-	if err != nil {
-		return nil, 0, err
-	}
-	dialer := spdy.NewDialer(upgrader, &http.Client{Transport: transport}, http.MethodPost, req.URL())
+// GetPodLogs retrieves the logs of a pod.
+// GetPodLogs reads the whole logs into memory. Use StreamPodLogs or PodLogLines for following the logs.
+func (c *CoreV1) GetPodLogs(ctx context.Context, namespace, name string, opts *corev1.PodLogOptions) ([]byte, error) {
+	return c.backend.RESTClient().Get().
+		Namespace(namespace).
+		Resource("pods").
+		Name(name).
+		SubResource("log").
+		VersionedParams(opts, ParameterCodec).
+		DoRaw(ctx)
+}
 
-	readyCh := make(chan struct{})
-	pf, err := portforward.New(dialer, []string{fmt.Sprintf(":%d", port)}, ctx.Done(), readyCh, nil, nil)
-	if err != nil {
-		return nil, 0, err
+// ExecInPod executes the command in the container of the pod and streams stdin, stdout and stderr.
+// The nil stream is not redirected. If tty is true, stderr is merged into stdout by the server
+// and sizeQueue is used for resizing the terminal. sizeQueue can be nil.
+// The WebSocket protocol is used and SPDY is used if the server doesn't support WebSocket.
+func (c *CoreV1) ExecInPod(ctx context.Context, namespace, name, container string, command []string, stdin io.Reader, stdout, stderr io.Writer, tty bool, sizeQueue remotecommand.TerminalSizeQueue) error {
+	opts := &corev1.PodExecOptions{
+		Container: container,
+		Command:   command,
+		Stdin:     stdin != nil,
+		Stdout:    stdout != nil,
+		Stderr:    stderr != nil && !tty,
+		TTY:       tty,
 	}
-	errCh := make(chan error, 1)
-	go func() {
-		defer close(errCh)
+	req := c.backend.RESTClient().Post().
+		Namespace(namespace).
+		Resource("pods").
+		Name(name).
+		SubResource("exec").
+		VersionedParams(opts, ParameterCodec)
 
-		err := pf.ForwardPorts()
-		if err != nil {
-			errCh <- err
-		}
-	}()
+	return c.stream(ctx, req, remotecommand.StreamOptions{
+		Stdin:             stdin,
+		Stdout:            stdout,
+		Stderr:            stderr,
+		Tty:               tty,
+		TerminalSizeQueue: sizeQueue,
+	})
+}
 
-	select {
-	case <-readyCh:
-	case err := <-errCh:
-		return nil, 0, err
-	case <-time.After(5 * time.Second):
-		return nil, 0, errors.New("timed out")
+// AttachPod attaches to the running process in the container of the pod.
+// The arguments are the same as ExecInPod.
+func (c *CoreV1) AttachPod(ctx context.Context, namespace, name, container string, stdin io.Reader, stdout, stderr io.Writer, tty bool, sizeQueue remotecommand.TerminalSizeQueue) error {
+	opts := &corev1.PodAttachOptions{
+		Container: container,
+		Stdin:     stdin != nil,
+		Stdout:    stdout != nil,
+		Stderr:    stderr != nil && !tty,
+		TTY:       tty,
 	}
+	req := c.backend.RESTClient().Post().
+		Namespace(namespace).
+		Resource("pods").
+		Name(name).
+		SubResource("attach").
+		VersionedParams(opts, ParameterCodec)
 
-	ports, err := pf.GetPorts()
+	return c.stream(ctx, req, remotecommand.StreamOptions{
+		Stdin:             stdin,
+		Stdout:            stdout,
+		Stderr:            stderr,
+		Tty:               tty,
+		TerminalSizeQueue: sizeQueue,
+	})
+}
+
+func (c *CoreV1) stream(ctx context.Context, req *rest.Request, opts remotecommand.StreamOptions) error {
+	wsExec, err := remotecommand.NewWebSocketExecutor(c.config, http.MethodGet, req.URL().String())
+	if err != nil {
+		return err
+	}
+	spdyExec, err := remotecommand.NewSPDYExecutor(c.config, http.MethodPost, req.URL())
+	if err != nil {
+		return err
+	}
+	exec, err := remotecommand.NewFallbackExecutor(wsExec, spdyExec, func(err error) bool {
+		return httpstream.IsUpgradeFailure(err) || httpstream.IsHTTPSProxyError(err)
+	})
 	if err != nil {
-		return nil, 0, err
+		return err
 	}
 
-	return pf, ports[0].Local, nil
+	return exec.StreamWithContext(ctx, opts)
 }
 
-// GetPodLogs retrieves the logs of a pod.
-func (c *CoreV1) GetPodLogs(ctx context.Context, namespace, name string, opts *corev1.PodLogOptions) ([]byte, error) {
-	return c.backend.RESTClient().Get().
-		Namespace(namespace).
-		Resource("pods").
-		Name(name).
-		SubResource("log").
-		VersionedParams(opts, ParameterCodec).
-		DoRaw(ctx)
+// EvictPod evicts the pod which is specified by the name and the namespace of eviction.
+// The eviction is refused by the server if the eviction violates PodDisruptionBudget.
+// The testing client doesn't delete the pod. Add a reactor of the eviction if the test depends on it.
+func (c *CoreV1) EvictPod(ctx context.Context, eviction *policyv1.Eviction, opts metav1.CreateOptions) error {
+	_, err := c.podClient.SubResource(ctx, http.MethodPost, eviction.Namespace, eviction.Name, "eviction", eviction, &opts, &metav1.Status{})
+	return err
+}
+
+// BindPod binds the pod which is specified by the name and the namespace of binding to the target node.
+// The testing client doesn't update the pod. Add a reactor of the binding if the test depends on it.
+func (c *CoreV1) BindPod(ctx context.Context, binding *corev1.Binding, opts metav1.CreateOptions) error {
+	_, err := c.podClient.SubResource(ctx, http.MethodPost, binding.Namespace, binding.Name, "binding", binding, &opts, &metav1.Status{})
+	return err
+}
+
+// GetPodEphemeralContainers retrieves the pod via ephemeralcontainers sub resource.
+func (c *CoreV1) GetPodEphemeralContainers(ctx context.Context, namespace, name string, opts metav1.GetOptions) (*corev1.Pod, error) {
+	result, err := c.podClient.SubResource(ctx, http.MethodGet, namespace, name, "ephemeralcontainers", nil, &opts, &corev1.Pod{})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*corev1.Pod), nil
 }
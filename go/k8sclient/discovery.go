@@ -3,9 +3,15 @@ package k8sclient
 import (
 	"context"
 	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
 	"mime"
+	"net/http"
 	"net/url"
 	"path"
+	"sort"
+	"strings"
 	"sync"
 
 	"k8s.io/apimachinery/pkg/runtime"
@@ -14,14 +20,35 @@ import (
 	"k8s.io/client-go/kubernetes/scheme"
 	"k8s.io/client-go/rest"
 
-	"go.f110.dev/kubeproto/go/apis/apidiscoveryv2beta1"
+	"go.f110.dev/kubeproto/go/apis/apidiscoveryv2"
 	"go.f110.dev/kubeproto/go/apis/metav1"
 )
 
 const (
-	discoveryAcceptHeader = "application/json;g=apidiscovery.k8s.io;v=v2beta1;as=APIGroupDiscoveryList,application/json"
+	discoveryAcceptHeader = "application/json;g=apidiscovery.k8s.io;v=v2;as=APIGroupDiscoveryList," +
+		"application/json;g=apidiscovery.k8s.io;v=v2beta1;as=APIGroupDiscoveryList," +
+		"application/json"
 )
 
+// ErrStaleGroupVersion is the error of the group version which is marked as stale by the aggregated discovery.
+var ErrStaleGroupVersion = errors.New("discovery document of the group version is stale")
+
+// ErrGroupDiscoveryFailed is returned when the resources of some group versions could not be retrieved.
+// The results of the other group versions are returned with this error.
+type ErrGroupDiscoveryFailed struct {
+	// Groups is the error of each group version which is failed.
+	Groups map[schema.GroupVersion]error
+}
+
+func (e *ErrGroupDiscoveryFailed) Error() string {
+	var msgs []string
+	for gv, err := range e.Groups {
+		msgs = append(msgs, fmt.Sprintf("%s: %v", gv, err))
+	}
+	sort.Strings(msgs)
+	return fmt.Sprintf("unable to retrieve the complete list of server APIs: %s", strings.Join(msgs, ", "))
+}
+
 type DiscoveryClient struct {
 	client         *rest.RESTClient
 	maxConcurrency int
@@ -37,84 +64,163 @@ func NewDiscoveryClient(cfg *rest.Config) (*DiscoveryClient, error) {
 	return &DiscoveryClient{client: c, maxConcurrency: 10}, nil
 }
 
+// APIResourceLists returns the groups and the resources of the core group (/api) and the named groups (/apis).
+// The aggregated discovery is used if the server supports it.
+// If the resources of some group versions could not be retrieved, the partial results are returned with
+// *ErrGroupDiscoveryFailed.
 func (d *DiscoveryClient) APIResourceLists(ctx context.Context) (*metav1.APIGroupList, map[schema.GroupVersion]*metav1.APIResourceList, error) {
-	var contentType string
-	body, err := d.client.Get().
-		AbsPath("/apis").
-		SetHeader("Accept", discoveryAcceptHeader).
-		Do(ctx).
-		ContentType(&contentType).
-		Raw()
+	var docs []*discoveryDocument
+	for _, p := range discoveryPaths {
+		doc, _, err := d.fetch(ctx, p, "")
+		if err != nil {
+			return nil, nil, err
+		}
+		docs = append(docs, doc)
+	}
+
+	return d.build(ctx, docs)
+}
+
+func (d *DiscoveryClient) SetMaxConcurrency(c int) {
+	d.maxConcurrency = c
+}
+
+// discoveryPaths are the paths of the discovery documents of the core group and the named groups.
+var discoveryPaths = []string{"/api", "/apis"}
+
+// discoveryDocument is the response of the discovery endpoint.
+type discoveryDocument struct {
+	Path string `json:"path"`
+	Body []byte `json:"body"`
+	// ETag is the entity tag of the document. The API server returns ETag only for the aggregated discovery.
+	ETag       string `json:"etag,omitempty"`
+	Aggregated bool   `json:"aggregated"`
+}
+
+// fetch retrieves the discovery document of p.
+// If etag is not empty and the document is not modified, fetch returns nil and notModified is true.
+func (d *DiscoveryClient) fetch(ctx context.Context, p, etag string) (doc *discoveryDocument, notModified bool, err error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, d.client.Get().AbsPath(p).URL().String(), nil)
+	if err != nil {
+		return nil, false, err
+	}
+	req.Header.Set("Accept", discoveryAcceptHeader)
+	if etag != "" {
+		req.Header.Set("If-None-Match", etag)
+	}
+	res, err := d.client.Client.Do(req)
+	if err != nil {
+		return nil, false, err
+	}
+	defer res.Body.Close()
+	if res.StatusCode == http.StatusNotModified && etag != "" {
+		return nil, true, nil
+	}
+	body, err := io.ReadAll(res.Body)
 	if err != nil {
-		return nil, nil, err
+		return nil, false, err
+	}
+	if res.StatusCode != http.StatusOK {
+		return nil, false, fmt.Errorf("failed to get %s: %s: %s", p, res.Status, string(body))
 	}
 
-	mediaType, params, err := mime.ParseMediaType(contentType)
+	mediaType, params, err := mime.ParseMediaType(res.Header.Get("Content-Type"))
 	if err != nil {
-		return nil, nil, err
+		return nil, false, err
 	}
-	var groupList metav1.APIGroupList
-	resources := make(map[schema.GroupVersion]*metav1.APIResourceList)
-	if mediaType == "application/json" && params["g"] == "apidiscovery.k8s.io" && params["v"] == "v2beta1" && params["as"] == "APIGroupDiscoveryList" {
-		var discoveryList apidiscoveryv2beta1.APIGroupDiscoveryList
-		if err := json.Unmarshal(body, &discoveryList); err != nil {
-			return nil, nil, err
-		}
+	aggregated := mediaType == "application/json" &&
+		params["g"] == "apidiscovery.k8s.io" &&
+		(params["v"] == "v2" || params["v"] == "v2beta1") &&
+		params["as"] == "APIGroupDiscoveryList"
+	return &discoveryDocument{Path: p, Body: body, ETag: res.Header.Get("ETag"), Aggregated: aggregated}, false, nil
+}
 
-		for _, v := range discoveryList.Items {
-			group, resourceList := d.toAPIGroup(v)
+// build converts the discovery documents to the groups and the resources.
+// The resources of each group version are retrieved if the document is not the aggregated discovery.
+func (d *DiscoveryClient) build(ctx context.Context, docs []*discoveryDocument) (*metav1.APIGroupList, map[schema.GroupVersion]*metav1.APIResourceList, error) {
+	result := &discoveryResult{
+		resources: make(map[schema.GroupVersion]*metav1.APIResourceList),
+		failed:    make(map[schema.GroupVersion]error),
+	}
 
-			groupList.Groups = append(groupList.Groups, *group)
-			for gv, list := range resourceList {
-				resources[gv] = list
+	for _, doc := range docs {
+		if doc.Aggregated {
+			// The schema of apidiscovery.k8s.io/v2beta1 is the same as v2.
+			var discoveryList apidiscoveryv2.APIGroupDiscoveryList
+			if err := json.Unmarshal(doc.Body, &discoveryList); err != nil {
+				return nil, nil, err
 			}
-		}
-	} else {
-		if err := json.Unmarshal(body, &groupList); err != nil {
-			return nil, nil, err
+			for _, v := range discoveryList.Items {
+				result.addAggregatedGroup(v)
+			}
+			continue
 		}
 
-		sem := make(chan struct{}, d.maxConcurrency)
-		var errs []error
-		var wg sync.WaitGroup
-		for _, group := range groupList.Groups {
-			for _, version := range group.Versions {
-				gv := schema.GroupVersion{Group: group.Name, Version: version.Version}
-				wg.Add(1)
-				go func(gv schema.GroupVersion) {
-					sem <- struct{}{}
-					defer func() {
-						<-sem
-						wg.Done()
-					}()
-
-					resourceList, err := d.fetchAPIResourceList(ctx, gv)
-					if err != nil {
-						errs = append(errs, err)
-						return
-					}
-					resources[gv] = resourceList
-				}(gv)
+		var groups []metav1.APIGroup
+		if doc.Path == "/api" {
+			var versions metav1.APIVersions
+			if err := json.Unmarshal(doc.Body, &versions); err != nil {
+				return nil, nil, err
 			}
+			if len(versions.Versions) > 0 {
+				group := metav1.APIGroup{}
+				for _, v := range versions.Versions {
+					group.Versions = append(group.Versions, metav1.GroupVersionForDiscovery{GroupVersion: v, Version: v})
+				}
+				group.PreferredVersion = &group.Versions[0]
+				groups = append(groups, group)
+			}
+		} else {
+			var groupList metav1.APIGroupList
+			if err := json.Unmarshal(doc.Body, &groupList); err != nil {
+				return nil, nil, err
+			}
+			groups = groupList.Groups
 		}
-		wg.Wait()
-		if len(errs) > 0 {
-			return nil, nil, errs[0]
-		}
-	}
-	if len(resources) > 0 {
-		return &groupList, resources, nil
+		result.groups.Groups = append(result.groups.Groups, groups...)
+		d.fetchGroups(ctx, groups, result)
 	}
 
-	return &groupList, resources, nil
+	if len(result.failed) > 0 {
+		return &result.groups, result.resources, &ErrGroupDiscoveryFailed{Groups: result.failed}
+	}
+	return &result.groups, result.resources, nil
 }
 
-func (d *DiscoveryClient) SetMaxConcurrency(c int) {
-	d.maxConcurrency = c
+// fetchGroups retrieves the resources of all versions of groups concurrently.
+func (d *DiscoveryClient) fetchGroups(ctx context.Context, groups []metav1.APIGroup, result *discoveryResult) {
+	sem := make(chan struct{}, d.maxConcurrency)
+	var wg sync.WaitGroup
+	for _, group := range groups {
+		for _, version := range group.Versions {
+			gv := schema.GroupVersion{Group: group.Name, Version: version.Version}
+			wg.Add(1)
+			go func() {
+				sem <- struct{}{}
+				defer func() {
+					<-sem
+					wg.Done()
+				}()
+
+				resourceList, err := d.fetchAPIResourceList(ctx, gv)
+				result.mu.Lock()
+				defer result.mu.Unlock()
+				if err != nil {
+					result.failed[gv] = err
+					return
+				}
+				result.resources[gv] = resourceList
+			}()
+		}
+	}
+	wg.Wait()
 }
 
 func (d *DiscoveryClient) fetchAPIResourceList(ctx context.Context, groupVersion schema.GroupVersion) (*metav1.APIResourceList, error) {
-	u := url.URL{Path: path.Join("apis", groupVersion.String())}
+	u := url.URL{Path: path.Join("/apis", groupVersion.String())}
+	if groupVersion.Group == "" {
+		u.Path = path.Join("/api", groupVersion.Version)
+	}
 
 	var resourceList metav1.APIResourceList
 	err := d.client.Get().
@@ -127,35 +233,63 @@ func (d *DiscoveryClient) fetchAPIResourceList(ctx context.Context, groupVersion
 	return &resourceList, nil
 }
 
-func (*DiscoveryClient) toAPIGroup(in apidiscoveryv2beta1.APIGroupDiscovery) (*metav1.APIGroup, map[schema.GroupVersion]*metav1.APIResourceList) {
+type discoveryResult struct {
+	mu        sync.Mutex
+	groups    metav1.APIGroupList
+	resources map[schema.GroupVersion]*metav1.APIResourceList
+	failed    map[schema.GroupVersion]error
+}
+
+// addAggregatedGroup converts the group of the aggregated discovery and adds it to the result.
+// The stale group version is reported as failed.
+func (r *discoveryResult) addAggregatedGroup(in apidiscoveryv2.APIGroupDiscovery) {
 	var emptyKind = metav1.GroupVersionKind{}
 
-	apiGroup := &metav1.APIGroup{}
-	apiResources := make(map[schema.GroupVersion]*metav1.APIResourceList)
+	apiGroup := metav1.APIGroup{Name: in.Name}
 	for _, v := range in.Versions {
 		gv := schema.GroupVersion{Group: in.Name, Version: v.Version}
-		version := metav1.GroupVersionForDiscovery{GroupVersion: gv.String(), Version: v.Version}
-		apiGroup.Versions = append(apiGroup.Versions, version)
+		apiGroup.Versions = append(apiGroup.Versions, metav1.GroupVersionForDiscovery{GroupVersion: gv.String(), Version: v.Version})
+		if v.Freshness == apidiscoveryv2.DiscoveryFreshnessStale {
+			r.failed[gv] = ErrStaleGroupVersion
+			continue
+		}
 
-		var resources *metav1.APIResourceList
-		for _, r := range v.Resources {
+		resources := &metav1.APIResourceList{GroupVersion: gv.String()}
+		for _, res := range v.Resources {
 			resource := metav1.APIResource{
-				Name:         r.Resource,
-				SingularName: r.SingularResource,
-				Verbs:        r.Verbs,
-				ShortNames:   r.ShortNames,
-				Categories:   r.Categories,
-				Namespaced:   r.Scope == apidiscoveryv2beta1.ResourceScopeNamespaced,
+				Name:         res.Resource,
+				SingularName: res.SingularResource,
+				Verbs:        res.Verbs,
+				ShortNames:   res.ShortNames,
+				Categories:   res.Categories,
+				Namespaced:   res.Scope == apidiscoveryv2.ResourceScopeNamespaced,
 			}
-			if r.ResponseKind != nil && *r.ResponseKind != emptyKind {
-				resource.Group = r.ResponseKind.Group
-				resource.Version = r.ResponseKind.Version
-				resource.Kind = r.ResponseKind.Kind
+			if res.ResponseKind != nil && *res.ResponseKind != emptyKind {
+				resource.Group = res.ResponseKind.Group
+				resource.Version = res.ResponseKind.Version
+				resource.Kind = res.ResponseKind.Kind
 			}
 			resources.APIResources = append(resources.APIResources, resource)
+
+			for _, sub := range res.Subresources {
+				subresource := metav1.APIResource{
+					Name:       res.Resource + "/" + sub.Subresource,
+					Verbs:      sub.Verbs,
+					Namespaced: resource.Namespaced,
+				}
+				if sub.ResponseKind != nil && *sub.ResponseKind != emptyKind {
+					subresource.Group = sub.ResponseKind.Group
+					subresource.Version = sub.ResponseKind.Version
+					subresource.Kind = sub.ResponseKind.Kind
+				}
+				resources.APIResources = append(resources.APIResources, subresource)
+			}
 		}
-		apiResources[gv] = resources
+		r.resources[gv] = resources
+	}
+	if len(apiGroup.Versions) > 0 {
+		apiGroup.PreferredVersion = &apiGroup.Versions[0]
 	}
 
-	return apiGroup, apiResources
+	r.groups.Groups = append(r.groups.Groups, apiGroup)
 }
@@ -0,0 +1,291 @@
+package k8sclient
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/rest"
+
+	"go.f110.dev/kubeproto/go/apis/metav1"
+)
+
+// DefaultDiscoveryCacheTTL is the default TTL of CachedDiscoveryClient.
+const DefaultDiscoveryCacheTTL = 6 * time.Hour
+
+// CachedDiscoveryClient is DiscoveryClient with the cache in memory and on the disk.
+// The cache on the disk is stored for each host of the API server.
+// After the TTL is expired, the cache is revalidated by ETag if the server supports the aggregated discovery.
+type CachedDiscoveryClient struct {
+	client   *DiscoveryClient
+	host     string
+	cacheDir string
+	ttl      time.Duration
+	now      func() time.Time
+
+	mu    sync.Mutex
+	cache *discoveryCache
+}
+
+type discoveryCache struct {
+	Host      string                    `json:"host"`
+	FetchedAt time.Time                 `json:"fetchedAt"`
+	Documents []*discoveryDocument      `json:"documents"`
+	Groups    *metav1.APIGroupList      `json:"groups"`
+	Resources []*metav1.APIResourceList `json:"resources"`
+	// Failed is the group versions which could not be retrieved. These are retried at the next time.
+	Failed []string `json:"failed,omitempty"`
+
+	resources map[schema.GroupVersion]*metav1.APIResourceList
+}
+
+// NewCachedDiscoveryClient returns CachedDiscoveryClient.
+// If cacheDir is empty, the cache is not stored on the disk. If ttl is zero, DefaultDiscoveryCacheTTL is used.
+func NewCachedDiscoveryClient(cfg *rest.Config, cacheDir string, ttl time.Duration) (*CachedDiscoveryClient, error) {
+	c, err := NewDiscoveryClient(cfg)
+	if err != nil {
+		return nil, err
+	}
+	if ttl == 0 {
+		ttl = DefaultDiscoveryCacheTTL
+	}
+	return &CachedDiscoveryClient{client: c, host: cfg.Host, cacheDir: cacheDir, ttl: ttl, now: time.Now}, nil
+}
+
+// APIResourceLists returns the groups and the resources from the cache.
+// The cache is refreshed if the cache doesn't exist or is expired.
+func (c *CachedDiscoveryClient) APIResourceLists(ctx context.Context) (*metav1.APIGroupList, map[schema.GroupVersion]*metav1.APIResourceList, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	cache, _, err := c.get(ctx, false)
+	if cache == nil {
+		return nil, nil, err
+	}
+	return cache.Groups, cache.resources, err
+}
+
+// APIResourceList returns the resources of the group version.
+// If the group version is not found in the cache, the cache is refreshed once.
+func (c *CachedDiscoveryClient) APIResourceList(ctx context.Context, gv schema.GroupVersion) (*metav1.APIResourceList, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	cache, refreshed, err := c.get(ctx, false)
+	if cache == nil {
+		return nil, err
+	}
+	if v, ok := cache.resources[gv]; ok {
+		return v, nil
+	}
+	if !refreshed {
+		cache, _, err = c.get(ctx, true)
+		if cache == nil {
+			return nil, err
+		}
+		if v, ok := cache.resources[gv]; ok {
+			return v, nil
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return nil, fmt.Errorf("group version %s is not found", gv)
+}
+
+// Invalidate removes the cache in memory and on the disk.
+func (c *CachedDiscoveryClient) Invalidate() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.cache = nil
+	if c.cacheDir == "" {
+		return nil
+	}
+	if err := os.Remove(c.cacheFile()); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+	return nil
+}
+
+// get returns the cache. If the cache is expired or force is true, get refreshes the cache.
+// refreshed is true if the cache is refreshed by this call.
+// The partial result is cached and returned with *ErrGroupDiscoveryFailed. The failed group versions are retried
+// at the next time even if the cache is not expired.
+func (c *CachedDiscoveryClient) get(ctx context.Context, force bool) (cache *discoveryCache, refreshed bool, err error) {
+	if c.cache == nil && c.cacheDir != "" {
+		c.cache = c.load()
+	}
+	if !force && c.cache != nil && c.now().Sub(c.cache.FetchedAt) < c.ttl {
+		if len(c.cache.Failed) == 0 {
+			return c.cache, false, nil
+		}
+		cache = c.cache
+		err = c.retryFailed(ctx, cache)
+	} else {
+		cache, err = c.refresh(ctx)
+		if cache == nil {
+			return nil, false, err
+		}
+		refreshed = true
+	}
+
+	c.cache = cache
+	if c.cacheDir != "" {
+		if err := c.save(cache); err != nil {
+			return nil, refreshed, err
+		}
+	}
+	return cache, refreshed, err
+}
+
+// retryFailed retrieves the resources of only the failed group versions and merges them into cache.
+func (c *CachedDiscoveryClient) retryFailed(ctx context.Context, cache *discoveryCache) error {
+	var groups []metav1.APIGroup
+	for _, v := range cache.Failed {
+		gv, err := schema.ParseGroupVersion(v)
+		if err != nil {
+			return err
+		}
+		groups = append(groups, metav1.APIGroup{
+			Name:     gv.Group,
+			Versions: []metav1.GroupVersionForDiscovery{{GroupVersion: v, Version: gv.Version}},
+		})
+	}
+	result := &discoveryResult{
+		resources: make(map[schema.GroupVersion]*metav1.APIResourceList),
+		failed:    make(map[schema.GroupVersion]error),
+	}
+	c.client.fetchGroups(ctx, groups, result)
+
+	for gv, v := range result.resources {
+		cache.resources[gv] = v
+		cache.Resources = append(cache.Resources, v)
+	}
+	cache.Failed = nil
+	for gv := range result.failed {
+		cache.Failed = append(cache.Failed, gv.String())
+	}
+	sort.Strings(cache.Failed)
+	if len(result.failed) > 0 {
+		return &ErrGroupDiscoveryFailed{Groups: result.failed}
+	}
+	return nil
+}
+
+// refresh retrieves the discovery documents. The document is reused if the document is not modified.
+func (c *CachedDiscoveryClient) refresh(ctx context.Context) (*discoveryCache, error) {
+	prev := make(map[string]*discoveryDocument)
+	if c.cache != nil {
+		for _, v := range c.cache.Documents {
+			prev[v.Path] = v
+		}
+	}
+
+	notModified := c.cache != nil
+	var docs []*discoveryDocument
+	for _, p := range discoveryPaths {
+		var etag string
+		if v, ok := prev[p]; ok {
+			etag = v.ETag
+		}
+		doc, nm, err := c.client.fetch(ctx, p, etag)
+		if err != nil {
+			return nil, err
+		}
+		if nm {
+			doc = prev[p]
+		} else {
+			notModified = false
+		}
+		docs = append(docs, doc)
+	}
+	if notModified {
+		cache := *c.cache
+		cache.FetchedAt = c.now()
+		if len(cache.Failed) > 0 {
+			return &cache, c.retryFailed(ctx, &cache)
+		}
+		return &cache, nil
+	}
+
+	groups, resources, err := c.client.build(ctx, docs)
+	var discoveryErr *ErrGroupDiscoveryFailed
+	if err != nil && !errors.As(err, &discoveryErr) {
+		return nil, err
+	}
+	cache := &discoveryCache{Host: c.host, FetchedAt: c.now(), Documents: docs, Groups: groups, resources: resources}
+	for _, v := range resources {
+		cache.Resources = append(cache.Resources, v)
+	}
+	if discoveryErr != nil {
+		for gv := range discoveryErr.Groups {
+			cache.Failed = append(cache.Failed, gv.String())
+		}
+		sort.Strings(cache.Failed)
+	}
+	return cache, err
+}
+
+var unsafeCacheDirChars = regexp.MustCompile(`[^(\w/.)]`)
+
+func (c *CachedDiscoveryClient) cacheFile() string {
+	host := strings.TrimPrefix(strings.TrimPrefix(c.host, "https://"), "http://")
+	return filepath.Join(c.cacheDir, unsafeCacheDirChars.ReplaceAllString(host, "_"), "discovery.json")
+}
+
+// load reads the cache from the disk. load returns nil if the cache could not be read.
+func (c *CachedDiscoveryClient) load() *discoveryCache {
+	b, err := os.ReadFile(c.cacheFile())
+	if err != nil {
+		return nil
+	}
+	cache := &discoveryCache{}
+	if err := json.Unmarshal(b, cache); err != nil || cache.Host != c.host {
+		return nil
+	}
+	cache.resources = make(map[schema.GroupVersion]*metav1.APIResourceList)
+	for _, v := range cache.Resources {
+		gv, err := schema.ParseGroupVersion(v.GroupVersion)
+		if err != nil {
+			return nil
+		}
+		cache.resources[gv] = v
+	}
+	return cache
+}
+
+func (c *CachedDiscoveryClient) save(cache *discoveryCache) error {
+	b, err := json.Marshal(cache)
+	if err != nil {
+		return err
+	}
+	p := c.cacheFile()
+	if err := os.MkdirAll(filepath.Dir(p), 0o750); err != nil {
+		return err
+	}
+	// Write to the temporary file and rename it for not leaving the broken file.
+	f, err := os.CreateTemp(filepath.Dir(p), "discovery-*.json")
+	if err != nil {
+		return err
+	}
+	if _, err := f.Write(b); err != nil {
+		f.Close()
+		os.Remove(f.Name())
+		return err
+	}
+	if err := f.Close(); err != nil {
+		os.Remove(f.Name())
+		return err
+	}
+	return os.Rename(f.Name(), p)
+}
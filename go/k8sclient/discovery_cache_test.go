@@ -0,0 +1,206 @@
+package k8sclient
+
+import (
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"sync"
+	"testing"
+	"time"
+
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/rest"
+
+	"go.f110.dev/kubeproto/go/internal/assertion"
+)
+
+type fakeDiscoveryServer struct {
+	*httptest.Server
+
+	mu       sync.Mutex
+	apis     string
+	requests int
+	modified int
+	// resources is the response of the endpoint of each group version. The endpoint returns the error if it is empty.
+	resources map[string]string
+}
+
+func newFakeDiscoveryServer(t *testing.T) *fakeDiscoveryServer {
+	f := &fakeDiscoveryServer{
+		apis: `{"kind":"APIGroupDiscoveryList","items":[{"metadata":{"name":"apps"},"versions":[{"version":"v1","resources":[` +
+			`{"resource":"deployments","responseKind":{"group":"apps","version":"v1","kind":"Deployment"},"scope":"Namespaced","verbs":["get"]}]}]}]}`,
+	}
+	mux := http.NewServeMux()
+	handle := func(p string, body func() string) {
+		mux.HandleFunc("GET "+p, func(w http.ResponseWriter, req *http.Request) {
+			f.mu.Lock()
+			defer f.mu.Unlock()
+			f.requests++
+
+			b := body()
+			etag := fmt.Sprintf(`"%x"`, sha256.Sum256([]byte(b)))
+			if req.Header.Get("If-None-Match") == etag {
+				w.WriteHeader(http.StatusNotModified)
+				return
+			}
+			f.modified++
+			w.Header().Set("Content-Type", "application/json;g=apidiscovery.k8s.io;v=v2;as=APIGroupDiscoveryList")
+			w.Header().Set("ETag", etag)
+			_, _ = io.WriteString(w, b)
+		})
+	}
+	handle("/api", func() string {
+		return `{"kind":"APIGroupDiscoveryList","items":[{"metadata":{},"versions":[{"version":"v1","resources":[` +
+			`{"resource":"pods","responseKind":{"group":"","version":"v1","kind":"Pod"},"scope":"Namespaced","verbs":["get"]}]}]}]}`
+	})
+	handle("/apis", func() string { return f.apis })
+	mux.HandleFunc("GET /apis/{group}/{version}", func(w http.ResponseWriter, req *http.Request) {
+		f.mu.Lock()
+		defer f.mu.Unlock()
+		f.requests++
+
+		b, ok := f.resources[req.URL.Path]
+		if !ok {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = io.WriteString(w, b)
+	})
+	f.Server = httptest.NewServer(mux)
+	t.Cleanup(f.Close)
+	return f
+}
+
+func (f *fakeDiscoveryServer) counts() (requests int, modified int) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.requests, f.modified
+}
+
+func (f *fakeDiscoveryServer) setAPIs(body string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.apis = body
+}
+
+func (f *fakeDiscoveryServer) setResources(p, body string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.resources == nil {
+		f.resources = make(map[string]string)
+	}
+	f.resources[p] = body
+}
+
+func TestCachedDiscoveryClient(t *testing.T) {
+	s := newFakeDiscoveryServer(t)
+	cacheDir := t.TempDir()
+	now := time.Now()
+
+	d, err := NewCachedDiscoveryClient(&rest.Config{Host: s.URL}, cacheDir, time.Minute)
+	assertion.MustNoError(t, err)
+	d.now = func() time.Time { return now }
+	_, resources, err := d.APIResourceLists(t.Context())
+	assertion.MustNoError(t, err)
+	assertion.Len(t, resources, 2)
+	requests, _ := s.counts()
+	assertion.Equal(t, 2, requests)
+
+	// The cache in memory is used.
+	_, _, err = d.APIResourceLists(t.Context())
+	assertion.MustNoError(t, err)
+	requests, _ = s.counts()
+	assertion.Equal(t, 2, requests)
+
+	// The cache on the disk is used by the new client.
+	d, err = NewCachedDiscoveryClient(&rest.Config{Host: s.URL}, cacheDir, time.Minute)
+	assertion.MustNoError(t, err)
+	d.now = func() time.Time { return now }
+	v, err := d.APIResourceList(t.Context(), schema.GroupVersion{Group: "apps", Version: "v1"})
+	assertion.MustNoError(t, err)
+	assertion.Equal(t, "deployments", v.APIResources[0].Name)
+	requests, _ = s.counts()
+	assertion.Equal(t, 2, requests)
+
+	// The expired cache is revalidated by ETag.
+	now = now.Add(2 * time.Minute)
+	_, resources, err = d.APIResourceLists(t.Context())
+	assertion.MustNoError(t, err)
+	assertion.Len(t, resources, 2)
+	requests, modified := s.counts()
+	assertion.Equal(t, 4, requests)
+	assertion.Equal(t, 2, modified)
+
+	// The cache is refreshed if the group version is not found.
+	s.setAPIs(`{"kind":"APIGroupDiscoveryList","items":[{"metadata":{"name":"batch"},"versions":[{"version":"v1","resources":[` +
+		`{"resource":"jobs","responseKind":{"group":"batch","version":"v1","kind":"Job"},"scope":"Namespaced","verbs":["get"]}]}]}]}`)
+	v, err = d.APIResourceList(t.Context(), schema.GroupVersion{Group: "batch", Version: "v1"})
+	assertion.MustNoError(t, err)
+	assertion.Equal(t, "jobs", v.APIResources[0].Name)
+	requests, modified = s.counts()
+	assertion.Equal(t, 6, requests)
+	assertion.Equal(t, 3, modified)
+
+	_, err = d.APIResourceList(t.Context(), schema.GroupVersion{Group: "apps", Version: "v1"})
+	assertion.Equal(t, true, err != nil)
+
+	// Invalidate removes the cache on the disk.
+	assertion.MustNoError(t, d.Invalidate())
+	_, err = os.Stat(d.cacheFile())
+	assertion.Equal(t, true, os.IsNotExist(err))
+	_, _, err = d.APIResourceLists(t.Context())
+	assertion.MustNoError(t, err)
+	requests, modified = s.counts()
+	assertion.Equal(t, 10, requests)
+	assertion.Equal(t, 5, modified)
+}
+
+func TestCachedDiscoveryClient_PartialResult(t *testing.T) {
+	s := newFakeDiscoveryServer(t)
+	s.setAPIs(`{"kind":"APIGroupDiscoveryList","items":[{"metadata":{"name":"apps"},"versions":[{"version":"v1","resources":[` +
+		`{"resource":"deployments","responseKind":{"group":"apps","version":"v1","kind":"Deployment"},"scope":"Namespaced","verbs":["get"]}]}]},` +
+		`{"metadata":{"name":"metrics.k8s.io"},"versions":[{"version":"v1beta1","freshness":"Stale"}]}]}`)
+	cacheDir := t.TempDir()
+	now := time.Now()
+
+	d, err := NewCachedDiscoveryClient(&rest.Config{Host: s.URL}, cacheDir, time.Minute)
+	assertion.MustNoError(t, err)
+	d.now = func() time.Time { return now }
+	_, resources, err := d.APIResourceLists(t.Context())
+	var discoveryErr *ErrGroupDiscoveryFailed
+	assertion.Equal(t, true, errors.As(err, &discoveryErr))
+	assertion.Len(t, resources, 2)
+	requests, _ := s.counts()
+	assertion.Equal(t, 2, requests)
+
+	// The partial result is cached and only the failed group version is retried.
+	_, resources, err = d.APIResourceLists(t.Context())
+	assertion.Equal(t, true, errors.As(err, &discoveryErr))
+	assertion.Len(t, resources, 2)
+	requests, _ = s.counts()
+	assertion.Equal(t, 3, requests)
+
+	// The failed group version is retried by the new client which loads the cache from the disk.
+	s.setResources("/apis/metrics.k8s.io/v1beta1", `{"kind":"APIResourceList","groupVersion":"metrics.k8s.io/v1beta1","resources":[`+
+		`{"name":"pods","namespaced":true,"kind":"PodMetrics","verbs":["get"]}]}`)
+	d, err = NewCachedDiscoveryClient(&rest.Config{Host: s.URL}, cacheDir, time.Minute)
+	assertion.MustNoError(t, err)
+	d.now = func() time.Time { return now }
+	v, err := d.APIResourceList(t.Context(), schema.GroupVersion{Group: "metrics.k8s.io", Version: "v1beta1"})
+	assertion.MustNoError(t, err)
+	assertion.Equal(t, "pods", v.APIResources[0].Name)
+	requests, _ = s.counts()
+	assertion.Equal(t, 4, requests)
+
+	// The cache is complete after the retry.
+	_, resources, err = d.APIResourceLists(t.Context())
+	assertion.MustNoError(t, err)
+	assertion.Len(t, resources, 3)
+	requests, _ = s.counts()
+	assertion.Equal(t, 4, requests)
+}
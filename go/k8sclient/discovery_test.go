@@ -0,0 +1,82 @@
+package k8sclient
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/rest"
+
+	"go.f110.dev/kubeproto/go/internal/assertion"
+)
+
+func TestDiscoveryClient_Legacy(t *testing.T) {
+	mux := http.NewServeMux()
+	handle := func(p, body string) {
+		mux.HandleFunc("GET "+p, func(w http.ResponseWriter, _ *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = io.WriteString(w, body)
+		})
+	}
+	handle("/api", `{"kind":"APIVersions","versions":["v1"]}`)
+	handle("/apis", `{"kind":"APIGroupList","groups":[`+
+		`{"name":"apps","versions":[{"groupVersion":"apps/v1","version":"v1"}]},`+
+		`{"name":"metrics.k8s.io","versions":[{"groupVersion":"metrics.k8s.io/v1beta1","version":"v1beta1"}]}]}`)
+	handle("/api/v1", `{"kind":"APIResourceList","groupVersion":"v1","resources":[{"name":"pods","namespaced":true,"kind":"Pod","verbs":["get"]}]}`)
+	handle("/apis/apps/v1", `{"kind":"APIResourceList","groupVersion":"apps/v1","resources":[{"name":"deployments","namespaced":true,"kind":"Deployment","verbs":["get"]}]}`)
+	mux.HandleFunc("GET /apis/metrics.k8s.io/v1beta1", func(w http.ResponseWriter, _ *http.Request) {
+		http.Error(w, "service unavailable", http.StatusServiceUnavailable)
+	})
+	s := httptest.NewServer(mux)
+	defer s.Close()
+
+	d, err := NewDiscoveryClient(&rest.Config{Host: s.URL})
+	assertion.MustNoError(t, err)
+	groups, resources, err := d.APIResourceLists(t.Context())
+	var discoveryErr *ErrGroupDiscoveryFailed
+	assertion.Equal(t, true, errors.As(err, &discoveryErr))
+	assertion.Len(t, discoveryErr.Groups, 1)
+	assertion.Equal(t, true, discoveryErr.Groups[schema.GroupVersion{Group: "metrics.k8s.io", Version: "v1beta1"}] != nil)
+
+	assertion.Len(t, groups.Groups, 3)
+	assertion.Equal(t, "", groups.Groups[0].Name)
+	assertion.Len(t, resources, 2)
+	assertion.Equal(t, "pods", resources[schema.GroupVersion{Version: "v1"}].APIResources[0].Name)
+	assertion.Equal(t, "deployments", resources[schema.GroupVersion{Group: "apps", Version: "v1"}].APIResources[0].Name)
+}
+
+func TestDiscoveryClient_Aggregated(t *testing.T) {
+	mux := http.NewServeMux()
+	handle := func(p, body string) {
+		mux.HandleFunc("GET "+p, func(w http.ResponseWriter, _ *http.Request) {
+			w.Header().Set("Content-Type", "application/json;g=apidiscovery.k8s.io;v=v2;as=APIGroupDiscoveryList")
+			_, _ = io.WriteString(w, body)
+		})
+	}
+	handle("/api", `{"kind":"APIGroupDiscoveryList","items":[{"metadata":{},"versions":[{"version":"v1","resources":[`+
+		`{"resource":"pods","responseKind":{"group":"","version":"v1","kind":"Pod"},"scope":"Namespaced","singularResource":"pod","verbs":["get"],`+
+		`"subresources":[{"subresource":"status","responseKind":{"group":"","version":"v1","kind":"Pod"},"verbs":["get"]}]}]}]}]}`)
+	handle("/apis", `{"kind":"APIGroupDiscoveryList","items":[`+
+		`{"metadata":{"name":"apps"},"versions":[{"version":"v1","resources":[{"resource":"deployments","responseKind":{"group":"apps","version":"v1","kind":"Deployment"},"scope":"Namespaced","singularResource":"deployment","verbs":["get"]}]}]},`+
+		`{"metadata":{"name":"metrics.k8s.io"},"versions":[{"version":"v1beta1","freshness":"Stale"}]}]}`)
+	s := httptest.NewServer(mux)
+	defer s.Close()
+
+	d, err := NewDiscoveryClient(&rest.Config{Host: s.URL})
+	assertion.MustNoError(t, err)
+	groups, resources, err := d.APIResourceLists(t.Context())
+	var discoveryErr *ErrGroupDiscoveryFailed
+	assertion.Equal(t, true, errors.As(err, &discoveryErr))
+	assertion.Equal(t, ErrStaleGroupVersion, discoveryErr.Groups[schema.GroupVersion{Group: "metrics.k8s.io", Version: "v1beta1"}])
+
+	assertion.Len(t, groups.Groups, 3)
+	assertion.Len(t, resources, 2)
+	pods := resources[schema.GroupVersion{Version: "v1"}]
+	assertion.Equal(t, "v1", pods.GroupVersion)
+	assertion.Len(t, pods.APIResources, 2)
+	assertion.Equal(t, "pods/status", pods.APIResources[1].Name)
+	assertion.Equal(t, "Deployment", resources[schema.GroupVersion{Group: "apps", Version: "v1"}].APIResources[0].Kind)
+}
@@ -0,0 +1,204 @@
+package k8sclient
+
+import (
+	"context"
+	"encoding/json"
+
+	k8smetav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/rest"
+
+	"go.f110.dev/kubeproto/go/apis/metav1"
+)
+
+// DynamicBackend is the transport of DynamicClient.
+// namespace is empty if the resource is cluster scoped.
+type DynamicBackend interface {
+	Get(ctx context.Context, gvr schema.GroupVersionResource, namespace, name string, opts metav1.GetOptions, subresources ...string) (*unstructured.Unstructured, error)
+	List(ctx context.Context, gvr schema.GroupVersionResource, namespace string, opts metav1.ListOptions) (*unstructured.UnstructuredList, error)
+	Create(ctx context.Context, gvr schema.GroupVersionResource, namespace string, obj *unstructured.Unstructured, opts metav1.CreateOptions, subresources ...string) (*unstructured.Unstructured, error)
+	Update(ctx context.Context, gvr schema.GroupVersionResource, namespace string, obj *unstructured.Unstructured, opts metav1.UpdateOptions, subresources ...string) (*unstructured.Unstructured, error)
+	Patch(ctx context.Context, gvr schema.GroupVersionResource, namespace, name string, pt types.PatchType, data []byte, opts metav1.PatchOptions, subresources ...string) (*unstructured.Unstructured, error)
+	Delete(ctx context.Context, gvr schema.GroupVersionResource, namespace, name string, opts metav1.DeleteOptions, subresources ...string) error
+	Watch(ctx context.Context, gvr schema.GroupVersionResource, namespace string, opts metav1.ListOptions) (watch.Interface, error)
+}
+
+// DynamicClient is the client which operates on unstructured.Unstructured by GroupVersionResource.
+// DynamicClient can handle the kinds which are unknown at compile time.
+type DynamicClient struct {
+	backend DynamicBackend
+}
+
+// NewDynamicClient returns DynamicClient which sends the requests to the API server.
+// cfg is the same as the config of NewSet.
+func NewDynamicClient(cfg *rest.Config) (*DynamicClient, error) {
+	c, err := dynamic.NewForConfig(cfg)
+	if err != nil {
+		return nil, err
+	}
+	return NewDynamicClientWithBackend(&restDynamicBackend{client: c}), nil
+}
+
+// NewDynamicClientWithBackend returns DynamicClient of b.
+func NewDynamicClientWithBackend(b DynamicBackend) *DynamicClient {
+	return &DynamicClient{backend: b}
+}
+
+// Resource returns the client of gvr. Use DynamicResourceClient.Namespace for the namespaced resource.
+func (c *DynamicClient) Resource(gvr schema.GroupVersionResource) *DynamicResourceClient {
+	return &DynamicResourceClient{backend: c.backend, gvr: gvr}
+}
+
+// DynamicResourceClient is the client of the resource.
+type DynamicResourceClient struct {
+	backend   DynamicBackend
+	gvr       schema.GroupVersionResource
+	namespace string
+}
+
+// Namespace returns the client of the resource in namespace.
+func (c *DynamicResourceClient) Namespace(namespace string) *DynamicResourceClient {
+	return &DynamicResourceClient{backend: c.backend, gvr: c.gvr, namespace: namespace}
+}
+
+func (c *DynamicResourceClient) Get(ctx context.Context, name string, opts metav1.GetOptions, subresources ...string) (*unstructured.Unstructured, error) {
+	return c.backend.Get(ctx, c.gvr, c.namespace, name, opts, subresources...)
+}
+
+func (c *DynamicResourceClient) List(ctx context.Context, opts metav1.ListOptions) (*unstructured.UnstructuredList, error) {
+	return c.backend.List(ctx, c.gvr, c.namespace, opts)
+}
+
+func (c *DynamicResourceClient) Create(ctx context.Context, obj *unstructured.Unstructured, opts metav1.CreateOptions, subresources ...string) (*unstructured.Unstructured, error) {
+	return c.backend.Create(ctx, c.gvr, c.namespace, obj, opts, subresources...)
+}
+
+func (c *DynamicResourceClient) Update(ctx context.Context, obj *unstructured.Unstructured, opts metav1.UpdateOptions, subresources ...string) (*unstructured.Unstructured, error) {
+	return c.backend.Update(ctx, c.gvr, c.namespace, obj, opts, subresources...)
+}
+
+func (c *DynamicResourceClient) UpdateStatus(ctx context.Context, obj *unstructured.Unstructured, opts metav1.UpdateOptions) (*unstructured.Unstructured, error) {
+	return c.backend.Update(ctx, c.gvr, c.namespace, obj, opts, "status")
+}
+
+func (c *DynamicResourceClient) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts metav1.PatchOptions, subresources ...string) (*unstructured.Unstructured, error) {
+	return c.backend.Patch(ctx, c.gvr, c.namespace, name, pt, data, opts, subresources...)
+}
+
+func (c *DynamicResourceClient) Delete(ctx context.Context, name string, opts metav1.DeleteOptions, subresources ...string) error {
+	return c.backend.Delete(ctx, c.gvr, c.namespace, name, opts, subresources...)
+}
+
+func (c *DynamicResourceClient) Watch(ctx context.Context, opts metav1.ListOptions) (watch.Interface, error) {
+	return c.backend.Watch(ctx, c.gvr, c.namespace, opts)
+}
+
+// Apply applies obj by the server-side apply. opts.FieldManager is required.
+func (c *DynamicResourceClient) Apply(ctx context.Context, name string, obj *unstructured.Unstructured, opts metav1.ApplyOptions, subresources ...string) (*unstructured.Unstructured, error) {
+	data, err := json.Marshal(obj)
+	if err != nil {
+		return nil, err
+	}
+	patchOpts := metav1.PatchOptions{DryRun: opts.DryRun, Force: opts.Force, FieldManager: opts.FieldManager}
+	return c.backend.Patch(ctx, c.gvr, c.namespace, name, types.ApplyPatchType, data, patchOpts, subresources...)
+}
+
+// ApplyStatus applies the status of obj by the server-side apply.
+func (c *DynamicResourceClient) ApplyStatus(ctx context.Context, name string, obj *unstructured.Unstructured, opts metav1.ApplyOptions) (*unstructured.Unstructured, error) {
+	return c.Apply(ctx, name, obj, opts, "status")
+}
+
+// restDynamicBackend is DynamicBackend which sends the requests through the dynamic client of client-go.
+type restDynamicBackend struct {
+	client dynamic.Interface
+}
+
+var _ DynamicBackend = &restDynamicBackend{}
+
+func (b *restDynamicBackend) resource(gvr schema.GroupVersionResource, namespace string) dynamic.ResourceInterface {
+	if namespace == "" {
+		return b.client.Resource(gvr)
+	}
+	return b.client.Resource(gvr).Namespace(namespace)
+}
+
+func (b *restDynamicBackend) Get(ctx context.Context, gvr schema.GroupVersionResource, namespace, name string, opts metav1.GetOptions, subresources ...string) (*unstructured.Unstructured, error) {
+	return b.resource(gvr, namespace).Get(ctx, name, k8smetav1.GetOptions{ResourceVersion: opts.ResourceVersion}, subresources...)
+}
+
+func (b *restDynamicBackend) List(ctx context.Context, gvr schema.GroupVersionResource, namespace string, opts metav1.ListOptions) (*unstructured.UnstructuredList, error) {
+	return b.resource(gvr, namespace).List(ctx, listOptionsToUpstream(opts))
+}
+
+func (b *restDynamicBackend) Create(ctx context.Context, gvr schema.GroupVersionResource, namespace string, obj *unstructured.Unstructured, opts metav1.CreateOptions, subresources ...string) (*unstructured.Unstructured, error) {
+	o := k8smetav1.CreateOptions{DryRun: opts.DryRun, FieldManager: opts.FieldManager, FieldValidation: opts.FieldValidation}
+	return b.resource(gvr, namespace).Create(ctx, obj, o, subresources...)
+}
+
+func (b *restDynamicBackend) Update(ctx context.Context, gvr schema.GroupVersionResource, namespace string, obj *unstructured.Unstructured, opts metav1.UpdateOptions, subresources ...string) (*unstructured.Unstructured, error) {
+	o := k8smetav1.UpdateOptions{DryRun: opts.DryRun, FieldManager: opts.FieldManager, FieldValidation: opts.FieldValidation}
+	return b.resource(gvr, namespace).Update(ctx, obj, o, subresources...)
+}
+
+func (b *restDynamicBackend) Patch(ctx context.Context, gvr schema.GroupVersionResource, namespace, name string, pt types.PatchType, data []byte, opts metav1.PatchOptions, subresources ...string) (*unstructured.Unstructured, error) {
+	o := k8smetav1.PatchOptions{DryRun: opts.DryRun, FieldManager: opts.FieldManager, FieldValidation: opts.FieldValidation}
+	if opts.Force {
+		o.Force = &opts.Force
+	}
+	return b.resource(gvr, namespace).Patch(ctx, name, pt, data, o, subresources...)
+}
+
+func (b *restDynamicBackend) Delete(ctx context.Context, gvr schema.GroupVersionResource, namespace, name string, opts metav1.DeleteOptions, subresources ...string) error {
+	o := k8smetav1.DeleteOptions{DryRun: opts.DryRun}
+	if opts.GracePeriodSeconds != 0 {
+		o.GracePeriodSeconds = &opts.GracePeriodSeconds
+	}
+	if opts.Preconditions != nil {
+		o.Preconditions = &k8smetav1.Preconditions{}
+		if opts.Preconditions.UID != "" {
+			uid := types.UID(opts.Preconditions.UID)
+			o.Preconditions.UID = &uid
+		}
+		if opts.Preconditions.ResourceVersion != "" {
+			o.Preconditions.ResourceVersion = &opts.Preconditions.ResourceVersion
+		}
+	}
+	if opts.OrphanDependents {
+		o.OrphanDependents = &opts.OrphanDependents
+	}
+	if opts.PropagationPolicy != "" {
+		policy := k8smetav1.DeletionPropagation(opts.PropagationPolicy)
+		o.PropagationPolicy = &policy
+	}
+	return b.resource(gvr, namespace).Delete(ctx, name, o, subresources...)
+}
+
+func (b *restDynamicBackend) Watch(ctx context.Context, gvr schema.GroupVersionResource, namespace string, opts metav1.ListOptions) (watch.Interface, error) {
+	return b.resource(gvr, namespace).Watch(ctx, listOptionsToUpstream(opts))
+}
+
+// listOptionsToUpstream converts ListOptions to the upstream ListOptions.
+// The zero value of the optional field is not set.
+func listOptionsToUpstream(in metav1.ListOptions) k8smetav1.ListOptions {
+	out := k8smetav1.ListOptions{
+		LabelSelector:        in.LabelSelector,
+		FieldSelector:        in.FieldSelector,
+		Watch:                in.Watch,
+		AllowWatchBookmarks:  in.AllowWatchBookmarks,
+		ResourceVersion:      in.ResourceVersion,
+		ResourceVersionMatch: k8smetav1.ResourceVersionMatch(in.ResourceVersionMatch),
+		Limit:                in.Limit,
+		Continue:             in.Continue,
+	}
+	if in.TimeoutSeconds != 0 {
+		out.TimeoutSeconds = &in.TimeoutSeconds
+	}
+	if in.SendInitialEvents {
+		out.SendInitialEvents = &in.SendInitialEvents
+	}
+	return out
+}
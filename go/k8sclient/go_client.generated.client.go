@@ -2,8 +2,10 @@ package k8sclient
 
 import (
 	"context"
-	"errors"
+	"fmt"
 	"reflect"
+	"sort"
+	"strings"
 	"sync"
 	"time"
 
@@ -13,6 +15,7 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/runtime/serializer"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/watch"
 	"k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/cache"
@@ -36,6 +39,7 @@ import (
 	"go.f110.dev/kubeproto/go/apis/resourcev1"
 	"go.f110.dev/kubeproto/go/apis/schedulingv1"
 	"go.f110.dev/kubeproto/go/apis/storagev1"
+	"go.f110.dev/kubeproto/go/typedclient"
 )
 
 var (
@@ -93,24 +97,7 @@ func init() {
 	}
 }
 
-type Backend interface {
-	Get(ctx context.Context, resourceName, namespace, name string, opts metav1.GetOptions, result runtime.Object) (runtime.Object, error)
-	List(ctx context.Context, resourceName, namespace string, opts metav1.ListOptions, result runtime.Object) (runtime.Object, error)
-	Create(ctx context.Context, resourceName string, obj runtime.Object, opts metav1.CreateOptions, result runtime.Object) (runtime.Object, error)
-	Update(ctx context.Context, resourceName string, obj runtime.Object, opts metav1.UpdateOptions, result runtime.Object) (runtime.Object, error)
-	UpdateStatus(ctx context.Context, resourceName string, obj runtime.Object, opts metav1.UpdateOptions, result runtime.Object) (runtime.Object, error)
-	Delete(ctx context.Context, gvr schema.GroupVersionResource, namespace, name string, opts metav1.DeleteOptions) error
-	Watch(ctx context.Context, gvr schema.GroupVersionResource, namespace string, opts metav1.ListOptions) (watch.Interface, error)
-	GetClusterScoped(ctx context.Context, resourceName, name string, opts metav1.GetOptions, result runtime.Object) (runtime.Object, error)
-	ListClusterScoped(ctx context.Context, resourceName string, opts metav1.ListOptions, result runtime.Object) (runtime.Object, error)
-	CreateClusterScoped(ctx context.Context, resourceName string, obj runtime.Object, opts metav1.CreateOptions, result runtime.Object) (runtime.Object, error)
-	UpdateClusterScoped(ctx context.Context, resourceName string, obj runtime.Object, opts metav1.UpdateOptions, result runtime.Object) (runtime.Object, error)
-	UpdateStatusClusterScoped(ctx context.Context, resourceName string, obj runtime.Object, opts metav1.UpdateOptions, result runtime.Object) (runtime.Object, error)
-	DeleteClusterScoped(ctx context.Context, gvr schema.GroupVersionResource, name string, opts metav1.DeleteOptions) error
-	WatchClusterScoped(ctx context.Context, gvr schema.GroupVersionResource, opts metav1.ListOptions) (watch.Interface, error)
-
-	RESTClient() *rest.RESTClient
-}
+type Backend = typedclient.Backend
 
 type Set struct {
 	CoreV1                       *CoreV1
@@ -144,7 +131,7 @@ func NewSet(cfg *rest.Config) (*Set, error) {
 		if err != nil {
 			return nil, err
 		}
-		s.CoreV1 = NewCoreV1Client(&restBackend{client: c}, &conf)
+		s.CoreV1 = NewCoreV1Client(typedclient.NewRESTBackend(c, ParameterCodec), &conf)
 	}
 	{
 		conf := *cfg
@@ -155,7 +142,7 @@ func NewSet(cfg *rest.Config) (*Set, error) {
 		if err != nil {
 			return nil, err
 		}
-		s.AdmissionregistrationK8sIoV1 = NewAdmissionregistrationK8sIoV1Client(&restBackend{client: c}, &conf)
+		s.AdmissionregistrationK8sIoV1 = NewAdmissionregistrationK8sIoV1Client(typedclient.NewRESTBackend(c, ParameterCodec), &conf)
 	}
 	{
 		conf := *cfg
@@ -166,7 +153,7 @@ func NewSet(cfg *rest.Config) (*Set, error) {
 		if err != nil {
 			return nil, err
 		}
-		s.AppsV1 = NewAppsV1Client(&restBackend{client: c}, &conf)
+		s.AppsV1 = NewAppsV1Client(typedclient.NewRESTBackend(c, ParameterCodec), &conf)
 	}
 	{
 		conf := *cfg
@@ -177,7 +164,7 @@ func NewSet(cfg *rest.Config) (*Set, error) {
 		if err != nil {
 			return nil, err
 		}
-		s.AuthenticationK8sIoV1 = NewAuthenticationK8sIoV1Client(&restBackend{client: c}, &conf)
+		s.AuthenticationK8sIoV1 = NewAuthenticationK8sIoV1Client(typedclient.NewRESTBackend(c, ParameterCodec), &conf)
 	}
 	{
 		conf := *cfg
@@ -188,7 +175,7 @@ func NewSet(cfg *rest.Config) (*Set, error) {
 		if err != nil {
 			return nil, err
 		}
-		s.AuthorizationK8sIoV1 = NewAuthorizationK8sIoV1Client(&restBackend{client: c}, &conf)
+		s.AuthorizationK8sIoV1 = NewAuthorizationK8sIoV1Client(typedclient.NewRESTBackend(c, ParameterCodec), &conf)
 	}
 	{
 		conf := *cfg
@@ -199,7 +186,7 @@ func NewSet(cfg *rest.Config) (*Set, error) {
 		if err != nil {
 			return nil, err
 		}
-		s.AutoscalingV1 = NewAutoscalingV1Client(&restBackend{client: c}, &conf)
+		s.AutoscalingV1 = NewAutoscalingV1Client(typedclient.NewRESTBackend(c, ParameterCodec), &conf)
 	}
 	{
 		conf := *cfg
@@ -210,7 +197,7 @@ func NewSet(cfg *rest.Config) (*Set, error) {
 		if err != nil {
 			return nil, err
 		}
-		s.AutoscalingV2 = NewAutoscalingV2Client(&restBackend{client: c}, &conf)
+		s.AutoscalingV2 = NewAutoscalingV2Client(typedclient.NewRESTBackend(c, ParameterCodec), &conf)
 	}
 	{
 		conf := *cfg
@@ -221,7 +208,7 @@ func NewSet(cfg *rest.Config) (*Set, error) {
 		if err != nil {
 			return nil, err
 		}
-		s.BatchV1 = NewBatchV1Client(&restBackend{client: c}, &conf)
+		s.BatchV1 = NewBatchV1Client(typedclient.NewRESTBackend(c, ParameterCodec), &conf)
 	}
 	{
 		conf := *cfg
@@ -232,7 +219,7 @@ func NewSet(cfg *rest.Config) (*Set, error) {
 		if err != nil {
 			return nil, err
 		}
-		s.CertificatesK8sIoV1 = NewCertificatesK8sIoV1Client(&restBackend{client: c}, &conf)
+		s.CertificatesK8sIoV1 = NewCertificatesK8sIoV1Client(typedclient.NewRESTBackend(c, ParameterCodec), &conf)
 	}
 	{
 		conf := *cfg
@@ -243,7 +230,7 @@ func NewSet(cfg *rest.Config) (*Set, error) {
 		if err != nil {
 			return nil, err
 		}
-		s.CoordinationK8sIoV1 = NewCoordinationK8sIoV1Client(&restBackend{client: c}, &conf)
+		s.CoordinationK8sIoV1 = NewCoordinationK8sIoV1Client(typedclient.NewRESTBackend(c, ParameterCodec), &conf)
 	}
 	{
 		conf := *cfg
@@ -254,7 +241,7 @@ func NewSet(cfg *rest.Config) (*Set, error) {
 		if err != nil {
 			return nil, err
 		}
-		s.DiscoveryK8sIoV1 = NewDiscoveryK8sIoV1Client(&restBackend{client: c}, &conf)
+		s.DiscoveryK8sIoV1 = NewDiscoveryK8sIoV1Client(typedclient.NewRESTBackend(c, ParameterCodec), &conf)
 	}
 	{
 		conf := *cfg
@@ -265,7 +252,7 @@ func NewSet(cfg *rest.Config) (*Set, error) {
 		if err != nil {
 			return nil, err
 		}
-		s.EventsK8sIoV1 = NewEventsK8sIoV1Client(&restBackend{client: c}, &conf)
+		s.EventsK8sIoV1 = NewEventsK8sIoV1Client(typedclient.NewRESTBackend(c, ParameterCodec), &conf)
 	}
 	{
 		conf := *cfg
@@ -276,7 +263,7 @@ func NewSet(cfg *rest.Config) (*Set, error) {
 		if err != nil {
 			return nil, err
 		}
-		s.NetworkingK8sIoV1 = NewNetworkingK8sIoV1Client(&restBackend{client: c}, &conf)
+		s.NetworkingK8sIoV1 = NewNetworkingK8sIoV1Client(typedclient.NewRESTBackend(c, ParameterCodec), &conf)
 	}
 	{
 		conf := *cfg
@@ -287,7 +274,7 @@ func NewSet(cfg *rest.Config) (*Set, error) {
 		if err != nil {
 			return nil, err
 		}
-		s.PolicyV1 = NewPolicyV1Client(&restBackend{client: c}, &conf)
+		s.PolicyV1 = NewPolicyV1Client(typedclient.NewRESTBackend(c, ParameterCodec), &conf)
 	}
 	{
 		conf := *cfg
@@ -298,7 +285,7 @@ func NewSet(cfg *rest.Config) (*Set, error) {
 		if err != nil {
 			return nil, err
 		}
-		s.RbacAuthorizationK8sIoV1 = NewRbacAuthorizationK8sIoV1Client(&restBackend{client: c}, &conf)
+		s.RbacAuthorizationK8sIoV1 = NewRbacAuthorizationK8sIoV1Client(typedclient.NewRESTBackend(c, ParameterCodec), &conf)
 	}
 	{
 		conf := *cfg
@@ -309,7 +296,7 @@ func NewSet(cfg *rest.Config) (*Set, error) {
 		if err != nil {
 			return nil, err
 		}
-		s.ResourceV1 = NewResourceV1Client(&restBackend{client: c}, &conf)
+		s.ResourceV1 = NewResourceV1Client(typedclient.NewRESTBackend(c, ParameterCodec), &conf)
 	}
 	{
 		conf := *cfg
@@ -320,7 +307,7 @@ func NewSet(cfg *rest.Config) (*Set, error) {
 		if err != nil {
 			return nil, err
 		}
-		s.SchedulingK8sIoV1 = NewSchedulingK8sIoV1Client(&restBackend{client: c}, &conf)
+		s.SchedulingK8sIoV1 = NewSchedulingK8sIoV1Client(typedclient.NewRESTBackend(c, ParameterCodec), &conf)
 	}
 	{
 		conf := *cfg
@@ -331,3357 +318,3328 @@ func NewSet(cfg *rest.Config) (*Set, error) {
 		if err != nil {
 			return nil, err
 		}
-		s.StorageK8sIoV1 = NewStorageK8sIoV1Client(&restBackend{client: c}, &conf)
+		s.StorageK8sIoV1 = NewStorageK8sIoV1Client(typedclient.NewRESTBackend(c, ParameterCodec), &conf)
 	}
 
 	return s, nil
 }
 
-type restBackend struct {
-	client *rest.RESTClient
-}
-
-func (r *restBackend) Get(ctx context.Context, resourceName, namespace, name string, opts metav1.GetOptions, result runtime.Object) (runtime.Object, error) {
-	return result, r.client.Get().
-		Namespace(namespace).
-		Resource(resourceName).
-		Name(name).
-		VersionedParams(&opts, ParameterCodec).
-		Do(ctx).
-		Into(result)
-}
-
-func (r *restBackend) List(ctx context.Context, resourceName, namespace string, opts metav1.ListOptions, result runtime.Object) (runtime.Object, error) {
-	var timeout time.Duration
-	if opts.TimeoutSeconds > 0 {
-		timeout = time.Duration(opts.TimeoutSeconds) * time.Second
-	}
-	return result, r.client.Get().
-		Namespace(namespace).
-		Resource(resourceName).
-		VersionedParams(&opts, ParameterCodec).
-		Timeout(timeout).
-		Do(ctx).
-		Into(result)
-}
-
-func (r *restBackend) Create(ctx context.Context, resourceName string, obj runtime.Object, opts metav1.CreateOptions, result runtime.Object) (runtime.Object, error) {
-	m := obj.(metav1.Object)
-	if m == nil {
-		return nil, errors.New("obj is not implement metav1.Object")
-	}
-	meta := m.GetObjectMeta()
-	return result, r.client.Post().
-		Namespace(meta.Namespace).
-		Resource(resourceName).
-		VersionedParams(&opts, ParameterCodec).
-		Body(obj).
-		Do(ctx).
-		Into(result)
-}
-
-func (r *restBackend) Update(ctx context.Context, resourceName string, obj runtime.Object, opts metav1.UpdateOptions, result runtime.Object) (runtime.Object, error) {
-	m := obj.(metav1.Object)
-	if m == nil {
-		return nil, errors.New("obj is not implement metav1.Object")
-	}
-	meta := m.GetObjectMeta()
-	return result, r.client.Put().
-		Namespace(meta.Namespace).
-		Resource(resourceName).
-		Name(meta.Name).
-		VersionedParams(&opts, ParameterCodec).
-		Body(obj).
-		Do(ctx).
-		Into(result)
-}
-
-func (r *restBackend) UpdateStatus(ctx context.Context, resourceName string, obj runtime.Object, opts metav1.UpdateOptions, result runtime.Object) (runtime.Object, error) {
-	m := obj.(metav1.Object)
-	if m == nil {
-		return nil, errors.New("obj is not implement metav1.Object")
-	}
-	meta := m.GetObjectMeta()
-	return result, r.client.Put().
-		Namespace(meta.Namespace).
-		Resource(resourceName).
-		Name(meta.Name).
-		SubResource("status").
-		VersionedParams(&opts, ParameterCodec).
-		Body(obj).
-		Do(ctx).
-		Into(result)
-}
-
-func (r *restBackend) Delete(ctx context.Context, gvr schema.GroupVersionResource, namespace, name string, opts metav1.DeleteOptions) error {
-	return r.client.Delete().
-		Namespace(namespace).
-		Resource(gvr.Resource).
-		Name(name).
-		Body(&opts).
-		Do(ctx).
-		Error()
-}
-
-func (r *restBackend) Watch(ctx context.Context, gvr schema.GroupVersionResource, namespace string, opts metav1.ListOptions) (watch.Interface, error) {
-	var timeout time.Duration
-	if opts.TimeoutSeconds > 0 {
-		timeout = time.Duration(opts.TimeoutSeconds) * time.Second
-	}
-	opts.Watch = true
-	return r.client.Get().
-		Namespace(namespace).
-		Resource(gvr.Resource).
-		VersionedParams(&opts, ParameterCodec).
-		Timeout(timeout).
-		Watch(ctx)
-}
-
-func (r *restBackend) GetClusterScoped(ctx context.Context, resourceName, name string, opts metav1.GetOptions, result runtime.Object) (runtime.Object, error) {
-	return result, r.client.Get().
-		Resource(resourceName).
-		Name(name).
-		VersionedParams(&opts, ParameterCodec).
-		Do(ctx).
-		Into(result)
-}
-
-func (r *restBackend) ListClusterScoped(ctx context.Context, resourceName string, opts metav1.ListOptions, result runtime.Object) (runtime.Object, error) {
-	var timeout time.Duration
-	if opts.TimeoutSeconds > 0 {
-		timeout = time.Duration(opts.TimeoutSeconds) * time.Second
-	}
-	return result, r.client.Get().
-		Resource(resourceName).
-		VersionedParams(&opts, ParameterCodec).
-		Timeout(timeout).
-		Do(ctx).
-		Into(result)
-}
-
-func (r *restBackend) CreateClusterScoped(ctx context.Context, resourceName string, obj runtime.Object, opts metav1.CreateOptions, result runtime.Object) (runtime.Object, error) {
-	return result, r.client.Post().
-		Resource(resourceName).
-		VersionedParams(&opts, ParameterCodec).
-		Body(obj).
-		Do(ctx).
-		Into(result)
-}
-
-func (r *restBackend) UpdateClusterScoped(ctx context.Context, resourceName string, obj runtime.Object, opts metav1.UpdateOptions, result runtime.Object) (runtime.Object, error) {
-	m := obj.(metav1.Object)
-	if m == nil {
-		return nil, errors.New("obj is not implement metav1.Object")
-	}
-	meta := m.GetObjectMeta()
-	return result, r.client.Put().
-		Resource(resourceName).
-		Name(meta.Name).
-		VersionedParams(&opts, ParameterCodec).
-		Body(obj).
-		Do(ctx).
-		Into(result)
-}
-
-func (r *restBackend) UpdateStatusClusterScoped(ctx context.Context, resourceName string, obj runtime.Object, opts metav1.UpdateOptions, result runtime.Object) (runtime.Object, error) {
-	m := obj.(metav1.Object)
-	if m == nil {
-		return nil, errors.New("obj is not implement metav1.Object")
-	}
-	meta := m.GetObjectMeta()
-	return result, r.client.Put().
-		Resource(resourceName).
-		Name(meta.Name).
-		SubResource("status").
-		VersionedParams(&opts, ParameterCodec).
-		Body(obj).
-		Do(ctx).
-		Into(result)
-}
-
-func (r *restBackend) DeleteClusterScoped(ctx context.Context, gvr schema.GroupVersionResource, name string, opts metav1.DeleteOptions) error {
-	return r.client.Delete().
-		Resource(gvr.Resource).
-		Name(name).
-		Body(&opts).
-		Do(ctx).
-		Error()
-}
-
-func (r *restBackend) WatchClusterScoped(ctx context.Context, gvr schema.GroupVersionResource, opts metav1.ListOptions) (watch.Interface, error) {
-	var timeout time.Duration
-	if opts.TimeoutSeconds > 0 {
-		timeout = time.Duration(opts.TimeoutSeconds) * time.Second
-	}
-	opts.Watch = true
-	return r.client.Get().
-		Resource(gvr.Resource).
-		VersionedParams(&opts, ParameterCodec).
-		Timeout(timeout).
-		Watch(ctx)
-}
-
-func (r *restBackend) RESTClient() *rest.RESTClient {
-	return r.client
-}
-
 type CoreV1 struct {
 	backend Backend
 	config  *rest.Config
-}
 
-func NewCoreV1Client(b Backend, config *rest.Config) *CoreV1 {
-	return &CoreV1{backend: b, config: config}
+	bindingClient               *typedclient.ResourceClient[*corev1.Binding, *corev1.BindingList]
+	componentStatusClient       *typedclient.ClusterResourceClient[*corev1.ComponentStatus, *corev1.ComponentStatusList]
+	configMapClient             *typedclient.ResourceClient[*corev1.ConfigMap, *corev1.ConfigMapList]
+	endpointsClient             *typedclient.ResourceClient[*corev1.Endpoints, *corev1.EndpointsList]
+	eventClient                 *typedclient.ResourceClient[*corev1.Event, *corev1.EventList]
+	limitRangeClient            *typedclient.ResourceClient[*corev1.LimitRange, *corev1.LimitRangeList]
+	namespaceClient             *typedclient.ClusterResourceClient[*corev1.Namespace, *corev1.NamespaceList]
+	nodeClient                  *typedclient.ClusterResourceClient[*corev1.Node, *corev1.NodeList]
+	persistentVolumeClient      *typedclient.ClusterResourceClient[*corev1.PersistentVolume, *corev1.PersistentVolumeList]
+	persistentVolumeClaimClient *typedclient.ResourceClient[*corev1.PersistentVolumeClaim, *corev1.PersistentVolumeClaimList]
+	podClient                   *typedclient.ResourceClient[*corev1.Pod, *corev1.PodList]
+	podStatusResultClient       *typedclient.ResourceClient[*corev1.PodStatusResult, *corev1.PodStatusResultList]
+	podTemplateClient           *typedclient.ResourceClient[*corev1.PodTemplate, *corev1.PodTemplateList]
+	rangeAllocationClient       *typedclient.ResourceClient[*corev1.RangeAllocation, *corev1.RangeAllocationList]
+	replicationControllerClient *typedclient.ResourceClient[*corev1.ReplicationController, *corev1.ReplicationControllerList]
+	resourceQuotaClient         *typedclient.ResourceClient[*corev1.ResourceQuota, *corev1.ResourceQuotaList]
+	secretClient                *typedclient.ResourceClient[*corev1.Secret, *corev1.SecretList]
+	serviceClient               *typedclient.ResourceClient[*corev1.Service, *corev1.ServiceList]
+	serviceAccountClient        *typedclient.ResourceClient[*corev1.ServiceAccount, *corev1.ServiceAccountList]
 }
 
-func (c *CoreV1) GetBinding(ctx context.Context, namespace, name string, opts metav1.GetOptions) (*corev1.Binding, error) {
-	result, err := c.backend.Get(ctx, "bindings", namespace, name, opts, &corev1.Binding{})
-	if err != nil {
-		return nil, err
-	}
-	return result.(*corev1.Binding), nil
+func NewCoreV1Client(b Backend, config *rest.Config) *CoreV1 {
+	return &CoreV1{
+		backend:                     b,
+		config:                      config,
+		bindingClient:               typedclient.NewResourceClient[*corev1.Binding, *corev1.BindingList](b, schema.GroupVersionResource{Group: "", Version: "v1", Resource: "bindings"}),
+		componentStatusClient:       typedclient.NewClusterResourceClient[*corev1.ComponentStatus, *corev1.ComponentStatusList](b, schema.GroupVersionResource{Group: "", Version: "v1", Resource: "componentstatuses"}),
+		configMapClient:             typedclient.NewResourceClient[*corev1.ConfigMap, *corev1.ConfigMapList](b, schema.GroupVersionResource{Group: "", Version: "v1", Resource: "configmaps"}),
+		endpointsClient:             typedclient.NewResourceClient[*corev1.Endpoints, *corev1.EndpointsList](b, schema.GroupVersionResource{Group: "", Version: "v1", Resource: "endpoints"}),
+		eventClient:                 typedclient.NewResourceClient[*corev1.Event, *corev1.EventList](b, schema.GroupVersionResource{Group: "", Version: "v1", Resource: "events"}),
+		limitRangeClient:            typedclient.NewResourceClient[*corev1.LimitRange, *corev1.LimitRangeList](b, schema.GroupVersionResource{Group: "", Version: "v1", Resource: "limitranges"}),
+		namespaceClient:             typedclient.NewClusterResourceClient[*corev1.Namespace, *corev1.NamespaceList](b, schema.GroupVersionResource{Group: "", Version: "v1", Resource: "namespaces"}),
+		nodeClient:                  typedclient.NewClusterResourceClient[*corev1.Node, *corev1.NodeList](b, schema.GroupVersionResource{Group: "", Version: "v1", Resource: "nodes"}),
+		persistentVolumeClient:      typedclient.NewClusterResourceClient[*corev1.PersistentVolume, *corev1.PersistentVolumeList](b, schema.GroupVersionResource{Group: "", Version: "v1", Resource: "persistentvolumes"}),
+		persistentVolumeClaimClient: typedclient.NewResourceClient[*corev1.PersistentVolumeClaim, *corev1.PersistentVolumeClaimList](b, schema.GroupVersionResource{Group: "", Version: "v1", Resource: "persistentvolumeclaims"}),
+		podClient:                   typedclient.NewResourceClient[*corev1.Pod, *corev1.PodList](b, schema.GroupVersionResource{Group: "", Version: "v1", Resource: "pods"}),
+		podStatusResultClient:       typedclient.NewResourceClient[*corev1.PodStatusResult, *corev1.PodStatusResultList](b, schema.GroupVersionResource{Group: "", Version: "v1", Resource: "podstatusresults"}),
+		podTemplateClient:           typedclient.NewResourceClient[*corev1.PodTemplate, *corev1.PodTemplateList](b, schema.GroupVersionResource{Group: "", Version: "v1", Resource: "podtemplates"}),
+		rangeAllocationClient:       typedclient.NewResourceClient[*corev1.RangeAllocation, *corev1.RangeAllocationList](b, schema.GroupVersionResource{Group: "", Version: "v1", Resource: "rangeallocations"}),
+		replicationControllerClient: typedclient.NewResourceClient[*corev1.ReplicationController, *corev1.ReplicationControllerList](b, schema.GroupVersionResource{Group: "", Version: "v1", Resource: "replicationcontrollers"}),
+		resourceQuotaClient:         typedclient.NewResourceClient[*corev1.ResourceQuota, *corev1.ResourceQuotaList](b, schema.GroupVersionResource{Group: "", Version: "v1", Resource: "resourcequotas"}),
+		secretClient:                typedclient.NewResourceClient[*corev1.Secret, *corev1.SecretList](b, schema.GroupVersionResource{Group: "", Version: "v1", Resource: "secrets"}),
+		serviceClient:               typedclient.NewResourceClient[*corev1.Service, *corev1.ServiceList](b, schema.GroupVersionResource{Group: "", Version: "v1", Resource: "services"}),
+		serviceAccountClient:        typedclient.NewResourceClient[*corev1.ServiceAccount, *corev1.ServiceAccountList](b, schema.GroupVersionResource{Group: "", Version: "v1", Resource: "serviceaccounts"}),
+	}
+}
+
+// Bindings returns the client of Binding.
+func (c *CoreV1) Bindings() *typedclient.ResourceClient[*corev1.Binding, *corev1.BindingList] {
+	return c.bindingClient
+}
+
+func (c *CoreV1) GetBinding(ctx context.Context, namespace string, name string, opts metav1.GetOptions) (*corev1.Binding, error) {
+	return c.bindingClient.Get(ctx, namespace, name, opts)
 }
 
 func (c *CoreV1) CreateBinding(ctx context.Context, v *corev1.Binding, opts metav1.CreateOptions) (*corev1.Binding, error) {
-	result, err := c.backend.Create(ctx, "bindings", v, opts, &corev1.Binding{})
-	if err != nil {
-		return nil, err
-	}
-	return result.(*corev1.Binding), nil
+	return c.bindingClient.Create(ctx, v, opts)
 }
 
 func (c *CoreV1) UpdateBinding(ctx context.Context, v *corev1.Binding, opts metav1.UpdateOptions) (*corev1.Binding, error) {
-	result, err := c.backend.Update(ctx, "bindings", v, opts, &corev1.Binding{})
-	if err != nil {
-		return nil, err
-	}
-	return result.(*corev1.Binding), nil
+	return c.bindingClient.Update(ctx, v, opts)
 }
 
-func (c *CoreV1) DeleteBinding(ctx context.Context, namespace, name string, opts metav1.DeleteOptions) error {
-	return c.backend.Delete(ctx, schema.GroupVersionResource{Group: "", Version: "v1", Resource: "bindings"}, namespace, name, opts)
+func (c *CoreV1) PatchBinding(ctx context.Context, namespace string, name string, pt types.PatchType, data []byte, opts metav1.PatchOptions) (*corev1.Binding, error) {
+	return c.bindingClient.Patch(ctx, namespace, name, pt, data, opts)
+}
+
+func (c *CoreV1) DeleteBinding(ctx context.Context, namespace string, name string, opts metav1.DeleteOptions) error {
+	return c.bindingClient.Delete(ctx, namespace, name, opts)
+}
+
+func (c *CoreV1) DeleteCollectionBinding(ctx context.Context, namespace string, opts metav1.DeleteOptions, listOpts metav1.ListOptions) error {
+	return c.bindingClient.DeleteCollection(ctx, namespace, opts, listOpts)
 }
 
 func (c *CoreV1) ListBinding(ctx context.Context, namespace string, opts metav1.ListOptions) (*corev1.BindingList, error) {
-	result, err := c.backend.List(ctx, "bindings", namespace, opts, &corev1.BindingList{})
-	if err != nil {
-		return nil, err
-	}
-	return result.(*corev1.BindingList), nil
+	return c.bindingClient.List(ctx, namespace, opts)
 }
 
 func (c *CoreV1) WatchBinding(ctx context.Context, namespace string, opts metav1.ListOptions) (watch.Interface, error) {
-	return c.backend.Watch(ctx, schema.GroupVersionResource{Group: "", Version: "v1", Resource: "bindings"}, namespace, opts)
+	return c.bindingClient.Watch(ctx, namespace, opts)
+}
+
+// ComponentStatuses returns the client of ComponentStatus.
+func (c *CoreV1) ComponentStatuses() *typedclient.ClusterResourceClient[*corev1.ComponentStatus, *corev1.ComponentStatusList] {
+	return c.componentStatusClient
 }
 
 func (c *CoreV1) GetComponentStatus(ctx context.Context, name string, opts metav1.GetOptions) (*corev1.ComponentStatus, error) {
-	result, err := c.backend.GetClusterScoped(ctx, "componentstatuses", name, opts, &corev1.ComponentStatus{})
-	if err != nil {
-		return nil, err
-	}
-	return result.(*corev1.ComponentStatus), nil
+	return c.componentStatusClient.Get(ctx, name, opts)
 }
 
 func (c *CoreV1) CreateComponentStatus(ctx context.Context, v *corev1.ComponentStatus, opts metav1.CreateOptions) (*corev1.ComponentStatus, error) {
-	result, err := c.backend.CreateClusterScoped(ctx, "componentstatuses", v, opts, &corev1.ComponentStatus{})
-	if err != nil {
-		return nil, err
-	}
-	return result.(*corev1.ComponentStatus), nil
+	return c.componentStatusClient.Create(ctx, v, opts)
 }
 
 func (c *CoreV1) UpdateComponentStatus(ctx context.Context, v *corev1.ComponentStatus, opts metav1.UpdateOptions) (*corev1.ComponentStatus, error) {
-	result, err := c.backend.UpdateClusterScoped(ctx, "componentstatuses", v, opts, &corev1.ComponentStatus{})
-	if err != nil {
-		return nil, err
-	}
-	return result.(*corev1.ComponentStatus), nil
+	return c.componentStatusClient.Update(ctx, v, opts)
+}
+
+func (c *CoreV1) PatchComponentStatus(ctx context.Context, name string, pt types.PatchType, data []byte, opts metav1.PatchOptions) (*corev1.ComponentStatus, error) {
+	return c.componentStatusClient.Patch(ctx, name, pt, data, opts)
 }
 
 func (c *CoreV1) DeleteComponentStatus(ctx context.Context, name string, opts metav1.DeleteOptions) error {
-	return c.backend.DeleteClusterScoped(ctx, schema.GroupVersionResource{Group: "", Version: "v1", Resource: "componentstatuses"}, name, opts)
+	return c.componentStatusClient.Delete(ctx, name, opts)
+}
+
+func (c *CoreV1) DeleteCollectionComponentStatus(ctx context.Context, opts metav1.DeleteOptions, listOpts metav1.ListOptions) error {
+	return c.componentStatusClient.DeleteCollection(ctx, opts, listOpts)
 }
 
 func (c *CoreV1) ListComponentStatus(ctx context.Context, opts metav1.ListOptions) (*corev1.ComponentStatusList, error) {
-	result, err := c.backend.ListClusterScoped(ctx, "componentstatuses", opts, &corev1.ComponentStatusList{})
-	if err != nil {
-		return nil, err
-	}
-	return result.(*corev1.ComponentStatusList), nil
+	return c.componentStatusClient.List(ctx, opts)
 }
 
 func (c *CoreV1) WatchComponentStatus(ctx context.Context, opts metav1.ListOptions) (watch.Interface, error) {
-	return c.backend.WatchClusterScoped(ctx, schema.GroupVersionResource{Group: "", Version: "v1", Resource: "componentstatuses"}, opts)
+	return c.componentStatusClient.Watch(ctx, opts)
 }
 
-func (c *CoreV1) GetConfigMap(ctx context.Context, namespace, name string, opts metav1.GetOptions) (*corev1.ConfigMap, error) {
-	result, err := c.backend.Get(ctx, "configmaps", namespace, name, opts, &corev1.ConfigMap{})
-	if err != nil {
-		return nil, err
-	}
-	return result.(*corev1.ConfigMap), nil
+// ConfigMaps returns the client of ConfigMap.
+func (c *CoreV1) ConfigMaps() *typedclient.ResourceClient[*corev1.ConfigMap, *corev1.ConfigMapList] {
+	return c.configMapClient
+}
+
+func (c *CoreV1) GetConfigMap(ctx context.Context, namespace string, name string, opts metav1.GetOptions) (*corev1.ConfigMap, error) {
+	return c.configMapClient.Get(ctx, namespace, name, opts)
 }
 
 func (c *CoreV1) CreateConfigMap(ctx context.Context, v *corev1.ConfigMap, opts metav1.CreateOptions) (*corev1.ConfigMap, error) {
-	result, err := c.backend.Create(ctx, "configmaps", v, opts, &corev1.ConfigMap{})
-	if err != nil {
-		return nil, err
-	}
-	return result.(*corev1.ConfigMap), nil
+	return c.configMapClient.Create(ctx, v, opts)
 }
 
 func (c *CoreV1) UpdateConfigMap(ctx context.Context, v *corev1.ConfigMap, opts metav1.UpdateOptions) (*corev1.ConfigMap, error) {
-	result, err := c.backend.Update(ctx, "configmaps", v, opts, &corev1.ConfigMap{})
-	if err != nil {
-		return nil, err
-	}
-	return result.(*corev1.ConfigMap), nil
+	return c.configMapClient.Update(ctx, v, opts)
+}
+
+func (c *CoreV1) PatchConfigMap(ctx context.Context, namespace string, name string, pt types.PatchType, data []byte, opts metav1.PatchOptions) (*corev1.ConfigMap, error) {
+	return c.configMapClient.Patch(ctx, namespace, name, pt, data, opts)
+}
+
+func (c *CoreV1) DeleteConfigMap(ctx context.Context, namespace string, name string, opts metav1.DeleteOptions) error {
+	return c.configMapClient.Delete(ctx, namespace, name, opts)
 }
 
-func (c *CoreV1) DeleteConfigMap(ctx context.Context, namespace, name string, opts metav1.DeleteOptions) error {
-	return c.backend.Delete(ctx, schema.GroupVersionResource{Group: "", Version: "v1", Resource: "configmaps"}, namespace, name, opts)
+func (c *CoreV1) DeleteCollectionConfigMap(ctx context.Context, namespace string, opts metav1.DeleteOptions, listOpts metav1.ListOptions) error {
+	return c.configMapClient.DeleteCollection(ctx, namespace, opts, listOpts)
 }
 
 func (c *CoreV1) ListConfigMap(ctx context.Context, namespace string, opts metav1.ListOptions) (*corev1.ConfigMapList, error) {
-	result, err := c.backend.List(ctx, "configmaps", namespace, opts, &corev1.ConfigMapList{})
-	if err != nil {
-		return nil, err
-	}
-	return result.(*corev1.ConfigMapList), nil
+	return c.configMapClient.List(ctx, namespace, opts)
 }
 
 func (c *CoreV1) WatchConfigMap(ctx context.Context, namespace string, opts metav1.ListOptions) (watch.Interface, error) {
-	return c.backend.Watch(ctx, schema.GroupVersionResource{Group: "", Version: "v1", Resource: "configmaps"}, namespace, opts)
+	return c.configMapClient.Watch(ctx, namespace, opts)
 }
 
-func (c *CoreV1) GetEndpoints(ctx context.Context, namespace, name string, opts metav1.GetOptions) (*corev1.Endpoints, error) {
-	result, err := c.backend.Get(ctx, "endpoints", namespace, name, opts, &corev1.Endpoints{})
-	if err != nil {
-		return nil, err
-	}
-	return result.(*corev1.Endpoints), nil
+// Endpoints returns the client of Endpoints.
+func (c *CoreV1) Endpoints() *typedclient.ResourceClient[*corev1.Endpoints, *corev1.EndpointsList] {
+	return c.endpointsClient
+}
+
+func (c *CoreV1) GetEndpoints(ctx context.Context, namespace string, name string, opts metav1.GetOptions) (*corev1.Endpoints, error) {
+	return c.endpointsClient.Get(ctx, namespace, name, opts)
 }
 
 func (c *CoreV1) CreateEndpoints(ctx context.Context, v *corev1.Endpoints, opts metav1.CreateOptions) (*corev1.Endpoints, error) {
-	result, err := c.backend.Create(ctx, "endpoints", v, opts, &corev1.Endpoints{})
-	if err != nil {
-		return nil, err
-	}
-	return result.(*corev1.Endpoints), nil
+	return c.endpointsClient.Create(ctx, v, opts)
 }
 
 func (c *CoreV1) UpdateEndpoints(ctx context.Context, v *corev1.Endpoints, opts metav1.UpdateOptions) (*corev1.Endpoints, error) {
-	result, err := c.backend.Update(ctx, "endpoints", v, opts, &corev1.Endpoints{})
-	if err != nil {
-		return nil, err
-	}
-	return result.(*corev1.Endpoints), nil
+	return c.endpointsClient.Update(ctx, v, opts)
+}
+
+func (c *CoreV1) PatchEndpoints(ctx context.Context, namespace string, name string, pt types.PatchType, data []byte, opts metav1.PatchOptions) (*corev1.Endpoints, error) {
+	return c.endpointsClient.Patch(ctx, namespace, name, pt, data, opts)
 }
 
-func (c *CoreV1) DeleteEndpoints(ctx context.Context, namespace, name string, opts metav1.DeleteOptions) error {
-	return c.backend.Delete(ctx, schema.GroupVersionResource{Group: "", Version: "v1", Resource: "endpoints"}, namespace, name, opts)
+func (c *CoreV1) DeleteEndpoints(ctx context.Context, namespace string, name string, opts metav1.DeleteOptions) error {
+	return c.endpointsClient.Delete(ctx, namespace, name, opts)
+}
+
+func (c *CoreV1) DeleteCollectionEndpoints(ctx context.Context, namespace string, opts metav1.DeleteOptions, listOpts metav1.ListOptions) error {
+	return c.endpointsClient.DeleteCollection(ctx, namespace, opts, listOpts)
 }
 
 func (c *CoreV1) ListEndpoints(ctx context.Context, namespace string, opts metav1.ListOptions) (*corev1.EndpointsList, error) {
-	result, err := c.backend.List(ctx, "endpoints", namespace, opts, &corev1.EndpointsList{})
-	if err != nil {
-		return nil, err
-	}
-	return result.(*corev1.EndpointsList), nil
+	return c.endpointsClient.List(ctx, namespace, opts)
 }
 
 func (c *CoreV1) WatchEndpoints(ctx context.Context, namespace string, opts metav1.ListOptions) (watch.Interface, error) {
-	return c.backend.Watch(ctx, schema.GroupVersionResource{Group: "", Version: "v1", Resource: "endpoints"}, namespace, opts)
+	return c.endpointsClient.Watch(ctx, namespace, opts)
 }
 
-func (c *CoreV1) GetEvent(ctx context.Context, namespace, name string, opts metav1.GetOptions) (*corev1.Event, error) {
-	result, err := c.backend.Get(ctx, "events", namespace, name, opts, &corev1.Event{})
-	if err != nil {
-		return nil, err
-	}
-	return result.(*corev1.Event), nil
+// Events returns the client of Event.
+func (c *CoreV1) Events() *typedclient.ResourceClient[*corev1.Event, *corev1.EventList] {
+	return c.eventClient
+}
+
+func (c *CoreV1) GetEvent(ctx context.Context, namespace string, name string, opts metav1.GetOptions) (*corev1.Event, error) {
+	return c.eventClient.Get(ctx, namespace, name, opts)
 }
 
 func (c *CoreV1) CreateEvent(ctx context.Context, v *corev1.Event, opts metav1.CreateOptions) (*corev1.Event, error) {
-	result, err := c.backend.Create(ctx, "events", v, opts, &corev1.Event{})
-	if err != nil {
-		return nil, err
-	}
-	return result.(*corev1.Event), nil
+	return c.eventClient.Create(ctx, v, opts)
 }
 
 func (c *CoreV1) UpdateEvent(ctx context.Context, v *corev1.Event, opts metav1.UpdateOptions) (*corev1.Event, error) {
-	result, err := c.backend.Update(ctx, "events", v, opts, &corev1.Event{})
-	if err != nil {
-		return nil, err
-	}
-	return result.(*corev1.Event), nil
+	return c.eventClient.Update(ctx, v, opts)
 }
 
-func (c *CoreV1) DeleteEvent(ctx context.Context, namespace, name string, opts metav1.DeleteOptions) error {
-	return c.backend.Delete(ctx, schema.GroupVersionResource{Group: "", Version: "v1", Resource: "events"}, namespace, name, opts)
+func (c *CoreV1) PatchEvent(ctx context.Context, namespace string, name string, pt types.PatchType, data []byte, opts metav1.PatchOptions) (*corev1.Event, error) {
+	return c.eventClient.Patch(ctx, namespace, name, pt, data, opts)
+}
+
+func (c *CoreV1) DeleteEvent(ctx context.Context, namespace string, name string, opts metav1.DeleteOptions) error {
+	return c.eventClient.Delete(ctx, namespace, name, opts)
+}
+
+func (c *CoreV1) DeleteCollectionEvent(ctx context.Context, namespace string, opts metav1.DeleteOptions, listOpts metav1.ListOptions) error {
+	return c.eventClient.DeleteCollection(ctx, namespace, opts, listOpts)
 }
 
 func (c *CoreV1) ListEvent(ctx context.Context, namespace string, opts metav1.ListOptions) (*corev1.EventList, error) {
-	result, err := c.backend.List(ctx, "events", namespace, opts, &corev1.EventList{})
-	if err != nil {
-		return nil, err
-	}
-	return result.(*corev1.EventList), nil
+	return c.eventClient.List(ctx, namespace, opts)
 }
 
 func (c *CoreV1) WatchEvent(ctx context.Context, namespace string, opts metav1.ListOptions) (watch.Interface, error) {
-	return c.backend.Watch(ctx, schema.GroupVersionResource{Group: "", Version: "v1", Resource: "events"}, namespace, opts)
+	return c.eventClient.Watch(ctx, namespace, opts)
 }
 
-func (c *CoreV1) GetLimitRange(ctx context.Context, namespace, name string, opts metav1.GetOptions) (*corev1.LimitRange, error) {
-	result, err := c.backend.Get(ctx, "limitranges", namespace, name, opts, &corev1.LimitRange{})
-	if err != nil {
-		return nil, err
-	}
-	return result.(*corev1.LimitRange), nil
+// LimitRanges returns the client of LimitRange.
+func (c *CoreV1) LimitRanges() *typedclient.ResourceClient[*corev1.LimitRange, *corev1.LimitRangeList] {
+	return c.limitRangeClient
+}
+
+func (c *CoreV1) GetLimitRange(ctx context.Context, namespace string, name string, opts metav1.GetOptions) (*corev1.LimitRange, error) {
+	return c.limitRangeClient.Get(ctx, namespace, name, opts)
 }
 
 func (c *CoreV1) CreateLimitRange(ctx context.Context, v *corev1.LimitRange, opts metav1.CreateOptions) (*corev1.LimitRange, error) {
-	result, err := c.backend.Create(ctx, "limitranges", v, opts, &corev1.LimitRange{})
-	if err != nil {
-		return nil, err
-	}
-	return result.(*corev1.LimitRange), nil
+	return c.limitRangeClient.Create(ctx, v, opts)
 }
 
 func (c *CoreV1) UpdateLimitRange(ctx context.Context, v *corev1.LimitRange, opts metav1.UpdateOptions) (*corev1.LimitRange, error) {
-	result, err := c.backend.Update(ctx, "limitranges", v, opts, &corev1.LimitRange{})
-	if err != nil {
-		return nil, err
-	}
-	return result.(*corev1.LimitRange), nil
+	return c.limitRangeClient.Update(ctx, v, opts)
+}
+
+func (c *CoreV1) PatchLimitRange(ctx context.Context, namespace string, name string, pt types.PatchType, data []byte, opts metav1.PatchOptions) (*corev1.LimitRange, error) {
+	return c.limitRangeClient.Patch(ctx, namespace, name, pt, data, opts)
 }
 
-func (c *CoreV1) DeleteLimitRange(ctx context.Context, namespace, name string, opts metav1.DeleteOptions) error {
-	return c.backend.Delete(ctx, schema.GroupVersionResource{Group: "", Version: "v1", Resource: "limitranges"}, namespace, name, opts)
+func (c *CoreV1) DeleteLimitRange(ctx context.Context, namespace string, name string, opts metav1.DeleteOptions) error {
+	return c.limitRangeClient.Delete(ctx, namespace, name, opts)
+}
+
+func (c *CoreV1) DeleteCollectionLimitRange(ctx context.Context, namespace string, opts metav1.DeleteOptions, listOpts metav1.ListOptions) error {
+	return c.limitRangeClient.DeleteCollection(ctx, namespace, opts, listOpts)
 }
 
 func (c *CoreV1) ListLimitRange(ctx context.Context, namespace string, opts metav1.ListOptions) (*corev1.LimitRangeList, error) {
-	result, err := c.backend.List(ctx, "limitranges", namespace, opts, &corev1.LimitRangeList{})
-	if err != nil {
-		return nil, err
-	}
-	return result.(*corev1.LimitRangeList), nil
+	return c.limitRangeClient.List(ctx, namespace, opts)
 }
 
 func (c *CoreV1) WatchLimitRange(ctx context.Context, namespace string, opts metav1.ListOptions) (watch.Interface, error) {
-	return c.backend.Watch(ctx, schema.GroupVersionResource{Group: "", Version: "v1", Resource: "limitranges"}, namespace, opts)
+	return c.limitRangeClient.Watch(ctx, namespace, opts)
+}
+
+// Namespaces returns the client of Namespace.
+func (c *CoreV1) Namespaces() *typedclient.ClusterResourceClient[*corev1.Namespace, *corev1.NamespaceList] {
+	return c.namespaceClient
 }
 
 func (c *CoreV1) GetNamespace(ctx context.Context, name string, opts metav1.GetOptions) (*corev1.Namespace, error) {
-	result, err := c.backend.GetClusterScoped(ctx, "namespaces", name, opts, &corev1.Namespace{})
-	if err != nil {
-		return nil, err
-	}
-	return result.(*corev1.Namespace), nil
+	return c.namespaceClient.Get(ctx, name, opts)
 }
 
 func (c *CoreV1) CreateNamespace(ctx context.Context, v *corev1.Namespace, opts metav1.CreateOptions) (*corev1.Namespace, error) {
-	result, err := c.backend.CreateClusterScoped(ctx, "namespaces", v, opts, &corev1.Namespace{})
-	if err != nil {
-		return nil, err
-	}
-	return result.(*corev1.Namespace), nil
+	return c.namespaceClient.Create(ctx, v, opts)
 }
 
 func (c *CoreV1) UpdateNamespace(ctx context.Context, v *corev1.Namespace, opts metav1.UpdateOptions) (*corev1.Namespace, error) {
-	result, err := c.backend.UpdateClusterScoped(ctx, "namespaces", v, opts, &corev1.Namespace{})
-	if err != nil {
-		return nil, err
-	}
-	return result.(*corev1.Namespace), nil
+	return c.namespaceClient.Update(ctx, v, opts)
 }
 
 func (c *CoreV1) UpdateStatusNamespace(ctx context.Context, v *corev1.Namespace, opts metav1.UpdateOptions) (*corev1.Namespace, error) {
-	result, err := c.backend.UpdateStatusClusterScoped(ctx, "namespaces", v, opts, &corev1.Namespace{})
-	if err != nil {
-		return nil, err
-	}
-	return result.(*corev1.Namespace), nil
+	return c.namespaceClient.UpdateStatus(ctx, v, opts)
+}
+
+func (c *CoreV1) PatchNamespace(ctx context.Context, name string, pt types.PatchType, data []byte, opts metav1.PatchOptions) (*corev1.Namespace, error) {
+	return c.namespaceClient.Patch(ctx, name, pt, data, opts)
+}
+
+func (c *CoreV1) PatchNamespaceStatus(ctx context.Context, name string, pt types.PatchType, data []byte, opts metav1.PatchOptions) (*corev1.Namespace, error) {
+	return c.namespaceClient.Patch(ctx, name, pt, data, opts, "status")
 }
 
 func (c *CoreV1) DeleteNamespace(ctx context.Context, name string, opts metav1.DeleteOptions) error {
-	return c.backend.DeleteClusterScoped(ctx, schema.GroupVersionResource{Group: "", Version: "v1", Resource: "namespaces"}, name, opts)
+	return c.namespaceClient.Delete(ctx, name, opts)
+}
+
+func (c *CoreV1) DeleteCollectionNamespace(ctx context.Context, opts metav1.DeleteOptions, listOpts metav1.ListOptions) error {
+	return c.namespaceClient.DeleteCollection(ctx, opts, listOpts)
 }
 
 func (c *CoreV1) ListNamespace(ctx context.Context, opts metav1.ListOptions) (*corev1.NamespaceList, error) {
-	result, err := c.backend.ListClusterScoped(ctx, "namespaces", opts, &corev1.NamespaceList{})
-	if err != nil {
-		return nil, err
-	}
-	return result.(*corev1.NamespaceList), nil
+	return c.namespaceClient.List(ctx, opts)
 }
 
 func (c *CoreV1) WatchNamespace(ctx context.Context, opts metav1.ListOptions) (watch.Interface, error) {
-	return c.backend.WatchClusterScoped(ctx, schema.GroupVersionResource{Group: "", Version: "v1", Resource: "namespaces"}, opts)
+	return c.namespaceClient.Watch(ctx, opts)
+}
+
+// Nodes returns the client of Node.
+func (c *CoreV1) Nodes() *typedclient.ClusterResourceClient[*corev1.Node, *corev1.NodeList] {
+	return c.nodeClient
 }
 
 func (c *CoreV1) GetNode(ctx context.Context, name string, opts metav1.GetOptions) (*corev1.Node, error) {
-	result, err := c.backend.GetClusterScoped(ctx, "nodes", name, opts, &corev1.Node{})
-	if err != nil {
-		return nil, err
-	}
-	return result.(*corev1.Node), nil
+	return c.nodeClient.Get(ctx, name, opts)
 }
 
 func (c *CoreV1) CreateNode(ctx context.Context, v *corev1.Node, opts metav1.CreateOptions) (*corev1.Node, error) {
-	result, err := c.backend.CreateClusterScoped(ctx, "nodes", v, opts, &corev1.Node{})
-	if err != nil {
-		return nil, err
-	}
-	return result.(*corev1.Node), nil
+	return c.nodeClient.Create(ctx, v, opts)
 }
 
 func (c *CoreV1) UpdateNode(ctx context.Context, v *corev1.Node, opts metav1.UpdateOptions) (*corev1.Node, error) {
-	result, err := c.backend.UpdateClusterScoped(ctx, "nodes", v, opts, &corev1.Node{})
-	if err != nil {
-		return nil, err
-	}
-	return result.(*corev1.Node), nil
+	return c.nodeClient.Update(ctx, v, opts)
 }
 
 func (c *CoreV1) UpdateStatusNode(ctx context.Context, v *corev1.Node, opts metav1.UpdateOptions) (*corev1.Node, error) {
-	result, err := c.backend.UpdateStatusClusterScoped(ctx, "nodes", v, opts, &corev1.Node{})
-	if err != nil {
-		return nil, err
-	}
-	return result.(*corev1.Node), nil
+	return c.nodeClient.UpdateStatus(ctx, v, opts)
+}
+
+func (c *CoreV1) PatchNode(ctx context.Context, name string, pt types.PatchType, data []byte, opts metav1.PatchOptions) (*corev1.Node, error) {
+	return c.nodeClient.Patch(ctx, name, pt, data, opts)
+}
+
+func (c *CoreV1) PatchNodeStatus(ctx context.Context, name string, pt types.PatchType, data []byte, opts metav1.PatchOptions) (*corev1.Node, error) {
+	return c.nodeClient.Patch(ctx, name, pt, data, opts, "status")
 }
 
 func (c *CoreV1) DeleteNode(ctx context.Context, name string, opts metav1.DeleteOptions) error {
-	return c.backend.DeleteClusterScoped(ctx, schema.GroupVersionResource{Group: "", Version: "v1", Resource: "nodes"}, name, opts)
+	return c.nodeClient.Delete(ctx, name, opts)
+}
+
+func (c *CoreV1) DeleteCollectionNode(ctx context.Context, opts metav1.DeleteOptions, listOpts metav1.ListOptions) error {
+	return c.nodeClient.DeleteCollection(ctx, opts, listOpts)
 }
 
 func (c *CoreV1) ListNode(ctx context.Context, opts metav1.ListOptions) (*corev1.NodeList, error) {
-	result, err := c.backend.ListClusterScoped(ctx, "nodes", opts, &corev1.NodeList{})
-	if err != nil {
-		return nil, err
-	}
-	return result.(*corev1.NodeList), nil
+	return c.nodeClient.List(ctx, opts)
 }
 
 func (c *CoreV1) WatchNode(ctx context.Context, opts metav1.ListOptions) (watch.Interface, error) {
-	return c.backend.WatchClusterScoped(ctx, schema.GroupVersionResource{Group: "", Version: "v1", Resource: "nodes"}, opts)
+	return c.nodeClient.Watch(ctx, opts)
+}
+
+// PersistentVolumes returns the client of PersistentVolume.
+func (c *CoreV1) PersistentVolumes() *typedclient.ClusterResourceClient[*corev1.PersistentVolume, *corev1.PersistentVolumeList] {
+	return c.persistentVolumeClient
 }
 
 func (c *CoreV1) GetPersistentVolume(ctx context.Context, name string, opts metav1.GetOptions) (*corev1.PersistentVolume, error) {
-	result, err := c.backend.GetClusterScoped(ctx, "persistentvolumes", name, opts, &corev1.PersistentVolume{})
-	if err != nil {
-		return nil, err
-	}
-	return result.(*corev1.PersistentVolume), nil
+	return c.persistentVolumeClient.Get(ctx, name, opts)
 }
 
 func (c *CoreV1) CreatePersistentVolume(ctx context.Context, v *corev1.PersistentVolume, opts metav1.CreateOptions) (*corev1.PersistentVolume, error) {
-	result, err := c.backend.CreateClusterScoped(ctx, "persistentvolumes", v, opts, &corev1.PersistentVolume{})
-	if err != nil {
-		return nil, err
-	}
-	return result.(*corev1.PersistentVolume), nil
+	return c.persistentVolumeClient.Create(ctx, v, opts)
 }
 
 func (c *CoreV1) UpdatePersistentVolume(ctx context.Context, v *corev1.PersistentVolume, opts metav1.UpdateOptions) (*corev1.PersistentVolume, error) {
-	result, err := c.backend.UpdateClusterScoped(ctx, "persistentvolumes", v, opts, &corev1.PersistentVolume{})
-	if err != nil {
-		return nil, err
-	}
-	return result.(*corev1.PersistentVolume), nil
+	return c.persistentVolumeClient.Update(ctx, v, opts)
 }
 
 func (c *CoreV1) UpdateStatusPersistentVolume(ctx context.Context, v *corev1.PersistentVolume, opts metav1.UpdateOptions) (*corev1.PersistentVolume, error) {
-	result, err := c.backend.UpdateStatusClusterScoped(ctx, "persistentvolumes", v, opts, &corev1.PersistentVolume{})
-	if err != nil {
-		return nil, err
-	}
-	return result.(*corev1.PersistentVolume), nil
+	return c.persistentVolumeClient.UpdateStatus(ctx, v, opts)
+}
+
+func (c *CoreV1) PatchPersistentVolume(ctx context.Context, name string, pt types.PatchType, data []byte, opts metav1.PatchOptions) (*corev1.PersistentVolume, error) {
+	return c.persistentVolumeClient.Patch(ctx, name, pt, data, opts)
+}
+
+func (c *CoreV1) PatchPersistentVolumeStatus(ctx context.Context, name string, pt types.PatchType, data []byte, opts metav1.PatchOptions) (*corev1.PersistentVolume, error) {
+	return c.persistentVolumeClient.Patch(ctx, name, pt, data, opts, "status")
 }
 
 func (c *CoreV1) DeletePersistentVolume(ctx context.Context, name string, opts metav1.DeleteOptions) error {
-	return c.backend.DeleteClusterScoped(ctx, schema.GroupVersionResource{Group: "", Version: "v1", Resource: "persistentvolumes"}, name, opts)
+	return c.persistentVolumeClient.Delete(ctx, name, opts)
+}
+
+func (c *CoreV1) DeleteCollectionPersistentVolume(ctx context.Context, opts metav1.DeleteOptions, listOpts metav1.ListOptions) error {
+	return c.persistentVolumeClient.DeleteCollection(ctx, opts, listOpts)
 }
 
 func (c *CoreV1) ListPersistentVolume(ctx context.Context, opts metav1.ListOptions) (*corev1.PersistentVolumeList, error) {
-	result, err := c.backend.ListClusterScoped(ctx, "persistentvolumes", opts, &corev1.PersistentVolumeList{})
-	if err != nil {
-		return nil, err
-	}
-	return result.(*corev1.PersistentVolumeList), nil
+	return c.persistentVolumeClient.List(ctx, opts)
 }
 
 func (c *CoreV1) WatchPersistentVolume(ctx context.Context, opts metav1.ListOptions) (watch.Interface, error) {
-	return c.backend.WatchClusterScoped(ctx, schema.GroupVersionResource{Group: "", Version: "v1", Resource: "persistentvolumes"}, opts)
+	return c.persistentVolumeClient.Watch(ctx, opts)
 }
 
-func (c *CoreV1) GetPersistentVolumeClaim(ctx context.Context, namespace, name string, opts metav1.GetOptions) (*corev1.PersistentVolumeClaim, error) {
-	result, err := c.backend.Get(ctx, "persistentvolumeclaims", namespace, name, opts, &corev1.PersistentVolumeClaim{})
-	if err != nil {
-		return nil, err
-	}
-	return result.(*corev1.PersistentVolumeClaim), nil
+// PersistentVolumeClaims returns the client of PersistentVolumeClaim.
+func (c *CoreV1) PersistentVolumeClaims() *typedclient.ResourceClient[*corev1.PersistentVolumeClaim, *corev1.PersistentVolumeClaimList] {
+	return c.persistentVolumeClaimClient
+}
+
+func (c *CoreV1) GetPersistentVolumeClaim(ctx context.Context, namespace string, name string, opts metav1.GetOptions) (*corev1.PersistentVolumeClaim, error) {
+	return c.persistentVolumeClaimClient.Get(ctx, namespace, name, opts)
 }
 
 func (c *CoreV1) CreatePersistentVolumeClaim(ctx context.Context, v *corev1.PersistentVolumeClaim, opts metav1.CreateOptions) (*corev1.PersistentVolumeClaim, error) {
-	result, err := c.backend.Create(ctx, "persistentvolumeclaims", v, opts, &corev1.PersistentVolumeClaim{})
-	if err != nil {
-		return nil, err
-	}
-	return result.(*corev1.PersistentVolumeClaim), nil
+	return c.persistentVolumeClaimClient.Create(ctx, v, opts)
 }
 
 func (c *CoreV1) UpdatePersistentVolumeClaim(ctx context.Context, v *corev1.PersistentVolumeClaim, opts metav1.UpdateOptions) (*corev1.PersistentVolumeClaim, error) {
-	result, err := c.backend.Update(ctx, "persistentvolumeclaims", v, opts, &corev1.PersistentVolumeClaim{})
-	if err != nil {
-		return nil, err
-	}
-	return result.(*corev1.PersistentVolumeClaim), nil
+	return c.persistentVolumeClaimClient.Update(ctx, v, opts)
 }
 
 func (c *CoreV1) UpdateStatusPersistentVolumeClaim(ctx context.Context, v *corev1.PersistentVolumeClaim, opts metav1.UpdateOptions) (*corev1.PersistentVolumeClaim, error) {
-	result, err := c.backend.UpdateStatus(ctx, "persistentvolumeclaims", v, opts, &corev1.PersistentVolumeClaim{})
-	if err != nil {
-		return nil, err
-	}
-	return result.(*corev1.PersistentVolumeClaim), nil
+	return c.persistentVolumeClaimClient.UpdateStatus(ctx, v, opts)
+}
+
+func (c *CoreV1) PatchPersistentVolumeClaim(ctx context.Context, namespace string, name string, pt types.PatchType, data []byte, opts metav1.PatchOptions) (*corev1.PersistentVolumeClaim, error) {
+	return c.persistentVolumeClaimClient.Patch(ctx, namespace, name, pt, data, opts)
+}
+
+func (c *CoreV1) PatchPersistentVolumeClaimStatus(ctx context.Context, namespace string, name string, pt types.PatchType, data []byte, opts metav1.PatchOptions) (*corev1.PersistentVolumeClaim, error) {
+	return c.persistentVolumeClaimClient.Patch(ctx, namespace, name, pt, data, opts, "status")
 }
 
-func (c *CoreV1) DeletePersistentVolumeClaim(ctx context.Context, namespace, name string, opts metav1.DeleteOptions) error {
-	return c.backend.Delete(ctx, schema.GroupVersionResource{Group: "", Version: "v1", Resource: "persistentvolumeclaims"}, namespace, name, opts)
+func (c *CoreV1) DeletePersistentVolumeClaim(ctx context.Context, namespace string, name string, opts metav1.DeleteOptions) error {
+	return c.persistentVolumeClaimClient.Delete(ctx, namespace, name, opts)
+}
+
+func (c *CoreV1) DeleteCollectionPersistentVolumeClaim(ctx context.Context, namespace string, opts metav1.DeleteOptions, listOpts metav1.ListOptions) error {
+	return c.persistentVolumeClaimClient.DeleteCollection(ctx, namespace, opts, listOpts)
 }
 
 func (c *CoreV1) ListPersistentVolumeClaim(ctx context.Context, namespace string, opts metav1.ListOptions) (*corev1.PersistentVolumeClaimList, error) {
-	result, err := c.backend.List(ctx, "persistentvolumeclaims", namespace, opts, &corev1.PersistentVolumeClaimList{})
-	if err != nil {
-		return nil, err
-	}
-	return result.(*corev1.PersistentVolumeClaimList), nil
+	return c.persistentVolumeClaimClient.List(ctx, namespace, opts)
 }
 
 func (c *CoreV1) WatchPersistentVolumeClaim(ctx context.Context, namespace string, opts metav1.ListOptions) (watch.Interface, error) {
-	return c.backend.Watch(ctx, schema.GroupVersionResource{Group: "", Version: "v1", Resource: "persistentvolumeclaims"}, namespace, opts)
+	return c.persistentVolumeClaimClient.Watch(ctx, namespace, opts)
 }
 
-func (c *CoreV1) GetPod(ctx context.Context, namespace, name string, opts metav1.GetOptions) (*corev1.Pod, error) {
-	result, err := c.backend.Get(ctx, "pods", namespace, name, opts, &corev1.Pod{})
-	if err != nil {
-		return nil, err
-	}
-	return result.(*corev1.Pod), nil
+// Pods returns the client of Pod.
+func (c *CoreV1) Pods() *typedclient.ResourceClient[*corev1.Pod, *corev1.PodList] {
+	return c.podClient
+}
+
+func (c *CoreV1) GetPod(ctx context.Context, namespace string, name string, opts metav1.GetOptions) (*corev1.Pod, error) {
+	return c.podClient.Get(ctx, namespace, name, opts)
 }
 
 func (c *CoreV1) CreatePod(ctx context.Context, v *corev1.Pod, opts metav1.CreateOptions) (*corev1.Pod, error) {
-	result, err := c.backend.Create(ctx, "pods", v, opts, &corev1.Pod{})
-	if err != nil {
-		return nil, err
-	}
-	return result.(*corev1.Pod), nil
+	return c.podClient.Create(ctx, v, opts)
 }
 
 func (c *CoreV1) UpdatePod(ctx context.Context, v *corev1.Pod, opts metav1.UpdateOptions) (*corev1.Pod, error) {
-	result, err := c.backend.Update(ctx, "pods", v, opts, &corev1.Pod{})
-	if err != nil {
-		return nil, err
-	}
-	return result.(*corev1.Pod), nil
+	return c.podClient.Update(ctx, v, opts)
 }
 
 func (c *CoreV1) UpdateStatusPod(ctx context.Context, v *corev1.Pod, opts metav1.UpdateOptions) (*corev1.Pod, error) {
-	result, err := c.backend.UpdateStatus(ctx, "pods", v, opts, &corev1.Pod{})
-	if err != nil {
-		return nil, err
-	}
-	return result.(*corev1.Pod), nil
+	return c.podClient.UpdateStatus(ctx, v, opts)
+}
+
+func (c *CoreV1) PatchPod(ctx context.Context, namespace string, name string, pt types.PatchType, data []byte, opts metav1.PatchOptions) (*corev1.Pod, error) {
+	return c.podClient.Patch(ctx, namespace, name, pt, data, opts)
+}
+
+func (c *CoreV1) PatchPodStatus(ctx context.Context, namespace string, name string, pt types.PatchType, data []byte, opts metav1.PatchOptions) (*corev1.Pod, error) {
+	return c.podClient.Patch(ctx, namespace, name, pt, data, opts, "status")
+}
+
+func (c *CoreV1) DeletePod(ctx context.Context, namespace string, name string, opts metav1.DeleteOptions) error {
+	return c.podClient.Delete(ctx, namespace, name, opts)
 }
 
-func (c *CoreV1) DeletePod(ctx context.Context, namespace, name string, opts metav1.DeleteOptions) error {
-	return c.backend.Delete(ctx, schema.GroupVersionResource{Group: "", Version: "v1", Resource: "pods"}, namespace, name, opts)
+func (c *CoreV1) DeleteCollectionPod(ctx context.Context, namespace string, opts metav1.DeleteOptions, listOpts metav1.ListOptions) error {
+	return c.podClient.DeleteCollection(ctx, namespace, opts, listOpts)
 }
 
 func (c *CoreV1) ListPod(ctx context.Context, namespace string, opts metav1.ListOptions) (*corev1.PodList, error) {
-	result, err := c.backend.List(ctx, "pods", namespace, opts, &corev1.PodList{})
-	if err != nil {
-		return nil, err
-	}
-	return result.(*corev1.PodList), nil
+	return c.podClient.List(ctx, namespace, opts)
 }
 
 func (c *CoreV1) WatchPod(ctx context.Context, namespace string, opts metav1.ListOptions) (watch.Interface, error) {
-	return c.backend.Watch(ctx, schema.GroupVersionResource{Group: "", Version: "v1", Resource: "pods"}, namespace, opts)
+	return c.podClient.Watch(ctx, namespace, opts)
 }
 
-func (c *CoreV1) GetPodStatusResult(ctx context.Context, namespace, name string, opts metav1.GetOptions) (*corev1.PodStatusResult, error) {
-	result, err := c.backend.Get(ctx, "podstatusresults", namespace, name, opts, &corev1.PodStatusResult{})
+func (c *CoreV1) UpdatePodEphemeralContainers(ctx context.Context, namespace, name string, v *corev1.Pod, opts metav1.UpdateOptions) (*corev1.Pod, error) {
+	result, err := c.podClient.SubResource(ctx, "PUT", namespace, name, "ephemeralcontainers", v, &opts, &corev1.Pod{})
 	if err != nil {
 		return nil, err
 	}
-	return result.(*corev1.PodStatusResult), nil
+	return result.(*corev1.Pod), nil
 }
 
-func (c *CoreV1) CreatePodStatusResult(ctx context.Context, v *corev1.PodStatusResult, opts metav1.CreateOptions) (*corev1.PodStatusResult, error) {
-	result, err := c.backend.Create(ctx, "podstatusresults", v, opts, &corev1.PodStatusResult{})
+func (c *CoreV1) UpdatePodResize(ctx context.Context, namespace, name string, v *corev1.Pod, opts metav1.UpdateOptions) (*corev1.Pod, error) {
+	result, err := c.podClient.SubResource(ctx, "PUT", namespace, name, "resize", v, &opts, &corev1.Pod{})
 	if err != nil {
 		return nil, err
 	}
-	return result.(*corev1.PodStatusResult), nil
+	return result.(*corev1.Pod), nil
+}
+
+// PodStatusResults returns the client of PodStatusResult.
+func (c *CoreV1) PodStatusResults() *typedclient.ResourceClient[*corev1.PodStatusResult, *corev1.PodStatusResultList] {
+	return c.podStatusResultClient
+}
+
+func (c *CoreV1) GetPodStatusResult(ctx context.Context, namespace string, name string, opts metav1.GetOptions) (*corev1.PodStatusResult, error) {
+	return c.podStatusResultClient.Get(ctx, namespace, name, opts)
+}
+
+func (c *CoreV1) CreatePodStatusResult(ctx context.Context, v *corev1.PodStatusResult, opts metav1.CreateOptions) (*corev1.PodStatusResult, error) {
+	return c.podStatusResultClient.Create(ctx, v, opts)
 }
 
 func (c *CoreV1) UpdatePodStatusResult(ctx context.Context, v *corev1.PodStatusResult, opts metav1.UpdateOptions) (*corev1.PodStatusResult, error) {
-	result, err := c.backend.Update(ctx, "podstatusresults", v, opts, &corev1.PodStatusResult{})
-	if err != nil {
-		return nil, err
-	}
-	return result.(*corev1.PodStatusResult), nil
+	return c.podStatusResultClient.Update(ctx, v, opts)
 }
 
 func (c *CoreV1) UpdateStatusPodStatusResult(ctx context.Context, v *corev1.PodStatusResult, opts metav1.UpdateOptions) (*corev1.PodStatusResult, error) {
-	result, err := c.backend.UpdateStatus(ctx, "podstatusresults", v, opts, &corev1.PodStatusResult{})
-	if err != nil {
-		return nil, err
-	}
-	return result.(*corev1.PodStatusResult), nil
+	return c.podStatusResultClient.UpdateStatus(ctx, v, opts)
 }
 
-func (c *CoreV1) DeletePodStatusResult(ctx context.Context, namespace, name string, opts metav1.DeleteOptions) error {
-	return c.backend.Delete(ctx, schema.GroupVersionResource{Group: "", Version: "v1", Resource: "podstatusresults"}, namespace, name, opts)
+func (c *CoreV1) PatchPodStatusResult(ctx context.Context, namespace string, name string, pt types.PatchType, data []byte, opts metav1.PatchOptions) (*corev1.PodStatusResult, error) {
+	return c.podStatusResultClient.Patch(ctx, namespace, name, pt, data, opts)
 }
 
-func (c *CoreV1) ListPodStatusResult(ctx context.Context, namespace string, opts metav1.ListOptions) (*corev1.PodStatusResultList, error) {
-	result, err := c.backend.List(ctx, "podstatusresults", namespace, opts, &corev1.PodStatusResultList{})
-	if err != nil {
-		return nil, err
-	}
-	return result.(*corev1.PodStatusResultList), nil
+func (c *CoreV1) PatchPodStatusResultStatus(ctx context.Context, namespace string, name string, pt types.PatchType, data []byte, opts metav1.PatchOptions) (*corev1.PodStatusResult, error) {
+	return c.podStatusResultClient.Patch(ctx, namespace, name, pt, data, opts, "status")
 }
 
-func (c *CoreV1) WatchPodStatusResult(ctx context.Context, namespace string, opts metav1.ListOptions) (watch.Interface, error) {
-	return c.backend.Watch(ctx, schema.GroupVersionResource{Group: "", Version: "v1", Resource: "podstatusresults"}, namespace, opts)
+func (c *CoreV1) DeletePodStatusResult(ctx context.Context, namespace string, name string, opts metav1.DeleteOptions) error {
+	return c.podStatusResultClient.Delete(ctx, namespace, name, opts)
 }
 
-func (c *CoreV1) GetPodTemplate(ctx context.Context, namespace, name string, opts metav1.GetOptions) (*corev1.PodTemplate, error) {
-	result, err := c.backend.Get(ctx, "podtemplates", namespace, name, opts, &corev1.PodTemplate{})
-	if err != nil {
-		return nil, err
-	}
-	return result.(*corev1.PodTemplate), nil
+func (c *CoreV1) DeleteCollectionPodStatusResult(ctx context.Context, namespace string, opts metav1.DeleteOptions, listOpts metav1.ListOptions) error {
+	return c.podStatusResultClient.DeleteCollection(ctx, namespace, opts, listOpts)
+}
+
+func (c *CoreV1) ListPodStatusResult(ctx context.Context, namespace string, opts metav1.ListOptions) (*corev1.PodStatusResultList, error) {
+	return c.podStatusResultClient.List(ctx, namespace, opts)
+}
+
+func (c *CoreV1) WatchPodStatusResult(ctx context.Context, namespace string, opts metav1.ListOptions) (watch.Interface, error) {
+	return c.podStatusResultClient.Watch(ctx, namespace, opts)
+}
+
+// PodTemplates returns the client of PodTemplate.
+func (c *CoreV1) PodTemplates() *typedclient.ResourceClient[*corev1.PodTemplate, *corev1.PodTemplateList] {
+	return c.podTemplateClient
+}
+
+func (c *CoreV1) GetPodTemplate(ctx context.Context, namespace string, name string, opts metav1.GetOptions) (*corev1.PodTemplate, error) {
+	return c.podTemplateClient.Get(ctx, namespace, name, opts)
 }
 
 func (c *CoreV1) CreatePodTemplate(ctx context.Context, v *corev1.PodTemplate, opts metav1.CreateOptions) (*corev1.PodTemplate, error) {
-	result, err := c.backend.Create(ctx, "podtemplates", v, opts, &corev1.PodTemplate{})
-	if err != nil {
-		return nil, err
-	}
-	return result.(*corev1.PodTemplate), nil
+	return c.podTemplateClient.Create(ctx, v, opts)
 }
 
 func (c *CoreV1) UpdatePodTemplate(ctx context.Context, v *corev1.PodTemplate, opts metav1.UpdateOptions) (*corev1.PodTemplate, error) {
-	result, err := c.backend.Update(ctx, "podtemplates", v, opts, &corev1.PodTemplate{})
-	if err != nil {
-		return nil, err
-	}
-	return result.(*corev1.PodTemplate), nil
+	return c.podTemplateClient.Update(ctx, v, opts)
+}
+
+func (c *CoreV1) PatchPodTemplate(ctx context.Context, namespace string, name string, pt types.PatchType, data []byte, opts metav1.PatchOptions) (*corev1.PodTemplate, error) {
+	return c.podTemplateClient.Patch(ctx, namespace, name, pt, data, opts)
+}
+
+func (c *CoreV1) DeletePodTemplate(ctx context.Context, namespace string, name string, opts metav1.DeleteOptions) error {
+	return c.podTemplateClient.Delete(ctx, namespace, name, opts)
 }
 
-func (c *CoreV1) DeletePodTemplate(ctx context.Context, namespace, name string, opts metav1.DeleteOptions) error {
-	return c.backend.Delete(ctx, schema.GroupVersionResource{Group: "", Version: "v1", Resource: "podtemplates"}, namespace, name, opts)
+func (c *CoreV1) DeleteCollectionPodTemplate(ctx context.Context, namespace string, opts metav1.DeleteOptions, listOpts metav1.ListOptions) error {
+	return c.podTemplateClient.DeleteCollection(ctx, namespace, opts, listOpts)
 }
 
 func (c *CoreV1) ListPodTemplate(ctx context.Context, namespace string, opts metav1.ListOptions) (*corev1.PodTemplateList, error) {
-	result, err := c.backend.List(ctx, "podtemplates", namespace, opts, &corev1.PodTemplateList{})
-	if err != nil {
-		return nil, err
-	}
-	return result.(*corev1.PodTemplateList), nil
+	return c.podTemplateClient.List(ctx, namespace, opts)
 }
 
 func (c *CoreV1) WatchPodTemplate(ctx context.Context, namespace string, opts metav1.ListOptions) (watch.Interface, error) {
-	return c.backend.Watch(ctx, schema.GroupVersionResource{Group: "", Version: "v1", Resource: "podtemplates"}, namespace, opts)
+	return c.podTemplateClient.Watch(ctx, namespace, opts)
 }
 
-func (c *CoreV1) GetRangeAllocation(ctx context.Context, namespace, name string, opts metav1.GetOptions) (*corev1.RangeAllocation, error) {
-	result, err := c.backend.Get(ctx, "rangeallocations", namespace, name, opts, &corev1.RangeAllocation{})
-	if err != nil {
-		return nil, err
-	}
-	return result.(*corev1.RangeAllocation), nil
+// RangeAllocations returns the client of RangeAllocation.
+func (c *CoreV1) RangeAllocations() *typedclient.ResourceClient[*corev1.RangeAllocation, *corev1.RangeAllocationList] {
+	return c.rangeAllocationClient
+}
+
+func (c *CoreV1) GetRangeAllocation(ctx context.Context, namespace string, name string, opts metav1.GetOptions) (*corev1.RangeAllocation, error) {
+	return c.rangeAllocationClient.Get(ctx, namespace, name, opts)
 }
 
 func (c *CoreV1) CreateRangeAllocation(ctx context.Context, v *corev1.RangeAllocation, opts metav1.CreateOptions) (*corev1.RangeAllocation, error) {
-	result, err := c.backend.Create(ctx, "rangeallocations", v, opts, &corev1.RangeAllocation{})
-	if err != nil {
-		return nil, err
-	}
-	return result.(*corev1.RangeAllocation), nil
+	return c.rangeAllocationClient.Create(ctx, v, opts)
 }
 
 func (c *CoreV1) UpdateRangeAllocation(ctx context.Context, v *corev1.RangeAllocation, opts metav1.UpdateOptions) (*corev1.RangeAllocation, error) {
-	result, err := c.backend.Update(ctx, "rangeallocations", v, opts, &corev1.RangeAllocation{})
-	if err != nil {
-		return nil, err
-	}
-	return result.(*corev1.RangeAllocation), nil
+	return c.rangeAllocationClient.Update(ctx, v, opts)
+}
+
+func (c *CoreV1) PatchRangeAllocation(ctx context.Context, namespace string, name string, pt types.PatchType, data []byte, opts metav1.PatchOptions) (*corev1.RangeAllocation, error) {
+	return c.rangeAllocationClient.Patch(ctx, namespace, name, pt, data, opts)
+}
+
+func (c *CoreV1) DeleteRangeAllocation(ctx context.Context, namespace string, name string, opts metav1.DeleteOptions) error {
+	return c.rangeAllocationClient.Delete(ctx, namespace, name, opts)
 }
 
-func (c *CoreV1) DeleteRangeAllocation(ctx context.Context, namespace, name string, opts metav1.DeleteOptions) error {
-	return c.backend.Delete(ctx, schema.GroupVersionResource{Group: "", Version: "v1", Resource: "rangeallocations"}, namespace, name, opts)
+func (c *CoreV1) DeleteCollectionRangeAllocation(ctx context.Context, namespace string, opts metav1.DeleteOptions, listOpts metav1.ListOptions) error {
+	return c.rangeAllocationClient.DeleteCollection(ctx, namespace, opts, listOpts)
 }
 
 func (c *CoreV1) ListRangeAllocation(ctx context.Context, namespace string, opts metav1.ListOptions) (*corev1.RangeAllocationList, error) {
-	result, err := c.backend.List(ctx, "rangeallocations", namespace, opts, &corev1.RangeAllocationList{})
-	if err != nil {
-		return nil, err
-	}
-	return result.(*corev1.RangeAllocationList), nil
+	return c.rangeAllocationClient.List(ctx, namespace, opts)
 }
 
 func (c *CoreV1) WatchRangeAllocation(ctx context.Context, namespace string, opts metav1.ListOptions) (watch.Interface, error) {
-	return c.backend.Watch(ctx, schema.GroupVersionResource{Group: "", Version: "v1", Resource: "rangeallocations"}, namespace, opts)
+	return c.rangeAllocationClient.Watch(ctx, namespace, opts)
 }
 
-func (c *CoreV1) GetReplicationController(ctx context.Context, namespace, name string, opts metav1.GetOptions) (*corev1.ReplicationController, error) {
-	result, err := c.backend.Get(ctx, "replicationcontrollers", namespace, name, opts, &corev1.ReplicationController{})
-	if err != nil {
-		return nil, err
-	}
-	return result.(*corev1.ReplicationController), nil
+// ReplicationControllers returns the client of ReplicationController.
+func (c *CoreV1) ReplicationControllers() *typedclient.ResourceClient[*corev1.ReplicationController, *corev1.ReplicationControllerList] {
+	return c.replicationControllerClient
+}
+
+func (c *CoreV1) GetReplicationController(ctx context.Context, namespace string, name string, opts metav1.GetOptions) (*corev1.ReplicationController, error) {
+	return c.replicationControllerClient.Get(ctx, namespace, name, opts)
 }
 
 func (c *CoreV1) CreateReplicationController(ctx context.Context, v *corev1.ReplicationController, opts metav1.CreateOptions) (*corev1.ReplicationController, error) {
-	result, err := c.backend.Create(ctx, "replicationcontrollers", v, opts, &corev1.ReplicationController{})
-	if err != nil {
-		return nil, err
-	}
-	return result.(*corev1.ReplicationController), nil
+	return c.replicationControllerClient.Create(ctx, v, opts)
 }
 
 func (c *CoreV1) UpdateReplicationController(ctx context.Context, v *corev1.ReplicationController, opts metav1.UpdateOptions) (*corev1.ReplicationController, error) {
-	result, err := c.backend.Update(ctx, "replicationcontrollers", v, opts, &corev1.ReplicationController{})
-	if err != nil {
-		return nil, err
-	}
-	return result.(*corev1.ReplicationController), nil
+	return c.replicationControllerClient.Update(ctx, v, opts)
 }
 
 func (c *CoreV1) UpdateStatusReplicationController(ctx context.Context, v *corev1.ReplicationController, opts metav1.UpdateOptions) (*corev1.ReplicationController, error) {
-	result, err := c.backend.UpdateStatus(ctx, "replicationcontrollers", v, opts, &corev1.ReplicationController{})
-	if err != nil {
-		return nil, err
-	}
-	return result.(*corev1.ReplicationController), nil
+	return c.replicationControllerClient.UpdateStatus(ctx, v, opts)
+}
+
+func (c *CoreV1) PatchReplicationController(ctx context.Context, namespace string, name string, pt types.PatchType, data []byte, opts metav1.PatchOptions) (*corev1.ReplicationController, error) {
+	return c.replicationControllerClient.Patch(ctx, namespace, name, pt, data, opts)
+}
+
+func (c *CoreV1) PatchReplicationControllerStatus(ctx context.Context, namespace string, name string, pt types.PatchType, data []byte, opts metav1.PatchOptions) (*corev1.ReplicationController, error) {
+	return c.replicationControllerClient.Patch(ctx, namespace, name, pt, data, opts, "status")
 }
 
-func (c *CoreV1) DeleteReplicationController(ctx context.Context, namespace, name string, opts metav1.DeleteOptions) error {
-	return c.backend.Delete(ctx, schema.GroupVersionResource{Group: "", Version: "v1", Resource: "replicationcontrollers"}, namespace, name, opts)
+func (c *CoreV1) DeleteReplicationController(ctx context.Context, namespace string, name string, opts metav1.DeleteOptions) error {
+	return c.replicationControllerClient.Delete(ctx, namespace, name, opts)
+}
+
+func (c *CoreV1) DeleteCollectionReplicationController(ctx context.Context, namespace string, opts metav1.DeleteOptions, listOpts metav1.ListOptions) error {
+	return c.replicationControllerClient.DeleteCollection(ctx, namespace, opts, listOpts)
 }
 
 func (c *CoreV1) ListReplicationController(ctx context.Context, namespace string, opts metav1.ListOptions) (*corev1.ReplicationControllerList, error) {
-	result, err := c.backend.List(ctx, "replicationcontrollers", namespace, opts, &corev1.ReplicationControllerList{})
-	if err != nil {
-		return nil, err
-	}
-	return result.(*corev1.ReplicationControllerList), nil
+	return c.replicationControllerClient.List(ctx, namespace, opts)
 }
 
 func (c *CoreV1) WatchReplicationController(ctx context.Context, namespace string, opts metav1.ListOptions) (watch.Interface, error) {
-	return c.backend.Watch(ctx, schema.GroupVersionResource{Group: "", Version: "v1", Resource: "replicationcontrollers"}, namespace, opts)
+	return c.replicationControllerClient.Watch(ctx, namespace, opts)
 }
 
-func (c *CoreV1) GetResourceQuota(ctx context.Context, namespace, name string, opts metav1.GetOptions) (*corev1.ResourceQuota, error) {
-	result, err := c.backend.Get(ctx, "resourcequotas", namespace, name, opts, &corev1.ResourceQuota{})
+func (c *CoreV1) GetReplicationControllerScale(ctx context.Context, namespace, name string, opts metav1.GetOptions) (*autoscalingv1.Scale, error) {
+	result, err := c.replicationControllerClient.SubResource(ctx, "GET", namespace, name, "scale", nil, &opts, &autoscalingv1.Scale{})
 	if err != nil {
 		return nil, err
 	}
-	return result.(*corev1.ResourceQuota), nil
+	return result.(*autoscalingv1.Scale), nil
 }
 
-func (c *CoreV1) CreateResourceQuota(ctx context.Context, v *corev1.ResourceQuota, opts metav1.CreateOptions) (*corev1.ResourceQuota, error) {
-	result, err := c.backend.Create(ctx, "resourcequotas", v, opts, &corev1.ResourceQuota{})
+func (c *CoreV1) UpdateReplicationControllerScale(ctx context.Context, namespace, name string, v *autoscalingv1.Scale, opts metav1.UpdateOptions) (*autoscalingv1.Scale, error) {
+	result, err := c.replicationControllerClient.SubResource(ctx, "PUT", namespace, name, "scale", v, &opts, &autoscalingv1.Scale{})
 	if err != nil {
 		return nil, err
 	}
-	return result.(*corev1.ResourceQuota), nil
+	return result.(*autoscalingv1.Scale), nil
+}
+
+// ResourceQuotas returns the client of ResourceQuota.
+func (c *CoreV1) ResourceQuotas() *typedclient.ResourceClient[*corev1.ResourceQuota, *corev1.ResourceQuotaList] {
+	return c.resourceQuotaClient
+}
+
+func (c *CoreV1) GetResourceQuota(ctx context.Context, namespace string, name string, opts metav1.GetOptions) (*corev1.ResourceQuota, error) {
+	return c.resourceQuotaClient.Get(ctx, namespace, name, opts)
+}
+
+func (c *CoreV1) CreateResourceQuota(ctx context.Context, v *corev1.ResourceQuota, opts metav1.CreateOptions) (*corev1.ResourceQuota, error) {
+	return c.resourceQuotaClient.Create(ctx, v, opts)
 }
 
 func (c *CoreV1) UpdateResourceQuota(ctx context.Context, v *corev1.ResourceQuota, opts metav1.UpdateOptions) (*corev1.ResourceQuota, error) {
-	result, err := c.backend.Update(ctx, "resourcequotas", v, opts, &corev1.ResourceQuota{})
-	if err != nil {
-		return nil, err
-	}
-	return result.(*corev1.ResourceQuota), nil
+	return c.resourceQuotaClient.Update(ctx, v, opts)
 }
 
 func (c *CoreV1) UpdateStatusResourceQuota(ctx context.Context, v *corev1.ResourceQuota, opts metav1.UpdateOptions) (*corev1.ResourceQuota, error) {
-	result, err := c.backend.UpdateStatus(ctx, "resourcequotas", v, opts, &corev1.ResourceQuota{})
-	if err != nil {
-		return nil, err
-	}
-	return result.(*corev1.ResourceQuota), nil
+	return c.resourceQuotaClient.UpdateStatus(ctx, v, opts)
+}
+
+func (c *CoreV1) PatchResourceQuota(ctx context.Context, namespace string, name string, pt types.PatchType, data []byte, opts metav1.PatchOptions) (*corev1.ResourceQuota, error) {
+	return c.resourceQuotaClient.Patch(ctx, namespace, name, pt, data, opts)
+}
+
+func (c *CoreV1) PatchResourceQuotaStatus(ctx context.Context, namespace string, name string, pt types.PatchType, data []byte, opts metav1.PatchOptions) (*corev1.ResourceQuota, error) {
+	return c.resourceQuotaClient.Patch(ctx, namespace, name, pt, data, opts, "status")
 }
 
-func (c *CoreV1) DeleteResourceQuota(ctx context.Context, namespace, name string, opts metav1.DeleteOptions) error {
-	return c.backend.Delete(ctx, schema.GroupVersionResource{Group: "", Version: "v1", Resource: "resourcequotas"}, namespace, name, opts)
+func (c *CoreV1) DeleteResourceQuota(ctx context.Context, namespace string, name string, opts metav1.DeleteOptions) error {
+	return c.resourceQuotaClient.Delete(ctx, namespace, name, opts)
+}
+
+func (c *CoreV1) DeleteCollectionResourceQuota(ctx context.Context, namespace string, opts metav1.DeleteOptions, listOpts metav1.ListOptions) error {
+	return c.resourceQuotaClient.DeleteCollection(ctx, namespace, opts, listOpts)
 }
 
 func (c *CoreV1) ListResourceQuota(ctx context.Context, namespace string, opts metav1.ListOptions) (*corev1.ResourceQuotaList, error) {
-	result, err := c.backend.List(ctx, "resourcequotas", namespace, opts, &corev1.ResourceQuotaList{})
-	if err != nil {
-		return nil, err
-	}
-	return result.(*corev1.ResourceQuotaList), nil
+	return c.resourceQuotaClient.List(ctx, namespace, opts)
 }
 
 func (c *CoreV1) WatchResourceQuota(ctx context.Context, namespace string, opts metav1.ListOptions) (watch.Interface, error) {
-	return c.backend.Watch(ctx, schema.GroupVersionResource{Group: "", Version: "v1", Resource: "resourcequotas"}, namespace, opts)
+	return c.resourceQuotaClient.Watch(ctx, namespace, opts)
 }
 
-func (c *CoreV1) GetSecret(ctx context.Context, namespace, name string, opts metav1.GetOptions) (*corev1.Secret, error) {
-	result, err := c.backend.Get(ctx, "secrets", namespace, name, opts, &corev1.Secret{})
-	if err != nil {
-		return nil, err
-	}
-	return result.(*corev1.Secret), nil
+// Secrets returns the client of Secret.
+func (c *CoreV1) Secrets() *typedclient.ResourceClient[*corev1.Secret, *corev1.SecretList] {
+	return c.secretClient
+}
+
+func (c *CoreV1) GetSecret(ctx context.Context, namespace string, name string, opts metav1.GetOptions) (*corev1.Secret, error) {
+	return c.secretClient.Get(ctx, namespace, name, opts)
 }
 
 func (c *CoreV1) CreateSecret(ctx context.Context, v *corev1.Secret, opts metav1.CreateOptions) (*corev1.Secret, error) {
-	result, err := c.backend.Create(ctx, "secrets", v, opts, &corev1.Secret{})
-	if err != nil {
-		return nil, err
-	}
-	return result.(*corev1.Secret), nil
+	return c.secretClient.Create(ctx, v, opts)
 }
 
 func (c *CoreV1) UpdateSecret(ctx context.Context, v *corev1.Secret, opts metav1.UpdateOptions) (*corev1.Secret, error) {
-	result, err := c.backend.Update(ctx, "secrets", v, opts, &corev1.Secret{})
-	if err != nil {
-		return nil, err
-	}
-	return result.(*corev1.Secret), nil
+	return c.secretClient.Update(ctx, v, opts)
 }
 
-func (c *CoreV1) DeleteSecret(ctx context.Context, namespace, name string, opts metav1.DeleteOptions) error {
-	return c.backend.Delete(ctx, schema.GroupVersionResource{Group: "", Version: "v1", Resource: "secrets"}, namespace, name, opts)
+func (c *CoreV1) PatchSecret(ctx context.Context, namespace string, name string, pt types.PatchType, data []byte, opts metav1.PatchOptions) (*corev1.Secret, error) {
+	return c.secretClient.Patch(ctx, namespace, name, pt, data, opts)
+}
+
+func (c *CoreV1) DeleteSecret(ctx context.Context, namespace string, name string, opts metav1.DeleteOptions) error {
+	return c.secretClient.Delete(ctx, namespace, name, opts)
+}
+
+func (c *CoreV1) DeleteCollectionSecret(ctx context.Context, namespace string, opts metav1.DeleteOptions, listOpts metav1.ListOptions) error {
+	return c.secretClient.DeleteCollection(ctx, namespace, opts, listOpts)
 }
 
 func (c *CoreV1) ListSecret(ctx context.Context, namespace string, opts metav1.ListOptions) (*corev1.SecretList, error) {
-	result, err := c.backend.List(ctx, "secrets", namespace, opts, &corev1.SecretList{})
-	if err != nil {
-		return nil, err
-	}
-	return result.(*corev1.SecretList), nil
+	return c.secretClient.List(ctx, namespace, opts)
 }
 
 func (c *CoreV1) WatchSecret(ctx context.Context, namespace string, opts metav1.ListOptions) (watch.Interface, error) {
-	return c.backend.Watch(ctx, schema.GroupVersionResource{Group: "", Version: "v1", Resource: "secrets"}, namespace, opts)
+	return c.secretClient.Watch(ctx, namespace, opts)
 }
 
-func (c *CoreV1) GetService(ctx context.Context, namespace, name string, opts metav1.GetOptions) (*corev1.Service, error) {
-	result, err := c.backend.Get(ctx, "services", namespace, name, opts, &corev1.Service{})
-	if err != nil {
-		return nil, err
-	}
-	return result.(*corev1.Service), nil
+// Services returns the client of Service.
+func (c *CoreV1) Services() *typedclient.ResourceClient[*corev1.Service, *corev1.ServiceList] {
+	return c.serviceClient
+}
+
+func (c *CoreV1) GetService(ctx context.Context, namespace string, name string, opts metav1.GetOptions) (*corev1.Service, error) {
+	return c.serviceClient.Get(ctx, namespace, name, opts)
 }
 
 func (c *CoreV1) CreateService(ctx context.Context, v *corev1.Service, opts metav1.CreateOptions) (*corev1.Service, error) {
-	result, err := c.backend.Create(ctx, "services", v, opts, &corev1.Service{})
-	if err != nil {
-		return nil, err
-	}
-	return result.(*corev1.Service), nil
+	return c.serviceClient.Create(ctx, v, opts)
 }
 
 func (c *CoreV1) UpdateService(ctx context.Context, v *corev1.Service, opts metav1.UpdateOptions) (*corev1.Service, error) {
-	result, err := c.backend.Update(ctx, "services", v, opts, &corev1.Service{})
-	if err != nil {
-		return nil, err
-	}
-	return result.(*corev1.Service), nil
+	return c.serviceClient.Update(ctx, v, opts)
 }
 
 func (c *CoreV1) UpdateStatusService(ctx context.Context, v *corev1.Service, opts metav1.UpdateOptions) (*corev1.Service, error) {
-	result, err := c.backend.UpdateStatus(ctx, "services", v, opts, &corev1.Service{})
-	if err != nil {
-		return nil, err
-	}
-	return result.(*corev1.Service), nil
+	return c.serviceClient.UpdateStatus(ctx, v, opts)
+}
+
+func (c *CoreV1) PatchService(ctx context.Context, namespace string, name string, pt types.PatchType, data []byte, opts metav1.PatchOptions) (*corev1.Service, error) {
+	return c.serviceClient.Patch(ctx, namespace, name, pt, data, opts)
+}
+
+func (c *CoreV1) PatchServiceStatus(ctx context.Context, namespace string, name string, pt types.PatchType, data []byte, opts metav1.PatchOptions) (*corev1.Service, error) {
+	return c.serviceClient.Patch(ctx, namespace, name, pt, data, opts, "status")
 }
 
-func (c *CoreV1) DeleteService(ctx context.Context, namespace, name string, opts metav1.DeleteOptions) error {
-	return c.backend.Delete(ctx, schema.GroupVersionResource{Group: "", Version: "v1", Resource: "services"}, namespace, name, opts)
+func (c *CoreV1) DeleteService(ctx context.Context, namespace string, name string, opts metav1.DeleteOptions) error {
+	return c.serviceClient.Delete(ctx, namespace, name, opts)
+}
+
+func (c *CoreV1) DeleteCollectionService(ctx context.Context, namespace string, opts metav1.DeleteOptions, listOpts metav1.ListOptions) error {
+	return c.serviceClient.DeleteCollection(ctx, namespace, opts, listOpts)
 }
 
 func (c *CoreV1) ListService(ctx context.Context, namespace string, opts metav1.ListOptions) (*corev1.ServiceList, error) {
-	result, err := c.backend.List(ctx, "services", namespace, opts, &corev1.ServiceList{})
-	if err != nil {
-		return nil, err
-	}
-	return result.(*corev1.ServiceList), nil
+	return c.serviceClient.List(ctx, namespace, opts)
 }
 
 func (c *CoreV1) WatchService(ctx context.Context, namespace string, opts metav1.ListOptions) (watch.Interface, error) {
-	return c.backend.Watch(ctx, schema.GroupVersionResource{Group: "", Version: "v1", Resource: "services"}, namespace, opts)
+	return c.serviceClient.Watch(ctx, namespace, opts)
 }
 
-func (c *CoreV1) GetServiceAccount(ctx context.Context, namespace, name string, opts metav1.GetOptions) (*corev1.ServiceAccount, error) {
-	result, err := c.backend.Get(ctx, "serviceaccounts", namespace, name, opts, &corev1.ServiceAccount{})
-	if err != nil {
-		return nil, err
-	}
-	return result.(*corev1.ServiceAccount), nil
+// ServiceAccounts returns the client of ServiceAccount.
+func (c *CoreV1) ServiceAccounts() *typedclient.ResourceClient[*corev1.ServiceAccount, *corev1.ServiceAccountList] {
+	return c.serviceAccountClient
+}
+
+func (c *CoreV1) GetServiceAccount(ctx context.Context, namespace string, name string, opts metav1.GetOptions) (*corev1.ServiceAccount, error) {
+	return c.serviceAccountClient.Get(ctx, namespace, name, opts)
 }
 
 func (c *CoreV1) CreateServiceAccount(ctx context.Context, v *corev1.ServiceAccount, opts metav1.CreateOptions) (*corev1.ServiceAccount, error) {
-	result, err := c.backend.Create(ctx, "serviceaccounts", v, opts, &corev1.ServiceAccount{})
-	if err != nil {
-		return nil, err
-	}
-	return result.(*corev1.ServiceAccount), nil
+	return c.serviceAccountClient.Create(ctx, v, opts)
 }
 
 func (c *CoreV1) UpdateServiceAccount(ctx context.Context, v *corev1.ServiceAccount, opts metav1.UpdateOptions) (*corev1.ServiceAccount, error) {
-	result, err := c.backend.Update(ctx, "serviceaccounts", v, opts, &corev1.ServiceAccount{})
-	if err != nil {
-		return nil, err
-	}
-	return result.(*corev1.ServiceAccount), nil
+	return c.serviceAccountClient.Update(ctx, v, opts)
+}
+
+func (c *CoreV1) PatchServiceAccount(ctx context.Context, namespace string, name string, pt types.PatchType, data []byte, opts metav1.PatchOptions) (*corev1.ServiceAccount, error) {
+	return c.serviceAccountClient.Patch(ctx, namespace, name, pt, data, opts)
 }
 
-func (c *CoreV1) DeleteServiceAccount(ctx context.Context, namespace, name string, opts metav1.DeleteOptions) error {
-	return c.backend.Delete(ctx, schema.GroupVersionResource{Group: "", Version: "v1", Resource: "serviceaccounts"}, namespace, name, opts)
+func (c *CoreV1) DeleteServiceAccount(ctx context.Context, namespace string, name string, opts metav1.DeleteOptions) error {
+	return c.serviceAccountClient.Delete(ctx, namespace, name, opts)
+}
+
+func (c *CoreV1) DeleteCollectionServiceAccount(ctx context.Context, namespace string, opts metav1.DeleteOptions, listOpts metav1.ListOptions) error {
+	return c.serviceAccountClient.DeleteCollection(ctx, namespace, opts, listOpts)
 }
 
 func (c *CoreV1) ListServiceAccount(ctx context.Context, namespace string, opts metav1.ListOptions) (*corev1.ServiceAccountList, error) {
-	result, err := c.backend.List(ctx, "serviceaccounts", namespace, opts, &corev1.ServiceAccountList{})
-	if err != nil {
-		return nil, err
-	}
-	return result.(*corev1.ServiceAccountList), nil
+	return c.serviceAccountClient.List(ctx, namespace, opts)
 }
 
 func (c *CoreV1) WatchServiceAccount(ctx context.Context, namespace string, opts metav1.ListOptions) (watch.Interface, error) {
-	return c.backend.Watch(ctx, schema.GroupVersionResource{Group: "", Version: "v1", Resource: "serviceaccounts"}, namespace, opts)
+	return c.serviceAccountClient.Watch(ctx, namespace, opts)
+}
+
+func (c *CoreV1) CreateServiceAccountToken(ctx context.Context, namespace, name string, v *authenticationv1.TokenRequest, opts metav1.CreateOptions) (*authenticationv1.TokenRequest, error) {
+	result, err := c.serviceAccountClient.SubResource(ctx, "POST", namespace, name, "token", v, &opts, &authenticationv1.TokenRequest{})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*authenticationv1.TokenRequest), nil
 }
 
 type AdmissionregistrationK8sIoV1 struct {
 	backend Backend
 	config  *rest.Config
+
+	mutatingAdmissionPolicyClient          *typedclient.ClusterResourceClient[*admissionregistrationv1.MutatingAdmissionPolicy, *admissionregistrationv1.MutatingAdmissionPolicyList]
+	mutatingAdmissionPolicyBindingClient   *typedclient.ClusterResourceClient[*admissionregistrationv1.MutatingAdmissionPolicyBinding, *admissionregistrationv1.MutatingAdmissionPolicyBindingList]
+	mutatingWebhookConfigurationClient     *typedclient.ClusterResourceClient[*admissionregistrationv1.MutatingWebhookConfiguration, *admissionregistrationv1.MutatingWebhookConfigurationList]
+	validatingAdmissionPolicyClient        *typedclient.ClusterResourceClient[*admissionregistrationv1.ValidatingAdmissionPolicy, *admissionregistrationv1.ValidatingAdmissionPolicyList]
+	validatingAdmissionPolicyBindingClient *typedclient.ClusterResourceClient[*admissionregistrationv1.ValidatingAdmissionPolicyBinding, *admissionregistrationv1.ValidatingAdmissionPolicyBindingList]
+	validatingWebhookConfigurationClient   *typedclient.ClusterResourceClient[*admissionregistrationv1.ValidatingWebhookConfiguration, *admissionregistrationv1.ValidatingWebhookConfigurationList]
 }
 
 func NewAdmissionregistrationK8sIoV1Client(b Backend, config *rest.Config) *AdmissionregistrationK8sIoV1 {
-	return &AdmissionregistrationK8sIoV1{backend: b, config: config}
+	return &AdmissionregistrationK8sIoV1{
+		backend:                                b,
+		config:                                 config,
+		mutatingAdmissionPolicyClient:          typedclient.NewClusterResourceClient[*admissionregistrationv1.MutatingAdmissionPolicy, *admissionregistrationv1.MutatingAdmissionPolicyList](b, schema.GroupVersionResource{Group: "admissionregistration.k8s.io", Version: "v1", Resource: "mutatingadmissionpolicies"}),
+		mutatingAdmissionPolicyBindingClient:   typedclient.NewClusterResourceClient[*admissionregistrationv1.MutatingAdmissionPolicyBinding, *admissionregistrationv1.MutatingAdmissionPolicyBindingList](b, schema.GroupVersionResource{Group: "admissionregistration.k8s.io", Version: "v1", Resource: "mutatingadmissionpolicybindings"}),
+		mutatingWebhookConfigurationClient:     typedclient.NewClusterResourceClient[*admissionregistrationv1.MutatingWebhookConfiguration, *admissionregistrationv1.MutatingWebhookConfigurationList](b, schema.GroupVersionResource{Group: "admissionregistration.k8s.io", Version: "v1", Resource: "mutatingwebhookconfigurations"}),
+		validatingAdmissionPolicyClient:        typedclient.NewClusterResourceClient[*admissionregistrationv1.ValidatingAdmissionPolicy, *admissionregistrationv1.ValidatingAdmissionPolicyList](b, schema.GroupVersionResource{Group: "admissionregistration.k8s.io", Version: "v1", Resource: "validatingadmissionpolicies"}),
+		validatingAdmissionPolicyBindingClient: typedclient.NewClusterResourceClient[*admissionregistrationv1.ValidatingAdmissionPolicyBinding, *admissionregistrationv1.ValidatingAdmissionPolicyBindingList](b, schema.GroupVersionResource{Group: "admissionregistration.k8s.io", Version: "v1", Resource: "validatingadmissionpolicybindings"}),
+		validatingWebhookConfigurationClient:   typedclient.NewClusterResourceClient[*admissionregistrationv1.ValidatingWebhookConfiguration, *admissionregistrationv1.ValidatingWebhookConfigurationList](b, schema.GroupVersionResource{Group: "admissionregistration.k8s.io", Version: "v1", Resource: "validatingwebhookconfigurations"}),
+	}
+}
+
+// MutatingAdmissionPolicies returns the client of MutatingAdmissionPolicy.
+func (c *AdmissionregistrationK8sIoV1) MutatingAdmissionPolicies() *typedclient.ClusterResourceClient[*admissionregistrationv1.MutatingAdmissionPolicy, *admissionregistrationv1.MutatingAdmissionPolicyList] {
+	return c.mutatingAdmissionPolicyClient
 }
 
 func (c *AdmissionregistrationK8sIoV1) GetMutatingAdmissionPolicy(ctx context.Context, name string, opts metav1.GetOptions) (*admissionregistrationv1.MutatingAdmissionPolicy, error) {
-	result, err := c.backend.GetClusterScoped(ctx, "mutatingadmissionpolicies", name, opts, &admissionregistrationv1.MutatingAdmissionPolicy{})
-	if err != nil {
-		return nil, err
-	}
-	return result.(*admissionregistrationv1.MutatingAdmissionPolicy), nil
+	return c.mutatingAdmissionPolicyClient.Get(ctx, name, opts)
 }
 
 func (c *AdmissionregistrationK8sIoV1) CreateMutatingAdmissionPolicy(ctx context.Context, v *admissionregistrationv1.MutatingAdmissionPolicy, opts metav1.CreateOptions) (*admissionregistrationv1.MutatingAdmissionPolicy, error) {
-	result, err := c.backend.CreateClusterScoped(ctx, "mutatingadmissionpolicies", v, opts, &admissionregistrationv1.MutatingAdmissionPolicy{})
-	if err != nil {
-		return nil, err
-	}
-	return result.(*admissionregistrationv1.MutatingAdmissionPolicy), nil
+	return c.mutatingAdmissionPolicyClient.Create(ctx, v, opts)
 }
 
 func (c *AdmissionregistrationK8sIoV1) UpdateMutatingAdmissionPolicy(ctx context.Context, v *admissionregistrationv1.MutatingAdmissionPolicy, opts metav1.UpdateOptions) (*admissionregistrationv1.MutatingAdmissionPolicy, error) {
-	result, err := c.backend.UpdateClusterScoped(ctx, "mutatingadmissionpolicies", v, opts, &admissionregistrationv1.MutatingAdmissionPolicy{})
-	if err != nil {
-		return nil, err
-	}
-	return result.(*admissionregistrationv1.MutatingAdmissionPolicy), nil
+	return c.mutatingAdmissionPolicyClient.Update(ctx, v, opts)
+}
+
+func (c *AdmissionregistrationK8sIoV1) PatchMutatingAdmissionPolicy(ctx context.Context, name string, pt types.PatchType, data []byte, opts metav1.PatchOptions) (*admissionregistrationv1.MutatingAdmissionPolicy, error) {
+	return c.mutatingAdmissionPolicyClient.Patch(ctx, name, pt, data, opts)
 }
 
 func (c *AdmissionregistrationK8sIoV1) DeleteMutatingAdmissionPolicy(ctx context.Context, name string, opts metav1.DeleteOptions) error {
-	return c.backend.DeleteClusterScoped(ctx, schema.GroupVersionResource{Group: ".admissionregistration.k8s.io", Version: "v1", Resource: "mutatingadmissionpolicies"}, name, opts)
+	return c.mutatingAdmissionPolicyClient.Delete(ctx, name, opts)
+}
+
+func (c *AdmissionregistrationK8sIoV1) DeleteCollectionMutatingAdmissionPolicy(ctx context.Context, opts metav1.DeleteOptions, listOpts metav1.ListOptions) error {
+	return c.mutatingAdmissionPolicyClient.DeleteCollection(ctx, opts, listOpts)
 }
 
 func (c *AdmissionregistrationK8sIoV1) ListMutatingAdmissionPolicy(ctx context.Context, opts metav1.ListOptions) (*admissionregistrationv1.MutatingAdmissionPolicyList, error) {
-	result, err := c.backend.ListClusterScoped(ctx, "mutatingadmissionpolicies", opts, &admissionregistrationv1.MutatingAdmissionPolicyList{})
-	if err != nil {
-		return nil, err
-	}
-	return result.(*admissionregistrationv1.MutatingAdmissionPolicyList), nil
+	return c.mutatingAdmissionPolicyClient.List(ctx, opts)
 }
 
 func (c *AdmissionregistrationK8sIoV1) WatchMutatingAdmissionPolicy(ctx context.Context, opts metav1.ListOptions) (watch.Interface, error) {
-	return c.backend.WatchClusterScoped(ctx, schema.GroupVersionResource{Group: ".admissionregistration.k8s.io", Version: "v1", Resource: "mutatingadmissionpolicies"}, opts)
+	return c.mutatingAdmissionPolicyClient.Watch(ctx, opts)
+}
+
+// MutatingAdmissionPolicyBindings returns the client of MutatingAdmissionPolicyBinding.
+func (c *AdmissionregistrationK8sIoV1) MutatingAdmissionPolicyBindings() *typedclient.ClusterResourceClient[*admissionregistrationv1.MutatingAdmissionPolicyBinding, *admissionregistrationv1.MutatingAdmissionPolicyBindingList] {
+	return c.mutatingAdmissionPolicyBindingClient
 }
 
 func (c *AdmissionregistrationK8sIoV1) GetMutatingAdmissionPolicyBinding(ctx context.Context, name string, opts metav1.GetOptions) (*admissionregistrationv1.MutatingAdmissionPolicyBinding, error) {
-	result, err := c.backend.GetClusterScoped(ctx, "mutatingadmissionpolicybindings", name, opts, &admissionregistrationv1.MutatingAdmissionPolicyBinding{})
-	if err != nil {
-		return nil, err
-	}
-	return result.(*admissionregistrationv1.MutatingAdmissionPolicyBinding), nil
+	return c.mutatingAdmissionPolicyBindingClient.Get(ctx, name, opts)
 }
 
 func (c *AdmissionregistrationK8sIoV1) CreateMutatingAdmissionPolicyBinding(ctx context.Context, v *admissionregistrationv1.MutatingAdmissionPolicyBinding, opts metav1.CreateOptions) (*admissionregistrationv1.MutatingAdmissionPolicyBinding, error) {
-	result, err := c.backend.CreateClusterScoped(ctx, "mutatingadmissionpolicybindings", v, opts, &admissionregistrationv1.MutatingAdmissionPolicyBinding{})
-	if err != nil {
-		return nil, err
-	}
-	return result.(*admissionregistrationv1.MutatingAdmissionPolicyBinding), nil
+	return c.mutatingAdmissionPolicyBindingClient.Create(ctx, v, opts)
 }
 
 func (c *AdmissionregistrationK8sIoV1) UpdateMutatingAdmissionPolicyBinding(ctx context.Context, v *admissionregistrationv1.MutatingAdmissionPolicyBinding, opts metav1.UpdateOptions) (*admissionregistrationv1.MutatingAdmissionPolicyBinding, error) {
-	result, err := c.backend.UpdateClusterScoped(ctx, "mutatingadmissionpolicybindings", v, opts, &admissionregistrationv1.MutatingAdmissionPolicyBinding{})
-	if err != nil {
-		return nil, err
-	}
-	return result.(*admissionregistrationv1.MutatingAdmissionPolicyBinding), nil
+	return c.mutatingAdmissionPolicyBindingClient.Update(ctx, v, opts)
+}
+
+func (c *AdmissionregistrationK8sIoV1) PatchMutatingAdmissionPolicyBinding(ctx context.Context, name string, pt types.PatchType, data []byte, opts metav1.PatchOptions) (*admissionregistrationv1.MutatingAdmissionPolicyBinding, error) {
+	return c.mutatingAdmissionPolicyBindingClient.Patch(ctx, name, pt, data, opts)
 }
 
 func (c *AdmissionregistrationK8sIoV1) DeleteMutatingAdmissionPolicyBinding(ctx context.Context, name string, opts metav1.DeleteOptions) error {
-	return c.backend.DeleteClusterScoped(ctx, schema.GroupVersionResource{Group: ".admissionregistration.k8s.io", Version: "v1", Resource: "mutatingadmissionpolicybindings"}, name, opts)
+	return c.mutatingAdmissionPolicyBindingClient.Delete(ctx, name, opts)
+}
+
+func (c *AdmissionregistrationK8sIoV1) DeleteCollectionMutatingAdmissionPolicyBinding(ctx context.Context, opts metav1.DeleteOptions, listOpts metav1.ListOptions) error {
+	return c.mutatingAdmissionPolicyBindingClient.DeleteCollection(ctx, opts, listOpts)
 }
 
 func (c *AdmissionregistrationK8sIoV1) ListMutatingAdmissionPolicyBinding(ctx context.Context, opts metav1.ListOptions) (*admissionregistrationv1.MutatingAdmissionPolicyBindingList, error) {
-	result, err := c.backend.ListClusterScoped(ctx, "mutatingadmissionpolicybindings", opts, &admissionregistrationv1.MutatingAdmissionPolicyBindingList{})
-	if err != nil {
-		return nil, err
-	}
-	return result.(*admissionregistrationv1.MutatingAdmissionPolicyBindingList), nil
+	return c.mutatingAdmissionPolicyBindingClient.List(ctx, opts)
 }
 
 func (c *AdmissionregistrationK8sIoV1) WatchMutatingAdmissionPolicyBinding(ctx context.Context, opts metav1.ListOptions) (watch.Interface, error) {
-	return c.backend.WatchClusterScoped(ctx, schema.GroupVersionResource{Group: ".admissionregistration.k8s.io", Version: "v1", Resource: "mutatingadmissionpolicybindings"}, opts)
+	return c.mutatingAdmissionPolicyBindingClient.Watch(ctx, opts)
+}
+
+// MutatingWebhookConfigurations returns the client of MutatingWebhookConfiguration.
+func (c *AdmissionregistrationK8sIoV1) MutatingWebhookConfigurations() *typedclient.ClusterResourceClient[*admissionregistrationv1.MutatingWebhookConfiguration, *admissionregistrationv1.MutatingWebhookConfigurationList] {
+	return c.mutatingWebhookConfigurationClient
 }
 
 func (c *AdmissionregistrationK8sIoV1) GetMutatingWebhookConfiguration(ctx context.Context, name string, opts metav1.GetOptions) (*admissionregistrationv1.MutatingWebhookConfiguration, error) {
-	result, err := c.backend.GetClusterScoped(ctx, "mutatingwebhookconfigurations", name, opts, &admissionregistrationv1.MutatingWebhookConfiguration{})
-	if err != nil {
-		return nil, err
-	}
-	return result.(*admissionregistrationv1.MutatingWebhookConfiguration), nil
+	return c.mutatingWebhookConfigurationClient.Get(ctx, name, opts)
 }
 
 func (c *AdmissionregistrationK8sIoV1) CreateMutatingWebhookConfiguration(ctx context.Context, v *admissionregistrationv1.MutatingWebhookConfiguration, opts metav1.CreateOptions) (*admissionregistrationv1.MutatingWebhookConfiguration, error) {
-	result, err := c.backend.CreateClusterScoped(ctx, "mutatingwebhookconfigurations", v, opts, &admissionregistrationv1.MutatingWebhookConfiguration{})
-	if err != nil {
-		return nil, err
-	}
-	return result.(*admissionregistrationv1.MutatingWebhookConfiguration), nil
+	return c.mutatingWebhookConfigurationClient.Create(ctx, v, opts)
 }
 
 func (c *AdmissionregistrationK8sIoV1) UpdateMutatingWebhookConfiguration(ctx context.Context, v *admissionregistrationv1.MutatingWebhookConfiguration, opts metav1.UpdateOptions) (*admissionregistrationv1.MutatingWebhookConfiguration, error) {
-	result, err := c.backend.UpdateClusterScoped(ctx, "mutatingwebhookconfigurations", v, opts, &admissionregistrationv1.MutatingWebhookConfiguration{})
-	if err != nil {
-		return nil, err
-	}
-	return result.(*admissionregistrationv1.MutatingWebhookConfiguration), nil
+	return c.mutatingWebhookConfigurationClient.Update(ctx, v, opts)
+}
+
+func (c *AdmissionregistrationK8sIoV1) PatchMutatingWebhookConfiguration(ctx context.Context, name string, pt types.PatchType, data []byte, opts metav1.PatchOptions) (*admissionregistrationv1.MutatingWebhookConfiguration, error) {
+	return c.mutatingWebhookConfigurationClient.Patch(ctx, name, pt, data, opts)
 }
 
 func (c *AdmissionregistrationK8sIoV1) DeleteMutatingWebhookConfiguration(ctx context.Context, name string, opts metav1.DeleteOptions) error {
-	return c.backend.DeleteClusterScoped(ctx, schema.GroupVersionResource{Group: ".admissionregistration.k8s.io", Version: "v1", Resource: "mutatingwebhookconfigurations"}, name, opts)
+	return c.mutatingWebhookConfigurationClient.Delete(ctx, name, opts)
+}
+
+func (c *AdmissionregistrationK8sIoV1) DeleteCollectionMutatingWebhookConfiguration(ctx context.Context, opts metav1.DeleteOptions, listOpts metav1.ListOptions) error {
+	return c.mutatingWebhookConfigurationClient.DeleteCollection(ctx, opts, listOpts)
 }
 
 func (c *AdmissionregistrationK8sIoV1) ListMutatingWebhookConfiguration(ctx context.Context, opts metav1.ListOptions) (*admissionregistrationv1.MutatingWebhookConfigurationList, error) {
-	result, err := c.backend.ListClusterScoped(ctx, "mutatingwebhookconfigurations", opts, &admissionregistrationv1.MutatingWebhookConfigurationList{})
-	if err != nil {
-		return nil, err
-	}
-	return result.(*admissionregistrationv1.MutatingWebhookConfigurationList), nil
+	return c.mutatingWebhookConfigurationClient.List(ctx, opts)
 }
 
 func (c *AdmissionregistrationK8sIoV1) WatchMutatingWebhookConfiguration(ctx context.Context, opts metav1.ListOptions) (watch.Interface, error) {
-	return c.backend.WatchClusterScoped(ctx, schema.GroupVersionResource{Group: ".admissionregistration.k8s.io", Version: "v1", Resource: "mutatingwebhookconfigurations"}, opts)
+	return c.mutatingWebhookConfigurationClient.Watch(ctx, opts)
+}
+
+// ValidatingAdmissionPolicies returns the client of ValidatingAdmissionPolicy.
+func (c *AdmissionregistrationK8sIoV1) ValidatingAdmissionPolicies() *typedclient.ClusterResourceClient[*admissionregistrationv1.ValidatingAdmissionPolicy, *admissionregistrationv1.ValidatingAdmissionPolicyList] {
+	return c.validatingAdmissionPolicyClient
 }
 
 func (c *AdmissionregistrationK8sIoV1) GetValidatingAdmissionPolicy(ctx context.Context, name string, opts metav1.GetOptions) (*admissionregistrationv1.ValidatingAdmissionPolicy, error) {
-	result, err := c.backend.GetClusterScoped(ctx, "validatingadmissionpolicies", name, opts, &admissionregistrationv1.ValidatingAdmissionPolicy{})
-	if err != nil {
-		return nil, err
-	}
-	return result.(*admissionregistrationv1.ValidatingAdmissionPolicy), nil
+	return c.validatingAdmissionPolicyClient.Get(ctx, name, opts)
 }
 
 func (c *AdmissionregistrationK8sIoV1) CreateValidatingAdmissionPolicy(ctx context.Context, v *admissionregistrationv1.ValidatingAdmissionPolicy, opts metav1.CreateOptions) (*admissionregistrationv1.ValidatingAdmissionPolicy, error) {
-	result, err := c.backend.CreateClusterScoped(ctx, "validatingadmissionpolicies", v, opts, &admissionregistrationv1.ValidatingAdmissionPolicy{})
-	if err != nil {
-		return nil, err
-	}
-	return result.(*admissionregistrationv1.ValidatingAdmissionPolicy), nil
+	return c.validatingAdmissionPolicyClient.Create(ctx, v, opts)
 }
 
 func (c *AdmissionregistrationK8sIoV1) UpdateValidatingAdmissionPolicy(ctx context.Context, v *admissionregistrationv1.ValidatingAdmissionPolicy, opts metav1.UpdateOptions) (*admissionregistrationv1.ValidatingAdmissionPolicy, error) {
-	result, err := c.backend.UpdateClusterScoped(ctx, "validatingadmissionpolicies", v, opts, &admissionregistrationv1.ValidatingAdmissionPolicy{})
-	if err != nil {
-		return nil, err
-	}
-	return result.(*admissionregistrationv1.ValidatingAdmissionPolicy), nil
+	return c.validatingAdmissionPolicyClient.Update(ctx, v, opts)
 }
 
 func (c *AdmissionregistrationK8sIoV1) UpdateStatusValidatingAdmissionPolicy(ctx context.Context, v *admissionregistrationv1.ValidatingAdmissionPolicy, opts metav1.UpdateOptions) (*admissionregistrationv1.ValidatingAdmissionPolicy, error) {
-	result, err := c.backend.UpdateStatusClusterScoped(ctx, "validatingadmissionpolicies", v, opts, &admissionregistrationv1.ValidatingAdmissionPolicy{})
-	if err != nil {
-		return nil, err
-	}
-	return result.(*admissionregistrationv1.ValidatingAdmissionPolicy), nil
+	return c.validatingAdmissionPolicyClient.UpdateStatus(ctx, v, opts)
+}
+
+func (c *AdmissionregistrationK8sIoV1) PatchValidatingAdmissionPolicy(ctx context.Context, name string, pt types.PatchType, data []byte, opts metav1.PatchOptions) (*admissionregistrationv1.ValidatingAdmissionPolicy, error) {
+	return c.validatingAdmissionPolicyClient.Patch(ctx, name, pt, data, opts)
+}
+
+func (c *AdmissionregistrationK8sIoV1) PatchValidatingAdmissionPolicyStatus(ctx context.Context, name string, pt types.PatchType, data []byte, opts metav1.PatchOptions) (*admissionregistrationv1.ValidatingAdmissionPolicy, error) {
+	return c.validatingAdmissionPolicyClient.Patch(ctx, name, pt, data, opts, "status")
 }
 
 func (c *AdmissionregistrationK8sIoV1) DeleteValidatingAdmissionPolicy(ctx context.Context, name string, opts metav1.DeleteOptions) error {
-	return c.backend.DeleteClusterScoped(ctx, schema.GroupVersionResource{Group: ".admissionregistration.k8s.io", Version: "v1", Resource: "validatingadmissionpolicies"}, name, opts)
+	return c.validatingAdmissionPolicyClient.Delete(ctx, name, opts)
+}
+
+func (c *AdmissionregistrationK8sIoV1) DeleteCollectionValidatingAdmissionPolicy(ctx context.Context, opts metav1.DeleteOptions, listOpts metav1.ListOptions) error {
+	return c.validatingAdmissionPolicyClient.DeleteCollection(ctx, opts, listOpts)
 }
 
 func (c *AdmissionregistrationK8sIoV1) ListValidatingAdmissionPolicy(ctx context.Context, opts metav1.ListOptions) (*admissionregistrationv1.ValidatingAdmissionPolicyList, error) {
-	result, err := c.backend.ListClusterScoped(ctx, "validatingadmissionpolicies", opts, &admissionregistrationv1.ValidatingAdmissionPolicyList{})
-	if err != nil {
-		return nil, err
-	}
-	return result.(*admissionregistrationv1.ValidatingAdmissionPolicyList), nil
+	return c.validatingAdmissionPolicyClient.List(ctx, opts)
 }
 
 func (c *AdmissionregistrationK8sIoV1) WatchValidatingAdmissionPolicy(ctx context.Context, opts metav1.ListOptions) (watch.Interface, error) {
-	return c.backend.WatchClusterScoped(ctx, schema.GroupVersionResource{Group: ".admissionregistration.k8s.io", Version: "v1", Resource: "validatingadmissionpolicies"}, opts)
+	return c.validatingAdmissionPolicyClient.Watch(ctx, opts)
+}
+
+// ValidatingAdmissionPolicyBindings returns the client of ValidatingAdmissionPolicyBinding.
+func (c *AdmissionregistrationK8sIoV1) ValidatingAdmissionPolicyBindings() *typedclient.ClusterResourceClient[*admissionregistrationv1.ValidatingAdmissionPolicyBinding, *admissionregistrationv1.ValidatingAdmissionPolicyBindingList] {
+	return c.validatingAdmissionPolicyBindingClient
 }
 
 func (c *AdmissionregistrationK8sIoV1) GetValidatingAdmissionPolicyBinding(ctx context.Context, name string, opts metav1.GetOptions) (*admissionregistrationv1.ValidatingAdmissionPolicyBinding, error) {
-	result, err := c.backend.GetClusterScoped(ctx, "validatingadmissionpolicybindings", name, opts, &admissionregistrationv1.ValidatingAdmissionPolicyBinding{})
-	if err != nil {
-		return nil, err
-	}
-	return result.(*admissionregistrationv1.ValidatingAdmissionPolicyBinding), nil
+	return c.validatingAdmissionPolicyBindingClient.Get(ctx, name, opts)
 }
 
 func (c *AdmissionregistrationK8sIoV1) CreateValidatingAdmissionPolicyBinding(ctx context.Context, v *admissionregistrationv1.ValidatingAdmissionPolicyBinding, opts metav1.CreateOptions) (*admissionregistrationv1.ValidatingAdmissionPolicyBinding, error) {
-	result, err := c.backend.CreateClusterScoped(ctx, "validatingadmissionpolicybindings", v, opts, &admissionregistrationv1.ValidatingAdmissionPolicyBinding{})
-	if err != nil {
-		return nil, err
-	}
-	return result.(*admissionregistrationv1.ValidatingAdmissionPolicyBinding), nil
+	return c.validatingAdmissionPolicyBindingClient.Create(ctx, v, opts)
 }
 
 func (c *AdmissionregistrationK8sIoV1) UpdateValidatingAdmissionPolicyBinding(ctx context.Context, v *admissionregistrationv1.ValidatingAdmissionPolicyBinding, opts metav1.UpdateOptions) (*admissionregistrationv1.ValidatingAdmissionPolicyBinding, error) {
-	result, err := c.backend.UpdateClusterScoped(ctx, "validatingadmissionpolicybindings", v, opts, &admissionregistrationv1.ValidatingAdmissionPolicyBinding{})
-	if err != nil {
-		return nil, err
-	}
-	return result.(*admissionregistrationv1.ValidatingAdmissionPolicyBinding), nil
+	return c.validatingAdmissionPolicyBindingClient.Update(ctx, v, opts)
+}
+
+func (c *AdmissionregistrationK8sIoV1) PatchValidatingAdmissionPolicyBinding(ctx context.Context, name string, pt types.PatchType, data []byte, opts metav1.PatchOptions) (*admissionregistrationv1.ValidatingAdmissionPolicyBinding, error) {
+	return c.validatingAdmissionPolicyBindingClient.Patch(ctx, name, pt, data, opts)
 }
 
 func (c *AdmissionregistrationK8sIoV1) DeleteValidatingAdmissionPolicyBinding(ctx context.Context, name string, opts metav1.DeleteOptions) error {
-	return c.backend.DeleteClusterScoped(ctx, schema.GroupVersionResource{Group: ".admissionregistration.k8s.io", Version: "v1", Resource: "validatingadmissionpolicybindings"}, name, opts)
+	return c.validatingAdmissionPolicyBindingClient.Delete(ctx, name, opts)
+}
+
+func (c *AdmissionregistrationK8sIoV1) DeleteCollectionValidatingAdmissionPolicyBinding(ctx context.Context, opts metav1.DeleteOptions, listOpts metav1.ListOptions) error {
+	return c.validatingAdmissionPolicyBindingClient.DeleteCollection(ctx, opts, listOpts)
 }
 
 func (c *AdmissionregistrationK8sIoV1) ListValidatingAdmissionPolicyBinding(ctx context.Context, opts metav1.ListOptions) (*admissionregistrationv1.ValidatingAdmissionPolicyBindingList, error) {
-	result, err := c.backend.ListClusterScoped(ctx, "validatingadmissionpolicybindings", opts, &admissionregistrationv1.ValidatingAdmissionPolicyBindingList{})
-	if err != nil {
-		return nil, err
-	}
-	return result.(*admissionregistrationv1.ValidatingAdmissionPolicyBindingList), nil
+	return c.validatingAdmissionPolicyBindingClient.List(ctx, opts)
 }
 
 func (c *AdmissionregistrationK8sIoV1) WatchValidatingAdmissionPolicyBinding(ctx context.Context, opts metav1.ListOptions) (watch.Interface, error) {
-	return c.backend.WatchClusterScoped(ctx, schema.GroupVersionResource{Group: ".admissionregistration.k8s.io", Version: "v1", Resource: "validatingadmissionpolicybindings"}, opts)
+	return c.validatingAdmissionPolicyBindingClient.Watch(ctx, opts)
+}
+
+// ValidatingWebhookConfigurations returns the client of ValidatingWebhookConfiguration.
+func (c *AdmissionregistrationK8sIoV1) ValidatingWebhookConfigurations() *typedclient.ClusterResourceClient[*admissionregistrationv1.ValidatingWebhookConfiguration, *admissionregistrationv1.ValidatingWebhookConfigurationList] {
+	return c.validatingWebhookConfigurationClient
 }
 
 func (c *AdmissionregistrationK8sIoV1) GetValidatingWebhookConfiguration(ctx context.Context, name string, opts metav1.GetOptions) (*admissionregistrationv1.ValidatingWebhookConfiguration, error) {
-	result, err := c.backend.GetClusterScoped(ctx, "validatingwebhookconfigurations", name, opts, &admissionregistrationv1.ValidatingWebhookConfiguration{})
-	if err != nil {
-		return nil, err
-	}
-	return result.(*admissionregistrationv1.ValidatingWebhookConfiguration), nil
+	return c.validatingWebhookConfigurationClient.Get(ctx, name, opts)
 }
 
 func (c *AdmissionregistrationK8sIoV1) CreateValidatingWebhookConfiguration(ctx context.Context, v *admissionregistrationv1.ValidatingWebhookConfiguration, opts metav1.CreateOptions) (*admissionregistrationv1.ValidatingWebhookConfiguration, error) {
-	result, err := c.backend.CreateClusterScoped(ctx, "validatingwebhookconfigurations", v, opts, &admissionregistrationv1.ValidatingWebhookConfiguration{})
-	if err != nil {
-		return nil, err
-	}
-	return result.(*admissionregistrationv1.ValidatingWebhookConfiguration), nil
+	return c.validatingWebhookConfigurationClient.Create(ctx, v, opts)
 }
 
 func (c *AdmissionregistrationK8sIoV1) UpdateValidatingWebhookConfiguration(ctx context.Context, v *admissionregistrationv1.ValidatingWebhookConfiguration, opts metav1.UpdateOptions) (*admissionregistrationv1.ValidatingWebhookConfiguration, error) {
-	result, err := c.backend.UpdateClusterScoped(ctx, "validatingwebhookconfigurations", v, opts, &admissionregistrationv1.ValidatingWebhookConfiguration{})
-	if err != nil {
-		return nil, err
-	}
-	return result.(*admissionregistrationv1.ValidatingWebhookConfiguration), nil
+	return c.validatingWebhookConfigurationClient.Update(ctx, v, opts)
+}
+
+func (c *AdmissionregistrationK8sIoV1) PatchValidatingWebhookConfiguration(ctx context.Context, name string, pt types.PatchType, data []byte, opts metav1.PatchOptions) (*admissionregistrationv1.ValidatingWebhookConfiguration, error) {
+	return c.validatingWebhookConfigurationClient.Patch(ctx, name, pt, data, opts)
 }
 
 func (c *AdmissionregistrationK8sIoV1) DeleteValidatingWebhookConfiguration(ctx context.Context, name string, opts metav1.DeleteOptions) error {
-	return c.backend.DeleteClusterScoped(ctx, schema.GroupVersionResource{Group: ".admissionregistration.k8s.io", Version: "v1", Resource: "validatingwebhookconfigurations"}, name, opts)
+	return c.validatingWebhookConfigurationClient.Delete(ctx, name, opts)
+}
+
+func (c *AdmissionregistrationK8sIoV1) DeleteCollectionValidatingWebhookConfiguration(ctx context.Context, opts metav1.DeleteOptions, listOpts metav1.ListOptions) error {
+	return c.validatingWebhookConfigurationClient.DeleteCollection(ctx, opts, listOpts)
 }
 
 func (c *AdmissionregistrationK8sIoV1) ListValidatingWebhookConfiguration(ctx context.Context, opts metav1.ListOptions) (*admissionregistrationv1.ValidatingWebhookConfigurationList, error) {
-	result, err := c.backend.ListClusterScoped(ctx, "validatingwebhookconfigurations", opts, &admissionregistrationv1.ValidatingWebhookConfigurationList{})
-	if err != nil {
-		return nil, err
-	}
-	return result.(*admissionregistrationv1.ValidatingWebhookConfigurationList), nil
+	return c.validatingWebhookConfigurationClient.List(ctx, opts)
 }
 
 func (c *AdmissionregistrationK8sIoV1) WatchValidatingWebhookConfiguration(ctx context.Context, opts metav1.ListOptions) (watch.Interface, error) {
-	return c.backend.WatchClusterScoped(ctx, schema.GroupVersionResource{Group: ".admissionregistration.k8s.io", Version: "v1", Resource: "validatingwebhookconfigurations"}, opts)
+	return c.validatingWebhookConfigurationClient.Watch(ctx, opts)
 }
 
 type AppsV1 struct {
 	backend Backend
 	config  *rest.Config
+
+	controllerRevisionClient *typedclient.ResourceClient[*appsv1.ControllerRevision, *appsv1.ControllerRevisionList]
+	daemonSetClient          *typedclient.ResourceClient[*appsv1.DaemonSet, *appsv1.DaemonSetList]
+	deploymentClient         *typedclient.ResourceClient[*appsv1.Deployment, *appsv1.DeploymentList]
+	replicaSetClient         *typedclient.ResourceClient[*appsv1.ReplicaSet, *appsv1.ReplicaSetList]
+	statefulSetClient        *typedclient.ResourceClient[*appsv1.StatefulSet, *appsv1.StatefulSetList]
 }
 
 func NewAppsV1Client(b Backend, config *rest.Config) *AppsV1 {
-	return &AppsV1{backend: b, config: config}
+	return &AppsV1{
+		backend:                  b,
+		config:                   config,
+		controllerRevisionClient: typedclient.NewResourceClient[*appsv1.ControllerRevision, *appsv1.ControllerRevisionList](b, schema.GroupVersionResource{Group: "apps", Version: "v1", Resource: "controllerrevisions"}),
+		daemonSetClient:          typedclient.NewResourceClient[*appsv1.DaemonSet, *appsv1.DaemonSetList](b, schema.GroupVersionResource{Group: "apps", Version: "v1", Resource: "daemonsets"}),
+		deploymentClient:         typedclient.NewResourceClient[*appsv1.Deployment, *appsv1.DeploymentList](b, schema.GroupVersionResource{Group: "apps", Version: "v1", Resource: "deployments"}),
+		replicaSetClient:         typedclient.NewResourceClient[*appsv1.ReplicaSet, *appsv1.ReplicaSetList](b, schema.GroupVersionResource{Group: "apps", Version: "v1", Resource: "replicasets"}),
+		statefulSetClient:        typedclient.NewResourceClient[*appsv1.StatefulSet, *appsv1.StatefulSetList](b, schema.GroupVersionResource{Group: "apps", Version: "v1", Resource: "statefulsets"}),
+	}
 }
 
-func (c *AppsV1) GetControllerRevision(ctx context.Context, namespace, name string, opts metav1.GetOptions) (*appsv1.ControllerRevision, error) {
-	result, err := c.backend.Get(ctx, "controllerrevisions", namespace, name, opts, &appsv1.ControllerRevision{})
-	if err != nil {
-		return nil, err
-	}
-	return result.(*appsv1.ControllerRevision), nil
+// ControllerRevisions returns the client of ControllerRevision.
+func (c *AppsV1) ControllerRevisions() *typedclient.ResourceClient[*appsv1.ControllerRevision, *appsv1.ControllerRevisionList] {
+	return c.controllerRevisionClient
+}
+
+func (c *AppsV1) GetControllerRevision(ctx context.Context, namespace string, name string, opts metav1.GetOptions) (*appsv1.ControllerRevision, error) {
+	return c.controllerRevisionClient.Get(ctx, namespace, name, opts)
 }
 
 func (c *AppsV1) CreateControllerRevision(ctx context.Context, v *appsv1.ControllerRevision, opts metav1.CreateOptions) (*appsv1.ControllerRevision, error) {
-	result, err := c.backend.Create(ctx, "controllerrevisions", v, opts, &appsv1.ControllerRevision{})
-	if err != nil {
-		return nil, err
-	}
-	return result.(*appsv1.ControllerRevision), nil
+	return c.controllerRevisionClient.Create(ctx, v, opts)
 }
 
 func (c *AppsV1) UpdateControllerRevision(ctx context.Context, v *appsv1.ControllerRevision, opts metav1.UpdateOptions) (*appsv1.ControllerRevision, error) {
-	result, err := c.backend.Update(ctx, "controllerrevisions", v, opts, &appsv1.ControllerRevision{})
-	if err != nil {
-		return nil, err
-	}
-	return result.(*appsv1.ControllerRevision), nil
+	return c.controllerRevisionClient.Update(ctx, v, opts)
+}
+
+func (c *AppsV1) PatchControllerRevision(ctx context.Context, namespace string, name string, pt types.PatchType, data []byte, opts metav1.PatchOptions) (*appsv1.ControllerRevision, error) {
+	return c.controllerRevisionClient.Patch(ctx, namespace, name, pt, data, opts)
+}
+
+func (c *AppsV1) DeleteControllerRevision(ctx context.Context, namespace string, name string, opts metav1.DeleteOptions) error {
+	return c.controllerRevisionClient.Delete(ctx, namespace, name, opts)
 }
 
-func (c *AppsV1) DeleteControllerRevision(ctx context.Context, namespace, name string, opts metav1.DeleteOptions) error {
-	return c.backend.Delete(ctx, schema.GroupVersionResource{Group: ".apps", Version: "v1", Resource: "controllerrevisions"}, namespace, name, opts)
+func (c *AppsV1) DeleteCollectionControllerRevision(ctx context.Context, namespace string, opts metav1.DeleteOptions, listOpts metav1.ListOptions) error {
+	return c.controllerRevisionClient.DeleteCollection(ctx, namespace, opts, listOpts)
 }
 
 func (c *AppsV1) ListControllerRevision(ctx context.Context, namespace string, opts metav1.ListOptions) (*appsv1.ControllerRevisionList, error) {
-	result, err := c.backend.List(ctx, "controllerrevisions", namespace, opts, &appsv1.ControllerRevisionList{})
-	if err != nil {
-		return nil, err
-	}
-	return result.(*appsv1.ControllerRevisionList), nil
+	return c.controllerRevisionClient.List(ctx, namespace, opts)
 }
 
 func (c *AppsV1) WatchControllerRevision(ctx context.Context, namespace string, opts metav1.ListOptions) (watch.Interface, error) {
-	return c.backend.Watch(ctx, schema.GroupVersionResource{Group: ".apps", Version: "v1", Resource: "controllerrevisions"}, namespace, opts)
+	return c.controllerRevisionClient.Watch(ctx, namespace, opts)
 }
 
-func (c *AppsV1) GetDaemonSet(ctx context.Context, namespace, name string, opts metav1.GetOptions) (*appsv1.DaemonSet, error) {
-	result, err := c.backend.Get(ctx, "daemonsets", namespace, name, opts, &appsv1.DaemonSet{})
-	if err != nil {
-		return nil, err
-	}
-	return result.(*appsv1.DaemonSet), nil
+// DaemonSets returns the client of DaemonSet.
+func (c *AppsV1) DaemonSets() *typedclient.ResourceClient[*appsv1.DaemonSet, *appsv1.DaemonSetList] {
+	return c.daemonSetClient
+}
+
+func (c *AppsV1) GetDaemonSet(ctx context.Context, namespace string, name string, opts metav1.GetOptions) (*appsv1.DaemonSet, error) {
+	return c.daemonSetClient.Get(ctx, namespace, name, opts)
 }
 
 func (c *AppsV1) CreateDaemonSet(ctx context.Context, v *appsv1.DaemonSet, opts metav1.CreateOptions) (*appsv1.DaemonSet, error) {
-	result, err := c.backend.Create(ctx, "daemonsets", v, opts, &appsv1.DaemonSet{})
-	if err != nil {
-		return nil, err
-	}
-	return result.(*appsv1.DaemonSet), nil
+	return c.daemonSetClient.Create(ctx, v, opts)
 }
 
 func (c *AppsV1) UpdateDaemonSet(ctx context.Context, v *appsv1.DaemonSet, opts metav1.UpdateOptions) (*appsv1.DaemonSet, error) {
-	result, err := c.backend.Update(ctx, "daemonsets", v, opts, &appsv1.DaemonSet{})
-	if err != nil {
-		return nil, err
-	}
-	return result.(*appsv1.DaemonSet), nil
+	return c.daemonSetClient.Update(ctx, v, opts)
 }
 
 func (c *AppsV1) UpdateStatusDaemonSet(ctx context.Context, v *appsv1.DaemonSet, opts metav1.UpdateOptions) (*appsv1.DaemonSet, error) {
-	result, err := c.backend.UpdateStatus(ctx, "daemonsets", v, opts, &appsv1.DaemonSet{})
-	if err != nil {
-		return nil, err
-	}
-	return result.(*appsv1.DaemonSet), nil
+	return c.daemonSetClient.UpdateStatus(ctx, v, opts)
+}
+
+func (c *AppsV1) PatchDaemonSet(ctx context.Context, namespace string, name string, pt types.PatchType, data []byte, opts metav1.PatchOptions) (*appsv1.DaemonSet, error) {
+	return c.daemonSetClient.Patch(ctx, namespace, name, pt, data, opts)
 }
 
-func (c *AppsV1) DeleteDaemonSet(ctx context.Context, namespace, name string, opts metav1.DeleteOptions) error {
-	return c.backend.Delete(ctx, schema.GroupVersionResource{Group: ".apps", Version: "v1", Resource: "daemonsets"}, namespace, name, opts)
+func (c *AppsV1) PatchDaemonSetStatus(ctx context.Context, namespace string, name string, pt types.PatchType, data []byte, opts metav1.PatchOptions) (*appsv1.DaemonSet, error) {
+	return c.daemonSetClient.Patch(ctx, namespace, name, pt, data, opts, "status")
+}
+
+func (c *AppsV1) DeleteDaemonSet(ctx context.Context, namespace string, name string, opts metav1.DeleteOptions) error {
+	return c.daemonSetClient.Delete(ctx, namespace, name, opts)
+}
+
+func (c *AppsV1) DeleteCollectionDaemonSet(ctx context.Context, namespace string, opts metav1.DeleteOptions, listOpts metav1.ListOptions) error {
+	return c.daemonSetClient.DeleteCollection(ctx, namespace, opts, listOpts)
 }
 
 func (c *AppsV1) ListDaemonSet(ctx context.Context, namespace string, opts metav1.ListOptions) (*appsv1.DaemonSetList, error) {
-	result, err := c.backend.List(ctx, "daemonsets", namespace, opts, &appsv1.DaemonSetList{})
-	if err != nil {
-		return nil, err
-	}
-	return result.(*appsv1.DaemonSetList), nil
+	return c.daemonSetClient.List(ctx, namespace, opts)
 }
 
 func (c *AppsV1) WatchDaemonSet(ctx context.Context, namespace string, opts metav1.ListOptions) (watch.Interface, error) {
-	return c.backend.Watch(ctx, schema.GroupVersionResource{Group: ".apps", Version: "v1", Resource: "daemonsets"}, namespace, opts)
+	return c.daemonSetClient.Watch(ctx, namespace, opts)
 }
 
-func (c *AppsV1) GetDeployment(ctx context.Context, namespace, name string, opts metav1.GetOptions) (*appsv1.Deployment, error) {
-	result, err := c.backend.Get(ctx, "deployments", namespace, name, opts, &appsv1.Deployment{})
-	if err != nil {
-		return nil, err
-	}
-	return result.(*appsv1.Deployment), nil
+// Deployments returns the client of Deployment.
+func (c *AppsV1) Deployments() *typedclient.ResourceClient[*appsv1.Deployment, *appsv1.DeploymentList] {
+	return c.deploymentClient
+}
+
+func (c *AppsV1) GetDeployment(ctx context.Context, namespace string, name string, opts metav1.GetOptions) (*appsv1.Deployment, error) {
+	return c.deploymentClient.Get(ctx, namespace, name, opts)
 }
 
 func (c *AppsV1) CreateDeployment(ctx context.Context, v *appsv1.Deployment, opts metav1.CreateOptions) (*appsv1.Deployment, error) {
-	result, err := c.backend.Create(ctx, "deployments", v, opts, &appsv1.Deployment{})
-	if err != nil {
-		return nil, err
-	}
-	return result.(*appsv1.Deployment), nil
+	return c.deploymentClient.Create(ctx, v, opts)
 }
 
 func (c *AppsV1) UpdateDeployment(ctx context.Context, v *appsv1.Deployment, opts metav1.UpdateOptions) (*appsv1.Deployment, error) {
-	result, err := c.backend.Update(ctx, "deployments", v, opts, &appsv1.Deployment{})
-	if err != nil {
-		return nil, err
-	}
-	return result.(*appsv1.Deployment), nil
+	return c.deploymentClient.Update(ctx, v, opts)
 }
 
 func (c *AppsV1) UpdateStatusDeployment(ctx context.Context, v *appsv1.Deployment, opts metav1.UpdateOptions) (*appsv1.Deployment, error) {
-	result, err := c.backend.UpdateStatus(ctx, "deployments", v, opts, &appsv1.Deployment{})
-	if err != nil {
-		return nil, err
-	}
-	return result.(*appsv1.Deployment), nil
+	return c.deploymentClient.UpdateStatus(ctx, v, opts)
+}
+
+func (c *AppsV1) PatchDeployment(ctx context.Context, namespace string, name string, pt types.PatchType, data []byte, opts metav1.PatchOptions) (*appsv1.Deployment, error) {
+	return c.deploymentClient.Patch(ctx, namespace, name, pt, data, opts)
+}
+
+func (c *AppsV1) PatchDeploymentStatus(ctx context.Context, namespace string, name string, pt types.PatchType, data []byte, opts metav1.PatchOptions) (*appsv1.Deployment, error) {
+	return c.deploymentClient.Patch(ctx, namespace, name, pt, data, opts, "status")
 }
 
-func (c *AppsV1) DeleteDeployment(ctx context.Context, namespace, name string, opts metav1.DeleteOptions) error {
-	return c.backend.Delete(ctx, schema.GroupVersionResource{Group: ".apps", Version: "v1", Resource: "deployments"}, namespace, name, opts)
+func (c *AppsV1) DeleteDeployment(ctx context.Context, namespace string, name string, opts metav1.DeleteOptions) error {
+	return c.deploymentClient.Delete(ctx, namespace, name, opts)
+}
+
+func (c *AppsV1) DeleteCollectionDeployment(ctx context.Context, namespace string, opts metav1.DeleteOptions, listOpts metav1.ListOptions) error {
+	return c.deploymentClient.DeleteCollection(ctx, namespace, opts, listOpts)
 }
 
 func (c *AppsV1) ListDeployment(ctx context.Context, namespace string, opts metav1.ListOptions) (*appsv1.DeploymentList, error) {
-	result, err := c.backend.List(ctx, "deployments", namespace, opts, &appsv1.DeploymentList{})
-	if err != nil {
-		return nil, err
-	}
-	return result.(*appsv1.DeploymentList), nil
+	return c.deploymentClient.List(ctx, namespace, opts)
 }
 
 func (c *AppsV1) WatchDeployment(ctx context.Context, namespace string, opts metav1.ListOptions) (watch.Interface, error) {
-	return c.backend.Watch(ctx, schema.GroupVersionResource{Group: ".apps", Version: "v1", Resource: "deployments"}, namespace, opts)
+	return c.deploymentClient.Watch(ctx, namespace, opts)
 }
 
-func (c *AppsV1) GetReplicaSet(ctx context.Context, namespace, name string, opts metav1.GetOptions) (*appsv1.ReplicaSet, error) {
-	result, err := c.backend.Get(ctx, "replicasets", namespace, name, opts, &appsv1.ReplicaSet{})
+func (c *AppsV1) GetDeploymentScale(ctx context.Context, namespace, name string, opts metav1.GetOptions) (*autoscalingv1.Scale, error) {
+	result, err := c.deploymentClient.SubResource(ctx, "GET", namespace, name, "scale", nil, &opts, &autoscalingv1.Scale{})
 	if err != nil {
 		return nil, err
 	}
-	return result.(*appsv1.ReplicaSet), nil
+	return result.(*autoscalingv1.Scale), nil
 }
 
-func (c *AppsV1) CreateReplicaSet(ctx context.Context, v *appsv1.ReplicaSet, opts metav1.CreateOptions) (*appsv1.ReplicaSet, error) {
-	result, err := c.backend.Create(ctx, "replicasets", v, opts, &appsv1.ReplicaSet{})
+func (c *AppsV1) UpdateDeploymentScale(ctx context.Context, namespace, name string, v *autoscalingv1.Scale, opts metav1.UpdateOptions) (*autoscalingv1.Scale, error) {
+	result, err := c.deploymentClient.SubResource(ctx, "PUT", namespace, name, "scale", v, &opts, &autoscalingv1.Scale{})
 	if err != nil {
 		return nil, err
 	}
-	return result.(*appsv1.ReplicaSet), nil
+	return result.(*autoscalingv1.Scale), nil
+}
+
+// ReplicaSets returns the client of ReplicaSet.
+func (c *AppsV1) ReplicaSets() *typedclient.ResourceClient[*appsv1.ReplicaSet, *appsv1.ReplicaSetList] {
+	return c.replicaSetClient
+}
+
+func (c *AppsV1) GetReplicaSet(ctx context.Context, namespace string, name string, opts metav1.GetOptions) (*appsv1.ReplicaSet, error) {
+	return c.replicaSetClient.Get(ctx, namespace, name, opts)
+}
+
+func (c *AppsV1) CreateReplicaSet(ctx context.Context, v *appsv1.ReplicaSet, opts metav1.CreateOptions) (*appsv1.ReplicaSet, error) {
+	return c.replicaSetClient.Create(ctx, v, opts)
 }
 
 func (c *AppsV1) UpdateReplicaSet(ctx context.Context, v *appsv1.ReplicaSet, opts metav1.UpdateOptions) (*appsv1.ReplicaSet, error) {
-	result, err := c.backend.Update(ctx, "replicasets", v, opts, &appsv1.ReplicaSet{})
-	if err != nil {
-		return nil, err
-	}
-	return result.(*appsv1.ReplicaSet), nil
+	return c.replicaSetClient.Update(ctx, v, opts)
 }
 
 func (c *AppsV1) UpdateStatusReplicaSet(ctx context.Context, v *appsv1.ReplicaSet, opts metav1.UpdateOptions) (*appsv1.ReplicaSet, error) {
-	result, err := c.backend.UpdateStatus(ctx, "replicasets", v, opts, &appsv1.ReplicaSet{})
-	if err != nil {
-		return nil, err
-	}
-	return result.(*appsv1.ReplicaSet), nil
+	return c.replicaSetClient.UpdateStatus(ctx, v, opts)
 }
 
-func (c *AppsV1) DeleteReplicaSet(ctx context.Context, namespace, name string, opts metav1.DeleteOptions) error {
-	return c.backend.Delete(ctx, schema.GroupVersionResource{Group: ".apps", Version: "v1", Resource: "replicasets"}, namespace, name, opts)
+func (c *AppsV1) PatchReplicaSet(ctx context.Context, namespace string, name string, pt types.PatchType, data []byte, opts metav1.PatchOptions) (*appsv1.ReplicaSet, error) {
+	return c.replicaSetClient.Patch(ctx, namespace, name, pt, data, opts)
+}
+
+func (c *AppsV1) PatchReplicaSetStatus(ctx context.Context, namespace string, name string, pt types.PatchType, data []byte, opts metav1.PatchOptions) (*appsv1.ReplicaSet, error) {
+	return c.replicaSetClient.Patch(ctx, namespace, name, pt, data, opts, "status")
+}
+
+func (c *AppsV1) DeleteReplicaSet(ctx context.Context, namespace string, name string, opts metav1.DeleteOptions) error {
+	return c.replicaSetClient.Delete(ctx, namespace, name, opts)
+}
+
+func (c *AppsV1) DeleteCollectionReplicaSet(ctx context.Context, namespace string, opts metav1.DeleteOptions, listOpts metav1.ListOptions) error {
+	return c.replicaSetClient.DeleteCollection(ctx, namespace, opts, listOpts)
 }
 
 func (c *AppsV1) ListReplicaSet(ctx context.Context, namespace string, opts metav1.ListOptions) (*appsv1.ReplicaSetList, error) {
-	result, err := c.backend.List(ctx, "replicasets", namespace, opts, &appsv1.ReplicaSetList{})
-	if err != nil {
-		return nil, err
-	}
-	return result.(*appsv1.ReplicaSetList), nil
+	return c.replicaSetClient.List(ctx, namespace, opts)
 }
 
 func (c *AppsV1) WatchReplicaSet(ctx context.Context, namespace string, opts metav1.ListOptions) (watch.Interface, error) {
-	return c.backend.Watch(ctx, schema.GroupVersionResource{Group: ".apps", Version: "v1", Resource: "replicasets"}, namespace, opts)
+	return c.replicaSetClient.Watch(ctx, namespace, opts)
 }
 
-func (c *AppsV1) GetStatefulSet(ctx context.Context, namespace, name string, opts metav1.GetOptions) (*appsv1.StatefulSet, error) {
-	result, err := c.backend.Get(ctx, "statefulsets", namespace, name, opts, &appsv1.StatefulSet{})
+func (c *AppsV1) GetReplicaSetScale(ctx context.Context, namespace, name string, opts metav1.GetOptions) (*autoscalingv1.Scale, error) {
+	result, err := c.replicaSetClient.SubResource(ctx, "GET", namespace, name, "scale", nil, &opts, &autoscalingv1.Scale{})
 	if err != nil {
 		return nil, err
 	}
-	return result.(*appsv1.StatefulSet), nil
+	return result.(*autoscalingv1.Scale), nil
 }
 
-func (c *AppsV1) CreateStatefulSet(ctx context.Context, v *appsv1.StatefulSet, opts metav1.CreateOptions) (*appsv1.StatefulSet, error) {
-	result, err := c.backend.Create(ctx, "statefulsets", v, opts, &appsv1.StatefulSet{})
+func (c *AppsV1) UpdateReplicaSetScale(ctx context.Context, namespace, name string, v *autoscalingv1.Scale, opts metav1.UpdateOptions) (*autoscalingv1.Scale, error) {
+	result, err := c.replicaSetClient.SubResource(ctx, "PUT", namespace, name, "scale", v, &opts, &autoscalingv1.Scale{})
 	if err != nil {
 		return nil, err
 	}
-	return result.(*appsv1.StatefulSet), nil
+	return result.(*autoscalingv1.Scale), nil
+}
+
+// StatefulSets returns the client of StatefulSet.
+func (c *AppsV1) StatefulSets() *typedclient.ResourceClient[*appsv1.StatefulSet, *appsv1.StatefulSetList] {
+	return c.statefulSetClient
+}
+
+func (c *AppsV1) GetStatefulSet(ctx context.Context, namespace string, name string, opts metav1.GetOptions) (*appsv1.StatefulSet, error) {
+	return c.statefulSetClient.Get(ctx, namespace, name, opts)
+}
+
+func (c *AppsV1) CreateStatefulSet(ctx context.Context, v *appsv1.StatefulSet, opts metav1.CreateOptions) (*appsv1.StatefulSet, error) {
+	return c.statefulSetClient.Create(ctx, v, opts)
 }
 
 func (c *AppsV1) UpdateStatefulSet(ctx context.Context, v *appsv1.StatefulSet, opts metav1.UpdateOptions) (*appsv1.StatefulSet, error) {
-	result, err := c.backend.Update(ctx, "statefulsets", v, opts, &appsv1.StatefulSet{})
-	if err != nil {
-		return nil, err
-	}
-	return result.(*appsv1.StatefulSet), nil
+	return c.statefulSetClient.Update(ctx, v, opts)
 }
 
 func (c *AppsV1) UpdateStatusStatefulSet(ctx context.Context, v *appsv1.StatefulSet, opts metav1.UpdateOptions) (*appsv1.StatefulSet, error) {
-	result, err := c.backend.UpdateStatus(ctx, "statefulsets", v, opts, &appsv1.StatefulSet{})
-	if err != nil {
-		return nil, err
-	}
-	return result.(*appsv1.StatefulSet), nil
+	return c.statefulSetClient.UpdateStatus(ctx, v, opts)
+}
+
+func (c *AppsV1) PatchStatefulSet(ctx context.Context, namespace string, name string, pt types.PatchType, data []byte, opts metav1.PatchOptions) (*appsv1.StatefulSet, error) {
+	return c.statefulSetClient.Patch(ctx, namespace, name, pt, data, opts)
 }
 
-func (c *AppsV1) DeleteStatefulSet(ctx context.Context, namespace, name string, opts metav1.DeleteOptions) error {
-	return c.backend.Delete(ctx, schema.GroupVersionResource{Group: ".apps", Version: "v1", Resource: "statefulsets"}, namespace, name, opts)
+func (c *AppsV1) PatchStatefulSetStatus(ctx context.Context, namespace string, name string, pt types.PatchType, data []byte, opts metav1.PatchOptions) (*appsv1.StatefulSet, error) {
+	return c.statefulSetClient.Patch(ctx, namespace, name, pt, data, opts, "status")
+}
+
+func (c *AppsV1) DeleteStatefulSet(ctx context.Context, namespace string, name string, opts metav1.DeleteOptions) error {
+	return c.statefulSetClient.Delete(ctx, namespace, name, opts)
+}
+
+func (c *AppsV1) DeleteCollectionStatefulSet(ctx context.Context, namespace string, opts metav1.DeleteOptions, listOpts metav1.ListOptions) error {
+	return c.statefulSetClient.DeleteCollection(ctx, namespace, opts, listOpts)
 }
 
 func (c *AppsV1) ListStatefulSet(ctx context.Context, namespace string, opts metav1.ListOptions) (*appsv1.StatefulSetList, error) {
-	result, err := c.backend.List(ctx, "statefulsets", namespace, opts, &appsv1.StatefulSetList{})
+	return c.statefulSetClient.List(ctx, namespace, opts)
+}
+
+func (c *AppsV1) WatchStatefulSet(ctx context.Context, namespace string, opts metav1.ListOptions) (watch.Interface, error) {
+	return c.statefulSetClient.Watch(ctx, namespace, opts)
+}
+
+func (c *AppsV1) GetStatefulSetScale(ctx context.Context, namespace, name string, opts metav1.GetOptions) (*autoscalingv1.Scale, error) {
+	result, err := c.statefulSetClient.SubResource(ctx, "GET", namespace, name, "scale", nil, &opts, &autoscalingv1.Scale{})
 	if err != nil {
 		return nil, err
 	}
-	return result.(*appsv1.StatefulSetList), nil
+	return result.(*autoscalingv1.Scale), nil
 }
 
-func (c *AppsV1) WatchStatefulSet(ctx context.Context, namespace string, opts metav1.ListOptions) (watch.Interface, error) {
-	return c.backend.Watch(ctx, schema.GroupVersionResource{Group: ".apps", Version: "v1", Resource: "statefulsets"}, namespace, opts)
+func (c *AppsV1) UpdateStatefulSetScale(ctx context.Context, namespace, name string, v *autoscalingv1.Scale, opts metav1.UpdateOptions) (*autoscalingv1.Scale, error) {
+	result, err := c.statefulSetClient.SubResource(ctx, "PUT", namespace, name, "scale", v, &opts, &autoscalingv1.Scale{})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*autoscalingv1.Scale), nil
 }
 
 type AuthenticationK8sIoV1 struct {
 	backend Backend
 	config  *rest.Config
+
+	selfSubjectReviewClient *typedclient.ClusterResourceClient[*authenticationv1.SelfSubjectReview, *authenticationv1.SelfSubjectReviewList]
+	tokenRequestClient      *typedclient.ResourceClient[*authenticationv1.TokenRequest, *authenticationv1.TokenRequestList]
+	tokenReviewClient       *typedclient.ClusterResourceClient[*authenticationv1.TokenReview, *authenticationv1.TokenReviewList]
 }
 
 func NewAuthenticationK8sIoV1Client(b Backend, config *rest.Config) *AuthenticationK8sIoV1 {
-	return &AuthenticationK8sIoV1{backend: b, config: config}
+	return &AuthenticationK8sIoV1{
+		backend:                 b,
+		config:                  config,
+		selfSubjectReviewClient: typedclient.NewClusterResourceClient[*authenticationv1.SelfSubjectReview, *authenticationv1.SelfSubjectReviewList](b, schema.GroupVersionResource{Group: "authentication.k8s.io", Version: "v1", Resource: "selfsubjectreviews"}),
+		tokenRequestClient:      typedclient.NewResourceClient[*authenticationv1.TokenRequest, *authenticationv1.TokenRequestList](b, schema.GroupVersionResource{Group: "authentication.k8s.io", Version: "v1", Resource: "tokenrequests"}),
+		tokenReviewClient:       typedclient.NewClusterResourceClient[*authenticationv1.TokenReview, *authenticationv1.TokenReviewList](b, schema.GroupVersionResource{Group: "authentication.k8s.io", Version: "v1", Resource: "tokenreviews"}),
+	}
+}
+
+// SelfSubjectReviews returns the client of SelfSubjectReview.
+func (c *AuthenticationK8sIoV1) SelfSubjectReviews() *typedclient.ClusterResourceClient[*authenticationv1.SelfSubjectReview, *authenticationv1.SelfSubjectReviewList] {
+	return c.selfSubjectReviewClient
 }
 
 func (c *AuthenticationK8sIoV1) GetSelfSubjectReview(ctx context.Context, name string, opts metav1.GetOptions) (*authenticationv1.SelfSubjectReview, error) {
-	result, err := c.backend.GetClusterScoped(ctx, "selfsubjectreviews", name, opts, &authenticationv1.SelfSubjectReview{})
-	if err != nil {
-		return nil, err
-	}
-	return result.(*authenticationv1.SelfSubjectReview), nil
+	return c.selfSubjectReviewClient.Get(ctx, name, opts)
 }
 
 func (c *AuthenticationK8sIoV1) CreateSelfSubjectReview(ctx context.Context, v *authenticationv1.SelfSubjectReview, opts metav1.CreateOptions) (*authenticationv1.SelfSubjectReview, error) {
-	result, err := c.backend.CreateClusterScoped(ctx, "selfsubjectreviews", v, opts, &authenticationv1.SelfSubjectReview{})
-	if err != nil {
-		return nil, err
-	}
-	return result.(*authenticationv1.SelfSubjectReview), nil
+	return c.selfSubjectReviewClient.Create(ctx, v, opts)
 }
 
 func (c *AuthenticationK8sIoV1) UpdateSelfSubjectReview(ctx context.Context, v *authenticationv1.SelfSubjectReview, opts metav1.UpdateOptions) (*authenticationv1.SelfSubjectReview, error) {
-	result, err := c.backend.UpdateClusterScoped(ctx, "selfsubjectreviews", v, opts, &authenticationv1.SelfSubjectReview{})
-	if err != nil {
-		return nil, err
-	}
-	return result.(*authenticationv1.SelfSubjectReview), nil
+	return c.selfSubjectReviewClient.Update(ctx, v, opts)
 }
 
 func (c *AuthenticationK8sIoV1) UpdateStatusSelfSubjectReview(ctx context.Context, v *authenticationv1.SelfSubjectReview, opts metav1.UpdateOptions) (*authenticationv1.SelfSubjectReview, error) {
-	result, err := c.backend.UpdateStatusClusterScoped(ctx, "selfsubjectreviews", v, opts, &authenticationv1.SelfSubjectReview{})
-	if err != nil {
-		return nil, err
-	}
-	return result.(*authenticationv1.SelfSubjectReview), nil
+	return c.selfSubjectReviewClient.UpdateStatus(ctx, v, opts)
+}
+
+func (c *AuthenticationK8sIoV1) PatchSelfSubjectReview(ctx context.Context, name string, pt types.PatchType, data []byte, opts metav1.PatchOptions) (*authenticationv1.SelfSubjectReview, error) {
+	return c.selfSubjectReviewClient.Patch(ctx, name, pt, data, opts)
+}
+
+func (c *AuthenticationK8sIoV1) PatchSelfSubjectReviewStatus(ctx context.Context, name string, pt types.PatchType, data []byte, opts metav1.PatchOptions) (*authenticationv1.SelfSubjectReview, error) {
+	return c.selfSubjectReviewClient.Patch(ctx, name, pt, data, opts, "status")
 }
 
 func (c *AuthenticationK8sIoV1) DeleteSelfSubjectReview(ctx context.Context, name string, opts metav1.DeleteOptions) error {
-	return c.backend.DeleteClusterScoped(ctx, schema.GroupVersionResource{Group: ".authentication.k8s.io", Version: "v1", Resource: "selfsubjectreviews"}, name, opts)
+	return c.selfSubjectReviewClient.Delete(ctx, name, opts)
+}
+
+func (c *AuthenticationK8sIoV1) DeleteCollectionSelfSubjectReview(ctx context.Context, opts metav1.DeleteOptions, listOpts metav1.ListOptions) error {
+	return c.selfSubjectReviewClient.DeleteCollection(ctx, opts, listOpts)
 }
 
 func (c *AuthenticationK8sIoV1) ListSelfSubjectReview(ctx context.Context, opts metav1.ListOptions) (*authenticationv1.SelfSubjectReviewList, error) {
-	result, err := c.backend.ListClusterScoped(ctx, "selfsubjectreviews", opts, &authenticationv1.SelfSubjectReviewList{})
-	if err != nil {
-		return nil, err
-	}
-	return result.(*authenticationv1.SelfSubjectReviewList), nil
+	return c.selfSubjectReviewClient.List(ctx, opts)
 }
 
 func (c *AuthenticationK8sIoV1) WatchSelfSubjectReview(ctx context.Context, opts metav1.ListOptions) (watch.Interface, error) {
-	return c.backend.WatchClusterScoped(ctx, schema.GroupVersionResource{Group: ".authentication.k8s.io", Version: "v1", Resource: "selfsubjectreviews"}, opts)
+	return c.selfSubjectReviewClient.Watch(ctx, opts)
 }
 
-func (c *AuthenticationK8sIoV1) GetTokenRequest(ctx context.Context, namespace, name string, opts metav1.GetOptions) (*authenticationv1.TokenRequest, error) {
-	result, err := c.backend.Get(ctx, "tokenrequests", namespace, name, opts, &authenticationv1.TokenRequest{})
-	if err != nil {
-		return nil, err
-	}
-	return result.(*authenticationv1.TokenRequest), nil
+// TokenRequests returns the client of TokenRequest.
+func (c *AuthenticationK8sIoV1) TokenRequests() *typedclient.ResourceClient[*authenticationv1.TokenRequest, *authenticationv1.TokenRequestList] {
+	return c.tokenRequestClient
+}
+
+func (c *AuthenticationK8sIoV1) GetTokenRequest(ctx context.Context, namespace string, name string, opts metav1.GetOptions) (*authenticationv1.TokenRequest, error) {
+	return c.tokenRequestClient.Get(ctx, namespace, name, opts)
 }
 
 func (c *AuthenticationK8sIoV1) CreateTokenRequest(ctx context.Context, v *authenticationv1.TokenRequest, opts metav1.CreateOptions) (*authenticationv1.TokenRequest, error) {
-	result, err := c.backend.Create(ctx, "tokenrequests", v, opts, &authenticationv1.TokenRequest{})
-	if err != nil {
-		return nil, err
-	}
-	return result.(*authenticationv1.TokenRequest), nil
+	return c.tokenRequestClient.Create(ctx, v, opts)
 }
 
 func (c *AuthenticationK8sIoV1) UpdateTokenRequest(ctx context.Context, v *authenticationv1.TokenRequest, opts metav1.UpdateOptions) (*authenticationv1.TokenRequest, error) {
-	result, err := c.backend.Update(ctx, "tokenrequests", v, opts, &authenticationv1.TokenRequest{})
-	if err != nil {
-		return nil, err
-	}
-	return result.(*authenticationv1.TokenRequest), nil
+	return c.tokenRequestClient.Update(ctx, v, opts)
 }
 
 func (c *AuthenticationK8sIoV1) UpdateStatusTokenRequest(ctx context.Context, v *authenticationv1.TokenRequest, opts metav1.UpdateOptions) (*authenticationv1.TokenRequest, error) {
-	result, err := c.backend.UpdateStatus(ctx, "tokenrequests", v, opts, &authenticationv1.TokenRequest{})
-	if err != nil {
-		return nil, err
-	}
-	return result.(*authenticationv1.TokenRequest), nil
+	return c.tokenRequestClient.UpdateStatus(ctx, v, opts)
+}
+
+func (c *AuthenticationK8sIoV1) PatchTokenRequest(ctx context.Context, namespace string, name string, pt types.PatchType, data []byte, opts metav1.PatchOptions) (*authenticationv1.TokenRequest, error) {
+	return c.tokenRequestClient.Patch(ctx, namespace, name, pt, data, opts)
+}
+
+func (c *AuthenticationK8sIoV1) PatchTokenRequestStatus(ctx context.Context, namespace string, name string, pt types.PatchType, data []byte, opts metav1.PatchOptions) (*authenticationv1.TokenRequest, error) {
+	return c.tokenRequestClient.Patch(ctx, namespace, name, pt, data, opts, "status")
+}
+
+func (c *AuthenticationK8sIoV1) DeleteTokenRequest(ctx context.Context, namespace string, name string, opts metav1.DeleteOptions) error {
+	return c.tokenRequestClient.Delete(ctx, namespace, name, opts)
 }
 
-func (c *AuthenticationK8sIoV1) DeleteTokenRequest(ctx context.Context, namespace, name string, opts metav1.DeleteOptions) error {
-	return c.backend.Delete(ctx, schema.GroupVersionResource{Group: ".authentication.k8s.io", Version: "v1", Resource: "tokenrequests"}, namespace, name, opts)
+func (c *AuthenticationK8sIoV1) DeleteCollectionTokenRequest(ctx context.Context, namespace string, opts metav1.DeleteOptions, listOpts metav1.ListOptions) error {
+	return c.tokenRequestClient.DeleteCollection(ctx, namespace, opts, listOpts)
 }
 
 func (c *AuthenticationK8sIoV1) ListTokenRequest(ctx context.Context, namespace string, opts metav1.ListOptions) (*authenticationv1.TokenRequestList, error) {
-	result, err := c.backend.List(ctx, "tokenrequests", namespace, opts, &authenticationv1.TokenRequestList{})
-	if err != nil {
-		return nil, err
-	}
-	return result.(*authenticationv1.TokenRequestList), nil
+	return c.tokenRequestClient.List(ctx, namespace, opts)
 }
 
 func (c *AuthenticationK8sIoV1) WatchTokenRequest(ctx context.Context, namespace string, opts metav1.ListOptions) (watch.Interface, error) {
-	return c.backend.Watch(ctx, schema.GroupVersionResource{Group: ".authentication.k8s.io", Version: "v1", Resource: "tokenrequests"}, namespace, opts)
+	return c.tokenRequestClient.Watch(ctx, namespace, opts)
+}
+
+// TokenReviews returns the client of TokenReview.
+func (c *AuthenticationK8sIoV1) TokenReviews() *typedclient.ClusterResourceClient[*authenticationv1.TokenReview, *authenticationv1.TokenReviewList] {
+	return c.tokenReviewClient
 }
 
 func (c *AuthenticationK8sIoV1) GetTokenReview(ctx context.Context, name string, opts metav1.GetOptions) (*authenticationv1.TokenReview, error) {
-	result, err := c.backend.GetClusterScoped(ctx, "tokenreviews", name, opts, &authenticationv1.TokenReview{})
-	if err != nil {
-		return nil, err
-	}
-	return result.(*authenticationv1.TokenReview), nil
+	return c.tokenReviewClient.Get(ctx, name, opts)
 }
 
 func (c *AuthenticationK8sIoV1) CreateTokenReview(ctx context.Context, v *authenticationv1.TokenReview, opts metav1.CreateOptions) (*authenticationv1.TokenReview, error) {
-	result, err := c.backend.CreateClusterScoped(ctx, "tokenreviews", v, opts, &authenticationv1.TokenReview{})
-	if err != nil {
-		return nil, err
-	}
-	return result.(*authenticationv1.TokenReview), nil
+	return c.tokenReviewClient.Create(ctx, v, opts)
 }
 
 func (c *AuthenticationK8sIoV1) UpdateTokenReview(ctx context.Context, v *authenticationv1.TokenReview, opts metav1.UpdateOptions) (*authenticationv1.TokenReview, error) {
-	result, err := c.backend.UpdateClusterScoped(ctx, "tokenreviews", v, opts, &authenticationv1.TokenReview{})
-	if err != nil {
-		return nil, err
-	}
-	return result.(*authenticationv1.TokenReview), nil
+	return c.tokenReviewClient.Update(ctx, v, opts)
 }
 
 func (c *AuthenticationK8sIoV1) UpdateStatusTokenReview(ctx context.Context, v *authenticationv1.TokenReview, opts metav1.UpdateOptions) (*authenticationv1.TokenReview, error) {
-	result, err := c.backend.UpdateStatusClusterScoped(ctx, "tokenreviews", v, opts, &authenticationv1.TokenReview{})
-	if err != nil {
-		return nil, err
-	}
-	return result.(*authenticationv1.TokenReview), nil
+	return c.tokenReviewClient.UpdateStatus(ctx, v, opts)
+}
+
+func (c *AuthenticationK8sIoV1) PatchTokenReview(ctx context.Context, name string, pt types.PatchType, data []byte, opts metav1.PatchOptions) (*authenticationv1.TokenReview, error) {
+	return c.tokenReviewClient.Patch(ctx, name, pt, data, opts)
+}
+
+func (c *AuthenticationK8sIoV1) PatchTokenReviewStatus(ctx context.Context, name string, pt types.PatchType, data []byte, opts metav1.PatchOptions) (*authenticationv1.TokenReview, error) {
+	return c.tokenReviewClient.Patch(ctx, name, pt, data, opts, "status")
 }
 
 func (c *AuthenticationK8sIoV1) DeleteTokenReview(ctx context.Context, name string, opts metav1.DeleteOptions) error {
-	return c.backend.DeleteClusterScoped(ctx, schema.GroupVersionResource{Group: ".authentication.k8s.io", Version: "v1", Resource: "tokenreviews"}, name, opts)
+	return c.tokenReviewClient.Delete(ctx, name, opts)
 }
 
-func (c *AuthenticationK8sIoV1) ListTokenReview(ctx context.Context, opts metav1.ListOptions) (*authenticationv1.TokenReviewList, error) {
-	result, err := c.backend.ListClusterScoped(ctx, "tokenreviews", opts, &authenticationv1.TokenReviewList{})
-	if err != nil {
-		return nil, err
-	}
-	return result.(*authenticationv1.TokenReviewList), nil
+func (c *AuthenticationK8sIoV1) DeleteCollectionTokenReview(ctx context.Context, opts metav1.DeleteOptions, listOpts metav1.ListOptions) error {
+	return c.tokenReviewClient.DeleteCollection(ctx, opts, listOpts)
+}
+
+func (c *AuthenticationK8sIoV1) ListTokenReview(ctx context.Context, opts metav1.ListOptions) (*authenticationv1.TokenReviewList, error) {
+	return c.tokenReviewClient.List(ctx, opts)
 }
 
 func (c *AuthenticationK8sIoV1) WatchTokenReview(ctx context.Context, opts metav1.ListOptions) (watch.Interface, error) {
-	return c.backend.WatchClusterScoped(ctx, schema.GroupVersionResource{Group: ".authentication.k8s.io", Version: "v1", Resource: "tokenreviews"}, opts)
+	return c.tokenReviewClient.Watch(ctx, opts)
 }
 
 type AuthorizationK8sIoV1 struct {
 	backend Backend
 	config  *rest.Config
+
+	localSubjectAccessReviewClient *typedclient.ResourceClient[*authorizationv1.LocalSubjectAccessReview, *authorizationv1.LocalSubjectAccessReviewList]
+	selfSubjectAccessReviewClient  *typedclient.ClusterResourceClient[*authorizationv1.SelfSubjectAccessReview, *authorizationv1.SelfSubjectAccessReviewList]
+	selfSubjectRulesReviewClient   *typedclient.ClusterResourceClient[*authorizationv1.SelfSubjectRulesReview, *authorizationv1.SelfSubjectRulesReviewList]
+	subjectAccessReviewClient      *typedclient.ClusterResourceClient[*authorizationv1.SubjectAccessReview, *authorizationv1.SubjectAccessReviewList]
 }
 
 func NewAuthorizationK8sIoV1Client(b Backend, config *rest.Config) *AuthorizationK8sIoV1 {
-	return &AuthorizationK8sIoV1{backend: b, config: config}
+	return &AuthorizationK8sIoV1{
+		backend:                        b,
+		config:                         config,
+		localSubjectAccessReviewClient: typedclient.NewResourceClient[*authorizationv1.LocalSubjectAccessReview, *authorizationv1.LocalSubjectAccessReviewList](b, schema.GroupVersionResource{Group: "authorization.k8s.io", Version: "v1", Resource: "localsubjectaccessreviews"}),
+		selfSubjectAccessReviewClient:  typedclient.NewClusterResourceClient[*authorizationv1.SelfSubjectAccessReview, *authorizationv1.SelfSubjectAccessReviewList](b, schema.GroupVersionResource{Group: "authorization.k8s.io", Version: "v1", Resource: "selfsubjectaccessreviews"}),
+		selfSubjectRulesReviewClient:   typedclient.NewClusterResourceClient[*authorizationv1.SelfSubjectRulesReview, *authorizationv1.SelfSubjectRulesReviewList](b, schema.GroupVersionResource{Group: "authorization.k8s.io", Version: "v1", Resource: "selfsubjectrulesreviews"}),
+		subjectAccessReviewClient:      typedclient.NewClusterResourceClient[*authorizationv1.SubjectAccessReview, *authorizationv1.SubjectAccessReviewList](b, schema.GroupVersionResource{Group: "authorization.k8s.io", Version: "v1", Resource: "subjectaccessreviews"}),
+	}
 }
 
-func (c *AuthorizationK8sIoV1) GetLocalSubjectAccessReview(ctx context.Context, namespace, name string, opts metav1.GetOptions) (*authorizationv1.LocalSubjectAccessReview, error) {
-	result, err := c.backend.Get(ctx, "localsubjectaccessreviews", namespace, name, opts, &authorizationv1.LocalSubjectAccessReview{})
-	if err != nil {
-		return nil, err
-	}
-	return result.(*authorizationv1.LocalSubjectAccessReview), nil
+// LocalSubjectAccessReviews returns the client of LocalSubjectAccessReview.
+func (c *AuthorizationK8sIoV1) LocalSubjectAccessReviews() *typedclient.ResourceClient[*authorizationv1.LocalSubjectAccessReview, *authorizationv1.LocalSubjectAccessReviewList] {
+	return c.localSubjectAccessReviewClient
+}
+
+func (c *AuthorizationK8sIoV1) GetLocalSubjectAccessReview(ctx context.Context, namespace string, name string, opts metav1.GetOptions) (*authorizationv1.LocalSubjectAccessReview, error) {
+	return c.localSubjectAccessReviewClient.Get(ctx, namespace, name, opts)
 }
 
 func (c *AuthorizationK8sIoV1) CreateLocalSubjectAccessReview(ctx context.Context, v *authorizationv1.LocalSubjectAccessReview, opts metav1.CreateOptions) (*authorizationv1.LocalSubjectAccessReview, error) {
-	result, err := c.backend.Create(ctx, "localsubjectaccessreviews", v, opts, &authorizationv1.LocalSubjectAccessReview{})
-	if err != nil {
-		return nil, err
-	}
-	return result.(*authorizationv1.LocalSubjectAccessReview), nil
+	return c.localSubjectAccessReviewClient.Create(ctx, v, opts)
 }
 
 func (c *AuthorizationK8sIoV1) UpdateLocalSubjectAccessReview(ctx context.Context, v *authorizationv1.LocalSubjectAccessReview, opts metav1.UpdateOptions) (*authorizationv1.LocalSubjectAccessReview, error) {
-	result, err := c.backend.Update(ctx, "localsubjectaccessreviews", v, opts, &authorizationv1.LocalSubjectAccessReview{})
-	if err != nil {
-		return nil, err
-	}
-	return result.(*authorizationv1.LocalSubjectAccessReview), nil
+	return c.localSubjectAccessReviewClient.Update(ctx, v, opts)
 }
 
 func (c *AuthorizationK8sIoV1) UpdateStatusLocalSubjectAccessReview(ctx context.Context, v *authorizationv1.LocalSubjectAccessReview, opts metav1.UpdateOptions) (*authorizationv1.LocalSubjectAccessReview, error) {
-	result, err := c.backend.UpdateStatus(ctx, "localsubjectaccessreviews", v, opts, &authorizationv1.LocalSubjectAccessReview{})
-	if err != nil {
-		return nil, err
-	}
-	return result.(*authorizationv1.LocalSubjectAccessReview), nil
+	return c.localSubjectAccessReviewClient.UpdateStatus(ctx, v, opts)
+}
+
+func (c *AuthorizationK8sIoV1) PatchLocalSubjectAccessReview(ctx context.Context, namespace string, name string, pt types.PatchType, data []byte, opts metav1.PatchOptions) (*authorizationv1.LocalSubjectAccessReview, error) {
+	return c.localSubjectAccessReviewClient.Patch(ctx, namespace, name, pt, data, opts)
+}
+
+func (c *AuthorizationK8sIoV1) PatchLocalSubjectAccessReviewStatus(ctx context.Context, namespace string, name string, pt types.PatchType, data []byte, opts metav1.PatchOptions) (*authorizationv1.LocalSubjectAccessReview, error) {
+	return c.localSubjectAccessReviewClient.Patch(ctx, namespace, name, pt, data, opts, "status")
+}
+
+func (c *AuthorizationK8sIoV1) DeleteLocalSubjectAccessReview(ctx context.Context, namespace string, name string, opts metav1.DeleteOptions) error {
+	return c.localSubjectAccessReviewClient.Delete(ctx, namespace, name, opts)
 }
 
-func (c *AuthorizationK8sIoV1) DeleteLocalSubjectAccessReview(ctx context.Context, namespace, name string, opts metav1.DeleteOptions) error {
-	return c.backend.Delete(ctx, schema.GroupVersionResource{Group: ".authorization.k8s.io", Version: "v1", Resource: "localsubjectaccessreviews"}, namespace, name, opts)
+func (c *AuthorizationK8sIoV1) DeleteCollectionLocalSubjectAccessReview(ctx context.Context, namespace string, opts metav1.DeleteOptions, listOpts metav1.ListOptions) error {
+	return c.localSubjectAccessReviewClient.DeleteCollection(ctx, namespace, opts, listOpts)
 }
 
 func (c *AuthorizationK8sIoV1) ListLocalSubjectAccessReview(ctx context.Context, namespace string, opts metav1.ListOptions) (*authorizationv1.LocalSubjectAccessReviewList, error) {
-	result, err := c.backend.List(ctx, "localsubjectaccessreviews", namespace, opts, &authorizationv1.LocalSubjectAccessReviewList{})
-	if err != nil {
-		return nil, err
-	}
-	return result.(*authorizationv1.LocalSubjectAccessReviewList), nil
+	return c.localSubjectAccessReviewClient.List(ctx, namespace, opts)
 }
 
 func (c *AuthorizationK8sIoV1) WatchLocalSubjectAccessReview(ctx context.Context, namespace string, opts metav1.ListOptions) (watch.Interface, error) {
-	return c.backend.Watch(ctx, schema.GroupVersionResource{Group: ".authorization.k8s.io", Version: "v1", Resource: "localsubjectaccessreviews"}, namespace, opts)
+	return c.localSubjectAccessReviewClient.Watch(ctx, namespace, opts)
+}
+
+// SelfSubjectAccessReviews returns the client of SelfSubjectAccessReview.
+func (c *AuthorizationK8sIoV1) SelfSubjectAccessReviews() *typedclient.ClusterResourceClient[*authorizationv1.SelfSubjectAccessReview, *authorizationv1.SelfSubjectAccessReviewList] {
+	return c.selfSubjectAccessReviewClient
 }
 
 func (c *AuthorizationK8sIoV1) GetSelfSubjectAccessReview(ctx context.Context, name string, opts metav1.GetOptions) (*authorizationv1.SelfSubjectAccessReview, error) {
-	result, err := c.backend.GetClusterScoped(ctx, "selfsubjectaccessreviews", name, opts, &authorizationv1.SelfSubjectAccessReview{})
-	if err != nil {
-		return nil, err
-	}
-	return result.(*authorizationv1.SelfSubjectAccessReview), nil
+	return c.selfSubjectAccessReviewClient.Get(ctx, name, opts)
 }
 
 func (c *AuthorizationK8sIoV1) CreateSelfSubjectAccessReview(ctx context.Context, v *authorizationv1.SelfSubjectAccessReview, opts metav1.CreateOptions) (*authorizationv1.SelfSubjectAccessReview, error) {
-	result, err := c.backend.CreateClusterScoped(ctx, "selfsubjectaccessreviews", v, opts, &authorizationv1.SelfSubjectAccessReview{})
-	if err != nil {
-		return nil, err
-	}
-	return result.(*authorizationv1.SelfSubjectAccessReview), nil
+	return c.selfSubjectAccessReviewClient.Create(ctx, v, opts)
 }
 
 func (c *AuthorizationK8sIoV1) UpdateSelfSubjectAccessReview(ctx context.Context, v *authorizationv1.SelfSubjectAccessReview, opts metav1.UpdateOptions) (*authorizationv1.SelfSubjectAccessReview, error) {
-	result, err := c.backend.UpdateClusterScoped(ctx, "selfsubjectaccessreviews", v, opts, &authorizationv1.SelfSubjectAccessReview{})
-	if err != nil {
-		return nil, err
-	}
-	return result.(*authorizationv1.SelfSubjectAccessReview), nil
+	return c.selfSubjectAccessReviewClient.Update(ctx, v, opts)
 }
 
 func (c *AuthorizationK8sIoV1) UpdateStatusSelfSubjectAccessReview(ctx context.Context, v *authorizationv1.SelfSubjectAccessReview, opts metav1.UpdateOptions) (*authorizationv1.SelfSubjectAccessReview, error) {
-	result, err := c.backend.UpdateStatusClusterScoped(ctx, "selfsubjectaccessreviews", v, opts, &authorizationv1.SelfSubjectAccessReview{})
-	if err != nil {
-		return nil, err
-	}
-	return result.(*authorizationv1.SelfSubjectAccessReview), nil
+	return c.selfSubjectAccessReviewClient.UpdateStatus(ctx, v, opts)
+}
+
+func (c *AuthorizationK8sIoV1) PatchSelfSubjectAccessReview(ctx context.Context, name string, pt types.PatchType, data []byte, opts metav1.PatchOptions) (*authorizationv1.SelfSubjectAccessReview, error) {
+	return c.selfSubjectAccessReviewClient.Patch(ctx, name, pt, data, opts)
+}
+
+func (c *AuthorizationK8sIoV1) PatchSelfSubjectAccessReviewStatus(ctx context.Context, name string, pt types.PatchType, data []byte, opts metav1.PatchOptions) (*authorizationv1.SelfSubjectAccessReview, error) {
+	return c.selfSubjectAccessReviewClient.Patch(ctx, name, pt, data, opts, "status")
 }
 
 func (c *AuthorizationK8sIoV1) DeleteSelfSubjectAccessReview(ctx context.Context, name string, opts metav1.DeleteOptions) error {
-	return c.backend.DeleteClusterScoped(ctx, schema.GroupVersionResource{Group: ".authorization.k8s.io", Version: "v1", Resource: "selfsubjectaccessreviews"}, name, opts)
+	return c.selfSubjectAccessReviewClient.Delete(ctx, name, opts)
+}
+
+func (c *AuthorizationK8sIoV1) DeleteCollectionSelfSubjectAccessReview(ctx context.Context, opts metav1.DeleteOptions, listOpts metav1.ListOptions) error {
+	return c.selfSubjectAccessReviewClient.DeleteCollection(ctx, opts, listOpts)
 }
 
 func (c *AuthorizationK8sIoV1) ListSelfSubjectAccessReview(ctx context.Context, opts metav1.ListOptions) (*authorizationv1.SelfSubjectAccessReviewList, error) {
-	result, err := c.backend.ListClusterScoped(ctx, "selfsubjectaccessreviews", opts, &authorizationv1.SelfSubjectAccessReviewList{})
-	if err != nil {
-		return nil, err
-	}
-	return result.(*authorizationv1.SelfSubjectAccessReviewList), nil
+	return c.selfSubjectAccessReviewClient.List(ctx, opts)
 }
 
 func (c *AuthorizationK8sIoV1) WatchSelfSubjectAccessReview(ctx context.Context, opts metav1.ListOptions) (watch.Interface, error) {
-	return c.backend.WatchClusterScoped(ctx, schema.GroupVersionResource{Group: ".authorization.k8s.io", Version: "v1", Resource: "selfsubjectaccessreviews"}, opts)
+	return c.selfSubjectAccessReviewClient.Watch(ctx, opts)
+}
+
+// SelfSubjectRulesReviews returns the client of SelfSubjectRulesReview.
+func (c *AuthorizationK8sIoV1) SelfSubjectRulesReviews() *typedclient.ClusterResourceClient[*authorizationv1.SelfSubjectRulesReview, *authorizationv1.SelfSubjectRulesReviewList] {
+	return c.selfSubjectRulesReviewClient
 }
 
 func (c *AuthorizationK8sIoV1) GetSelfSubjectRulesReview(ctx context.Context, name string, opts metav1.GetOptions) (*authorizationv1.SelfSubjectRulesReview, error) {
-	result, err := c.backend.GetClusterScoped(ctx, "selfsubjectrulesreviews", name, opts, &authorizationv1.SelfSubjectRulesReview{})
-	if err != nil {
-		return nil, err
-	}
-	return result.(*authorizationv1.SelfSubjectRulesReview), nil
+	return c.selfSubjectRulesReviewClient.Get(ctx, name, opts)
 }
 
 func (c *AuthorizationK8sIoV1) CreateSelfSubjectRulesReview(ctx context.Context, v *authorizationv1.SelfSubjectRulesReview, opts metav1.CreateOptions) (*authorizationv1.SelfSubjectRulesReview, error) {
-	result, err := c.backend.CreateClusterScoped(ctx, "selfsubjectrulesreviews", v, opts, &authorizationv1.SelfSubjectRulesReview{})
-	if err != nil {
-		return nil, err
-	}
-	return result.(*authorizationv1.SelfSubjectRulesReview), nil
+	return c.selfSubjectRulesReviewClient.Create(ctx, v, opts)
 }
 
 func (c *AuthorizationK8sIoV1) UpdateSelfSubjectRulesReview(ctx context.Context, v *authorizationv1.SelfSubjectRulesReview, opts metav1.UpdateOptions) (*authorizationv1.SelfSubjectRulesReview, error) {
-	result, err := c.backend.UpdateClusterScoped(ctx, "selfsubjectrulesreviews", v, opts, &authorizationv1.SelfSubjectRulesReview{})
-	if err != nil {
-		return nil, err
-	}
-	return result.(*authorizationv1.SelfSubjectRulesReview), nil
+	return c.selfSubjectRulesReviewClient.Update(ctx, v, opts)
 }
 
 func (c *AuthorizationK8sIoV1) UpdateStatusSelfSubjectRulesReview(ctx context.Context, v *authorizationv1.SelfSubjectRulesReview, opts metav1.UpdateOptions) (*authorizationv1.SelfSubjectRulesReview, error) {
-	result, err := c.backend.UpdateStatusClusterScoped(ctx, "selfsubjectrulesreviews", v, opts, &authorizationv1.SelfSubjectRulesReview{})
-	if err != nil {
-		return nil, err
-	}
-	return result.(*authorizationv1.SelfSubjectRulesReview), nil
+	return c.selfSubjectRulesReviewClient.UpdateStatus(ctx, v, opts)
+}
+
+func (c *AuthorizationK8sIoV1) PatchSelfSubjectRulesReview(ctx context.Context, name string, pt types.PatchType, data []byte, opts metav1.PatchOptions) (*authorizationv1.SelfSubjectRulesReview, error) {
+	return c.selfSubjectRulesReviewClient.Patch(ctx, name, pt, data, opts)
+}
+
+func (c *AuthorizationK8sIoV1) PatchSelfSubjectRulesReviewStatus(ctx context.Context, name string, pt types.PatchType, data []byte, opts metav1.PatchOptions) (*authorizationv1.SelfSubjectRulesReview, error) {
+	return c.selfSubjectRulesReviewClient.Patch(ctx, name, pt, data, opts, "status")
 }
 
 func (c *AuthorizationK8sIoV1) DeleteSelfSubjectRulesReview(ctx context.Context, name string, opts metav1.DeleteOptions) error {
-	return c.backend.DeleteClusterScoped(ctx, schema.GroupVersionResource{Group: ".authorization.k8s.io", Version: "v1", Resource: "selfsubjectrulesreviews"}, name, opts)
+	return c.selfSubjectRulesReviewClient.Delete(ctx, name, opts)
+}
+
+func (c *AuthorizationK8sIoV1) DeleteCollectionSelfSubjectRulesReview(ctx context.Context, opts metav1.DeleteOptions, listOpts metav1.ListOptions) error {
+	return c.selfSubjectRulesReviewClient.DeleteCollection(ctx, opts, listOpts)
 }
 
 func (c *AuthorizationK8sIoV1) ListSelfSubjectRulesReview(ctx context.Context, opts metav1.ListOptions) (*authorizationv1.SelfSubjectRulesReviewList, error) {
-	result, err := c.backend.ListClusterScoped(ctx, "selfsubjectrulesreviews", opts, &authorizationv1.SelfSubjectRulesReviewList{})
-	if err != nil {
-		return nil, err
-	}
-	return result.(*authorizationv1.SelfSubjectRulesReviewList), nil
+	return c.selfSubjectRulesReviewClient.List(ctx, opts)
 }
 
 func (c *AuthorizationK8sIoV1) WatchSelfSubjectRulesReview(ctx context.Context, opts metav1.ListOptions) (watch.Interface, error) {
-	return c.backend.WatchClusterScoped(ctx, schema.GroupVersionResource{Group: ".authorization.k8s.io", Version: "v1", Resource: "selfsubjectrulesreviews"}, opts)
+	return c.selfSubjectRulesReviewClient.Watch(ctx, opts)
+}
+
+// SubjectAccessReviews returns the client of SubjectAccessReview.
+func (c *AuthorizationK8sIoV1) SubjectAccessReviews() *typedclient.ClusterResourceClient[*authorizationv1.SubjectAccessReview, *authorizationv1.SubjectAccessReviewList] {
+	return c.subjectAccessReviewClient
 }
 
 func (c *AuthorizationK8sIoV1) GetSubjectAccessReview(ctx context.Context, name string, opts metav1.GetOptions) (*authorizationv1.SubjectAccessReview, error) {
-	result, err := c.backend.GetClusterScoped(ctx, "subjectaccessreviews", name, opts, &authorizationv1.SubjectAccessReview{})
-	if err != nil {
-		return nil, err
-	}
-	return result.(*authorizationv1.SubjectAccessReview), nil
+	return c.subjectAccessReviewClient.Get(ctx, name, opts)
 }
 
 func (c *AuthorizationK8sIoV1) CreateSubjectAccessReview(ctx context.Context, v *authorizationv1.SubjectAccessReview, opts metav1.CreateOptions) (*authorizationv1.SubjectAccessReview, error) {
-	result, err := c.backend.CreateClusterScoped(ctx, "subjectaccessreviews", v, opts, &authorizationv1.SubjectAccessReview{})
-	if err != nil {
-		return nil, err
-	}
-	return result.(*authorizationv1.SubjectAccessReview), nil
+	return c.subjectAccessReviewClient.Create(ctx, v, opts)
 }
 
 func (c *AuthorizationK8sIoV1) UpdateSubjectAccessReview(ctx context.Context, v *authorizationv1.SubjectAccessReview, opts metav1.UpdateOptions) (*authorizationv1.SubjectAccessReview, error) {
-	result, err := c.backend.UpdateClusterScoped(ctx, "subjectaccessreviews", v, opts, &authorizationv1.SubjectAccessReview{})
-	if err != nil {
-		return nil, err
-	}
-	return result.(*authorizationv1.SubjectAccessReview), nil
+	return c.subjectAccessReviewClient.Update(ctx, v, opts)
 }
 
 func (c *AuthorizationK8sIoV1) UpdateStatusSubjectAccessReview(ctx context.Context, v *authorizationv1.SubjectAccessReview, opts metav1.UpdateOptions) (*authorizationv1.SubjectAccessReview, error) {
-	result, err := c.backend.UpdateStatusClusterScoped(ctx, "subjectaccessreviews", v, opts, &authorizationv1.SubjectAccessReview{})
-	if err != nil {
-		return nil, err
-	}
-	return result.(*authorizationv1.SubjectAccessReview), nil
+	return c.subjectAccessReviewClient.UpdateStatus(ctx, v, opts)
+}
+
+func (c *AuthorizationK8sIoV1) PatchSubjectAccessReview(ctx context.Context, name string, pt types.PatchType, data []byte, opts metav1.PatchOptions) (*authorizationv1.SubjectAccessReview, error) {
+	return c.subjectAccessReviewClient.Patch(ctx, name, pt, data, opts)
+}
+
+func (c *AuthorizationK8sIoV1) PatchSubjectAccessReviewStatus(ctx context.Context, name string, pt types.PatchType, data []byte, opts metav1.PatchOptions) (*authorizationv1.SubjectAccessReview, error) {
+	return c.subjectAccessReviewClient.Patch(ctx, name, pt, data, opts, "status")
 }
 
 func (c *AuthorizationK8sIoV1) DeleteSubjectAccessReview(ctx context.Context, name string, opts metav1.DeleteOptions) error {
-	return c.backend.DeleteClusterScoped(ctx, schema.GroupVersionResource{Group: ".authorization.k8s.io", Version: "v1", Resource: "subjectaccessreviews"}, name, opts)
+	return c.subjectAccessReviewClient.Delete(ctx, name, opts)
+}
+
+func (c *AuthorizationK8sIoV1) DeleteCollectionSubjectAccessReview(ctx context.Context, opts metav1.DeleteOptions, listOpts metav1.ListOptions) error {
+	return c.subjectAccessReviewClient.DeleteCollection(ctx, opts, listOpts)
 }
 
 func (c *AuthorizationK8sIoV1) ListSubjectAccessReview(ctx context.Context, opts metav1.ListOptions) (*authorizationv1.SubjectAccessReviewList, error) {
-	result, err := c.backend.ListClusterScoped(ctx, "subjectaccessreviews", opts, &authorizationv1.SubjectAccessReviewList{})
-	if err != nil {
-		return nil, err
-	}
-	return result.(*authorizationv1.SubjectAccessReviewList), nil
+	return c.subjectAccessReviewClient.List(ctx, opts)
 }
 
 func (c *AuthorizationK8sIoV1) WatchSubjectAccessReview(ctx context.Context, opts metav1.ListOptions) (watch.Interface, error) {
-	return c.backend.WatchClusterScoped(ctx, schema.GroupVersionResource{Group: ".authorization.k8s.io", Version: "v1", Resource: "subjectaccessreviews"}, opts)
+	return c.subjectAccessReviewClient.Watch(ctx, opts)
 }
 
 type AutoscalingV1 struct {
 	backend Backend
 	config  *rest.Config
+
+	horizontalPodAutoscalerClient *typedclient.ResourceClient[*autoscalingv1.HorizontalPodAutoscaler, *autoscalingv1.HorizontalPodAutoscalerList]
+	scaleClient                   *typedclient.ResourceClient[*autoscalingv1.Scale, *autoscalingv1.ScaleList]
 }
 
 func NewAutoscalingV1Client(b Backend, config *rest.Config) *AutoscalingV1 {
-	return &AutoscalingV1{backend: b, config: config}
+	return &AutoscalingV1{
+		backend:                       b,
+		config:                        config,
+		horizontalPodAutoscalerClient: typedclient.NewResourceClient[*autoscalingv1.HorizontalPodAutoscaler, *autoscalingv1.HorizontalPodAutoscalerList](b, schema.GroupVersionResource{Group: "autoscaling", Version: "v1", Resource: "horizontalpodautoscalers"}),
+		scaleClient:                   typedclient.NewResourceClient[*autoscalingv1.Scale, *autoscalingv1.ScaleList](b, schema.GroupVersionResource{Group: "autoscaling", Version: "v1", Resource: "scales"}),
+	}
 }
 
-func (c *AutoscalingV1) GetHorizontalPodAutoscaler(ctx context.Context, namespace, name string, opts metav1.GetOptions) (*autoscalingv1.HorizontalPodAutoscaler, error) {
-	result, err := c.backend.Get(ctx, "horizontalpodautoscalers", namespace, name, opts, &autoscalingv1.HorizontalPodAutoscaler{})
-	if err != nil {
-		return nil, err
-	}
-	return result.(*autoscalingv1.HorizontalPodAutoscaler), nil
+// HorizontalPodAutoscalers returns the client of HorizontalPodAutoscaler.
+func (c *AutoscalingV1) HorizontalPodAutoscalers() *typedclient.ResourceClient[*autoscalingv1.HorizontalPodAutoscaler, *autoscalingv1.HorizontalPodAutoscalerList] {
+	return c.horizontalPodAutoscalerClient
+}
+
+func (c *AutoscalingV1) GetHorizontalPodAutoscaler(ctx context.Context, namespace string, name string, opts metav1.GetOptions) (*autoscalingv1.HorizontalPodAutoscaler, error) {
+	return c.horizontalPodAutoscalerClient.Get(ctx, namespace, name, opts)
 }
 
 func (c *AutoscalingV1) CreateHorizontalPodAutoscaler(ctx context.Context, v *autoscalingv1.HorizontalPodAutoscaler, opts metav1.CreateOptions) (*autoscalingv1.HorizontalPodAutoscaler, error) {
-	result, err := c.backend.Create(ctx, "horizontalpodautoscalers", v, opts, &autoscalingv1.HorizontalPodAutoscaler{})
-	if err != nil {
-		return nil, err
-	}
-	return result.(*autoscalingv1.HorizontalPodAutoscaler), nil
+	return c.horizontalPodAutoscalerClient.Create(ctx, v, opts)
 }
 
 func (c *AutoscalingV1) UpdateHorizontalPodAutoscaler(ctx context.Context, v *autoscalingv1.HorizontalPodAutoscaler, opts metav1.UpdateOptions) (*autoscalingv1.HorizontalPodAutoscaler, error) {
-	result, err := c.backend.Update(ctx, "horizontalpodautoscalers", v, opts, &autoscalingv1.HorizontalPodAutoscaler{})
-	if err != nil {
-		return nil, err
-	}
-	return result.(*autoscalingv1.HorizontalPodAutoscaler), nil
+	return c.horizontalPodAutoscalerClient.Update(ctx, v, opts)
 }
 
 func (c *AutoscalingV1) UpdateStatusHorizontalPodAutoscaler(ctx context.Context, v *autoscalingv1.HorizontalPodAutoscaler, opts metav1.UpdateOptions) (*autoscalingv1.HorizontalPodAutoscaler, error) {
-	result, err := c.backend.UpdateStatus(ctx, "horizontalpodautoscalers", v, opts, &autoscalingv1.HorizontalPodAutoscaler{})
-	if err != nil {
-		return nil, err
-	}
-	return result.(*autoscalingv1.HorizontalPodAutoscaler), nil
+	return c.horizontalPodAutoscalerClient.UpdateStatus(ctx, v, opts)
 }
 
-func (c *AutoscalingV1) DeleteHorizontalPodAutoscaler(ctx context.Context, namespace, name string, opts metav1.DeleteOptions) error {
-	return c.backend.Delete(ctx, schema.GroupVersionResource{Group: ".autoscaling", Version: "v1", Resource: "horizontalpodautoscalers"}, namespace, name, opts)
+func (c *AutoscalingV1) PatchHorizontalPodAutoscaler(ctx context.Context, namespace string, name string, pt types.PatchType, data []byte, opts metav1.PatchOptions) (*autoscalingv1.HorizontalPodAutoscaler, error) {
+	return c.horizontalPodAutoscalerClient.Patch(ctx, namespace, name, pt, data, opts)
+}
+
+func (c *AutoscalingV1) PatchHorizontalPodAutoscalerStatus(ctx context.Context, namespace string, name string, pt types.PatchType, data []byte, opts metav1.PatchOptions) (*autoscalingv1.HorizontalPodAutoscaler, error) {
+	return c.horizontalPodAutoscalerClient.Patch(ctx, namespace, name, pt, data, opts, "status")
+}
+
+func (c *AutoscalingV1) DeleteHorizontalPodAutoscaler(ctx context.Context, namespace string, name string, opts metav1.DeleteOptions) error {
+	return c.horizontalPodAutoscalerClient.Delete(ctx, namespace, name, opts)
+}
+
+func (c *AutoscalingV1) DeleteCollectionHorizontalPodAutoscaler(ctx context.Context, namespace string, opts metav1.DeleteOptions, listOpts metav1.ListOptions) error {
+	return c.horizontalPodAutoscalerClient.DeleteCollection(ctx, namespace, opts, listOpts)
 }
 
 func (c *AutoscalingV1) ListHorizontalPodAutoscaler(ctx context.Context, namespace string, opts metav1.ListOptions) (*autoscalingv1.HorizontalPodAutoscalerList, error) {
-	result, err := c.backend.List(ctx, "horizontalpodautoscalers", namespace, opts, &autoscalingv1.HorizontalPodAutoscalerList{})
-	if err != nil {
-		return nil, err
-	}
-	return result.(*autoscalingv1.HorizontalPodAutoscalerList), nil
+	return c.horizontalPodAutoscalerClient.List(ctx, namespace, opts)
 }
 
 func (c *AutoscalingV1) WatchHorizontalPodAutoscaler(ctx context.Context, namespace string, opts metav1.ListOptions) (watch.Interface, error) {
-	return c.backend.Watch(ctx, schema.GroupVersionResource{Group: ".autoscaling", Version: "v1", Resource: "horizontalpodautoscalers"}, namespace, opts)
+	return c.horizontalPodAutoscalerClient.Watch(ctx, namespace, opts)
 }
 
-func (c *AutoscalingV1) GetScale(ctx context.Context, namespace, name string, opts metav1.GetOptions) (*autoscalingv1.Scale, error) {
-	result, err := c.backend.Get(ctx, "scales", namespace, name, opts, &autoscalingv1.Scale{})
-	if err != nil {
-		return nil, err
-	}
-	return result.(*autoscalingv1.Scale), nil
+// Scales returns the client of Scale.
+func (c *AutoscalingV1) Scales() *typedclient.ResourceClient[*autoscalingv1.Scale, *autoscalingv1.ScaleList] {
+	return c.scaleClient
+}
+
+func (c *AutoscalingV1) GetScale(ctx context.Context, namespace string, name string, opts metav1.GetOptions) (*autoscalingv1.Scale, error) {
+	return c.scaleClient.Get(ctx, namespace, name, opts)
 }
 
 func (c *AutoscalingV1) CreateScale(ctx context.Context, v *autoscalingv1.Scale, opts metav1.CreateOptions) (*autoscalingv1.Scale, error) {
-	result, err := c.backend.Create(ctx, "scales", v, opts, &autoscalingv1.Scale{})
-	if err != nil {
-		return nil, err
-	}
-	return result.(*autoscalingv1.Scale), nil
+	return c.scaleClient.Create(ctx, v, opts)
 }
 
 func (c *AutoscalingV1) UpdateScale(ctx context.Context, v *autoscalingv1.Scale, opts metav1.UpdateOptions) (*autoscalingv1.Scale, error) {
-	result, err := c.backend.Update(ctx, "scales", v, opts, &autoscalingv1.Scale{})
-	if err != nil {
-		return nil, err
-	}
-	return result.(*autoscalingv1.Scale), nil
+	return c.scaleClient.Update(ctx, v, opts)
 }
 
 func (c *AutoscalingV1) UpdateStatusScale(ctx context.Context, v *autoscalingv1.Scale, opts metav1.UpdateOptions) (*autoscalingv1.Scale, error) {
-	result, err := c.backend.UpdateStatus(ctx, "scales", v, opts, &autoscalingv1.Scale{})
-	if err != nil {
-		return nil, err
-	}
-	return result.(*autoscalingv1.Scale), nil
+	return c.scaleClient.UpdateStatus(ctx, v, opts)
+}
+
+func (c *AutoscalingV1) PatchScale(ctx context.Context, namespace string, name string, pt types.PatchType, data []byte, opts metav1.PatchOptions) (*autoscalingv1.Scale, error) {
+	return c.scaleClient.Patch(ctx, namespace, name, pt, data, opts)
+}
+
+func (c *AutoscalingV1) PatchScaleStatus(ctx context.Context, namespace string, name string, pt types.PatchType, data []byte, opts metav1.PatchOptions) (*autoscalingv1.Scale, error) {
+	return c.scaleClient.Patch(ctx, namespace, name, pt, data, opts, "status")
+}
+
+func (c *AutoscalingV1) DeleteScale(ctx context.Context, namespace string, name string, opts metav1.DeleteOptions) error {
+	return c.scaleClient.Delete(ctx, namespace, name, opts)
 }
 
-func (c *AutoscalingV1) DeleteScale(ctx context.Context, namespace, name string, opts metav1.DeleteOptions) error {
-	return c.backend.Delete(ctx, schema.GroupVersionResource{Group: ".autoscaling", Version: "v1", Resource: "scales"}, namespace, name, opts)
+func (c *AutoscalingV1) DeleteCollectionScale(ctx context.Context, namespace string, opts metav1.DeleteOptions, listOpts metav1.ListOptions) error {
+	return c.scaleClient.DeleteCollection(ctx, namespace, opts, listOpts)
 }
 
 func (c *AutoscalingV1) ListScale(ctx context.Context, namespace string, opts metav1.ListOptions) (*autoscalingv1.ScaleList, error) {
-	result, err := c.backend.List(ctx, "scales", namespace, opts, &autoscalingv1.ScaleList{})
-	if err != nil {
-		return nil, err
-	}
-	return result.(*autoscalingv1.ScaleList), nil
+	return c.scaleClient.List(ctx, namespace, opts)
 }
 
 func (c *AutoscalingV1) WatchScale(ctx context.Context, namespace string, opts metav1.ListOptions) (watch.Interface, error) {
-	return c.backend.Watch(ctx, schema.GroupVersionResource{Group: ".autoscaling", Version: "v1", Resource: "scales"}, namespace, opts)
+	return c.scaleClient.Watch(ctx, namespace, opts)
 }
 
 type AutoscalingV2 struct {
 	backend Backend
 	config  *rest.Config
+
+	horizontalPodAutoscalerClient *typedclient.ResourceClient[*autoscalingv2.HorizontalPodAutoscaler, *autoscalingv2.HorizontalPodAutoscalerList]
 }
 
 func NewAutoscalingV2Client(b Backend, config *rest.Config) *AutoscalingV2 {
-	return &AutoscalingV2{backend: b, config: config}
+	return &AutoscalingV2{
+		backend:                       b,
+		config:                        config,
+		horizontalPodAutoscalerClient: typedclient.NewResourceClient[*autoscalingv2.HorizontalPodAutoscaler, *autoscalingv2.HorizontalPodAutoscalerList](b, schema.GroupVersionResource{Group: "autoscaling", Version: "v2", Resource: "horizontalpodautoscalers"}),
+	}
 }
 
-func (c *AutoscalingV2) GetHorizontalPodAutoscaler(ctx context.Context, namespace, name string, opts metav1.GetOptions) (*autoscalingv2.HorizontalPodAutoscaler, error) {
-	result, err := c.backend.Get(ctx, "horizontalpodautoscalers", namespace, name, opts, &autoscalingv2.HorizontalPodAutoscaler{})
-	if err != nil {
-		return nil, err
-	}
-	return result.(*autoscalingv2.HorizontalPodAutoscaler), nil
+// HorizontalPodAutoscalers returns the client of HorizontalPodAutoscaler.
+func (c *AutoscalingV2) HorizontalPodAutoscalers() *typedclient.ResourceClient[*autoscalingv2.HorizontalPodAutoscaler, *autoscalingv2.HorizontalPodAutoscalerList] {
+	return c.horizontalPodAutoscalerClient
+}
+
+func (c *AutoscalingV2) GetHorizontalPodAutoscaler(ctx context.Context, namespace string, name string, opts metav1.GetOptions) (*autoscalingv2.HorizontalPodAutoscaler, error) {
+	return c.horizontalPodAutoscalerClient.Get(ctx, namespace, name, opts)
 }
 
 func (c *AutoscalingV2) CreateHorizontalPodAutoscaler(ctx context.Context, v *autoscalingv2.HorizontalPodAutoscaler, opts metav1.CreateOptions) (*autoscalingv2.HorizontalPodAutoscaler, error) {
-	result, err := c.backend.Create(ctx, "horizontalpodautoscalers", v, opts, &autoscalingv2.HorizontalPodAutoscaler{})
-	if err != nil {
-		return nil, err
-	}
-	return result.(*autoscalingv2.HorizontalPodAutoscaler), nil
+	return c.horizontalPodAutoscalerClient.Create(ctx, v, opts)
 }
 
 func (c *AutoscalingV2) UpdateHorizontalPodAutoscaler(ctx context.Context, v *autoscalingv2.HorizontalPodAutoscaler, opts metav1.UpdateOptions) (*autoscalingv2.HorizontalPodAutoscaler, error) {
-	result, err := c.backend.Update(ctx, "horizontalpodautoscalers", v, opts, &autoscalingv2.HorizontalPodAutoscaler{})
-	if err != nil {
-		return nil, err
-	}
-	return result.(*autoscalingv2.HorizontalPodAutoscaler), nil
+	return c.horizontalPodAutoscalerClient.Update(ctx, v, opts)
 }
 
 func (c *AutoscalingV2) UpdateStatusHorizontalPodAutoscaler(ctx context.Context, v *autoscalingv2.HorizontalPodAutoscaler, opts metav1.UpdateOptions) (*autoscalingv2.HorizontalPodAutoscaler, error) {
-	result, err := c.backend.UpdateStatus(ctx, "horizontalpodautoscalers", v, opts, &autoscalingv2.HorizontalPodAutoscaler{})
-	if err != nil {
-		return nil, err
-	}
-	return result.(*autoscalingv2.HorizontalPodAutoscaler), nil
+	return c.horizontalPodAutoscalerClient.UpdateStatus(ctx, v, opts)
+}
+
+func (c *AutoscalingV2) PatchHorizontalPodAutoscaler(ctx context.Context, namespace string, name string, pt types.PatchType, data []byte, opts metav1.PatchOptions) (*autoscalingv2.HorizontalPodAutoscaler, error) {
+	return c.horizontalPodAutoscalerClient.Patch(ctx, namespace, name, pt, data, opts)
+}
+
+func (c *AutoscalingV2) PatchHorizontalPodAutoscalerStatus(ctx context.Context, namespace string, name string, pt types.PatchType, data []byte, opts metav1.PatchOptions) (*autoscalingv2.HorizontalPodAutoscaler, error) {
+	return c.horizontalPodAutoscalerClient.Patch(ctx, namespace, name, pt, data, opts, "status")
 }
 
-func (c *AutoscalingV2) DeleteHorizontalPodAutoscaler(ctx context.Context, namespace, name string, opts metav1.DeleteOptions) error {
-	return c.backend.Delete(ctx, schema.GroupVersionResource{Group: ".autoscaling", Version: "v2", Resource: "horizontalpodautoscalers"}, namespace, name, opts)
+func (c *AutoscalingV2) DeleteHorizontalPodAutoscaler(ctx context.Context, namespace string, name string, opts metav1.DeleteOptions) error {
+	return c.horizontalPodAutoscalerClient.Delete(ctx, namespace, name, opts)
+}
+
+func (c *AutoscalingV2) DeleteCollectionHorizontalPodAutoscaler(ctx context.Context, namespace string, opts metav1.DeleteOptions, listOpts metav1.ListOptions) error {
+	return c.horizontalPodAutoscalerClient.DeleteCollection(ctx, namespace, opts, listOpts)
 }
 
 func (c *AutoscalingV2) ListHorizontalPodAutoscaler(ctx context.Context, namespace string, opts metav1.ListOptions) (*autoscalingv2.HorizontalPodAutoscalerList, error) {
-	result, err := c.backend.List(ctx, "horizontalpodautoscalers", namespace, opts, &autoscalingv2.HorizontalPodAutoscalerList{})
-	if err != nil {
-		return nil, err
-	}
-	return result.(*autoscalingv2.HorizontalPodAutoscalerList), nil
+	return c.horizontalPodAutoscalerClient.List(ctx, namespace, opts)
 }
 
 func (c *AutoscalingV2) WatchHorizontalPodAutoscaler(ctx context.Context, namespace string, opts metav1.ListOptions) (watch.Interface, error) {
-	return c.backend.Watch(ctx, schema.GroupVersionResource{Group: ".autoscaling", Version: "v2", Resource: "horizontalpodautoscalers"}, namespace, opts)
+	return c.horizontalPodAutoscalerClient.Watch(ctx, namespace, opts)
 }
 
 type BatchV1 struct {
 	backend Backend
 	config  *rest.Config
+
+	cronJobClient *typedclient.ResourceClient[*batchv1.CronJob, *batchv1.CronJobList]
+	jobClient     *typedclient.ResourceClient[*batchv1.Job, *batchv1.JobList]
 }
 
 func NewBatchV1Client(b Backend, config *rest.Config) *BatchV1 {
-	return &BatchV1{backend: b, config: config}
+	return &BatchV1{
+		backend:       b,
+		config:        config,
+		cronJobClient: typedclient.NewResourceClient[*batchv1.CronJob, *batchv1.CronJobList](b, schema.GroupVersionResource{Group: "batch", Version: "v1", Resource: "cronjobs"}),
+		jobClient:     typedclient.NewResourceClient[*batchv1.Job, *batchv1.JobList](b, schema.GroupVersionResource{Group: "batch", Version: "v1", Resource: "jobs"}),
+	}
 }
 
-func (c *BatchV1) GetCronJob(ctx context.Context, namespace, name string, opts metav1.GetOptions) (*batchv1.CronJob, error) {
-	result, err := c.backend.Get(ctx, "cronjobs", namespace, name, opts, &batchv1.CronJob{})
-	if err != nil {
-		return nil, err
-	}
-	return result.(*batchv1.CronJob), nil
+// CronJobs returns the client of CronJob.
+func (c *BatchV1) CronJobs() *typedclient.ResourceClient[*batchv1.CronJob, *batchv1.CronJobList] {
+	return c.cronJobClient
+}
+
+func (c *BatchV1) GetCronJob(ctx context.Context, namespace string, name string, opts metav1.GetOptions) (*batchv1.CronJob, error) {
+	return c.cronJobClient.Get(ctx, namespace, name, opts)
 }
 
 func (c *BatchV1) CreateCronJob(ctx context.Context, v *batchv1.CronJob, opts metav1.CreateOptions) (*batchv1.CronJob, error) {
-	result, err := c.backend.Create(ctx, "cronjobs", v, opts, &batchv1.CronJob{})
-	if err != nil {
-		return nil, err
-	}
-	return result.(*batchv1.CronJob), nil
+	return c.cronJobClient.Create(ctx, v, opts)
 }
 
 func (c *BatchV1) UpdateCronJob(ctx context.Context, v *batchv1.CronJob, opts metav1.UpdateOptions) (*batchv1.CronJob, error) {
-	result, err := c.backend.Update(ctx, "cronjobs", v, opts, &batchv1.CronJob{})
-	if err != nil {
-		return nil, err
-	}
-	return result.(*batchv1.CronJob), nil
+	return c.cronJobClient.Update(ctx, v, opts)
 }
 
 func (c *BatchV1) UpdateStatusCronJob(ctx context.Context, v *batchv1.CronJob, opts metav1.UpdateOptions) (*batchv1.CronJob, error) {
-	result, err := c.backend.UpdateStatus(ctx, "cronjobs", v, opts, &batchv1.CronJob{})
-	if err != nil {
-		return nil, err
-	}
-	return result.(*batchv1.CronJob), nil
+	return c.cronJobClient.UpdateStatus(ctx, v, opts)
+}
+
+func (c *BatchV1) PatchCronJob(ctx context.Context, namespace string, name string, pt types.PatchType, data []byte, opts metav1.PatchOptions) (*batchv1.CronJob, error) {
+	return c.cronJobClient.Patch(ctx, namespace, name, pt, data, opts)
+}
+
+func (c *BatchV1) PatchCronJobStatus(ctx context.Context, namespace string, name string, pt types.PatchType, data []byte, opts metav1.PatchOptions) (*batchv1.CronJob, error) {
+	return c.cronJobClient.Patch(ctx, namespace, name, pt, data, opts, "status")
+}
+
+func (c *BatchV1) DeleteCronJob(ctx context.Context, namespace string, name string, opts metav1.DeleteOptions) error {
+	return c.cronJobClient.Delete(ctx, namespace, name, opts)
 }
 
-func (c *BatchV1) DeleteCronJob(ctx context.Context, namespace, name string, opts metav1.DeleteOptions) error {
-	return c.backend.Delete(ctx, schema.GroupVersionResource{Group: ".batch", Version: "v1", Resource: "cronjobs"}, namespace, name, opts)
+func (c *BatchV1) DeleteCollectionCronJob(ctx context.Context, namespace string, opts metav1.DeleteOptions, listOpts metav1.ListOptions) error {
+	return c.cronJobClient.DeleteCollection(ctx, namespace, opts, listOpts)
 }
 
 func (c *BatchV1) ListCronJob(ctx context.Context, namespace string, opts metav1.ListOptions) (*batchv1.CronJobList, error) {
-	result, err := c.backend.List(ctx, "cronjobs", namespace, opts, &batchv1.CronJobList{})
-	if err != nil {
-		return nil, err
-	}
-	return result.(*batchv1.CronJobList), nil
+	return c.cronJobClient.List(ctx, namespace, opts)
 }
 
 func (c *BatchV1) WatchCronJob(ctx context.Context, namespace string, opts metav1.ListOptions) (watch.Interface, error) {
-	return c.backend.Watch(ctx, schema.GroupVersionResource{Group: ".batch", Version: "v1", Resource: "cronjobs"}, namespace, opts)
+	return c.cronJobClient.Watch(ctx, namespace, opts)
 }
 
-func (c *BatchV1) GetJob(ctx context.Context, namespace, name string, opts metav1.GetOptions) (*batchv1.Job, error) {
-	result, err := c.backend.Get(ctx, "jobs", namespace, name, opts, &batchv1.Job{})
-	if err != nil {
-		return nil, err
-	}
-	return result.(*batchv1.Job), nil
+// Jobs returns the client of Job.
+func (c *BatchV1) Jobs() *typedclient.ResourceClient[*batchv1.Job, *batchv1.JobList] {
+	return c.jobClient
+}
+
+func (c *BatchV1) GetJob(ctx context.Context, namespace string, name string, opts metav1.GetOptions) (*batchv1.Job, error) {
+	return c.jobClient.Get(ctx, namespace, name, opts)
 }
 
 func (c *BatchV1) CreateJob(ctx context.Context, v *batchv1.Job, opts metav1.CreateOptions) (*batchv1.Job, error) {
-	result, err := c.backend.Create(ctx, "jobs", v, opts, &batchv1.Job{})
-	if err != nil {
-		return nil, err
-	}
-	return result.(*batchv1.Job), nil
+	return c.jobClient.Create(ctx, v, opts)
 }
 
 func (c *BatchV1) UpdateJob(ctx context.Context, v *batchv1.Job, opts metav1.UpdateOptions) (*batchv1.Job, error) {
-	result, err := c.backend.Update(ctx, "jobs", v, opts, &batchv1.Job{})
-	if err != nil {
-		return nil, err
-	}
-	return result.(*batchv1.Job), nil
+	return c.jobClient.Update(ctx, v, opts)
 }
 
 func (c *BatchV1) UpdateStatusJob(ctx context.Context, v *batchv1.Job, opts metav1.UpdateOptions) (*batchv1.Job, error) {
-	result, err := c.backend.UpdateStatus(ctx, "jobs", v, opts, &batchv1.Job{})
-	if err != nil {
-		return nil, err
-	}
-	return result.(*batchv1.Job), nil
+	return c.jobClient.UpdateStatus(ctx, v, opts)
+}
+
+func (c *BatchV1) PatchJob(ctx context.Context, namespace string, name string, pt types.PatchType, data []byte, opts metav1.PatchOptions) (*batchv1.Job, error) {
+	return c.jobClient.Patch(ctx, namespace, name, pt, data, opts)
+}
+
+func (c *BatchV1) PatchJobStatus(ctx context.Context, namespace string, name string, pt types.PatchType, data []byte, opts metav1.PatchOptions) (*batchv1.Job, error) {
+	return c.jobClient.Patch(ctx, namespace, name, pt, data, opts, "status")
 }
 
-func (c *BatchV1) DeleteJob(ctx context.Context, namespace, name string, opts metav1.DeleteOptions) error {
-	return c.backend.Delete(ctx, schema.GroupVersionResource{Group: ".batch", Version: "v1", Resource: "jobs"}, namespace, name, opts)
+func (c *BatchV1) DeleteJob(ctx context.Context, namespace string, name string, opts metav1.DeleteOptions) error {
+	return c.jobClient.Delete(ctx, namespace, name, opts)
+}
+
+func (c *BatchV1) DeleteCollectionJob(ctx context.Context, namespace string, opts metav1.DeleteOptions, listOpts metav1.ListOptions) error {
+	return c.jobClient.DeleteCollection(ctx, namespace, opts, listOpts)
 }
 
 func (c *BatchV1) ListJob(ctx context.Context, namespace string, opts metav1.ListOptions) (*batchv1.JobList, error) {
-	result, err := c.backend.List(ctx, "jobs", namespace, opts, &batchv1.JobList{})
-	if err != nil {
-		return nil, err
-	}
-	return result.(*batchv1.JobList), nil
+	return c.jobClient.List(ctx, namespace, opts)
 }
 
 func (c *BatchV1) WatchJob(ctx context.Context, namespace string, opts metav1.ListOptions) (watch.Interface, error) {
-	return c.backend.Watch(ctx, schema.GroupVersionResource{Group: ".batch", Version: "v1", Resource: "jobs"}, namespace, opts)
+	return c.jobClient.Watch(ctx, namespace, opts)
 }
 
 type CertificatesK8sIoV1 struct {
 	backend Backend
 	config  *rest.Config
+
+	certificateSigningRequestClient *typedclient.ClusterResourceClient[*certificatesv1.CertificateSigningRequest, *certificatesv1.CertificateSigningRequestList]
 }
 
 func NewCertificatesK8sIoV1Client(b Backend, config *rest.Config) *CertificatesK8sIoV1 {
-	return &CertificatesK8sIoV1{backend: b, config: config}
+	return &CertificatesK8sIoV1{
+		backend:                         b,
+		config:                          config,
+		certificateSigningRequestClient: typedclient.NewClusterResourceClient[*certificatesv1.CertificateSigningRequest, *certificatesv1.CertificateSigningRequestList](b, schema.GroupVersionResource{Group: "certificates.k8s.io", Version: "v1", Resource: "certificatesigningrequests"}),
+	}
+}
+
+// CertificateSigningRequests returns the client of CertificateSigningRequest.
+func (c *CertificatesK8sIoV1) CertificateSigningRequests() *typedclient.ClusterResourceClient[*certificatesv1.CertificateSigningRequest, *certificatesv1.CertificateSigningRequestList] {
+	return c.certificateSigningRequestClient
 }
 
 func (c *CertificatesK8sIoV1) GetCertificateSigningRequest(ctx context.Context, name string, opts metav1.GetOptions) (*certificatesv1.CertificateSigningRequest, error) {
-	result, err := c.backend.GetClusterScoped(ctx, "certificatesigningrequests", name, opts, &certificatesv1.CertificateSigningRequest{})
-	if err != nil {
-		return nil, err
-	}
-	return result.(*certificatesv1.CertificateSigningRequest), nil
+	return c.certificateSigningRequestClient.Get(ctx, name, opts)
 }
 
 func (c *CertificatesK8sIoV1) CreateCertificateSigningRequest(ctx context.Context, v *certificatesv1.CertificateSigningRequest, opts metav1.CreateOptions) (*certificatesv1.CertificateSigningRequest, error) {
-	result, err := c.backend.CreateClusterScoped(ctx, "certificatesigningrequests", v, opts, &certificatesv1.CertificateSigningRequest{})
-	if err != nil {
-		return nil, err
-	}
-	return result.(*certificatesv1.CertificateSigningRequest), nil
+	return c.certificateSigningRequestClient.Create(ctx, v, opts)
 }
 
 func (c *CertificatesK8sIoV1) UpdateCertificateSigningRequest(ctx context.Context, v *certificatesv1.CertificateSigningRequest, opts metav1.UpdateOptions) (*certificatesv1.CertificateSigningRequest, error) {
-	result, err := c.backend.UpdateClusterScoped(ctx, "certificatesigningrequests", v, opts, &certificatesv1.CertificateSigningRequest{})
-	if err != nil {
-		return nil, err
-	}
-	return result.(*certificatesv1.CertificateSigningRequest), nil
+	return c.certificateSigningRequestClient.Update(ctx, v, opts)
 }
 
 func (c *CertificatesK8sIoV1) UpdateStatusCertificateSigningRequest(ctx context.Context, v *certificatesv1.CertificateSigningRequest, opts metav1.UpdateOptions) (*certificatesv1.CertificateSigningRequest, error) {
-	result, err := c.backend.UpdateStatusClusterScoped(ctx, "certificatesigningrequests", v, opts, &certificatesv1.CertificateSigningRequest{})
-	if err != nil {
-		return nil, err
-	}
-	return result.(*certificatesv1.CertificateSigningRequest), nil
+	return c.certificateSigningRequestClient.UpdateStatus(ctx, v, opts)
+}
+
+func (c *CertificatesK8sIoV1) PatchCertificateSigningRequest(ctx context.Context, name string, pt types.PatchType, data []byte, opts metav1.PatchOptions) (*certificatesv1.CertificateSigningRequest, error) {
+	return c.certificateSigningRequestClient.Patch(ctx, name, pt, data, opts)
+}
+
+func (c *CertificatesK8sIoV1) PatchCertificateSigningRequestStatus(ctx context.Context, name string, pt types.PatchType, data []byte, opts metav1.PatchOptions) (*certificatesv1.CertificateSigningRequest, error) {
+	return c.certificateSigningRequestClient.Patch(ctx, name, pt, data, opts, "status")
 }
 
 func (c *CertificatesK8sIoV1) DeleteCertificateSigningRequest(ctx context.Context, name string, opts metav1.DeleteOptions) error {
-	return c.backend.DeleteClusterScoped(ctx, schema.GroupVersionResource{Group: ".certificates.k8s.io", Version: "v1", Resource: "certificatesigningrequests"}, name, opts)
+	return c.certificateSigningRequestClient.Delete(ctx, name, opts)
+}
+
+func (c *CertificatesK8sIoV1) DeleteCollectionCertificateSigningRequest(ctx context.Context, opts metav1.DeleteOptions, listOpts metav1.ListOptions) error {
+	return c.certificateSigningRequestClient.DeleteCollection(ctx, opts, listOpts)
 }
 
 func (c *CertificatesK8sIoV1) ListCertificateSigningRequest(ctx context.Context, opts metav1.ListOptions) (*certificatesv1.CertificateSigningRequestList, error) {
-	result, err := c.backend.ListClusterScoped(ctx, "certificatesigningrequests", opts, &certificatesv1.CertificateSigningRequestList{})
-	if err != nil {
-		return nil, err
-	}
-	return result.(*certificatesv1.CertificateSigningRequestList), nil
+	return c.certificateSigningRequestClient.List(ctx, opts)
 }
 
 func (c *CertificatesK8sIoV1) WatchCertificateSigningRequest(ctx context.Context, opts metav1.ListOptions) (watch.Interface, error) {
-	return c.backend.WatchClusterScoped(ctx, schema.GroupVersionResource{Group: ".certificates.k8s.io", Version: "v1", Resource: "certificatesigningrequests"}, opts)
+	return c.certificateSigningRequestClient.Watch(ctx, opts)
+}
+
+func (c *CertificatesK8sIoV1) UpdateCertificateSigningRequestApproval(ctx context.Context, name string, v *certificatesv1.CertificateSigningRequest, opts metav1.UpdateOptions) (*certificatesv1.CertificateSigningRequest, error) {
+	result, err := c.certificateSigningRequestClient.SubResource(ctx, "PUT", name, "approval", v, &opts, &certificatesv1.CertificateSigningRequest{})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*certificatesv1.CertificateSigningRequest), nil
 }
 
 type CoordinationK8sIoV1 struct {
 	backend Backend
 	config  *rest.Config
+
+	leaseClient *typedclient.ResourceClient[*coordinationv1.Lease, *coordinationv1.LeaseList]
 }
 
 func NewCoordinationK8sIoV1Client(b Backend, config *rest.Config) *CoordinationK8sIoV1 {
-	return &CoordinationK8sIoV1{backend: b, config: config}
+	return &CoordinationK8sIoV1{
+		backend:     b,
+		config:      config,
+		leaseClient: typedclient.NewResourceClient[*coordinationv1.Lease, *coordinationv1.LeaseList](b, schema.GroupVersionResource{Group: "coordination.k8s.io", Version: "v1", Resource: "leases"}),
+	}
 }
 
-func (c *CoordinationK8sIoV1) GetLease(ctx context.Context, namespace, name string, opts metav1.GetOptions) (*coordinationv1.Lease, error) {
-	result, err := c.backend.Get(ctx, "leases", namespace, name, opts, &coordinationv1.Lease{})
-	if err != nil {
-		return nil, err
-	}
-	return result.(*coordinationv1.Lease), nil
+// Leases returns the client of Lease.
+func (c *CoordinationK8sIoV1) Leases() *typedclient.ResourceClient[*coordinationv1.Lease, *coordinationv1.LeaseList] {
+	return c.leaseClient
+}
+
+func (c *CoordinationK8sIoV1) GetLease(ctx context.Context, namespace string, name string, opts metav1.GetOptions) (*coordinationv1.Lease, error) {
+	return c.leaseClient.Get(ctx, namespace, name, opts)
 }
 
 func (c *CoordinationK8sIoV1) CreateLease(ctx context.Context, v *coordinationv1.Lease, opts metav1.CreateOptions) (*coordinationv1.Lease, error) {
-	result, err := c.backend.Create(ctx, "leases", v, opts, &coordinationv1.Lease{})
-	if err != nil {
-		return nil, err
-	}
-	return result.(*coordinationv1.Lease), nil
+	return c.leaseClient.Create(ctx, v, opts)
 }
 
 func (c *CoordinationK8sIoV1) UpdateLease(ctx context.Context, v *coordinationv1.Lease, opts metav1.UpdateOptions) (*coordinationv1.Lease, error) {
-	result, err := c.backend.Update(ctx, "leases", v, opts, &coordinationv1.Lease{})
-	if err != nil {
-		return nil, err
-	}
-	return result.(*coordinationv1.Lease), nil
+	return c.leaseClient.Update(ctx, v, opts)
+}
+
+func (c *CoordinationK8sIoV1) PatchLease(ctx context.Context, namespace string, name string, pt types.PatchType, data []byte, opts metav1.PatchOptions) (*coordinationv1.Lease, error) {
+	return c.leaseClient.Patch(ctx, namespace, name, pt, data, opts)
+}
+
+func (c *CoordinationK8sIoV1) DeleteLease(ctx context.Context, namespace string, name string, opts metav1.DeleteOptions) error {
+	return c.leaseClient.Delete(ctx, namespace, name, opts)
 }
 
-func (c *CoordinationK8sIoV1) DeleteLease(ctx context.Context, namespace, name string, opts metav1.DeleteOptions) error {
-	return c.backend.Delete(ctx, schema.GroupVersionResource{Group: ".coordination.k8s.io", Version: "v1", Resource: "leases"}, namespace, name, opts)
+func (c *CoordinationK8sIoV1) DeleteCollectionLease(ctx context.Context, namespace string, opts metav1.DeleteOptions, listOpts metav1.ListOptions) error {
+	return c.leaseClient.DeleteCollection(ctx, namespace, opts, listOpts)
 }
 
 func (c *CoordinationK8sIoV1) ListLease(ctx context.Context, namespace string, opts metav1.ListOptions) (*coordinationv1.LeaseList, error) {
-	result, err := c.backend.List(ctx, "leases", namespace, opts, &coordinationv1.LeaseList{})
-	if err != nil {
-		return nil, err
-	}
-	return result.(*coordinationv1.LeaseList), nil
+	return c.leaseClient.List(ctx, namespace, opts)
 }
 
 func (c *CoordinationK8sIoV1) WatchLease(ctx context.Context, namespace string, opts metav1.ListOptions) (watch.Interface, error) {
-	return c.backend.Watch(ctx, schema.GroupVersionResource{Group: ".coordination.k8s.io", Version: "v1", Resource: "leases"}, namespace, opts)
+	return c.leaseClient.Watch(ctx, namespace, opts)
 }
 
 type DiscoveryK8sIoV1 struct {
 	backend Backend
 	config  *rest.Config
+
+	endpointSliceClient *typedclient.ResourceClient[*discoveryv1.EndpointSlice, *discoveryv1.EndpointSliceList]
 }
 
 func NewDiscoveryK8sIoV1Client(b Backend, config *rest.Config) *DiscoveryK8sIoV1 {
-	return &DiscoveryK8sIoV1{backend: b, config: config}
+	return &DiscoveryK8sIoV1{
+		backend:             b,
+		config:              config,
+		endpointSliceClient: typedclient.NewResourceClient[*discoveryv1.EndpointSlice, *discoveryv1.EndpointSliceList](b, schema.GroupVersionResource{Group: "discovery.k8s.io", Version: "v1", Resource: "endpointslices"}),
+	}
 }
 
-func (c *DiscoveryK8sIoV1) GetEndpointSlice(ctx context.Context, namespace, name string, opts metav1.GetOptions) (*discoveryv1.EndpointSlice, error) {
-	result, err := c.backend.Get(ctx, "endpointslices", namespace, name, opts, &discoveryv1.EndpointSlice{})
-	if err != nil {
-		return nil, err
-	}
-	return result.(*discoveryv1.EndpointSlice), nil
+// EndpointSlices returns the client of EndpointSlice.
+func (c *DiscoveryK8sIoV1) EndpointSlices() *typedclient.ResourceClient[*discoveryv1.EndpointSlice, *discoveryv1.EndpointSliceList] {
+	return c.endpointSliceClient
+}
+
+func (c *DiscoveryK8sIoV1) GetEndpointSlice(ctx context.Context, namespace string, name string, opts metav1.GetOptions) (*discoveryv1.EndpointSlice, error) {
+	return c.endpointSliceClient.Get(ctx, namespace, name, opts)
 }
 
 func (c *DiscoveryK8sIoV1) CreateEndpointSlice(ctx context.Context, v *discoveryv1.EndpointSlice, opts metav1.CreateOptions) (*discoveryv1.EndpointSlice, error) {
-	result, err := c.backend.Create(ctx, "endpointslices", v, opts, &discoveryv1.EndpointSlice{})
-	if err != nil {
-		return nil, err
-	}
-	return result.(*discoveryv1.EndpointSlice), nil
+	return c.endpointSliceClient.Create(ctx, v, opts)
 }
 
 func (c *DiscoveryK8sIoV1) UpdateEndpointSlice(ctx context.Context, v *discoveryv1.EndpointSlice, opts metav1.UpdateOptions) (*discoveryv1.EndpointSlice, error) {
-	result, err := c.backend.Update(ctx, "endpointslices", v, opts, &discoveryv1.EndpointSlice{})
-	if err != nil {
-		return nil, err
-	}
-	return result.(*discoveryv1.EndpointSlice), nil
+	return c.endpointSliceClient.Update(ctx, v, opts)
+}
+
+func (c *DiscoveryK8sIoV1) PatchEndpointSlice(ctx context.Context, namespace string, name string, pt types.PatchType, data []byte, opts metav1.PatchOptions) (*discoveryv1.EndpointSlice, error) {
+	return c.endpointSliceClient.Patch(ctx, namespace, name, pt, data, opts)
+}
+
+func (c *DiscoveryK8sIoV1) DeleteEndpointSlice(ctx context.Context, namespace string, name string, opts metav1.DeleteOptions) error {
+	return c.endpointSliceClient.Delete(ctx, namespace, name, opts)
 }
 
-func (c *DiscoveryK8sIoV1) DeleteEndpointSlice(ctx context.Context, namespace, name string, opts metav1.DeleteOptions) error {
-	return c.backend.Delete(ctx, schema.GroupVersionResource{Group: ".discovery.k8s.io", Version: "v1", Resource: "endpointslices"}, namespace, name, opts)
+func (c *DiscoveryK8sIoV1) DeleteCollectionEndpointSlice(ctx context.Context, namespace string, opts metav1.DeleteOptions, listOpts metav1.ListOptions) error {
+	return c.endpointSliceClient.DeleteCollection(ctx, namespace, opts, listOpts)
 }
 
 func (c *DiscoveryK8sIoV1) ListEndpointSlice(ctx context.Context, namespace string, opts metav1.ListOptions) (*discoveryv1.EndpointSliceList, error) {
-	result, err := c.backend.List(ctx, "endpointslices", namespace, opts, &discoveryv1.EndpointSliceList{})
-	if err != nil {
-		return nil, err
-	}
-	return result.(*discoveryv1.EndpointSliceList), nil
+	return c.endpointSliceClient.List(ctx, namespace, opts)
 }
 
 func (c *DiscoveryK8sIoV1) WatchEndpointSlice(ctx context.Context, namespace string, opts metav1.ListOptions) (watch.Interface, error) {
-	return c.backend.Watch(ctx, schema.GroupVersionResource{Group: ".discovery.k8s.io", Version: "v1", Resource: "endpointslices"}, namespace, opts)
+	return c.endpointSliceClient.Watch(ctx, namespace, opts)
 }
 
 type EventsK8sIoV1 struct {
 	backend Backend
 	config  *rest.Config
+
+	eventClient *typedclient.ResourceClient[*eventsv1.Event, *eventsv1.EventList]
 }
 
 func NewEventsK8sIoV1Client(b Backend, config *rest.Config) *EventsK8sIoV1 {
-	return &EventsK8sIoV1{backend: b, config: config}
+	return &EventsK8sIoV1{
+		backend:     b,
+		config:      config,
+		eventClient: typedclient.NewResourceClient[*eventsv1.Event, *eventsv1.EventList](b, schema.GroupVersionResource{Group: "events.k8s.io", Version: "v1", Resource: "events"}),
+	}
 }
 
-func (c *EventsK8sIoV1) GetEvent(ctx context.Context, namespace, name string, opts metav1.GetOptions) (*eventsv1.Event, error) {
-	result, err := c.backend.Get(ctx, "events", namespace, name, opts, &eventsv1.Event{})
-	if err != nil {
-		return nil, err
-	}
-	return result.(*eventsv1.Event), nil
+// Events returns the client of Event.
+func (c *EventsK8sIoV1) Events() *typedclient.ResourceClient[*eventsv1.Event, *eventsv1.EventList] {
+	return c.eventClient
+}
+
+func (c *EventsK8sIoV1) GetEvent(ctx context.Context, namespace string, name string, opts metav1.GetOptions) (*eventsv1.Event, error) {
+	return c.eventClient.Get(ctx, namespace, name, opts)
 }
 
 func (c *EventsK8sIoV1) CreateEvent(ctx context.Context, v *eventsv1.Event, opts metav1.CreateOptions) (*eventsv1.Event, error) {
-	result, err := c.backend.Create(ctx, "events", v, opts, &eventsv1.Event{})
-	if err != nil {
-		return nil, err
-	}
-	return result.(*eventsv1.Event), nil
+	return c.eventClient.Create(ctx, v, opts)
 }
 
 func (c *EventsK8sIoV1) UpdateEvent(ctx context.Context, v *eventsv1.Event, opts metav1.UpdateOptions) (*eventsv1.Event, error) {
-	result, err := c.backend.Update(ctx, "events", v, opts, &eventsv1.Event{})
-	if err != nil {
-		return nil, err
-	}
-	return result.(*eventsv1.Event), nil
+	return c.eventClient.Update(ctx, v, opts)
+}
+
+func (c *EventsK8sIoV1) PatchEvent(ctx context.Context, namespace string, name string, pt types.PatchType, data []byte, opts metav1.PatchOptions) (*eventsv1.Event, error) {
+	return c.eventClient.Patch(ctx, namespace, name, pt, data, opts)
+}
+
+func (c *EventsK8sIoV1) DeleteEvent(ctx context.Context, namespace string, name string, opts metav1.DeleteOptions) error {
+	return c.eventClient.Delete(ctx, namespace, name, opts)
 }
 
-func (c *EventsK8sIoV1) DeleteEvent(ctx context.Context, namespace, name string, opts metav1.DeleteOptions) error {
-	return c.backend.Delete(ctx, schema.GroupVersionResource{Group: ".events.k8s.io", Version: "v1", Resource: "events"}, namespace, name, opts)
+func (c *EventsK8sIoV1) DeleteCollectionEvent(ctx context.Context, namespace string, opts metav1.DeleteOptions, listOpts metav1.ListOptions) error {
+	return c.eventClient.DeleteCollection(ctx, namespace, opts, listOpts)
 }
 
 func (c *EventsK8sIoV1) ListEvent(ctx context.Context, namespace string, opts metav1.ListOptions) (*eventsv1.EventList, error) {
-	result, err := c.backend.List(ctx, "events", namespace, opts, &eventsv1.EventList{})
-	if err != nil {
-		return nil, err
-	}
-	return result.(*eventsv1.EventList), nil
+	return c.eventClient.List(ctx, namespace, opts)
 }
 
 func (c *EventsK8sIoV1) WatchEvent(ctx context.Context, namespace string, opts metav1.ListOptions) (watch.Interface, error) {
-	return c.backend.Watch(ctx, schema.GroupVersionResource{Group: ".events.k8s.io", Version: "v1", Resource: "events"}, namespace, opts)
+	return c.eventClient.Watch(ctx, namespace, opts)
 }
 
 type NetworkingK8sIoV1 struct {
 	backend Backend
 	config  *rest.Config
+
+	iPAddressClient     *typedclient.ClusterResourceClient[*networkingv1.IPAddress, *networkingv1.IPAddressList]
+	ingressClient       *typedclient.ResourceClient[*networkingv1.Ingress, *networkingv1.IngressList]
+	ingressClassClient  *typedclient.ClusterResourceClient[*networkingv1.IngressClass, *networkingv1.IngressClassList]
+	networkPolicyClient *typedclient.ResourceClient[*networkingv1.NetworkPolicy, *networkingv1.NetworkPolicyList]
+	serviceCIDRClient   *typedclient.ClusterResourceClient[*networkingv1.ServiceCIDR, *networkingv1.ServiceCIDRList]
 }
 
 func NewNetworkingK8sIoV1Client(b Backend, config *rest.Config) *NetworkingK8sIoV1 {
-	return &NetworkingK8sIoV1{backend: b, config: config}
+	return &NetworkingK8sIoV1{
+		backend:             b,
+		config:              config,
+		iPAddressClient:     typedclient.NewClusterResourceClient[*networkingv1.IPAddress, *networkingv1.IPAddressList](b, schema.GroupVersionResource{Group: "networking.k8s.io", Version: "v1", Resource: "ipaddresses"}),
+		ingressClient:       typedclient.NewResourceClient[*networkingv1.Ingress, *networkingv1.IngressList](b, schema.GroupVersionResource{Group: "networking.k8s.io", Version: "v1", Resource: "ingresses"}),
+		ingressClassClient:  typedclient.NewClusterResourceClient[*networkingv1.IngressClass, *networkingv1.IngressClassList](b, schema.GroupVersionResource{Group: "networking.k8s.io", Version: "v1", Resource: "ingressclasses"}),
+		networkPolicyClient: typedclient.NewResourceClient[*networkingv1.NetworkPolicy, *networkingv1.NetworkPolicyList](b, schema.GroupVersionResource{Group: "networking.k8s.io", Version: "v1", Resource: "networkpolicies"}),
+		serviceCIDRClient:   typedclient.NewClusterResourceClient[*networkingv1.ServiceCIDR, *networkingv1.ServiceCIDRList](b, schema.GroupVersionResource{Group: "networking.k8s.io", Version: "v1", Resource: "servicecidrs"}),
+	}
+}
+
+// IPAddresses returns the client of IPAddress.
+func (c *NetworkingK8sIoV1) IPAddresses() *typedclient.ClusterResourceClient[*networkingv1.IPAddress, *networkingv1.IPAddressList] {
+	return c.iPAddressClient
 }
 
 func (c *NetworkingK8sIoV1) GetIPAddress(ctx context.Context, name string, opts metav1.GetOptions) (*networkingv1.IPAddress, error) {
-	result, err := c.backend.GetClusterScoped(ctx, "ipaddresses", name, opts, &networkingv1.IPAddress{})
-	if err != nil {
-		return nil, err
-	}
-	return result.(*networkingv1.IPAddress), nil
+	return c.iPAddressClient.Get(ctx, name, opts)
 }
 
 func (c *NetworkingK8sIoV1) CreateIPAddress(ctx context.Context, v *networkingv1.IPAddress, opts metav1.CreateOptions) (*networkingv1.IPAddress, error) {
-	result, err := c.backend.CreateClusterScoped(ctx, "ipaddresses", v, opts, &networkingv1.IPAddress{})
-	if err != nil {
-		return nil, err
-	}
-	return result.(*networkingv1.IPAddress), nil
+	return c.iPAddressClient.Create(ctx, v, opts)
 }
 
 func (c *NetworkingK8sIoV1) UpdateIPAddress(ctx context.Context, v *networkingv1.IPAddress, opts metav1.UpdateOptions) (*networkingv1.IPAddress, error) {
-	result, err := c.backend.UpdateClusterScoped(ctx, "ipaddresses", v, opts, &networkingv1.IPAddress{})
-	if err != nil {
-		return nil, err
-	}
-	return result.(*networkingv1.IPAddress), nil
+	return c.iPAddressClient.Update(ctx, v, opts)
+}
+
+func (c *NetworkingK8sIoV1) PatchIPAddress(ctx context.Context, name string, pt types.PatchType, data []byte, opts metav1.PatchOptions) (*networkingv1.IPAddress, error) {
+	return c.iPAddressClient.Patch(ctx, name, pt, data, opts)
 }
 
 func (c *NetworkingK8sIoV1) DeleteIPAddress(ctx context.Context, name string, opts metav1.DeleteOptions) error {
-	return c.backend.DeleteClusterScoped(ctx, schema.GroupVersionResource{Group: ".networking.k8s.io", Version: "v1", Resource: "ipaddresses"}, name, opts)
+	return c.iPAddressClient.Delete(ctx, name, opts)
+}
+
+func (c *NetworkingK8sIoV1) DeleteCollectionIPAddress(ctx context.Context, opts metav1.DeleteOptions, listOpts metav1.ListOptions) error {
+	return c.iPAddressClient.DeleteCollection(ctx, opts, listOpts)
 }
 
 func (c *NetworkingK8sIoV1) ListIPAddress(ctx context.Context, opts metav1.ListOptions) (*networkingv1.IPAddressList, error) {
-	result, err := c.backend.ListClusterScoped(ctx, "ipaddresses", opts, &networkingv1.IPAddressList{})
-	if err != nil {
-		return nil, err
-	}
-	return result.(*networkingv1.IPAddressList), nil
+	return c.iPAddressClient.List(ctx, opts)
 }
 
 func (c *NetworkingK8sIoV1) WatchIPAddress(ctx context.Context, opts metav1.ListOptions) (watch.Interface, error) {
-	return c.backend.WatchClusterScoped(ctx, schema.GroupVersionResource{Group: ".networking.k8s.io", Version: "v1", Resource: "ipaddresses"}, opts)
+	return c.iPAddressClient.Watch(ctx, opts)
 }
 
-func (c *NetworkingK8sIoV1) GetIngress(ctx context.Context, namespace, name string, opts metav1.GetOptions) (*networkingv1.Ingress, error) {
-	result, err := c.backend.Get(ctx, "ingresses", namespace, name, opts, &networkingv1.Ingress{})
-	if err != nil {
-		return nil, err
-	}
-	return result.(*networkingv1.Ingress), nil
+// Ingresses returns the client of Ingress.
+func (c *NetworkingK8sIoV1) Ingresses() *typedclient.ResourceClient[*networkingv1.Ingress, *networkingv1.IngressList] {
+	return c.ingressClient
+}
+
+func (c *NetworkingK8sIoV1) GetIngress(ctx context.Context, namespace string, name string, opts metav1.GetOptions) (*networkingv1.Ingress, error) {
+	return c.ingressClient.Get(ctx, namespace, name, opts)
 }
 
 func (c *NetworkingK8sIoV1) CreateIngress(ctx context.Context, v *networkingv1.Ingress, opts metav1.CreateOptions) (*networkingv1.Ingress, error) {
-	result, err := c.backend.Create(ctx, "ingresses", v, opts, &networkingv1.Ingress{})
-	if err != nil {
-		return nil, err
-	}
-	return result.(*networkingv1.Ingress), nil
+	return c.ingressClient.Create(ctx, v, opts)
 }
 
 func (c *NetworkingK8sIoV1) UpdateIngress(ctx context.Context, v *networkingv1.Ingress, opts metav1.UpdateOptions) (*networkingv1.Ingress, error) {
-	result, err := c.backend.Update(ctx, "ingresses", v, opts, &networkingv1.Ingress{})
-	if err != nil {
-		return nil, err
-	}
-	return result.(*networkingv1.Ingress), nil
+	return c.ingressClient.Update(ctx, v, opts)
 }
 
 func (c *NetworkingK8sIoV1) UpdateStatusIngress(ctx context.Context, v *networkingv1.Ingress, opts metav1.UpdateOptions) (*networkingv1.Ingress, error) {
-	result, err := c.backend.UpdateStatus(ctx, "ingresses", v, opts, &networkingv1.Ingress{})
-	if err != nil {
-		return nil, err
-	}
-	return result.(*networkingv1.Ingress), nil
+	return c.ingressClient.UpdateStatus(ctx, v, opts)
+}
+
+func (c *NetworkingK8sIoV1) PatchIngress(ctx context.Context, namespace string, name string, pt types.PatchType, data []byte, opts metav1.PatchOptions) (*networkingv1.Ingress, error) {
+	return c.ingressClient.Patch(ctx, namespace, name, pt, data, opts)
 }
 
-func (c *NetworkingK8sIoV1) DeleteIngress(ctx context.Context, namespace, name string, opts metav1.DeleteOptions) error {
-	return c.backend.Delete(ctx, schema.GroupVersionResource{Group: ".networking.k8s.io", Version: "v1", Resource: "ingresses"}, namespace, name, opts)
+func (c *NetworkingK8sIoV1) PatchIngressStatus(ctx context.Context, namespace string, name string, pt types.PatchType, data []byte, opts metav1.PatchOptions) (*networkingv1.Ingress, error) {
+	return c.ingressClient.Patch(ctx, namespace, name, pt, data, opts, "status")
+}
+
+func (c *NetworkingK8sIoV1) DeleteIngress(ctx context.Context, namespace string, name string, opts metav1.DeleteOptions) error {
+	return c.ingressClient.Delete(ctx, namespace, name, opts)
+}
+
+func (c *NetworkingK8sIoV1) DeleteCollectionIngress(ctx context.Context, namespace string, opts metav1.DeleteOptions, listOpts metav1.ListOptions) error {
+	return c.ingressClient.DeleteCollection(ctx, namespace, opts, listOpts)
 }
 
 func (c *NetworkingK8sIoV1) ListIngress(ctx context.Context, namespace string, opts metav1.ListOptions) (*networkingv1.IngressList, error) {
-	result, err := c.backend.List(ctx, "ingresses", namespace, opts, &networkingv1.IngressList{})
-	if err != nil {
-		return nil, err
-	}
-	return result.(*networkingv1.IngressList), nil
+	return c.ingressClient.List(ctx, namespace, opts)
 }
 
 func (c *NetworkingK8sIoV1) WatchIngress(ctx context.Context, namespace string, opts metav1.ListOptions) (watch.Interface, error) {
-	return c.backend.Watch(ctx, schema.GroupVersionResource{Group: ".networking.k8s.io", Version: "v1", Resource: "ingresses"}, namespace, opts)
+	return c.ingressClient.Watch(ctx, namespace, opts)
+}
+
+// IngressClasses returns the client of IngressClass.
+func (c *NetworkingK8sIoV1) IngressClasses() *typedclient.ClusterResourceClient[*networkingv1.IngressClass, *networkingv1.IngressClassList] {
+	return c.ingressClassClient
 }
 
 func (c *NetworkingK8sIoV1) GetIngressClass(ctx context.Context, name string, opts metav1.GetOptions) (*networkingv1.IngressClass, error) {
-	result, err := c.backend.GetClusterScoped(ctx, "ingressclasses", name, opts, &networkingv1.IngressClass{})
-	if err != nil {
-		return nil, err
-	}
-	return result.(*networkingv1.IngressClass), nil
+	return c.ingressClassClient.Get(ctx, name, opts)
 }
 
 func (c *NetworkingK8sIoV1) CreateIngressClass(ctx context.Context, v *networkingv1.IngressClass, opts metav1.CreateOptions) (*networkingv1.IngressClass, error) {
-	result, err := c.backend.CreateClusterScoped(ctx, "ingressclasses", v, opts, &networkingv1.IngressClass{})
-	if err != nil {
-		return nil, err
-	}
-	return result.(*networkingv1.IngressClass), nil
+	return c.ingressClassClient.Create(ctx, v, opts)
 }
 
 func (c *NetworkingK8sIoV1) UpdateIngressClass(ctx context.Context, v *networkingv1.IngressClass, opts metav1.UpdateOptions) (*networkingv1.IngressClass, error) {
-	result, err := c.backend.UpdateClusterScoped(ctx, "ingressclasses", v, opts, &networkingv1.IngressClass{})
-	if err != nil {
-		return nil, err
-	}
-	return result.(*networkingv1.IngressClass), nil
+	return c.ingressClassClient.Update(ctx, v, opts)
+}
+
+func (c *NetworkingK8sIoV1) PatchIngressClass(ctx context.Context, name string, pt types.PatchType, data []byte, opts metav1.PatchOptions) (*networkingv1.IngressClass, error) {
+	return c.ingressClassClient.Patch(ctx, name, pt, data, opts)
 }
 
 func (c *NetworkingK8sIoV1) DeleteIngressClass(ctx context.Context, name string, opts metav1.DeleteOptions) error {
-	return c.backend.DeleteClusterScoped(ctx, schema.GroupVersionResource{Group: ".networking.k8s.io", Version: "v1", Resource: "ingressclasses"}, name, opts)
+	return c.ingressClassClient.Delete(ctx, name, opts)
+}
+
+func (c *NetworkingK8sIoV1) DeleteCollectionIngressClass(ctx context.Context, opts metav1.DeleteOptions, listOpts metav1.ListOptions) error {
+	return c.ingressClassClient.DeleteCollection(ctx, opts, listOpts)
 }
 
 func (c *NetworkingK8sIoV1) ListIngressClass(ctx context.Context, opts metav1.ListOptions) (*networkingv1.IngressClassList, error) {
-	result, err := c.backend.ListClusterScoped(ctx, "ingressclasses", opts, &networkingv1.IngressClassList{})
-	if err != nil {
-		return nil, err
-	}
-	return result.(*networkingv1.IngressClassList), nil
+	return c.ingressClassClient.List(ctx, opts)
 }
 
 func (c *NetworkingK8sIoV1) WatchIngressClass(ctx context.Context, opts metav1.ListOptions) (watch.Interface, error) {
-	return c.backend.WatchClusterScoped(ctx, schema.GroupVersionResource{Group: ".networking.k8s.io", Version: "v1", Resource: "ingressclasses"}, opts)
+	return c.ingressClassClient.Watch(ctx, opts)
 }
 
-func (c *NetworkingK8sIoV1) GetNetworkPolicy(ctx context.Context, namespace, name string, opts metav1.GetOptions) (*networkingv1.NetworkPolicy, error) {
-	result, err := c.backend.Get(ctx, "networkpolicies", namespace, name, opts, &networkingv1.NetworkPolicy{})
-	if err != nil {
-		return nil, err
-	}
-	return result.(*networkingv1.NetworkPolicy), nil
+// NetworkPolicies returns the client of NetworkPolicy.
+func (c *NetworkingK8sIoV1) NetworkPolicies() *typedclient.ResourceClient[*networkingv1.NetworkPolicy, *networkingv1.NetworkPolicyList] {
+	return c.networkPolicyClient
+}
+
+func (c *NetworkingK8sIoV1) GetNetworkPolicy(ctx context.Context, namespace string, name string, opts metav1.GetOptions) (*networkingv1.NetworkPolicy, error) {
+	return c.networkPolicyClient.Get(ctx, namespace, name, opts)
 }
 
 func (c *NetworkingK8sIoV1) CreateNetworkPolicy(ctx context.Context, v *networkingv1.NetworkPolicy, opts metav1.CreateOptions) (*networkingv1.NetworkPolicy, error) {
-	result, err := c.backend.Create(ctx, "networkpolicies", v, opts, &networkingv1.NetworkPolicy{})
-	if err != nil {
-		return nil, err
-	}
-	return result.(*networkingv1.NetworkPolicy), nil
+	return c.networkPolicyClient.Create(ctx, v, opts)
 }
 
 func (c *NetworkingK8sIoV1) UpdateNetworkPolicy(ctx context.Context, v *networkingv1.NetworkPolicy, opts metav1.UpdateOptions) (*networkingv1.NetworkPolicy, error) {
-	result, err := c.backend.Update(ctx, "networkpolicies", v, opts, &networkingv1.NetworkPolicy{})
-	if err != nil {
-		return nil, err
-	}
-	return result.(*networkingv1.NetworkPolicy), nil
+	return c.networkPolicyClient.Update(ctx, v, opts)
+}
+
+func (c *NetworkingK8sIoV1) PatchNetworkPolicy(ctx context.Context, namespace string, name string, pt types.PatchType, data []byte, opts metav1.PatchOptions) (*networkingv1.NetworkPolicy, error) {
+	return c.networkPolicyClient.Patch(ctx, namespace, name, pt, data, opts)
+}
+
+func (c *NetworkingK8sIoV1) DeleteNetworkPolicy(ctx context.Context, namespace string, name string, opts metav1.DeleteOptions) error {
+	return c.networkPolicyClient.Delete(ctx, namespace, name, opts)
 }
 
-func (c *NetworkingK8sIoV1) DeleteNetworkPolicy(ctx context.Context, namespace, name string, opts metav1.DeleteOptions) error {
-	return c.backend.Delete(ctx, schema.GroupVersionResource{Group: ".networking.k8s.io", Version: "v1", Resource: "networkpolicies"}, namespace, name, opts)
+func (c *NetworkingK8sIoV1) DeleteCollectionNetworkPolicy(ctx context.Context, namespace string, opts metav1.DeleteOptions, listOpts metav1.ListOptions) error {
+	return c.networkPolicyClient.DeleteCollection(ctx, namespace, opts, listOpts)
 }
 
 func (c *NetworkingK8sIoV1) ListNetworkPolicy(ctx context.Context, namespace string, opts metav1.ListOptions) (*networkingv1.NetworkPolicyList, error) {
-	result, err := c.backend.List(ctx, "networkpolicies", namespace, opts, &networkingv1.NetworkPolicyList{})
-	if err != nil {
-		return nil, err
-	}
-	return result.(*networkingv1.NetworkPolicyList), nil
+	return c.networkPolicyClient.List(ctx, namespace, opts)
 }
 
 func (c *NetworkingK8sIoV1) WatchNetworkPolicy(ctx context.Context, namespace string, opts metav1.ListOptions) (watch.Interface, error) {
-	return c.backend.Watch(ctx, schema.GroupVersionResource{Group: ".networking.k8s.io", Version: "v1", Resource: "networkpolicies"}, namespace, opts)
+	return c.networkPolicyClient.Watch(ctx, namespace, opts)
+}
+
+// ServiceCIDRS returns the client of ServiceCIDR.
+func (c *NetworkingK8sIoV1) ServiceCIDRS() *typedclient.ClusterResourceClient[*networkingv1.ServiceCIDR, *networkingv1.ServiceCIDRList] {
+	return c.serviceCIDRClient
 }
 
 func (c *NetworkingK8sIoV1) GetServiceCIDR(ctx context.Context, name string, opts metav1.GetOptions) (*networkingv1.ServiceCIDR, error) {
-	result, err := c.backend.GetClusterScoped(ctx, "servicecidrs", name, opts, &networkingv1.ServiceCIDR{})
-	if err != nil {
-		return nil, err
-	}
-	return result.(*networkingv1.ServiceCIDR), nil
+	return c.serviceCIDRClient.Get(ctx, name, opts)
 }
 
 func (c *NetworkingK8sIoV1) CreateServiceCIDR(ctx context.Context, v *networkingv1.ServiceCIDR, opts metav1.CreateOptions) (*networkingv1.ServiceCIDR, error) {
-	result, err := c.backend.CreateClusterScoped(ctx, "servicecidrs", v, opts, &networkingv1.ServiceCIDR{})
-	if err != nil {
-		return nil, err
-	}
-	return result.(*networkingv1.ServiceCIDR), nil
+	return c.serviceCIDRClient.Create(ctx, v, opts)
 }
 
 func (c *NetworkingK8sIoV1) UpdateServiceCIDR(ctx context.Context, v *networkingv1.ServiceCIDR, opts metav1.UpdateOptions) (*networkingv1.ServiceCIDR, error) {
-	result, err := c.backend.UpdateClusterScoped(ctx, "servicecidrs", v, opts, &networkingv1.ServiceCIDR{})
-	if err != nil {
-		return nil, err
-	}
-	return result.(*networkingv1.ServiceCIDR), nil
+	return c.serviceCIDRClient.Update(ctx, v, opts)
 }
 
 func (c *NetworkingK8sIoV1) UpdateStatusServiceCIDR(ctx context.Context, v *networkingv1.ServiceCIDR, opts metav1.UpdateOptions) (*networkingv1.ServiceCIDR, error) {
-	result, err := c.backend.UpdateStatusClusterScoped(ctx, "servicecidrs", v, opts, &networkingv1.ServiceCIDR{})
-	if err != nil {
-		return nil, err
-	}
-	return result.(*networkingv1.ServiceCIDR), nil
+	return c.serviceCIDRClient.UpdateStatus(ctx, v, opts)
+}
+
+func (c *NetworkingK8sIoV1) PatchServiceCIDR(ctx context.Context, name string, pt types.PatchType, data []byte, opts metav1.PatchOptions) (*networkingv1.ServiceCIDR, error) {
+	return c.serviceCIDRClient.Patch(ctx, name, pt, data, opts)
+}
+
+func (c *NetworkingK8sIoV1) PatchServiceCIDRStatus(ctx context.Context, name string, pt types.PatchType, data []byte, opts metav1.PatchOptions) (*networkingv1.ServiceCIDR, error) {
+	return c.serviceCIDRClient.Patch(ctx, name, pt, data, opts, "status")
 }
 
 func (c *NetworkingK8sIoV1) DeleteServiceCIDR(ctx context.Context, name string, opts metav1.DeleteOptions) error {
-	return c.backend.DeleteClusterScoped(ctx, schema.GroupVersionResource{Group: ".networking.k8s.io", Version: "v1", Resource: "servicecidrs"}, name, opts)
+	return c.serviceCIDRClient.Delete(ctx, name, opts)
+}
+
+func (c *NetworkingK8sIoV1) DeleteCollectionServiceCIDR(ctx context.Context, opts metav1.DeleteOptions, listOpts metav1.ListOptions) error {
+	return c.serviceCIDRClient.DeleteCollection(ctx, opts, listOpts)
 }
 
 func (c *NetworkingK8sIoV1) ListServiceCIDR(ctx context.Context, opts metav1.ListOptions) (*networkingv1.ServiceCIDRList, error) {
-	result, err := c.backend.ListClusterScoped(ctx, "servicecidrs", opts, &networkingv1.ServiceCIDRList{})
-	if err != nil {
-		return nil, err
-	}
-	return result.(*networkingv1.ServiceCIDRList), nil
+	return c.serviceCIDRClient.List(ctx, opts)
 }
 
 func (c *NetworkingK8sIoV1) WatchServiceCIDR(ctx context.Context, opts metav1.ListOptions) (watch.Interface, error) {
-	return c.backend.WatchClusterScoped(ctx, schema.GroupVersionResource{Group: ".networking.k8s.io", Version: "v1", Resource: "servicecidrs"}, opts)
+	return c.serviceCIDRClient.Watch(ctx, opts)
 }
 
 type PolicyV1 struct {
 	backend Backend
 	config  *rest.Config
+
+	evictionClient            *typedclient.ResourceClient[*policyv1.Eviction, *policyv1.EvictionList]
+	podDisruptionBudgetClient *typedclient.ResourceClient[*policyv1.PodDisruptionBudget, *policyv1.PodDisruptionBudgetList]
 }
 
 func NewPolicyV1Client(b Backend, config *rest.Config) *PolicyV1 {
-	return &PolicyV1{backend: b, config: config}
+	return &PolicyV1{
+		backend:                   b,
+		config:                    config,
+		evictionClient:            typedclient.NewResourceClient[*policyv1.Eviction, *policyv1.EvictionList](b, schema.GroupVersionResource{Group: "policy", Version: "v1", Resource: "evictions"}),
+		podDisruptionBudgetClient: typedclient.NewResourceClient[*policyv1.PodDisruptionBudget, *policyv1.PodDisruptionBudgetList](b, schema.GroupVersionResource{Group: "policy", Version: "v1", Resource: "poddisruptionbudgets"}),
+	}
 }
 
-func (c *PolicyV1) GetEviction(ctx context.Context, namespace, name string, opts metav1.GetOptions) (*policyv1.Eviction, error) {
-	result, err := c.backend.Get(ctx, "evictions", namespace, name, opts, &policyv1.Eviction{})
-	if err != nil {
-		return nil, err
-	}
-	return result.(*policyv1.Eviction), nil
+// Evictions returns the client of Eviction.
+func (c *PolicyV1) Evictions() *typedclient.ResourceClient[*policyv1.Eviction, *policyv1.EvictionList] {
+	return c.evictionClient
+}
+
+func (c *PolicyV1) GetEviction(ctx context.Context, namespace string, name string, opts metav1.GetOptions) (*policyv1.Eviction, error) {
+	return c.evictionClient.Get(ctx, namespace, name, opts)
 }
 
 func (c *PolicyV1) CreateEviction(ctx context.Context, v *policyv1.Eviction, opts metav1.CreateOptions) (*policyv1.Eviction, error) {
-	result, err := c.backend.Create(ctx, "evictions", v, opts, &policyv1.Eviction{})
-	if err != nil {
-		return nil, err
-	}
-	return result.(*policyv1.Eviction), nil
+	return c.evictionClient.Create(ctx, v, opts)
 }
 
 func (c *PolicyV1) UpdateEviction(ctx context.Context, v *policyv1.Eviction, opts metav1.UpdateOptions) (*policyv1.Eviction, error) {
-	result, err := c.backend.Update(ctx, "evictions", v, opts, &policyv1.Eviction{})
-	if err != nil {
-		return nil, err
-	}
-	return result.(*policyv1.Eviction), nil
+	return c.evictionClient.Update(ctx, v, opts)
+}
+
+func (c *PolicyV1) PatchEviction(ctx context.Context, namespace string, name string, pt types.PatchType, data []byte, opts metav1.PatchOptions) (*policyv1.Eviction, error) {
+	return c.evictionClient.Patch(ctx, namespace, name, pt, data, opts)
+}
+
+func (c *PolicyV1) DeleteEviction(ctx context.Context, namespace string, name string, opts metav1.DeleteOptions) error {
+	return c.evictionClient.Delete(ctx, namespace, name, opts)
 }
 
-func (c *PolicyV1) DeleteEviction(ctx context.Context, namespace, name string, opts metav1.DeleteOptions) error {
-	return c.backend.Delete(ctx, schema.GroupVersionResource{Group: ".policy", Version: "v1", Resource: "evictions"}, namespace, name, opts)
+func (c *PolicyV1) DeleteCollectionEviction(ctx context.Context, namespace string, opts metav1.DeleteOptions, listOpts metav1.ListOptions) error {
+	return c.evictionClient.DeleteCollection(ctx, namespace, opts, listOpts)
 }
 
 func (c *PolicyV1) ListEviction(ctx context.Context, namespace string, opts metav1.ListOptions) (*policyv1.EvictionList, error) {
-	result, err := c.backend.List(ctx, "evictions", namespace, opts, &policyv1.EvictionList{})
-	if err != nil {
-		return nil, err
-	}
-	return result.(*policyv1.EvictionList), nil
+	return c.evictionClient.List(ctx, namespace, opts)
 }
 
 func (c *PolicyV1) WatchEviction(ctx context.Context, namespace string, opts metav1.ListOptions) (watch.Interface, error) {
-	return c.backend.Watch(ctx, schema.GroupVersionResource{Group: ".policy", Version: "v1", Resource: "evictions"}, namespace, opts)
+	return c.evictionClient.Watch(ctx, namespace, opts)
 }
 
-func (c *PolicyV1) GetPodDisruptionBudget(ctx context.Context, namespace, name string, opts metav1.GetOptions) (*policyv1.PodDisruptionBudget, error) {
-	result, err := c.backend.Get(ctx, "poddisruptionbudgets", namespace, name, opts, &policyv1.PodDisruptionBudget{})
-	if err != nil {
-		return nil, err
-	}
-	return result.(*policyv1.PodDisruptionBudget), nil
+// PodDisruptionBudgets returns the client of PodDisruptionBudget.
+func (c *PolicyV1) PodDisruptionBudgets() *typedclient.ResourceClient[*policyv1.PodDisruptionBudget, *policyv1.PodDisruptionBudgetList] {
+	return c.podDisruptionBudgetClient
+}
+
+func (c *PolicyV1) GetPodDisruptionBudget(ctx context.Context, namespace string, name string, opts metav1.GetOptions) (*policyv1.PodDisruptionBudget, error) {
+	return c.podDisruptionBudgetClient.Get(ctx, namespace, name, opts)
 }
 
 func (c *PolicyV1) CreatePodDisruptionBudget(ctx context.Context, v *policyv1.PodDisruptionBudget, opts metav1.CreateOptions) (*policyv1.PodDisruptionBudget, error) {
-	result, err := c.backend.Create(ctx, "poddisruptionbudgets", v, opts, &policyv1.PodDisruptionBudget{})
-	if err != nil {
-		return nil, err
-	}
-	return result.(*policyv1.PodDisruptionBudget), nil
+	return c.podDisruptionBudgetClient.Create(ctx, v, opts)
 }
 
 func (c *PolicyV1) UpdatePodDisruptionBudget(ctx context.Context, v *policyv1.PodDisruptionBudget, opts metav1.UpdateOptions) (*policyv1.PodDisruptionBudget, error) {
-	result, err := c.backend.Update(ctx, "poddisruptionbudgets", v, opts, &policyv1.PodDisruptionBudget{})
-	if err != nil {
-		return nil, err
-	}
-	return result.(*policyv1.PodDisruptionBudget), nil
+	return c.podDisruptionBudgetClient.Update(ctx, v, opts)
 }
 
 func (c *PolicyV1) UpdateStatusPodDisruptionBudget(ctx context.Context, v *policyv1.PodDisruptionBudget, opts metav1.UpdateOptions) (*policyv1.PodDisruptionBudget, error) {
-	result, err := c.backend.UpdateStatus(ctx, "poddisruptionbudgets", v, opts, &policyv1.PodDisruptionBudget{})
-	if err != nil {
-		return nil, err
-	}
-	return result.(*policyv1.PodDisruptionBudget), nil
+	return c.podDisruptionBudgetClient.UpdateStatus(ctx, v, opts)
+}
+
+func (c *PolicyV1) PatchPodDisruptionBudget(ctx context.Context, namespace string, name string, pt types.PatchType, data []byte, opts metav1.PatchOptions) (*policyv1.PodDisruptionBudget, error) {
+	return c.podDisruptionBudgetClient.Patch(ctx, namespace, name, pt, data, opts)
+}
+
+func (c *PolicyV1) PatchPodDisruptionBudgetStatus(ctx context.Context, namespace string, name string, pt types.PatchType, data []byte, opts metav1.PatchOptions) (*policyv1.PodDisruptionBudget, error) {
+	return c.podDisruptionBudgetClient.Patch(ctx, namespace, name, pt, data, opts, "status")
 }
 
-func (c *PolicyV1) DeletePodDisruptionBudget(ctx context.Context, namespace, name string, opts metav1.DeleteOptions) error {
-	return c.backend.Delete(ctx, schema.GroupVersionResource{Group: ".policy", Version: "v1", Resource: "poddisruptionbudgets"}, namespace, name, opts)
+func (c *PolicyV1) DeletePodDisruptionBudget(ctx context.Context, namespace string, name string, opts metav1.DeleteOptions) error {
+	return c.podDisruptionBudgetClient.Delete(ctx, namespace, name, opts)
+}
+
+func (c *PolicyV1) DeleteCollectionPodDisruptionBudget(ctx context.Context, namespace string, opts metav1.DeleteOptions, listOpts metav1.ListOptions) error {
+	return c.podDisruptionBudgetClient.DeleteCollection(ctx, namespace, opts, listOpts)
 }
 
 func (c *PolicyV1) ListPodDisruptionBudget(ctx context.Context, namespace string, opts metav1.ListOptions) (*policyv1.PodDisruptionBudgetList, error) {
-	result, err := c.backend.List(ctx, "poddisruptionbudgets", namespace, opts, &policyv1.PodDisruptionBudgetList{})
-	if err != nil {
-		return nil, err
-	}
-	return result.(*policyv1.PodDisruptionBudgetList), nil
+	return c.podDisruptionBudgetClient.List(ctx, namespace, opts)
 }
 
 func (c *PolicyV1) WatchPodDisruptionBudget(ctx context.Context, namespace string, opts metav1.ListOptions) (watch.Interface, error) {
-	return c.backend.Watch(ctx, schema.GroupVersionResource{Group: ".policy", Version: "v1", Resource: "poddisruptionbudgets"}, namespace, opts)
+	return c.podDisruptionBudgetClient.Watch(ctx, namespace, opts)
 }
 
 type RbacAuthorizationK8sIoV1 struct {
 	backend Backend
 	config  *rest.Config
+
+	clusterRoleClient        *typedclient.ClusterResourceClient[*rbacv1.ClusterRole, *rbacv1.ClusterRoleList]
+	clusterRoleBindingClient *typedclient.ClusterResourceClient[*rbacv1.ClusterRoleBinding, *rbacv1.ClusterRoleBindingList]
+	roleClient               *typedclient.ResourceClient[*rbacv1.Role, *rbacv1.RoleList]
+	roleBindingClient        *typedclient.ResourceClient[*rbacv1.RoleBinding, *rbacv1.RoleBindingList]
 }
 
 func NewRbacAuthorizationK8sIoV1Client(b Backend, config *rest.Config) *RbacAuthorizationK8sIoV1 {
-	return &RbacAuthorizationK8sIoV1{backend: b, config: config}
+	return &RbacAuthorizationK8sIoV1{
+		backend:                  b,
+		config:                   config,
+		clusterRoleClient:        typedclient.NewClusterResourceClient[*rbacv1.ClusterRole, *rbacv1.ClusterRoleList](b, schema.GroupVersionResource{Group: "rbac.authorization.k8s.io", Version: "v1", Resource: "clusterroles"}),
+		clusterRoleBindingClient: typedclient.NewClusterResourceClient[*rbacv1.ClusterRoleBinding, *rbacv1.ClusterRoleBindingList](b, schema.GroupVersionResource{Group: "rbac.authorization.k8s.io", Version: "v1", Resource: "clusterrolebindings"}),
+		roleClient:               typedclient.NewResourceClient[*rbacv1.Role, *rbacv1.RoleList](b, schema.GroupVersionResource{Group: "rbac.authorization.k8s.io", Version: "v1", Resource: "roles"}),
+		roleBindingClient:        typedclient.NewResourceClient[*rbacv1.RoleBinding, *rbacv1.RoleBindingList](b, schema.GroupVersionResource{Group: "rbac.authorization.k8s.io", Version: "v1", Resource: "rolebindings"}),
+	}
+}
+
+// ClusterRoles returns the client of ClusterRole.
+func (c *RbacAuthorizationK8sIoV1) ClusterRoles() *typedclient.ClusterResourceClient[*rbacv1.ClusterRole, *rbacv1.ClusterRoleList] {
+	return c.clusterRoleClient
 }
 
 func (c *RbacAuthorizationK8sIoV1) GetClusterRole(ctx context.Context, name string, opts metav1.GetOptions) (*rbacv1.ClusterRole, error) {
-	result, err := c.backend.GetClusterScoped(ctx, "clusterroles", name, opts, &rbacv1.ClusterRole{})
-	if err != nil {
-		return nil, err
-	}
-	return result.(*rbacv1.ClusterRole), nil
+	return c.clusterRoleClient.Get(ctx, name, opts)
 }
 
 func (c *RbacAuthorizationK8sIoV1) CreateClusterRole(ctx context.Context, v *rbacv1.ClusterRole, opts metav1.CreateOptions) (*rbacv1.ClusterRole, error) {
-	result, err := c.backend.CreateClusterScoped(ctx, "clusterroles", v, opts, &rbacv1.ClusterRole{})
-	if err != nil {
-		return nil, err
-	}
-	return result.(*rbacv1.ClusterRole), nil
+	return c.clusterRoleClient.Create(ctx, v, opts)
 }
 
 func (c *RbacAuthorizationK8sIoV1) UpdateClusterRole(ctx context.Context, v *rbacv1.ClusterRole, opts metav1.UpdateOptions) (*rbacv1.ClusterRole, error) {
-	result, err := c.backend.UpdateClusterScoped(ctx, "clusterroles", v, opts, &rbacv1.ClusterRole{})
-	if err != nil {
-		return nil, err
-	}
-	return result.(*rbacv1.ClusterRole), nil
+	return c.clusterRoleClient.Update(ctx, v, opts)
+}
+
+func (c *RbacAuthorizationK8sIoV1) PatchClusterRole(ctx context.Context, name string, pt types.PatchType, data []byte, opts metav1.PatchOptions) (*rbacv1.ClusterRole, error) {
+	return c.clusterRoleClient.Patch(ctx, name, pt, data, opts)
 }
 
 func (c *RbacAuthorizationK8sIoV1) DeleteClusterRole(ctx context.Context, name string, opts metav1.DeleteOptions) error {
-	return c.backend.DeleteClusterScoped(ctx, schema.GroupVersionResource{Group: ".rbac.authorization.k8s.io", Version: "v1", Resource: "clusterroles"}, name, opts)
+	return c.clusterRoleClient.Delete(ctx, name, opts)
+}
+
+func (c *RbacAuthorizationK8sIoV1) DeleteCollectionClusterRole(ctx context.Context, opts metav1.DeleteOptions, listOpts metav1.ListOptions) error {
+	return c.clusterRoleClient.DeleteCollection(ctx, opts, listOpts)
 }
 
 func (c *RbacAuthorizationK8sIoV1) ListClusterRole(ctx context.Context, opts metav1.ListOptions) (*rbacv1.ClusterRoleList, error) {
-	result, err := c.backend.ListClusterScoped(ctx, "clusterroles", opts, &rbacv1.ClusterRoleList{})
-	if err != nil {
-		return nil, err
-	}
-	return result.(*rbacv1.ClusterRoleList), nil
+	return c.clusterRoleClient.List(ctx, opts)
 }
 
 func (c *RbacAuthorizationK8sIoV1) WatchClusterRole(ctx context.Context, opts metav1.ListOptions) (watch.Interface, error) {
-	return c.backend.WatchClusterScoped(ctx, schema.GroupVersionResource{Group: ".rbac.authorization.k8s.io", Version: "v1", Resource: "clusterroles"}, opts)
+	return c.clusterRoleClient.Watch(ctx, opts)
+}
+
+// ClusterRoleBindings returns the client of ClusterRoleBinding.
+func (c *RbacAuthorizationK8sIoV1) ClusterRoleBindings() *typedclient.ClusterResourceClient[*rbacv1.ClusterRoleBinding, *rbacv1.ClusterRoleBindingList] {
+	return c.clusterRoleBindingClient
 }
 
 func (c *RbacAuthorizationK8sIoV1) GetClusterRoleBinding(ctx context.Context, name string, opts metav1.GetOptions) (*rbacv1.ClusterRoleBinding, error) {
-	result, err := c.backend.GetClusterScoped(ctx, "clusterrolebindings", name, opts, &rbacv1.ClusterRoleBinding{})
-	if err != nil {
-		return nil, err
-	}
-	return result.(*rbacv1.ClusterRoleBinding), nil
+	return c.clusterRoleBindingClient.Get(ctx, name, opts)
 }
 
 func (c *RbacAuthorizationK8sIoV1) CreateClusterRoleBinding(ctx context.Context, v *rbacv1.ClusterRoleBinding, opts metav1.CreateOptions) (*rbacv1.ClusterRoleBinding, error) {
-	result, err := c.backend.CreateClusterScoped(ctx, "clusterrolebindings", v, opts, &rbacv1.ClusterRoleBinding{})
-	if err != nil {
-		return nil, err
-	}
-	return result.(*rbacv1.ClusterRoleBinding), nil
+	return c.clusterRoleBindingClient.Create(ctx, v, opts)
 }
 
 func (c *RbacAuthorizationK8sIoV1) UpdateClusterRoleBinding(ctx context.Context, v *rbacv1.ClusterRoleBinding, opts metav1.UpdateOptions) (*rbacv1.ClusterRoleBinding, error) {
-	result, err := c.backend.UpdateClusterScoped(ctx, "clusterrolebindings", v, opts, &rbacv1.ClusterRoleBinding{})
-	if err != nil {
-		return nil, err
-	}
-	return result.(*rbacv1.ClusterRoleBinding), nil
+	return c.clusterRoleBindingClient.Update(ctx, v, opts)
+}
+
+func (c *RbacAuthorizationK8sIoV1) PatchClusterRoleBinding(ctx context.Context, name string, pt types.PatchType, data []byte, opts metav1.PatchOptions) (*rbacv1.ClusterRoleBinding, error) {
+	return c.clusterRoleBindingClient.Patch(ctx, name, pt, data, opts)
 }
 
 func (c *RbacAuthorizationK8sIoV1) DeleteClusterRoleBinding(ctx context.Context, name string, opts metav1.DeleteOptions) error {
-	return c.backend.DeleteClusterScoped(ctx, schema.GroupVersionResource{Group: ".rbac.authorization.k8s.io", Version: "v1", Resource: "clusterrolebindings"}, name, opts)
+	return c.clusterRoleBindingClient.Delete(ctx, name, opts)
+}
+
+func (c *RbacAuthorizationK8sIoV1) DeleteCollectionClusterRoleBinding(ctx context.Context, opts metav1.DeleteOptions, listOpts metav1.ListOptions) error {
+	return c.clusterRoleBindingClient.DeleteCollection(ctx, opts, listOpts)
 }
 
 func (c *RbacAuthorizationK8sIoV1) ListClusterRoleBinding(ctx context.Context, opts metav1.ListOptions) (*rbacv1.ClusterRoleBindingList, error) {
-	result, err := c.backend.ListClusterScoped(ctx, "clusterrolebindings", opts, &rbacv1.ClusterRoleBindingList{})
-	if err != nil {
-		return nil, err
-	}
-	return result.(*rbacv1.ClusterRoleBindingList), nil
+	return c.clusterRoleBindingClient.List(ctx, opts)
 }
 
 func (c *RbacAuthorizationK8sIoV1) WatchClusterRoleBinding(ctx context.Context, opts metav1.ListOptions) (watch.Interface, error) {
-	return c.backend.WatchClusterScoped(ctx, schema.GroupVersionResource{Group: ".rbac.authorization.k8s.io", Version: "v1", Resource: "clusterrolebindings"}, opts)
+	return c.clusterRoleBindingClient.Watch(ctx, opts)
 }
 
-func (c *RbacAuthorizationK8sIoV1) GetRole(ctx context.Context, namespace, name string, opts metav1.GetOptions) (*rbacv1.Role, error) {
-	result, err := c.backend.Get(ctx, "roles", namespace, name, opts, &rbacv1.Role{})
-	if err != nil {
-		return nil, err
-	}
-	return result.(*rbacv1.Role), nil
+// Roles returns the client of Role.
+func (c *RbacAuthorizationK8sIoV1) Roles() *typedclient.ResourceClient[*rbacv1.Role, *rbacv1.RoleList] {
+	return c.roleClient
+}
+
+func (c *RbacAuthorizationK8sIoV1) GetRole(ctx context.Context, namespace string, name string, opts metav1.GetOptions) (*rbacv1.Role, error) {
+	return c.roleClient.Get(ctx, namespace, name, opts)
 }
 
 func (c *RbacAuthorizationK8sIoV1) CreateRole(ctx context.Context, v *rbacv1.Role, opts metav1.CreateOptions) (*rbacv1.Role, error) {
-	result, err := c.backend.Create(ctx, "roles", v, opts, &rbacv1.Role{})
-	if err != nil {
-		return nil, err
-	}
-	return result.(*rbacv1.Role), nil
+	return c.roleClient.Create(ctx, v, opts)
+}
+
+func (c *RbacAuthorizationK8sIoV1) UpdateRole(ctx context.Context, v *rbacv1.Role, opts metav1.UpdateOptions) (*rbacv1.Role, error) {
+	return c.roleClient.Update(ctx, v, opts)
+}
+
+func (c *RbacAuthorizationK8sIoV1) PatchRole(ctx context.Context, namespace string, name string, pt types.PatchType, data []byte, opts metav1.PatchOptions) (*rbacv1.Role, error) {
+	return c.roleClient.Patch(ctx, namespace, name, pt, data, opts)
 }
 
-func (c *RbacAuthorizationK8sIoV1) UpdateRole(ctx context.Context, v *rbacv1.Role, opts metav1.UpdateOptions) (*rbacv1.Role, error) {
-	result, err := c.backend.Update(ctx, "roles", v, opts, &rbacv1.Role{})
-	if err != nil {
-		return nil, err
-	}
-	return result.(*rbacv1.Role), nil
+func (c *RbacAuthorizationK8sIoV1) DeleteRole(ctx context.Context, namespace string, name string, opts metav1.DeleteOptions) error {
+	return c.roleClient.Delete(ctx, namespace, name, opts)
 }
 
-func (c *RbacAuthorizationK8sIoV1) DeleteRole(ctx context.Context, namespace, name string, opts metav1.DeleteOptions) error {
-	return c.backend.Delete(ctx, schema.GroupVersionResource{Group: ".rbac.authorization.k8s.io", Version: "v1", Resource: "roles"}, namespace, name, opts)
+func (c *RbacAuthorizationK8sIoV1) DeleteCollectionRole(ctx context.Context, namespace string, opts metav1.DeleteOptions, listOpts metav1.ListOptions) error {
+	return c.roleClient.DeleteCollection(ctx, namespace, opts, listOpts)
 }
 
 func (c *RbacAuthorizationK8sIoV1) ListRole(ctx context.Context, namespace string, opts metav1.ListOptions) (*rbacv1.RoleList, error) {
-	result, err := c.backend.List(ctx, "roles", namespace, opts, &rbacv1.RoleList{})
-	if err != nil {
-		return nil, err
-	}
-	return result.(*rbacv1.RoleList), nil
+	return c.roleClient.List(ctx, namespace, opts)
 }
 
 func (c *RbacAuthorizationK8sIoV1) WatchRole(ctx context.Context, namespace string, opts metav1.ListOptions) (watch.Interface, error) {
-	return c.backend.Watch(ctx, schema.GroupVersionResource{Group: ".rbac.authorization.k8s.io", Version: "v1", Resource: "roles"}, namespace, opts)
+	return c.roleClient.Watch(ctx, namespace, opts)
 }
 
-func (c *RbacAuthorizationK8sIoV1) GetRoleBinding(ctx context.Context, namespace, name string, opts metav1.GetOptions) (*rbacv1.RoleBinding, error) {
-	result, err := c.backend.Get(ctx, "rolebindings", namespace, name, opts, &rbacv1.RoleBinding{})
-	if err != nil {
-		return nil, err
-	}
-	return result.(*rbacv1.RoleBinding), nil
+// RoleBindings returns the client of RoleBinding.
+func (c *RbacAuthorizationK8sIoV1) RoleBindings() *typedclient.ResourceClient[*rbacv1.RoleBinding, *rbacv1.RoleBindingList] {
+	return c.roleBindingClient
+}
+
+func (c *RbacAuthorizationK8sIoV1) GetRoleBinding(ctx context.Context, namespace string, name string, opts metav1.GetOptions) (*rbacv1.RoleBinding, error) {
+	return c.roleBindingClient.Get(ctx, namespace, name, opts)
 }
 
 func (c *RbacAuthorizationK8sIoV1) CreateRoleBinding(ctx context.Context, v *rbacv1.RoleBinding, opts metav1.CreateOptions) (*rbacv1.RoleBinding, error) {
-	result, err := c.backend.Create(ctx, "rolebindings", v, opts, &rbacv1.RoleBinding{})
-	if err != nil {
-		return nil, err
-	}
-	return result.(*rbacv1.RoleBinding), nil
+	return c.roleBindingClient.Create(ctx, v, opts)
 }
 
 func (c *RbacAuthorizationK8sIoV1) UpdateRoleBinding(ctx context.Context, v *rbacv1.RoleBinding, opts metav1.UpdateOptions) (*rbacv1.RoleBinding, error) {
-	result, err := c.backend.Update(ctx, "rolebindings", v, opts, &rbacv1.RoleBinding{})
-	if err != nil {
-		return nil, err
-	}
-	return result.(*rbacv1.RoleBinding), nil
+	return c.roleBindingClient.Update(ctx, v, opts)
+}
+
+func (c *RbacAuthorizationK8sIoV1) PatchRoleBinding(ctx context.Context, namespace string, name string, pt types.PatchType, data []byte, opts metav1.PatchOptions) (*rbacv1.RoleBinding, error) {
+	return c.roleBindingClient.Patch(ctx, namespace, name, pt, data, opts)
 }
 
-func (c *RbacAuthorizationK8sIoV1) DeleteRoleBinding(ctx context.Context, namespace, name string, opts metav1.DeleteOptions) error {
-	return c.backend.Delete(ctx, schema.GroupVersionResource{Group: ".rbac.authorization.k8s.io", Version: "v1", Resource: "rolebindings"}, namespace, name, opts)
+func (c *RbacAuthorizationK8sIoV1) DeleteRoleBinding(ctx context.Context, namespace string, name string, opts metav1.DeleteOptions) error {
+	return c.roleBindingClient.Delete(ctx, namespace, name, opts)
+}
+
+func (c *RbacAuthorizationK8sIoV1) DeleteCollectionRoleBinding(ctx context.Context, namespace string, opts metav1.DeleteOptions, listOpts metav1.ListOptions) error {
+	return c.roleBindingClient.DeleteCollection(ctx, namespace, opts, listOpts)
 }
 
 func (c *RbacAuthorizationK8sIoV1) ListRoleBinding(ctx context.Context, namespace string, opts metav1.ListOptions) (*rbacv1.RoleBindingList, error) {
-	result, err := c.backend.List(ctx, "rolebindings", namespace, opts, &rbacv1.RoleBindingList{})
-	if err != nil {
-		return nil, err
-	}
-	return result.(*rbacv1.RoleBindingList), nil
+	return c.roleBindingClient.List(ctx, namespace, opts)
 }
 
 func (c *RbacAuthorizationK8sIoV1) WatchRoleBinding(ctx context.Context, namespace string, opts metav1.ListOptions) (watch.Interface, error) {
-	return c.backend.Watch(ctx, schema.GroupVersionResource{Group: ".rbac.authorization.k8s.io", Version: "v1", Resource: "rolebindings"}, namespace, opts)
+	return c.roleBindingClient.Watch(ctx, namespace, opts)
 }
 
 type ResourceV1 struct {
 	backend Backend
 	config  *rest.Config
+
+	deviceClassClient           *typedclient.ClusterResourceClient[*resourcev1.DeviceClass, *resourcev1.DeviceClassList]
+	resourceClaimClient         *typedclient.ResourceClient[*resourcev1.ResourceClaim, *resourcev1.ResourceClaimList]
+	resourceClaimTemplateClient *typedclient.ResourceClient[*resourcev1.ResourceClaimTemplate, *resourcev1.ResourceClaimTemplateList]
+	resourceSliceClient         *typedclient.ClusterResourceClient[*resourcev1.ResourceSlice, *resourcev1.ResourceSliceList]
 }
 
 func NewResourceV1Client(b Backend, config *rest.Config) *ResourceV1 {
-	return &ResourceV1{backend: b, config: config}
+	return &ResourceV1{
+		backend:                     b,
+		config:                      config,
+		deviceClassClient:           typedclient.NewClusterResourceClient[*resourcev1.DeviceClass, *resourcev1.DeviceClassList](b, schema.GroupVersionResource{Group: "resource", Version: "v1", Resource: "deviceclasses"}),
+		resourceClaimClient:         typedclient.NewResourceClient[*resourcev1.ResourceClaim, *resourcev1.ResourceClaimList](b, schema.GroupVersionResource{Group: "resource", Version: "v1", Resource: "resourceclaims"}),
+		resourceClaimTemplateClient: typedclient.NewResourceClient[*resourcev1.ResourceClaimTemplate, *resourcev1.ResourceClaimTemplateList](b, schema.GroupVersionResource{Group: "resource", Version: "v1", Resource: "resourceclaimtemplates"}),
+		resourceSliceClient:         typedclient.NewClusterResourceClient[*resourcev1.ResourceSlice, *resourcev1.ResourceSliceList](b, schema.GroupVersionResource{Group: "resource", Version: "v1", Resource: "resourceslices"}),
+	}
+}
+
+// DeviceClasses returns the client of DeviceClass.
+func (c *ResourceV1) DeviceClasses() *typedclient.ClusterResourceClient[*resourcev1.DeviceClass, *resourcev1.DeviceClassList] {
+	return c.deviceClassClient
 }
 
 func (c *ResourceV1) GetDeviceClass(ctx context.Context, name string, opts metav1.GetOptions) (*resourcev1.DeviceClass, error) {
-	result, err := c.backend.GetClusterScoped(ctx, "deviceclasses", name, opts, &resourcev1.DeviceClass{})
-	if err != nil {
-		return nil, err
-	}
-	return result.(*resourcev1.DeviceClass), nil
+	return c.deviceClassClient.Get(ctx, name, opts)
 }
 
 func (c *ResourceV1) CreateDeviceClass(ctx context.Context, v *resourcev1.DeviceClass, opts metav1.CreateOptions) (*resourcev1.DeviceClass, error) {
-	result, err := c.backend.CreateClusterScoped(ctx, "deviceclasses", v, opts, &resourcev1.DeviceClass{})
-	if err != nil {
-		return nil, err
-	}
-	return result.(*resourcev1.DeviceClass), nil
+	return c.deviceClassClient.Create(ctx, v, opts)
 }
 
 func (c *ResourceV1) UpdateDeviceClass(ctx context.Context, v *resourcev1.DeviceClass, opts metav1.UpdateOptions) (*resourcev1.DeviceClass, error) {
-	result, err := c.backend.UpdateClusterScoped(ctx, "deviceclasses", v, opts, &resourcev1.DeviceClass{})
-	if err != nil {
-		return nil, err
-	}
-	return result.(*resourcev1.DeviceClass), nil
+	return c.deviceClassClient.Update(ctx, v, opts)
+}
+
+func (c *ResourceV1) PatchDeviceClass(ctx context.Context, name string, pt types.PatchType, data []byte, opts metav1.PatchOptions) (*resourcev1.DeviceClass, error) {
+	return c.deviceClassClient.Patch(ctx, name, pt, data, opts)
 }
 
 func (c *ResourceV1) DeleteDeviceClass(ctx context.Context, name string, opts metav1.DeleteOptions) error {
-	return c.backend.DeleteClusterScoped(ctx, schema.GroupVersionResource{Group: ".resource", Version: "v1", Resource: "deviceclasses"}, name, opts)
+	return c.deviceClassClient.Delete(ctx, name, opts)
+}
+
+func (c *ResourceV1) DeleteCollectionDeviceClass(ctx context.Context, opts metav1.DeleteOptions, listOpts metav1.ListOptions) error {
+	return c.deviceClassClient.DeleteCollection(ctx, opts, listOpts)
 }
 
 func (c *ResourceV1) ListDeviceClass(ctx context.Context, opts metav1.ListOptions) (*resourcev1.DeviceClassList, error) {
-	result, err := c.backend.ListClusterScoped(ctx, "deviceclasses", opts, &resourcev1.DeviceClassList{})
-	if err != nil {
-		return nil, err
-	}
-	return result.(*resourcev1.DeviceClassList), nil
+	return c.deviceClassClient.List(ctx, opts)
 }
 
 func (c *ResourceV1) WatchDeviceClass(ctx context.Context, opts metav1.ListOptions) (watch.Interface, error) {
-	return c.backend.WatchClusterScoped(ctx, schema.GroupVersionResource{Group: ".resource", Version: "v1", Resource: "deviceclasses"}, opts)
+	return c.deviceClassClient.Watch(ctx, opts)
 }
 
-func (c *ResourceV1) GetResourceClaim(ctx context.Context, namespace, name string, opts metav1.GetOptions) (*resourcev1.ResourceClaim, error) {
-	result, err := c.backend.Get(ctx, "resourceclaims", namespace, name, opts, &resourcev1.ResourceClaim{})
-	if err != nil {
-		return nil, err
-	}
-	return result.(*resourcev1.ResourceClaim), nil
+// ResourceClaims returns the client of ResourceClaim.
+func (c *ResourceV1) ResourceClaims() *typedclient.ResourceClient[*resourcev1.ResourceClaim, *resourcev1.ResourceClaimList] {
+	return c.resourceClaimClient
+}
+
+func (c *ResourceV1) GetResourceClaim(ctx context.Context, namespace string, name string, opts metav1.GetOptions) (*resourcev1.ResourceClaim, error) {
+	return c.resourceClaimClient.Get(ctx, namespace, name, opts)
 }
 
 func (c *ResourceV1) CreateResourceClaim(ctx context.Context, v *resourcev1.ResourceClaim, opts metav1.CreateOptions) (*resourcev1.ResourceClaim, error) {
-	result, err := c.backend.Create(ctx, "resourceclaims", v, opts, &resourcev1.ResourceClaim{})
-	if err != nil {
-		return nil, err
-	}
-	return result.(*resourcev1.ResourceClaim), nil
+	return c.resourceClaimClient.Create(ctx, v, opts)
 }
 
 func (c *ResourceV1) UpdateResourceClaim(ctx context.Context, v *resourcev1.ResourceClaim, opts metav1.UpdateOptions) (*resourcev1.ResourceClaim, error) {
-	result, err := c.backend.Update(ctx, "resourceclaims", v, opts, &resourcev1.ResourceClaim{})
-	if err != nil {
-		return nil, err
-	}
-	return result.(*resourcev1.ResourceClaim), nil
+	return c.resourceClaimClient.Update(ctx, v, opts)
 }
 
 func (c *ResourceV1) UpdateStatusResourceClaim(ctx context.Context, v *resourcev1.ResourceClaim, opts metav1.UpdateOptions) (*resourcev1.ResourceClaim, error) {
-	result, err := c.backend.UpdateStatus(ctx, "resourceclaims", v, opts, &resourcev1.ResourceClaim{})
-	if err != nil {
-		return nil, err
-	}
-	return result.(*resourcev1.ResourceClaim), nil
+	return c.resourceClaimClient.UpdateStatus(ctx, v, opts)
+}
+
+func (c *ResourceV1) PatchResourceClaim(ctx context.Context, namespace string, name string, pt types.PatchType, data []byte, opts metav1.PatchOptions) (*resourcev1.ResourceClaim, error) {
+	return c.resourceClaimClient.Patch(ctx, namespace, name, pt, data, opts)
+}
+
+func (c *ResourceV1) PatchResourceClaimStatus(ctx context.Context, namespace string, name string, pt types.PatchType, data []byte, opts metav1.PatchOptions) (*resourcev1.ResourceClaim, error) {
+	return c.resourceClaimClient.Patch(ctx, namespace, name, pt, data, opts, "status")
+}
+
+func (c *ResourceV1) DeleteResourceClaim(ctx context.Context, namespace string, name string, opts metav1.DeleteOptions) error {
+	return c.resourceClaimClient.Delete(ctx, namespace, name, opts)
 }
 
-func (c *ResourceV1) DeleteResourceClaim(ctx context.Context, namespace, name string, opts metav1.DeleteOptions) error {
-	return c.backend.Delete(ctx, schema.GroupVersionResource{Group: ".resource", Version: "v1", Resource: "resourceclaims"}, namespace, name, opts)
+func (c *ResourceV1) DeleteCollectionResourceClaim(ctx context.Context, namespace string, opts metav1.DeleteOptions, listOpts metav1.ListOptions) error {
+	return c.resourceClaimClient.DeleteCollection(ctx, namespace, opts, listOpts)
 }
 
 func (c *ResourceV1) ListResourceClaim(ctx context.Context, namespace string, opts metav1.ListOptions) (*resourcev1.ResourceClaimList, error) {
-	result, err := c.backend.List(ctx, "resourceclaims", namespace, opts, &resourcev1.ResourceClaimList{})
-	if err != nil {
-		return nil, err
-	}
-	return result.(*resourcev1.ResourceClaimList), nil
+	return c.resourceClaimClient.List(ctx, namespace, opts)
 }
 
 func (c *ResourceV1) WatchResourceClaim(ctx context.Context, namespace string, opts metav1.ListOptions) (watch.Interface, error) {
-	return c.backend.Watch(ctx, schema.GroupVersionResource{Group: ".resource", Version: "v1", Resource: "resourceclaims"}, namespace, opts)
+	return c.resourceClaimClient.Watch(ctx, namespace, opts)
 }
 
-func (c *ResourceV1) GetResourceClaimTemplate(ctx context.Context, namespace, name string, opts metav1.GetOptions) (*resourcev1.ResourceClaimTemplate, error) {
-	result, err := c.backend.Get(ctx, "resourceclaimtemplates", namespace, name, opts, &resourcev1.ResourceClaimTemplate{})
-	if err != nil {
-		return nil, err
-	}
-	return result.(*resourcev1.ResourceClaimTemplate), nil
+// ResourceClaimTemplates returns the client of ResourceClaimTemplate.
+func (c *ResourceV1) ResourceClaimTemplates() *typedclient.ResourceClient[*resourcev1.ResourceClaimTemplate, *resourcev1.ResourceClaimTemplateList] {
+	return c.resourceClaimTemplateClient
+}
+
+func (c *ResourceV1) GetResourceClaimTemplate(ctx context.Context, namespace string, name string, opts metav1.GetOptions) (*resourcev1.ResourceClaimTemplate, error) {
+	return c.resourceClaimTemplateClient.Get(ctx, namespace, name, opts)
 }
 
 func (c *ResourceV1) CreateResourceClaimTemplate(ctx context.Context, v *resourcev1.ResourceClaimTemplate, opts metav1.CreateOptions) (*resourcev1.ResourceClaimTemplate, error) {
-	result, err := c.backend.Create(ctx, "resourceclaimtemplates", v, opts, &resourcev1.ResourceClaimTemplate{})
-	if err != nil {
-		return nil, err
-	}
-	return result.(*resourcev1.ResourceClaimTemplate), nil
+	return c.resourceClaimTemplateClient.Create(ctx, v, opts)
 }
 
 func (c *ResourceV1) UpdateResourceClaimTemplate(ctx context.Context, v *resourcev1.ResourceClaimTemplate, opts metav1.UpdateOptions) (*resourcev1.ResourceClaimTemplate, error) {
-	result, err := c.backend.Update(ctx, "resourceclaimtemplates", v, opts, &resourcev1.ResourceClaimTemplate{})
-	if err != nil {
-		return nil, err
-	}
-	return result.(*resourcev1.ResourceClaimTemplate), nil
+	return c.resourceClaimTemplateClient.Update(ctx, v, opts)
+}
+
+func (c *ResourceV1) PatchResourceClaimTemplate(ctx context.Context, namespace string, name string, pt types.PatchType, data []byte, opts metav1.PatchOptions) (*resourcev1.ResourceClaimTemplate, error) {
+	return c.resourceClaimTemplateClient.Patch(ctx, namespace, name, pt, data, opts)
+}
+
+func (c *ResourceV1) DeleteResourceClaimTemplate(ctx context.Context, namespace string, name string, opts metav1.DeleteOptions) error {
+	return c.resourceClaimTemplateClient.Delete(ctx, namespace, name, opts)
 }
 
-func (c *ResourceV1) DeleteResourceClaimTemplate(ctx context.Context, namespace, name string, opts metav1.DeleteOptions) error {
-	return c.backend.Delete(ctx, schema.GroupVersionResource{Group: ".resource", Version: "v1", Resource: "resourceclaimtemplates"}, namespace, name, opts)
+func (c *ResourceV1) DeleteCollectionResourceClaimTemplate(ctx context.Context, namespace string, opts metav1.DeleteOptions, listOpts metav1.ListOptions) error {
+	return c.resourceClaimTemplateClient.DeleteCollection(ctx, namespace, opts, listOpts)
 }
 
 func (c *ResourceV1) ListResourceClaimTemplate(ctx context.Context, namespace string, opts metav1.ListOptions) (*resourcev1.ResourceClaimTemplateList, error) {
-	result, err := c.backend.List(ctx, "resourceclaimtemplates", namespace, opts, &resourcev1.ResourceClaimTemplateList{})
-	if err != nil {
-		return nil, err
-	}
-	return result.(*resourcev1.ResourceClaimTemplateList), nil
+	return c.resourceClaimTemplateClient.List(ctx, namespace, opts)
 }
 
 func (c *ResourceV1) WatchResourceClaimTemplate(ctx context.Context, namespace string, opts metav1.ListOptions) (watch.Interface, error) {
-	return c.backend.Watch(ctx, schema.GroupVersionResource{Group: ".resource", Version: "v1", Resource: "resourceclaimtemplates"}, namespace, opts)
+	return c.resourceClaimTemplateClient.Watch(ctx, namespace, opts)
+}
+
+// ResourceSlices returns the client of ResourceSlice.
+func (c *ResourceV1) ResourceSlices() *typedclient.ClusterResourceClient[*resourcev1.ResourceSlice, *resourcev1.ResourceSliceList] {
+	return c.resourceSliceClient
 }
 
 func (c *ResourceV1) GetResourceSlice(ctx context.Context, name string, opts metav1.GetOptions) (*resourcev1.ResourceSlice, error) {
-	result, err := c.backend.GetClusterScoped(ctx, "resourceslices", name, opts, &resourcev1.ResourceSlice{})
-	if err != nil {
-		return nil, err
-	}
-	return result.(*resourcev1.ResourceSlice), nil
+	return c.resourceSliceClient.Get(ctx, name, opts)
 }
 
 func (c *ResourceV1) CreateResourceSlice(ctx context.Context, v *resourcev1.ResourceSlice, opts metav1.CreateOptions) (*resourcev1.ResourceSlice, error) {
-	result, err := c.backend.CreateClusterScoped(ctx, "resourceslices", v, opts, &resourcev1.ResourceSlice{})
-	if err != nil {
-		return nil, err
-	}
-	return result.(*resourcev1.ResourceSlice), nil
+	return c.resourceSliceClient.Create(ctx, v, opts)
 }
 
 func (c *ResourceV1) UpdateResourceSlice(ctx context.Context, v *resourcev1.ResourceSlice, opts metav1.UpdateOptions) (*resourcev1.ResourceSlice, error) {
-	result, err := c.backend.UpdateClusterScoped(ctx, "resourceslices", v, opts, &resourcev1.ResourceSlice{})
-	if err != nil {
-		return nil, err
-	}
-	return result.(*resourcev1.ResourceSlice), nil
+	return c.resourceSliceClient.Update(ctx, v, opts)
+}
+
+func (c *ResourceV1) PatchResourceSlice(ctx context.Context, name string, pt types.PatchType, data []byte, opts metav1.PatchOptions) (*resourcev1.ResourceSlice, error) {
+	return c.resourceSliceClient.Patch(ctx, name, pt, data, opts)
 }
 
 func (c *ResourceV1) DeleteResourceSlice(ctx context.Context, name string, opts metav1.DeleteOptions) error {
-	return c.backend.DeleteClusterScoped(ctx, schema.GroupVersionResource{Group: ".resource", Version: "v1", Resource: "resourceslices"}, name, opts)
+	return c.resourceSliceClient.Delete(ctx, name, opts)
+}
+
+func (c *ResourceV1) DeleteCollectionResourceSlice(ctx context.Context, opts metav1.DeleteOptions, listOpts metav1.ListOptions) error {
+	return c.resourceSliceClient.DeleteCollection(ctx, opts, listOpts)
 }
 
 func (c *ResourceV1) ListResourceSlice(ctx context.Context, opts metav1.ListOptions) (*resourcev1.ResourceSliceList, error) {
-	result, err := c.backend.ListClusterScoped(ctx, "resourceslices", opts, &resourcev1.ResourceSliceList{})
-	if err != nil {
-		return nil, err
-	}
-	return result.(*resourcev1.ResourceSliceList), nil
+	return c.resourceSliceClient.List(ctx, opts)
 }
 
 func (c *ResourceV1) WatchResourceSlice(ctx context.Context, opts metav1.ListOptions) (watch.Interface, error) {
-	return c.backend.WatchClusterScoped(ctx, schema.GroupVersionResource{Group: ".resource", Version: "v1", Resource: "resourceslices"}, opts)
+	return c.resourceSliceClient.Watch(ctx, opts)
 }
 
 type SchedulingK8sIoV1 struct {
 	backend Backend
 	config  *rest.Config
+
+	priorityClassClient *typedclient.ClusterResourceClient[*schedulingv1.PriorityClass, *schedulingv1.PriorityClassList]
 }
 
 func NewSchedulingK8sIoV1Client(b Backend, config *rest.Config) *SchedulingK8sIoV1 {
-	return &SchedulingK8sIoV1{backend: b, config: config}
+	return &SchedulingK8sIoV1{
+		backend:             b,
+		config:              config,
+		priorityClassClient: typedclient.NewClusterResourceClient[*schedulingv1.PriorityClass, *schedulingv1.PriorityClassList](b, schema.GroupVersionResource{Group: "scheduling.k8s.io", Version: "v1", Resource: "priorityclasses"}),
+	}
+}
+
+// PriorityClasses returns the client of PriorityClass.
+func (c *SchedulingK8sIoV1) PriorityClasses() *typedclient.ClusterResourceClient[*schedulingv1.PriorityClass, *schedulingv1.PriorityClassList] {
+	return c.priorityClassClient
 }
 
 func (c *SchedulingK8sIoV1) GetPriorityClass(ctx context.Context, name string, opts metav1.GetOptions) (*schedulingv1.PriorityClass, error) {
-	result, err := c.backend.GetClusterScoped(ctx, "priorityclasses", name, opts, &schedulingv1.PriorityClass{})
-	if err != nil {
-		return nil, err
-	}
-	return result.(*schedulingv1.PriorityClass), nil
+	return c.priorityClassClient.Get(ctx, name, opts)
 }
 
 func (c *SchedulingK8sIoV1) CreatePriorityClass(ctx context.Context, v *schedulingv1.PriorityClass, opts metav1.CreateOptions) (*schedulingv1.PriorityClass, error) {
-	result, err := c.backend.CreateClusterScoped(ctx, "priorityclasses", v, opts, &schedulingv1.PriorityClass{})
-	if err != nil {
-		return nil, err
-	}
-	return result.(*schedulingv1.PriorityClass), nil
+	return c.priorityClassClient.Create(ctx, v, opts)
 }
 
 func (c *SchedulingK8sIoV1) UpdatePriorityClass(ctx context.Context, v *schedulingv1.PriorityClass, opts metav1.UpdateOptions) (*schedulingv1.PriorityClass, error) {
-	result, err := c.backend.UpdateClusterScoped(ctx, "priorityclasses", v, opts, &schedulingv1.PriorityClass{})
-	if err != nil {
-		return nil, err
-	}
-	return result.(*schedulingv1.PriorityClass), nil
+	return c.priorityClassClient.Update(ctx, v, opts)
+}
+
+func (c *SchedulingK8sIoV1) PatchPriorityClass(ctx context.Context, name string, pt types.PatchType, data []byte, opts metav1.PatchOptions) (*schedulingv1.PriorityClass, error) {
+	return c.priorityClassClient.Patch(ctx, name, pt, data, opts)
 }
 
 func (c *SchedulingK8sIoV1) DeletePriorityClass(ctx context.Context, name string, opts metav1.DeleteOptions) error {
-	return c.backend.DeleteClusterScoped(ctx, schema.GroupVersionResource{Group: ".scheduling.k8s.io", Version: "v1", Resource: "priorityclasses"}, name, opts)
+	return c.priorityClassClient.Delete(ctx, name, opts)
+}
+
+func (c *SchedulingK8sIoV1) DeleteCollectionPriorityClass(ctx context.Context, opts metav1.DeleteOptions, listOpts metav1.ListOptions) error {
+	return c.priorityClassClient.DeleteCollection(ctx, opts, listOpts)
 }
 
 func (c *SchedulingK8sIoV1) ListPriorityClass(ctx context.Context, opts metav1.ListOptions) (*schedulingv1.PriorityClassList, error) {
-	result, err := c.backend.ListClusterScoped(ctx, "priorityclasses", opts, &schedulingv1.PriorityClassList{})
-	if err != nil {
-		return nil, err
-	}
-	return result.(*schedulingv1.PriorityClassList), nil
+	return c.priorityClassClient.List(ctx, opts)
 }
 
 func (c *SchedulingK8sIoV1) WatchPriorityClass(ctx context.Context, opts metav1.ListOptions) (watch.Interface, error) {
-	return c.backend.WatchClusterScoped(ctx, schema.GroupVersionResource{Group: ".scheduling.k8s.io", Version: "v1", Resource: "priorityclasses"}, opts)
+	return c.priorityClassClient.Watch(ctx, opts)
 }
 
 type StorageK8sIoV1 struct {
 	backend Backend
 	config  *rest.Config
+
+	cSIDriverClient             *typedclient.ClusterResourceClient[*storagev1.CSIDriver, *storagev1.CSIDriverList]
+	cSINodeClient               *typedclient.ClusterResourceClient[*storagev1.CSINode, *storagev1.CSINodeList]
+	cSIStorageCapacityClient    *typedclient.ResourceClient[*storagev1.CSIStorageCapacity, *storagev1.CSIStorageCapacityList]
+	storageClassClient          *typedclient.ClusterResourceClient[*storagev1.StorageClass, *storagev1.StorageClassList]
+	volumeAttachmentClient      *typedclient.ClusterResourceClient[*storagev1.VolumeAttachment, *storagev1.VolumeAttachmentList]
+	volumeAttributesClassClient *typedclient.ClusterResourceClient[*storagev1.VolumeAttributesClass, *storagev1.VolumeAttributesClassList]
 }
 
 func NewStorageK8sIoV1Client(b Backend, config *rest.Config) *StorageK8sIoV1 {
-	return &StorageK8sIoV1{backend: b, config: config}
+	return &StorageK8sIoV1{
+		backend:                     b,
+		config:                      config,
+		cSIDriverClient:             typedclient.NewClusterResourceClient[*storagev1.CSIDriver, *storagev1.CSIDriverList](b, schema.GroupVersionResource{Group: "storage.k8s.io", Version: "v1", Resource: "csidrivers"}),
+		cSINodeClient:               typedclient.NewClusterResourceClient[*storagev1.CSINode, *storagev1.CSINodeList](b, schema.GroupVersionResource{Group: "storage.k8s.io", Version: "v1", Resource: "csinodes"}),
+		cSIStorageCapacityClient:    typedclient.NewResourceClient[*storagev1.CSIStorageCapacity, *storagev1.CSIStorageCapacityList](b, schema.GroupVersionResource{Group: "storage.k8s.io", Version: "v1", Resource: "csistoragecapacities"}),
+		storageClassClient:          typedclient.NewClusterResourceClient[*storagev1.StorageClass, *storagev1.StorageClassList](b, schema.GroupVersionResource{Group: "storage.k8s.io", Version: "v1", Resource: "storageclasses"}),
+		volumeAttachmentClient:      typedclient.NewClusterResourceClient[*storagev1.VolumeAttachment, *storagev1.VolumeAttachmentList](b, schema.GroupVersionResource{Group: "storage.k8s.io", Version: "v1", Resource: "volumeattachments"}),
+		volumeAttributesClassClient: typedclient.NewClusterResourceClient[*storagev1.VolumeAttributesClass, *storagev1.VolumeAttributesClassList](b, schema.GroupVersionResource{Group: "storage.k8s.io", Version: "v1", Resource: "volumeattributesclasses"}),
+	}
+}
+
+// CSIDrivers returns the client of CSIDriver.
+func (c *StorageK8sIoV1) CSIDrivers() *typedclient.ClusterResourceClient[*storagev1.CSIDriver, *storagev1.CSIDriverList] {
+	return c.cSIDriverClient
 }
 
 func (c *StorageK8sIoV1) GetCSIDriver(ctx context.Context, name string, opts metav1.GetOptions) (*storagev1.CSIDriver, error) {
-	result, err := c.backend.GetClusterScoped(ctx, "csidrivers", name, opts, &storagev1.CSIDriver{})
-	if err != nil {
-		return nil, err
-	}
-	return result.(*storagev1.CSIDriver), nil
+	return c.cSIDriverClient.Get(ctx, name, opts)
 }
 
 func (c *StorageK8sIoV1) CreateCSIDriver(ctx context.Context, v *storagev1.CSIDriver, opts metav1.CreateOptions) (*storagev1.CSIDriver, error) {
-	result, err := c.backend.CreateClusterScoped(ctx, "csidrivers", v, opts, &storagev1.CSIDriver{})
-	if err != nil {
-		return nil, err
-	}
-	return result.(*storagev1.CSIDriver), nil
+	return c.cSIDriverClient.Create(ctx, v, opts)
 }
 
 func (c *StorageK8sIoV1) UpdateCSIDriver(ctx context.Context, v *storagev1.CSIDriver, opts metav1.UpdateOptions) (*storagev1.CSIDriver, error) {
-	result, err := c.backend.UpdateClusterScoped(ctx, "csidrivers", v, opts, &storagev1.CSIDriver{})
-	if err != nil {
-		return nil, err
-	}
-	return result.(*storagev1.CSIDriver), nil
+	return c.cSIDriverClient.Update(ctx, v, opts)
+}
+
+func (c *StorageK8sIoV1) PatchCSIDriver(ctx context.Context, name string, pt types.PatchType, data []byte, opts metav1.PatchOptions) (*storagev1.CSIDriver, error) {
+	return c.cSIDriverClient.Patch(ctx, name, pt, data, opts)
 }
 
 func (c *StorageK8sIoV1) DeleteCSIDriver(ctx context.Context, name string, opts metav1.DeleteOptions) error {
-	return c.backend.DeleteClusterScoped(ctx, schema.GroupVersionResource{Group: ".storage.k8s.io", Version: "v1", Resource: "csidrivers"}, name, opts)
+	return c.cSIDriverClient.Delete(ctx, name, opts)
+}
+
+func (c *StorageK8sIoV1) DeleteCollectionCSIDriver(ctx context.Context, opts metav1.DeleteOptions, listOpts metav1.ListOptions) error {
+	return c.cSIDriverClient.DeleteCollection(ctx, opts, listOpts)
 }
 
 func (c *StorageK8sIoV1) ListCSIDriver(ctx context.Context, opts metav1.ListOptions) (*storagev1.CSIDriverList, error) {
-	result, err := c.backend.ListClusterScoped(ctx, "csidrivers", opts, &storagev1.CSIDriverList{})
-	if err != nil {
-		return nil, err
-	}
-	return result.(*storagev1.CSIDriverList), nil
+	return c.cSIDriverClient.List(ctx, opts)
 }
 
 func (c *StorageK8sIoV1) WatchCSIDriver(ctx context.Context, opts metav1.ListOptions) (watch.Interface, error) {
-	return c.backend.WatchClusterScoped(ctx, schema.GroupVersionResource{Group: ".storage.k8s.io", Version: "v1", Resource: "csidrivers"}, opts)
+	return c.cSIDriverClient.Watch(ctx, opts)
+}
+
+// CSINodes returns the client of CSINode.
+func (c *StorageK8sIoV1) CSINodes() *typedclient.ClusterResourceClient[*storagev1.CSINode, *storagev1.CSINodeList] {
+	return c.cSINodeClient
 }
 
 func (c *StorageK8sIoV1) GetCSINode(ctx context.Context, name string, opts metav1.GetOptions) (*storagev1.CSINode, error) {
-	result, err := c.backend.GetClusterScoped(ctx, "csinodes", name, opts, &storagev1.CSINode{})
-	if err != nil {
-		return nil, err
-	}
-	return result.(*storagev1.CSINode), nil
+	return c.cSINodeClient.Get(ctx, name, opts)
 }
 
 func (c *StorageK8sIoV1) CreateCSINode(ctx context.Context, v *storagev1.CSINode, opts metav1.CreateOptions) (*storagev1.CSINode, error) {
-	result, err := c.backend.CreateClusterScoped(ctx, "csinodes", v, opts, &storagev1.CSINode{})
-	if err != nil {
-		return nil, err
-	}
-	return result.(*storagev1.CSINode), nil
+	return c.cSINodeClient.Create(ctx, v, opts)
 }
 
 func (c *StorageK8sIoV1) UpdateCSINode(ctx context.Context, v *storagev1.CSINode, opts metav1.UpdateOptions) (*storagev1.CSINode, error) {
-	result, err := c.backend.UpdateClusterScoped(ctx, "csinodes", v, opts, &storagev1.CSINode{})
-	if err != nil {
-		return nil, err
-	}
-	return result.(*storagev1.CSINode), nil
+	return c.cSINodeClient.Update(ctx, v, opts)
+}
+
+func (c *StorageK8sIoV1) PatchCSINode(ctx context.Context, name string, pt types.PatchType, data []byte, opts metav1.PatchOptions) (*storagev1.CSINode, error) {
+	return c.cSINodeClient.Patch(ctx, name, pt, data, opts)
 }
 
 func (c *StorageK8sIoV1) DeleteCSINode(ctx context.Context, name string, opts metav1.DeleteOptions) error {
-	return c.backend.DeleteClusterScoped(ctx, schema.GroupVersionResource{Group: ".storage.k8s.io", Version: "v1", Resource: "csinodes"}, name, opts)
+	return c.cSINodeClient.Delete(ctx, name, opts)
+}
+
+func (c *StorageK8sIoV1) DeleteCollectionCSINode(ctx context.Context, opts metav1.DeleteOptions, listOpts metav1.ListOptions) error {
+	return c.cSINodeClient.DeleteCollection(ctx, opts, listOpts)
 }
 
 func (c *StorageK8sIoV1) ListCSINode(ctx context.Context, opts metav1.ListOptions) (*storagev1.CSINodeList, error) {
-	result, err := c.backend.ListClusterScoped(ctx, "csinodes", opts, &storagev1.CSINodeList{})
-	if err != nil {
-		return nil, err
-	}
-	return result.(*storagev1.CSINodeList), nil
+	return c.cSINodeClient.List(ctx, opts)
 }
 
 func (c *StorageK8sIoV1) WatchCSINode(ctx context.Context, opts metav1.ListOptions) (watch.Interface, error) {
-	return c.backend.WatchClusterScoped(ctx, schema.GroupVersionResource{Group: ".storage.k8s.io", Version: "v1", Resource: "csinodes"}, opts)
+	return c.cSINodeClient.Watch(ctx, opts)
 }
 
-func (c *StorageK8sIoV1) GetCSIStorageCapacity(ctx context.Context, namespace, name string, opts metav1.GetOptions) (*storagev1.CSIStorageCapacity, error) {
-	result, err := c.backend.Get(ctx, "csistoragecapacities", namespace, name, opts, &storagev1.CSIStorageCapacity{})
-	if err != nil {
-		return nil, err
-	}
-	return result.(*storagev1.CSIStorageCapacity), nil
+// CSIStorageCapacities returns the client of CSIStorageCapacity.
+func (c *StorageK8sIoV1) CSIStorageCapacities() *typedclient.ResourceClient[*storagev1.CSIStorageCapacity, *storagev1.CSIStorageCapacityList] {
+	return c.cSIStorageCapacityClient
+}
+
+func (c *StorageK8sIoV1) GetCSIStorageCapacity(ctx context.Context, namespace string, name string, opts metav1.GetOptions) (*storagev1.CSIStorageCapacity, error) {
+	return c.cSIStorageCapacityClient.Get(ctx, namespace, name, opts)
 }
 
 func (c *StorageK8sIoV1) CreateCSIStorageCapacity(ctx context.Context, v *storagev1.CSIStorageCapacity, opts metav1.CreateOptions) (*storagev1.CSIStorageCapacity, error) {
-	result, err := c.backend.Create(ctx, "csistoragecapacities", v, opts, &storagev1.CSIStorageCapacity{})
-	if err != nil {
-		return nil, err
-	}
-	return result.(*storagev1.CSIStorageCapacity), nil
+	return c.cSIStorageCapacityClient.Create(ctx, v, opts)
 }
 
 func (c *StorageK8sIoV1) UpdateCSIStorageCapacity(ctx context.Context, v *storagev1.CSIStorageCapacity, opts metav1.UpdateOptions) (*storagev1.CSIStorageCapacity, error) {
-	result, err := c.backend.Update(ctx, "csistoragecapacities", v, opts, &storagev1.CSIStorageCapacity{})
-	if err != nil {
-		return nil, err
-	}
-	return result.(*storagev1.CSIStorageCapacity), nil
+	return c.cSIStorageCapacityClient.Update(ctx, v, opts)
+}
+
+func (c *StorageK8sIoV1) PatchCSIStorageCapacity(ctx context.Context, namespace string, name string, pt types.PatchType, data []byte, opts metav1.PatchOptions) (*storagev1.CSIStorageCapacity, error) {
+	return c.cSIStorageCapacityClient.Patch(ctx, namespace, name, pt, data, opts)
+}
+
+func (c *StorageK8sIoV1) DeleteCSIStorageCapacity(ctx context.Context, namespace string, name string, opts metav1.DeleteOptions) error {
+	return c.cSIStorageCapacityClient.Delete(ctx, namespace, name, opts)
 }
 
-func (c *StorageK8sIoV1) DeleteCSIStorageCapacity(ctx context.Context, namespace, name string, opts metav1.DeleteOptions) error {
-	return c.backend.Delete(ctx, schema.GroupVersionResource{Group: ".storage.k8s.io", Version: "v1", Resource: "csistoragecapacities"}, namespace, name, opts)
+func (c *StorageK8sIoV1) DeleteCollectionCSIStorageCapacity(ctx context.Context, namespace string, opts metav1.DeleteOptions, listOpts metav1.ListOptions) error {
+	return c.cSIStorageCapacityClient.DeleteCollection(ctx, namespace, opts, listOpts)
 }
 
 func (c *StorageK8sIoV1) ListCSIStorageCapacity(ctx context.Context, namespace string, opts metav1.ListOptions) (*storagev1.CSIStorageCapacityList, error) {
-	result, err := c.backend.List(ctx, "csistoragecapacities", namespace, opts, &storagev1.CSIStorageCapacityList{})
-	if err != nil {
-		return nil, err
-	}
-	return result.(*storagev1.CSIStorageCapacityList), nil
+	return c.cSIStorageCapacityClient.List(ctx, namespace, opts)
 }
 
 func (c *StorageK8sIoV1) WatchCSIStorageCapacity(ctx context.Context, namespace string, opts metav1.ListOptions) (watch.Interface, error) {
-	return c.backend.Watch(ctx, schema.GroupVersionResource{Group: ".storage.k8s.io", Version: "v1", Resource: "csistoragecapacities"}, namespace, opts)
+	return c.cSIStorageCapacityClient.Watch(ctx, namespace, opts)
+}
+
+// StorageClasses returns the client of StorageClass.
+func (c *StorageK8sIoV1) StorageClasses() *typedclient.ClusterResourceClient[*storagev1.StorageClass, *storagev1.StorageClassList] {
+	return c.storageClassClient
 }
 
 func (c *StorageK8sIoV1) GetStorageClass(ctx context.Context, name string, opts metav1.GetOptions) (*storagev1.StorageClass, error) {
-	result, err := c.backend.GetClusterScoped(ctx, "storageclasses", name, opts, &storagev1.StorageClass{})
-	if err != nil {
-		return nil, err
-	}
-	return result.(*storagev1.StorageClass), nil
+	return c.storageClassClient.Get(ctx, name, opts)
 }
 
 func (c *StorageK8sIoV1) CreateStorageClass(ctx context.Context, v *storagev1.StorageClass, opts metav1.CreateOptions) (*storagev1.StorageClass, error) {
-	result, err := c.backend.CreateClusterScoped(ctx, "storageclasses", v, opts, &storagev1.StorageClass{})
-	if err != nil {
-		return nil, err
-	}
-	return result.(*storagev1.StorageClass), nil
+	return c.storageClassClient.Create(ctx, v, opts)
 }
 
 func (c *StorageK8sIoV1) UpdateStorageClass(ctx context.Context, v *storagev1.StorageClass, opts metav1.UpdateOptions) (*storagev1.StorageClass, error) {
-	result, err := c.backend.UpdateClusterScoped(ctx, "storageclasses", v, opts, &storagev1.StorageClass{})
-	if err != nil {
-		return nil, err
-	}
-	return result.(*storagev1.StorageClass), nil
+	return c.storageClassClient.Update(ctx, v, opts)
+}
+
+func (c *StorageK8sIoV1) PatchStorageClass(ctx context.Context, name string, pt types.PatchType, data []byte, opts metav1.PatchOptions) (*storagev1.StorageClass, error) {
+	return c.storageClassClient.Patch(ctx, name, pt, data, opts)
 }
 
 func (c *StorageK8sIoV1) DeleteStorageClass(ctx context.Context, name string, opts metav1.DeleteOptions) error {
-	return c.backend.DeleteClusterScoped(ctx, schema.GroupVersionResource{Group: ".storage.k8s.io", Version: "v1", Resource: "storageclasses"}, name, opts)
+	return c.storageClassClient.Delete(ctx, name, opts)
+}
+
+func (c *StorageK8sIoV1) DeleteCollectionStorageClass(ctx context.Context, opts metav1.DeleteOptions, listOpts metav1.ListOptions) error {
+	return c.storageClassClient.DeleteCollection(ctx, opts, listOpts)
 }
 
 func (c *StorageK8sIoV1) ListStorageClass(ctx context.Context, opts metav1.ListOptions) (*storagev1.StorageClassList, error) {
-	result, err := c.backend.ListClusterScoped(ctx, "storageclasses", opts, &storagev1.StorageClassList{})
-	if err != nil {
-		return nil, err
-	}
-	return result.(*storagev1.StorageClassList), nil
+	return c.storageClassClient.List(ctx, opts)
 }
 
 func (c *StorageK8sIoV1) WatchStorageClass(ctx context.Context, opts metav1.ListOptions) (watch.Interface, error) {
-	return c.backend.WatchClusterScoped(ctx, schema.GroupVersionResource{Group: ".storage.k8s.io", Version: "v1", Resource: "storageclasses"}, opts)
+	return c.storageClassClient.Watch(ctx, opts)
+}
+
+// VolumeAttachments returns the client of VolumeAttachment.
+func (c *StorageK8sIoV1) VolumeAttachments() *typedclient.ClusterResourceClient[*storagev1.VolumeAttachment, *storagev1.VolumeAttachmentList] {
+	return c.volumeAttachmentClient
 }
 
 func (c *StorageK8sIoV1) GetVolumeAttachment(ctx context.Context, name string, opts metav1.GetOptions) (*storagev1.VolumeAttachment, error) {
-	result, err := c.backend.GetClusterScoped(ctx, "volumeattachments", name, opts, &storagev1.VolumeAttachment{})
-	if err != nil {
-		return nil, err
-	}
-	return result.(*storagev1.VolumeAttachment), nil
+	return c.volumeAttachmentClient.Get(ctx, name, opts)
 }
 
 func (c *StorageK8sIoV1) CreateVolumeAttachment(ctx context.Context, v *storagev1.VolumeAttachment, opts metav1.CreateOptions) (*storagev1.VolumeAttachment, error) {
-	result, err := c.backend.CreateClusterScoped(ctx, "volumeattachments", v, opts, &storagev1.VolumeAttachment{})
-	if err != nil {
-		return nil, err
-	}
-	return result.(*storagev1.VolumeAttachment), nil
+	return c.volumeAttachmentClient.Create(ctx, v, opts)
 }
 
 func (c *StorageK8sIoV1) UpdateVolumeAttachment(ctx context.Context, v *storagev1.VolumeAttachment, opts metav1.UpdateOptions) (*storagev1.VolumeAttachment, error) {
-	result, err := c.backend.UpdateClusterScoped(ctx, "volumeattachments", v, opts, &storagev1.VolumeAttachment{})
-	if err != nil {
-		return nil, err
-	}
-	return result.(*storagev1.VolumeAttachment), nil
+	return c.volumeAttachmentClient.Update(ctx, v, opts)
 }
 
 func (c *StorageK8sIoV1) UpdateStatusVolumeAttachment(ctx context.Context, v *storagev1.VolumeAttachment, opts metav1.UpdateOptions) (*storagev1.VolumeAttachment, error) {
-	result, err := c.backend.UpdateStatusClusterScoped(ctx, "volumeattachments", v, opts, &storagev1.VolumeAttachment{})
-	if err != nil {
-		return nil, err
-	}
-	return result.(*storagev1.VolumeAttachment), nil
+	return c.volumeAttachmentClient.UpdateStatus(ctx, v, opts)
+}
+
+func (c *StorageK8sIoV1) PatchVolumeAttachment(ctx context.Context, name string, pt types.PatchType, data []byte, opts metav1.PatchOptions) (*storagev1.VolumeAttachment, error) {
+	return c.volumeAttachmentClient.Patch(ctx, name, pt, data, opts)
+}
+
+func (c *StorageK8sIoV1) PatchVolumeAttachmentStatus(ctx context.Context, name string, pt types.PatchType, data []byte, opts metav1.PatchOptions) (*storagev1.VolumeAttachment, error) {
+	return c.volumeAttachmentClient.Patch(ctx, name, pt, data, opts, "status")
 }
 
 func (c *StorageK8sIoV1) DeleteVolumeAttachment(ctx context.Context, name string, opts metav1.DeleteOptions) error {
-	return c.backend.DeleteClusterScoped(ctx, schema.GroupVersionResource{Group: ".storage.k8s.io", Version: "v1", Resource: "volumeattachments"}, name, opts)
+	return c.volumeAttachmentClient.Delete(ctx, name, opts)
+}
+
+func (c *StorageK8sIoV1) DeleteCollectionVolumeAttachment(ctx context.Context, opts metav1.DeleteOptions, listOpts metav1.ListOptions) error {
+	return c.volumeAttachmentClient.DeleteCollection(ctx, opts, listOpts)
 }
 
 func (c *StorageK8sIoV1) ListVolumeAttachment(ctx context.Context, opts metav1.ListOptions) (*storagev1.VolumeAttachmentList, error) {
-	result, err := c.backend.ListClusterScoped(ctx, "volumeattachments", opts, &storagev1.VolumeAttachmentList{})
-	if err != nil {
-		return nil, err
-	}
-	return result.(*storagev1.VolumeAttachmentList), nil
+	return c.volumeAttachmentClient.List(ctx, opts)
 }
 
 func (c *StorageK8sIoV1) WatchVolumeAttachment(ctx context.Context, opts metav1.ListOptions) (watch.Interface, error) {
-	return c.backend.WatchClusterScoped(ctx, schema.GroupVersionResource{Group: ".storage.k8s.io", Version: "v1", Resource: "volumeattachments"}, opts)
+	return c.volumeAttachmentClient.Watch(ctx, opts)
+}
+
+// VolumeAttributesClasses returns the client of VolumeAttributesClass.
+func (c *StorageK8sIoV1) VolumeAttributesClasses() *typedclient.ClusterResourceClient[*storagev1.VolumeAttributesClass, *storagev1.VolumeAttributesClassList] {
+	return c.volumeAttributesClassClient
 }
 
 func (c *StorageK8sIoV1) GetVolumeAttributesClass(ctx context.Context, name string, opts metav1.GetOptions) (*storagev1.VolumeAttributesClass, error) {
-	result, err := c.backend.GetClusterScoped(ctx, "volumeattributesclasses", name, opts, &storagev1.VolumeAttributesClass{})
-	if err != nil {
-		return nil, err
-	}
-	return result.(*storagev1.VolumeAttributesClass), nil
+	return c.volumeAttributesClassClient.Get(ctx, name, opts)
 }
 
 func (c *StorageK8sIoV1) CreateVolumeAttributesClass(ctx context.Context, v *storagev1.VolumeAttributesClass, opts metav1.CreateOptions) (*storagev1.VolumeAttributesClass, error) {
-	result, err := c.backend.CreateClusterScoped(ctx, "volumeattributesclasses", v, opts, &storagev1.VolumeAttributesClass{})
-	if err != nil {
-		return nil, err
-	}
-	return result.(*storagev1.VolumeAttributesClass), nil
+	return c.volumeAttributesClassClient.Create(ctx, v, opts)
 }
 
 func (c *StorageK8sIoV1) UpdateVolumeAttributesClass(ctx context.Context, v *storagev1.VolumeAttributesClass, opts metav1.UpdateOptions) (*storagev1.VolumeAttributesClass, error) {
-	result, err := c.backend.UpdateClusterScoped(ctx, "volumeattributesclasses", v, opts, &storagev1.VolumeAttributesClass{})
-	if err != nil {
-		return nil, err
-	}
-	return result.(*storagev1.VolumeAttributesClass), nil
+	return c.volumeAttributesClassClient.Update(ctx, v, opts)
+}
+
+func (c *StorageK8sIoV1) PatchVolumeAttributesClass(ctx context.Context, name string, pt types.PatchType, data []byte, opts metav1.PatchOptions) (*storagev1.VolumeAttributesClass, error) {
+	return c.volumeAttributesClassClient.Patch(ctx, name, pt, data, opts)
 }
 
 func (c *StorageK8sIoV1) DeleteVolumeAttributesClass(ctx context.Context, name string, opts metav1.DeleteOptions) error {
-	return c.backend.DeleteClusterScoped(ctx, schema.GroupVersionResource{Group: ".storage.k8s.io", Version: "v1", Resource: "volumeattributesclasses"}, name, opts)
+	return c.volumeAttributesClassClient.Delete(ctx, name, opts)
+}
+
+func (c *StorageK8sIoV1) DeleteCollectionVolumeAttributesClass(ctx context.Context, opts metav1.DeleteOptions, listOpts metav1.ListOptions) error {
+	return c.volumeAttributesClassClient.DeleteCollection(ctx, opts, listOpts)
 }
 
 func (c *StorageK8sIoV1) ListVolumeAttributesClass(ctx context.Context, opts metav1.ListOptions) (*storagev1.VolumeAttributesClassList, error) {
-	result, err := c.backend.ListClusterScoped(ctx, "volumeattributesclasses", opts, &storagev1.VolumeAttributesClassList{})
-	if err != nil {
-		return nil, err
-	}
-	return result.(*storagev1.VolumeAttributesClassList), nil
+	return c.volumeAttributesClassClient.List(ctx, opts)
 }
 
 func (c *StorageK8sIoV1) WatchVolumeAttributesClass(ctx context.Context, opts metav1.ListOptions) (watch.Interface, error) {
-	return c.backend.WatchClusterScoped(ctx, schema.GroupVersionResource{Group: ".storage.k8s.io", Version: "v1", Resource: "volumeattributesclasses"}, opts)
+	return c.volumeAttributesClassClient.Watch(ctx, opts)
+}
+
+// InformerOptions is the options of the informers.
+type InformerOptions struct {
+	// Namespaces is the set of the namespaces which are watched by one informer.
+	// All namespaces are watched if Namespaces is empty. Namespaces is ignored by the cluster scoped resources.
+	Namespaces    []string
+	LabelSelector string
+	FieldSelector string
+	// TweakListOptions modifies the options of the list and the watch.
+	// The informers are shared if the options which are modified by TweakListOptions are the same.
+	TweakListOptions func(*k8smetav1.ListOptions)
+}
+
+func (o InformerOptions) namespaces() []string {
+	var namespaces []string
+	for _, v := range o.Namespaces {
+		if v == metav1.NamespaceAll {
+			return nil
+		}
+		namespaces = append(namespaces, v)
+	}
+	sort.Strings(namespaces)
+	return namespaces
+}
+
+// key returns the key of InformerCache.
+// The key consists of the namespaces and the list options which are modified by the options.
+func (o InformerOptions) key(namespaced bool) string {
+	var namespaces []string
+	if namespaced {
+		namespaces = o.namespaces()
+	}
+	listOptions := &k8smetav1.ListOptions{}
+	o.tweakListOptions(listOptions)
+	return strings.Join(namespaces, ",") + "/" + listOptions.String()
+}
+
+func (o InformerOptions) tweakListOptions(options *k8smetav1.ListOptions) {
+	if o.LabelSelector != "" {
+		options.LabelSelector = o.LabelSelector
+	}
+	if o.FieldSelector != "" {
+		options.FieldSelector = o.FieldSelector
+	}
+	if o.TweakListOptions != nil {
+		o.TweakListOptions(options)
+	}
+}
+
+type informerCacheKey struct {
+	typ    reflect.Type
+	filter string
 }
 
 type InformerCache struct {
 	mu        sync.Mutex
-	informers map[reflect.Type]cache.SharedIndexInformer
+	informers map[informerCacheKey]cache.SharedIndexInformer
 }
 
 func NewInformerCache() *InformerCache {
-	return &InformerCache{informers: make(map[reflect.Type]cache.SharedIndexInformer)}
+	return &InformerCache{informers: make(map[informerCacheKey]cache.SharedIndexInformer)}
 }
 
 func (c *InformerCache) Write(obj runtime.Object, newFunc func() cache.SharedIndexInformer) cache.SharedIndexInformer {
+	return c.WriteWithFilter(obj, "", newFunc)
+}
+
+// WriteWithFilter returns the informer of obj and filter. newFunc is called if the informer doesn't exist.
+// filter identifies the informers of the same type which watch the different objects.
+func (c *InformerCache) WriteWithFilter(obj runtime.Object, filter string, newFunc func() cache.SharedIndexInformer) cache.SharedIndexInformer {
 	c.mu.Lock()
 	defer c.mu.Unlock()
 
-	typ := reflect.TypeOf(obj)
-	if v, ok := c.informers[typ]; ok {
+	key := informerCacheKey{typ: reflect.TypeOf(obj), filter: filter}
+	if v, ok := c.informers[key]; ok {
 		return v
 	}
 	informer := newFunc()
-	c.informers[typ] = informer
+	c.informers[key] = informer
 
 	return informer
 }
@@ -3702,156 +3660,183 @@ type InformerFactory struct {
 	set   *Set
 	cache *InformerCache
 
-	namespace    string
+	options      InformerOptions
 	resyncPeriod time.Duration
 }
 
 func NewInformerFactory(s *Set, c *InformerCache, namespace string, resyncPeriod time.Duration) *InformerFactory {
-	return &InformerFactory{set: s, cache: c, namespace: namespace, resyncPeriod: resyncPeriod}
+	return NewInformerFactoryWithOptions(s, c, resyncPeriod, InformerOptions{Namespaces: []string{namespace}})
+}
+
+// NewInformerFactoryWithOptions returns InformerFactory of which informers are filtered by opts.
+// The informers are shared with the other factories which have the same cache and the same filter.
+func NewInformerFactoryWithOptions(s *Set, c *InformerCache, resyncPeriod time.Duration, opts InformerOptions) *InformerFactory {
+	return &InformerFactory{set: s, cache: c, options: opts, resyncPeriod: resyncPeriod}
 }
 
 func (f *InformerFactory) Cache() *InformerCache {
 	return f.cache
 }
 
+// AddIndexers adds indexers to the informer of obj. The indexer which has the same name as the existing indexer is ignored.
+// The indexers should be added before the informer starts because the existing objects are indexed again.
+func (f *InformerFactory) AddIndexers(obj runtime.Object, indexers cache.Indexers) error {
+	informer := f.InformerFor(obj)
+	if informer == nil {
+		return fmt.Errorf("unknown object: %T", obj)
+	}
+	existing := informer.GetIndexer().GetIndexers()
+	newIndexers := make(cache.Indexers)
+	for k, v := range indexers {
+		if _, ok := existing[k]; ok {
+			continue
+		}
+		newIndexers[k] = v
+	}
+	if len(newIndexers) == 0 {
+		return nil
+	}
+	return informer.AddIndexers(newIndexers)
+}
+
 func (f *InformerFactory) InformerFor(obj runtime.Object) cache.SharedIndexInformer {
 	switch obj.(type) {
 	case *corev1.Binding:
-		return NewCoreV1Informer(f.cache, f.set.CoreV1, f.namespace, f.resyncPeriod).BindingInformer()
+		return NewCoreV1InformerWithOptions(f.cache, f.set.CoreV1, f.resyncPeriod, f.options).BindingInformer()
 	case *corev1.ComponentStatus:
-		return NewCoreV1Informer(f.cache, f.set.CoreV1, f.namespace, f.resyncPeriod).ComponentStatusInformer()
+		return NewCoreV1InformerWithOptions(f.cache, f.set.CoreV1, f.resyncPeriod, f.options).ComponentStatusInformer()
 	case *corev1.ConfigMap:
-		return NewCoreV1Informer(f.cache, f.set.CoreV1, f.namespace, f.resyncPeriod).ConfigMapInformer()
+		return NewCoreV1InformerWithOptions(f.cache, f.set.CoreV1, f.resyncPeriod, f.options).ConfigMapInformer()
 	case *corev1.Endpoints:
-		return NewCoreV1Informer(f.cache, f.set.CoreV1, f.namespace, f.resyncPeriod).EndpointsInformer()
+		return NewCoreV1InformerWithOptions(f.cache, f.set.CoreV1, f.resyncPeriod, f.options).EndpointsInformer()
 	case *corev1.Event:
-		return NewCoreV1Informer(f.cache, f.set.CoreV1, f.namespace, f.resyncPeriod).EventInformer()
+		return NewCoreV1InformerWithOptions(f.cache, f.set.CoreV1, f.resyncPeriod, f.options).EventInformer()
 	case *corev1.LimitRange:
-		return NewCoreV1Informer(f.cache, f.set.CoreV1, f.namespace, f.resyncPeriod).LimitRangeInformer()
+		return NewCoreV1InformerWithOptions(f.cache, f.set.CoreV1, f.resyncPeriod, f.options).LimitRangeInformer()
 	case *corev1.Namespace:
-		return NewCoreV1Informer(f.cache, f.set.CoreV1, f.namespace, f.resyncPeriod).NamespaceInformer()
+		return NewCoreV1InformerWithOptions(f.cache, f.set.CoreV1, f.resyncPeriod, f.options).NamespaceInformer()
 	case *corev1.Node:
-		return NewCoreV1Informer(f.cache, f.set.CoreV1, f.namespace, f.resyncPeriod).NodeInformer()
+		return NewCoreV1InformerWithOptions(f.cache, f.set.CoreV1, f.resyncPeriod, f.options).NodeInformer()
 	case *corev1.PersistentVolume:
-		return NewCoreV1Informer(f.cache, f.set.CoreV1, f.namespace, f.resyncPeriod).PersistentVolumeInformer()
+		return NewCoreV1InformerWithOptions(f.cache, f.set.CoreV1, f.resyncPeriod, f.options).PersistentVolumeInformer()
 	case *corev1.PersistentVolumeClaim:
-		return NewCoreV1Informer(f.cache, f.set.CoreV1, f.namespace, f.resyncPeriod).PersistentVolumeClaimInformer()
+		return NewCoreV1InformerWithOptions(f.cache, f.set.CoreV1, f.resyncPeriod, f.options).PersistentVolumeClaimInformer()
 	case *corev1.Pod:
-		return NewCoreV1Informer(f.cache, f.set.CoreV1, f.namespace, f.resyncPeriod).PodInformer()
+		return NewCoreV1InformerWithOptions(f.cache, f.set.CoreV1, f.resyncPeriod, f.options).PodInformer()
 	case *corev1.PodStatusResult:
-		return NewCoreV1Informer(f.cache, f.set.CoreV1, f.namespace, f.resyncPeriod).PodStatusResultInformer()
+		return NewCoreV1InformerWithOptions(f.cache, f.set.CoreV1, f.resyncPeriod, f.options).PodStatusResultInformer()
 	case *corev1.PodTemplate:
-		return NewCoreV1Informer(f.cache, f.set.CoreV1, f.namespace, f.resyncPeriod).PodTemplateInformer()
+		return NewCoreV1InformerWithOptions(f.cache, f.set.CoreV1, f.resyncPeriod, f.options).PodTemplateInformer()
 	case *corev1.RangeAllocation:
-		return NewCoreV1Informer(f.cache, f.set.CoreV1, f.namespace, f.resyncPeriod).RangeAllocationInformer()
+		return NewCoreV1InformerWithOptions(f.cache, f.set.CoreV1, f.resyncPeriod, f.options).RangeAllocationInformer()
 	case *corev1.ReplicationController:
-		return NewCoreV1Informer(f.cache, f.set.CoreV1, f.namespace, f.resyncPeriod).ReplicationControllerInformer()
+		return NewCoreV1InformerWithOptions(f.cache, f.set.CoreV1, f.resyncPeriod, f.options).ReplicationControllerInformer()
 	case *corev1.ResourceQuota:
-		return NewCoreV1Informer(f.cache, f.set.CoreV1, f.namespace, f.resyncPeriod).ResourceQuotaInformer()
+		return NewCoreV1InformerWithOptions(f.cache, f.set.CoreV1, f.resyncPeriod, f.options).ResourceQuotaInformer()
 	case *corev1.Secret:
-		return NewCoreV1Informer(f.cache, f.set.CoreV1, f.namespace, f.resyncPeriod).SecretInformer()
+		return NewCoreV1InformerWithOptions(f.cache, f.set.CoreV1, f.resyncPeriod, f.options).SecretInformer()
 	case *corev1.Service:
-		return NewCoreV1Informer(f.cache, f.set.CoreV1, f.namespace, f.resyncPeriod).ServiceInformer()
+		return NewCoreV1InformerWithOptions(f.cache, f.set.CoreV1, f.resyncPeriod, f.options).ServiceInformer()
 	case *corev1.ServiceAccount:
-		return NewCoreV1Informer(f.cache, f.set.CoreV1, f.namespace, f.resyncPeriod).ServiceAccountInformer()
+		return NewCoreV1InformerWithOptions(f.cache, f.set.CoreV1, f.resyncPeriod, f.options).ServiceAccountInformer()
 	case *admissionregistrationv1.MutatingAdmissionPolicy:
-		return NewAdmissionregistrationK8sIoV1Informer(f.cache, f.set.AdmissionregistrationK8sIoV1, f.namespace, f.resyncPeriod).MutatingAdmissionPolicyInformer()
+		return NewAdmissionregistrationK8sIoV1InformerWithOptions(f.cache, f.set.AdmissionregistrationK8sIoV1, f.resyncPeriod, f.options).MutatingAdmissionPolicyInformer()
 	case *admissionregistrationv1.MutatingAdmissionPolicyBinding:
-		return NewAdmissionregistrationK8sIoV1Informer(f.cache, f.set.AdmissionregistrationK8sIoV1, f.namespace, f.resyncPeriod).MutatingAdmissionPolicyBindingInformer()
+		return NewAdmissionregistrationK8sIoV1InformerWithOptions(f.cache, f.set.AdmissionregistrationK8sIoV1, f.resyncPeriod, f.options).MutatingAdmissionPolicyBindingInformer()
 	case *admissionregistrationv1.MutatingWebhookConfiguration:
-		return NewAdmissionregistrationK8sIoV1Informer(f.cache, f.set.AdmissionregistrationK8sIoV1, f.namespace, f.resyncPeriod).MutatingWebhookConfigurationInformer()
+		return NewAdmissionregistrationK8sIoV1InformerWithOptions(f.cache, f.set.AdmissionregistrationK8sIoV1, f.resyncPeriod, f.options).MutatingWebhookConfigurationInformer()
 	case *admissionregistrationv1.ValidatingAdmissionPolicy:
-		return NewAdmissionregistrationK8sIoV1Informer(f.cache, f.set.AdmissionregistrationK8sIoV1, f.namespace, f.resyncPeriod).ValidatingAdmissionPolicyInformer()
+		return NewAdmissionregistrationK8sIoV1InformerWithOptions(f.cache, f.set.AdmissionregistrationK8sIoV1, f.resyncPeriod, f.options).ValidatingAdmissionPolicyInformer()
 	case *admissionregistrationv1.ValidatingAdmissionPolicyBinding:
-		return NewAdmissionregistrationK8sIoV1Informer(f.cache, f.set.AdmissionregistrationK8sIoV1, f.namespace, f.resyncPeriod).ValidatingAdmissionPolicyBindingInformer()
+		return NewAdmissionregistrationK8sIoV1InformerWithOptions(f.cache, f.set.AdmissionregistrationK8sIoV1, f.resyncPeriod, f.options).ValidatingAdmissionPolicyBindingInformer()
 	case *admissionregistrationv1.ValidatingWebhookConfiguration:
-		return NewAdmissionregistrationK8sIoV1Informer(f.cache, f.set.AdmissionregistrationK8sIoV1, f.namespace, f.resyncPeriod).ValidatingWebhookConfigurationInformer()
+		return NewAdmissionregistrationK8sIoV1InformerWithOptions(f.cache, f.set.AdmissionregistrationK8sIoV1, f.resyncPeriod, f.options).ValidatingWebhookConfigurationInformer()
 	case *appsv1.ControllerRevision:
-		return NewAppsV1Informer(f.cache, f.set.AppsV1, f.namespace, f.resyncPeriod).ControllerRevisionInformer()
+		return NewAppsV1InformerWithOptions(f.cache, f.set.AppsV1, f.resyncPeriod, f.options).ControllerRevisionInformer()
 	case *appsv1.DaemonSet:
-		return NewAppsV1Informer(f.cache, f.set.AppsV1, f.namespace, f.resyncPeriod).DaemonSetInformer()
+		return NewAppsV1InformerWithOptions(f.cache, f.set.AppsV1, f.resyncPeriod, f.options).DaemonSetInformer()
 	case *appsv1.Deployment:
-		return NewAppsV1Informer(f.cache, f.set.AppsV1, f.namespace, f.resyncPeriod).DeploymentInformer()
+		return NewAppsV1InformerWithOptions(f.cache, f.set.AppsV1, f.resyncPeriod, f.options).DeploymentInformer()
 	case *appsv1.ReplicaSet:
-		return NewAppsV1Informer(f.cache, f.set.AppsV1, f.namespace, f.resyncPeriod).ReplicaSetInformer()
+		return NewAppsV1InformerWithOptions(f.cache, f.set.AppsV1, f.resyncPeriod, f.options).ReplicaSetInformer()
 	case *appsv1.StatefulSet:
-		return NewAppsV1Informer(f.cache, f.set.AppsV1, f.namespace, f.resyncPeriod).StatefulSetInformer()
+		return NewAppsV1InformerWithOptions(f.cache, f.set.AppsV1, f.resyncPeriod, f.options).StatefulSetInformer()
 	case *authenticationv1.SelfSubjectReview:
-		return NewAuthenticationK8sIoV1Informer(f.cache, f.set.AuthenticationK8sIoV1, f.namespace, f.resyncPeriod).SelfSubjectReviewInformer()
+		return NewAuthenticationK8sIoV1InformerWithOptions(f.cache, f.set.AuthenticationK8sIoV1, f.resyncPeriod, f.options).SelfSubjectReviewInformer()
 	case *authenticationv1.TokenRequest:
-		return NewAuthenticationK8sIoV1Informer(f.cache, f.set.AuthenticationK8sIoV1, f.namespace, f.resyncPeriod).TokenRequestInformer()
+		return NewAuthenticationK8sIoV1InformerWithOptions(f.cache, f.set.AuthenticationK8sIoV1, f.resyncPeriod, f.options).TokenRequestInformer()
 	case *authenticationv1.TokenReview:
-		return NewAuthenticationK8sIoV1Informer(f.cache, f.set.AuthenticationK8sIoV1, f.namespace, f.resyncPeriod).TokenReviewInformer()
+		return NewAuthenticationK8sIoV1InformerWithOptions(f.cache, f.set.AuthenticationK8sIoV1, f.resyncPeriod, f.options).TokenReviewInformer()
 	case *authorizationv1.LocalSubjectAccessReview:
-		return NewAuthorizationK8sIoV1Informer(f.cache, f.set.AuthorizationK8sIoV1, f.namespace, f.resyncPeriod).LocalSubjectAccessReviewInformer()
+		return NewAuthorizationK8sIoV1InformerWithOptions(f.cache, f.set.AuthorizationK8sIoV1, f.resyncPeriod, f.options).LocalSubjectAccessReviewInformer()
 	case *authorizationv1.SelfSubjectAccessReview:
-		return NewAuthorizationK8sIoV1Informer(f.cache, f.set.AuthorizationK8sIoV1, f.namespace, f.resyncPeriod).SelfSubjectAccessReviewInformer()
+		return NewAuthorizationK8sIoV1InformerWithOptions(f.cache, f.set.AuthorizationK8sIoV1, f.resyncPeriod, f.options).SelfSubjectAccessReviewInformer()
 	case *authorizationv1.SelfSubjectRulesReview:
-		return NewAuthorizationK8sIoV1Informer(f.cache, f.set.AuthorizationK8sIoV1, f.namespace, f.resyncPeriod).SelfSubjectRulesReviewInformer()
+		return NewAuthorizationK8sIoV1InformerWithOptions(f.cache, f.set.AuthorizationK8sIoV1, f.resyncPeriod, f.options).SelfSubjectRulesReviewInformer()
 	case *authorizationv1.SubjectAccessReview:
-		return NewAuthorizationK8sIoV1Informer(f.cache, f.set.AuthorizationK8sIoV1, f.namespace, f.resyncPeriod).SubjectAccessReviewInformer()
+		return NewAuthorizationK8sIoV1InformerWithOptions(f.cache, f.set.AuthorizationK8sIoV1, f.resyncPeriod, f.options).SubjectAccessReviewInformer()
 	case *autoscalingv1.HorizontalPodAutoscaler:
-		return NewAutoscalingV1Informer(f.cache, f.set.AutoscalingV1, f.namespace, f.resyncPeriod).HorizontalPodAutoscalerInformer()
+		return NewAutoscalingV1InformerWithOptions(f.cache, f.set.AutoscalingV1, f.resyncPeriod, f.options).HorizontalPodAutoscalerInformer()
 	case *autoscalingv1.Scale:
-		return NewAutoscalingV1Informer(f.cache, f.set.AutoscalingV1, f.namespace, f.resyncPeriod).ScaleInformer()
+		return NewAutoscalingV1InformerWithOptions(f.cache, f.set.AutoscalingV1, f.resyncPeriod, f.options).ScaleInformer()
 	case *autoscalingv2.HorizontalPodAutoscaler:
-		return NewAutoscalingV2Informer(f.cache, f.set.AutoscalingV2, f.namespace, f.resyncPeriod).HorizontalPodAutoscalerInformer()
+		return NewAutoscalingV2InformerWithOptions(f.cache, f.set.AutoscalingV2, f.resyncPeriod, f.options).HorizontalPodAutoscalerInformer()
 	case *batchv1.CronJob:
-		return NewBatchV1Informer(f.cache, f.set.BatchV1, f.namespace, f.resyncPeriod).CronJobInformer()
+		return NewBatchV1InformerWithOptions(f.cache, f.set.BatchV1, f.resyncPeriod, f.options).CronJobInformer()
 	case *batchv1.Job:
-		return NewBatchV1Informer(f.cache, f.set.BatchV1, f.namespace, f.resyncPeriod).JobInformer()
+		return NewBatchV1InformerWithOptions(f.cache, f.set.BatchV1, f.resyncPeriod, f.options).JobInformer()
 	case *certificatesv1.CertificateSigningRequest:
-		return NewCertificatesK8sIoV1Informer(f.cache, f.set.CertificatesK8sIoV1, f.namespace, f.resyncPeriod).CertificateSigningRequestInformer()
+		return NewCertificatesK8sIoV1InformerWithOptions(f.cache, f.set.CertificatesK8sIoV1, f.resyncPeriod, f.options).CertificateSigningRequestInformer()
 	case *coordinationv1.Lease:
-		return NewCoordinationK8sIoV1Informer(f.cache, f.set.CoordinationK8sIoV1, f.namespace, f.resyncPeriod).LeaseInformer()
+		return NewCoordinationK8sIoV1InformerWithOptions(f.cache, f.set.CoordinationK8sIoV1, f.resyncPeriod, f.options).LeaseInformer()
 	case *discoveryv1.EndpointSlice:
-		return NewDiscoveryK8sIoV1Informer(f.cache, f.set.DiscoveryK8sIoV1, f.namespace, f.resyncPeriod).EndpointSliceInformer()
+		return NewDiscoveryK8sIoV1InformerWithOptions(f.cache, f.set.DiscoveryK8sIoV1, f.resyncPeriod, f.options).EndpointSliceInformer()
 	case *eventsv1.Event:
-		return NewEventsK8sIoV1Informer(f.cache, f.set.EventsK8sIoV1, f.namespace, f.resyncPeriod).EventInformer()
+		return NewEventsK8sIoV1InformerWithOptions(f.cache, f.set.EventsK8sIoV1, f.resyncPeriod, f.options).EventInformer()
 	case *networkingv1.IPAddress:
-		return NewNetworkingK8sIoV1Informer(f.cache, f.set.NetworkingK8sIoV1, f.namespace, f.resyncPeriod).IPAddressInformer()
+		return NewNetworkingK8sIoV1InformerWithOptions(f.cache, f.set.NetworkingK8sIoV1, f.resyncPeriod, f.options).IPAddressInformer()
 	case *networkingv1.Ingress:
-		return NewNetworkingK8sIoV1Informer(f.cache, f.set.NetworkingK8sIoV1, f.namespace, f.resyncPeriod).IngressInformer()
+		return NewNetworkingK8sIoV1InformerWithOptions(f.cache, f.set.NetworkingK8sIoV1, f.resyncPeriod, f.options).IngressInformer()
 	case *networkingv1.IngressClass:
-		return NewNetworkingK8sIoV1Informer(f.cache, f.set.NetworkingK8sIoV1, f.namespace, f.resyncPeriod).IngressClassInformer()
+		return NewNetworkingK8sIoV1InformerWithOptions(f.cache, f.set.NetworkingK8sIoV1, f.resyncPeriod, f.options).IngressClassInformer()
 	case *networkingv1.NetworkPolicy:
-		return NewNetworkingK8sIoV1Informer(f.cache, f.set.NetworkingK8sIoV1, f.namespace, f.resyncPeriod).NetworkPolicyInformer()
+		return NewNetworkingK8sIoV1InformerWithOptions(f.cache, f.set.NetworkingK8sIoV1, f.resyncPeriod, f.options).NetworkPolicyInformer()
 	case *networkingv1.ServiceCIDR:
-		return NewNetworkingK8sIoV1Informer(f.cache, f.set.NetworkingK8sIoV1, f.namespace, f.resyncPeriod).ServiceCIDRInformer()
+		return NewNetworkingK8sIoV1InformerWithOptions(f.cache, f.set.NetworkingK8sIoV1, f.resyncPeriod, f.options).ServiceCIDRInformer()
 	case *policyv1.Eviction:
-		return NewPolicyV1Informer(f.cache, f.set.PolicyV1, f.namespace, f.resyncPeriod).EvictionInformer()
+		return NewPolicyV1InformerWithOptions(f.cache, f.set.PolicyV1, f.resyncPeriod, f.options).EvictionInformer()
 	case *policyv1.PodDisruptionBudget:
-		return NewPolicyV1Informer(f.cache, f.set.PolicyV1, f.namespace, f.resyncPeriod).PodDisruptionBudgetInformer()
+		return NewPolicyV1InformerWithOptions(f.cache, f.set.PolicyV1, f.resyncPeriod, f.options).PodDisruptionBudgetInformer()
 	case *rbacv1.ClusterRole:
-		return NewRbacAuthorizationK8sIoV1Informer(f.cache, f.set.RbacAuthorizationK8sIoV1, f.namespace, f.resyncPeriod).ClusterRoleInformer()
+		return NewRbacAuthorizationK8sIoV1InformerWithOptions(f.cache, f.set.RbacAuthorizationK8sIoV1, f.resyncPeriod, f.options).ClusterRoleInformer()
 	case *rbacv1.ClusterRoleBinding:
-		return NewRbacAuthorizationK8sIoV1Informer(f.cache, f.set.RbacAuthorizationK8sIoV1, f.namespace, f.resyncPeriod).ClusterRoleBindingInformer()
+		return NewRbacAuthorizationK8sIoV1InformerWithOptions(f.cache, f.set.RbacAuthorizationK8sIoV1, f.resyncPeriod, f.options).ClusterRoleBindingInformer()
 	case *rbacv1.Role:
-		return NewRbacAuthorizationK8sIoV1Informer(f.cache, f.set.RbacAuthorizationK8sIoV1, f.namespace, f.resyncPeriod).RoleInformer()
+		return NewRbacAuthorizationK8sIoV1InformerWithOptions(f.cache, f.set.RbacAuthorizationK8sIoV1, f.resyncPeriod, f.options).RoleInformer()
 	case *rbacv1.RoleBinding:
-		return NewRbacAuthorizationK8sIoV1Informer(f.cache, f.set.RbacAuthorizationK8sIoV1, f.namespace, f.resyncPeriod).RoleBindingInformer()
+		return NewRbacAuthorizationK8sIoV1InformerWithOptions(f.cache, f.set.RbacAuthorizationK8sIoV1, f.resyncPeriod, f.options).RoleBindingInformer()
 	case *resourcev1.DeviceClass:
-		return NewResourceV1Informer(f.cache, f.set.ResourceV1, f.namespace, f.resyncPeriod).DeviceClassInformer()
+		return NewResourceV1InformerWithOptions(f.cache, f.set.ResourceV1, f.resyncPeriod, f.options).DeviceClassInformer()
 	case *resourcev1.ResourceClaim:
-		return NewResourceV1Informer(f.cache, f.set.ResourceV1, f.namespace, f.resyncPeriod).ResourceClaimInformer()
+		return NewResourceV1InformerWithOptions(f.cache, f.set.ResourceV1, f.resyncPeriod, f.options).ResourceClaimInformer()
 	case *resourcev1.ResourceClaimTemplate:
-		return NewResourceV1Informer(f.cache, f.set.ResourceV1, f.namespace, f.resyncPeriod).ResourceClaimTemplateInformer()
+		return NewResourceV1InformerWithOptions(f.cache, f.set.ResourceV1, f.resyncPeriod, f.options).ResourceClaimTemplateInformer()
 	case *resourcev1.ResourceSlice:
-		return NewResourceV1Informer(f.cache, f.set.ResourceV1, f.namespace, f.resyncPeriod).ResourceSliceInformer()
+		return NewResourceV1InformerWithOptions(f.cache, f.set.ResourceV1, f.resyncPeriod, f.options).ResourceSliceInformer()
 	case *schedulingv1.PriorityClass:
-		return NewSchedulingK8sIoV1Informer(f.cache, f.set.SchedulingK8sIoV1, f.namespace, f.resyncPeriod).PriorityClassInformer()
+		return NewSchedulingK8sIoV1InformerWithOptions(f.cache, f.set.SchedulingK8sIoV1, f.resyncPeriod, f.options).PriorityClassInformer()
 	case *storagev1.CSIDriver:
-		return NewStorageK8sIoV1Informer(f.cache, f.set.StorageK8sIoV1, f.namespace, f.resyncPeriod).CSIDriverInformer()
+		return NewStorageK8sIoV1InformerWithOptions(f.cache, f.set.StorageK8sIoV1, f.resyncPeriod, f.options).CSIDriverInformer()
 	case *storagev1.CSINode:
-		return NewStorageK8sIoV1Informer(f.cache, f.set.StorageK8sIoV1, f.namespace, f.resyncPeriod).CSINodeInformer()
+		return NewStorageK8sIoV1InformerWithOptions(f.cache, f.set.StorageK8sIoV1, f.resyncPeriod, f.options).CSINodeInformer()
 	case *storagev1.CSIStorageCapacity:
-		return NewStorageK8sIoV1Informer(f.cache, f.set.StorageK8sIoV1, f.namespace, f.resyncPeriod).CSIStorageCapacityInformer()
+		return NewStorageK8sIoV1InformerWithOptions(f.cache, f.set.StorageK8sIoV1, f.resyncPeriod, f.options).CSIStorageCapacityInformer()
 	case *storagev1.StorageClass:
-		return NewStorageK8sIoV1Informer(f.cache, f.set.StorageK8sIoV1, f.namespace, f.resyncPeriod).StorageClassInformer()
+		return NewStorageK8sIoV1InformerWithOptions(f.cache, f.set.StorageK8sIoV1, f.resyncPeriod, f.options).StorageClassInformer()
 	case *storagev1.VolumeAttachment:
-		return NewStorageK8sIoV1Informer(f.cache, f.set.StorageK8sIoV1, f.namespace, f.resyncPeriod).VolumeAttachmentInformer()
+		return NewStorageK8sIoV1InformerWithOptions(f.cache, f.set.StorageK8sIoV1, f.resyncPeriod, f.options).VolumeAttachmentInformer()
 	case *storagev1.VolumeAttributesClass:
-		return NewStorageK8sIoV1Informer(f.cache, f.set.StorageK8sIoV1, f.namespace, f.resyncPeriod).VolumeAttributesClassInformer()
+		return NewStorageK8sIoV1InformerWithOptions(f.cache, f.set.StorageK8sIoV1, f.resyncPeriod, f.options).VolumeAttributesClassInformer()
 	default:
 		return nil
 	}
@@ -3860,181 +3845,187 @@ func (f *InformerFactory) InformerFor(obj runtime.Object) cache.SharedIndexInfor
 func (f *InformerFactory) InformerForResource(gvr schema.GroupVersionResource) cache.SharedIndexInformer {
 	switch gvr {
 	case corev1.SchemaGroupVersion.WithResource("bindings"):
-		return NewCoreV1Informer(f.cache, f.set.CoreV1, f.namespace, f.resyncPeriod).BindingInformer()
+		return NewCoreV1InformerWithOptions(f.cache, f.set.CoreV1, f.resyncPeriod, f.options).BindingInformer()
 	case corev1.SchemaGroupVersion.WithResource("componentstatuses"):
-		return NewCoreV1Informer(f.cache, f.set.CoreV1, f.namespace, f.resyncPeriod).ComponentStatusInformer()
+		return NewCoreV1InformerWithOptions(f.cache, f.set.CoreV1, f.resyncPeriod, f.options).ComponentStatusInformer()
 	case corev1.SchemaGroupVersion.WithResource("configmaps"):
-		return NewCoreV1Informer(f.cache, f.set.CoreV1, f.namespace, f.resyncPeriod).ConfigMapInformer()
+		return NewCoreV1InformerWithOptions(f.cache, f.set.CoreV1, f.resyncPeriod, f.options).ConfigMapInformer()
 	case corev1.SchemaGroupVersion.WithResource("endpoints"):
-		return NewCoreV1Informer(f.cache, f.set.CoreV1, f.namespace, f.resyncPeriod).EndpointsInformer()
+		return NewCoreV1InformerWithOptions(f.cache, f.set.CoreV1, f.resyncPeriod, f.options).EndpointsInformer()
 	case corev1.SchemaGroupVersion.WithResource("events"):
-		return NewCoreV1Informer(f.cache, f.set.CoreV1, f.namespace, f.resyncPeriod).EventInformer()
+		return NewCoreV1InformerWithOptions(f.cache, f.set.CoreV1, f.resyncPeriod, f.options).EventInformer()
 	case corev1.SchemaGroupVersion.WithResource("limitranges"):
-		return NewCoreV1Informer(f.cache, f.set.CoreV1, f.namespace, f.resyncPeriod).LimitRangeInformer()
+		return NewCoreV1InformerWithOptions(f.cache, f.set.CoreV1, f.resyncPeriod, f.options).LimitRangeInformer()
 	case corev1.SchemaGroupVersion.WithResource("namespaces"):
-		return NewCoreV1Informer(f.cache, f.set.CoreV1, f.namespace, f.resyncPeriod).NamespaceInformer()
+		return NewCoreV1InformerWithOptions(f.cache, f.set.CoreV1, f.resyncPeriod, f.options).NamespaceInformer()
 	case corev1.SchemaGroupVersion.WithResource("nodes"):
-		return NewCoreV1Informer(f.cache, f.set.CoreV1, f.namespace, f.resyncPeriod).NodeInformer()
+		return NewCoreV1InformerWithOptions(f.cache, f.set.CoreV1, f.resyncPeriod, f.options).NodeInformer()
 	case corev1.SchemaGroupVersion.WithResource("persistentvolumes"):
-		return NewCoreV1Informer(f.cache, f.set.CoreV1, f.namespace, f.resyncPeriod).PersistentVolumeInformer()
+		return NewCoreV1InformerWithOptions(f.cache, f.set.CoreV1, f.resyncPeriod, f.options).PersistentVolumeInformer()
 	case corev1.SchemaGroupVersion.WithResource("persistentvolumeclaims"):
-		return NewCoreV1Informer(f.cache, f.set.CoreV1, f.namespace, f.resyncPeriod).PersistentVolumeClaimInformer()
+		return NewCoreV1InformerWithOptions(f.cache, f.set.CoreV1, f.resyncPeriod, f.options).PersistentVolumeClaimInformer()
 	case corev1.SchemaGroupVersion.WithResource("pods"):
-		return NewCoreV1Informer(f.cache, f.set.CoreV1, f.namespace, f.resyncPeriod).PodInformer()
+		return NewCoreV1InformerWithOptions(f.cache, f.set.CoreV1, f.resyncPeriod, f.options).PodInformer()
 	case corev1.SchemaGroupVersion.WithResource("podstatusresults"):
-		return NewCoreV1Informer(f.cache, f.set.CoreV1, f.namespace, f.resyncPeriod).PodStatusResultInformer()
+		return NewCoreV1InformerWithOptions(f.cache, f.set.CoreV1, f.resyncPeriod, f.options).PodStatusResultInformer()
 	case corev1.SchemaGroupVersion.WithResource("podtemplates"):
-		return NewCoreV1Informer(f.cache, f.set.CoreV1, f.namespace, f.resyncPeriod).PodTemplateInformer()
+		return NewCoreV1InformerWithOptions(f.cache, f.set.CoreV1, f.resyncPeriod, f.options).PodTemplateInformer()
 	case corev1.SchemaGroupVersion.WithResource("rangeallocations"):
-		return NewCoreV1Informer(f.cache, f.set.CoreV1, f.namespace, f.resyncPeriod).RangeAllocationInformer()
+		return NewCoreV1InformerWithOptions(f.cache, f.set.CoreV1, f.resyncPeriod, f.options).RangeAllocationInformer()
 	case corev1.SchemaGroupVersion.WithResource("replicationcontrollers"):
-		return NewCoreV1Informer(f.cache, f.set.CoreV1, f.namespace, f.resyncPeriod).ReplicationControllerInformer()
+		return NewCoreV1InformerWithOptions(f.cache, f.set.CoreV1, f.resyncPeriod, f.options).ReplicationControllerInformer()
 	case corev1.SchemaGroupVersion.WithResource("resourcequotas"):
-		return NewCoreV1Informer(f.cache, f.set.CoreV1, f.namespace, f.resyncPeriod).ResourceQuotaInformer()
+		return NewCoreV1InformerWithOptions(f.cache, f.set.CoreV1, f.resyncPeriod, f.options).ResourceQuotaInformer()
 	case corev1.SchemaGroupVersion.WithResource("secrets"):
-		return NewCoreV1Informer(f.cache, f.set.CoreV1, f.namespace, f.resyncPeriod).SecretInformer()
+		return NewCoreV1InformerWithOptions(f.cache, f.set.CoreV1, f.resyncPeriod, f.options).SecretInformer()
 	case corev1.SchemaGroupVersion.WithResource("services"):
-		return NewCoreV1Informer(f.cache, f.set.CoreV1, f.namespace, f.resyncPeriod).ServiceInformer()
+		return NewCoreV1InformerWithOptions(f.cache, f.set.CoreV1, f.resyncPeriod, f.options).ServiceInformer()
 	case corev1.SchemaGroupVersion.WithResource("serviceaccounts"):
-		return NewCoreV1Informer(f.cache, f.set.CoreV1, f.namespace, f.resyncPeriod).ServiceAccountInformer()
+		return NewCoreV1InformerWithOptions(f.cache, f.set.CoreV1, f.resyncPeriod, f.options).ServiceAccountInformer()
 	case admissionregistrationv1.SchemaGroupVersion.WithResource("mutatingadmissionpolicies"):
-		return NewAdmissionregistrationK8sIoV1Informer(f.cache, f.set.AdmissionregistrationK8sIoV1, f.namespace, f.resyncPeriod).MutatingAdmissionPolicyInformer()
+		return NewAdmissionregistrationK8sIoV1InformerWithOptions(f.cache, f.set.AdmissionregistrationK8sIoV1, f.resyncPeriod, f.options).MutatingAdmissionPolicyInformer()
 	case admissionregistrationv1.SchemaGroupVersion.WithResource("mutatingadmissionpolicybindings"):
-		return NewAdmissionregistrationK8sIoV1Informer(f.cache, f.set.AdmissionregistrationK8sIoV1, f.namespace, f.resyncPeriod).MutatingAdmissionPolicyBindingInformer()
+		return NewAdmissionregistrationK8sIoV1InformerWithOptions(f.cache, f.set.AdmissionregistrationK8sIoV1, f.resyncPeriod, f.options).MutatingAdmissionPolicyBindingInformer()
 	case admissionregistrationv1.SchemaGroupVersion.WithResource("mutatingwebhookconfigurations"):
-		return NewAdmissionregistrationK8sIoV1Informer(f.cache, f.set.AdmissionregistrationK8sIoV1, f.namespace, f.resyncPeriod).MutatingWebhookConfigurationInformer()
+		return NewAdmissionregistrationK8sIoV1InformerWithOptions(f.cache, f.set.AdmissionregistrationK8sIoV1, f.resyncPeriod, f.options).MutatingWebhookConfigurationInformer()
 	case admissionregistrationv1.SchemaGroupVersion.WithResource("validatingadmissionpolicies"):
-		return NewAdmissionregistrationK8sIoV1Informer(f.cache, f.set.AdmissionregistrationK8sIoV1, f.namespace, f.resyncPeriod).ValidatingAdmissionPolicyInformer()
+		return NewAdmissionregistrationK8sIoV1InformerWithOptions(f.cache, f.set.AdmissionregistrationK8sIoV1, f.resyncPeriod, f.options).ValidatingAdmissionPolicyInformer()
 	case admissionregistrationv1.SchemaGroupVersion.WithResource("validatingadmissionpolicybindings"):
-		return NewAdmissionregistrationK8sIoV1Informer(f.cache, f.set.AdmissionregistrationK8sIoV1, f.namespace, f.resyncPeriod).ValidatingAdmissionPolicyBindingInformer()
+		return NewAdmissionregistrationK8sIoV1InformerWithOptions(f.cache, f.set.AdmissionregistrationK8sIoV1, f.resyncPeriod, f.options).ValidatingAdmissionPolicyBindingInformer()
 	case admissionregistrationv1.SchemaGroupVersion.WithResource("validatingwebhookconfigurations"):
-		return NewAdmissionregistrationK8sIoV1Informer(f.cache, f.set.AdmissionregistrationK8sIoV1, f.namespace, f.resyncPeriod).ValidatingWebhookConfigurationInformer()
+		return NewAdmissionregistrationK8sIoV1InformerWithOptions(f.cache, f.set.AdmissionregistrationK8sIoV1, f.resyncPeriod, f.options).ValidatingWebhookConfigurationInformer()
 	case appsv1.SchemaGroupVersion.WithResource("controllerrevisions"):
-		return NewAppsV1Informer(f.cache, f.set.AppsV1, f.namespace, f.resyncPeriod).ControllerRevisionInformer()
+		return NewAppsV1InformerWithOptions(f.cache, f.set.AppsV1, f.resyncPeriod, f.options).ControllerRevisionInformer()
 	case appsv1.SchemaGroupVersion.WithResource("daemonsets"):
-		return NewAppsV1Informer(f.cache, f.set.AppsV1, f.namespace, f.resyncPeriod).DaemonSetInformer()
+		return NewAppsV1InformerWithOptions(f.cache, f.set.AppsV1, f.resyncPeriod, f.options).DaemonSetInformer()
 	case appsv1.SchemaGroupVersion.WithResource("deployments"):
-		return NewAppsV1Informer(f.cache, f.set.AppsV1, f.namespace, f.resyncPeriod).DeploymentInformer()
+		return NewAppsV1InformerWithOptions(f.cache, f.set.AppsV1, f.resyncPeriod, f.options).DeploymentInformer()
 	case appsv1.SchemaGroupVersion.WithResource("replicasets"):
-		return NewAppsV1Informer(f.cache, f.set.AppsV1, f.namespace, f.resyncPeriod).ReplicaSetInformer()
+		return NewAppsV1InformerWithOptions(f.cache, f.set.AppsV1, f.resyncPeriod, f.options).ReplicaSetInformer()
 	case appsv1.SchemaGroupVersion.WithResource("statefulsets"):
-		return NewAppsV1Informer(f.cache, f.set.AppsV1, f.namespace, f.resyncPeriod).StatefulSetInformer()
+		return NewAppsV1InformerWithOptions(f.cache, f.set.AppsV1, f.resyncPeriod, f.options).StatefulSetInformer()
 	case authenticationv1.SchemaGroupVersion.WithResource("selfsubjectreviews"):
-		return NewAuthenticationK8sIoV1Informer(f.cache, f.set.AuthenticationK8sIoV1, f.namespace, f.resyncPeriod).SelfSubjectReviewInformer()
+		return NewAuthenticationK8sIoV1InformerWithOptions(f.cache, f.set.AuthenticationK8sIoV1, f.resyncPeriod, f.options).SelfSubjectReviewInformer()
 	case authenticationv1.SchemaGroupVersion.WithResource("tokenrequests"):
-		return NewAuthenticationK8sIoV1Informer(f.cache, f.set.AuthenticationK8sIoV1, f.namespace, f.resyncPeriod).TokenRequestInformer()
+		return NewAuthenticationK8sIoV1InformerWithOptions(f.cache, f.set.AuthenticationK8sIoV1, f.resyncPeriod, f.options).TokenRequestInformer()
 	case authenticationv1.SchemaGroupVersion.WithResource("tokenreviews"):
-		return NewAuthenticationK8sIoV1Informer(f.cache, f.set.AuthenticationK8sIoV1, f.namespace, f.resyncPeriod).TokenReviewInformer()
+		return NewAuthenticationK8sIoV1InformerWithOptions(f.cache, f.set.AuthenticationK8sIoV1, f.resyncPeriod, f.options).TokenReviewInformer()
 	case authorizationv1.SchemaGroupVersion.WithResource("localsubjectaccessreviews"):
-		return NewAuthorizationK8sIoV1Informer(f.cache, f.set.AuthorizationK8sIoV1, f.namespace, f.resyncPeriod).LocalSubjectAccessReviewInformer()
+		return NewAuthorizationK8sIoV1InformerWithOptions(f.cache, f.set.AuthorizationK8sIoV1, f.resyncPeriod, f.options).LocalSubjectAccessReviewInformer()
 	case authorizationv1.SchemaGroupVersion.WithResource("selfsubjectaccessreviews"):
-		return NewAuthorizationK8sIoV1Informer(f.cache, f.set.AuthorizationK8sIoV1, f.namespace, f.resyncPeriod).SelfSubjectAccessReviewInformer()
+		return NewAuthorizationK8sIoV1InformerWithOptions(f.cache, f.set.AuthorizationK8sIoV1, f.resyncPeriod, f.options).SelfSubjectAccessReviewInformer()
 	case authorizationv1.SchemaGroupVersion.WithResource("selfsubjectrulesreviews"):
-		return NewAuthorizationK8sIoV1Informer(f.cache, f.set.AuthorizationK8sIoV1, f.namespace, f.resyncPeriod).SelfSubjectRulesReviewInformer()
+		return NewAuthorizationK8sIoV1InformerWithOptions(f.cache, f.set.AuthorizationK8sIoV1, f.resyncPeriod, f.options).SelfSubjectRulesReviewInformer()
 	case authorizationv1.SchemaGroupVersion.WithResource("subjectaccessreviews"):
-		return NewAuthorizationK8sIoV1Informer(f.cache, f.set.AuthorizationK8sIoV1, f.namespace, f.resyncPeriod).SubjectAccessReviewInformer()
+		return NewAuthorizationK8sIoV1InformerWithOptions(f.cache, f.set.AuthorizationK8sIoV1, f.resyncPeriod, f.options).SubjectAccessReviewInformer()
 	case autoscalingv1.SchemaGroupVersion.WithResource("horizontalpodautoscalers"):
-		return NewAutoscalingV1Informer(f.cache, f.set.AutoscalingV1, f.namespace, f.resyncPeriod).HorizontalPodAutoscalerInformer()
+		return NewAutoscalingV1InformerWithOptions(f.cache, f.set.AutoscalingV1, f.resyncPeriod, f.options).HorizontalPodAutoscalerInformer()
 	case autoscalingv1.SchemaGroupVersion.WithResource("scales"):
-		return NewAutoscalingV1Informer(f.cache, f.set.AutoscalingV1, f.namespace, f.resyncPeriod).ScaleInformer()
+		return NewAutoscalingV1InformerWithOptions(f.cache, f.set.AutoscalingV1, f.resyncPeriod, f.options).ScaleInformer()
 	case autoscalingv2.SchemaGroupVersion.WithResource("horizontalpodautoscalers"):
-		return NewAutoscalingV2Informer(f.cache, f.set.AutoscalingV2, f.namespace, f.resyncPeriod).HorizontalPodAutoscalerInformer()
+		return NewAutoscalingV2InformerWithOptions(f.cache, f.set.AutoscalingV2, f.resyncPeriod, f.options).HorizontalPodAutoscalerInformer()
 	case batchv1.SchemaGroupVersion.WithResource("cronjobs"):
-		return NewBatchV1Informer(f.cache, f.set.BatchV1, f.namespace, f.resyncPeriod).CronJobInformer()
+		return NewBatchV1InformerWithOptions(f.cache, f.set.BatchV1, f.resyncPeriod, f.options).CronJobInformer()
 	case batchv1.SchemaGroupVersion.WithResource("jobs"):
-		return NewBatchV1Informer(f.cache, f.set.BatchV1, f.namespace, f.resyncPeriod).JobInformer()
+		return NewBatchV1InformerWithOptions(f.cache, f.set.BatchV1, f.resyncPeriod, f.options).JobInformer()
 	case certificatesv1.SchemaGroupVersion.WithResource("certificatesigningrequests"):
-		return NewCertificatesK8sIoV1Informer(f.cache, f.set.CertificatesK8sIoV1, f.namespace, f.resyncPeriod).CertificateSigningRequestInformer()
+		return NewCertificatesK8sIoV1InformerWithOptions(f.cache, f.set.CertificatesK8sIoV1, f.resyncPeriod, f.options).CertificateSigningRequestInformer()
 	case coordinationv1.SchemaGroupVersion.WithResource("leases"):
-		return NewCoordinationK8sIoV1Informer(f.cache, f.set.CoordinationK8sIoV1, f.namespace, f.resyncPeriod).LeaseInformer()
+		return NewCoordinationK8sIoV1InformerWithOptions(f.cache, f.set.CoordinationK8sIoV1, f.resyncPeriod, f.options).LeaseInformer()
 	case discoveryv1.SchemaGroupVersion.WithResource("endpointslices"):
-		return NewDiscoveryK8sIoV1Informer(f.cache, f.set.DiscoveryK8sIoV1, f.namespace, f.resyncPeriod).EndpointSliceInformer()
+		return NewDiscoveryK8sIoV1InformerWithOptions(f.cache, f.set.DiscoveryK8sIoV1, f.resyncPeriod, f.options).EndpointSliceInformer()
 	case eventsv1.SchemaGroupVersion.WithResource("events"):
-		return NewEventsK8sIoV1Informer(f.cache, f.set.EventsK8sIoV1, f.namespace, f.resyncPeriod).EventInformer()
+		return NewEventsK8sIoV1InformerWithOptions(f.cache, f.set.EventsK8sIoV1, f.resyncPeriod, f.options).EventInformer()
 	case networkingv1.SchemaGroupVersion.WithResource("ipaddresses"):
-		return NewNetworkingK8sIoV1Informer(f.cache, f.set.NetworkingK8sIoV1, f.namespace, f.resyncPeriod).IPAddressInformer()
+		return NewNetworkingK8sIoV1InformerWithOptions(f.cache, f.set.NetworkingK8sIoV1, f.resyncPeriod, f.options).IPAddressInformer()
 	case networkingv1.SchemaGroupVersion.WithResource("ingresses"):
-		return NewNetworkingK8sIoV1Informer(f.cache, f.set.NetworkingK8sIoV1, f.namespace, f.resyncPeriod).IngressInformer()
+		return NewNetworkingK8sIoV1InformerWithOptions(f.cache, f.set.NetworkingK8sIoV1, f.resyncPeriod, f.options).IngressInformer()
 	case networkingv1.SchemaGroupVersion.WithResource("ingressclasses"):
-		return NewNetworkingK8sIoV1Informer(f.cache, f.set.NetworkingK8sIoV1, f.namespace, f.resyncPeriod).IngressClassInformer()
+		return NewNetworkingK8sIoV1InformerWithOptions(f.cache, f.set.NetworkingK8sIoV1, f.resyncPeriod, f.options).IngressClassInformer()
 	case networkingv1.SchemaGroupVersion.WithResource("networkpolicies"):
-		return NewNetworkingK8sIoV1Informer(f.cache, f.set.NetworkingK8sIoV1, f.namespace, f.resyncPeriod).NetworkPolicyInformer()
+		return NewNetworkingK8sIoV1InformerWithOptions(f.cache, f.set.NetworkingK8sIoV1, f.resyncPeriod, f.options).NetworkPolicyInformer()
 	case networkingv1.SchemaGroupVersion.WithResource("servicecidrs"):
-		return NewNetworkingK8sIoV1Informer(f.cache, f.set.NetworkingK8sIoV1, f.namespace, f.resyncPeriod).ServiceCIDRInformer()
+		return NewNetworkingK8sIoV1InformerWithOptions(f.cache, f.set.NetworkingK8sIoV1, f.resyncPeriod, f.options).ServiceCIDRInformer()
 	case policyv1.SchemaGroupVersion.WithResource("evictions"):
-		return NewPolicyV1Informer(f.cache, f.set.PolicyV1, f.namespace, f.resyncPeriod).EvictionInformer()
+		return NewPolicyV1InformerWithOptions(f.cache, f.set.PolicyV1, f.resyncPeriod, f.options).EvictionInformer()
 	case policyv1.SchemaGroupVersion.WithResource("poddisruptionbudgets"):
-		return NewPolicyV1Informer(f.cache, f.set.PolicyV1, f.namespace, f.resyncPeriod).PodDisruptionBudgetInformer()
+		return NewPolicyV1InformerWithOptions(f.cache, f.set.PolicyV1, f.resyncPeriod, f.options).PodDisruptionBudgetInformer()
 	case rbacv1.SchemaGroupVersion.WithResource("clusterroles"):
-		return NewRbacAuthorizationK8sIoV1Informer(f.cache, f.set.RbacAuthorizationK8sIoV1, f.namespace, f.resyncPeriod).ClusterRoleInformer()
+		return NewRbacAuthorizationK8sIoV1InformerWithOptions(f.cache, f.set.RbacAuthorizationK8sIoV1, f.resyncPeriod, f.options).ClusterRoleInformer()
 	case rbacv1.SchemaGroupVersion.WithResource("clusterrolebindings"):
-		return NewRbacAuthorizationK8sIoV1Informer(f.cache, f.set.RbacAuthorizationK8sIoV1, f.namespace, f.resyncPeriod).ClusterRoleBindingInformer()
+		return NewRbacAuthorizationK8sIoV1InformerWithOptions(f.cache, f.set.RbacAuthorizationK8sIoV1, f.resyncPeriod, f.options).ClusterRoleBindingInformer()
 	case rbacv1.SchemaGroupVersion.WithResource("roles"):
-		return NewRbacAuthorizationK8sIoV1Informer(f.cache, f.set.RbacAuthorizationK8sIoV1, f.namespace, f.resyncPeriod).RoleInformer()
+		return NewRbacAuthorizationK8sIoV1InformerWithOptions(f.cache, f.set.RbacAuthorizationK8sIoV1, f.resyncPeriod, f.options).RoleInformer()
 	case rbacv1.SchemaGroupVersion.WithResource("rolebindings"):
-		return NewRbacAuthorizationK8sIoV1Informer(f.cache, f.set.RbacAuthorizationK8sIoV1, f.namespace, f.resyncPeriod).RoleBindingInformer()
+		return NewRbacAuthorizationK8sIoV1InformerWithOptions(f.cache, f.set.RbacAuthorizationK8sIoV1, f.resyncPeriod, f.options).RoleBindingInformer()
 	case resourcev1.SchemaGroupVersion.WithResource("deviceclasses"):
-		return NewResourceV1Informer(f.cache, f.set.ResourceV1, f.namespace, f.resyncPeriod).DeviceClassInformer()
+		return NewResourceV1InformerWithOptions(f.cache, f.set.ResourceV1, f.resyncPeriod, f.options).DeviceClassInformer()
 	case resourcev1.SchemaGroupVersion.WithResource("resourceclaims"):
-		return NewResourceV1Informer(f.cache, f.set.ResourceV1, f.namespace, f.resyncPeriod).ResourceClaimInformer()
+		return NewResourceV1InformerWithOptions(f.cache, f.set.ResourceV1, f.resyncPeriod, f.options).ResourceClaimInformer()
 	case resourcev1.SchemaGroupVersion.WithResource("resourceclaimtemplates"):
-		return NewResourceV1Informer(f.cache, f.set.ResourceV1, f.namespace, f.resyncPeriod).ResourceClaimTemplateInformer()
+		return NewResourceV1InformerWithOptions(f.cache, f.set.ResourceV1, f.resyncPeriod, f.options).ResourceClaimTemplateInformer()
 	case resourcev1.SchemaGroupVersion.WithResource("resourceslices"):
-		return NewResourceV1Informer(f.cache, f.set.ResourceV1, f.namespace, f.resyncPeriod).ResourceSliceInformer()
+		return NewResourceV1InformerWithOptions(f.cache, f.set.ResourceV1, f.resyncPeriod, f.options).ResourceSliceInformer()
 	case schedulingv1.SchemaGroupVersion.WithResource("priorityclasses"):
-		return NewSchedulingK8sIoV1Informer(f.cache, f.set.SchedulingK8sIoV1, f.namespace, f.resyncPeriod).PriorityClassInformer()
+		return NewSchedulingK8sIoV1InformerWithOptions(f.cache, f.set.SchedulingK8sIoV1, f.resyncPeriod, f.options).PriorityClassInformer()
 	case storagev1.SchemaGroupVersion.WithResource("csidrivers"):
-		return NewStorageK8sIoV1Informer(f.cache, f.set.StorageK8sIoV1, f.namespace, f.resyncPeriod).CSIDriverInformer()
+		return NewStorageK8sIoV1InformerWithOptions(f.cache, f.set.StorageK8sIoV1, f.resyncPeriod, f.options).CSIDriverInformer()
 	case storagev1.SchemaGroupVersion.WithResource("csinodes"):
-		return NewStorageK8sIoV1Informer(f.cache, f.set.StorageK8sIoV1, f.namespace, f.resyncPeriod).CSINodeInformer()
+		return NewStorageK8sIoV1InformerWithOptions(f.cache, f.set.StorageK8sIoV1, f.resyncPeriod, f.options).CSINodeInformer()
 	case storagev1.SchemaGroupVersion.WithResource("csistoragecapacities"):
-		return NewStorageK8sIoV1Informer(f.cache, f.set.StorageK8sIoV1, f.namespace, f.resyncPeriod).CSIStorageCapacityInformer()
+		return NewStorageK8sIoV1InformerWithOptions(f.cache, f.set.StorageK8sIoV1, f.resyncPeriod, f.options).CSIStorageCapacityInformer()
 	case storagev1.SchemaGroupVersion.WithResource("storageclasses"):
-		return NewStorageK8sIoV1Informer(f.cache, f.set.StorageK8sIoV1, f.namespace, f.resyncPeriod).StorageClassInformer()
+		return NewStorageK8sIoV1InformerWithOptions(f.cache, f.set.StorageK8sIoV1, f.resyncPeriod, f.options).StorageClassInformer()
 	case storagev1.SchemaGroupVersion.WithResource("volumeattachments"):
-		return NewStorageK8sIoV1Informer(f.cache, f.set.StorageK8sIoV1, f.namespace, f.resyncPeriod).VolumeAttachmentInformer()
+		return NewStorageK8sIoV1InformerWithOptions(f.cache, f.set.StorageK8sIoV1, f.resyncPeriod, f.options).VolumeAttachmentInformer()
 	case storagev1.SchemaGroupVersion.WithResource("volumeattributesclasses"):
-		return NewStorageK8sIoV1Informer(f.cache, f.set.StorageK8sIoV1, f.namespace, f.resyncPeriod).VolumeAttributesClassInformer()
+		return NewStorageK8sIoV1InformerWithOptions(f.cache, f.set.StorageK8sIoV1, f.resyncPeriod, f.options).VolumeAttributesClassInformer()
 	default:
 		return nil
 	}
 }
 
+// Run starts all informers in the cache. ctx is passed to the list and the watch of the informers,
+// and the informers stop when ctx is canceled.
 func (f *InformerFactory) Run(ctx context.Context) {
 	for _, v := range f.cache.Informers() {
-		go v.Run(ctx.Done())
+		go v.RunWithContext(ctx)
 	}
 }
 
 type CoreV1Informer struct {
 	cache        *InformerCache
 	client       *CoreV1
-	namespace    string
+	options      InformerOptions
 	resyncPeriod time.Duration
 	indexers     cache.Indexers
 }
 
 func NewCoreV1Informer(c *InformerCache, client *CoreV1, namespace string, resyncPeriod time.Duration) *CoreV1Informer {
+	return NewCoreV1InformerWithOptions(c, client, resyncPeriod, InformerOptions{Namespaces: []string{namespace}})
+}
+
+func NewCoreV1InformerWithOptions(c *InformerCache, client *CoreV1, resyncPeriod time.Duration, opts InformerOptions) *CoreV1Informer {
 	return &CoreV1Informer{
 		cache:        c,
 		client:       client,
-		namespace:    namespace,
+		options:      opts,
 		resyncPeriod: resyncPeriod,
 		indexers:     cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc},
 	}
 }
 
 func (f *CoreV1Informer) BindingInformer() cache.SharedIndexInformer {
-	return f.cache.Write(&corev1.Binding{}, func() cache.SharedIndexInformer {
+	return f.cache.WriteWithFilter(&corev1.Binding{}, f.options.key(true), func() cache.SharedIndexInformer {
 		return cache.NewSharedIndexInformer(
-			&cache.ListWatch{
-				ListFunc: func(options k8smetav1.ListOptions) (runtime.Object, error) {
-					return f.client.ListBinding(context.TODO(), f.namespace, metav1.ListOptionsFromUpstream(options))
+			typedclient.NewListWatch(f.client.backend, f.options.namespaces(), f.options.tweakListOptions,
+				func(ctx context.Context, namespace string, options k8smetav1.ListOptions) (runtime.Object, error) {
+					return f.client.ListBinding(ctx, namespace, metav1.ListOptionsFromUpstream(options))
 				},
-				WatchFunc: func(options k8smetav1.ListOptions) (watch.Interface, error) {
-					return f.client.WatchBinding(context.TODO(), f.namespace, metav1.ListOptionsFromUpstream(options))
+				func(ctx context.Context, namespace string, options k8smetav1.ListOptions) (watch.Interface, error) {
+					return f.client.WatchBinding(ctx, namespace, metav1.ListOptionsFromUpstream(options))
 				},
-			},
+			),
 			&corev1.Binding{},
 			f.resyncPeriod,
 			f.indexers,
@@ -4047,16 +4038,16 @@ func (f *CoreV1Informer) BindingLister() *CoreV1BindingLister {
 }
 
 func (f *CoreV1Informer) ComponentStatusInformer() cache.SharedIndexInformer {
-	return f.cache.Write(&corev1.ComponentStatus{}, func() cache.SharedIndexInformer {
+	return f.cache.WriteWithFilter(&corev1.ComponentStatus{}, f.options.key(false), func() cache.SharedIndexInformer {
 		return cache.NewSharedIndexInformer(
-			&cache.ListWatch{
-				ListFunc: func(options k8smetav1.ListOptions) (runtime.Object, error) {
-					return f.client.ListComponentStatus(context.TODO(), metav1.ListOptionsFromUpstream(options))
+			typedclient.NewListWatch(f.client.backend, nil, f.options.tweakListOptions,
+				func(ctx context.Context, _ string, options k8smetav1.ListOptions) (runtime.Object, error) {
+					return f.client.ListComponentStatus(ctx, metav1.ListOptionsFromUpstream(options))
 				},
-				WatchFunc: func(options k8smetav1.ListOptions) (watch.Interface, error) {
-					return f.client.WatchComponentStatus(context.TODO(), metav1.ListOptionsFromUpstream(options))
+				func(ctx context.Context, _ string, options k8smetav1.ListOptions) (watch.Interface, error) {
+					return f.client.WatchComponentStatus(ctx, metav1.ListOptionsFromUpstream(options))
 				},
-			},
+			),
 			&corev1.ComponentStatus{},
 			f.resyncPeriod,
 			f.indexers,
@@ -4069,16 +4060,16 @@ func (f *CoreV1Informer) ComponentStatusLister() *CoreV1ComponentStatusLister {
 }
 
 func (f *CoreV1Informer) ConfigMapInformer() cache.SharedIndexInformer {
-	return f.cache.Write(&corev1.ConfigMap{}, func() cache.SharedIndexInformer {
+	return f.cache.WriteWithFilter(&corev1.ConfigMap{}, f.options.key(true), func() cache.SharedIndexInformer {
 		return cache.NewSharedIndexInformer(
-			&cache.ListWatch{
-				ListFunc: func(options k8smetav1.ListOptions) (runtime.Object, error) {
-					return f.client.ListConfigMap(context.TODO(), f.namespace, metav1.ListOptionsFromUpstream(options))
+			typedclient.NewListWatch(f.client.backend, f.options.namespaces(), f.options.tweakListOptions,
+				func(ctx context.Context, namespace string, options k8smetav1.ListOptions) (runtime.Object, error) {
+					return f.client.ListConfigMap(ctx, namespace, metav1.ListOptionsFromUpstream(options))
 				},
-				WatchFunc: func(options k8smetav1.ListOptions) (watch.Interface, error) {
-					return f.client.WatchConfigMap(context.TODO(), f.namespace, metav1.ListOptionsFromUpstream(options))
+				func(ctx context.Context, namespace string, options k8smetav1.ListOptions) (watch.Interface, error) {
+					return f.client.WatchConfigMap(ctx, namespace, metav1.ListOptionsFromUpstream(options))
 				},
-			},
+			),
 			&corev1.ConfigMap{},
 			f.resyncPeriod,
 			f.indexers,
@@ -4091,16 +4082,16 @@ func (f *CoreV1Informer) ConfigMapLister() *CoreV1ConfigMapLister {
 }
 
 func (f *CoreV1Informer) EndpointsInformer() cache.SharedIndexInformer {
-	return f.cache.Write(&corev1.Endpoints{}, func() cache.SharedIndexInformer {
+	return f.cache.WriteWithFilter(&corev1.Endpoints{}, f.options.key(true), func() cache.SharedIndexInformer {
 		return cache.NewSharedIndexInformer(
-			&cache.ListWatch{
-				ListFunc: func(options k8smetav1.ListOptions) (runtime.Object, error) {
-					return f.client.ListEndpoints(context.TODO(), f.namespace, metav1.ListOptionsFromUpstream(options))
+			typedclient.NewListWatch(f.client.backend, f.options.namespaces(), f.options.tweakListOptions,
+				func(ctx context.Context, namespace string, options k8smetav1.ListOptions) (runtime.Object, error) {
+					return f.client.ListEndpoints(ctx, namespace, metav1.ListOptionsFromUpstream(options))
 				},
-				WatchFunc: func(options k8smetav1.ListOptions) (watch.Interface, error) {
-					return f.client.WatchEndpoints(context.TODO(), f.namespace, metav1.ListOptionsFromUpstream(options))
+				func(ctx context.Context, namespace string, options k8smetav1.ListOptions) (watch.Interface, error) {
+					return f.client.WatchEndpoints(ctx, namespace, metav1.ListOptionsFromUpstream(options))
 				},
-			},
+			),
 			&corev1.Endpoints{},
 			f.resyncPeriod,
 			f.indexers,
@@ -4113,16 +4104,16 @@ func (f *CoreV1Informer) EndpointsLister() *CoreV1EndpointsLister {
 }
 
 func (f *CoreV1Informer) EventInformer() cache.SharedIndexInformer {
-	return f.cache.Write(&corev1.Event{}, func() cache.SharedIndexInformer {
+	return f.cache.WriteWithFilter(&corev1.Event{}, f.options.key(true), func() cache.SharedIndexInformer {
 		return cache.NewSharedIndexInformer(
-			&cache.ListWatch{
-				ListFunc: func(options k8smetav1.ListOptions) (runtime.Object, error) {
-					return f.client.ListEvent(context.TODO(), f.namespace, metav1.ListOptionsFromUpstream(options))
+			typedclient.NewListWatch(f.client.backend, f.options.namespaces(), f.options.tweakListOptions,
+				func(ctx context.Context, namespace string, options k8smetav1.ListOptions) (runtime.Object, error) {
+					return f.client.ListEvent(ctx, namespace, metav1.ListOptionsFromUpstream(options))
 				},
-				WatchFunc: func(options k8smetav1.ListOptions) (watch.Interface, error) {
-					return f.client.WatchEvent(context.TODO(), f.namespace, metav1.ListOptionsFromUpstream(options))
+				func(ctx context.Context, namespace string, options k8smetav1.ListOptions) (watch.Interface, error) {
+					return f.client.WatchEvent(ctx, namespace, metav1.ListOptionsFromUpstream(options))
 				},
-			},
+			),
 			&corev1.Event{},
 			f.resyncPeriod,
 			f.indexers,
@@ -4135,16 +4126,16 @@ func (f *CoreV1Informer) EventLister() *CoreV1EventLister {
 }
 
 func (f *CoreV1Informer) LimitRangeInformer() cache.SharedIndexInformer {
-	return f.cache.Write(&corev1.LimitRange{}, func() cache.SharedIndexInformer {
+	return f.cache.WriteWithFilter(&corev1.LimitRange{}, f.options.key(true), func() cache.SharedIndexInformer {
 		return cache.NewSharedIndexInformer(
-			&cache.ListWatch{
-				ListFunc: func(options k8smetav1.ListOptions) (runtime.Object, error) {
-					return f.client.ListLimitRange(context.TODO(), f.namespace, metav1.ListOptionsFromUpstream(options))
+			typedclient.NewListWatch(f.client.backend, f.options.namespaces(), f.options.tweakListOptions,
+				func(ctx context.Context, namespace string, options k8smetav1.ListOptions) (runtime.Object, error) {
+					return f.client.ListLimitRange(ctx, namespace, metav1.ListOptionsFromUpstream(options))
 				},
-				WatchFunc: func(options k8smetav1.ListOptions) (watch.Interface, error) {
-					return f.client.WatchLimitRange(context.TODO(), f.namespace, metav1.ListOptionsFromUpstream(options))
+				func(ctx context.Context, namespace string, options k8smetav1.ListOptions) (watch.Interface, error) {
+					return f.client.WatchLimitRange(ctx, namespace, metav1.ListOptionsFromUpstream(options))
 				},
-			},
+			),
 			&corev1.LimitRange{},
 			f.resyncPeriod,
 			f.indexers,
@@ -4157,16 +4148,16 @@ func (f *CoreV1Informer) LimitRangeLister() *CoreV1LimitRangeLister {
 }
 
 func (f *CoreV1Informer) NamespaceInformer() cache.SharedIndexInformer {
-	return f.cache.Write(&corev1.Namespace{}, func() cache.SharedIndexInformer {
+	return f.cache.WriteWithFilter(&corev1.Namespace{}, f.options.key(false), func() cache.SharedIndexInformer {
 		return cache.NewSharedIndexInformer(
-			&cache.ListWatch{
-				ListFunc: func(options k8smetav1.ListOptions) (runtime.Object, error) {
-					return f.client.ListNamespace(context.TODO(), metav1.ListOptionsFromUpstream(options))
+			typedclient.NewListWatch(f.client.backend, nil, f.options.tweakListOptions,
+				func(ctx context.Context, _ string, options k8smetav1.ListOptions) (runtime.Object, error) {
+					return f.client.ListNamespace(ctx, metav1.ListOptionsFromUpstream(options))
 				},
-				WatchFunc: func(options k8smetav1.ListOptions) (watch.Interface, error) {
-					return f.client.WatchNamespace(context.TODO(), metav1.ListOptionsFromUpstream(options))
+				func(ctx context.Context, _ string, options k8smetav1.ListOptions) (watch.Interface, error) {
+					return f.client.WatchNamespace(ctx, metav1.ListOptionsFromUpstream(options))
 				},
-			},
+			),
 			&corev1.Namespace{},
 			f.resyncPeriod,
 			f.indexers,
@@ -4179,16 +4170,16 @@ func (f *CoreV1Informer) NamespaceLister() *CoreV1NamespaceLister {
 }
 
 func (f *CoreV1Informer) NodeInformer() cache.SharedIndexInformer {
-	return f.cache.Write(&corev1.Node{}, func() cache.SharedIndexInformer {
+	return f.cache.WriteWithFilter(&corev1.Node{}, f.options.key(false), func() cache.SharedIndexInformer {
 		return cache.NewSharedIndexInformer(
-			&cache.ListWatch{
-				ListFunc: func(options k8smetav1.ListOptions) (runtime.Object, error) {
-					return f.client.ListNode(context.TODO(), metav1.ListOptionsFromUpstream(options))
+			typedclient.NewListWatch(f.client.backend, nil, f.options.tweakListOptions,
+				func(ctx context.Context, _ string, options k8smetav1.ListOptions) (runtime.Object, error) {
+					return f.client.ListNode(ctx, metav1.ListOptionsFromUpstream(options))
 				},
-				WatchFunc: func(options k8smetav1.ListOptions) (watch.Interface, error) {
-					return f.client.WatchNode(context.TODO(), metav1.ListOptionsFromUpstream(options))
+				func(ctx context.Context, _ string, options k8smetav1.ListOptions) (watch.Interface, error) {
+					return f.client.WatchNode(ctx, metav1.ListOptionsFromUpstream(options))
 				},
-			},
+			),
 			&corev1.Node{},
 			f.resyncPeriod,
 			f.indexers,
@@ -4201,16 +4192,16 @@ func (f *CoreV1Informer) NodeLister() *CoreV1NodeLister {
 }
 
 func (f *CoreV1Informer) PersistentVolumeInformer() cache.SharedIndexInformer {
-	return f.cache.Write(&corev1.PersistentVolume{}, func() cache.SharedIndexInformer {
+	return f.cache.WriteWithFilter(&corev1.PersistentVolume{}, f.options.key(false), func() cache.SharedIndexInformer {
 		return cache.NewSharedIndexInformer(
-			&cache.ListWatch{
-				ListFunc: func(options k8smetav1.ListOptions) (runtime.Object, error) {
-					return f.client.ListPersistentVolume(context.TODO(), metav1.ListOptionsFromUpstream(options))
+			typedclient.NewListWatch(f.client.backend, nil, f.options.tweakListOptions,
+				func(ctx context.Context, _ string, options k8smetav1.ListOptions) (runtime.Object, error) {
+					return f.client.ListPersistentVolume(ctx, metav1.ListOptionsFromUpstream(options))
 				},
-				WatchFunc: func(options k8smetav1.ListOptions) (watch.Interface, error) {
-					return f.client.WatchPersistentVolume(context.TODO(), metav1.ListOptionsFromUpstream(options))
+				func(ctx context.Context, _ string, options k8smetav1.ListOptions) (watch.Interface, error) {
+					return f.client.WatchPersistentVolume(ctx, metav1.ListOptionsFromUpstream(options))
 				},
-			},
+			),
 			&corev1.PersistentVolume{},
 			f.resyncPeriod,
 			f.indexers,
@@ -4223,16 +4214,16 @@ func (f *CoreV1Informer) PersistentVolumeLister() *CoreV1PersistentVolumeLister
 }
 
 func (f *CoreV1Informer) PersistentVolumeClaimInformer() cache.SharedIndexInformer {
-	return f.cache.Write(&corev1.PersistentVolumeClaim{}, func() cache.SharedIndexInformer {
+	return f.cache.WriteWithFilter(&corev1.PersistentVolumeClaim{}, f.options.key(true), func() cache.SharedIndexInformer {
 		return cache.NewSharedIndexInformer(
-			&cache.ListWatch{
-				ListFunc: func(options k8smetav1.ListOptions) (runtime.Object, error) {
-					return f.client.ListPersistentVolumeClaim(context.TODO(), f.namespace, metav1.ListOptionsFromUpstream(options))
+			typedclient.NewListWatch(f.client.backend, f.options.namespaces(), f.options.tweakListOptions,
+				func(ctx context.Context, namespace string, options k8smetav1.ListOptions) (runtime.Object, error) {
+					return f.client.ListPersistentVolumeClaim(ctx, namespace, metav1.ListOptionsFromUpstream(options))
 				},
-				WatchFunc: func(options k8smetav1.ListOptions) (watch.Interface, error) {
-					return f.client.WatchPersistentVolumeClaim(context.TODO(), f.namespace, metav1.ListOptionsFromUpstream(options))
+				func(ctx context.Context, namespace string, options k8smetav1.ListOptions) (watch.Interface, error) {
+					return f.client.WatchPersistentVolumeClaim(ctx, namespace, metav1.ListOptionsFromUpstream(options))
 				},
-			},
+			),
 			&corev1.PersistentVolumeClaim{},
 			f.resyncPeriod,
 			f.indexers,
@@ -4244,20 +4235,42 @@ func (f *CoreV1Informer) PersistentVolumeClaimLister() *CoreV1PersistentVolumeCl
 	return NewCoreV1PersistentVolumeClaimLister(f.PersistentVolumeClaimInformer().GetIndexer())
 }
 
+const (
+	// CoreV1PodIndexSpecNodeName is the name of the index by spec.nodeName of Pod.
+	CoreV1PodIndexSpecNodeName = "spec.nodeName"
+)
+
+// CoreV1PodIndexers returns the indexers of the fields of Pod which are marked as indexed.
+// The indexers are added to the informer of Pod.
+func CoreV1PodIndexers() cache.Indexers {
+	return cache.Indexers{
+		CoreV1PodIndexSpecNodeName: func(obj any) ([]string, error) {
+			v, ok := obj.(*corev1.Pod)
+			if !ok {
+				return nil, nil
+			}
+			if v.Spec != nil {
+				return []string{v.Spec.NodeName}, nil
+			}
+			return nil, nil
+		},
+	}
+}
+
 func (f *CoreV1Informer) PodInformer() cache.SharedIndexInformer {
-	return f.cache.Write(&corev1.Pod{}, func() cache.SharedIndexInformer {
+	return f.cache.WriteWithFilter(&corev1.Pod{}, f.options.key(true), func() cache.SharedIndexInformer {
 		return cache.NewSharedIndexInformer(
-			&cache.ListWatch{
-				ListFunc: func(options k8smetav1.ListOptions) (runtime.Object, error) {
-					return f.client.ListPod(context.TODO(), f.namespace, metav1.ListOptionsFromUpstream(options))
+			typedclient.NewListWatch(f.client.backend, f.options.namespaces(), f.options.tweakListOptions,
+				func(ctx context.Context, namespace string, options k8smetav1.ListOptions) (runtime.Object, error) {
+					return f.client.ListPod(ctx, namespace, metav1.ListOptionsFromUpstream(options))
 				},
-				WatchFunc: func(options k8smetav1.ListOptions) (watch.Interface, error) {
-					return f.client.WatchPod(context.TODO(), f.namespace, metav1.ListOptionsFromUpstream(options))
+				func(ctx context.Context, namespace string, options k8smetav1.ListOptions) (watch.Interface, error) {
+					return f.client.WatchPod(ctx, namespace, metav1.ListOptionsFromUpstream(options))
 				},
-			},
+			),
 			&corev1.Pod{},
 			f.resyncPeriod,
-			f.indexers,
+			typedclient.MergeIndexers(f.indexers, CoreV1PodIndexers()),
 		)
 	})
 }
@@ -4267,16 +4280,16 @@ func (f *CoreV1Informer) PodLister() *CoreV1PodLister {
 }
 
 func (f *CoreV1Informer) PodStatusResultInformer() cache.SharedIndexInformer {
-	return f.cache.Write(&corev1.PodStatusResult{}, func() cache.SharedIndexInformer {
+	return f.cache.WriteWithFilter(&corev1.PodStatusResult{}, f.options.key(true), func() cache.SharedIndexInformer {
 		return cache.NewSharedIndexInformer(
-			&cache.ListWatch{
-				ListFunc: func(options k8smetav1.ListOptions) (runtime.Object, error) {
-					return f.client.ListPodStatusResult(context.TODO(), f.namespace, metav1.ListOptionsFromUpstream(options))
+			typedclient.NewListWatch(f.client.backend, f.options.namespaces(), f.options.tweakListOptions,
+				func(ctx context.Context, namespace string, options k8smetav1.ListOptions) (runtime.Object, error) {
+					return f.client.ListPodStatusResult(ctx, namespace, metav1.ListOptionsFromUpstream(options))
 				},
-				WatchFunc: func(options k8smetav1.ListOptions) (watch.Interface, error) {
-					return f.client.WatchPodStatusResult(context.TODO(), f.namespace, metav1.ListOptionsFromUpstream(options))
+				func(ctx context.Context, namespace string, options k8smetav1.ListOptions) (watch.Interface, error) {
+					return f.client.WatchPodStatusResult(ctx, namespace, metav1.ListOptionsFromUpstream(options))
 				},
-			},
+			),
 			&corev1.PodStatusResult{},
 			f.resyncPeriod,
 			f.indexers,
@@ -4288,20 +4301,42 @@ func (f *CoreV1Informer) PodStatusResultLister() *CoreV1PodStatusResultLister {
 	return NewCoreV1PodStatusResultLister(f.PodStatusResultInformer().GetIndexer())
 }
 
+const (
+	// CoreV1PodTemplateIndexTemplateSpecNodeName is the name of the index by template.spec.nodeName of PodTemplate.
+	CoreV1PodTemplateIndexTemplateSpecNodeName = "template.spec.nodeName"
+)
+
+// CoreV1PodTemplateIndexers returns the indexers of the fields of PodTemplate which are marked as indexed.
+// The indexers are added to the informer of PodTemplate.
+func CoreV1PodTemplateIndexers() cache.Indexers {
+	return cache.Indexers{
+		CoreV1PodTemplateIndexTemplateSpecNodeName: func(obj any) ([]string, error) {
+			v, ok := obj.(*corev1.PodTemplate)
+			if !ok {
+				return nil, nil
+			}
+			if v.Template != nil && v.Template.Spec != nil {
+				return []string{v.Template.Spec.NodeName}, nil
+			}
+			return nil, nil
+		},
+	}
+}
+
 func (f *CoreV1Informer) PodTemplateInformer() cache.SharedIndexInformer {
-	return f.cache.Write(&corev1.PodTemplate{}, func() cache.SharedIndexInformer {
+	return f.cache.WriteWithFilter(&corev1.PodTemplate{}, f.options.key(true), func() cache.SharedIndexInformer {
 		return cache.NewSharedIndexInformer(
-			&cache.ListWatch{
-				ListFunc: func(options k8smetav1.ListOptions) (runtime.Object, error) {
-					return f.client.ListPodTemplate(context.TODO(), f.namespace, metav1.ListOptionsFromUpstream(options))
+			typedclient.NewListWatch(f.client.backend, f.options.namespaces(), f.options.tweakListOptions,
+				func(ctx context.Context, namespace string, options k8smetav1.ListOptions) (runtime.Object, error) {
+					return f.client.ListPodTemplate(ctx, namespace, metav1.ListOptionsFromUpstream(options))
 				},
-				WatchFunc: func(options k8smetav1.ListOptions) (watch.Interface, error) {
-					return f.client.WatchPodTemplate(context.TODO(), f.namespace, metav1.ListOptionsFromUpstream(options))
+				func(ctx context.Context, namespace string, options k8smetav1.ListOptions) (watch.Interface, error) {
+					return f.client.WatchPodTemplate(ctx, namespace, metav1.ListOptionsFromUpstream(options))
 				},
-			},
+			),
 			&corev1.PodTemplate{},
 			f.resyncPeriod,
-			f.indexers,
+			typedclient.MergeIndexers(f.indexers, CoreV1PodTemplateIndexers()),
 		)
 	})
 }
@@ -4311,16 +4346,16 @@ func (f *CoreV1Informer) PodTemplateLister() *CoreV1PodTemplateLister {
 }
 
 func (f *CoreV1Informer) RangeAllocationInformer() cache.SharedIndexInformer {
-	return f.cache.Write(&corev1.RangeAllocation{}, func() cache.SharedIndexInformer {
+	return f.cache.WriteWithFilter(&corev1.RangeAllocation{}, f.options.key(true), func() cache.SharedIndexInformer {
 		return cache.NewSharedIndexInformer(
-			&cache.ListWatch{
-				ListFunc: func(options k8smetav1.ListOptions) (runtime.Object, error) {
-					return f.client.ListRangeAllocation(context.TODO(), f.namespace, metav1.ListOptionsFromUpstream(options))
+			typedclient.NewListWatch(f.client.backend, f.options.namespaces(), f.options.tweakListOptions,
+				func(ctx context.Context, namespace string, options k8smetav1.ListOptions) (runtime.Object, error) {
+					return f.client.ListRangeAllocation(ctx, namespace, metav1.ListOptionsFromUpstream(options))
 				},
-				WatchFunc: func(options k8smetav1.ListOptions) (watch.Interface, error) {
-					return f.client.WatchRangeAllocation(context.TODO(), f.namespace, metav1.ListOptionsFromUpstream(options))
+				func(ctx context.Context, namespace string, options k8smetav1.ListOptions) (watch.Interface, error) {
+					return f.client.WatchRangeAllocation(ctx, namespace, metav1.ListOptionsFromUpstream(options))
 				},
-			},
+			),
 			&corev1.RangeAllocation{},
 			f.resyncPeriod,
 			f.indexers,
@@ -4332,20 +4367,42 @@ func (f *CoreV1Informer) RangeAllocationLister() *CoreV1RangeAllocationLister {
 	return NewCoreV1RangeAllocationLister(f.RangeAllocationInformer().GetIndexer())
 }
 
+const (
+	// CoreV1ReplicationControllerIndexSpecTemplateSpecNodeName is the name of the index by spec.template.spec.nodeName of ReplicationController.
+	CoreV1ReplicationControllerIndexSpecTemplateSpecNodeName = "spec.template.spec.nodeName"
+)
+
+// CoreV1ReplicationControllerIndexers returns the indexers of the fields of ReplicationController which are marked as indexed.
+// The indexers are added to the informer of ReplicationController.
+func CoreV1ReplicationControllerIndexers() cache.Indexers {
+	return cache.Indexers{
+		CoreV1ReplicationControllerIndexSpecTemplateSpecNodeName: func(obj any) ([]string, error) {
+			v, ok := obj.(*corev1.ReplicationController)
+			if !ok {
+				return nil, nil
+			}
+			if v.Spec != nil && v.Spec.Template != nil && v.Spec.Template.Spec != nil {
+				return []string{v.Spec.Template.Spec.NodeName}, nil
+			}
+			return nil, nil
+		},
+	}
+}
+
 func (f *CoreV1Informer) ReplicationControllerInformer() cache.SharedIndexInformer {
-	return f.cache.Write(&corev1.ReplicationController{}, func() cache.SharedIndexInformer {
+	return f.cache.WriteWithFilter(&corev1.ReplicationController{}, f.options.key(true), func() cache.SharedIndexInformer {
 		return cache.NewSharedIndexInformer(
-			&cache.ListWatch{
-				ListFunc: func(options k8smetav1.ListOptions) (runtime.Object, error) {
-					return f.client.ListReplicationController(context.TODO(), f.namespace, metav1.ListOptionsFromUpstream(options))
+			typedclient.NewListWatch(f.client.backend, f.options.namespaces(), f.options.tweakListOptions,
+				func(ctx context.Context, namespace string, options k8smetav1.ListOptions) (runtime.Object, error) {
+					return f.client.ListReplicationController(ctx, namespace, metav1.ListOptionsFromUpstream(options))
 				},
-				WatchFunc: func(options k8smetav1.ListOptions) (watch.Interface, error) {
-					return f.client.WatchReplicationController(context.TODO(), f.namespace, metav1.ListOptionsFromUpstream(options))
+				func(ctx context.Context, namespace string, options k8smetav1.ListOptions) (watch.Interface, error) {
+					return f.client.WatchReplicationController(ctx, namespace, metav1.ListOptionsFromUpstream(options))
 				},
-			},
+			),
 			&corev1.ReplicationController{},
 			f.resyncPeriod,
-			f.indexers,
+			typedclient.MergeIndexers(f.indexers, CoreV1ReplicationControllerIndexers()),
 		)
 	})
 }
@@ -4355,16 +4412,16 @@ func (f *CoreV1Informer) ReplicationControllerLister() *CoreV1ReplicationControl
 }
 
 func (f *CoreV1Informer) ResourceQuotaInformer() cache.SharedIndexInformer {
-	return f.cache.Write(&corev1.ResourceQuota{}, func() cache.SharedIndexInformer {
+	return f.cache.WriteWithFilter(&corev1.ResourceQuota{}, f.options.key(true), func() cache.SharedIndexInformer {
 		return cache.NewSharedIndexInformer(
-			&cache.ListWatch{
-				ListFunc: func(options k8smetav1.ListOptions) (runtime.Object, error) {
-					return f.client.ListResourceQuota(context.TODO(), f.namespace, metav1.ListOptionsFromUpstream(options))
+			typedclient.NewListWatch(f.client.backend, f.options.namespaces(), f.options.tweakListOptions,
+				func(ctx context.Context, namespace string, options k8smetav1.ListOptions) (runtime.Object, error) {
+					return f.client.ListResourceQuota(ctx, namespace, metav1.ListOptionsFromUpstream(options))
 				},
-				WatchFunc: func(options k8smetav1.ListOptions) (watch.Interface, error) {
-					return f.client.WatchResourceQuota(context.TODO(), f.namespace, metav1.ListOptionsFromUpstream(options))
+				func(ctx context.Context, namespace string, options k8smetav1.ListOptions) (watch.Interface, error) {
+					return f.client.WatchResourceQuota(ctx, namespace, metav1.ListOptionsFromUpstream(options))
 				},
-			},
+			),
 			&corev1.ResourceQuota{},
 			f.resyncPeriod,
 			f.indexers,
@@ -4377,16 +4434,16 @@ func (f *CoreV1Informer) ResourceQuotaLister() *CoreV1ResourceQuotaLister {
 }
 
 func (f *CoreV1Informer) SecretInformer() cache.SharedIndexInformer {
-	return f.cache.Write(&corev1.Secret{}, func() cache.SharedIndexInformer {
+	return f.cache.WriteWithFilter(&corev1.Secret{}, f.options.key(true), func() cache.SharedIndexInformer {
 		return cache.NewSharedIndexInformer(
-			&cache.ListWatch{
-				ListFunc: func(options k8smetav1.ListOptions) (runtime.Object, error) {
-					return f.client.ListSecret(context.TODO(), f.namespace, metav1.ListOptionsFromUpstream(options))
+			typedclient.NewListWatch(f.client.backend, f.options.namespaces(), f.options.tweakListOptions,
+				func(ctx context.Context, namespace string, options k8smetav1.ListOptions) (runtime.Object, error) {
+					return f.client.ListSecret(ctx, namespace, metav1.ListOptionsFromUpstream(options))
 				},
-				WatchFunc: func(options k8smetav1.ListOptions) (watch.Interface, error) {
-					return f.client.WatchSecret(context.TODO(), f.namespace, metav1.ListOptionsFromUpstream(options))
+				func(ctx context.Context, namespace string, options k8smetav1.ListOptions) (watch.Interface, error) {
+					return f.client.WatchSecret(ctx, namespace, metav1.ListOptionsFromUpstream(options))
 				},
-			},
+			),
 			&corev1.Secret{},
 			f.resyncPeriod,
 			f.indexers,
@@ -4399,16 +4456,16 @@ func (f *CoreV1Informer) SecretLister() *CoreV1SecretLister {
 }
 
 func (f *CoreV1Informer) ServiceInformer() cache.SharedIndexInformer {
-	return f.cache.Write(&corev1.Service{}, func() cache.SharedIndexInformer {
+	return f.cache.WriteWithFilter(&corev1.Service{}, f.options.key(true), func() cache.SharedIndexInformer {
 		return cache.NewSharedIndexInformer(
-			&cache.ListWatch{
-				ListFunc: func(options k8smetav1.ListOptions) (runtime.Object, error) {
-					return f.client.ListService(context.TODO(), f.namespace, metav1.ListOptionsFromUpstream(options))
+			typedclient.NewListWatch(f.client.backend, f.options.namespaces(), f.options.tweakListOptions,
+				func(ctx context.Context, namespace string, options k8smetav1.ListOptions) (runtime.Object, error) {
+					return f.client.ListService(ctx, namespace, metav1.ListOptionsFromUpstream(options))
 				},
-				WatchFunc: func(options k8smetav1.ListOptions) (watch.Interface, error) {
-					return f.client.WatchService(context.TODO(), f.namespace, metav1.ListOptionsFromUpstream(options))
+				func(ctx context.Context, namespace string, options k8smetav1.ListOptions) (watch.Interface, error) {
+					return f.client.WatchService(ctx, namespace, metav1.ListOptionsFromUpstream(options))
 				},
-			},
+			),
 			&corev1.Service{},
 			f.resyncPeriod,
 			f.indexers,
@@ -4421,16 +4478,16 @@ func (f *CoreV1Informer) ServiceLister() *CoreV1ServiceLister {
 }
 
 func (f *CoreV1Informer) ServiceAccountInformer() cache.SharedIndexInformer {
-	return f.cache.Write(&corev1.ServiceAccount{}, func() cache.SharedIndexInformer {
+	return f.cache.WriteWithFilter(&corev1.ServiceAccount{}, f.options.key(true), func() cache.SharedIndexInformer {
 		return cache.NewSharedIndexInformer(
-			&cache.ListWatch{
-				ListFunc: func(options k8smetav1.ListOptions) (runtime.Object, error) {
-					return f.client.ListServiceAccount(context.TODO(), f.namespace, metav1.ListOptionsFromUpstream(options))
+			typedclient.NewListWatch(f.client.backend, f.options.namespaces(), f.options.tweakListOptions,
+				func(ctx context.Context, namespace string, options k8smetav1.ListOptions) (runtime.Object, error) {
+					return f.client.ListServiceAccount(ctx, namespace, metav1.ListOptionsFromUpstream(options))
 				},
-				WatchFunc: func(options k8smetav1.ListOptions) (watch.Interface, error) {
-					return f.client.WatchServiceAccount(context.TODO(), f.namespace, metav1.ListOptionsFromUpstream(options))
+				func(ctx context.Context, namespace string, options k8smetav1.ListOptions) (watch.Interface, error) {
+					return f.client.WatchServiceAccount(ctx, namespace, metav1.ListOptionsFromUpstream(options))
 				},
-			},
+			),
 			&corev1.ServiceAccount{},
 			f.resyncPeriod,
 			f.indexers,
@@ -4445,32 +4502,36 @@ func (f *CoreV1Informer) ServiceAccountLister() *CoreV1ServiceAccountLister {
 type AdmissionregistrationK8sIoV1Informer struct {
 	cache        *InformerCache
 	client       *AdmissionregistrationK8sIoV1
-	namespace    string
+	options      InformerOptions
 	resyncPeriod time.Duration
 	indexers     cache.Indexers
 }
 
 func NewAdmissionregistrationK8sIoV1Informer(c *InformerCache, client *AdmissionregistrationK8sIoV1, namespace string, resyncPeriod time.Duration) *AdmissionregistrationK8sIoV1Informer {
+	return NewAdmissionregistrationK8sIoV1InformerWithOptions(c, client, resyncPeriod, InformerOptions{Namespaces: []string{namespace}})
+}
+
+func NewAdmissionregistrationK8sIoV1InformerWithOptions(c *InformerCache, client *AdmissionregistrationK8sIoV1, resyncPeriod time.Duration, opts InformerOptions) *AdmissionregistrationK8sIoV1Informer {
 	return &AdmissionregistrationK8sIoV1Informer{
 		cache:        c,
 		client:       client,
-		namespace:    namespace,
+		options:      opts,
 		resyncPeriod: resyncPeriod,
 		indexers:     cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc},
 	}
 }
 
 func (f *AdmissionregistrationK8sIoV1Informer) MutatingAdmissionPolicyInformer() cache.SharedIndexInformer {
-	return f.cache.Write(&admissionregistrationv1.MutatingAdmissionPolicy{}, func() cache.SharedIndexInformer {
+	return f.cache.WriteWithFilter(&admissionregistrationv1.MutatingAdmissionPolicy{}, f.options.key(false), func() cache.SharedIndexInformer {
 		return cache.NewSharedIndexInformer(
-			&cache.ListWatch{
-				ListFunc: func(options k8smetav1.ListOptions) (runtime.Object, error) {
-					return f.client.ListMutatingAdmissionPolicy(context.TODO(), metav1.ListOptionsFromUpstream(options))
+			typedclient.NewListWatch(f.client.backend, nil, f.options.tweakListOptions,
+				func(ctx context.Context, _ string, options k8smetav1.ListOptions) (runtime.Object, error) {
+					return f.client.ListMutatingAdmissionPolicy(ctx, metav1.ListOptionsFromUpstream(options))
 				},
-				WatchFunc: func(options k8smetav1.ListOptions) (watch.Interface, error) {
-					return f.client.WatchMutatingAdmissionPolicy(context.TODO(), metav1.ListOptionsFromUpstream(options))
+				func(ctx context.Context, _ string, options k8smetav1.ListOptions) (watch.Interface, error) {
+					return f.client.WatchMutatingAdmissionPolicy(ctx, metav1.ListOptionsFromUpstream(options))
 				},
-			},
+			),
 			&admissionregistrationv1.MutatingAdmissionPolicy{},
 			f.resyncPeriod,
 			f.indexers,
@@ -4483,16 +4544,16 @@ func (f *AdmissionregistrationK8sIoV1Informer) MutatingAdmissionPolicyLister() *
 }
 
 func (f *AdmissionregistrationK8sIoV1Informer) MutatingAdmissionPolicyBindingInformer() cache.SharedIndexInformer {
-	return f.cache.Write(&admissionregistrationv1.MutatingAdmissionPolicyBinding{}, func() cache.SharedIndexInformer {
+	return f.cache.WriteWithFilter(&admissionregistrationv1.MutatingAdmissionPolicyBinding{}, f.options.key(false), func() cache.SharedIndexInformer {
 		return cache.NewSharedIndexInformer(
-			&cache.ListWatch{
-				ListFunc: func(options k8smetav1.ListOptions) (runtime.Object, error) {
-					return f.client.ListMutatingAdmissionPolicyBinding(context.TODO(), metav1.ListOptionsFromUpstream(options))
+			typedclient.NewListWatch(f.client.backend, nil, f.options.tweakListOptions,
+				func(ctx context.Context, _ string, options k8smetav1.ListOptions) (runtime.Object, error) {
+					return f.client.ListMutatingAdmissionPolicyBinding(ctx, metav1.ListOptionsFromUpstream(options))
 				},
-				WatchFunc: func(options k8smetav1.ListOptions) (watch.Interface, error) {
-					return f.client.WatchMutatingAdmissionPolicyBinding(context.TODO(), metav1.ListOptionsFromUpstream(options))
+				func(ctx context.Context, _ string, options k8smetav1.ListOptions) (watch.Interface, error) {
+					return f.client.WatchMutatingAdmissionPolicyBinding(ctx, metav1.ListOptionsFromUpstream(options))
 				},
-			},
+			),
 			&admissionregistrationv1.MutatingAdmissionPolicyBinding{},
 			f.resyncPeriod,
 			f.indexers,
@@ -4505,16 +4566,16 @@ func (f *AdmissionregistrationK8sIoV1Informer) MutatingAdmissionPolicyBindingLis
 }
 
 func (f *AdmissionregistrationK8sIoV1Informer) MutatingWebhookConfigurationInformer() cache.SharedIndexInformer {
-	return f.cache.Write(&admissionregistrationv1.MutatingWebhookConfiguration{}, func() cache.SharedIndexInformer {
+	return f.cache.WriteWithFilter(&admissionregistrationv1.MutatingWebhookConfiguration{}, f.options.key(false), func() cache.SharedIndexInformer {
 		return cache.NewSharedIndexInformer(
-			&cache.ListWatch{
-				ListFunc: func(options k8smetav1.ListOptions) (runtime.Object, error) {
-					return f.client.ListMutatingWebhookConfiguration(context.TODO(), metav1.ListOptionsFromUpstream(options))
+			typedclient.NewListWatch(f.client.backend, nil, f.options.tweakListOptions,
+				func(ctx context.Context, _ string, options k8smetav1.ListOptions) (runtime.Object, error) {
+					return f.client.ListMutatingWebhookConfiguration(ctx, metav1.ListOptionsFromUpstream(options))
 				},
-				WatchFunc: func(options k8smetav1.ListOptions) (watch.Interface, error) {
-					return f.client.WatchMutatingWebhookConfiguration(context.TODO(), metav1.ListOptionsFromUpstream(options))
+				func(ctx context.Context, _ string, options k8smetav1.ListOptions) (watch.Interface, error) {
+					return f.client.WatchMutatingWebhookConfiguration(ctx, metav1.ListOptionsFromUpstream(options))
 				},
-			},
+			),
 			&admissionregistrationv1.MutatingWebhookConfiguration{},
 			f.resyncPeriod,
 			f.indexers,
@@ -4527,16 +4588,16 @@ func (f *AdmissionregistrationK8sIoV1Informer) MutatingWebhookConfigurationListe
 }
 
 func (f *AdmissionregistrationK8sIoV1Informer) ValidatingAdmissionPolicyInformer() cache.SharedIndexInformer {
-	return f.cache.Write(&admissionregistrationv1.ValidatingAdmissionPolicy{}, func() cache.SharedIndexInformer {
+	return f.cache.WriteWithFilter(&admissionregistrationv1.ValidatingAdmissionPolicy{}, f.options.key(false), func() cache.SharedIndexInformer {
 		return cache.NewSharedIndexInformer(
-			&cache.ListWatch{
-				ListFunc: func(options k8smetav1.ListOptions) (runtime.Object, error) {
-					return f.client.ListValidatingAdmissionPolicy(context.TODO(), metav1.ListOptionsFromUpstream(options))
+			typedclient.NewListWatch(f.client.backend, nil, f.options.tweakListOptions,
+				func(ctx context.Context, _ string, options k8smetav1.ListOptions) (runtime.Object, error) {
+					return f.client.ListValidatingAdmissionPolicy(ctx, metav1.ListOptionsFromUpstream(options))
 				},
-				WatchFunc: func(options k8smetav1.ListOptions) (watch.Interface, error) {
-					return f.client.WatchValidatingAdmissionPolicy(context.TODO(), metav1.ListOptionsFromUpstream(options))
+				func(ctx context.Context, _ string, options k8smetav1.ListOptions) (watch.Interface, error) {
+					return f.client.WatchValidatingAdmissionPolicy(ctx, metav1.ListOptionsFromUpstream(options))
 				},
-			},
+			),
 			&admissionregistrationv1.ValidatingAdmissionPolicy{},
 			f.resyncPeriod,
 			f.indexers,
@@ -4549,16 +4610,16 @@ func (f *AdmissionregistrationK8sIoV1Informer) ValidatingAdmissionPolicyLister()
 }
 
 func (f *AdmissionregistrationK8sIoV1Informer) ValidatingAdmissionPolicyBindingInformer() cache.SharedIndexInformer {
-	return f.cache.Write(&admissionregistrationv1.ValidatingAdmissionPolicyBinding{}, func() cache.SharedIndexInformer {
+	return f.cache.WriteWithFilter(&admissionregistrationv1.ValidatingAdmissionPolicyBinding{}, f.options.key(false), func() cache.SharedIndexInformer {
 		return cache.NewSharedIndexInformer(
-			&cache.ListWatch{
-				ListFunc: func(options k8smetav1.ListOptions) (runtime.Object, error) {
-					return f.client.ListValidatingAdmissionPolicyBinding(context.TODO(), metav1.ListOptionsFromUpstream(options))
+			typedclient.NewListWatch(f.client.backend, nil, f.options.tweakListOptions,
+				func(ctx context.Context, _ string, options k8smetav1.ListOptions) (runtime.Object, error) {
+					return f.client.ListValidatingAdmissionPolicyBinding(ctx, metav1.ListOptionsFromUpstream(options))
 				},
-				WatchFunc: func(options k8smetav1.ListOptions) (watch.Interface, error) {
-					return f.client.WatchValidatingAdmissionPolicyBinding(context.TODO(), metav1.ListOptionsFromUpstream(options))
+				func(ctx context.Context, _ string, options k8smetav1.ListOptions) (watch.Interface, error) {
+					return f.client.WatchValidatingAdmissionPolicyBinding(ctx, metav1.ListOptionsFromUpstream(options))
 				},
-			},
+			),
 			&admissionregistrationv1.ValidatingAdmissionPolicyBinding{},
 			f.resyncPeriod,
 			f.indexers,
@@ -4571,16 +4632,16 @@ func (f *AdmissionregistrationK8sIoV1Informer) ValidatingAdmissionPolicyBindingL
 }
 
 func (f *AdmissionregistrationK8sIoV1Informer) ValidatingWebhookConfigurationInformer() cache.SharedIndexInformer {
-	return f.cache.Write(&admissionregistrationv1.ValidatingWebhookConfiguration{}, func() cache.SharedIndexInformer {
+	return f.cache.WriteWithFilter(&admissionregistrationv1.ValidatingWebhookConfiguration{}, f.options.key(false), func() cache.SharedIndexInformer {
 		return cache.NewSharedIndexInformer(
-			&cache.ListWatch{
-				ListFunc: func(options k8smetav1.ListOptions) (runtime.Object, error) {
-					return f.client.ListValidatingWebhookConfiguration(context.TODO(), metav1.ListOptionsFromUpstream(options))
+			typedclient.NewListWatch(f.client.backend, nil, f.options.tweakListOptions,
+				func(ctx context.Context, _ string, options k8smetav1.ListOptions) (runtime.Object, error) {
+					return f.client.ListValidatingWebhookConfiguration(ctx, metav1.ListOptionsFromUpstream(options))
 				},
-				WatchFunc: func(options k8smetav1.ListOptions) (watch.Interface, error) {
-					return f.client.WatchValidatingWebhookConfiguration(context.TODO(), metav1.ListOptionsFromUpstream(options))
+				func(ctx context.Context, _ string, options k8smetav1.ListOptions) (watch.Interface, error) {
+					return f.client.WatchValidatingWebhookConfiguration(ctx, metav1.ListOptionsFromUpstream(options))
 				},
-			},
+			),
 			&admissionregistrationv1.ValidatingWebhookConfiguration{},
 			f.resyncPeriod,
 			f.indexers,
@@ -4595,32 +4656,36 @@ func (f *AdmissionregistrationK8sIoV1Informer) ValidatingWebhookConfigurationLis
 type AppsV1Informer struct {
 	cache        *InformerCache
 	client       *AppsV1
-	namespace    string
+	options      InformerOptions
 	resyncPeriod time.Duration
 	indexers     cache.Indexers
 }
 
 func NewAppsV1Informer(c *InformerCache, client *AppsV1, namespace string, resyncPeriod time.Duration) *AppsV1Informer {
+	return NewAppsV1InformerWithOptions(c, client, resyncPeriod, InformerOptions{Namespaces: []string{namespace}})
+}
+
+func NewAppsV1InformerWithOptions(c *InformerCache, client *AppsV1, resyncPeriod time.Duration, opts InformerOptions) *AppsV1Informer {
 	return &AppsV1Informer{
 		cache:        c,
 		client:       client,
-		namespace:    namespace,
+		options:      opts,
 		resyncPeriod: resyncPeriod,
 		indexers:     cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc},
 	}
 }
 
 func (f *AppsV1Informer) ControllerRevisionInformer() cache.SharedIndexInformer {
-	return f.cache.Write(&appsv1.ControllerRevision{}, func() cache.SharedIndexInformer {
+	return f.cache.WriteWithFilter(&appsv1.ControllerRevision{}, f.options.key(true), func() cache.SharedIndexInformer {
 		return cache.NewSharedIndexInformer(
-			&cache.ListWatch{
-				ListFunc: func(options k8smetav1.ListOptions) (runtime.Object, error) {
-					return f.client.ListControllerRevision(context.TODO(), f.namespace, metav1.ListOptionsFromUpstream(options))
+			typedclient.NewListWatch(f.client.backend, f.options.namespaces(), f.options.tweakListOptions,
+				func(ctx context.Context, namespace string, options k8smetav1.ListOptions) (runtime.Object, error) {
+					return f.client.ListControllerRevision(ctx, namespace, metav1.ListOptionsFromUpstream(options))
 				},
-				WatchFunc: func(options k8smetav1.ListOptions) (watch.Interface, error) {
-					return f.client.WatchControllerRevision(context.TODO(), f.namespace, metav1.ListOptionsFromUpstream(options))
+				func(ctx context.Context, namespace string, options k8smetav1.ListOptions) (watch.Interface, error) {
+					return f.client.WatchControllerRevision(ctx, namespace, metav1.ListOptionsFromUpstream(options))
 				},
-			},
+			),
 			&appsv1.ControllerRevision{},
 			f.resyncPeriod,
 			f.indexers,
@@ -4632,20 +4697,42 @@ func (f *AppsV1Informer) ControllerRevisionLister() *AppsV1ControllerRevisionLis
 	return NewAppsV1ControllerRevisionLister(f.ControllerRevisionInformer().GetIndexer())
 }
 
+const (
+	// AppsV1DaemonSetIndexSpecTemplateSpecNodeName is the name of the index by spec.template.spec.nodeName of DaemonSet.
+	AppsV1DaemonSetIndexSpecTemplateSpecNodeName = "spec.template.spec.nodeName"
+)
+
+// AppsV1DaemonSetIndexers returns the indexers of the fields of DaemonSet which are marked as indexed.
+// The indexers are added to the informer of DaemonSet.
+func AppsV1DaemonSetIndexers() cache.Indexers {
+	return cache.Indexers{
+		AppsV1DaemonSetIndexSpecTemplateSpecNodeName: func(obj any) ([]string, error) {
+			v, ok := obj.(*appsv1.DaemonSet)
+			if !ok {
+				return nil, nil
+			}
+			if v.Spec != nil && v.Spec.Template.Spec != nil {
+				return []string{v.Spec.Template.Spec.NodeName}, nil
+			}
+			return nil, nil
+		},
+	}
+}
+
 func (f *AppsV1Informer) DaemonSetInformer() cache.SharedIndexInformer {
-	return f.cache.Write(&appsv1.DaemonSet{}, func() cache.SharedIndexInformer {
+	return f.cache.WriteWithFilter(&appsv1.DaemonSet{}, f.options.key(true), func() cache.SharedIndexInformer {
 		return cache.NewSharedIndexInformer(
-			&cache.ListWatch{
-				ListFunc: func(options k8smetav1.ListOptions) (runtime.Object, error) {
-					return f.client.ListDaemonSet(context.TODO(), f.namespace, metav1.ListOptionsFromUpstream(options))
+			typedclient.NewListWatch(f.client.backend, f.options.namespaces(), f.options.tweakListOptions,
+				func(ctx context.Context, namespace string, options k8smetav1.ListOptions) (runtime.Object, error) {
+					return f.client.ListDaemonSet(ctx, namespace, metav1.ListOptionsFromUpstream(options))
 				},
-				WatchFunc: func(options k8smetav1.ListOptions) (watch.Interface, error) {
-					return f.client.WatchDaemonSet(context.TODO(), f.namespace, metav1.ListOptionsFromUpstream(options))
+				func(ctx context.Context, namespace string, options k8smetav1.ListOptions) (watch.Interface, error) {
+					return f.client.WatchDaemonSet(ctx, namespace, metav1.ListOptionsFromUpstream(options))
 				},
-			},
+			),
 			&appsv1.DaemonSet{},
 			f.resyncPeriod,
-			f.indexers,
+			typedclient.MergeIndexers(f.indexers, AppsV1DaemonSetIndexers()),
 		)
 	})
 }
@@ -4654,20 +4741,42 @@ func (f *AppsV1Informer) DaemonSetLister() *AppsV1DaemonSetLister {
 	return NewAppsV1DaemonSetLister(f.DaemonSetInformer().GetIndexer())
 }
 
+const (
+	// AppsV1DeploymentIndexSpecTemplateSpecNodeName is the name of the index by spec.template.spec.nodeName of Deployment.
+	AppsV1DeploymentIndexSpecTemplateSpecNodeName = "spec.template.spec.nodeName"
+)
+
+// AppsV1DeploymentIndexers returns the indexers of the fields of Deployment which are marked as indexed.
+// The indexers are added to the informer of Deployment.
+func AppsV1DeploymentIndexers() cache.Indexers {
+	return cache.Indexers{
+		AppsV1DeploymentIndexSpecTemplateSpecNodeName: func(obj any) ([]string, error) {
+			v, ok := obj.(*appsv1.Deployment)
+			if !ok {
+				return nil, nil
+			}
+			if v.Spec != nil && v.Spec.Template.Spec != nil {
+				return []string{v.Spec.Template.Spec.NodeName}, nil
+			}
+			return nil, nil
+		},
+	}
+}
+
 func (f *AppsV1Informer) DeploymentInformer() cache.SharedIndexInformer {
-	return f.cache.Write(&appsv1.Deployment{}, func() cache.SharedIndexInformer {
+	return f.cache.WriteWithFilter(&appsv1.Deployment{}, f.options.key(true), func() cache.SharedIndexInformer {
 		return cache.NewSharedIndexInformer(
-			&cache.ListWatch{
-				ListFunc: func(options k8smetav1.ListOptions) (runtime.Object, error) {
-					return f.client.ListDeployment(context.TODO(), f.namespace, metav1.ListOptionsFromUpstream(options))
+			typedclient.NewListWatch(f.client.backend, f.options.namespaces(), f.options.tweakListOptions,
+				func(ctx context.Context, namespace string, options k8smetav1.ListOptions) (runtime.Object, error) {
+					return f.client.ListDeployment(ctx, namespace, metav1.ListOptionsFromUpstream(options))
 				},
-				WatchFunc: func(options k8smetav1.ListOptions) (watch.Interface, error) {
-					return f.client.WatchDeployment(context.TODO(), f.namespace, metav1.ListOptionsFromUpstream(options))
+				func(ctx context.Context, namespace string, options k8smetav1.ListOptions) (watch.Interface, error) {
+					return f.client.WatchDeployment(ctx, namespace, metav1.ListOptionsFromUpstream(options))
 				},
-			},
+			),
 			&appsv1.Deployment{},
 			f.resyncPeriod,
-			f.indexers,
+			typedclient.MergeIndexers(f.indexers, AppsV1DeploymentIndexers()),
 		)
 	})
 }
@@ -4676,20 +4785,42 @@ func (f *AppsV1Informer) DeploymentLister() *AppsV1DeploymentLister {
 	return NewAppsV1DeploymentLister(f.DeploymentInformer().GetIndexer())
 }
 
+const (
+	// AppsV1ReplicaSetIndexSpecTemplateSpecNodeName is the name of the index by spec.template.spec.nodeName of ReplicaSet.
+	AppsV1ReplicaSetIndexSpecTemplateSpecNodeName = "spec.template.spec.nodeName"
+)
+
+// AppsV1ReplicaSetIndexers returns the indexers of the fields of ReplicaSet which are marked as indexed.
+// The indexers are added to the informer of ReplicaSet.
+func AppsV1ReplicaSetIndexers() cache.Indexers {
+	return cache.Indexers{
+		AppsV1ReplicaSetIndexSpecTemplateSpecNodeName: func(obj any) ([]string, error) {
+			v, ok := obj.(*appsv1.ReplicaSet)
+			if !ok {
+				return nil, nil
+			}
+			if v.Spec != nil && v.Spec.Template != nil && v.Spec.Template.Spec != nil {
+				return []string{v.Spec.Template.Spec.NodeName}, nil
+			}
+			return nil, nil
+		},
+	}
+}
+
 func (f *AppsV1Informer) ReplicaSetInformer() cache.SharedIndexInformer {
-	return f.cache.Write(&appsv1.ReplicaSet{}, func() cache.SharedIndexInformer {
+	return f.cache.WriteWithFilter(&appsv1.ReplicaSet{}, f.options.key(true), func() cache.SharedIndexInformer {
 		return cache.NewSharedIndexInformer(
-			&cache.ListWatch{
-				ListFunc: func(options k8smetav1.ListOptions) (runtime.Object, error) {
-					return f.client.ListReplicaSet(context.TODO(), f.namespace, metav1.ListOptionsFromUpstream(options))
+			typedclient.NewListWatch(f.client.backend, f.options.namespaces(), f.options.tweakListOptions,
+				func(ctx context.Context, namespace string, options k8smetav1.ListOptions) (runtime.Object, error) {
+					return f.client.ListReplicaSet(ctx, namespace, metav1.ListOptionsFromUpstream(options))
 				},
-				WatchFunc: func(options k8smetav1.ListOptions) (watch.Interface, error) {
-					return f.client.WatchReplicaSet(context.TODO(), f.namespace, metav1.ListOptionsFromUpstream(options))
+				func(ctx context.Context, namespace string, options k8smetav1.ListOptions) (watch.Interface, error) {
+					return f.client.WatchReplicaSet(ctx, namespace, metav1.ListOptionsFromUpstream(options))
 				},
-			},
+			),
 			&appsv1.ReplicaSet{},
 			f.resyncPeriod,
-			f.indexers,
+			typedclient.MergeIndexers(f.indexers, AppsV1ReplicaSetIndexers()),
 		)
 	})
 }
@@ -4698,20 +4829,42 @@ func (f *AppsV1Informer) ReplicaSetLister() *AppsV1ReplicaSetLister {
 	return NewAppsV1ReplicaSetLister(f.ReplicaSetInformer().GetIndexer())
 }
 
+const (
+	// AppsV1StatefulSetIndexSpecTemplateSpecNodeName is the name of the index by spec.template.spec.nodeName of StatefulSet.
+	AppsV1StatefulSetIndexSpecTemplateSpecNodeName = "spec.template.spec.nodeName"
+)
+
+// AppsV1StatefulSetIndexers returns the indexers of the fields of StatefulSet which are marked as indexed.
+// The indexers are added to the informer of StatefulSet.
+func AppsV1StatefulSetIndexers() cache.Indexers {
+	return cache.Indexers{
+		AppsV1StatefulSetIndexSpecTemplateSpecNodeName: func(obj any) ([]string, error) {
+			v, ok := obj.(*appsv1.StatefulSet)
+			if !ok {
+				return nil, nil
+			}
+			if v.Spec != nil && v.Spec.Template.Spec != nil {
+				return []string{v.Spec.Template.Spec.NodeName}, nil
+			}
+			return nil, nil
+		},
+	}
+}
+
 func (f *AppsV1Informer) StatefulSetInformer() cache.SharedIndexInformer {
-	return f.cache.Write(&appsv1.StatefulSet{}, func() cache.SharedIndexInformer {
+	return f.cache.WriteWithFilter(&appsv1.StatefulSet{}, f.options.key(true), func() cache.SharedIndexInformer {
 		return cache.NewSharedIndexInformer(
-			&cache.ListWatch{
-				ListFunc: func(options k8smetav1.ListOptions) (runtime.Object, error) {
-					return f.client.ListStatefulSet(context.TODO(), f.namespace, metav1.ListOptionsFromUpstream(options))
+			typedclient.NewListWatch(f.client.backend, f.options.namespaces(), f.options.tweakListOptions,
+				func(ctx context.Context, namespace string, options k8smetav1.ListOptions) (runtime.Object, error) {
+					return f.client.ListStatefulSet(ctx, namespace, metav1.ListOptionsFromUpstream(options))
 				},
-				WatchFunc: func(options k8smetav1.ListOptions) (watch.Interface, error) {
-					return f.client.WatchStatefulSet(context.TODO(), f.namespace, metav1.ListOptionsFromUpstream(options))
+				func(ctx context.Context, namespace string, options k8smetav1.ListOptions) (watch.Interface, error) {
+					return f.client.WatchStatefulSet(ctx, namespace, metav1.ListOptionsFromUpstream(options))
 				},
-			},
+			),
 			&appsv1.StatefulSet{},
 			f.resyncPeriod,
-			f.indexers,
+			typedclient.MergeIndexers(f.indexers, AppsV1StatefulSetIndexers()),
 		)
 	})
 }
@@ -4723,32 +4876,36 @@ func (f *AppsV1Informer) StatefulSetLister() *AppsV1StatefulSetLister {
 type AuthenticationK8sIoV1Informer struct {
 	cache        *InformerCache
 	client       *AuthenticationK8sIoV1
-	namespace    string
+	options      InformerOptions
 	resyncPeriod time.Duration
 	indexers     cache.Indexers
 }
 
 func NewAuthenticationK8sIoV1Informer(c *InformerCache, client *AuthenticationK8sIoV1, namespace string, resyncPeriod time.Duration) *AuthenticationK8sIoV1Informer {
+	return NewAuthenticationK8sIoV1InformerWithOptions(c, client, resyncPeriod, InformerOptions{Namespaces: []string{namespace}})
+}
+
+func NewAuthenticationK8sIoV1InformerWithOptions(c *InformerCache, client *AuthenticationK8sIoV1, resyncPeriod time.Duration, opts InformerOptions) *AuthenticationK8sIoV1Informer {
 	return &AuthenticationK8sIoV1Informer{
 		cache:        c,
 		client:       client,
-		namespace:    namespace,
+		options:      opts,
 		resyncPeriod: resyncPeriod,
 		indexers:     cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc},
 	}
 }
 
 func (f *AuthenticationK8sIoV1Informer) SelfSubjectReviewInformer() cache.SharedIndexInformer {
-	return f.cache.Write(&authenticationv1.SelfSubjectReview{}, func() cache.SharedIndexInformer {
+	return f.cache.WriteWithFilter(&authenticationv1.SelfSubjectReview{}, f.options.key(false), func() cache.SharedIndexInformer {
 		return cache.NewSharedIndexInformer(
-			&cache.ListWatch{
-				ListFunc: func(options k8smetav1.ListOptions) (runtime.Object, error) {
-					return f.client.ListSelfSubjectReview(context.TODO(), metav1.ListOptionsFromUpstream(options))
+			typedclient.NewListWatch(f.client.backend, nil, f.options.tweakListOptions,
+				func(ctx context.Context, _ string, options k8smetav1.ListOptions) (runtime.Object, error) {
+					return f.client.ListSelfSubjectReview(ctx, metav1.ListOptionsFromUpstream(options))
 				},
-				WatchFunc: func(options k8smetav1.ListOptions) (watch.Interface, error) {
-					return f.client.WatchSelfSubjectReview(context.TODO(), metav1.ListOptionsFromUpstream(options))
+				func(ctx context.Context, _ string, options k8smetav1.ListOptions) (watch.Interface, error) {
+					return f.client.WatchSelfSubjectReview(ctx, metav1.ListOptionsFromUpstream(options))
 				},
-			},
+			),
 			&authenticationv1.SelfSubjectReview{},
 			f.resyncPeriod,
 			f.indexers,
@@ -4761,16 +4918,16 @@ func (f *AuthenticationK8sIoV1Informer) SelfSubjectReviewLister() *Authenticatio
 }
 
 func (f *AuthenticationK8sIoV1Informer) TokenRequestInformer() cache.SharedIndexInformer {
-	return f.cache.Write(&authenticationv1.TokenRequest{}, func() cache.SharedIndexInformer {
+	return f.cache.WriteWithFilter(&authenticationv1.TokenRequest{}, f.options.key(true), func() cache.SharedIndexInformer {
 		return cache.NewSharedIndexInformer(
-			&cache.ListWatch{
-				ListFunc: func(options k8smetav1.ListOptions) (runtime.Object, error) {
-					return f.client.ListTokenRequest(context.TODO(), f.namespace, metav1.ListOptionsFromUpstream(options))
+			typedclient.NewListWatch(f.client.backend, f.options.namespaces(), f.options.tweakListOptions,
+				func(ctx context.Context, namespace string, options k8smetav1.ListOptions) (runtime.Object, error) {
+					return f.client.ListTokenRequest(ctx, namespace, metav1.ListOptionsFromUpstream(options))
 				},
-				WatchFunc: func(options k8smetav1.ListOptions) (watch.Interface, error) {
-					return f.client.WatchTokenRequest(context.TODO(), f.namespace, metav1.ListOptionsFromUpstream(options))
+				func(ctx context.Context, namespace string, options k8smetav1.ListOptions) (watch.Interface, error) {
+					return f.client.WatchTokenRequest(ctx, namespace, metav1.ListOptionsFromUpstream(options))
 				},
-			},
+			),
 			&authenticationv1.TokenRequest{},
 			f.resyncPeriod,
 			f.indexers,
@@ -4783,16 +4940,16 @@ func (f *AuthenticationK8sIoV1Informer) TokenRequestLister() *AuthenticationK8sI
 }
 
 func (f *AuthenticationK8sIoV1Informer) TokenReviewInformer() cache.SharedIndexInformer {
-	return f.cache.Write(&authenticationv1.TokenReview{}, func() cache.SharedIndexInformer {
+	return f.cache.WriteWithFilter(&authenticationv1.TokenReview{}, f.options.key(false), func() cache.SharedIndexInformer {
 		return cache.NewSharedIndexInformer(
-			&cache.ListWatch{
-				ListFunc: func(options k8smetav1.ListOptions) (runtime.Object, error) {
-					return f.client.ListTokenReview(context.TODO(), metav1.ListOptionsFromUpstream(options))
+			typedclient.NewListWatch(f.client.backend, nil, f.options.tweakListOptions,
+				func(ctx context.Context, _ string, options k8smetav1.ListOptions) (runtime.Object, error) {
+					return f.client.ListTokenReview(ctx, metav1.ListOptionsFromUpstream(options))
 				},
-				WatchFunc: func(options k8smetav1.ListOptions) (watch.Interface, error) {
-					return f.client.WatchTokenReview(context.TODO(), metav1.ListOptionsFromUpstream(options))
+				func(ctx context.Context, _ string, options k8smetav1.ListOptions) (watch.Interface, error) {
+					return f.client.WatchTokenReview(ctx, metav1.ListOptionsFromUpstream(options))
 				},
-			},
+			),
 			&authenticationv1.TokenReview{},
 			f.resyncPeriod,
 			f.indexers,
@@ -4807,32 +4964,36 @@ func (f *AuthenticationK8sIoV1Informer) TokenReviewLister() *AuthenticationK8sIo
 type AuthorizationK8sIoV1Informer struct {
 	cache        *InformerCache
 	client       *AuthorizationK8sIoV1
-	namespace    string
+	options      InformerOptions
 	resyncPeriod time.Duration
 	indexers     cache.Indexers
 }
 
 func NewAuthorizationK8sIoV1Informer(c *InformerCache, client *AuthorizationK8sIoV1, namespace string, resyncPeriod time.Duration) *AuthorizationK8sIoV1Informer {
+	return NewAuthorizationK8sIoV1InformerWithOptions(c, client, resyncPeriod, InformerOptions{Namespaces: []string{namespace}})
+}
+
+func NewAuthorizationK8sIoV1InformerWithOptions(c *InformerCache, client *AuthorizationK8sIoV1, resyncPeriod time.Duration, opts InformerOptions) *AuthorizationK8sIoV1Informer {
 	return &AuthorizationK8sIoV1Informer{
 		cache:        c,
 		client:       client,
-		namespace:    namespace,
+		options:      opts,
 		resyncPeriod: resyncPeriod,
 		indexers:     cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc},
 	}
 }
 
 func (f *AuthorizationK8sIoV1Informer) LocalSubjectAccessReviewInformer() cache.SharedIndexInformer {
-	return f.cache.Write(&authorizationv1.LocalSubjectAccessReview{}, func() cache.SharedIndexInformer {
+	return f.cache.WriteWithFilter(&authorizationv1.LocalSubjectAccessReview{}, f.options.key(true), func() cache.SharedIndexInformer {
 		return cache.NewSharedIndexInformer(
-			&cache.ListWatch{
-				ListFunc: func(options k8smetav1.ListOptions) (runtime.Object, error) {
-					return f.client.ListLocalSubjectAccessReview(context.TODO(), f.namespace, metav1.ListOptionsFromUpstream(options))
+			typedclient.NewListWatch(f.client.backend, f.options.namespaces(), f.options.tweakListOptions,
+				func(ctx context.Context, namespace string, options k8smetav1.ListOptions) (runtime.Object, error) {
+					return f.client.ListLocalSubjectAccessReview(ctx, namespace, metav1.ListOptionsFromUpstream(options))
 				},
-				WatchFunc: func(options k8smetav1.ListOptions) (watch.Interface, error) {
-					return f.client.WatchLocalSubjectAccessReview(context.TODO(), f.namespace, metav1.ListOptionsFromUpstream(options))
+				func(ctx context.Context, namespace string, options k8smetav1.ListOptions) (watch.Interface, error) {
+					return f.client.WatchLocalSubjectAccessReview(ctx, namespace, metav1.ListOptionsFromUpstream(options))
 				},
-			},
+			),
 			&authorizationv1.LocalSubjectAccessReview{},
 			f.resyncPeriod,
 			f.indexers,
@@ -4845,16 +5006,16 @@ func (f *AuthorizationK8sIoV1Informer) LocalSubjectAccessReviewLister() *Authori
 }
 
 func (f *AuthorizationK8sIoV1Informer) SelfSubjectAccessReviewInformer() cache.SharedIndexInformer {
-	return f.cache.Write(&authorizationv1.SelfSubjectAccessReview{}, func() cache.SharedIndexInformer {
+	return f.cache.WriteWithFilter(&authorizationv1.SelfSubjectAccessReview{}, f.options.key(false), func() cache.SharedIndexInformer {
 		return cache.NewSharedIndexInformer(
-			&cache.ListWatch{
-				ListFunc: func(options k8smetav1.ListOptions) (runtime.Object, error) {
-					return f.client.ListSelfSubjectAccessReview(context.TODO(), metav1.ListOptionsFromUpstream(options))
+			typedclient.NewListWatch(f.client.backend, nil, f.options.tweakListOptions,
+				func(ctx context.Context, _ string, options k8smetav1.ListOptions) (runtime.Object, error) {
+					return f.client.ListSelfSubjectAccessReview(ctx, metav1.ListOptionsFromUpstream(options))
 				},
-				WatchFunc: func(options k8smetav1.ListOptions) (watch.Interface, error) {
-					return f.client.WatchSelfSubjectAccessReview(context.TODO(), metav1.ListOptionsFromUpstream(options))
+				func(ctx context.Context, _ string, options k8smetav1.ListOptions) (watch.Interface, error) {
+					return f.client.WatchSelfSubjectAccessReview(ctx, metav1.ListOptionsFromUpstream(options))
 				},
-			},
+			),
 			&authorizationv1.SelfSubjectAccessReview{},
 			f.resyncPeriod,
 			f.indexers,
@@ -4867,16 +5028,16 @@ func (f *AuthorizationK8sIoV1Informer) SelfSubjectAccessReviewLister() *Authoriz
 }
 
 func (f *AuthorizationK8sIoV1Informer) SelfSubjectRulesReviewInformer() cache.SharedIndexInformer {
-	return f.cache.Write(&authorizationv1.SelfSubjectRulesReview{}, func() cache.SharedIndexInformer {
+	return f.cache.WriteWithFilter(&authorizationv1.SelfSubjectRulesReview{}, f.options.key(false), func() cache.SharedIndexInformer {
 		return cache.NewSharedIndexInformer(
-			&cache.ListWatch{
-				ListFunc: func(options k8smetav1.ListOptions) (runtime.Object, error) {
-					return f.client.ListSelfSubjectRulesReview(context.TODO(), metav1.ListOptionsFromUpstream(options))
+			typedclient.NewListWatch(f.client.backend, nil, f.options.tweakListOptions,
+				func(ctx context.Context, _ string, options k8smetav1.ListOptions) (runtime.Object, error) {
+					return f.client.ListSelfSubjectRulesReview(ctx, metav1.ListOptionsFromUpstream(options))
 				},
-				WatchFunc: func(options k8smetav1.ListOptions) (watch.Interface, error) {
-					return f.client.WatchSelfSubjectRulesReview(context.TODO(), metav1.ListOptionsFromUpstream(options))
+				func(ctx context.Context, _ string, options k8smetav1.ListOptions) (watch.Interface, error) {
+					return f.client.WatchSelfSubjectRulesReview(ctx, metav1.ListOptionsFromUpstream(options))
 				},
-			},
+			),
 			&authorizationv1.SelfSubjectRulesReview{},
 			f.resyncPeriod,
 			f.indexers,
@@ -4889,16 +5050,16 @@ func (f *AuthorizationK8sIoV1Informer) SelfSubjectRulesReviewLister() *Authoriza
 }
 
 func (f *AuthorizationK8sIoV1Informer) SubjectAccessReviewInformer() cache.SharedIndexInformer {
-	return f.cache.Write(&authorizationv1.SubjectAccessReview{}, func() cache.SharedIndexInformer {
+	return f.cache.WriteWithFilter(&authorizationv1.SubjectAccessReview{}, f.options.key(false), func() cache.SharedIndexInformer {
 		return cache.NewSharedIndexInformer(
-			&cache.ListWatch{
-				ListFunc: func(options k8smetav1.ListOptions) (runtime.Object, error) {
-					return f.client.ListSubjectAccessReview(context.TODO(), metav1.ListOptionsFromUpstream(options))
+			typedclient.NewListWatch(f.client.backend, nil, f.options.tweakListOptions,
+				func(ctx context.Context, _ string, options k8smetav1.ListOptions) (runtime.Object, error) {
+					return f.client.ListSubjectAccessReview(ctx, metav1.ListOptionsFromUpstream(options))
 				},
-				WatchFunc: func(options k8smetav1.ListOptions) (watch.Interface, error) {
-					return f.client.WatchSubjectAccessReview(context.TODO(), metav1.ListOptionsFromUpstream(options))
+				func(ctx context.Context, _ string, options k8smetav1.ListOptions) (watch.Interface, error) {
+					return f.client.WatchSubjectAccessReview(ctx, metav1.ListOptionsFromUpstream(options))
 				},
-			},
+			),
 			&authorizationv1.SubjectAccessReview{},
 			f.resyncPeriod,
 			f.indexers,
@@ -4913,32 +5074,36 @@ func (f *AuthorizationK8sIoV1Informer) SubjectAccessReviewLister() *Authorizatio
 type AutoscalingV1Informer struct {
 	cache        *InformerCache
 	client       *AutoscalingV1
-	namespace    string
+	options      InformerOptions
 	resyncPeriod time.Duration
 	indexers     cache.Indexers
 }
 
 func NewAutoscalingV1Informer(c *InformerCache, client *AutoscalingV1, namespace string, resyncPeriod time.Duration) *AutoscalingV1Informer {
+	return NewAutoscalingV1InformerWithOptions(c, client, resyncPeriod, InformerOptions{Namespaces: []string{namespace}})
+}
+
+func NewAutoscalingV1InformerWithOptions(c *InformerCache, client *AutoscalingV1, resyncPeriod time.Duration, opts InformerOptions) *AutoscalingV1Informer {
 	return &AutoscalingV1Informer{
 		cache:        c,
 		client:       client,
-		namespace:    namespace,
+		options:      opts,
 		resyncPeriod: resyncPeriod,
 		indexers:     cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc},
 	}
 }
 
 func (f *AutoscalingV1Informer) HorizontalPodAutoscalerInformer() cache.SharedIndexInformer {
-	return f.cache.Write(&autoscalingv1.HorizontalPodAutoscaler{}, func() cache.SharedIndexInformer {
+	return f.cache.WriteWithFilter(&autoscalingv1.HorizontalPodAutoscaler{}, f.options.key(true), func() cache.SharedIndexInformer {
 		return cache.NewSharedIndexInformer(
-			&cache.ListWatch{
-				ListFunc: func(options k8smetav1.ListOptions) (runtime.Object, error) {
-					return f.client.ListHorizontalPodAutoscaler(context.TODO(), f.namespace, metav1.ListOptionsFromUpstream(options))
+			typedclient.NewListWatch(f.client.backend, f.options.namespaces(), f.options.tweakListOptions,
+				func(ctx context.Context, namespace string, options k8smetav1.ListOptions) (runtime.Object, error) {
+					return f.client.ListHorizontalPodAutoscaler(ctx, namespace, metav1.ListOptionsFromUpstream(options))
 				},
-				WatchFunc: func(options k8smetav1.ListOptions) (watch.Interface, error) {
-					return f.client.WatchHorizontalPodAutoscaler(context.TODO(), f.namespace, metav1.ListOptionsFromUpstream(options))
+				func(ctx context.Context, namespace string, options k8smetav1.ListOptions) (watch.Interface, error) {
+					return f.client.WatchHorizontalPodAutoscaler(ctx, namespace, metav1.ListOptionsFromUpstream(options))
 				},
-			},
+			),
 			&autoscalingv1.HorizontalPodAutoscaler{},
 			f.resyncPeriod,
 			f.indexers,
@@ -4951,16 +5116,16 @@ func (f *AutoscalingV1Informer) HorizontalPodAutoscalerLister() *AutoscalingV1Ho
 }
 
 func (f *AutoscalingV1Informer) ScaleInformer() cache.SharedIndexInformer {
-	return f.cache.Write(&autoscalingv1.Scale{}, func() cache.SharedIndexInformer {
+	return f.cache.WriteWithFilter(&autoscalingv1.Scale{}, f.options.key(true), func() cache.SharedIndexInformer {
 		return cache.NewSharedIndexInformer(
-			&cache.ListWatch{
-				ListFunc: func(options k8smetav1.ListOptions) (runtime.Object, error) {
-					return f.client.ListScale(context.TODO(), f.namespace, metav1.ListOptionsFromUpstream(options))
+			typedclient.NewListWatch(f.client.backend, f.options.namespaces(), f.options.tweakListOptions,
+				func(ctx context.Context, namespace string, options k8smetav1.ListOptions) (runtime.Object, error) {
+					return f.client.ListScale(ctx, namespace, metav1.ListOptionsFromUpstream(options))
 				},
-				WatchFunc: func(options k8smetav1.ListOptions) (watch.Interface, error) {
-					return f.client.WatchScale(context.TODO(), f.namespace, metav1.ListOptionsFromUpstream(options))
+				func(ctx context.Context, namespace string, options k8smetav1.ListOptions) (watch.Interface, error) {
+					return f.client.WatchScale(ctx, namespace, metav1.ListOptionsFromUpstream(options))
 				},
-			},
+			),
 			&autoscalingv1.Scale{},
 			f.resyncPeriod,
 			f.indexers,
@@ -4975,32 +5140,36 @@ func (f *AutoscalingV1Informer) ScaleLister() *AutoscalingV1ScaleLister {
 type AutoscalingV2Informer struct {
 	cache        *InformerCache
 	client       *AutoscalingV2
-	namespace    string
+	options      InformerOptions
 	resyncPeriod time.Duration
 	indexers     cache.Indexers
 }
 
 func NewAutoscalingV2Informer(c *InformerCache, client *AutoscalingV2, namespace string, resyncPeriod time.Duration) *AutoscalingV2Informer {
+	return NewAutoscalingV2InformerWithOptions(c, client, resyncPeriod, InformerOptions{Namespaces: []string{namespace}})
+}
+
+func NewAutoscalingV2InformerWithOptions(c *InformerCache, client *AutoscalingV2, resyncPeriod time.Duration, opts InformerOptions) *AutoscalingV2Informer {
 	return &AutoscalingV2Informer{
 		cache:        c,
 		client:       client,
-		namespace:    namespace,
+		options:      opts,
 		resyncPeriod: resyncPeriod,
 		indexers:     cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc},
 	}
 }
 
 func (f *AutoscalingV2Informer) HorizontalPodAutoscalerInformer() cache.SharedIndexInformer {
-	return f.cache.Write(&autoscalingv2.HorizontalPodAutoscaler{}, func() cache.SharedIndexInformer {
+	return f.cache.WriteWithFilter(&autoscalingv2.HorizontalPodAutoscaler{}, f.options.key(true), func() cache.SharedIndexInformer {
 		return cache.NewSharedIndexInformer(
-			&cache.ListWatch{
-				ListFunc: func(options k8smetav1.ListOptions) (runtime.Object, error) {
-					return f.client.ListHorizontalPodAutoscaler(context.TODO(), f.namespace, metav1.ListOptionsFromUpstream(options))
+			typedclient.NewListWatch(f.client.backend, f.options.namespaces(), f.options.tweakListOptions,
+				func(ctx context.Context, namespace string, options k8smetav1.ListOptions) (runtime.Object, error) {
+					return f.client.ListHorizontalPodAutoscaler(ctx, namespace, metav1.ListOptionsFromUpstream(options))
 				},
-				WatchFunc: func(options k8smetav1.ListOptions) (watch.Interface, error) {
-					return f.client.WatchHorizontalPodAutoscaler(context.TODO(), f.namespace, metav1.ListOptionsFromUpstream(options))
+				func(ctx context.Context, namespace string, options k8smetav1.ListOptions) (watch.Interface, error) {
+					return f.client.WatchHorizontalPodAutoscaler(ctx, namespace, metav1.ListOptionsFromUpstream(options))
 				},
-			},
+			),
 			&autoscalingv2.HorizontalPodAutoscaler{},
 			f.resyncPeriod,
 			f.indexers,
@@ -5015,35 +5184,61 @@ func (f *AutoscalingV2Informer) HorizontalPodAutoscalerLister() *AutoscalingV2Ho
 type BatchV1Informer struct {
 	cache        *InformerCache
 	client       *BatchV1
-	namespace    string
+	options      InformerOptions
 	resyncPeriod time.Duration
 	indexers     cache.Indexers
 }
 
 func NewBatchV1Informer(c *InformerCache, client *BatchV1, namespace string, resyncPeriod time.Duration) *BatchV1Informer {
+	return NewBatchV1InformerWithOptions(c, client, resyncPeriod, InformerOptions{Namespaces: []string{namespace}})
+}
+
+func NewBatchV1InformerWithOptions(c *InformerCache, client *BatchV1, resyncPeriod time.Duration, opts InformerOptions) *BatchV1Informer {
 	return &BatchV1Informer{
 		cache:        c,
 		client:       client,
-		namespace:    namespace,
+		options:      opts,
 		resyncPeriod: resyncPeriod,
 		indexers:     cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc},
 	}
 }
 
+const (
+	// BatchV1CronJobIndexSpecJobTemplateSpecTemplateSpecNodeName is the name of the index by spec.jobTemplate.spec.template.spec.nodeName of CronJob.
+	BatchV1CronJobIndexSpecJobTemplateSpecTemplateSpecNodeName = "spec.jobTemplate.spec.template.spec.nodeName"
+)
+
+// BatchV1CronJobIndexers returns the indexers of the fields of CronJob which are marked as indexed.
+// The indexers are added to the informer of CronJob.
+func BatchV1CronJobIndexers() cache.Indexers {
+	return cache.Indexers{
+		BatchV1CronJobIndexSpecJobTemplateSpecTemplateSpecNodeName: func(obj any) ([]string, error) {
+			v, ok := obj.(*batchv1.CronJob)
+			if !ok {
+				return nil, nil
+			}
+			if v.Spec != nil && v.Spec.JobTemplate.Spec != nil && v.Spec.JobTemplate.Spec.Template.Spec != nil {
+				return []string{v.Spec.JobTemplate.Spec.Template.Spec.NodeName}, nil
+			}
+			return nil, nil
+		},
+	}
+}
+
 func (f *BatchV1Informer) CronJobInformer() cache.SharedIndexInformer {
-	return f.cache.Write(&batchv1.CronJob{}, func() cache.SharedIndexInformer {
+	return f.cache.WriteWithFilter(&batchv1.CronJob{}, f.options.key(true), func() cache.SharedIndexInformer {
 		return cache.NewSharedIndexInformer(
-			&cache.ListWatch{
-				ListFunc: func(options k8smetav1.ListOptions) (runtime.Object, error) {
-					return f.client.ListCronJob(context.TODO(), f.namespace, metav1.ListOptionsFromUpstream(options))
+			typedclient.NewListWatch(f.client.backend, f.options.namespaces(), f.options.tweakListOptions,
+				func(ctx context.Context, namespace string, options k8smetav1.ListOptions) (runtime.Object, error) {
+					return f.client.ListCronJob(ctx, namespace, metav1.ListOptionsFromUpstream(options))
 				},
-				WatchFunc: func(options k8smetav1.ListOptions) (watch.Interface, error) {
-					return f.client.WatchCronJob(context.TODO(), f.namespace, metav1.ListOptionsFromUpstream(options))
+				func(ctx context.Context, namespace string, options k8smetav1.ListOptions) (watch.Interface, error) {
+					return f.client.WatchCronJob(ctx, namespace, metav1.ListOptionsFromUpstream(options))
 				},
-			},
+			),
 			&batchv1.CronJob{},
 			f.resyncPeriod,
-			f.indexers,
+			typedclient.MergeIndexers(f.indexers, BatchV1CronJobIndexers()),
 		)
 	})
 }
@@ -5052,20 +5247,42 @@ func (f *BatchV1Informer) CronJobLister() *BatchV1CronJobLister {
 	return NewBatchV1CronJobLister(f.CronJobInformer().GetIndexer())
 }
 
+const (
+	// BatchV1JobIndexSpecTemplateSpecNodeName is the name of the index by spec.template.spec.nodeName of Job.
+	BatchV1JobIndexSpecTemplateSpecNodeName = "spec.template.spec.nodeName"
+)
+
+// BatchV1JobIndexers returns the indexers of the fields of Job which are marked as indexed.
+// The indexers are added to the informer of Job.
+func BatchV1JobIndexers() cache.Indexers {
+	return cache.Indexers{
+		BatchV1JobIndexSpecTemplateSpecNodeName: func(obj any) ([]string, error) {
+			v, ok := obj.(*batchv1.Job)
+			if !ok {
+				return nil, nil
+			}
+			if v.Spec != nil && v.Spec.Template.Spec != nil {
+				return []string{v.Spec.Template.Spec.NodeName}, nil
+			}
+			return nil, nil
+		},
+	}
+}
+
 func (f *BatchV1Informer) JobInformer() cache.SharedIndexInformer {
-	return f.cache.Write(&batchv1.Job{}, func() cache.SharedIndexInformer {
+	return f.cache.WriteWithFilter(&batchv1.Job{}, f.options.key(true), func() cache.SharedIndexInformer {
 		return cache.NewSharedIndexInformer(
-			&cache.ListWatch{
-				ListFunc: func(options k8smetav1.ListOptions) (runtime.Object, error) {
-					return f.client.ListJob(context.TODO(), f.namespace, metav1.ListOptionsFromUpstream(options))
+			typedclient.NewListWatch(f.client.backend, f.options.namespaces(), f.options.tweakListOptions,
+				func(ctx context.Context, namespace string, options k8smetav1.ListOptions) (runtime.Object, error) {
+					return f.client.ListJob(ctx, namespace, metav1.ListOptionsFromUpstream(options))
 				},
-				WatchFunc: func(options k8smetav1.ListOptions) (watch.Interface, error) {
-					return f.client.WatchJob(context.TODO(), f.namespace, metav1.ListOptionsFromUpstream(options))
+				func(ctx context.Context, namespace string, options k8smetav1.ListOptions) (watch.Interface, error) {
+					return f.client.WatchJob(ctx, namespace, metav1.ListOptionsFromUpstream(options))
 				},
-			},
+			),
 			&batchv1.Job{},
 			f.resyncPeriod,
-			f.indexers,
+			typedclient.MergeIndexers(f.indexers, BatchV1JobIndexers()),
 		)
 	})
 }
@@ -5077,32 +5294,36 @@ func (f *BatchV1Informer) JobLister() *BatchV1JobLister {
 type CertificatesK8sIoV1Informer struct {
 	cache        *InformerCache
 	client       *CertificatesK8sIoV1
-	namespace    string
+	options      InformerOptions
 	resyncPeriod time.Duration
 	indexers     cache.Indexers
 }
 
 func NewCertificatesK8sIoV1Informer(c *InformerCache, client *CertificatesK8sIoV1, namespace string, resyncPeriod time.Duration) *CertificatesK8sIoV1Informer {
+	return NewCertificatesK8sIoV1InformerWithOptions(c, client, resyncPeriod, InformerOptions{Namespaces: []string{namespace}})
+}
+
+func NewCertificatesK8sIoV1InformerWithOptions(c *InformerCache, client *CertificatesK8sIoV1, resyncPeriod time.Duration, opts InformerOptions) *CertificatesK8sIoV1Informer {
 	return &CertificatesK8sIoV1Informer{
 		cache:        c,
 		client:       client,
-		namespace:    namespace,
+		options:      opts,
 		resyncPeriod: resyncPeriod,
 		indexers:     cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc},
 	}
 }
 
 func (f *CertificatesK8sIoV1Informer) CertificateSigningRequestInformer() cache.SharedIndexInformer {
-	return f.cache.Write(&certificatesv1.CertificateSigningRequest{}, func() cache.SharedIndexInformer {
+	return f.cache.WriteWithFilter(&certificatesv1.CertificateSigningRequest{}, f.options.key(false), func() cache.SharedIndexInformer {
 		return cache.NewSharedIndexInformer(
-			&cache.ListWatch{
-				ListFunc: func(options k8smetav1.ListOptions) (runtime.Object, error) {
-					return f.client.ListCertificateSigningRequest(context.TODO(), metav1.ListOptionsFromUpstream(options))
+			typedclient.NewListWatch(f.client.backend, nil, f.options.tweakListOptions,
+				func(ctx context.Context, _ string, options k8smetav1.ListOptions) (runtime.Object, error) {
+					return f.client.ListCertificateSigningRequest(ctx, metav1.ListOptionsFromUpstream(options))
 				},
-				WatchFunc: func(options k8smetav1.ListOptions) (watch.Interface, error) {
-					return f.client.WatchCertificateSigningRequest(context.TODO(), metav1.ListOptionsFromUpstream(options))
+				func(ctx context.Context, _ string, options k8smetav1.ListOptions) (watch.Interface, error) {
+					return f.client.WatchCertificateSigningRequest(ctx, metav1.ListOptionsFromUpstream(options))
 				},
-			},
+			),
 			&certificatesv1.CertificateSigningRequest{},
 			f.resyncPeriod,
 			f.indexers,
@@ -5117,32 +5338,36 @@ func (f *CertificatesK8sIoV1Informer) CertificateSigningRequestLister() *Certifi
 type CoordinationK8sIoV1Informer struct {
 	cache        *InformerCache
 	client       *CoordinationK8sIoV1
-	namespace    string
+	options      InformerOptions
 	resyncPeriod time.Duration
 	indexers     cache.Indexers
 }
 
 func NewCoordinationK8sIoV1Informer(c *InformerCache, client *CoordinationK8sIoV1, namespace string, resyncPeriod time.Duration) *CoordinationK8sIoV1Informer {
+	return NewCoordinationK8sIoV1InformerWithOptions(c, client, resyncPeriod, InformerOptions{Namespaces: []string{namespace}})
+}
+
+func NewCoordinationK8sIoV1InformerWithOptions(c *InformerCache, client *CoordinationK8sIoV1, resyncPeriod time.Duration, opts InformerOptions) *CoordinationK8sIoV1Informer {
 	return &CoordinationK8sIoV1Informer{
 		cache:        c,
 		client:       client,
-		namespace:    namespace,
+		options:      opts,
 		resyncPeriod: resyncPeriod,
 		indexers:     cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc},
 	}
 }
 
 func (f *CoordinationK8sIoV1Informer) LeaseInformer() cache.SharedIndexInformer {
-	return f.cache.Write(&coordinationv1.Lease{}, func() cache.SharedIndexInformer {
+	return f.cache.WriteWithFilter(&coordinationv1.Lease{}, f.options.key(true), func() cache.SharedIndexInformer {
 		return cache.NewSharedIndexInformer(
-			&cache.ListWatch{
-				ListFunc: func(options k8smetav1.ListOptions) (runtime.Object, error) {
-					return f.client.ListLease(context.TODO(), f.namespace, metav1.ListOptionsFromUpstream(options))
+			typedclient.NewListWatch(f.client.backend, f.options.namespaces(), f.options.tweakListOptions,
+				func(ctx context.Context, namespace string, options k8smetav1.ListOptions) (runtime.Object, error) {
+					return f.client.ListLease(ctx, namespace, metav1.ListOptionsFromUpstream(options))
 				},
-				WatchFunc: func(options k8smetav1.ListOptions) (watch.Interface, error) {
-					return f.client.WatchLease(context.TODO(), f.namespace, metav1.ListOptionsFromUpstream(options))
+				func(ctx context.Context, namespace string, options k8smetav1.ListOptions) (watch.Interface, error) {
+					return f.client.WatchLease(ctx, namespace, metav1.ListOptionsFromUpstream(options))
 				},
-			},
+			),
 			&coordinationv1.Lease{},
 			f.resyncPeriod,
 			f.indexers,
@@ -5157,32 +5382,36 @@ func (f *CoordinationK8sIoV1Informer) LeaseLister() *CoordinationK8sIoV1LeaseLis
 type DiscoveryK8sIoV1Informer struct {
 	cache        *InformerCache
 	client       *DiscoveryK8sIoV1
-	namespace    string
+	options      InformerOptions
 	resyncPeriod time.Duration
 	indexers     cache.Indexers
 }
 
 func NewDiscoveryK8sIoV1Informer(c *InformerCache, client *DiscoveryK8sIoV1, namespace string, resyncPeriod time.Duration) *DiscoveryK8sIoV1Informer {
+	return NewDiscoveryK8sIoV1InformerWithOptions(c, client, resyncPeriod, InformerOptions{Namespaces: []string{namespace}})
+}
+
+func NewDiscoveryK8sIoV1InformerWithOptions(c *InformerCache, client *DiscoveryK8sIoV1, resyncPeriod time.Duration, opts InformerOptions) *DiscoveryK8sIoV1Informer {
 	return &DiscoveryK8sIoV1Informer{
 		cache:        c,
 		client:       client,
-		namespace:    namespace,
+		options:      opts,
 		resyncPeriod: resyncPeriod,
 		indexers:     cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc},
 	}
 }
 
 func (f *DiscoveryK8sIoV1Informer) EndpointSliceInformer() cache.SharedIndexInformer {
-	return f.cache.Write(&discoveryv1.EndpointSlice{}, func() cache.SharedIndexInformer {
+	return f.cache.WriteWithFilter(&discoveryv1.EndpointSlice{}, f.options.key(true), func() cache.SharedIndexInformer {
 		return cache.NewSharedIndexInformer(
-			&cache.ListWatch{
-				ListFunc: func(options k8smetav1.ListOptions) (runtime.Object, error) {
-					return f.client.ListEndpointSlice(context.TODO(), f.namespace, metav1.ListOptionsFromUpstream(options))
+			typedclient.NewListWatch(f.client.backend, f.options.namespaces(), f.options.tweakListOptions,
+				func(ctx context.Context, namespace string, options k8smetav1.ListOptions) (runtime.Object, error) {
+					return f.client.ListEndpointSlice(ctx, namespace, metav1.ListOptionsFromUpstream(options))
 				},
-				WatchFunc: func(options k8smetav1.ListOptions) (watch.Interface, error) {
-					return f.client.WatchEndpointSlice(context.TODO(), f.namespace, metav1.ListOptionsFromUpstream(options))
+				func(ctx context.Context, namespace string, options k8smetav1.ListOptions) (watch.Interface, error) {
+					return f.client.WatchEndpointSlice(ctx, namespace, metav1.ListOptionsFromUpstream(options))
 				},
-			},
+			),
 			&discoveryv1.EndpointSlice{},
 			f.resyncPeriod,
 			f.indexers,
@@ -5197,32 +5426,36 @@ func (f *DiscoveryK8sIoV1Informer) EndpointSliceLister() *DiscoveryK8sIoV1Endpoi
 type EventsK8sIoV1Informer struct {
 	cache        *InformerCache
 	client       *EventsK8sIoV1
-	namespace    string
+	options      InformerOptions
 	resyncPeriod time.Duration
 	indexers     cache.Indexers
 }
 
 func NewEventsK8sIoV1Informer(c *InformerCache, client *EventsK8sIoV1, namespace string, resyncPeriod time.Duration) *EventsK8sIoV1Informer {
+	return NewEventsK8sIoV1InformerWithOptions(c, client, resyncPeriod, InformerOptions{Namespaces: []string{namespace}})
+}
+
+func NewEventsK8sIoV1InformerWithOptions(c *InformerCache, client *EventsK8sIoV1, resyncPeriod time.Duration, opts InformerOptions) *EventsK8sIoV1Informer {
 	return &EventsK8sIoV1Informer{
 		cache:        c,
 		client:       client,
-		namespace:    namespace,
+		options:      opts,
 		resyncPeriod: resyncPeriod,
 		indexers:     cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc},
 	}
 }
 
 func (f *EventsK8sIoV1Informer) EventInformer() cache.SharedIndexInformer {
-	return f.cache.Write(&eventsv1.Event{}, func() cache.SharedIndexInformer {
+	return f.cache.WriteWithFilter(&eventsv1.Event{}, f.options.key(true), func() cache.SharedIndexInformer {
 		return cache.NewSharedIndexInformer(
-			&cache.ListWatch{
-				ListFunc: func(options k8smetav1.ListOptions) (runtime.Object, error) {
-					return f.client.ListEvent(context.TODO(), f.namespace, metav1.ListOptionsFromUpstream(options))
+			typedclient.NewListWatch(f.client.backend, f.options.namespaces(), f.options.tweakListOptions,
+				func(ctx context.Context, namespace string, options k8smetav1.ListOptions) (runtime.Object, error) {
+					return f.client.ListEvent(ctx, namespace, metav1.ListOptionsFromUpstream(options))
 				},
-				WatchFunc: func(options k8smetav1.ListOptions) (watch.Interface, error) {
-					return f.client.WatchEvent(context.TODO(), f.namespace, metav1.ListOptionsFromUpstream(options))
+				func(ctx context.Context, namespace string, options k8smetav1.ListOptions) (watch.Interface, error) {
+					return f.client.WatchEvent(ctx, namespace, metav1.ListOptionsFromUpstream(options))
 				},
-			},
+			),
 			&eventsv1.Event{},
 			f.resyncPeriod,
 			f.indexers,
@@ -5237,32 +5470,36 @@ func (f *EventsK8sIoV1Informer) EventLister() *EventsK8sIoV1EventLister {
 type NetworkingK8sIoV1Informer struct {
 	cache        *InformerCache
 	client       *NetworkingK8sIoV1
-	namespace    string
+	options      InformerOptions
 	resyncPeriod time.Duration
 	indexers     cache.Indexers
 }
 
 func NewNetworkingK8sIoV1Informer(c *InformerCache, client *NetworkingK8sIoV1, namespace string, resyncPeriod time.Duration) *NetworkingK8sIoV1Informer {
+	return NewNetworkingK8sIoV1InformerWithOptions(c, client, resyncPeriod, InformerOptions{Namespaces: []string{namespace}})
+}
+
+func NewNetworkingK8sIoV1InformerWithOptions(c *InformerCache, client *NetworkingK8sIoV1, resyncPeriod time.Duration, opts InformerOptions) *NetworkingK8sIoV1Informer {
 	return &NetworkingK8sIoV1Informer{
 		cache:        c,
 		client:       client,
-		namespace:    namespace,
+		options:      opts,
 		resyncPeriod: resyncPeriod,
 		indexers:     cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc},
 	}
 }
 
 func (f *NetworkingK8sIoV1Informer) IPAddressInformer() cache.SharedIndexInformer {
-	return f.cache.Write(&networkingv1.IPAddress{}, func() cache.SharedIndexInformer {
+	return f.cache.WriteWithFilter(&networkingv1.IPAddress{}, f.options.key(false), func() cache.SharedIndexInformer {
 		return cache.NewSharedIndexInformer(
-			&cache.ListWatch{
-				ListFunc: func(options k8smetav1.ListOptions) (runtime.Object, error) {
-					return f.client.ListIPAddress(context.TODO(), metav1.ListOptionsFromUpstream(options))
+			typedclient.NewListWatch(f.client.backend, nil, f.options.tweakListOptions,
+				func(ctx context.Context, _ string, options k8smetav1.ListOptions) (runtime.Object, error) {
+					return f.client.ListIPAddress(ctx, metav1.ListOptionsFromUpstream(options))
 				},
-				WatchFunc: func(options k8smetav1.ListOptions) (watch.Interface, error) {
-					return f.client.WatchIPAddress(context.TODO(), metav1.ListOptionsFromUpstream(options))
+				func(ctx context.Context, _ string, options k8smetav1.ListOptions) (watch.Interface, error) {
+					return f.client.WatchIPAddress(ctx, metav1.ListOptionsFromUpstream(options))
 				},
-			},
+			),
 			&networkingv1.IPAddress{},
 			f.resyncPeriod,
 			f.indexers,
@@ -5275,16 +5512,16 @@ func (f *NetworkingK8sIoV1Informer) IPAddressLister() *NetworkingK8sIoV1IPAddres
 }
 
 func (f *NetworkingK8sIoV1Informer) IngressInformer() cache.SharedIndexInformer {
-	return f.cache.Write(&networkingv1.Ingress{}, func() cache.SharedIndexInformer {
+	return f.cache.WriteWithFilter(&networkingv1.Ingress{}, f.options.key(true), func() cache.SharedIndexInformer {
 		return cache.NewSharedIndexInformer(
-			&cache.ListWatch{
-				ListFunc: func(options k8smetav1.ListOptions) (runtime.Object, error) {
-					return f.client.ListIngress(context.TODO(), f.namespace, metav1.ListOptionsFromUpstream(options))
+			typedclient.NewListWatch(f.client.backend, f.options.namespaces(), f.options.tweakListOptions,
+				func(ctx context.Context, namespace string, options k8smetav1.ListOptions) (runtime.Object, error) {
+					return f.client.ListIngress(ctx, namespace, metav1.ListOptionsFromUpstream(options))
 				},
-				WatchFunc: func(options k8smetav1.ListOptions) (watch.Interface, error) {
-					return f.client.WatchIngress(context.TODO(), f.namespace, metav1.ListOptionsFromUpstream(options))
+				func(ctx context.Context, namespace string, options k8smetav1.ListOptions) (watch.Interface, error) {
+					return f.client.WatchIngress(ctx, namespace, metav1.ListOptionsFromUpstream(options))
 				},
-			},
+			),
 			&networkingv1.Ingress{},
 			f.resyncPeriod,
 			f.indexers,
@@ -5297,16 +5534,16 @@ func (f *NetworkingK8sIoV1Informer) IngressLister() *NetworkingK8sIoV1IngressLis
 }
 
 func (f *NetworkingK8sIoV1Informer) IngressClassInformer() cache.SharedIndexInformer {
-	return f.cache.Write(&networkingv1.IngressClass{}, func() cache.SharedIndexInformer {
+	return f.cache.WriteWithFilter(&networkingv1.IngressClass{}, f.options.key(false), func() cache.SharedIndexInformer {
 		return cache.NewSharedIndexInformer(
-			&cache.ListWatch{
-				ListFunc: func(options k8smetav1.ListOptions) (runtime.Object, error) {
-					return f.client.ListIngressClass(context.TODO(), metav1.ListOptionsFromUpstream(options))
+			typedclient.NewListWatch(f.client.backend, nil, f.options.tweakListOptions,
+				func(ctx context.Context, _ string, options k8smetav1.ListOptions) (runtime.Object, error) {
+					return f.client.ListIngressClass(ctx, metav1.ListOptionsFromUpstream(options))
 				},
-				WatchFunc: func(options k8smetav1.ListOptions) (watch.Interface, error) {
-					return f.client.WatchIngressClass(context.TODO(), metav1.ListOptionsFromUpstream(options))
+				func(ctx context.Context, _ string, options k8smetav1.ListOptions) (watch.Interface, error) {
+					return f.client.WatchIngressClass(ctx, metav1.ListOptionsFromUpstream(options))
 				},
-			},
+			),
 			&networkingv1.IngressClass{},
 			f.resyncPeriod,
 			f.indexers,
@@ -5319,16 +5556,16 @@ func (f *NetworkingK8sIoV1Informer) IngressClassLister() *NetworkingK8sIoV1Ingre
 }
 
 func (f *NetworkingK8sIoV1Informer) NetworkPolicyInformer() cache.SharedIndexInformer {
-	return f.cache.Write(&networkingv1.NetworkPolicy{}, func() cache.SharedIndexInformer {
+	return f.cache.WriteWithFilter(&networkingv1.NetworkPolicy{}, f.options.key(true), func() cache.SharedIndexInformer {
 		return cache.NewSharedIndexInformer(
-			&cache.ListWatch{
-				ListFunc: func(options k8smetav1.ListOptions) (runtime.Object, error) {
-					return f.client.ListNetworkPolicy(context.TODO(), f.namespace, metav1.ListOptionsFromUpstream(options))
+			typedclient.NewListWatch(f.client.backend, f.options.namespaces(), f.options.tweakListOptions,
+				func(ctx context.Context, namespace string, options k8smetav1.ListOptions) (runtime.Object, error) {
+					return f.client.ListNetworkPolicy(ctx, namespace, metav1.ListOptionsFromUpstream(options))
 				},
-				WatchFunc: func(options k8smetav1.ListOptions) (watch.Interface, error) {
-					return f.client.WatchNetworkPolicy(context.TODO(), f.namespace, metav1.ListOptionsFromUpstream(options))
+				func(ctx context.Context, namespace string, options k8smetav1.ListOptions) (watch.Interface, error) {
+					return f.client.WatchNetworkPolicy(ctx, namespace, metav1.ListOptionsFromUpstream(options))
 				},
-			},
+			),
 			&networkingv1.NetworkPolicy{},
 			f.resyncPeriod,
 			f.indexers,
@@ -5341,16 +5578,16 @@ func (f *NetworkingK8sIoV1Informer) NetworkPolicyLister() *NetworkingK8sIoV1Netw
 }
 
 func (f *NetworkingK8sIoV1Informer) ServiceCIDRInformer() cache.SharedIndexInformer {
-	return f.cache.Write(&networkingv1.ServiceCIDR{}, func() cache.SharedIndexInformer {
+	return f.cache.WriteWithFilter(&networkingv1.ServiceCIDR{}, f.options.key(false), func() cache.SharedIndexInformer {
 		return cache.NewSharedIndexInformer(
-			&cache.ListWatch{
-				ListFunc: func(options k8smetav1.ListOptions) (runtime.Object, error) {
-					return f.client.ListServiceCIDR(context.TODO(), metav1.ListOptionsFromUpstream(options))
+			typedclient.NewListWatch(f.client.backend, nil, f.options.tweakListOptions,
+				func(ctx context.Context, _ string, options k8smetav1.ListOptions) (runtime.Object, error) {
+					return f.client.ListServiceCIDR(ctx, metav1.ListOptionsFromUpstream(options))
 				},
-				WatchFunc: func(options k8smetav1.ListOptions) (watch.Interface, error) {
-					return f.client.WatchServiceCIDR(context.TODO(), metav1.ListOptionsFromUpstream(options))
+				func(ctx context.Context, _ string, options k8smetav1.ListOptions) (watch.Interface, error) {
+					return f.client.WatchServiceCIDR(ctx, metav1.ListOptionsFromUpstream(options))
 				},
-			},
+			),
 			&networkingv1.ServiceCIDR{},
 			f.resyncPeriod,
 			f.indexers,
@@ -5365,32 +5602,36 @@ func (f *NetworkingK8sIoV1Informer) ServiceCIDRLister() *NetworkingK8sIoV1Servic
 type PolicyV1Informer struct {
 	cache        *InformerCache
 	client       *PolicyV1
-	namespace    string
+	options      InformerOptions
 	resyncPeriod time.Duration
 	indexers     cache.Indexers
 }
 
 func NewPolicyV1Informer(c *InformerCache, client *PolicyV1, namespace string, resyncPeriod time.Duration) *PolicyV1Informer {
+	return NewPolicyV1InformerWithOptions(c, client, resyncPeriod, InformerOptions{Namespaces: []string{namespace}})
+}
+
+func NewPolicyV1InformerWithOptions(c *InformerCache, client *PolicyV1, resyncPeriod time.Duration, opts InformerOptions) *PolicyV1Informer {
 	return &PolicyV1Informer{
 		cache:        c,
 		client:       client,
-		namespace:    namespace,
+		options:      opts,
 		resyncPeriod: resyncPeriod,
 		indexers:     cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc},
 	}
 }
 
 func (f *PolicyV1Informer) EvictionInformer() cache.SharedIndexInformer {
-	return f.cache.Write(&policyv1.Eviction{}, func() cache.SharedIndexInformer {
+	return f.cache.WriteWithFilter(&policyv1.Eviction{}, f.options.key(true), func() cache.SharedIndexInformer {
 		return cache.NewSharedIndexInformer(
-			&cache.ListWatch{
-				ListFunc: func(options k8smetav1.ListOptions) (runtime.Object, error) {
-					return f.client.ListEviction(context.TODO(), f.namespace, metav1.ListOptionsFromUpstream(options))
+			typedclient.NewListWatch(f.client.backend, f.options.namespaces(), f.options.tweakListOptions,
+				func(ctx context.Context, namespace string, options k8smetav1.ListOptions) (runtime.Object, error) {
+					return f.client.ListEviction(ctx, namespace, metav1.ListOptionsFromUpstream(options))
 				},
-				WatchFunc: func(options k8smetav1.ListOptions) (watch.Interface, error) {
-					return f.client.WatchEviction(context.TODO(), f.namespace, metav1.ListOptionsFromUpstream(options))
+				func(ctx context.Context, namespace string, options k8smetav1.ListOptions) (watch.Interface, error) {
+					return f.client.WatchEviction(ctx, namespace, metav1.ListOptionsFromUpstream(options))
 				},
-			},
+			),
 			&policyv1.Eviction{},
 			f.resyncPeriod,
 			f.indexers,
@@ -5403,16 +5644,16 @@ func (f *PolicyV1Informer) EvictionLister() *PolicyV1EvictionLister {
 }
 
 func (f *PolicyV1Informer) PodDisruptionBudgetInformer() cache.SharedIndexInformer {
-	return f.cache.Write(&policyv1.PodDisruptionBudget{}, func() cache.SharedIndexInformer {
+	return f.cache.WriteWithFilter(&policyv1.PodDisruptionBudget{}, f.options.key(true), func() cache.SharedIndexInformer {
 		return cache.NewSharedIndexInformer(
-			&cache.ListWatch{
-				ListFunc: func(options k8smetav1.ListOptions) (runtime.Object, error) {
-					return f.client.ListPodDisruptionBudget(context.TODO(), f.namespace, metav1.ListOptionsFromUpstream(options))
+			typedclient.NewListWatch(f.client.backend, f.options.namespaces(), f.options.tweakListOptions,
+				func(ctx context.Context, namespace string, options k8smetav1.ListOptions) (runtime.Object, error) {
+					return f.client.ListPodDisruptionBudget(ctx, namespace, metav1.ListOptionsFromUpstream(options))
 				},
-				WatchFunc: func(options k8smetav1.ListOptions) (watch.Interface, error) {
-					return f.client.WatchPodDisruptionBudget(context.TODO(), f.namespace, metav1.ListOptionsFromUpstream(options))
+				func(ctx context.Context, namespace string, options k8smetav1.ListOptions) (watch.Interface, error) {
+					return f.client.WatchPodDisruptionBudget(ctx, namespace, metav1.ListOptionsFromUpstream(options))
 				},
-			},
+			),
 			&policyv1.PodDisruptionBudget{},
 			f.resyncPeriod,
 			f.indexers,
@@ -5427,32 +5668,36 @@ func (f *PolicyV1Informer) PodDisruptionBudgetLister() *PolicyV1PodDisruptionBud
 type RbacAuthorizationK8sIoV1Informer struct {
 	cache        *InformerCache
 	client       *RbacAuthorizationK8sIoV1
-	namespace    string
+	options      InformerOptions
 	resyncPeriod time.Duration
 	indexers     cache.Indexers
 }
 
 func NewRbacAuthorizationK8sIoV1Informer(c *InformerCache, client *RbacAuthorizationK8sIoV1, namespace string, resyncPeriod time.Duration) *RbacAuthorizationK8sIoV1Informer {
+	return NewRbacAuthorizationK8sIoV1InformerWithOptions(c, client, resyncPeriod, InformerOptions{Namespaces: []string{namespace}})
+}
+
+func NewRbacAuthorizationK8sIoV1InformerWithOptions(c *InformerCache, client *RbacAuthorizationK8sIoV1, resyncPeriod time.Duration, opts InformerOptions) *RbacAuthorizationK8sIoV1Informer {
 	return &RbacAuthorizationK8sIoV1Informer{
 		cache:        c,
 		client:       client,
-		namespace:    namespace,
+		options:      opts,
 		resyncPeriod: resyncPeriod,
 		indexers:     cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc},
 	}
 }
 
 func (f *RbacAuthorizationK8sIoV1Informer) ClusterRoleInformer() cache.SharedIndexInformer {
-	return f.cache.Write(&rbacv1.ClusterRole{}, func() cache.SharedIndexInformer {
+	return f.cache.WriteWithFilter(&rbacv1.ClusterRole{}, f.options.key(false), func() cache.SharedIndexInformer {
 		return cache.NewSharedIndexInformer(
-			&cache.ListWatch{
-				ListFunc: func(options k8smetav1.ListOptions) (runtime.Object, error) {
-					return f.client.ListClusterRole(context.TODO(), metav1.ListOptionsFromUpstream(options))
+			typedclient.NewListWatch(f.client.backend, nil, f.options.tweakListOptions,
+				func(ctx context.Context, _ string, options k8smetav1.ListOptions) (runtime.Object, error) {
+					return f.client.ListClusterRole(ctx, metav1.ListOptionsFromUpstream(options))
 				},
-				WatchFunc: func(options k8smetav1.ListOptions) (watch.Interface, error) {
-					return f.client.WatchClusterRole(context.TODO(), metav1.ListOptionsFromUpstream(options))
+				func(ctx context.Context, _ string, options k8smetav1.ListOptions) (watch.Interface, error) {
+					return f.client.WatchClusterRole(ctx, metav1.ListOptionsFromUpstream(options))
 				},
-			},
+			),
 			&rbacv1.ClusterRole{},
 			f.resyncPeriod,
 			f.indexers,
@@ -5465,16 +5710,16 @@ func (f *RbacAuthorizationK8sIoV1Informer) ClusterRoleLister() *RbacAuthorizatio
 }
 
 func (f *RbacAuthorizationK8sIoV1Informer) ClusterRoleBindingInformer() cache.SharedIndexInformer {
-	return f.cache.Write(&rbacv1.ClusterRoleBinding{}, func() cache.SharedIndexInformer {
+	return f.cache.WriteWithFilter(&rbacv1.ClusterRoleBinding{}, f.options.key(false), func() cache.SharedIndexInformer {
 		return cache.NewSharedIndexInformer(
-			&cache.ListWatch{
-				ListFunc: func(options k8smetav1.ListOptions) (runtime.Object, error) {
-					return f.client.ListClusterRoleBinding(context.TODO(), metav1.ListOptionsFromUpstream(options))
+			typedclient.NewListWatch(f.client.backend, nil, f.options.tweakListOptions,
+				func(ctx context.Context, _ string, options k8smetav1.ListOptions) (runtime.Object, error) {
+					return f.client.ListClusterRoleBinding(ctx, metav1.ListOptionsFromUpstream(options))
 				},
-				WatchFunc: func(options k8smetav1.ListOptions) (watch.Interface, error) {
-					return f.client.WatchClusterRoleBinding(context.TODO(), metav1.ListOptionsFromUpstream(options))
+				func(ctx context.Context, _ string, options k8smetav1.ListOptions) (watch.Interface, error) {
+					return f.client.WatchClusterRoleBinding(ctx, metav1.ListOptionsFromUpstream(options))
 				},
-			},
+			),
 			&rbacv1.ClusterRoleBinding{},
 			f.resyncPeriod,
 			f.indexers,
@@ -5487,16 +5732,16 @@ func (f *RbacAuthorizationK8sIoV1Informer) ClusterRoleBindingLister() *RbacAutho
 }
 
 func (f *RbacAuthorizationK8sIoV1Informer) RoleInformer() cache.SharedIndexInformer {
-	return f.cache.Write(&rbacv1.Role{}, func() cache.SharedIndexInformer {
+	return f.cache.WriteWithFilter(&rbacv1.Role{}, f.options.key(true), func() cache.SharedIndexInformer {
 		return cache.NewSharedIndexInformer(
-			&cache.ListWatch{
-				ListFunc: func(options k8smetav1.ListOptions) (runtime.Object, error) {
-					return f.client.ListRole(context.TODO(), f.namespace, metav1.ListOptionsFromUpstream(options))
+			typedclient.NewListWatch(f.client.backend, f.options.namespaces(), f.options.tweakListOptions,
+				func(ctx context.Context, namespace string, options k8smetav1.ListOptions) (runtime.Object, error) {
+					return f.client.ListRole(ctx, namespace, metav1.ListOptionsFromUpstream(options))
 				},
-				WatchFunc: func(options k8smetav1.ListOptions) (watch.Interface, error) {
-					return f.client.WatchRole(context.TODO(), f.namespace, metav1.ListOptionsFromUpstream(options))
+				func(ctx context.Context, namespace string, options k8smetav1.ListOptions) (watch.Interface, error) {
+					return f.client.WatchRole(ctx, namespace, metav1.ListOptionsFromUpstream(options))
 				},
-			},
+			),
 			&rbacv1.Role{},
 			f.resyncPeriod,
 			f.indexers,
@@ -5509,16 +5754,16 @@ func (f *RbacAuthorizationK8sIoV1Informer) RoleLister() *RbacAuthorizationK8sIoV
 }
 
 func (f *RbacAuthorizationK8sIoV1Informer) RoleBindingInformer() cache.SharedIndexInformer {
-	return f.cache.Write(&rbacv1.RoleBinding{}, func() cache.SharedIndexInformer {
+	return f.cache.WriteWithFilter(&rbacv1.RoleBinding{}, f.options.key(true), func() cache.SharedIndexInformer {
 		return cache.NewSharedIndexInformer(
-			&cache.ListWatch{
-				ListFunc: func(options k8smetav1.ListOptions) (runtime.Object, error) {
-					return f.client.ListRoleBinding(context.TODO(), f.namespace, metav1.ListOptionsFromUpstream(options))
+			typedclient.NewListWatch(f.client.backend, f.options.namespaces(), f.options.tweakListOptions,
+				func(ctx context.Context, namespace string, options k8smetav1.ListOptions) (runtime.Object, error) {
+					return f.client.ListRoleBinding(ctx, namespace, metav1.ListOptionsFromUpstream(options))
 				},
-				WatchFunc: func(options k8smetav1.ListOptions) (watch.Interface, error) {
-					return f.client.WatchRoleBinding(context.TODO(), f.namespace, metav1.ListOptionsFromUpstream(options))
+				func(ctx context.Context, namespace string, options k8smetav1.ListOptions) (watch.Interface, error) {
+					return f.client.WatchRoleBinding(ctx, namespace, metav1.ListOptionsFromUpstream(options))
 				},
-			},
+			),
 			&rbacv1.RoleBinding{},
 			f.resyncPeriod,
 			f.indexers,
@@ -5533,32 +5778,36 @@ func (f *RbacAuthorizationK8sIoV1Informer) RoleBindingLister() *RbacAuthorizatio
 type ResourceV1Informer struct {
 	cache        *InformerCache
 	client       *ResourceV1
-	namespace    string
+	options      InformerOptions
 	resyncPeriod time.Duration
 	indexers     cache.Indexers
 }
 
 func NewResourceV1Informer(c *InformerCache, client *ResourceV1, namespace string, resyncPeriod time.Duration) *ResourceV1Informer {
+	return NewResourceV1InformerWithOptions(c, client, resyncPeriod, InformerOptions{Namespaces: []string{namespace}})
+}
+
+func NewResourceV1InformerWithOptions(c *InformerCache, client *ResourceV1, resyncPeriod time.Duration, opts InformerOptions) *ResourceV1Informer {
 	return &ResourceV1Informer{
 		cache:        c,
 		client:       client,
-		namespace:    namespace,
+		options:      opts,
 		resyncPeriod: resyncPeriod,
 		indexers:     cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc},
 	}
 }
 
 func (f *ResourceV1Informer) DeviceClassInformer() cache.SharedIndexInformer {
-	return f.cache.Write(&resourcev1.DeviceClass{}, func() cache.SharedIndexInformer {
+	return f.cache.WriteWithFilter(&resourcev1.DeviceClass{}, f.options.key(false), func() cache.SharedIndexInformer {
 		return cache.NewSharedIndexInformer(
-			&cache.ListWatch{
-				ListFunc: func(options k8smetav1.ListOptions) (runtime.Object, error) {
-					return f.client.ListDeviceClass(context.TODO(), metav1.ListOptionsFromUpstream(options))
+			typedclient.NewListWatch(f.client.backend, nil, f.options.tweakListOptions,
+				func(ctx context.Context, _ string, options k8smetav1.ListOptions) (runtime.Object, error) {
+					return f.client.ListDeviceClass(ctx, metav1.ListOptionsFromUpstream(options))
 				},
-				WatchFunc: func(options k8smetav1.ListOptions) (watch.Interface, error) {
-					return f.client.WatchDeviceClass(context.TODO(), metav1.ListOptionsFromUpstream(options))
+				func(ctx context.Context, _ string, options k8smetav1.ListOptions) (watch.Interface, error) {
+					return f.client.WatchDeviceClass(ctx, metav1.ListOptionsFromUpstream(options))
 				},
-			},
+			),
 			&resourcev1.DeviceClass{},
 			f.resyncPeriod,
 			f.indexers,
@@ -5571,16 +5820,16 @@ func (f *ResourceV1Informer) DeviceClassLister() *ResourceV1DeviceClassLister {
 }
 
 func (f *ResourceV1Informer) ResourceClaimInformer() cache.SharedIndexInformer {
-	return f.cache.Write(&resourcev1.ResourceClaim{}, func() cache.SharedIndexInformer {
+	return f.cache.WriteWithFilter(&resourcev1.ResourceClaim{}, f.options.key(true), func() cache.SharedIndexInformer {
 		return cache.NewSharedIndexInformer(
-			&cache.ListWatch{
-				ListFunc: func(options k8smetav1.ListOptions) (runtime.Object, error) {
-					return f.client.ListResourceClaim(context.TODO(), f.namespace, metav1.ListOptionsFromUpstream(options))
+			typedclient.NewListWatch(f.client.backend, f.options.namespaces(), f.options.tweakListOptions,
+				func(ctx context.Context, namespace string, options k8smetav1.ListOptions) (runtime.Object, error) {
+					return f.client.ListResourceClaim(ctx, namespace, metav1.ListOptionsFromUpstream(options))
 				},
-				WatchFunc: func(options k8smetav1.ListOptions) (watch.Interface, error) {
-					return f.client.WatchResourceClaim(context.TODO(), f.namespace, metav1.ListOptionsFromUpstream(options))
+				func(ctx context.Context, namespace string, options k8smetav1.ListOptions) (watch.Interface, error) {
+					return f.client.WatchResourceClaim(ctx, namespace, metav1.ListOptionsFromUpstream(options))
 				},
-			},
+			),
 			&resourcev1.ResourceClaim{},
 			f.resyncPeriod,
 			f.indexers,
@@ -5593,16 +5842,16 @@ func (f *ResourceV1Informer) ResourceClaimLister() *ResourceV1ResourceClaimListe
 }
 
 func (f *ResourceV1Informer) ResourceClaimTemplateInformer() cache.SharedIndexInformer {
-	return f.cache.Write(&resourcev1.ResourceClaimTemplate{}, func() cache.SharedIndexInformer {
+	return f.cache.WriteWithFilter(&resourcev1.ResourceClaimTemplate{}, f.options.key(true), func() cache.SharedIndexInformer {
 		return cache.NewSharedIndexInformer(
-			&cache.ListWatch{
-				ListFunc: func(options k8smetav1.ListOptions) (runtime.Object, error) {
-					return f.client.ListResourceClaimTemplate(context.TODO(), f.namespace, metav1.ListOptionsFromUpstream(options))
+			typedclient.NewListWatch(f.client.backend, f.options.namespaces(), f.options.tweakListOptions,
+				func(ctx context.Context, namespace string, options k8smetav1.ListOptions) (runtime.Object, error) {
+					return f.client.ListResourceClaimTemplate(ctx, namespace, metav1.ListOptionsFromUpstream(options))
 				},
-				WatchFunc: func(options k8smetav1.ListOptions) (watch.Interface, error) {
-					return f.client.WatchResourceClaimTemplate(context.TODO(), f.namespace, metav1.ListOptionsFromUpstream(options))
+				func(ctx context.Context, namespace string, options k8smetav1.ListOptions) (watch.Interface, error) {
+					return f.client.WatchResourceClaimTemplate(ctx, namespace, metav1.ListOptionsFromUpstream(options))
 				},
-			},
+			),
 			&resourcev1.ResourceClaimTemplate{},
 			f.resyncPeriod,
 			f.indexers,
@@ -5615,16 +5864,16 @@ func (f *ResourceV1Informer) ResourceClaimTemplateLister() *ResourceV1ResourceCl
 }
 
 func (f *ResourceV1Informer) ResourceSliceInformer() cache.SharedIndexInformer {
-	return f.cache.Write(&resourcev1.ResourceSlice{}, func() cache.SharedIndexInformer {
+	return f.cache.WriteWithFilter(&resourcev1.ResourceSlice{}, f.options.key(false), func() cache.SharedIndexInformer {
 		return cache.NewSharedIndexInformer(
-			&cache.ListWatch{
-				ListFunc: func(options k8smetav1.ListOptions) (runtime.Object, error) {
-					return f.client.ListResourceSlice(context.TODO(), metav1.ListOptionsFromUpstream(options))
+			typedclient.NewListWatch(f.client.backend, nil, f.options.tweakListOptions,
+				func(ctx context.Context, _ string, options k8smetav1.ListOptions) (runtime.Object, error) {
+					return f.client.ListResourceSlice(ctx, metav1.ListOptionsFromUpstream(options))
 				},
-				WatchFunc: func(options k8smetav1.ListOptions) (watch.Interface, error) {
-					return f.client.WatchResourceSlice(context.TODO(), metav1.ListOptionsFromUpstream(options))
+				func(ctx context.Context, _ string, options k8smetav1.ListOptions) (watch.Interface, error) {
+					return f.client.WatchResourceSlice(ctx, metav1.ListOptionsFromUpstream(options))
 				},
-			},
+			),
 			&resourcev1.ResourceSlice{},
 			f.resyncPeriod,
 			f.indexers,
@@ -5639,32 +5888,36 @@ func (f *ResourceV1Informer) ResourceSliceLister() *ResourceV1ResourceSliceListe
 type SchedulingK8sIoV1Informer struct {
 	cache        *InformerCache
 	client       *SchedulingK8sIoV1
-	namespace    string
+	options      InformerOptions
 	resyncPeriod time.Duration
 	indexers     cache.Indexers
 }
 
 func NewSchedulingK8sIoV1Informer(c *InformerCache, client *SchedulingK8sIoV1, namespace string, resyncPeriod time.Duration) *SchedulingK8sIoV1Informer {
+	return NewSchedulingK8sIoV1InformerWithOptions(c, client, resyncPeriod, InformerOptions{Namespaces: []string{namespace}})
+}
+
+func NewSchedulingK8sIoV1InformerWithOptions(c *InformerCache, client *SchedulingK8sIoV1, resyncPeriod time.Duration, opts InformerOptions) *SchedulingK8sIoV1Informer {
 	return &SchedulingK8sIoV1Informer{
 		cache:        c,
 		client:       client,
-		namespace:    namespace,
+		options:      opts,
 		resyncPeriod: resyncPeriod,
 		indexers:     cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc},
 	}
 }
 
 func (f *SchedulingK8sIoV1Informer) PriorityClassInformer() cache.SharedIndexInformer {
-	return f.cache.Write(&schedulingv1.PriorityClass{}, func() cache.SharedIndexInformer {
+	return f.cache.WriteWithFilter(&schedulingv1.PriorityClass{}, f.options.key(false), func() cache.SharedIndexInformer {
 		return cache.NewSharedIndexInformer(
-			&cache.ListWatch{
-				ListFunc: func(options k8smetav1.ListOptions) (runtime.Object, error) {
-					return f.client.ListPriorityClass(context.TODO(), metav1.ListOptionsFromUpstream(options))
+			typedclient.NewListWatch(f.client.backend, nil, f.options.tweakListOptions,
+				func(ctx context.Context, _ string, options k8smetav1.ListOptions) (runtime.Object, error) {
+					return f.client.ListPriorityClass(ctx, metav1.ListOptionsFromUpstream(options))
 				},
-				WatchFunc: func(options k8smetav1.ListOptions) (watch.Interface, error) {
-					return f.client.WatchPriorityClass(context.TODO(), metav1.ListOptionsFromUpstream(options))
+				func(ctx context.Context, _ string, options k8smetav1.ListOptions) (watch.Interface, error) {
+					return f.client.WatchPriorityClass(ctx, metav1.ListOptionsFromUpstream(options))
 				},
-			},
+			),
 			&schedulingv1.PriorityClass{},
 			f.resyncPeriod,
 			f.indexers,
@@ -5679,32 +5932,36 @@ func (f *SchedulingK8sIoV1Informer) PriorityClassLister() *SchedulingK8sIoV1Prio
 type StorageK8sIoV1Informer struct {
 	cache        *InformerCache
 	client       *StorageK8sIoV1
-	namespace    string
+	options      InformerOptions
 	resyncPeriod time.Duration
 	indexers     cache.Indexers
 }
 
 func NewStorageK8sIoV1Informer(c *InformerCache, client *StorageK8sIoV1, namespace string, resyncPeriod time.Duration) *StorageK8sIoV1Informer {
+	return NewStorageK8sIoV1InformerWithOptions(c, client, resyncPeriod, InformerOptions{Namespaces: []string{namespace}})
+}
+
+func NewStorageK8sIoV1InformerWithOptions(c *InformerCache, client *StorageK8sIoV1, resyncPeriod time.Duration, opts InformerOptions) *StorageK8sIoV1Informer {
 	return &StorageK8sIoV1Informer{
 		cache:        c,
 		client:       client,
-		namespace:    namespace,
+		options:      opts,
 		resyncPeriod: resyncPeriod,
 		indexers:     cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc},
 	}
 }
 
 func (f *StorageK8sIoV1Informer) CSIDriverInformer() cache.SharedIndexInformer {
-	return f.cache.Write(&storagev1.CSIDriver{}, func() cache.SharedIndexInformer {
+	return f.cache.WriteWithFilter(&storagev1.CSIDriver{}, f.options.key(false), func() cache.SharedIndexInformer {
 		return cache.NewSharedIndexInformer(
-			&cache.ListWatch{
-				ListFunc: func(options k8smetav1.ListOptions) (runtime.Object, error) {
-					return f.client.ListCSIDriver(context.TODO(), metav1.ListOptionsFromUpstream(options))
+			typedclient.NewListWatch(f.client.backend, nil, f.options.tweakListOptions,
+				func(ctx context.Context, _ string, options k8smetav1.ListOptions) (runtime.Object, error) {
+					return f.client.ListCSIDriver(ctx, metav1.ListOptionsFromUpstream(options))
 				},
-				WatchFunc: func(options k8smetav1.ListOptions) (watch.Interface, error) {
-					return f.client.WatchCSIDriver(context.TODO(), metav1.ListOptionsFromUpstream(options))
+				func(ctx context.Context, _ string, options k8smetav1.ListOptions) (watch.Interface, error) {
+					return f.client.WatchCSIDriver(ctx, metav1.ListOptionsFromUpstream(options))
 				},
-			},
+			),
 			&storagev1.CSIDriver{},
 			f.resyncPeriod,
 			f.indexers,
@@ -5717,16 +5974,16 @@ func (f *StorageK8sIoV1Informer) CSIDriverLister() *StorageK8sIoV1CSIDriverListe
 }
 
 func (f *StorageK8sIoV1Informer) CSINodeInformer() cache.SharedIndexInformer {
-	return f.cache.Write(&storagev1.CSINode{}, func() cache.SharedIndexInformer {
+	return f.cache.WriteWithFilter(&storagev1.CSINode{}, f.options.key(false), func() cache.SharedIndexInformer {
 		return cache.NewSharedIndexInformer(
-			&cache.ListWatch{
-				ListFunc: func(options k8smetav1.ListOptions) (runtime.Object, error) {
-					return f.client.ListCSINode(context.TODO(), metav1.ListOptionsFromUpstream(options))
+			typedclient.NewListWatch(f.client.backend, nil, f.options.tweakListOptions,
+				func(ctx context.Context, _ string, options k8smetav1.ListOptions) (runtime.Object, error) {
+					return f.client.ListCSINode(ctx, metav1.ListOptionsFromUpstream(options))
 				},
-				WatchFunc: func(options k8smetav1.ListOptions) (watch.Interface, error) {
-					return f.client.WatchCSINode(context.TODO(), metav1.ListOptionsFromUpstream(options))
+				func(ctx context.Context, _ string, options k8smetav1.ListOptions) (watch.Interface, error) {
+					return f.client.WatchCSINode(ctx, metav1.ListOptionsFromUpstream(options))
 				},
-			},
+			),
 			&storagev1.CSINode{},
 			f.resyncPeriod,
 			f.indexers,
@@ -5739,16 +5996,16 @@ func (f *StorageK8sIoV1Informer) CSINodeLister() *StorageK8sIoV1CSINodeLister {
 }
 
 func (f *StorageK8sIoV1Informer) CSIStorageCapacityInformer() cache.SharedIndexInformer {
-	return f.cache.Write(&storagev1.CSIStorageCapacity{}, func() cache.SharedIndexInformer {
+	return f.cache.WriteWithFilter(&storagev1.CSIStorageCapacity{}, f.options.key(true), func() cache.SharedIndexInformer {
 		return cache.NewSharedIndexInformer(
-			&cache.ListWatch{
-				ListFunc: func(options k8smetav1.ListOptions) (runtime.Object, error) {
-					return f.client.ListCSIStorageCapacity(context.TODO(), f.namespace, metav1.ListOptionsFromUpstream(options))
+			typedclient.NewListWatch(f.client.backend, f.options.namespaces(), f.options.tweakListOptions,
+				func(ctx context.Context, namespace string, options k8smetav1.ListOptions) (runtime.Object, error) {
+					return f.client.ListCSIStorageCapacity(ctx, namespace, metav1.ListOptionsFromUpstream(options))
 				},
-				WatchFunc: func(options k8smetav1.ListOptions) (watch.Interface, error) {
-					return f.client.WatchCSIStorageCapacity(context.TODO(), f.namespace, metav1.ListOptionsFromUpstream(options))
+				func(ctx context.Context, namespace string, options k8smetav1.ListOptions) (watch.Interface, error) {
+					return f.client.WatchCSIStorageCapacity(ctx, namespace, metav1.ListOptionsFromUpstream(options))
 				},
-			},
+			),
 			&storagev1.CSIStorageCapacity{},
 			f.resyncPeriod,
 			f.indexers,
@@ -5761,16 +6018,16 @@ func (f *StorageK8sIoV1Informer) CSIStorageCapacityLister() *StorageK8sIoV1CSISt
 }
 
 func (f *StorageK8sIoV1Informer) StorageClassInformer() cache.SharedIndexInformer {
-	return f.cache.Write(&storagev1.StorageClass{}, func() cache.SharedIndexInformer {
+	return f.cache.WriteWithFilter(&storagev1.StorageClass{}, f.options.key(false), func() cache.SharedIndexInformer {
 		return cache.NewSharedIndexInformer(
-			&cache.ListWatch{
-				ListFunc: func(options k8smetav1.ListOptions) (runtime.Object, error) {
-					return f.client.ListStorageClass(context.TODO(), metav1.ListOptionsFromUpstream(options))
+			typedclient.NewListWatch(f.client.backend, nil, f.options.tweakListOptions,
+				func(ctx context.Context, _ string, options k8smetav1.ListOptions) (runtime.Object, error) {
+					return f.client.ListStorageClass(ctx, metav1.ListOptionsFromUpstream(options))
 				},
-				WatchFunc: func(options k8smetav1.ListOptions) (watch.Interface, error) {
-					return f.client.WatchStorageClass(context.TODO(), metav1.ListOptionsFromUpstream(options))
+				func(ctx context.Context, _ string, options k8smetav1.ListOptions) (watch.Interface, error) {
+					return f.client.WatchStorageClass(ctx, metav1.ListOptionsFromUpstream(options))
 				},
-			},
+			),
 			&storagev1.StorageClass{},
 			f.resyncPeriod,
 			f.indexers,
@@ -5783,16 +6040,16 @@ func (f *StorageK8sIoV1Informer) StorageClassLister() *StorageK8sIoV1StorageClas
 }
 
 func (f *StorageK8sIoV1Informer) VolumeAttachmentInformer() cache.SharedIndexInformer {
-	return f.cache.Write(&storagev1.VolumeAttachment{}, func() cache.SharedIndexInformer {
+	return f.cache.WriteWithFilter(&storagev1.VolumeAttachment{}, f.options.key(false), func() cache.SharedIndexInformer {
 		return cache.NewSharedIndexInformer(
-			&cache.ListWatch{
-				ListFunc: func(options k8smetav1.ListOptions) (runtime.Object, error) {
-					return f.client.ListVolumeAttachment(context.TODO(), metav1.ListOptionsFromUpstream(options))
+			typedclient.NewListWatch(f.client.backend, nil, f.options.tweakListOptions,
+				func(ctx context.Context, _ string, options k8smetav1.ListOptions) (runtime.Object, error) {
+					return f.client.ListVolumeAttachment(ctx, metav1.ListOptionsFromUpstream(options))
 				},
-				WatchFunc: func(options k8smetav1.ListOptions) (watch.Interface, error) {
-					return f.client.WatchVolumeAttachment(context.TODO(), metav1.ListOptionsFromUpstream(options))
+				func(ctx context.Context, _ string, options k8smetav1.ListOptions) (watch.Interface, error) {
+					return f.client.WatchVolumeAttachment(ctx, metav1.ListOptionsFromUpstream(options))
 				},
-			},
+			),
 			&storagev1.VolumeAttachment{},
 			f.resyncPeriod,
 			f.indexers,
@@ -5805,16 +6062,16 @@ func (f *StorageK8sIoV1Informer) VolumeAttachmentLister() *StorageK8sIoV1VolumeA
 }
 
 func (f *StorageK8sIoV1Informer) VolumeAttributesClassInformer() cache.SharedIndexInformer {
-	return f.cache.Write(&storagev1.VolumeAttributesClass{}, func() cache.SharedIndexInformer {
+	return f.cache.WriteWithFilter(&storagev1.VolumeAttributesClass{}, f.options.key(false), func() cache.SharedIndexInformer {
 		return cache.NewSharedIndexInformer(
-			&cache.ListWatch{
-				ListFunc: func(options k8smetav1.ListOptions) (runtime.Object, error) {
-					return f.client.ListVolumeAttributesClass(context.TODO(), metav1.ListOptionsFromUpstream(options))
+			typedclient.NewListWatch(f.client.backend, nil, f.options.tweakListOptions,
+				func(ctx context.Context, _ string, options k8smetav1.ListOptions) (runtime.Object, error) {
+					return f.client.ListVolumeAttributesClass(ctx, metav1.ListOptionsFromUpstream(options))
 				},
-				WatchFunc: func(options k8smetav1.ListOptions) (watch.Interface, error) {
-					return f.client.WatchVolumeAttributesClass(context.TODO(), metav1.ListOptionsFromUpstream(options))
+				func(ctx context.Context, _ string, options k8smetav1.ListOptions) (watch.Interface, error) {
+					return f.client.WatchVolumeAttributesClass(ctx, metav1.ListOptionsFromUpstream(options))
 				},
-			},
+			),
 			&storagev1.VolumeAttributesClass{},
 			f.resyncPeriod,
 			f.indexers,
@@ -5826,6 +6083,76 @@ func (f *StorageK8sIoV1Informer) VolumeAttributesClassLister() *StorageK8sIoV1Vo
 	return NewStorageK8sIoV1VolumeAttributesClassLister(f.VolumeAttributesClassInformer().GetIndexer())
 }
 
+// NewRESTMapper returns meta.RESTMapper which knows all kinds of Set.
+// The core group is preferred over the other groups and the newer version is preferred in the same group.
+func NewRESTMapper() *typedclient.StaticRESTMapper {
+	return typedclient.NewStaticRESTMapper(
+		typedclient.ResourceMapping{GroupVersionKind: corev1.SchemaGroupVersion.WithKind("Binding"), Resource: "bindings", SingularResource: "binding", Namespaced: true},
+		typedclient.ResourceMapping{GroupVersionKind: corev1.SchemaGroupVersion.WithKind("ComponentStatus"), Resource: "componentstatuses", SingularResource: "componentstatus", ShortNames: []string{"cs"}, Namespaced: false},
+		typedclient.ResourceMapping{GroupVersionKind: corev1.SchemaGroupVersion.WithKind("ConfigMap"), Resource: "configmaps", SingularResource: "configmap", ShortNames: []string{"cm"}, Namespaced: true},
+		typedclient.ResourceMapping{GroupVersionKind: corev1.SchemaGroupVersion.WithKind("Endpoints"), Resource: "endpoints", SingularResource: "endpoints", ShortNames: []string{"ep"}, Namespaced: true},
+		typedclient.ResourceMapping{GroupVersionKind: corev1.SchemaGroupVersion.WithKind("Event"), Resource: "events", SingularResource: "event", ShortNames: []string{"ev"}, Namespaced: true},
+		typedclient.ResourceMapping{GroupVersionKind: corev1.SchemaGroupVersion.WithKind("LimitRange"), Resource: "limitranges", SingularResource: "limitrange", ShortNames: []string{"limits"}, Namespaced: true},
+		typedclient.ResourceMapping{GroupVersionKind: corev1.SchemaGroupVersion.WithKind("Namespace"), Resource: "namespaces", SingularResource: "namespace", ShortNames: []string{"ns"}, Namespaced: false},
+		typedclient.ResourceMapping{GroupVersionKind: corev1.SchemaGroupVersion.WithKind("Node"), Resource: "nodes", SingularResource: "node", ShortNames: []string{"no"}, Namespaced: false},
+		typedclient.ResourceMapping{GroupVersionKind: corev1.SchemaGroupVersion.WithKind("PersistentVolume"), Resource: "persistentvolumes", SingularResource: "persistentvolume", ShortNames: []string{"pv"}, Namespaced: false},
+		typedclient.ResourceMapping{GroupVersionKind: corev1.SchemaGroupVersion.WithKind("PersistentVolumeClaim"), Resource: "persistentvolumeclaims", SingularResource: "persistentvolumeclaim", ShortNames: []string{"pvc"}, Namespaced: true},
+		typedclient.ResourceMapping{GroupVersionKind: corev1.SchemaGroupVersion.WithKind("Pod"), Resource: "pods", SingularResource: "pod", ShortNames: []string{"po"}, Namespaced: true},
+		typedclient.ResourceMapping{GroupVersionKind: corev1.SchemaGroupVersion.WithKind("PodTemplate"), Resource: "podtemplates", SingularResource: "podtemplate", Namespaced: true},
+		typedclient.ResourceMapping{GroupVersionKind: corev1.SchemaGroupVersion.WithKind("ReplicationController"), Resource: "replicationcontrollers", SingularResource: "replicationcontroller", ShortNames: []string{"rc"}, Namespaced: true},
+		typedclient.ResourceMapping{GroupVersionKind: corev1.SchemaGroupVersion.WithKind("ResourceQuota"), Resource: "resourcequotas", SingularResource: "resourcequota", ShortNames: []string{"quota"}, Namespaced: true},
+		typedclient.ResourceMapping{GroupVersionKind: corev1.SchemaGroupVersion.WithKind("Secret"), Resource: "secrets", SingularResource: "secret", Namespaced: true},
+		typedclient.ResourceMapping{GroupVersionKind: corev1.SchemaGroupVersion.WithKind("Service"), Resource: "services", SingularResource: "service", ShortNames: []string{"svc"}, Namespaced: true},
+		typedclient.ResourceMapping{GroupVersionKind: corev1.SchemaGroupVersion.WithKind("ServiceAccount"), Resource: "serviceaccounts", SingularResource: "serviceaccount", ShortNames: []string{"sa"}, Namespaced: true},
+		typedclient.ResourceMapping{GroupVersionKind: admissionregistrationv1.SchemaGroupVersion.WithKind("MutatingAdmissionPolicy"), Resource: "mutatingadmissionpolicies", SingularResource: "mutatingadmissionpolicy", Namespaced: false},
+		typedclient.ResourceMapping{GroupVersionKind: admissionregistrationv1.SchemaGroupVersion.WithKind("MutatingAdmissionPolicyBinding"), Resource: "mutatingadmissionpolicybindings", SingularResource: "mutatingadmissionpolicybinding", Namespaced: false},
+		typedclient.ResourceMapping{GroupVersionKind: admissionregistrationv1.SchemaGroupVersion.WithKind("MutatingWebhookConfiguration"), Resource: "mutatingwebhookconfigurations", SingularResource: "mutatingwebhookconfiguration", Namespaced: false},
+		typedclient.ResourceMapping{GroupVersionKind: admissionregistrationv1.SchemaGroupVersion.WithKind("ValidatingAdmissionPolicy"), Resource: "validatingadmissionpolicies", SingularResource: "validatingadmissionpolicy", Namespaced: false},
+		typedclient.ResourceMapping{GroupVersionKind: admissionregistrationv1.SchemaGroupVersion.WithKind("ValidatingAdmissionPolicyBinding"), Resource: "validatingadmissionpolicybindings", SingularResource: "validatingadmissionpolicybinding", Namespaced: false},
+		typedclient.ResourceMapping{GroupVersionKind: admissionregistrationv1.SchemaGroupVersion.WithKind("ValidatingWebhookConfiguration"), Resource: "validatingwebhookconfigurations", SingularResource: "validatingwebhookconfiguration", Namespaced: false},
+		typedclient.ResourceMapping{GroupVersionKind: appsv1.SchemaGroupVersion.WithKind("ControllerRevision"), Resource: "controllerrevisions", SingularResource: "controllerrevision", Namespaced: true},
+		typedclient.ResourceMapping{GroupVersionKind: appsv1.SchemaGroupVersion.WithKind("DaemonSet"), Resource: "daemonsets", SingularResource: "daemonset", ShortNames: []string{"ds"}, Namespaced: true},
+		typedclient.ResourceMapping{GroupVersionKind: appsv1.SchemaGroupVersion.WithKind("Deployment"), Resource: "deployments", SingularResource: "deployment", ShortNames: []string{"deploy"}, Namespaced: true},
+		typedclient.ResourceMapping{GroupVersionKind: appsv1.SchemaGroupVersion.WithKind("ReplicaSet"), Resource: "replicasets", SingularResource: "replicaset", ShortNames: []string{"rs"}, Namespaced: true},
+		typedclient.ResourceMapping{GroupVersionKind: appsv1.SchemaGroupVersion.WithKind("StatefulSet"), Resource: "statefulsets", SingularResource: "statefulset", ShortNames: []string{"sts"}, Namespaced: true},
+		typedclient.ResourceMapping{GroupVersionKind: authenticationv1.SchemaGroupVersion.WithKind("SelfSubjectReview"), Resource: "selfsubjectreviews", SingularResource: "selfsubjectreview", Namespaced: false},
+		typedclient.ResourceMapping{GroupVersionKind: authenticationv1.SchemaGroupVersion.WithKind("TokenReview"), Resource: "tokenreviews", SingularResource: "tokenreview", Namespaced: false},
+		typedclient.ResourceMapping{GroupVersionKind: authorizationv1.SchemaGroupVersion.WithKind("LocalSubjectAccessReview"), Resource: "localsubjectaccessreviews", SingularResource: "localsubjectaccessreview", Namespaced: true},
+		typedclient.ResourceMapping{GroupVersionKind: authorizationv1.SchemaGroupVersion.WithKind("SelfSubjectAccessReview"), Resource: "selfsubjectaccessreviews", SingularResource: "selfsubjectaccessreview", Namespaced: false},
+		typedclient.ResourceMapping{GroupVersionKind: authorizationv1.SchemaGroupVersion.WithKind("SelfSubjectRulesReview"), Resource: "selfsubjectrulesreviews", SingularResource: "selfsubjectrulesreview", Namespaced: false},
+		typedclient.ResourceMapping{GroupVersionKind: authorizationv1.SchemaGroupVersion.WithKind("SubjectAccessReview"), Resource: "subjectaccessreviews", SingularResource: "subjectaccessreview", Namespaced: false},
+		typedclient.ResourceMapping{GroupVersionKind: autoscalingv2.SchemaGroupVersion.WithKind("HorizontalPodAutoscaler"), Resource: "horizontalpodautoscalers", SingularResource: "horizontalpodautoscaler", ShortNames: []string{"hpa"}, Namespaced: true},
+		typedclient.ResourceMapping{GroupVersionKind: autoscalingv1.SchemaGroupVersion.WithKind("HorizontalPodAutoscaler"), Resource: "horizontalpodautoscalers", SingularResource: "horizontalpodautoscaler", ShortNames: []string{"hpa"}, Namespaced: true},
+		typedclient.ResourceMapping{GroupVersionKind: batchv1.SchemaGroupVersion.WithKind("CronJob"), Resource: "cronjobs", SingularResource: "cronjob", ShortNames: []string{"cj"}, Namespaced: true},
+		typedclient.ResourceMapping{GroupVersionKind: batchv1.SchemaGroupVersion.WithKind("Job"), Resource: "jobs", SingularResource: "job", Namespaced: true},
+		typedclient.ResourceMapping{GroupVersionKind: certificatesv1.SchemaGroupVersion.WithKind("CertificateSigningRequest"), Resource: "certificatesigningrequests", SingularResource: "certificatesigningrequest", ShortNames: []string{"csr"}, Namespaced: false},
+		typedclient.ResourceMapping{GroupVersionKind: coordinationv1.SchemaGroupVersion.WithKind("Lease"), Resource: "leases", SingularResource: "lease", Namespaced: true},
+		typedclient.ResourceMapping{GroupVersionKind: discoveryv1.SchemaGroupVersion.WithKind("EndpointSlice"), Resource: "endpointslices", SingularResource: "endpointslice", Namespaced: true},
+		typedclient.ResourceMapping{GroupVersionKind: eventsv1.SchemaGroupVersion.WithKind("Event"), Resource: "events", SingularResource: "event", ShortNames: []string{"ev"}, Namespaced: true},
+		typedclient.ResourceMapping{GroupVersionKind: networkingv1.SchemaGroupVersion.WithKind("IPAddress"), Resource: "ipaddresses", SingularResource: "ipaddress", Namespaced: false},
+		typedclient.ResourceMapping{GroupVersionKind: networkingv1.SchemaGroupVersion.WithKind("Ingress"), Resource: "ingresses", SingularResource: "ingress", ShortNames: []string{"ing"}, Namespaced: true},
+		typedclient.ResourceMapping{GroupVersionKind: networkingv1.SchemaGroupVersion.WithKind("IngressClass"), Resource: "ingressclasses", SingularResource: "ingressclass", Namespaced: false},
+		typedclient.ResourceMapping{GroupVersionKind: networkingv1.SchemaGroupVersion.WithKind("NetworkPolicy"), Resource: "networkpolicies", SingularResource: "networkpolicy", ShortNames: []string{"netpol"}, Namespaced: true},
+		typedclient.ResourceMapping{GroupVersionKind: networkingv1.SchemaGroupVersion.WithKind("ServiceCIDR"), Resource: "servicecidrs", SingularResource: "servicecidr", Namespaced: false},
+		typedclient.ResourceMapping{GroupVersionKind: policyv1.SchemaGroupVersion.WithKind("PodDisruptionBudget"), Resource: "poddisruptionbudgets", SingularResource: "poddisruptionbudget", ShortNames: []string{"pdb"}, Namespaced: true},
+		typedclient.ResourceMapping{GroupVersionKind: rbacv1.SchemaGroupVersion.WithKind("ClusterRole"), Resource: "clusterroles", SingularResource: "clusterrole", Namespaced: false},
+		typedclient.ResourceMapping{GroupVersionKind: rbacv1.SchemaGroupVersion.WithKind("ClusterRoleBinding"), Resource: "clusterrolebindings", SingularResource: "clusterrolebinding", Namespaced: false},
+		typedclient.ResourceMapping{GroupVersionKind: rbacv1.SchemaGroupVersion.WithKind("Role"), Resource: "roles", SingularResource: "role", Namespaced: true},
+		typedclient.ResourceMapping{GroupVersionKind: rbacv1.SchemaGroupVersion.WithKind("RoleBinding"), Resource: "rolebindings", SingularResource: "rolebinding", Namespaced: true},
+		typedclient.ResourceMapping{GroupVersionKind: resourcev1.SchemaGroupVersion.WithKind("DeviceClass"), Resource: "deviceclasses", SingularResource: "deviceclass", Namespaced: false},
+		typedclient.ResourceMapping{GroupVersionKind: resourcev1.SchemaGroupVersion.WithKind("ResourceClaim"), Resource: "resourceclaims", SingularResource: "resourceclaim", Namespaced: true},
+		typedclient.ResourceMapping{GroupVersionKind: resourcev1.SchemaGroupVersion.WithKind("ResourceClaimTemplate"), Resource: "resourceclaimtemplates", SingularResource: "resourceclaimtemplate", Namespaced: true},
+		typedclient.ResourceMapping{GroupVersionKind: resourcev1.SchemaGroupVersion.WithKind("ResourceSlice"), Resource: "resourceslices", SingularResource: "resourceslice", Namespaced: false},
+		typedclient.ResourceMapping{GroupVersionKind: schedulingv1.SchemaGroupVersion.WithKind("PriorityClass"), Resource: "priorityclasses", SingularResource: "priorityclass", ShortNames: []string{"pc"}, Namespaced: false},
+		typedclient.ResourceMapping{GroupVersionKind: storagev1.SchemaGroupVersion.WithKind("CSIDriver"), Resource: "csidrivers", SingularResource: "csidriver", Namespaced: false},
+		typedclient.ResourceMapping{GroupVersionKind: storagev1.SchemaGroupVersion.WithKind("CSINode"), Resource: "csinodes", SingularResource: "csinode", Namespaced: false},
+		typedclient.ResourceMapping{GroupVersionKind: storagev1.SchemaGroupVersion.WithKind("CSIStorageCapacity"), Resource: "csistoragecapacities", SingularResource: "csistoragecapacity", Namespaced: true},
+		typedclient.ResourceMapping{GroupVersionKind: storagev1.SchemaGroupVersion.WithKind("StorageClass"), Resource: "storageclasses", SingularResource: "storageclass", ShortNames: []string{"sc"}, Namespaced: false},
+		typedclient.ResourceMapping{GroupVersionKind: storagev1.SchemaGroupVersion.WithKind("VolumeAttachment"), Resource: "volumeattachments", SingularResource: "volumeattachment", Namespaced: false},
+		typedclient.ResourceMapping{GroupVersionKind: storagev1.SchemaGroupVersion.WithKind("VolumeAttributesClass"), Resource: "volumeattributesclasses", SingularResource: "volumeattributesclass", Namespaced: false},
+	)
+}
+
 type CoreV1BindingLister struct {
 	indexer cache.Indexer
 }
@@ -5853,6 +6180,19 @@ func (x *CoreV1BindingLister) Get(namespace, name string) (*corev1.Binding, erro
 	return obj.(*corev1.Binding).DeepCopy(), nil
 }
 
+// ListByIndex returns the objects of which indexedValue of the index is matched.
+func (x *CoreV1BindingLister) ListByIndex(indexName, indexedValue string) ([]*corev1.Binding, error) {
+	objs, err := x.indexer.ByIndex(indexName, indexedValue)
+	if err != nil {
+		return nil, err
+	}
+	ret := make([]*corev1.Binding, 0, len(objs))
+	for _, v := range objs {
+		ret = append(ret, v.(*corev1.Binding).DeepCopy())
+	}
+	return ret, nil
+}
+
 type CoreV1ComponentStatusLister struct {
 	indexer cache.Indexer
 }
@@ -5880,6 +6220,19 @@ func (x *CoreV1ComponentStatusLister) Get(name string) (*corev1.ComponentStatus,
 	return obj.(*corev1.ComponentStatus).DeepCopy(), nil
 }
 
+// ListByIndex returns the objects of which indexedValue of the index is matched.
+func (x *CoreV1ComponentStatusLister) ListByIndex(indexName, indexedValue string) ([]*corev1.ComponentStatus, error) {
+	objs, err := x.indexer.ByIndex(indexName, indexedValue)
+	if err != nil {
+		return nil, err
+	}
+	ret := make([]*corev1.ComponentStatus, 0, len(objs))
+	for _, v := range objs {
+		ret = append(ret, v.(*corev1.ComponentStatus).DeepCopy())
+	}
+	return ret, nil
+}
+
 type CoreV1ConfigMapLister struct {
 	indexer cache.Indexer
 }
@@ -5907,6 +6260,19 @@ func (x *CoreV1ConfigMapLister) Get(namespace, name string) (*corev1.ConfigMap,
 	return obj.(*corev1.ConfigMap).DeepCopy(), nil
 }
 
+// ListByIndex returns the objects of which indexedValue of the index is matched.
+func (x *CoreV1ConfigMapLister) ListByIndex(indexName, indexedValue string) ([]*corev1.ConfigMap, error) {
+	objs, err := x.indexer.ByIndex(indexName, indexedValue)
+	if err != nil {
+		return nil, err
+	}
+	ret := make([]*corev1.ConfigMap, 0, len(objs))
+	for _, v := range objs {
+		ret = append(ret, v.(*corev1.ConfigMap).DeepCopy())
+	}
+	return ret, nil
+}
+
 type CoreV1EndpointsLister struct {
 	indexer cache.Indexer
 }
@@ -5934,6 +6300,19 @@ func (x *CoreV1EndpointsLister) Get(namespace, name string) (*corev1.Endpoints,
 	return obj.(*corev1.Endpoints).DeepCopy(), nil
 }
 
+// ListByIndex returns the objects of which indexedValue of the index is matched.
+func (x *CoreV1EndpointsLister) ListByIndex(indexName, indexedValue string) ([]*corev1.Endpoints, error) {
+	objs, err := x.indexer.ByIndex(indexName, indexedValue)
+	if err != nil {
+		return nil, err
+	}
+	ret := make([]*corev1.Endpoints, 0, len(objs))
+	for _, v := range objs {
+		ret = append(ret, v.(*corev1.Endpoints).DeepCopy())
+	}
+	return ret, nil
+}
+
 type CoreV1EventLister struct {
 	indexer cache.Indexer
 }
@@ -5961,6 +6340,19 @@ func (x *CoreV1EventLister) Get(namespace, name string) (*corev1.Event, error) {
 	return obj.(*corev1.Event).DeepCopy(), nil
 }
 
+// ListByIndex returns the objects of which indexedValue of the index is matched.
+func (x *CoreV1EventLister) ListByIndex(indexName, indexedValue string) ([]*corev1.Event, error) {
+	objs, err := x.indexer.ByIndex(indexName, indexedValue)
+	if err != nil {
+		return nil, err
+	}
+	ret := make([]*corev1.Event, 0, len(objs))
+	for _, v := range objs {
+		ret = append(ret, v.(*corev1.Event).DeepCopy())
+	}
+	return ret, nil
+}
+
 type CoreV1LimitRangeLister struct {
 	indexer cache.Indexer
 }
@@ -5988,6 +6380,19 @@ func (x *CoreV1LimitRangeLister) Get(namespace, name string) (*corev1.LimitRange
 	return obj.(*corev1.LimitRange).DeepCopy(), nil
 }
 
+// ListByIndex returns the objects of which indexedValue of the index is matched.
+func (x *CoreV1LimitRangeLister) ListByIndex(indexName, indexedValue string) ([]*corev1.LimitRange, error) {
+	objs, err := x.indexer.ByIndex(indexName, indexedValue)
+	if err != nil {
+		return nil, err
+	}
+	ret := make([]*corev1.LimitRange, 0, len(objs))
+	for _, v := range objs {
+		ret = append(ret, v.(*corev1.LimitRange).DeepCopy())
+	}
+	return ret, nil
+}
+
 type CoreV1NamespaceLister struct {
 	indexer cache.Indexer
 }
@@ -6015,6 +6420,19 @@ func (x *CoreV1NamespaceLister) Get(name string) (*corev1.Namespace, error) {
 	return obj.(*corev1.Namespace).DeepCopy(), nil
 }
 
+// ListByIndex returns the objects of which indexedValue of the index is matched.
+func (x *CoreV1NamespaceLister) ListByIndex(indexName, indexedValue string) ([]*corev1.Namespace, error) {
+	objs, err := x.indexer.ByIndex(indexName, indexedValue)
+	if err != nil {
+		return nil, err
+	}
+	ret := make([]*corev1.Namespace, 0, len(objs))
+	for _, v := range objs {
+		ret = append(ret, v.(*corev1.Namespace).DeepCopy())
+	}
+	return ret, nil
+}
+
 type CoreV1NodeLister struct {
 	indexer cache.Indexer
 }
@@ -6042,6 +6460,19 @@ func (x *CoreV1NodeLister) Get(name string) (*corev1.Node, error) {
 	return obj.(*corev1.Node).DeepCopy(), nil
 }
 
+// ListByIndex returns the objects of which indexedValue of the index is matched.
+func (x *CoreV1NodeLister) ListByIndex(indexName, indexedValue string) ([]*corev1.Node, error) {
+	objs, err := x.indexer.ByIndex(indexName, indexedValue)
+	if err != nil {
+		return nil, err
+	}
+	ret := make([]*corev1.Node, 0, len(objs))
+	for _, v := range objs {
+		ret = append(ret, v.(*corev1.Node).DeepCopy())
+	}
+	return ret, nil
+}
+
 type CoreV1PersistentVolumeLister struct {
 	indexer cache.Indexer
 }
@@ -6069,6 +6500,19 @@ func (x *CoreV1PersistentVolumeLister) Get(name string) (*corev1.PersistentVolum
 	return obj.(*corev1.PersistentVolume).DeepCopy(), nil
 }
 
+// ListByIndex returns the objects of which indexedValue of the index is matched.
+func (x *CoreV1PersistentVolumeLister) ListByIndex(indexName, indexedValue string) ([]*corev1.PersistentVolume, error) {
+	objs, err := x.indexer.ByIndex(indexName, indexedValue)
+	if err != nil {
+		return nil, err
+	}
+	ret := make([]*corev1.PersistentVolume, 0, len(objs))
+	for _, v := range objs {
+		ret = append(ret, v.(*corev1.PersistentVolume).DeepCopy())
+	}
+	return ret, nil
+}
+
 type CoreV1PersistentVolumeClaimLister struct {
 	indexer cache.Indexer
 }
@@ -6096,6 +6540,19 @@ func (x *CoreV1PersistentVolumeClaimLister) Get(namespace, name string) (*corev1
 	return obj.(*corev1.PersistentVolumeClaim).DeepCopy(), nil
 }
 
+// ListByIndex returns the objects of which indexedValue of the index is matched.
+func (x *CoreV1PersistentVolumeClaimLister) ListByIndex(indexName, indexedValue string) ([]*corev1.PersistentVolumeClaim, error) {
+	objs, err := x.indexer.ByIndex(indexName, indexedValue)
+	if err != nil {
+		return nil, err
+	}
+	ret := make([]*corev1.PersistentVolumeClaim, 0, len(objs))
+	for _, v := range objs {
+		ret = append(ret, v.(*corev1.PersistentVolumeClaim).DeepCopy())
+	}
+	return ret, nil
+}
+
 type CoreV1PodLister struct {
 	indexer cache.Indexer
 }
@@ -6123,6 +6580,19 @@ func (x *CoreV1PodLister) Get(namespace, name string) (*corev1.Pod, error) {
 	return obj.(*corev1.Pod).DeepCopy(), nil
 }
 
+// ListByIndex returns the objects of which indexedValue of the index is matched.
+func (x *CoreV1PodLister) ListByIndex(indexName, indexedValue string) ([]*corev1.Pod, error) {
+	objs, err := x.indexer.ByIndex(indexName, indexedValue)
+	if err != nil {
+		return nil, err
+	}
+	ret := make([]*corev1.Pod, 0, len(objs))
+	for _, v := range objs {
+		ret = append(ret, v.(*corev1.Pod).DeepCopy())
+	}
+	return ret, nil
+}
+
 type CoreV1PodStatusResultLister struct {
 	indexer cache.Indexer
 }
@@ -6150,6 +6620,19 @@ func (x *CoreV1PodStatusResultLister) Get(namespace, name string) (*corev1.PodSt
 	return obj.(*corev1.PodStatusResult).DeepCopy(), nil
 }
 
+// ListByIndex returns the objects of which indexedValue of the index is matched.
+func (x *CoreV1PodStatusResultLister) ListByIndex(indexName, indexedValue string) ([]*corev1.PodStatusResult, error) {
+	objs, err := x.indexer.ByIndex(indexName, indexedValue)
+	if err != nil {
+		return nil, err
+	}
+	ret := make([]*corev1.PodStatusResult, 0, len(objs))
+	for _, v := range objs {
+		ret = append(ret, v.(*corev1.PodStatusResult).DeepCopy())
+	}
+	return ret, nil
+}
+
 type CoreV1PodTemplateLister struct {
 	indexer cache.Indexer
 }
@@ -6177,6 +6660,19 @@ func (x *CoreV1PodTemplateLister) Get(namespace, name string) (*corev1.PodTempla
 	return obj.(*corev1.PodTemplate).DeepCopy(), nil
 }
 
+// ListByIndex returns the objects of which indexedValue of the index is matched.
+func (x *CoreV1PodTemplateLister) ListByIndex(indexName, indexedValue string) ([]*corev1.PodTemplate, error) {
+	objs, err := x.indexer.ByIndex(indexName, indexedValue)
+	if err != nil {
+		return nil, err
+	}
+	ret := make([]*corev1.PodTemplate, 0, len(objs))
+	for _, v := range objs {
+		ret = append(ret, v.(*corev1.PodTemplate).DeepCopy())
+	}
+	return ret, nil
+}
+
 type CoreV1RangeAllocationLister struct {
 	indexer cache.Indexer
 }
@@ -6204,6 +6700,19 @@ func (x *CoreV1RangeAllocationLister) Get(namespace, name string) (*corev1.Range
 	return obj.(*corev1.RangeAllocation).DeepCopy(), nil
 }
 
+// ListByIndex returns the objects of which indexedValue of the index is matched.
+func (x *CoreV1RangeAllocationLister) ListByIndex(indexName, indexedValue string) ([]*corev1.RangeAllocation, error) {
+	objs, err := x.indexer.ByIndex(indexName, indexedValue)
+	if err != nil {
+		return nil, err
+	}
+	ret := make([]*corev1.RangeAllocation, 0, len(objs))
+	for _, v := range objs {
+		ret = append(ret, v.(*corev1.RangeAllocation).DeepCopy())
+	}
+	return ret, nil
+}
+
 type CoreV1ReplicationControllerLister struct {
 	indexer cache.Indexer
 }
@@ -6231,6 +6740,19 @@ func (x *CoreV1ReplicationControllerLister) Get(namespace, name string) (*corev1
 	return obj.(*corev1.ReplicationController).DeepCopy(), nil
 }
 
+// ListByIndex returns the objects of which indexedValue of the index is matched.
+func (x *CoreV1ReplicationControllerLister) ListByIndex(indexName, indexedValue string) ([]*corev1.ReplicationController, error) {
+	objs, err := x.indexer.ByIndex(indexName, indexedValue)
+	if err != nil {
+		return nil, err
+	}
+	ret := make([]*corev1.ReplicationController, 0, len(objs))
+	for _, v := range objs {
+		ret = append(ret, v.(*corev1.ReplicationController).DeepCopy())
+	}
+	return ret, nil
+}
+
 type CoreV1ResourceQuotaLister struct {
 	indexer cache.Indexer
 }
@@ -6258,6 +6780,19 @@ func (x *CoreV1ResourceQuotaLister) Get(namespace, name string) (*corev1.Resourc
 	return obj.(*corev1.ResourceQuota).DeepCopy(), nil
 }
 
+// ListByIndex returns the objects of which indexedValue of the index is matched.
+func (x *CoreV1ResourceQuotaLister) ListByIndex(indexName, indexedValue string) ([]*corev1.ResourceQuota, error) {
+	objs, err := x.indexer.ByIndex(indexName, indexedValue)
+	if err != nil {
+		return nil, err
+	}
+	ret := make([]*corev1.ResourceQuota, 0, len(objs))
+	for _, v := range objs {
+		ret = append(ret, v.(*corev1.ResourceQuota).DeepCopy())
+	}
+	return ret, nil
+}
+
 type CoreV1SecretLister struct {
 	indexer cache.Indexer
 }
@@ -6285,6 +6820,19 @@ func (x *CoreV1SecretLister) Get(namespace, name string) (*corev1.Secret, error)
 	return obj.(*corev1.Secret).DeepCopy(), nil
 }
 
+// ListByIndex returns the objects of which indexedValue of the index is matched.
+func (x *CoreV1SecretLister) ListByIndex(indexName, indexedValue string) ([]*corev1.Secret, error) {
+	objs, err := x.indexer.ByIndex(indexName, indexedValue)
+	if err != nil {
+		return nil, err
+	}
+	ret := make([]*corev1.Secret, 0, len(objs))
+	for _, v := range objs {
+		ret = append(ret, v.(*corev1.Secret).DeepCopy())
+	}
+	return ret, nil
+}
+
 type CoreV1ServiceLister struct {
 	indexer cache.Indexer
 }
@@ -6312,6 +6860,19 @@ func (x *CoreV1ServiceLister) Get(namespace, name string) (*corev1.Service, erro
 	return obj.(*corev1.Service).DeepCopy(), nil
 }
 
+// ListByIndex returns the objects of which indexedValue of the index is matched.
+func (x *CoreV1ServiceLister) ListByIndex(indexName, indexedValue string) ([]*corev1.Service, error) {
+	objs, err := x.indexer.ByIndex(indexName, indexedValue)
+	if err != nil {
+		return nil, err
+	}
+	ret := make([]*corev1.Service, 0, len(objs))
+	for _, v := range objs {
+		ret = append(ret, v.(*corev1.Service).DeepCopy())
+	}
+	return ret, nil
+}
+
 type CoreV1ServiceAccountLister struct {
 	indexer cache.Indexer
 }
@@ -6339,6 +6900,19 @@ func (x *CoreV1ServiceAccountLister) Get(namespace, name string) (*corev1.Servic
 	return obj.(*corev1.ServiceAccount).DeepCopy(), nil
 }
 
+// ListByIndex returns the objects of which indexedValue of the index is matched.
+func (x *CoreV1ServiceAccountLister) ListByIndex(indexName, indexedValue string) ([]*corev1.ServiceAccount, error) {
+	objs, err := x.indexer.ByIndex(indexName, indexedValue)
+	if err != nil {
+		return nil, err
+	}
+	ret := make([]*corev1.ServiceAccount, 0, len(objs))
+	for _, v := range objs {
+		ret = append(ret, v.(*corev1.ServiceAccount).DeepCopy())
+	}
+	return ret, nil
+}
+
 type AdmissionregistrationK8sIoV1MutatingAdmissionPolicyLister struct {
 	indexer cache.Indexer
 }
@@ -6366,6 +6940,19 @@ func (x *AdmissionregistrationK8sIoV1MutatingAdmissionPolicyLister) Get(name str
 	return obj.(*admissionregistrationv1.MutatingAdmissionPolicy).DeepCopy(), nil
 }
 
+// ListByIndex returns the objects of which indexedValue of the index is matched.
+func (x *AdmissionregistrationK8sIoV1MutatingAdmissionPolicyLister) ListByIndex(indexName, indexedValue string) ([]*admissionregistrationv1.MutatingAdmissionPolicy, error) {
+	objs, err := x.indexer.ByIndex(indexName, indexedValue)
+	if err != nil {
+		return nil, err
+	}
+	ret := make([]*admissionregistrationv1.MutatingAdmissionPolicy, 0, len(objs))
+	for _, v := range objs {
+		ret = append(ret, v.(*admissionregistrationv1.MutatingAdmissionPolicy).DeepCopy())
+	}
+	return ret, nil
+}
+
 type AdmissionregistrationK8sIoV1MutatingAdmissionPolicyBindingLister struct {
 	indexer cache.Indexer
 }
@@ -6393,6 +6980,19 @@ func (x *AdmissionregistrationK8sIoV1MutatingAdmissionPolicyBindingLister) Get(n
 	return obj.(*admissionregistrationv1.MutatingAdmissionPolicyBinding).DeepCopy(), nil
 }
 
+// ListByIndex returns the objects of which indexedValue of the index is matched.
+func (x *AdmissionregistrationK8sIoV1MutatingAdmissionPolicyBindingLister) ListByIndex(indexName, indexedValue string) ([]*admissionregistrationv1.MutatingAdmissionPolicyBinding, error) {
+	objs, err := x.indexer.ByIndex(indexName, indexedValue)
+	if err != nil {
+		return nil, err
+	}
+	ret := make([]*admissionregistrationv1.MutatingAdmissionPolicyBinding, 0, len(objs))
+	for _, v := range objs {
+		ret = append(ret, v.(*admissionregistrationv1.MutatingAdmissionPolicyBinding).DeepCopy())
+	}
+	return ret, nil
+}
+
 type AdmissionregistrationK8sIoV1MutatingWebhookConfigurationLister struct {
 	indexer cache.Indexer
 }
@@ -6420,6 +7020,19 @@ func (x *AdmissionregistrationK8sIoV1MutatingWebhookConfigurationLister) Get(nam
 	return obj.(*admissionregistrationv1.MutatingWebhookConfiguration).DeepCopy(), nil
 }
 
+// ListByIndex returns the objects of which indexedValue of the index is matched.
+func (x *AdmissionregistrationK8sIoV1MutatingWebhookConfigurationLister) ListByIndex(indexName, indexedValue string) ([]*admissionregistrationv1.MutatingWebhookConfiguration, error) {
+	objs, err := x.indexer.ByIndex(indexName, indexedValue)
+	if err != nil {
+		return nil, err
+	}
+	ret := make([]*admissionregistrationv1.MutatingWebhookConfiguration, 0, len(objs))
+	for _, v := range objs {
+		ret = append(ret, v.(*admissionregistrationv1.MutatingWebhookConfiguration).DeepCopy())
+	}
+	return ret, nil
+}
+
 type AdmissionregistrationK8sIoV1ValidatingAdmissionPolicyLister struct {
 	indexer cache.Indexer
 }
@@ -6447,6 +7060,19 @@ func (x *AdmissionregistrationK8sIoV1ValidatingAdmissionPolicyLister) Get(name s
 	return obj.(*admissionregistrationv1.ValidatingAdmissionPolicy).DeepCopy(), nil
 }
 
+// ListByIndex returns the objects of which indexedValue of the index is matched.
+func (x *AdmissionregistrationK8sIoV1ValidatingAdmissionPolicyLister) ListByIndex(indexName, indexedValue string) ([]*admissionregistrationv1.ValidatingAdmissionPolicy, error) {
+	objs, err := x.indexer.ByIndex(indexName, indexedValue)
+	if err != nil {
+		return nil, err
+	}
+	ret := make([]*admissionregistrationv1.ValidatingAdmissionPolicy, 0, len(objs))
+	for _, v := range objs {
+		ret = append(ret, v.(*admissionregistrationv1.ValidatingAdmissionPolicy).DeepCopy())
+	}
+	return ret, nil
+}
+
 type AdmissionregistrationK8sIoV1ValidatingAdmissionPolicyBindingLister struct {
 	indexer cache.Indexer
 }
@@ -6474,6 +7100,19 @@ func (x *AdmissionregistrationK8sIoV1ValidatingAdmissionPolicyBindingLister) Get
 	return obj.(*admissionregistrationv1.ValidatingAdmissionPolicyBinding).DeepCopy(), nil
 }
 
+// ListByIndex returns the objects of which indexedValue of the index is matched.
+func (x *AdmissionregistrationK8sIoV1ValidatingAdmissionPolicyBindingLister) ListByIndex(indexName, indexedValue string) ([]*admissionregistrationv1.ValidatingAdmissionPolicyBinding, error) {
+	objs, err := x.indexer.ByIndex(indexName, indexedValue)
+	if err != nil {
+		return nil, err
+	}
+	ret := make([]*admissionregistrationv1.ValidatingAdmissionPolicyBinding, 0, len(objs))
+	for _, v := range objs {
+		ret = append(ret, v.(*admissionregistrationv1.ValidatingAdmissionPolicyBinding).DeepCopy())
+	}
+	return ret, nil
+}
+
 type AdmissionregistrationK8sIoV1ValidatingWebhookConfigurationLister struct {
 	indexer cache.Indexer
 }
@@ -6501,6 +7140,19 @@ func (x *AdmissionregistrationK8sIoV1ValidatingWebhookConfigurationLister) Get(n
 	return obj.(*admissionregistrationv1.ValidatingWebhookConfiguration).DeepCopy(), nil
 }
 
+// ListByIndex returns the objects of which indexedValue of the index is matched.
+func (x *AdmissionregistrationK8sIoV1ValidatingWebhookConfigurationLister) ListByIndex(indexName, indexedValue string) ([]*admissionregistrationv1.ValidatingWebhookConfiguration, error) {
+	objs, err := x.indexer.ByIndex(indexName, indexedValue)
+	if err != nil {
+		return nil, err
+	}
+	ret := make([]*admissionregistrationv1.ValidatingWebhookConfiguration, 0, len(objs))
+	for _, v := range objs {
+		ret = append(ret, v.(*admissionregistrationv1.ValidatingWebhookConfiguration).DeepCopy())
+	}
+	return ret, nil
+}
+
 type AppsV1ControllerRevisionLister struct {
 	indexer cache.Indexer
 }
@@ -6522,10 +7174,23 @@ func (x *AppsV1ControllerRevisionLister) Get(namespace, name string) (*appsv1.Co
 	if err != nil {
 		return nil, err
 	}
-	if !exists {
-		return nil, k8serrors.NewNotFound(appsv1.SchemaGroupVersion.WithResource("controllerrevision").GroupResource(), name)
+	if !exists {
+		return nil, k8serrors.NewNotFound(appsv1.SchemaGroupVersion.WithResource("controllerrevision").GroupResource(), name)
+	}
+	return obj.(*appsv1.ControllerRevision).DeepCopy(), nil
+}
+
+// ListByIndex returns the objects of which indexedValue of the index is matched.
+func (x *AppsV1ControllerRevisionLister) ListByIndex(indexName, indexedValue string) ([]*appsv1.ControllerRevision, error) {
+	objs, err := x.indexer.ByIndex(indexName, indexedValue)
+	if err != nil {
+		return nil, err
+	}
+	ret := make([]*appsv1.ControllerRevision, 0, len(objs))
+	for _, v := range objs {
+		ret = append(ret, v.(*appsv1.ControllerRevision).DeepCopy())
 	}
-	return obj.(*appsv1.ControllerRevision).DeepCopy(), nil
+	return ret, nil
 }
 
 type AppsV1DaemonSetLister struct {
@@ -6555,6 +7220,19 @@ func (x *AppsV1DaemonSetLister) Get(namespace, name string) (*appsv1.DaemonSet,
 	return obj.(*appsv1.DaemonSet).DeepCopy(), nil
 }
 
+// ListByIndex returns the objects of which indexedValue of the index is matched.
+func (x *AppsV1DaemonSetLister) ListByIndex(indexName, indexedValue string) ([]*appsv1.DaemonSet, error) {
+	objs, err := x.indexer.ByIndex(indexName, indexedValue)
+	if err != nil {
+		return nil, err
+	}
+	ret := make([]*appsv1.DaemonSet, 0, len(objs))
+	for _, v := range objs {
+		ret = append(ret, v.(*appsv1.DaemonSet).DeepCopy())
+	}
+	return ret, nil
+}
+
 type AppsV1DeploymentLister struct {
 	indexer cache.Indexer
 }
@@ -6582,6 +7260,19 @@ func (x *AppsV1DeploymentLister) Get(namespace, name string) (*appsv1.Deployment
 	return obj.(*appsv1.Deployment).DeepCopy(), nil
 }
 
+// ListByIndex returns the objects of which indexedValue of the index is matched.
+func (x *AppsV1DeploymentLister) ListByIndex(indexName, indexedValue string) ([]*appsv1.Deployment, error) {
+	objs, err := x.indexer.ByIndex(indexName, indexedValue)
+	if err != nil {
+		return nil, err
+	}
+	ret := make([]*appsv1.Deployment, 0, len(objs))
+	for _, v := range objs {
+		ret = append(ret, v.(*appsv1.Deployment).DeepCopy())
+	}
+	return ret, nil
+}
+
 type AppsV1ReplicaSetLister struct {
 	indexer cache.Indexer
 }
@@ -6609,6 +7300,19 @@ func (x *AppsV1ReplicaSetLister) Get(namespace, name string) (*appsv1.ReplicaSet
 	return obj.(*appsv1.ReplicaSet).DeepCopy(), nil
 }
 
+// ListByIndex returns the objects of which indexedValue of the index is matched.
+func (x *AppsV1ReplicaSetLister) ListByIndex(indexName, indexedValue string) ([]*appsv1.ReplicaSet, error) {
+	objs, err := x.indexer.ByIndex(indexName, indexedValue)
+	if err != nil {
+		return nil, err
+	}
+	ret := make([]*appsv1.ReplicaSet, 0, len(objs))
+	for _, v := range objs {
+		ret = append(ret, v.(*appsv1.ReplicaSet).DeepCopy())
+	}
+	return ret, nil
+}
+
 type AppsV1StatefulSetLister struct {
 	indexer cache.Indexer
 }
@@ -6636,6 +7340,19 @@ func (x *AppsV1StatefulSetLister) Get(namespace, name string) (*appsv1.StatefulS
 	return obj.(*appsv1.StatefulSet).DeepCopy(), nil
 }
 
+// ListByIndex returns the objects of which indexedValue of the index is matched.
+func (x *AppsV1StatefulSetLister) ListByIndex(indexName, indexedValue string) ([]*appsv1.StatefulSet, error) {
+	objs, err := x.indexer.ByIndex(indexName, indexedValue)
+	if err != nil {
+		return nil, err
+	}
+	ret := make([]*appsv1.StatefulSet, 0, len(objs))
+	for _, v := range objs {
+		ret = append(ret, v.(*appsv1.StatefulSet).DeepCopy())
+	}
+	return ret, nil
+}
+
 type AuthenticationK8sIoV1SelfSubjectReviewLister struct {
 	indexer cache.Indexer
 }
@@ -6663,6 +7380,19 @@ func (x *AuthenticationK8sIoV1SelfSubjectReviewLister) Get(name string) (*authen
 	return obj.(*authenticationv1.SelfSubjectReview).DeepCopy(), nil
 }
 
+// ListByIndex returns the objects of which indexedValue of the index is matched.
+func (x *AuthenticationK8sIoV1SelfSubjectReviewLister) ListByIndex(indexName, indexedValue string) ([]*authenticationv1.SelfSubjectReview, error) {
+	objs, err := x.indexer.ByIndex(indexName, indexedValue)
+	if err != nil {
+		return nil, err
+	}
+	ret := make([]*authenticationv1.SelfSubjectReview, 0, len(objs))
+	for _, v := range objs {
+		ret = append(ret, v.(*authenticationv1.SelfSubjectReview).DeepCopy())
+	}
+	return ret, nil
+}
+
 type AuthenticationK8sIoV1TokenRequestLister struct {
 	indexer cache.Indexer
 }
@@ -6690,6 +7420,19 @@ func (x *AuthenticationK8sIoV1TokenRequestLister) Get(namespace, name string) (*
 	return obj.(*authenticationv1.TokenRequest).DeepCopy(), nil
 }
 
+// ListByIndex returns the objects of which indexedValue of the index is matched.
+func (x *AuthenticationK8sIoV1TokenRequestLister) ListByIndex(indexName, indexedValue string) ([]*authenticationv1.TokenRequest, error) {
+	objs, err := x.indexer.ByIndex(indexName, indexedValue)
+	if err != nil {
+		return nil, err
+	}
+	ret := make([]*authenticationv1.TokenRequest, 0, len(objs))
+	for _, v := range objs {
+		ret = append(ret, v.(*authenticationv1.TokenRequest).DeepCopy())
+	}
+	return ret, nil
+}
+
 type AuthenticationK8sIoV1TokenReviewLister struct {
 	indexer cache.Indexer
 }
@@ -6717,6 +7460,19 @@ func (x *AuthenticationK8sIoV1TokenReviewLister) Get(name string) (*authenticati
 	return obj.(*authenticationv1.TokenReview).DeepCopy(), nil
 }
 
+// ListByIndex returns the objects of which indexedValue of the index is matched.
+func (x *AuthenticationK8sIoV1TokenReviewLister) ListByIndex(indexName, indexedValue string) ([]*authenticationv1.TokenReview, error) {
+	objs, err := x.indexer.ByIndex(indexName, indexedValue)
+	if err != nil {
+		return nil, err
+	}
+	ret := make([]*authenticationv1.TokenReview, 0, len(objs))
+	for _, v := range objs {
+		ret = append(ret, v.(*authenticationv1.TokenReview).DeepCopy())
+	}
+	return ret, nil
+}
+
 type AuthorizationK8sIoV1LocalSubjectAccessReviewLister struct {
 	indexer cache.Indexer
 }
@@ -6744,6 +7500,19 @@ func (x *AuthorizationK8sIoV1LocalSubjectAccessReviewLister) Get(namespace, name
 	return obj.(*authorizationv1.LocalSubjectAccessReview).DeepCopy(), nil
 }
 
+// ListByIndex returns the objects of which indexedValue of the index is matched.
+func (x *AuthorizationK8sIoV1LocalSubjectAccessReviewLister) ListByIndex(indexName, indexedValue string) ([]*authorizationv1.LocalSubjectAccessReview, error) {
+	objs, err := x.indexer.ByIndex(indexName, indexedValue)
+	if err != nil {
+		return nil, err
+	}
+	ret := make([]*authorizationv1.LocalSubjectAccessReview, 0, len(objs))
+	for _, v := range objs {
+		ret = append(ret, v.(*authorizationv1.LocalSubjectAccessReview).DeepCopy())
+	}
+	return ret, nil
+}
+
 type AuthorizationK8sIoV1SelfSubjectAccessReviewLister struct {
 	indexer cache.Indexer
 }
@@ -6771,6 +7540,19 @@ func (x *AuthorizationK8sIoV1SelfSubjectAccessReviewLister) Get(name string) (*a
 	return obj.(*authorizationv1.SelfSubjectAccessReview).DeepCopy(), nil
 }
 
+// ListByIndex returns the objects of which indexedValue of the index is matched.
+func (x *AuthorizationK8sIoV1SelfSubjectAccessReviewLister) ListByIndex(indexName, indexedValue string) ([]*authorizationv1.SelfSubjectAccessReview, error) {
+	objs, err := x.indexer.ByIndex(indexName, indexedValue)
+	if err != nil {
+		return nil, err
+	}
+	ret := make([]*authorizationv1.SelfSubjectAccessReview, 0, len(objs))
+	for _, v := range objs {
+		ret = append(ret, v.(*authorizationv1.SelfSubjectAccessReview).DeepCopy())
+	}
+	return ret, nil
+}
+
 type AuthorizationK8sIoV1SelfSubjectRulesReviewLister struct {
 	indexer cache.Indexer
 }
@@ -6798,6 +7580,19 @@ func (x *AuthorizationK8sIoV1SelfSubjectRulesReviewLister) Get(name string) (*au
 	return obj.(*authorizationv1.SelfSubjectRulesReview).DeepCopy(), nil
 }
 
+// ListByIndex returns the objects of which indexedValue of the index is matched.
+func (x *AuthorizationK8sIoV1SelfSubjectRulesReviewLister) ListByIndex(indexName, indexedValue string) ([]*authorizationv1.SelfSubjectRulesReview, error) {
+	objs, err := x.indexer.ByIndex(indexName, indexedValue)
+	if err != nil {
+		return nil, err
+	}
+	ret := make([]*authorizationv1.SelfSubjectRulesReview, 0, len(objs))
+	for _, v := range objs {
+		ret = append(ret, v.(*authorizationv1.SelfSubjectRulesReview).DeepCopy())
+	}
+	return ret, nil
+}
+
 type AuthorizationK8sIoV1SubjectAccessReviewLister struct {
 	indexer cache.Indexer
 }
@@ -6825,6 +7620,19 @@ func (x *AuthorizationK8sIoV1SubjectAccessReviewLister) Get(name string) (*autho
 	return obj.(*authorizationv1.SubjectAccessReview).DeepCopy(), nil
 }
 
+// ListByIndex returns the objects of which indexedValue of the index is matched.
+func (x *AuthorizationK8sIoV1SubjectAccessReviewLister) ListByIndex(indexName, indexedValue string) ([]*authorizationv1.SubjectAccessReview, error) {
+	objs, err := x.indexer.ByIndex(indexName, indexedValue)
+	if err != nil {
+		return nil, err
+	}
+	ret := make([]*authorizationv1.SubjectAccessReview, 0, len(objs))
+	for _, v := range objs {
+		ret = append(ret, v.(*authorizationv1.SubjectAccessReview).DeepCopy())
+	}
+	return ret, nil
+}
+
 type AutoscalingV1HorizontalPodAutoscalerLister struct {
 	indexer cache.Indexer
 }
@@ -6852,6 +7660,19 @@ func (x *AutoscalingV1HorizontalPodAutoscalerLister) Get(namespace, name string)
 	return obj.(*autoscalingv1.HorizontalPodAutoscaler).DeepCopy(), nil
 }
 
+// ListByIndex returns the objects of which indexedValue of the index is matched.
+func (x *AutoscalingV1HorizontalPodAutoscalerLister) ListByIndex(indexName, indexedValue string) ([]*autoscalingv1.HorizontalPodAutoscaler, error) {
+	objs, err := x.indexer.ByIndex(indexName, indexedValue)
+	if err != nil {
+		return nil, err
+	}
+	ret := make([]*autoscalingv1.HorizontalPodAutoscaler, 0, len(objs))
+	for _, v := range objs {
+		ret = append(ret, v.(*autoscalingv1.HorizontalPodAutoscaler).DeepCopy())
+	}
+	return ret, nil
+}
+
 type AutoscalingV1ScaleLister struct {
 	indexer cache.Indexer
 }
@@ -6879,6 +7700,19 @@ func (x *AutoscalingV1ScaleLister) Get(namespace, name string) (*autoscalingv1.S
 	return obj.(*autoscalingv1.Scale).DeepCopy(), nil
 }
 
+// ListByIndex returns the objects of which indexedValue of the index is matched.
+func (x *AutoscalingV1ScaleLister) ListByIndex(indexName, indexedValue string) ([]*autoscalingv1.Scale, error) {
+	objs, err := x.indexer.ByIndex(indexName, indexedValue)
+	if err != nil {
+		return nil, err
+	}
+	ret := make([]*autoscalingv1.Scale, 0, len(objs))
+	for _, v := range objs {
+		ret = append(ret, v.(*autoscalingv1.Scale).DeepCopy())
+	}
+	return ret, nil
+}
+
 type AutoscalingV2HorizontalPodAutoscalerLister struct {
 	indexer cache.Indexer
 }
@@ -6906,6 +7740,19 @@ func (x *AutoscalingV2HorizontalPodAutoscalerLister) Get(namespace, name string)
 	return obj.(*autoscalingv2.HorizontalPodAutoscaler).DeepCopy(), nil
 }
 
+// ListByIndex returns the objects of which indexedValue of the index is matched.
+func (x *AutoscalingV2HorizontalPodAutoscalerLister) ListByIndex(indexName, indexedValue string) ([]*autoscalingv2.HorizontalPodAutoscaler, error) {
+	objs, err := x.indexer.ByIndex(indexName, indexedValue)
+	if err != nil {
+		return nil, err
+	}
+	ret := make([]*autoscalingv2.HorizontalPodAutoscaler, 0, len(objs))
+	for _, v := range objs {
+		ret = append(ret, v.(*autoscalingv2.HorizontalPodAutoscaler).DeepCopy())
+	}
+	return ret, nil
+}
+
 type BatchV1CronJobLister struct {
 	indexer cache.Indexer
 }
@@ -6933,6 +7780,19 @@ func (x *BatchV1CronJobLister) Get(namespace, name string) (*batchv1.CronJob, er
 	return obj.(*batchv1.CronJob).DeepCopy(), nil
 }
 
+// ListByIndex returns the objects of which indexedValue of the index is matched.
+func (x *BatchV1CronJobLister) ListByIndex(indexName, indexedValue string) ([]*batchv1.CronJob, error) {
+	objs, err := x.indexer.ByIndex(indexName, indexedValue)
+	if err != nil {
+		return nil, err
+	}
+	ret := make([]*batchv1.CronJob, 0, len(objs))
+	for _, v := range objs {
+		ret = append(ret, v.(*batchv1.CronJob).DeepCopy())
+	}
+	return ret, nil
+}
+
 type BatchV1JobLister struct {
 	indexer cache.Indexer
 }
@@ -6960,6 +7820,19 @@ func (x *BatchV1JobLister) Get(namespace, name string) (*batchv1.Job, error) {
 	return obj.(*batchv1.Job).DeepCopy(), nil
 }
 
+// ListByIndex returns the objects of which indexedValue of the index is matched.
+func (x *BatchV1JobLister) ListByIndex(indexName, indexedValue string) ([]*batchv1.Job, error) {
+	objs, err := x.indexer.ByIndex(indexName, indexedValue)
+	if err != nil {
+		return nil, err
+	}
+	ret := make([]*batchv1.Job, 0, len(objs))
+	for _, v := range objs {
+		ret = append(ret, v.(*batchv1.Job).DeepCopy())
+	}
+	return ret, nil
+}
+
 type CertificatesK8sIoV1CertificateSigningRequestLister struct {
 	indexer cache.Indexer
 }
@@ -6987,6 +7860,19 @@ func (x *CertificatesK8sIoV1CertificateSigningRequestLister) Get(name string) (*
 	return obj.(*certificatesv1.CertificateSigningRequest).DeepCopy(), nil
 }
 
+// ListByIndex returns the objects of which indexedValue of the index is matched.
+func (x *CertificatesK8sIoV1CertificateSigningRequestLister) ListByIndex(indexName, indexedValue string) ([]*certificatesv1.CertificateSigningRequest, error) {
+	objs, err := x.indexer.ByIndex(indexName, indexedValue)
+	if err != nil {
+		return nil, err
+	}
+	ret := make([]*certificatesv1.CertificateSigningRequest, 0, len(objs))
+	for _, v := range objs {
+		ret = append(ret, v.(*certificatesv1.CertificateSigningRequest).DeepCopy())
+	}
+	return ret, nil
+}
+
 type CoordinationK8sIoV1LeaseLister struct {
 	indexer cache.Indexer
 }
@@ -7014,6 +7900,19 @@ func (x *CoordinationK8sIoV1LeaseLister) Get(namespace, name string) (*coordinat
 	return obj.(*coordinationv1.Lease).DeepCopy(), nil
 }
 
+// ListByIndex returns the objects of which indexedValue of the index is matched.
+func (x *CoordinationK8sIoV1LeaseLister) ListByIndex(indexName, indexedValue string) ([]*coordinationv1.Lease, error) {
+	objs, err := x.indexer.ByIndex(indexName, indexedValue)
+	if err != nil {
+		return nil, err
+	}
+	ret := make([]*coordinationv1.Lease, 0, len(objs))
+	for _, v := range objs {
+		ret = append(ret, v.(*coordinationv1.Lease).DeepCopy())
+	}
+	return ret, nil
+}
+
 type DiscoveryK8sIoV1EndpointSliceLister struct {
 	indexer cache.Indexer
 }
@@ -7041,6 +7940,19 @@ func (x *DiscoveryK8sIoV1EndpointSliceLister) Get(namespace, name string) (*disc
 	return obj.(*discoveryv1.EndpointSlice).DeepCopy(), nil
 }
 
+// ListByIndex returns the objects of which indexedValue of the index is matched.
+func (x *DiscoveryK8sIoV1EndpointSliceLister) ListByIndex(indexName, indexedValue string) ([]*discoveryv1.EndpointSlice, error) {
+	objs, err := x.indexer.ByIndex(indexName, indexedValue)
+	if err != nil {
+		return nil, err
+	}
+	ret := make([]*discoveryv1.EndpointSlice, 0, len(objs))
+	for _, v := range objs {
+		ret = append(ret, v.(*discoveryv1.EndpointSlice).DeepCopy())
+	}
+	return ret, nil
+}
+
 type EventsK8sIoV1EventLister struct {
 	indexer cache.Indexer
 }
@@ -7068,6 +7980,19 @@ func (x *EventsK8sIoV1EventLister) Get(namespace, name string) (*eventsv1.Event,
 	return obj.(*eventsv1.Event).DeepCopy(), nil
 }
 
+// ListByIndex returns the objects of which indexedValue of the index is matched.
+func (x *EventsK8sIoV1EventLister) ListByIndex(indexName, indexedValue string) ([]*eventsv1.Event, error) {
+	objs, err := x.indexer.ByIndex(indexName, indexedValue)
+	if err != nil {
+		return nil, err
+	}
+	ret := make([]*eventsv1.Event, 0, len(objs))
+	for _, v := range objs {
+		ret = append(ret, v.(*eventsv1.Event).DeepCopy())
+	}
+	return ret, nil
+}
+
 type NetworkingK8sIoV1IPAddressLister struct {
 	indexer cache.Indexer
 }
@@ -7095,6 +8020,19 @@ func (x *NetworkingK8sIoV1IPAddressLister) Get(name string) (*networkingv1.IPAdd
 	return obj.(*networkingv1.IPAddress).DeepCopy(), nil
 }
 
+// ListByIndex returns the objects of which indexedValue of the index is matched.
+func (x *NetworkingK8sIoV1IPAddressLister) ListByIndex(indexName, indexedValue string) ([]*networkingv1.IPAddress, error) {
+	objs, err := x.indexer.ByIndex(indexName, indexedValue)
+	if err != nil {
+		return nil, err
+	}
+	ret := make([]*networkingv1.IPAddress, 0, len(objs))
+	for _, v := range objs {
+		ret = append(ret, v.(*networkingv1.IPAddress).DeepCopy())
+	}
+	return ret, nil
+}
+
 type NetworkingK8sIoV1IngressLister struct {
 	indexer cache.Indexer
 }
@@ -7122,6 +8060,19 @@ func (x *NetworkingK8sIoV1IngressLister) Get(namespace, name string) (*networkin
 	return obj.(*networkingv1.Ingress).DeepCopy(), nil
 }
 
+// ListByIndex returns the objects of which indexedValue of the index is matched.
+func (x *NetworkingK8sIoV1IngressLister) ListByIndex(indexName, indexedValue string) ([]*networkingv1.Ingress, error) {
+	objs, err := x.indexer.ByIndex(indexName, indexedValue)
+	if err != nil {
+		return nil, err
+	}
+	ret := make([]*networkingv1.Ingress, 0, len(objs))
+	for _, v := range objs {
+		ret = append(ret, v.(*networkingv1.Ingress).DeepCopy())
+	}
+	return ret, nil
+}
+
 type NetworkingK8sIoV1IngressClassLister struct {
 	indexer cache.Indexer
 }
@@ -7149,6 +8100,19 @@ func (x *NetworkingK8sIoV1IngressClassLister) Get(name string) (*networkingv1.In
 	return obj.(*networkingv1.IngressClass).DeepCopy(), nil
 }
 
+// ListByIndex returns the objects of which indexedValue of the index is matched.
+func (x *NetworkingK8sIoV1IngressClassLister) ListByIndex(indexName, indexedValue string) ([]*networkingv1.IngressClass, error) {
+	objs, err := x.indexer.ByIndex(indexName, indexedValue)
+	if err != nil {
+		return nil, err
+	}
+	ret := make([]*networkingv1.IngressClass, 0, len(objs))
+	for _, v := range objs {
+		ret = append(ret, v.(*networkingv1.IngressClass).DeepCopy())
+	}
+	return ret, nil
+}
+
 type NetworkingK8sIoV1NetworkPolicyLister struct {
 	indexer cache.Indexer
 }
@@ -7176,6 +8140,19 @@ func (x *NetworkingK8sIoV1NetworkPolicyLister) Get(namespace, name string) (*net
 	return obj.(*networkingv1.NetworkPolicy).DeepCopy(), nil
 }
 
+// ListByIndex returns the objects of which indexedValue of the index is matched.
+func (x *NetworkingK8sIoV1NetworkPolicyLister) ListByIndex(indexName, indexedValue string) ([]*networkingv1.NetworkPolicy, error) {
+	objs, err := x.indexer.ByIndex(indexName, indexedValue)
+	if err != nil {
+		return nil, err
+	}
+	ret := make([]*networkingv1.NetworkPolicy, 0, len(objs))
+	for _, v := range objs {
+		ret = append(ret, v.(*networkingv1.NetworkPolicy).DeepCopy())
+	}
+	return ret, nil
+}
+
 type NetworkingK8sIoV1ServiceCIDRLister struct {
 	indexer cache.Indexer
 }
@@ -7203,6 +8180,19 @@ func (x *NetworkingK8sIoV1ServiceCIDRLister) Get(name string) (*networkingv1.Ser
 	return obj.(*networkingv1.ServiceCIDR).DeepCopy(), nil
 }
 
+// ListByIndex returns the objects of which indexedValue of the index is matched.
+func (x *NetworkingK8sIoV1ServiceCIDRLister) ListByIndex(indexName, indexedValue string) ([]*networkingv1.ServiceCIDR, error) {
+	objs, err := x.indexer.ByIndex(indexName, indexedValue)
+	if err != nil {
+		return nil, err
+	}
+	ret := make([]*networkingv1.ServiceCIDR, 0, len(objs))
+	for _, v := range objs {
+		ret = append(ret, v.(*networkingv1.ServiceCIDR).DeepCopy())
+	}
+	return ret, nil
+}
+
 type PolicyV1EvictionLister struct {
 	indexer cache.Indexer
 }
@@ -7230,6 +8220,19 @@ func (x *PolicyV1EvictionLister) Get(namespace, name string) (*policyv1.Eviction
 	return obj.(*policyv1.Eviction).DeepCopy(), nil
 }
 
+// ListByIndex returns the objects of which indexedValue of the index is matched.
+func (x *PolicyV1EvictionLister) ListByIndex(indexName, indexedValue string) ([]*policyv1.Eviction, error) {
+	objs, err := x.indexer.ByIndex(indexName, indexedValue)
+	if err != nil {
+		return nil, err
+	}
+	ret := make([]*policyv1.Eviction, 0, len(objs))
+	for _, v := range objs {
+		ret = append(ret, v.(*policyv1.Eviction).DeepCopy())
+	}
+	return ret, nil
+}
+
 type PolicyV1PodDisruptionBudgetLister struct {
 	indexer cache.Indexer
 }
@@ -7257,6 +8260,19 @@ func (x *PolicyV1PodDisruptionBudgetLister) Get(namespace, name string) (*policy
 	return obj.(*policyv1.PodDisruptionBudget).DeepCopy(), nil
 }
 
+// ListByIndex returns the objects of which indexedValue of the index is matched.
+func (x *PolicyV1PodDisruptionBudgetLister) ListByIndex(indexName, indexedValue string) ([]*policyv1.PodDisruptionBudget, error) {
+	objs, err := x.indexer.ByIndex(indexName, indexedValue)
+	if err != nil {
+		return nil, err
+	}
+	ret := make([]*policyv1.PodDisruptionBudget, 0, len(objs))
+	for _, v := range objs {
+		ret = append(ret, v.(*policyv1.PodDisruptionBudget).DeepCopy())
+	}
+	return ret, nil
+}
+
 type RbacAuthorizationK8sIoV1ClusterRoleLister struct {
 	indexer cache.Indexer
 }
@@ -7284,6 +8300,19 @@ func (x *RbacAuthorizationK8sIoV1ClusterRoleLister) Get(name string) (*rbacv1.Cl
 	return obj.(*rbacv1.ClusterRole).DeepCopy(), nil
 }
 
+// ListByIndex returns the objects of which indexedValue of the index is matched.
+func (x *RbacAuthorizationK8sIoV1ClusterRoleLister) ListByIndex(indexName, indexedValue string) ([]*rbacv1.ClusterRole, error) {
+	objs, err := x.indexer.ByIndex(indexName, indexedValue)
+	if err != nil {
+		return nil, err
+	}
+	ret := make([]*rbacv1.ClusterRole, 0, len(objs))
+	for _, v := range objs {
+		ret = append(ret, v.(*rbacv1.ClusterRole).DeepCopy())
+	}
+	return ret, nil
+}
+
 type RbacAuthorizationK8sIoV1ClusterRoleBindingLister struct {
 	indexer cache.Indexer
 }
@@ -7311,6 +8340,19 @@ func (x *RbacAuthorizationK8sIoV1ClusterRoleBindingLister) Get(name string) (*rb
 	return obj.(*rbacv1.ClusterRoleBinding).DeepCopy(), nil
 }
 
+// ListByIndex returns the objects of which indexedValue of the index is matched.
+func (x *RbacAuthorizationK8sIoV1ClusterRoleBindingLister) ListByIndex(indexName, indexedValue string) ([]*rbacv1.ClusterRoleBinding, error) {
+	objs, err := x.indexer.ByIndex(indexName, indexedValue)
+	if err != nil {
+		return nil, err
+	}
+	ret := make([]*rbacv1.ClusterRoleBinding, 0, len(objs))
+	for _, v := range objs {
+		ret = append(ret, v.(*rbacv1.ClusterRoleBinding).DeepCopy())
+	}
+	return ret, nil
+}
+
 type RbacAuthorizationK8sIoV1RoleLister struct {
 	indexer cache.Indexer
 }
@@ -7338,6 +8380,19 @@ func (x *RbacAuthorizationK8sIoV1RoleLister) Get(namespace, name string) (*rbacv
 	return obj.(*rbacv1.Role).DeepCopy(), nil
 }
 
+// ListByIndex returns the objects of which indexedValue of the index is matched.
+func (x *RbacAuthorizationK8sIoV1RoleLister) ListByIndex(indexName, indexedValue string) ([]*rbacv1.Role, error) {
+	objs, err := x.indexer.ByIndex(indexName, indexedValue)
+	if err != nil {
+		return nil, err
+	}
+	ret := make([]*rbacv1.Role, 0, len(objs))
+	for _, v := range objs {
+		ret = append(ret, v.(*rbacv1.Role).DeepCopy())
+	}
+	return ret, nil
+}
+
 type RbacAuthorizationK8sIoV1RoleBindingLister struct {
 	indexer cache.Indexer
 }
@@ -7365,6 +8420,19 @@ func (x *RbacAuthorizationK8sIoV1RoleBindingLister) Get(namespace, name string)
 	return obj.(*rbacv1.RoleBinding).DeepCopy(), nil
 }
 
+// ListByIndex returns the objects of which indexedValue of the index is matched.
+func (x *RbacAuthorizationK8sIoV1RoleBindingLister) ListByIndex(indexName, indexedValue string) ([]*rbacv1.RoleBinding, error) {
+	objs, err := x.indexer.ByIndex(indexName, indexedValue)
+	if err != nil {
+		return nil, err
+	}
+	ret := make([]*rbacv1.RoleBinding, 0, len(objs))
+	for _, v := range objs {
+		ret = append(ret, v.(*rbacv1.RoleBinding).DeepCopy())
+	}
+	return ret, nil
+}
+
 type ResourceV1DeviceClassLister struct {
 	indexer cache.Indexer
 }
@@ -7392,6 +8460,19 @@ func (x *ResourceV1DeviceClassLister) Get(name string) (*resourcev1.DeviceClass,
 	return obj.(*resourcev1.DeviceClass).DeepCopy(), nil
 }
 
+// ListByIndex returns the objects of which indexedValue of the index is matched.
+func (x *ResourceV1DeviceClassLister) ListByIndex(indexName, indexedValue string) ([]*resourcev1.DeviceClass, error) {
+	objs, err := x.indexer.ByIndex(indexName, indexedValue)
+	if err != nil {
+		return nil, err
+	}
+	ret := make([]*resourcev1.DeviceClass, 0, len(objs))
+	for _, v := range objs {
+		ret = append(ret, v.(*resourcev1.DeviceClass).DeepCopy())
+	}
+	return ret, nil
+}
+
 type ResourceV1ResourceClaimLister struct {
 	indexer cache.Indexer
 }
@@ -7419,6 +8500,19 @@ func (x *ResourceV1ResourceClaimLister) Get(namespace, name string) (*resourcev1
 	return obj.(*resourcev1.ResourceClaim).DeepCopy(), nil
 }
 
+// ListByIndex returns the objects of which indexedValue of the index is matched.
+func (x *ResourceV1ResourceClaimLister) ListByIndex(indexName, indexedValue string) ([]*resourcev1.ResourceClaim, error) {
+	objs, err := x.indexer.ByIndex(indexName, indexedValue)
+	if err != nil {
+		return nil, err
+	}
+	ret := make([]*resourcev1.ResourceClaim, 0, len(objs))
+	for _, v := range objs {
+		ret = append(ret, v.(*resourcev1.ResourceClaim).DeepCopy())
+	}
+	return ret, nil
+}
+
 type ResourceV1ResourceClaimTemplateLister struct {
 	indexer cache.Indexer
 }
@@ -7446,6 +8540,19 @@ func (x *ResourceV1ResourceClaimTemplateLister) Get(namespace, name string) (*re
 	return obj.(*resourcev1.ResourceClaimTemplate).DeepCopy(), nil
 }
 
+// ListByIndex returns the objects of which indexedValue of the index is matched.
+func (x *ResourceV1ResourceClaimTemplateLister) ListByIndex(indexName, indexedValue string) ([]*resourcev1.ResourceClaimTemplate, error) {
+	objs, err := x.indexer.ByIndex(indexName, indexedValue)
+	if err != nil {
+		return nil, err
+	}
+	ret := make([]*resourcev1.ResourceClaimTemplate, 0, len(objs))
+	for _, v := range objs {
+		ret = append(ret, v.(*resourcev1.ResourceClaimTemplate).DeepCopy())
+	}
+	return ret, nil
+}
+
 type ResourceV1ResourceSliceLister struct {
 	indexer cache.Indexer
 }
@@ -7473,6 +8580,19 @@ func (x *ResourceV1ResourceSliceLister) Get(name string) (*resourcev1.ResourceSl
 	return obj.(*resourcev1.ResourceSlice).DeepCopy(), nil
 }
 
+// ListByIndex returns the objects of which indexedValue of the index is matched.
+func (x *ResourceV1ResourceSliceLister) ListByIndex(indexName, indexedValue string) ([]*resourcev1.ResourceSlice, error) {
+	objs, err := x.indexer.ByIndex(indexName, indexedValue)
+	if err != nil {
+		return nil, err
+	}
+	ret := make([]*resourcev1.ResourceSlice, 0, len(objs))
+	for _, v := range objs {
+		ret = append(ret, v.(*resourcev1.ResourceSlice).DeepCopy())
+	}
+	return ret, nil
+}
+
 type SchedulingK8sIoV1PriorityClassLister struct {
 	indexer cache.Indexer
 }
@@ -7500,6 +8620,19 @@ func (x *SchedulingK8sIoV1PriorityClassLister) Get(name string) (*schedulingv1.P
 	return obj.(*schedulingv1.PriorityClass).DeepCopy(), nil
 }
 
+// ListByIndex returns the objects of which indexedValue of the index is matched.
+func (x *SchedulingK8sIoV1PriorityClassLister) ListByIndex(indexName, indexedValue string) ([]*schedulingv1.PriorityClass, error) {
+	objs, err := x.indexer.ByIndex(indexName, indexedValue)
+	if err != nil {
+		return nil, err
+	}
+	ret := make([]*schedulingv1.PriorityClass, 0, len(objs))
+	for _, v := range objs {
+		ret = append(ret, v.(*schedulingv1.PriorityClass).DeepCopy())
+	}
+	return ret, nil
+}
+
 type StorageK8sIoV1CSIDriverLister struct {
 	indexer cache.Indexer
 }
@@ -7527,6 +8660,19 @@ func (x *StorageK8sIoV1CSIDriverLister) Get(name string) (*storagev1.CSIDriver,
 	return obj.(*storagev1.CSIDriver).DeepCopy(), nil
 }
 
+// ListByIndex returns the objects of which indexedValue of the index is matched.
+func (x *StorageK8sIoV1CSIDriverLister) ListByIndex(indexName, indexedValue string) ([]*storagev1.CSIDriver, error) {
+	objs, err := x.indexer.ByIndex(indexName, indexedValue)
+	if err != nil {
+		return nil, err
+	}
+	ret := make([]*storagev1.CSIDriver, 0, len(objs))
+	for _, v := range objs {
+		ret = append(ret, v.(*storagev1.CSIDriver).DeepCopy())
+	}
+	return ret, nil
+}
+
 type StorageK8sIoV1CSINodeLister struct {
 	indexer cache.Indexer
 }
@@ -7554,6 +8700,19 @@ func (x *StorageK8sIoV1CSINodeLister) Get(name string) (*storagev1.CSINode, erro
 	return obj.(*storagev1.CSINode).DeepCopy(), nil
 }
 
+// ListByIndex returns the objects of which indexedValue of the index is matched.
+func (x *StorageK8sIoV1CSINodeLister) ListByIndex(indexName, indexedValue string) ([]*storagev1.CSINode, error) {
+	objs, err := x.indexer.ByIndex(indexName, indexedValue)
+	if err != nil {
+		return nil, err
+	}
+	ret := make([]*storagev1.CSINode, 0, len(objs))
+	for _, v := range objs {
+		ret = append(ret, v.(*storagev1.CSINode).DeepCopy())
+	}
+	return ret, nil
+}
+
 type StorageK8sIoV1CSIStorageCapacityLister struct {
 	indexer cache.Indexer
 }
@@ -7581,6 +8740,19 @@ func (x *StorageK8sIoV1CSIStorageCapacityLister) Get(namespace, name string) (*s
 	return obj.(*storagev1.CSIStorageCapacity).DeepCopy(), nil
 }
 
+// ListByIndex returns the objects of which indexedValue of the index is matched.
+func (x *StorageK8sIoV1CSIStorageCapacityLister) ListByIndex(indexName, indexedValue string) ([]*storagev1.CSIStorageCapacity, error) {
+	objs, err := x.indexer.ByIndex(indexName, indexedValue)
+	if err != nil {
+		return nil, err
+	}
+	ret := make([]*storagev1.CSIStorageCapacity, 0, len(objs))
+	for _, v := range objs {
+		ret = append(ret, v.(*storagev1.CSIStorageCapacity).DeepCopy())
+	}
+	return ret, nil
+}
+
 type StorageK8sIoV1StorageClassLister struct {
 	indexer cache.Indexer
 }
@@ -7608,6 +8780,19 @@ func (x *StorageK8sIoV1StorageClassLister) Get(name string) (*storagev1.StorageC
 	return obj.(*storagev1.StorageClass).DeepCopy(), nil
 }
 
+// ListByIndex returns the objects of which indexedValue of the index is matched.
+func (x *StorageK8sIoV1StorageClassLister) ListByIndex(indexName, indexedValue string) ([]*storagev1.StorageClass, error) {
+	objs, err := x.indexer.ByIndex(indexName, indexedValue)
+	if err != nil {
+		return nil, err
+	}
+	ret := make([]*storagev1.StorageClass, 0, len(objs))
+	for _, v := range objs {
+		ret = append(ret, v.(*storagev1.StorageClass).DeepCopy())
+	}
+	return ret, nil
+}
+
 type StorageK8sIoV1VolumeAttachmentLister struct {
 	indexer cache.Indexer
 }
@@ -7635,6 +8820,19 @@ func (x *StorageK8sIoV1VolumeAttachmentLister) Get(name string) (*storagev1.Volu
 	return obj.(*storagev1.VolumeAttachment).DeepCopy(), nil
 }
 
+// ListByIndex returns the objects of which indexedValue of the index is matched.
+func (x *StorageK8sIoV1VolumeAttachmentLister) ListByIndex(indexName, indexedValue string) ([]*storagev1.VolumeAttachment, error) {
+	objs, err := x.indexer.ByIndex(indexName, indexedValue)
+	if err != nil {
+		return nil, err
+	}
+	ret := make([]*storagev1.VolumeAttachment, 0, len(objs))
+	for _, v := range objs {
+		ret = append(ret, v.(*storagev1.VolumeAttachment).DeepCopy())
+	}
+	return ret, nil
+}
+
 type StorageK8sIoV1VolumeAttributesClassLister struct {
 	indexer cache.Indexer
 }
@@ -7661,3 +8859,16 @@ func (x *StorageK8sIoV1VolumeAttributesClassLister) Get(name string) (*storagev1
 	}
 	return obj.(*storagev1.VolumeAttributesClass).DeepCopy(), nil
 }
+
+// ListByIndex returns the objects of which indexedValue of the index is matched.
+func (x *StorageK8sIoV1VolumeAttributesClassLister) ListByIndex(indexName, indexedValue string) ([]*storagev1.VolumeAttributesClass, error) {
+	objs, err := x.indexer.ByIndex(indexName, indexedValue)
+	if err != nil {
+		return nil, err
+	}
+	ret := make([]*storagev1.VolumeAttributesClass, 0, len(objs))
+	for _, v := range objs {
+		ret = append(ret, v.(*storagev1.VolumeAttributesClass).DeepCopy())
+	}
+	return ret, nil
+}
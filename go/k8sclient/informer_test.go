@@ -0,0 +1,93 @@
+package k8sclient
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+	"testing"
+	"time"
+
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/cache"
+
+	"go.f110.dev/kubeproto/go/apis/corev1"
+	"go.f110.dev/kubeproto/go/apis/metav1"
+	"go.f110.dev/kubeproto/go/internal/assertion"
+)
+
+type fakeWatchListServer struct {
+	mu           sync.Mutex
+	listRequests int
+	watchQuery   url.Values
+	watchClosed  chan struct{}
+}
+
+func (s *fakeWatchListServer) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	if req.URL.Query().Get("watch") != "true" {
+		s.mu.Lock()
+		s.listRequests++
+		s.mu.Unlock()
+		fmt.Fprint(w, `{"kind":"PodList","apiVersion":"v1","metadata":{"resourceVersion":"11"},"items":[]}`)
+		return
+	}
+
+	s.mu.Lock()
+	if s.watchQuery != nil {
+		// The informer is already synced. Keep the subsequent watch open until the context is canceled.
+		s.mu.Unlock()
+		<-req.Context().Done()
+		return
+	}
+	s.watchQuery = req.URL.Query()
+	s.mu.Unlock()
+
+	fmt.Fprintln(w, `{"type":"ADDED","object":{"kind":"Pod","apiVersion":"v1","metadata":{"name":"test-1","namespace":"default","resourceVersion":"10"}}}`)
+	fmt.Fprintln(w, `{"type":"ADDED","object":{"kind":"Pod","apiVersion":"v1","metadata":{"name":"test-2","namespace":"default","resourceVersion":"11"}}}`)
+	fmt.Fprintln(w, `{"type":"BOOKMARK","object":{"kind":"Pod","apiVersion":"v1","metadata":{"resourceVersion":"11","annotations":{"k8s.io/initial-events-end":"true"}}}}`)
+	w.(http.Flusher).Flush()
+
+	<-req.Context().Done()
+	close(s.watchClosed)
+}
+
+func TestInformerFactory_WatchList(t *testing.T) {
+	handler := &fakeWatchListServer{watchClosed: make(chan struct{})}
+	s := httptest.NewServer(handler)
+	defer s.Close()
+
+	set, err := NewSet(&rest.Config{Host: s.URL})
+	assertion.MustNoError(t, err)
+	factory := NewInformerFactory(set, NewInformerCache(), metav1.NamespaceAll, 30*time.Second)
+	informer := factory.InformerFor(&corev1.Pod{})
+
+	ctx, cancel := context.WithCancel(t.Context())
+	defer cancel()
+	factory.Run(ctx)
+	syncCtx, syncCancel := context.WithTimeout(ctx, 5*time.Second)
+	defer syncCancel()
+	assertion.Equal(t, true, cache.WaitForCacheSync(syncCtx.Done(), informer.HasSynced))
+
+	// The initial items are received by the streaming list instead of LIST.
+	pods, err := NewCoreV1PodLister(informer.GetIndexer()).List(metav1.NamespaceDefault, labels.Everything())
+	assertion.MustNoError(t, err)
+	assertion.Len(t, pods, 2)
+	handler.mu.Lock()
+	assertion.Equal(t, 0, handler.listRequests)
+	assertion.Equal(t, "true", handler.watchQuery.Get("sendInitialEvents"))
+	assertion.Equal(t, "true", handler.watchQuery.Get("allowWatchBookmarks"))
+	assertion.Equal(t, "NotOlderThan", handler.watchQuery.Get("resourceVersionMatch"))
+	handler.mu.Unlock()
+
+	// The watch is stopped by the context of Run.
+	cancel()
+	select {
+	case <-handler.watchClosed:
+	case <-time.After(5 * time.Second):
+		t.Fatal("the watch is not stopped")
+	}
+}
@@ -0,0 +1,230 @@
+package k8sclient
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"iter"
+	"strings"
+	"sync"
+	"time"
+
+	"k8s.io/apimachinery/pkg/watch"
+
+	"go.f110.dev/kubeproto/go/apis/corev1"
+	"go.f110.dev/kubeproto/go/apis/metav1"
+)
+
+// LogLine is the line of the log of the container.
+type LogLine struct {
+	Namespace string
+	Pod       string
+	Container string
+	// Timestamp is the time which is added by kubelet.
+	// Timestamp is zero if PodLogOptions.Timestamps is false.
+	Timestamp time.Time
+	// Line is the line without the timestamp and the line break.
+	Line string
+}
+
+// String returns the line which is prefixed with the name of the pod and the container.
+func (l LogLine) String() string {
+	if l.Pod == "" {
+		return l.Line
+	}
+	return fmt.Sprintf("[%s/%s] %s", l.Pod, l.Container, l.Line)
+}
+
+// StreamPodLogs opens the stream of the logs of a pod.
+// The caller must close the returned stream.
+func (c *CoreV1) StreamPodLogs(ctx context.Context, namespace, name string, opts *corev1.PodLogOptions) (io.ReadCloser, error) {
+	return c.backend.RESTClient().Get().
+		Namespace(namespace).
+		Resource("pods").
+		Name(name).
+		SubResource("log").
+		VersionedParams(opts, ParameterCodec).
+		Stream(ctx)
+}
+
+// PodLogLines returns the iterator of the lines of the logs of a pod.
+// If opts.Timestamps is true, the timestamp of each line is parsed into LogLine.Timestamp.
+// The iterator stops after yielding an error.
+func (c *CoreV1) PodLogLines(ctx context.Context, namespace, name string, opts *corev1.PodLogOptions) iter.Seq2[LogLine, error] {
+	if opts == nil {
+		opts = &corev1.PodLogOptions{}
+	}
+	return func(yield func(LogLine, error) bool) {
+		stream, err := c.StreamPodLogs(ctx, namespace, name, opts)
+		if err != nil {
+			yield(LogLine{}, err)
+			return
+		}
+		defer stream.Close()
+
+		r := bufio.NewReader(stream)
+		for {
+			line, err := r.ReadString('\n')
+			if len(line) > 0 {
+				l := parseLogLine(strings.TrimSuffix(line, "\n"), opts.Timestamps)
+				l.Namespace, l.Pod, l.Container = namespace, name, opts.Container
+				if !yield(l, nil) {
+					return
+				}
+			}
+			if errors.Is(err, io.EOF) {
+				return
+			}
+			if err != nil {
+				if ctx.Err() == nil {
+					yield(LogLine{}, err)
+				}
+				return
+			}
+		}
+	}
+}
+
+// FollowPodLogs follows the logs of all containers of all pods which are matched by selector.
+// The pods which are created after the call are also followed.
+// The error of each container is yielded and the iterator continues until ctx is canceled or the caller stops iterating.
+// Use LogLine.String for getting the line which is prefixed with the name of the pod and the container.
+func (c *CoreV1) FollowPodLogs(ctx context.Context, namespace, selector string, opts *corev1.PodLogOptions) iter.Seq2[LogLine, error] {
+	return func(yield func(LogLine, error) bool) {
+		var wg sync.WaitGroup
+		defer wg.Wait()
+		ctx, cancel := context.WithCancel(ctx)
+		defer cancel()
+
+		ch := make(chan logResult)
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			c.watchPodLogs(ctx, &wg, namespace, selector, opts, ch)
+		}()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case r := <-ch:
+				if !yield(r.line, r.err) {
+					return
+				}
+			}
+		}
+	}
+}
+
+type logResult struct {
+	line LogLine
+	err  error
+}
+
+func (c *CoreV1) watchPodLogs(ctx context.Context, wg *sync.WaitGroup, namespace, selector string, opts *corev1.PodLogOptions, ch chan<- logResult) {
+	send := func(r logResult) bool {
+		select {
+		case ch <- r:
+			return true
+		case <-ctx.Done():
+			return false
+		}
+	}
+
+	// following has the restart count of the container which is followed. The key is "namespace/pod/container".
+	// The container is followed again when the container is restarted.
+	following := make(map[string]int)
+	for ctx.Err() == nil {
+		w, err := c.WatchPod(ctx, namespace, metav1.ListOptions{LabelSelector: selector})
+		if err != nil {
+			if !send(logResult{err: err}) {
+				return
+			}
+			select {
+			case <-time.After(time.Second):
+			case <-ctx.Done():
+			}
+			continue
+		}
+
+		c.followPods(ctx, wg, w, following, opts, send)
+		w.Stop()
+	}
+}
+
+func (c *CoreV1) followPods(ctx context.Context, wg *sync.WaitGroup, w watch.Interface, following map[string]int, opts *corev1.PodLogOptions, send func(logResult) bool) {
+	for {
+		var ev watch.Event
+		select {
+		case e, ok := <-w.ResultChan():
+			if !ok {
+				return
+			}
+			ev = e
+		case <-ctx.Done():
+			return
+		}
+
+		pod, ok := ev.Object.(*corev1.Pod)
+		if !ok {
+			continue
+		}
+		if ev.Type == watch.Deleted {
+			for key := range following {
+				if strings.HasPrefix(key, pod.Namespace+"/"+pod.Name+"/") {
+					delete(following, key)
+				}
+			}
+			continue
+		}
+		if pod.Spec == nil || pod.Status == nil || pod.Status.Phase == corev1.PodPhasePending {
+			continue
+		}
+
+		restartCounts := make(map[string]int)
+		for _, v := range pod.Status.ContainerStatuses {
+			restartCounts[v.Name] = v.RestartCount
+		}
+		for _, container := range pod.Spec.Containers {
+			key := pod.Namespace + "/" + pod.Name + "/" + container.Name
+			if n, ok := following[key]; ok && n == restartCounts[container.Name] {
+				continue
+			}
+			following[key] = restartCounts[container.Name]
+
+			o := &corev1.PodLogOptions{}
+			if opts != nil {
+				o = opts.DeepCopy()
+			}
+			o.Container = container.Name
+			o.Follow = true
+			wg.Add(1)
+			go func(namespace, name string) {
+				defer wg.Done()
+				for l, err := range c.PodLogLines(ctx, namespace, name, o) {
+					if !send(logResult{line: l, err: err}) {
+						return
+					}
+				}
+			}(pod.Namespace, pod.Name)
+		}
+	}
+}
+
+func parseLogLine(line string, timestamps bool) LogLine {
+	if !timestamps {
+		return LogLine{Line: line}
+	}
+	ts, rest, ok := strings.Cut(line, " ")
+	if !ok {
+		// The line which is empty has only the timestamp.
+		ts = line
+	}
+	t, err := time.Parse(time.RFC3339Nano, ts)
+	if err != nil {
+		return LogLine{Line: line}
+	}
+	return LogLine{Timestamp: t, Line: rest}
+}
@@ -0,0 +1,120 @@
+package k8sclient
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"k8s.io/client-go/rest"
+
+	"go.f110.dev/kubeproto/go/apis/corev1"
+	"go.f110.dev/kubeproto/go/internal/assertion"
+)
+
+func TestParseLogLine(t *testing.T) {
+	l := parseLogLine("2024-01-02T03:04:05.123456789Z hello world", true)
+	assertion.Equal(t, "hello world", l.Line)
+	assertion.Equal(t, time.Date(2024, 1, 2, 3, 4, 5, 123456789, time.UTC), l.Timestamp)
+
+	l = parseLogLine("2024-01-02T03:04:05Z", true)
+	assertion.Equal(t, "", l.Line)
+	assertion.Equal(t, false, l.Timestamp.IsZero())
+
+	l = parseLogLine("hello world", true)
+	assertion.Equal(t, "hello world", l.Line)
+	assertion.Equal(t, true, l.Timestamp.IsZero())
+
+	l = parseLogLine("2024-01-02T03:04:05Z hello", false)
+	assertion.Equal(t, "2024-01-02T03:04:05Z hello", l.Line)
+}
+
+func TestFollowPodLogs(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /api/v1/namespaces/default/pods", func(w http.ResponseWriter, req *http.Request) {
+		if req.URL.Query().Get("watch") != "true" || req.URL.Query().Get("labelSelector") != "app=test" {
+			http.Error(w, "unexpected request", http.StatusBadRequest)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		for _, name := range []string{"test-1", "test-2"} {
+			fmt.Fprintf(w, `{"type":"ADDED","object":{"apiVersion":"v1","kind":"Pod","metadata":{"name":%q,"namespace":"default"},"spec":{"containers":[{"name":"app"}]},"status":{"phase":"Running"}}}`+"\n", name)
+		}
+		w.(http.Flusher).Flush()
+		<-req.Context().Done()
+	})
+	mux.HandleFunc("GET /api/v1/namespaces/default/pods/{name}/log", func(w http.ResponseWriter, req *http.Request) {
+		assertion.Equal(t, "app", req.URL.Query().Get("container"))
+		assertion.Equal(t, "true", req.URL.Query().Get("follow"))
+		fmt.Fprintf(w, "2024-01-02T03:04:05Z %s\n", req.PathValue("name"))
+	})
+	s := httptest.NewServer(mux)
+	defer s.Close()
+
+	set, err := NewSet(&rest.Config{Host: s.URL})
+	assertion.MustNoError(t, err)
+
+	var lines []string
+	for l, err := range set.CoreV1.FollowPodLogs(t.Context(), "default", "app=test", &corev1.PodLogOptions{Timestamps: true}) {
+		assertion.MustNoError(t, err)
+		assertion.Equal(t, false, l.Timestamp.IsZero())
+		lines = append(lines, l.String())
+		if len(lines) == 2 {
+			break
+		}
+	}
+	sort.Strings(lines)
+	assertion.Equal(t, "[test-1/app] test-1", lines[0])
+	assertion.Equal(t, "[test-2/app] test-2", lines[1])
+}
+
+func TestFollowPodLogs_RestartedContainer(t *testing.T) {
+	firstLog := make(chan struct{})
+	var logRequests atomic.Int32
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /api/v1/namespaces/default/pods", func(w http.ResponseWriter, req *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintln(w, `{"type":"ADDED","object":{"apiVersion":"v1","kind":"Pod","metadata":{"name":"test-1","namespace":"default"},"spec":{"containers":[{"name":"app"}]},"status":{"phase":"Running","containerStatuses":[{"name":"app","restartCount":0}]}}}`)
+		w.(http.Flusher).Flush()
+		select {
+		case <-firstLog:
+		case <-req.Context().Done():
+			return
+		}
+		// The container is restarted after the stream of the first container is closed.
+		fmt.Fprintln(w, `{"type":"MODIFIED","object":{"apiVersion":"v1","kind":"Pod","metadata":{"name":"test-1","namespace":"default"},"spec":{"containers":[{"name":"app"}]},"status":{"phase":"Running","containerStatuses":[{"name":"app","restartCount":1}]}}}`)
+		w.(http.Flusher).Flush()
+		<-req.Context().Done()
+	})
+	mux.HandleFunc("GET /api/v1/namespaces/default/pods/{name}/log", func(w http.ResponseWriter, req *http.Request) {
+		n := logRequests.Add(1)
+		fmt.Fprintf(w, "run-%d\n", n)
+		if n == 1 {
+			close(firstLog)
+		}
+	})
+	s := httptest.NewServer(mux)
+	defer s.Close()
+
+	set, err := NewSet(&rest.Config{Host: s.URL})
+	assertion.MustNoError(t, err)
+
+	ctx, cancel := context.WithTimeout(t.Context(), 5*time.Second)
+	defer cancel()
+	var lines []string
+	for l, err := range set.CoreV1.FollowPodLogs(ctx, "default", "app=test", nil) {
+		assertion.MustNoError(t, err)
+		lines = append(lines, l.String())
+		if len(lines) == 2 {
+			break
+		}
+	}
+	assertion.Len(t, lines, 2)
+	sort.Strings(lines)
+	assertion.Equal(t, "[test-1/app] run-1", lines[0])
+	assertion.Equal(t, "[test-1/app] run-2", lines[1])
+}
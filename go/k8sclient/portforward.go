@@ -0,0 +1,383 @@
+package k8sclient
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/tools/portforward"
+	"k8s.io/client-go/transport/spdy"
+
+	"go.f110.dev/kubeproto/go/apis/corev1"
+	"go.f110.dev/kubeproto/go/apis/metav1"
+)
+
+const defaultPortForwardTimeout = 5 * time.Second
+
+// PortForwardOptions is the options of PortForwardWithOptions and PortForwardService.
+type PortForwardOptions struct {
+	// Ports is the list of the pair of the local port and the remote port (e.g. "8080:80").
+	// The local port is the same as the remote port if the local port is omitted (e.g. "80").
+	// The local port is chosen randomly if the local port is empty (e.g. ":80").
+	Ports []string
+	// Addresses is the list of the local addresses to listen. The default is localhost.
+	Addresses []string
+	// Timeout is the duration for waiting for the forwarder to be ready. The default is 5 seconds.
+	Timeout time.Duration
+	// Out and ErrOut are the writers for the messages of the forwarder. The messages are discarded if nil.
+	Out    io.Writer
+	ErrOut io.Writer
+}
+
+func (c *CoreV1) PortForward(ctx context.Context, pod *corev1.Pod, port int) (*portforward.PortForwarder, uint16, error) {
+	pf, ports, err := c.PortForwardWithOptions(ctx, pod, PortForwardOptions{Ports: []string{fmt.Sprintf(":%d", port)}})
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return pf, ports[0].Local, nil
+}
+
+// PortForwardWithOptions forwards the local ports to the ports of the pod.
+// The forwarder is stopped when ctx is canceled.
+func (c *CoreV1) PortForwardWithOptions(ctx context.Context, pod *corev1.Pod, opts PortForwardOptions) (*portforward.PortForwarder, []portforward.ForwardedPort, error) {
+	pf, ports, _, err := c.startPortForward(ctx, pod, opts)
+	return pf, ports, err
+}
+
+// startPortForward starts the forwarder. The returned channel receives the error of the forwarder
+// and is closed when the forwarder is stopped.
+func (c *CoreV1) startPortForward(ctx context.Context, pod *corev1.Pod, opts PortForwardOptions) (*portforward.PortForwarder, []portforward.ForwardedPort, <-chan error, error) {
+	req := c.backend.RESTClient().Post().Resource("pods").Namespace(pod.Namespace).Name(pod.Name).SubResource("portforward")
+	transport, upgrader, err := spdy.RoundTripperFor(c.config)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	dialer := spdy.NewDialer(upgrader, &http.Client{Transport: transport}, http.MethodPost, req.URL())
+
+	addresses := opts.Addresses
+	if len(addresses) == 0 {
+		addresses = []string{"localhost"}
+	}
+	timeout := opts.Timeout
+	if timeout == 0 {
+		timeout = defaultPortForwardTimeout
+	}
+	readyCh := make(chan struct{})
+	pf, err := portforward.NewOnAddresses(dialer, addresses, opts.Ports, ctx.Done(), readyCh, opts.Out, opts.ErrOut)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	errCh := make(chan error, 1)
+	go func() {
+		defer close(errCh)
+
+		err := pf.ForwardPorts()
+		if err != nil {
+			errCh <- err
+		}
+	}()
+
+	select {
+	case <-readyCh:
+	case err := <-errCh:
+		if err == nil {
+			err = errors.New("port forwarder is stopped")
+		}
+		return nil, nil, nil, err
+	case <-time.After(timeout):
+		pf.Close()
+		return nil, nil, nil, errors.New("timed out")
+	}
+
+	ports, err := pf.GetPorts()
+	if err != nil {
+		pf.Close()
+		return nil, nil, nil, err
+	}
+
+	return pf, ports, errCh, nil
+}
+
+// ServicePortForwarder forwards the local ports to the pod of the Service.
+// The forward is re-established to another ready pod when the pod goes away.
+type ServicePortForwarder struct {
+	c         *CoreV1
+	namespace string
+	name      string
+	opts      PortForwardOptions
+
+	mu    sync.Mutex
+	pod   string
+	ports []portforward.ForwardedPort
+	done  chan struct{}
+}
+
+// PortForwardService forwards the local ports to the ports of the Service.
+// The remote port of opts.Ports is the port of the Service and is resolved to the targetPort of the pod.
+// The local ports are kept during re-establishing the forward. The forwarder is stopped when ctx is canceled.
+func (c *CoreV1) PortForwardService(ctx context.Context, namespace, name string, opts PortForwardOptions) (*ServicePortForwarder, error) {
+	f := &ServicePortForwarder{c: c, namespace: namespace, name: name, opts: opts, done: make(chan struct{})}
+	pod, ports, errCh, stop, err := f.forward(ctx, opts.Ports)
+	if err != nil {
+		return nil, err
+	}
+	f.setPod(pod, ports)
+
+	go f.run(ctx, pod, errCh, stop)
+	return f, nil
+}
+
+// Pod returns the name of the pod which the forwarder is connected to.
+func (f *ServicePortForwarder) Pod() string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	return f.pod
+}
+
+// Ports returns the forwarded ports. The order is the same as PortForwardOptions.Ports.
+func (f *ServicePortForwarder) Ports() []portforward.ForwardedPort {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	return f.ports
+}
+
+// Done returns the channel which is closed when the forwarder is stopped.
+func (f *ServicePortForwarder) Done() <-chan struct{} {
+	return f.done
+}
+
+func (f *ServicePortForwarder) setPod(pod *corev1.Pod, ports []portforward.ForwardedPort) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.pod = pod.Name
+	f.ports = ports
+}
+
+func (f *ServicePortForwarder) run(ctx context.Context, pod *corev1.Pod, errCh <-chan error, stop context.CancelFunc) {
+	defer close(f.done)
+
+	// The local ports are fixed for re-establishing the forward.
+	var ports []string
+	for i, v := range f.Ports() {
+		_, remote, _ := strings.Cut(f.opts.Ports[i], ":")
+		if remote == "" {
+			remote = f.opts.Ports[i]
+		}
+		ports = append(ports, fmt.Sprintf("%d:%s", v.Local, remote))
+	}
+
+	for {
+		f.waitPod(ctx, pod, errCh)
+		// Wait for releasing the local ports.
+		stop()
+		for range errCh {
+		}
+
+		for {
+			if ctx.Err() != nil {
+				return
+			}
+			p, forwardedPorts, ch, cancel, err := f.forward(ctx, ports)
+			if err == nil {
+				pod, errCh, stop = p, ch, cancel
+				f.setPod(pod, forwardedPorts)
+				break
+			}
+			if f.opts.ErrOut != nil {
+				fmt.Fprintf(f.opts.ErrOut, "failed to re-establish the forward: %v\n", err)
+			}
+			select {
+			case <-time.After(time.Second):
+			case <-ctx.Done():
+			}
+		}
+	}
+}
+
+// waitPod blocks until the pod goes away or the forwarder of the pod is stopped.
+// The watch is re-established when the watch is closed by the API server.
+func (f *ServicePortForwarder) waitPod(ctx context.Context, pod *corev1.Pod, errCh <-chan error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	for {
+		w, err := f.c.WatchPod(ctx, pod.Namespace, metav1.ListOptions{FieldSelector: "metadata.name=" + pod.Name, ResourceVersion: pod.ResourceVersion})
+		if err == nil {
+			gone := f.watchPod(ctx, w, errCh)
+			w.Stop()
+			if gone {
+				return
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-errCh:
+			return
+		case <-time.After(time.Second):
+		}
+		// The events are lost while the watch is closed. Thus the pod is retrieved again before re-establishing the watch.
+		p, err := f.c.GetPod(ctx, pod.Namespace, pod.Name, metav1.GetOptions{})
+		if err != nil {
+			if k8serrors.IsNotFound(err) {
+				return
+			}
+			continue
+		}
+		if p.UID != pod.UID || !isPodReady(p) {
+			return
+		}
+		pod = p
+	}
+}
+
+// watchPod returns true if the pod goes away or the forwarder of the pod is stopped.
+// watchPod returns false if the watch is closed.
+func (f *ServicePortForwarder) watchPod(ctx context.Context, w watch.Interface, errCh <-chan error) bool {
+	for {
+		select {
+		case <-ctx.Done():
+			return true
+		case <-errCh:
+			// The forwarder is stopped by the error.
+			return true
+		case ev, ok := <-w.ResultChan():
+			if !ok {
+				return false
+			}
+			switch ev.Type {
+			case watch.Deleted:
+				return true
+			case watch.Error:
+				// The watch can't be continued. (e.g. the resource version is too old)
+				return false
+			}
+			if p, ok := ev.Object.(*corev1.Pod); ok && !isPodReady(p) {
+				return true
+			}
+		}
+	}
+}
+
+// forward resolves the pod of the Service and starts the forwarder to the pod.
+// The forwarder is stopped when ctx is canceled or the returned function is called.
+func (f *ServicePortForwarder) forward(ctx context.Context, ports []string) (*corev1.Pod, []portforward.ForwardedPort, <-chan error, context.CancelFunc, error) {
+	svc, err := f.c.GetService(ctx, f.namespace, f.name, metav1.GetOptions{})
+	if err != nil {
+		return nil, nil, nil, nil, err
+	}
+	if svc.Spec == nil || len(svc.Spec.Selector) == 0 {
+		return nil, nil, nil, nil, fmt.Errorf("service %s/%s doesn't have the selector", f.namespace, f.name)
+	}
+	pods, err := f.c.ListPod(ctx, f.namespace, metav1.ListOptions{LabelSelector: labels.SelectorFromSet(svc.Spec.Selector).String()})
+	if err != nil {
+		return nil, nil, nil, nil, err
+	}
+	var pod *corev1.Pod
+	for i := range pods.Items {
+		if isPodReady(&pods.Items[i]) {
+			pod = &pods.Items[i]
+			break
+		}
+	}
+	if pod == nil {
+		return nil, nil, nil, nil, fmt.Errorf("service %s/%s doesn't have the ready pod", f.namespace, f.name)
+	}
+
+	podPorts, err := resolveServicePorts(svc, pod, ports)
+	if err != nil {
+		return nil, nil, nil, nil, err
+	}
+	opts := f.opts
+	opts.Ports = podPorts
+	pfCtx, cancel := context.WithCancel(ctx)
+	_, forwardedPorts, errCh, err := f.c.startPortForward(pfCtx, pod, opts)
+	if err != nil {
+		cancel()
+		return nil, nil, nil, nil, err
+	}
+	return pod, forwardedPorts, errCh, cancel, nil
+}
+
+// resolveServicePorts converts the pair of the local port and the port of the Service to the pair of the local port
+// and the port of the pod.
+func resolveServicePorts(svc *corev1.Service, pod *corev1.Pod, ports []string) ([]string, error) {
+	var result []string
+	for _, v := range ports {
+		local, remote, ok := strings.Cut(v, ":")
+		if !ok {
+			local, remote = v, v
+		}
+		port, err := strconv.Atoi(remote)
+		if err != nil {
+			return nil, fmt.Errorf("invalid port %q: %w", v, err)
+		}
+
+		var servicePort *corev1.ServicePort
+		for i := range svc.Spec.Ports {
+			if svc.Spec.Ports[i].Port == port {
+				servicePort = &svc.Spec.Ports[i]
+				break
+			}
+		}
+		if servicePort == nil {
+			return nil, fmt.Errorf("service %s/%s doesn't have the port %d", svc.Namespace, svc.Name, port)
+		}
+		targetPort, err := resolveTargetPort(servicePort, pod)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, fmt.Sprintf("%s:%d", local, targetPort))
+	}
+	return result, nil
+}
+
+func resolveTargetPort(servicePort *corev1.ServicePort, pod *corev1.Pod) (int, error) {
+	if servicePort.TargetPort == nil {
+		return servicePort.Port, nil
+	}
+	if servicePort.TargetPort.Type == intstr.Int {
+		if servicePort.TargetPort.IntVal == 0 {
+			return servicePort.Port, nil
+		}
+		return int(servicePort.TargetPort.IntVal), nil
+	}
+
+	if pod.Spec != nil {
+		for _, container := range pod.Spec.Containers {
+			for _, port := range container.Ports {
+				if port.Name == servicePort.TargetPort.StrVal {
+					return port.ContainerPort, nil
+				}
+			}
+		}
+	}
+	return 0, fmt.Errorf("pod %s/%s doesn't have the port %q", pod.Namespace, pod.Name, servicePort.TargetPort.StrVal)
+}
+
+func isPodReady(pod *corev1.Pod) bool {
+	if pod.DeletionTimestamp != nil || pod.Status == nil || pod.Status.Phase != corev1.PodPhaseRunning {
+		return false
+	}
+	for _, v := range pod.Status.Conditions {
+		if v.Type == corev1.PodConditionTypeReady {
+			return v.Status == corev1.ConditionStatusTrue
+		}
+	}
+	return false
+}
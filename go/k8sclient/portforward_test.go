@@ -0,0 +1,224 @@
+package k8sclient
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"k8s.io/apimachinery/pkg/util/httpstream"
+	"k8s.io/apimachinery/pkg/util/httpstream/spdy"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/portforward"
+
+	"go.f110.dev/kubeproto/go/apis/corev1"
+	"go.f110.dev/kubeproto/go/apis/metav1"
+	"go.f110.dev/kubeproto/go/internal/assertion"
+)
+
+func TestResolveServicePorts(t *testing.T) {
+	httpPort := intstr.FromString("http")
+	metricsPort := intstr.FromInt32(9090)
+	svc := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: metav1.NamespaceDefault},
+		Spec: &corev1.ServiceSpec{
+			Ports: []corev1.ServicePort{
+				{Name: "http", Port: 80, TargetPort: &httpPort},
+				{Name: "metrics", Port: 9000, TargetPort: &metricsPort},
+				{Name: "grpc", Port: 50051},
+			},
+		},
+	}
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-1", Namespace: metav1.NamespaceDefault},
+		Spec: &corev1.PodSpec{
+			Containers: []corev1.Container{
+				{Name: "app", Ports: []corev1.ContainerPort{{Name: "http", ContainerPort: 8080}}},
+			},
+		},
+	}
+
+	ports, err := resolveServicePorts(svc, pod, []string{"8000:80", ":9000", "50051"})
+	assertion.MustNoError(t, err)
+	assertion.Equal(t, "8000:8080 :9090 50051:50051", strings.Join(ports, " "))
+
+	_, err = resolveServicePorts(svc, pod, []string{"443"})
+	assertion.Equal(t, true, err != nil)
+
+	pod.Spec.Containers[0].Ports = nil
+	_, err = resolveServicePorts(svc, pod, []string{"80"})
+	assertion.Equal(t, true, err != nil)
+}
+
+func TestIsPodReady(t *testing.T) {
+	pod := &corev1.Pod{
+		Status: &corev1.PodStatus{
+			Phase:      corev1.PodPhaseRunning,
+			Conditions: []corev1.PodCondition{{Type: corev1.PodConditionTypeReady, Status: corev1.ConditionStatusTrue}},
+		},
+	}
+	assertion.Equal(t, true, isPodReady(pod))
+
+	pod.Status.Conditions[0].Status = corev1.ConditionStatusFalse
+	assertion.Equal(t, false, isPodReady(pod))
+
+	pod.Status.Conditions[0].Status = corev1.ConditionStatusTrue
+	pod.DeletionTimestamp = &metav1.Time{}
+	assertion.Equal(t, false, isPodReady(pod))
+}
+
+type fakePortForwardServer struct {
+	*httptest.Server
+
+	mu      sync.Mutex
+	deleted bool
+	watches int
+	// deleteCh is closed when the first pod is deleted.
+	deleteCh chan struct{}
+}
+
+func newFakePortForwardServer(t *testing.T) *fakePortForwardServer {
+	f := &fakePortForwardServer{deleteCh: make(chan struct{})}
+	pod := func(name string) string {
+		return fmt.Sprintf(`{"kind":"Pod","apiVersion":"v1","metadata":{"name":%q,"namespace":"default","uid":%q,"resourceVersion":"1","labels":{"app":"test"}},`+
+			`"status":{"phase":"Running","conditions":[{"type":"Ready","status":"True"}]}}`, name, name)
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /api/v1/namespaces/default/services/test", func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"kind":"Service","apiVersion":"v1","metadata":{"name":"test","namespace":"default"},`+
+			`"spec":{"selector":{"app":"test"},"ports":[{"port":80,"targetPort":8080}]}}`)
+	})
+	mux.HandleFunc("GET /api/v1/namespaces/default/pods", func(w http.ResponseWriter, req *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if req.URL.Query().Get("watch") != "true" {
+			f.mu.Lock()
+			defer f.mu.Unlock()
+			if f.deleted {
+				fmt.Fprintf(w, `{"kind":"PodList","apiVersion":"v1","metadata":{},"items":[%s]}`, pod("pod-2"))
+			} else {
+				fmt.Fprintf(w, `{"kind":"PodList","apiVersion":"v1","metadata":{},"items":[%s,%s]}`, pod("pod-1"), pod("pod-2"))
+			}
+			return
+		}
+
+		f.mu.Lock()
+		f.watches++
+		watches := f.watches
+		f.mu.Unlock()
+		// The first watch is closed immediately like the timeout of the API server.
+		if watches == 1 {
+			return
+		}
+		w.(http.Flusher).Flush()
+		if req.URL.Query().Get("fieldSelector") == "metadata.name=pod-1" {
+			select {
+			case <-f.deleteCh:
+				fmt.Fprintf(w, `{"type":"DELETED","object":%s}`+"\n", pod("pod-1"))
+				w.(http.Flusher).Flush()
+			case <-req.Context().Done():
+				return
+			}
+		}
+		<-req.Context().Done()
+	})
+	mux.HandleFunc("GET /api/v1/namespaces/default/pods/{name}", func(w http.ResponseWriter, req *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, pod(req.PathValue("name")))
+	})
+	mux.HandleFunc("POST /api/v1/namespaces/default/pods/{name}/portforward", func(w http.ResponseWriter, req *http.Request) {
+		if _, err := httpstream.Handshake(req, w, []string{portforward.PortForwardProtocolV1Name}); err != nil {
+			return
+		}
+		// The data stream responds the name of the pod.
+		conn := spdy.NewResponseUpgrader().UpgradeResponse(w, req, func(stream httpstream.Stream, replySent <-chan struct{}) error {
+			go func() {
+				<-replySent
+				if stream.Headers().Get("streamType") == "data" {
+					_, _ = io.WriteString(stream, req.PathValue("name"))
+				}
+				stream.Close()
+			}()
+			return nil
+		})
+		if conn == nil {
+			return
+		}
+		defer conn.Close()
+		<-conn.CloseChan()
+	})
+	f.Server = httptest.NewServer(mux)
+	t.Cleanup(f.Close)
+	return f
+}
+
+func (f *fakePortForwardServer) deletePod() {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.deleted = true
+	close(f.deleteCh)
+}
+
+func (f *fakePortForwardServer) watchCount() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.watches
+}
+
+func readForwardedPort(t *testing.T, port uint16) string {
+	conn, err := net.Dial("tcp", fmt.Sprintf("localhost:%d", port))
+	assertion.MustNoError(t, err)
+	defer conn.Close()
+	b, err := io.ReadAll(conn)
+	assertion.MustNoError(t, err)
+	return string(b)
+}
+
+func waitFor(t *testing.T, cond func() bool) {
+	deadline := time.Now().Add(10 * time.Second)
+	for !cond() {
+		if time.Now().After(deadline) {
+			t.Fatal("timed out")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+func TestPortForwardService(t *testing.T) {
+	s := newFakePortForwardServer(t)
+	set, err := NewSet(&rest.Config{Host: s.URL})
+	assertion.MustNoError(t, err)
+
+	ctx, cancel := context.WithCancel(t.Context())
+	defer cancel()
+	f, err := set.CoreV1.PortForwardService(ctx, metav1.NamespaceDefault, "test", PortForwardOptions{Ports: []string{":80"}})
+	assertion.MustNoError(t, err)
+	assertion.Equal(t, "pod-1", f.Pod())
+	localPort := f.Ports()[0].Local
+	assertion.Equal(t, "pod-1", readForwardedPort(t, localPort))
+
+	// The watch is re-established after the first watch is closed.
+	waitFor(t, func() bool { return s.watchCount() >= 2 })
+	assertion.Equal(t, "pod-1", f.Pod())
+
+	// The forward is moved to the second pod after the first pod is deleted.
+	s.deletePod()
+	waitFor(t, func() bool { return f.Pod() == "pod-2" })
+	assertion.Equal(t, localPort, f.Ports()[0].Local)
+	assertion.Equal(t, "pod-2", readForwardedPort(t, localPort))
+
+	cancel()
+	select {
+	case <-f.Done():
+	case <-time.After(10 * time.Second):
+		t.Fatal("the forwarder is not stopped")
+	}
+}
@@ -0,0 +1,19 @@
+package k8sclient
+
+import (
+	"net/http"
+)
+
+// ProxyService returns the http.Client which sends the requests to the port of the Service
+// through the proxy sub resource of the API server. port is the name or the number of the port of the Service.
+// The host of the request URL is ignored. If the scheme of the request URL is https, the API server connects
+// to the Service with TLS.
+func (c *CoreV1) ProxyService(namespace, name, port string) *http.Client {
+	return &http.Client{Transport: c.backend.ProxyRoundTripper("services", namespace, name, port)}
+}
+
+// ProxyPod returns the http.Client which sends the requests to the port of the pod
+// through the proxy sub resource of the API server. The usage is the same as ProxyService.
+func (c *CoreV1) ProxyPod(namespace, name, port string) *http.Client {
+	return &http.Client{Transport: c.backend.ProxyRoundTripper("pods", namespace, name, port)}
+}
@@ -0,0 +1,36 @@
+package k8sclient
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"k8s.io/client-go/rest"
+
+	"go.f110.dev/kubeproto/go/internal/assertion"
+)
+
+func TestProxyService(t *testing.T) {
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		_, _ = io.WriteString(w, req.URL.Path+"?"+req.URL.RawQuery)
+	}))
+	defer s.Close()
+
+	set, err := NewSet(&rest.Config{Host: s.URL})
+	assertion.MustNoError(t, err)
+
+	res, err := set.CoreV1.ProxyService("default", "admin", "http").Get("http://admin/metrics/?format=text")
+	assertion.MustNoError(t, err)
+	body, err := io.ReadAll(res.Body)
+	assertion.MustNoError(t, err)
+	res.Body.Close()
+	assertion.Equal(t, "/api/v1/namespaces/default/services/admin:http/proxy/metrics/?format=text", string(body))
+
+	res, err = set.CoreV1.ProxyPod("default", "test-1", "8443").Get("https://test-1/healthz")
+	assertion.MustNoError(t, err)
+	body, err = io.ReadAll(res.Body)
+	assertion.MustNoError(t, err)
+	res.Body.Close()
+	assertion.Equal(t, "/api/v1/namespaces/default/pods/https:test-1:8443/proxy/healthz?", string(body))
+}
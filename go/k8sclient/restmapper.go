@@ -0,0 +1,192 @@
+package k8sclient
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"sync"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"go.f110.dev/kubeproto/go/apis/metav1"
+	"go.f110.dev/kubeproto/go/typedclient"
+)
+
+// APIResourceLister is the interface of DiscoveryClient and CachedDiscoveryClient.
+type APIResourceLister interface {
+	APIResourceLists(ctx context.Context) (*metav1.APIGroupList, map[schema.GroupVersion]*metav1.APIResourceList, error)
+}
+
+// DiscoveryRESTMapper is meta.RESTMapper which is built from the result of the discovery.
+// The mappings are retrieved at the first call, and retrieved again when the resource or the kind is not found.
+// The preferred version of each group and the order of the groups of the discovery are the priority of the mappings.
+type DiscoveryRESTMapper struct {
+	client APIResourceLister
+
+	mu     sync.Mutex
+	mapper *typedclient.StaticRESTMapper
+}
+
+var _ meta.ResettableRESTMapper = &DiscoveryRESTMapper{}
+
+// NewDiscoveryRESTMapper returns DiscoveryRESTMapper.
+// If client has Invalidate method (e.g. CachedDiscoveryClient), the cache is invalidated before retrieving the mappings again.
+func NewDiscoveryRESTMapper(client APIResourceLister) *DiscoveryRESTMapper {
+	return &DiscoveryRESTMapper{client: client}
+}
+
+// Reset drops the mappings. The mappings are retrieved at the next call.
+func (m *DiscoveryRESTMapper) Reset() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.mapper = nil
+}
+
+func (m *DiscoveryRESTMapper) KindFor(resource schema.GroupVersionResource) (schema.GroupVersionKind, error) {
+	var v schema.GroupVersionKind
+	err := m.do(func(mapper *typedclient.StaticRESTMapper) (err error) {
+		v, err = mapper.KindFor(resource)
+		return err
+	})
+	return v, err
+}
+
+func (m *DiscoveryRESTMapper) KindsFor(resource schema.GroupVersionResource) ([]schema.GroupVersionKind, error) {
+	var v []schema.GroupVersionKind
+	err := m.do(func(mapper *typedclient.StaticRESTMapper) (err error) {
+		v, err = mapper.KindsFor(resource)
+		return err
+	})
+	return v, err
+}
+
+func (m *DiscoveryRESTMapper) ResourceFor(input schema.GroupVersionResource) (schema.GroupVersionResource, error) {
+	var v schema.GroupVersionResource
+	err := m.do(func(mapper *typedclient.StaticRESTMapper) (err error) {
+		v, err = mapper.ResourceFor(input)
+		return err
+	})
+	return v, err
+}
+
+func (m *DiscoveryRESTMapper) ResourcesFor(input schema.GroupVersionResource) ([]schema.GroupVersionResource, error) {
+	var v []schema.GroupVersionResource
+	err := m.do(func(mapper *typedclient.StaticRESTMapper) (err error) {
+		v, err = mapper.ResourcesFor(input)
+		return err
+	})
+	return v, err
+}
+
+func (m *DiscoveryRESTMapper) RESTMapping(gk schema.GroupKind, versions ...string) (*meta.RESTMapping, error) {
+	var v *meta.RESTMapping
+	err := m.do(func(mapper *typedclient.StaticRESTMapper) (err error) {
+		v, err = mapper.RESTMapping(gk, versions...)
+		return err
+	})
+	return v, err
+}
+
+func (m *DiscoveryRESTMapper) RESTMappings(gk schema.GroupKind, versions ...string) ([]*meta.RESTMapping, error) {
+	var v []*meta.RESTMapping
+	err := m.do(func(mapper *typedclient.StaticRESTMapper) (err error) {
+		v, err = mapper.RESTMappings(gk, versions...)
+		return err
+	})
+	return v, err
+}
+
+func (m *DiscoveryRESTMapper) ResourceSingularizer(resource string) (string, error) {
+	var v string
+	err := m.do(func(mapper *typedclient.StaticRESTMapper) (err error) {
+		v, err = mapper.ResourceSingularizer(resource)
+		return err
+	})
+	return v, err
+}
+
+// do calls fn with the mapper. If fn returns the error of no match, the mappings are retrieved again and fn is called
+// once more.
+func (m *DiscoveryRESTMapper) do(fn func(mapper *typedclient.StaticRESTMapper) error) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	refreshed := false
+	if m.mapper == nil {
+		if err := m.refresh(false); err != nil {
+			return err
+		}
+		refreshed = true
+	}
+	err := fn(m.mapper)
+	if err == nil || refreshed || !meta.IsNoMatchError(err) {
+		return err
+	}
+
+	if err := m.refresh(true); err != nil {
+		return err
+	}
+	return fn(m.mapper)
+}
+
+func (m *DiscoveryRESTMapper) refresh(invalidate bool) error {
+	if v, ok := m.client.(interface{ Invalidate() error }); ok && invalidate {
+		if err := v.Invalidate(); err != nil {
+			return err
+		}
+	}
+
+	ctx := context.TODO()
+	groups, resources, err := m.client.APIResourceLists(ctx)
+	var discoveryErr *ErrGroupDiscoveryFailed
+	if err != nil && !errors.As(err, &discoveryErr) {
+		return err
+	}
+	// The mappings of the group versions which are failed are ignored.
+	m.mapper = typedclient.NewStaticRESTMapper(resourceMappings(groups, resources)...)
+	return nil
+}
+
+// resourceMappings converts the result of the discovery to the mappings in order of the priority.
+func resourceMappings(groups *metav1.APIGroupList, resources map[schema.GroupVersion]*metav1.APIResourceList) []typedclient.ResourceMapping {
+	var mappings []typedclient.ResourceMapping
+	for _, group := range groups.Groups {
+		var versions []string
+		if group.PreferredVersion != nil {
+			versions = append(versions, group.PreferredVersion.Version)
+		}
+		for _, v := range group.Versions {
+			if group.PreferredVersion == nil || v.Version != group.PreferredVersion.Version {
+				versions = append(versions, v.Version)
+			}
+		}
+
+		for _, version := range versions {
+			gv := schema.GroupVersion{Group: group.Name, Version: version}
+			list, ok := resources[gv]
+			if !ok {
+				continue
+			}
+			for _, r := range list.APIResources {
+				// Skip the sub resources.
+				if strings.Contains(r.Name, "/") {
+					continue
+				}
+				singular := r.SingularName
+				if singular == "" {
+					singular = strings.ToLower(r.Kind)
+				}
+				mappings = append(mappings, typedclient.ResourceMapping{
+					GroupVersionKind: gv.WithKind(r.Kind),
+					Resource:         r.Name,
+					SingularResource: singular,
+					ShortNames:       r.ShortNames,
+					Namespaced:       r.Namespaced,
+				})
+			}
+		}
+	}
+	return mappings
+}
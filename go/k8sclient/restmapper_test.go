@@ -0,0 +1,129 @@
+package k8sclient
+
+import (
+	"context"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"go.f110.dev/kubeproto/go/apis/metav1"
+	"go.f110.dev/kubeproto/go/internal/assertion"
+)
+
+func TestNewRESTMapper(t *testing.T) {
+	m := NewRESTMapper()
+
+	gvr, err := m.ResourceFor(schema.GroupVersionResource{Resource: "deploy"})
+	assertion.MustNoError(t, err)
+	assertion.Equal(t, schema.GroupVersionResource{Group: "apps", Version: "v1", Resource: "deployments"}, gvr)
+
+	// The core group is preferred.
+	gvk, err := m.KindFor(schema.GroupVersionResource{Resource: "events"})
+	assertion.MustNoError(t, err)
+	assertion.Equal(t, schema.GroupVersionKind{Version: "v1", Kind: "Event"}, gvk)
+
+	// The newer version is preferred.
+	mapping, err := m.RESTMapping(schema.GroupKind{Group: "autoscaling", Kind: "HorizontalPodAutoscaler"})
+	assertion.MustNoError(t, err)
+	assertion.Equal(t, "v2", mapping.Resource.Version)
+	mapping, err = m.RESTMapping(schema.GroupKind{Group: "autoscaling", Kind: "HorizontalPodAutoscaler"}, "v1")
+	assertion.MustNoError(t, err)
+	assertion.Equal(t, "v1", mapping.Resource.Version)
+
+	mapping, err = m.RESTMapping(schema.GroupKind{Kind: "Namespace"})
+	assertion.MustNoError(t, err)
+	assertion.Equal(t, meta.RESTScopeNameRoot, mapping.Scope.Name())
+	assertion.Equal(t, "namespaces", mapping.Resource.Resource)
+
+	singular, err := m.ResourceSingularizer("pods")
+	assertion.MustNoError(t, err)
+	assertion.Equal(t, "pod", singular)
+
+	_, err = m.KindFor(schema.GroupVersionResource{Resource: "unknown"})
+	assertion.Equal(t, true, meta.IsNoMatchError(err))
+
+	// The kinds which are not served as the resource are not mapped.
+	for _, gk := range []schema.GroupKind{
+		{Group: "autoscaling", Kind: "Scale"},
+		{Group: "policy", Kind: "Eviction"},
+		{Group: "authentication.k8s.io", Kind: "TokenRequest"},
+		{Kind: "PodStatusResult"},
+		{Kind: "RangeAllocation"},
+	} {
+		_, err = m.RESTMapping(gk)
+		assertion.Equal(t, true, meta.IsNoMatchError(err))
+	}
+	for _, resource := range []string{"scales", "evictions", "tokenrequests", "podstatusresults", "rangeallocations"} {
+		_, err = m.KindFor(schema.GroupVersionResource{Resource: resource})
+		assertion.Equal(t, true, meta.IsNoMatchError(err))
+	}
+	mapping, err = m.RESTMapping(schema.GroupKind{Kind: "Binding"})
+	assertion.MustNoError(t, err)
+	assertion.Equal(t, "bindings", mapping.Resource.Resource)
+}
+
+type fakeAPIResourceLister struct {
+	groups      *metav1.APIGroupList
+	resources   map[schema.GroupVersion]*metav1.APIResourceList
+	calls       int
+	invalidated int
+}
+
+func (f *fakeAPIResourceLister) APIResourceLists(_ context.Context) (*metav1.APIGroupList, map[schema.GroupVersion]*metav1.APIResourceList, error) {
+	f.calls++
+	return f.groups, f.resources, nil
+}
+
+func (f *fakeAPIResourceLister) Invalidate() error {
+	f.invalidated++
+	return nil
+}
+
+func (f *fakeAPIResourceLister) addGroup(name string, versions []string, resources ...metav1.APIResource) {
+	group := metav1.APIGroup{Name: name}
+	for _, v := range versions {
+		gv := schema.GroupVersion{Group: name, Version: v}
+		group.Versions = append(group.Versions, metav1.GroupVersionForDiscovery{GroupVersion: gv.String(), Version: v})
+		f.resources[gv] = &metav1.APIResourceList{GroupVersion: gv.String(), APIResources: resources}
+	}
+	group.PreferredVersion = &group.Versions[len(group.Versions)-1]
+	f.groups.Groups = append(f.groups.Groups, group)
+}
+
+func TestDiscoveryRESTMapper(t *testing.T) {
+	lister := &fakeAPIResourceLister{groups: &metav1.APIGroupList{}, resources: make(map[schema.GroupVersion]*metav1.APIResourceList)}
+	lister.addGroup("example.com", []string{"v1alpha1", "v1"},
+		metav1.APIResource{Name: "widgets", SingularName: "widget", Kind: "Widget", Namespaced: true, ShortNames: []string{"wg"}},
+		metav1.APIResource{Name: "widgets/status", Kind: "Widget", Namespaced: true},
+	)
+	m := NewDiscoveryRESTMapper(lister)
+
+	// The preferred version is used.
+	gvr, err := m.ResourceFor(schema.GroupVersionResource{Resource: "wg"})
+	assertion.MustNoError(t, err)
+	assertion.Equal(t, schema.GroupVersionResource{Group: "example.com", Version: "v1", Resource: "widgets"}, gvr)
+	resources, err := m.ResourcesFor(schema.GroupVersionResource{Resource: "widgets"})
+	assertion.MustNoError(t, err)
+	assertion.Len(t, resources, 2)
+	assertion.Equal(t, 1, lister.calls)
+
+	// The mappings are retrieved again when the kind is not found.
+	lister.addGroup("example.org", []string{"v1"}, metav1.APIResource{Name: "gadgets", SingularName: "gadget", Kind: "Gadget"})
+	mapping, err := m.RESTMapping(schema.GroupKind{Group: "example.org", Kind: "Gadget"})
+	assertion.MustNoError(t, err)
+	assertion.Equal(t, "gadgets", mapping.Resource.Resource)
+	assertion.Equal(t, meta.RESTScopeNameRoot, mapping.Scope.Name())
+	assertion.Equal(t, 2, lister.calls)
+	assertion.Equal(t, 1, lister.invalidated)
+
+	_, err = m.KindFor(schema.GroupVersionResource{Resource: "unknown"})
+	assertion.Equal(t, true, meta.IsNoMatchError(err))
+	assertion.Equal(t, 3, lister.calls)
+
+	m.Reset()
+	_, err = m.KindFor(schema.GroupVersionResource{Resource: "gadget"})
+	assertion.MustNoError(t, err)
+	assertion.Equal(t, 4, lister.calls)
+	assertion.Equal(t, 2, lister.invalidated)
+}
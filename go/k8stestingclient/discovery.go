@@ -19,10 +19,13 @@ func (d *DiscoveryClient) APIResourceLists(_ context.Context) (*metav1.APIGroupL
 	var groups metav1.APIGroupList
 	resources := make(map[schema.GroupVersion]*metav1.APIResourceList)
 	for _, v := range d.Resources {
-		gv := v.GroupVersionKind().GroupVersion()
+		gv, err := schema.ParseGroupVersion(v.GroupVersion)
+		if err != nil {
+			return nil, nil, err
+		}
 		resources[gv] = v
 
-		var g metav1.APIGroup
+		g := metav1.APIGroup{Name: gv.Group}
 		g.Versions = []metav1.GroupVersionForDiscovery{{GroupVersion: gv.String(), Version: gv.Version}}
 		groups.Groups = append(groups.Groups, g)
 	}
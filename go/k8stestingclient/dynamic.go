@@ -0,0 +1,289 @@
+package k8stestingclient
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	k8smetav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	utiljson "k8s.io/apimachinery/pkg/util/json"
+	"k8s.io/apimachinery/pkg/watch"
+	k8stesting "k8s.io/client-go/testing"
+
+	"go.f110.dev/kubeproto/go/apis/metav1"
+	"go.f110.dev/kubeproto/go/k8sclient"
+)
+
+// DynamicClient returns k8sclient.DynamicClient which shares the tracker and the actions of the Set.
+// The objects of the kinds which are known to the Set are stored as the typed objects,
+// so the objects are visible from both the typed clients and the dynamic client.
+// kinds is the kind of each resource which is unknown to the Set (e.g. the custom resource).
+func (s *Set) DynamicClient(kinds map[schema.GroupVersionResource]string) *k8sclient.DynamicClient {
+	b := &dynamicBackend{fake: &s.fake, tracker: s.tracker, scheme: s.scheme, kinds: make(map[schema.GroupVersionResource]string)}
+	for gvr, kind := range resourceKinds {
+		b.kinds[gvr] = kind
+	}
+	for gvr, kind := range kinds {
+		b.kinds[gvr] = kind
+		gvk := gvr.GroupVersion().WithKind(kind)
+		if !s.scheme.Recognizes(gvk) {
+			s.scheme.AddKnownTypeWithName(gvk, &unstructured.Unstructured{})
+			s.scheme.AddKnownTypeWithName(gvr.GroupVersion().WithKind(kind+"List"), &unstructured.UnstructuredList{})
+			s.fake.PrependReactor("patch", gvr.Resource, applyUnstructuredReaction(s.tracker, gvr))
+		}
+	}
+	return k8sclient.NewDynamicClientWithBackend(b)
+}
+
+type dynamicBackend struct {
+	fake    *k8stesting.Fake
+	tracker k8stesting.ObjectTracker
+	scheme  *runtime.Scheme
+	kinds   map[schema.GroupVersionResource]string
+}
+
+var _ k8sclient.DynamicBackend = &dynamicBackend{}
+
+func (d *dynamicBackend) Get(_ context.Context, gvr schema.GroupVersionResource, namespace, name string, _ metav1.GetOptions, subresources ...string) (*unstructured.Unstructured, error) {
+	var action k8stesting.Action = k8stesting.NewGetAction(gvr, namespace, name)
+	if len(subresources) > 0 {
+		action = k8stesting.NewGetSubresourceAction(gvr, namespace, subresources[0], name)
+	}
+	obj, err := d.fake.Invokes(action, nil)
+	if obj == nil {
+		return nil, err
+	}
+	return d.toUnstructured(gvr, obj)
+}
+
+func (d *dynamicBackend) List(_ context.Context, gvr schema.GroupVersionResource, namespace string, opts metav1.ListOptions) (*unstructured.UnstructuredList, error) {
+	kind, ok := d.kinds[gvr]
+	if !ok {
+		return nil, fmt.Errorf("unknown resource: %s", gvr.String())
+	}
+	k8sListOpt := k8smetav1.ListOptions{LabelSelector: opts.LabelSelector, FieldSelector: opts.FieldSelector, ResourceVersion: opts.ResourceVersion}
+	obj, err := d.fake.Invokes(k8stesting.NewListAction(gvr, gvr.GroupVersion().WithKind(kind), namespace, k8sListOpt), nil)
+	if obj == nil {
+		return nil, err
+	}
+
+	label, err := labels.Parse(opts.LabelSelector)
+	if err != nil {
+		return nil, err
+	}
+	items, err := meta.ExtractList(obj)
+	if err != nil {
+		return nil, err
+	}
+	list := &unstructured.UnstructuredList{Object: map[string]any{}}
+	list.SetGroupVersionKind(gvr.GroupVersion().WithKind(kind + "List"))
+	for _, item := range items {
+		u, err := d.toUnstructured(gvr, item)
+		if err != nil {
+			return nil, err
+		}
+		if !label.Matches(labels.Set(u.GetLabels())) {
+			continue
+		}
+		list.Items = append(list.Items, *u)
+	}
+	return list, nil
+}
+
+func (d *dynamicBackend) Create(_ context.Context, gvr schema.GroupVersionResource, namespace string, obj *unstructured.Unstructured, _ metav1.CreateOptions, subresources ...string) (*unstructured.Unstructured, error) {
+	typed, err := d.fromUnstructured(obj)
+	if err != nil {
+		return nil, err
+	}
+	var action k8stesting.Action = k8stesting.NewCreateAction(gvr, namespace, typed)
+	if len(subresources) > 0 {
+		action = k8stesting.NewCreateSubresourceAction(gvr, obj.GetName(), subresources[0], namespace, typed)
+	}
+	ret, err := d.fake.Invokes(action, nil)
+	if ret == nil {
+		return nil, err
+	}
+	return d.toUnstructured(gvr, ret)
+}
+
+func (d *dynamicBackend) Update(_ context.Context, gvr schema.GroupVersionResource, namespace string, obj *unstructured.Unstructured, _ metav1.UpdateOptions, subresources ...string) (*unstructured.Unstructured, error) {
+	typed, err := d.fromUnstructured(obj)
+	if err != nil {
+		return nil, err
+	}
+	var action k8stesting.Action = k8stesting.NewUpdateAction(gvr, namespace, typed)
+	if len(subresources) > 0 {
+		action = k8stesting.NewUpdateSubresourceAction(gvr, subresources[0], namespace, typed)
+	}
+	ret, err := d.fake.Invokes(action, nil)
+	if ret == nil {
+		return nil, err
+	}
+	return d.toUnstructured(gvr, ret)
+}
+
+// Patch patches the object. The apply patch creates the object if the object doesn't exist.
+// The apply patch is handled as the strategic merge patch (the JSON merge patch for unstructured.Unstructured),
+// and the field ownership is not considered.
+func (d *dynamicBackend) Patch(_ context.Context, gvr schema.GroupVersionResource, namespace, name string, pt types.PatchType, data []byte, opts metav1.PatchOptions, subresources ...string) (*unstructured.Unstructured, error) {
+	k8sPatchOpt := k8smetav1.PatchOptions{DryRun: opts.DryRun, FieldManager: opts.FieldManager}
+	if opts.Force {
+		k8sPatchOpt.Force = &opts.Force
+	}
+	action := k8stesting.NewPatchSubresourceAction(gvr, namespace, name, pt, data, subresources...)
+	action.PatchOptions = k8sPatchOpt
+	ret, err := d.fake.Invokes(action, nil)
+	if pt == types.ApplyPatchType && apierrors.IsNotFound(err) {
+		obj := &unstructured.Unstructured{}
+		if err := utiljson.Unmarshal(data, &obj.Object); err != nil {
+			return nil, err
+		}
+		obj.SetName(name)
+		obj.SetNamespace(namespace)
+		typed, err := d.fromUnstructured(obj)
+		if err != nil {
+			return nil, err
+		}
+		if err := d.tracker.Create(gvr, typed, namespace); err != nil {
+			return nil, err
+		}
+		ret, err = d.tracker.Get(gvr, namespace, name)
+	}
+	if ret == nil {
+		return nil, err
+	}
+	return d.toUnstructured(gvr, ret)
+}
+
+func (d *dynamicBackend) Delete(_ context.Context, gvr schema.GroupVersionResource, namespace, name string, _ metav1.DeleteOptions, subresources ...string) error {
+	var action k8stesting.Action = k8stesting.NewDeleteAction(gvr, namespace, name)
+	if len(subresources) > 0 {
+		action = k8stesting.NewDeleteSubresourceAction(gvr, subresources[0], namespace, name)
+	}
+	_, err := d.fake.Invokes(action, nil)
+	return err
+}
+
+func (d *dynamicBackend) Watch(_ context.Context, gvr schema.GroupVersionResource, namespace string, opts metav1.ListOptions) (watch.Interface, error) {
+	w, err := d.fake.InvokesWatch(k8stesting.NewWatchAction(gvr, namespace, opts))
+	if err != nil {
+		return nil, err
+	}
+	return watch.Filter(w, func(in watch.Event) (watch.Event, bool) {
+		if in.Type == watch.Error || in.Object == nil {
+			return in, true
+		}
+		u, err := d.toUnstructured(gvr, in.Object)
+		if err != nil {
+			return in, false
+		}
+		in.Object = u
+		return in, true
+	}), nil
+}
+
+// applyUnstructuredReaction returns the reaction of the apply patch of the resource which is stored as
+// unstructured.Unstructured. The tracker can't apply the strategic merge patch to unstructured.Unstructured,
+// so the apply patch is handled as the JSON merge patch.
+func applyUnstructuredReaction(tracker k8stesting.ObjectTracker, gvr schema.GroupVersionResource) k8stesting.ReactionFunc {
+	return func(action k8stesting.Action) (bool, runtime.Object, error) {
+		patch, ok := action.(k8stesting.PatchActionImpl)
+		if !ok || patch.GetPatchType() != types.ApplyPatchType || patch.GetResource() != gvr {
+			return false, nil, nil
+		}
+		obj, err := tracker.Get(gvr, patch.GetNamespace(), patch.GetName())
+		if err != nil {
+			return true, nil, err
+		}
+		u, ok := obj.(*unstructured.Unstructured)
+		if !ok {
+			return false, nil, nil
+		}
+		var data map[string]any
+		if err := utiljson.Unmarshal(patch.GetPatch(), &data); err != nil {
+			return true, nil, err
+		}
+		mergeObject(u.Object, data)
+		if err := tracker.Update(gvr, u, patch.GetNamespace()); err != nil {
+			return true, nil, err
+		}
+		obj, err = tracker.Get(gvr, patch.GetNamespace(), patch.GetName())
+		return true, obj, err
+	}
+}
+
+// mergeObject merges patch into dst by the rule of the JSON merge patch.
+func mergeObject(dst, patch map[string]any) {
+	for k, v := range patch {
+		if v == nil {
+			delete(dst, k)
+			continue
+		}
+		p, ok := v.(map[string]any)
+		if !ok {
+			dst[k] = v
+			continue
+		}
+		d, ok := dst[k].(map[string]any)
+		if !ok {
+			d = make(map[string]any)
+			dst[k] = d
+		}
+		mergeObject(d, p)
+	}
+}
+
+// toUnstructured converts the object in the tracker to unstructured.Unstructured.
+func (d *dynamicBackend) toUnstructured(gvr schema.GroupVersionResource, obj runtime.Object) (*unstructured.Unstructured, error) {
+	if u, ok := obj.(*unstructured.Unstructured); ok {
+		return u.DeepCopy(), nil
+	}
+
+	b, err := json.Marshal(obj)
+	if err != nil {
+		return nil, err
+	}
+	u := &unstructured.Unstructured{}
+	if err := utiljson.Unmarshal(b, &u.Object); err != nil {
+		return nil, err
+	}
+	// The typed object in the tracker doesn't have TypeMeta.
+	if u.GetKind() == "" {
+		gvks, _, err := d.scheme.ObjectKinds(obj)
+		if err != nil {
+			return nil, err
+		}
+		u.SetGroupVersionKind(gvr.GroupVersion().WithKind(gvks[0].Kind))
+	}
+	return u, nil
+}
+
+// fromUnstructured converts obj to the typed object if the kind is known to the Set.
+func (d *dynamicBackend) fromUnstructured(obj *unstructured.Unstructured) (runtime.Object, error) {
+	typed, err := d.scheme.New(obj.GroupVersionKind())
+	if err != nil {
+		if runtime.IsNotRegisteredError(err) {
+			return obj.DeepCopy(), nil
+		}
+		return nil, err
+	}
+	if _, ok := typed.(*unstructured.Unstructured); ok {
+		return obj.DeepCopy(), nil
+	}
+
+	b, err := json.Marshal(obj.Object)
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(b, typed); err != nil {
+		return nil, err
+	}
+	return typed, nil
+}
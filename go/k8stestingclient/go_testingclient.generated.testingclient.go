@@ -2,19 +2,44 @@ package k8stestingclient
 
 import (
 	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
 	"strings"
+	"sync"
 
 	"k8s.io/apimachinery/pkg/api/meta"
 	k8smetav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/runtime/serializer"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/watch"
 	"k8s.io/client-go/rest"
 	k8stesting "k8s.io/client-go/testing"
 
+	"go.f110.dev/kubeproto/go/apis/admissionregistrationv1"
+	"go.f110.dev/kubeproto/go/apis/appsv1"
+	"go.f110.dev/kubeproto/go/apis/authenticationv1"
+	"go.f110.dev/kubeproto/go/apis/authorizationv1"
+	"go.f110.dev/kubeproto/go/apis/autoscalingv1"
+	"go.f110.dev/kubeproto/go/apis/autoscalingv2"
+	"go.f110.dev/kubeproto/go/apis/batchv1"
+	"go.f110.dev/kubeproto/go/apis/certificatesv1"
+	"go.f110.dev/kubeproto/go/apis/coordinationv1"
+	"go.f110.dev/kubeproto/go/apis/corev1"
+	"go.f110.dev/kubeproto/go/apis/discoveryv1"
+	"go.f110.dev/kubeproto/go/apis/eventsv1"
 	"go.f110.dev/kubeproto/go/apis/metav1"
+	"go.f110.dev/kubeproto/go/apis/networkingv1"
+	"go.f110.dev/kubeproto/go/apis/policyv1"
+	"go.f110.dev/kubeproto/go/apis/rbacv1"
+	"go.f110.dev/kubeproto/go/apis/resourcev1"
+	"go.f110.dev/kubeproto/go/apis/schedulingv1"
+	"go.f110.dev/kubeproto/go/apis/storagev1"
 	"go.f110.dev/kubeproto/go/k8sclient"
 )
 
@@ -25,13 +50,21 @@ var (
 type Set struct {
 	k8sclient.Set
 
-	fake    k8stesting.Fake
+	fake k8stesting.Fake
+	// scheme is the scheme of the tracker. The kinds which are unknown to the client can be registered to scheme.
+	scheme  *runtime.Scheme
 	tracker k8stesting.ObjectTracker
+	proxy   *proxyHandlers
 }
 
 func NewSet() *Set {
-	s := &Set{}
-	s.tracker = k8stesting.NewObjectTracker(k8sclient.Scheme, codecs.UniversalDecoder())
+	s := &Set{proxy: &proxyHandlers{handlers: make(map[string]http.Handler)}}
+	s.scheme = runtime.NewScheme()
+	if err := k8sclient.AddToScheme(s.scheme); err != nil {
+		panic(err)
+	}
+	s.tracker = k8stesting.NewObjectTracker(s.scheme, codecs.UniversalDecoder())
+	s.fake.AddReactor("delete-collection", "*", deleteCollectionReaction(s.tracker))
 	s.fake.AddReactor("*", "*", k8stesting.ObjectReaction(s.tracker))
 	s.fake.AddWatchReactor("*", func(action k8stesting.Action) (handled bool, ret watch.Interface, err error) {
 		w, err := s.tracker.Watch(action.GetResource(), action.GetNamespace())
@@ -41,24 +74,24 @@ func NewSet() *Set {
 		return true, w, nil
 	})
 
-	s.CoreV1 = k8sclient.NewCoreV1Client(&fakerBackend{fake: &s.fake}, nil)
-	s.AdmissionregistrationK8sIoV1 = k8sclient.NewAdmissionregistrationK8sIoV1Client(&fakerBackend{fake: &s.fake}, nil)
-	s.AppsV1 = k8sclient.NewAppsV1Client(&fakerBackend{fake: &s.fake}, nil)
-	s.AuthenticationK8sIoV1 = k8sclient.NewAuthenticationK8sIoV1Client(&fakerBackend{fake: &s.fake}, nil)
-	s.AuthorizationK8sIoV1 = k8sclient.NewAuthorizationK8sIoV1Client(&fakerBackend{fake: &s.fake}, nil)
-	s.AutoscalingV1 = k8sclient.NewAutoscalingV1Client(&fakerBackend{fake: &s.fake}, nil)
-	s.AutoscalingV2 = k8sclient.NewAutoscalingV2Client(&fakerBackend{fake: &s.fake}, nil)
-	s.BatchV1 = k8sclient.NewBatchV1Client(&fakerBackend{fake: &s.fake}, nil)
-	s.CertificatesK8sIoV1 = k8sclient.NewCertificatesK8sIoV1Client(&fakerBackend{fake: &s.fake}, nil)
-	s.CoordinationK8sIoV1 = k8sclient.NewCoordinationK8sIoV1Client(&fakerBackend{fake: &s.fake}, nil)
-	s.DiscoveryK8sIoV1 = k8sclient.NewDiscoveryK8sIoV1Client(&fakerBackend{fake: &s.fake}, nil)
-	s.EventsK8sIoV1 = k8sclient.NewEventsK8sIoV1Client(&fakerBackend{fake: &s.fake}, nil)
-	s.NetworkingK8sIoV1 = k8sclient.NewNetworkingK8sIoV1Client(&fakerBackend{fake: &s.fake}, nil)
-	s.PolicyV1 = k8sclient.NewPolicyV1Client(&fakerBackend{fake: &s.fake}, nil)
-	s.RbacAuthorizationK8sIoV1 = k8sclient.NewRbacAuthorizationK8sIoV1Client(&fakerBackend{fake: &s.fake}, nil)
-	s.ResourceV1 = k8sclient.NewResourceV1Client(&fakerBackend{fake: &s.fake}, nil)
-	s.SchedulingK8sIoV1 = k8sclient.NewSchedulingK8sIoV1Client(&fakerBackend{fake: &s.fake}, nil)
-	s.StorageK8sIoV1 = k8sclient.NewStorageK8sIoV1Client(&fakerBackend{fake: &s.fake}, nil)
+	s.CoreV1 = k8sclient.NewCoreV1Client(&fakerBackend{fake: &s.fake, tracker: s.tracker, proxy: s.proxy}, nil)
+	s.AdmissionregistrationK8sIoV1 = k8sclient.NewAdmissionregistrationK8sIoV1Client(&fakerBackend{fake: &s.fake, tracker: s.tracker, proxy: s.proxy}, nil)
+	s.AppsV1 = k8sclient.NewAppsV1Client(&fakerBackend{fake: &s.fake, tracker: s.tracker, proxy: s.proxy}, nil)
+	s.AuthenticationK8sIoV1 = k8sclient.NewAuthenticationK8sIoV1Client(&fakerBackend{fake: &s.fake, tracker: s.tracker, proxy: s.proxy}, nil)
+	s.AuthorizationK8sIoV1 = k8sclient.NewAuthorizationK8sIoV1Client(&fakerBackend{fake: &s.fake, tracker: s.tracker, proxy: s.proxy}, nil)
+	s.AutoscalingV1 = k8sclient.NewAutoscalingV1Client(&fakerBackend{fake: &s.fake, tracker: s.tracker, proxy: s.proxy}, nil)
+	s.AutoscalingV2 = k8sclient.NewAutoscalingV2Client(&fakerBackend{fake: &s.fake, tracker: s.tracker, proxy: s.proxy}, nil)
+	s.BatchV1 = k8sclient.NewBatchV1Client(&fakerBackend{fake: &s.fake, tracker: s.tracker, proxy: s.proxy}, nil)
+	s.CertificatesK8sIoV1 = k8sclient.NewCertificatesK8sIoV1Client(&fakerBackend{fake: &s.fake, tracker: s.tracker, proxy: s.proxy}, nil)
+	s.CoordinationK8sIoV1 = k8sclient.NewCoordinationK8sIoV1Client(&fakerBackend{fake: &s.fake, tracker: s.tracker, proxy: s.proxy}, nil)
+	s.DiscoveryK8sIoV1 = k8sclient.NewDiscoveryK8sIoV1Client(&fakerBackend{fake: &s.fake, tracker: s.tracker, proxy: s.proxy}, nil)
+	s.EventsK8sIoV1 = k8sclient.NewEventsK8sIoV1Client(&fakerBackend{fake: &s.fake, tracker: s.tracker, proxy: s.proxy}, nil)
+	s.NetworkingK8sIoV1 = k8sclient.NewNetworkingK8sIoV1Client(&fakerBackend{fake: &s.fake, tracker: s.tracker, proxy: s.proxy}, nil)
+	s.PolicyV1 = k8sclient.NewPolicyV1Client(&fakerBackend{fake: &s.fake, tracker: s.tracker, proxy: s.proxy}, nil)
+	s.RbacAuthorizationK8sIoV1 = k8sclient.NewRbacAuthorizationK8sIoV1Client(&fakerBackend{fake: &s.fake, tracker: s.tracker, proxy: s.proxy}, nil)
+	s.ResourceV1 = k8sclient.NewResourceV1Client(&fakerBackend{fake: &s.fake, tracker: s.tracker, proxy: s.proxy}, nil)
+	s.SchedulingK8sIoV1 = k8sclient.NewSchedulingK8sIoV1Client(&fakerBackend{fake: &s.fake, tracker: s.tracker, proxy: s.proxy}, nil)
+	s.StorageK8sIoV1 = k8sclient.NewStorageK8sIoV1Client(&fakerBackend{fake: &s.fake, tracker: s.tracker, proxy: s.proxy}, nil)
 	return s
 }
 
@@ -74,10 +107,271 @@ func (s *Set) ClearActions() {
 	s.fake.ClearActions()
 }
 
+// PrependReactor adds the reactor which is called before the reactors of the tracker.
+func (s *Set) PrependReactor(verb, resource string, reaction k8stesting.ReactionFunc) {
+	s.fake.PrependReactor(verb, resource, reaction)
+}
+
+// RegisterProxyHandler registers the handler which serves the requests through the proxy sub resource.
+// resourceName is "services" or "pods". The handler serves the requests to all ports of the object.
+// The request is recorded as k8stesting.ProxyGetAction whose verb is the lower case of the method.
+// If the reactor of the action returns the error, the request fails with the error.
+func (s *Set) RegisterProxyHandler(resourceName, namespace, name string, h http.Handler) {
+	s.proxy.mu.Lock()
+	defer s.proxy.mu.Unlock()
+
+	s.proxy.handlers[resourceName+"/"+namespace+"/"+name] = h
+}
+
+var resourceKinds = map[schema.GroupVersionResource]string{
+	{Group: "", Version: "v1", Resource: "bindings"}:                                                      "Binding",
+	{Group: "", Version: "v1", Resource: "componentstatuses"}:                                             "ComponentStatus",
+	{Group: "", Version: "v1", Resource: "configmaps"}:                                                    "ConfigMap",
+	{Group: "", Version: "v1", Resource: "endpoints"}:                                                     "Endpoints",
+	{Group: "", Version: "v1", Resource: "events"}:                                                        "Event",
+	{Group: "", Version: "v1", Resource: "limitranges"}:                                                   "LimitRange",
+	{Group: "", Version: "v1", Resource: "namespaces"}:                                                    "Namespace",
+	{Group: "", Version: "v1", Resource: "nodes"}:                                                         "Node",
+	{Group: "", Version: "v1", Resource: "persistentvolumes"}:                                             "PersistentVolume",
+	{Group: "", Version: "v1", Resource: "persistentvolumeclaims"}:                                        "PersistentVolumeClaim",
+	{Group: "", Version: "v1", Resource: "pods"}:                                                          "Pod",
+	{Group: "", Version: "v1", Resource: "podstatusresults"}:                                              "PodStatusResult",
+	{Group: "", Version: "v1", Resource: "podtemplates"}:                                                  "PodTemplate",
+	{Group: "", Version: "v1", Resource: "rangeallocations"}:                                              "RangeAllocation",
+	{Group: "", Version: "v1", Resource: "replicationcontrollers"}:                                        "ReplicationController",
+	{Group: "", Version: "v1", Resource: "resourcequotas"}:                                                "ResourceQuota",
+	{Group: "", Version: "v1", Resource: "secrets"}:                                                       "Secret",
+	{Group: "", Version: "v1", Resource: "services"}:                                                      "Service",
+	{Group: "", Version: "v1", Resource: "serviceaccounts"}:                                               "ServiceAccount",
+	{Group: "admissionregistration.k8s.io", Version: "v1", Resource: "mutatingadmissionpolicies"}:         "MutatingAdmissionPolicy",
+	{Group: "admissionregistration.k8s.io", Version: "v1", Resource: "mutatingadmissionpolicybindings"}:   "MutatingAdmissionPolicyBinding",
+	{Group: "admissionregistration.k8s.io", Version: "v1", Resource: "mutatingwebhookconfigurations"}:     "MutatingWebhookConfiguration",
+	{Group: "admissionregistration.k8s.io", Version: "v1", Resource: "validatingadmissionpolicies"}:       "ValidatingAdmissionPolicy",
+	{Group: "admissionregistration.k8s.io", Version: "v1", Resource: "validatingadmissionpolicybindings"}: "ValidatingAdmissionPolicyBinding",
+	{Group: "admissionregistration.k8s.io", Version: "v1", Resource: "validatingwebhookconfigurations"}:   "ValidatingWebhookConfiguration",
+	{Group: "apps", Version: "v1", Resource: "controllerrevisions"}:                                       "ControllerRevision",
+	{Group: "apps", Version: "v1", Resource: "daemonsets"}:                                                "DaemonSet",
+	{Group: "apps", Version: "v1", Resource: "deployments"}:                                               "Deployment",
+	{Group: "apps", Version: "v1", Resource: "replicasets"}:                                               "ReplicaSet",
+	{Group: "apps", Version: "v1", Resource: "statefulsets"}:                                              "StatefulSet",
+	{Group: "authentication.k8s.io", Version: "v1", Resource: "selfsubjectreviews"}:                       "SelfSubjectReview",
+	{Group: "authentication.k8s.io", Version: "v1", Resource: "tokenrequests"}:                            "TokenRequest",
+	{Group: "authentication.k8s.io", Version: "v1", Resource: "tokenreviews"}:                             "TokenReview",
+	{Group: "authorization.k8s.io", Version: "v1", Resource: "localsubjectaccessreviews"}:                 "LocalSubjectAccessReview",
+	{Group: "authorization.k8s.io", Version: "v1", Resource: "selfsubjectaccessreviews"}:                  "SelfSubjectAccessReview",
+	{Group: "authorization.k8s.io", Version: "v1", Resource: "selfsubjectrulesreviews"}:                   "SelfSubjectRulesReview",
+	{Group: "authorization.k8s.io", Version: "v1", Resource: "subjectaccessreviews"}:                      "SubjectAccessReview",
+	{Group: "autoscaling", Version: "v1", Resource: "horizontalpodautoscalers"}:                           "HorizontalPodAutoscaler",
+	{Group: "autoscaling", Version: "v1", Resource: "scales"}:                                             "Scale",
+	{Group: "autoscaling", Version: "v2", Resource: "horizontalpodautoscalers"}:                           "HorizontalPodAutoscaler",
+	{Group: "batch", Version: "v1", Resource: "cronjobs"}:                                                 "CronJob",
+	{Group: "batch", Version: "v1", Resource: "jobs"}:                                                     "Job",
+	{Group: "certificates.k8s.io", Version: "v1", Resource: "certificatesigningrequests"}:                 "CertificateSigningRequest",
+	{Group: "coordination.k8s.io", Version: "v1", Resource: "leases"}:                                     "Lease",
+	{Group: "discovery.k8s.io", Version: "v1", Resource: "endpointslices"}:                                "EndpointSlice",
+	{Group: "events.k8s.io", Version: "v1", Resource: "events"}:                                           "Event",
+	{Group: "networking.k8s.io", Version: "v1", Resource: "ipaddresses"}:                                  "IPAddress",
+	{Group: "networking.k8s.io", Version: "v1", Resource: "ingresses"}:                                    "Ingress",
+	{Group: "networking.k8s.io", Version: "v1", Resource: "ingressclasses"}:                               "IngressClass",
+	{Group: "networking.k8s.io", Version: "v1", Resource: "networkpolicies"}:                              "NetworkPolicy",
+	{Group: "networking.k8s.io", Version: "v1", Resource: "servicecidrs"}:                                 "ServiceCIDR",
+	{Group: "policy", Version: "v1", Resource: "evictions"}:                                               "Eviction",
+	{Group: "policy", Version: "v1", Resource: "poddisruptionbudgets"}:                                    "PodDisruptionBudget",
+	{Group: "rbac.authorization.k8s.io", Version: "v1", Resource: "clusterroles"}:                         "ClusterRole",
+	{Group: "rbac.authorization.k8s.io", Version: "v1", Resource: "clusterrolebindings"}:                  "ClusterRoleBinding",
+	{Group: "rbac.authorization.k8s.io", Version: "v1", Resource: "roles"}:                                "Role",
+	{Group: "rbac.authorization.k8s.io", Version: "v1", Resource: "rolebindings"}:                         "RoleBinding",
+	{Group: "resource", Version: "v1", Resource: "deviceclasses"}:                                         "DeviceClass",
+	{Group: "resource", Version: "v1", Resource: "resourceclaims"}:                                        "ResourceClaim",
+	{Group: "resource", Version: "v1", Resource: "resourceclaimtemplates"}:                                "ResourceClaimTemplate",
+	{Group: "resource", Version: "v1", Resource: "resourceslices"}:                                        "ResourceSlice",
+	{Group: "scheduling.k8s.io", Version: "v1", Resource: "priorityclasses"}:                              "PriorityClass",
+	{Group: "storage.k8s.io", Version: "v1", Resource: "csidrivers"}:                                      "CSIDriver",
+	{Group: "storage.k8s.io", Version: "v1", Resource: "csinodes"}:                                        "CSINode",
+	{Group: "storage.k8s.io", Version: "v1", Resource: "csistoragecapacities"}:                            "CSIStorageCapacity",
+	{Group: "storage.k8s.io", Version: "v1", Resource: "storageclasses"}:                                  "StorageClass",
+	{Group: "storage.k8s.io", Version: "v1", Resource: "volumeattachments"}:                               "VolumeAttachment",
+	{Group: "storage.k8s.io", Version: "v1", Resource: "volumeattributesclasses"}:                         "VolumeAttributesClass",
+}
+
+// selectableFields returns the fields of obj which can be used by the field selector.
+func selectableFields(obj runtime.Object) fields.Set {
+	switch v := obj.(type) {
+	case *corev1.Binding:
+		return corev1.BindingToSelectableFields(v)
+	case *corev1.ComponentStatus:
+		return corev1.ComponentStatusToSelectableFields(v)
+	case *corev1.ConfigMap:
+		return corev1.ConfigMapToSelectableFields(v)
+	case *corev1.Endpoints:
+		return corev1.EndpointsToSelectableFields(v)
+	case *corev1.Event:
+		return corev1.EventToSelectableFields(v)
+	case *corev1.LimitRange:
+		return corev1.LimitRangeToSelectableFields(v)
+	case *corev1.Namespace:
+		return corev1.NamespaceToSelectableFields(v)
+	case *corev1.Node:
+		return corev1.NodeToSelectableFields(v)
+	case *corev1.PersistentVolume:
+		return corev1.PersistentVolumeToSelectableFields(v)
+	case *corev1.PersistentVolumeClaim:
+		return corev1.PersistentVolumeClaimToSelectableFields(v)
+	case *corev1.Pod:
+		return corev1.PodToSelectableFields(v)
+	case *corev1.PodStatusResult:
+		return corev1.PodStatusResultToSelectableFields(v)
+	case *corev1.PodTemplate:
+		return corev1.PodTemplateToSelectableFields(v)
+	case *corev1.RangeAllocation:
+		return corev1.RangeAllocationToSelectableFields(v)
+	case *corev1.ReplicationController:
+		return corev1.ReplicationControllerToSelectableFields(v)
+	case *corev1.ResourceQuota:
+		return corev1.ResourceQuotaToSelectableFields(v)
+	case *corev1.Secret:
+		return corev1.SecretToSelectableFields(v)
+	case *corev1.Service:
+		return corev1.ServiceToSelectableFields(v)
+	case *corev1.ServiceAccount:
+		return corev1.ServiceAccountToSelectableFields(v)
+	case *admissionregistrationv1.MutatingAdmissionPolicy:
+		return admissionregistrationv1.MutatingAdmissionPolicyToSelectableFields(v)
+	case *admissionregistrationv1.MutatingAdmissionPolicyBinding:
+		return admissionregistrationv1.MutatingAdmissionPolicyBindingToSelectableFields(v)
+	case *admissionregistrationv1.MutatingWebhookConfiguration:
+		return admissionregistrationv1.MutatingWebhookConfigurationToSelectableFields(v)
+	case *admissionregistrationv1.ValidatingAdmissionPolicy:
+		return admissionregistrationv1.ValidatingAdmissionPolicyToSelectableFields(v)
+	case *admissionregistrationv1.ValidatingAdmissionPolicyBinding:
+		return admissionregistrationv1.ValidatingAdmissionPolicyBindingToSelectableFields(v)
+	case *admissionregistrationv1.ValidatingWebhookConfiguration:
+		return admissionregistrationv1.ValidatingWebhookConfigurationToSelectableFields(v)
+	case *appsv1.ControllerRevision:
+		return appsv1.ControllerRevisionToSelectableFields(v)
+	case *appsv1.DaemonSet:
+		return appsv1.DaemonSetToSelectableFields(v)
+	case *appsv1.Deployment:
+		return appsv1.DeploymentToSelectableFields(v)
+	case *appsv1.ReplicaSet:
+		return appsv1.ReplicaSetToSelectableFields(v)
+	case *appsv1.StatefulSet:
+		return appsv1.StatefulSetToSelectableFields(v)
+	case *authenticationv1.SelfSubjectReview:
+		return authenticationv1.SelfSubjectReviewToSelectableFields(v)
+	case *authenticationv1.TokenRequest:
+		return authenticationv1.TokenRequestToSelectableFields(v)
+	case *authenticationv1.TokenReview:
+		return authenticationv1.TokenReviewToSelectableFields(v)
+	case *authorizationv1.LocalSubjectAccessReview:
+		return authorizationv1.LocalSubjectAccessReviewToSelectableFields(v)
+	case *authorizationv1.SelfSubjectAccessReview:
+		return authorizationv1.SelfSubjectAccessReviewToSelectableFields(v)
+	case *authorizationv1.SelfSubjectRulesReview:
+		return authorizationv1.SelfSubjectRulesReviewToSelectableFields(v)
+	case *authorizationv1.SubjectAccessReview:
+		return authorizationv1.SubjectAccessReviewToSelectableFields(v)
+	case *autoscalingv1.HorizontalPodAutoscaler:
+		return autoscalingv1.HorizontalPodAutoscalerToSelectableFields(v)
+	case *autoscalingv1.Scale:
+		return autoscalingv1.ScaleToSelectableFields(v)
+	case *autoscalingv2.HorizontalPodAutoscaler:
+		return autoscalingv2.HorizontalPodAutoscalerToSelectableFields(v)
+	case *batchv1.CronJob:
+		return batchv1.CronJobToSelectableFields(v)
+	case *batchv1.Job:
+		return batchv1.JobToSelectableFields(v)
+	case *certificatesv1.CertificateSigningRequest:
+		return certificatesv1.CertificateSigningRequestToSelectableFields(v)
+	case *coordinationv1.Lease:
+		return coordinationv1.LeaseToSelectableFields(v)
+	case *discoveryv1.EndpointSlice:
+		return discoveryv1.EndpointSliceToSelectableFields(v)
+	case *eventsv1.Event:
+		return eventsv1.EventToSelectableFields(v)
+	case *networkingv1.IPAddress:
+		return networkingv1.IPAddressToSelectableFields(v)
+	case *networkingv1.Ingress:
+		return networkingv1.IngressToSelectableFields(v)
+	case *networkingv1.IngressClass:
+		return networkingv1.IngressClassToSelectableFields(v)
+	case *networkingv1.NetworkPolicy:
+		return networkingv1.NetworkPolicyToSelectableFields(v)
+	case *networkingv1.ServiceCIDR:
+		return networkingv1.ServiceCIDRToSelectableFields(v)
+	case *policyv1.Eviction:
+		return policyv1.EvictionToSelectableFields(v)
+	case *policyv1.PodDisruptionBudget:
+		return policyv1.PodDisruptionBudgetToSelectableFields(v)
+	case *rbacv1.ClusterRole:
+		return rbacv1.ClusterRoleToSelectableFields(v)
+	case *rbacv1.ClusterRoleBinding:
+		return rbacv1.ClusterRoleBindingToSelectableFields(v)
+	case *rbacv1.Role:
+		return rbacv1.RoleToSelectableFields(v)
+	case *rbacv1.RoleBinding:
+		return rbacv1.RoleBindingToSelectableFields(v)
+	case *resourcev1.DeviceClass:
+		return resourcev1.DeviceClassToSelectableFields(v)
+	case *resourcev1.ResourceClaim:
+		return resourcev1.ResourceClaimToSelectableFields(v)
+	case *resourcev1.ResourceClaimTemplate:
+		return resourcev1.ResourceClaimTemplateToSelectableFields(v)
+	case *resourcev1.ResourceSlice:
+		return resourcev1.ResourceSliceToSelectableFields(v)
+	case *schedulingv1.PriorityClass:
+		return schedulingv1.PriorityClassToSelectableFields(v)
+	case *storagev1.CSIDriver:
+		return storagev1.CSIDriverToSelectableFields(v)
+	case *storagev1.CSINode:
+		return storagev1.CSINodeToSelectableFields(v)
+	case *storagev1.CSIStorageCapacity:
+		return storagev1.CSIStorageCapacityToSelectableFields(v)
+	case *storagev1.StorageClass:
+		return storagev1.StorageClassToSelectableFields(v)
+	case *storagev1.VolumeAttachment:
+		return storagev1.VolumeAttachmentToSelectableFields(v)
+	case *storagev1.VolumeAttributesClass:
+		return storagev1.VolumeAttributesClassToSelectableFields(v)
+	}
+	objMeta := obj.(metav1.Object).GetObjectMeta()
+	return fields.Set{"metadata.name": objMeta.Name, "metadata.namespace": objMeta.Namespace}
+}
+
+// validateFieldSelector returns the error if selector has the field which is not selectable for gvk.
+// The kind which doesn't have the selectable fields supports only metadata.name and metadata.namespace like the API server.
+func validateFieldSelector(gvk schema.GroupVersionKind, selector fields.Selector) error {
+	for _, r := range selector.Requirements() {
+		if _, _, err := k8sclient.Scheme.ConvertFieldLabel(gvk, r.Field, r.Value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 type fakerBackend struct {
-	fake *k8stesting.Fake
+	fake    *k8stesting.Fake
+	tracker k8stesting.ObjectTracker
+	proxy   *proxyHandlers
+}
+
+type proxyHandlers struct {
+	mu       sync.RWMutex
+	handlers map[string]http.Handler
 }
 
+func (p *proxyHandlers) get(resourceName, namespace, name string) http.Handler {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+
+	return p.handlers[resourceName+"/"+namespace+"/"+name]
+}
+
+type roundTripperFunc func(req *http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
 func (f *fakerBackend) Get(ctx context.Context, resourceName, namespace, name string, opts metav1.GetOptions, result runtime.Object) (runtime.Object, error) {
 	gvks, _, err := k8sclient.Scheme.ObjectKinds(result)
 	if err != nil {
@@ -100,6 +394,17 @@ func (f *fakerBackend) List(ctx context.Context, resourceName, namespace string,
 	if strings.HasSuffix(gvk.Kind, "List") {
 		gvk.Kind = strings.TrimSuffix(gvk.Kind, "List")
 	}
+	label, err := labels.Parse(opts.LabelSelector)
+	if err != nil {
+		return nil, err
+	}
+	field, err := fields.ParseSelector(opts.FieldSelector)
+	if err != nil {
+		return nil, err
+	}
+	if err := validateFieldSelector(gvk, field); err != nil {
+		return nil, err
+	}
 	k8sListOpt := k8smetav1.ListOptions{
 		LabelSelector:   opts.LabelSelector,
 		FieldSelector:   opts.FieldSelector,
@@ -111,10 +416,6 @@ func (f *fakerBackend) List(ctx context.Context, resourceName, namespace string,
 		return nil, err
 	}
 
-	label, _, _ := k8stesting.ExtractFromListOptions(k8sListOpt)
-	if label == nil {
-		label = labels.Everything()
-	}
 	objs, err := meta.ExtractList(obj)
 	if err != nil {
 		return nil, err
@@ -123,7 +424,7 @@ func (f *fakerBackend) List(ctx context.Context, resourceName, namespace string,
 	for _, item := range objs {
 		m := item.(metav1.Object)
 		objMeta := m.GetObjectMeta()
-		if label.Matches(labels.Set(objMeta.Labels)) {
+		if label.Matches(labels.Set(objMeta.Labels)) && field.Matches(selectableFields(item)) {
 			filtered = append(filtered, item)
 		}
 	}
@@ -184,9 +485,142 @@ func (f *fakerBackend) Delete(ctx context.Context, gvr schema.GroupVersionResour
 
 	return err
 }
+func (f *fakerBackend) DeleteCollection(ctx context.Context, gvr schema.GroupVersionResource, namespace string, opts metav1.DeleteOptions, listOpts metav1.ListOptions) error {
+	if _, err := labels.Parse(listOpts.LabelSelector); err != nil {
+		return err
+	}
+	if _, err := fields.ParseSelector(listOpts.FieldSelector); err != nil {
+		return err
+	}
+	k8sListOpt := k8smetav1.ListOptions{
+		LabelSelector:   listOpts.LabelSelector,
+		FieldSelector:   listOpts.FieldSelector,
+		ResourceVersion: listOpts.ResourceVersion,
+	}
+	_, err := f.fake.Invokes(k8stesting.NewDeleteCollectionAction(gvr, namespace, k8sListOpt), nil)
+
+	return err
+}
+
+// deleteCollectionReaction returns the reactor which deletes the objects matched to the selectors of the action from tracker.
+func deleteCollectionReaction(tracker k8stesting.ObjectTracker) k8stesting.ReactionFunc {
+	return func(action k8stesting.Action) (bool, runtime.Object, error) {
+		a := action.(k8stesting.DeleteCollectionAction)
+		gvr := a.GetResource()
+		kind, ok := resourceKinds[gvr]
+		if !ok {
+			return true, nil, fmt.Errorf("unknown resource: %s", gvr.String())
+		}
+		restrictions := a.GetListRestrictions()
+		if err := validateFieldSelector(gvr.GroupVersion().WithKind(kind), restrictions.Fields); err != nil {
+			return true, nil, err
+		}
+		list, err := tracker.List(gvr, gvr.GroupVersion().WithKind(kind), a.GetNamespace())
+		if err != nil {
+			return true, nil, err
+		}
+		objs, err := meta.ExtractList(list)
+		if err != nil {
+			return true, nil, err
+		}
+		for _, item := range objs {
+			objMeta := item.(metav1.Object).GetObjectMeta()
+			if !restrictions.Labels.Matches(labels.Set(objMeta.Labels)) || !restrictions.Fields.Matches(selectableFields(item)) {
+				continue
+			}
+			if err := tracker.Delete(gvr, objMeta.Namespace, objMeta.Name); err != nil {
+				return true, nil, err
+			}
+		}
+		return true, nil, nil
+	}
+}
+
+// Watch watches the objects which are matched to the label selector and the field selector of opts.
 func (f *fakerBackend) Watch(ctx context.Context, gvr schema.GroupVersionResource, namespace string, opts metav1.ListOptions) (watch.Interface, error) {
-	return f.fake.InvokesWatch(k8stesting.NewWatchAction(gvr, namespace, opts))
+	label, err := labels.Parse(opts.LabelSelector)
+	if err != nil {
+		return nil, err
+	}
+	field, err := fields.ParseSelector(opts.FieldSelector)
+	if err != nil {
+		return nil, err
+	}
+	if kind, ok := resourceKinds[gvr]; ok {
+		if err := validateFieldSelector(gvr.GroupVersion().WithKind(kind), field); err != nil {
+			return nil, err
+		}
+	}
+	w, err := f.fake.InvokesWatch(k8stesting.NewWatchAction(gvr, namespace, opts))
+	if err != nil || (label.Empty() && field.Empty()) {
+		return w, err
+	}
+	return watch.Filter(w, func(in watch.Event) (watch.Event, bool) {
+		m, ok := in.Object.(metav1.Object)
+		if !ok {
+			return in, true
+		}
+		return in, label.Matches(labels.Set(m.GetObjectMeta().Labels)) && field.Matches(selectableFields(in.Object))
+	}), nil
 }
+
+// IsWatchListSemanticsUnSupported reports that the tracker doesn't send the initial events and the bookmark.
+// The informers fall back to the list and the watch.
+func (f *fakerBackend) IsWatchListSemanticsUnSupported() bool {
+	return true
+}
+func (f *fakerBackend) Patch(ctx context.Context, resourceName, namespace, name string, pt types.PatchType, data []byte, opts metav1.PatchOptions, result runtime.Object, subresources ...string) (runtime.Object, error) {
+	gvks, _, err := k8sclient.Scheme.ObjectKinds(result)
+	if err != nil {
+		return nil, err
+	}
+	gvk := gvks[0]
+	k8sPatchOpt := k8smetav1.PatchOptions{
+		DryRun:       opts.DryRun,
+		FieldManager: opts.FieldManager,
+	}
+	if opts.Force {
+		force := true
+		k8sPatchOpt.Force = &force
+	}
+	obj, err := f.fake.Invokes(k8stesting.NewPatchSubresourceActionWithOptions(gvk.GroupVersion().WithResource(resourceName), namespace, name, pt, data, k8sPatchOpt, subresources...), result)
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.DeepCopyObject(), err
+}
+
+func (f *fakerBackend) SubResource(ctx context.Context, verb string, gvr schema.GroupVersionResource, namespace, name, subresource string, obj, opts, result runtime.Object) (runtime.Object, error) {
+	var action k8stesting.Action
+	switch verb {
+	case "GET":
+		action = k8stesting.NewGetSubresourceAction(gvr, namespace, subresource, name)
+	case "POST":
+		action = k8stesting.NewCreateSubresourceAction(gvr, name, subresource, namespace, obj)
+	case "PUT":
+		action = k8stesting.NewUpdateSubresourceAction(gvr, subresource, namespace, obj)
+	default:
+		return nil, fmt.Errorf("%s is not supported", verb)
+	}
+	ret, err := f.fake.Invokes(action, result)
+
+	if ret == nil {
+		return nil, err
+	}
+	if reflect.TypeOf(ret) != reflect.TypeOf(result) {
+		// The tracker returns the request object as it is if the type of the request is not the parent object.
+		// (e.g. Binding of Pod) The tracker doesn't have the side effect of the sub resource like binding the pod
+		// to the node or evicting the pod. The test which depends on the side effect has to add a reactor.
+		if obj != nil && reflect.TypeOf(ret) == reflect.TypeOf(obj) {
+			return result, err
+		}
+		// The tracker returns the parent object instead of the sub resource.
+		return nil, fmt.Errorf("sub resource %s of %s is not supported by the tracker; add a reactor", subresource, gvr.Resource)
+	}
+	return ret.DeepCopyObject(), err
+}
+
 func (f *fakerBackend) GetClusterScoped(ctx context.Context, resourceName, name string, opts metav1.GetOptions, result runtime.Object) (runtime.Object, error) {
 	return f.Get(ctx, resourceName, "", name, opts, result)
 }
@@ -211,10 +645,46 @@ func (f *fakerBackend) DeleteClusterScoped(ctx context.Context, gvr schema.Group
 	return f.Delete(ctx, gvr, "", name, opts)
 }
 
+func (f *fakerBackend) DeleteCollectionClusterScoped(ctx context.Context, gvr schema.GroupVersionResource, opts metav1.DeleteOptions, listOpts metav1.ListOptions) error {
+	return f.DeleteCollection(ctx, gvr, "", opts, listOpts)
+}
+
 func (f *fakerBackend) WatchClusterScoped(ctx context.Context, gvr schema.GroupVersionResource, opts metav1.ListOptions) (watch.Interface, error) {
 	return f.Watch(ctx, gvr, "", opts)
 }
 
+func (f *fakerBackend) PatchClusterScoped(ctx context.Context, resourceName, name string, pt types.PatchType, data []byte, opts metav1.PatchOptions, result runtime.Object, subresources ...string) (runtime.Object, error) {
+	return f.Patch(ctx, resourceName, "", name, pt, data, opts, result, subresources...)
+}
+
+func (f *fakerBackend) SubResourceClusterScoped(ctx context.Context, verb string, gvr schema.GroupVersionResource, name, subresource string, obj, opts, result runtime.Object) (runtime.Object, error) {
+	return f.SubResource(ctx, verb, gvr, "", name, subresource, obj, opts, result)
+}
+
+func (f *fakerBackend) ProxyRoundTripper(resourceName, namespace, name, port string) http.RoundTripper {
+	return roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		params := make(map[string]string)
+		for k, v := range req.URL.Query() {
+			params[k] = v[0]
+		}
+		action := k8stesting.NewProxyGetAction(schema.GroupVersionResource{Version: "v1", Resource: resourceName}, namespace, req.URL.Scheme, name, port, req.URL.Path, params)
+		// The verb of the action is the method of the request. (e.g. post)
+		action.Verb = strings.ToLower(req.Method)
+		if _, err := f.fake.Invokes(action, nil); err != nil {
+			return nil, err
+		}
+
+		rec := httptest.NewRecorder()
+		h := f.proxy.get(resourceName, namespace, name)
+		if h == nil {
+			http.Error(rec, fmt.Sprintf("no handler for %s %s/%s", resourceName, namespace, name), http.StatusServiceUnavailable)
+		} else {
+			h.ServeHTTP(rec, req)
+		}
+		return rec.Result(), nil
+	})
+}
+
 func (f *fakerBackend) RESTClient() *rest.RESTClient {
 	return nil
 }
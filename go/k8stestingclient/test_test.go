@@ -1,14 +1,32 @@
 package k8stestingclient
 
 import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strings"
 	"testing"
 	"time"
 
+	"go.f110.dev/kubeproto/go/apis/appsv1"
+	"go.f110.dev/kubeproto/go/apis/authenticationv1"
 	"go.f110.dev/kubeproto/go/apis/corev1"
 	"go.f110.dev/kubeproto/go/apis/metav1"
+	"go.f110.dev/kubeproto/go/apis/policyv1"
 	"go.f110.dev/kubeproto/go/internal/assertion"
 	"go.f110.dev/kubeproto/go/k8sclient"
+	"go.f110.dev/kubeproto/go/typedclient"
+	k8smetav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	k8stesting "k8s.io/client-go/testing"
+	"k8s.io/client-go/tools/cache"
 )
 
 func TestTestingClient(t *testing.T) {
@@ -39,3 +57,438 @@ func TestTestingClient(t *testing.T) {
 	assertion.MustNoError(t, err)
 	assertion.Len(t, podsFromLister, 2)
 }
+
+func TestTestingClient_Patch(t *testing.T) {
+	s := NewSet()
+	err := s.Tracker().Add(&corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "test-1", Namespace: metav1.NamespaceDefault}, Status: &corev1.PodStatus{Phase: corev1.PodPhasePending}})
+	assertion.MustNoError(t, err)
+
+	p, err := corev1.NewPodPatch().SetLabel("app", "test").MergePatch()
+	assertion.MustNoError(t, err)
+	pod, err := s.CoreV1.PatchPod(t.Context(), metav1.NamespaceDefault, "test-1", types.MergePatchType, p, metav1.PatchOptions{})
+	assertion.MustNoError(t, err)
+	assertion.Equal(t, "test", pod.Labels["app"])
+
+	p, err = corev1.NewPodPatch().SetStatusPhase(corev1.PodPhaseRunning).JSONPatch()
+	assertion.MustNoError(t, err)
+	pod, err = s.CoreV1.PatchPodStatus(t.Context(), metav1.NamespaceDefault, "test-1", types.JSONPatchType, p, metav1.PatchOptions{})
+	assertion.MustNoError(t, err)
+	assertion.Equal(t, corev1.PodPhaseRunning, pod.Status.Phase)
+
+	assertion.Equal(t, "test", pod.Labels["app"])
+	actions := s.Actions()
+	assertion.Len(t, actions, 2)
+	assertion.Equal(t, "status", actions[1].GetSubresource())
+}
+
+func TestTestingClient_PatchWithoutParent(t *testing.T) {
+	s := NewSet()
+	err := s.Tracker().Add(&corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "test-1", Namespace: metav1.NamespaceDefault}, Spec: &corev1.PodSpec{}})
+	assertion.MustNoError(t, err)
+
+	// JSON patch can't add the field if the parent doesn't exist.
+	for _, b := range []*corev1.PodPatch{
+		corev1.NewPodPatch().SetLabel("app", "test"),
+		corev1.NewPodPatch().SetSpecSecurityContextRunAsNonRoot(true),
+	} {
+		p, err := b.JSONPatch()
+		assertion.MustNoError(t, err)
+		_, err = s.CoreV1.PatchPod(t.Context(), metav1.NamespaceDefault, "test-1", types.JSONPatchType, p, metav1.PatchOptions{})
+		assertion.Equal(t, true, err != nil)
+	}
+
+	// JSON merge patch creates the parents.
+	p, err := corev1.NewPodPatch().SetLabel("app", "test").SetSpecSecurityContextRunAsNonRoot(true).MergePatch()
+	assertion.MustNoError(t, err)
+	pod, err := s.CoreV1.PatchPod(t.Context(), metav1.NamespaceDefault, "test-1", types.MergePatchType, p, metav1.PatchOptions{})
+	assertion.MustNoError(t, err)
+	assertion.Equal(t, "test", pod.Labels["app"])
+	assertion.Equal(t, true, pod.Spec.SecurityContext.RunAsNonRoot)
+}
+
+func TestTestingClient_DeleteCollection(t *testing.T) {
+	s := NewSet()
+	for _, v := range []*corev1.Pod{
+		{ObjectMeta: metav1.ObjectMeta{Name: "test-1", Namespace: metav1.NamespaceDefault, Labels: map[string]string{"app": "test"}}},
+		{ObjectMeta: metav1.ObjectMeta{Name: "test-2", Namespace: metav1.NamespaceDefault, Labels: map[string]string{"app": "test"}}},
+		{ObjectMeta: metav1.ObjectMeta{Name: "test-3", Namespace: metav1.NamespaceDefault}},
+		{ObjectMeta: metav1.ObjectMeta{Name: "test-4", Namespace: "other", Labels: map[string]string{"app": "test"}}},
+	} {
+		err := s.Tracker().Add(v)
+		assertion.MustNoError(t, err)
+	}
+
+	err := s.CoreV1.DeleteCollectionPod(t.Context(), metav1.NamespaceDefault, metav1.DeleteOptions{}, metav1.ListOptions{LabelSelector: "app=test", FieldSelector: "metadata.name!=test-2"})
+	assertion.MustNoError(t, err)
+
+	pods, err := s.CoreV1.ListPod(t.Context(), metav1.NamespaceAll, metav1.ListOptions{})
+	assertion.MustNoError(t, err)
+	var names []string
+	for _, v := range pods.Items {
+		names = append(names, v.Name)
+	}
+	sort.Strings(names)
+	assertion.Equal(t, "test-2,test-3,test-4", strings.Join(names, ","))
+	assertion.Len(t, s.Actions(), 2)
+	assertion.Equal(t, "delete-collection", s.Actions()[0].GetVerb())
+}
+
+func TestTestingClient_DeleteCollectionReactor(t *testing.T) {
+	s := NewSet()
+	err := s.Tracker().Add(&corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "test-1", Namespace: metav1.NamespaceDefault}})
+	assertion.MustNoError(t, err)
+	s.PrependReactor("delete-collection", "pods", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		return true, nil, errors.New("forbidden")
+	})
+
+	err = s.CoreV1.DeleteCollectionPod(t.Context(), metav1.NamespaceDefault, metav1.DeleteOptions{}, metav1.ListOptions{})
+	if err == nil || err.Error() != "forbidden" {
+		t.Fatalf("expected the error of the reactor: %v", err)
+	}
+	// The prepended reactor handled the action, so the pod is not deleted.
+	_, err = s.CoreV1.GetPod(t.Context(), metav1.NamespaceDefault, "test-1", metav1.GetOptions{})
+	assertion.MustNoError(t, err)
+}
+
+func TestTestingClient_UnsupportedFieldSelector(t *testing.T) {
+	s := NewSet()
+	err := s.Tracker().Add(&corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "test-1", Namespace: metav1.NamespaceDefault}, Spec: &corev1.PodSpec{NodeName: "node1"}})
+	assertion.MustNoError(t, err)
+
+	// Pod has no selectable field except the metadata. The unsupported field is the error instead of the empty list.
+	opts := metav1.ListOptions{FieldSelector: "spec.nodeName=node1"}
+	_, err = s.CoreV1.ListPod(t.Context(), metav1.NamespaceDefault, opts)
+	assertion.Equal(t, true, err != nil)
+	_, err = s.CoreV1.WatchPod(t.Context(), metav1.NamespaceDefault, opts)
+	assertion.Equal(t, true, err != nil)
+	err = s.CoreV1.DeleteCollectionPod(t.Context(), metav1.NamespaceDefault, metav1.DeleteOptions{}, opts)
+	assertion.Equal(t, true, err != nil)
+	_, err = s.CoreV1.GetPod(t.Context(), metav1.NamespaceDefault, "test-1", metav1.GetOptions{})
+	assertion.MustNoError(t, err)
+
+	pods, err := s.CoreV1.ListPod(t.Context(), metav1.NamespaceDefault, metav1.ListOptions{FieldSelector: "metadata.name=test-1"})
+	assertion.MustNoError(t, err)
+	assertion.Len(t, pods.Items, 1)
+}
+
+func TestTestingClient_ResourceClient(t *testing.T) {
+	s := NewSet()
+
+	_, err := s.CoreV1.Namespaces().Create(t.Context(), &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "test"}}, metav1.CreateOptions{})
+	assertion.MustNoError(t, err)
+	ns, err := s.CoreV1.Namespaces().Get(t.Context(), "test", metav1.GetOptions{})
+	assertion.MustNoError(t, err)
+	assertion.Equal(t, "test", ns.Name)
+
+	_, err = s.CoreV1.Pods().Create(t.Context(), &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "test-1", Namespace: "test"}}, metav1.CreateOptions{})
+	assertion.MustNoError(t, err)
+	pods, err := s.CoreV1.Pods().List(t.Context(), "test", metav1.ListOptions{})
+	assertion.MustNoError(t, err)
+	assertion.Len(t, pods.Items, 1)
+
+	_, err = s.CoreV1.Pods().Get(t.Context(), "test", "test-2", metav1.GetOptions{})
+	assertion.Equal(t, true, err != nil)
+}
+
+func TestTestingClient_SubResource(t *testing.T) {
+	s := NewSet()
+	err := s.Tracker().Add(&corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "test-1", Namespace: metav1.NamespaceDefault}, Spec: &corev1.PodSpec{}})
+	assertion.MustNoError(t, err)
+
+	pod, err := s.CoreV1.GetPod(t.Context(), metav1.NamespaceDefault, "test-1", metav1.GetOptions{})
+	assertion.MustNoError(t, err)
+	pod.Spec.EphemeralContainers = append(pod.Spec.EphemeralContainers, corev1.EphemeralContainer{EphemeralContainerCommon: corev1.EphemeralContainerCommon{Name: "debug", Image: "busybox"}})
+	_, err = s.CoreV1.UpdatePodEphemeralContainers(t.Context(), metav1.NamespaceDefault, "test-1", pod, metav1.UpdateOptions{})
+	assertion.MustNoError(t, err)
+
+	pod, err = s.CoreV1.GetPod(t.Context(), metav1.NamespaceDefault, "test-1", metav1.GetOptions{})
+	assertion.MustNoError(t, err)
+	assertion.Len(t, pod.Spec.EphemeralContainers, 1)
+
+	actions := s.Actions()
+	assertion.Equal(t, "ephemeralcontainers", actions[1].GetSubresource())
+}
+
+func TestTestingClient_PodSubResource(t *testing.T) {
+	s := NewSet()
+	err := s.Tracker().Add(&corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "test-1", Namespace: metav1.NamespaceDefault}, Spec: &corev1.PodSpec{}})
+	assertion.MustNoError(t, err)
+	err = s.Tracker().Add(&corev1.ServiceAccount{ObjectMeta: metav1.ObjectMeta{Name: "default", Namespace: metav1.NamespaceDefault}})
+	assertion.MustNoError(t, err)
+
+	err = s.CoreV1.BindPod(t.Context(), &corev1.Binding{ObjectMeta: metav1.ObjectMeta{Name: "test-1", Namespace: metav1.NamespaceDefault}, Target: corev1.ObjectReference{Kind: "Node", Name: "node-1"}}, metav1.CreateOptions{})
+	assertion.MustNoError(t, err)
+	pod, err := s.CoreV1.GetPodEphemeralContainers(t.Context(), metav1.NamespaceDefault, "test-1", metav1.GetOptions{})
+	assertion.MustNoError(t, err)
+	assertion.Equal(t, "test-1", pod.Name)
+	_, err = s.CoreV1.UpdatePodResize(t.Context(), pod.Namespace, pod.Name, pod, metav1.UpdateOptions{})
+	assertion.MustNoError(t, err)
+	token, err := s.CoreV1.CreateServiceAccountToken(t.Context(), metav1.NamespaceDefault, "default", &authenticationv1.TokenRequest{ObjectMeta: metav1.ObjectMeta{Name: "default", Namespace: metav1.NamespaceDefault}}, metav1.CreateOptions{})
+	assertion.MustNoError(t, err)
+	assertion.Equal(t, "default", token.Name)
+	err = s.CoreV1.EvictPod(t.Context(), &policyv1.Eviction{ObjectMeta: metav1.ObjectMeta{Name: "test-1", Namespace: metav1.NamespaceDefault}}, metav1.CreateOptions{})
+	assertion.MustNoError(t, err)
+
+	var subresources []string
+	for _, a := range s.Actions() {
+		subresources = append(subresources, a.GetVerb()+"/"+a.GetResource().Resource+"/"+a.GetSubresource())
+	}
+	assertion.Equal(t, "create/pods/binding get/pods/ephemeralcontainers update/pods/resize create/serviceaccounts/token create/pods/eviction", strings.Join(subresources, " "))
+}
+
+func TestTestingClient_EvictPod(t *testing.T) {
+	s := NewSet()
+	err := s.Tracker().Add(&corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "test-1", Namespace: metav1.NamespaceDefault}, Spec: &corev1.PodSpec{}})
+	assertion.MustNoError(t, err)
+	eviction := &policyv1.Eviction{ObjectMeta: metav1.ObjectMeta{Name: "test-1", Namespace: metav1.NamespaceDefault}}
+
+	// The tracker doesn't delete the pod.
+	err = s.CoreV1.EvictPod(t.Context(), eviction, metav1.CreateOptions{})
+	assertion.MustNoError(t, err)
+	_, err = s.CoreV1.GetPod(t.Context(), metav1.NamespaceDefault, "test-1", metav1.GetOptions{})
+	assertion.MustNoError(t, err)
+
+	// The reactor implements the side effect of the eviction.
+	s.PrependReactor("create", "pods", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		if action.GetSubresource() != "eviction" {
+			return false, nil, nil
+		}
+		obj := action.(k8stesting.CreateAction).GetObject().(*policyv1.Eviction)
+		return true, obj, s.Tracker().Delete(action.GetResource(), obj.Namespace, obj.Name)
+	})
+	err = s.CoreV1.EvictPod(t.Context(), eviction, metav1.CreateOptions{})
+	assertion.MustNoError(t, err)
+	_, err = s.CoreV1.GetPod(t.Context(), metav1.NamespaceDefault, "test-1", metav1.GetOptions{})
+	assertion.Equal(t, true, err != nil)
+}
+
+func TestTestingClient_UnsupportedSubResource(t *testing.T) {
+	s := NewSet()
+	err := s.Tracker().Add(&appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: metav1.NamespaceDefault}})
+	assertion.MustNoError(t, err)
+
+	// The tracker returns Deployment instead of Scale.
+	_, err = s.AppsV1.GetDeploymentScale(t.Context(), metav1.NamespaceDefault, "test", metav1.GetOptions{})
+	if err == nil {
+		t.Fatal("expected the error")
+	}
+	assertion.Equal(t, "sub resource scale of deployments is not supported by the tracker; add a reactor", err.Error())
+}
+
+func TestTestingClient_ProxyService(t *testing.T) {
+	s := NewSet()
+	s.RegisterProxyHandler("services", metav1.NamespaceDefault, "admin", http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		fmt.Fprintf(w, "%s %s", req.URL.Path, req.URL.Query().Get("q"))
+	}))
+
+	client := s.CoreV1.ProxyService(metav1.NamespaceDefault, "admin", "http")
+	res, err := client.Get("http://admin/status?q=1")
+	assertion.MustNoError(t, err)
+	body, err := io.ReadAll(res.Body)
+	assertion.MustNoError(t, err)
+	res.Body.Close()
+	assertion.Equal(t, http.StatusOK, res.StatusCode)
+	assertion.Equal(t, "/status 1", string(body))
+
+	actions := s.Actions()
+	assertion.Len(t, actions, 1)
+	proxy := actions[0].(k8stesting.ProxyGetAction)
+	assertion.Equal(t, "admin", proxy.GetName())
+	assertion.Equal(t, "/status", proxy.GetPath())
+
+	res, err = s.CoreV1.ProxyService(metav1.NamespaceDefault, "unknown", "http").Get("http://unknown/")
+	assertion.MustNoError(t, err)
+	res.Body.Close()
+	assertion.Equal(t, http.StatusServiceUnavailable, res.StatusCode)
+
+	// The verb of the action is the method of the request.
+	s.ClearActions()
+	res, err = client.Post("http://admin/status", "text/plain", strings.NewReader("test"))
+	assertion.MustNoError(t, err)
+	res.Body.Close()
+	actions = s.Actions()
+	assertion.Len(t, actions, 1)
+	assertion.Equal(t, "post", actions[0].GetVerb())
+
+	// The error of the reactor is returned as the error of the request.
+	s.PrependReactor("post", "services", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		return true, nil, errors.New("injected")
+	})
+	_, err = client.Post("http://admin/status", "text/plain", strings.NewReader("test"))
+	assertion.Equal(t, true, err != nil && strings.Contains(err.Error(), "injected"))
+	res, err = client.Get("http://admin/status")
+	assertion.MustNoError(t, err)
+	res.Body.Close()
+}
+
+func TestTestingClient_DynamicClient(t *testing.T) {
+	s := NewSet()
+	err := s.Tracker().Add(&corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "test-1", Namespace: metav1.NamespaceDefault, Labels: map[string]string{"app": "test"}}})
+	assertion.MustNoError(t, err)
+	widgets := schema.GroupVersionResource{Group: "example.com", Version: "v1", Resource: "widgets"}
+	d := s.DynamicClient(map[schema.GroupVersionResource]string{widgets: "Widget"})
+
+	// The typed object is visible from the dynamic client.
+	pods := d.Resource(corev1.SchemaGroupVersion.WithResource("pods")).Namespace(metav1.NamespaceDefault)
+	pod, err := pods.Get(t.Context(), "test-1", metav1.GetOptions{})
+	assertion.MustNoError(t, err)
+	assertion.Equal(t, "Pod", pod.GetKind())
+	assertion.Equal(t, "test", pod.GetLabels()["app"])
+
+	// The object which is created by the dynamic client is visible from the typed client.
+	newPod := &unstructured.Unstructured{}
+	newPod.SetAPIVersion("v1")
+	newPod.SetKind("Pod")
+	newPod.SetName("test-2")
+	_, err = pods.Create(t.Context(), newPod, metav1.CreateOptions{})
+	assertion.MustNoError(t, err)
+	typedPod, err := s.CoreV1.GetPod(t.Context(), metav1.NamespaceDefault, "test-2", metav1.GetOptions{})
+	assertion.MustNoError(t, err)
+	assertion.Equal(t, "test-2", typedPod.Name)
+	list, err := pods.List(t.Context(), metav1.ListOptions{LabelSelector: "app=test"})
+	assertion.MustNoError(t, err)
+	assertion.Len(t, list.Items, 1)
+
+	// The custom resource is stored as unstructured.Unstructured.
+	widget := &unstructured.Unstructured{Object: map[string]any{"spec": map[string]any{"size": int64(1)}}}
+	widget.SetAPIVersion("example.com/v1")
+	widget.SetKind("Widget")
+	widgetClient := d.Resource(widgets).Namespace(metav1.NamespaceDefault)
+	_, err = widgetClient.Apply(t.Context(), "test", widget, metav1.ApplyOptions{FieldManager: "test"})
+	assertion.MustNoError(t, err)
+	_, err = widgetClient.Patch(t.Context(), "test", types.MergePatchType, []byte(`{"spec":{"size":2}}`), metav1.PatchOptions{})
+	assertion.MustNoError(t, err)
+	widget.Object["spec"] = map[string]any{"size": int64(2), "color": "red"}
+	_, err = widgetClient.Apply(t.Context(), "test", widget, metav1.ApplyOptions{FieldManager: "test"})
+	assertion.MustNoError(t, err)
+	widgetList, err := widgetClient.List(t.Context(), metav1.ListOptions{})
+	assertion.MustNoError(t, err)
+	assertion.Len(t, widgetList.Items, 1)
+	size, _, _ := unstructured.NestedInt64(widgetList.Items[0].Object, "spec", "size")
+	assertion.Equal(t, int64(2), size)
+	assertion.MustNoError(t, widgetClient.Delete(t.Context(), "test", metav1.DeleteOptions{}))
+
+	var verbs []string
+	for _, v := range s.Actions() {
+		verbs = append(verbs, v.GetVerb()+"/"+v.GetResource().Resource)
+	}
+	assertion.Equal(t, "get/pods create/pods get/pods list/pods patch/widgets patch/widgets patch/widgets list/widgets delete/widgets", strings.Join(verbs, " "))
+}
+
+func TestTestingClient_FilteredInformer(t *testing.T) {
+	s := NewSet()
+	for _, v := range []struct{ namespace, name, app string }{
+		{"tenant-1", "test-1", "test"},
+		{"tenant-1", "test-2", "other"},
+		{"tenant-2", "test-3", "test"},
+		{"tenant-3", "test-4", "test"},
+	} {
+		pod := &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: v.name, Namespace: v.namespace, Labels: map[string]string{"app": v.app}}}
+		assertion.MustNoError(t, s.Tracker().Add(pod))
+	}
+
+	c := k8sclient.NewInformerCache()
+	factory := k8sclient.NewInformerFactory(&s.Set, c, metav1.NamespaceAll, 30*time.Second)
+	filteredFactory := k8sclient.NewInformerFactoryWithOptions(&s.Set, c, 30*time.Second, k8sclient.InformerOptions{
+		Namespaces:    []string{"tenant-2", "tenant-1"},
+		LabelSelector: "app=test",
+	})
+	informer := factory.InformerFor(&corev1.Pod{})
+	filteredInformer := filteredFactory.InformerFor(&corev1.Pod{})
+	assertion.Equal(t, false, informer == filteredInformer)
+	// The informer is shared by the same filter.
+	sameFilter := k8sclient.NewCoreV1InformerWithOptions(c, s.CoreV1, 30*time.Second, k8sclient.InformerOptions{
+		Namespaces:    []string{"tenant-1", "tenant-2"},
+		LabelSelector: "app=test",
+	})
+	assertion.Equal(t, true, filteredInformer == sameFilter.PodInformer())
+	// The informer is shared if TweakListOptions modifies the options to the same.
+	tweaked := k8sclient.NewCoreV1InformerWithOptions(c, s.CoreV1, 30*time.Second, k8sclient.InformerOptions{
+		Namespaces:       []string{"tenant-1", "tenant-2"},
+		TweakListOptions: func(options *k8smetav1.ListOptions) { options.LabelSelector = "app=test" },
+	})
+	assertion.Equal(t, true, filteredInformer == tweaked.PodInformer())
+	// The informer is not shared if TweakListOptions modifies the options to the different.
+	otherTweaked := k8sclient.NewCoreV1InformerWithOptions(c, s.CoreV1, 30*time.Second, k8sclient.InformerOptions{
+		Namespaces:       []string{"tenant-1", "tenant-2"},
+		TweakListOptions: func(options *k8smetav1.ListOptions) { options.LabelSelector = "app=other" },
+	})
+	assertion.Equal(t, false, filteredInformer == otherTweaked.PodInformer())
+	// The cluster scoped informer is not affected by the namespaces.
+	assertion.Equal(t, true, factory.InformerFor(&corev1.Node{}) == k8sclient.NewCoreV1InformerWithOptions(c, s.CoreV1, 30*time.Second, k8sclient.InformerOptions{Namespaces: []string{"tenant-1"}}).NodeInformer())
+
+	ctx, cancel := context.WithCancel(t.Context())
+	defer cancel()
+	factory.Run(ctx)
+	assertion.Equal(t, true, cache.WaitForCacheSync(ctx.Done(), informer.HasSynced, filteredInformer.HasSynced))
+	assertion.Len(t, informer.GetStore().List(), 4)
+	assertion.Len(t, filteredInformer.GetStore().List(), 2)
+
+	// The events of all namespaces are received by the informer.
+	_, err := s.CoreV1.CreatePod(t.Context(), &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "test-5", Namespace: "tenant-2", Labels: map[string]string{"app": "test"}}}, metav1.CreateOptions{})
+	assertion.MustNoError(t, err)
+	_, err = s.CoreV1.CreatePod(t.Context(), &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "test-6", Namespace: "tenant-1", Labels: map[string]string{"app": "other"}}}, metav1.CreateOptions{})
+	assertion.MustNoError(t, err)
+	_, err = s.CoreV1.CreatePod(t.Context(), &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "test-7", Namespace: "tenant-1", Labels: map[string]string{"app": "test"}}}, metav1.CreateOptions{})
+	assertion.MustNoError(t, err)
+	deadline := time.Now().Add(5 * time.Second)
+	for len(filteredInformer.GetStore().List()) != 4 || len(informer.GetStore().List()) != 7 {
+		if time.Now().After(deadline) {
+			t.Fatalf("the informers are not updated: %d, %d", len(filteredInformer.GetStore().List()), len(informer.GetStore().List()))
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	pods, err := k8sclient.NewCoreV1PodLister(filteredInformer.GetIndexer()).List("tenant-1", labels.Everything())
+	assertion.MustNoError(t, err)
+	assertion.Len(t, pods, 2)
+}
+
+func TestTestingClient_Indexer(t *testing.T) {
+	s := NewSet()
+	owner := metav1.OwnerReference{APIVersion: "apps/v1", Kind: "ReplicaSet", Name: "test", UID: "owner-1"}
+	for _, v := range []struct {
+		name, node, app string
+		owners          []metav1.OwnerReference
+	}{
+		{"test-1", "node-1", "test", []metav1.OwnerReference{owner}},
+		{"test-2", "node-1", "other", []metav1.OwnerReference{owner}},
+		{"test-3", "node-2", "test", nil},
+	} {
+		pod := &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: v.name, Namespace: metav1.NamespaceDefault, Labels: map[string]string{"app": v.app}, OwnerReferences: v.owners},
+			Spec:       &corev1.PodSpec{NodeName: v.node},
+		}
+		assertion.MustNoError(t, s.Tracker().Add(pod))
+	}
+
+	factory := k8sclient.NewInformerFactory(&s.Set, k8sclient.NewInformerCache(), metav1.NamespaceAll, 30*time.Second)
+	err := factory.AddIndexers(&corev1.Pod{}, cache.Indexers{
+		typedclient.IndexOwnerUID:         typedclient.OwnerUIDIndexFunc,
+		typedclient.LabelIndexName("app"): typedclient.LabelIndexFunc("app"),
+	})
+	assertion.MustNoError(t, err)
+	// The indexer which is already registered is ignored.
+	err = factory.AddIndexers(&corev1.Pod{}, cache.Indexers{typedclient.IndexOwnerUID: typedclient.OwnerUIDIndexFunc})
+	assertion.MustNoError(t, err)
+
+	ctx, cancel := context.WithCancel(t.Context())
+	defer cancel()
+	factory.Run(ctx)
+	informer := factory.InformerFor(&corev1.Pod{})
+	assertion.Equal(t, true, cache.WaitForCacheSync(ctx.Done(), informer.HasSynced))
+
+	lister := k8sclient.NewCoreV1PodLister(informer.GetIndexer())
+	pods, err := lister.ListByIndex(typedclient.IndexOwnerUID, "owner-1")
+	assertion.MustNoError(t, err)
+	assertion.Len(t, pods, 2)
+	pods, err = lister.ListByIndex(typedclient.LabelIndexName("app"), "test")
+	assertion.MustNoError(t, err)
+	assertion.Len(t, pods, 2)
+	// The index of the field which is marked in the proto is registered by default.
+	pods, err = lister.ListByIndex(k8sclient.CoreV1PodIndexSpecNodeName, "node-2")
+	assertion.MustNoError(t, err)
+	assertion.Len(t, pods, 1)
+	assertion.Equal(t, "test-3", pods[0].Name)
+	_, err = lister.ListByIndex("unknown", "")
+	assertion.Equal(t, true, err != nil)
+}
@@ -0,0 +1,153 @@
+// Package patch provides the builder of JSON patch (RFC 6902) and JSON merge patch (RFC 7386).
+// The typed builders of each Kind are generated by protoc-gen-object on top of Builder.
+package patch
+
+import (
+	"encoding/json"
+	"errors"
+	"strings"
+)
+
+const (
+	opAdd    = "add"
+	opRemove = "remove"
+)
+
+type operation struct {
+	Op    string
+	Path  []string
+	Value any
+	// Append indicates that Value is appended to the list.
+	Append bool
+}
+
+func (o operation) pointer() string {
+	var b strings.Builder
+	for _, v := range o.Path {
+		b.WriteByte('/')
+		b.WriteString(escape(v))
+	}
+	if o.Append {
+		b.WriteString("/-")
+	}
+	return b.String()
+}
+
+// Builder builds the patch from the operations.
+// The same operations can be built as either JSON patch or JSON merge patch.
+type Builder struct {
+	ops []operation
+}
+
+func NewBuilder() *Builder {
+	return &Builder{}
+}
+
+// Set sets value to the field which is pointed by path.
+// In JSON patch, Set is the "add" operation. Hence the field is replaced if it exists.
+// The "add" operation requires the parent of the field. e.g. Set([]string{"metadata", "labels", "app"}, v) fails
+// if the object has no label. The parents are created in JSON merge patch.
+func (b *Builder) Set(path []string, value any) {
+	b.ops = append(b.ops, operation{Op: opAdd, Path: path, Value: value})
+}
+
+// Append appends value to the list which is pointed by path.
+// Append can't be represented by JSON merge patch.
+func (b *Builder) Append(path []string, value any) {
+	b.ops = append(b.ops, operation{Op: opAdd, Path: path, Value: value, Append: true})
+}
+
+// Remove removes the field which is pointed by path.
+// In JSON patch, the patch will fail if the field doesn't exist.
+func (b *Builder) Remove(path []string) {
+	b.ops = append(b.ops, operation{Op: opRemove, Path: path})
+}
+
+// JSONPatch returns the JSON patch (RFC 6902).
+// The patch fails to be applied if the parent of the path of any operation doesn't exist in the object.
+// The builder doesn't create the parents because the parent can't be added without replacing the existing value.
+// Use MergePatch if the parents may not exist.
+func (b *Builder) JSONPatch() ([]byte, error) {
+	type jsonPatchOperation struct {
+		Op    string `json:"op"`
+		Path  string `json:"path"`
+		Value any    `json:"value"`
+	}
+	type jsonPatchRemoveOperation struct {
+		Op   string `json:"op"`
+		Path string `json:"path"`
+	}
+
+	ops := make([]any, 0, len(b.ops))
+	for _, v := range b.ops {
+		switch v.Op {
+		case opRemove:
+			ops = append(ops, jsonPatchRemoveOperation{Op: v.Op, Path: v.pointer()})
+		default:
+			ops = append(ops, jsonPatchOperation{Op: v.Op, Path: v.pointer(), Value: v.Value})
+		}
+	}
+	return json.Marshal(ops)
+}
+
+// MergePatch returns the JSON merge patch (RFC 7386).
+// MergePatch returns an error if the builder has Append operations because merge patch replaces the whole list.
+func (b *Builder) MergePatch() ([]byte, error) {
+	patch := make(map[string]any)
+	for _, v := range b.ops {
+		if v.Append {
+			return nil, errors.New("patch: merge patch can't append the value to the list")
+		}
+		if len(v.Path) == 0 {
+			return nil, errors.New("patch: the path is empty")
+		}
+
+		parent := patch
+		for _, key := range v.Path[:len(v.Path)-1] {
+			child, err := toObject(parent[key])
+			if err != nil {
+				return nil, err
+			}
+			parent[key] = child
+			parent = child
+		}
+
+		key := v.Path[len(v.Path)-1]
+		switch v.Op {
+		case opRemove:
+			parent[key] = nil
+		default:
+			parent[key] = v.Value
+		}
+	}
+
+	return json.Marshal(patch)
+}
+
+// toObject converts v to the JSON object so that the child field can be set.
+func toObject(v any) (map[string]any, error) {
+	switch o := v.(type) {
+	case nil:
+		return make(map[string]any), nil
+	case map[string]any:
+		return o, nil
+	}
+
+	buf, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	var obj map[string]any
+	if err := json.Unmarshal(buf, &obj); err != nil {
+		return nil, err
+	}
+	if obj == nil {
+		obj = make(map[string]any)
+	}
+	return obj, nil
+}
+
+// escape escapes the reference token of JSON pointer (RFC 6901).
+func escape(s string) string {
+	return strings.NewReplacer("~", "~0", "/", "~1").Replace(s)
+}
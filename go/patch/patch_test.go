@@ -0,0 +1,48 @@
+package patch
+
+import (
+	"testing"
+
+	"go.f110.dev/kubeproto/go/internal/assertion"
+)
+
+func TestBuilder(t *testing.T) {
+	b := NewBuilder()
+	b.Set([]string{"spec", "title"}, "foo")
+	b.Set([]string{"metadata", "annotations", "example.com/owner"}, "")
+	b.Remove([]string{"spec", "description"})
+
+	jsonPatch, err := b.JSONPatch()
+	assertion.MustNoError(t, err)
+	assertion.Equal(t,
+		`[{"op":"add","path":"/spec/title","value":"foo"},{"op":"add","path":"/metadata/annotations/example.com~1owner","value":""},{"op":"remove","path":"/spec/description"}]`,
+		string(jsonPatch),
+	)
+
+	mergePatch, err := b.MergePatch()
+	assertion.MustNoError(t, err)
+	assertion.Equal(t,
+		`{"metadata":{"annotations":{"example.com/owner":""}},"spec":{"description":null,"title":"foo"}}`,
+		string(mergePatch),
+	)
+
+	b.Append([]string{"spec", "tags"}, "bar")
+	jsonPatch, err = b.JSONPatch()
+	assertion.MustNoError(t, err)
+	assertion.Equal(t, true, len(jsonPatch) > 0)
+	_, err = b.MergePatch()
+	assertion.Equal(t, true, err != nil)
+}
+
+func TestBuilder_MergePatchIntoValue(t *testing.T) {
+	type spec struct {
+		Title string `json:"title"`
+	}
+	b := NewBuilder()
+	b.Set([]string{"spec"}, spec{Title: "foo"})
+	b.Set([]string{"spec", "subject"}, "bar")
+
+	mergePatch, err := b.MergePatch()
+	assertion.MustNoError(t, err)
+	assertion.Equal(t, `{"spec":{"subject":"bar","title":"foo"}}`, string(mergePatch))
+}
@@ -0,0 +1,359 @@
+package typedclient
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+	"time"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/rest"
+
+	"go.f110.dev/kubeproto/go/apis/metav1"
+)
+
+// Backend is the transport of the typed clients.
+// The resource is specified by the name of the resource (e.g. "pods") or GroupVersionResource.
+// SubResource calls the sub resource by verb which is the HTTP method. obj is the request body and can be nil.
+type Backend interface {
+	Get(ctx context.Context, resourceName, namespace, name string, opts metav1.GetOptions, result runtime.Object) (runtime.Object, error)
+	List(ctx context.Context, resourceName, namespace string, opts metav1.ListOptions, result runtime.Object) (runtime.Object, error)
+	Create(ctx context.Context, resourceName string, obj runtime.Object, opts metav1.CreateOptions, result runtime.Object) (runtime.Object, error)
+	Update(ctx context.Context, resourceName string, obj runtime.Object, opts metav1.UpdateOptions, result runtime.Object) (runtime.Object, error)
+	UpdateStatus(ctx context.Context, resourceName string, obj runtime.Object, opts metav1.UpdateOptions, result runtime.Object) (runtime.Object, error)
+	Delete(ctx context.Context, gvr schema.GroupVersionResource, namespace, name string, opts metav1.DeleteOptions) error
+	DeleteCollection(ctx context.Context, gvr schema.GroupVersionResource, namespace string, opts metav1.DeleteOptions, listOpts metav1.ListOptions) error
+	Watch(ctx context.Context, gvr schema.GroupVersionResource, namespace string, opts metav1.ListOptions) (watch.Interface, error)
+	Patch(ctx context.Context, resourceName, namespace, name string, pt types.PatchType, data []byte, opts metav1.PatchOptions, result runtime.Object, subresources ...string) (runtime.Object, error)
+	SubResource(ctx context.Context, verb string, gvr schema.GroupVersionResource, namespace, name, subresource string, obj, opts, result runtime.Object) (runtime.Object, error)
+	GetClusterScoped(ctx context.Context, resourceName, name string, opts metav1.GetOptions, result runtime.Object) (runtime.Object, error)
+	ListClusterScoped(ctx context.Context, resourceName string, opts metav1.ListOptions, result runtime.Object) (runtime.Object, error)
+	CreateClusterScoped(ctx context.Context, resourceName string, obj runtime.Object, opts metav1.CreateOptions, result runtime.Object) (runtime.Object, error)
+	UpdateClusterScoped(ctx context.Context, resourceName string, obj runtime.Object, opts metav1.UpdateOptions, result runtime.Object) (runtime.Object, error)
+	UpdateStatusClusterScoped(ctx context.Context, resourceName string, obj runtime.Object, opts metav1.UpdateOptions, result runtime.Object) (runtime.Object, error)
+	DeleteClusterScoped(ctx context.Context, gvr schema.GroupVersionResource, name string, opts metav1.DeleteOptions) error
+	DeleteCollectionClusterScoped(ctx context.Context, gvr schema.GroupVersionResource, opts metav1.DeleteOptions, listOpts metav1.ListOptions) error
+	WatchClusterScoped(ctx context.Context, gvr schema.GroupVersionResource, opts metav1.ListOptions) (watch.Interface, error)
+	PatchClusterScoped(ctx context.Context, resourceName, name string, pt types.PatchType, data []byte, opts metav1.PatchOptions, result runtime.Object, subresources ...string) (runtime.Object, error)
+	SubResourceClusterScoped(ctx context.Context, verb string, gvr schema.GroupVersionResource, name, subresource string, obj, opts, result runtime.Object) (runtime.Object, error)
+	// ProxyRoundTripper returns the http.RoundTripper which sends the requests to the port of the object
+	// through the proxy sub resource. resourceName is "services" or "pods".
+	ProxyRoundTripper(resourceName, namespace, name, port string) http.RoundTripper
+
+	RESTClient() *rest.RESTClient
+}
+
+// RESTBackend is the Backend which sends the requests to the API server through rest.RESTClient.
+type RESTBackend struct {
+	client *rest.RESTClient
+	codec  runtime.ParameterCodec
+}
+
+var _ Backend = &RESTBackend{}
+
+// NewRESTBackend returns the Backend of client.
+// codec is used to encode the options to the query parameters.
+func NewRESTBackend(client *rest.RESTClient, codec runtime.ParameterCodec) *RESTBackend {
+	return &RESTBackend{client: client, codec: codec}
+}
+
+func (r *RESTBackend) Get(ctx context.Context, resourceName, namespace, name string, opts metav1.GetOptions, result runtime.Object) (runtime.Object, error) {
+	return result, r.client.Get().
+		Namespace(namespace).
+		Resource(resourceName).
+		Name(name).
+		VersionedParams(&opts, r.codec).
+		Do(ctx).
+		Into(result)
+}
+
+func (r *RESTBackend) List(ctx context.Context, resourceName, namespace string, opts metav1.ListOptions, result runtime.Object) (runtime.Object, error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds > 0 {
+		timeout = time.Duration(opts.TimeoutSeconds) * time.Second
+	}
+	return result, r.client.Get().
+		Namespace(namespace).
+		Resource(resourceName).
+		VersionedParams(&opts, r.codec).
+		Timeout(timeout).
+		Do(ctx).
+		Into(result)
+}
+
+func (r *RESTBackend) Create(ctx context.Context, resourceName string, obj runtime.Object, opts metav1.CreateOptions, result runtime.Object) (runtime.Object, error) {
+	m := obj.(metav1.Object)
+	if m == nil {
+		return nil, errors.New("obj is not implement metav1.Object")
+	}
+	meta := m.GetObjectMeta()
+	return result, r.client.Post().
+		Namespace(meta.Namespace).
+		Resource(resourceName).
+		VersionedParams(&opts, r.codec).
+		Body(obj).
+		Do(ctx).
+		Into(result)
+}
+
+func (r *RESTBackend) Update(ctx context.Context, resourceName string, obj runtime.Object, opts metav1.UpdateOptions, result runtime.Object) (runtime.Object, error) {
+	m := obj.(metav1.Object)
+	if m == nil {
+		return nil, errors.New("obj is not implement metav1.Object")
+	}
+	meta := m.GetObjectMeta()
+	return result, r.client.Put().
+		Namespace(meta.Namespace).
+		Resource(resourceName).
+		Name(meta.Name).
+		VersionedParams(&opts, r.codec).
+		Body(obj).
+		Do(ctx).
+		Into(result)
+}
+
+func (r *RESTBackend) UpdateStatus(ctx context.Context, resourceName string, obj runtime.Object, opts metav1.UpdateOptions, result runtime.Object) (runtime.Object, error) {
+	m := obj.(metav1.Object)
+	if m == nil {
+		return nil, errors.New("obj is not implement metav1.Object")
+	}
+	meta := m.GetObjectMeta()
+	return result, r.client.Put().
+		Namespace(meta.Namespace).
+		Resource(resourceName).
+		Name(meta.Name).
+		SubResource("status").
+		VersionedParams(&opts, r.codec).
+		Body(obj).
+		Do(ctx).
+		Into(result)
+}
+
+func (r *RESTBackend) Delete(ctx context.Context, gvr schema.GroupVersionResource, namespace, name string, opts metav1.DeleteOptions) error {
+	return r.client.Delete().
+		Namespace(namespace).
+		Resource(gvr.Resource).
+		Name(name).
+		Body(&opts).
+		Do(ctx).
+		Error()
+}
+
+func (r *RESTBackend) DeleteCollection(ctx context.Context, gvr schema.GroupVersionResource, namespace string, opts metav1.DeleteOptions, listOpts metav1.ListOptions) error {
+	var timeout time.Duration
+	if listOpts.TimeoutSeconds > 0 {
+		timeout = time.Duration(listOpts.TimeoutSeconds) * time.Second
+	}
+	return r.client.Delete().
+		Namespace(namespace).
+		Resource(gvr.Resource).
+		VersionedParams(&listOpts, r.codec).
+		Timeout(timeout).
+		Body(&opts).
+		Do(ctx).
+		Error()
+}
+
+func (r *RESTBackend) Watch(ctx context.Context, gvr schema.GroupVersionResource, namespace string, opts metav1.ListOptions) (watch.Interface, error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds > 0 {
+		timeout = time.Duration(opts.TimeoutSeconds) * time.Second
+	}
+	opts.Watch = true
+	return r.client.Get().
+		Namespace(namespace).
+		Resource(gvr.Resource).
+		VersionedParams(&opts, r.codec).
+		Timeout(timeout).
+		Watch(ctx)
+}
+
+func (r *RESTBackend) Patch(ctx context.Context, resourceName, namespace, name string, pt types.PatchType, data []byte, opts metav1.PatchOptions, result runtime.Object, subresources ...string) (runtime.Object, error) {
+	return result, r.client.Patch(pt).
+		Namespace(namespace).
+		Resource(resourceName).
+		Name(name).
+		SubResource(subresources...).
+		VersionedParams(&opts, r.codec).
+		Body(data).
+		Do(ctx).
+		Into(result)
+}
+
+func (r *RESTBackend) SubResource(ctx context.Context, verb string, gvr schema.GroupVersionResource, namespace, name, subresource string, obj, opts, result runtime.Object) (runtime.Object, error) {
+	req := r.client.Verb(verb).
+		Namespace(namespace).
+		Resource(gvr.Resource).
+		Name(name).
+		SubResource(subresource).
+		VersionedParams(opts, r.codec)
+	if obj != nil {
+		req = req.Body(obj)
+	}
+	return result, req.Do(ctx).Into(result)
+}
+
+func (r *RESTBackend) GetClusterScoped(ctx context.Context, resourceName, name string, opts metav1.GetOptions, result runtime.Object) (runtime.Object, error) {
+	return result, r.client.Get().
+		Resource(resourceName).
+		Name(name).
+		VersionedParams(&opts, r.codec).
+		Do(ctx).
+		Into(result)
+}
+
+func (r *RESTBackend) ListClusterScoped(ctx context.Context, resourceName string, opts metav1.ListOptions, result runtime.Object) (runtime.Object, error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds > 0 {
+		timeout = time.Duration(opts.TimeoutSeconds) * time.Second
+	}
+	return result, r.client.Get().
+		Resource(resourceName).
+		VersionedParams(&opts, r.codec).
+		Timeout(timeout).
+		Do(ctx).
+		Into(result)
+}
+
+func (r *RESTBackend) CreateClusterScoped(ctx context.Context, resourceName string, obj runtime.Object, opts metav1.CreateOptions, result runtime.Object) (runtime.Object, error) {
+	return result, r.client.Post().
+		Resource(resourceName).
+		VersionedParams(&opts, r.codec).
+		Body(obj).
+		Do(ctx).
+		Into(result)
+}
+
+func (r *RESTBackend) UpdateClusterScoped(ctx context.Context, resourceName string, obj runtime.Object, opts metav1.UpdateOptions, result runtime.Object) (runtime.Object, error) {
+	m := obj.(metav1.Object)
+	if m == nil {
+		return nil, errors.New("obj is not implement metav1.Object")
+	}
+	meta := m.GetObjectMeta()
+	return result, r.client.Put().
+		Resource(resourceName).
+		Name(meta.Name).
+		VersionedParams(&opts, r.codec).
+		Body(obj).
+		Do(ctx).
+		Into(result)
+}
+
+func (r *RESTBackend) UpdateStatusClusterScoped(ctx context.Context, resourceName string, obj runtime.Object, opts metav1.UpdateOptions, result runtime.Object) (runtime.Object, error) {
+	m := obj.(metav1.Object)
+	if m == nil {
+		return nil, errors.New("obj is not implement metav1.Object")
+	}
+	meta := m.GetObjectMeta()
+	return result, r.client.Put().
+		Resource(resourceName).
+		Name(meta.Name).
+		SubResource("status").
+		VersionedParams(&opts, r.codec).
+		Body(obj).
+		Do(ctx).
+		Into(result)
+}
+
+func (r *RESTBackend) DeleteClusterScoped(ctx context.Context, gvr schema.GroupVersionResource, name string, opts metav1.DeleteOptions) error {
+	return r.client.Delete().
+		Resource(gvr.Resource).
+		Name(name).
+		Body(&opts).
+		Do(ctx).
+		Error()
+}
+
+func (r *RESTBackend) DeleteCollectionClusterScoped(ctx context.Context, gvr schema.GroupVersionResource, opts metav1.DeleteOptions, listOpts metav1.ListOptions) error {
+	var timeout time.Duration
+	if listOpts.TimeoutSeconds > 0 {
+		timeout = time.Duration(listOpts.TimeoutSeconds) * time.Second
+	}
+	return r.client.Delete().
+		Resource(gvr.Resource).
+		VersionedParams(&listOpts, r.codec).
+		Timeout(timeout).
+		Body(&opts).
+		Do(ctx).
+		Error()
+}
+
+func (r *RESTBackend) WatchClusterScoped(ctx context.Context, gvr schema.GroupVersionResource, opts metav1.ListOptions) (watch.Interface, error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds > 0 {
+		timeout = time.Duration(opts.TimeoutSeconds) * time.Second
+	}
+	opts.Watch = true
+	return r.client.Get().
+		Resource(gvr.Resource).
+		VersionedParams(&opts, r.codec).
+		Timeout(timeout).
+		Watch(ctx)
+}
+
+func (r *RESTBackend) PatchClusterScoped(ctx context.Context, resourceName, name string, pt types.PatchType, data []byte, opts metav1.PatchOptions, result runtime.Object, subresources ...string) (runtime.Object, error) {
+	return result, r.client.Patch(pt).
+		Resource(resourceName).
+		Name(name).
+		SubResource(subresources...).
+		VersionedParams(&opts, r.codec).
+		Body(data).
+		Do(ctx).
+		Into(result)
+}
+
+func (r *RESTBackend) SubResourceClusterScoped(ctx context.Context, verb string, gvr schema.GroupVersionResource, name, subresource string, obj, opts, result runtime.Object) (runtime.Object, error) {
+	req := r.client.Verb(verb).
+		Resource(gvr.Resource).
+		Name(name).
+		SubResource(subresource).
+		VersionedParams(opts, r.codec)
+	if obj != nil {
+		req = req.Body(obj)
+	}
+	return result, req.Do(ctx).Into(result)
+}
+
+func (r *RESTBackend) ProxyRoundTripper(resourceName, namespace, name, port string) http.RoundTripper {
+	return &proxyRoundTripper{client: r.client, resourceName: resourceName, namespace: namespace, name: name, port: port}
+}
+
+func (r *RESTBackend) RESTClient() *rest.RESTClient {
+	return r.client
+}
+
+// proxyRoundTripper rewrites the URL of the request to the URL of the proxy sub resource.
+// The scheme of the request is passed to the API server as the scheme for the object.
+type proxyRoundTripper struct {
+	client       *rest.RESTClient
+	resourceName string
+	namespace    string
+	name         string
+	port         string
+}
+
+func (p *proxyRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	name := p.name
+	if p.port != "" {
+		name += ":" + p.port
+	}
+	if req.URL.Scheme == "https" {
+		name = "https:" + name
+	}
+	u := p.client.Get().Namespace(p.namespace).Resource(p.resourceName).Name(name).SubResource("proxy").URL()
+	u.Path = strings.TrimSuffix(u.Path, "/") + "/" + strings.TrimPrefix(req.URL.Path, "/")
+	u.RawQuery = req.URL.RawQuery
+
+	r := req.Clone(req.Context())
+	r.URL = u
+	r.Host = ""
+	transport := p.client.Client.Transport
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+	return transport.RoundTrip(r)
+}
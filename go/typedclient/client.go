@@ -0,0 +1,130 @@
+package typedclient
+
+import (
+	"context"
+	"reflect"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/watch"
+
+	"go.f110.dev/kubeproto/go/apis/metav1"
+)
+
+// ResourceClient is the client of the namespaced resource.
+// T is the pointer of the object and L is the pointer of the list of the object.
+type ResourceClient[T runtime.Object, L runtime.Object] struct {
+	backend Backend
+	gvr     schema.GroupVersionResource
+}
+
+func NewResourceClient[T runtime.Object, L runtime.Object](b Backend, gvr schema.GroupVersionResource) *ResourceClient[T, L] {
+	return &ResourceClient[T, L]{backend: b, gvr: gvr}
+}
+
+func (c *ResourceClient[T, L]) Get(ctx context.Context, namespace, name string, opts metav1.GetOptions) (T, error) {
+	return result[T](c.backend.Get(ctx, c.gvr.Resource, namespace, name, opts, newObject[T]()))
+}
+
+func (c *ResourceClient[T, L]) List(ctx context.Context, namespace string, opts metav1.ListOptions) (L, error) {
+	return result[L](c.backend.List(ctx, c.gvr.Resource, namespace, opts, newObject[L]()))
+}
+
+func (c *ResourceClient[T, L]) Create(ctx context.Context, v T, opts metav1.CreateOptions) (T, error) {
+	return result[T](c.backend.Create(ctx, c.gvr.Resource, v, opts, newObject[T]()))
+}
+
+func (c *ResourceClient[T, L]) Update(ctx context.Context, v T, opts metav1.UpdateOptions) (T, error) {
+	return result[T](c.backend.Update(ctx, c.gvr.Resource, v, opts, newObject[T]()))
+}
+
+func (c *ResourceClient[T, L]) UpdateStatus(ctx context.Context, v T, opts metav1.UpdateOptions) (T, error) {
+	return result[T](c.backend.UpdateStatus(ctx, c.gvr.Resource, v, opts, newObject[T]()))
+}
+
+func (c *ResourceClient[T, L]) Patch(ctx context.Context, namespace, name string, pt types.PatchType, data []byte, opts metav1.PatchOptions, subresources ...string) (T, error) {
+	return result[T](c.backend.Patch(ctx, c.gvr.Resource, namespace, name, pt, data, opts, newObject[T](), subresources...))
+}
+
+// SubResource calls the sub resource of the object. verb is the HTTP method and obj is the request body.
+func (c *ResourceClient[T, L]) SubResource(ctx context.Context, verb, namespace, name, subresource string, obj, opts, result runtime.Object) (runtime.Object, error) {
+	return c.backend.SubResource(ctx, verb, c.gvr, namespace, name, subresource, obj, opts, result)
+}
+
+func (c *ResourceClient[T, L]) Delete(ctx context.Context, namespace, name string, opts metav1.DeleteOptions) error {
+	return c.backend.Delete(ctx, c.gvr, namespace, name, opts)
+}
+
+func (c *ResourceClient[T, L]) DeleteCollection(ctx context.Context, namespace string, opts metav1.DeleteOptions, listOpts metav1.ListOptions) error {
+	return c.backend.DeleteCollection(ctx, c.gvr, namespace, opts, listOpts)
+}
+
+func (c *ResourceClient[T, L]) Watch(ctx context.Context, namespace string, opts metav1.ListOptions) (watch.Interface, error) {
+	return c.backend.Watch(ctx, c.gvr, namespace, opts)
+}
+
+// ClusterResourceClient is the client of the cluster-scoped resource.
+// T is the pointer of the object and L is the pointer of the list of the object.
+type ClusterResourceClient[T runtime.Object, L runtime.Object] struct {
+	backend Backend
+	gvr     schema.GroupVersionResource
+}
+
+func NewClusterResourceClient[T runtime.Object, L runtime.Object](b Backend, gvr schema.GroupVersionResource) *ClusterResourceClient[T, L] {
+	return &ClusterResourceClient[T, L]{backend: b, gvr: gvr}
+}
+
+func (c *ClusterResourceClient[T, L]) Get(ctx context.Context, name string, opts metav1.GetOptions) (T, error) {
+	return result[T](c.backend.GetClusterScoped(ctx, c.gvr.Resource, name, opts, newObject[T]()))
+}
+
+func (c *ClusterResourceClient[T, L]) List(ctx context.Context, opts metav1.ListOptions) (L, error) {
+	return result[L](c.backend.ListClusterScoped(ctx, c.gvr.Resource, opts, newObject[L]()))
+}
+
+func (c *ClusterResourceClient[T, L]) Create(ctx context.Context, v T, opts metav1.CreateOptions) (T, error) {
+	return result[T](c.backend.CreateClusterScoped(ctx, c.gvr.Resource, v, opts, newObject[T]()))
+}
+
+func (c *ClusterResourceClient[T, L]) Update(ctx context.Context, v T, opts metav1.UpdateOptions) (T, error) {
+	return result[T](c.backend.UpdateClusterScoped(ctx, c.gvr.Resource, v, opts, newObject[T]()))
+}
+
+func (c *ClusterResourceClient[T, L]) UpdateStatus(ctx context.Context, v T, opts metav1.UpdateOptions) (T, error) {
+	return result[T](c.backend.UpdateStatusClusterScoped(ctx, c.gvr.Resource, v, opts, newObject[T]()))
+}
+
+func (c *ClusterResourceClient[T, L]) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts metav1.PatchOptions, subresources ...string) (T, error) {
+	return result[T](c.backend.PatchClusterScoped(ctx, c.gvr.Resource, name, pt, data, opts, newObject[T](), subresources...))
+}
+
+// SubResource calls the sub resource of the object. verb is the HTTP method and obj is the request body.
+func (c *ClusterResourceClient[T, L]) SubResource(ctx context.Context, verb, name, subresource string, obj, opts, result runtime.Object) (runtime.Object, error) {
+	return c.backend.SubResourceClusterScoped(ctx, verb, c.gvr, name, subresource, obj, opts, result)
+}
+
+func (c *ClusterResourceClient[T, L]) Delete(ctx context.Context, name string, opts metav1.DeleteOptions) error {
+	return c.backend.DeleteClusterScoped(ctx, c.gvr, name, opts)
+}
+
+func (c *ClusterResourceClient[T, L]) DeleteCollection(ctx context.Context, opts metav1.DeleteOptions, listOpts metav1.ListOptions) error {
+	return c.backend.DeleteCollectionClusterScoped(ctx, c.gvr, opts, listOpts)
+}
+
+func (c *ClusterResourceClient[T, L]) Watch(ctx context.Context, opts metav1.ListOptions) (watch.Interface, error) {
+	return c.backend.WatchClusterScoped(ctx, c.gvr, opts)
+}
+
+// newObject returns the new object of T. T must be the pointer of the struct.
+func newObject[T runtime.Object]() T {
+	return reflect.New(reflect.TypeFor[T]().Elem()).Interface().(T)
+}
+
+func result[T runtime.Object](obj runtime.Object, err error) (T, error) {
+	if err != nil {
+		var zero T
+		return zero, err
+	}
+	return obj.(T), nil
+}
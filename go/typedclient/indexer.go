@@ -0,0 +1,59 @@
+package typedclient
+
+import (
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/client-go/tools/cache"
+)
+
+// IndexOwnerUID is the name of the index by the UID of the owners.
+const IndexOwnerUID = "metadata.ownerReferences.uid"
+
+// OwnerUIDIndexFunc indexes the object by the UID of the owners.
+func OwnerUIDIndexFunc(obj any) ([]string, error) {
+	m, err := meta.Accessor(obj)
+	if err != nil {
+		return nil, err
+	}
+	refs := m.GetOwnerReferences()
+	if len(refs) == 0 {
+		return nil, nil
+	}
+	uids := make([]string, 0, len(refs))
+	for _, v := range refs {
+		uids = append(uids, string(v.UID))
+	}
+	return uids, nil
+}
+
+// LabelIndexName returns the name of the index by the value of the label key.
+func LabelIndexName(key string) string {
+	return "metadata.labels." + key
+}
+
+// LabelIndexFunc returns the function which indexes the object by the value of the label key.
+// The object which doesn't have the label is not indexed.
+func LabelIndexFunc(key string) cache.IndexFunc {
+	return func(obj any) ([]string, error) {
+		m, err := meta.Accessor(obj)
+		if err != nil {
+			return nil, err
+		}
+		v, ok := m.GetLabels()[key]
+		if !ok {
+			return nil, nil
+		}
+		return []string{v}, nil
+	}
+}
+
+// MergeIndexers returns the indexers which has all indexers of a and b. The indexer of b is used if the name is duplicated.
+func MergeIndexers(a, b cache.Indexers) cache.Indexers {
+	indexers := make(cache.Indexers, len(a)+len(b))
+	for k, v := range a {
+		indexers[k] = v
+	}
+	for k, v := range b {
+		indexers[k] = v
+	}
+	return indexers
+}
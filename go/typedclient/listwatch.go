@@ -0,0 +1,264 @@
+package typedclient
+
+import (
+	"context"
+	"strconv"
+	"sync"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	k8smetav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/tools/cache"
+)
+
+// NamespacedListFunc lists the objects in namespace. namespace is empty for all namespaces.
+type NamespacedListFunc func(ctx context.Context, namespace string, options k8smetav1.ListOptions) (runtime.Object, error)
+
+// NamespacedWatchFunc watches the objects in namespace. namespace is empty for all namespaces.
+type NamespacedWatchFunc func(ctx context.Context, namespace string, options k8smetav1.ListOptions) (watch.Interface, error)
+
+// NewListWatch returns cache.ListerWatcher for the informer.
+// The objects in all namespaces are listed and watched if namespaces is empty.
+// tweakListOptions modifies the options of the list and the watch and can be nil.
+// client is used to find whether the client supports the streaming list (see cache.ToListWatcherWithWatchListSemantics).
+func NewListWatch(client any, namespaces []string, tweakListOptions func(*k8smetav1.ListOptions), list NamespacedListFunc, watchFunc NamespacedWatchFunc) cache.ListerWatcher {
+	if tweakListOptions == nil {
+		tweakListOptions = func(*k8smetav1.ListOptions) {}
+	}
+	if len(namespaces) > 1 {
+		return &MultiNamespaceListWatch{
+			namespaces:       namespaces,
+			tweakListOptions: tweakListOptions,
+			list:             list,
+			watch:            watchFunc,
+		}
+	}
+
+	var namespace string
+	if len(namespaces) == 1 {
+		namespace = namespaces[0]
+	}
+	return cache.ToListWatcherWithWatchListSemantics(&cache.ListWatch{
+		ListWithContextFunc: func(ctx context.Context, options k8smetav1.ListOptions) (runtime.Object, error) {
+			tweakListOptions(&options)
+			return list(ctx, namespace, options)
+		},
+		WatchFuncWithContext: func(ctx context.Context, options k8smetav1.ListOptions) (watch.Interface, error) {
+			tweakListOptions(&options)
+			return watchFunc(ctx, namespace, options)
+		},
+	}, client)
+}
+
+// MultiNamespaceListWatch lists and watches the objects in the set of namespaces as one resource.
+// The list is the concatenation of the lists of each namespace, and the watch is multiplexed.
+//
+// The resource version of the list and the event is the resource version of each namespace.
+// MultiNamespaceListWatch remembers the resource version of each namespace, and the watch which is started from
+// the last resource version is resumed from the resource version of each namespace.
+// The streaming list is not supported.
+type MultiNamespaceListWatch struct {
+	namespaces       []string
+	tweakListOptions func(*k8smetav1.ListOptions)
+	list             NamespacedListFunc
+	watch            NamespacedWatchFunc
+
+	mu sync.Mutex
+	// resourceVersions is the resource version which is received last in each namespace.
+	resourceVersions map[string]string
+	// lastResourceVersion is the resource version which is passed to the informer last.
+	lastResourceVersion string
+	current             *multiplexedWatcher
+}
+
+var _ cache.ListerWatcherWithContext = &MultiNamespaceListWatch{}
+
+func (lw *MultiNamespaceListWatch) List(options k8smetav1.ListOptions) (runtime.Object, error) {
+	return lw.ListWithContext(context.Background(), options)
+}
+
+func (lw *MultiNamespaceListWatch) Watch(options k8smetav1.ListOptions) (watch.Interface, error) {
+	return lw.WatchWithContext(context.Background(), options)
+}
+
+// ListWithContext lists the objects in all namespaces.
+// The result is not paginated because the continue token can't be shared between namespaces.
+func (lw *MultiNamespaceListWatch) ListWithContext(ctx context.Context, options k8smetav1.ListOptions) (runtime.Object, error) {
+	lw.tweakListOptions(&options)
+	options.Limit = 0
+	options.Continue = ""
+
+	var result runtime.Object
+	var items []runtime.Object
+	resourceVersions := make(map[string]string)
+	for _, ns := range lw.namespaces {
+		obj, err := lw.list(ctx, ns, options)
+		if err != nil {
+			return nil, err
+		}
+		listMeta, err := meta.ListAccessor(obj)
+		if err != nil {
+			return nil, err
+		}
+		resourceVersions[ns] = listMeta.GetResourceVersion()
+		l, err := meta.ExtractList(obj)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, l...)
+		if result == nil {
+			result = obj
+		}
+	}
+	if err := meta.SetList(result, items); err != nil {
+		return nil, err
+	}
+	listMeta, err := meta.ListAccessor(result)
+	if err != nil {
+		return nil, err
+	}
+	// The oldest resource version is used as the resource version of the list.
+	// Even if the watch is started from it, the events which are received again are handled as the update.
+	rv := oldestResourceVersion(resourceVersions)
+	listMeta.SetResourceVersion(rv)
+	listMeta.SetContinue("")
+
+	lw.mu.Lock()
+	lw.resourceVersions = resourceVersions
+	lw.lastResourceVersion = rv
+	lw.mu.Unlock()
+	return result, nil
+}
+
+// WatchWithContext watches the objects in all namespaces.
+// The returned watch is stopped when the watch of any namespace is closed.
+func (lw *MultiNamespaceListWatch) WatchWithContext(ctx context.Context, options k8smetav1.ListOptions) (watch.Interface, error) {
+	lw.tweakListOptions(&options)
+
+	// Wait for the previous watch so that the resource versions are not updated by it.
+	lw.mu.Lock()
+	prev := lw.current
+	lw.mu.Unlock()
+	if prev != nil {
+		prev.Stop()
+		prev.wg.Wait()
+	}
+
+	lw.mu.Lock()
+	resourceVersions := make(map[string]string)
+	for _, ns := range lw.namespaces {
+		resourceVersions[ns] = options.ResourceVersion
+		if options.ResourceVersion != "" && options.ResourceVersion == lw.lastResourceVersion {
+			if rv, ok := lw.resourceVersions[ns]; ok {
+				resourceVersions[ns] = rv
+			}
+		}
+	}
+	lw.resourceVersions = resourceVersions
+	lw.mu.Unlock()
+
+	mw := &multiplexedWatcher{result: make(chan watch.Event), done: make(chan struct{})}
+	lw.mu.Lock()
+	lw.current = mw
+	lw.mu.Unlock()
+	for _, ns := range lw.namespaces {
+		opts := options
+		opts.ResourceVersion = resourceVersions[ns]
+		w, err := lw.watch(ctx, ns, opts)
+		if err != nil {
+			mw.Stop()
+			return nil, err
+		}
+		mw.watchers = append(mw.watchers, w)
+	}
+
+	for i, w := range mw.watchers {
+		mw.wg.Add(1)
+		go lw.forward(mw, lw.namespaces[i], w)
+	}
+	go func() {
+		mw.wg.Wait()
+		close(mw.result)
+	}()
+	return mw, nil
+}
+
+// IsWatchListSemanticsUnSupported reports that the streaming list is not supported.
+func (lw *MultiNamespaceListWatch) IsWatchListSemanticsUnSupported() bool {
+	return true
+}
+
+func (lw *MultiNamespaceListWatch) forward(mw *multiplexedWatcher, namespace string, w watch.Interface) {
+	defer mw.wg.Done()
+	// If the watch of the namespace is closed, the other watches are stopped too.
+	// The informer starts the new watch from the last resource version.
+	defer mw.Stop()
+
+	for {
+		select {
+		case <-mw.done:
+			return
+		case ev, ok := <-w.ResultChan():
+			if !ok {
+				return
+			}
+			select {
+			case mw.result <- ev:
+			case <-mw.done:
+				return
+			}
+
+			// The resource version is recorded after the event is passed to the informer.
+			if ev.Type != watch.Error {
+				if m, err := meta.Accessor(ev.Object); err == nil {
+					lw.mu.Lock()
+					lw.resourceVersions[namespace] = m.GetResourceVersion()
+					lw.lastResourceVersion = m.GetResourceVersion()
+					lw.mu.Unlock()
+				}
+			}
+		}
+	}
+}
+
+type multiplexedWatcher struct {
+	watchers []watch.Interface
+	result   chan watch.Event
+	wg       sync.WaitGroup
+
+	once sync.Once
+	done chan struct{}
+}
+
+var _ watch.Interface = &multiplexedWatcher{}
+
+func (w *multiplexedWatcher) Stop() {
+	w.once.Do(func() {
+		close(w.done)
+		for _, v := range w.watchers {
+			v.Stop()
+		}
+	})
+}
+
+func (w *multiplexedWatcher) ResultChan() <-chan watch.Event {
+	return w.result
+}
+
+// oldestResourceVersion returns the oldest resource version in resourceVersions.
+// The resource version is compared as the integer. If any resource version is not the integer, returns empty.
+func oldestResourceVersion(resourceVersions map[string]string) string {
+	var oldest uint64
+	var rv string
+	for _, v := range resourceVersions {
+		i, err := strconv.ParseUint(v, 10, 64)
+		if err != nil {
+			return ""
+		}
+		if rv == "" || i < oldest {
+			oldest, rv = i, v
+		}
+	}
+	return rv
+}
@@ -0,0 +1,76 @@
+package typedclient
+
+import (
+	"context"
+	"testing"
+
+	k8smetav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/watch"
+
+	"go.f110.dev/kubeproto/go/apis/corev1"
+	"go.f110.dev/kubeproto/go/apis/metav1"
+	"go.f110.dev/kubeproto/go/internal/assertion"
+)
+
+func TestMultiNamespaceListWatch(t *testing.T) {
+	listResourceVersions := map[string]string{"tenant-1": "10", "tenant-2": "20"}
+	watchers := make(map[string]*watch.FakeWatcher)
+	watchResourceVersions := make(map[string]string)
+	lw := NewListWatch(nil, []string{"tenant-1", "tenant-2"},
+		func(options *k8smetav1.ListOptions) { options.LabelSelector = "app=test" },
+		func(_ context.Context, namespace string, options k8smetav1.ListOptions) (runtime.Object, error) {
+			assertion.Equal(t, "app=test", options.LabelSelector)
+			assertion.Equal(t, int64(0), options.Limit)
+			return &corev1.PodList{
+				ListMeta: metav1.ListMeta{ResourceVersion: listResourceVersions[namespace]},
+				Items:    []corev1.Pod{{ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: namespace}}},
+			}, nil
+		},
+		func(_ context.Context, namespace string, options k8smetav1.ListOptions) (watch.Interface, error) {
+			watchResourceVersions[namespace] = options.ResourceVersion
+			watchers[namespace] = watch.NewFake()
+			return watchers[namespace], nil
+		},
+	)
+	_, ok := lw.(*MultiNamespaceListWatch)
+	assertion.Equal(t, true, ok)
+
+	obj, err := lw.List(k8smetav1.ListOptions{Limit: 500})
+	assertion.MustNoError(t, err)
+	list := obj.(*corev1.PodList)
+	assertion.Len(t, list.Items, 2)
+	// The oldest resource version is used.
+	assertion.Equal(t, "10", list.ResourceVersion)
+
+	// The watch is started from the resource version of each namespace.
+	w, err := lw.Watch(k8smetav1.ListOptions{ResourceVersion: "10"})
+	assertion.MustNoError(t, err)
+	assertion.Equal(t, "10", watchResourceVersions["tenant-1"])
+	assertion.Equal(t, "20", watchResourceVersions["tenant-2"])
+	watchers["tenant-2"].Add(&corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "test-2", Namespace: "tenant-2", ResourceVersion: "25"}})
+	ev := <-w.ResultChan()
+	assertion.Equal(t, watch.Added, ev.Type)
+
+	// The watch is resumed from the last resource version of each namespace.
+	watchers["tenant-1"].Stop()
+	_, ok = <-w.ResultChan()
+	assertion.Equal(t, false, ok)
+	w, err = lw.Watch(k8smetav1.ListOptions{ResourceVersion: "25"})
+	assertion.MustNoError(t, err)
+	assertion.Equal(t, "10", watchResourceVersions["tenant-1"])
+	assertion.Equal(t, "25", watchResourceVersions["tenant-2"])
+	w.Stop()
+
+	// The unknown resource version is used for all namespaces.
+	w, err = lw.Watch(k8smetav1.ListOptions{ResourceVersion: "30"})
+	assertion.MustNoError(t, err)
+	assertion.Equal(t, "30", watchResourceVersions["tenant-1"])
+	assertion.Equal(t, "30", watchResourceVersions["tenant-2"])
+	w.Stop()
+
+	// The streaming list is not used.
+	assertion.Equal(t, true, lw.(interface{ IsWatchListSemanticsUnSupported() bool }).IsWatchListSemanticsUnSupported())
+	_, ok = NewListWatch(nil, []string{"tenant-1"}, nil, nil, nil).(*MultiNamespaceListWatch)
+	assertion.Equal(t, false, ok)
+}
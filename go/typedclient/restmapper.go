@@ -0,0 +1,153 @@
+package typedclient
+
+import (
+	"strings"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// ResourceMapping is the mapping between Kind and the resource.
+type ResourceMapping struct {
+	GroupVersionKind schema.GroupVersionKind
+	// Resource is the plural name of the resource. (e.g. pods)
+	Resource string
+	// SingularResource is the singular name of the resource. (e.g. pod)
+	SingularResource string
+	ShortNames       []string
+	Namespaced       bool
+}
+
+// GroupVersionResource returns the GroupVersionResource of the mapping.
+func (m ResourceMapping) GroupVersionResource() schema.GroupVersionResource {
+	return m.GroupVersionKind.GroupVersion().WithResource(m.Resource)
+}
+
+func (m ResourceMapping) restMapping() *meta.RESTMapping {
+	scope := meta.RESTScopeRoot
+	if m.Namespaced {
+		scope = meta.RESTScopeNamespace
+	}
+	return &meta.RESTMapping{Resource: m.GroupVersionResource(), GroupVersionKind: m.GroupVersionKind, Scope: scope}
+}
+
+// matchResource reports whether the resource matches the mapping.
+// The resource can be the plural name, the singular name or the short name.
+// The empty group and the empty version match any group and any version.
+func (m ResourceMapping) matchResource(resource schema.GroupVersionResource) bool {
+	if resource.Group != "" && resource.Group != m.GroupVersionKind.Group {
+		return false
+	}
+	if resource.Version != "" && resource.Version != m.GroupVersionKind.Version {
+		return false
+	}
+	name := strings.ToLower(resource.Resource)
+	if name == m.Resource || name == m.SingularResource {
+		return true
+	}
+	for _, v := range m.ShortNames {
+		if name == v {
+			return true
+		}
+	}
+	return false
+}
+
+// StaticRESTMapper is meta.RESTMapper which resolves the fixed mappings.
+// The order of the mappings is the priority. If the resource or the kind is matched with some mappings,
+// the first mapping is preferred.
+type StaticRESTMapper struct {
+	mappings []ResourceMapping
+}
+
+var _ meta.RESTMapper = &StaticRESTMapper{}
+
+// NewStaticRESTMapper returns StaticRESTMapper of mappings.
+func NewStaticRESTMapper(mappings ...ResourceMapping) *StaticRESTMapper {
+	return &StaticRESTMapper{mappings: mappings}
+}
+
+// Mappings returns all mappings of the mapper.
+func (m *StaticRESTMapper) Mappings() []ResourceMapping {
+	return m.mappings
+}
+
+func (m *StaticRESTMapper) KindFor(resource schema.GroupVersionResource) (schema.GroupVersionKind, error) {
+	kinds, err := m.KindsFor(resource)
+	if err != nil {
+		return schema.GroupVersionKind{}, err
+	}
+	return kinds[0], nil
+}
+
+func (m *StaticRESTMapper) KindsFor(resource schema.GroupVersionResource) ([]schema.GroupVersionKind, error) {
+	var kinds []schema.GroupVersionKind
+	for _, v := range m.mappings {
+		if v.matchResource(resource) {
+			kinds = append(kinds, v.GroupVersionKind)
+		}
+	}
+	if len(kinds) == 0 {
+		return nil, &meta.NoResourceMatchError{PartialResource: resource}
+	}
+	return kinds, nil
+}
+
+func (m *StaticRESTMapper) ResourceFor(input schema.GroupVersionResource) (schema.GroupVersionResource, error) {
+	resources, err := m.ResourcesFor(input)
+	if err != nil {
+		return schema.GroupVersionResource{}, err
+	}
+	return resources[0], nil
+}
+
+func (m *StaticRESTMapper) ResourcesFor(input schema.GroupVersionResource) ([]schema.GroupVersionResource, error) {
+	var resources []schema.GroupVersionResource
+	for _, v := range m.mappings {
+		if v.matchResource(input) {
+			resources = append(resources, v.GroupVersionResource())
+		}
+	}
+	if len(resources) == 0 {
+		return nil, &meta.NoResourceMatchError{PartialResource: input}
+	}
+	return resources, nil
+}
+
+// RESTMapping returns the mapping of the kind. If versions are specified, the first version which is found is used.
+func (m *StaticRESTMapper) RESTMapping(gk schema.GroupKind, versions ...string) (*meta.RESTMapping, error) {
+	mappings, err := m.RESTMappings(gk, versions...)
+	if err != nil {
+		return nil, err
+	}
+	return mappings[0], nil
+}
+
+func (m *StaticRESTMapper) RESTMappings(gk schema.GroupKind, versions ...string) ([]*meta.RESTMapping, error) {
+	searchVersions := versions
+	if len(searchVersions) == 0 {
+		// The empty version matches any version.
+		searchVersions = []string{""}
+	}
+	var mappings []*meta.RESTMapping
+	for _, ver := range searchVersions {
+		for _, v := range m.mappings {
+			if v.GroupVersionKind.GroupKind() == gk && (ver == "" || v.GroupVersionKind.Version == ver) {
+				mappings = append(mappings, v.restMapping())
+			}
+		}
+	}
+	if len(mappings) == 0 {
+		return nil, &meta.NoKindMatchError{GroupKind: gk, SearchedVersions: versions}
+	}
+	return mappings, nil
+}
+
+func (m *StaticRESTMapper) ResourceSingularizer(resource string) (string, error) {
+	for _, v := range m.mappings {
+		if v.matchResource(schema.GroupVersionResource{Resource: resource}) {
+			return v.SingularResource, nil
+		}
+	}
+	return "", &meta.NoResourceMatchError{PartialResource: schema.GroupVersionResource{Resource: resource}}
+}
@@ -73,7 +73,7 @@ func NewEnumFromEnumDescriptor(e protoreflect.EnumDescriptor, f protoreflect.Fil
 	}
 	return &Enum{
 		Name:      string(e.FullName()),
-		ShortName: string(e.Name()),
+		ShortName: goName(e),
 		Values:    values,
 		Package: ImportPackage{
 			Name: path.Base(goPackage),
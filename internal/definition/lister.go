@@ -96,21 +96,22 @@ func (l *Lister) GetMessages() Messages {
 		return true
 	}
 
-	l.allFiles.RangeFiles(func(desc protoreflect.FileDescriptor) bool {
-		for i := 0; i < desc.Messages().Len(); i++ {
-			m := desc.Messages().Get(i)
+	// addMessages adds the messages and the nested messages in all depths.
+	var addMessages func(messages protoreflect.MessageDescriptors, desc protoreflect.FileDescriptor) bool
+	addMessages = func(messages protoreflect.MessageDescriptors, desc protoreflect.FileDescriptor) bool {
+		for i := 0; i < messages.Len(); i++ {
+			m := messages.Get(i)
 			if !addMessage(m, desc) {
 				return false
 			}
-			// For nested message declarations
-			for i := 0; i < m.Messages().Len(); i++ {
-				if !addMessage(m.Messages().Get(i), desc) {
-					return false
-				}
+			if !addMessages(m.Messages(), desc) {
+				return false
 			}
 		}
-
 		return true
+	}
+	l.allFiles.RangeFiles(func(desc protoreflect.FileDescriptor) bool {
+		return addMessages(desc.Messages(), desc)
 	})
 
 	msgs = append(msgs, MessageTypeMeta, MessageObjectMeta, MessageListMeta)
@@ -118,6 +119,39 @@ func (l *Lister) GetMessages() Messages {
 	return msgs
 }
 
+// Validate returns an error if the messages or the enums of the files to generate have the same name in the Go package.
+func (l *Lister) Validate() error {
+	names := make(map[string]string)
+	add := func(packagePath, goName, name string) error {
+		key := packagePath + "." + goName
+		if exists, ok := names[key]; ok {
+			return fmt.Errorf("%s and %s have the same name in Go: %s", exists, name, key)
+		}
+		names[key] = name
+		return nil
+	}
+
+	for _, m := range l.GetMessages() {
+		// The virtual messages (e.g. the list of Kind) are not generated if the same message is defined.
+		if m.Dep || m.Virtual {
+			continue
+		}
+		if err := add(m.Package.Path, m.ShortName, m.Name); err != nil {
+			return err
+		}
+	}
+	for _, e := range l.GetEnums() {
+		if e.External {
+			continue
+		}
+		if err := add(e.Package.Path, e.ShortName, e.Name); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (l *Lister) GetEnums() Enums {
 	if l.enums != nil {
 		return l.enums
@@ -127,10 +161,17 @@ func (l *Lister) GetEnums() Enums {
 	l.allFiles.RangeFiles(func(desc protoreflect.FileDescriptor) bool {
 		_, own := l.files[desc.Path()]
 
-		for i := 0; i < desc.Enums().Len(); i++ {
-			e := desc.Enums().Get(i)
-			enums = append(enums, NewEnumFromEnumDescriptor(e, desc, !own))
+		var addEnums func(e protoreflect.EnumDescriptors, messages protoreflect.MessageDescriptors)
+		addEnums = func(e protoreflect.EnumDescriptors, messages protoreflect.MessageDescriptors) {
+			for i := 0; i < e.Len(); i++ {
+				enums = append(enums, NewEnumFromEnumDescriptor(e.Get(i), desc, !own))
+			}
+			// For nested enum declarations
+			for i := 0; i < messages.Len(); i++ {
+				addEnums(messages.Get(i).Enums(), messages.Get(i).Messages())
+			}
 		}
+		addEnums(desc.Enums(), desc.Messages())
 
 		return true
 	})
@@ -0,0 +1,71 @@
+package definition
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+func TestLister_NestedMessages(t *testing.T) {
+	newFiles := func(messages ...*descriptorpb.DescriptorProto) *descriptorpb.FileDescriptorSet {
+		return &descriptorpb.FileDescriptorSet{
+			File: []*descriptorpb.FileDescriptorProto{
+				{
+					Name:    proto.String("test.proto"),
+					Package: proto.String("testing.apis"),
+					Syntax:  proto.String("proto3"),
+					Options: &descriptorpb.FileOptions{
+						GoPackage: proto.String("go.f110.dev/kubeproto/internal/definition"),
+					},
+					MessageType: messages,
+				},
+			},
+		}
+	}
+	nested := func(name string) *descriptorpb.DescriptorProto {
+		return &descriptorpb.DescriptorProto{
+			Name: proto.String(name),
+			NestedType: []*descriptorpb.DescriptorProto{
+				{
+					Name:       proto.String("Spec"),
+					NestedType: []*descriptorpb.DescriptorProto{{Name: proto.String("Address")}},
+					EnumType: []*descriptorpb.EnumDescriptorProto{
+						{
+							Name:  proto.String("Role"),
+							Value: []*descriptorpb.EnumValueDescriptorProto{{Name: proto.String("ROLE_WRITER"), Number: proto.Int32(0)}},
+						},
+					},
+				},
+			},
+		}
+	}
+
+	files, err := protodesc.NewFiles(newFiles(nested("Foo"), nested("Bar")))
+	require.NoError(t, err)
+	lister := NewLister([]string{"test.proto"}, files, NewPackageNamespaceManager())
+	require.NoError(t, lister.Validate())
+
+	messages := lister.GetMessages()
+	for name, goName := range map[string]string{
+		"testing.apis.Foo.Spec":         "Foo_Spec",
+		"testing.apis.Bar.Spec":         "Bar_Spec",
+		"testing.apis.Foo.Spec.Address": "Foo_Spec_Address",
+	} {
+		m := messages.Find(name)
+		if assert.NotNil(t, m, name) {
+			assert.Equal(t, goName, m.ShortName)
+		}
+	}
+	if e := lister.GetEnums().Find("testing.apis.Bar.Spec.Role"); assert.NotNil(t, e) {
+		assert.Equal(t, "Bar_Spec_Role", e.ShortName)
+	}
+
+	files, err = protodesc.NewFiles(newFiles(nested("Foo"), &descriptorpb.DescriptorProto{Name: proto.String("Foo_Spec")}))
+	require.NoError(t, err)
+	lister = NewLister([]string{"test.proto"}, files, NewPackageNamespaceManager())
+	assert.Error(t, lister.Validate())
+}
@@ -33,6 +33,9 @@ var ProtoreflectKindToJSONSchemaType = map[protoreflect.Kind]string{
 	protoreflect.BoolKind:   "boolean",
 }
 
+// MessageCondition is the name of metav1.Condition
+const MessageCondition = "k8s.io.apimachinery.pkg.apis.meta.v1.Condition"
+
 var (
 	MessageTypeMeta = &Message{
 		Dep:       true,
@@ -41,14 +44,14 @@ var (
 		ShortName: "TypeMeta",
 		Fields: []*Field{
 			{
-				Name:        "kind",
+				Name:        "Kind",
 				FieldName:   "kind",
 				Optional:    true,
 				Kind:        protoreflect.StringKind,
 				Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated.",
 			},
 			{
-				Name:        "api_version",
+				Name:        "APIVersion",
 				FieldName:   "apiVersion",
 				Optional:    true,
 				Kind:        protoreflect.StringKind,
@@ -105,6 +108,19 @@ func (m Messages) Find(name string) *Message {
 	return nil
 }
 
+// goName returns the name of the Go type of desc.
+// The name of the nested declaration is qualified by the names of the parent messages. (e.g. Foo_Spec)
+func goName(desc protoreflect.Descriptor) string {
+	name := string(desc.Name())
+	for p := desc.Parent(); p != nil; p = p.Parent() {
+		if _, ok := p.(protoreflect.MessageDescriptor); !ok {
+			break
+		}
+		name = string(p.Name()) + "_" + name
+	}
+	return name
+}
+
 func isKind(desc protoreflect.MessageDescriptor) bool {
 	e := proto.GetExtension(desc.Options(), kubeproto.E_Kind)
 	if e == nil {
@@ -147,8 +163,16 @@ type Message struct {
 	Version string
 	// Scope is a type of this message.
 	Scope ScopeType
+	// ConditionsPath is the path to the field that has the list of metav1.Condition.
+	ConditionsPath string
 	// HasTypeMeta indicates this message contains TypeMeta
 	HasTypeMeta bool
+	// SubResources are the sub resources which are declared by the option of Kind.
+	SubResources []*SubResource
+	// ShortNames are the short names of the resource which are declared by the option of Kind.
+	ShortNames []string
+	// NonResource indicates that the kind is not served as the resource. (e.g. Scale)
+	NonResource bool
 
 	fileDescriptor    protoreflect.FileDescriptor
 	messageDescriptor protoreflect.MessageDescriptor
@@ -160,12 +184,15 @@ func NewMessageFromMessageDescriptor(m protoreflect.MessageDescriptor, f protore
 		v := m.Fields().Get(i)
 
 		var name, fieldName string
-		var subResource, inline bool
+		var subResource, inline, selectable, immutable, indexed bool
 		e := proto.GetExtension(v.Options(), kubeproto.E_Field)
 		ext := e.(*kubeproto.Field)
 		if ext != nil {
 			name = ext.GetGoName()
 			subResource = ext.SubResource
+			selectable = ext.Selectable
+			immutable = ext.Immutable
+			indexed = ext.Indexed
 			if ext.ApiFieldName != "" {
 				fieldName = ext.ApiFieldName
 			}
@@ -202,26 +229,46 @@ func NewMessageFromMessageDescriptor(m protoreflect.MessageDescriptor, f protore
 			Embed:       inline,
 			Optional:    v.HasOptionalKeyword() || v.IsMap(),
 			SubResource: subResource,
+			Selectable:  selectable,
+			Immutable:   immutable,
+			Indexed:     indexed,
 			descriptor:  v,
 		})
 	}
 
 	var printerColumns []*kubeproto.PrinterColumn
+	var conditionsPath string
+	var subResources []*SubResource
+	var shortNames []string
+	var nonResource bool
 	messageScope := ScopeTypeNamespaced
 	e := proto.GetExtension(m.Options(), kubeproto.E_Kind)
 	ext := e.(*kubeproto.Kind)
 	if ext != nil {
 		printerColumns = ext.AdditionalPrinterColumns
+		conditionsPath = ext.Conditions
+		shortNames = ext.ShortNames
+		nonResource = ext.NonResource
 		if ext.Scope == kubeproto.Scope_SCOPE_CLUSTER {
 			messageScope = ScopeTypeCluster
 		}
+		for _, v := range ext.SubResources {
+			subResource, err := newSubResource(v, m)
+			if err != nil {
+				return nil, err
+			}
+			subResources = append(subResources, subResource)
+		}
 	}
 
 	var group, subGroup, version string
 	e = proto.GetExtension(f.Options(), kubeproto.E_K8S)
 	k8sExt := e.(*kubeproto.Kubernetes)
 	if k8sExt != nil {
-		group = fmt.Sprintf("%s.%s", k8sExt.SubGroup, k8sExt.Domain)
+		group = k8sExt.Domain
+		if k8sExt.SubGroup != "" {
+			group = fmt.Sprintf("%s.%s", k8sExt.SubGroup, k8sExt.Domain)
+		}
 		subGroup = k8sExt.SubGroup
 		version = k8sExt.Version
 	}
@@ -247,9 +294,13 @@ func NewMessageFromMessageDescriptor(m protoreflect.MessageDescriptor, f protore
 	goPackageAlias = nsm.Add(goPackage, goPackageAlias)
 	msg := &Message{
 		Name:                     string(m.FullName()),
-		ShortName:                string(m.Name()),
+		ShortName:                goName(m),
 		Fields:                   fields,
 		AdditionalPrinterColumns: printerColumns,
+		ConditionsPath:           conditionsPath,
+		SubResources:             subResources,
+		ShortNames:               shortNames,
+		NonResource:              nonResource,
 		Group:                    group,
 		SubGroup:                 subGroup,
 		Version:                  version,
@@ -272,6 +323,14 @@ func NewMessageFromMessageDescriptor(m protoreflect.MessageDescriptor, f protore
 			break
 		}
 	}
+	if messageExt := proto.GetExtension(m.Options(), kubeproto.E_Message).(*kubeproto.Message); messageExt != nil && messageExt.Immutable {
+		for _, v := range msg.Fields {
+			if v.Embed || v.Inline || v.SubResource {
+				continue
+			}
+			v.Immutable = true
+		}
+	}
 	return msg, nil
 }
 
@@ -295,6 +354,151 @@ func (m *Message) IsDefinedSubResource() bool {
 	return false
 }
 
+// ConditionsField returns the fields from m to the field which has the list of metav1.Condition.
+// If ConditionsPath is empty, status.conditions and conditions are looked up.
+// ConditionsField returns nil if m doesn't have conditions.
+func (m *Message) ConditionsField(messages Messages) ([]*Field, error) {
+	if m.ConditionsPath != "" {
+		fields, err := m.lookupConditionsField(messages, strings.Split(m.ConditionsPath, "."))
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", m.ShortName, err)
+		}
+		return fields, nil
+	}
+
+	for _, v := range [][]string{{"status", "conditions"}, {"conditions"}} {
+		if fields, err := m.lookupConditionsField(messages, v); err == nil {
+			return fields, nil
+		}
+	}
+	return nil, nil
+}
+
+func (m *Message) lookupConditionsField(messages Messages, path []string) ([]*Field, error) {
+	var fields []*Field
+	current := m
+	for i, name := range path {
+		var field *Field
+		for _, f := range current.Fields {
+			if f.FieldName == name {
+				field = f
+				break
+			}
+		}
+		if field == nil {
+			return nil, fmt.Errorf("field %s is not found in %s", name, current.ShortName)
+		}
+		fields = append(fields, field)
+
+		if i == len(path)-1 {
+			if !field.Repeated || strings.TrimPrefix(field.MessageName, ".") != MessageCondition {
+				return nil, fmt.Errorf("%s is not the list of metav1.Condition", name)
+			}
+			break
+		}
+		if field.Kind != protoreflect.MessageKind || field.Repeated || field.IsMap() {
+			return nil, fmt.Errorf("%s is not the message", name)
+		}
+		current = messages.Find(field.MessageName)
+		if current == nil {
+			return nil, fmt.Errorf("%s is not found", field.MessageName)
+		}
+	}
+
+	return fields, nil
+}
+
+// SelectableFields returns the paths from m to the fields which are marked as selectable.
+// The fields in the nested messages are also looked up, but the fields in the list or the map are not.
+func (m *Message) SelectableFields(messages Messages) ([][]*Field, error) {
+	var paths [][]*Field
+	if err := m.lookupScalarFields(messages, "selectable", func(f *Field) bool { return f.Selectable }, nil, &paths, make(map[string]struct{})); err != nil {
+		return nil, fmt.Errorf("%s: %w", m.ShortName, err)
+	}
+	return paths, nil
+}
+
+// IndexedFields returns the paths from m to the fields which are marked as indexed.
+// The fields are looked up in the same way as SelectableFields.
+func (m *Message) IndexedFields(messages Messages) ([][]*Field, error) {
+	var paths [][]*Field
+	if err := m.lookupScalarFields(messages, "indexed", func(f *Field) bool { return f.Indexed }, nil, &paths, make(map[string]struct{})); err != nil {
+		return nil, fmt.Errorf("%s: %w", m.ShortName, err)
+	}
+	return paths, nil
+}
+
+// lookupScalarFields looks up the scalar fields which are marked. name is the name of the mark for the error message.
+func (m *Message) lookupScalarFields(messages Messages, name string, marked func(*Field) bool, parent []*Field, paths *[][]*Field, visited map[string]struct{}) error {
+	if _, ok := visited[m.Name]; ok {
+		return nil
+	}
+	visited[m.Name] = struct{}{}
+	defer delete(visited, m.Name)
+
+	for _, f := range m.Fields {
+		if f.Inline || f.Embed || f.Repeated || f.IsMap() {
+			if marked(f) {
+				return fmt.Errorf("%s can't be %s", f.Name, name)
+			}
+			continue
+		}
+
+		path := append(append([]*Field{}, parent...), f)
+		if f.Kind == protoreflect.MessageKind {
+			if marked(f) {
+				return fmt.Errorf("%s can't be %s because it is the message", f.Name, name)
+			}
+			child := messages.Find(f.MessageName)
+			if child == nil || child.Virtual {
+				continue
+			}
+			if err := child.lookupScalarFields(messages, name, marked, path, paths, visited); err != nil {
+				return err
+			}
+			continue
+		}
+		if marked(f) {
+			*paths = append(*paths, path)
+		}
+	}
+
+	return nil
+}
+
+// ImmutableFields returns the paths from m to the fields which are marked as immutable.
+// The fields in the nested messages are also looked up, but the fields in the list or the map are not.
+func (m *Message) ImmutableFields(messages Messages) [][]*Field {
+	var paths [][]*Field
+	m.lookupImmutableFields(messages, nil, &paths, make(map[string]struct{}))
+	return paths
+}
+
+func (m *Message) lookupImmutableFields(messages Messages, parent []*Field, paths *[][]*Field, visited map[string]struct{}) {
+	if _, ok := visited[m.Name]; ok {
+		return
+	}
+	visited[m.Name] = struct{}{}
+	defer delete(visited, m.Name)
+
+	for _, f := range m.Fields {
+		path := append(append([]*Field{}, parent...), f)
+		if f.Immutable {
+			*paths = append(*paths, path)
+			continue
+		}
+		if f.Kind != protoreflect.MessageKind || f.Inline || f.Embed || f.Repeated || f.IsMap() {
+			continue
+		}
+
+		child := messages.Find(f.MessageName)
+		if child == nil || child.Virtual {
+			continue
+		}
+		child.lookupImmutableFields(messages, path, paths, visited)
+	}
+}
+
 func (m *Message) IsList() bool {
 	if len(m.Fields) == 1 && m.Fields[0].Name == "Items" && m.Fields[0].Repeated && m.Fields[0].Kind != protoreflect.MessageKind {
 		return true
@@ -363,6 +567,12 @@ type Field struct {
 	Embed bool
 	// SubResource indicates that this field is the sub resource of Kind
 	SubResource bool
+	// Selectable indicates that this field can be used by the field selector
+	Selectable bool
+	// Immutable indicates that the value of this field can't be changed after the creation
+	Immutable bool
+	// Indexed indicates that this field is the index of the informer
+	Indexed bool
 
 	importPath   string
 	packageAlias string
@@ -0,0 +1,79 @@
+package definition
+
+import (
+	"fmt"
+	"strings"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"go.f110.dev/kubeproto"
+	"go.f110.dev/kubeproto/internal/stringsutil"
+)
+
+// SubResource is the sub resource of Kind which is declared by the option of Kind.
+// The status sub resource is declared by the field instead of the option.
+type SubResource struct {
+	// Name is the name of the sub resource in the path. (e.g. approval)
+	Name string
+	// GoName is the name of the sub resource in the name of the method. (e.g. Approval)
+	GoName string
+	Verb   kubeproto.Verb
+	// Request is the fully qualified name of the message of the request body.
+	// Request is empty if Verb is VERB_GET.
+	Request string
+	// Response is the fully qualified name of the message of the response.
+	Response string
+}
+
+func newSubResource(ext *kubeproto.SubResource, m protoreflect.MessageDescriptor) (*SubResource, error) {
+	if ext.Name == "" {
+		return nil, fmt.Errorf("%s: the name of the sub resource is empty", m.FullName())
+	}
+	if ext.Name == "status" {
+		return nil, fmt.Errorf("%s: status sub resource must be declared by the field", m.FullName())
+	}
+	goName := ext.GoName
+	if goName == "" {
+		goName = stringsutil.ToUpperCamelCase(ext.Name)
+	}
+
+	s := &SubResource{
+		Name:     ext.Name,
+		GoName:   goName,
+		Verb:     ext.Verb,
+		Response: resolveMessageName(ext.Response, m),
+	}
+	if ext.Verb != kubeproto.Verb_VERB_GET {
+		s.Request = resolveMessageName(ext.Request, m)
+	}
+	return s, nil
+}
+
+// Method returns the HTTP method of the sub resource.
+func (s *SubResource) Method() string {
+	switch s.Verb {
+	case kubeproto.Verb_VERB_CREATE:
+		return "POST"
+	case kubeproto.Verb_VERB_UPDATE:
+		return "PUT"
+	default:
+		return "GET"
+	}
+}
+
+// MethodName returns the name of the method of the client. (e.g. UpdateCertificateSigningRequestApproval)
+func (s *SubResource) MethodName(kind string) string {
+	return stringsutil.ToUpperCamelCase(strings.ToLower(strings.TrimPrefix(s.Verb.String(), "VERB_"))) + kind + s.GoName
+}
+
+// resolveMessageName returns the fully qualified name of the message.
+// The name without the package is resolved in the package of m. If name is empty, m is returned.
+func resolveMessageName(name string, m protoreflect.MessageDescriptor) string {
+	if name == "" {
+		return string(m.FullName())
+	}
+	if strings.Contains(name, ".") {
+		return strings.TrimPrefix(name, ".")
+	}
+	return string(m.ParentFile().Package().Append(protoreflect.Name(name)))
+}
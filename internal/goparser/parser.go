@@ -109,6 +109,86 @@ func init() {
 	}
 }
 
+// builtinShortNames is the short names of the built-in kinds.
+// The built-in kinds don't have the marker of kubebuilder, so the short names are defined here.
+// The key of map is Go package, and the key of the value is the name of the kind.
+var builtinShortNames = map[string]map[string][]string{
+	"k8s.io/api/core/v1": {
+		"ComponentStatus":       {"cs"},
+		"ConfigMap":             {"cm"},
+		"Endpoints":             {"ep"},
+		"Event":                 {"ev"},
+		"LimitRange":            {"limits"},
+		"Namespace":             {"ns"},
+		"Node":                  {"no"},
+		"PersistentVolume":      {"pv"},
+		"PersistentVolumeClaim": {"pvc"},
+		"Pod":                   {"po"},
+		"ReplicationController": {"rc"},
+		"ResourceQuota":         {"quota"},
+		"Service":               {"svc"},
+		"ServiceAccount":        {"sa"},
+	},
+	"k8s.io/api/apps/v1": {
+		"DaemonSet":   {"ds"},
+		"Deployment":  {"deploy"},
+		"ReplicaSet":  {"rs"},
+		"StatefulSet": {"sts"},
+	},
+	"k8s.io/api/autoscaling/v1": {
+		"HorizontalPodAutoscaler": {"hpa"},
+	},
+	"k8s.io/api/autoscaling/v2": {
+		"HorizontalPodAutoscaler": {"hpa"},
+	},
+	"k8s.io/api/batch/v1": {
+		"CronJob": {"cj"},
+	},
+	"k8s.io/api/certificates/v1": {
+		"CertificateSigningRequest": {"csr"},
+	},
+	"k8s.io/api/events/v1": {
+		"Event": {"ev"},
+	},
+	"k8s.io/api/networking/v1": {
+		"Ingress":       {"ing"},
+		"NetworkPolicy": {"netpol"},
+	},
+	"k8s.io/api/policy/v1": {
+		"PodDisruptionBudget": {"pdb"},
+	},
+	"k8s.io/api/scheduling/v1": {
+		"PriorityClass": {"pc"},
+	},
+	"k8s.io/api/storage/v1": {
+		"StorageClass": {"sc"},
+	},
+}
+
+// builtinNonResources is the built-in kinds which are not served as the resource.
+// These kinds are used as the request or the response of the sub resource, or used only inside the API server.
+// The key of map is Go package, and the key of the value is the name of the kind.
+var builtinNonResources = map[string]map[string]bool{
+	"k8s.io/api/core/v1": {
+		"PodStatusResult": true,
+		"RangeAllocation": true,
+	},
+	"k8s.io/api/authentication/v1": {
+		"TokenRequest": true,
+	},
+	"k8s.io/api/autoscaling/v1": {
+		"Scale": true,
+	},
+}
+
+// builtinIndexedFields is the fields of the built-in kinds which are indexed by the informer.
+// The key of map is Go package, and the key of the value is the name of the struct and the field.
+var builtinIndexedFields = map[string]map[string]bool{
+	"k8s.io/api/core/v1": {
+		"PodSpec.NodeName": true,
+	},
+}
+
 type typeDeclaration struct {
 	Name                 string
 	ProtobufKind         string
@@ -493,7 +573,11 @@ func (g *Generator) WriteFile(outputFilePath string) error {
 			if f.SubResource {
 				w.Fn("sub_resource: true, ")
 			}
-			w.Fn("inline: %v}];", f.Inline)
+			w.Fn("inline: %v", f.Inline)
+			if f.Indexed {
+				w.Fn(", indexed: true")
+			}
+			w.F("}];")
 			w.F("")
 		}
 
@@ -503,6 +587,22 @@ func (g *Generator) WriteFile(outputFilePath string) error {
 			if m.Option.ClusterScope {
 				w.F("scope: SCOPE_CLUSTER")
 			}
+			for _, v := range m.Option.ShortNames {
+				w.F("short_names: %q", v)
+			}
+			if m.Option.NonResource {
+				w.F("non_resource: true")
+			}
+			for _, v := range m.Option.SubResources {
+				w.Fn("sub_resources: {name: %q, go_name: %q, verb: %s", v.Name, v.GoName, v.Verb)
+				if v.Request != "" {
+					w.Fn(", request: %q", v.Request)
+				}
+				if v.Response != "" {
+					w.Fn(", response: %q", v.Response)
+				}
+				w.F("}")
+			}
 			w.F("};")
 		}
 		w.F("}")
@@ -749,6 +849,7 @@ func (g *Generator) structToProtobufMessage(v *ast.GenDecl, comment *ast.Comment
 			Optional:        optional,
 			Repeated:        repeated,
 			Inline:          inline,
+			Indexed:         builtinIndexedFields[g.goPackage][typeSpec.Name.String()+"."+name],
 			Doc:             f.Doc.Text(),
 		})
 		i++
@@ -770,17 +871,109 @@ func (g *Generator) structToProtobufMessage(v *ast.GenDecl, comment *ast.Comment
 		m.Fields = fields
 
 		for _, v := range comment.List {
+			if strings.HasPrefix(v.Text, "// +genclient:method=") {
+				if subResource := g.parseGenClientMethod(v.Text); subResource != nil {
+					m.Option.SubResources = append(m.Option.SubResources, subResource)
+				}
+				continue
+			}
+			if strings.HasPrefix(v.Text, "// +kubebuilder:resource:") {
+				m.Option.ShortNames = append(m.Option.ShortNames, parseKubebuilderShortNames(v.Text)...)
+				continue
+			}
 			if strings.HasPrefix(v.Text, "// +genclient") {
 				if strings.Contains(v.Text, "nonNamespaced") {
 					m.Option.ClusterScope = true
 				}
+				if strings.Contains(v.Text, "noVerbs") {
+					m.Option.NonResource = true
+				}
 			}
 		}
+		if len(m.Option.ShortNames) == 0 {
+			m.Option.ShortNames = builtinShortNames[g.goPackage][typeSpec.Name.String()]
+		}
+		if builtinNonResources[g.goPackage][typeSpec.Name.String()] {
+			m.Option.NonResource = true
+		}
 	}
 
 	return m
 }
 
+// parseGenClientMethod parses the marker of the custom method of client-gen.
+// (e.g. +genclient:method=UpdateApproval,verb=update,subresource=approval,input=k8s.io/api/certificates/v1.CertificateSigningRequest)
+// nil is returned if the method isn't the sub resource or the verb is not supported.
+func (g *Generator) parseGenClientMethod(marker string) *ProtobufSubResource {
+	params := make(map[string]string)
+	for _, v := range strings.Split(strings.TrimPrefix(marker, "// "), ",") {
+		key, value, _ := strings.Cut(v, "=")
+		params[key] = value
+	}
+	if params["subresource"] == "" {
+		return nil
+	}
+
+	var verb string
+	switch params["verb"] {
+	case "get":
+		verb = "VERB_GET"
+	case "create":
+		verb = "VERB_CREATE"
+	case "update":
+		verb = "VERB_UPDATE"
+	default:
+		return nil
+	}
+	return &ProtobufSubResource{
+		Name:     params["subresource"],
+		GoName:   strings.TrimPrefix(params["+genclient:method"], stringsutil.ToUpperCamelCase(params["verb"])),
+		Verb:     verb,
+		Request:  g.resolveProtobufMessageName(params["input"]),
+		Response: g.resolveProtobufMessageName(params["result"]),
+	}
+}
+
+// parseKubebuilderShortNames returns the short names from the marker of kubebuilder.
+// (e.g. +kubebuilder:resource:scope=Cluster,shortName=foo;bar)
+func parseKubebuilderShortNames(marker string) []string {
+	for _, v := range strings.Split(strings.TrimPrefix(marker, "// +kubebuilder:resource:"), ",") {
+		key, value, _ := strings.Cut(v, "=")
+		if key != "shortName" {
+			continue
+		}
+		return strings.Split(strings.Trim(value, `"{}`), ";")
+	}
+	return nil
+}
+
+// resolveProtobufMessageName returns the name of the message from the Go type. (e.g. k8s.io/api/autoscaling/v1.Scale)
+// The message in the same package is returned without the package.
+func (g *Generator) resolveProtobufMessageName(in string) string {
+	i := strings.LastIndex(in, ".")
+	if i < 0 {
+		return in
+	}
+	goPackage, name := in[:i], in[i+1:]
+
+	protobufPackage, ok := packageMap[goPackage]
+	if !ok {
+		for _, v := range g.importedPackages {
+			if v.GoPackage == goPackage {
+				protobufPackage = v.ProtobufPackage
+				break
+			}
+		}
+	}
+	if protobufPackage == "" {
+		protobufPackage = strings.ReplaceAll(goPackage, "/", ".")
+	}
+	if protobufPackage == g.protoPackage {
+		return name
+	}
+	return protobufPackage + "." + name
+}
+
 func (g *Generator) goTypeToProtobufKind(in ast.Expr) string {
 	switch v := in.(type) {
 	case *ast.Ident:
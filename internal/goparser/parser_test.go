@@ -65,3 +65,193 @@ const (
 		}
 	})
 }
+
+func TestGenClientMethod(t *testing.T) {
+	code := `package v1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +genclient
+// +genclient:method=UpdateApproval,verb=update,subresource=approval,input=k8s.io/api/certificates/v1.CertificateSigningRequest,result=k8s.io/api/certificates/v1.CertificateSigningRequest
+// +genclient:method=GetScale,verb=get,subresource=scale,result=k8s.io/api/autoscaling/v1.Scale
+// +genclient:method=ApplyScale,verb=apply,subresource=scale,input=k8s.io/api/autoscaling/v1.Scale,result=k8s.io/api/autoscaling/v1.Scale
+type CertificateSigningRequest struct {
+	metav1.TypeMeta ` + "`json:\",inline\"`" + `
+	metav1.ObjectMeta ` + "`json:\"metadata,omitempty\"`" + `
+}`
+	tmpDir := t.TempDir()
+	g := New()
+	g.SetProtoPackage("k8s.io.api.certificates.v1")
+	err := os.WriteFile(filepath.Join(tmpDir, "types.go"), []byte(code), 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = g.AddDir(tmpDir, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(g.protobufFile.Messages) != 1 {
+		t.Fatalf("expect one message but %d messages", len(g.protobufFile.Messages))
+	}
+	m := g.protobufFile.Messages[0]
+	if m.Option == nil {
+		t.Fatal("the message is not Kind")
+	}
+	// The method of apply is not supported.
+	if len(m.Option.SubResources) != 2 {
+		t.Fatalf("expect two sub resources but %d sub resources", len(m.Option.SubResources))
+	}
+	approval := m.Option.SubResources[0]
+	if approval.Name != "approval" || approval.GoName != "Approval" || approval.Verb != "VERB_UPDATE" {
+		t.Errorf("unexpected sub resource: %+v", approval)
+	}
+	if approval.Request != "CertificateSigningRequest" || approval.Response != "CertificateSigningRequest" {
+		t.Errorf("the message in the same package should be resolved without the package: %+v", approval)
+	}
+	scale := m.Option.SubResources[1]
+	if scale.Verb != "VERB_GET" || scale.Request != "" || scale.Response != "k8s.io.api.autoscaling.v1.Scale" {
+		t.Errorf("unexpected sub resource: %+v", scale)
+	}
+}
+
+func TestKubebuilderShortNames(t *testing.T) {
+	code := `package v1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +kubebuilder:resource:scope=Namespaced,shortName=gr;grp
+type Group struct {
+	metav1.TypeMeta ` + "`json:\",inline\"`" + `
+	metav1.ObjectMeta ` + "`json:\"metadata,omitempty\"`" + `
+}`
+	tmpDir := t.TempDir()
+	g := New()
+	g.SetProtoPackage("example.v1")
+	err := os.WriteFile(filepath.Join(tmpDir, "types.go"), []byte(code), 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = g.AddDir(tmpDir, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(g.protobufFile.Messages) != 1 {
+		t.Fatalf("expect one message but %d messages", len(g.protobufFile.Messages))
+	}
+	m := g.protobufFile.Messages[0]
+	if m.Option == nil {
+		t.Fatal("the message is not Kind")
+	}
+	if len(m.Option.ShortNames) != 2 || m.Option.ShortNames[0] != "gr" || m.Option.ShortNames[1] != "grp" {
+		t.Errorf("unexpected short names: %v", m.Option.ShortNames)
+	}
+}
+
+func TestBuiltinShortNames(t *testing.T) {
+	code := `package v1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+type Deployment struct {
+	metav1.TypeMeta ` + "`json:\",inline\"`" + `
+	metav1.ObjectMeta ` + "`json:\"metadata,omitempty\"`" + `
+}`
+	tmpDir := t.TempDir()
+	g := New()
+	g.SetProtoPackage("k8s.io.api.apps.v1")
+	g.SetGoPackage("k8s.io/api/apps/v1")
+	err := os.WriteFile(filepath.Join(tmpDir, "types.go"), []byte(code), 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = g.AddDir(tmpDir, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(g.protobufFile.Messages) != 1 {
+		t.Fatalf("expect one message but %d messages", len(g.protobufFile.Messages))
+	}
+	m := g.protobufFile.Messages[0]
+	if m.Option == nil {
+		t.Fatal("the message is not Kind")
+	}
+	if len(m.Option.ShortNames) != 1 || m.Option.ShortNames[0] != "deploy" {
+		t.Errorf("unexpected short names: %v", m.Option.ShortNames)
+	}
+}
+
+func TestBuiltinIndexedFields(t *testing.T) {
+	code := `package v1
+
+type PodSpec struct {
+	NodeName string ` + "`json:\"nodeName,omitempty\"`" + `
+	Hostname string ` + "`json:\"hostname,omitempty\"`" + `
+}`
+	tmpDir := t.TempDir()
+	g := New()
+	g.SetProtoPackage("k8s.io.api.core.v1")
+	g.SetGoPackage("k8s.io/api/core/v1")
+	err := os.WriteFile(filepath.Join(tmpDir, "types.go"), []byte(code), 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = g.AddDir(tmpDir, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(g.protobufFile.Messages) != 1 {
+		t.Fatalf("expect one message but %d messages", len(g.protobufFile.Messages))
+	}
+	m := g.protobufFile.Messages[0]
+	if len(m.Fields) != 2 {
+		t.Fatalf("expect two fields but %d fields", len(m.Fields))
+	}
+	if !m.Fields[0].Indexed {
+		t.Errorf("%s is not indexed", m.Fields[0].GoName)
+	}
+	if m.Fields[1].Indexed {
+		t.Errorf("%s is indexed", m.Fields[1].GoName)
+	}
+}
+
+func TestGenClientNoVerbs(t *testing.T) {
+	code := `package v1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +genclient
+// +genclient:noVerbs
+type Eviction struct {
+	metav1.TypeMeta ` + "`json:\",inline\"`" + `
+	metav1.ObjectMeta ` + "`json:\"metadata,omitempty\"`" + `
+}`
+	tmpDir := t.TempDir()
+	g := New()
+	g.SetProtoPackage("example.v1")
+	err := os.WriteFile(filepath.Join(tmpDir, "types.go"), []byte(code), 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = g.AddDir(tmpDir, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(g.protobufFile.Messages) != 1 {
+		t.Fatalf("expect one message but %d messages", len(g.protobufFile.Messages))
+	}
+	m := g.protobufFile.Messages[0]
+	if m.Option == nil {
+		t.Fatal("the message is not Kind")
+	}
+	if !m.Option.NonResource {
+		t.Error("the kind which has no verbs should not be the resource")
+	}
+}
@@ -19,6 +19,18 @@ type ProtobufMessage struct {
 
 type ProtobufMessageOption struct {
 	ClusterScope bool
+	SubResources []*ProtobufSubResource
+	ShortNames   []string
+	NonResource  bool
+}
+
+type ProtobufSubResource struct {
+	Name   string
+	GoName string
+	// Verb is the name of the value of dev.f110.kubeproto.Verb. (e.g. VERB_UPDATE)
+	Verb     string
+	Request  string
+	Response string
 }
 
 func (m *ProtobufMessage) IsRuntimeObject() bool {
@@ -51,6 +63,7 @@ type ProtobufField struct {
 	Repeated        bool
 	Optional        bool
 	Inline          bool
+	Indexed         bool
 	ExternalPackage string
 	Doc             string
 }
@@ -10,6 +10,7 @@ import (
 	"google.golang.org/protobuf/reflect/protoregistry"
 	"google.golang.org/protobuf/types/descriptorpb"
 
+	"go.f110.dev/kubeproto"
 	"go.f110.dev/kubeproto/internal/codegeneration"
 	"go.f110.dev/kubeproto/internal/definition"
 	"go.f110.dev/kubeproto/internal/stringsutil"
@@ -36,7 +37,6 @@ func (g *ClientGenerator) Generate(out io.Writer, packageName, importPath string
 
 	// The key is a package path. The value is an alias.
 	importPackages := map[string]string{
-		"errors":                                 "",
 		"context":                                "",
 		"time":                                   "",
 		"k8s.io/apimachinery/pkg/runtime":        "",
@@ -89,25 +89,7 @@ func (g *ClientGenerator) Generate(out io.Writer, packageName, importPath string
 	writer.F("}") // end of init()
 	writer.F("")
 
-	writer.F(`
-type Backend interface {
-	Get(ctx context.Context, resourceName, namespace, name string, opts metav1.GetOptions, result runtime.Object) (runtime.Object, error)
-	List(ctx context.Context, resourceName, namespace string, opts metav1.ListOptions, result runtime.Object) (runtime.Object, error)
-	Create(ctx context.Context, resourceName string, obj runtime.Object, opts metav1.CreateOptions, result runtime.Object) (runtime.Object, error)
-	Update(ctx context.Context, resourceName string, obj runtime.Object, opts metav1.UpdateOptions, result runtime.Object) (runtime.Object, error)
-	UpdateStatus(ctx context.Context, resourceName string, obj runtime.Object, opts metav1.UpdateOptions, result runtime.Object) (runtime.Object, error)
-	Delete(ctx context.Context, gvr schema.GroupVersionResource, namespace, name string, opts metav1.DeleteOptions) error
-	Watch(ctx context.Context, gvr schema.GroupVersionResource, namespace string, opts metav1.ListOptions) (watch.Interface, error)
-	GetClusterScoped(ctx context.Context, resourceName, name string, opts metav1.GetOptions, result runtime.Object) (runtime.Object, error)
-	ListClusterScoped(ctx context.Context, resourceName string, opts metav1.ListOptions, result runtime.Object) (runtime.Object, error)
-	CreateClusterScoped(ctx context.Context, resourceName string, obj runtime.Object, opts metav1.CreateOptions, result runtime.Object) (runtime.Object, error)
-	UpdateClusterScoped(ctx context.Context, resourceName string, obj runtime.Object, opts metav1.UpdateOptions, result runtime.Object) (runtime.Object, error)
-	UpdateStatusClusterScoped(ctx context.Context, resourceName string, obj runtime.Object, opts metav1.UpdateOptions, result runtime.Object) (runtime.Object, error)
-	DeleteClusterScoped(ctx context.Context, gvr schema.GroupVersionResource, name string, opts metav1.DeleteOptions) error
-	WatchClusterScoped(ctx context.Context, gvr schema.GroupVersionResource, opts metav1.ListOptions) (watch.Interface, error)
-
-	RESTClient() *rest.RESTClient
-}`)
+	writer.F("type Backend = typedclient.Backend")
 	writer.F("")
 
 	writer.F("type Set struct {")
@@ -136,7 +118,7 @@ type Backend interface {
 		writer.F("if err != nil {")
 		writer.F("return nil, err")
 		writer.F("}")
-		writer.F("s.%s = New%sClient(&restBackend{client: c}, &conf)", clientName, clientName)
+		writer.F("s.%s = New%sClient(typedclient.NewRESTBackend(c, ParameterCodec), &conf)", clientName, clientName)
 		writer.F("}")
 	}
 	writer.F("")
@@ -144,20 +126,27 @@ type Backend interface {
 	writer.F("}") // end of NewSet
 	writer.F("")
 
-	restClient := newRestClientGenerator(groupVersions)
+	restClient := newRestClientGenerator(groupVersions, messages)
 	if err := restClient.WriteTo(writer, fqdnSetName); err != nil {
 		return err
 	}
 	for p, a := range restClient.Import() {
 		importPackages[p] = a
 	}
-	informer := newInformerGenerator(groupVersions)
+	informer := newInformerGenerator(groupVersions, messages)
 	if err := informer.WriteTo(writer, fqdnSetName); err != nil {
 		return err
 	}
 	for p, a := range informer.Import() {
 		importPackages[p] = a
 	}
+	restMapper := newRESTMapperGenerator(groupVersions)
+	if err := restMapper.WriteTo(writer); err != nil {
+		return err
+	}
+	for p, a := range restMapper.Import() {
+		importPackages[p] = a
+	}
 	lister := newListerGenerator(groupVersions)
 	if err := lister.WriteTo(writer, fqdnSetName); err != nil {
 		return err
@@ -229,17 +218,23 @@ func isProjectPackage(v, projPkg string) bool {
 
 type restClientGenerator struct {
 	groupVersions map[string][]*definition.Message
+	messages      definition.Messages
+
+	// subResourceMessages are the messages of the request and the response of the sub resources.
+	subResourceMessages []*definition.Message
 }
 
-func newRestClientGenerator(groupVersions map[string][]*definition.Message) *restClientGenerator {
-	return &restClientGenerator{groupVersions: groupVersions}
+func newRestClientGenerator(groupVersions map[string][]*definition.Message, messages definition.Messages) *restClientGenerator {
+	return &restClientGenerator{groupVersions: groupVersions, messages: messages}
 }
 
 func (g *restClientGenerator) Import() map[string]string {
 	importPackages := map[string]string{
 		"k8s.io/client-go/rest":                "",
 		"k8s.io/apimachinery/pkg/watch":        "",
+		"k8s.io/apimachinery/pkg/types":        "",
 		"go.f110.dev/kubeproto/go/apis/metav1": "",
+		"go.f110.dev/kubeproto/go/typedclient": "",
 	}
 	for _, v := range g.groupVersions {
 		for _, m := range v {
@@ -251,367 +246,213 @@ func (g *restClientGenerator) Import() map[string]string {
 			importPackages[m.Package.Path] = alias
 		}
 	}
+	for _, m := range g.subResourceMessages {
+		_, p := path.Split(m.Package.Path)
+		alias := m.Package.Alias
+		if p == m.Package.Alias {
+			alias = ""
+		}
+		importPackages[m.Package.Path] = alias
+	}
 
 	return importPackages
 }
 
 func (g *restClientGenerator) WriteTo(writer *codegeneration.Writer, fqdn bool) error {
-	writer.F(`type restBackend struct {
-	client *rest.RESTClient
-}
-
-func (r *restBackend) Get(ctx context.Context, resourceName, namespace, name string, opts metav1.GetOptions, result runtime.Object) (runtime.Object, error) {
-	return result, r.client.Get().
-		Namespace(namespace).
-		Resource(resourceName).
-		Name(name).
-		VersionedParams(&opts, ParameterCodec).
-		Do(ctx).
-		Into(result)
-}
-
-func (r *restBackend) List(ctx context.Context, resourceName, namespace string, opts metav1.ListOptions, result runtime.Object) (runtime.Object, error) {
-	var timeout time.Duration
-	if opts.TimeoutSeconds > 0 {
-		timeout = time.Duration(opts.TimeoutSeconds) * time.Second
-	}
-	return result, r.client.Get().
-		Namespace(namespace).
-		Resource(resourceName).
-		VersionedParams(&opts, ParameterCodec).
-		Timeout(timeout).
-		Do(ctx).
-		Into(result)
-}
-
-func (r *restBackend) Create(ctx context.Context, resourceName string, obj runtime.Object, opts metav1.CreateOptions, result runtime.Object) (runtime.Object, error) {
-	m := obj.(metav1.Object)
-	if m == nil {
-		return nil, errors.New("obj is not implement metav1.Object")
-	}
-	meta := m.GetObjectMeta()
-	return result, r.client.Post().
-		Namespace(meta.Namespace).
-		Resource(resourceName).
-		VersionedParams(&opts, ParameterCodec).
-		Body(obj).
-		Do(ctx).
-		Into(result)
-}
-
-func (r *restBackend) Update(ctx context.Context, resourceName string, obj runtime.Object, opts metav1.UpdateOptions, result runtime.Object) (runtime.Object, error) {
-	m := obj.(metav1.Object)
-	if m == nil {
-		return nil, errors.New("obj is not implement metav1.Object")
-	}
-	meta := m.GetObjectMeta()
-	return result, r.client.Put().
-		Namespace(meta.Namespace).
-		Resource(resourceName).
-		Name(meta.Name).
-		VersionedParams(&opts, ParameterCodec).
-		Body(obj).
-		Do(ctx).
-		Into(result)
-}
-
-func (r *restBackend) UpdateStatus(ctx context.Context, resourceName string, obj runtime.Object, opts metav1.UpdateOptions, result runtime.Object) (runtime.Object, error) {
-	m := obj.(metav1.Object)
-	if m == nil {
-		return nil, errors.New("obj is not implement metav1.Object")
-	}
-	meta := m.GetObjectMeta()
-	return result, r.client.Put().
-		Namespace(meta.Namespace).
-		Resource(resourceName).
-		Name(meta.Name).
-		SubResource("status").
-		VersionedParams(&opts, ParameterCodec).
-		Body(obj).
-		Do(ctx).
-		Into(result)
-}
-
-func (r *restBackend) Delete(ctx context.Context, gvr schema.GroupVersionResource, namespace, name string, opts metav1.DeleteOptions) error {
-	return r.client.Delete().
-		Namespace(namespace).
-		Resource(gvr.Resource).
-		Name(name).
-		Body(&opts).
-		Do(ctx).
-		Error()
-}
-
-func (r *restBackend) Watch(ctx context.Context, gvr schema.GroupVersionResource, namespace string, opts metav1.ListOptions) (watch.Interface, error) {
-	var timeout time.Duration
-	if opts.TimeoutSeconds > 0 {
-		timeout = time.Duration(opts.TimeoutSeconds) * time.Second
-	}
-	opts.Watch = true
-	return r.client.Get().
-		Namespace(namespace).
-		Resource(gvr.Resource).
-		VersionedParams(&opts, ParameterCodec).
-		Timeout(timeout).
-		Watch(ctx)
-}
-
-func (r *restBackend) GetClusterScoped(ctx context.Context, resourceName, name string, opts metav1.GetOptions, result runtime.Object) (runtime.Object, error) {
-	return result, r.client.Get().
-		Resource(resourceName).
-		Name(name).
-		VersionedParams(&opts, ParameterCodec).
-		Do(ctx).
-		Into(result)
-}
-
-func (r *restBackend) ListClusterScoped(ctx context.Context, resourceName string, opts metav1.ListOptions, result runtime.Object) (runtime.Object, error) {
-	var timeout time.Duration
-	if opts.TimeoutSeconds > 0 {
-		timeout = time.Duration(opts.TimeoutSeconds) * time.Second
-	}
-	return result, r.client.Get().
-		Resource(resourceName).
-		VersionedParams(&opts, ParameterCodec).
-		Timeout(timeout).
-		Do(ctx).
-		Into(result)
-}
-
-func (r *restBackend) CreateClusterScoped(ctx context.Context, resourceName string, obj runtime.Object, opts metav1.CreateOptions, result runtime.Object) (runtime.Object, error) {
-	return result, r.client.Post().
-		Resource(resourceName).
-		VersionedParams(&opts, ParameterCodec).
-		Body(obj).
-		Do(ctx).
-		Into(result)
-}
-
-func (r *restBackend) UpdateClusterScoped(ctx context.Context, resourceName string, obj runtime.Object, opts metav1.UpdateOptions, result runtime.Object) (runtime.Object, error) {
-	m := obj.(metav1.Object)
-	if m == nil {
-		return nil, errors.New("obj is not implement metav1.Object")
-	}
-	meta := m.GetObjectMeta()
-	return result, r.client.Put().
-		Resource(resourceName).
-		Name(meta.Name).
-		VersionedParams(&opts, ParameterCodec).
-		Body(obj).
-		Do(ctx).
-		Into(result)
-}
-
-func (r *restBackend) UpdateStatusClusterScoped(ctx context.Context, resourceName string, obj runtime.Object, opts metav1.UpdateOptions, result runtime.Object) (runtime.Object, error) {
-	m := obj.(metav1.Object)
-	if m == nil {
-		return nil, errors.New("obj is not implement metav1.Object")
-	}
-	meta := m.GetObjectMeta()
-	return result, r.client.Put().
-		Resource(resourceName).
-		Name(meta.Name).
-		SubResource("status").
-		VersionedParams(&opts, ParameterCodec).
-		Body(obj).
-		Do(ctx).
-		Into(result)
-}
-
-func (r *restBackend) DeleteClusterScoped(ctx context.Context, gvr schema.GroupVersionResource, name string, opts metav1.DeleteOptions) error {
-	return r.client.Delete().
-		Resource(gvr.Resource).
-		Name(name).
-		Body(&opts).
-		Do(ctx).
-		Error()
-}
-
-func (r *restBackend) WatchClusterScoped(ctx context.Context, gvr schema.GroupVersionResource, opts metav1.ListOptions) (watch.Interface, error) {
-	var timeout time.Duration
-	if opts.TimeoutSeconds > 0 {
-		timeout = time.Duration(opts.TimeoutSeconds) * time.Second
-	}
-	opts.Watch = true
-	return r.client.Get().
-		Resource(gvr.Resource).
-		VersionedParams(&opts, ParameterCodec).
-		Timeout(timeout).
-		Watch(ctx)
-}
-
-func (r *restBackend) RESTClient() *rest.RESTClient {
-	return r.client
-}
-`)
-
 	for _, k := range keys(g.groupVersions) {
 		v := g.groupVersions[k]
 		m := v[0]
 		clientName := m.ClientName(fqdn)
-		writer.F(`type %s struct {
-	backend Backend
-	config *rest.Config
-}`, clientName)
+		writer.F("type %s struct {", clientName)
+		writer.F("backend Backend")
+		writer.F("config *rest.Config")
 		writer.F("")
-
-		writer.F("func New%sClient(b Backend, config *rest.Config) *%s {", clientName, clientName)
-		writer.F("return &%s{backend: b, config: config}", clientName)
+		for _, m := range v {
+			writer.F("%s *typedclient.%s", resourceClientFieldName(m), resourceClientTypeName(m))
+		}
 		writer.F("}")
 		writer.F("")
 
+		writer.F("func New%sClient(b Backend, config *rest.Config) *%s {", clientName, clientName)
+		writer.F("return &%s{", clientName)
+		writer.F("backend: b,")
+		writer.F("config: config,")
 		for _, m := range v {
 			group := m.Group
 			if group == "." {
 				group = ""
 			}
+			writer.F(
+				"%s: typedclient.New%s(b, schema.GroupVersionResource{Group: %q, Version: %q, Resource: %q}),",
+				resourceClientFieldName(m),
+				resourceClientTypeName(m),
+				group,
+				m.Version,
+				strings.ToLower(stringsutil.Plural(m.ShortName)),
+			)
+		}
+		writer.F("}")
+		writer.F("}")
+		writer.F("")
+
+		for _, m := range v {
 			structNameWithPkg := fmt.Sprintf("%s.%s", m.Package.Alias, m.ShortName)
-			// GetXXX
-			if m.Scope == definition.ScopeTypeCluster {
-				writer.F("func(c *%s) Get%s(ctx context.Context, name string, opts metav1.GetOptions) (*%s, error) {", clientName, m.ShortName, structNameWithPkg)
-				writer.F("result, err := c.backend.GetClusterScoped(ctx, %q, name, opts, &%s{})", strings.ToLower(stringsutil.Plural(m.ShortName)), structNameWithPkg)
-				writer.F("if err != nil {")
-				writer.F("return nil, err")
-				writer.F("}")
-				writer.F("return result.(*%s), nil", structNameWithPkg)
-				writer.F("}")
-				writer.F("")
-			} else {
-				writer.F("func(c *%s) Get%s(ctx context.Context, namespace, name string, opts metav1.GetOptions) (*%s, error) {", clientName, m.ShortName, structNameWithPkg)
-				writer.F("result, err := c.backend.Get(ctx, %q, namespace, name, opts, &%s{})", strings.ToLower(stringsutil.Plural(m.ShortName)), structNameWithPkg)
-				writer.F("if err != nil {")
-				writer.F("return nil, err")
-				writer.F("}")
-				writer.F("return result.(*%s), nil", structNameWithPkg)
-				writer.F("}")
-				writer.F("")
+			field := resourceClientFieldName(m)
+			// The cluster-scoped resource doesn't have the namespace argument.
+			var nsParam, nsArg string
+			if m.Scope != definition.ScopeTypeCluster {
+				nsParam, nsArg = "namespace string, ", "namespace, "
 			}
 
-			// CreateXXX
-			if m.Scope == definition.ScopeTypeCluster {
-				writer.F("func (c *%s) Create%s(ctx context.Context, v *%s, opts metav1.CreateOptions) (*%s, error) {", clientName, m.ShortName, structNameWithPkg, structNameWithPkg)
-				writer.F("result, err := c.backend.CreateClusterScoped(ctx, %q, v, opts, &%s{})", strings.ToLower(stringsutil.Plural(m.ShortName)), structNameWithPkg)
-				writer.F("if err != nil {")
-				writer.F("return nil, err")
-				writer.F("}")
-				writer.F("return result.(*%s), nil", structNameWithPkg)
-				writer.F("}")
-				writer.F("")
-			} else {
-				writer.F("func (c *%s) Create%s(ctx context.Context, v *%s, opts metav1.CreateOptions) (*%s, error) {", clientName, m.ShortName, structNameWithPkg, structNameWithPkg)
-				writer.F("result, err := c.backend.Create(ctx, %q, v, opts, &%s{})", strings.ToLower(stringsutil.Plural(m.ShortName)), structNameWithPkg)
-				writer.F("if err != nil {")
-				writer.F("return nil, err")
-				writer.F("}")
-				writer.F("return result.(*%s), nil", structNameWithPkg)
+			writer.F("// %s returns the client of %s.", stringsutil.Plural(m.ShortName), m.ShortName)
+			writer.F("func (c *%s) %s() *typedclient.%s {", clientName, stringsutil.Plural(m.ShortName), resourceClientTypeName(m))
+			writer.F("return c.%s", field)
+			writer.F("}")
+			writer.F("")
+
+			writer.F("func (c *%s) Get%s(ctx context.Context, %sname string, opts metav1.GetOptions) (*%s, error) {", clientName, m.ShortName, nsParam, structNameWithPkg)
+			writer.F("return c.%s.Get(ctx, %sname, opts)", field, nsArg)
+			writer.F("}")
+			writer.F("")
+
+			writer.F("func (c *%s) Create%s(ctx context.Context, v *%s, opts metav1.CreateOptions) (*%s, error) {", clientName, m.ShortName, structNameWithPkg, structNameWithPkg)
+			writer.F("return c.%s.Create(ctx, v, opts)", field)
+			writer.F("}")
+			writer.F("")
+
+			writer.F("func (c *%s) Update%s(ctx context.Context, v *%s, opts metav1.UpdateOptions) (*%s, error) {", clientName, m.ShortName, structNameWithPkg, structNameWithPkg)
+			writer.F("return c.%s.Update(ctx, v, opts)", field)
+			writer.F("}")
+			writer.F("")
+
+			if m.IsDefinedSubResource() {
+				writer.F("func (c *%s) UpdateStatus%s(ctx context.Context, v *%s, opts metav1.UpdateOptions) (*%s, error) {", clientName, m.ShortName, structNameWithPkg, structNameWithPkg)
+				writer.F("return c.%s.UpdateStatus(ctx, v, opts)", field)
 				writer.F("}")
 				writer.F("")
 			}
 
-			// UpdateXXX
-			if m.Scope == definition.ScopeTypeCluster {
-				writer.F("func (c *%s) Update%s(ctx context.Context, v *%s, opts metav1.UpdateOptions) (*%s, error) {", clientName, m.ShortName, structNameWithPkg, structNameWithPkg)
-				writer.F("result, err := c.backend.UpdateClusterScoped(ctx, %q, v, opts, &%s{})", strings.ToLower(stringsutil.Plural(m.ShortName)), structNameWithPkg)
-				writer.F("if err != nil {")
-				writer.F("return nil, err")
-				writer.F("}")
-				writer.F("return result.(*%s), nil", structNameWithPkg)
-				writer.F("}")
-				writer.F("")
-			} else {
-				writer.F("func (c *%s) Update%s(ctx context.Context, v *%s, opts metav1.UpdateOptions) (*%s, error) {", clientName, m.ShortName, structNameWithPkg, structNameWithPkg)
-				writer.F("result, err := c.backend.Update(ctx, %q, v, opts, &%s{})", strings.ToLower(stringsutil.Plural(m.ShortName)), structNameWithPkg)
-				writer.F("if err != nil {")
-				writer.F("return nil, err")
-				writer.F("}")
-				writer.F("return result.(*%s), nil", structNameWithPkg)
+			writer.F("func (c *%s) Patch%s(ctx context.Context, %sname string, pt types.PatchType, data []byte, opts metav1.PatchOptions) (*%s, error) {", clientName, m.ShortName, nsParam, structNameWithPkg)
+			writer.F("return c.%s.Patch(ctx, %sname, pt, data, opts)", field, nsArg)
+			writer.F("}")
+			writer.F("")
+
+			if m.IsDefinedSubResource() {
+				writer.F("func (c *%s) Patch%sStatus(ctx context.Context, %sname string, pt types.PatchType, data []byte, opts metav1.PatchOptions) (*%s, error) {", clientName, m.ShortName, nsParam, structNameWithPkg)
+				writer.F("return c.%s.Patch(ctx, %sname, pt, data, opts, \"status\")", field, nsArg)
 				writer.F("}")
 				writer.F("")
 			}
 
-			// UpdateStatusXXX
-			if m.IsDefinedSubResource() {
-				if m.Scope == definition.ScopeTypeCluster {
-					writer.F("func (c *%s) UpdateStatus%s(ctx context.Context, v *%s, opts metav1.UpdateOptions) (*%s, error) {", clientName, m.ShortName, structNameWithPkg, structNameWithPkg)
-					writer.F("result, err := c.backend.UpdateStatusClusterScoped(ctx, %q, v, opts, &%s{})", strings.ToLower(stringsutil.Plural(m.ShortName)), structNameWithPkg)
-					writer.F("if err != nil {")
-					writer.F("return nil, err")
-					writer.F("}")
-					writer.F("return result.(*%s), nil", structNameWithPkg)
-					writer.F("}")
-					writer.F("")
-				} else {
-					writer.F("func (c *%s) UpdateStatus%s(ctx context.Context, v *%s, opts metav1.UpdateOptions) (*%s, error) {", clientName, m.ShortName, structNameWithPkg, structNameWithPkg)
-					writer.F("result, err := c.backend.UpdateStatus(ctx, %q, v, opts, &%s{})", strings.ToLower(stringsutil.Plural(m.ShortName)), structNameWithPkg)
-					writer.F("if err != nil {")
-					writer.F("return nil, err")
-					writer.F("}")
-					writer.F("return result.(*%s), nil", structNameWithPkg)
-					writer.F("}")
-					writer.F("")
+			writer.F("func (c *%s) Delete%s(ctx context.Context, %sname string, opts metav1.DeleteOptions) error {", clientName, m.ShortName, nsParam)
+			writer.F("return c.%s.Delete(ctx, %sname, opts)", field, nsArg)
+			writer.F("}")
+			writer.F("")
+
+			writer.F("func (c *%s) DeleteCollection%s(ctx context.Context, %sopts metav1.DeleteOptions, listOpts metav1.ListOptions) error {", clientName, m.ShortName, nsParam)
+			writer.F("return c.%s.DeleteCollection(ctx, %sopts, listOpts)", field, nsArg)
+			writer.F("}")
+			writer.F("")
+
+			writer.F("func (c *%s) List%s(ctx context.Context, %sopts metav1.ListOptions) (*%sList, error) {", clientName, m.ShortName, nsParam, structNameWithPkg)
+			writer.F("return c.%s.List(ctx, %sopts)", field, nsArg)
+			writer.F("}")
+			writer.F("")
+
+			writer.F("func (c *%s) Watch%s(ctx context.Context, %sopts metav1.ListOptions) (watch.Interface, error) {", clientName, m.ShortName, nsParam)
+			writer.F("return c.%s.Watch(ctx, %sopts)", field, nsArg)
+			writer.F("}")
+			writer.F("")
+
+			for _, sub := range m.SubResources {
+				if err := g.writeSubResource(writer, clientName, m, sub); err != nil {
+					return err
 				}
 			}
+		}
+	}
 
-			// DeleteXXX
-			if m.Scope == definition.ScopeTypeCluster {
-				writer.F("func (c *%s) Delete%s(ctx context.Context, name string, opts metav1.DeleteOptions) error {", clientName, m.ShortName)
-				writer.F("return c.backend.DeleteClusterScoped(ctx, schema.GroupVersionResource{Group:%q, Version:%q, Resource:%q}, name, opts)", group, m.Version, strings.ToLower(stringsutil.Plural(m.ShortName)))
-				writer.F("}")
-				writer.F("")
-			} else {
-				writer.F("func (c *%s) Delete%s(ctx context.Context, namespace, name string, opts metav1.DeleteOptions) error {", clientName, m.ShortName)
-				writer.F("return c.backend.Delete(ctx, schema.GroupVersionResource{Group:%q, Version:%q, Resource:%q}, namespace, name, opts)", group, m.Version, strings.ToLower(stringsutil.Plural(m.ShortName)))
-				writer.F("}")
-				writer.F("")
-			}
+	return nil
+}
 
-			// ListXXX
-			if m.Scope == definition.ScopeTypeCluster {
-				writer.F("func (c *%s) List%s(ctx context.Context, opts metav1.ListOptions) (*%s.%sList, error) {", clientName, m.ShortName, m.Package.Alias, m.ShortName)
-				writer.F("result, err := c.backend.ListClusterScoped(ctx, %q, opts, &%s.%sList{})", strings.ToLower(stringsutil.Plural(m.ShortName)), m.Package.Alias, m.ShortName)
-				writer.F("if err != nil {")
-				writer.F("return nil, err")
-				writer.F("}")
-				writer.F("return result.(*%s.%sList), nil", m.Package.Alias, m.ShortName)
-				writer.F("}")
-				writer.F("")
-			} else {
-				writer.F("func (c *%s) List%s(ctx context.Context, namespace string, opts metav1.ListOptions) (*%s.%sList, error) {", clientName, m.ShortName, m.Package.Alias, m.ShortName)
-				writer.F("result, err := c.backend.List(ctx, %q, namespace, opts, &%s.%sList{})", strings.ToLower(stringsutil.Plural(m.ShortName)), m.Package.Alias, m.ShortName)
-				writer.F("if err != nil {")
-				writer.F("return nil, err")
-				writer.F("}")
-				writer.F("return result.(*%s.%sList), nil", m.Package.Alias, m.ShortName)
-				writer.F("}")
-				writer.F("")
-			}
+func (g *restClientGenerator) writeSubResource(writer *codegeneration.Writer, clientName string, m *definition.Message, sub *definition.SubResource) error {
+	res, err := g.findRuntimeObject(m, sub, sub.Response)
+	if err != nil {
+		return err
+	}
+	resType := fmt.Sprintf("%s.%s", res.Package.Alias, res.ShortName)
 
-			// WatchXXX
-			if m.Scope == definition.ScopeTypeCluster {
-				writer.F("func (c *%s) Watch%s(ctx context.Context, opts metav1.ListOptions) (watch.Interface, error) {", clientName, m.ShortName)
-				writer.F("return c.backend.WatchClusterScoped(ctx, schema.GroupVersionResource{Group:%q, Version:%q, Resource:%q}, opts)", group, m.Version, strings.ToLower(stringsutil.Plural(m.ShortName)))
-				writer.F("}")
-				writer.F("")
-			} else {
-				writer.F("func (c *%s) Watch%s(ctx context.Context, namespace string, opts metav1.ListOptions) (watch.Interface, error) {", clientName, m.ShortName)
-				writer.F("return c.backend.Watch(ctx, schema.GroupVersionResource{Group:%q, Version:%q, Resource:%q}, namespace, opts)", group, m.Version, strings.ToLower(stringsutil.Plural(m.ShortName)))
-				writer.F("}")
-				writer.F("")
-			}
+	var nsParam, nsArg string
+	if m.Scope != definition.ScopeTypeCluster {
+		nsParam, nsArg = "namespace, ", "namespace, "
+	}
+	reqParam, reqArg := "", "nil"
+	optsType := "GetOptions"
+	switch sub.Verb {
+	case kubeproto.Verb_VERB_CREATE, kubeproto.Verb_VERB_UPDATE:
+		req, err := g.findRuntimeObject(m, sub, sub.Request)
+		if err != nil {
+			return err
+		}
+		reqParam, reqArg = fmt.Sprintf("v *%s.%s, ", req.Package.Alias, req.ShortName), "v"
+		optsType = "CreateOptions"
+		if sub.Verb == kubeproto.Verb_VERB_UPDATE {
+			optsType = "UpdateOptions"
 		}
 	}
 
+	writer.F("func (c *%s) %s(ctx context.Context, %sname string, %sopts metav1.%s) (*%s, error) {", clientName, sub.MethodName(m.ShortName), nsParam, reqParam, optsType, resType)
+	writer.F("result, err := c.%s.SubResource(ctx, %q, %sname, %q, %s, &opts, &%s{})", resourceClientFieldName(m), sub.Method(), nsArg, sub.Name, reqArg, resType)
+	writer.F("if err != nil {")
+	writer.F("return nil, err")
+	writer.F("}")
+	writer.F("return result.(*%s), nil", resType)
+	writer.F("}")
+	writer.F("")
 	return nil
 }
 
+// findRuntimeObject returns the message of the request or the response of the sub resource.
+func (g *restClientGenerator) findRuntimeObject(m *definition.Message, sub *definition.SubResource, name string) (*definition.Message, error) {
+	msg := g.messages.Find(name)
+	if msg == nil {
+		return nil, fmt.Errorf("%s: %s of %s sub resource is not found", m.Name, name, sub.Name)
+	}
+	if !msg.HasTypeMeta {
+		return nil, fmt.Errorf("%s: %s of %s sub resource is not runtime.Object", m.Name, name, sub.Name)
+	}
+	g.subResourceMessages = append(g.subResourceMessages, msg)
+	return msg, nil
+}
+
+// resourceClientTypeName returns the type of the generic client of m.
+func resourceClientTypeName(m *definition.Message) string {
+	typ := "ResourceClient"
+	if m.Scope == definition.ScopeTypeCluster {
+		typ = "ClusterResourceClient"
+	}
+	return fmt.Sprintf("%s[*%s.%s, *%s.%sList]", typ, m.Package.Alias, m.ShortName, m.Package.Alias, m.ShortName)
+}
+
+func resourceClientFieldName(m *definition.Message) string {
+	return stringsutil.ToLowerCamelCase(m.ShortName) + "Client"
+}
+
 type informerGenerator struct {
 	groupVersions map[string][]*definition.Message
+	messages      definition.Messages
+	// importPackages is the packages which are used by the generated code of the indexed fields.
+	importPackages map[string]string
 }
 
 func (g *informerGenerator) Import() map[string]string {
 	importPackages := map[string]string{
+		"fmt":                                    "",
 		"reflect":                                "",
+		"sort":                                   "",
+		"strings":                                "",
 		"sync":                                   "",
+		"go.f110.dev/kubeproto/go/typedclient":   "",
 		"context":                                "",
 		"time":                                   "",
 		"k8s.io/client-go/rest":                  "",
@@ -632,36 +473,98 @@ func (g *informerGenerator) Import() map[string]string {
 			importPackages[m.Package.Path] = alias
 		}
 	}
+	for k, v := range g.importPackages {
+		importPackages[k] = v
+	}
 
 	return importPackages
 }
 
-func newInformerGenerator(groupVersions map[string][]*definition.Message) *informerGenerator {
-	return &informerGenerator{groupVersions: groupVersions}
+func newInformerGenerator(groupVersions map[string][]*definition.Message, messages definition.Messages) *informerGenerator {
+	return &informerGenerator{groupVersions: groupVersions, messages: messages, importPackages: make(map[string]string)}
 }
 
 func (g *informerGenerator) WriteTo(writer *codegeneration.Writer, fqdn bool) error {
+	writer.F("// InformerOptions is the options of the informers.")
+	writer.F("type InformerOptions struct {")
+	writer.F("// Namespaces is the set of the namespaces which are watched by one informer.")
+	writer.F("// All namespaces are watched if Namespaces is empty. Namespaces is ignored by the cluster scoped resources.")
+	writer.F("Namespaces []string")
+	writer.F("LabelSelector string")
+	writer.F("FieldSelector string")
+	writer.F("// TweakListOptions modifies the options of the list and the watch.")
+	writer.F("// The informers are shared if the options which are modified by TweakListOptions are the same.")
+	writer.F("TweakListOptions func(*k8smetav1.ListOptions)")
+	writer.F("}")
+	writer.F("")
+	writer.F("func (o InformerOptions) namespaces() []string {")
+	writer.F("var namespaces []string")
+	writer.F("for _, v := range o.Namespaces {")
+	writer.F("if v == metav1.NamespaceAll {")
+	writer.F("return nil")
+	writer.F("}")
+	writer.F("namespaces = append(namespaces, v)")
+	writer.F("}")
+	writer.F("sort.Strings(namespaces)")
+	writer.F("return namespaces")
+	writer.F("}")
+	writer.F("")
+	writer.F("// key returns the key of InformerCache.")
+	writer.F("// The key consists of the namespaces and the list options which are modified by the options.")
+	writer.F("func (o InformerOptions) key(namespaced bool) string {")
+	writer.F("var namespaces []string")
+	writer.F("if namespaced {")
+	writer.F("namespaces = o.namespaces()")
+	writer.F("}")
+	writer.F("listOptions := &k8smetav1.ListOptions{}")
+	writer.F("o.tweakListOptions(listOptions)")
+	writer.F("return strings.Join(namespaces, \",\") + \"/\" + listOptions.String()")
+	writer.F("}")
+	writer.F("")
+	writer.F("func (o InformerOptions) tweakListOptions(options *k8smetav1.ListOptions) {")
+	writer.F("if o.LabelSelector != \"\" {")
+	writer.F("options.LabelSelector = o.LabelSelector")
+	writer.F("}")
+	writer.F("if o.FieldSelector != \"\" {")
+	writer.F("options.FieldSelector = o.FieldSelector")
+	writer.F("}")
+	writer.F("if o.TweakListOptions != nil {")
+	writer.F("o.TweakListOptions(options)")
+	writer.F("}")
+	writer.F("}")
+	writer.F("")
+	writer.F("type informerCacheKey struct {")
+	writer.F("typ reflect.Type")
+	writer.F("filter string")
+	writer.F("}")
+	writer.F("")
 	writer.F("type InformerCache struct {")
 	writer.F("mu sync.Mutex")
-	writer.F("informers map[reflect.Type]cache.SharedIndexInformer")
+	writer.F("informers map[informerCacheKey]cache.SharedIndexInformer")
 	writer.F("}")
 	writer.F("func NewInformerCache() *InformerCache {")
-	writer.F("return &InformerCache{informers: make(map[reflect.Type]cache.SharedIndexInformer)}")
+	writer.F("return &InformerCache{informers: make(map[informerCacheKey]cache.SharedIndexInformer)}")
 	writer.F("}")
 	writer.F("")
 	writer.F("func (c *InformerCache) Write(obj runtime.Object, newFunc func() cache.SharedIndexInformer) cache.SharedIndexInformer {")
+	writer.F("return c.WriteWithFilter(obj, \"\", newFunc)")
+	writer.F("}")
+	writer.F("")
+	writer.F("// WriteWithFilter returns the informer of obj and filter. newFunc is called if the informer doesn't exist.")
+	writer.F("// filter identifies the informers of the same type which watch the different objects.")
+	writer.F("func (c *InformerCache) WriteWithFilter(obj runtime.Object, filter string, newFunc func() cache.SharedIndexInformer) cache.SharedIndexInformer {")
 	writer.F("c.mu.Lock()")
 	writer.F("defer c.mu.Unlock()")
 	writer.F("")
-	writer.F("typ := reflect.TypeOf(obj)")
-	writer.F("if v, ok := c.informers[typ]; ok {")
+	writer.F("key := informerCacheKey{typ: reflect.TypeOf(obj), filter: filter}")
+	writer.F("if v, ok := c.informers[key]; ok {")
 	writer.F("return v")
 	writer.F("}")
 	writer.F("informer := newFunc()")
-	writer.F("c.informers[typ] = informer")
+	writer.F("c.informers[key] = informer")
 	writer.F("")
 	writer.F("return informer")
-	writer.F("}") // end of Write
+	writer.F("}") // end of WriteWithFilter
 	writer.F("")
 	writer.F("func (c *InformerCache) Informers() []cache.SharedIndexInformer {")
 	writer.F("c.mu.Lock()")
@@ -680,18 +583,45 @@ func (g *informerGenerator) WriteTo(writer *codegeneration.Writer, fqdn bool) er
 	writer.F("set *Set")
 	writer.F("cache *InformerCache")
 	writer.F("")
-	writer.F("namespace string")
+	writer.F("options InformerOptions")
 	writer.F("resyncPeriod time.Duration")
 	writer.F("}")
 	writer.F("")
 	writer.F("func NewInformerFactory(s *Set, c *InformerCache, namespace string, resyncPeriod time.Duration) *InformerFactory {")
-	writer.F("return &InformerFactory{set: s, cache: c, namespace: namespace, resyncPeriod: resyncPeriod}")
+	writer.F("return NewInformerFactoryWithOptions(s, c, resyncPeriod, InformerOptions{Namespaces: []string{namespace}})")
 	writer.F("}") // end of NewInformerFactory
 	writer.F("")
+	writer.F("// NewInformerFactoryWithOptions returns InformerFactory of which informers are filtered by opts.")
+	writer.F("// The informers are shared with the other factories which have the same cache and the same filter.")
+	writer.F("func NewInformerFactoryWithOptions(s *Set, c *InformerCache, resyncPeriod time.Duration, opts InformerOptions) *InformerFactory {")
+	writer.F("return &InformerFactory{set: s, cache: c, options: opts, resyncPeriod: resyncPeriod}")
+	writer.F("}") // end of NewInformerFactoryWithOptions
+	writer.F("")
 	writer.F("func (f *InformerFactory) Cache() *InformerCache {")
 	writer.F("return f.cache")
 	writer.F("}") // end of Cache
 	writer.F("")
+	writer.F("// AddIndexers adds indexers to the informer of obj. The indexer which has the same name as the existing indexer is ignored.")
+	writer.F("// The indexers should be added before the informer starts because the existing objects are indexed again.")
+	writer.F("func (f *InformerFactory) AddIndexers(obj runtime.Object, indexers cache.Indexers) error {")
+	writer.F("informer := f.InformerFor(obj)")
+	writer.F("if informer == nil {")
+	writer.F("return fmt.Errorf(\"unknown object: %%T\", obj)")
+	writer.F("}")
+	writer.F("existing := informer.GetIndexer().GetIndexers()")
+	writer.F("newIndexers := make(cache.Indexers)")
+	writer.F("for k, v := range indexers {")
+	writer.F("if _, ok := existing[k]; ok {")
+	writer.F("continue")
+	writer.F("}")
+	writer.F("newIndexers[k] = v")
+	writer.F("}")
+	writer.F("if len(newIndexers) == 0 {")
+	writer.F("return nil")
+	writer.F("}")
+	writer.F("return informer.AddIndexers(newIndexers)")
+	writer.F("}") // end of AddIndexers
+	writer.F("")
 
 	writer.F("func (f *InformerFactory) InformerFor(obj runtime.Object) cache.SharedIndexInformer {")
 	writer.F("switch obj.(type) {")
@@ -700,7 +630,7 @@ func (g *informerGenerator) WriteTo(writer *codegeneration.Writer, fqdn bool) er
 		for _, m := range v {
 			clientName := m.ClientName(fqdn)
 			writer.F("case *%s.%s:", m.Package.Alias, m.ShortName)
-			writer.F("return New%sInformer(f.cache, f.set.%s, f.namespace, f.resyncPeriod).%sInformer()", clientName, clientName, m.ShortName)
+			writer.F("return New%sInformerWithOptions(f.cache, f.set.%s, f.resyncPeriod, f.options).%sInformer()", clientName, clientName, m.ShortName)
 		}
 	}
 	writer.F("default:")
@@ -716,7 +646,7 @@ func (g *informerGenerator) WriteTo(writer *codegeneration.Writer, fqdn bool) er
 		for _, m := range v {
 			clientName := m.ClientName(fqdn)
 			writer.F("case %s.SchemaGroupVersion.WithResource(%q):", m.Package.Alias, strings.ToLower(stringsutil.Plural(m.ShortName)))
-			writer.F("return New%sInformer(f.cache, f.set.%s, f.namespace, f.resyncPeriod).%sInformer()", clientName, clientName, m.ShortName)
+			writer.F("return New%sInformerWithOptions(f.cache, f.set.%s, f.resyncPeriod, f.options).%sInformer()", clientName, clientName, m.ShortName)
 		}
 	}
 	writer.F("default:")
@@ -725,9 +655,11 @@ func (g *informerGenerator) WriteTo(writer *codegeneration.Writer, fqdn bool) er
 	writer.F("}") // end of InformerForResource
 	writer.F("")
 
+	writer.F("// Run starts all informers in the cache. ctx is passed to the list and the watch of the informers,")
+	writer.F("// and the informers stop when ctx is canceled.")
 	writer.F("func (f *InformerFactory) Run(ctx context.Context) {")
 	writer.F("for _, v := range f.cache.Informers() {")
-	writer.F("go v.Run(ctx.Done())")
+	writer.F("go v.RunWithContext(ctx)")
 	writer.F("}")
 	writer.F("}") // end of Run
 	writer.F("")
@@ -740,58 +672,69 @@ func (g *informerGenerator) WriteTo(writer *codegeneration.Writer, fqdn bool) er
 		writer.F("type %sInformer struct {", clientName)
 		writer.F("cache *InformerCache")
 		writer.F("client  *%s", clientName)
-		writer.F("namespace string")
+		writer.F("options InformerOptions")
 		writer.F("resyncPeriod time.Duration")
 		writer.F("indexers cache.Indexers")
 		writer.F("}")
 		writer.F("")
 		writer.F("func New%sInformer(c *InformerCache, client *%s, namespace string, resyncPeriod time.Duration) *%sInformer {", clientName, clientName, clientName)
+		writer.F("return New%sInformerWithOptions(c, client, resyncPeriod, InformerOptions{Namespaces: []string{namespace}})", clientName)
+		writer.F("}") // end of NewXXXInformer
+		writer.F("")
+		writer.F("func New%sInformerWithOptions(c *InformerCache, client *%s, resyncPeriod time.Duration, opts InformerOptions) *%sInformer {", clientName, clientName, clientName)
 		writer.F("return &%sInformer{", clientName)
 		writer.F("cache: c,")
 		writer.F("client: client,")
-		writer.F("namespace: namespace,")
+		writer.F("options: opts,")
 		writer.F("resyncPeriod: resyncPeriod,")
 		writer.F("indexers: cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc},")
 		writer.F("}")
-		writer.F("}") // end of NewXXXInformer
+		writer.F("}") // end of NewXXXInformerWithOptions
 		writer.F("")
 
 		for _, m := range v {
+			indexers := "f.indexers"
+			paths, err := m.IndexedFields(g.messages)
+			if err != nil {
+				return err
+			}
+			if len(paths) > 0 {
+				g.writeIndexers(writer, clientName, m, paths)
+				indexers = fmt.Sprintf("typedclient.MergeIndexers(f.indexers, %s%sIndexers())", clientName, m.ShortName)
+			}
+
 			writer.F(
 				"func (f *%sInformer) %sInformer() cache.SharedIndexInformer{",
 				clientName,
 				m.ShortName,
 			)
-			writer.F("return f.cache.Write(&%s.%s{}, func () cache.SharedIndexInformer{", m.Package.Alias, m.ShortName)
 			if m.Scope == definition.ScopeTypeCluster {
+				writer.F("return f.cache.WriteWithFilter(&%s.%s{}, f.options.key(false), func () cache.SharedIndexInformer{", m.Package.Alias, m.ShortName)
 				writer.F("return cache.NewSharedIndexInformer(")
-				writer.F("&cache.ListWatch{")
-				writer.F("ListFunc: func (options k8smetav1.ListOptions) (runtime.Object, error){")
-				writer.F("return f.client.List%s(context.TODO(), metav1.ListOptionsFromUpstream(options))", m.ShortName)
-				writer.F("},") // end of ListFunc
-				writer.F("WatchFunc: func (options k8smetav1.ListOptions) (watch.Interface, error){")
-				writer.F("return f.client.Watch%s(context.TODO(), metav1.ListOptionsFromUpstream(options))", m.ShortName)
-				writer.F("},") // end of WatchFunc
+				writer.F("typedclient.NewListWatch(f.client.backend, nil, f.options.tweakListOptions,")
+				writer.F("func (ctx context.Context, _ string, options k8smetav1.ListOptions) (runtime.Object, error){")
+				writer.F("return f.client.List%s(ctx, metav1.ListOptionsFromUpstream(options))", m.ShortName)
 				writer.F("},")
-				writer.F("&%s.%s{},", m.Package.Alias, m.ShortName)
-				writer.F("f.resyncPeriod,")
-				writer.F("f.indexers,")
-				writer.F(")")
+				writer.F("func (ctx context.Context, _ string, options k8smetav1.ListOptions) (watch.Interface, error){")
+				writer.F("return f.client.Watch%s(ctx, metav1.ListOptionsFromUpstream(options))", m.ShortName)
+				writer.F("},")
+				writer.F("),")
 			} else {
+				writer.F("return f.cache.WriteWithFilter(&%s.%s{}, f.options.key(true), func () cache.SharedIndexInformer{", m.Package.Alias, m.ShortName)
 				writer.F("return cache.NewSharedIndexInformer(")
-				writer.F("&cache.ListWatch{")
-				writer.F("ListFunc: func (options k8smetav1.ListOptions) (runtime.Object, error){")
-				writer.F("return f.client.List%s(context.TODO(), f.namespace, metav1.ListOptionsFromUpstream(options))", m.ShortName)
-				writer.F("},") // end of ListFunc
-				writer.F("WatchFunc: func (options k8smetav1.ListOptions) (watch.Interface, error){")
-				writer.F("return f.client.Watch%s(context.TODO(), f.namespace, metav1.ListOptionsFromUpstream(options))", m.ShortName)
-				writer.F("},") // end of WatchFunc
+				writer.F("typedclient.NewListWatch(f.client.backend, f.options.namespaces(), f.options.tweakListOptions,")
+				writer.F("func (ctx context.Context, namespace string, options k8smetav1.ListOptions) (runtime.Object, error){")
+				writer.F("return f.client.List%s(ctx, namespace, metav1.ListOptionsFromUpstream(options))", m.ShortName)
+				writer.F("},")
+				writer.F("func (ctx context.Context, namespace string, options k8smetav1.ListOptions) (watch.Interface, error){")
+				writer.F("return f.client.Watch%s(ctx, namespace, metav1.ListOptionsFromUpstream(options))", m.ShortName)
 				writer.F("},")
-				writer.F("&%s.%s{},", m.Package.Alias, m.ShortName)
-				writer.F("f.resyncPeriod,")
-				writer.F("f.indexers,")
-				writer.F(")")
+				writer.F("),")
 			}
+			writer.F("&%s.%s{},", m.Package.Alias, m.ShortName)
+			writer.F("f.resyncPeriod,")
+			writer.F("%s,", indexers)
+			writer.F(")")
 			writer.F("})")
 			writer.F("}") // end of NewXXXInformer
 			writer.F("")
@@ -806,6 +749,53 @@ func (g *informerGenerator) WriteTo(writer *codegeneration.Writer, fqdn bool) er
 	return nil
 }
 
+// writeIndexers writes the indexers of the fields which are marked as indexed.
+// The name of the index is the JSON path of the field.
+func (g *informerGenerator) writeIndexers(writer *codegeneration.Writer, clientName string, m *definition.Message, paths [][]*definition.Field) {
+	writer.F("const (")
+	for _, path := range paths {
+		var goPath []string
+		for _, f := range path {
+			goPath = append(goPath, string(f.Name))
+		}
+		name := fmt.Sprintf("%s%sIndex%s", clientName, m.ShortName, strings.Join(goPath, ""))
+		jsonPath, _, _ := fieldPathExpr("obj", path, g.importPackages)
+		writer.F("// %s is the name of the index by %s of %s.", name, jsonPath, m.ShortName)
+		writer.F("%s = %q", name, jsonPath)
+	}
+	writer.F(")")
+	writer.F("")
+
+	writer.F("// %s%sIndexers returns the indexers of the fields of %s which are marked as indexed.", clientName, m.ShortName, m.ShortName)
+	writer.F("// The indexers are added to the informer of %s.", m.ShortName)
+	writer.F("func %s%sIndexers() cache.Indexers {", clientName, m.ShortName)
+	writer.F("return cache.Indexers{")
+	for _, path := range paths {
+		var goPath []string
+		for _, f := range path {
+			goPath = append(goPath, string(f.Name))
+		}
+		_, value, conditions := fieldPathExpr("v", path, g.importPackages)
+		writer.F("%s%sIndex%s: func(obj any) ([]string, error) {", clientName, m.ShortName, strings.Join(goPath, ""))
+		writer.F("v, ok := obj.(*%s.%s)", m.Package.Alias, m.ShortName)
+		writer.F("if !ok {")
+		writer.F("return nil, nil")
+		writer.F("}")
+		if len(conditions) > 0 {
+			writer.F("if %s {", strings.Join(conditions, " && "))
+			writer.F("return []string{%s}, nil", value)
+			writer.F("}")
+			writer.F("return nil, nil")
+		} else {
+			writer.F("return []string{%s}, nil", value)
+		}
+		writer.F("},")
+	}
+	writer.F("}")
+	writer.F("}")
+	writer.F("")
+}
+
 type listerGenerator struct {
 	groupVersions map[string][]*definition.Message
 }
@@ -897,6 +887,21 @@ func (g *listerGenerator) WriteTo(writer *codegeneration.Writer, fqdn bool) erro
 				writer.F("}")
 				writer.F("")
 			}
+
+			// ListByIndex
+			writer.F("// ListByIndex returns the objects of which indexedValue of the index is matched.")
+			writer.F("func (x *%s%sLister) ListByIndex(indexName, indexedValue string) ([]*%s.%s, error) {", clientName, m.ShortName, m.Package.Alias, m.ShortName)
+			writer.F("objs, err := x.indexer.ByIndex(indexName, indexedValue)")
+			writer.F("if err != nil {")
+			writer.F("return nil, err")
+			writer.F("}")
+			writer.F("ret := make([]*%s.%s, 0, len(objs))", m.Package.Alias, m.ShortName)
+			writer.F("for _, v := range objs {")
+			writer.F("ret = append(ret, v.(*%s.%s).DeepCopy())", m.Package.Alias, m.ShortName)
+			writer.F("}")
+			writer.F("return ret, nil")
+			writer.F("}")
+			writer.F("")
 		}
 	}
 
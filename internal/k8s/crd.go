@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"slices"
 	"sort"
 	"strings"
 
@@ -17,24 +18,28 @@ import (
 )
 
 type CRDGenerator struct {
-	files  protoreflect.FileDescriptor
+	pkg    *goPackage
 	lister *definition.Lister
 }
 
 func NewCRDGenerator(fileToGenerate []string, files *protoregistry.Files) (*CRDGenerator, error) {
-	desc, err := files.FindFileByPath(fileToGenerate[0])
+	pkg, err := newGoPackage(fileToGenerate, files)
 	if err != nil {
 		return nil, err
 	}
 
 	nsm := definition.NewPackageNamespaceManager()
 	return &CRDGenerator{
-		files:  desc,
+		pkg:    pkg,
 		lister: definition.NewLister(fileToGenerate, files, nsm),
 	}, nil
 }
 
 func (g *CRDGenerator) Generate(out io.Writer) error {
+	if err := g.lister.Validate(); err != nil {
+		return err
+	}
+
 	messages := g.lister.GetMessages()
 	var keys []string
 	kinds := make(map[string][]*definition.Message)
@@ -97,10 +102,11 @@ func (g *CRDGenerator) Generate(out io.Writer) error {
 			Spec: apiextensionsv1.CustomResourceDefinitionSpec{
 				Group: fmt.Sprintf("%s.%s", ext.SubGroup, ext.Domain),
 				Names: apiextensionsv1.CustomResourceDefinitionNames{
-					Kind:     name,
-					ListKind: fmt.Sprintf("%sList", name),
-					Plural:   strings.ToLower(stringsutil.Plural(name)),
-					Singular: strings.ToLower(stringsutil.Singular(name)),
+					Kind:       name,
+					ListKind:   fmt.Sprintf("%sList", name),
+					Plural:     strings.ToLower(stringsutil.Plural(name)),
+					Singular:   strings.ToLower(stringsutil.Singular(name)),
+					ShortNames: msgs[0].ShortNames,
 				},
 				Scope: apiextensionsv1.NamespaceScoped,
 			},
@@ -141,13 +147,30 @@ func (g *CRDGenerator) Generate(out io.Writer) error {
 				}
 			}
 
-			schema := g.ToOpenAPISchema(m)
+			schema, err := g.ToOpenAPISchema(m)
+			if err != nil {
+				return err
+			}
+			paths, err := m.SelectableFields(g.lister.GetMessages())
+			if err != nil {
+				return err
+			}
+			var selectableFields []apiextensionsv1.SelectableField
+			for _, path := range paths {
+				var jsonPath []string
+				for _, f := range path {
+					jsonPath = append(jsonPath, f.FieldName)
+				}
+				selectableFields = append(selectableFields, apiextensionsv1.SelectableField{JSONPath: "." + strings.Join(jsonPath, ".")})
+			}
+
 			ver := apiextensionsv1.CustomResourceDefinitionVersion{
 				Name:                     k8sExt.Version,
 				Served:                   k8sExt.Served,
 				Storage:                  k8sExt.Storage,
 				AdditionalPrinterColumns: printerColumns,
 				Subresources:             subResources,
+				SelectableFields:         selectableFields,
 				Schema: &apiextensionsv1.CustomResourceValidation{
 					OpenAPIV3Schema: schema,
 				},
@@ -179,7 +202,12 @@ func (g *CRDGenerator) Generate(out io.Writer) error {
 	return nil
 }
 
-func (g *CRDGenerator) ToOpenAPISchema(m *definition.Message) *apiextensionsv1.JSONSchemaProps {
+func (g *CRDGenerator) ToOpenAPISchema(m *definition.Message) (*apiextensionsv1.JSONSchemaProps, error) {
+	return g.toOpenAPISchema(m, false)
+}
+
+// toOpenAPISchema returns the schema of m. inList indicates that m is the item of the list.
+func (g *CRDGenerator) toOpenAPISchema(m *definition.Message, inList bool) (*apiextensionsv1.JSONSchemaProps, error) {
 	required := make([]string, 0)
 	properties := make(map[string]apiextensionsv1.JSONSchemaProps)
 	for _, f := range m.Fields {
@@ -190,7 +218,10 @@ func (g *CRDGenerator) ToOpenAPISchema(m *definition.Message) *apiextensionsv1.J
 				required = append(required, f.FieldName)
 			}
 		case protoreflect.MessageKind:
-			props := g.messageToJSONSchemaProps(f)
+			props, err := g.messageToJSONSchemaProps(f, inList)
+			if err != nil {
+				return nil, err
+			}
 			if f.Inline {
 				for k, v := range props.Properties {
 					properties[k] = v
@@ -222,13 +253,38 @@ func (g *CRDGenerator) ToOpenAPISchema(m *definition.Message) *apiextensionsv1.J
 			}
 		}
 	}
+	var validations []apiextensionsv1.ValidationRule
+	for _, f := range m.Fields {
+		if !f.Immutable {
+			continue
+		}
+		// The API server can't compare the item of the list with the old item because the list is not listType=map.
+		if inList {
+			return nil, fmt.Errorf("%s: %s can't be immutable because it is in the list", m.ShortName, f.Name)
+		}
+		if v, ok := properties[f.FieldName]; ok && !f.Inline {
+			v.XValidations = append(v.XValidations, apiextensionsv1.ValidationRule{
+				Rule:    "self == oldSelf",
+				Message: fmt.Sprintf("%s is immutable", f.FieldName),
+			})
+			properties[f.FieldName] = v
+			// The rule of the field is not evaluated when the field is removed, so the parent checks the existence.
+			if !slices.Contains(required, f.FieldName) {
+				validations = append(validations, apiextensionsv1.ValidationRule{
+					Rule:    fmt.Sprintf("has(self.%s) == has(oldSelf.%s)", f.FieldName, f.FieldName),
+					Message: fmt.Sprintf("%s is immutable", f.FieldName),
+				})
+			}
+		}
+	}
 	props := &apiextensionsv1.JSONSchemaProps{
-		Type:       "object",
-		Properties: properties,
-		Required:   required,
+		Type:         "object",
+		Properties:   properties,
+		Required:     required,
+		XValidations: validations,
 	}
 
-	return props
+	return props, nil
 }
 
 func (g *CRDGenerator) fieldToJSONSchemaProps(f *definition.Field) apiextensionsv1.JSONSchemaProps {
@@ -255,7 +311,7 @@ func (g *CRDGenerator) fieldToJSONSchemaProps(f *definition.Field) apiextensions
 	return props
 }
 
-func (g *CRDGenerator) messageToJSONSchemaProps(f *definition.Field) *apiextensionsv1.JSONSchemaProps {
+func (g *CRDGenerator) messageToJSONSchemaProps(f *definition.Field, inList bool) (*apiextensionsv1.JSONSchemaProps, error) {
 	props := &apiextensionsv1.JSONSchemaProps{
 		Description: f.Description,
 	}
@@ -263,7 +319,7 @@ func (g *CRDGenerator) messageToJSONSchemaProps(f *definition.Field) *apiextensi
 	if f.IsMap() {
 		props.Type = "object"
 		props.AdditionalProperties = &apiextensionsv1.JSONSchemaPropsOrBool{Schema: &apiextensionsv1.JSONSchemaProps{Type: "string"}}
-		return props
+		return props, nil
 	}
 
 	switch f.MessageName {
@@ -275,7 +331,11 @@ func (g *CRDGenerator) messageToJSONSchemaProps(f *definition.Field) *apiextensi
 		props.Format = "duration"
 	default:
 		child := g.lister.GetMessages().Find(f.MessageName)
-		props = g.ToOpenAPISchema(child)
+		var err error
+		props, err = g.toOpenAPISchema(child, inList || f.Repeated)
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	if f.Repeated {
@@ -286,10 +346,10 @@ func (g *CRDGenerator) messageToJSONSchemaProps(f *definition.Field) *apiextensi
 			Items: &apiextensionsv1.JSONSchemaPropsOrArray{
 				Schema: props,
 			},
-		}
+		}, nil
 	}
 
-	return props
+	return props, nil
 }
 
 type customResourceDefinition struct {
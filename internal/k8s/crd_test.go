@@ -0,0 +1,95 @@
+package k8s
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/types/descriptorpb"
+
+	"go.f110.dev/kubeproto"
+)
+
+func TestCRDGenerator_ImmutableField(t *testing.T) {
+	newFile := func(repeated bool) *descriptorpb.FileDescriptorProto {
+		fileOpts := &descriptorpb.FileOptions{GoPackage: proto.String("go.f110.dev/kubeproto/testing/apis")}
+		proto.SetExtension(fileOpts, kubeproto.E_K8S, &kubeproto.Kubernetes{Domain: "example.com", Version: "v1"})
+		kindOpts := &descriptorpb.MessageOptions{}
+		proto.SetExtension(kindOpts, kubeproto.E_Kind, &kubeproto.Kind{})
+		immutable := &descriptorpb.FieldOptions{}
+		proto.SetExtension(immutable, kubeproto.E_Field, &kubeproto.Field{Immutable: true})
+
+		label := descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL
+		if repeated {
+			label = descriptorpb.FieldDescriptorProto_LABEL_REPEATED
+		}
+		return &descriptorpb.FileDescriptorProto{
+			Name:    proto.String("foo.proto"),
+			Package: proto.String("testing.apis"),
+			Syntax:  proto.String("proto3"),
+			Options: fileOpts,
+			MessageType: []*descriptorpb.DescriptorProto{
+				{
+					Name:    proto.String("Foo"),
+					Options: kindOpts,
+					Field: []*descriptorpb.FieldDescriptorProto{
+						{
+							Name:     proto.String("items"),
+							JsonName: proto.String("items"),
+							Number:   proto.Int32(1),
+							Label:    label.Enum(),
+							Type:     descriptorpb.FieldDescriptorProto_TYPE_MESSAGE.Enum(),
+							TypeName: proto.String(".testing.apis.FooItem"),
+						},
+					},
+				},
+				{
+					Name: proto.String("FooItem"),
+					Field: []*descriptorpb.FieldDescriptorProto{
+						{
+							Name:     proto.String("name"),
+							JsonName: proto.String("name"),
+							Number:   proto.Int32(1),
+							Label:    descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL.Enum(),
+							Type:     descriptorpb.FieldDescriptorProto_TYPE_STRING.Enum(),
+							Options:  immutable,
+						},
+					},
+				},
+			},
+		}
+	}
+
+	cases := []struct {
+		Name     string
+		Repeated bool
+		// Error is the substring of the error. An empty means no error.
+		Error string
+	}{
+		{Name: "Message"},
+		// The API server rejects the rule which uses oldSelf in the item of the list.
+		{Name: "InList", Repeated: true, Error: "FooItem: Name can't be immutable because it is in the list"},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			files, err := protodesc.NewFiles(&descriptorpb.FileDescriptorSet{File: []*descriptorpb.FileDescriptorProto{newFile(tc.Repeated)}})
+			require.NoError(t, err)
+			g, err := NewCRDGenerator([]string{"foo.proto"}, files)
+			require.NoError(t, err)
+
+			buf := new(bytes.Buffer)
+			err = g.Generate(buf)
+			if tc.Error != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.Error)
+				return
+			}
+			require.NoError(t, err)
+			assert.Contains(t, buf.String(), "self == oldSelf")
+		})
+	}
+}
@@ -0,0 +1,550 @@
+package k8s
+
+import (
+	"fmt"
+	"strings"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"go.f110.dev/kubeproto/internal/codegeneration"
+	"go.f110.dev/kubeproto/internal/definition"
+)
+
+// embeddableMessages are the messages that are embedded into other objects including hand-written structs.
+// MarshalJSON and UnmarshalJSON are not generated for them because these methods are promoted to the embedding struct.
+var embeddableMessages = map[string]struct{}{
+	"k8s.io.apimachinery.pkg.apis.meta.v1.TypeMeta":   {},
+	"k8s.io.apimachinery.pkg.apis.meta.v1.ObjectMeta": {},
+	"k8s.io.apimachinery.pkg.apis.meta.v1.ListMeta":   {},
+}
+
+type jsonValueKind int
+
+const (
+	jsonValueScalar jsonValueKind = iota
+	jsonValueEnum
+	jsonValueMessage
+	// jsonValueOpaque is a value that is encoded by encoding/json.
+	jsonValueOpaque
+	jsonValueSlice
+	jsonValueMap
+)
+
+type jsonValue struct {
+	Kind   jsonValueKind
+	Scalar protoreflect.Kind
+	// Type is a go type of the value without the pointer.
+	Type    string
+	Pointer bool
+	Elem    *jsonValue
+}
+
+type jsonField struct {
+	Key       string
+	Selector  string
+	OmitEmpty bool
+	Value     *jsonValue
+
+	depth int
+}
+
+// jsonGenerator generates the marshaler and the unmarshaler which don't depend on reflection.
+// The generated code uses the writer and the lexer of easyjson.
+type jsonGenerator struct {
+	lister      *definition.Lister
+	messages    definition.Messages
+	packageName string
+
+	imports map[string]string
+}
+
+func newJSONGenerator(lister *definition.Lister, packageName string) *jsonGenerator {
+	return &jsonGenerator{
+		lister:      lister,
+		messages:    lister.GetMessages(),
+		packageName: packageName,
+		imports: map[string]string{
+			"github.com/mailru/easyjson/jlexer":  "",
+			"github.com/mailru/easyjson/jwriter": "",
+		},
+	}
+}
+
+func (g *jsonGenerator) Import() map[string]string {
+	return g.imports
+}
+
+// IsTarget reports whether the methods can be generated for m.
+// If m has the inline field which can not be flattened, the methods will not be generated and encoding/json will encode m by reflection.
+func (g *jsonGenerator) IsTarget(m *definition.Message) bool {
+	if m == nil || m.IsList() {
+		return false
+	}
+	if isForeignPackage(m.Package.Path) {
+		return false
+	}
+	switch strings.TrimPrefix(m.Name, ".") {
+	case "k8s.io.apimachinery.pkg.apis.meta.v1.Time":
+		return false
+	}
+
+	return g.flattenable(m, make(map[string]struct{}))
+}
+
+func (g *jsonGenerator) flattenable(m *definition.Message, visited map[string]struct{}) bool {
+	if _, ok := visited[m.Name]; ok {
+		return false
+	}
+	visited[m.Name] = struct{}{}
+	defer delete(visited, m.Name)
+
+	for _, f := range m.Fields {
+		if !isInlineJSONField(f) {
+			continue
+		}
+		child := g.messages.Find(f.MessageName)
+		if f.Optional || child == nil || child.IsList() || isForeignPackage(child.Package.Path) {
+			return false
+		}
+		if !g.flattenable(child, visited) {
+			return false
+		}
+	}
+	return true
+}
+
+func (g *jsonGenerator) WriteTo(w *codegeneration.Writer, m *definition.Message) error {
+	if !g.IsTarget(m) {
+		return fmt.Errorf("%s has the inline field which can not be flattened", m.ShortName)
+	}
+	fields := dominantJSONFields(g.fields(m, "", 0))
+
+	if _, ok := embeddableMessages[strings.TrimPrefix(m.Name, ".")]; !ok {
+		w.F("func (in *%s) MarshalJSON() ([]byte, error) {", m.ShortName)
+		w.F("w := jwriter.Writer{}")
+		w.F("in.MarshalEasyJSON(&w)")
+		w.F("return w.BuildBytes()")
+		w.F("}")
+		w.F("")
+		w.F("func (in *%s) UnmarshalJSON(data []byte) error {", m.ShortName)
+		w.F("l := jlexer.Lexer{Data: data}")
+		w.F("in.UnmarshalEasyJSON(&l)")
+		w.F("return l.Error()")
+		w.F("}")
+		w.F("")
+	}
+
+	// Marshaler
+	body := codegeneration.NewWriter()
+	// written indicates that any field has been written certainly.
+	// If it can not be determined at generation time, the generated code holds it in "first".
+	written, useFirst, readFirst := false, false, false
+	for _, f := range fields {
+		expr := "in." + f.Selector
+		cond := ""
+		if f.OmitEmpty {
+			cond = g.nonEmptyCondition(expr, f.Value)
+		}
+		if cond != "" {
+			body.F("if %s {", cond)
+		}
+		switch {
+		case written:
+			body.F("w.RawString(%q)", fmt.Sprintf(",%q:", f.Key))
+		case useFirst:
+			readFirst = true
+			body.F("if !first {")
+			body.F("w.RawByte(',')")
+			body.F("}")
+			body.F("w.RawString(%q)", fmt.Sprintf("%q:", f.Key))
+		default:
+			body.F("w.RawString(%q)", fmt.Sprintf("%q:", f.Key))
+		}
+		if cond != "" {
+			g.marshalNonNilValue(body, expr, f.Value, 0)
+		} else {
+			g.marshalValue(body, expr, f.Value, 0)
+		}
+		if cond != "" {
+			if !written {
+				body.F("first = false")
+				useFirst = true
+			}
+			body.F("}")
+		} else {
+			written = true
+		}
+	}
+	w.F("func (in *%s) MarshalEasyJSON(w *jwriter.Writer) {", m.ShortName)
+	w.F("w.RawByte('{')")
+	if readFirst {
+		w.F("first := true")
+		body.WriteTo(w)
+	} else {
+		w.F("%s", strings.ReplaceAll(body.String(), "first = false\n", ""))
+	}
+	w.F("w.RawByte('}')")
+	w.F("}")
+	w.F("")
+
+	// Unmarshaler
+	w.F("func (in *%s) UnmarshalEasyJSON(l *jlexer.Lexer) {", m.ShortName)
+	w.F("isTopLevel := l.IsStart()")
+	w.F("if l.IsNull() {")
+	w.F("if isTopLevel {")
+	w.F("l.Consumed()")
+	w.F("}")
+	w.F("l.Skip()")
+	w.F("return")
+	w.F("}")
+	w.F("l.Delim('{')")
+	w.F("for !l.IsDelim('}') {")
+	w.F("key := l.UnsafeFieldName(false)")
+	w.F("l.WantColon()")
+	w.F("switch key {")
+	for _, f := range fields {
+		w.F("case %q:", f.Key)
+		g.unmarshalValue(w, "in."+f.Selector, f.Value, 0)
+	}
+	w.F("default:")
+	w.F("l.SkipRecursive()")
+	w.F("}")
+	w.F("l.WantComma()")
+	w.F("}")
+	w.F("l.Delim('}')")
+	w.F("if isTopLevel {")
+	w.F("l.Consumed()")
+	w.F("}")
+	w.F("}")
+	w.F("")
+
+	return nil
+}
+
+// fields returns the fields of m in the order of encoding/json.
+// The fields of the inline field are expanded to the same level of m.
+func (g *jsonGenerator) fields(m *definition.Message, prefix string, depth int) []*jsonField {
+	var fields []*jsonField
+	for _, f := range m.Fields {
+		if isInlineJSONField(f) {
+			child := g.messages.Find(f.MessageName)
+			fields = append(fields, g.fields(child, prefix+g.goFieldName(f)+".", depth+1)...)
+			continue
+		}
+
+		if f.Tag() == "`json:\"-\"`" {
+			continue
+		}
+		key := f.FieldName
+		if key == "" {
+			key = g.goFieldName(f)
+		}
+		fields = append(fields, &jsonField{
+			Key:       key,
+			Selector:  prefix + g.goFieldName(f),
+			OmitEmpty: f.Optional,
+			Value:     g.fieldValue(f),
+			depth:     depth,
+		})
+	}
+	return fields
+}
+
+// dominantJSONFields drops the fields which are hidden by other fields according to the rule of encoding/json.
+func dominantJSONFields(fields []*jsonField) []*jsonField {
+	minDepth := make(map[string]int)
+	count := make(map[string]int)
+	for _, f := range fields {
+		d, ok := minDepth[f.Key]
+		if !ok || f.depth < d {
+			minDepth[f.Key] = f.depth
+			count[f.Key] = 1
+		} else if f.depth == d {
+			count[f.Key]++
+		}
+	}
+
+	var result []*jsonField
+	for _, f := range fields {
+		if minDepth[f.Key] == f.depth && count[f.Key] == 1 {
+			result = append(result, f)
+		}
+	}
+	return result
+}
+
+func (g *jsonGenerator) goFieldName(f *definition.Field) string {
+	if !f.Embed {
+		return string(f.Name)
+	}
+
+	_, alias, typ := g.lister.ResolveGoType(g.packageName, f)
+	typ = strings.TrimPrefix(typ, "*")
+	if alias != "" {
+		typ = strings.TrimPrefix(typ, alias+".")
+	}
+	return typ
+}
+
+func (g *jsonGenerator) fieldValue(f *definition.Field) *jsonValue {
+	importPath, alias, typ := g.lister.ResolveGoType(g.packageName, f)
+	if importPath != "" {
+		g.imports[importPath] = alias
+	}
+
+	if f.IsMap() {
+		key, value := f.MapKeyValue()
+		if key.Kind() != protoreflect.StringKind {
+			return g.opaqueValue(typ, false)
+		}
+		valueType := typ[strings.Index(typ, "]")+1:]
+		var elem *jsonValue
+		switch value.Kind() {
+		case protoreflect.MessageKind:
+			elem = g.messageValue(g.messages.Find(string(value.Message().FullName())), valueType, false)
+		case protoreflect.EnumKind:
+			elem = g.opaqueValue(valueType, false)
+		default:
+			elem = &jsonValue{Kind: jsonValueScalar, Scalar: value.Kind(), Type: valueType}
+		}
+		return &jsonValue{Kind: jsonValueMap, Type: typ, Elem: elem}
+	}
+
+	elemType := strings.TrimPrefix(typ, "[]")
+	var v *jsonValue
+	switch f.Kind {
+	case protoreflect.MessageKind:
+		pointer := strings.HasPrefix(elemType, "*")
+		v = g.messageValue(g.messages.Find(f.MessageName), strings.TrimPrefix(elemType, "*"), pointer)
+	case protoreflect.EnumKind:
+		v = &jsonValue{Kind: jsonValueEnum, Type: elemType}
+	default:
+		v = &jsonValue{Kind: jsonValueScalar, Scalar: f.Kind, Type: elemType}
+	}
+	if f.Repeated {
+		return &jsonValue{Kind: jsonValueSlice, Type: typ, Elem: v}
+	}
+	return v
+}
+
+func (g *jsonGenerator) messageValue(m *definition.Message, typ string, pointer bool) *jsonValue {
+	if m != nil && m.IsList() {
+		items := m.Fields[0]
+		var elem *jsonValue
+		if items.Kind == protoreflect.EnumKind {
+			_, _, itemType := g.lister.ResolveGoType(g.packageName, items)
+			elem = &jsonValue{Kind: jsonValueEnum, Type: strings.TrimPrefix(itemType, "[]")}
+		} else {
+			elem = &jsonValue{Kind: jsonValueScalar, Scalar: items.Kind, Type: jsonScalarGoType[items.Kind]}
+		}
+		return &jsonValue{Kind: jsonValueSlice, Type: typ, Pointer: pointer, Elem: elem}
+	}
+	if !g.IsTarget(m) {
+		return g.opaqueValue(typ, pointer)
+	}
+	return &jsonValue{Kind: jsonValueMessage, Type: typ, Pointer: pointer}
+}
+
+func (g *jsonGenerator) opaqueValue(typ string, pointer bool) *jsonValue {
+	g.imports["encoding/json"] = ""
+	return &jsonValue{Kind: jsonValueOpaque, Type: typ, Pointer: pointer}
+}
+
+func (g *jsonGenerator) nonEmptyCondition(expr string, v *jsonValue) string {
+	if v.Pointer {
+		return fmt.Sprintf("%s != nil", expr)
+	}
+	switch v.Kind {
+	case jsonValueSlice, jsonValueMap:
+		return fmt.Sprintf("len(%s) != 0", expr)
+	case jsonValueEnum:
+		return fmt.Sprintf("%s != \"\"", expr)
+	case jsonValueScalar:
+		switch v.Scalar {
+		case protoreflect.StringKind:
+			return fmt.Sprintf("%s != \"\"", expr)
+		case protoreflect.BoolKind:
+			return expr
+		case protoreflect.BytesKind:
+			return fmt.Sprintf("len(%s) != 0", expr)
+		default:
+			return fmt.Sprintf("%s != 0", expr)
+		}
+	}
+	// encoding/json never omits the struct
+	return ""
+}
+
+var jsonScalarGoType = map[protoreflect.Kind]string{
+	protoreflect.StringKind: "string",
+	protoreflect.Int64Kind:  "int64",
+	protoreflect.Uint64Kind: "uint64",
+	protoreflect.Int32Kind:  "int",
+	protoreflect.Uint32Kind: "uint32",
+	protoreflect.BoolKind:   "bool",
+	protoreflect.BytesKind:  "[]byte",
+}
+
+var jsonScalarMethod = map[protoreflect.Kind]string{
+	protoreflect.StringKind: "String",
+	protoreflect.Int64Kind:  "Int64",
+	protoreflect.Uint64Kind: "Uint64",
+	protoreflect.Int32Kind:  "Int",
+	protoreflect.Uint32Kind: "Uint32",
+	protoreflect.BoolKind:   "Bool",
+}
+
+func (g *jsonGenerator) marshalValue(w *codegeneration.Writer, expr string, v *jsonValue, depth int) {
+	if v.Pointer && v.Kind != jsonValueOpaque {
+		w.F("if %s == nil {", expr)
+		w.F("w.RawString(\"null\")")
+		w.F("} else {")
+		g.marshalNonNilValue(w, expr, v, depth)
+		w.F("}")
+		return
+	}
+
+	switch v.Kind {
+	case jsonValueScalar:
+		if v.Scalar == protoreflect.BytesKind {
+			w.F("w.Base64Bytes(%s)", expr)
+		} else {
+			w.F("w.%s(%s)", jsonScalarMethod[v.Scalar], expr)
+		}
+	case jsonValueEnum:
+		w.F("w.String(string(%s))", expr)
+	case jsonValueMessage:
+		w.F("%s.MarshalEasyJSON(w)", expr)
+	case jsonValueOpaque:
+		if v.Pointer {
+			w.F("w.Raw(json.Marshal(%s))", expr)
+		} else {
+			w.F("w.Raw(json.Marshal(&%s))", expr)
+		}
+	case jsonValueSlice:
+		i := fmt.Sprintf("i%d", depth)
+		w.F("if %s == nil {", expr)
+		w.F("w.RawString(\"null\")")
+		w.F("} else {")
+		w.F("w.RawByte('[')")
+		w.F("for %s := range %s {", i, expr)
+		w.F("if %s > 0 {", i)
+		w.F("w.RawByte(',')")
+		w.F("}")
+		g.marshalValue(w, fmt.Sprintf("%s[%s]", expr, i), v.Elem, depth+1)
+		w.F("}")
+		w.F("w.RawByte(']')")
+		w.F("}")
+	case jsonValueMap:
+		g.imports["maps"] = ""
+		g.imports["slices"] = ""
+		i, k, val := fmt.Sprintf("i%d", depth), fmt.Sprintf("k%d", depth), fmt.Sprintf("v%d", depth)
+		w.F("if %s == nil {", expr)
+		w.F("w.RawString(\"null\")")
+		w.F("} else {")
+		w.F("w.RawByte('{')")
+		w.F("for %s, %s := range slices.Sorted(maps.Keys(%s)) {", i, k, expr)
+		w.F("if %s > 0 {", i)
+		w.F("w.RawByte(',')")
+		w.F("}")
+		w.F("w.String(%s)", k)
+		w.F("w.RawByte(':')")
+		w.F("%s := %s[%s]", val, expr, k)
+		g.marshalValue(w, val, v.Elem, depth+1)
+		w.F("}")
+		w.F("w.RawByte('}')")
+		w.F("}")
+	}
+}
+
+// marshalNonNilValue writes the code for v which is known as not nil.
+func (g *jsonGenerator) marshalNonNilValue(w *codegeneration.Writer, expr string, v *jsonValue, depth int) {
+	if !v.Pointer || v.Kind == jsonValueOpaque {
+		g.marshalValue(w, expr, v, depth)
+		return
+	}
+
+	switch v.Kind {
+	case jsonValueSlice:
+		g.marshalValue(w, "(*"+expr+")", &jsonValue{Kind: v.Kind, Type: v.Type, Elem: v.Elem}, depth)
+	default:
+		g.marshalValue(w, expr, &jsonValue{Kind: v.Kind, Type: v.Type}, depth)
+	}
+}
+
+func (g *jsonGenerator) unmarshalValue(w *codegeneration.Writer, expr string, v *jsonValue, depth int) {
+	if v.Kind == jsonValueOpaque {
+		w.F("l.AddError(json.Unmarshal(l.Raw(), &%s))", expr)
+		return
+	}
+
+	w.F("if l.IsNull() {")
+	w.F("l.Skip()")
+	if v.Pointer || v.Kind == jsonValueSlice || v.Kind == jsonValueMap || (v.Kind == jsonValueScalar && v.Scalar == protoreflect.BytesKind) {
+		w.F("%s = nil", expr)
+	}
+	w.F("} else {")
+	if v.Pointer {
+		w.F("if %s == nil {", expr)
+		w.F("%s = new(%s)", expr, v.Type)
+		w.F("}")
+		if v.Kind == jsonValueSlice {
+			expr = "(*" + expr + ")"
+		}
+	}
+
+	switch v.Kind {
+	case jsonValueScalar:
+		if v.Scalar == protoreflect.BytesKind {
+			w.F("%s = l.Bytes()", expr)
+		} else {
+			w.F("%s = l.%s()", expr, jsonScalarMethod[v.Scalar])
+		}
+	case jsonValueEnum:
+		w.F("%s = %s(l.String())", expr, v.Type)
+	case jsonValueMessage:
+		w.F("%s.UnmarshalEasyJSON(l)", expr)
+	case jsonValueSlice:
+		val := fmt.Sprintf("v%d", depth)
+		w.F("%s = make(%s, 0)", expr, v.Type)
+		w.F("l.Delim('[')")
+		w.F("for !l.IsDelim(']') {")
+		w.F("var %s %s", val, g.goType(v.Elem))
+		g.unmarshalValue(w, val, v.Elem, depth+1)
+		w.F("%s = append(%s, %s)", expr, expr, val)
+		w.F("l.WantComma()")
+		w.F("}")
+		w.F("l.Delim(']')")
+	case jsonValueMap:
+		k, val := fmt.Sprintf("k%d", depth), fmt.Sprintf("v%d", depth)
+		w.F("if %s == nil {", expr)
+		w.F("%s = make(%s)", expr, v.Type)
+		w.F("}")
+		w.F("l.Delim('{')")
+		w.F("for !l.IsDelim('}') {")
+		w.F("%s := l.String()", k)
+		w.F("l.WantColon()")
+		w.F("var %s %s", val, g.goType(v.Elem))
+		g.unmarshalValue(w, val, v.Elem, depth+1)
+		w.F("%s[%s] = %s", expr, k, val)
+		w.F("l.WantComma()")
+		w.F("}")
+		w.F("l.Delim('}')")
+	}
+	w.F("}")
+}
+
+func (g *jsonGenerator) goType(v *jsonValue) string {
+	if v.Pointer {
+		return "*" + v.Type
+	}
+	return v.Type
+}
+
+func isInlineJSONField(f *definition.Field) bool {
+	return f.Inline || (f.Embed && f.FieldName == "")
+}
+
+func isForeignPackage(importPath string) bool {
+	return strings.HasPrefix(importPath, "k8s.io/") || strings.HasPrefix(importPath, "google.golang.org/")
+}
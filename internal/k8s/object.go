@@ -2,44 +2,47 @@ package k8s
 
 import (
 	"bufio"
+	"fmt"
 	"io"
-	"os"
-	"path"
 	"sort"
 	"strings"
 
-	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/reflect/protoreflect"
 	"google.golang.org/protobuf/reflect/protoregistry"
-	"google.golang.org/protobuf/types/descriptorpb"
 
-	"go.f110.dev/kubeproto"
 	"go.f110.dev/kubeproto/internal/codegeneration"
 	"go.f110.dev/kubeproto/internal/definition"
 	"go.f110.dev/kubeproto/internal/stringsutil"
 )
 
 type ObjectGenerator struct {
-	file                    protoreflect.FileDescriptor
+	pkg                     *goPackage
 	lister                  *definition.Lister
 	packageNamespaceManager *definition.PackageNamespaceManager
 }
 
 func NewObjectGenerator(fileToGenerate []string, files *protoregistry.Files) (*ObjectGenerator, error) {
-	desc, err := files.FindFileByPath(fileToGenerate[0])
+	pkg, err := newGoPackage(fileToGenerate, files)
 	if err != nil {
 		return nil, err
 	}
 	nsm := definition.NewPackageNamespaceManager()
 
 	return &ObjectGenerator{
-		file:                    desc.(protoreflect.FileDescriptor),
+		pkg:                     pkg,
 		lister:                  definition.NewLister(fileToGenerate, files, nsm),
 		packageNamespaceManager: nsm,
 	}, nil
 }
 
-func (g *ObjectGenerator) Generate(out io.Writer) error {
+// Generate writes the definitions of the objects.
+// If all is true, Generate writes all messages in the file instead of the messages which are referenced by Kinds.
+// If skipDeepCopy is true, the DeepCopy functions are not generated.
+func (g *ObjectGenerator) Generate(out io.Writer, all, skipDeepCopy bool) error {
+	if err := g.lister.Validate(); err != nil {
+		return err
+	}
+
 	importPackages := map[string]string{
 		"k8s.io/apimachinery/pkg/runtime": "",
 	}
@@ -48,17 +51,11 @@ func (g *ObjectGenerator) Generate(out io.Writer) error {
 	messages := g.lister.GetMessages()
 
 	defW := codegeneration.NewWriter()
-	fileOpt := g.file.Options().(*descriptorpb.FileOptions)
-	e := proto.GetExtension(g.file.Options(), kubeproto.E_K8S)
-	ext := e.(*kubeproto.Kubernetes)
+	ext := g.pkg.Kubernetes
 	hasRuntimeObject := ext != nil
 
-	packageName := fileOpt.GetGoPackage()
-	kubeprotoGoPackage := proto.GetExtension(g.file.Options(), kubeproto.E_KubeprotoGoPackage)
-	if kubeprotoGoPackage.(string) != "" {
-		packageName = kubeprotoGoPackage.(string)
-	}
-	w.F("package %s", path.Base(packageName))
+	packageName := g.pkg.Path
+	w.F("package %s", g.pkg.Name())
 
 	if hasRuntimeObject {
 		importPackages["k8s.io/apimachinery/pkg/runtime/schema"] = ""
@@ -84,6 +81,21 @@ func (g *ObjectGenerator) Generate(out io.Writer) error {
 		}
 		defW.F(")")
 		defW.F("metav1.AddToGroupVersion(scheme, SchemaGroupVersion)")
+		for _, m := range messages.FilterKind() {
+			if m.Virtual {
+				continue
+			}
+			selectableFields, err := m.SelectableFields(messages)
+			if err != nil {
+				return err
+			}
+			if len(selectableFields) == 0 {
+				continue
+			}
+			defW.F("if err := scheme.AddFieldLabelConversionFunc(SchemaGroupVersion.WithKind(%q), fieldLabelConversionFuncFor%s); err != nil {", m.ShortName, m.ShortName)
+			defW.F("return err")
+			defW.F("}")
+		}
 		defW.F("return nil")
 		defW.F("}")
 	}
@@ -103,9 +115,11 @@ func (g *ObjectGenerator) Generate(out io.Writer) error {
 		defW.F(")")
 	}
 
+	jsonGenerator := newJSONGenerator(g.lister, packageName)
+	hasJSONMethods := false
 	mark := make(map[string]struct{})
 	var objs definition.Messages
-	if os.Getenv("KUBEPROTO_OPTS") == "all" {
+	if all {
 		objMap := make(map[string]*definition.Message)
 		for _, v := range messages {
 			if !v.Dep {
@@ -202,105 +216,156 @@ func (g *ObjectGenerator) Generate(out io.Writer) error {
 			defW.F("}")
 			defW.F("")
 
-			// DeepCopy functions (DeepCopyInto / DeepCopy / DeepCopyObject)
-			defW.F("func (in *%s) DeepCopyInto(out *%s) {", obj.ShortName, obj.ShortName)
-			defW.F("*out = *in")
-			for _, f := range obj.Fields {
-				m := messages.Find(f.MessageName)
-
-				switch f.Kind {
-				case protoreflect.MessageKind:
-					if f.Repeated {
-						defW.F("if in.%s != nil {", f.Name)
-						_, _, typ := g.lister.ResolveGoType(packageName, f)
-						defW.F("l := make(%s, len(in.%s))", typ, f.Name)
-						defW.F("for i := range in.%s {", f.Name)
-						defW.F("in.%s[i].DeepCopyInto(&l[i])", f.Name)
-						defW.F("}")
-						defW.F("out.%s = l", f.Name)
-						defW.F("}")
-						continue
-					}
-					if f.Optional {
-						defW.F("if in.%s != nil {", f.Name)
-						if f.IsMap() {
-							defW.F("in, out := &in.%s, &out.%s", f.Name, f.Name)
+			if !skipDeepCopy {
+				// DeepCopy functions (DeepCopyInto / DeepCopy / DeepCopyObject)
+				defW.F("func (in *%s) DeepCopyInto(out *%s) {", obj.ShortName, obj.ShortName)
+				defW.F("*out = *in")
+				for _, f := range obj.Fields {
+					m := messages.Find(f.MessageName)
+
+					switch f.Kind {
+					case protoreflect.MessageKind:
+						if f.Repeated {
+							defW.F("if in.%s != nil {", f.Name)
 							_, _, typ := g.lister.ResolveGoType(packageName, f)
-							defW.F("*out = make(%s, len(*in))", typ)
-							defW.F("for k, v := range *in {")
-							defW.F("(*out)[k] = v")
+							defW.F("l := make(%s, len(in.%s))", typ, f.Name)
+							defW.F("for i := range in.%s {", f.Name)
+							defW.F("in.%s[i].DeepCopyInto(&l[i])", f.Name)
+							defW.F("}")
+							defW.F("out.%s = l", f.Name)
 							defW.F("}")
+							continue
+						}
+						if f.Optional {
+							defW.F("if in.%s != nil {", f.Name)
+							if f.IsMap() {
+								defW.F("in, out := &in.%s, &out.%s", f.Name, f.Name)
+								_, _, typ := g.lister.ResolveGoType(packageName, f)
+								defW.F("*out = make(%s, len(*in))", typ)
+								defW.F("for k, v := range *in {")
+								defW.F("(*out)[k] = v")
+								defW.F("}")
+							} else {
+								defW.F("in, out := &in.%s, &out.%s", f.Name, f.Name)
+								importPath, _, typ := g.lister.ResolveGoType(packageName, f)
+								defW.F("*out = new(%s)", typ[1:])
+								switch importPath {
+								case "k8s.io/apimachinery/pkg/util/intstr":
+									defW.F("*out = *in")
+								default:
+									if m != nil && m.IsList() {
+										defW.F("copy(**out, **in)")
+									} else {
+										defW.F("(*in).DeepCopyInto(*out)")
+									}
+								}
+							}
+							defW.F("}")
+							continue
+						}
+						if f.Inline {
+							_, alias, typ := g.lister.ResolveGoType(packageName, f)
+							if alias != "" {
+								typ = strings.TrimPrefix(typ, alias+".")
+							}
+							defW.F("out.%s = in.%s", typ, typ)
 						} else {
-							defW.F("in, out := &in.%s, &out.%s", f.Name, f.Name)
-							importPath, _, typ := g.lister.ResolveGoType(packageName, f)
-							defW.F("*out = new(%s)", typ[1:])
+							importPath, _, _ := g.lister.ResolveGoType(packageName, f)
 							switch importPath {
 							case "k8s.io/apimachinery/pkg/util/intstr":
-								defW.F("*out = *in")
+								defW.F("in = out")
 							default:
 								if m != nil && m.IsList() {
-									defW.F("copy(**out, **in)")
+									defW.F("copy(out.%s, in.%s)", f.Name, f.Name)
 								} else {
-									defW.F("(*in).DeepCopyInto(*out)")
+									defW.F("in.%s.DeepCopyInto(&out.%s)", f.Name, f.Name)
 								}
 							}
 						}
-						defW.F("}")
-						continue
-					}
-					if f.Inline {
-						_, alias, typ := g.lister.ResolveGoType(packageName, f)
-						if alias != "" {
-							typ = strings.TrimPrefix(typ, alias+".")
-						}
-						defW.F("out.%s = in.%s", typ, typ)
-					} else {
-						importPath, _, _ := g.lister.ResolveGoType(packageName, f)
-						switch importPath {
-						case "k8s.io/apimachinery/pkg/util/intstr":
-							defW.F("in = out")
-						default:
-							if m != nil && m.IsList() {
-								defW.F("copy(out.%s, in.%s)", f.Name, f.Name)
-							} else {
-								defW.F("in.%s.DeepCopyInto(&out.%s)", f.Name, f.Name)
-							}
+					default:
+						if f.Repeated {
+							defW.F("if in.%s != nil {", f.Name)
+							_, _, typ := g.lister.ResolveGoType(packageName, f)
+							defW.F("t := make(%s, len(in.%s))", typ, f.Name)
+							defW.F("copy(t, in.%s)", f.Name)
+							defW.F("out.%s = t", f.Name)
+							defW.F("}")
 						}
 					}
-				default:
-					if f.Repeated {
-						defW.F("if in.%s != nil {", f.Name)
-						_, _, typ := g.lister.ResolveGoType(packageName, f)
-						defW.F("t := make(%s, len(in.%s))", typ, f.Name)
-						defW.F("copy(t, in.%s)", f.Name)
-						defW.F("out.%s = t", f.Name)
-						defW.F("}")
-					}
 				}
-			}
-			defW.F("}")
-			defW.F("")
-			defW.F("func (in *%s) DeepCopy() *%s {", obj.ShortName, obj.ShortName)
-			defW.F("if in == nil {\nreturn nil\n}")
-			defW.F("out := new(%s)", obj.ShortName)
-			defW.F("in.DeepCopyInto(out)")
-			defW.F("return out")
-			defW.F("}")
-			defW.F("")
-			// TypeMeta partially implements runtime.Object.
-			if obj.HasTypeMeta {
-				defW.F("func (in *%s) DeepCopyObject() runtime.Object {", obj.ShortName)
-				defW.F("if c := in.DeepCopy(); c != nil {")
-				defW.F("return c")
 				defW.F("}")
-				defW.F("return nil")
+				defW.F("")
+				defW.F("func (in *%s) DeepCopy() *%s {", obj.ShortName, obj.ShortName)
+				defW.F("if in == nil {\nreturn nil\n}")
+				defW.F("out := new(%s)", obj.ShortName)
+				defW.F("in.DeepCopyInto(out)")
+				defW.F("return out")
 				defW.F("}")
+				defW.F("")
+				// TypeMeta partially implements runtime.Object.
+				if obj.HasTypeMeta {
+					defW.F("func (in *%s) DeepCopyObject() runtime.Object {", obj.ShortName)
+					defW.F("if c := in.DeepCopy(); c != nil {")
+					defW.F("return c")
+					defW.F("}")
+					defW.F("return nil")
+					defW.F("}")
+					defW.F("")
+				}
+			}
+
+			// Condition functions
+			if obj.Kind {
+				conditionsField, err := obj.ConditionsField(messages)
+				if err != nil {
+					return err
+				}
+				if conditionsField != nil {
+					g.writeConditionFunctions(defW, importPackages, packageName, obj, conditionsField)
+				}
+			}
+
+			// ValidateUpdate
+			if obj.Kind && !obj.Virtual {
+				if immutableFields := obj.ImmutableFields(messages); len(immutableFields) > 0 {
+					g.writeValidateUpdate(defW, importPackages, obj, immutableFields)
+				}
+			}
+
+			// Field selector functions
+			if hasRuntimeObject && obj.Kind && !obj.Virtual {
+				selectableFields, err := obj.SelectableFields(messages)
+				if err != nil {
+					return err
+				}
+				g.writeSelectableFieldsFunctions(defW, importPackages, obj, selectableFields)
+			}
+
+			// Patch builder
+			if hasRuntimeObject && obj.Kind && !obj.Virtual {
+				g.writePatchBuilder(defW, importPackages, packageName, messages, obj)
+			}
+
+			// JSON functions (MarshalJSON / UnmarshalJSON)
+			if jsonGenerator.IsTarget(obj) {
+				if err := jsonGenerator.WriteTo(defW, obj); err != nil {
+					return err
+				}
+				hasJSONMethods = true
 			}
 		}
 
 		objs = objs[1:]
 	}
+	if hasJSONMethods {
+		for k, v := range jsonGenerator.Import() {
+			importPackages[k] = v
+		}
+	}
 
+	if skipDeepCopy && !hasRuntimeObject {
+		delete(importPackages, "k8s.io/apimachinery/pkg/runtime")
+	}
 	w.F("import (")
 	for p, a := range importPackages {
 		alias := g.packageNamespaceManager.Alias(p)
@@ -322,3 +387,180 @@ func (g *ObjectGenerator) Generate(out io.Writer) error {
 	}
 	return nil
 }
+
+// writeConditionFunctions writes the accessors of the conditions.
+// These functions are thin wrappers of the functions of metav1 package.
+func (g *ObjectGenerator) writeConditionFunctions(w *codegeneration.Writer, importPackages map[string]string, packageName string, obj *definition.Message, fields []*definition.Field) {
+	conditionsField := fields[len(fields)-1]
+	importPath, alias, typ := g.lister.ResolveGoType(packageName, conditionsField)
+	if importPath != "" {
+		importPackages[importPath] = alias
+	}
+	conditionType := strings.TrimPrefix(typ, "[]")
+	var pkg string
+	if alias != "" {
+		pkg = alias + "."
+	}
+
+	var selector []string
+	for _, f := range fields {
+		selector = append(selector, string(f.Name))
+	}
+
+	w.F("func (in *%s) GetConditions() []%s {", obj.ShortName, conditionType)
+	for i, f := range fields[:len(fields)-1] {
+		if f.Optional {
+			w.F("if in.%s == nil {", strings.Join(selector[:i+1], "."))
+			w.F("return nil")
+			w.F("}")
+		}
+	}
+	w.F("return in.%s", strings.Join(selector, "."))
+	w.F("}")
+	w.F("")
+	w.F("func (in *%s) SetConditions(conditions []%s) {", obj.ShortName, conditionType)
+	for i, f := range fields[:len(fields)-1] {
+		if f.Optional {
+			_, _, fieldType := g.lister.ResolveGoType(packageName, f)
+			w.F("if in.%s == nil {", strings.Join(selector[:i+1], "."))
+			w.F("in.%s = &%s{}", strings.Join(selector[:i+1], "."), strings.TrimPrefix(fieldType, "*"))
+			w.F("}")
+		}
+	}
+	w.F("in.%s = conditions", strings.Join(selector, "."))
+	w.F("}")
+	w.F("")
+	w.F("func (in *%s) FindCondition(conditionType string) *%s {", obj.ShortName, conditionType)
+	w.F("return %sFindCondition(in.GetConditions(), conditionType)", pkg)
+	w.F("}")
+	w.F("")
+	w.F("func (in *%s) SetCondition(condition %s) {", obj.ShortName, conditionType)
+	w.F("conditions := in.GetConditions()")
+	w.F("%sSetCondition(&conditions, condition)", pkg)
+	w.F("in.SetConditions(conditions)")
+	w.F("}")
+	w.F("")
+	w.F("func (in *%s) IsConditionTrue(conditionType string) bool {", obj.ShortName)
+	w.F("return %sIsConditionTrue(in.GetConditions(), conditionType)", pkg)
+	w.F("}")
+	w.F("")
+}
+
+// writeSelectableFieldsFunctions writes the function which returns the fields for the field selector.
+// If the object has the selectable fields, the field label conversion function is also written.
+func (g *ObjectGenerator) writeSelectableFieldsFunctions(w *codegeneration.Writer, importPackages map[string]string, obj *definition.Message, paths [][]*definition.Field) {
+	importPackages["k8s.io/apimachinery/pkg/fields"] = ""
+
+	w.F("// %sToSelectableFields returns the fields of %s which can be used by the field selector.", obj.ShortName, obj.ShortName)
+	w.F("func %sToSelectableFields(obj *%s) fields.Set {", obj.ShortName, obj.ShortName)
+	w.F("set := fields.Set{")
+	w.F("%q: obj.ObjectMeta.Name,", "metadata.name")
+	w.F("%q: obj.ObjectMeta.Namespace,", "metadata.namespace")
+	w.F("}")
+	labels := []string{"metadata.name", "metadata.namespace"}
+	for _, path := range paths {
+		label, value, conditions := fieldPathExpr("obj", path, importPackages)
+		labels = append(labels, label)
+
+		if len(conditions) > 0 {
+			w.F("if %s {", strings.Join(conditions, " && "))
+		}
+		w.F("set[%q] = %s", label, value)
+		if len(conditions) > 0 {
+			w.F("}")
+		}
+	}
+	w.F("return set")
+	w.F("}")
+	w.F("")
+
+	if len(paths) == 0 {
+		return
+	}
+	importPackages["fmt"] = ""
+	w.F("func fieldLabelConversionFuncFor%s(label, value string) (string, string, error) {", obj.ShortName)
+	w.F("switch label {")
+	for i := range labels {
+		labels[i] = fmt.Sprintf("%q", labels[i])
+	}
+	w.F("case %s:", strings.Join(labels, ", "))
+	w.F("return label, value, nil")
+	w.F("default:")
+	w.F("return \"\", \"\", fmt.Errorf(\"field label not supported: %%s\", label)")
+	w.F("}")
+	w.F("}")
+	w.F("")
+}
+
+// writeValidateUpdate writes ValidateUpdate which reports the immutable fields which are changed.
+func (g *ObjectGenerator) writeValidateUpdate(w *codegeneration.Writer, importPackages map[string]string, obj *definition.Message, paths [][]*definition.Field) {
+	importPackages["k8s.io/apimachinery/pkg/util/validation/field"] = ""
+
+	w.F("// ValidateUpdate returns the errors if the immutable fields are changed from old.")
+	w.F("func (in *%s) ValidateUpdate(old *%s) field.ErrorList {", obj.ShortName, obj.ShortName)
+	w.F("var allErrs field.ErrorList")
+	for _, path := range paths {
+		var jsonPath, selector, conditions []string
+		for i, f := range path {
+			jsonPath = append(jsonPath, fmt.Sprintf("%q", f.FieldName))
+			selector = append(selector, string(f.Name))
+			// The field is validated only when the field exists in both objects.
+			if i < len(path)-1 && f.Optional {
+				s := strings.Join(selector, ".")
+				conditions = append(conditions, fmt.Sprintf("in.%s != nil && old.%s != nil", s, s))
+			}
+		}
+
+		f := path[len(path)-1]
+		s := strings.Join(selector, ".")
+		var changed string
+		if f.Kind == protoreflect.MessageKind || f.Repeated || f.IsMap() || f.Kind == protoreflect.BytesKind {
+			importPackages["k8s.io/apimachinery/pkg/api/equality"] = ""
+			changed = fmt.Sprintf("!equality.Semantic.DeepEqual(in.%s, old.%s)", s, s)
+		} else {
+			changed = fmt.Sprintf("in.%s != old.%s", s, s)
+		}
+		conditions = append(conditions, changed)
+
+		w.F("if %s {", strings.Join(conditions, " && "))
+		w.F("allErrs = append(allErrs, field.Invalid(field.NewPath(%s), in.%s, \"field is immutable\"))", strings.Join(jsonPath, ", "), s)
+		w.F("}")
+	}
+	w.F("return allErrs")
+	w.F("}")
+	w.F("")
+}
+
+// fieldPathExpr returns the JSON path of the scalar field which is pointed by path, the expression which converts
+// the value of the field of receiver to string and the conditions for accessing the field.
+func fieldPathExpr(receiver string, path []*definition.Field, importPackages map[string]string) (string, string, []string) {
+	var jsonPath, selector, conditions []string
+	for i, f := range path {
+		jsonPath = append(jsonPath, f.FieldName)
+		selector = append(selector, string(f.Name))
+		if i < len(path)-1 && f.Optional {
+			conditions = append(conditions, fmt.Sprintf("%s.%s != nil", receiver, strings.Join(selector, ".")))
+		}
+	}
+
+	value := receiver + "." + strings.Join(selector, ".")
+	switch path[len(path)-1].Kind {
+	case protoreflect.StringKind:
+	case protoreflect.BoolKind:
+		importPackages["strconv"] = ""
+		value = fmt.Sprintf("strconv.FormatBool(%s)", value)
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind,
+		protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		importPackages["strconv"] = ""
+		value = fmt.Sprintf("strconv.FormatInt(int64(%s), 10)", value)
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind, protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		importPackages["strconv"] = ""
+		value = fmt.Sprintf("strconv.FormatUint(uint64(%s), 10)", value)
+	case protoreflect.FloatKind, protoreflect.DoubleKind:
+		importPackages["strconv"] = ""
+		value = fmt.Sprintf("strconv.FormatFloat(float64(%s), 'f', -1, 64)", value)
+	default:
+		value = fmt.Sprintf("string(%s)", value)
+	}
+	return strings.Join(jsonPath, "."), value, conditions
+}
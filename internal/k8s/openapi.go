@@ -0,0 +1,253 @@
+package k8s
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"reflect"
+	"sort"
+	"strings"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+
+	"go.f110.dev/kubeproto/internal/codegeneration"
+	"go.f110.dev/kubeproto/internal/definition"
+)
+
+// openAPIReferenceNames maps the messages to the name of the definition which is provided by the upstream.
+// The aggregated API server has the definitions of them already.
+var openAPIReferenceNames = map[string]string{
+	"k8s.io.apimachinery.pkg.apis.meta.v1.ObjectMeta": "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta",
+	"k8s.io.apimachinery.pkg.apis.meta.v1.ListMeta":   "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta",
+}
+
+// OpenAPIGenerator generates GetOpenAPIDefinitions which returns the definitions in the format of kube-openapi.
+// The schema is the same as the schema of CRD.
+type OpenAPIGenerator struct {
+	pkg    *goPackage
+	lister *definition.Lister
+	crd    *CRDGenerator
+}
+
+func NewOpenAPIGenerator(fileToGenerate []string, files *protoregistry.Files) (*OpenAPIGenerator, error) {
+	pkg, err := newGoPackage(fileToGenerate, files)
+	if err != nil {
+		return nil, err
+	}
+
+	nsm := definition.NewPackageNamespaceManager()
+	lister := definition.NewLister(fileToGenerate, files, nsm)
+	return &OpenAPIGenerator{
+		pkg:    pkg,
+		lister: lister,
+		crd:    &CRDGenerator{pkg: pkg, lister: lister},
+	}, nil
+}
+
+func (g *OpenAPIGenerator) Generate(out io.Writer) error {
+	if err := g.lister.Validate(); err != nil {
+		return err
+	}
+
+	packageName := g.pkg.Path
+
+	w := codegeneration.NewWriter()
+	w.F("package %s", g.pkg.Name())
+	w.F("")
+	w.F("import (")
+	w.F("%q", "k8s.io/kube-openapi/pkg/common")
+	w.F("%q", "k8s.io/kube-openapi/pkg/validation/spec")
+	w.F(")")
+	w.F("")
+
+	kinds := g.lister.GetMessages().FilterKind()
+	w.F("func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenAPIDefinition {")
+	w.F("return map[string]common.OpenAPIDefinition{")
+	for _, m := range kinds {
+		w.F("\"%s.%s\": openAPISchema%s(ref),", packageName, m.ShortName, m.ShortName)
+	}
+	w.F("}")
+	w.F("}")
+	w.F("")
+
+	for _, m := range kinds {
+		// The field of the message which has the definition (e.g. ObjectMeta and the items of the list) is the reference.
+		// These fields are not passed to ToOpenAPISchema because the schema of them is not inlined.
+		refs := make(map[*definition.Field]string)
+		msg := *m
+		msg.Fields = nil
+		for _, f := range m.Fields {
+			if f.Kind == protoreflect.MessageKind && f.FieldName != "" {
+				name := strings.TrimPrefix(f.MessageName, ".")
+				if ref, ok := openAPIReferenceNames[name]; ok {
+					refs[f] = ref
+					continue
+				}
+				if k := kinds.Find(name); k != nil {
+					refs[f] = fmt.Sprintf("%s.%s", packageName, k.ShortName)
+					continue
+				}
+			}
+			msg.Fields = append(msg.Fields, f)
+		}
+		schema, err := g.crd.ToOpenAPISchema(&msg)
+		if err != nil {
+			return err
+		}
+		var dependencies []string
+		for _, f := range m.Fields {
+			ref, ok := refs[f]
+			if !ok {
+				continue
+			}
+			props := apiextensionsv1.JSONSchemaProps{Ref: &ref}
+			if f.Repeated {
+				item := props
+				props = apiextensionsv1.JSONSchemaProps{Type: "array", Items: &apiextensionsv1.JSONSchemaPropsOrArray{Schema: &item}}
+			}
+			props.Description = f.Description
+			schema.Properties[f.FieldName] = props
+			dependencies = append(dependencies, ref)
+		}
+
+		w.F("func openAPISchema%s(ref common.ReferenceCallback) common.OpenAPIDefinition {", m.ShortName)
+		w.F("return common.OpenAPIDefinition{")
+		w.Fn("Schema: ")
+		if err := g.writeSchema(w, schema); err != nil {
+			return err
+		}
+		w.F(",")
+		if len(dependencies) > 0 {
+			w.F("Dependencies: []string{")
+			for _, v := range dependencies {
+				w.F("%q,", v)
+			}
+			w.F("},")
+		}
+		w.F("}")
+		w.F("}")
+		w.F("")
+	}
+
+	if err := w.Format(); err != nil {
+		return err
+	}
+	if _, err := w.WriteTo(out); err != nil {
+		return err
+	}
+	return nil
+}
+
+// writeSchema writes props as spec.Schema.
+// The property which is not written (e.g. pattern and the bounds of the number) is the error instead of being dropped.
+func (g *OpenAPIGenerator) writeSchema(w *codegeneration.Writer, props *apiextensionsv1.JSONSchemaProps) error {
+	unsupported := *props
+	unsupported.Ref = nil
+	unsupported.Description = ""
+	unsupported.Type = ""
+	unsupported.Format = ""
+	unsupported.Enum = nil
+	if props.Items != nil && props.Items.Schema != nil && len(props.Items.JSONSchemas) == 0 {
+		unsupported.Items = nil
+	}
+	if props.AdditionalProperties != nil && props.AdditionalProperties.Schema != nil {
+		unsupported.AdditionalProperties = nil
+	}
+	unsupported.Properties = nil
+	unsupported.Required = nil
+	unsupported.XValidations = nil
+	if !reflect.DeepEqual(unsupported, apiextensionsv1.JSONSchemaProps{}) {
+		b, err := json.Marshal(unsupported)
+		if err != nil {
+			return err
+		}
+		return fmt.Errorf("the schema has the property which is not supported by OpenAPI generator: %s", string(b))
+	}
+
+	w.F("spec.Schema{")
+	w.F("SchemaProps: spec.SchemaProps{")
+	if props.Ref != nil {
+		w.F("Ref: ref(%q),", *props.Ref)
+	}
+	if props.Description != "" {
+		w.F("Description: %q,", props.Description)
+	}
+	if props.Type != "" {
+		w.F("Type: []string{%q},", props.Type)
+	}
+	if props.Format != "" {
+		w.F("Format: %q,", props.Format)
+	}
+	if len(props.Enum) > 0 {
+		w.F("Enum: []interface{}{")
+		for _, v := range props.Enum {
+			var value string
+			if err := json.Unmarshal(v.Raw, &value); err != nil {
+				return fmt.Errorf("enum value must be string: %w", err)
+			}
+			w.F("%q,", value)
+		}
+		w.F("},")
+	}
+	if props.Items != nil && props.Items.Schema != nil {
+		w.Fn("Items: &spec.SchemaOrArray{Schema: &")
+		if err := g.writeSchema(w, props.Items.Schema); err != nil {
+			return err
+		}
+		w.F("},")
+	}
+	if props.AdditionalProperties != nil && props.AdditionalProperties.Schema != nil {
+		w.Fn("AdditionalProperties: &spec.SchemaOrBool{Allows: true, Schema: &")
+		if err := g.writeSchema(w, props.AdditionalProperties.Schema); err != nil {
+			return err
+		}
+		w.F("},")
+	}
+	if len(props.Properties) > 0 {
+		keys := make([]string, 0, len(props.Properties))
+		for k := range props.Properties {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+
+		w.F("Properties: map[string]spec.Schema{")
+		for _, k := range keys {
+			v := props.Properties[k]
+			w.Fn("%q: ", k)
+			if err := g.writeSchema(w, &v); err != nil {
+				return err
+			}
+			w.F(",")
+		}
+		w.F("},")
+	}
+	if len(props.Required) > 0 {
+		w.Fn("Required: []string{")
+		for _, v := range props.Required {
+			w.Fn("%q,", v)
+		}
+		w.F("},")
+	}
+	w.F("},")
+	// The rules of CEL (e.g. the rule of the immutable field) are the extension of kube-openapi.
+	if len(props.XValidations) > 0 {
+		w.F("VendorExtensible: spec.VendorExtensible{")
+		w.F("Extensions: spec.Extensions{")
+		w.F("\"x-kubernetes-validations\": []interface{}{")
+		for _, v := range props.XValidations {
+			w.Fn("map[string]interface{}{\"rule\": %q", v.Rule)
+			if v.Message != "" {
+				w.Fn(", \"message\": %q", v.Message)
+			}
+			w.F("},")
+		}
+		w.F("},")
+		w.F("},")
+		w.F("},")
+	}
+	w.Fn("}")
+
+	return nil
+}
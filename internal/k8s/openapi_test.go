@@ -0,0 +1,53 @@
+package k8s
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+
+	"go.f110.dev/kubeproto/internal/codegeneration"
+)
+
+func TestOpenAPIGenerator_WriteSchema(t *testing.T) {
+	minimum := float64(1)
+	cases := []struct {
+		Name  string
+		Props *apiextensionsv1.JSONSchemaProps
+		// Error indicates that the schema can't be written.
+		Error bool
+	}{
+		{
+			Name: "Supported",
+			Props: &apiextensionsv1.JSONSchemaProps{
+				Type:       "object",
+				Properties: map[string]apiextensionsv1.JSONSchemaProps{"name": {Type: "string", Format: "hostname"}},
+				Required:   []string{"name"},
+			},
+		},
+		{Name: "Pattern", Props: &apiextensionsv1.JSONSchemaProps{Type: "string", Pattern: "^[a-z]+$"}, Error: true},
+		{Name: "Minimum", Props: &apiextensionsv1.JSONSchemaProps{Type: "integer", Minimum: &minimum}, Error: true},
+		{Name: "Nullable", Props: &apiextensionsv1.JSONSchemaProps{Type: "string", Nullable: true}, Error: true},
+		{
+			Name: "NestedProperty",
+			Props: &apiextensionsv1.JSONSchemaProps{
+				Type:       "object",
+				Properties: map[string]apiextensionsv1.JSONSchemaProps{"name": {Type: "string", MaxLength: new(int64)}},
+			},
+			Error: true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			g := &OpenAPIGenerator{}
+			err := g.writeSchema(codegeneration.NewWriter(), tc.Props)
+			if tc.Error {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
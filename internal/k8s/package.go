@@ -0,0 +1,88 @@
+package k8s
+
+import (
+	"fmt"
+	"path"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/descriptorpb"
+
+	"go.f110.dev/kubeproto"
+)
+
+// goPackage represents the Go package which is generated from one or more proto files.
+// All files of the package must have the same go_package and the same dev.f110.kubeproto.k8s option.
+type goPackage struct {
+	Files []protoreflect.FileDescriptor
+	// Path is the import path of the package.
+	Path string
+	// Kubernetes is the option of the API group and version. nil if the package doesn't have it.
+	Kubernetes *kubeproto.Kubernetes
+}
+
+func newGoPackage(fileToGenerate []string, files *protoregistry.Files) (*goPackage, error) {
+	pkg := &goPackage{}
+	var k8sFile, kindFile, kind string
+	for i, v := range fileToGenerate {
+		desc, err := files.FindFileByPath(v)
+		if err != nil {
+			return nil, err
+		}
+		pkg.Files = append(pkg.Files, desc)
+
+		p := goPackagePath(desc)
+		if i == 0 {
+			pkg.Path = p
+		} else if pkg.Path != p {
+			return nil, fmt.Errorf("%s and %s have a different Go package: %q and %q", fileToGenerate[0], v, pkg.Path, p)
+		}
+
+		ext := proto.GetExtension(desc.Options(), kubeproto.E_K8S).(*kubeproto.Kubernetes)
+		if ext == nil {
+			// A file which has no Kind (e.g. common types only) may omit the option.
+			if k := findKind(desc); k != "" && kindFile == "" {
+				kindFile, kind = v, k
+			}
+			continue
+		}
+		if pkg.Kubernetes == nil {
+			pkg.Kubernetes = ext
+			k8sFile = v
+		} else if !proto.Equal(pkg.Kubernetes, ext) {
+			return nil, fmt.Errorf("%s and %s have a different dev.f110.kubeproto.k8s option", k8sFile, v)
+		}
+	}
+	if pkg.Kubernetes != nil && kindFile != "" {
+		return nil, fmt.Errorf("%s: %s is Kind but the file doesn't have dev.f110.kubeproto.k8s option which %s has", kindFile, kind, k8sFile)
+	}
+
+	return pkg, nil
+}
+
+// Name returns the name of the package.
+func (p *goPackage) Name() string {
+	return path.Base(p.Path)
+}
+
+func goPackagePath(desc protoreflect.FileDescriptor) string {
+	var packagePath string
+	if v, ok := desc.Options().(*descriptorpb.FileOptions); ok {
+		packagePath = v.GetGoPackage()
+	}
+	if v := proto.GetExtension(desc.Options(), kubeproto.E_KubeprotoGoPackage).(string); v != "" {
+		packagePath = v
+	}
+	return packagePath
+}
+
+func findKind(desc protoreflect.FileDescriptor) string {
+	for i := 0; i < desc.Messages().Len(); i++ {
+		m := desc.Messages().Get(i)
+		if ext := proto.GetExtension(m.Options(), kubeproto.E_Kind).(*kubeproto.Kind); ext != nil {
+			return string(m.Name())
+		}
+	}
+	return ""
+}
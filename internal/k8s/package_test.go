@@ -0,0 +1,103 @@
+package k8s
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/types/descriptorpb"
+
+	"go.f110.dev/kubeproto"
+)
+
+func TestNewGoPackage(t *testing.T) {
+	k8sOption := func(version string) *kubeproto.Kubernetes {
+		return &kubeproto.Kubernetes{Domain: "example.com", Version: version}
+	}
+	newFile := func(name, goPackage string, k8s *kubeproto.Kubernetes, kind string) *descriptorpb.FileDescriptorProto {
+		opts := &descriptorpb.FileOptions{GoPackage: proto.String(goPackage)}
+		if k8s != nil {
+			proto.SetExtension(opts, kubeproto.E_K8S, k8s)
+		}
+		f := &descriptorpb.FileDescriptorProto{
+			Name:    proto.String(name),
+			Package: proto.String("testing.apis"),
+			Syntax:  proto.String("proto3"),
+			Options: opts,
+		}
+		if kind != "" {
+			msgOpts := &descriptorpb.MessageOptions{}
+			proto.SetExtension(msgOpts, kubeproto.E_Kind, &kubeproto.Kind{})
+			f.MessageType = append(f.MessageType, &descriptorpb.DescriptorProto{Name: proto.String(kind), Options: msgOpts})
+		}
+		return f
+	}
+
+	cases := []struct {
+		Name  string
+		Files []*descriptorpb.FileDescriptorProto
+		// Error is the substring of the error. An empty means no error.
+		Error string
+	}{
+		{
+			Name: "Merged",
+			Files: []*descriptorpb.FileDescriptorProto{
+				newFile("foo.proto", "go.f110.dev/kubeproto/testing/apis", k8sOption("v1"), "Foo"),
+				newFile("bar.proto", "go.f110.dev/kubeproto/testing/apis", k8sOption("v1"), "Bar"),
+				// The file which has no Kind may omit the option.
+				newFile("common.proto", "go.f110.dev/kubeproto/testing/apis", nil, ""),
+			},
+		},
+		{
+			Name: "MismatchedGoPackage",
+			Files: []*descriptorpb.FileDescriptorProto{
+				newFile("foo.proto", "go.f110.dev/kubeproto/testing/apis", k8sOption("v1"), "Foo"),
+				newFile("bar.proto", "go.f110.dev/kubeproto/testing/other", k8sOption("v1"), "Bar"),
+			},
+			Error: "foo.proto and bar.proto have a different Go package",
+		},
+		{
+			Name: "MismatchedK8sOption",
+			Files: []*descriptorpb.FileDescriptorProto{
+				newFile("foo.proto", "go.f110.dev/kubeproto/testing/apis", k8sOption("v1"), "Foo"),
+				newFile("bar.proto", "go.f110.dev/kubeproto/testing/apis", k8sOption("v2"), "Bar"),
+			},
+			Error: "foo.proto and bar.proto have a different dev.f110.kubeproto.k8s option",
+		},
+		{
+			Name: "KindWithoutK8sOption",
+			Files: []*descriptorpb.FileDescriptorProto{
+				newFile("foo.proto", "go.f110.dev/kubeproto/testing/apis", k8sOption("v1"), "Foo"),
+				newFile("bar.proto", "go.f110.dev/kubeproto/testing/apis", nil, "Bar"),
+			},
+			Error: "bar.proto: Bar is Kind",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			files, err := protodesc.NewFiles(&descriptorpb.FileDescriptorSet{File: tc.Files})
+			require.NoError(t, err)
+			var fileToGenerate []string
+			for _, v := range tc.Files {
+				fileToGenerate = append(fileToGenerate, v.GetName())
+			}
+
+			pkg, err := newGoPackage(fileToGenerate, files)
+			if tc.Error != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.Error)
+				return
+			}
+			require.NoError(t, err)
+			assert.Len(t, pkg.Files, len(tc.Files))
+			assert.Equal(t, "go.f110.dev/kubeproto/testing/apis", pkg.Path)
+			assert.Equal(t, "apis", pkg.Name())
+			if assert.NotNil(t, pkg.Kubernetes) {
+				assert.Equal(t, "v1", pkg.Kubernetes.Version)
+			}
+		})
+	}
+}
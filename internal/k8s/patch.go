@@ -0,0 +1,137 @@
+package k8s
+
+import (
+	"fmt"
+	"strings"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"go.f110.dev/kubeproto/internal/codegeneration"
+	"go.f110.dev/kubeproto/internal/definition"
+)
+
+// writePatchBuilder writes the typed builder of the patch of obj.
+// The builder has the setters of the fields of obj and the fields of the nested messages in the same package.
+// The paths of the patch are the JSON names of the fields. Hence the setters are changed when the proto is changed.
+// If the name of the setter is the same as the setter of the other field (e.g. Spec.FooBar and Spec.Foo.Bar),
+// the name of the latter setter is qualified by the underscore (e.g. SetSpec_Foo_Bar).
+// JSON patch of the setters requires the parents of the field. See patch.Builder.JSONPatch.
+func (g *ObjectGenerator) writePatchBuilder(w *codegeneration.Writer, importPackages map[string]string, packageName string, messages definition.Messages, obj *definition.Message) {
+	importPackages["go.f110.dev/kubeproto/go/patch"] = ""
+	name := obj.ShortName + "Patch"
+
+	w.F("// %s builds JSON patch or JSON merge patch of %s.", name, obj.ShortName)
+	w.F("// JSON patch fails if the parent of the field doesn't exist (e.g. SetLabel to the object which has no label). MergePatch creates the parent.")
+	w.F("type %s struct {", name)
+	w.F("*patch.Builder")
+	w.F("}")
+	w.F("")
+	w.F("func New%s() *%s {", name, name)
+	w.F("return &%s{Builder: patch.NewBuilder()}", name)
+	w.F("}")
+	w.F("")
+	w.F("func (p *%s) SetLabel(key, value string) *%s {", name, name)
+	w.F("p.Set([]string{\"metadata\", \"labels\", key}, value)")
+	w.F("return p")
+	w.F("}")
+	w.F("")
+	w.F("func (p *%s) RemoveLabel(key string) *%s {", name, name)
+	w.F("p.Remove([]string{\"metadata\", \"labels\", key})")
+	w.F("return p")
+	w.F("}")
+	w.F("")
+	w.F("func (p *%s) SetAnnotation(key, value string) *%s {", name, name)
+	w.F("p.Set([]string{\"metadata\", \"annotations\", key}, value)")
+	w.F("return p")
+	w.F("}")
+	w.F("")
+	w.F("func (p *%s) RemoveAnnotation(key string) *%s {", name, name)
+	w.F("p.Remove([]string{\"metadata\", \"annotations\", key})")
+	w.F("return p")
+	w.F("}")
+	w.F("")
+
+	// Label and Annotation are reserved by the setters of metadata.
+	// methods has the path of the field of each setter.
+	methods := map[string]string{"Label": "metadata.labels", "Annotation": "metadata.annotations"}
+	g.writePatchSetters(w, importPackages, packageName, messages, name, obj, nil, nil, methods, make(map[string]struct{}))
+}
+
+func (g *ObjectGenerator) writePatchSetters(w *codegeneration.Writer, importPackages map[string]string, packageName string, messages definition.Messages, builderName string, m *definition.Message, goPath, jsonPath []string, methods map[string]string, visited map[string]struct{}) {
+	if _, ok := visited[m.Name]; ok {
+		return
+	}
+	visited[m.Name] = struct{}{}
+	defer delete(visited, m.Name)
+
+	for _, f := range m.Fields {
+		if f.Inline || f.Embed || f.FieldName == "" {
+			continue
+		}
+		fieldGoPath := append(append([]string{}, goPath...), string(f.Name))
+		fieldJSONPath := append(append([]string{}, jsonPath...), f.FieldName)
+		if !g.writePatchSetter(w, importPackages, packageName, builderName, f, fieldGoPath, fieldJSONPath, methods) {
+			continue
+		}
+		if f.Repeated || f.Kind != protoreflect.MessageKind || f.IsMap() {
+			continue
+		}
+		child := messages.Find(f.MessageName)
+		// The fields of the messages in the other packages are not expanded.
+		if child == nil || child.Virtual || child.Dep || child.IsList() {
+			continue
+		}
+		g.writePatchSetters(w, importPackages, packageName, messages, builderName, child, fieldGoPath, fieldJSONPath, methods, visited)
+	}
+}
+
+// writePatchSetter writes the setters of the field f. It returns false if the type of f can't be resolved.
+func (g *ObjectGenerator) writePatchSetter(w *codegeneration.Writer, importPackages map[string]string, packageName, builderName string, f *definition.Field, fieldGoPath, fieldJSONPath []string, methods map[string]string) bool {
+	importPath, alias, typ := g.lister.ResolveGoType(packageName, f)
+	if typ == "" {
+		return false
+	}
+
+	goPath := strings.Join(fieldGoPath, ".")
+	methodName := strings.Join(fieldGoPath, "")
+	if _, ok := methods[methodName]; ok {
+		methodName = strings.Join(fieldGoPath, "_")
+	}
+	if p, ok := methods[methodName]; ok {
+		// The nested fields still have the setters.
+		w.F("// The setter of %s is not generated because the name conflicts with the setter of %s.", goPath, p)
+		w.F("")
+		return true
+	}
+	methods[methodName] = goPath
+
+	quoted := make([]string, len(fieldJSONPath))
+	for i, v := range fieldJSONPath {
+		quoted[i] = fmt.Sprintf("%q", v)
+	}
+	path := strings.Join(quoted, ", ")
+
+	if importPath != "" {
+		importPackages[importPath] = alias
+	}
+	w.F("func (p *%s) Set%s(v %s) *%s {", builderName, methodName, typ, builderName)
+	w.F("p.Set([]string{%s}, v)", path)
+	w.F("return p")
+	w.F("}")
+	w.F("")
+	w.F("func (p *%s) Remove%s() *%s {", builderName, methodName, builderName)
+	w.F("p.Remove([]string{%s})", path)
+	w.F("return p")
+	w.F("}")
+	w.F("")
+	if f.Repeated {
+		w.F("func (p *%s) Add%s(v ...%s) *%s {", builderName, methodName, strings.TrimPrefix(typ, "[]"), builderName)
+		w.F("for _, e := range v {")
+		w.F("p.Append([]string{%s}, e)", path)
+		w.F("}")
+		w.F("return p")
+		w.F("}")
+		w.F("")
+	}
+	return true
+}
@@ -0,0 +1,102 @@
+package k8s
+
+import (
+	"path"
+	"sort"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/version"
+
+	"go.f110.dev/kubeproto/internal/codegeneration"
+	"go.f110.dev/kubeproto/internal/definition"
+	"go.f110.dev/kubeproto/internal/stringsutil"
+)
+
+type restMapperGenerator struct {
+	groupVersions map[string][]*definition.Message
+}
+
+func newRESTMapperGenerator(groupVersions map[string][]*definition.Message) *restMapperGenerator {
+	return &restMapperGenerator{groupVersions: groupVersions}
+}
+
+func (g *restMapperGenerator) Import() map[string]string {
+	importPackages := map[string]string{
+		"go.f110.dev/kubeproto/go/typedclient": "",
+	}
+	for _, v := range g.groupVersions {
+		for _, m := range v {
+			if m.NonResource {
+				continue
+			}
+			_, p := path.Split(m.Package.Path)
+			alias := m.Package.Alias
+			if p == m.Package.Alias {
+				alias = ""
+			}
+			importPackages[m.Package.Path] = alias
+		}
+	}
+
+	return importPackages
+}
+
+func (g *restMapperGenerator) WriteTo(writer *codegeneration.Writer) error {
+	writer.F("// NewRESTMapper returns meta.RESTMapper which knows all kinds of Set.")
+	writer.F("// The core group is preferred over the other groups and the newer version is preferred in the same group.")
+	writer.F("func NewRESTMapper() *typedclient.StaticRESTMapper {")
+	writer.F("return typedclient.NewStaticRESTMapper(")
+	for _, k := range g.sortedGroupVersions() {
+		for _, m := range g.groupVersions[k] {
+			// The kind which is not served as the resource can't be mapped to the resource.
+			if m.NonResource {
+				continue
+			}
+			writer.Fn("typedclient.ResourceMapping{")
+			writer.Fn("GroupVersionKind: %s.SchemaGroupVersion.WithKind(%q), ", m.Package.Alias, m.ShortName)
+			writer.Fn("Resource: %q, ", strings.ToLower(stringsutil.Plural(m.ShortName)))
+			writer.Fn("SingularResource: %q, ", strings.ToLower(m.ShortName))
+			if len(m.ShortNames) > 0 {
+				writer.Fn("ShortNames: []string{%q}, ", strings.Join(m.ShortNames, `", "`))
+			}
+			writer.F("Namespaced: %v},", m.Scope != definition.ScopeTypeCluster)
+		}
+	}
+	writer.F(")")
+	writer.F("}")
+	writer.F("")
+
+	return nil
+}
+
+// sortedGroupVersions returns the keys of groupVersions in order of the priority.
+func (g *restMapperGenerator) sortedGroupVersions() []string {
+	type groupVersion struct {
+		key     string
+		group   string
+		version string
+	}
+	var gvs []groupVersion
+	for k, v := range g.groupVersions {
+		group := v[0].Group
+		if group == "." {
+			group = ""
+		}
+		gvs = append(gvs, groupVersion{key: k, group: group, version: v[0].Version})
+	}
+	sort.Slice(gvs, func(i, j int) bool {
+		if gvs[i].group != gvs[j].group {
+			if gvs[i].group == "" || gvs[j].group == "" {
+				return gvs[i].group == ""
+			}
+			return gvs[i].group < gvs[j].group
+		}
+		return version.CompareKubeAwareVersionStrings(gvs[i].version, gvs[j].version) > 0
+	})
+
+	keys := make([]string, 0, len(gvs))
+	for _, v := range gvs {
+		keys = append(keys, v.key)
+	}
+	return keys
+}
@@ -4,12 +4,14 @@ import (
 	"fmt"
 	"io"
 	"path"
+	"strings"
 
 	"google.golang.org/protobuf/reflect/protoregistry"
 	"google.golang.org/protobuf/types/descriptorpb"
 
 	"go.f110.dev/kubeproto/internal/codegeneration"
 	"go.f110.dev/kubeproto/internal/definition"
+	"go.f110.dev/kubeproto/internal/stringsutil"
 )
 
 type FakeClientGenerator struct {
@@ -33,8 +35,13 @@ func (g *FakeClientGenerator) Generate(out io.Writer, packageName, importPath, c
 
 	// The key is a package path. The value is an alias.
 	importPackages := map[string]string{
-		"context": "",
-		"strings": "",
+		"context":           "",
+		"fmt":               "",
+		"net/http":          "",
+		"net/http/httptest": "",
+		"reflect":           "",
+		"strings":           "",
+		"sync":              "",
 	}
 	for k, v := range importPackages {
 		g.packageNamespaceManager.Add(k, v)
@@ -100,16 +107,28 @@ func (g *restFakeClientGenerator) Import() map[string]string {
 	importPackages := map[string]string{
 		"k8s.io/apimachinery/pkg/api/meta":           "",
 		"k8s.io/apimachinery/pkg/apis/meta/v1":       "k8smetav1",
+		"k8s.io/apimachinery/pkg/fields":             "",
 		"k8s.io/apimachinery/pkg/watch":              "",
 		"k8s.io/apimachinery/pkg/labels":             "",
 		"k8s.io/apimachinery/pkg/runtime":            "",
 		"k8s.io/apimachinery/pkg/runtime/schema":     "",
 		"k8s.io/apimachinery/pkg/runtime/serializer": "",
+		"k8s.io/apimachinery/pkg/types":              "",
 		"k8s.io/client-go/rest":                      "",
 		"k8s.io/client-go/testing":                   "k8stesting",
 		"go.f110.dev/kubeproto/go/apis/metav1":       "",
 		g.clientPath:                                 "",
 	}
+	for _, v := range g.groupVersions {
+		for _, m := range v {
+			_, p := path.Split(m.Package.Path)
+			alias := m.Package.Alias
+			if p == m.Package.Alias {
+				alias = ""
+			}
+			importPackages[m.Package.Path] = alias
+		}
+	}
 
 	return importPackages
 }
@@ -126,12 +145,20 @@ func (g *restFakeClientGenerator) WriteTo(writer *codegeneration.Writer, fqdn bo
 	writer.F("%s.Set", clientPackageName)
 	writer.F("")
 	writer.F("fake k8stesting.Fake")
+	writer.F("// scheme is the scheme of the tracker. The kinds which are unknown to the client can be registered to scheme.")
+	writer.F("scheme *runtime.Scheme")
 	writer.F("tracker k8stesting.ObjectTracker")
+	writer.F("proxy *proxyHandlers")
 	writer.F("}")
 	writer.F("")
 	writer.F("func NewSet() *Set {")
-	writer.F("s := &Set{}")
-	writer.F("s.tracker = k8stesting.NewObjectTracker(%s.Scheme, codecs.UniversalDecoder())", clientPackageName)
+	writer.F("s := &Set{proxy: &proxyHandlers{handlers: make(map[string]http.Handler)}}")
+	writer.F("s.scheme = runtime.NewScheme()")
+	writer.F("if err := %s.AddToScheme(s.scheme); err != nil {", clientPackageName)
+	writer.F("panic(err)")
+	writer.F("}")
+	writer.F("s.tracker = k8stesting.NewObjectTracker(s.scheme, codecs.UniversalDecoder())")
+	writer.F("s.fake.AddReactor(\"delete-collection\", \"*\", deleteCollectionReaction(s.tracker))")
 	writer.F("s.fake.AddReactor(\"*\", \"*\", k8stesting.ObjectReaction(s.tracker))")
 	writer.F("s.fake.AddWatchReactor(\"*\", func(action k8stesting.Action) (handled bool, ret watch.Interface, err error) {")
 	writer.F("w, err := s.tracker.Watch(action.GetResource(), action.GetNamespace())")
@@ -144,7 +171,7 @@ func (g *restFakeClientGenerator) WriteTo(writer *codegeneration.Writer, fqdn bo
 	for _, k := range keys(g.groupVersions) {
 		m := g.groupVersions[k][0]
 		clientName := m.ClientName(fqdn)
-		writer.F("s.%s = %s.New%sClient(&fakerBackend{fake: &s.fake}, nil)", clientName, clientPackageName, clientName)
+		writer.F("s.%s = %s.New%sClient(&fakerBackend{fake: &s.fake, tracker: s.tracker, proxy: s.proxy}, nil)", clientName, clientPackageName, clientName)
 	}
 	writer.F("return s")
 	writer.F("}") // end of NewSet
@@ -161,10 +188,85 @@ func (g *restFakeClientGenerator) WriteTo(writer *codegeneration.Writer, fqdn bo
 	writer.F("s.fake.ClearActions()")
 	writer.F("}")
 	writer.F("")
+	writer.F("// PrependReactor adds the reactor which is called before the reactors of the tracker.")
+	writer.F("func (s *Set) PrependReactor(verb, resource string, reaction k8stesting.ReactionFunc) {")
+	writer.F("s.fake.PrependReactor(verb, resource, reaction)")
+	writer.F("}")
+	writer.F("")
+	writer.F("// RegisterProxyHandler registers the handler which serves the requests through the proxy sub resource.")
+	writer.F("// resourceName is \"services\" or \"pods\". The handler serves the requests to all ports of the object.")
+	writer.F("// The request is recorded as k8stesting.ProxyGetAction whose verb is the lower case of the method.")
+	writer.F("// If the reactor of the action returns the error, the request fails with the error.")
+	writer.F("func (s *Set) RegisterProxyHandler(resourceName, namespace, name string, h http.Handler) {")
+	writer.F("s.proxy.mu.Lock()")
+	writer.F("defer s.proxy.mu.Unlock()")
+	writer.F("")
+	writer.F("s.proxy.handlers[resourceName+\"/\"+namespace+\"/\"+name] = h")
+	writer.F("}")
+	writer.F("")
+
+	// The tracker requires the kind to list the objects.
+	writer.F("var resourceKinds = map[schema.GroupVersionResource]string{")
+	for _, k := range keys(g.groupVersions) {
+		for _, m := range g.groupVersions[k] {
+			group := m.Group
+			if group == "." {
+				group = ""
+			}
+			writer.F("{Group: %q, Version: %q, Resource: %q}: %q,", group, m.Version, strings.ToLower(stringsutil.Plural(m.ShortName)), m.ShortName)
+		}
+	}
+	writer.F("}")
+	writer.F("")
+	writer.F("// selectableFields returns the fields of obj which can be used by the field selector.")
+	writer.F("func selectableFields(obj runtime.Object) fields.Set {")
+	writer.F("switch v := obj.(type) {")
+	for _, k := range keys(g.groupVersions) {
+		for _, m := range g.groupVersions[k] {
+			writer.F("case *%s.%s:", m.Package.Alias, m.ShortName)
+			writer.F("return %s.%sToSelectableFields(v)", m.Package.Alias, m.ShortName)
+		}
+	}
+	writer.F("}")
+	writer.F("objMeta := obj.(metav1.Object).GetObjectMeta()")
+	writer.F("return fields.Set{\"metadata.name\": objMeta.Name, \"metadata.namespace\": objMeta.Namespace}")
+	writer.F("}")
+	writer.F("")
+	writer.F("// validateFieldSelector returns the error if selector has the field which is not selectable for gvk.")
+	writer.F("// The kind which doesn't have the selectable fields supports only metadata.name and metadata.namespace like the API server.")
+	writer.F("func validateFieldSelector(gvk schema.GroupVersionKind, selector fields.Selector) error {")
+	writer.F("for _, r := range selector.Requirements() {")
+	writer.F("if _, _, err := %s.Scheme.ConvertFieldLabel(gvk, r.Field, r.Value); err != nil {", clientPackageName)
+	writer.F("return err")
+	writer.F("}")
+	writer.F("}")
+	writer.F("return nil")
+	writer.F("}")
+	writer.F("")
 
 	writer.F(`
 type fakerBackend struct {
-	fake *k8stesting.Fake
+	fake    *k8stesting.Fake
+	tracker k8stesting.ObjectTracker
+	proxy   *proxyHandlers
+}
+
+type proxyHandlers struct {
+	mu       sync.RWMutex
+	handlers map[string]http.Handler
+}
+
+func (p *proxyHandlers) get(resourceName, namespace, name string) http.Handler {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+
+	return p.handlers[resourceName+"/"+namespace+"/"+name]
+}
+
+type roundTripperFunc func(req *http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
 }
 `)
 
@@ -191,6 +293,17 @@ type fakerBackend struct {
 	if strings.HasSuffix(gvk.Kind, "List") {
 		gvk.Kind = strings.TrimSuffix(gvk.Kind, "List")
 	}
+	label, err := labels.Parse(opts.LabelSelector)
+	if err != nil {
+		return nil, err
+	}
+	field, err := fields.ParseSelector(opts.FieldSelector)
+	if err != nil {
+		return nil, err
+	}
+	if err := validateFieldSelector(gvk, field); err != nil {
+		return nil, err
+	}
 	k8sListOpt := k8smetav1.ListOptions{
 		LabelSelector:   opts.LabelSelector,
 		FieldSelector:   opts.FieldSelector,
@@ -202,10 +315,6 @@ type fakerBackend struct {
 		return nil, err
 	}
 
-	label, _, _ := k8stesting.ExtractFromListOptions(k8sListOpt)
-	if label == nil {
-		label = labels.Everything()
-	}
 	objs, err := meta.ExtractList(obj)
 	if err != nil {
 		return nil, err
@@ -214,7 +323,7 @@ type fakerBackend struct {
 	for _, item := range objs {
 		m := item.(metav1.Object)
 		objMeta := m.GetObjectMeta()
-		if label.Matches(labels.Set(objMeta.Labels)) {
+		if label.Matches(labels.Set(objMeta.Labels)) && field.Matches(selectableFields(item)) {
 			filtered = append(filtered, item)
 		}
 	}
@@ -280,11 +389,146 @@ type fakerBackend struct {
 	return err
 }`)
 
-	writer.F(`func (f *fakerBackend) Watch(ctx context.Context, gvr schema.GroupVersionResource, namespace string, opts metav1.ListOptions) (watch.Interface, error) {
-	return f.fake.InvokesWatch(k8stesting.NewWatchAction(gvr, namespace, opts))
+	writer.F(`func (f *fakerBackend) DeleteCollection(ctx context.Context, gvr schema.GroupVersionResource, namespace string, opts metav1.DeleteOptions, listOpts metav1.ListOptions) error {
+	if _, err := labels.Parse(listOpts.LabelSelector); err != nil {
+		return err
+	}
+	if _, err := fields.ParseSelector(listOpts.FieldSelector); err != nil {
+		return err
+	}
+	k8sListOpt := k8smetav1.ListOptions{
+		LabelSelector:   listOpts.LabelSelector,
+		FieldSelector:   listOpts.FieldSelector,
+		ResourceVersion: listOpts.ResourceVersion,
+	}
+	_, err := f.fake.Invokes(k8stesting.NewDeleteCollectionAction(gvr, namespace, k8sListOpt), nil)
+
+	return err
+}
+
+// deleteCollectionReaction returns the reactor which deletes the objects matched to the selectors of the action from tracker.
+func deleteCollectionReaction(tracker k8stesting.ObjectTracker) k8stesting.ReactionFunc {
+	return func(action k8stesting.Action) (bool, runtime.Object, error) {
+		a := action.(k8stesting.DeleteCollectionAction)
+		gvr := a.GetResource()
+		kind, ok := resourceKinds[gvr]
+		if !ok {
+			return true, nil, fmt.Errorf("unknown resource: %%s", gvr.String())
+		}
+		restrictions := a.GetListRestrictions()
+		if err := validateFieldSelector(gvr.GroupVersion().WithKind(kind), restrictions.Fields); err != nil {
+			return true, nil, err
+		}
+		list, err := tracker.List(gvr, gvr.GroupVersion().WithKind(kind), a.GetNamespace())
+		if err != nil {
+			return true, nil, err
+		}
+		objs, err := meta.ExtractList(list)
+		if err != nil {
+			return true, nil, err
+		}
+		for _, item := range objs {
+			objMeta := item.(metav1.Object).GetObjectMeta()
+			if !restrictions.Labels.Matches(labels.Set(objMeta.Labels)) || !restrictions.Fields.Matches(selectableFields(item)) {
+				continue
+			}
+			if err := tracker.Delete(gvr, objMeta.Namespace, objMeta.Name); err != nil {
+				return true, nil, err
+			}
+		}
+		return true, nil, nil
+	}
+}
+
+// Watch watches the objects which are matched to the label selector and the field selector of opts.
+func (f *fakerBackend) Watch(ctx context.Context, gvr schema.GroupVersionResource, namespace string, opts metav1.ListOptions) (watch.Interface, error) {
+	label, err := labels.Parse(opts.LabelSelector)
+	if err != nil {
+		return nil, err
+	}
+	field, err := fields.ParseSelector(opts.FieldSelector)
+	if err != nil {
+		return nil, err
+	}
+	if kind, ok := resourceKinds[gvr]; ok {
+		if err := validateFieldSelector(gvr.GroupVersion().WithKind(kind), field); err != nil {
+			return nil, err
+		}
+	}
+	w, err := f.fake.InvokesWatch(k8stesting.NewWatchAction(gvr, namespace, opts))
+	if err != nil || (label.Empty() && field.Empty()) {
+		return w, err
+	}
+	return watch.Filter(w, func(in watch.Event) (watch.Event, bool) {
+		m, ok := in.Object.(metav1.Object)
+		if !ok {
+			return in, true
+		}
+		return in, label.Matches(labels.Set(m.GetObjectMeta().Labels)) && field.Matches(selectableFields(in.Object))
+	}), nil
+}
+
+// IsWatchListSemanticsUnSupported reports that the tracker doesn't send the initial events and the bookmark.
+// The informers fall back to the list and the watch.
+func (f *fakerBackend) IsWatchListSemanticsUnSupported() bool {
+	return true
 }
 `)
 
+	writer.F(`func (f *fakerBackend) Patch(ctx context.Context, resourceName, namespace, name string, pt types.PatchType, data []byte, opts metav1.PatchOptions, result runtime.Object, subresources ...string) (runtime.Object, error) {
+	gvks, _, err := %s.Scheme.ObjectKinds(result)
+	if err != nil {
+		return nil, err
+	}
+	gvk := gvks[0]
+	k8sPatchOpt := k8smetav1.PatchOptions{
+		DryRun:       opts.DryRun,
+		FieldManager: opts.FieldManager,
+	}
+	if opts.Force {
+		force := true
+		k8sPatchOpt.Force = &force
+	}
+	obj, err := f.fake.Invokes(k8stesting.NewPatchSubresourceActionWithOptions(gvk.GroupVersion().WithResource(resourceName), namespace, name, pt, data, k8sPatchOpt, subresources...), result)
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.DeepCopyObject(), err
+}`, clientPackageName)
+	writer.F("")
+
+	writer.F(`func (f *fakerBackend) SubResource(ctx context.Context, verb string, gvr schema.GroupVersionResource, namespace, name, subresource string, obj, opts, result runtime.Object) (runtime.Object, error) {
+	var action k8stesting.Action
+	switch verb {
+	case "GET":
+		action = k8stesting.NewGetSubresourceAction(gvr, namespace, subresource, name)
+	case "POST":
+		action = k8stesting.NewCreateSubresourceAction(gvr, name, subresource, namespace, obj)
+	case "PUT":
+		action = k8stesting.NewUpdateSubresourceAction(gvr, subresource, namespace, obj)
+	default:
+		return nil, fmt.Errorf("%%s is not supported", verb)
+	}
+	ret, err := f.fake.Invokes(action, result)
+
+	if ret == nil {
+		return nil, err
+	}
+	if reflect.TypeOf(ret) != reflect.TypeOf(result) {
+		// The tracker returns the request object as it is if the type of the request is not the parent object.
+		// (e.g. Binding of Pod) The tracker doesn't have the side effect of the sub resource like binding the pod
+		// to the node or evicting the pod. The test which depends on the side effect has to add a reactor.
+		if obj != nil && reflect.TypeOf(ret) == reflect.TypeOf(obj) {
+			return result, err
+		}
+		// The tracker returns the parent object instead of the sub resource.
+		return nil, fmt.Errorf("sub resource %%s of %%s is not supported by the tracker; add a reactor", subresource, gvr.Resource)
+	}
+	return ret.DeepCopyObject(), err
+}`)
+	writer.F("")
+
 	// For non-namespaced resource
 	writer.F(`func (f *fakerBackend) GetClusterScoped(ctx context.Context, resourceName, name string, opts metav1.GetOptions, result runtime.Object) (runtime.Object, error) {
 	return f.Get(ctx, resourceName, "", name, opts, result)
@@ -310,10 +554,46 @@ func (f *fakerBackend) DeleteClusterScoped(ctx context.Context, gvr schema.Group
 	return f.Delete(ctx, gvr, "", name, opts)
 }
 
+func (f *fakerBackend) DeleteCollectionClusterScoped(ctx context.Context, gvr schema.GroupVersionResource, opts metav1.DeleteOptions, listOpts metav1.ListOptions) error {
+	return f.DeleteCollection(ctx, gvr, "", opts, listOpts)
+}
+
 func (f *fakerBackend) WatchClusterScoped(ctx context.Context, gvr schema.GroupVersionResource, opts metav1.ListOptions) (watch.Interface, error) {
 	return f.Watch(ctx, gvr, "", opts)
 }
 
+func (f *fakerBackend) PatchClusterScoped(ctx context.Context, resourceName, name string, pt types.PatchType, data []byte, opts metav1.PatchOptions, result runtime.Object, subresources ...string) (runtime.Object, error) {
+	return f.Patch(ctx, resourceName, "", name, pt, data, opts, result, subresources...)
+}
+
+func (f *fakerBackend) SubResourceClusterScoped(ctx context.Context, verb string, gvr schema.GroupVersionResource, name, subresource string, obj, opts, result runtime.Object) (runtime.Object, error) {
+	return f.SubResource(ctx, verb, gvr, "", name, subresource, obj, opts, result)
+}
+
+func (f *fakerBackend) ProxyRoundTripper(resourceName, namespace, name, port string) http.RoundTripper {
+	return roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		params := make(map[string]string)
+		for k, v := range req.URL.Query() {
+			params[k] = v[0]
+		}
+		action := k8stesting.NewProxyGetAction(schema.GroupVersionResource{Version: "v1", Resource: resourceName}, namespace, req.URL.Scheme, name, port, req.URL.Path, params)
+		// The verb of the action is the method of the request. (e.g. post)
+		action.Verb = strings.ToLower(req.Method)
+		if _, err := f.fake.Invokes(action, nil); err != nil {
+			return nil, err
+		}
+
+		rec := httptest.NewRecorder()
+		h := f.proxy.get(resourceName, namespace, name)
+		if h == nil {
+			http.Error(rec, fmt.Sprintf("no handler for %%s %%s/%%s", resourceName, namespace, name), http.StatusServiceUnavailable)
+		} else {
+			h.ServeHTTP(rec, req)
+		}
+		return rec.Result(), nil
+	})
+}
+
 func (f *fakerBackend) RESTClient() *rest.RESTClient {
 	return nil
 }
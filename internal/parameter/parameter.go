@@ -0,0 +1,120 @@
+package parameter
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Key is the name of the option of the plugins.
+type Key string
+
+const (
+	// All generates the code for all messages instead of the messages which are referenced by Kinds.
+	All Key = "all"
+	// FQDNSet uses the fully qualified name for the name of the client in the Set.
+	FQDNSet Key = "fqdn-set"
+	// Out is the path of the output file.
+	Out Key = "out"
+	// Header is the comment which is put on the top of the output file.
+	// "\n" in the value is treated as the line break because the value can't contain the newline.
+	Header Key = "header"
+	// SkipDeepCopy doesn't generate the DeepCopy functions.
+	SkipDeepCopy Key = "skip-deepcopy"
+	// ImportPath is the import path of the package of the output file.
+	ImportPath Key = "importpath"
+	// ClientImportPath is the import path of the package of the client.
+	ClientImportPath Key = "client-importpath"
+)
+
+var booleanKeys = map[Key]struct{}{
+	All:          {},
+	FQDNSet:      {},
+	SkipDeepCopy: {},
+}
+
+// Parameter is the options of the plugins.
+type Parameter struct {
+	All              bool
+	FQDNSet          bool
+	Out              string
+	Header           string
+	SkipDeepCopy     bool
+	ImportPath       string
+	ClientImportPath string
+}
+
+// Parse parses the parameter which is passed to the plugin.
+// The parameter is a comma separated list of key=value. The value of a boolean option can be omitted.
+// accepts is the list of the keys which the plugin supports. Parse returns an error if the parameter has an unknown key.
+func Parse(s string, accepts ...Key) (*Parameter, error) {
+	accepted := make(map[Key]struct{})
+	for _, v := range accepts {
+		accepted[v] = struct{}{}
+	}
+
+	p := &Parameter{}
+	if s == "" {
+		return p, nil
+	}
+	for _, v := range strings.Split(s, ",") {
+		if v == "" {
+			continue
+		}
+		k, value, hasValue := strings.Cut(v, "=")
+		key := Key(strings.TrimSpace(k))
+		if _, ok := accepted[key]; !ok {
+			return nil, fmt.Errorf("unknown option: %s", key)
+		}
+
+		var b bool
+		if _, ok := booleanKeys[key]; ok {
+			b = true
+			if hasValue {
+				parsed, err := strconv.ParseBool(value)
+				if err != nil {
+					return nil, fmt.Errorf("%s is a boolean option: %w", key, err)
+				}
+				b = parsed
+			}
+		} else if !hasValue || value == "" {
+			return nil, fmt.Errorf("%s requires the value", key)
+		}
+
+		switch key {
+		case All:
+			p.All = b
+		case FQDNSet:
+			p.FQDNSet = b
+		case SkipDeepCopy:
+			p.SkipDeepCopy = b
+		case Out:
+			p.Out = value
+		case Header:
+			p.Header = value
+		case ImportPath:
+			p.ImportPath = value
+		case ClientImportPath:
+			p.ClientImportPath = value
+		}
+	}
+
+	return p, nil
+}
+
+// HeaderComment returns the header as comment lines. prefix is the marker of the comment of the output file.
+// HeaderComment returns an empty string if the header is not specified.
+func (p *Parameter) HeaderComment(prefix string) string {
+	if p.Header == "" {
+		return ""
+	}
+
+	var b strings.Builder
+	for _, line := range strings.Split(p.Header, "\\n") {
+		b.WriteString(prefix)
+		b.WriteString(line)
+		b.WriteString("\n")
+	}
+	b.WriteString("\n")
+	return b.String()
+}
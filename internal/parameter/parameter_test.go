@@ -0,0 +1,42 @@
+package parameter
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParse(t *testing.T) {
+	p, err := Parse("out=go/k8sclient/client.go,importpath=go.f110.dev/kubeproto/go/k8sclient,fqdn-set", Out, ImportPath, FQDNSet)
+	require.NoError(t, err)
+	assert.Equal(t, "go/k8sclient/client.go", p.Out)
+	assert.Equal(t, "go.f110.dev/kubeproto/go/k8sclient", p.ImportPath)
+	assert.True(t, p.FQDNSet)
+
+	p, err = Parse("all=false,skip-deepcopy=true", All, SkipDeepCopy)
+	require.NoError(t, err)
+	assert.False(t, p.All)
+	assert.True(t, p.SkipDeepCopy)
+
+	p, err = Parse("", Out)
+	require.NoError(t, err)
+	assert.Equal(t, "", p.Out)
+
+	_, err = Parse("fqdn-set", Out)
+	assert.Error(t, err)
+	_, err = Parse("out", Out)
+	assert.Error(t, err)
+	_, err = Parse("all=yes", All)
+	assert.Error(t, err)
+}
+
+func TestParameter_HeaderComment(t *testing.T) {
+	p, err := Parse(`header=Code generated by kubeproto.\nDO NOT EDIT.`, Header)
+	require.NoError(t, err)
+	assert.Equal(t, "// Code generated by kubeproto.\n// DO NOT EDIT.\n\n", p.HeaderComment("// "))
+
+	p, err = Parse("", Header)
+	require.NoError(t, err)
+	assert.Equal(t, "", p.HeaderComment("// "))
+}
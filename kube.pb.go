@@ -1,6 +1,6 @@
 // Code generated by protoc-gen-go. DO NOT EDIT.
 // versions:
-// 	protoc-gen-go v1.36.3
+// 	protoc-gen-go v1.36.11-devel
 // 	protoc        v6.32.1
 // source: kube.proto
 
@@ -12,6 +12,7 @@ import (
 	descriptorpb "google.golang.org/protobuf/types/descriptorpb"
 	reflect "reflect"
 	sync "sync"
+	unsafe "unsafe"
 )
 
 const (
@@ -67,12 +68,70 @@ func (Scope) EnumDescriptor() ([]byte, []int) {
 	return file_kube_proto_rawDescGZIP(), []int{0}
 }
 
+type Verb int32
+
+const (
+	Verb_VERB_GET    Verb = 0
+	Verb_VERB_CREATE Verb = 1
+	Verb_VERB_UPDATE Verb = 2
+)
+
+// Enum value maps for Verb.
+var (
+	Verb_name = map[int32]string{
+		0: "VERB_GET",
+		1: "VERB_CREATE",
+		2: "VERB_UPDATE",
+	}
+	Verb_value = map[string]int32{
+		"VERB_GET":    0,
+		"VERB_CREATE": 1,
+		"VERB_UPDATE": 2,
+	}
+)
+
+func (x Verb) Enum() *Verb {
+	p := new(Verb)
+	*p = x
+	return p
+}
+
+func (x Verb) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Verb) Descriptor() protoreflect.EnumDescriptor {
+	return file_kube_proto_enumTypes[1].Descriptor()
+}
+
+func (Verb) Type() protoreflect.EnumType {
+	return &file_kube_proto_enumTypes[1]
+}
+
+func (x Verb) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Verb.Descriptor instead.
+func (Verb) EnumDescriptor() ([]byte, []int) {
+	return file_kube_proto_rawDescGZIP(), []int{1}
+}
+
 type Kind struct {
 	state                    protoimpl.MessageState `protogen:"open.v1"`
 	AdditionalPrinterColumns []*PrinterColumn       `protobuf:"bytes,1,rep,name=additional_printer_columns,json=additionalPrinterColumns,proto3" json:"additional_printer_columns,omitempty"`
 	Scope                    Scope                  `protobuf:"varint,2,opt,name=scope,proto3,enum=dev.f110.kubeproto.Scope" json:"scope,omitempty"`
-	unknownFields            protoimpl.UnknownFields
-	sizeCache                protoimpl.SizeCache
+	// conditions is the path to the field that has the list of metav1.Condition. (e.g. status.conditions)
+	// If conditions is empty, status.conditions or conditions is used when the type of the field is the list of metav1.Condition.
+	Conditions string `protobuf:"bytes,3,opt,name=conditions,proto3" json:"conditions,omitempty"`
+	// sub_resources are the sub resources except status. status is declared by the field.
+	SubResources []*SubResource `protobuf:"bytes,4,rep,name=sub_resources,json=subResources,proto3" json:"sub_resources,omitempty"`
+	// short_names are the short names of the resource. (e.g. po)
+	ShortNames []string `protobuf:"bytes,5,rep,name=short_names,json=shortNames,proto3" json:"short_names,omitempty"`
+	// non_resource indicates that the kind is not served as the resource. (e.g. Scale which is used only by the sub resource)
+	NonResource   bool `protobuf:"varint,6,opt,name=non_resource,json=nonResource,proto3" json:"non_resource,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *Kind) Reset() {
@@ -119,19 +178,138 @@ func (x *Kind) GetScope() Scope {
 	return Scope_SCOPE_NAMESPACED
 }
 
+func (x *Kind) GetConditions() string {
+	if x != nil {
+		return x.Conditions
+	}
+	return ""
+}
+
+func (x *Kind) GetSubResources() []*SubResource {
+	if x != nil {
+		return x.SubResources
+	}
+	return nil
+}
+
+func (x *Kind) GetShortNames() []string {
+	if x != nil {
+		return x.ShortNames
+	}
+	return nil
+}
+
+func (x *Kind) GetNonResource() bool {
+	if x != nil {
+		return x.NonResource
+	}
+	return false
+}
+
+type SubResource struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// name is the name of the sub resource. (e.g. approval)
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Verb Verb   `protobuf:"varint,2,opt,name=verb,proto3,enum=dev.f110.kubeproto.Verb" json:"verb,omitempty"`
+	// request is the name of the message of the request body.
+	// The name without the package is resolved in the package of Kind.
+	// If request is empty, Kind is used. request is ignored when verb is VERB_GET.
+	Request string `protobuf:"bytes,3,opt,name=request,proto3" json:"request,omitempty"`
+	// response is the name of the message of the response. If response is empty, Kind is used.
+	Response string `protobuf:"bytes,4,opt,name=response,proto3" json:"response,omitempty"`
+	// go_name is the name of the sub resource in the name of the method. (e.g. EphemeralContainers)
+	// If go_name is empty, name is converted to the upper camel case.
+	GoName        string `protobuf:"bytes,5,opt,name=go_name,json=goName,proto3" json:"go_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubResource) Reset() {
+	*x = SubResource{}
+	mi := &file_kube_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubResource) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubResource) ProtoMessage() {}
+
+func (x *SubResource) ProtoReflect() protoreflect.Message {
+	mi := &file_kube_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubResource.ProtoReflect.Descriptor instead.
+func (*SubResource) Descriptor() ([]byte, []int) {
+	return file_kube_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *SubResource) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SubResource) GetVerb() Verb {
+	if x != nil {
+		return x.Verb
+	}
+	return Verb_VERB_GET
+}
+
+func (x *SubResource) GetRequest() string {
+	if x != nil {
+		return x.Request
+	}
+	return ""
+}
+
+func (x *SubResource) GetResponse() string {
+	if x != nil {
+		return x.Response
+	}
+	return ""
+}
+
+func (x *SubResource) GetGoName() string {
+	if x != nil {
+		return x.GoName
+	}
+	return ""
+}
+
 type Field struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	GoName        string                 `protobuf:"bytes,1,opt,name=go_name,json=goName,proto3" json:"go_name,omitempty"`
-	Inline        bool                   `protobuf:"varint,2,opt,name=inline,proto3" json:"inline,omitempty"`
-	SubResource   bool                   `protobuf:"varint,3,opt,name=sub_resource,json=subResource,proto3" json:"sub_resource,omitempty"`
-	ApiFieldName  string                 `protobuf:"bytes,4,opt,name=api_field_name,json=apiFieldName,proto3" json:"api_field_name,omitempty"`
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	GoName       string                 `protobuf:"bytes,1,opt,name=go_name,json=goName,proto3" json:"go_name,omitempty"`
+	Inline       bool                   `protobuf:"varint,2,opt,name=inline,proto3" json:"inline,omitempty"`
+	SubResource  bool                   `protobuf:"varint,3,opt,name=sub_resource,json=subResource,proto3" json:"sub_resource,omitempty"`
+	ApiFieldName string                 `protobuf:"bytes,4,opt,name=api_field_name,json=apiFieldName,proto3" json:"api_field_name,omitempty"`
+	// selectable marks the field as the field which can be used by the field selector.
+	// The field must be a scalar or an enum and must not be in the list.
+	Selectable bool `protobuf:"varint,5,opt,name=selectable,proto3" json:"selectable,omitempty"`
+	// immutable forbids changing the value of the field after the creation.
+	Immutable bool `protobuf:"varint,6,opt,name=immutable,proto3" json:"immutable,omitempty"`
+	// indexed marks the field as the index of the informer. The index name is the JSON path of the field (e.g. "spec.nodeName").
+	// The field must be a scalar or an enum and must not be in the list.
+	Indexed       bool `protobuf:"varint,7,opt,name=indexed,proto3" json:"indexed,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *Field) Reset() {
 	*x = Field{}
-	mi := &file_kube_proto_msgTypes[1]
+	mi := &file_kube_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -143,7 +321,7 @@ func (x *Field) String() string {
 func (*Field) ProtoMessage() {}
 
 func (x *Field) ProtoReflect() protoreflect.Message {
-	mi := &file_kube_proto_msgTypes[1]
+	mi := &file_kube_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -156,7 +334,7 @@ func (x *Field) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Field.ProtoReflect.Descriptor instead.
 func (*Field) Descriptor() ([]byte, []int) {
-	return file_kube_proto_rawDescGZIP(), []int{1}
+	return file_kube_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *Field) GetGoName() string {
@@ -187,20 +365,90 @@ func (x *Field) GetApiFieldName() string {
 	return ""
 }
 
+func (x *Field) GetSelectable() bool {
+	if x != nil {
+		return x.Selectable
+	}
+	return false
+}
+
+func (x *Field) GetImmutable() bool {
+	if x != nil {
+		return x.Immutable
+	}
+	return false
+}
+
+func (x *Field) GetIndexed() bool {
+	if x != nil {
+		return x.Indexed
+	}
+	return false
+}
+
+type Message struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// immutable forbids changing the value of all fields of the message after the creation.
+	// If the message is Kind, metadata and sub resources are excluded.
+	Immutable     bool `protobuf:"varint,1,opt,name=immutable,proto3" json:"immutable,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Message) Reset() {
+	*x = Message{}
+	mi := &file_kube_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Message) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Message) ProtoMessage() {}
+
+func (x *Message) ProtoReflect() protoreflect.Message {
+	mi := &file_kube_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Message.ProtoReflect.Descriptor instead.
+func (*Message) Descriptor() ([]byte, []int) {
+	return file_kube_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *Message) GetImmutable() bool {
+	if x != nil {
+		return x.Immutable
+	}
+	return false
+}
+
 type Kubernetes struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Domain        string                 `protobuf:"bytes,1,opt,name=domain,proto3" json:"domain,omitempty"`
-	SubGroup      string                 `protobuf:"bytes,2,opt,name=sub_group,json=subGroup,proto3" json:"sub_group,omitempty"`
-	Version       string                 `protobuf:"bytes,3,opt,name=version,proto3" json:"version,omitempty"`
-	Served        bool                   `protobuf:"varint,4,opt,name=served,proto3" json:"served,omitempty"`
-	Storage       bool                   `protobuf:"varint,5,opt,name=storage,proto3" json:"storage,omitempty"`
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// domain and sub_group are combined to the group
+	// and combined the group and version are "apiVersion".
+	// apiVersion is "${domain}.${sub_group}/${version}"
+	Domain        string `protobuf:"bytes,1,opt,name=domain,proto3" json:"domain,omitempty"`
+	SubGroup      string `protobuf:"bytes,2,opt,name=sub_group,json=subGroup,proto3" json:"sub_group,omitempty"`
+	Version       string `protobuf:"bytes,3,opt,name=version,proto3" json:"version,omitempty"`
+	Served        bool   `protobuf:"varint,4,opt,name=served,proto3" json:"served,omitempty"`
+	Storage       bool   `protobuf:"varint,5,opt,name=storage,proto3" json:"storage,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *Kubernetes) Reset() {
 	*x = Kubernetes{}
-	mi := &file_kube_proto_msgTypes[2]
+	mi := &file_kube_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -212,7 +460,7 @@ func (x *Kubernetes) String() string {
 func (*Kubernetes) ProtoMessage() {}
 
 func (x *Kubernetes) ProtoReflect() protoreflect.Message {
-	mi := &file_kube_proto_msgTypes[2]
+	mi := &file_kube_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -225,7 +473,7 @@ func (x *Kubernetes) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Kubernetes.ProtoReflect.Descriptor instead.
 func (*Kubernetes) Descriptor() ([]byte, []int) {
-	return file_kube_proto_rawDescGZIP(), []int{2}
+	return file_kube_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *Kubernetes) GetDomain() string {
@@ -264,20 +512,21 @@ func (x *Kubernetes) GetStorage() bool {
 }
 
 type PrinterColumn struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Description   string                 `protobuf:"bytes,1,opt,name=description,proto3" json:"description,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	JsonPath      string                 `protobuf:"bytes,3,opt,name=json_path,json=jsonPath,proto3" json:"json_path,omitempty"`
-	Priority      int32                  `protobuf:"varint,4,opt,name=priority,proto3" json:"priority,omitempty"`
-	Type          string                 `protobuf:"bytes,5,opt,name=type,proto3" json:"type,omitempty"`
-	Format        string                 `protobuf:"bytes,6,opt,name=format,proto3" json:"format,omitempty"`
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Description string                 `protobuf:"bytes,1,opt,name=description,proto3" json:"description,omitempty"`
+	Name        string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	JsonPath    string                 `protobuf:"bytes,3,opt,name=json_path,json=jsonPath,proto3" json:"json_path,omitempty"`
+	Priority    int32                  `protobuf:"varint,4,opt,name=priority,proto3" json:"priority,omitempty"`
+	// In future, type and format field will remove.
+	Type          string `protobuf:"bytes,5,opt,name=type,proto3" json:"type,omitempty"`
+	Format        string `protobuf:"bytes,6,opt,name=format,proto3" json:"format,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *PrinterColumn) Reset() {
 	*x = PrinterColumn{}
-	mi := &file_kube_proto_msgTypes[3]
+	mi := &file_kube_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -289,7 +538,7 @@ func (x *PrinterColumn) String() string {
 func (*PrinterColumn) ProtoMessage() {}
 
 func (x *PrinterColumn) ProtoReflect() protoreflect.Message {
-	mi := &file_kube_proto_msgTypes[3]
+	mi := &file_kube_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -302,7 +551,7 @@ func (x *PrinterColumn) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PrinterColumn.ProtoReflect.Descriptor instead.
 func (*PrinterColumn) Descriptor() ([]byte, []int) {
-	return file_kube_proto_rawDescGZIP(), []int{3}
+	return file_kube_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *PrinterColumn) GetDescription() string {
@@ -356,7 +605,7 @@ type EnumValue struct {
 
 func (x *EnumValue) Reset() {
 	*x = EnumValue{}
-	mi := &file_kube_proto_msgTypes[4]
+	mi := &file_kube_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -368,7 +617,7 @@ func (x *EnumValue) String() string {
 func (*EnumValue) ProtoMessage() {}
 
 func (x *EnumValue) ProtoReflect() protoreflect.Message {
-	mi := &file_kube_proto_msgTypes[4]
+	mi := &file_kube_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -381,7 +630,7 @@ func (x *EnumValue) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EnumValue.ProtoReflect.Descriptor instead.
 func (*EnumValue) Descriptor() ([]byte, []int) {
-	return file_kube_proto_rawDescGZIP(), []int{4}
+	return file_kube_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *EnumValue) GetValue() string {
@@ -400,6 +649,14 @@ var file_kube_proto_extTypes = []protoimpl.ExtensionInfo{
 		Tag:           "bytes,60010,opt,name=kind",
 		Filename:      "kube.proto",
 	},
+	{
+		ExtendedType:  (*descriptorpb.MessageOptions)(nil),
+		ExtensionType: (*Message)(nil),
+		Field:         60011,
+		Name:          "dev.f110.kubeproto.message",
+		Tag:           "bytes,60011,opt,name=message",
+		Filename:      "kube.proto",
+	},
 	{
 		ExtendedType:  (*descriptorpb.FieldOptions)(nil),
 		ExtensionType: (*Field)(nil),
@@ -438,150 +695,144 @@ var file_kube_proto_extTypes = []protoimpl.ExtensionInfo{
 var (
 	// optional dev.f110.kubeproto.Kind kind = 60010;
 	E_Kind = &file_kube_proto_extTypes[0]
+	// optional dev.f110.kubeproto.Message message = 60011;
+	E_Message = &file_kube_proto_extTypes[1]
 )
 
 // Extension fields to descriptorpb.FieldOptions.
 var (
 	// optional dev.f110.kubeproto.Field field = 60010;
-	E_Field = &file_kube_proto_extTypes[1]
+	E_Field = &file_kube_proto_extTypes[2]
 )
 
 // Extension fields to descriptorpb.FileOptions.
 var (
 	// optional dev.f110.kubeproto.Kubernetes k8s = 60010;
-	E_K8S = &file_kube_proto_extTypes[2]
+	E_K8S = &file_kube_proto_extTypes[3]
 	// optional string kubeproto_go_package = 60011;
-	E_KubeprotoGoPackage = &file_kube_proto_extTypes[3]
+	E_KubeprotoGoPackage = &file_kube_proto_extTypes[4]
 )
 
 // Extension fields to descriptorpb.EnumValueOptions.
 var (
 	// optional dev.f110.kubeproto.EnumValue value = 60010;
-	E_Value = &file_kube_proto_extTypes[4]
+	E_Value = &file_kube_proto_extTypes[5]
 )
 
 var File_kube_proto protoreflect.FileDescriptor
 
-var file_kube_proto_rawDesc = []byte{
-	0x0a, 0x0a, 0x6b, 0x75, 0x62, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x12, 0x64, 0x65,
-	0x76, 0x2e, 0x66, 0x31, 0x31, 0x30, 0x2e, 0x6b, 0x75, 0x62, 0x65, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x1a, 0x20, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
-	0x66, 0x2f, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x22, 0x98, 0x01, 0x0a, 0x04, 0x4b, 0x69, 0x6e, 0x64, 0x12, 0x5f, 0x0a, 0x1a, 0x61,
-	0x64, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x5f, 0x70, 0x72, 0x69, 0x6e, 0x74, 0x65,
-	0x72, 0x5f, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x21, 0x2e, 0x64, 0x65, 0x76, 0x2e, 0x66, 0x31, 0x31, 0x30, 0x2e, 0x6b, 0x75, 0x62, 0x65, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x50, 0x72, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x43, 0x6f, 0x6c, 0x75,
-	0x6d, 0x6e, 0x52, 0x18, 0x61, 0x64, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x50, 0x72,
-	0x69, 0x6e, 0x74, 0x65, 0x72, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x12, 0x2f, 0x0a, 0x05,
-	0x73, 0x63, 0x6f, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x64, 0x65,
-	0x76, 0x2e, 0x66, 0x31, 0x31, 0x30, 0x2e, 0x6b, 0x75, 0x62, 0x65, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x2e, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x22, 0x81, 0x01,
-	0x0a, 0x05, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x67, 0x6f, 0x5f, 0x6e, 0x61,
-	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x67, 0x6f, 0x4e, 0x61, 0x6d, 0x65,
-	0x12, 0x16, 0x0a, 0x06, 0x69, 0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x06, 0x69, 0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x75, 0x62, 0x5f,
-	0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b,
-	0x73, 0x75, 0x62, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x24, 0x0a, 0x0e, 0x61,
-	0x70, 0x69, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0c, 0x61, 0x70, 0x69, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x4e, 0x61, 0x6d,
-	0x65, 0x22, 0x8d, 0x01, 0x0a, 0x0a, 0x4b, 0x75, 0x62, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x65, 0x73,
-	0x12, 0x16, 0x0a, 0x06, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x06, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x75, 0x62, 0x5f,
-	0x67, 0x72, 0x6f, 0x75, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x75, 0x62,
-	0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12,
-	0x16, 0x0a, 0x06, 0x73, 0x65, 0x72, 0x76, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52,
-	0x06, 0x73, 0x65, 0x72, 0x76, 0x65, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x74, 0x6f, 0x72, 0x61,
-	0x67, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67,
-	0x65, 0x22, 0xaa, 0x01, 0x0a, 0x0d, 0x50, 0x72, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x43, 0x6f, 0x6c,
-	0x75, 0x6d, 0x6e, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
-	0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69,
-	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x6a, 0x73, 0x6f,
-	0x6e, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6a, 0x73,
-	0x6f, 0x6e, 0x50, 0x61, 0x74, 0x68, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69,
-	0x74, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69,
-	0x74, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74,
-	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x22, 0x21,
-	0x0a, 0x09, 0x45, 0x6e, 0x75, 0x6d, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76,
-	0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
-	0x65, 0x2a, 0x30, 0x0a, 0x05, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x12, 0x14, 0x0a, 0x10, 0x53, 0x43,
-	0x4f, 0x50, 0x45, 0x5f, 0x4e, 0x41, 0x4d, 0x45, 0x53, 0x50, 0x41, 0x43, 0x45, 0x44, 0x10, 0x00,
-	0x12, 0x11, 0x0a, 0x0d, 0x53, 0x43, 0x4f, 0x50, 0x45, 0x5f, 0x43, 0x4c, 0x55, 0x53, 0x54, 0x45,
-	0x52, 0x10, 0x01, 0x3a, 0x4f, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x1f, 0x2e, 0x67, 0x6f,
-	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x4d, 0x65,
-	0x73, 0x73, 0x61, 0x67, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0xea, 0xd4, 0x03,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x64, 0x65, 0x76, 0x2e, 0x66, 0x31, 0x31, 0x30, 0x2e,
-	0x6b, 0x75, 0x62, 0x65, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4b, 0x69, 0x6e, 0x64, 0x52, 0x04,
-	0x6b, 0x69, 0x6e, 0x64, 0x3a, 0x50, 0x0a, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x1d, 0x2e,
-	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
-	0x46, 0x69, 0x65, 0x6c, 0x64, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0xea, 0xd4, 0x03,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x64, 0x65, 0x76, 0x2e, 0x66, 0x31, 0x31, 0x30, 0x2e,
-	0x6b, 0x75, 0x62, 0x65, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x52,
-	0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x3a, 0x50, 0x0a, 0x03, 0x6b, 0x38, 0x73, 0x12, 0x1c, 0x2e,
-	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
-	0x46, 0x69, 0x6c, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0xea, 0xd4, 0x03, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x64, 0x65, 0x76, 0x2e, 0x66, 0x31, 0x31, 0x30, 0x2e, 0x6b,
-	0x75, 0x62, 0x65, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4b, 0x75, 0x62, 0x65, 0x72, 0x6e, 0x65,
-	0x74, 0x65, 0x73, 0x52, 0x03, 0x6b, 0x38, 0x73, 0x3a, 0x50, 0x0a, 0x14, 0x6b, 0x75, 0x62, 0x65,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x5f, 0x67, 0x6f, 0x5f, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65,
-	0x12, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
-	0x75, 0x66, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0xeb,
-	0xd4, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x6b, 0x75, 0x62, 0x65, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x47, 0x6f, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x3a, 0x58, 0x0a, 0x05, 0x76, 0x61,
-	0x6c, 0x75, 0x65, 0x12, 0x21, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6e, 0x75, 0x6d, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x4f,
-	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0xea, 0xd4, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d,
-	0x2e, 0x64, 0x65, 0x76, 0x2e, 0x66, 0x31, 0x31, 0x30, 0x2e, 0x6b, 0x75, 0x62, 0x65, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x2e, 0x45, 0x6e, 0x75, 0x6d, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x76,
-	0x61, 0x6c, 0x75, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
-}
+const file_kube_proto_rawDesc = "" +
+	"\n" +
+	"\n" +
+	"kube.proto\x12\x12dev.f110.kubeproto\x1a google/protobuf/descriptor.proto\"\xc2\x02\n" +
+	"\x04Kind\x12_\n" +
+	"\x1aadditional_printer_columns\x18\x01 \x03(\v2!.dev.f110.kubeproto.PrinterColumnR\x18additionalPrinterColumns\x12/\n" +
+	"\x05scope\x18\x02 \x01(\x0e2\x19.dev.f110.kubeproto.ScopeR\x05scope\x12\x1e\n" +
+	"\n" +
+	"conditions\x18\x03 \x01(\tR\n" +
+	"conditions\x12D\n" +
+	"\rsub_resources\x18\x04 \x03(\v2\x1f.dev.f110.kubeproto.SubResourceR\fsubResources\x12\x1f\n" +
+	"\vshort_names\x18\x05 \x03(\tR\n" +
+	"shortNames\x12!\n" +
+	"\fnon_resource\x18\x06 \x01(\bR\vnonResource\"\x9e\x01\n" +
+	"\vSubResource\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12,\n" +
+	"\x04verb\x18\x02 \x01(\x0e2\x18.dev.f110.kubeproto.VerbR\x04verb\x12\x18\n" +
+	"\arequest\x18\x03 \x01(\tR\arequest\x12\x1a\n" +
+	"\bresponse\x18\x04 \x01(\tR\bresponse\x12\x17\n" +
+	"\ago_name\x18\x05 \x01(\tR\x06goName\"\xd9\x01\n" +
+	"\x05Field\x12\x17\n" +
+	"\ago_name\x18\x01 \x01(\tR\x06goName\x12\x16\n" +
+	"\x06inline\x18\x02 \x01(\bR\x06inline\x12!\n" +
+	"\fsub_resource\x18\x03 \x01(\bR\vsubResource\x12$\n" +
+	"\x0eapi_field_name\x18\x04 \x01(\tR\fapiFieldName\x12\x1e\n" +
+	"\n" +
+	"selectable\x18\x05 \x01(\bR\n" +
+	"selectable\x12\x1c\n" +
+	"\timmutable\x18\x06 \x01(\bR\timmutable\x12\x18\n" +
+	"\aindexed\x18\a \x01(\bR\aindexed\"'\n" +
+	"\aMessage\x12\x1c\n" +
+	"\timmutable\x18\x01 \x01(\bR\timmutable\"\x8d\x01\n" +
+	"\n" +
+	"Kubernetes\x12\x16\n" +
+	"\x06domain\x18\x01 \x01(\tR\x06domain\x12\x1b\n" +
+	"\tsub_group\x18\x02 \x01(\tR\bsubGroup\x12\x18\n" +
+	"\aversion\x18\x03 \x01(\tR\aversion\x12\x16\n" +
+	"\x06served\x18\x04 \x01(\bR\x06served\x12\x18\n" +
+	"\astorage\x18\x05 \x01(\bR\astorage\"\xaa\x01\n" +
+	"\rPrinterColumn\x12 \n" +
+	"\vdescription\x18\x01 \x01(\tR\vdescription\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1b\n" +
+	"\tjson_path\x18\x03 \x01(\tR\bjsonPath\x12\x1a\n" +
+	"\bpriority\x18\x04 \x01(\x05R\bpriority\x12\x12\n" +
+	"\x04type\x18\x05 \x01(\tR\x04type\x12\x16\n" +
+	"\x06format\x18\x06 \x01(\tR\x06format\"!\n" +
+	"\tEnumValue\x12\x14\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value*0\n" +
+	"\x05Scope\x12\x14\n" +
+	"\x10SCOPE_NAMESPACED\x10\x00\x12\x11\n" +
+	"\rSCOPE_CLUSTER\x10\x01*6\n" +
+	"\x04Verb\x12\f\n" +
+	"\bVERB_GET\x10\x00\x12\x0f\n" +
+	"\vVERB_CREATE\x10\x01\x12\x0f\n" +
+	"\vVERB_UPDATE\x10\x02:O\n" +
+	"\x04kind\x12\x1f.google.protobuf.MessageOptions\x18\xea\xd4\x03 \x01(\v2\x18.dev.f110.kubeproto.KindR\x04kind:X\n" +
+	"\amessage\x12\x1f.google.protobuf.MessageOptions\x18\xeb\xd4\x03 \x01(\v2\x1b.dev.f110.kubeproto.MessageR\amessage:P\n" +
+	"\x05field\x12\x1d.google.protobuf.FieldOptions\x18\xea\xd4\x03 \x01(\v2\x19.dev.f110.kubeproto.FieldR\x05field:P\n" +
+	"\x03k8s\x12\x1c.google.protobuf.FileOptions\x18\xea\xd4\x03 \x01(\v2\x1e.dev.f110.kubeproto.KubernetesR\x03k8s:P\n" +
+	"\x14kubeproto_go_package\x12\x1c.google.protobuf.FileOptions\x18\xeb\xd4\x03 \x01(\tR\x12kubeprotoGoPackage:X\n" +
+	"\x05value\x12!.google.protobuf.EnumValueOptions\x18\xea\xd4\x03 \x01(\v2\x1d.dev.f110.kubeproto.EnumValueR\x05valueb\x06proto3"
 
 var (
 	file_kube_proto_rawDescOnce sync.Once
-	file_kube_proto_rawDescData = file_kube_proto_rawDesc
+	file_kube_proto_rawDescData []byte
 )
 
 func file_kube_proto_rawDescGZIP() []byte {
 	file_kube_proto_rawDescOnce.Do(func() {
-		file_kube_proto_rawDescData = protoimpl.X.CompressGZIP(file_kube_proto_rawDescData)
+		file_kube_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_kube_proto_rawDesc), len(file_kube_proto_rawDesc)))
 	})
 	return file_kube_proto_rawDescData
 }
 
-var file_kube_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_kube_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_kube_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_kube_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
 var file_kube_proto_goTypes = []any{
 	(Scope)(0),                            // 0: dev.f110.kubeproto.Scope
-	(*Kind)(nil),                          // 1: dev.f110.kubeproto.Kind
-	(*Field)(nil),                         // 2: dev.f110.kubeproto.Field
-	(*Kubernetes)(nil),                    // 3: dev.f110.kubeproto.Kubernetes
-	(*PrinterColumn)(nil),                 // 4: dev.f110.kubeproto.PrinterColumn
-	(*EnumValue)(nil),                     // 5: dev.f110.kubeproto.EnumValue
-	(*descriptorpb.MessageOptions)(nil),   // 6: google.protobuf.MessageOptions
-	(*descriptorpb.FieldOptions)(nil),     // 7: google.protobuf.FieldOptions
-	(*descriptorpb.FileOptions)(nil),      // 8: google.protobuf.FileOptions
-	(*descriptorpb.EnumValueOptions)(nil), // 9: google.protobuf.EnumValueOptions
+	(Verb)(0),                             // 1: dev.f110.kubeproto.Verb
+	(*Kind)(nil),                          // 2: dev.f110.kubeproto.Kind
+	(*SubResource)(nil),                   // 3: dev.f110.kubeproto.SubResource
+	(*Field)(nil),                         // 4: dev.f110.kubeproto.Field
+	(*Message)(nil),                       // 5: dev.f110.kubeproto.Message
+	(*Kubernetes)(nil),                    // 6: dev.f110.kubeproto.Kubernetes
+	(*PrinterColumn)(nil),                 // 7: dev.f110.kubeproto.PrinterColumn
+	(*EnumValue)(nil),                     // 8: dev.f110.kubeproto.EnumValue
+	(*descriptorpb.MessageOptions)(nil),   // 9: google.protobuf.MessageOptions
+	(*descriptorpb.FieldOptions)(nil),     // 10: google.protobuf.FieldOptions
+	(*descriptorpb.FileOptions)(nil),      // 11: google.protobuf.FileOptions
+	(*descriptorpb.EnumValueOptions)(nil), // 12: google.protobuf.EnumValueOptions
 }
 var file_kube_proto_depIdxs = []int32{
-	4,  // 0: dev.f110.kubeproto.Kind.additional_printer_columns:type_name -> dev.f110.kubeproto.PrinterColumn
+	7,  // 0: dev.f110.kubeproto.Kind.additional_printer_columns:type_name -> dev.f110.kubeproto.PrinterColumn
 	0,  // 1: dev.f110.kubeproto.Kind.scope:type_name -> dev.f110.kubeproto.Scope
-	6,  // 2: dev.f110.kubeproto.kind:extendee -> google.protobuf.MessageOptions
-	7,  // 3: dev.f110.kubeproto.field:extendee -> google.protobuf.FieldOptions
-	8,  // 4: dev.f110.kubeproto.k8s:extendee -> google.protobuf.FileOptions
-	8,  // 5: dev.f110.kubeproto.kubeproto_go_package:extendee -> google.protobuf.FileOptions
-	9,  // 6: dev.f110.kubeproto.value:extendee -> google.protobuf.EnumValueOptions
-	1,  // 7: dev.f110.kubeproto.kind:type_name -> dev.f110.kubeproto.Kind
-	2,  // 8: dev.f110.kubeproto.field:type_name -> dev.f110.kubeproto.Field
-	3,  // 9: dev.f110.kubeproto.k8s:type_name -> dev.f110.kubeproto.Kubernetes
-	5,  // 10: dev.f110.kubeproto.value:type_name -> dev.f110.kubeproto.EnumValue
-	11, // [11:11] is the sub-list for method output_type
-	11, // [11:11] is the sub-list for method input_type
-	7,  // [7:11] is the sub-list for extension type_name
-	2,  // [2:7] is the sub-list for extension extendee
-	0,  // [0:2] is the sub-list for field type_name
+	3,  // 2: dev.f110.kubeproto.Kind.sub_resources:type_name -> dev.f110.kubeproto.SubResource
+	1,  // 3: dev.f110.kubeproto.SubResource.verb:type_name -> dev.f110.kubeproto.Verb
+	9,  // 4: dev.f110.kubeproto.kind:extendee -> google.protobuf.MessageOptions
+	9,  // 5: dev.f110.kubeproto.message:extendee -> google.protobuf.MessageOptions
+	10, // 6: dev.f110.kubeproto.field:extendee -> google.protobuf.FieldOptions
+	11, // 7: dev.f110.kubeproto.k8s:extendee -> google.protobuf.FileOptions
+	11, // 8: dev.f110.kubeproto.kubeproto_go_package:extendee -> google.protobuf.FileOptions
+	12, // 9: dev.f110.kubeproto.value:extendee -> google.protobuf.EnumValueOptions
+	2,  // 10: dev.f110.kubeproto.kind:type_name -> dev.f110.kubeproto.Kind
+	5,  // 11: dev.f110.kubeproto.message:type_name -> dev.f110.kubeproto.Message
+	4,  // 12: dev.f110.kubeproto.field:type_name -> dev.f110.kubeproto.Field
+	6,  // 13: dev.f110.kubeproto.k8s:type_name -> dev.f110.kubeproto.Kubernetes
+	8,  // 14: dev.f110.kubeproto.value:type_name -> dev.f110.kubeproto.EnumValue
+	15, // [15:15] is the sub-list for method output_type
+	15, // [15:15] is the sub-list for method input_type
+	10, // [10:15] is the sub-list for extension type_name
+	4,  // [4:10] is the sub-list for extension extendee
+	0,  // [0:4] is the sub-list for field type_name
 }
 
 func init() { file_kube_proto_init() }
@@ -593,10 +844,10 @@ func file_kube_proto_init() {
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
-			RawDescriptor: file_kube_proto_rawDesc,
-			NumEnums:      1,
-			NumMessages:   5,
-			NumExtensions: 5,
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_kube_proto_rawDesc), len(file_kube_proto_rawDesc)),
+			NumEnums:      2,
+			NumMessages:   7,
+			NumExtensions: 6,
 			NumServices:   0,
 		},
 		GoTypes:           file_kube_proto_goTypes,
@@ -606,7 +857,6 @@ func file_kube_proto_init() {
 		ExtensionInfos:    file_kube_proto_extTypes,
 	}.Build()
 	File_kube_proto = out.File
-	file_kube_proto_rawDesc = nil
 	file_kube_proto_goTypes = nil
 	file_kube_proto_depIdxs = nil
 }